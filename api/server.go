@@ -26,22 +26,34 @@ import (
 	"care-cordination/features/dashboard"
 	"care-cordination/features/employee"
 	"care-cordination/features/evaluation"
+	"care-cordination/features/health"
 	"care-cordination/features/incident"
 	"care-cordination/features/intake"
 	locTransfer "care-cordination/features/location_transfer"
 	"care-cordination/features/locations"
-	"care-cordination/lib/middleware"
+	"care-cordination/features/metadata"
 	"care-cordination/features/notification"
 	"care-cordination/features/rbac"
 	referringOrgs "care-cordination/features/referring_orgs"
 	"care-cordination/features/registration"
 	"care-cordination/lib/logger"
+	"care-cordination/lib/middleware"
 	"care-cordination/lib/ratelimit"
+	"care-cordination/lib/resp"
+	"care-cordination/lib/version"
 	"care-cordination/lib/websocket"
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"slices"
+	"sync"
 	"time"
 
+	"github.com/getkin/kin-openapi/openapi2"
+	"github.com/getkin/kin-openapi/openapi2conv"
+	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gin-contrib/cors"
 	ginzap "github.com/gin-contrib/zap"
 	"github.com/gin-gonic/gin"
@@ -71,13 +83,36 @@ type Server struct {
 	notificationHandler *notification.NotificationHandler
 	auditHandler        *audit.AuditHandler
 	dashboardHandler    *dashboard.DashboardHandler
+	metadataHandler     *metadata.MetadataHandler
+	healthHandler       *health.HealthHandler
 	wsHub               *websocket.Hub
 
 	environment string
 	rateLimiter ratelimit.RateLimiter
+	clientIP    gin.HandlerFunc
+	ipAllowlist gin.HandlerFunc
+	compression gin.HandlerFunc
+	security    gin.HandlerFunc
 	logger      logger.Logger
 	addr        string
 	url         string
+	timeouts    Timeouts
+}
+
+// Timeouts configures the underlying http.Server. A zero value falls back to
+// the matching default, since net/http treats zero as "no timeout".
+type Timeouts struct {
+	ReadHeader time.Duration
+	Read       time.Duration
+	Write      time.Duration
+	Idle       time.Duration
+}
+
+var defaultTimeouts = Timeouts{
+	ReadHeader: 5 * time.Second,
+	Read:       5 * time.Second,
+	Write:      10 * time.Second,
+	Idle:       60 * time.Second,
 }
 
 func NewServer(
@@ -99,8 +134,15 @@ func NewServer(
 	notificationHandler *notification.NotificationHandler,
 	auditHandler *audit.AuditHandler,
 	dashboardHandler *dashboard.DashboardHandler,
+	metadataHandler *metadata.MetadataHandler,
+	healthHandler *health.HealthHandler,
 	wsHub *websocket.Hub,
-	rateLimiter ratelimit.RateLimiter, addr string, url string) *Server {
+	rateLimiter ratelimit.RateLimiter,
+	clientIP gin.HandlerFunc,
+	ipAllowlist gin.HandlerFunc,
+	compression gin.HandlerFunc,
+	security gin.HandlerFunc,
+	timeouts Timeouts, addr string, url string) *Server {
 	s := &Server{
 		environment:         environment,
 		authHandler:         authHandler,
@@ -108,6 +150,10 @@ func NewServer(
 		registrationHandler: registrationHandler,
 		attachmentsHandler:  attachmentsHandler,
 		rateLimiter:         rateLimiter,
+		clientIP:            clientIP,
+		ipAllowlist:         ipAllowlist,
+		compression:         compression,
+		security:            security,
 		locationHandler:     locationHandler,
 		intakeHandler:       intakeHandler,
 		incidentHandler:     incidentHandler,
@@ -120,25 +166,40 @@ func NewServer(
 		notificationHandler: notificationHandler,
 		auditHandler:        auditHandler,
 		dashboardHandler:    dashboardHandler,
+		metadataHandler:     metadataHandler,
+		healthHandler:       healthHandler,
 		wsHub:               wsHub,
 		logger:              logger,
 		addr:                addr,
 		url:                 url,
+		timeouts:            timeouts,
 	}
 	s.setupRoutes(logger)
 	return s
 }
 
 func (s *Server) Start() error {
-	s.httpServer = &http.Server{
-		Addr:         s.addr,
-		Handler:      s.router,
-		ReadTimeout:  5 * time.Second,
-		WriteTimeout: 10 * time.Second,
-	}
+	s.httpServer = newHTTPServer(s.addr, s.router, s.timeouts)
 	return s.httpServer.ListenAndServe()
 }
 
+func newHTTPServer(addr string, handler http.Handler, timeouts Timeouts) *http.Server {
+	orDefault := func(d, def time.Duration) time.Duration {
+		if d <= 0 {
+			return def
+		}
+		return d
+	}
+	return &http.Server{
+		Addr:              addr,
+		Handler:           handler,
+		ReadHeaderTimeout: orDefault(timeouts.ReadHeader, defaultTimeouts.ReadHeader),
+		ReadTimeout:       orDefault(timeouts.Read, defaultTimeouts.Read),
+		WriteTimeout:      orDefault(timeouts.Write, defaultTimeouts.Write),
+		IdleTimeout:       orDefault(timeouts.Idle, defaultTimeouts.Idle),
+	}
+}
+
 func (s *Server) Shutdown(ctx context.Context) error {
 	return s.httpServer.Shutdown(ctx)
 }
@@ -167,9 +228,15 @@ func (s *Server) setupRoutes(logger logger.Logger) {
 		MaxAge:           12 * time.Hour,
 	}))
 
+	// Security headers - set early so error responses carry them too
+	router.Use(s.security)
+
 	// Request ID middleware - must be before ginzap for logging
 	router.Use(middleware.RequestIDMiddleware())
 
+	// Client IP resolved against the trusted proxies, for audit and session records
+	router.Use(s.clientIP)
+
 	router.Use(ginzap.GinzapWithConfig(logger.ZapLogger(), &ginzap.Config{
 		UTC:        true,
 		TimeFormat: "2006-01-02 15:04:05",
@@ -183,7 +250,18 @@ func (s *Server) setupRoutes(logger logger.Logger) {
 	}))
 	router.Use(ginzap.RecoveryWithZap(logger.ZapLogger(), true))
 
+	// IP allowlist for sensitive routes - after logging so rejections are logged
+	router.Use(s.ipAllowlist)
+
+	// Gzip compression for clients that accept it
+	router.Use(s.compression)
+
+	// Queries cancelled by the database statement timeout answer 503, not 500
+	router.Use(middleware.StatementTimeoutMiddleware())
+
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
+	router.GET("/openapi.json", handleOpenAPI)
+	router.GET("/version", handleVersion)
 
 	s.authHandler.SetupAuthRoutes(router, s.rateLimiter)
 	s.employeeHandler.SetupEmployeeRoutes(router)
@@ -201,9 +279,70 @@ func (s *Server) setupRoutes(logger logger.Logger) {
 	s.notificationHandler.SetupRoutes(router)
 	s.auditHandler.SetupAuditRoutes(router)
 	s.dashboardHandler.SetupDashboardRoutes(router)
+	s.metadataHandler.SetupMetadataRoutes(router)
+	s.healthHandler.SetupHealthRoutes(router)
 	s.router = router
 }
 
+// @Summary Get build version
+// @Description Get the version, git commit and build time of the running API
+// @Tags System
+// @Produce json
+// @Success 200 {object} resp.SuccessResponse[version.Info]
+// @Router /version [get]
+func handleVersion(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, resp.Success(version.Get(), "Version retrieved successfully"))
+}
+
+// ErrInternal is returned instead of errors the client cannot act on
+var ErrInternal = errors.New("internal server error")
+
+// openAPIDocument converts the generated Swagger 2.0 document to OpenAPI 3.0
+// once; setupSwagger fills in docs.SwaggerInfo before the router serves any
+// request
+var openAPIDocument = sync.OnceValues(func() ([]byte, error) {
+	var v2 openapi2.T
+	if err := json.Unmarshal([]byte(docs.SwaggerInfo.ReadDoc()), &v2); err != nil {
+		return nil, fmt.Errorf("parse swagger document: %w", err)
+	}
+	v3, err := openapi2conv.ToV3(&v2)
+	if err != nil {
+		return nil, fmt.Errorf("convert swagger document: %w", err)
+	}
+
+	// swag marks operations that need no token with a "-" requirement, which
+	// OpenAPI spells as an empty list
+	for _, path := range v3.Paths.Map() {
+		for _, op := range path.Operations() {
+			if op.Security != nil && slices.ContainsFunc(*op.Security, func(req openapi3.SecurityRequirement) bool {
+				_, ok := req["-"]
+				return ok
+			}) {
+				op.Security = openapi3.NewSecurityRequirements()
+			}
+		}
+	}
+	return json.Marshal(v3)
+})
+
+// @Summary Get the OpenAPI specification
+// @Description Get the API contract as an OpenAPI 3.0 document, generated from the handler annotations
+// @Tags System
+// @Produce json
+// @Success 200 {object} map[string]any
+// @Failure 500 {object} resp.ErrorResponse
+// @Router /openapi.json [get]
+func handleOpenAPI(ctx *gin.Context) {
+	spec, err := openAPIDocument()
+	if err != nil {
+		// Recorded for the request logger; the client only learns it failed
+		_ = ctx.Error(err)
+		ctx.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		return
+	}
+	ctx.Data(http.StatusOK, "application/json", spec)
+}
+
 func (s *Server) setupSwagger() {
 	docs.SwaggerInfo.Title = "Care-Cordination API"
 	docs.SwaggerInfo.Description = "This is the Care-Cordination server API documentation."
@@ -0,0 +1,183 @@
+package api
+
+import (
+	"bytes"
+	"care-cordination/lib/openapi"
+	"care-cordination/lib/resp"
+	"care-cordination/lib/version"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func()
+		expected version.Info
+	}{
+		{
+			name:     "defaults_when_not_injected",
+			setup:    func() {},
+			expected: version.Info{Version: "dev", Commit: "dev", BuildTime: "dev"},
+		},
+		{
+			name: "injected_build_info",
+			setup: func() {
+				version.Version = "v1.4.0"
+				version.Commit = "a1b2c3d"
+				version.BuildTime = "2026-01-22T10:00:00Z"
+			},
+			expected: version.Info{Version: "v1.4.0", Commit: "a1b2c3d", BuildTime: "2026-01-22T10:00:00Z"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Restore the link-time values after each case
+			origVersion, origCommit, origBuildTime := version.Version, version.Commit, version.BuildTime
+			t.Cleanup(func() {
+				version.Version, version.Commit, version.BuildTime = origVersion, origCommit, origBuildTime
+			})
+			tt.setup()
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.GET("/version", handleVersion)
+
+			req, _ := http.NewRequest(http.MethodGet, "/version", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+
+			var body resp.SuccessResponse[version.Info]
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+			assert.True(t, body.Success)
+			assert.Equal(t, tt.expected, body.Data)
+		})
+	}
+}
+
+func TestHandleOpenAPI(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/openapi.json", handleOpenAPI)
+
+	req, _ := http.NewRequest(http.MethodGet, "/openapi.json", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	require.NoError(t, openapi.Validate(w.Body.Bytes()))
+
+	var spec struct {
+		OpenAPI    string                               `json:"openapi"`
+		Paths      map[string]map[string]map[string]any `json:"paths"`
+		Components struct {
+			Schemas         map[string]any `json:"schemas"`
+			SecuritySchemes map[string]any `json:"securitySchemes"`
+		} `json:"components"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &spec))
+
+	assert.Equal(t, openapi.Version, spec.OpenAPI)
+	assert.Contains(t, spec.Components.SecuritySchemes, "Bearer")
+	assert.Contains(t, spec.Components.Schemas, "resp.ErrorResponse")
+
+	for path, method := range map[string]string{
+		"/clients/waiting-list": "get",
+		"/clients/in-care":      "get",
+		"/clients/discharged":   "get",
+		"/auth/login":           "post",
+	} {
+		assert.Contains(t, spec.Paths[path], method, "%s %s missing from the spec", method, path)
+	}
+
+	// Login is the one operation that must not demand a token
+	assert.Equal(t, []any{}, spec.Paths["/auth/login"]["post"]["security"])
+}
+
+func TestHandleVersion_MatchesContract(t *testing.T) {
+	spec, err := openAPIDocument()
+	require.NoError(t, err)
+	doc, err := openapi.Load(spec)
+	require.NoError(t, err)
+	// Match on the path only, whatever host the spec names
+	doc.Servers = nil
+	contract, err := gorillamux.NewRouter(doc)
+	require.NoError(t, err)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/version", handleVersion)
+
+	req, _ := http.NewRequest(http.MethodGet, "/version", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	route, pathParams, err := contract.FindRoute(req)
+	require.NoError(t, err)
+	input := &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: &openapi3filter.RequestValidationInput{
+			Request:    req,
+			PathParams: pathParams,
+			Route:      route,
+		},
+		Status: w.Code,
+		Header: w.Header(),
+	}
+	input.SetBodyBytes(bytes.Clone(w.Body.Bytes()))
+	require.NoError(t, openapi3filter.ValidateResponse(req.Context(), input))
+}
+
+func TestNewHTTPServer_Timeouts(t *testing.T) {
+	tests := []struct {
+		name     string
+		timeouts Timeouts
+		expected Timeouts
+	}{
+		{
+			name:     "defaults_when_unset",
+			timeouts: Timeouts{},
+			expected: defaultTimeouts,
+		},
+		{
+			name: "configured_values",
+			timeouts: Timeouts{
+				ReadHeader: 2 * time.Second,
+				Read:       20 * time.Second,
+				Write:      30 * time.Second,
+				Idle:       90 * time.Second,
+			},
+			expected: Timeouts{
+				ReadHeader: 2 * time.Second,
+				Read:       20 * time.Second,
+				Write:      30 * time.Second,
+				Idle:       90 * time.Second,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newHTTPServer(":0", http.NewServeMux(), tt.timeouts)
+
+			assert.NotZero(t, srv.ReadHeaderTimeout)
+			assert.Equal(t, tt.expected.ReadHeader, srv.ReadHeaderTimeout)
+			assert.Equal(t, tt.expected.Read, srv.ReadTimeout)
+			assert.Equal(t, tt.expected.Write, srv.WriteTimeout)
+			assert.Equal(t, tt.expected.Idle, srv.IdleTimeout)
+		})
+	}
+}
@@ -3,12 +3,10 @@ package main
 import (
 	"care-cordination/lib/config"
 	db "care-cordination/lib/db/sqlc"
-	"care-cordination/lib/nanoid"
 	"context"
 	"log"
-	"time"
+	"os"
 
-	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"golang.org/x/crypto/bcrypt"
 )
@@ -19,9 +17,34 @@ func main() {
 		log.Fatalf("cannot load config: %v", err)
 	}
 
+	// Without a command the admin user is bootstrapped
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "purge-discharged":
+			purgeDischarged(cfg, os.Args[2:])
+		default:
+			log.Fatalf("unknown command %q (available: purge-discharged)", os.Args[1])
+		}
+		return
+	}
+
+	createAdmin(cfg)
+}
+
+// createAdmin bootstraps the admin user. It can be run repeatedly: on later
+// runs it restores the admin role and its permissions, resets the admin's
+// password to ADMIN_PASSWORD and re-assigns the admin role if it was removed.
+func createAdmin(cfg *config.Config) {
 	if cfg.AdminEmail == "" || cfg.AdminPassword == "" {
 		log.Fatal("ADMIN_EMAIL and ADMIN_PASSWORD must be set")
 	}
+	policy, err := cfg.PasswordPolicy()
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := policy.Validate(cfg.AdminPassword); err != nil {
+		log.Fatalf("ADMIN_PASSWORD rejected: %v", err)
+	}
 
 	connPool, err := pgxpool.New(context.Background(), cfg.DBSource)
 	if err != nil {
@@ -32,14 +55,6 @@ func main() {
 	store := db.NewStore(connPool)
 	ctx := context.Background()
 
-	// Check if admin user already exists
-	_, err = store.GetUserByEmail(ctx, cfg.AdminEmail)
-	if err == nil {
-		log.Printf("Admin user %s already exists", cfg.AdminEmail)
-		return
-	}
-
-	// Create admin user
 	hashedPassword, err := bcrypt.GenerateFromPassword(
 		[]byte(cfg.AdminPassword),
 		bcrypt.DefaultCost,
@@ -48,61 +63,20 @@ func main() {
 		log.Fatalf("cannot hash password: %v", err)
 	}
 
-	userID := nanoid.Generate()
-	employeeID := nanoid.Generate()
-
-	// Create user
-	_, err = store.CreateUser(ctx, db.CreateUserParams{
-		ID:           userID,
-		Email:        cfg.AdminEmail,
-		PasswordHash: string(hashedPassword),
+	result, err := store.BootstrapAdminTx(ctx, db.BootstrapAdminTxParams{
+		Email:            cfg.AdminEmail,
+		PasswordHash:     string(hashedPassword),
+		OrganizationName: cfg.AdminOrganizationName,
 	})
 	if err != nil {
-		log.Fatalf("cannot create admin user: %v", err)
+		log.Fatalf("cannot bootstrap admin: %v", err)
 	}
-	log.Printf("Admin user created with ID: %s", userID)
 
-	// Create a default system location for admin
-	locationID := nanoid.Generate()
-	err = store.CreateLocation(ctx, db.CreateLocationParams{
-		ID:         locationID,
-		Name:       "System Location",
-		PostalCode: "0000AA",
-		Address:    "System",
-		Capacity:   0,
-		Occupied:   0,
-	})
-	if err != nil {
-		log.Fatalf("cannot create system location: %v", err)
-	}
-	log.Printf("System location created with ID: %s", locationID)
-
-	// Create employee record for admin
-	err = store.CreateEmployee(ctx, db.CreateEmployeeParams{
-		ID:          employeeID,
-		UserID:      userID,
-		FirstName:   "System",
-		LastName:    "Admin",
-		Bsn:         "000000000",
-		DateOfBirth: pgtype.Date{Time: time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC), Valid: true},
-		PhoneNumber: "0000000000",
-		Gender:      db.GenderEnumOther,
-		LocationID:  locationID,
-	})
-	if err != nil {
-		log.Fatalf("cannot create admin employee: %v", err)
+	if result.Created {
+		log.Printf("Admin user %s created with ID: %s (organization %q)",
+			cfg.AdminEmail, result.UserID, cfg.AdminOrganizationName)
+	} else {
+		log.Printf("Admin user %s already exists; password and admin role updated", cfg.AdminEmail)
 	}
-	log.Printf("Admin employee created with ID: %s", employeeID)
-
-	// Assign admin role (role_admin is preset in the migration)
-	err = store.AssignRoleToUser(ctx, db.AssignRoleToUserParams{
-		UserID: userID,
-		RoleID: "role_admin",
-	})
-	if err != nil {
-		log.Fatalf("cannot assign admin role: %v", err)
-	}
-	log.Printf("Admin role assigned to user %s", cfg.AdminEmail)
-
 	log.Println("Admin setup complete!")
 }
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"care-cordination/lib/config"
+	db "care-cordination/lib/db/sqlc"
+	"context"
+	"flag"
+	"log"
+	"os"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// purgeDischarged removes the personal data of clients discharged longer ago
+// than the retention period. Clients keep their non-identifying fields so
+// statistics stay intact.
+func purgeDischarged(cfg *config.Config, args []string) {
+	flags := flag.NewFlagSet("purge-discharged", flag.ExitOnError)
+	months := flags.Int("months", cfg.ClientRetentionMonths,
+		"purge clients discharged more than this many months ago (defaults to CLIENT_RETENTION_MONTHS)")
+	dryRun := flags.Bool("dry-run", false, "only list the clients that would be purged")
+	flags.Parse(args)
+
+	if *months < 1 {
+		log.Fatal("retention period is not set: set CLIENT_RETENTION_MONTHS or pass -months")
+	}
+
+	connPool, err := pgxpool.New(context.Background(), cfg.DBSource)
+	if err != nil {
+		log.Fatalf("cannot connect to db: %v", err)
+	}
+	defer connPool.Close()
+
+	store := db.NewStore(connPool)
+	ctx := context.Background()
+
+	clients, err := store.ListClientsDueForPurge(ctx, int32(*months))
+	if err != nil {
+		log.Fatalf("cannot list clients due for purge: %v", err)
+	}
+	log.Printf("%d discharged client(s) past the %d-month retention period", len(clients), *months)
+
+	purged, failed := 0, 0
+	for _, client := range clients {
+		discharged := client.DischargeDate.Time.Format("2006-01-02")
+		if *dryRun {
+			log.Printf("Would purge client %s (discharged %s)", client.ID, discharged)
+			continue
+		}
+
+		err := store.PurgeClientPIITx(ctx, db.PurgeClientPIITxParams{
+			ClientID:           client.ID,
+			RegistrationFormID: client.RegistrationFormID,
+			IntakeFormID:       client.IntakeFormID,
+			RetentionMonths:    int32(*months),
+		})
+		if err != nil {
+			log.Printf("cannot purge client %s: %v", client.ID, err)
+			failed++
+			continue
+		}
+		log.Printf("Purged client %s (discharged %s): name, BSN, date of birth (year kept), phone, "+
+			"notes and reports; registration form %s; intake form %s; client notes",
+			client.ID, discharged, client.RegistrationFormID, client.IntakeFormID)
+		purged++
+	}
+
+	if *dryRun {
+		log.Println("Dry run complete, nothing was changed")
+		return
+	}
+	log.Printf("Purge complete: %d purged, %d failed", purged, failed)
+	if failed > 0 {
+		os.Exit(1)
+	}
+}
@@ -10,22 +10,31 @@ import (
 	"care-cordination/features/dashboard"
 	"care-cordination/features/employee"
 	"care-cordination/features/evaluation"
+	"care-cordination/features/health"
 	"care-cordination/features/incident"
 	"care-cordination/features/intake"
 	locTransfer "care-cordination/features/location_transfer"
 	"care-cordination/features/locations"
+	"care-cordination/features/metadata"
 	"care-cordination/features/notification"
 	"care-cordination/features/rbac"
 	referringOrgs "care-cordination/features/referring_orgs"
 	"care-cordination/features/registration"
+	"care-cordination/lib/assignment"
 	libAudit "care-cordination/lib/audit"
 	"care-cordination/lib/bucket"
 	"care-cordination/lib/config"
+	"care-cordination/lib/db/pool"
 	db "care-cordination/lib/db/sqlc"
+	"care-cordination/lib/email"
+	"care-cordination/lib/exportlimit"
+	"care-cordination/lib/featureflags"
 	"care-cordination/lib/logger"
 	"care-cordination/lib/middleware"
 	"care-cordination/lib/ratelimit"
 	"care-cordination/lib/token"
+	"care-cordination/lib/urgency"
+	"care-cordination/lib/util"
 	"care-cordination/lib/websocket"
 
 	"context"
@@ -48,6 +57,9 @@ func main() {
 	if err != nil {
 		log.Fatalf("cannot load config: %v", err)
 	}
+	if err := util.SetAppTimezone(cfg.AppTimezone); err != nil {
+		log.Fatalf("cannot set app timezone: %v", err)
+	}
 
 	// 2. Initialize Logger
 	l := logger.NewLogger(cfg.Environment)
@@ -65,8 +77,12 @@ func main() {
 	}
 
 	poolConfig.ConnConfig.DefaultQueryExecMode = pgx.QueryExecModeDescribeExec
+	pool.ConfigureStatementTimeout(poolConfig, cfg.DBStatementTimeout)
 
-	connPool, err := pgxpool.NewWithConfig(ctx, poolConfig)
+	connPool, err := pool.Connect(ctx, poolConfig, pool.Options{
+		MaxAttempts:  cfg.DBConnectAttempts,
+		RetryBackoff: cfg.DBConnectBackoff,
+	}, l)
 	if err != nil {
 		l.Error(ctx, "main", "cannot connect to db", zap.Error(err))
 		os.Exit(1)
@@ -74,7 +90,10 @@ func main() {
 	defer connPool.Close()
 
 	// 4. Initialize Dependencies
-	store := db.NewStore(connPool)
+	store := db.NewStoreWithTxRetry(connPool, db.TxRetryOptions{
+		MaxRetries:   cfg.DBTxMaxRetries,
+		RetryBackoff: cfg.DBTxRetryBackoff,
+	})
 	tokenManager := token.NewTokenManager(
 		cfg.AccessTokenSecret,
 		cfg.RefreshTokenSecret,
@@ -90,6 +109,11 @@ func main() {
 		cfg.MinioSecretAccessKey,
 		cfg.MinioUseSSL,
 		cfg.MinioBucketName,
+		bucket.Options{
+			OperationTimeout: cfg.MinioOpTimeout,
+			MaxRetries:       cfg.MinioMaxRetries,
+			RetryBackoff:     cfg.MinioRetryBackoff,
+		},
 	)
 	if err != nil {
 		l.Error(ctx, "main", "cannot create object storage client", zap.Error(err))
@@ -112,6 +136,7 @@ func main() {
 			EmailLimit:     cfg.LoginRateLimitPerEmail,
 			EmailWindow:    cfg.LoginRateLimitWindowEmail,
 			EnableFallback: true, // Use in-memory fallback if Redis fails
+			Logger:         l,
 		}
 
 		rateLimiter, err = ratelimit.NewRateLimiter(rlConfig)
@@ -138,19 +163,49 @@ func main() {
 	// 5. Initialize Features
 	// Create audit logger first (needed by middleware)
 	auditLogger := libAudit.NewAuditLoggerService(*store, l)
-	mdw := middleware.NewMiddleware(tokenManager, rateLimiter, l, store, auditLogger)
+	mdw, err := middleware.NewMiddleware(
+		tokenManager,
+		rateLimiter,
+		l,
+		store,
+		auditLogger,
+		cfg.SearchMinLength,
+		cfg.TrustedProxies,
+	)
+	if err != nil {
+		l.Error(ctx, "main", "invalid trusted proxy configuration", zap.Error(err))
+		os.Exit(1)
+	}
 
-	authService := auth.NewAuthServiceWithMFA(store, tokenManager, l, cfg.MFASecretKey, cfg.MFAIssuer)
+	passwordPolicy, err := cfg.PasswordPolicy()
+	if err != nil {
+		l.Error(ctx, "main", "invalid password policy configuration", zap.Error(err))
+		os.Exit(1)
+	}
+
+	authService := auth.NewAuthServiceWithMFA(
+		store,
+		tokenManager,
+		l,
+		cfg.MFASecretKey,
+		cfg.MFAIssuer,
+		auth.LockoutPolicy{
+			MaxAttempts: cfg.LoginLockoutThreshold,
+			Duration:    cfg.LoginLockoutDuration,
+		},
+		passwordPolicy,
+	)
 	authHandler := auth.NewAuthHandler(authService, mdw)
 
-	employeeService := employee.NewEmployeeService(store, l)
+	employeeService := employee.NewEmployeeService(store, l, passwordPolicy)
 	employeeHandler := employee.NewEmployeeHandler(employeeService, mdw)
 
-	registrationService := registration.NewRegistrationService(store, l)
-	registrationHandler := registration.NewRegistrationHandler(registrationService, mdw)
-
-	attachmentsService := attachments.NewAttachmentsService(store, bucketClient, l)
-	attachmentsHandler := attachments.NewAttachmentsHandler(attachmentsService, mdw)
+	uploadLimits := attachments.UploadLimits{
+		MaxSize:        cfg.MaxUploadSize,
+		PerContentType: cfg.UploadSizeLimits,
+	}
+	attachmentsService := attachments.NewAttachmentsService(store, bucketClient, l, uploadLimits)
+	attachmentsHandler := attachments.NewAttachmentsHandler(attachmentsService, mdw, uploadLimits)
 
 	referringOrgService := referringOrgs.NewReferringOrgService(store, l)
 	referringOrgHandler := referringOrgs.NewReferringOrgHandler(referringOrgService, mdw)
@@ -158,23 +213,38 @@ func main() {
 	locationService := locations.NewLocationService(store, l)
 	locationHandler := locations.NewLocationHandler(locationService, mdw)
 
-	intakeService := intake.NewIntakeService(store, l)
+	caseloadLimit := assignment.CaseloadLimit(cfg.DefaultMaxCaseload)
+
+	intakeService := intake.NewIntakeService(
+		store,
+		l,
+		cfg.TextFieldMaxLength,
+		cfg.CoordinatorLocationCheck,
+		caseloadLimit,
+	)
 	intakeHandler := intake.NewIntakeHandler(intakeService, mdw)
 
-	evaluationService := evaluation.NewEvaluationService(store, l)
+	evaluationService := evaluation.NewEvaluationService(store, l, cfg.EvaluationIntervalWeeks)
 	evaluationHandler := evaluation.NewEvaluationHandler(evaluationService, mdw)
 
-	clientService := client.NewClientService(store, l)
-	clientHandler := client.NewClientHandler(clientService, mdw)
+	clientService := client.NewClientService(
+		store,
+		l,
+		cfg.TextFieldMaxLength,
+		cfg.CoordinatorLocationCheck,
+		caseloadLimit,
+	)
+	clientHandler := client.NewClientHandler(
+		clientService,
+		mdw,
+		exportlimit.New(cfg.ExportConcurrency, cfg.ExportQueueSize),
+	)
 
-	rbacService := rbac.NewRBACService(store, l)
+	rbacService := rbac.NewRBACService(store, l, auditLogger)
 	rbacHandler := rbac.NewRBACHandler(rbacService, mdw)
 
-	calendarService := calendar.NewCalendarService(store, l)
-	calendarHandler := calendar.NewCalendarHandler(calendarService, mdw)
-
 	// Initialize WebSocket Hub and Notification Feature
-	wsHub := websocket.NewHub(l)
+	wsHub := websocket.NewHubWithBatchWindow(l, cfg.NotificationBatchWindow)
 	go wsHub.Run() // Start hub in background
 
 	// Initialize Redis client for ticket manager
@@ -192,7 +262,26 @@ func main() {
 		l.Warn(ctx, "main", "redis URL not set, websocket auth tickets disabled")
 	}
 
-	notificationService := notification.NewNotificationService(store, wsHub, l)
+	delivery, err := notification.NewDeliveryConfig(
+		cfg.NotificationRouting,
+		cfg.NotificationDigestInterval,
+		email.SMTPConfig{
+			Host:     cfg.SMTPHost,
+			Port:     cfg.SMTPPort,
+			Username: cfg.SMTPUsername,
+			Password: cfg.SMTPPassword,
+			From:     cfg.SMTPFrom,
+		},
+		email.RetryPolicy{
+			Attempts: cfg.SMTPSendAttempts,
+			Backoff:  cfg.SMTPRetryBackoff,
+		},
+	)
+	if err != nil {
+		l.Error(ctx, "main", "invalid notification delivery settings", zap.Error(err))
+		os.Exit(1)
+	}
+	notificationService := notification.NewNotificationServiceWithDelivery(store, wsHub, l, delivery)
 	notificationHandler := notification.NewNotificationHandler(
 		notificationService,
 		wsHub,
@@ -202,20 +291,84 @@ func main() {
 	)
 
 	// Services with notification triggers
-	locTransferService := locTransfer.NewLocationTransferService(store, l, notificationService)
+	registrationService := registration.NewRegistrationService(
+		store,
+		l,
+		notificationService,
+		cfg.MaxAttachmentsPerForm,
+	)
+	registrationHandler := registration.NewRegistrationHandler(registrationService, mdw)
+
+	locTransferService := locTransfer.NewLocationTransferService(
+		store,
+		l,
+		notificationService,
+		cfg.CoordinatorLocationCheck,
+		caseloadLimit,
+	)
 	locTransferHandler := locTransfer.NewLocTransferHandler(locTransferService, mdw)
 
-	incidentService := incident.NewIncidentService(store, l, notificationService)
+	incidentService := incident.NewIncidentService(store, l, notificationService, auditLogger)
 	incidentHandler := incident.NewIncidentHandler(incidentService, mdw)
 
+	calendarService := calendar.NewCalendarService(store, l, notificationService)
+	calendarHandler := calendar.NewCalendarHandler(calendarService, mdw)
+
 	// Audit Service - NEN7510/ISO27001 compliant audit logging
 	auditService := featureAudit.NewAuditService(*store, l)
 	auditHandler := featureAudit.NewAuditHandler(auditService, mdw)
 
+	// Feature flags for experimental features, shared by services that consult them
+	flags := featureflags.NewFeatureFlags(store, l, cfg.FeatureFlagCacheTTL)
+
 	// Dashboard Service
-	dashboardService := dashboard.NewDashboardService(store, l)
+	dashboardService := dashboard.NewDashboardService(
+		store,
+		l,
+		cfg.CareEndingSoonDays,
+		urgency.Thresholds{
+			HighDays:    cfg.EvaluationHighPriorityDays,
+			DueSoonDays: cfg.EvaluationDueSoonDays,
+		},
+		cfg.CapacityWarningPercent,
+		flags,
+	)
 	dashboardHandler := dashboard.NewDashboardHandler(dashboardService, mdw)
 
+	// Metadata Service
+	metadataService := metadata.NewMetadataService()
+	metadataHandler := metadata.NewMetadataHandler(metadataService, mdw)
+
+	// Health Service - readiness probe and worker heartbeat monitoring
+	healthService := health.NewHealthService(store, l, cfg.WorkerTickInterval)
+	healthHandler := health.NewHealthHandler(healthService, mdw)
+
+	ipAllowlist, err := middleware.IPAllowlistMiddleware(middleware.IPAllowlistConfig{
+		AllowedCIDRs:   cfg.IPAllowlist,
+		TrustedProxies: cfg.TrustedProxies,
+		Routes:         cfg.IPAllowlistRoutes,
+	})
+	if err != nil {
+		l.Error(ctx, "main", "invalid IP allowlist configuration", zap.Error(err))
+		os.Exit(1)
+	}
+
+	compression := middleware.GzipMiddleware(middleware.GzipConfig{
+		Enabled: cfg.CompressionEnabled,
+		MinSize: cfg.CompressionMinSize,
+	})
+
+	securityHeaders, err := middleware.SecurityHeadersMiddleware(middleware.SecurityHeadersConfig{
+		Enabled:               cfg.SecurityHeadersEnabled,
+		ContentSecurityPolicy: cfg.ContentSecurityPolicy,
+		HSTSMaxAge:            cfg.HSTSMaxAge,
+		TrustedProxies:        cfg.TrustedProxies,
+	})
+	if err != nil {
+		l.Error(ctx, "main", "invalid security headers configuration", zap.Error(err))
+		os.Exit(1)
+	}
+
 	// 6. Initialize Server
 	server := api.NewServer(
 		l,
@@ -236,8 +389,20 @@ func main() {
 		notificationHandler,
 		auditHandler,
 		dashboardHandler,
+		metadataHandler,
+		healthHandler,
 		wsHub,
 		rateLimiter,
+		mdw.ClientIPMiddleware(),
+		ipAllowlist,
+		compression,
+		securityHeaders,
+		api.Timeouts{
+			ReadHeader: cfg.ServerReadHeaderTimeout,
+			Read:       cfg.ServerReadTimeout,
+			Write:      cfg.ServerWriteTimeout,
+			Idle:       cfg.ServerIdleTimeout,
+		},
 		cfg.ServerAddress,
 		cfg.Url,
 	)
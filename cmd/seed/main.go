@@ -10,6 +10,7 @@ import (
 	"math/rand"
 	"time"
 
+	"care-cordination/features/incident"
 	"care-cordination/lib/config"
 	db "care-cordination/lib/db/sqlc"
 
@@ -19,6 +20,9 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
+// organizationID is the tenant every seeded row belongs to, resolved in main
+var organizationID *string
+
 // Sample data for generating random employees
 var (
 	firstNames = []string{
@@ -421,6 +425,12 @@ func main() {
 	// Create store
 	store := db.NewStore(connPool)
 
+	// Seeded data joins the admin's organization so it is visible after login
+	organizationID, err = resolveSeedOrganization(ctx, store, cfg.AdminEmail)
+	if err != nil {
+		log.Fatalf("Failed to resolve organization: %v", err)
+	}
+
 	// Seed locations first (needed for employees, intake forms, and clients)
 	locationIDs, err := seedLocations(ctx, store, 8)
 	if err != nil {
@@ -433,6 +443,11 @@ func main() {
 		log.Fatalf("Failed to seed employees: %v", err)
 	}
 
+	// Seed weekday working hours so intakes can be booked through the API
+	if err := seedCoordinatorAvailability(ctx, store, employeeIDs); err != nil {
+		log.Fatalf("Failed to seed coordinator availability: %v", err)
+	}
+
 	// Seed referring organizations
 	orgIDs, err := seedReferringOrganizations(ctx, store, 10)
 	if err != nil {
@@ -536,6 +551,26 @@ func main() {
 	fmt.Println("✅ Successfully seeded database!")
 }
 
+// resolveSeedOrganization returns the admin's organization, or creates one
+// when the admin bootstrap has not run
+func resolveSeedOrganization(ctx context.Context, store *db.Store, adminEmail string) (*string, error) {
+	if admin, err := store.GetUserByEmail(ctx, adminEmail); err == nil && admin.OrganizationID != nil {
+		return admin.OrganizationID, nil
+	}
+
+	id, err := gonanoid.New()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate organization id: %w", err)
+	}
+	if err := store.CreateOrganization(ctx, db.CreateOrganizationParams{
+		ID:   id,
+		Name: "Seed Organization",
+	}); err != nil {
+		return nil, fmt.Errorf("failed to create organization: %w", err)
+	}
+	return &id, nil
+}
+
 func seedEmployees(ctx context.Context, store *db.Store, count int, locationIDs []string) ([]string, error) {
 	fmt.Printf("🌱 Seeding %d employees...\n", count)
 
@@ -559,6 +594,35 @@ func seedEmployees(ctx context.Context, store *db.Store, count int, locationIDs
 	return employeeIDs, nil
 }
 
+func seedCoordinatorAvailability(ctx context.Context, store *db.Store, employeeIDs []string) error {
+	fmt.Printf("🌱 Seeding availability for %d employees...\n", len(employeeIDs))
+
+	for _, employeeID := range employeeIDs {
+		availability := make([]db.CreateCoordinatorAvailabilityParams, 0, 5)
+		for weekday := time.Monday; weekday <= time.Friday; weekday++ {
+			id, err := gonanoid.New()
+			if err != nil {
+				return fmt.Errorf("failed to generate availability ID: %w", err)
+			}
+			availability = append(availability, db.CreateCoordinatorAvailabilityParams{
+				ID:        id,
+				Weekday:   int32(weekday),
+				StartTime: pgtype.Time{Microseconds: int64(9*3600) * 1000000, Valid: true},
+				EndTime:   pgtype.Time{Microseconds: int64(17*3600) * 1000000, Valid: true},
+			})
+		}
+		if err := store.SetCoordinatorAvailabilityTx(ctx, db.SetCoordinatorAvailabilityTxParams{
+			EmployeeID:   employeeID,
+			Availability: availability,
+		}); err != nil {
+			return fmt.Errorf("failed to set availability for employee %s: %w", employeeID, err)
+		}
+	}
+
+	fmt.Printf("✅ Successfully seeded availability for %d employees\n", len(employeeIDs))
+	return nil
+}
+
 type EmployeeInfo struct {
 	ID        string
 	FirstName string
@@ -593,20 +657,22 @@ func createRandomEmployee(ctx context.Context, store *db.Store, locationIDs []st
 	// Create the employee using the transaction
 	err = store.CreateEmployeeTx(ctx, db.CreateEmployeeTxParams{
 		User: db.CreateUserParams{
-			ID:           userID,
-			Email:        email,
-			PasswordHash: string(passwordHash),
+			ID:             userID,
+			Email:          email,
+			PasswordHash:   string(passwordHash),
+			OrganizationID: organizationID,
 		},
 		Emp: db.CreateEmployeeParams{
-			ID:          employeeID,
-			UserID:      userID, // Will be overwritten in tx, but need to provide
-			FirstName:   firstName,
-			LastName:    lastName,
-			Bsn:         generateBSN(),
-			DateOfBirth: generateRandomDateOfBirth(),
-			PhoneNumber: generatePhoneNumber(),
-			Gender:      randomGender(),
-			LocationID:  locationID,
+			ID:             employeeID,
+			UserID:         userID, // Will be overwritten in tx, but need to provide
+			FirstName:      firstName,
+			LastName:       lastName,
+			Bsn:            generateBSN(),
+			DateOfBirth:    generateRandomDateOfBirth(),
+			PhoneNumber:    generatePhoneNumber(),
+			Gender:         randomGender(),
+			LocationID:     locationID,
+			OrganizationID: organizationID,
 		},
 	})
 	if err != nil {
@@ -843,7 +909,6 @@ func createRandomRegistrationForm(
 		RegistrationDate:   regDate,
 		RegistrationReason: reason,
 		AdditionalNotes:    notes,
-		AttachmentIds:      []string{}, // Empty for seeding
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create registration form: %w", err)
@@ -935,12 +1000,13 @@ func createRandomLocation(ctx context.Context, store *db.Store, index int) (*Loc
 	occupied := int32(rand.Intn(int(capacity)))
 
 	err = store.CreateLocation(ctx, db.CreateLocationParams{
-		ID:         locID,
-		Name:       locName,
-		PostalCode: postalCode,
-		Address:    address,
-		Capacity:   capacity,
-		Occupied:   occupied,
+		ID:             locID,
+		Name:           locName,
+		PostalCode:     postalCode,
+		Address:        address,
+		Capacity:       capacity,
+		Occupied:       occupied,
+		OrganizationID: organizationID,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create location: %w", err)
@@ -1269,6 +1335,7 @@ func createWaitingListClient(
 		CoordinatorID:       coordinatorID,
 		FocusAreas:          focusAreasStr,
 		Notes:               notesStr,
+		OrganizationID:      organizationID,
 	})
 	if err != nil {
 		return err
@@ -1284,12 +1351,34 @@ func createWaitingListClient(
 	}
 
 	// Update intake form status to completed since client is now in waiting list
+	if err := markIntakeDocumentsReceived(ctx, store, intakeID); err != nil {
+		return err
+	}
 	return store.UpdateIntakeFormStatus(ctx, db.UpdateIntakeFormStatusParams{
 		ID:     intakeID,
 		Status: db.IntakeStatusEnumCompleted,
 	})
 }
 
+// markIntakeDocumentsReceived ticks off the intake's document checklist so the
+// intake can be completed
+func markIntakeDocumentsReceived(ctx context.Context, store *db.Store, intakeID string) error {
+	documents, err := store.ListIntakeDocuments(ctx, intakeID)
+	if err != nil {
+		return err
+	}
+	for _, document := range documents {
+		if _, err := store.UpdateIntakeDocumentStatus(ctx, db.UpdateIntakeDocumentStatusParams{
+			Status:       db.IntakeDocumentStatusEnumReceived,
+			IntakeFormID: intakeID,
+			DocumentType: document.DocumentType,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func createInCareClient(
 	ctx context.Context,
 	store *db.Store,
@@ -1350,6 +1439,7 @@ func createInCareClient(
 		Limitations:         intakeInfo.Limitations,
 		FocusAreas:          intakeInfo.FocusAreas,
 		Notes:               notesStr,
+		OrganizationID:      organizationID,
 	})
 	if err != nil {
 		return nil, err
@@ -1378,6 +1468,9 @@ func createInCareClient(
 	}
 
 	// Update intake form status to completed since client is now in care
+	if err := markIntakeDocumentsReceived(ctx, store, intakeInfo.ID); err != nil {
+		return nil, err
+	}
 	err = store.UpdateIntakeFormStatus(ctx, db.UpdateIntakeFormStatusParams{
 		ID:     intakeInfo.ID,
 		Status: db.IntakeStatusEnumCompleted,
@@ -1462,6 +1555,7 @@ func createDischargedClient(
 		FamilySituation:     intakeInfo.FamilySituation,
 		Limitations:         intakeInfo.Limitations,
 		FocusAreas:          intakeInfo.FocusAreas,
+		OrganizationID:      organizationID,
 	})
 	if err != nil {
 		return fmt.Errorf("step 1 (create waiting_list) failed: %w", err)
@@ -1513,6 +1607,9 @@ func createDischargedClient(
 	}
 
 	// Update intake form status to completed since client was processed
+	if err := markIntakeDocumentsReceived(ctx, store, intakeInfo.ID); err != nil {
+		return fmt.Errorf("step 4 (receive intake documents) failed: %w", err)
+	}
 	err = store.UpdateIntakeFormStatus(ctx, db.UpdateIntakeFormStatusParams{
 		ID:     intakeInfo.ID,
 		Status: db.IntakeStatusEnumCompleted,
@@ -1664,6 +1761,9 @@ func createIncident(ctx context.Context, store *db.Store, client ClientInfo) err
 	// Random incident type
 	incidentType := randomElement(incidentTypes)
 
+	// Category matching the type
+	category := randomElement(incident.CategoriesByType[incidentType])
+
 	// Get description based on type
 	descriptions := incidentDescriptions[incidentType]
 	description := randomElement(descriptions)
@@ -1696,6 +1796,7 @@ func createIncident(ctx context.Context, store *db.Store, client ClientInfo) err
 		IncidentTime:        incidentTime,
 		IncidentType:        incidentType,
 		IncidentSeverity:    severity,
+		IncidentCategory:    category,
 		LocationID:          client.LocationID,
 		CoordinatorID:       client.CoordinatorID,
 		IncidentDescription: description,
@@ -1771,7 +1872,7 @@ func seedEvaluations(ctx context.Context, store *db.Store, inCareClients []Clien
 
 	for _, client := range inCareClients {
 		// Get client details to retrieve goals and evaluation interval
-		clientDetails, err := store.GetClientByID(ctx, client.ID)
+		clientDetails, err := store.GetClientByID(ctx, db.GetClientByIDParams{ID: client.ID})
 		if err != nil {
 			return fmt.Errorf("failed to get client details for %s: %w", client.ID, err)
 		}
@@ -2408,6 +2509,7 @@ func seedAuditLogs(
 
 		// Generate audit log entry
 		id := generateFakeID()
+		userAgent := randomElement(userAgents)
 		currentHash := computeAuditHash(
 			id,
 			strPtr(userID),
@@ -2418,6 +2520,7 @@ func seedAuditLogs(
 			oldValue,
 			newValue,
 			&ipAddress,
+			&userAgent,
 			strPtr(requestID),
 			status,
 			failureReason,
@@ -2437,7 +2540,7 @@ func seedAuditLogs(
 			OldValue:      oldValue,
 			NewValue:      newValue,
 			IpAddress:     strPtr(ipAddress),
-			UserAgent:     strPtr(randomElement(userAgents)),
+			UserAgent:     strPtr(userAgent),
 			RequestID:     strPtr(requestID),
 			Status:        status,
 			FailureReason: failureReason,
@@ -2488,7 +2591,7 @@ func computeAuditHash(
 	action, resourceType string,
 	resourceID *string,
 	oldValue, newValue []byte,
-	ipAddress, requestID *string,
+	ipAddress, userAgent, requestID *string,
 	status db.AuditStatusEnum,
 	failureReason *string,
 	prevHash string,
@@ -2504,6 +2607,7 @@ func computeAuditHash(
 		string(oldValue),
 		string(newValue),
 		strVal(ipAddress),
+		strVal(userAgent),
 		strVal(requestID),
 		string(status),
 		strVal(failureReason),
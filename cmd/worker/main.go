@@ -1,35 +1,40 @@
 package main
 
 import (
+	"care-cordination/features/health"
 	"care-cordination/features/notification"
 	"care-cordination/lib/config"
+	"care-cordination/lib/db/pool"
 	db "care-cordination/lib/db/sqlc"
+	"care-cordination/lib/email"
+	"care-cordination/lib/featureflags"
 	"care-cordination/lib/logger"
+	"care-cordination/lib/nanoid"
+	"care-cordination/lib/urgency"
 	"care-cordination/lib/util"
+	"care-cordination/lib/version"
 	"care-cordination/lib/websocket"
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"os/signal"
+	"slices"
 	"syscall"
 	"time"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 )
 
 const (
-	// How often to run the scheduler
-	tickInterval = 5 * time.Minute
-
 	// Prevent duplicate notifications by tracking sent ones
 	notificationCooldown = 30 * time.Minute
 )
 
-// sentNotifications tracks recently sent notifications to avoid duplicates
-var sentNotifications = make(map[string]time.Time)
-
 func main() {
 	// 1. Load Configuration
 	cfg, err := config.LoadConfig()
@@ -37,6 +42,10 @@ func main() {
 		fmt.Printf("cannot load config: %v\n", err)
 		os.Exit(1)
 	}
+	if err := util.SetAppTimezone(cfg.AppTimezone); err != nil {
+		fmt.Printf("cannot set app timezone: %v\n", err)
+		os.Exit(1)
+	}
 
 	// 2. Initialize Logger
 	l := logger.NewLogger(cfg.Environment)
@@ -44,7 +53,12 @@ func main() {
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
-	l.Info(ctx, "worker", "Starting notification background worker")
+	buildInfo := version.Get()
+	l.Info(ctx, "worker", "Starting notification background worker",
+		zap.String("version", buildInfo.Version),
+		zap.String("commit", buildInfo.Commit),
+		zap.String("buildTime", buildInfo.BuildTime),
+	)
 
 	// 3. Initialize Database Connection
 	poolConfig, err := pgxpool.ParseConfig(cfg.DBSource)
@@ -54,8 +68,12 @@ func main() {
 	}
 
 	poolConfig.ConnConfig.DefaultQueryExecMode = pgx.QueryExecModeDescribeExec
+	pool.ConfigureStatementTimeout(poolConfig, cfg.DBStatementTimeout)
 
-	connPool, err := pgxpool.NewWithConfig(ctx, poolConfig)
+	connPool, err := pool.Connect(ctx, poolConfig, pool.Options{
+		MaxAttempts:  cfg.DBConnectAttempts,
+		RetryBackoff: cfg.DBConnectBackoff,
+	}, l)
 	if err != nil {
 		l.Error(ctx, "worker", "cannot connect to db", zap.Error(err))
 		os.Exit(1)
@@ -63,26 +81,65 @@ func main() {
 	defer connPool.Close()
 
 	// 4. Initialize Dependencies
-	store := db.NewStore(connPool)
+	store := db.NewStoreWithTxRetry(connPool, db.TxRetryOptions{
+		MaxRetries:   cfg.DBTxMaxRetries,
+		RetryBackoff: cfg.DBTxRetryBackoff,
+	})
 
 	// Initialize WebSocket Hub (for real-time delivery if worker runs with API)
 	wsHub := websocket.NewHub(l)
 	go wsHub.Run()
 
-	notificationService := notification.NewNotificationService(store, wsHub, l)
-
-	// 5. Create the worker
-	worker := &NotificationWorker{
-		store:               store,
-		notificationService: notificationService,
-		logger:              l,
+	delivery, err := notification.NewDeliveryConfig(
+		cfg.NotificationRouting,
+		cfg.NotificationDigestInterval,
+		email.SMTPConfig{
+			Host:     cfg.SMTPHost,
+			Port:     cfg.SMTPPort,
+			Username: cfg.SMTPUsername,
+			Password: cfg.SMTPPassword,
+			From:     cfg.SMTPFrom,
+		},
+		email.RetryPolicy{
+			Attempts: cfg.SMTPSendAttempts,
+			Backoff:  cfg.SMTPRetryBackoff,
+		},
+	)
+	if err != nil {
+		l.Error(ctx, "worker", "invalid notification delivery settings", zap.Error(err))
+		os.Exit(1)
 	}
+	notificationService := notification.NewNotificationServiceWithDelivery(store, wsHub, l, delivery)
+	flags := featureflags.NewFeatureFlags(store, l, cfg.FeatureFlagCacheTTL)
+
+	// 5. Create the worker. Replicas share a run lease so only one of them sends
+	// each tick's notifications; it outlives one missed tick before another takes over.
+	worker := NewNotificationWorker(
+		store,
+		notificationService,
+		flags,
+		l,
+		cfg.AppointmentReminderLeadTimes,
+		urgency.Thresholds{
+			HighDays:    cfg.EvaluationHighPriorityDays,
+			DueSoonDays: cfg.EvaluationDueSoonDays,
+		},
+		IncidentEscalation{
+			SevereHours:   cfg.IncidentEscalationSevereHours,
+			ModerateHours: cfg.IncidentEscalationModerateHours,
+			MinorHours:    cfg.IncidentEscalationMinorHours,
+			Role:          cfg.IncidentEscalationRole,
+		},
+		workerInstanceID(),
+		2*cfg.WorkerTickInterval,
+	)
+	defer worker.ReleaseLease(context.Background())
 
 	// 6. Run the ticker
-	ticker := time.NewTicker(tickInterval)
+	ticker := time.NewTicker(cfg.WorkerTickInterval)
 	defer ticker.Stop()
 
-	l.Info(ctx, "worker", "Worker started, running every", zap.Duration("interval", tickInterval))
+	l.Info(ctx, "worker", "Worker started, running every", zap.Duration("interval", cfg.WorkerTickInterval))
 
 	// Run immediately on start
 	worker.Run(ctx)
@@ -100,74 +157,247 @@ func main() {
 
 // NotificationWorker handles scheduled notification checks
 type NotificationWorker struct {
-	store               *db.Store
+	store               db.StoreInterface
 	notificationService notification.NotificationService
+	flags               featureflags.FeatureFlags
 	logger              logger.Logger
+
+	// reminderLeadTimes lists how long before an appointment a reminder is sent, shortest first
+	reminderLeadTimes []time.Duration
+
+	// evaluationUrgency sets how far ahead evaluation reminders look and which
+	// of them are sent as high priority
+	evaluationUrgency urgency.Thresholds
+
+	// incidentEscalation sets when unresolved incidents are escalated and to whom
+	incidentEscalation IncidentEscalation
+
+	// sent tracks recently sent notifications to avoid duplicates
+	sent *sentTracker
+
+	// instanceID identifies this replica as the holder of the run lease
+	instanceID string
+	// leaseDuration is how long a run lease lasts without being renewed
+	leaseDuration time.Duration
+}
+
+// IncidentEscalation configures the escalation of incidents left unresolved
+// past their severity's SLA
+type IncidentEscalation struct {
+	// SLA in hours per severity; 0 never escalates incidents of that severity
+	SevereHours   int
+	ModerateHours int
+	MinorHours    int
+	// Role whose users are notified of escalations
+	Role string
+}
+
+// slaHours returns the SLA for an incident severity
+func (e IncidentEscalation) slaHours(severity db.IncidentSeverityEnum) int {
+	switch severity {
+	case db.IncidentSeverityEnumSevere:
+		return e.SevereHours
+	case db.IncidentSeverityEnumModerate:
+		return e.ModerateHours
+	default:
+		return e.MinorHours
+	}
+}
+
+// workerInstanceID returns an identifier unique to this worker process
+func workerInstanceID() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "worker"
+	}
+	return fmt.Sprintf("%s-%d-%s", host, os.Getpid(), nanoid.Generate())
 }
 
-// Run executes all notification checks
+// NewNotificationWorker creates a worker that sends one appointment reminder per lead time,
+// reminds coordinators of evaluations due within evaluationUrgency.DueSoonDays and escalates
+// incidents as configured by incidentEscalation. Runs only do work while instanceID holds the
+// worker's run lease.
+func NewNotificationWorker(
+	store db.StoreInterface,
+	notificationService notification.NotificationService,
+	flags featureflags.FeatureFlags,
+	logger logger.Logger,
+	reminderLeadTimes []time.Duration,
+	evaluationUrgency urgency.Thresholds,
+	incidentEscalation IncidentEscalation,
+	instanceID string,
+	leaseDuration time.Duration,
+) *NotificationWorker {
+	leadTimes := slices.Clone(reminderLeadTimes)
+	slices.Sort(leadTimes)
+	leadTimes = slices.Compact(leadTimes)
+
+	return &NotificationWorker{
+		store:               store,
+		notificationService: notificationService,
+		flags:               flags,
+		logger:              logger,
+		reminderLeadTimes:   leadTimes,
+		evaluationUrgency:   evaluationUrgency,
+		incidentEscalation:  incidentEscalation,
+		sent:                newSentTracker(),
+		instanceID:          instanceID,
+		leaseDuration:       leaseDuration,
+	}
+}
+
+// Run executes all notification checks, unless another replica holds the run lease
 func (w *NotificationWorker) Run(ctx context.Context) {
+	if !w.acquireLease(ctx) {
+		return
+	}
+
 	w.logger.Info(ctx, "worker", "Running scheduled notification checks")
 
 	// Clean up old sent notification records
 	w.cleanupSentNotifications()
 
-	// Check for various scheduled notifications
-	w.checkUpcomingAppointments(ctx)
-	w.checkEvaluationsDueSoon(ctx)
-	w.checkPendingReminders(ctx)
+	// Check for various scheduled notifications; the checks are independent
+	// and log their own failures, so run them side by side
+	var g errgroup.Group
+	g.Go(func() error {
+		w.checkUpcomingAppointments(ctx)
+		return nil
+	})
+	g.Go(func() error {
+		w.checkEvaluationsDueSoon(ctx)
+		return nil
+	})
+	g.Go(func() error {
+		w.checkPendingReminders(ctx)
+		return nil
+	})
+	g.Go(func() error {
+		w.snapshotLocationCapacity(ctx)
+		return nil
+	})
+	g.Go(func() error {
+		w.escalateOverdueIncidents(ctx)
+		return nil
+	})
+	_ = g.Wait()
+
+	w.recordHeartbeat(ctx)
 
 	w.logger.Info(ctx, "worker", "Scheduled notification checks completed")
 }
 
+// acquireLease takes or renews the run lease. A replica that cannot confirm it
+// holds the lease skips the run rather than risk sending duplicates.
+func (w *NotificationWorker) acquireLease(ctx context.Context) bool {
+	_, err := w.store.AcquireWorkerLease(ctx, db.AcquireWorkerLeaseParams{
+		WorkerName:   health.NotificationWorkerName,
+		Holder:       w.instanceID,
+		LeaseSeconds: int32(w.leaseDuration.Seconds()),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			w.logger.Info(ctx, "worker", "Run lease held by another replica, skipping checks")
+		} else {
+			w.logger.Error(ctx, "worker", "Failed to acquire run lease", zap.Error(err))
+		}
+		return false
+	}
+	return true
+}
+
+// ReleaseLease gives up the run lease so another replica can take over without
+// waiting for it to expire
+func (w *NotificationWorker) ReleaseLease(ctx context.Context) {
+	err := w.store.ReleaseWorkerLease(ctx, db.ReleaseWorkerLeaseParams{
+		WorkerName: health.NotificationWorkerName,
+		Holder:     w.instanceID,
+	})
+	if err != nil {
+		w.logger.Error(ctx, "worker", "Failed to release run lease", zap.Error(err))
+	}
+}
+
+// recordHeartbeat tells the API the worker is alive; /readyz flags the worker
+// once the heartbeat is more than two ticks old
+func (w *NotificationWorker) recordHeartbeat(ctx context.Context) {
+	if err := w.store.RecordWorkerHeartbeat(ctx, health.NotificationWorkerName); err != nil {
+		w.logger.Error(ctx, "worker", "Failed to record heartbeat", zap.Error(err))
+	}
+}
+
 // cleanupSentNotifications removes old entries from the sent tracking map
 func (w *NotificationWorker) cleanupSentNotifications() {
-	now := time.Now()
-	for key, sentAt := range sentNotifications {
-		if now.Sub(sentAt) > notificationCooldown {
-			delete(sentNotifications, key)
-		}
+	maxCooldown := notificationCooldown
+	if len(w.reminderLeadTimes) > 0 {
+		maxCooldown = max(maxCooldown, w.reminderLeadTimes[len(w.reminderLeadTimes)-1])
 	}
+
+	w.sent.Cleanup(maxCooldown)
 }
 
-// shouldSendNotification checks if we should send a notification (not recently sent)
-func shouldSendNotification(key string) bool {
-	if sentAt, exists := sentNotifications[key]; exists {
-		if time.Since(sentAt) < notificationCooldown {
-			return false
+// reminderLeadTime returns the lead time whose window contains timeUntil.
+// Each window runs from the next shorter lead time up to the lead time itself,
+// so with [1h, 24h] an appointment 30 minutes away gets the 1h reminder and
+// one 20 hours away gets the 24h reminder.
+func (w *NotificationWorker) reminderLeadTime(timeUntil time.Duration) (time.Duration, bool) {
+	for _, lead := range w.reminderLeadTimes {
+		if timeUntil <= lead {
+			return lead, true
 		}
 	}
-	sentNotifications[key] = time.Now()
-	return true
+	return 0, false
+}
+
+// formatTimeUntil renders the time left before an appointment for reminder messages
+func formatTimeUntil(d time.Duration) string {
+	if d >= time.Hour {
+		return fmt.Sprintf("%d hours", int(d.Round(time.Hour).Hours()))
+	}
+	return fmt.Sprintf("%d minutes", int(d.Minutes()))
 }
 
-// checkUpcomingAppointments sends reminders for appointments starting soon
+// checkUpcomingAppointments sends a reminder for each configured lead time before an appointment
 func (w *NotificationWorker) checkUpcomingAppointments(ctx context.Context) {
-	appointments, err := w.store.GetUpcomingAppointments(ctx)
+	if len(w.reminderLeadTimes) == 0 {
+		return
+	}
+
+	now := time.Now()
+	longestLead := w.reminderLeadTimes[len(w.reminderLeadTimes)-1]
+	windowEnd := pgtype.Timestamptz{Time: now.Add(longestLead), Valid: true}
+
+	appointments, err := w.store.GetUpcomingAppointments(ctx, windowEnd)
 	if err != nil {
 		w.logger.Error(ctx, "worker", "Failed to get upcoming appointments", zap.Error(err))
 		return
 	}
 
 	for _, apt := range appointments {
-		key := fmt.Sprintf("appointment:%s", apt.ID)
-		if !shouldSendNotification(key) {
+		// Calculate time until appointment
+		timeUntil := apt.StartTime.Time.Sub(now)
+		lead, ok := w.reminderLeadTime(timeUntil)
+		if !ok {
+			continue
+		}
+
+		// Each lead time is tracked separately so the day-before reminder does not
+		// suppress the hour-before one. The cooldown covers the whole window so a
+		// reminder is not repeated while the appointment stays inside it.
+		key := fmt.Sprintf("appointment:%s:%s", apt.ID, lead)
+		if w.sent.Seen(key, max(notificationCooldown, lead)) {
 			continue
 		}
 
 		resourceType := notification.ResourceTypeAppointment
 		resourceID := apt.ID
 
-		// Calculate time until appointment
-		timeUntil := time.Until(apt.StartTime.Time)
-		minutesUntil := int(timeUntil.Minutes())
-
 		w.notificationService.Enqueue(&notification.CreateNotificationRequest{
 			UserID:       apt.OrganizerUserID,
 			Type:         notification.TypeAppointmentReminder,
 			Priority:     notification.PriorityNormal,
 			Title:        "Upcoming Appointment",
-			Message:      fmt.Sprintf("%s starts in %d minutes", apt.Title, minutesUntil),
+			Message:      fmt.Sprintf("%s starts in %s", apt.Title, formatTimeUntil(timeUntil)),
 			ResourceType: &resourceType,
 			ResourceID:   &resourceID,
 		})
@@ -175,34 +405,42 @@ func (w *NotificationWorker) checkUpcomingAppointments(ctx context.Context) {
 		w.logger.Info(ctx, "worker", "Sent appointment reminder",
 			zap.String("appointmentID", apt.ID),
 			zap.String("title", apt.Title),
+			zap.Duration("leadTime", lead),
 		)
 	}
 }
 
-// checkEvaluationsDueSoon sends reminders for evaluations due in the next 3 days
+// checkEvaluationsDueSoon sends reminders for evaluations due within the configured window.
+// With digest notifications enabled, evaluations that are not yet urgent are
+// grouped into a single notification per coordinator.
 func (w *NotificationWorker) checkEvaluationsDueSoon(ctx context.Context) {
-	evaluations, err := w.store.GetEvaluationsDueSoon(ctx)
+	evaluations, err := w.store.GetEvaluationsDueSoon(ctx, db.GetEvaluationsDueSoonParams{
+		Today:       util.Today(),
+		DueSoonDays: int32(w.evaluationUrgency.DueSoonDays),
+	})
 	if err != nil {
 		w.logger.Error(ctx, "worker", "Failed to get evaluations due soon", zap.Error(err))
 		return
 	}
 
+	digest := w.flags.IsEnabled(ctx, featureflags.DigestNotifications)
+	now := util.InAppTimezone(time.Now())
+
 	for _, eval := range evaluations {
 		key := fmt.Sprintf("evaluation:%s:%s", eval.ClientID, util.PgtypeDateToStr(eval.NextEvaluationDate))
-		if !shouldSendNotification(key) {
+		if w.sent.Seen(key, notificationCooldown) {
 			continue
 		}
 
 		resourceType := notification.ResourceTypeEvaluation
 		resourceID := eval.ClientID
 
-		// Calculate days until due
-		dueDate := eval.NextEvaluationDate.Time
-		daysUntil := int(time.Until(dueDate).Hours() / 24)
+		daysUntil := urgency.DaysUntil(eval.NextEvaluationDate.Time, now)
+		priority := evaluationPriority(w.evaluationUrgency.Classify(daysUntil))
 
-		urgency := notification.PriorityNormal
-		if daysUntil <= 1 {
-			urgency = notification.PriorityHigh
+		// Low priority reminders are held back for the notification digest
+		if digest && priority != notification.PriorityHigh {
+			priority = notification.PriorityLow
 		}
 
 		message := fmt.Sprintf("Evaluation for %s %s is due", eval.FirstName, eval.LastName)
@@ -217,7 +455,7 @@ func (w *NotificationWorker) checkEvaluationsDueSoon(ctx context.Context) {
 		w.notificationService.Enqueue(&notification.CreateNotificationRequest{
 			UserID:       eval.CoordinatorUserID,
 			Type:         notification.TypeEvaluationDue,
-			Priority:     urgency,
+			Priority:     priority,
 			Title:        "Evaluation Due",
 			Message:      message,
 			ResourceType: &resourceType,
@@ -232,6 +470,96 @@ func (w *NotificationWorker) checkEvaluationsDueSoon(ctx context.Context) {
 	}
 }
 
+// evaluationPriority maps an evaluation's urgency to its reminder priority
+func evaluationPriority(level urgency.Level) string {
+	switch level {
+	case urgency.Overdue, urgency.High:
+		return notification.PriorityHigh
+	default:
+		return notification.PriorityNormal
+	}
+}
+
+// snapshotLocationCapacity records each location's occupancy for today's point
+// of the capacity trend; the last run of the day determines the stored value
+func (w *NotificationWorker) snapshotLocationCapacity(ctx context.Context) {
+	written, err := w.store.SnapshotLocationCapacity(ctx)
+	if err != nil {
+		w.logger.Error(ctx, "worker", "Failed to snapshot location capacity", zap.Error(err))
+		return
+	}
+
+	w.logger.Info(ctx, "worker", "Recorded location capacity snapshots", zap.Int64("locations", written))
+}
+
+// escalateOverdueIncidents notifies the escalation role of incidents still
+// unresolved past their severity's SLA. Each incident is escalated in its own
+// transaction that stores the notifications along with the escalation mark,
+// so a failure leaves the incident and its notifications for the next run and
+// an incident is escalated only once across restarts and replicas. The stored
+// notifications are delivered once the transaction commits.
+func (w *NotificationWorker) escalateOverdueIncidents(ctx context.Context) {
+	incidents, err := w.store.ListOverdueIncidents(ctx, db.ListOverdueIncidentsParams{
+		SevereHours:   int32(w.incidentEscalation.SevereHours),
+		ModerateHours: int32(w.incidentEscalation.ModerateHours),
+		MinorHours:    int32(w.incidentEscalation.MinorHours),
+	})
+	if err != nil {
+		w.logger.Error(ctx, "worker", "Failed to list overdue incidents", zap.Error(err))
+		return
+	}
+
+	for _, incident := range incidents {
+		resourceType := notification.ResourceTypeIncident
+		resourceID := incident.ID
+
+		priority := notification.PriorityHigh
+		if incident.IncidentSeverity == db.IncidentSeverityEnumSevere {
+			priority = notification.PriorityUrgent
+		}
+
+		message := fmt.Sprintf("Incident (%s) for %s %s is still unresolved after %d hours",
+			incident.IncidentSeverity, incident.ClientFirstName, incident.ClientLastName,
+			w.incidentEscalation.slaHours(incident.IncidentSeverity))
+
+		result, err := w.store.EscalateIncidentTx(ctx, db.EscalateIncidentTxParams{
+			IncidentID:     incident.ID,
+			OrganizationID: incident.OrganizationID,
+			Role:           w.incidentEscalation.Role,
+			Notification: func(userID string) db.CreateNotificationParams {
+				return db.CreateNotificationParams{
+					ID:           nanoid.Generate(),
+					UserID:       userID,
+					Type:         db.NotificationTypeEnum(notification.TypeIncidentEscalated),
+					Priority:     db.NotificationPriorityEnum(priority),
+					Title:        "Incident Escalated",
+					Message:      message,
+					ResourceType: &resourceType,
+					ResourceID:   &resourceID,
+				}
+			},
+		})
+		if errors.Is(err, db.ErrIncidentAlreadyEscalated) {
+			continue
+		}
+		if err != nil {
+			w.logger.Error(ctx, "worker", "Failed to escalate overdue incident",
+				zap.String("incidentID", incident.ID),
+				zap.Error(err),
+			)
+			continue
+		}
+		w.notificationService.Deliver(ctx, result.Notifications)
+
+		w.logger.Info(ctx, "worker", "Escalated overdue incident",
+			zap.String("incidentID", incident.ID),
+			zap.String("severity", string(incident.IncidentSeverity)),
+			zap.String("role", w.incidentEscalation.Role),
+			zap.Int("users", len(result.Notifications)),
+		)
+	}
+}
+
 // checkPendingReminders sends notifications for reminders due soon
 func (w *NotificationWorker) checkPendingReminders(ctx context.Context) {
 	reminders, err := w.store.GetPendingRemindersByDueTime(ctx)
@@ -242,7 +570,7 @@ func (w *NotificationWorker) checkPendingReminders(ctx context.Context) {
 
 	for _, rem := range reminders {
 		key := fmt.Sprintf("reminder:%s", rem.ID)
-		if !shouldSendNotification(key) {
+		if w.sent.Seen(key, notificationCooldown) {
 			continue
 		}
 
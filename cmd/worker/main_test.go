@@ -0,0 +1,513 @@
+package main
+
+import (
+	"care-cordination/features/health"
+	"care-cordination/features/notification"
+	db "care-cordination/lib/db/sqlc"
+	dbmocks "care-cordination/lib/db/sqlc/mocks"
+	"care-cordination/lib/featureflags"
+	loggermocks "care-cordination/lib/logger/mocks"
+	"care-cordination/lib/urgency"
+	"care-cordination/lib/util"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// ============================================================
+// Test Helpers
+// ============================================================
+
+// recordingNotificationService records enqueued and delivered notifications
+// instead of sending them
+type recordingNotificationService struct {
+	notification.NotificationService
+	mu        sync.Mutex
+	enqueued  []*notification.CreateNotificationRequest
+	delivered []db.Notification
+}
+
+func (r *recordingNotificationService) Enqueue(req *notification.CreateNotificationRequest) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.enqueued = append(r.enqueued, req)
+}
+
+func (r *recordingNotificationService) Deliver(_ context.Context, notifications []db.Notification) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.delivered = append(r.delivered, notifications...)
+}
+
+// staticFlags enables exactly the listed feature flags
+type staticFlags map[string]bool
+
+func (f staticFlags) Get(_ context.Context, key string) featureflags.Flag {
+	return featureflags.Flag{Key: key, Enabled: f[key]}
+}
+
+func (f staticFlags) IsEnabled(_ context.Context, key string) bool {
+	return f[key]
+}
+
+func (f staticFlags) Set(_ context.Context, key string, enabled bool, value json.RawMessage) (featureflags.Flag, error) {
+	f[key] = enabled
+	return featureflags.Flag{Key: key, Enabled: enabled, Value: value}, nil
+}
+
+var testEvaluationUrgency = urgency.Thresholds{HighDays: 1, DueSoonDays: 3}
+
+var testIncidentEscalation = IncidentEscalation{SevereHours: 24, ModerateHours: 72, Role: "manager"}
+
+func newTestWorker(t *testing.T, leadTimes []time.Duration) (*NotificationWorker, *dbmocks.MockStoreInterface, *recordingNotificationService) {
+	ctrl := gomock.NewController(t)
+	mockStore := dbmocks.NewMockStoreInterface(ctrl)
+	mockLogger := loggermocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	notifier := &recordingNotificationService{}
+	worker := NewNotificationWorker(mockStore, notifier, staticFlags{}, mockLogger, leadTimes, testEvaluationUrgency, testIncidentEscalation, "worker-1", time.Minute)
+	return worker, mockStore, notifier
+}
+
+func upcomingAppointment(id string, startsIn time.Duration) db.GetUpcomingAppointmentsRow {
+	return db.GetUpcomingAppointmentsRow{
+		ID:              id,
+		Title:           "Intake meeting",
+		StartTime:       pgtype.Timestamptz{Time: time.Now().Add(startsIn), Valid: true},
+		OrganizerUserID: "user-1",
+	}
+}
+
+// ============================================================
+// Test: Appointment reminders
+// ============================================================
+
+func TestCheckUpcomingAppointments_MultipleLeadTimes(t *testing.T) {
+	worker, mockStore, notifier := newTestWorker(t, []time.Duration{time.Hour, 24 * time.Hour})
+	ctx := context.Background()
+
+	dayBefore := []db.GetUpcomingAppointmentsRow{upcomingAppointment("apt-1", 20*time.Hour)}
+	hourBefore := []db.GetUpcomingAppointmentsRow{upcomingAppointment("apt-1", 30*time.Minute+30*time.Second)}
+
+	// Two ticks inside the 24h window, then two ticks inside the 1h window
+	gomock.InOrder(
+		mockStore.EXPECT().GetUpcomingAppointments(gomock.Any(), gomock.Any()).Return(dayBefore, nil).Times(2),
+		mockStore.EXPECT().GetUpcomingAppointments(gomock.Any(), gomock.Any()).Return(hourBefore, nil).Times(2),
+	)
+
+	for range 4 {
+		worker.checkUpcomingAppointments(ctx)
+	}
+
+	require.Len(t, notifier.enqueued, 2)
+	assert.Equal(t, "Intake meeting starts in 20 hours", notifier.enqueued[0].Message)
+	assert.Equal(t, "Intake meeting starts in 30 minutes", notifier.enqueued[1].Message)
+	for _, req := range notifier.enqueued {
+		assert.Equal(t, "user-1", req.UserID)
+		assert.Equal(t, notification.TypeAppointmentReminder, req.Type)
+		require.NotNil(t, req.ResourceID)
+		assert.Equal(t, "apt-1", *req.ResourceID)
+	}
+	assert.Contains(t, worker.sent.sent, "appointment:apt-1:24h0m0s")
+	assert.Contains(t, worker.sent.sent, "appointment:apt-1:1h0m0s")
+}
+
+func TestCheckUpcomingAppointments_QueriesUpToLongestLeadTime(t *testing.T) {
+	worker, mockStore, notifier := newTestWorker(t, []time.Duration{24 * time.Hour, time.Hour})
+
+	mockStore.EXPECT().
+		GetUpcomingAppointments(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, windowEnd pgtype.Timestamptz) ([]db.GetUpcomingAppointmentsRow, error) {
+			assert.True(t, windowEnd.Valid)
+			assert.WithinDuration(t, time.Now().Add(24*time.Hour), windowEnd.Time, time.Minute)
+			return []db.GetUpcomingAppointmentsRow{}, nil
+		})
+
+	worker.checkUpcomingAppointments(context.Background())
+
+	assert.Empty(t, notifier.enqueued)
+}
+
+func TestReminderLeadTime(t *testing.T) {
+	worker, _, _ := newTestWorker(t, []time.Duration{24 * time.Hour, time.Hour})
+
+	tests := []struct {
+		name      string
+		timeUntil time.Duration
+		wantLead  time.Duration
+		wantOK    bool
+	}{
+		{name: "inside_hour_window", timeUntil: 45 * time.Minute, wantLead: time.Hour, wantOK: true},
+		{name: "hour_boundary", timeUntil: time.Hour, wantLead: time.Hour, wantOK: true},
+		{name: "inside_day_window", timeUntil: 3 * time.Hour, wantLead: 24 * time.Hour, wantOK: true},
+		{name: "beyond_longest_lead", timeUntil: 25 * time.Hour, wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lead, ok := worker.reminderLeadTime(tt.timeUntil)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantLead, lead)
+		})
+	}
+}
+
+// ============================================================
+// Test: Evaluation reminders
+// ============================================================
+
+func evaluationDueIn(clientID, coordinatorUserID string, days int) db.GetEvaluationsDueSoonRow {
+	today := util.InAppTimezone(time.Now())
+	return db.GetEvaluationsDueSoonRow{
+		ClientID:           clientID,
+		FirstName:          "Client",
+		LastName:           clientID,
+		CoordinatorUserID:  coordinatorUserID,
+		NextEvaluationDate: pgtype.Date{Time: time.Date(today.Year(), today.Month(), today.Day()+days, 0, 0, 0, 0, time.UTC), Valid: true},
+	}
+}
+
+func TestCheckEvaluationsDueSoon_Digest(t *testing.T) {
+	evaluations := []db.GetEvaluationsDueSoonRow{
+		evaluationDueIn("client-1", "user-1", 2),
+		evaluationDueIn("client-2", "user-1", 3),
+		evaluationDueIn("client-3", "user-1", 0),
+		evaluationDueIn("client-4", "user-2", 2),
+	}
+
+	tests := []struct {
+		name           string
+		flags          staticFlags
+		wantPriorities []string
+	}{
+		{
+			name:  "flag_off_sends_at_normal_priority",
+			flags: staticFlags{},
+			wantPriorities: []string{
+				notification.PriorityNormal, notification.PriorityNormal,
+				notification.PriorityHigh, notification.PriorityNormal,
+			},
+		},
+		{
+			// Low priority is routed to the notification digest; the urgent
+			// evaluation still goes out right away
+			name:  "flag_on_sends_non_urgent_at_low_priority",
+			flags: staticFlags{featureflags.DigestNotifications: true},
+			wantPriorities: []string{
+				notification.PriorityLow, notification.PriorityLow,
+				notification.PriorityHigh, notification.PriorityLow,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			worker, mockStore, notifier := newTestWorker(t, []time.Duration{time.Hour})
+			worker.flags = tt.flags
+
+			mockStore.EXPECT().GetEvaluationsDueSoon(gomock.Any(), db.GetEvaluationsDueSoonParams{Today: util.Today(), DueSoonDays: 3}).Return(evaluations, nil)
+
+			worker.checkEvaluationsDueSoon(context.Background())
+
+			priorities := []string{}
+			for _, req := range notifier.enqueued {
+				assert.Equal(t, "Evaluation Due", req.Title)
+				priorities = append(priorities, req.Priority)
+			}
+			assert.Equal(t, tt.wantPriorities, priorities)
+		})
+	}
+}
+
+func TestCheckEvaluationsDueSoon_UsesOwnWindow(t *testing.T) {
+	worker, mockStore, notifier := newTestWorker(t, []time.Duration{24 * time.Hour})
+	worker.evaluationUrgency.DueSoonDays = 10
+
+	// The evaluation window is independent of the appointment lead times
+	mockStore.EXPECT().
+		GetEvaluationsDueSoon(gomock.Any(), db.GetEvaluationsDueSoonParams{Today: util.Today(), DueSoonDays: 10}).
+		Return([]db.GetEvaluationsDueSoonRow{evaluationDueIn("client-1", "user-1", 9)}, nil)
+
+	worker.checkEvaluationsDueSoon(context.Background())
+
+	require.Len(t, notifier.enqueued, 1)
+	assert.Contains(t, notifier.enqueued[0].Message, "is due in")
+}
+
+func TestCheckEvaluationsDueSoon_PriorityThresholds(t *testing.T) {
+	worker, mockStore, notifier := newTestWorker(t, []time.Duration{time.Hour})
+	worker.evaluationUrgency = urgency.Thresholds{HighDays: 2, DueSoonDays: 5}
+
+	mockStore.EXPECT().
+		GetEvaluationsDueSoon(gomock.Any(), db.GetEvaluationsDueSoonParams{Today: util.Today(), DueSoonDays: 5}).
+		Return([]db.GetEvaluationsDueSoonRow{
+			evaluationDueIn("client-0", "user-1", 0),
+			evaluationDueIn("client-2", "user-1", 2),
+			evaluationDueIn("client-3", "user-1", 3),
+			evaluationDueIn("client-5", "user-1", 5),
+		}, nil)
+
+	worker.checkEvaluationsDueSoon(context.Background())
+
+	priorities := map[string]string{}
+	for _, req := range notifier.enqueued {
+		priorities[*req.ResourceID] = req.Priority
+	}
+	assert.Equal(t, map[string]string{
+		"client-0": notification.PriorityHigh,
+		"client-2": notification.PriorityHigh,
+		"client-3": notification.PriorityNormal,
+		"client-5": notification.PriorityNormal,
+	}, priorities)
+}
+
+// ============================================================
+// Test: escalateOverdueIncidents
+// ============================================================
+
+// escalateWith commits the escalation transaction, storing a notification for
+// each of the given users
+func escalateWith(userIDs ...string) func(context.Context, db.EscalateIncidentTxParams) (db.EscalateIncidentTxResult, error) {
+	return func(_ context.Context, arg db.EscalateIncidentTxParams) (db.EscalateIncidentTxResult, error) {
+		var result db.EscalateIncidentTxResult
+		for _, userID := range userIDs {
+			params := arg.Notification(userID)
+			result.Notifications = append(result.Notifications, db.Notification{
+				ID:           params.ID,
+				UserID:       params.UserID,
+				Type:         params.Type,
+				Priority:     params.Priority,
+				Title:        params.Title,
+				Message:      params.Message,
+				ResourceType: params.ResourceType,
+				ResourceID:   params.ResourceID,
+			})
+		}
+		return result, nil
+	}
+}
+
+func TestEscalateOverdueIncidents(t *testing.T) {
+	orgID := "org-1"
+	overdue := db.ListOverdueIncidentsRow{
+		ID:               "inc-1",
+		ClientID:         "client-1",
+		IncidentSeverity: db.IncidentSeverityEnumSevere,
+		Status:           db.IncidentStatusEnumPending,
+		CreatedAt:        pgtype.Timestamp{Time: time.Now().Add(-30 * time.Hour), Valid: true},
+		ClientFirstName:  "Sam",
+		ClientLastName:   "Jansen",
+		OrganizationID:   &orgID,
+	}
+	params := db.ListOverdueIncidentsParams{SevereHours: 24, ModerateHours: 72, MinorHours: 0}
+	txParams := db.EscalateIncidentTxParams{IncidentID: "inc-1", OrganizationID: &orgID, Role: "manager"}
+	// Notification is a closure, so match the transaction on the other fields
+	matchTx := gomock.Cond(func(x any) bool {
+		arg, ok := x.(db.EscalateIncidentTxParams)
+		return ok && arg.Notification != nil &&
+			arg.IncidentID == txParams.IncidentID &&
+			*arg.OrganizationID == *txParams.OrganizationID &&
+			arg.Role == txParams.Role
+	})
+
+	tests := []struct {
+		name          string
+		setup         func(*dbmocks.MockStoreInterface)
+		wantDelivered int
+	}{
+		{
+			name: "notifies_role_in_organization",
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().ListOverdueIncidents(gomock.Any(), params).
+					Return([]db.ListOverdueIncidentsRow{overdue}, nil)
+				mockStore.EXPECT().EscalateIncidentTx(gomock.Any(), matchTx).
+					DoAndReturn(escalateWith("user-1", "user-2"))
+			},
+			wantDelivered: 2,
+		},
+		{
+			// The transaction rolls back its notifications along with the
+			// escalation, leaving both for the next run
+			name: "failed_escalation_delivers_nothing",
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().ListOverdueIncidents(gomock.Any(), params).
+					Return([]db.ListOverdueIncidentsRow{overdue}, nil)
+				mockStore.EXPECT().EscalateIncidentTx(gomock.Any(), matchTx).
+					Return(db.EscalateIncidentTxResult{}, assert.AnError)
+			},
+		},
+		{
+			name: "already_escalated_by_another_run",
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().ListOverdueIncidents(gomock.Any(), params).
+					Return([]db.ListOverdueIncidentsRow{overdue}, nil)
+				mockStore.EXPECT().EscalateIncidentTx(gomock.Any(), matchTx).
+					Return(db.EscalateIncidentTxResult{}, db.ErrIncidentAlreadyEscalated)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			worker, mockStore, notifier := newTestWorker(t, nil)
+			tt.setup(mockStore)
+
+			worker.escalateOverdueIncidents(context.Background())
+
+			assert.Empty(t, notifier.enqueued)
+			require.Len(t, notifier.delivered, tt.wantDelivered)
+			for i, n := range notifier.delivered {
+				assert.NotEmpty(t, n.ID)
+				assert.Equal(t, fmt.Sprintf("user-%d", i+1), n.UserID)
+				assert.Equal(t, db.NotificationTypeEnum(notification.TypeIncidentEscalated), n.Type)
+				assert.Equal(t, db.NotificationPriorityEnum(notification.PriorityUrgent), n.Priority)
+				assert.Equal(t, "Incident (severe) for Sam Jansen is still unresolved after 24 hours", n.Message)
+				require.NotNil(t, n.ResourceID)
+				assert.Equal(t, "inc-1", *n.ResourceID)
+			}
+		})
+	}
+}
+
+// ============================================================
+// Test: Run
+// ============================================================
+
+func TestRun_ChecksRunConcurrently(t *testing.T) {
+	worker, mockStore, notifier := newTestWorker(t, []time.Duration{time.Hour})
+
+	mockStore.EXPECT().
+		AcquireWorkerLease(gomock.Any(), db.AcquireWorkerLeaseParams{
+			WorkerName:   health.NotificationWorkerName,
+			Holder:       "worker-1",
+			LeaseSeconds: 60,
+		}).
+		Return(db.WorkerLease{}, nil)
+	mockStore.EXPECT().
+		GetUpcomingAppointments(gomock.Any(), gomock.Any()).
+		Return([]db.GetUpcomingAppointmentsRow{upcomingAppointment("apt-1", 30*time.Minute)}, nil)
+	mockStore.EXPECT().
+		GetEvaluationsDueSoon(gomock.Any(), gomock.Any()).
+		Return([]db.GetEvaluationsDueSoonRow{{
+			ClientID:           "client-1",
+			FirstName:          "Sam",
+			LastName:           "Jansen",
+			CoordinatorUserID:  "user-2",
+			NextEvaluationDate: pgtype.Date{Time: time.Now().AddDate(0, 0, 2), Valid: true},
+		}}, nil)
+	mockStore.EXPECT().
+		GetPendingRemindersByDueTime(gomock.Any()).
+		Return([]db.Reminder{{ID: "rem-1", UserID: "user-3", Title: "Call family"}}, nil)
+	mockStore.EXPECT().
+		SnapshotLocationCapacity(gomock.Any()).
+		Return(int64(2), nil)
+	mockStore.EXPECT().
+		ListOverdueIncidents(gomock.Any(), gomock.Any()).
+		Return(nil, nil)
+	mockStore.EXPECT().
+		RecordWorkerHeartbeat(gomock.Any(), health.NotificationWorkerName).
+		Return(nil)
+
+	worker.Run(context.Background())
+
+	require.Len(t, notifier.enqueued, 3)
+	titles := []string{}
+	for _, req := range notifier.enqueued {
+		titles = append(titles, req.Title)
+	}
+	assert.ElementsMatch(t, []string{"Upcoming Appointment", "Evaluation Due", "Reminder"}, titles)
+}
+
+// leaseTable mimics the worker_leases table: a lease can be taken by anyone
+// once it is released, but only renewed by its holder while it is held
+type leaseTable struct {
+	mu      sync.Mutex
+	holders map[string]string
+}
+
+func (l *leaseTable) acquire(_ context.Context, arg db.AcquireWorkerLeaseParams) (db.WorkerLease, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if holder, ok := l.holders[arg.WorkerName]; ok && holder != arg.Holder {
+		return db.WorkerLease{}, pgx.ErrNoRows
+	}
+	l.holders[arg.WorkerName] = arg.Holder
+	return db.WorkerLease{WorkerName: arg.WorkerName, Holder: arg.Holder}, nil
+}
+
+func (l *leaseTable) release(_ context.Context, arg db.ReleaseWorkerLeaseParams) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.holders[arg.WorkerName] == arg.Holder {
+		delete(l.holders, arg.WorkerName)
+	}
+	return nil
+}
+
+func TestRun_SingleReplicaSendsPerTick(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := dbmocks.NewMockStoreInterface(ctrl)
+	mockLogger := loggermocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	leases := &leaseTable{holders: map[string]string{}}
+	mockStore.EXPECT().AcquireWorkerLease(gomock.Any(), gomock.Any()).DoAndReturn(leases.acquire).AnyTimes()
+	mockStore.EXPECT().ReleaseWorkerLease(gomock.Any(), gomock.Any()).DoAndReturn(leases.release).AnyTimes()
+
+	// Only the replica holding the lease queries and sends: three runs in total
+	// across the two concurrent ticks, the leader's next tick and the takeover
+	mockStore.EXPECT().
+		GetUpcomingAppointments(gomock.Any(), gomock.Any()).
+		Return([]db.GetUpcomingAppointmentsRow{upcomingAppointment("apt-1", 30*time.Minute)}, nil).
+		Times(3)
+	mockStore.EXPECT().GetEvaluationsDueSoon(gomock.Any(), gomock.Any()).Return(nil, nil).Times(3)
+	mockStore.EXPECT().GetPendingRemindersByDueTime(gomock.Any()).Return(nil, nil).Times(3)
+	mockStore.EXPECT().SnapshotLocationCapacity(gomock.Any()).Return(int64(1), nil).Times(3)
+	mockStore.EXPECT().ListOverdueIncidents(gomock.Any(), gomock.Any()).Return(nil, nil).Times(3)
+	mockStore.EXPECT().RecordWorkerHeartbeat(gomock.Any(), gomock.Any()).Return(nil).Times(3)
+
+	notifier := &recordingNotificationService{}
+	leadTimes := []time.Duration{time.Hour}
+	replicaA := NewNotificationWorker(mockStore, notifier, staticFlags{}, mockLogger, leadTimes, testEvaluationUrgency, testIncidentEscalation, "replica-a", time.Minute)
+	replicaB := NewNotificationWorker(mockStore, notifier, staticFlags{}, mockLogger, leadTimes, testEvaluationUrgency, testIncidentEscalation, "replica-b", time.Minute)
+	ctx := context.Background()
+
+	// Both replicas tick at the same time; the reminder goes out once
+	var wg sync.WaitGroup
+	for _, w := range []*NotificationWorker{replicaA, replicaB} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			w.Run(ctx)
+		}()
+	}
+	wg.Wait()
+	require.Len(t, notifier.enqueued, 1)
+
+	// The leader keeps the lease on its next tick, so the follower stays idle
+	replicaA.Run(ctx)
+	replicaB.Run(ctx)
+	assert.Len(t, notifier.enqueued, 1)
+	assert.Len(t, leases.holders, 1)
+
+	// Once the leader shuts down the follower takes over
+	leader, follower := replicaA, replicaB
+	if leases.holders[health.NotificationWorkerName] == "replica-b" {
+		leader, follower = replicaB, replicaA
+	}
+	leader.ReleaseLease(ctx)
+	follower.Run(ctx)
+	assert.Equal(t, follower.instanceID, leases.holders[health.NotificationWorkerName])
+}
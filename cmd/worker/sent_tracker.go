@@ -0,0 +1,44 @@
+package main
+
+import (
+	"sync"
+	"time"
+)
+
+// sentTracker remembers recently sent notifications to avoid duplicates.
+// It is safe for concurrent use, so the scheduled checks can run in parallel.
+type sentTracker struct {
+	mu   sync.Mutex
+	sent map[string]time.Time
+}
+
+func newSentTracker() *sentTracker {
+	return &sentTracker{sent: make(map[string]time.Time)}
+}
+
+// Seen reports whether key was already sent within cooldown. When it was not,
+// the key is recorded as sent now, so only one of several concurrent callers
+// for the same key gets false.
+func (t *sentTracker) Seen(key string, cooldown time.Duration) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if sentAt, exists := t.sent[key]; exists && time.Since(sentAt) < cooldown {
+		return true
+	}
+	t.sent[key] = time.Now()
+	return false
+}
+
+// Cleanup removes entries older than cooldown
+func (t *sentTracker) Cleanup(cooldown time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	for key, sentAt := range t.sent {
+		if now.Sub(sentAt) > cooldown {
+			delete(t.sent, key)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSentTracker_Seen(t *testing.T) {
+	tracker := newSentTracker()
+
+	assert.False(t, tracker.Seen("reminder:1", time.Minute), "first call records the key")
+	assert.True(t, tracker.Seen("reminder:1", time.Minute), "second call within cooldown is a duplicate")
+	assert.False(t, tracker.Seen("reminder:2", time.Minute), "other keys are tracked independently")
+
+	// Once the cooldown has passed the key may be sent again
+	tracker.sent["reminder:1"] = time.Now().Add(-2 * time.Minute)
+	assert.False(t, tracker.Seen("reminder:1", time.Minute))
+}
+
+func TestSentTracker_Cleanup(t *testing.T) {
+	tracker := newSentTracker()
+	tracker.sent["old"] = time.Now().Add(-time.Hour)
+	tracker.sent["fresh"] = time.Now()
+
+	tracker.Cleanup(30 * time.Minute)
+
+	assert.NotContains(t, tracker.sent, "old")
+	assert.Contains(t, tracker.sent, "fresh")
+}
+
+// Run with -race: concurrent Seen and Cleanup calls must not race, and exactly
+// one caller per key may be told the notification was not sent yet.
+func TestSentTracker_ConcurrentSeen(t *testing.T) {
+	tracker := newSentTracker()
+	keys := []string{"appointment:1", "evaluation:1", "reminder:1"}
+
+	const goroutinesPerKey = 50
+	var firstSends atomic.Int32
+	var wg sync.WaitGroup
+
+	for _, key := range keys {
+		for range goroutinesPerKey {
+			wg.Add(1)
+			go func(key string) {
+				defer wg.Done()
+				if !tracker.Seen(key, time.Minute) {
+					firstSends.Add(1)
+				}
+				tracker.Cleanup(time.Minute)
+			}(key)
+		}
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(len(keys)), firstSends.Load())
+}
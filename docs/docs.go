@@ -69,6 +69,65 @@ const docTemplate = `{
                 }
             }
         },
+        "/admin/permissions/{id}/roles": {
+            "delete": {
+                "description": "Remove a permission from every role that holds it, optionally deleting the permission afterwards",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RBAC - Role Permissions"
+                ],
+                "summary": "Remove permission from all roles",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Permission ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also delete the permission",
+                        "name": "deletePermission",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-rbac_RemovePermissionFromAllRolesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/admin/roles": {
             "get": {
                 "description": "List all roles with permission and user counts",
@@ -633,6 +692,106 @@ const docTemplate = `{
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/attachments/{id}/access": {
+            "get": {
+                "description": "List who downloaded an attachment and when, newest first. Admin only.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Attachments"
+                ],
+                "summary": "List attachment access",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Attachment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-array_attachments_AttachmentAccessResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/attachments/{id}/download": {
+            "get": {
+                "description": "Record the download and return a short-lived presigned URL for the file. The file must belong to the caller's organization and the caller needs read access to the registration form or client it is attached to; otherwise it is reported as not found.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Attachments"
+                ],
+                "summary": "Download an attachment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Attachment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-attachments_DownloadAttachmentResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -967,6 +1126,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
+                    "423": {
+                        "description": "Locked",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1270,7 +1435,7 @@ const docTemplate = `{
         },
         "/auth/reset-password": {
             "post": {
-                "description": "Reset password for the authenticated user",
+                "description": "Reset password for the authenticated user; the new password must meet the password policy",
                 "consumes": [
                     "application/json"
                 ],
@@ -1324,10 +1489,10 @@ const docTemplate = `{
             "get": {
                 "security": [
                     {
-                        "BearerAuth": []
+                        "Bearer": []
                     }
                 ],
-                "description": "List all appointments for an employee",
+                "description": "List appointments ordered by start time, optionally filtered by employee, client, organizer, start time range, type and location. Without an employee, client or organizer filter the current user's appointments are listed. Breaking change: the response used to be a bare array of appointments and is now a paginated object whose items are in data.data, and the employee filter is employeeId instead of employee_id.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1339,10 +1504,63 @@ const docTemplate = `{
                 ],
                 "summary": "List appointments",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "Employee ID (defaults to current user)",
-                        "name": "employee_id",
+                        "description": "Employee organizing or attending (defaults to current user)",
+                        "name": "employeeId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client participating in the appointment",
+                        "name": "clientId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Organizing employee",
+                        "name": "organizerId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Earliest start time, inclusive (RFC3339 format)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Latest start time, exclusive (RFC3339 format)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "general",
+                            "intake",
+                            "ambulatory"
+                        ],
+                        "type": "string",
+                        "description": "Appointment type",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Location (case-insensitive exact match)",
+                        "name": "location",
                         "in": "query"
                     }
                 ],
@@ -1350,7 +1568,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-array_calendar_AppointmentResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-resp_PaginationResponse-array_calendar_ListAppointmentsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
                     "401": {
@@ -1370,7 +1594,7 @@ const docTemplate = `{
             "post": {
                 "security": [
                     {
-                        "BearerAuth": []
+                        "Bearer": []
                     }
                 ],
                 "description": "Create a new calendar appointment",
@@ -1427,7 +1651,7 @@ const docTemplate = `{
             "get": {
                 "security": [
                     {
-                        "BearerAuth": []
+                        "Bearer": []
                     }
                 ],
                 "description": "Get a specific appointment by ID",
@@ -1480,7 +1704,7 @@ const docTemplate = `{
             "delete": {
                 "security": [
                     {
-                        "BearerAuth": []
+                        "Bearer": []
                     }
                 ],
                 "description": "Delete an appointment by ID",
@@ -1533,7 +1757,7 @@ const docTemplate = `{
             "patch": {
                 "security": [
                     {
-                        "BearerAuth": []
+                        "Bearer": []
                     }
                 ],
                 "description": "Update an existing appointment",
@@ -1599,14 +1823,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/calendar/reminders": {
-            "get": {
+        "/calendar/appointments/{id}/cancel": {
+            "post": {
                 "security": [
                     {
-                        "BearerAuth": []
+                        "Bearer": []
                     }
                 ],
-                "description": "List all reminders for an employee",
+                "description": "Cancel an appointment with a reason. Cancelled appointments are kept but no longer appear on the dashboard or trigger reminders; the organizer is notified.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1614,22 +1838,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Calendar - Reminders"
+                    "Calendar - Appointments"
                 ],
-                "summary": "List reminders",
+                "summary": "Cancel appointment",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Employee ID (defaults to current user)",
-                        "name": "employee_id",
-                        "in": "query"
+                        "description": "Appointment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Cancellation reason",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/calendar.CancelAppointmentRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-array_calendar_ReminderResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-calendar_AppointmentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
                     "401": {
@@ -1638,6 +1878,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1645,14 +1897,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/calendar/reminders": {
+            "get": {
                 "security": [
                     {
-                        "BearerAuth": []
+                        "Bearer": []
                     }
                 ],
-                "description": "Create a new reminder",
+                "description": "List all reminders for an employee",
                 "consumes": [
                     "application/json"
                 ],
@@ -1662,16 +1916,62 @@ const docTemplate = `{
                 "tags": [
                     "Calendar - Reminders"
                 ],
-                "summary": "Create reminder",
+                "summary": "List reminders",
                 "parameters": [
                     {
-                        "description": "Reminder details",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/calendar.CreateReminderRequest"
-                        }
+                        "type": "string",
+                        "description": "Employee ID (defaults to current user)",
+                        "name": "employee_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-array_calendar_ReminderResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Create a new reminder",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Calendar - Reminders"
+                ],
+                "summary": "Create reminder",
+                "parameters": [
+                    {
+                        "description": "Reminder details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/calendar.CreateReminderRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -1706,7 +2006,7 @@ const docTemplate = `{
             "get": {
                 "security": [
                     {
-                        "BearerAuth": []
+                        "Bearer": []
                     }
                 ],
                 "description": "Get a specific reminder by ID",
@@ -1759,7 +2059,7 @@ const docTemplate = `{
             "delete": {
                 "security": [
                     {
-                        "BearerAuth": []
+                        "Bearer": []
                     }
                 ],
                 "description": "Delete a reminder by ID",
@@ -1812,7 +2112,7 @@ const docTemplate = `{
             "patch": {
                 "security": [
                     {
-                        "BearerAuth": []
+                        "Bearer": []
                     }
                 ],
                 "description": "Update a reminder's completion status",
@@ -1882,7 +2182,7 @@ const docTemplate = `{
             "get": {
                 "security": [
                     {
-                        "BearerAuth": []
+                        "Bearer": []
                     }
                 ],
                 "description": "Get a unified list of appointments and reminders for a date range",
@@ -2045,42 +2345,27 @@ const docTemplate = `{
                 }
             }
         },
-        "/clients/in-care": {
+        "/clients/discharged/trend": {
             "get": {
-                "description": "List all clients currently in care with pagination, search, and care type filter. Returns weeks in accommodation for living care types or used ambulatory hours for ambulatory care.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get planned and premature discharge counts per month for the last months (12 by default), oldest first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Client"
                 ],
-                "summary": "List in-care clients",
+                "summary": "Get monthly discharge trend",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Page number (default: 1)",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page size (default: 10, max: 100)",
-                        "name": "page_size",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Search by client first name or last name",
-                        "name": "search",
+                        "description": "Number of months, including the current one (1-60, default 12)",
+                        "name": "months",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter by care type (protected_living, semi_independent_living, independent_assisted_living, ambulatory_care)",
-                        "name": "careType",
+                        "description": "IANA timezone deciding the current month (default APP_TIMEZONE)",
+                        "name": "timezone",
                         "in": "query"
                     }
                 ],
@@ -2088,7 +2373,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-resp_PaginationResponse-array_client_ListInCareClientsResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-array_client_DischargeTrendMonth"
                         }
                     },
                     "400": {
@@ -2112,67 +2397,32 @@ const docTemplate = `{
                 }
             }
         },
-        "/clients/in-care/stats": {
+        "/clients/export": {
             "get": {
-                "description": "Get comprehensive statistics for clients currently in care including total count, average days in care, and care type breakdowns",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Client"
-                ],
-                "summary": "Get in-care statistics",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-client_GetInCareStatsResponse"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/resp.ErrorResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/resp.ErrorResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/clients/move-to-waiting-list": {
-            "post": {
-                "description": "Move a client from intake form to waiting list by creating a client record",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Stream every client of the caller's organization, in any status, as a JSON array. Requires the client export permission. Rows are written as they are read from the database, so the response is not wrapped in the usual success envelope. Only a few exports run at once; the rest wait in a short queue and are refused with 429 once it is full.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Client"
                 ],
-                "summary": "Move client to waiting list",
+                "summary": "Export all clients",
                 "parameters": [
                     {
-                        "description": "Intake Form ID",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/client.MoveClientToWaitingListRequest"
-                        }
+                        "type": "boolean",
+                        "description": "Mask the BSN (last two digits kept), reduce names to initials and the date of birth to the year, and leave out gender and the coordinator",
+                        "name": "redact",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-client_MoveClientToWaitingListResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/client.ExportClientResponse"
+                            }
                         }
                     },
                     "400": {
@@ -2187,8 +2437,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
                         "schema": {
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
@@ -2202,9 +2458,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/clients/waiting-list": {
+        "/clients/in-care": {
             "get": {
-                "description": "List all clients on the waiting list with pagination and search",
+                "description": "List all clients currently in care with pagination, search, and care type filter. Returns weeks in accommodation for living care types or used ambulatory hours for ambulatory care.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2214,7 +2470,7 @@ const docTemplate = `{
                 "tags": [
                     "Client"
                 ],
-                "summary": "List waiting list clients",
+                "summary": "List in-care clients",
                 "parameters": [
                     {
                         "type": "integer",
@@ -2233,13 +2489,25 @@ const docTemplate = `{
                         "description": "Search by client first name or last name",
                         "name": "search",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by care type (protected_living, semi_independent_living, independent_assisted_living, ambulatory_care)",
+                        "name": "careType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Keyset pagination cursor; send empty for the first page, then nextCursor from the previous response. page is ignored when set",
+                        "name": "cursor",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-resp_PaginationResponse-array_client_ListWaitingListClientsResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-resp_PaginationResponse-array_client_ListInCareClientsResponse"
                         }
                     },
                     "400": {
@@ -2263,21 +2531,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/clients/waiting-list/stats": {
+        "/clients/in-care/stats": {
             "get": {
-                "description": "Get comprehensive statistics for clients on the waiting list including total count, average wait time, and priority breakdowns",
+                "description": "Get comprehensive statistics for clients currently in care including total count, average days in care, and care type breakdowns",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Client"
                 ],
-                "summary": "Get waitlist statistics",
+                "summary": "Get in-care statistics",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-client_GetWaitlistStatsResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-client_GetInCareStatsResponse"
                         }
                     },
                     "401": {
@@ -2295,9 +2563,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/clients/{id}/complete-discharge": {
+        "/clients/lookup-by-bsn": {
             "post": {
-                "description": "Complete the discharge process for a client. Requires closing and evaluation reports. Client status changes to discharged.",
+                "description": "Get the active (not discharged) client with the given BSN. Discharged clients are found through the client search.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2307,22 +2575,15 @@ const docTemplate = `{
                 "tags": [
                     "Client"
                 ],
-                "summary": "Complete client discharge",
+                "summary": "Look up a client by BSN",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Client ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Reports and optional attachments",
+                        "description": "BSN",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/client.CompleteDischargeRequest"
+                            "$ref": "#/definitions/client.GetClientByBSNRequest"
                         }
                     }
                 ],
@@ -2330,7 +2591,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-client_CompleteDischargeResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-client_GetClientResponse"
                         }
                     },
                     "400": {
@@ -2360,30 +2621,41 @@ const docTemplate = `{
                 }
             }
         },
-        "/clients/{id}/goals": {
-            "get": {
-                "description": "Get all goals for a specific client",
+        "/clients/move-to-waiting-list": {
+            "post": {
+                "description": "Move a client from intake form to waiting list by creating a client record",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Client"
                 ],
-                "summary": "List client goals",
+                "summary": "Move client to waiting list",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Client ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Intake Form ID",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/client.MoveClientToWaitingListRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-array_client_ListClientGoalsResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-client_MoveClientToWaitingListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
                     "401": {
@@ -2392,12 +2664,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2407,9 +2691,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/clients/{id}/move-to-care": {
-            "post": {
-                "description": "Move a client from waiting list to in care status",
+        "/clients/search": {
+            "get": {
+                "description": "Search clients in every status by name or BSN, optionally filtered by status",
                 "consumes": [
                     "application/json"
                 ],
@@ -2419,30 +2703,39 @@ const docTemplate = `{
                 "tags": [
                     "Client"
                 ],
-                "summary": "Move client to in care",
+                "summary": "Search clients",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default: 10, max: 100)",
+                        "name": "page_size",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "Client ID",
-                        "name": "id",
-                        "in": "path",
+                        "description": "Client first name, last name or BSN",
+                        "name": "search",
+                        "in": "query",
                         "required": true
                     },
                     {
-                        "description": "Care start date and optional ambulatory hours",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/client.MoveClientInCareRequest"
-                        }
+                        "type": "string",
+                        "description": "Filter by status (waiting_list, in_care, discharged)",
+                        "name": "status",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-client_MoveClientInCareResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-resp_PaginationResponse-array_client_SearchClientsResponse"
                         }
                     },
                     "400": {
@@ -2457,14 +2750,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/resp.ErrorResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
@@ -2472,9 +2759,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/clients/{id}/start-discharge": {
-            "post": {
-                "description": "Start the discharge process for a client. Client remains in care with discharge_status = in_progress",
+        "/clients/waiting-list": {
+            "get": {
+                "description": "List all clients on the waiting list with pagination and search",
                 "consumes": [
                     "application/json"
                 ],
@@ -2484,30 +2771,38 @@ const docTemplate = `{
                 "tags": [
                     "Client"
                 ],
-                "summary": "Start client discharge",
+                "summary": "List waiting list clients",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default: 10, max: 100)",
+                        "name": "page_size",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "Client ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Search by client first name or last name",
+                        "name": "search",
+                        "in": "query"
                     },
                     {
-                        "description": "Discharge date and reason",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/client.StartDischargeRequest"
-                        }
+                        "type": "string",
+                        "description": "Sort as field or field:desc; field is priority, name or created_at. priority:desc lists high priority first (default: priority:desc, then longest waiting)",
+                        "name": "sort",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-client_StartDischargeResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-resp_PaginationResponse-array_client_ListWaitingListClientsResponse"
                         }
                     },
                     "400": {
@@ -2522,12 +2817,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/resp.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2537,21 +2826,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/dashboard/care-type-distribution": {
+        "/clients/waiting-list/stats": {
             "get": {
-                "description": "Get distribution of in-care clients by care type",
+                "description": "Get comprehensive statistics for clients on the waiting list including total count, average wait time, and priority breakdowns",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Dashboard"
+                    "Client"
                 ],
-                "summary": "Get care type distribution",
+                "summary": "Get waitlist statistics",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-dashboard_CareTypeDistributionResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-client_GetWaitlistStatsResponse"
                         }
                     },
                     "401": {
@@ -2569,57 +2858,52 @@ const docTemplate = `{
                 }
             }
         },
-        "/dashboard/coordinator/clients": {
+        "/clients/{id}": {
             "get": {
-                "description": "Get list of clients assigned to this coordinator",
+                "description": "Get a client's details. Pass fields to return only the listed top-level fields.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Dashboard - Coordinator"
+                    "Client"
+                ],
+                "summary": "Get client",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Client ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated response fields, e.g. id,firstName,status",
+                        "name": "fields",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get coordinator clients",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-dashboard_CoordinatorClientsResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-client_GetClientResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
-                    }
-                }
-            }
-        },
-        "/dashboard/coordinator/goals-progress": {
-            "get": {
-                "description": "Get aggregated goals progress for all coordinator's clients",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Dashboard - Coordinator"
-                ],
-                "summary": "Get coordinator goals progress",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-dashboard_CoordinatorGoalsProgressResponse"
-                        }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
@@ -2633,21 +2917,48 @@ const docTemplate = `{
                 }
             }
         },
-        "/dashboard/coordinator/incidents": {
-            "get": {
-                "description": "Get incidents for coordinator's assigned clients",
+        "/clients/{id}/complete-discharge": {
+            "post": {
+                "description": "Complete the discharge process for a client. Requires closing and evaluation reports. Client status changes to discharged.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Dashboard - Coordinator"
+                    "Client"
+                ],
+                "summary": "Complete client discharge",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Client ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reports and optional attachments",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/client.CompleteDischargeRequest"
+                        }
+                    }
                 ],
-                "summary": "Get coordinator incidents",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-dashboard_CoordinatorIncidentsResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-client_CompleteDischargeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
                     "401": {
@@ -2656,6 +2967,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2665,21 +2982,30 @@ const docTemplate = `{
                 }
             }
         },
-        "/dashboard/coordinator/reminders": {
+        "/clients/{id}/goals": {
             "get": {
-                "description": "Get pending reminders and tasks for the coordinator",
+                "description": "Get all goals for a specific client",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Dashboard - Coordinator"
+                    "Client"
+                ],
+                "summary": "List client goals",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Client ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get coordinator reminders",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-dashboard_CoordinatorRemindersResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-array_client_ListClientGoalsResponse"
                         }
                     },
                     "401": {
@@ -2688,6 +3014,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2697,21 +3029,48 @@ const docTemplate = `{
                 }
             }
         },
-        "/dashboard/coordinator/stats": {
-            "get": {
-                "description": "Get personal statistics for the coordinator's dashboard summary",
+        "/clients/{id}/move-to-care": {
+            "post": {
+                "description": "Move a client from waiting list to in care status",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Dashboard - Coordinator"
+                    "Client"
+                ],
+                "summary": "Move client to in care",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Client ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Care start date and optional ambulatory hours",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/client.MoveClientInCareRequest"
+                        }
+                    }
                 ],
-                "summary": "Get coordinator personal stats",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-dashboard_CoordinatorStatsResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-client_MoveClientInCareResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
                     "401": {
@@ -2720,6 +3079,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2729,21 +3094,36 @@ const docTemplate = `{
                 }
             }
         },
-        "/dashboard/coordinator/today-schedule": {
+        "/clients/{id}/notes": {
             "get": {
-                "description": "Get today's schedule for the logged-in coordinator",
+                "description": "List a client's notes, newest first, with their authors",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Dashboard - Coordinator"
+                    "Client"
+                ],
+                "summary": "List client notes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Client ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get coordinator today schedule",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-dashboard_CoordinatorTodayScheduleResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-array_client_ClientNoteResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
                     "401": {
@@ -2752,6 +3132,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2759,59 +3145,58 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/dashboard/coordinator/urgent-alerts": {
-            "get": {
-                "description": "Get urgent alerts for the logged-in coordinator's clients",
+            },
+            "post": {
+                "description": "Append a timestamped note to a client's history, authored by the current employee",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Dashboard - Coordinator"
+                    "Client"
+                ],
+                "summary": "Add client note",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Client ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Note body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/client.AddClientNoteRequest"
+                        }
+                    }
                 ],
-                "summary": "Get coordinator urgent alerts",
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-dashboard_CoordinatorUrgentAlertsResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-client_ClientNoteResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
-                    }
-                }
-            }
-        },
-        "/dashboard/critical-alerts": {
-            "get": {
-                "description": "Get critical alerts for the admin dashboard",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Dashboard"
-                ],
-                "summary": "Get critical alerts",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-dashboard_CriticalAlertsResponse"
-                        }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
@@ -2825,21 +3210,36 @@ const docTemplate = `{
                 }
             }
         },
-        "/dashboard/discharge-stats": {
+        "/clients/{id}/placement-suggestions": {
             "get": {
-                "description": "Get discharge statistics",
+                "description": "List active locations with a free bed that offer the client's care type, most available beds first. Locations that list no care types accept every care type.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Dashboard"
+                    "Client"
+                ],
+                "summary": "Suggest placements for a waiting-list client",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Client ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get discharge stats",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-dashboard_DischargeStatsResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-array_client_PlacementSuggestionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
                     "401": {
@@ -2848,6 +3248,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2857,21 +3263,48 @@ const docTemplate = `{
                 }
             }
         },
-        "/dashboard/evaluation-stats": {
-            "get": {
-                "description": "Get evaluation statistics for all coordinators",
+        "/clients/{id}/start-discharge": {
+            "post": {
+                "description": "Start the discharge process for a client. Client remains in care with discharge_status = in_progress",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Dashboard"
+                    "Client"
+                ],
+                "summary": "Start client discharge",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Client ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Discharge date and reason",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/client.StartDischargeRequest"
+                        }
+                    }
                 ],
-                "summary": "Get evaluation stats",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-dashboard_EvaluationStatsResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-client_StartDischargeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
                     "401": {
@@ -2880,6 +3313,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2889,26 +3328,33 @@ const docTemplate = `{
                 }
             }
         },
-        "/dashboard/location-capacity": {
+        "/dashboard": {
             "get": {
-                "description": "Get location capacity statistics with optional limit and sorting",
+                "description": "Load overview, critical alerts, pipeline, care types, location capacity and evaluation stats in one call.\nSections that fail to load are null and flagged in errors; the call only fails when every section does.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Dashboard"
                 ],
-                "summary": "Get location capacity",
+                "summary": "Get the admin dashboard",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Number of locations to return (default: 4, max: 100)",
+                        "default": 4,
+                        "description": "Number of locations in the capacity section",
                         "name": "limit",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "occupancy_desc",
+                            "occupancy_asc",
+                            "name"
+                        ],
                         "type": "string",
-                        "description": "Sort order: occupancy_desc, occupancy_asc, name (default: occupancy_desc)",
+                        "default": "occupancy_desc",
+                        "description": "Capacity sort order",
                         "name": "sort",
                         "in": "query"
                     }
@@ -2917,7 +3363,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-dashboard_LocationCapacityResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-dashboard_DashboardResponse"
                         }
                     },
                     "400": {
@@ -2941,21 +3387,39 @@ const docTemplate = `{
                 }
             }
         },
-        "/dashboard/overview-stats": {
+        "/dashboard/age-distribution": {
             "get": {
-                "description": "Get overview statistics for the admin dashboard",
+                "description": "Get distribution of in-care clients by age band. Bands are given by their lower bounds in ascending order; the highest band is open-ended.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Dashboard"
                 ],
-                "summary": "Get dashboard overview stats",
+                "summary": "Get client age distribution",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Lower bound of each age band (default: 18, 26, 41, 65)",
+                        "name": "bands",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-dashboard_OverviewResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-dashboard_ClientAgeDistributionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
                     "401": {
@@ -2973,21 +3437,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/dashboard/pipeline-stats": {
+        "/dashboard/care-type-distribution": {
             "get": {
-                "description": "Get pipeline statistics showing client journey through the care system",
+                "description": "Get distribution of in-care clients by care type",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Dashboard"
                 ],
-                "summary": "Get pipeline stats",
+                "summary": "Get care type distribution",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-dashboard_PipelineStatsResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-dashboard_CareTypeDistributionResponse"
                         }
                     },
                     "401": {
@@ -3005,21 +3469,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/dashboard/today-appointments": {
+        "/dashboard/coordinator/clients": {
             "get": {
-                "description": "Get today's appointments for the logged-in user",
+                "description": "Get list of clients assigned to this coordinator",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Dashboard"
+                    "Dashboard - Coordinator"
                 ],
-                "summary": "Get today's appointments",
+                "summary": "Get coordinator clients",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-dashboard_TodayAppointmentsResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-dashboard_CoordinatorClientsResponse"
                         }
                     },
                     "401": {
@@ -3037,50 +3501,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/employees": {
+        "/dashboard/coordinator/goals-progress": {
             "get": {
-                "description": "List all employees with pagination and search",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get aggregated goals progress for all coordinator's clients",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Employee"
-                ],
-                "summary": "List employees",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Page number (default: 1)",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page size (default: 10, max: 100)",
-                        "name": "page_size",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Search by first name, last name, or full name",
-                        "name": "search",
-                        "in": "query"
-                    }
+                    "Dashboard - Coordinator"
                 ],
+                "summary": "Get coordinator goals progress",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-resp_PaginationResponse-array_employee_ListEmployeesResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/resp.ErrorResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-dashboard_CoordinatorGoalsProgressResponse"
                         }
                     },
                     "401": {
@@ -3096,41 +3531,23 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Create a new employee",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/dashboard/coordinator/incidents": {
+            "get": {
+                "description": "Get incidents for coordinator's assigned clients",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Employee"
-                ],
-                "summary": "Create an employee",
-                "parameters": [
-                    {
-                        "description": "Employee",
-                        "name": "employee",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/employee.CreateEmployeeRequest"
-                        }
-                    }
+                    "Dashboard - Coordinator"
                 ],
+                "summary": "Get coordinator incidents",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-employee_CreateEmployeeResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/resp.ErrorResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-dashboard_CoordinatorIncidentsResponse"
                         }
                     },
                     "401": {
@@ -3148,21 +3565,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/employees/me": {
+        "/dashboard/coordinator/reminders": {
             "get": {
-                "description": "Get the employee profile of the currently authenticated user",
+                "description": "Get pending reminders and tasks for the coordinator",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Employee"
+                    "Dashboard - Coordinator"
                 ],
-                "summary": "Get logged-in user's employee profile",
+                "summary": "Get coordinator reminders",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-employee_GetMyProfileResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-dashboard_CoordinatorRemindersResponse"
                         }
                     },
                     "401": {
@@ -3180,36 +3597,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/employees/{id}": {
+        "/dashboard/coordinator/stats": {
             "get": {
-                "description": "Get a single employee by their ID with all details",
+                "description": "Get personal statistics for the coordinator's dashboard summary",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Employee"
-                ],
-                "summary": "Get employee by ID",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Employee ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Dashboard - Coordinator"
                 ],
+                "summary": "Get coordinator personal stats",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-employee_GetEmployeeByIDResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/resp.ErrorResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-dashboard_CoordinatorStatsResponse"
                         }
                     },
                     "401": {
@@ -3218,12 +3620,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/resp.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3231,48 +3627,23 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Update an existing employee's details including password",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/dashboard/coordinator/today-schedule": {
+            "get": {
+                "description": "Get today's schedule for the logged-in coordinator",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Employee"
-                ],
-                "summary": "Update an employee",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Employee ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Employee update data",
-                        "name": "employee",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/employee.UpdateEmployeeRequest"
-                        }
-                    }
+                    "Dashboard - Coordinator"
                 ],
+                "summary": "Get coordinator today schedule",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-employee_UpdateEmployeeResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/resp.ErrorResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-dashboard_CoordinatorTodayScheduleResponse"
                         }
                     },
                     "401": {
@@ -3288,36 +3659,23 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Soft delete an employee by ID",
+            }
+        },
+        "/dashboard/coordinator/urgent-alerts": {
+            "get": {
+                "description": "Get urgent alerts for the logged-in coordinator's clients",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Employee"
-                ],
-                "summary": "Delete an employee",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Employee ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Dashboard - Coordinator"
                 ],
+                "summary": "Get coordinator urgent alerts",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-any"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/resp.ErrorResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-dashboard_CoordinatorUrgentAlertsResponse"
                         }
                     },
                     "401": {
@@ -3335,35 +3693,34 @@ const docTemplate = `{
                 }
             }
         },
-        "/evaluations": {
-            "post": {
-                "description": "Record progress logs for all current client goals and schedule the next evaluation.",
-                "consumes": [
-                    "application/json"
-                ],
+        "/dashboard/critical-alerts": {
+            "get": {
+                "description": "Get critical alerts for the admin dashboard. Use scope=own to limit alerts to the caller's own caseload.\nCallers without the dashboard:read permission always get their own caseload.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Evaluation"
+                    "Dashboard"
                 ],
-                "summary": "Create a client evaluation",
+                "summary": "Get critical alerts",
                 "parameters": [
                     {
-                        "description": "Evaluation Details",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/evaluation.CreateEvaluationRequest"
-                        }
+                        "enum": [
+                            "all",
+                            "own"
+                        ],
+                        "type": "string",
+                        "default": "all",
+                        "description": "Alert scope",
+                        "name": "scope",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-evaluation_CreateEvaluationResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-dashboard_CriticalAlertsResponse"
                         }
                     },
                     "400": {
@@ -3387,21 +3744,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/evaluations/critical": {
+        "/dashboard/discharge-stats": {
             "get": {
-                "description": "List evaluations due within the next 7 days or overdue.",
+                "description": "Get discharge statistics",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Evaluation"
+                    "Dashboard"
                 ],
-                "summary": "Get critical evaluations (Dashboard)",
+                "summary": "Get discharge stats",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-resp_PaginationResponse-evaluation_UpcomingEvaluationItem"
+                            "$ref": "#/definitions/resp.SuccessResponse-dashboard_DischargeStatsResponse"
                         }
                     },
                     "401": {
@@ -3419,36 +3776,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/evaluations/drafts": {
+        "/dashboard/evaluation-stats": {
             "get": {
-                "description": "Retrieve all draft evaluations for the logged-in coordinator.",
+                "description": "Get evaluation statistics for all coordinators",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Evaluation"
-                ],
-                "summary": "Get all draft evaluations",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Coordinator ID",
-                        "name": "coordinatorId",
-                        "in": "query",
-                        "required": true
-                    }
+                    "Dashboard"
                 ],
+                "summary": "Get evaluation stats",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-resp_PaginationResponse-evaluation_DraftEvaluationListItem"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/resp.ErrorResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-dashboard_EvaluationStatsResponse"
                         }
                     },
                     "401": {
@@ -3464,41 +3806,23 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Create a new draft or update an existing draft evaluation for a client.",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/dashboard/features": {
+            "get": {
+                "description": "Report which experimental features are enabled through feature flags",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Evaluation"
-                ],
-                "summary": "Save or update a draft evaluation",
-                "parameters": [
-                    {
-                        "description": "Draft Evaluation Details",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/evaluation.SaveDraftRequest"
-                        }
-                    }
+                    "Dashboard"
                 ],
+                "summary": "Get experimental feature toggles",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-evaluation_SaveDraftResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/resp.ErrorResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-dashboard_FeaturesResponse"
                         }
                     },
                     "401": {
@@ -3506,46 +3830,25 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/resp.ErrorResponse"
-                        }
                     }
                 }
             }
         },
-        "/evaluations/drafts/{id}": {
+        "/dashboard/incident-categories": {
             "get": {
-                "description": "Retrieve a draft evaluation by ID with all progress logs.",
+                "description": "Count non-deleted incidents per category, most frequent first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Evaluation"
-                ],
-                "summary": "Get a specific draft evaluation",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Draft Evaluation ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Dashboard"
                 ],
+                "summary": "Get incident stats by category",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-evaluation_DraftEvaluationResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/resp.ErrorResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-dashboard_IncidentCategoryStatsResponse"
                         }
                     },
                     "401": {
@@ -3554,12 +3857,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/resp.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3567,30 +3864,37 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete a draft evaluation permanently.",
+            }
+        },
+        "/dashboard/location-capacity": {
+            "get": {
+                "description": "Get location capacity statistics with optional limit and sorting",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Evaluation"
+                    "Dashboard"
                 ],
-                "summary": "Delete a draft evaluation",
+                "summary": "Get location capacity",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Number of locations to return (default: 4, max: 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "Draft Evaluation ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Sort order: occupancy_desc, occupancy_asc, name (default: occupancy_desc)",
+                        "name": "sort",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-any"
+                            "$ref": "#/definitions/resp.SuccessResponse-dashboard_LocationCapacityResponse"
                         }
                     },
                     "400": {
@@ -3614,36 +3918,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/evaluations/drafts/{id}/submit": {
-            "post": {
-                "description": "Convert a draft evaluation to submitted status and update client's next evaluation date.",
+        "/dashboard/overview-stats": {
+            "get": {
+                "description": "Get overview statistics for the admin dashboard",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Evaluation"
-                ],
-                "summary": "Submit a draft evaluation",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Draft Evaluation ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Dashboard"
                 ],
+                "summary": "Get dashboard overview stats",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-evaluation_CreateEvaluationResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/resp.ErrorResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-dashboard_OverviewResponse"
                         }
                     },
                     "401": {
@@ -3652,12 +3941,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/resp.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3667,30 +3950,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/evaluations/history/{clientId}": {
+        "/dashboard/pipeline-stats": {
             "get": {
-                "description": "Retrieve all past evaluations and goal progress logs for a specific client.",
+                "description": "Get pipeline statistics showing client journey through the care system. With from and/or to, forms only count when created in the range and clients when their record was last updated in it.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Evaluation"
+                    "Dashboard"
                 ],
-                "summary": "Get evaluation history for a client",
+                "summary": "Get pipeline stats",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Client ID",
-                        "name": "clientId",
-                        "in": "path",
-                        "required": true
+                        "description": "Start of the range, inclusive (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End of the range, inclusive (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-array_evaluation_EvaluationHistoryItem"
+                            "$ref": "#/definitions/resp.SuccessResponse-dashboard_PipelineStatsResponse"
                         }
                     },
                     "400": {
@@ -3714,30 +4002,76 @@ const docTemplate = `{
                 }
             }
         },
-        "/evaluations/last/{clientId}": {
+        "/dashboard/today-appointments": {
             "get": {
-                "description": "Retrieve the most recent evaluation with all goal progress logs for a specific client.",
+                "description": "Get today's appointments for the logged-in user",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Evaluation"
+                    "Dashboard"
                 ],
-                "summary": "Get last evaluation for a client",
+                "summary": "Get today's appointments",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-dashboard_TodayAppointmentsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/employees": {
+            "get": {
+                "description": "List all employees with pagination and search",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Employee"
+                ],
+                "summary": "List employees",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default: 10, max: 100)",
+                        "name": "page_size",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "Client ID",
-                        "name": "clientId",
-                        "in": "path",
-                        "required": true
+                        "description": "Search by first name, last name, or full name",
+                        "name": "search",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-evaluation_LastEvaluationItem"
+                            "$ref": "#/definitions/resp.SuccessResponse-resp_PaginationResponse-array_employee_ListEmployeesResponse"
                         }
                     },
                     "400": {
@@ -3752,12 +4086,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/resp.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3765,23 +4093,41 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/evaluations/recent": {
-            "get": {
-                "description": "List the last 20 evaluations submitted across all clients.",
+            },
+            "post": {
+                "description": "Create a new employee; the password must meet the password policy",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Evaluation"
+                    "Employee"
+                ],
+                "summary": "Create an employee",
+                "parameters": [
+                    {
+                        "description": "Employee",
+                        "name": "employee",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/employee.CreateEmployeeRequest"
+                        }
+                    }
                 ],
-                "summary": "Get recent evaluations (Dashboard)",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-resp_PaginationResponse-evaluation_GlobalRecentEvaluationItem"
+                            "$ref": "#/definitions/resp.SuccessResponse-employee_CreateEmployeeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
                     "401": {
@@ -3799,21 +4145,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/evaluations/scheduled": {
+        "/employees/me": {
             "get": {
-                "description": "List evaluations scheduled between 8 and 30 days from now.",
+                "description": "Get the email, employee details, role, permissions and last login of the currently authenticated user",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Evaluation"
+                    "Employee"
                 ],
-                "summary": "Get scheduled evaluations (Dashboard)",
+                "summary": "Get logged-in user's profile",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-resp_PaginationResponse-evaluation_UpcomingEvaluationItem"
+                            "$ref": "#/definitions/resp.SuccessResponse-employee_GetMyProfileResponse"
                         }
                     },
                     "401": {
@@ -3831,20 +4177,20 @@ const docTemplate = `{
                 }
             }
         },
-        "/evaluations/{id}": {
+        "/employees/{id}": {
             "get": {
-                "description": "Retrieve an evaluation (submitted or draft) by ID with all progress logs.",
+                "description": "Get a single employee by their ID with all details",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Evaluation"
+                    "Employee"
                 ],
-                "summary": "Get a specific evaluation",
+                "summary": "Get employee by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Evaluation ID",
+                        "description": "Employee ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -3854,7 +4200,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-evaluation_EvaluationResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-employee_GetEmployeeByIDResponse"
                         }
                     },
                     "400": {
@@ -3884,7 +4230,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "Update an existing submitted evaluation's date, notes, and goal progress.",
+                "description": "Update an existing employee's details, email and password. BSN cannot be changed, a new password must meet the password policy and roles are managed through RBAC.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3892,24 +4238,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Evaluation"
+                    "Employee"
                 ],
-                "summary": "Update a submitted evaluation",
+                "summary": "Update an employee",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Evaluation ID",
+                        "description": "Employee ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Updated Evaluation Details",
-                        "name": "request",
+                        "description": "Employee update data",
+                        "name": "employee",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/evaluation.UpdateEvaluationRequest"
+                            "$ref": "#/definitions/employee.UpdateEmployeeRequest"
                         }
                     }
                 ],
@@ -3917,7 +4263,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-evaluation_UpdateEvaluationResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-employee_UpdateEmployeeResponse"
                         }
                     },
                     "400": {
@@ -3938,6 +4284,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3945,46 +4297,30 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/incidents": {
-            "get": {
-                "description": "List all incidents with pagination and search by client name",
-                "consumes": [
-                    "application/json"
-                ],
+            },
+            "delete": {
+                "description": "Soft delete an employee by ID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Incident"
+                    "Employee"
                 ],
-                "summary": "List incidents",
+                "summary": "Delete an employee",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Page number (default: 1)",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page size (default: 10, max: 100)",
-                        "name": "page_size",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "description": "Search by client first name, last name, or full name",
-                        "name": "search",
-                        "in": "query"
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-resp_PaginationResponse-array_incident_ListIncidentsResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-any"
                         }
                     },
                     "400": {
@@ -4006,35 +4342,32 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Create a new incident",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/employees/{id}/availability": {
+            "get": {
+                "description": "Get the weekly working hours within which intakes can be booked with this coordinator",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Incident"
+                    "Employee"
                 ],
-                "summary": "Create an incident",
+                "summary": "Get employee availability",
                 "parameters": [
                     {
-                        "description": "Incident",
-                        "name": "incident",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/incident.CreateIncidentRequest"
-                        }
+                        "type": "string",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-incident_CreateIncidentResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-employee_AvailabilityResponse"
                         }
                     },
                     "400": {
@@ -4056,23 +4389,48 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/incidents/stats": {
-            "get": {
-                "description": "Get comprehensive statistics for incidents including counts by severity, status, and type",
+            },
+            "put": {
+                "description": "Replace the weekly working hours within which intakes can be booked with this coordinator. An empty list clears the schedule.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Incident"
+                    "Employee"
+                ],
+                "summary": "Set employee availability",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Employee ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Weekly availability",
+                        "name": "availability",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/employee.SetAvailabilityRequest"
+                        }
+                    }
                 ],
-                "summary": "Get incident statistics",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-incident_GetIncidentStatsResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-employee_AvailabilityResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
                     "401": {
@@ -4081,6 +4439,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -4090,20 +4454,20 @@ const docTemplate = `{
                 }
             }
         },
-        "/incidents/{id}": {
+        "/employees/{id}/replacement-coordinator": {
             "get": {
-                "description": "Get a single incident by ID",
+                "description": "Suggest who should take over an employee's clients before deactivating them: the active coordinator at the same location with the lowest in-care caseload",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Incident"
+                    "Employee"
                 ],
-                "summary": "Get an incident",
+                "summary": "Suggest a replacement coordinator",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Incident ID",
+                        "description": "Employee ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -4113,7 +4477,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-incident_GetIncidentResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-employee_ReplacementCoordinatorResponse"
                         }
                     },
                     "400": {
@@ -4141,30 +4505,37 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Soft delete an incident by ID",
+            }
+        },
+        "/evaluations": {
+            "post": {
+                "description": "Record progress logs for all current client goals and schedule the next evaluation.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Incident"
+                    "Evaluation"
                 ],
-                "summary": "Delete an incident",
+                "summary": "Create a client evaluation",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Incident ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Evaluation Details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/evaluation.CreateEvaluationRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-incident_DeleteIncidentResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-evaluation_CreateEvaluationResponse"
                         }
                     },
                     "400": {
@@ -4186,48 +4557,23 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
-                "description": "Update an existing incident by ID",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/evaluations/critical": {
+            "get": {
+                "description": "List evaluations due within the next 7 days or overdue.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Incident"
-                ],
-                "summary": "Update an incident",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Incident ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Incident update data",
-                        "name": "incident",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/incident.UpdateIncidentRequest"
-                        }
-                    }
+                    "Evaluation"
                 ],
+                "summary": "Get critical evaluations (Dashboard)",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-incident_UpdateIncidentResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/resp.ErrorResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-resp_PaginationResponse-evaluation_UpcomingEvaluationItem"
                         }
                     },
                     "401": {
@@ -4245,32 +4591,30 @@ const docTemplate = `{
                 }
             }
         },
-        "/intakes": {
+        "/evaluations/drafts": {
             "get": {
-                "description": "List all intake forms",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieve all draft evaluations for the logged-in coordinator.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Intake"
+                    "Evaluation"
                 ],
-                "summary": "List intake forms",
+                "summary": "Get all draft evaluations",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Search by client first name or last name",
-                        "name": "search",
-                        "in": "query"
+                        "description": "Coordinator ID",
+                        "name": "coordinatorId",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-resp_PaginationResponse-intake_ListIntakeFormsResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-resp_PaginationResponse-evaluation_DraftEvaluationListItem"
                         }
                     },
                     "400": {
@@ -4294,7 +4638,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Create a new intake form",
+                "description": "Create a new draft or update an existing draft evaluation for a client.",
                 "consumes": [
                     "application/json"
                 ],
@@ -4302,17 +4646,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Intake"
+                    "Evaluation"
                 ],
-                "summary": "Create an intake form",
+                "summary": "Save or update a draft evaluation",
                 "parameters": [
                     {
-                        "description": "Intake Form",
-                        "name": "intake",
+                        "description": "Draft Evaluation Details",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/intake.CreateIntakeFormRequest"
+                            "$ref": "#/definitions/evaluation.SaveDraftRequest"
                         }
                     }
                 ],
@@ -4320,7 +4664,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-intake_CreateIntakeFormResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-evaluation_SaveDraftResponse"
                         }
                     },
                     "400": {
@@ -4344,52 +4688,20 @@ const docTemplate = `{
                 }
             }
         },
-        "/intakes/stats": {
-            "get": {
-                "description": "Get total count, pending count, and conversion percentage of intake forms",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Intake"
-                ],
-                "summary": "Get intake statistics",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-intake_GetIntakeStatsResponse"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/resp.ErrorResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/resp.ErrorResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/intakes/{id}": {
+        "/evaluations/drafts/{id}": {
             "get": {
-                "description": "Get an intake form by ID with details",
+                "description": "Retrieve a draft evaluation by ID with all progress logs.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Intake"
+                    "Evaluation"
                 ],
-                "summary": "Get an intake form",
+                "summary": "Get a specific draft evaluation",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Intake Form ID",
+                        "description": "Draft Evaluation ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -4399,7 +4711,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-intake_GetIntakeFormResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-evaluation_DraftEvaluationResponse"
                         }
                     },
                     "400": {
@@ -4428,41 +4740,29 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
-                "description": "Update an existing intake form",
-                "consumes": [
-                    "application/json"
-                ],
+            "delete": {
+                "description": "Delete a draft evaluation permanently.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Intake"
+                    "Evaluation"
                 ],
-                "summary": "Update an intake form",
+                "summary": "Delete a draft evaluation",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Intake Form ID",
+                        "description": "Draft Evaluation ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Intake Form Update",
-                        "name": "intake",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/intake.UpdateIntakeFormRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-intake_UpdateIntakeFormResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-any"
                         }
                     },
                     "400": {
@@ -4486,44 +4786,30 @@ const docTemplate = `{
                 }
             }
         },
-        "/location-transfers": {
-            "get": {
-                "description": "List all location transfers with pagination and search",
-                "consumes": [
-                    "application/json"
-                ],
+        "/evaluations/drafts/{id}/submit": {
+            "post": {
+                "description": "Convert a draft evaluation to submitted status and update client's next evaluation date.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "LocationTransfer"
+                    "Evaluation"
                 ],
-                "summary": "List location transfers",
+                "summary": "Submit a draft evaluation",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Page number (default: 1)",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page size (default: 10, max: 100)",
-                        "name": "page_size",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "description": "Search by client name",
-                        "name": "search",
-                        "in": "query"
+                        "description": "Draft Evaluation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-resp_PaginationResponse-location_transfer_ListLocationTransfersResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-evaluation_CreateEvaluationResponse"
                         }
                     },
                     "400": {
@@ -4538,6 +4824,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -4545,35 +4837,32 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Register a new location transfer for a client",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
+            }
+        },
+        "/evaluations/history/{clientId}": {
+            "get": {
+                "description": "Retrieve all past evaluations and goal progress logs for a specific client.",
+                "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "LocationTransfer"
+                    "Evaluation"
                 ],
-                "summary": "Register a location transfer",
+                "summary": "Get evaluation history for a client",
                 "parameters": [
                     {
-                        "description": "Location Transfer Request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/location_transfer.RegisterLocationTransferRequest"
-                        }
+                        "type": "string",
+                        "description": "Client ID",
+                        "name": "clientId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-location_transfer_RegisterLocationTransferResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-array_evaluation_EvaluationHistoryItem"
                         }
                     },
                     "400": {
@@ -4588,12 +4877,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/resp.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -4603,63 +4886,43 @@ const docTemplate = `{
                 }
             }
         },
-        "/location-transfers/stats": {
+        "/evaluations/last/{clientId}": {
             "get": {
-                "description": "Get comprehensive statistics for location transfers including total count, pending count, approval rate, and status breakdowns",
+                "description": "Retrieve the most recent evaluation with all goal progress logs for a specific client.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "LocationTransfer"
+                    "Evaluation"
+                ],
+                "summary": "Get last evaluation for a client",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Client ID",
+                        "name": "clientId",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get location transfer statistics",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-location_transfer_GetLocationTransferStatsResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-evaluation_LastEvaluationItem"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
-                    }
-                }
-            }
-        },
-        "/location-transfers/{id}": {
-            "get": {
-                "description": "Get a single location transfer with all details",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "LocationTransfer"
-                ],
-                "summary": "Get a location transfer by ID",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Transfer ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-location_transfer_ListLocationTransfersResponse"
-                        }
                     },
                     "404": {
                         "description": "Not Found",
@@ -4674,42 +4937,43 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Update a pending location transfer (new location, coordinator, or reason)",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/evaluations/overdue": {
+            "get": {
+                "description": "List in-care clients whose next evaluation date has passed, grouped per coordinator with days overdue.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "LocationTransfer"
+                    "Evaluation"
                 ],
-                "summary": "Update a location transfer",
+                "summary": "List overdue evaluations",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Transfer ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Filter by coordinator (employee) ID",
+                        "name": "coordinatorId",
+                        "in": "query"
                     },
                     {
-                        "description": "Update fields",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/location_transfer.UpdateLocationTransferRequest"
-                        }
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-any"
+                            "$ref": "#/definitions/resp.SuccessResponse-resp_PaginationResponse-evaluation_OverdueEvaluationItem"
                         }
                     },
                     "400": {
@@ -4718,8 +4982,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
@@ -4733,40 +4997,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/location-transfers/{id}/confirm": {
-            "post": {
-                "description": "Confirm a pending location transfer, updating the client's location and coordinator",
+        "/evaluations/recent": {
+            "get": {
+                "description": "List the last 20 evaluations submitted across all clients.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "LocationTransfer"
-                ],
-                "summary": "Confirm a location transfer",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Transfer ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Evaluation"
                 ],
+                "summary": "Get recent evaluations (Dashboard)",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-any"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/resp.ErrorResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-resp_PaginationResponse-evaluation_GlobalRecentEvaluationItem"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
@@ -4780,9 +5029,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/location-transfers/{id}/refuse": {
+        "/evaluations/records": {
             "post": {
-                "description": "Refuse a pending location transfer with a reason",
+                "description": "Schedule an evaluation for a client on a given date.",
                 "consumes": [
                     "application/json"
                 ],
@@ -4790,24 +5039,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "LocationTransfer"
+                    "Evaluation"
                 ],
-                "summary": "Refuse a location transfer",
+                "summary": "Schedule an evaluation record",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Transfer ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Refusal reason",
+                        "description": "Evaluation Record Details",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/location_transfer.RefuseLocationTransferRequest"
+                            "$ref": "#/definitions/evaluation.CreateEvaluationRecordRequest"
                         }
                     }
                 ],
@@ -4815,7 +5057,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-any"
+                            "$ref": "#/definitions/resp.SuccessResponse-evaluation_EvaluationRecordResponse"
                         }
                     },
                     "400": {
@@ -4824,8 +5066,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
@@ -4839,20 +5081,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/locations": {
+        "/evaluations/records/client/{clientId}": {
             "get": {
-                "description": "List all locations with pagination and search",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "List a client's scheduled and completed evaluations, most recently scheduled first.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Location"
+                    "Evaluation"
                 ],
-                "summary": "List locations",
+                "summary": "List evaluation records for a client",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Client ID",
+                        "name": "clientId",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "type": "integer",
                         "description": "Page number (default: 1)",
@@ -4864,19 +5110,13 @@ const docTemplate = `{
                         "description": "Page size (default: 10, max: 100)",
                         "name": "page_size",
                         "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Search by name, postal code, or address",
-                        "name": "search",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-resp_PaginationResponse-array_locations_ListLocationsResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-resp_PaginationResponse-evaluation_EvaluationRecordListItem"
                         }
                     },
                     "400": {
@@ -4898,9 +5138,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/evaluations/records/{id}/complete": {
             "post": {
-                "description": "Create a new location",
+                "description": "Record the outcome of an open evaluation, roll the client's next evaluation date forward and schedule the next evaluation.",
                 "consumes": [
                     "application/json"
                 ],
@@ -4908,17 +5150,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Location"
+                    "Evaluation"
                 ],
-                "summary": "Create a location",
+                "summary": "Complete an evaluation record",
                 "parameters": [
                     {
-                        "description": "Location",
-                        "name": "location",
+                        "type": "string",
+                        "description": "Evaluation Record ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Completion Details",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/locations.CreateLocationRequest"
+                            "$ref": "#/definitions/evaluation.CompleteEvaluationRecordRequest"
                         }
                     }
                 ],
@@ -4926,7 +5175,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-locations_CreateLocationResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-evaluation_CompleteEvaluationRecordResponse"
                         }
                     },
                     "400": {
@@ -4941,6 +5190,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -4950,21 +5205,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/locations/capacity-stats": {
+        "/evaluations/scheduled": {
             "get": {
-                "description": "Get total capacity, capacity used (clients in care), and free capacity across all locations",
+                "description": "List evaluations scheduled between 8 and 30 days from now.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Location"
+                    "Evaluation"
                 ],
-                "summary": "Get location capacity statistics",
+                "summary": "Get scheduled evaluations (Dashboard)",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-locations_GetLocationCapacityStatsResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-resp_PaginationResponse-evaluation_UpcomingEvaluationItem"
                         }
                     },
                     "401": {
@@ -4982,23 +5237,20 @@ const docTemplate = `{
                 }
             }
         },
-        "/locations/{id}": {
-            "delete": {
-                "description": "Soft delete a location by ID",
-                "consumes": [
-                    "application/json"
-                ],
+        "/evaluations/{id}": {
+            "get": {
+                "description": "Retrieve an evaluation (submitted or draft) by ID with all progress logs.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Location"
+                    "Evaluation"
                 ],
-                "summary": "Delete a location (soft delete)",
+                "summary": "Get a specific evaluation",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Location ID",
+                        "description": "Evaluation ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -5008,7 +5260,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-locations_DeleteLocationResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-evaluation_EvaluationResponse"
                         }
                     },
                     "400": {
@@ -5037,8 +5289,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "patch": {
-                "description": "Update an existing location by ID",
+            "put": {
+                "description": "Update an existing submitted evaluation's date, notes, and goal progress.",
                 "consumes": [
                     "application/json"
                 ],
@@ -5046,24 +5298,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Location"
+                    "Evaluation"
                 ],
-                "summary": "Update a location",
+                "summary": "Update a submitted evaluation",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Location ID",
+                        "description": "Evaluation ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Location update data",
-                        "name": "location",
+                        "description": "Updated Evaluation Details",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/locations.UpdateLocationRequest"
+                            "$ref": "#/definitions/evaluation.UpdateEvaluationRequest"
                         }
                     }
                 ],
@@ -5071,7 +5323,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-locations_UpdateLocationResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-evaluation_UpdateEvaluationResponse"
                         }
                     },
                     "400": {
@@ -5101,21 +5353,57 @@ const docTemplate = `{
                 }
             }
         },
-        "/notifications": {
+        "/health/worker": {
             "get": {
-                "security": [
-                    {
-                        "Bearer": []
+                "description": "Get the notification worker's last heartbeat and whether it is considered down (admin only)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System"
+                ],
+                "summary": "Get notification worker health",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-health_WorkerHealthResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
                     }
+                }
+            }
+        },
+        "/incidents": {
+            "get": {
+                "description": "List all incidents with pagination and search by client name",
+                "consumes": [
+                    "application/json"
                 ],
-                "description": "List notifications for the current user with optional filtering",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Notifications"
+                    "Incident"
                 ],
-                "summary": "List notifications",
+                "summary": "List incidents",
                 "parameters": [
                     {
                         "type": "integer",
@@ -5130,9 +5418,9 @@ const docTemplate = `{
                         "in": "query"
                     },
                     {
-                        "type": "boolean",
-                        "description": "Filter by read status",
-                        "name": "is_read",
+                        "type": "string",
+                        "description": "Search by client first name, last name, or full name",
+                        "name": "search",
                         "in": "query"
                     }
                 ],
@@ -5140,7 +5428,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-resp_PaginationResponse-array_notification_NotificationResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-resp_PaginationResponse-array_incident_ListIncidentsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
                     "401": {
@@ -5156,28 +5450,41 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/notifications/read-all": {
-            "patch": {
-                "security": [
-                    {
-                        "Bearer": []
-                    }
+            },
+            "post": {
+                "description": "Create a new incident. The category must be one that fits the incident type",
+                "consumes": [
+                    "application/json"
                 ],
-                "description": "Mark all notifications as read for the current user",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Notifications"
+                    "Incident"
+                ],
+                "summary": "Create an incident",
+                "parameters": [
+                    {
+                        "description": "Incident",
+                        "name": "incident",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/incident.CreateIncidentRequest"
+                        }
+                    }
                 ],
-                "summary": "Mark all notifications as read",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-any"
+                            "$ref": "#/definitions/resp.SuccessResponse-incident_CreateIncidentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
                     "401": {
@@ -5195,26 +5502,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/notifications/unread-count": {
+        "/incidents/stats": {
             "get": {
-                "security": [
-                    {
-                        "Bearer": []
-                    }
-                ],
-                "description": "Get the count of unread notifications for the current user",
+                "description": "Get comprehensive statistics for incidents including counts by severity, status, and type",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Notifications"
+                    "Incident"
                 ],
-                "summary": "Get unread notification count",
+                "summary": "Get incident statistics",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-notification_UnreadCountResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-incident_GetIncidentStatsResponse"
                         }
                     },
                     "401": {
@@ -5232,35 +5534,29 @@ const docTemplate = `{
                 }
             }
         },
-        "/notifications/{id}": {
-            "delete": {
-                "security": [
-                    {
-                        "Bearer": []
-                    }
-                ],
-                "description": "Delete a notification",
+        "/incidents/stats/trend": {
+            "get": {
+                "description": "Get incident counts per severity for each of the last months (12 by default), oldest first. Deleted incidents are not counted",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Notifications"
+                    "Incident"
                 ],
-                "summary": "Delete notification",
+                "summary": "Get monthly incident trend",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Notification ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "type": "integer",
+                        "description": "Number of months, including the current one (1-60, default 12)",
+                        "name": "months",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-any"
+                            "$ref": "#/definitions/resp.SuccessResponse-array_incident_IncidentTrendMonth"
                         }
                     },
                     "400": {
@@ -5284,25 +5580,20 @@ const docTemplate = `{
                 }
             }
         },
-        "/notifications/{id}/read": {
-            "patch": {
-                "security": [
-                    {
-                        "Bearer": []
-                    }
-                ],
-                "description": "Mark a single notification as read",
+        "/incidents/{id}": {
+            "get": {
+                "description": "Get a single incident by ID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Notifications"
+                    "Incident"
                 ],
-                "summary": "Mark notification as read",
+                "summary": "Get an incident",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Notification ID",
+                        "description": "Incident ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -5312,7 +5603,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-any"
+                            "$ref": "#/definitions/resp.SuccessResponse-incident_GetIncidentResponse"
                         }
                     },
                     "400": {
@@ -5327,6 +5618,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -5334,11 +5631,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/referring-orgs": {
-            "get": {
-                "description": "Get a paginated list of referring organizations with optional search",
+            },
+            "delete": {
+                "description": "Soft delete an incident by ID. Admin only; the reason is recorded in the audit log.",
                 "consumes": [
                     "application/json"
                 ],
@@ -5346,34 +5641,56 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "referring-orgs"
+                    "Incident"
                 ],
-                "summary": "List referring organizations",
+                "summary": "Delete an incident",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Search term for name, contact person, or email",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page number (default: 1)",
-                        "name": "page",
-                        "in": "query"
+                        "description": "Incident ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "Items per page (default: 10)",
-                        "name": "pageSize",
-                        "in": "query"
+                        "description": "Deletion reason",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/incident.DeleteIncidentRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.PaginationResponse-referring_orgs_ListReferringOrgsResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-incident_DeleteIncidentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
                     "500": {
@@ -5384,8 +5701,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
-                "description": "Create a new referring organization with the provided details",
+            "patch": {
+                "description": "Update an existing incident by ID. The resulting category must fit the resulting incident type",
                 "consumes": [
                     "application/json"
                 ],
@@ -5393,25 +5710,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "referring-orgs"
+                    "Incident"
                 ],
-                "summary": "Create a new referring organization",
+                "summary": "Update an incident",
                 "parameters": [
                     {
-                        "description": "Referring Organization data",
-                        "name": "request",
+                        "type": "string",
+                        "description": "Incident ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Incident update data",
+                        "name": "incident",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/referring_orgs.CreateReferringOrgRequest"
+                            "$ref": "#/definitions/incident.UpdateIncidentRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/referring_orgs.CreateReferringOrgResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-incident_UpdateIncidentResponse"
                         }
                     },
                     "400": {
@@ -5420,34 +5744,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
-                    }
-                }
-            }
-        },
-        "/referring-orgs/stats": {
-            "get": {
-                "description": "Get comprehensive statistics for referring organizations including total orgs, orgs with in-care/waitlist clients, and total clients referred",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "referring-orgs"
-                ],
-                "summary": "Get referring organization statistics",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-referring_orgs_GetReferringOrgStatsResponse"
-                        }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
@@ -5461,9 +5765,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/referring-orgs/{id}": {
-            "put": {
-                "description": "Update an existing referring organization with partial data",
+        "/intakes": {
+            "get": {
+                "description": "List all intake forms",
                 "consumes": [
                     "application/json"
                 ],
@@ -5471,32 +5775,28 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "referring-orgs"
+                    "Intake"
                 ],
-                "summary": "Update a referring organization",
+                "summary": "List intake forms",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Referring Organization ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Search by client first name or last name",
+                        "name": "search",
+                        "in": "query"
                     },
                     {
-                        "description": "Referring Organization update data",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/referring_orgs.UpdateReferringOrgRequest"
-                        }
+                        "type": "string",
+                        "description": "Sort as field or field:desc; field is intake_date, name or created_at (default: newest first)",
+                        "name": "sort",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/referring_orgs.UpdateReferringOrgResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-resp_PaginationResponse-intake_ListIntakeFormsResponse"
                         }
                     },
                     "400": {
@@ -5505,6 +5805,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -5512,49 +5818,35 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/registrations": {
-            "get": {
-                "description": "List all registration forms",
+            },
+            "post": {
+                "description": "Create a new intake form. The intake time must be one of the coordinator's open slots on that date.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Registration"
+                    "Intake"
                 ],
-                "summary": "List registration forms",
+                "summary": "Create an intake form",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page size",
-                        "name": "page_size",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Search",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by status (pending, approved, rejected, in_review)",
-                        "name": "status",
-                        "in": "query"
+                        "description": "Intake Form",
+                        "name": "intake",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/intake.CreateIntakeFormRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-resp_PaginationResponse-registration_ListRegistrationFormsResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-intake_CreateIntakeFormResponse"
                         }
                     },
                     "400": {
@@ -5569,6 +5861,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -5576,35 +5880,39 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Create a new registration form",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/intakes/slots": {
+            "get": {
+                "description": "List the open intake slots for a coordinator on a date, based on their weekly availability and existing intakes and appointments",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Registration"
+                    "Intake"
                 ],
-                "summary": "Create a registration form",
+                "summary": "Get available intake slots",
                 "parameters": [
                     {
-                        "description": "Registration Form",
-                        "name": "registration",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/registration.CreateRegistrationFormRequest"
-                        }
+                        "type": "string",
+                        "description": "Coordinator (employee) ID",
+                        "name": "coordinatorId",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date (YYYY-MM-DD)",
+                        "name": "date",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-registration_CreateRegistrationFormResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-intake_GetAvailableIntakeSlotsResponse"
                         }
                     },
                     "400": {
@@ -5628,21 +5936,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/registrations/stats": {
+        "/intakes/stats": {
             "get": {
-                "description": "Get counts of total, approved, and in-review registration forms",
+                "description": "Get total count, pending count, and conversion percentage of intake forms",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Registration"
+                    "Intake"
                 ],
-                "summary": "Get registration statistics",
+                "summary": "Get intake statistics",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-registration_GetRegistrationStatsResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-intake_GetIntakeStatsResponse"
                         }
                     },
                     "401": {
@@ -5660,20 +5968,20 @@ const docTemplate = `{
                 }
             }
         },
-        "/registrations/{id}": {
+        "/intakes/{id}": {
             "get": {
-                "description": "Get a registration form by ID with details",
+                "description": "Get an intake form by ID with details",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Registration"
+                    "Intake"
                 ],
-                "summary": "Get a registration form",
+                "summary": "Get an intake form",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Registration Form ID",
+                        "description": "Intake Form ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -5683,7 +5991,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-registration_GetRegistrationFormResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-intake_GetIntakeFormResponse"
                         }
                     },
                     "400": {
@@ -5713,7 +6021,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "Update an existing registration form",
+                "description": "Update an existing intake form. A new date, time or coordinator must be one of the coordinator's open slots.",
                 "consumes": [
                     "application/json"
                 ],
@@ -5721,24 +6029,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Registration"
+                    "Intake"
                 ],
-                "summary": "Update a registration form",
+                "summary": "Update an intake form",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Registration Form ID",
+                        "description": "Intake Form ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Registration Form Update",
-                        "name": "registration",
+                        "description": "Intake Form Update",
+                        "name": "intake",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/registration.UpdateRegistrationFormRequest"
+                            "$ref": "#/definitions/intake.UpdateIntakeFormRequest"
                         }
                     }
                 ],
@@ -5746,7 +6054,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-registration_UpdateRegistrationFormResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-intake_UpdateIntakeFormResponse"
                         }
                     },
                     "400": {
@@ -5761,6 +6069,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -5770,18 +6096,18 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Soft delete a registration form by ID",
+                "description": "Soft delete an intake form so a new intake can be created for its registration.\nIntakes that were already converted into a client cannot be deleted.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Registration"
+                    "Intake"
                 ],
-                "summary": "Delete a registration form",
+                "summary": "Delete an intake form",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Registration Form ID",
+                        "description": "Intake Form ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -5791,7 +6117,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-registration_DeleteRegistrationFormResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-intake_DeleteIntakeFormResponse"
                         }
                     },
                     "400": {
@@ -5806,6 +6132,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -5815,26 +6153,30 @@ const docTemplate = `{
                 }
             }
         },
-        "/ws/auth": {
-            "post": {
-                "security": [
-                    {
-                        "Bearer": []
-                    }
-                ],
-                "description": "Exchange JWT for a one-time WebSocket connection ticket",
+        "/intakes/{id}/documents": {
+            "get": {
+                "description": "List the document checklist of an intake form. The required documents depend on the care type.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Notifications"
+                    "Intake"
+                ],
+                "summary": "List an intake's required documents",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Intake Form ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Create WebSocket auth ticket",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/resp.SuccessResponse-notification_WSAuthResponse"
+                            "$ref": "#/definitions/resp.SuccessResponse-array_intake_IntakeDocumentResponse"
                         }
                     },
                     "401": {
@@ -5843,6 +6185,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -5852,908 +6200,5111 @@ const docTemplate = `{
                 }
             }
         },
-        "/ws/notifications": {
-            "get": {
-                "description": "Establish WebSocket connection for real-time notifications",
+        "/intakes/{id}/documents/{documentType}": {
+            "put": {
+                "description": "Mark a document on the intake's checklist as received or missing",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Notifications"
+                    "Intake"
                 ],
-                "summary": "Connect to WebSocket",
+                "summary": "Update a required document",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "One-time auth ticket from /ws/auth",
-                        "name": "ticket",
-                        "in": "query",
+                        "description": "Intake Form ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "identification",
+                            "care_indication",
+                            "consent"
+                        ],
+                        "type": "string",
+                        "description": "Document type",
+                        "name": "documentType",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "New document status",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/intake.UpdateIntakeDocumentRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "101": {
-                        "description": "Switching Protocols"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-intake_IntakeDocumentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/resp.ErrorResponse"
                         }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "attachments.UploadAttachmentResponse": {
+        },
+        "/intakes/{id}/reschedule-history": {
+            "get": {
+                "description": "List every change of an intake's date or time, oldest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Intake"
+                ],
+                "summary": "List an intake's reschedules",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Intake Form ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-array_intake_IntakeRescheduleResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/location-transfers": {
+            "get": {
+                "description": "List all location transfers with pagination and search",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "LocationTransfer"
+                ],
+                "summary": "List location transfers",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default: 10, max: 100)",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search by client name",
+                        "name": "search",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-resp_PaginationResponse-location_transfer_ListLocationTransfersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Register a new location transfer for a client",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "LocationTransfer"
+                ],
+                "summary": "Register a location transfer",
+                "parameters": [
+                    {
+                        "description": "Location Transfer Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/location_transfer.RegisterLocationTransferRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-location_transfer_RegisterLocationTransferResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/location-transfers/stats": {
+            "get": {
+                "description": "Get comprehensive statistics for location transfers including total count, pending count, approval rate, and status breakdowns",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "LocationTransfer"
+                ],
+                "summary": "Get location transfer statistics",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-location_transfer_GetLocationTransferStatsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/location-transfers/{id}": {
+            "get": {
+                "description": "Get a single location transfer with all details",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "LocationTransfer"
+                ],
+                "summary": "Get a location transfer by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transfer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-location_transfer_ListLocationTransfersResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a pending location transfer (new location, coordinator, or reason)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "LocationTransfer"
+                ],
+                "summary": "Update a location transfer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transfer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update fields",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/location_transfer.UpdateLocationTransferRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-any"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/location-transfers/{id}/cancel": {
+            "post": {
+                "description": "Withdraw a pending location transfer. Only the coordinator who requested it or an admin can cancel it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "LocationTransfer"
+                ],
+                "summary": "Cancel a location transfer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transfer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Cancellation reason",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/location_transfer.CancelLocationTransferRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-any"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/location-transfers/{id}/confirm": {
+            "post": {
+                "description": "Confirm a pending location transfer, updating the client's location and coordinator.\nThe new coordinator is notified of the overdue evaluations and unresolved incidents they inherit.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "LocationTransfer"
+                ],
+                "summary": "Confirm a location transfer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transfer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Handover notes for the new coordinator",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/location_transfer.ConfirmLocationTransferRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-any"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/location-transfers/{id}/refuse": {
+            "post": {
+                "description": "Refuse a pending location transfer with a reason",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "LocationTransfer"
+                ],
+                "summary": "Refuse a location transfer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transfer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Refusal reason",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/location_transfer.RefuseLocationTransferRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-any"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/locations": {
+            "get": {
+                "description": "List all locations with pagination and search",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Location"
+                ],
+                "summary": "List locations",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default: 10, max: 100)",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search by name, postal code, or address",
+                        "name": "search",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-resp_PaginationResponse-array_locations_ListLocationsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new location",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Location"
+                ],
+                "summary": "Create a location",
+                "parameters": [
+                    {
+                        "description": "Location",
+                        "name": "location",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/locations.CreateLocationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-locations_CreateLocationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/locations/capacity-stats": {
+            "get": {
+                "description": "Get total capacity, capacity used (clients in care), and free capacity across the organization's locations",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Location"
+                ],
+                "summary": "Get location capacity statistics",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-locations_GetLocationCapacityStatsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/locations/{id}": {
+            "delete": {
+                "description": "Soft delete a location by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Location"
+                ],
+                "summary": "Delete a location (soft delete)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Location ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-locations_DeleteLocationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Update an existing location by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Location"
+                ],
+                "summary": "Update a location",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Location ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Location update data",
+                        "name": "location",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/locations.UpdateLocationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-locations_UpdateLocationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/locations/{id}/capacity-trend": {
+            "get": {
+                "description": "Get the daily capacity and occupancy of a location between two dates (inclusive), oldest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Location"
+                ],
+                "summary": "Get location capacity trend",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Location ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-array_locations_LocationCapacityPoint"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/me": {
+            "get": {
+                "description": "Get the email, employee details, role, permissions and last login of the currently authenticated user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Employee"
+                ],
+                "summary": "Get logged-in user's profile",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-employee_GetMyProfileResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/metadata/enums": {
+            "get": {
+                "description": "Get the allowed values and Dutch labels of every enum used in forms and filters",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Metadata"
+                ],
+                "summary": "Get enum options",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-metadata_EnumOptionsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "List notifications for the current user with optional filtering",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "List notifications",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default: 10, max: 100)",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Filter by read status",
+                        "name": "is_read",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-resp_PaginationResponse-array_notification_NotificationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/quiet-hours": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get the current user's quiet hours, during which non-critical notifications are held back",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Get quiet hours",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-notification_QuietHoursResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Set the current user's quiet hours. High and urgent notifications are still delivered during them.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Update quiet hours",
+                "parameters": [
+                    {
+                        "description": "Quiet hours",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/notification.UpdateQuietHoursRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-notification_QuietHoursResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Turn quiet hours off for the current user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Delete quiet hours",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.MessageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/read-all": {
+            "patch": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Mark all notifications as read for the current user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Mark all notifications as read",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-any"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/unread-count": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get the count of unread notifications for the current user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Get unread notification count",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-notification_UnreadCountResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Delete a notification",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Delete notification",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Notification ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-any"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/{id}/read": {
+            "patch": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Mark a single notification as read",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Mark notification as read",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Notification ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-any"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/openapi.json": {
+            "get": {
+                "description": "Get the API contract as an OpenAPI 3.0 document, generated from the handler annotations",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System"
+                ],
+                "summary": "Get the OpenAPI specification",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/readyz": {
+            "get": {
+                "description": "Report the health of the database and the notification worker.\nThe worker is unhealthy when its last heartbeat is older than twice its tick interval.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System"
+                ],
+                "summary": "Readiness probe",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/health.ReadinessResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/health.ReadinessResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/referring-orgs": {
+            "get": {
+                "description": "Get a paginated list of referring organizations with optional search",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "referring-orgs"
+                ],
+                "summary": "List referring organizations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search term for name, contact person, or email",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page (default: 10)",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.PaginationResponse-referring_orgs_ListReferringOrgsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new referring organization with the provided details",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "referring-orgs"
+                ],
+                "summary": "Create a new referring organization",
+                "parameters": [
+                    {
+                        "description": "Referring Organization data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/referring_orgs.CreateReferringOrgRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/referring_orgs.CreateReferringOrgResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/referring-orgs/stats": {
+            "get": {
+                "description": "Get comprehensive statistics for referring organizations including total orgs, orgs with in-care/waitlist clients, and total clients referred",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "referring-orgs"
+                ],
+                "summary": "Get referring organization statistics",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-referring_orgs_GetReferringOrgStatsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/referring-orgs/{id}": {
+            "put": {
+                "description": "Update an existing referring organization with partial data",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "referring-orgs"
+                ],
+                "summary": "Update a referring organization",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Referring Organization ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Referring Organization update data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/referring_orgs.UpdateReferringOrgRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/referring_orgs.UpdateReferringOrgResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/registrations": {
+            "get": {
+                "description": "List all registration forms",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Registration"
+                ],
+                "summary": "List registration forms",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by status (pending, approved, rejected, in_review)",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-resp_PaginationResponse-registration_ListRegistrationFormsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new registration form",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Registration"
+                ],
+                "summary": "Create a registration form",
+                "parameters": [
+                    {
+                        "description": "Registration Form",
+                        "name": "registration",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/registration.CreateRegistrationFormRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-registration_CreateRegistrationFormResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/registration.DuplicateBSNResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/registrations/deleted": {
+            "get": {
+                "description": "List soft-deleted registration forms, most recently deleted first (admin only)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Registration"
+                ],
+                "summary": "List deleted registration forms",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-resp_PaginationResponse-registration_ListDeletedRegistrationFormsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/registrations/stats": {
+            "get": {
+                "description": "Get counts of total, approved, and in-review registration forms",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Registration"
+                ],
+                "summary": "Get registration statistics",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-registration_GetRegistrationStatsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/registrations/status": {
+            "patch": {
+                "description": "Set the same status on several registration forms at once. Soft-deleted forms are skipped.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Registration"
+                ],
+                "summary": "Update the status of multiple registration forms",
+                "parameters": [
+                    {
+                        "description": "Registration form IDs and new status",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/registration.BatchUpdateRegistrationStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-registration_BatchUpdateRegistrationStatusResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/registrations/{id}": {
+            "get": {
+                "description": "Get a registration form by ID with details",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Registration"
+                ],
+                "summary": "Get a registration form",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Registration Form ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-registration_GetRegistrationFormResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update an existing registration form",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Registration"
+                ],
+                "summary": "Update a registration form",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Registration Form ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Registration Form Update",
+                        "name": "registration",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/registration.UpdateRegistrationFormRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-registration_UpdateRegistrationFormResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Soft delete a registration form by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Registration"
+                ],
+                "summary": "Delete a registration form",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Registration Form ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-registration_DeleteRegistrationFormResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/registrations/{id}/attachments/order": {
+            "put": {
+                "description": "Set the display order of the attachments linked to a registration form. The list must contain every linked attachment exactly once.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Registration"
+                ],
+                "summary": "Reorder registration form attachments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Registration Form ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Attachment IDs in display order",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/registration.ReorderRegistrationAttachmentsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/registrations/{id}/attachments/{attachmentId}": {
+            "patch": {
+                "description": "Set or clear the caption of an attachment linked to a registration form",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Registration"
+                ],
+                "summary": "Update a registration form attachment caption",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Registration Form ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Attachment ID",
+                        "name": "attachmentId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Caption",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/registration.UpdateRegistrationAttachmentCaptionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/registrations/{id}/restore": {
+            "post": {
+                "description": "Undo a soft delete. Rejected while another active form has the same BSN (admin only).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Registration"
+                ],
+                "summary": "Restore a deleted registration form",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Registration Form ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-registration_RestoreRegistrationFormResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/registration.DuplicateBSNResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/registrations/{id}/status-history": {
+            "get": {
+                "description": "List every status change of a registration form, oldest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Registration"
+                ],
+                "summary": "Get registration status history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Registration Form ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-array_registration_RegistrationStatusChangeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/version": {
+            "get": {
+                "description": "Get the version, git commit and build time of the running API",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System"
+                ],
+                "summary": "Get build version",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-version_Info"
+                        }
+                    }
+                }
+            }
+        },
+        "/ws/auth": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Exchange JWT for a one-time WebSocket connection ticket",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Create WebSocket auth ticket",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/resp.SuccessResponse-notification_WSAuthResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/ws/notifications": {
+            "get": {
+                "description": "Establish WebSocket connection for real-time notifications",
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Connect to WebSocket",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "One-time auth ticket from /ws/auth",
+                        "name": "ticket",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "101": {
+                        "description": "Switching Protocols"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/resp.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "attachments.AttachmentAccessResponse": {
+            "type": "object",
+            "properties": {
+                "accessedAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "ipAddress": {
+                    "type": "string"
+                },
+                "resourceId": {
+                    "type": "string"
+                },
+                "resourceType": {
+                    "description": "ResourceType and ResourceID name the registration form or client the\nattachment belonged to when it was downloaded",
+                    "type": "string"
+                },
+                "userAgent": {
+                    "type": "string"
+                },
+                "userEmail": {
+                    "type": "string"
+                },
+                "userId": {
+                    "description": "Nil once the user is deleted",
+                    "type": "string"
+                }
+            }
+        },
+        "attachments.DownloadAttachmentResponse": {
+            "type": "object",
+            "properties": {
+                "contentType": {
+                    "type": "string"
+                },
+                "expiresAt": {
+                    "type": "string"
+                },
+                "url": {
+                    "description": "URL is a presigned object storage link, valid until ExpiresAt",
+                    "type": "string"
+                }
+            }
+        },
+        "attachments.UploadAttachmentResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
+        "audit.AuditLogResponse": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "client_id": {
+                    "type": "string"
+                },
+                "client_name": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "current_hash": {
+                    "type": "string"
+                },
+                "employee_id": {
+                    "type": "string"
+                },
+                "employee_name": {
+                    "type": "string"
+                },
+                "failure_reason": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "ip_address": {
+                    "type": "string"
+                },
+                "new_value": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "old_value": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "prev_hash": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "resource_id": {
+                    "type": "string"
+                },
+                "resource_type": {
+                    "type": "string"
+                },
+                "sequence_number": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "user_agent": {
+                    "type": "string"
+                },
+                "user_email": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "audit.AuditLogStatsResponse": {
+            "type": "object",
+            "properties": {
+                "create_count": {
+                    "type": "integer"
+                },
+                "delete_count": {
+                    "type": "integer"
+                },
+                "failure_count": {
+                    "type": "integer"
+                },
+                "read_count": {
+                    "type": "integer"
+                },
+                "total_logs": {
+                    "type": "integer"
+                },
+                "update_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "audit.ChainVerificationResult": {
+            "type": "object",
+            "properties": {
+                "actual_hash": {
+                    "type": "string"
+                },
+                "broken_entry_id": {
+                    "type": "string"
+                },
+                "expected_hash": {
+                    "type": "string"
+                },
+                "first_broken_seq": {
+                    "type": "integer"
+                },
+                "is_valid": {
+                    "type": "boolean"
+                },
+                "total_entries": {
+                    "type": "integer"
+                },
+                "verified_at": {
+                    "type": "string"
+                },
+                "verified_entries": {
+                    "type": "integer"
+                }
+            }
+        },
+        "auth.DisableMFARequest": {
+            "type": "object",
+            "required": [
+                "password"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "auth.EnableMFARequest": {
+            "type": "object",
+            "required": [
+                "code"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string"
+                }
+            }
+        },
+        "auth.EnableMFAResponse": {
+            "type": "object",
+            "properties": {
+                "backupCodes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "auth.LoginRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "auth.LoginResponse": {
+            "type": "object",
+            "properties": {
+                "accessToken": {
+                    "type": "string"
+                },
+                "mfaRequired": {
+                    "type": "boolean"
+                },
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "preAuthToken": {
+                    "type": "string"
+                },
+                "refreshToken": {
+                    "type": "string"
+                },
+                "role": {
+                    "description": "Role and Permissions are empty while MFA verification is pending",
+                    "type": "string"
+                }
+            }
+        },
+        "auth.LogoutRequest": {
+            "type": "object",
+            "required": [
+                "refreshToken"
+            ],
+            "properties": {
+                "refreshToken": {
+                    "type": "string"
+                }
+            }
+        },
+        "auth.RefreshTokensRequest": {
+            "type": "object",
+            "required": [
+                "refreshToken"
+            ],
+            "properties": {
+                "refreshToken": {
+                    "type": "string"
+                }
+            }
+        },
+        "auth.RefreshTokensResponse": {
+            "type": "object",
+            "properties": {
+                "accessToken": {
+                    "type": "string"
+                },
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "refreshToken": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                }
+            }
+        },
+        "auth.ResetPasswordRequest": {
+            "type": "object",
+            "required": [
+                "currentPassword",
+                "newPassword"
+            ],
+            "properties": {
+                "currentPassword": {
+                    "type": "string"
+                },
+                "newPassword": {
+                    "type": "string"
+                }
+            }
+        },
+        "auth.SetupMFAResponse": {
+            "type": "object",
+            "properties": {
+                "otpAuthUrl": {
+                    "type": "string"
+                },
+                "secret": {
+                    "type": "string"
+                }
+            }
+        },
+        "auth.VerifyMFARequest": {
+            "type": "object",
+            "required": [
+                "code",
+                "preAuthToken"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "preAuthToken": {
+                    "type": "string"
+                }
+            }
+        },
+        "auth.VerifyMFAResponse": {
+            "type": "object",
+            "properties": {
+                "accessToken": {
+                    "type": "string"
+                },
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "refreshToken": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                }
+            }
+        },
+        "calendar.AppointmentResponse": {
+            "type": "object",
+            "properties": {
+                "cancellationReason": {
+                    "description": "Set only for cancelled appointments",
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "endTime": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "organizerId": {
+                    "type": "string"
+                },
+                "participants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/calendar.Participant"
+                    }
+                },
+                "recurrenceRule": {
+                    "type": "string"
+                },
+                "startTime": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/calendar.AppointmentStatus"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/calendar.AppointmentType"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "calendar.AppointmentStatus": {
+            "type": "string",
+            "enum": [
+                "confirmed",
+                "cancelled",
+                "tentative"
+            ],
+            "x-enum-varnames": [
+                "StatusConfirmed",
+                "StatusCancelled",
+                "StatusTentative"
+            ]
+        },
+        "calendar.AppointmentType": {
+            "type": "string",
+            "enum": [
+                "general",
+                "intake",
+                "ambulatory"
+            ],
+            "x-enum-varnames": [
+                "TypeGeneral",
+                "TypeIntake",
+                "TypeAmbulatory"
+            ]
+        },
+        "calendar.CalendarEvent": {
+            "type": "object",
+            "properties": {
+                "allDay": {
+                    "type": "boolean"
+                },
+                "backgroundColor": {
+                    "type": "string"
+                },
+                "end": {
+                    "type": "string"
+                },
+                "extendedProps": {
+                    "$ref": "#/definitions/calendar.CalendarExtendedProps"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "start": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "calendar.CalendarExtendedProps": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "isCompleted": {
+                    "type": "boolean"
+                },
+                "isRecurring": {
+                    "type": "boolean"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "originalEventId": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "calendar.CancelAppointmentRequest": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "calendar.CreateAppointmentRequest": {
+            "type": "object",
+            "required": [
+                "endTime",
+                "participants",
+                "startTime",
+                "title",
+                "type"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "endTime": {
+                    "type": "string"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "participants": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/calendar.Participant"
+                    }
+                },
+                "recurrenceRule": {
+                    "type": "string"
+                },
+                "startTime": {
+                    "type": "string"
+                },
+                "status": {
+                    "enum": [
+                        "confirmed",
+                        "cancelled",
+                        "tentative"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/calendar.AppointmentStatus"
+                        }
+                    ]
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "enum": [
+                        "general",
+                        "intake",
+                        "ambulatory"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/calendar.AppointmentType"
+                        }
+                    ]
+                }
+            }
+        },
+        "calendar.CreateReminderRequest": {
+            "type": "object",
+            "required": [
+                "dueTime",
+                "title"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "dueTime": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "calendar.ListAppointmentsResponse": {
+            "type": "object",
+            "properties": {
+                "clientFirstName": {
+                    "type": "string"
+                },
+                "clientId": {
+                    "type": "string"
+                },
+                "clientLastName": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "endTime": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "organizerId": {
+                    "type": "string"
+                },
+                "participants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/calendar.Participant"
+                    }
+                },
+                "recurrenceRule": {
+                    "type": "string"
+                },
+                "startTime": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/calendar.AppointmentStatus"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/calendar.AppointmentType"
+                }
+            }
+        },
+        "calendar.Participant": {
+            "type": "object",
+            "required": [
+                "id",
+                "type"
+            ],
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "type": {
+                    "enum": [
+                        "employee",
+                        "client"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/calendar.ParticipantType"
+                        }
+                    ]
+                }
+            }
+        },
+        "calendar.ParticipantType": {
+            "type": "string",
+            "enum": [
+                "employee",
+                "client"
+            ],
+            "x-enum-varnames": [
+                "ParticipantEmployee",
+                "ParticipantClient"
+            ]
+        },
+        "calendar.ReminderResponse": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "dueTime": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "isCompleted": {
+                    "type": "boolean"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "string"
+                }
+            }
+        },
+        "calendar.UpdateAppointmentRequest": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "endTime": {
+                    "type": "string"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "participants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/calendar.Participant"
+                    }
+                },
+                "recurrenceRule": {
+                    "type": "string"
+                },
+                "startTime": {
+                    "type": "string"
+                },
+                "status": {
+                    "enum": [
+                        "confirmed",
+                        "cancelled",
+                        "tentative"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/calendar.AppointmentStatus"
+                        }
+                    ]
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "enum": [
+                        "general",
+                        "intake",
+                        "ambulatory"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/calendar.AppointmentType"
+                        }
+                    ]
+                }
+            }
+        },
+        "calendar.UpdateReminderRequest": {
+            "type": "object",
+            "properties": {
+                "isCompleted": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "client.AddClientNoteRequest": {
+            "type": "object",
+            "required": [
+                "body"
+            ],
+            "properties": {
+                "body": {
+                    "type": "string"
+                }
+            }
+        },
+        "client.CareTypeCounts": {
+            "type": "object",
+            "properties": {
+                "ambulatoryCare": {
+                    "type": "integer"
+                },
+                "independentAssistedLiving": {
+                    "type": "integer"
+                },
+                "protectedLiving": {
+                    "type": "integer"
+                },
+                "semiIndependentLiving": {
+                    "type": "integer"
+                }
+            }
+        },
+        "client.ClientNoteResponse": {
+            "type": "object",
+            "properties": {
+                "authorFirstName": {
+                    "type": "string"
+                },
+                "authorId": {
+                    "type": "string"
+                },
+                "authorLastName": {
+                    "type": "string"
+                },
+                "body": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
+        "client.CompleteDischargeRequest": {
+            "type": "object",
+            "required": [
+                "closingReport",
+                "evaluationReport"
+            ],
+            "properties": {
+                "closingReport": {
+                    "type": "string"
+                },
+                "dischargeAttachmentIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "evaluationReport": {
+                    "type": "string"
+                }
+            }
+        },
+        "client.CompleteDischargeResponse": {
+            "type": "object",
+            "properties": {
+                "clientId": {
+                    "type": "string"
+                }
+            }
+        },
+        "client.DischargeTrendMonth": {
+            "type": "object",
+            "properties": {
+                "month": {
+                    "description": "YYYY-MM",
+                    "type": "string"
+                },
+                "plannedDischarges": {
+                    "type": "integer"
+                },
+                "prematureDischarges": {
+                    "type": "integer"
+                },
+                "totalCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "client.ExportClientResponse": {
+            "type": "object",
+            "properties": {
+                "bsn": {
+                    "type": "string"
+                },
+                "careEndDate": {
+                    "type": "string"
+                },
+                "careStartDate": {
+                    "type": "string"
+                },
+                "careType": {
+                    "type": "string"
+                },
+                "coordinatorFirstName": {
+                    "type": "string"
+                },
+                "coordinatorLastName": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "dateOfBirth": {
+                    "type": "string"
+                },
+                "dischargeDate": {
+                    "type": "string"
+                },
+                "firstName": {
+                    "type": "string"
+                },
+                "gender": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "lastName": {
+                    "type": "string"
+                },
+                "locationName": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "client.GetClientByBSNRequest": {
+            "type": "object",
+            "required": [
+                "bsn"
+            ],
+            "properties": {
+                "bsn": {
+                    "type": "string"
+                }
+            }
+        },
+        "client.GetClientResponse": {
+            "type": "object",
+            "properties": {
+                "ambulatoryWeeklyHours": {
+                    "type": "integer"
+                },
+                "bsn": {
+                    "type": "string"
+                },
+                "careEndDate": {
+                    "type": "string"
+                },
+                "careStartDate": {
+                    "type": "string"
+                },
+                "careType": {
+                    "type": "string"
+                },
+                "closingReport": {
+                    "type": "string"
+                },
+                "coordinatorId": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "dateOfBirth": {
+                    "type": "string"
+                },
+                "dischargeDate": {
+                    "type": "string"
+                },
+                "dischargeStatus": {
+                    "type": "string"
+                },
+                "evaluationIntervalWeeks": {
+                    "type": "integer"
+                },
+                "evaluationReport": {
+                    "type": "string"
+                },
+                "familySituation": {
+                    "type": "string"
+                },
+                "firstName": {
+                    "type": "string"
+                },
+                "focusAreas": {
+                    "type": "string"
+                },
+                "gender": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "intakeFormId": {
+                    "type": "string"
+                },
+                "lastName": {
+                    "type": "string"
+                },
+                "limitations": {
+                    "type": "string"
+                },
+                "locationId": {
+                    "type": "string"
+                },
+                "nextEvaluationDate": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "phoneNumber": {
+                    "type": "string"
+                },
+                "reasonForDischarge": {
+                    "type": "string"
+                },
+                "referringOrgId": {
+                    "type": "string"
+                },
+                "registrationFormId": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "waitingListPriority": {
+                    "type": "string"
+                }
+            }
+        },
+        "client.GetDischargeStatsResponse": {
+            "type": "object",
+            "properties": {
+                "averageDaysInCare": {
+                    "type": "number"
+                },
+                "completedDischarges": {
+                    "type": "integer"
+                },
+                "dischargeCompletionRate": {
+                    "type": "number"
+                },
+                "prematureDischarges": {
+                    "type": "integer"
+                },
+                "totalCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "client.GetInCareStatsResponse": {
+            "type": "object",
+            "properties": {
+                "averageDaysInCare": {
+                    "description": "AverageDaysInCare and LongestStayDays count from care start to today",
+                    "type": "number"
+                },
+                "countsByCareType": {
+                    "$ref": "#/definitions/client.CareTypeCounts"
+                },
+                "longestStayDays": {
+                    "type": "integer"
+                },
+                "totalCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "client.GetWaitlistStatsResponse": {
+            "type": "object",
+            "properties": {
+                "averageDaysWaiting": {
+                    "type": "number"
+                },
+                "countsByPriority": {
+                    "$ref": "#/definitions/client.PriorityCounts"
+                },
+                "highPriorityCount": {
+                    "type": "integer"
+                },
+                "totalCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "client.ListClientGoalsResponse": {
+            "type": "object",
+            "properties": {
+                "clientId": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "client.ListDischargedClientsResponse": {
+            "type": "object",
+            "properties": {
+                "bsn": {
+                    "type": "string"
+                },
+                "careStartDate": {
+                    "type": "string"
+                },
+                "careType": {
+                    "type": "string"
+                },
+                "closingReport": {
+                    "type": "string"
+                },
+                "coordinatorFirstName": {
+                    "type": "string"
+                },
+                "coordinatorId": {
+                    "type": "string"
+                },
+                "coordinatorLastName": {
+                    "type": "string"
+                },
+                "dateOfBirth": {
+                    "type": "string"
+                },
+                "dischargeDate": {
+                    "type": "string"
+                },
+                "dischargeStatus": {
+                    "type": "string"
+                },
+                "evaluationReport": {
+                    "type": "string"
+                },
+                "firstName": {
+                    "type": "string"
+                },
+                "gender": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "lastName": {
+                    "type": "string"
+                },
+                "locationId": {
+                    "type": "string"
+                },
+                "locationName": {
+                    "type": "string"
+                },
+                "phoneNumber": {
+                    "type": "string"
+                },
+                "reasonForDischarge": {
+                    "type": "string"
+                },
+                "referringOrgName": {
+                    "type": "string"
+                }
+            }
+        },
+        "client.ListInCareClientsResponse": {
+            "type": "object",
+            "properties": {
+                "bsn": {
+                    "type": "string"
+                },
+                "careEndDate": {
+                    "type": "string"
+                },
+                "careStartDate": {
+                    "type": "string"
+                },
+                "careType": {
+                    "type": "string"
+                },
+                "coordinatorFirstName": {
+                    "type": "string"
+                },
+                "coordinatorId": {
+                    "type": "string"
+                },
+                "coordinatorLastName": {
+                    "type": "string"
+                },
+                "dateOfBirth": {
+                    "type": "string"
+                },
+                "firstName": {
+                    "type": "string"
+                },
+                "gender": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "lastName": {
+                    "type": "string"
+                },
+                "locationId": {
+                    "type": "string"
+                },
+                "locationName": {
+                    "type": "string"
+                },
+                "phoneNumber": {
+                    "type": "string"
+                },
+                "referringOrgName": {
+                    "type": "string"
+                },
+                "usedAmbulatoryHours": {
+                    "description": "For ambulatory_care only",
+                    "type": "integer"
+                },
+                "weeksInAccommodation": {
+                    "description": "For protected_living, semi_independent_living, independent_assisted_living",
+                    "type": "integer"
+                }
+            }
+        },
+        "client.ListWaitingListClientsResponse": {
+            "type": "object",
+            "properties": {
+                "bsn": {
+                    "type": "string"
+                },
+                "careType": {
+                    "type": "string"
+                },
+                "coordinatorFirstName": {
+                    "type": "string"
+                },
+                "coordinatorId": {
+                    "type": "string"
+                },
+                "coordinatorLastName": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "dateOfBirth": {
+                    "type": "string"
+                },
+                "firstName": {
+                    "type": "string"
+                },
+                "focusAreas": {
+                    "type": "string"
+                },
+                "gender": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "lastName": {
+                    "type": "string"
+                },
+                "locationId": {
+                    "type": "string"
+                },
+                "locationName": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "phoneNumber": {
+                    "type": "string"
+                },
+                "referringOrgName": {
+                    "type": "string"
+                },
+                "waitingListPriority": {
+                    "type": "string"
+                }
+            }
+        },
+        "client.MoveClientInCareRequest": {
+            "type": "object",
+            "required": [
+                "careEndDate",
+                "careStartDate"
+            ],
+            "properties": {
+                "ambulatoryWeeklyHours": {
+                    "type": "integer"
+                },
+                "careEndDate": {
+                    "type": "string"
+                },
+                "careStartDate": {
+                    "type": "string"
+                }
+            }
+        },
+        "client.MoveClientInCareResponse": {
+            "type": "object",
+            "properties": {
+                "clientId": {
+                    "type": "string"
+                }
+            }
+        },
+        "client.MoveClientToWaitingListRequest": {
+            "type": "object",
+            "required": [
+                "waitingListPriority"
+            ],
+            "properties": {
+                "intakeFormId": {
+                    "type": "string"
+                },
+                "overrideCaseload": {
+                    "description": "Admin only: assign the coordinator even when they are at their caseload cap",
+                    "type": "boolean"
+                },
+                "waitingListPriority": {
+                    "type": "string",
+                    "enum": [
+                        "low",
+                        "normal",
+                        "high"
+                    ]
+                }
+            }
+        },
+        "client.MoveClientToWaitingListResponse": {
+            "type": "object",
+            "properties": {
+                "clientId": {
+                    "type": "string"
+                }
+            }
+        },
+        "client.PlacementSuggestionResponse": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "available": {
+                    "type": "integer"
+                },
+                "capacity": {
+                    "type": "integer"
+                },
+                "locationId": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "occupied": {
+                    "type": "integer"
+                },
+                "postalCode": {
+                    "type": "string"
+                }
+            }
+        },
+        "client.PriorityCounts": {
+            "type": "object",
+            "properties": {
+                "high": {
+                    "type": "integer"
+                },
+                "low": {
+                    "type": "integer"
+                },
+                "normal": {
+                    "type": "integer"
+                }
+            }
+        },
+        "client.SearchClientsResponse": {
+            "type": "object",
+            "properties": {
+                "bsn": {
+                    "type": "string"
+                },
+                "dateOfBirth": {
+                    "type": "string"
+                },
+                "firstName": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "lastName": {
+                    "type": "string"
+                },
+                "locationId": {
+                    "type": "string"
+                },
+                "locationName": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "client.StartDischargeRequest": {
+            "type": "object",
+            "required": [
+                "dischargeDate",
+                "reasonForDischarge"
+            ],
+            "properties": {
+                "dischargeDate": {
+                    "type": "string"
+                },
+                "reasonForDischarge": {
+                    "type": "string",
+                    "enum": [
+                        "treatment_completed",
+                        "terminated_by_mutual_agreement",
+                        "terminated_by_client",
+                        "terminated_by_provider",
+                        "terminated_due_to_external_factors",
+                        "other"
+                    ]
+                }
+            }
+        },
+        "client.StartDischargeResponse": {
+            "type": "object",
+            "properties": {
+                "clientId": {
+                    "type": "string"
+                }
+            }
+        },
+        "dashboard.AgeBandItem": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "maxAge": {
+                    "description": "nil for the open-ended highest band and the unknown bucket",
+                    "type": "integer"
+                },
+                "minAge": {
+                    "description": "nil for the unknown bucket",
+                    "type": "integer"
+                },
+                "percentage": {
+                    "type": "number"
+                }
+            }
+        },
+        "dashboard.AlertItem": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "link": {
+                    "type": "string"
+                },
+                "severity": {
+                    "$ref": "#/definitions/dashboard.AlertSeverity"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/dashboard.AlertType"
+                }
+            }
+        },
+        "dashboard.AlertSeverity": {
+            "type": "string",
+            "enum": [
+                "critical",
+                "warning"
+            ],
+            "x-enum-varnames": [
+                "AlertSeverityCritical",
+                "AlertSeverityWarning"
+            ]
+        },
+        "dashboard.AlertType": {
+            "type": "string",
+            "enum": [
+                "evaluation",
+                "care_end",
+                "incident",
+                "incident_escalated",
+                "waitlist",
+                "transfer"
+            ],
+            "x-enum-varnames": [
+                "AlertTypeEvaluation",
+                "AlertTypeCareEnd",
+                "AlertTypeIncident",
+                "AlertTypeIncidentEscalated",
+                "AlertTypeWaitlist",
+                "AlertTypeTransfer"
+            ]
+        },
+        "dashboard.CareTypeDistributionItem": {
+            "type": "object",
+            "properties": {
+                "careType": {
+                    "type": "string"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "percentage": {
+                    "type": "number"
+                }
+            }
+        },
+        "dashboard.CareTypeDistributionResponse": {
+            "type": "object",
+            "properties": {
+                "distribution": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dashboard.CareTypeDistributionItem"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dashboard.ClientAgeDistributionResponse": {
+            "type": "object",
+            "properties": {
+                "distribution": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dashboard.AgeBandItem"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dashboard.CoordinatorAlertSeverity": {
+            "type": "string",
+            "enum": [
+                "critical",
+                "warning",
+                "info"
+            ],
+            "x-enum-varnames": [
+                "CoordinatorAlertSeverityCritical",
+                "CoordinatorAlertSeverityWarning",
+                "CoordinatorAlertSeverityInfo"
+            ]
+        },
+        "dashboard.CoordinatorAlertType": {
+            "type": "string",
+            "enum": [
+                "evaluation",
+                "contract",
+                "draft",
+                "incident",
+                "waiting_long",
+                "transfer",
+                "high_priority"
+            ],
+            "x-enum-varnames": [
+                "CoordinatorAlertTypeEvaluation",
+                "CoordinatorAlertTypeContract",
+                "CoordinatorAlertTypeDraft",
+                "CoordinatorAlertTypeIncident",
+                "CoordinatorAlertTypeWaitingLong",
+                "CoordinatorAlertTypeTransfer",
+                "CoordinatorAlertTypeHighPriority"
+            ]
+        },
+        "dashboard.CoordinatorClientItem": {
+            "type": "object",
+            "properties": {
+                "careType": {
+                    "type": "string"
+                },
+                "daysUntilEnd": {
+                    "type": "integer"
+                },
+                "evaluationStatus": {
+                    "type": "string"
+                },
+                "firstName": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "lastName": {
+                    "type": "string"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "nextEvaluation": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "dashboard.CoordinatorClientsResponse": {
+            "type": "object",
+            "properties": {
+                "clients": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dashboard.CoordinatorClientItem"
+                    }
+                }
+            }
+        },
+        "dashboard.CoordinatorGoalsProgressResponse": {
+            "type": "object",
+            "properties": {
+                "achieved": {
+                    "type": "integer"
+                },
+                "delayed": {
+                    "type": "integer"
+                },
+                "notStarted": {
+                    "type": "integer"
+                },
+                "onTrack": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dashboard.CoordinatorIncidentItem": {
+            "type": "object",
+            "properties": {
+                "client": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "severity": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "dashboard.CoordinatorIncidentsResponse": {
+            "type": "object",
+            "properties": {
+                "incidents": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dashboard.CoordinatorIncidentItem"
+                    }
+                }
+            }
+        },
+        "dashboard.CoordinatorRemindersResponse": {
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "string"
+                "reminders": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dashboard.ReminderItem"
+                    }
                 }
             }
         },
-        "audit.AuditLogResponse": {
+        "dashboard.CoordinatorScheduleItem": {
             "type": "object",
             "properties": {
-                "action": {
+                "clientId": {
                     "type": "string"
                 },
-                "client_id": {
+                "clientName": {
                     "type": "string"
                 },
-                "client_name": {
+                "endTime": {
                     "type": "string"
                 },
-                "created_at": {
+                "id": {
                     "type": "string"
                 },
-                "current_hash": {
+                "locationId": {
                     "type": "string"
                 },
-                "employee_id": {
+                "locationName": {
                     "type": "string"
                 },
-                "employee_name": {
+                "status": {
                     "type": "string"
                 },
-                "failure_reason": {
+                "time": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "dashboard.CoordinatorStatsResponse": {
+            "type": "object",
+            "properties": {
+                "myActiveClients": {
+                    "type": "integer"
+                },
+                "myPendingIntakes": {
+                    "type": "integer"
+                },
+                "myUpcomingEvaluations": {
+                    "type": "integer"
+                },
+                "myWaitingListClients": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dashboard.CoordinatorTodayScheduleResponse": {
+            "type": "object",
+            "properties": {
+                "appointments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dashboard.CoordinatorScheduleItem"
+                    }
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "date": {
+                    "type": "string"
+                }
+            }
+        },
+        "dashboard.CoordinatorUrgentAlertItem": {
+            "type": "object",
+            "properties": {
+                "clientIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "description": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "ip_address": {
+                "link": {
                     "type": "string"
                 },
-                "new_value": {
-                    "type": "object",
-                    "additionalProperties": true
+                "severity": {
+                    "$ref": "#/definitions/dashboard.CoordinatorAlertSeverity"
                 },
-                "old_value": {
-                    "type": "object",
-                    "additionalProperties": true
+                "title": {
+                    "type": "string"
                 },
-                "prev_hash": {
+                "type": {
+                    "$ref": "#/definitions/dashboard.CoordinatorAlertType"
+                }
+            }
+        },
+        "dashboard.CoordinatorUrgentAlertsResponse": {
+            "type": "object",
+            "properties": {
+                "alerts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dashboard.CoordinatorUrgentAlertItem"
+                    }
+                }
+            }
+        },
+        "dashboard.CriticalAlertsResponse": {
+            "type": "object",
+            "properties": {
+                "alerts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dashboard.AlertItem"
+                    }
+                }
+            }
+        },
+        "dashboard.DashboardResponse": {
+            "type": "object",
+            "properties": {
+                "capacity": {
+                    "$ref": "#/definitions/dashboard.LocationCapacityResponse"
+                },
+                "careTypes": {
+                    "$ref": "#/definitions/dashboard.CareTypeDistributionResponse"
+                },
+                "criticalAlerts": {
+                    "$ref": "#/definitions/dashboard.CriticalAlertsResponse"
+                },
+                "errors": {
+                    "$ref": "#/definitions/dashboard.DashboardSectionErrors"
+                },
+                "evaluations": {
+                    "$ref": "#/definitions/dashboard.EvaluationStatsResponse"
+                },
+                "overview": {
+                    "$ref": "#/definitions/dashboard.OverviewResponse"
+                },
+                "pipeline": {
+                    "$ref": "#/definitions/dashboard.PipelineStatsResponse"
+                }
+            }
+        },
+        "dashboard.DashboardSectionErrors": {
+            "type": "object",
+            "properties": {
+                "capacity": {
+                    "type": "boolean"
+                },
+                "careTypes": {
+                    "type": "boolean"
+                },
+                "criticalAlerts": {
+                    "type": "boolean"
+                },
+                "evaluations": {
+                    "type": "boolean"
+                },
+                "overview": {
+                    "type": "boolean"
+                },
+                "pipeline": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dashboard.DischargeStatsResponse": {
+            "type": "object",
+            "properties": {
+                "averageDaysInCare": {
+                    "type": "integer"
+                },
+                "plannedRate": {
+                    "type": "integer"
+                },
+                "thisMonth": {
+                    "type": "integer"
+                },
+                "thisYear": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dashboard.EvaluationStatsResponse": {
+            "type": "object",
+            "properties": {
+                "completed": {
+                    "type": "integer"
+                },
+                "completionRate": {
+                    "type": "integer"
+                },
+                "dueSoon": {
+                    "type": "integer"
+                },
+                "overdue": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dashboard.FeaturesResponse": {
+            "type": "object",
+            "properties": {
+                "digestNotifications": {
+                    "type": "boolean"
+                },
+                "geoPlacement": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dashboard.IncidentCategoryStatsItem": {
+            "type": "object",
+            "properties": {
+                "category": {
                     "type": "string"
                 },
-                "request_id": {
+                "count": {
+                    "type": "integer"
+                },
+                "label": {
                     "type": "string"
                 },
-                "resource_id": {
+                "percentage": {
+                    "type": "number"
+                }
+            }
+        },
+        "dashboard.IncidentCategoryStatsResponse": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dashboard.IncidentCategoryStatsItem"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dashboard.LocationCapacityItem": {
+            "type": "object",
+            "properties": {
+                "available": {
+                    "type": "integer"
+                },
+                "capacity": {
+                    "type": "integer"
+                },
+                "id": {
                     "type": "string"
                 },
-                "resource_type": {
+                "name": {
+                    "type": "string"
+                },
+                "occupied": {
+                    "type": "integer"
+                },
+                "percentage": {
+                    "type": "number"
+                },
+                "status": {
+                    "description": "Status is ok, warning (at or above the configured warning percentage) or full",
                     "type": "string"
                 },
-                "sequence_number": {
+                "waitingCount": {
+                    "description": "WaitingCount is the number of waiting-list clients assigned to this location",
+                    "type": "integer"
+                }
+            }
+        },
+        "dashboard.LocationCapacityResponse": {
+            "type": "object",
+            "properties": {
+                "locations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dashboard.LocationCapacityItem"
+                    }
+                },
+                "totals": {
+                    "$ref": "#/definitions/dashboard.LocationCapacityTotals"
+                }
+            }
+        },
+        "dashboard.LocationCapacityTotals": {
+            "type": "object",
+            "properties": {
+                "atRiskLocations": {
+                    "description": "AtRiskLocations counts every location with status warning or full, not\nonly the ones returned in the (limited) list",
                     "type": "integer"
                 },
-                "status": {
-                    "type": "string"
+                "overallPercentage": {
+                    "type": "number"
                 },
-                "user_agent": {
-                    "type": "string"
+                "totalAvailable": {
+                    "type": "integer"
                 },
-                "user_email": {
-                    "type": "string"
+                "totalCapacity": {
+                    "type": "integer"
                 },
-                "user_id": {
-                    "type": "string"
+                "totalOccupied": {
+                    "type": "integer"
                 }
             }
         },
-        "audit.AuditLogStatsResponse": {
+        "dashboard.OverviewResponse": {
             "type": "object",
             "properties": {
-                "create_count": {
+                "openIncidents": {
                     "type": "integer"
                 },
-                "delete_count": {
+                "pendingRegistrations": {
                     "type": "integer"
                 },
-                "failure_count": {
+                "totalActiveClients": {
                     "type": "integer"
                 },
-                "read_count": {
+                "totalCoordinators": {
                     "type": "integer"
                 },
-                "total_logs": {
+                "totalEmployees": {
                     "type": "integer"
                 },
-                "update_count": {
+                "waitingListCount": {
                     "type": "integer"
                 }
             }
         },
-        "audit.ChainVerificationResult": {
+        "dashboard.PipelineStatsResponse": {
             "type": "object",
             "properties": {
-                "actual_hash": {
-                    "type": "string"
-                },
-                "broken_entry_id": {
-                    "type": "string"
-                },
-                "expected_hash": {
-                    "type": "string"
-                },
-                "first_broken_seq": {
+                "discharged": {
                     "type": "integer"
                 },
-                "is_valid": {
-                    "type": "boolean"
+                "inCare": {
+                    "type": "integer"
                 },
-                "total_entries": {
+                "intakes": {
                     "type": "integer"
                 },
-                "verified_at": {
-                    "type": "string"
+                "registrations": {
+                    "type": "integer"
                 },
-                "verified_entries": {
+                "waitingList": {
                     "type": "integer"
                 }
             }
         },
-        "auth.DisableMFARequest": {
+        "dashboard.ReminderItem": {
             "type": "object",
-            "required": [
-                "password"
-            ],
             "properties": {
-                "password": {
+                "client": {
                     "type": "string"
-                }
-            }
-        },
-        "auth.EnableMFARequest": {
-            "type": "object",
-            "required": [
-                "code"
-            ],
-            "properties": {
-                "code": {
+                },
+                "dueDate": {
                     "type": "string"
-                }
-            }
-        },
-        "auth.EnableMFAResponse": {
-            "type": "object",
-            "properties": {
-                "backupCodes": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                }
-            }
-        },
-        "auth.LoginRequest": {
-            "type": "object",
-            "required": [
-                "email",
-                "password"
-            ],
-            "properties": {
-                "email": {
+                },
+                "id": {
                     "type": "string"
                 },
-                "password": {
+                "priority": {
+                    "type": "string"
+                },
+                "title": {
                     "type": "string"
                 }
             }
         },
-        "auth.LoginResponse": {
+        "dashboard.TodayAppointmentItem": {
             "type": "object",
             "properties": {
-                "accessToken": {
+                "clientId": {
                     "type": "string"
                 },
-                "mfaRequired": {
-                    "type": "boolean"
+                "clientName": {
+                    "type": "string"
                 },
-                "preAuthToken": {
+                "endTime": {
                     "type": "string"
                 },
-                "refreshToken": {
+                "id": {
+                    "type": "string"
+                },
+                "locationName": {
+                    "type": "string"
+                },
+                "startTime": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
                     "type": "string"
                 }
             }
         },
-        "auth.LogoutRequest": {
+        "dashboard.TodayAppointmentsResponse": {
             "type": "object",
-            "required": [
-                "refreshToken"
-            ],
             "properties": {
-                "refreshToken": {
-                    "type": "string"
+                "appointments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dashboard.TodayAppointmentItem"
+                    }
+                },
+                "count": {
+                    "type": "integer"
                 }
             }
         },
-        "auth.RefreshTokensRequest": {
+        "employee.AvailabilityItem": {
             "type": "object",
             "required": [
-                "refreshToken"
+                "endTime",
+                "startTime"
             ],
             "properties": {
-                "refreshToken": {
+                "endTime": {
+                    "type": "string"
+                },
+                "startTime": {
                     "type": "string"
+                },
+                "weekday": {
+                    "description": "Weekday follows time.Weekday: 0 = Sunday ... 6 = Saturday",
+                    "type": "integer",
+                    "maximum": 6,
+                    "minimum": 0
                 }
             }
         },
-        "auth.RefreshTokensResponse": {
+        "employee.AvailabilityResponse": {
             "type": "object",
             "properties": {
-                "accessToken": {
-                    "type": "string"
+                "availability": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/employee.AvailabilityItem"
+                    }
                 },
-                "refreshToken": {
+                "employeeId": {
                     "type": "string"
                 }
             }
         },
-        "auth.ResetPasswordRequest": {
+        "employee.CreateEmployeeRequest": {
             "type": "object",
             "required": [
-                "currentPassword",
-                "newPassword"
+                "bsn",
+                "dateOfBirth",
+                "email",
+                "firstName",
+                "gender",
+                "lastName",
+                "locationId",
+                "password",
+                "phoneNumber",
+                "role"
             ],
             "properties": {
-                "currentPassword": {
+                "bsn": {
                     "type": "string"
                 },
-                "newPassword": {
+                "contractHours": {
+                    "type": "integer"
+                },
+                "contractType": {
                     "type": "string",
-                    "minLength": 6
-                }
-            }
-        },
-        "auth.SetupMFAResponse": {
-            "type": "object",
-            "properties": {
-                "otpAuthUrl": {
+                    "enum": [
+                        "self_employed",
+                        "payroll_service"
+                    ]
+                },
+                "dateOfBirth": {
                     "type": "string"
                 },
-                "secret": {
+                "email": {
+                    "type": "string"
+                },
+                "firstName": {
+                    "type": "string"
+                },
+                "gender": {
+                    "type": "string",
+                    "enum": [
+                        "male",
+                        "female",
+                        "other",
+                        "non_binary",
+                        "unknown",
+                        "prefer_not_to_say"
+                    ]
+                },
+                "lastName": {
+                    "type": "string"
+                },
+                "locationId": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "phoneNumber": {
+                    "type": "string"
+                },
+                "role": {
                     "type": "string"
                 }
             }
         },
-        "auth.VerifyMFARequest": {
+        "employee.CreateEmployeeResponse": {
             "type": "object",
-            "required": [
-                "code",
-                "preAuthToken"
-            ],
             "properties": {
-                "code": {
-                    "type": "string"
-                },
-                "preAuthToken": {
+                "id": {
                     "type": "string"
                 }
             }
         },
-        "auth.VerifyMFAResponse": {
+        "employee.GetEmployeeByIDResponse": {
             "type": "object",
             "properties": {
-                "accessToken": {
+                "bsn": {
+                    "type": "string"
+                },
+                "clientCount": {
+                    "type": "integer"
+                },
+                "contractHours": {
+                    "type": "integer"
+                },
+                "contractType": {
                     "type": "string"
                 },
-                "refreshToken": {
+                "dateOfBirth": {
                     "type": "string"
-                }
-            }
-        },
-        "calendar.AppointmentResponse": {
-            "type": "object",
-            "properties": {
-                "createdAt": {
+                },
+                "email": {
                     "type": "string"
                 },
-                "description": {
+                "firstName": {
                     "type": "string"
                 },
-                "endTime": {
+                "gender": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "location": {
+                "lastName": {
                     "type": "string"
                 },
-                "organizerId": {
+                "locationId": {
                     "type": "string"
                 },
-                "participants": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/calendar.Participant"
-                    }
-                },
-                "recurrenceRule": {
+                "locationName": {
                     "type": "string"
                 },
-                "startTime": {
-                    "type": "string"
+                "maxCaseload": {
+                    "description": "MaxCaseload is the employee's own cap on active clients; nil means the\nconfigured default applies",
+                    "type": "integer"
                 },
-                "status": {
-                    "$ref": "#/definitions/calendar.AppointmentStatus"
+                "phoneNumber": {
+                    "type": "string"
                 },
-                "title": {
+                "roleId": {
                     "type": "string"
                 },
-                "type": {
-                    "$ref": "#/definitions/calendar.AppointmentType"
+                "roleName": {
+                    "type": "string"
                 },
-                "updatedAt": {
+                "userId": {
                     "type": "string"
                 }
             }
         },
-        "calendar.AppointmentStatus": {
-            "type": "string",
-            "enum": [
-                "confirmed",
-                "cancelled",
-                "tentative"
-            ],
-            "x-enum-varnames": [
-                "StatusConfirmed",
-                "StatusCancelled",
-                "StatusTentative"
-            ]
-        },
-        "calendar.AppointmentType": {
-            "type": "string",
-            "enum": [
-                "general",
-                "intake",
-                "ambulatory"
-            ],
-            "x-enum-varnames": [
-                "TypeGeneral",
-                "TypeIntake",
-                "TypeAmbulatory"
-            ]
-        },
-        "calendar.CalendarEvent": {
+        "employee.GetMyProfileResponse": {
             "type": "object",
             "properties": {
-                "allDay": {
-                    "type": "boolean"
-                },
-                "backgroundColor": {
+                "bsn": {
                     "type": "string"
                 },
-                "end": {
-                    "type": "string"
+                "clientCount": {
+                    "type": "integer"
                 },
-                "extendedProps": {
-                    "$ref": "#/definitions/calendar.CalendarExtendedProps"
+                "contractHours": {
+                    "type": "integer"
                 },
-                "id": {
+                "contractType": {
                     "type": "string"
                 },
-                "start": {
+                "dateOfBirth": {
                     "type": "string"
                 },
-                "title": {
+                "email": {
                     "type": "string"
                 },
-                "type": {
-                    "type": "string"
-                }
-            }
-        },
-        "calendar.CalendarExtendedProps": {
-            "type": "object",
-            "properties": {
-                "description": {
+                "firstName": {
                     "type": "string"
                 },
-                "isCompleted": {
-                    "type": "boolean"
-                },
-                "isRecurring": {
-                    "type": "boolean"
+                "gender": {
+                    "type": "string"
                 },
-                "location": {
+                "id": {
                     "type": "string"
                 },
-                "originalEventId": {
+                "lastLoginAt": {
+                    "description": "Nil until the first completed sign-in",
                     "type": "string"
                 },
-                "status": {
+                "lastName": {
                     "type": "string"
                 },
-                "type": {
+                "locationAddress": {
                     "type": "string"
-                }
-            }
-        },
-        "calendar.CreateAppointmentRequest": {
-            "type": "object",
-            "required": [
-                "endTime",
-                "participants",
-                "startTime",
-                "title",
-                "type"
-            ],
-            "properties": {
-                "description": {
+                },
+                "locationId": {
                     "type": "string"
                 },
-                "endTime": {
+                "locationName": {
                     "type": "string"
                 },
-                "location": {
+                "locationPostalCode": {
                     "type": "string"
                 },
-                "participants": {
+                "permissions": {
                     "type": "array",
-                    "minItems": 1,
                     "items": {
-                        "$ref": "#/definitions/calendar.Participant"
+                        "$ref": "#/definitions/employee.PermissionResponse"
                     }
                 },
-                "recurrenceRule": {
+                "phoneNumber": {
                     "type": "string"
                 },
-                "startTime": {
+                "role": {
                     "type": "string"
                 },
-                "status": {
-                    "enum": [
-                        "confirmed",
-                        "cancelled",
-                        "tentative"
-                    ],
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/calendar.AppointmentStatus"
-                        }
-                    ]
-                },
-                "title": {
+                "userId": {
                     "type": "string"
-                },
-                "type": {
-                    "enum": [
-                        "general",
-                        "intake",
-                        "ambulatory"
-                    ],
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/calendar.AppointmentType"
-                        }
-                    ]
                 }
             }
         },
-        "calendar.CreateReminderRequest": {
+        "employee.ListEmployeesResponse": {
             "type": "object",
-            "required": [
-                "dueTime",
-                "title"
-            ],
             "properties": {
-                "description": {
+                "bsn": {
                     "type": "string"
                 },
-                "dueTime": {
+                "clientCount": {
+                    "type": "integer"
+                },
+                "contractHours": {
+                    "type": "integer"
+                },
+                "contractType": {
                     "type": "string"
                 },
-                "title": {
+                "dateOfBirth": {
                     "type": "string"
-                }
-            }
-        },
-        "calendar.Participant": {
-            "type": "object",
-            "required": [
-                "id",
-                "type"
-            ],
-            "properties": {
+                },
+                "email": {
+                    "type": "string"
+                },
+                "firstName": {
+                    "type": "string"
+                },
+                "gender": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
-                "type": {
-                    "enum": [
-                        "employee",
-                        "client"
-                    ],
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/calendar.ParticipantType"
-                        }
-                    ]
-                }
-            }
-        },
-        "calendar.ParticipantType": {
-            "type": "string",
-            "enum": [
-                "employee",
-                "client"
-            ],
-            "x-enum-varnames": [
-                "ParticipantEmployee",
-                "ParticipantClient"
-            ]
-        },
-        "calendar.ReminderResponse": {
-            "type": "object",
-            "properties": {
-                "createdAt": {
+                "lastName": {
                     "type": "string"
                 },
-                "description": {
+                "locationId": {
                     "type": "string"
                 },
-                "dueTime": {
+                "locationName": {
                     "type": "string"
                 },
-                "id": {
+                "phoneNumber": {
                     "type": "string"
                 },
-                "isCompleted": {
-                    "type": "boolean"
+                "userId": {
+                    "type": "string"
+                }
+            }
+        },
+        "employee.PermissionResponse": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
                 },
-                "title": {
+                "description": {
                     "type": "string"
                 },
-                "updatedAt": {
+                "id": {
                     "type": "string"
                 },
-                "userId": {
+                "resource": {
                     "type": "string"
                 }
             }
         },
-        "calendar.UpdateAppointmentRequest": {
+        "employee.ReplacementCoordinatorResponse": {
             "type": "object",
             "properties": {
-                "description": {
+                "caseload": {
+                    "description": "Caseload is the number of in-care clients the coordinator already has",
+                    "type": "integer"
+                },
+                "employeeId": {
                     "type": "string"
                 },
-                "endTime": {
+                "firstName": {
                     "type": "string"
                 },
-                "location": {
+                "lastName": {
                     "type": "string"
                 },
-                "participants": {
+                "locationId": {
+                    "type": "string"
+                }
+            }
+        },
+        "employee.SetAvailabilityRequest": {
+            "type": "object",
+            "properties": {
+                "availability": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/calendar.Participant"
+                        "$ref": "#/definitions/employee.AvailabilityItem"
                     }
-                },
-                "recurrenceRule": {
+                }
+            }
+        },
+        "employee.UpdateEmployeeRequest": {
+            "type": "object",
+            "properties": {
+                "bsn": {
+                    "description": "Immutable; only accepted when unchanged",
                     "type": "string"
                 },
-                "startTime": {
-                    "type": "string"
+                "clearMaxCaseload": {
+                    "description": "ClearMaxCaseload drops the employee's own cap so the default applies\nagain; it cannot be combined with MaxCaseload",
+                    "type": "boolean"
                 },
-                "status": {
+                "contractHours": {
+                    "type": "integer"
+                },
+                "contractType": {
+                    "type": "string",
                     "enum": [
-                        "confirmed",
-                        "cancelled",
-                        "tentative"
-                    ],
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/calendar.AppointmentStatus"
-                        }
+                        "self_employed",
+                        "payroll_service"
                     ]
                 },
-                "title": {
+                "dateOfBirth": {
                     "type": "string"
                 },
-                "type": {
+                "email": {
+                    "type": "string"
+                },
+                "firstName": {
+                    "type": "string"
+                },
+                "gender": {
+                    "type": "string",
                     "enum": [
-                        "general",
-                        "intake",
-                        "ambulatory"
-                    ],
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/calendar.AppointmentType"
-                        }
+                        "male",
+                        "female",
+                        "other",
+                        "non_binary",
+                        "unknown",
+                        "prefer_not_to_say"
                     ]
+                },
+                "lastName": {
+                    "type": "string"
+                },
+                "locationId": {
+                    "type": "string"
+                },
+                "maxCaseload": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "password": {
+                    "type": "string"
+                },
+                "phoneNumber": {
+                    "type": "string"
                 }
             }
         },
-        "calendar.UpdateReminderRequest": {
+        "employee.UpdateEmployeeResponse": {
             "type": "object",
             "properties": {
-                "isCompleted": {
-                    "type": "boolean"
+                "id": {
+                    "type": "string"
                 }
             }
         },
-        "client.CareTypeCounts": {
+        "evaluation.CompleteEvaluationRecordRequest": {
             "type": "object",
+            "required": [
+                "completedDate",
+                "outcome"
+            ],
             "properties": {
-                "ambulatoryCare": {
-                    "type": "integer"
-                },
-                "independentAssistedLiving": {
-                    "type": "integer"
+                "completedDate": {
+                    "type": "string"
                 },
-                "protectedLiving": {
-                    "type": "integer"
+                "notes": {
+                    "type": "string"
                 },
-                "semiIndependentLiving": {
-                    "type": "integer"
+                "outcome": {
+                    "type": "string",
+                    "enum": [
+                        "on_track",
+                        "needs_attention",
+                        "plan_adjusted"
+                    ]
                 }
             }
         },
-        "client.CompleteDischargeRequest": {
+        "evaluation.CompleteEvaluationRecordResponse": {
             "type": "object",
-            "required": [
-                "closingReport",
-                "evaluationReport"
-            ],
             "properties": {
-                "closingReport": {
-                    "type": "string"
+                "evaluation": {
+                    "$ref": "#/definitions/evaluation.EvaluationRecordResponse"
                 },
-                "dischargeAttachmentIds": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "nextEvaluationDate": {
+                    "type": "string"
                 },
-                "evaluationReport": {
+                "nextEvaluationId": {
                     "type": "string"
                 }
             }
         },
-        "client.CompleteDischargeResponse": {
+        "evaluation.CoordinatorInfo": {
             "type": "object",
             "properties": {
-                "clientId": {
+                "firstName": {
+                    "type": "string"
+                },
+                "lastName": {
                     "type": "string"
                 }
             }
         },
-        "client.GetDischargeStatsResponse": {
+        "evaluation.CreateEvaluationRecordRequest": {
             "type": "object",
+            "required": [
+                "clientId",
+                "coordinatorId",
+                "scheduledDate"
+            ],
             "properties": {
-                "averageDaysInCare": {
-                    "type": "number"
-                },
-                "completedDischarges": {
-                    "type": "integer"
+                "clientId": {
+                    "type": "string"
                 },
-                "dischargeCompletionRate": {
-                    "type": "number"
+                "coordinatorId": {
+                    "type": "string"
                 },
-                "prematureDischarges": {
-                    "type": "integer"
+                "notes": {
+                    "type": "string"
                 },
-                "totalCount": {
-                    "type": "integer"
+                "scheduledDate": {
+                    "type": "string"
                 }
             }
         },
-        "client.GetInCareStatsResponse": {
+        "evaluation.CreateEvaluationRequest": {
             "type": "object",
+            "required": [
+                "clientId",
+                "coordinatorId",
+                "evaluationDate"
+            ],
             "properties": {
-                "averageDaysInCare": {
-                    "type": "number"
+                "clientId": {
+                    "type": "string"
                 },
-                "countsByCareType": {
-                    "$ref": "#/definitions/client.CareTypeCounts"
+                "coordinatorId": {
+                    "type": "string"
                 },
-                "totalCount": {
-                    "type": "integer"
+                "evaluationDate": {
+                    "type": "string"
+                },
+                "isDraft": {
+                    "type": "boolean"
+                },
+                "overallNotes": {
+                    "type": "string"
+                },
+                "progressLogs": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/evaluation.GoalProgressItem"
+                    }
                 }
             }
         },
-        "client.GetWaitlistStatsResponse": {
+        "evaluation.CreateEvaluationResponse": {
             "type": "object",
             "properties": {
-                "averageDaysWaiting": {
-                    "type": "number"
-                },
-                "countsByPriority": {
-                    "$ref": "#/definitions/client.PriorityCounts"
+                "id": {
+                    "type": "string"
                 },
-                "highPriorityCount": {
-                    "type": "integer"
+                "isDraft": {
+                    "type": "boolean"
                 },
-                "totalCount": {
-                    "type": "integer"
+                "nextEvaluationDate": {
+                    "type": "string"
                 }
             }
         },
-        "client.ListClientGoalsResponse": {
+        "evaluation.DraftEvaluationListItem": {
             "type": "object",
             "properties": {
-                "clientId": {
+                "clientFirstName": {
                     "type": "string"
                 },
-                "createdAt": {
+                "clientId": {
                     "type": "string"
                 },
-                "description": {
+                "clientLastName": {
                     "type": "string"
                 },
-                "id": {
+                "evaluationDate": {
                     "type": "string"
                 },
-                "title": {
+                "evaluationId": {
                     "type": "string"
                 },
+                "goalsCount": {
+                    "type": "integer"
+                },
                 "updatedAt": {
                     "type": "string"
                 }
             }
         },
-        "client.ListDischargedClientsResponse": {
+        "evaluation.DraftEvaluationResponse": {
             "type": "object",
             "properties": {
-                "bsn": {
-                    "type": "string"
-                },
-                "careStartDate": {
+                "clientFirstName": {
                     "type": "string"
                 },
-                "careType": {
+                "clientId": {
                     "type": "string"
                 },
-                "closingReport": {
+                "clientLastName": {
                     "type": "string"
                 },
                 "coordinatorFirstName": {
                     "type": "string"
                 },
-                "coordinatorId": {
-                    "type": "string"
-                },
                 "coordinatorLastName": {
                     "type": "string"
                 },
-                "dateOfBirth": {
+                "createdAt": {
                     "type": "string"
                 },
-                "dischargeDate": {
+                "evaluationDate": {
                     "type": "string"
                 },
-                "dischargeStatus": {
+                "evaluationId": {
                     "type": "string"
                 },
-                "evaluationReport": {
+                "goalProgress": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/evaluation.GoalProgressItem"
+                    }
+                },
+                "overallNotes": {
                     "type": "string"
                 },
-                "firstName": {
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "evaluation.EvaluationHistoryItem": {
+            "type": "object",
+            "properties": {
+                "coordinatorFirstName": {
                     "type": "string"
                 },
-                "gender": {
+                "coordinatorLastName": {
                     "type": "string"
                 },
-                "id": {
+                "evaluationDate": {
                     "type": "string"
                 },
-                "lastName": {
+                "evaluationId": {
                     "type": "string"
                 },
-                "locationId": {
+                "goalId": {
                     "type": "string"
                 },
-                "locationName": {
+                "goalTitle": {
                     "type": "string"
                 },
-                "phoneNumber": {
+                "overallNotes": {
                     "type": "string"
                 },
-                "reasonForDischarge": {
+                "progressNotes": {
                     "type": "string"
                 },
-                "referringOrgName": {
+                "status": {
                     "type": "string"
                 }
             }
         },
-        "client.ListInCareClientsResponse": {
+        "evaluation.EvaluationRecordListItem": {
             "type": "object",
             "properties": {
-                "bsn": {
+                "completedDate": {
                     "type": "string"
                 },
-                "careEndDate": {
+                "coordinatorFirstName": {
                     "type": "string"
                 },
-                "careStartDate": {
+                "coordinatorId": {
                     "type": "string"
                 },
-                "careType": {
+                "coordinatorLastName": {
                     "type": "string"
                 },
-                "coordinatorFirstName": {
+                "id": {
                     "type": "string"
                 },
-                "coordinatorId": {
+                "notes": {
                     "type": "string"
                 },
-                "coordinatorLastName": {
+                "outcome": {
                     "type": "string"
                 },
-                "dateOfBirth": {
+                "scheduledDate": {
                     "type": "string"
-                },
-                "firstName": {
+                }
+            }
+        },
+        "evaluation.EvaluationRecordResponse": {
+            "type": "object",
+            "properties": {
+                "clientId": {
                     "type": "string"
                 },
-                "gender": {
+                "completedDate": {
                     "type": "string"
                 },
-                "id": {
+                "coordinatorId": {
                     "type": "string"
                 },
-                "lastName": {
+                "createdAt": {
                     "type": "string"
                 },
-                "locationId": {
+                "id": {
                     "type": "string"
                 },
-                "locationName": {
+                "notes": {
                     "type": "string"
                 },
-                "phoneNumber": {
+                "outcome": {
                     "type": "string"
                 },
-                "referringOrgName": {
+                "scheduledDate": {
                     "type": "string"
                 },
-                "usedAmbulatoryHours": {
-                    "description": "For ambulatory_care only",
-                    "type": "integer"
-                },
-                "weeksInAccommodation": {
-                    "description": "For protected_living, semi_independent_living, independent_assisted_living",
-                    "type": "integer"
+                "updatedAt": {
+                    "type": "string"
                 }
             }
         },
-        "client.ListWaitingListClientsResponse": {
+        "evaluation.EvaluationResponse": {
             "type": "object",
             "properties": {
-                "bsn": {
+                "clientFirstName": {
                     "type": "string"
                 },
-                "careType": {
+                "clientId": {
                     "type": "string"
                 },
-                "coordinatorFirstName": {
+                "clientLastName": {
                     "type": "string"
                 },
-                "coordinatorId": {
+                "coordinatorFirstName": {
                     "type": "string"
                 },
                 "coordinatorLastName": {
@@ -6762,745 +11313,724 @@ const docTemplate = `{
                 "createdAt": {
                     "type": "string"
                 },
-                "dateOfBirth": {
+                "evaluationDate": {
                     "type": "string"
                 },
-                "firstName": {
+                "evaluationId": {
                     "type": "string"
                 },
-                "focusAreas": {
-                    "type": "string"
+                "goalProgress": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/evaluation.GoalProgressItem"
+                    }
                 },
-                "gender": {
+                "overallNotes": {
                     "type": "string"
                 },
-                "id": {
+                "status": {
                     "type": "string"
                 },
-                "lastName": {
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "evaluation.GlobalRecentEvaluationItem": {
+            "type": "object",
+            "properties": {
+                "clientFirstName": {
                     "type": "string"
                 },
-                "locationId": {
+                "clientId": {
                     "type": "string"
                 },
-                "locationName": {
+                "clientLastName": {
                     "type": "string"
                 },
-                "notes": {
+                "coordinatorFirstName": {
                     "type": "string"
                 },
-                "phoneNumber": {
+                "coordinatorLastName": {
                     "type": "string"
                 },
-                "referringOrgName": {
+                "evaluationDate": {
                     "type": "string"
                 },
-                "waitingListPriority": {
+                "evaluationId": {
                     "type": "string"
+                },
+                "goalsAchieved": {
+                    "type": "integer"
+                },
+                "totalGoals": {
+                    "type": "integer"
                 }
             }
         },
-        "client.MoveClientInCareRequest": {
+        "evaluation.GoalProgressItem": {
             "type": "object",
-            "required": [
-                "careEndDate",
-                "careStartDate"
-            ],
             "properties": {
-                "ambulatoryWeeklyHours": {
-                    "type": "integer"
+                "goalId": {
+                    "type": "string"
                 },
-                "careEndDate": {
+                "goalTitle": {
                     "type": "string"
                 },
-                "careStartDate": {
+                "progressNotes": {
                     "type": "string"
-                }
-            }
-        },
-        "client.MoveClientInCareResponse": {
-            "type": "object",
-            "properties": {
-                "clientId": {
+                },
+                "status": {
                     "type": "string"
                 }
             }
         },
-        "client.MoveClientToWaitingListRequest": {
+        "evaluation.LastEvaluationItem": {
             "type": "object",
-            "required": [
-                "waitingListPriority"
-            ],
             "properties": {
-                "intakeFormId": {
+                "coordinator": {
+                    "$ref": "#/definitions/evaluation.CoordinatorInfo"
+                },
+                "evaluationDate": {
                     "type": "string"
                 },
-                "waitingListPriority": {
-                    "type": "string",
-                    "enum": [
-                        "low",
-                        "normal",
-                        "high"
-                    ]
-                }
-            }
-        },
-        "client.MoveClientToWaitingListResponse": {
-            "type": "object",
-            "properties": {
-                "clientId": {
+                "evaluationId": {
                     "type": "string"
-                }
-            }
-        },
-        "client.PriorityCounts": {
-            "type": "object",
-            "properties": {
-                "high": {
-                    "type": "integer"
                 },
-                "low": {
-                    "type": "integer"
+                "goalProgress": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/evaluation.GoalProgressItem"
+                    }
                 },
-                "normal": {
-                    "type": "integer"
+                "overallNotes": {
+                    "type": "string"
                 }
             }
         },
-        "client.StartDischargeRequest": {
+        "evaluation.OverdueEvaluationItem": {
             "type": "object",
-            "required": [
-                "dischargeDate",
-                "reasonForDischarge"
-            ],
             "properties": {
-                "dischargeDate": {
+                "coordinatorFirstName": {
                     "type": "string"
                 },
-                "reasonForDischarge": {
-                    "type": "string",
-                    "enum": [
-                        "treatment_completed",
-                        "terminated_by_mutual_agreement",
-                        "terminated_by_client",
-                        "terminated_by_provider",
-                        "terminated_due_to_external_factors",
-                        "other"
-                    ]
-                }
-            }
-        },
-        "client.StartDischargeResponse": {
-            "type": "object",
-            "properties": {
-                "clientId": {
+                "coordinatorId": {
                     "type": "string"
-                }
-            }
-        },
-        "dashboard.AlertItem": {
-            "type": "object",
-            "properties": {
-                "count": {
+                },
+                "coordinatorLastName": {
+                    "type": "string"
+                },
+                "daysOverdue": {
                     "type": "integer"
                 },
-                "description": {
+                "firstName": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "link": {
+                "lastName": {
                     "type": "string"
                 },
-                "severity": {
-                    "$ref": "#/definitions/dashboard.AlertSeverity"
-                },
-                "title": {
+                "locationName": {
                     "type": "string"
                 },
-                "type": {
-                    "$ref": "#/definitions/dashboard.AlertType"
+                "nextEvaluationDate": {
+                    "type": "string"
                 }
             }
         },
-        "dashboard.AlertSeverity": {
-            "type": "string",
-            "enum": [
-                "critical",
-                "warning"
-            ],
-            "x-enum-varnames": [
-                "AlertSeverityCritical",
-                "AlertSeverityWarning"
-            ]
-        },
-        "dashboard.AlertType": {
-            "type": "string",
-            "enum": [
-                "evaluation",
-                "care_end",
-                "incident",
-                "waitlist",
-                "transfer"
-            ],
-            "x-enum-varnames": [
-                "AlertTypeEvaluation",
-                "AlertTypeCareEnd",
-                "AlertTypeIncident",
-                "AlertTypeWaitlist",
-                "AlertTypeTransfer"
-            ]
-        },
-        "dashboard.CareTypeDistributionItem": {
+        "evaluation.SaveDraftRequest": {
             "type": "object",
+            "required": [
+                "clientId",
+                "coordinatorId",
+                "evaluationDate"
+            ],
             "properties": {
-                "careType": {
+                "clientId": {
                     "type": "string"
                 },
-                "count": {
-                    "type": "integer"
+                "coordinatorId": {
+                    "type": "string"
                 },
-                "label": {
+                "evaluationDate": {
                     "type": "string"
                 },
-                "percentage": {
-                    "type": "number"
+                "overallNotes": {
+                    "type": "string"
+                },
+                "progressLogs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/evaluation.GoalProgressItem"
+                    }
                 }
             }
         },
-        "dashboard.CareTypeDistributionResponse": {
+        "evaluation.SaveDraftResponse": {
             "type": "object",
             "properties": {
-                "distribution": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dashboard.CareTypeDistributionItem"
-                    }
+                "id": {
+                    "type": "string"
                 },
-                "total": {
-                    "type": "integer"
+                "updatedAt": {
+                    "type": "string"
                 }
             }
         },
-        "dashboard.CoordinatorAlertSeverity": {
-            "type": "string",
-            "enum": [
-                "critical",
-                "warning",
-                "info"
-            ],
-            "x-enum-varnames": [
-                "CoordinatorAlertSeverityCritical",
-                "CoordinatorAlertSeverityWarning",
-                "CoordinatorAlertSeverityInfo"
-            ]
-        },
-        "dashboard.CoordinatorAlertType": {
-            "type": "string",
-            "enum": [
-                "evaluation",
-                "contract",
-                "draft",
-                "incident",
-                "waiting_long"
-            ],
-            "x-enum-varnames": [
-                "CoordinatorAlertTypeEvaluation",
-                "CoordinatorAlertTypeContract",
-                "CoordinatorAlertTypeDraft",
-                "CoordinatorAlertTypeIncident",
-                "CoordinatorAlertTypeWaitingLong"
-            ]
-        },
-        "dashboard.CoordinatorClientItem": {
+        "evaluation.UpcomingEvaluationItem": {
             "type": "object",
             "properties": {
-                "careType": {
+                "coordinatorFirstName": {
                     "type": "string"
                 },
-                "daysUntilEnd": {
-                    "type": "integer"
+                "coordinatorLastName": {
+                    "type": "string"
                 },
-                "evaluationStatus": {
+                "draftId": {
                     "type": "string"
                 },
+                "evaluationIntervalWeeks": {
+                    "type": "integer"
+                },
                 "firstName": {
                     "type": "string"
                 },
+                "hasDraft": {
+                    "type": "boolean"
+                },
                 "id": {
                     "type": "string"
                 },
                 "lastName": {
                     "type": "string"
                 },
-                "location": {
-                    "type": "string"
-                },
-                "nextEvaluation": {
+                "locationName": {
                     "type": "string"
                 },
-                "status": {
+                "nextEvaluationDate": {
                     "type": "string"
                 }
             }
         },
-        "dashboard.CoordinatorClientsResponse": {
+        "evaluation.UpdateEvaluationRequest": {
             "type": "object",
+            "required": [
+                "evaluationDate",
+                "progressLogs"
+            ],
             "properties": {
-                "clients": {
+                "evaluationDate": {
+                    "type": "string"
+                },
+                "overallNotes": {
+                    "type": "string"
+                },
+                "progressLogs": {
                     "type": "array",
+                    "minItems": 1,
                     "items": {
-                        "$ref": "#/definitions/dashboard.CoordinatorClientItem"
+                        "$ref": "#/definitions/evaluation.GoalProgressItem"
                     }
                 }
             }
         },
-        "dashboard.CoordinatorGoalsProgressResponse": {
+        "evaluation.UpdateEvaluationResponse": {
             "type": "object",
             "properties": {
-                "achieved": {
-                    "type": "integer"
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
+        "health.ComponentHealth": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string"
                 },
-                "delayed": {
-                    "type": "integer"
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "health.ReadinessResponse": {
+            "type": "object",
+            "properties": {
+                "components": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/health.ComponentHealth"
+                    }
                 },
-                "notStarted": {
-                    "type": "integer"
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "health.WorkerHealthResponse": {
+            "type": "object",
+            "properties": {
+                "healthy": {
+                    "type": "boolean"
                 },
-                "onTrack": {
-                    "type": "integer"
+                "lastRunAt": {
+                    "description": "nil when the worker has never run",
+                    "type": "string"
                 },
-                "total": {
+                "staleAfterSeconds": {
+                    "description": "StaleAfterSeconds is how old the last heartbeat may get before the worker counts as down",
                     "type": "integer"
+                },
+                "workerName": {
+                    "type": "string"
                 }
             }
         },
-        "dashboard.CoordinatorIncidentItem": {
+        "incident.CreateIncidentRequest": {
             "type": "object",
+            "required": [
+                "actionTaken",
+                "clientId",
+                "coordinatorId",
+                "incidentCategory",
+                "incidentDate",
+                "incidentDescription",
+                "incidentSeverity",
+                "incidentTime",
+                "incidentType",
+                "locationId",
+                "status"
+            ],
             "properties": {
-                "client": {
+                "actionTaken": {
                     "type": "string"
                 },
-                "date": {
+                "clientId": {
                     "type": "string"
                 },
-                "id": {
+                "coordinatorId": {
                     "type": "string"
                 },
-                "severity": {
+                "incidentCategory": {
+                    "type": "string",
+                    "enum": [
+                        "medication",
+                        "aggression",
+                        "fall",
+                        "absconding",
+                        "self_harm",
+                        "substance_use",
+                        "property_damage",
+                        "other"
+                    ]
+                },
+                "incidentDate": {
                     "type": "string"
                 },
-                "status": {
+                "incidentDescription": {
                     "type": "string"
                 },
-                "type": {
+                "incidentSeverity": {
+                    "type": "string",
+                    "enum": [
+                        "minor",
+                        "moderate",
+                        "severe"
+                    ]
+                },
+                "incidentTime": {
+                    "type": "string"
+                },
+                "incidentType": {
+                    "type": "string",
+                    "enum": [
+                        "aggression",
+                        "medical_emergency",
+                        "safety_concern",
+                        "unwanted_behavior",
+                        "other"
+                    ]
+                },
+                "locationId": {
                     "type": "string"
+                },
+                "otherParties": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "under_investigation",
+                        "completed"
+                    ]
                 }
             }
         },
-        "dashboard.CoordinatorIncidentsResponse": {
+        "incident.CreateIncidentResponse": {
             "type": "object",
             "properties": {
-                "incidents": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dashboard.CoordinatorIncidentItem"
-                    }
+                "id": {
+                    "type": "string"
                 }
             }
         },
-        "dashboard.CoordinatorRemindersResponse": {
+        "incident.DeleteIncidentRequest": {
             "type": "object",
+            "required": [
+                "reason"
+            ],
             "properties": {
-                "reminders": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dashboard.ReminderItem"
-                    }
+                "reason": {
+                    "type": "string"
                 }
             }
         },
-        "dashboard.CoordinatorScheduleItem": {
+        "incident.DeleteIncidentResponse": {
+            "type": "object",
+            "properties": {
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "incident.GetIncidentResponse": {
             "type": "object",
             "properties": {
+                "actionTaken": {
+                    "type": "string"
+                },
+                "clientFirstName": {
+                    "type": "string"
+                },
                 "clientId": {
                     "type": "string"
                 },
-                "clientName": {
+                "clientLastName": {
+                    "type": "string"
+                },
+                "coordinatorFirstName": {
+                    "type": "string"
+                },
+                "coordinatorId": {
+                    "type": "string"
+                },
+                "coordinatorLastName": {
+                    "type": "string"
+                },
+                "createdAt": {
                     "type": "string"
                 },
-                "endTime": {
+                "createdByUserId": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "locationId": {
+                "incidentCategory": {
                     "type": "string"
                 },
-                "locationName": {
+                "incidentDate": {
                     "type": "string"
                 },
-                "status": {
+                "incidentDescription": {
                     "type": "string"
                 },
-                "time": {
+                "incidentSeverity": {
                     "type": "string"
                 },
-                "type": {
+                "incidentTime": {
                     "type": "string"
-                }
-            }
-        },
-        "dashboard.CoordinatorStatsResponse": {
-            "type": "object",
-            "properties": {
-                "myActiveClients": {
-                    "type": "integer"
-                },
-                "myPendingIntakes": {
-                    "type": "integer"
-                },
-                "myUpcomingEvaluations": {
-                    "type": "integer"
-                },
-                "myWaitingListClients": {
-                    "type": "integer"
-                }
-            }
-        },
-        "dashboard.CoordinatorTodayScheduleResponse": {
-            "type": "object",
-            "properties": {
-                "appointments": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dashboard.CoordinatorScheduleItem"
-                    }
-                },
-                "count": {
-                    "type": "integer"
                 },
-                "date": {
+                "incidentType": {
                     "type": "string"
-                }
-            }
-        },
-        "dashboard.CoordinatorUrgentAlertItem": {
-            "type": "object",
-            "properties": {
-                "clientIds": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
-                "count": {
-                    "type": "integer"
                 },
-                "description": {
+                "locationId": {
                     "type": "string"
                 },
-                "id": {
+                "locationName": {
                     "type": "string"
                 },
-                "link": {
+                "otherParties": {
                     "type": "string"
                 },
-                "severity": {
-                    "$ref": "#/definitions/dashboard.CoordinatorAlertSeverity"
-                },
-                "title": {
+                "status": {
                     "type": "string"
-                },
-                "type": {
-                    "$ref": "#/definitions/dashboard.CoordinatorAlertType"
-                }
-            }
-        },
-        "dashboard.CoordinatorUrgentAlertsResponse": {
-            "type": "object",
-            "properties": {
-                "alerts": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dashboard.CoordinatorUrgentAlertItem"
-                    }
-                }
-            }
-        },
-        "dashboard.CriticalAlertsResponse": {
-            "type": "object",
-            "properties": {
-                "alerts": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dashboard.AlertItem"
-                    }
                 }
             }
         },
-        "dashboard.DischargeStatsResponse": {
+        "incident.GetIncidentStatsResponse": {
             "type": "object",
             "properties": {
-                "averageDaysInCare": {
-                    "type": "integer"
+                "countsBySeverity": {
+                    "$ref": "#/definitions/incident.IncidentSeverityCounts"
                 },
-                "plannedRate": {
-                    "type": "integer"
+                "countsByStatus": {
+                    "$ref": "#/definitions/incident.IncidentStatusCounts"
                 },
-                "thisMonth": {
-                    "type": "integer"
+                "countsByType": {
+                    "$ref": "#/definitions/incident.IncidentTypeCounts"
                 },
-                "thisYear": {
+                "totalCount": {
                     "type": "integer"
                 }
             }
         },
-        "dashboard.EvaluationStatsResponse": {
+        "incident.IncidentSeverityCounts": {
             "type": "object",
             "properties": {
-                "completed": {
-                    "type": "integer"
-                },
-                "completionRate": {
-                    "type": "integer"
-                },
-                "dueSoon": {
+                "minor": {
                     "type": "integer"
                 },
-                "overdue": {
+                "moderate": {
                     "type": "integer"
                 },
-                "total": {
+                "severe": {
                     "type": "integer"
                 }
             }
         },
-        "dashboard.LocationCapacityItem": {
+        "incident.IncidentStatusCounts": {
             "type": "object",
             "properties": {
-                "available": {
+                "completed": {
                     "type": "integer"
                 },
-                "capacity": {
+                "pending": {
                     "type": "integer"
                 },
-                "id": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "occupied": {
+                "underInvestigation": {
                     "type": "integer"
-                },
-                "percentage": {
-                    "type": "number"
-                }
-            }
-        },
-        "dashboard.LocationCapacityResponse": {
-            "type": "object",
-            "properties": {
-                "locations": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dashboard.LocationCapacityItem"
-                    }
-                },
-                "totals": {
-                    "$ref": "#/definitions/dashboard.LocationCapacityTotals"
                 }
             }
         },
-        "dashboard.LocationCapacityTotals": {
+        "incident.IncidentTrendMonth": {
             "type": "object",
             "properties": {
-                "overallPercentage": {
-                    "type": "number"
-                },
-                "totalAvailable": {
-                    "type": "integer"
+                "countsBySeverity": {
+                    "$ref": "#/definitions/incident.IncidentSeverityCounts"
                 },
-                "totalCapacity": {
-                    "type": "integer"
+                "month": {
+                    "description": "YYYY-MM",
+                    "type": "string"
                 },
-                "totalOccupied": {
+                "totalCount": {
                     "type": "integer"
                 }
             }
         },
-        "dashboard.OverviewResponse": {
+        "incident.IncidentTypeCounts": {
             "type": "object",
             "properties": {
-                "openIncidents": {
-                    "type": "integer"
-                },
-                "pendingRegistrations": {
+                "aggression": {
                     "type": "integer"
                 },
-                "totalActiveClients": {
+                "medicalEmergency": {
                     "type": "integer"
                 },
-                "totalCoordinators": {
+                "other": {
                     "type": "integer"
                 },
-                "totalEmployees": {
+                "safetyConcern": {
                     "type": "integer"
                 },
-                "waitingListCount": {
+                "unwantedBehavior": {
                     "type": "integer"
                 }
             }
         },
-        "dashboard.PipelineStatsResponse": {
+        "incident.ListIncidentsResponse": {
             "type": "object",
             "properties": {
-                "discharged": {
-                    "type": "integer"
+                "actionTaken": {
+                    "type": "string"
                 },
-                "inCare": {
-                    "type": "integer"
+                "clientFirstName": {
+                    "type": "string"
                 },
-                "intakes": {
-                    "type": "integer"
+                "clientId": {
+                    "type": "string"
                 },
-                "registrations": {
-                    "type": "integer"
+                "clientLastName": {
+                    "type": "string"
                 },
-                "waitingList": {
-                    "type": "integer"
-                }
-            }
-        },
-        "dashboard.ReminderItem": {
-            "type": "object",
-            "properties": {
-                "client": {
+                "coordinatorFirstName": {
                     "type": "string"
                 },
-                "dueDate": {
+                "coordinatorId": {
+                    "type": "string"
+                },
+                "coordinatorLastName": {
+                    "type": "string"
+                },
+                "createdAt": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "priority": {
+                "incidentCategory": {
                     "type": "string"
                 },
-                "title": {
+                "incidentDate": {
+                    "type": "string"
+                },
+                "incidentDescription": {
+                    "type": "string"
+                },
+                "incidentSeverity": {
+                    "type": "string"
+                },
+                "incidentTime": {
+                    "type": "string"
+                },
+                "incidentType": {
+                    "type": "string"
+                },
+                "locationId": {
+                    "type": "string"
+                },
+                "locationName": {
+                    "type": "string"
+                },
+                "otherParties": {
+                    "type": "string"
+                },
+                "status": {
                     "type": "string"
                 }
             }
         },
-        "dashboard.TodayAppointmentItem": {
+        "incident.UpdateIncidentRequest": {
             "type": "object",
             "properties": {
-                "clientId": {
+                "actionTaken": {
+                    "type": "string"
+                },
+                "coordinatorId": {
                     "type": "string"
                 },
-                "clientName": {
+                "incidentCategory": {
+                    "type": "string",
+                    "enum": [
+                        "medication",
+                        "aggression",
+                        "fall",
+                        "absconding",
+                        "self_harm",
+                        "substance_use",
+                        "property_damage",
+                        "other"
+                    ]
+                },
+                "incidentDate": {
                     "type": "string"
                 },
-                "endTime": {
+                "incidentDescription": {
                     "type": "string"
                 },
-                "id": {
-                    "type": "string"
+                "incidentSeverity": {
+                    "type": "string",
+                    "enum": [
+                        "minor",
+                        "moderate",
+                        "severe"
+                    ]
                 },
-                "locationName": {
+                "incidentTime": {
                     "type": "string"
                 },
-                "startTime": {
-                    "type": "string"
+                "incidentType": {
+                    "type": "string",
+                    "enum": [
+                        "aggression",
+                        "medical_emergency",
+                        "safety_concern",
+                        "unwanted_behavior",
+                        "other"
+                    ]
                 },
-                "title": {
+                "locationId": {
                     "type": "string"
                 },
-                "type": {
+                "otherParties": {
                     "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "under_investigation",
+                        "completed"
+                    ]
                 }
             }
         },
-        "dashboard.TodayAppointmentsResponse": {
+        "incident.UpdateIncidentResponse": {
             "type": "object",
             "properties": {
-                "appointments": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dashboard.TodayAppointmentItem"
-                    }
-                },
-                "count": {
-                    "type": "integer"
+                "success": {
+                    "type": "boolean"
                 }
             }
         },
-        "employee.CreateEmployeeRequest": {
+        "intake.CreateIntakeFormRequest": {
             "type": "object",
             "required": [
-                "bsn",
-                "dateOfBirth",
-                "email",
-                "firstName",
-                "gender",
-                "lastName",
+                "coordinatorId",
+                "intakeDate",
+                "intakeTime",
                 "locationId",
-                "password",
-                "phoneNumber",
-                "role"
+                "registrationFormId"
             ],
             "properties": {
-                "bsn": {
+                "coordinatorId": {
                     "type": "string"
                 },
-                "contractHours": {
-                    "type": "integer"
-                },
-                "contractType": {
-                    "type": "string",
-                    "enum": [
-                        "self_employed",
-                        "payroll_service"
-                    ]
+                "evaluationIntervalWeeks": {
+                    "type": "integer",
+                    "maximum": 52,
+                    "minimum": 1
                 },
-                "dateOfBirth": {
+                "familySituation": {
                     "type": "string"
                 },
-                "email": {
+                "focusAreas": {
                     "type": "string"
                 },
-                "firstName": {
+                "goals": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/intake.GoalItem"
+                    }
+                },
+                "intakeDate": {
                     "type": "string"
                 },
-                "gender": {
-                    "type": "string",
-                    "enum": [
-                        "male",
-                        "female",
-                        "other"
-                    ]
+                "intakeTime": {
+                    "type": "string"
                 },
-                "lastName": {
+                "limitations": {
                     "type": "string"
                 },
                 "locationId": {
                     "type": "string"
                 },
-                "password": {
+                "mainProvider": {
                     "type": "string"
                 },
-                "phoneNumber": {
+                "notes": {
                     "type": "string"
                 },
-                "role": {
+                "registrationFormId": {
                     "type": "string"
                 }
             }
         },
-        "employee.CreateEmployeeResponse": {
+        "intake.CreateIntakeFormResponse": {
             "type": "object",
             "properties": {
                 "id": {
@@ -7508,37 +12038,96 @@ const docTemplate = `{
                 }
             }
         },
-        "employee.GetEmployeeByIDResponse": {
+        "intake.DeleteIntakeFormResponse": {
             "type": "object",
             "properties": {
-                "bsn": {
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
+        "intake.GetAvailableIntakeSlotsResponse": {
+            "type": "object",
+            "properties": {
+                "coordinatorId": {
                     "type": "string"
                 },
-                "clientCount": {
-                    "type": "integer"
+                "date": {
+                    "type": "string"
                 },
-                "contractHours": {
-                    "type": "integer"
+                "slots": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/intake.IntakeSlot"
+                    }
+                }
+            }
+        },
+        "intake.GetIntakeFormResponse": {
+            "type": "object",
+            "properties": {
+                "careType": {
+                    "type": "string"
                 },
-                "contractType": {
+                "clientBsn": {
                     "type": "string"
                 },
-                "dateOfBirth": {
+                "clientFirstName": {
                     "type": "string"
                 },
-                "email": {
+                "clientLastName": {
                     "type": "string"
                 },
-                "firstName": {
+                "coordinatorFirstName": {
                     "type": "string"
                 },
-                "gender": {
+                "coordinatorId": {
+                    "type": "string"
+                },
+                "coordinatorLastName": {
+                    "type": "string"
+                },
+                "createdByUserId": {
+                    "type": "string"
+                },
+                "documents": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/intake.IntakeDocumentResponse"
+                    }
+                },
+                "documentsComplete": {
+                    "description": "DocumentsComplete is false while any required document is still missing",
+                    "type": "boolean"
+                },
+                "evaluationIntervalWeeks": {
+                    "type": "integer"
+                },
+                "familySituation": {
                     "type": "string"
                 },
+                "focusAreas": {
+                    "type": "string"
+                },
+                "goals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/intake.GoalItem"
+                    }
+                },
+                "hasClient": {
+                    "type": "boolean"
+                },
                 "id": {
                     "type": "string"
                 },
-                "lastName": {
+                "intakeDate": {
+                    "type": "string"
+                },
+                "intakeTime": {
+                    "type": "string"
+                },
+                "limitations": {
                     "type": "string"
                 },
                 "locationId": {
@@ -7547,113 +12136,139 @@ const docTemplate = `{
                 "locationName": {
                     "type": "string"
                 },
-                "phoneNumber": {
+                "mainProvider": {
                     "type": "string"
                 },
-                "roleId": {
+                "notes": {
                     "type": "string"
                 },
-                "roleName": {
+                "organizationName": {
                     "type": "string"
                 },
-                "userId": {
+                "registrationFormId": {
+                    "type": "string"
+                },
+                "status": {
                     "type": "string"
                 }
             }
         },
-        "employee.GetMyProfileResponse": {
+        "intake.GetIntakeStatsResponse": {
             "type": "object",
             "properties": {
-                "bsn": {
-                    "type": "string"
+                "conversionPercentage": {
+                    "type": "number"
                 },
-                "clientCount": {
+                "pendingCount": {
                     "type": "integer"
                 },
-                "contractHours": {
+                "totalCount": {
                     "type": "integer"
-                },
-                "contractType": {
-                    "type": "string"
-                },
-                "dateOfBirth": {
+                }
+            }
+        },
+        "intake.GoalItem": {
+            "type": "object",
+            "required": [
+                "title"
+            ],
+            "properties": {
+                "description": {
                     "type": "string"
                 },
-                "email": {
+                "id": {
                     "type": "string"
                 },
-                "firstName": {
+                "title": {
                     "type": "string"
-                },
-                "gender": {
+                }
+            }
+        },
+        "intake.IntakeDocumentResponse": {
+            "type": "object",
+            "properties": {
+                "documentType": {
                     "type": "string"
                 },
-                "id": {
+                "receivedAt": {
                     "type": "string"
                 },
-                "lastName": {
+                "status": {
                     "type": "string"
                 },
-                "locationAddress": {
+                "updatedAt": {
                     "type": "string"
-                },
-                "locationId": {
+                }
+            }
+        },
+        "intake.IntakeRescheduleResponse": {
+            "type": "object",
+            "properties": {
+                "changedAt": {
                     "type": "string"
                 },
-                "locationName": {
+                "changedBy": {
                     "type": "string"
                 },
-                "locationPostalCode": {
+                "id": {
                     "type": "string"
                 },
-                "permissions": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/employee.PermissionResponse"
-                    }
-                },
-                "phoneNumber": {
+                "newIntakeDate": {
                     "type": "string"
                 },
-                "role": {
+                "newIntakeTime": {
                     "type": "string"
                 },
-                "userId": {
+                "oldIntakeDate": {
+                    "type": "string"
+                },
+                "oldIntakeTime": {
                     "type": "string"
                 }
             }
         },
-        "employee.ListEmployeesResponse": {
+        "intake.IntakeSlot": {
             "type": "object",
             "properties": {
-                "bsn": {
+                "endTime": {
                     "type": "string"
                 },
-                "clientCount": {
-                    "type": "integer"
+                "startTime": {
+                    "type": "string"
+                }
+            }
+        },
+        "intake.ListIntakeFormsResponse": {
+            "type": "object",
+            "properties": {
+                "careType": {
+                    "type": "string"
                 },
-                "contractHours": {
-                    "type": "integer"
+                "clientBsn": {
+                    "type": "string"
                 },
-                "contractType": {
+                "clientFirstName": {
                     "type": "string"
                 },
-                "dateOfBirth": {
+                "clientLastName": {
                     "type": "string"
                 },
-                "email": {
+                "coordinatorFirstName": {
                     "type": "string"
                 },
-                "firstName": {
+                "coordinatorId": {
                     "type": "string"
                 },
-                "gender": {
+                "coordinatorLastName": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "lastName": {
+                "intakeDate": {
+                    "type": "string"
+                },
+                "intakeTime": {
                     "type": "string"
                 },
                 "locationId": {
@@ -7662,80 +12277,93 @@ const docTemplate = `{
                 "locationName": {
                     "type": "string"
                 },
-                "phoneNumber": {
+                "mainProvider": {
                     "type": "string"
                 },
-                "userId": {
-                    "type": "string"
-                }
-            }
-        },
-        "employee.PermissionResponse": {
-            "type": "object",
-            "properties": {
-                "action": {
+                "organizationName": {
                     "type": "string"
                 },
-                "description": {
+                "registrationFormId": {
                     "type": "string"
                 },
-                "id": {
+                "status": {
                     "type": "string"
                 },
-                "resource": {
+                "updatedAt": {
                     "type": "string"
                 }
             }
         },
-        "employee.UpdateEmployeeRequest": {
+        "intake.UpdateIntakeDocumentRequest": {
             "type": "object",
+            "required": [
+                "status"
+            ],
             "properties": {
-                "bsn": {
-                    "type": "string"
-                },
-                "contractHours": {
-                    "type": "integer"
-                },
-                "contractType": {
+                "status": {
                     "type": "string",
                     "enum": [
-                        "self_employed",
-                        "payroll_service"
+                        "missing",
+                        "received"
                     ]
+                }
+            }
+        },
+        "intake.UpdateIntakeFormRequest": {
+            "type": "object",
+            "properties": {
+                "coordinatorId": {
+                    "type": "string"
                 },
-                "dateOfBirth": {
+                "evaluationIntervalWeeks": {
+                    "type": "integer",
+                    "maximum": 52,
+                    "minimum": 1
+                },
+                "familySituation": {
                     "type": "string"
                 },
-                "email": {
+                "focusAreas": {
                     "type": "string"
                 },
-                "firstName": {
+                "goals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/intake.GoalItem"
+                    }
+                },
+                "intakeDate": {
                     "type": "string"
                 },
-                "gender": {
-                    "type": "string",
-                    "enum": [
-                        "male",
-                        "female",
-                        "other"
-                    ]
+                "intakeTime": {
+                    "type": "string"
                 },
-                "lastName": {
+                "limitations": {
                     "type": "string"
                 },
                 "locationId": {
                     "type": "string"
                 },
-                "password": {
-                    "type": "string",
-                    "minLength": 6
+                "mainProvider": {
+                    "type": "string"
                 },
-                "phoneNumber": {
+                "notes": {
                     "type": "string"
+                },
+                "overrideCaseload": {
+                    "description": "Admin only: move the client to the coordinator even when they are at their caseload cap",
+                    "type": "boolean"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "completed",
+                        "pending"
+                    ]
                 }
             }
         },
-        "employee.UpdateEmployeeResponse": {
+        "intake.UpdateIntakeFormResponse": {
             "type": "object",
             "properties": {
                 "id": {
@@ -7743,66 +12371,57 @@ const docTemplate = `{
                 }
             }
         },
-        "evaluation.CoordinatorInfo": {
+        "location_transfer.CancelLocationTransferRequest": {
             "type": "object",
             "properties": {
-                "firstName": {
-                    "type": "string"
-                },
-                "lastName": {
+                "reason": {
                     "type": "string"
                 }
             }
         },
-        "evaluation.CreateEvaluationRequest": {
+        "location_transfer.ConfirmLocationTransferRequest": {
             "type": "object",
-            "required": [
-                "clientId",
-                "coordinatorId",
-                "evaluationDate"
-            ],
             "properties": {
-                "clientId": {
+                "handoverNotes": {
                     "type": "string"
                 },
-                "coordinatorId": {
-                    "type": "string"
-                },
-                "evaluationDate": {
-                    "type": "string"
-                },
-                "isDraft": {
+                "overrideCaseload": {
+                    "description": "Admin only: approve even when the new coordinator is at their caseload cap",
                     "type": "boolean"
-                },
-                "overallNotes": {
-                    "type": "string"
-                },
-                "progressLogs": {
-                    "type": "array",
-                    "minItems": 1,
-                    "items": {
-                        "$ref": "#/definitions/evaluation.GoalProgressItem"
-                    }
                 }
             }
         },
-        "evaluation.CreateEvaluationResponse": {
+        "location_transfer.GetLocationTransferStatsResponse": {
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "string"
+                "approvalRate": {
+                    "type": "number"
                 },
-                "isDraft": {
-                    "type": "boolean"
+                "avgTimeToDecisionHours": {
+                    "description": "Average hours from request to approval or rejection",
+                    "type": "number"
                 },
-                "nextEvaluationDate": {
-                    "type": "string"
+                "countsByStatus": {
+                    "$ref": "#/definitions/location_transfer.TransferStatusCounts"
+                },
+                "pendingCount": {
+                    "type": "integer"
+                },
+                "totalCount": {
+                    "type": "integer"
                 }
             }
         },
-        "evaluation.DraftEvaluationListItem": {
+        "location_transfer.ListLocationTransfersResponse": {
             "type": "object",
             "properties": {
+                "approvedAt": {
+                    "description": "Set on a single transfer once it is decided; TimeToDecisionHours is how\nlong it waited for approval or rejection",
+                    "type": "string"
+                },
+                "cancellationReason": {
+                    "type": "string"
+                },
                 "clientFirstName": {
                     "type": "string"
                 },
@@ -7812,738 +12431,839 @@ const docTemplate = `{
                 "clientLastName": {
                     "type": "string"
                 },
-                "evaluationDate": {
+                "createdByUserId": {
                     "type": "string"
                 },
-                "evaluationId": {
+                "currentCoordinatorFirstName": {
                     "type": "string"
                 },
-                "goalsCount": {
-                    "type": "integer"
+                "currentCoordinatorId": {
+                    "type": "string"
                 },
-                "updatedAt": {
+                "currentCoordinatorLastName": {
                     "type": "string"
-                }
-            }
-        },
-        "evaluation.DraftEvaluationResponse": {
-            "type": "object",
-            "properties": {
-                "clientFirstName": {
+                },
+                "fromLocationId": {
                     "type": "string"
                 },
-                "clientId": {
+                "fromLocationName": {
                     "type": "string"
                 },
-                "clientLastName": {
+                "handoverNotes": {
                     "type": "string"
                 },
-                "coordinatorFirstName": {
+                "id": {
                     "type": "string"
                 },
-                "coordinatorLastName": {
+                "newCoordinatorFirstName": {
                     "type": "string"
                 },
-                "createdAt": {
+                "newCoordinatorId": {
                     "type": "string"
                 },
-                "evaluationDate": {
+                "newCoordinatorLastName": {
                     "type": "string"
                 },
-                "evaluationId": {
+                "reason": {
                     "type": "string"
                 },
-                "goalProgress": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/evaluation.GoalProgressItem"
-                    }
+                "rejectedAt": {
+                    "type": "string"
+                },
+                "rejectionReason": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "timeToDecisionHours": {
+                    "type": "number"
+                },
+                "toLocationId": {
+                    "type": "string"
+                },
+                "toLocationName": {
+                    "type": "string"
+                },
+                "transferDate": {
+                    "description": "or time.Time, but for JSON, string",
+                    "type": "string"
+                }
+            }
+        },
+        "location_transfer.RefuseLocationTransferRequest": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "location_transfer.RegisterLocationTransferRequest": {
+            "type": "object",
+            "required": [
+                "clientId",
+                "newCoordinatorId",
+                "newLocationId"
+            ],
+            "properties": {
+                "clientId": {
+                    "type": "string"
                 },
-                "overallNotes": {
+                "newCoordinatorId": {
                     "type": "string"
                 },
-                "updatedAt": {
+                "newLocationId": {
                     "type": "string"
                 }
             }
         },
-        "evaluation.EvaluationHistoryItem": {
+        "location_transfer.RegisterLocationTransferResponse": {
             "type": "object",
             "properties": {
-                "coordinatorFirstName": {
-                    "type": "string"
-                },
-                "coordinatorLastName": {
-                    "type": "string"
-                },
-                "evaluationDate": {
-                    "type": "string"
-                },
-                "evaluationId": {
-                    "type": "string"
-                },
-                "goalId": {
-                    "type": "string"
-                },
-                "goalTitle": {
-                    "type": "string"
-                },
-                "overallNotes": {
-                    "type": "string"
-                },
-                "progressNotes": {
-                    "type": "string"
-                },
-                "status": {
+                "transferId": {
                     "type": "string"
                 }
             }
         },
-        "evaluation.EvaluationResponse": {
+        "location_transfer.TransferStatusCounts": {
             "type": "object",
             "properties": {
-                "clientFirstName": {
-                    "type": "string"
+                "approved": {
+                    "type": "integer"
                 },
-                "clientId": {
-                    "type": "string"
+                "cancelled": {
+                    "type": "integer"
                 },
-                "clientLastName": {
-                    "type": "string"
+                "pending": {
+                    "type": "integer"
                 },
-                "coordinatorFirstName": {
+                "rejected": {
+                    "type": "integer"
+                }
+            }
+        },
+        "location_transfer.UpdateLocationTransferRequest": {
+            "type": "object",
+            "properties": {
+                "newCoordinatorId": {
                     "type": "string"
                 },
-                "coordinatorLastName": {
+                "newLocationId": {
                     "type": "string"
                 },
-                "createdAt": {
+                "reason": {
                     "type": "string"
-                },
-                "evaluationDate": {
+                }
+            }
+        },
+        "locations.CreateLocationRequest": {
+            "type": "object",
+            "required": [
+                "address",
+                "name",
+                "postalCode"
+            ],
+            "properties": {
+                "address": {
                     "type": "string"
                 },
-                "evaluationId": {
-                    "type": "string"
+                "capacity": {
+                    "type": "integer",
+                    "minimum": 1
                 },
-                "goalProgress": {
+                "careTypes": {
+                    "description": "Care types the location offers; empty means it accepts every care type",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/evaluation.GoalProgressItem"
+                        "type": "string"
                     }
                 },
-                "overallNotes": {
+                "name": {
                     "type": "string"
                 },
-                "status": {
-                    "type": "string"
+                "occupied": {
+                    "type": "integer",
+                    "minimum": 0
                 },
-                "updatedAt": {
+                "postalCode": {
                     "type": "string"
                 }
             }
         },
-        "evaluation.GlobalRecentEvaluationItem": {
+        "locations.CreateLocationResponse": {
             "type": "object",
             "properties": {
-                "clientFirstName": {
+                "id": {
                     "type": "string"
+                }
+            }
+        },
+        "locations.DeleteLocationResponse": {
+            "type": "object",
+            "properties": {
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "locations.GetLocationCapacityStatsResponse": {
+            "type": "object",
+            "properties": {
+                "capacityUsed": {
+                    "type": "integer"
                 },
-                "clientId": {
-                    "type": "string"
+                "freeCapacity": {
+                    "type": "integer"
                 },
-                "clientLastName": {
+                "totalCapacity": {
+                    "type": "integer"
+                }
+            }
+        },
+        "locations.ListLocationsResponse": {
+            "type": "object",
+            "properties": {
+                "address": {
                     "type": "string"
                 },
-                "coordinatorFirstName": {
-                    "type": "string"
+                "capacity": {
+                    "type": "integer"
                 },
-                "coordinatorLastName": {
-                    "type": "string"
+                "careTypes": {
+                    "description": "Care types the location offers; empty means it accepts every care type",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "evaluationDate": {
+                "id": {
                     "type": "string"
                 },
-                "evaluationId": {
+                "name": {
                     "type": "string"
                 },
-                "goalsAchieved": {
+                "occupied": {
                     "type": "integer"
                 },
-                "totalGoals": {
-                    "type": "integer"
+                "postalCode": {
+                    "type": "string"
                 }
             }
         },
-        "evaluation.GoalProgressItem": {
+        "locations.LocationCapacityPoint": {
             "type": "object",
             "properties": {
-                "goalId": {
-                    "type": "string"
-                },
-                "goalTitle": {
-                    "type": "string"
+                "capacity": {
+                    "type": "integer"
                 },
-                "progressNotes": {
+                "date": {
                     "type": "string"
                 },
-                "status": {
-                    "type": "string"
+                "occupied": {
+                    "type": "integer"
                 }
             }
         },
-        "evaluation.LastEvaluationItem": {
+        "locations.UpdateLocationRequest": {
             "type": "object",
             "properties": {
-                "coordinator": {
-                    "$ref": "#/definitions/evaluation.CoordinatorInfo"
-                },
-                "evaluationDate": {
+                "address": {
                     "type": "string"
                 },
-                "evaluationId": {
-                    "type": "string"
+                "capacity": {
+                    "type": "integer",
+                    "minimum": 1
                 },
-                "goalProgress": {
+                "careTypes": {
+                    "description": "Replaces the location's care types when set; an empty list accepts every care type",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/evaluation.GoalProgressItem"
+                        "type": "string"
                     }
                 },
-                "overallNotes": {
+                "name": {
+                    "type": "string"
+                },
+                "occupied": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "postalCode": {
                     "type": "string"
                 }
             }
         },
-        "evaluation.SaveDraftRequest": {
+        "locations.UpdateLocationResponse": {
             "type": "object",
-            "required": [
-                "clientId",
-                "coordinatorId",
-                "evaluationDate"
-            ],
             "properties": {
-                "clientId": {
-                    "type": "string"
-                },
-                "coordinatorId": {
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "metadata.EnumOption": {
+            "type": "object",
+            "properties": {
+                "color": {
+                    "description": "Color is a suggested hex color for enums shown as colored badges",
                     "type": "string"
                 },
-                "evaluationDate": {
+                "label": {
                     "type": "string"
                 },
-                "overallNotes": {
+                "value": {
                     "type": "string"
-                },
-                "progressLogs": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/evaluation.GoalProgressItem"
-                    }
                 }
             }
         },
-        "evaluation.SaveDraftResponse": {
+        "metadata.EnumOptionsResponse": {
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "string"
-                },
-                "updatedAt": {
-                    "type": "string"
+                "enums": {
+                    "description": "Enums maps the enum name (e.g. careType) to its options in declaration order",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "$ref": "#/definitions/metadata.EnumOption"
+                        }
+                    }
                 }
             }
         },
-        "evaluation.UpcomingEvaluationItem": {
+        "notification.NotificationResponse": {
             "type": "object",
             "properties": {
-                "coordinatorFirstName": {
+                "created_at": {
                     "type": "string"
                 },
-                "coordinatorLastName": {
+                "id": {
                     "type": "string"
                 },
-                "draftId": {
-                    "type": "string"
+                "is_read": {
+                    "type": "boolean"
                 },
-                "evaluationIntervalWeeks": {
-                    "type": "integer"
+                "message": {
+                    "type": "string"
                 },
-                "firstName": {
+                "priority": {
                     "type": "string"
                 },
-                "hasDraft": {
-                    "type": "boolean"
+                "read_at": {
+                    "type": "string"
                 },
-                "id": {
+                "resource_id": {
                     "type": "string"
                 },
-                "lastName": {
+                "resource_type": {
                     "type": "string"
                 },
-                "locationName": {
+                "title": {
                     "type": "string"
                 },
-                "nextEvaluationDate": {
+                "type": {
                     "type": "string"
                 }
             }
-        },
-        "evaluation.UpdateEvaluationRequest": {
-            "type": "object",
-            "required": [
-                "evaluationDate",
-                "progressLogs"
-            ],
+        },
+        "notification.QuietHoursResponse": {
+            "type": "object",
             "properties": {
-                "evaluationDate": {
+                "enabled": {
+                    "type": "boolean"
+                },
+                "end": {
                     "type": "string"
                 },
-                "overallNotes": {
+                "start": {
                     "type": "string"
                 },
-                "progressLogs": {
-                    "type": "array",
-                    "minItems": 1,
-                    "items": {
-                        "$ref": "#/definitions/evaluation.GoalProgressItem"
-                    }
+                "timezone": {
+                    "type": "string"
                 }
             }
         },
-        "evaluation.UpdateEvaluationResponse": {
+        "notification.UnreadCountResponse": {
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "string"
+                "count": {
+                    "type": "integer"
                 }
             }
         },
-        "incident.CreateIncidentRequest": {
+        "notification.UpdateQuietHoursRequest": {
             "type": "object",
             "required": [
-                "actionTaken",
-                "clientId",
-                "coordinatorId",
-                "incidentDate",
-                "incidentDescription",
-                "incidentSeverity",
-                "incidentTime",
-                "incidentType",
-                "locationId",
-                "status"
+                "end",
+                "start"
             ],
             "properties": {
-                "actionTaken": {
-                    "type": "string"
-                },
-                "clientId": {
-                    "type": "string"
-                },
-                "coordinatorId": {
-                    "type": "string"
-                },
-                "incidentDate": {
-                    "type": "string"
-                },
-                "incidentDescription": {
-                    "type": "string"
-                },
-                "incidentSeverity": {
+                "end": {
                     "type": "string",
-                    "enum": [
-                        "minor",
-                        "moderate",
-                        "severe"
-                    ]
-                },
-                "incidentTime": {
-                    "type": "string"
+                    "example": "07:00"
                 },
-                "incidentType": {
+                "start": {
                     "type": "string",
-                    "enum": [
-                        "aggression",
-                        "medical_emergency",
-                        "safety_concern",
-                        "unwanted_behavior",
-                        "other"
-                    ]
-                },
-                "locationId": {
-                    "type": "string"
-                },
-                "otherParties": {
-                    "type": "string"
+                    "example": "22:00"
                 },
-                "status": {
+                "timezone": {
                     "type": "string",
-                    "enum": [
-                        "pending",
-                        "under_investigation",
-                        "completed"
-                    ]
+                    "example": "Europe/Amsterdam"
                 }
             }
         },
-        "incident.CreateIncidentResponse": {
+        "notification.WSAuthResponse": {
             "type": "object",
             "properties": {
-                "id": {
+                "ticket": {
                     "type": "string"
                 }
             }
         },
-        "incident.DeleteIncidentResponse": {
+        "rbac.AssignPermissionRequest": {
             "type": "object",
+            "required": [
+                "permissionId"
+            ],
             "properties": {
-                "success": {
-                    "type": "boolean"
+                "permissionId": {
+                    "type": "string"
                 }
             }
         },
-        "incident.GetIncidentResponse": {
+        "rbac.AssignRoleToUserRequest": {
             "type": "object",
+            "required": [
+                "roleId",
+                "userId"
+            ],
             "properties": {
-                "actionTaken": {
+                "roleId": {
                     "type": "string"
                 },
-                "clientFirstName": {
+                "userId": {
                     "type": "string"
-                },
-                "clientId": {
+                }
+            }
+        },
+        "rbac.CreateRoleRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "description": {
                     "type": "string"
                 },
-                "clientLastName": {
+                "name": {
                     "type": "string"
                 },
-                "coordinatorFirstName": {
+                "permissionIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "rbac.CreateRoleResponse": {
+            "type": "object",
+            "properties": {
+                "description": {
                     "type": "string"
                 },
-                "coordinatorId": {
+                "id": {
                     "type": "string"
                 },
-                "coordinatorLastName": {
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "rbac.PermissionResponse": {
+            "type": "object",
+            "properties": {
+                "action": {
                     "type": "string"
                 },
-                "createdAt": {
+                "description": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "incidentDate": {
+                "resource": {
                     "type": "string"
+                }
+            }
+        },
+        "rbac.RemovePermissionFromAllRolesResponse": {
+            "type": "object",
+            "properties": {
+                "permissionDeleted": {
+                    "type": "boolean"
                 },
-                "incidentDescription": {
+                "permissionId": {
                     "type": "string"
                 },
-                "incidentSeverity": {
+                "rolesAffected": {
+                    "type": "integer"
+                }
+            }
+        },
+        "rbac.RoleListItem": {
+            "type": "object",
+            "properties": {
+                "description": {
                     "type": "string"
                 },
-                "incidentTime": {
+                "id": {
                     "type": "string"
                 },
-                "incidentType": {
+                "name": {
                     "type": "string"
                 },
-                "locationId": {
-                    "type": "string"
+                "permissionCount": {
+                    "type": "integer"
                 },
-                "locationName": {
+                "userCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "rbac.RoleResponse": {
+            "type": "object",
+            "properties": {
+                "description": {
                     "type": "string"
                 },
-                "otherParties": {
+                "id": {
                     "type": "string"
                 },
-                "status": {
+                "name": {
                     "type": "string"
+                },
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/rbac.PermissionResponse"
+                    }
                 }
             }
         },
-        "incident.GetIncidentStatsResponse": {
+        "rbac.UpdateRoleRequest": {
             "type": "object",
+            "required": [
+                "name"
+            ],
             "properties": {
-                "countsBySeverity": {
-                    "$ref": "#/definitions/incident.IncidentSeverityCounts"
-                },
-                "countsByStatus": {
-                    "$ref": "#/definitions/incident.IncidentStatusCounts"
+                "description": {
+                    "type": "string"
                 },
-                "countsByType": {
-                    "$ref": "#/definitions/incident.IncidentTypeCounts"
+                "name": {
+                    "type": "string"
                 },
-                "totalCount": {
-                    "type": "integer"
+                "permissionIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "incident.IncidentSeverityCounts": {
+        "referring_orgs.CreateReferringOrgRequest": {
             "type": "object",
+            "required": [
+                "contactPerson",
+                "email",
+                "name",
+                "phoneNumber"
+            ],
             "properties": {
-                "minor": {
-                    "type": "integer"
+                "contactPerson": {
+                    "type": "string"
                 },
-                "moderate": {
-                    "type": "integer"
+                "email": {
+                    "type": "string"
                 },
-                "severe": {
-                    "type": "integer"
+                "name": {
+                    "type": "string"
+                },
+                "phoneNumber": {
+                    "type": "string"
                 }
             }
         },
-        "incident.IncidentStatusCounts": {
+        "referring_orgs.CreateReferringOrgResponse": {
             "type": "object",
             "properties": {
-                "completed": {
-                    "type": "integer"
-                },
-                "pending": {
-                    "type": "integer"
-                },
-                "underInvestigation": {
-                    "type": "integer"
+                "id": {
+                    "type": "string"
                 }
             }
         },
-        "incident.IncidentTypeCounts": {
+        "referring_orgs.GetReferringOrgStatsResponse": {
             "type": "object",
             "properties": {
-                "aggression": {
-                    "type": "integer"
-                },
-                "medicalEmergency": {
+                "orgsWithInCareClients": {
                     "type": "integer"
                 },
-                "other": {
+                "orgsWithWaitlistClients": {
                     "type": "integer"
                 },
-                "safetyConcern": {
+                "totalClientsReferred": {
                     "type": "integer"
                 },
-                "unwantedBehavior": {
+                "totalOrgs": {
                     "type": "integer"
                 }
             }
         },
-        "incident.ListIncidentsResponse": {
+        "referring_orgs.ListReferringOrgsResponse": {
             "type": "object",
             "properties": {
-                "actionTaken": {
-                    "type": "string"
-                },
-                "clientFirstName": {
-                    "type": "string"
-                },
-                "clientId": {
-                    "type": "string"
-                },
-                "clientLastName": {
-                    "type": "string"
-                },
-                "coordinatorFirstName": {
-                    "type": "string"
-                },
-                "coordinatorId": {
-                    "type": "string"
-                },
-                "coordinatorLastName": {
+                "contactPerson": {
                     "type": "string"
                 },
                 "createdAt": {
                     "type": "string"
                 },
-                "id": {
-                    "type": "string"
-                },
-                "incidentDate": {
-                    "type": "string"
-                },
-                "incidentDescription": {
-                    "type": "string"
+                "dischargedCount": {
+                    "type": "integer"
                 },
-                "incidentSeverity": {
+                "email": {
                     "type": "string"
                 },
-                "incidentTime": {
+                "id": {
                     "type": "string"
                 },
-                "incidentType": {
-                    "type": "string"
+                "inCareCount": {
+                    "type": "integer"
                 },
-                "locationId": {
+                "name": {
                     "type": "string"
                 },
-                "locationName": {
+                "phoneNumber": {
                     "type": "string"
                 },
-                "otherParties": {
+                "updatedAt": {
                     "type": "string"
                 },
-                "status": {
-                    "type": "string"
+                "waitingListCount": {
+                    "type": "integer"
                 }
             }
         },
-        "incident.UpdateIncidentRequest": {
+        "referring_orgs.UpdateReferringOrgRequest": {
             "type": "object",
             "properties": {
-                "actionTaken": {
-                    "type": "string"
-                },
-                "coordinatorId": {
-                    "type": "string"
-                },
-                "incidentDate": {
+                "contactPerson": {
                     "type": "string"
                 },
-                "incidentDescription": {
+                "email": {
                     "type": "string"
                 },
-                "incidentSeverity": {
-                    "type": "string",
-                    "enum": [
-                        "minor",
-                        "moderate",
-                        "severe"
-                    ]
-                },
-                "incidentTime": {
+                "name": {
                     "type": "string"
                 },
-                "incidentType": {
-                    "type": "string",
-                    "enum": [
-                        "aggression",
-                        "medical_emergency",
-                        "safety_concern",
-                        "unwanted_behavior",
-                        "other"
-                    ]
-                },
-                "locationId": {
+                "phoneNumber": {
                     "type": "string"
-                },
-                "otherParties": {
+                }
+            }
+        },
+        "referring_orgs.UpdateReferringOrgResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
                     "type": "string"
+                }
+            }
+        },
+        "registration.BatchUpdateRegistrationStatusRequest": {
+            "type": "object",
+            "required": [
+                "ids",
+                "status"
+            ],
+            "properties": {
+                "ids": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
                 },
                 "status": {
                     "type": "string",
                     "enum": [
                         "pending",
-                        "under_investigation",
-                        "completed"
+                        "approved",
+                        "rejected",
+                        "in_review"
                     ]
                 }
             }
         },
-        "incident.UpdateIncidentResponse": {
+        "registration.BatchUpdateRegistrationStatusResponse": {
             "type": "object",
             "properties": {
-                "success": {
-                    "type": "boolean"
+                "updatedCount": {
+                    "type": "integer"
                 }
             }
         },
-        "intake.CreateIntakeFormRequest": {
+        "registration.CreateRegistrationFormRequest": {
             "type": "object",
             "required": [
-                "coordinatorId",
-                "intakeDate",
-                "intakeTime",
-                "locationId",
-                "registrationFormId"
+                "bsn",
+                "careType",
+                "dateOfBirth",
+                "firstName",
+                "gender",
+                "lastName",
+                "refferingOrgId",
+                "registrationDate",
+                "registrationReason"
             ],
             "properties": {
-                "coordinatorId": {
-                    "type": "string"
-                },
-                "evaluationIntervalWeeks": {
-                    "type": "integer",
-                    "minimum": 1
-                },
-                "familySituation": {
-                    "type": "string"
-                },
-                "focusAreas": {
+                "additionalNotes": {
                     "type": "string"
                 },
-                "goals": {
+                "attachmentIds": {
                     "type": "array",
-                    "minItems": 1,
                     "items": {
-                        "$ref": "#/definitions/intake.GoalItem"
+                        "type": "string"
                     }
                 },
-                "intakeDate": {
+                "bsn": {
                     "type": "string"
                 },
-                "intakeTime": {
-                    "type": "string"
+                "careType": {
+                    "type": "string",
+                    "enum": [
+                        "protected_living",
+                        "semi_independent_living",
+                        "independent_assisted_living",
+                        "ambulatory_care"
+                    ]
                 },
-                "limitations": {
+                "dateOfBirth": {
+                    "type": "string",
+                    "format": "2006-01-02"
+                },
+                "firstName": {
                     "type": "string"
                 },
-                "locationId": {
+                "gender": {
+                    "type": "string",
+                    "enum": [
+                        "male",
+                        "female",
+                        "other",
+                        "non_binary",
+                        "unknown",
+                        "prefer_not_to_say"
+                    ]
+                },
+                "lastName": {
                     "type": "string"
                 },
-                "mainProvider": {
+                "phoneNumber": {
                     "type": "string"
                 },
-                "notes": {
+                "refferingOrgId": {
                     "type": "string"
                 },
-                "registrationFormId": {
+                "registrationDate": {
+                    "type": "string",
+                    "format": "2006-01-02"
+                },
+                "registrationReason": {
                     "type": "string"
                 }
             }
         },
-        "intake.CreateIntakeFormResponse": {
+        "registration.CreateRegistrationFormResponse": {
             "type": "object",
             "properties": {
+                "existingFormIds": {
+                    "description": "ExistingFormIDs lists the active registration with the same BSN when\nthe create is refused",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "id": {
                     "type": "string"
                 }
             }
         },
-        "intake.GetIntakeFormResponse": {
+        "registration.DeleteRegistrationFormResponse": {
             "type": "object",
             "properties": {
-                "careType": {
+                "id": {
                     "type": "string"
+                }
+            }
+        },
+        "registration.DuplicateBSNResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "an active registration form with this BSN already exists"
                 },
-                "clientBsn": {
-                    "type": "string"
+                "existingFormIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "clientFirstName": {
+                "success": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "registration.GetRegistrationFormResponse": {
+            "type": "object",
+            "properties": {
+                "additionalNotes": {
                     "type": "string"
                 },
-                "clientLastName": {
-                    "type": "string"
+                "attachmentIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "coordinatorFirstName": {
-                    "type": "string"
+                "attachments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/registration.RegistrationAttachmentResponse"
+                    }
                 },
-                "coordinatorId": {
+                "bsn": {
                     "type": "string"
                 },
-                "coordinatorLastName": {
+                "careType": {
                     "type": "string"
                 },
-                "evaluationIntervalWeeks": {
-                    "type": "integer"
+                "createdByUserId": {
+                    "type": "string"
                 },
-                "familySituation": {
+                "dateOfBirth": {
                     "type": "string"
                 },
-                "focusAreas": {
+                "firstName": {
                     "type": "string"
                 },
-                "goals": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/intake.GoalItem"
-                    }
+                "gender": {
+                    "type": "string"
                 },
                 "hasClient": {
                     "type": "boolean"
@@ -8551,31 +13271,31 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
-                "intakeDate": {
-                    "type": "string"
+                "intakeCompleted": {
+                    "type": "boolean"
                 },
-                "intakeTime": {
+                "lastName": {
                     "type": "string"
                 },
-                "limitations": {
+                "orgContactPerson": {
                     "type": "string"
                 },
-                "locationId": {
+                "orgEmail": {
                     "type": "string"
                 },
-                "locationName": {
+                "orgName": {
                     "type": "string"
                 },
-                "mainProvider": {
+                "orgPhoneNumber": {
                     "type": "string"
                 },
-                "notes": {
+                "refferingOrgId": {
                     "type": "string"
                 },
-                "organizationName": {
+                "registrationDate": {
                     "type": "string"
                 },
-                "registrationFormId": {
+                "registrationReason": {
                     "type": "string"
                 },
                 "status": {
@@ -8583,13 +13303,13 @@ const docTemplate = `{
                 }
             }
         },
-        "intake.GetIntakeStatsResponse": {
+        "registration.GetRegistrationStatsResponse": {
             "type": "object",
             "properties": {
-                "conversionPercentage": {
-                    "type": "number"
+                "approvedCount": {
+                    "type": "integer"
                 },
-                "pendingCount": {
+                "inReviewCount": {
                     "type": "integer"
                 },
                 "totalCount": {
@@ -8597,984 +13317,1189 @@ const docTemplate = `{
                 }
             }
         },
-        "intake.GoalItem": {
+        "registration.ListDeletedRegistrationFormsResponse": {
             "type": "object",
-            "required": [
-                "title"
-            ],
             "properties": {
-                "description": {
+                "bsn": {
+                    "type": "string"
+                },
+                "careType": {
+                    "type": "string"
+                },
+                "dateOfBirth": {
+                    "type": "string"
+                },
+                "deletedAt": {
+                    "type": "string"
+                },
+                "firstName": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "title": {
+                "lastName": {
+                    "type": "string"
+                },
+                "registrationDate": {
+                    "type": "string"
+                },
+                "status": {
                     "type": "string"
                 }
             }
         },
-        "intake.ListIntakeFormsResponse": {
+        "registration.ListRegistrationFormsResponse": {
             "type": "object",
             "properties": {
-                "careType": {
+                "additionalNotes": {
                     "type": "string"
                 },
-                "clientBsn": {
+                "bsn": {
                     "type": "string"
                 },
-                "clientFirstName": {
+                "careType": {
                     "type": "string"
                 },
-                "clientLastName": {
+                "dateOfBirth": {
                     "type": "string"
                 },
-                "coordinatorFirstName": {
+                "firstName": {
                     "type": "string"
                 },
-                "coordinatorId": {
+                "id": {
                     "type": "string"
                 },
-                "coordinatorLastName": {
-                    "type": "string"
+                "intakeCompleted": {
+                    "type": "boolean"
                 },
-                "id": {
+                "lastName": {
                     "type": "string"
                 },
-                "intakeDate": {
-                    "type": "string"
+                "numberOfAttachments": {
+                    "type": "integer"
                 },
-                "intakeTime": {
+                "orgContactPerson": {
                     "type": "string"
                 },
-                "locationId": {
+                "orgEmail": {
                     "type": "string"
                 },
-                "locationName": {
+                "orgName": {
                     "type": "string"
                 },
-                "mainProvider": {
+                "orgPhoneNumber": {
                     "type": "string"
                 },
-                "organizationName": {
+                "refferingOrgId": {
                     "type": "string"
                 },
-                "registrationFormId": {
+                "registrationDate": {
                     "type": "string"
                 },
-                "status": {
+                "registrationReason": {
                     "type": "string"
                 },
-                "updatedAt": {
+                "status": {
                     "type": "string"
                 }
             }
         },
-        "intake.UpdateIntakeFormRequest": {
+        "registration.RegistrationAttachmentResponse": {
             "type": "object",
             "properties": {
-                "coordinatorId": {
+                "caption": {
                     "type": "string"
                 },
-                "evaluationIntervalWeeks": {
-                    "type": "integer",
-                    "minimum": 1
+                "contentType": {
+                    "type": "string"
                 },
-                "familySituation": {
+                "filename": {
                     "type": "string"
                 },
-                "focusAreas": {
+                "id": {
                     "type": "string"
                 },
-                "goals": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/intake.GoalItem"
-                    }
+                "sortOrder": {
+                    "type": "integer"
                 },
-                "intakeDate": {
+                "uploadedAt": {
                     "type": "string"
-                },
-                "intakeTime": {
+                }
+            }
+        },
+        "registration.RegistrationStatusChangeResponse": {
+            "type": "object",
+            "properties": {
+                "changedAt": {
                     "type": "string"
                 },
-                "limitations": {
+                "changedBy": {
                     "type": "string"
                 },
-                "locationId": {
+                "id": {
                     "type": "string"
                 },
-                "mainProvider": {
+                "newStatus": {
                     "type": "string"
                 },
-                "notes": {
+                "oldStatus": {
                     "type": "string"
-                },
-                "status": {
-                    "type": "string",
-                    "enum": [
-                        "completed",
-                        "pending"
-                    ]
                 }
             }
         },
-        "intake.UpdateIntakeFormResponse": {
+        "registration.ReorderRegistrationAttachmentsRequest": {
+            "type": "object",
+            "required": [
+                "attachmentIds"
+            ],
+            "properties": {
+                "attachmentIds": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "registration.RestoreRegistrationFormResponse": {
             "type": "object",
             "properties": {
+                "existingFormIds": {
+                    "description": "ExistingFormIDs lists the active forms that block the restore",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "id": {
                     "type": "string"
                 }
             }
         },
-        "location_transfer.GetLocationTransferStatsResponse": {
+        "registration.UpdateRegistrationAttachmentCaptionRequest": {
             "type": "object",
             "properties": {
-                "approvalRate": {
-                    "type": "number"
-                },
-                "countsByStatus": {
-                    "$ref": "#/definitions/location_transfer.TransferStatusCounts"
-                },
-                "pendingCount": {
-                    "type": "integer"
-                },
-                "totalCount": {
-                    "type": "integer"
+                "caption": {
+                    "type": "string",
+                    "maxLength": 500
                 }
             }
         },
-        "location_transfer.ListLocationTransfersResponse": {
+        "registration.UpdateRegistrationFormRequest": {
             "type": "object",
             "properties": {
-                "clientFirstName": {
-                    "type": "string"
-                },
-                "clientId": {
-                    "type": "string"
-                },
-                "clientLastName": {
+                "additionalNotes": {
                     "type": "string"
                 },
-                "currentCoordinatorFirstName": {
-                    "type": "string"
+                "attachmentIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "currentCoordinatorId": {
+                "bsn": {
                     "type": "string"
                 },
-                "currentCoordinatorLastName": {
-                    "type": "string"
+                "careType": {
+                    "type": "string",
+                    "enum": [
+                        "protected_living",
+                        "semi_independent_living",
+                        "independent_assisted_living",
+                        "ambulatory_care"
+                    ]
                 },
-                "fromLocationId": {
-                    "type": "string"
+                "dateOfBirth": {
+                    "type": "string",
+                    "format": "2006-01-02"
                 },
-                "fromLocationName": {
+                "firstName": {
                     "type": "string"
                 },
-                "id": {
-                    "type": "string"
+                "gender": {
+                    "type": "string",
+                    "enum": [
+                        "male",
+                        "female",
+                        "other",
+                        "non_binary",
+                        "unknown",
+                        "prefer_not_to_say"
+                    ]
                 },
-                "newCoordinatorFirstName": {
+                "lastName": {
                     "type": "string"
                 },
-                "newCoordinatorId": {
+                "phoneNumber": {
                     "type": "string"
                 },
-                "newCoordinatorLastName": {
+                "refferingOrgId": {
                     "type": "string"
                 },
-                "reason": {
-                    "type": "string"
+                "registrationDate": {
+                    "type": "string",
+                    "format": "2006-01-02"
                 },
-                "rejectionReason": {
+                "registrationReason": {
                     "type": "string"
                 },
                 "status": {
-                    "type": "string"
-                },
-                "toLocationId": {
-                    "type": "string"
-                },
-                "toLocationName": {
-                    "type": "string"
-                },
-                "transferDate": {
-                    "description": "or time.Time, but for JSON, string",
-                    "type": "string"
-                }
-            }
-        },
-        "location_transfer.RefuseLocationTransferRequest": {
-            "type": "object",
-            "required": [
-                "reason"
-            ],
-            "properties": {
-                "reason": {
-                    "type": "string"
-                }
-            }
-        },
-        "location_transfer.RegisterLocationTransferRequest": {
-            "type": "object",
-            "required": [
-                "clientId",
-                "newCoordinatorId",
-                "newLocationId"
-            ],
-            "properties": {
-                "clientId": {
-                    "type": "string"
-                },
-                "newCoordinatorId": {
-                    "type": "string"
-                },
-                "newLocationId": {
-                    "type": "string"
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "approved",
+                        "rejected",
+                        "in_review"
+                    ]
                 }
             }
         },
-        "location_transfer.RegisterLocationTransferResponse": {
+        "registration.UpdateRegistrationFormResponse": {
             "type": "object",
             "properties": {
-                "transferId": {
+                "id": {
                     "type": "string"
                 }
             }
         },
-        "location_transfer.TransferStatusCounts": {
+        "resp.ErrorResponse": {
             "type": "object",
             "properties": {
-                "approved": {
-                    "type": "integer"
-                },
-                "pending": {
-                    "type": "integer"
+                "error": {
+                    "type": "string",
+                    "example": "error message"
                 },
-                "rejected": {
-                    "type": "integer"
+                "success": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "location_transfer.UpdateLocationTransferRequest": {
+        "resp.MessageResponse": {
             "type": "object",
             "properties": {
-                "newCoordinatorId": {
-                    "type": "string"
-                },
-                "newLocationId": {
-                    "type": "string"
+                "message": {
+                    "type": "string",
+                    "example": "success message"
                 },
-                "reason": {
-                    "type": "string"
+                "success": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "locations.CreateLocationRequest": {
+        "resp.PaginationResponse-array_audit_AuditLogResponse": {
             "type": "object",
-            "required": [
-                "address",
-                "name",
-                "postalCode"
-            ],
             "properties": {
-                "address": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "$ref": "#/definitions/audit.AuditLogResponse"
+                        }
+                    }
+                },
+                "nextCursor": {
+                    "description": "NextCursor is only set on keyset-paginated responses that have more rows",
                     "type": "string"
                 },
-                "capacity": {
-                    "type": "integer",
-                    "minimum": 1
+                "page": {
+                    "type": "integer"
                 },
-                "name": {
-                    "type": "string"
+                "pageSize": {
+                    "type": "integer"
                 },
-                "occupied": {
-                    "type": "integer",
-                    "minimum": 0
+                "totalCount": {
+                    "type": "integer"
                 },
-                "postalCode": {
-                    "type": "string"
+                "totalPages": {
+                    "type": "integer"
                 }
             }
         },
-        "locations.CreateLocationResponse": {
+        "resp.PaginationResponse-array_calendar_ListAppointmentsResponse": {
             "type": "object",
             "properties": {
-                "id": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "$ref": "#/definitions/calendar.ListAppointmentsResponse"
+                        }
+                    }
+                },
+                "nextCursor": {
+                    "description": "NextCursor is only set on keyset-paginated responses that have more rows",
                     "type": "string"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "pageSize": {
+                    "type": "integer"
+                },
+                "totalCount": {
+                    "type": "integer"
+                },
+                "totalPages": {
+                    "type": "integer"
                 }
             }
         },
-        "locations.DeleteLocationResponse": {
-            "type": "object",
-            "properties": {
-                "success": {
-                    "type": "boolean"
-                }
-            }
-        },
-        "locations.GetLocationCapacityStatsResponse": {
+        "resp.PaginationResponse-array_client_ListDischargedClientsResponse": {
             "type": "object",
             "properties": {
-                "capacityUsed": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "$ref": "#/definitions/client.ListDischargedClientsResponse"
+                        }
+                    }
+                },
+                "nextCursor": {
+                    "description": "NextCursor is only set on keyset-paginated responses that have more rows",
+                    "type": "string"
+                },
+                "page": {
                     "type": "integer"
                 },
-                "freeCapacity": {
+                "pageSize": {
                     "type": "integer"
                 },
-                "totalCapacity": {
+                "totalCount": {
+                    "type": "integer"
+                },
+                "totalPages": {
                     "type": "integer"
                 }
             }
         },
-        "locations.ListLocationsResponse": {
+        "resp.PaginationResponse-array_client_ListInCareClientsResponse": {
             "type": "object",
             "properties": {
-                "address": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "$ref": "#/definitions/client.ListInCareClientsResponse"
+                        }
+                    }
+                },
+                "nextCursor": {
+                    "description": "NextCursor is only set on keyset-paginated responses that have more rows",
                     "type": "string"
                 },
-                "capacity": {
+                "page": {
                     "type": "integer"
                 },
-                "id": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
+                "pageSize": {
+                    "type": "integer"
                 },
-                "occupied": {
+                "totalCount": {
                     "type": "integer"
                 },
-                "postalCode": {
-                    "type": "string"
+                "totalPages": {
+                    "type": "integer"
                 }
             }
         },
-        "locations.UpdateLocationRequest": {
+        "resp.PaginationResponse-array_client_ListWaitingListClientsResponse": {
             "type": "object",
             "properties": {
-                "address": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "$ref": "#/definitions/client.ListWaitingListClientsResponse"
+                        }
+                    }
+                },
+                "nextCursor": {
+                    "description": "NextCursor is only set on keyset-paginated responses that have more rows",
                     "type": "string"
                 },
-                "capacity": {
-                    "type": "integer",
-                    "minimum": 1
+                "page": {
+                    "type": "integer"
                 },
-                "name": {
-                    "type": "string"
+                "pageSize": {
+                    "type": "integer"
                 },
-                "occupied": {
-                    "type": "integer",
-                    "minimum": 0
+                "totalCount": {
+                    "type": "integer"
                 },
-                "postalCode": {
-                    "type": "string"
+                "totalPages": {
+                    "type": "integer"
                 }
             }
         },
-        "locations.UpdateLocationResponse": {
+        "resp.PaginationResponse-array_client_SearchClientsResponse": {
             "type": "object",
             "properties": {
-                "success": {
-                    "type": "boolean"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "$ref": "#/definitions/client.SearchClientsResponse"
+                        }
+                    }
+                },
+                "nextCursor": {
+                    "description": "NextCursor is only set on keyset-paginated responses that have more rows",
+                    "type": "string"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "pageSize": {
+                    "type": "integer"
+                },
+                "totalCount": {
+                    "type": "integer"
+                },
+                "totalPages": {
+                    "type": "integer"
                 }
             }
         },
-        "notification.NotificationResponse": {
+        "resp.PaginationResponse-array_employee_ListEmployeesResponse": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "$ref": "#/definitions/employee.ListEmployeesResponse"
+                        }
+                    }
                 },
-                "id": {
+                "nextCursor": {
+                    "description": "NextCursor is only set on keyset-paginated responses that have more rows",
                     "type": "string"
                 },
-                "is_read": {
-                    "type": "boolean"
+                "page": {
+                    "type": "integer"
                 },
-                "message": {
-                    "type": "string"
+                "pageSize": {
+                    "type": "integer"
                 },
-                "priority": {
-                    "type": "string"
+                "totalCount": {
+                    "type": "integer"
                 },
-                "read_at": {
-                    "type": "string"
+                "totalPages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "resp.PaginationResponse-array_incident_ListIncidentsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "$ref": "#/definitions/incident.ListIncidentsResponse"
+                        }
+                    }
                 },
-                "resource_id": {
+                "nextCursor": {
+                    "description": "NextCursor is only set on keyset-paginated responses that have more rows",
                     "type": "string"
                 },
-                "resource_type": {
-                    "type": "string"
+                "page": {
+                    "type": "integer"
                 },
-                "title": {
-                    "type": "string"
+                "pageSize": {
+                    "type": "integer"
                 },
-                "type": {
-                    "type": "string"
-                }
-            }
-        },
-        "notification.UnreadCountResponse": {
-            "type": "object",
-            "properties": {
-                "count": {
+                "totalCount": {
+                    "type": "integer"
+                },
+                "totalPages": {
                     "type": "integer"
                 }
             }
         },
-        "notification.WSAuthResponse": {
+        "resp.PaginationResponse-array_locations_ListLocationsResponse": {
             "type": "object",
             "properties": {
-                "ticket": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "$ref": "#/definitions/locations.ListLocationsResponse"
+                        }
+                    }
+                },
+                "nextCursor": {
+                    "description": "NextCursor is only set on keyset-paginated responses that have more rows",
                     "type": "string"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "pageSize": {
+                    "type": "integer"
+                },
+                "totalCount": {
+                    "type": "integer"
+                },
+                "totalPages": {
+                    "type": "integer"
                 }
             }
         },
-        "rbac.AssignPermissionRequest": {
+        "resp.PaginationResponse-array_notification_NotificationResponse": {
             "type": "object",
-            "required": [
-                "permissionId"
-            ],
             "properties": {
-                "permissionId": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "$ref": "#/definitions/notification.NotificationResponse"
+                        }
+                    }
+                },
+                "nextCursor": {
+                    "description": "NextCursor is only set on keyset-paginated responses that have more rows",
                     "type": "string"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "pageSize": {
+                    "type": "integer"
+                },
+                "totalCount": {
+                    "type": "integer"
+                },
+                "totalPages": {
+                    "type": "integer"
                 }
             }
         },
-        "rbac.AssignRoleToUserRequest": {
+        "resp.PaginationResponse-evaluation_DraftEvaluationListItem": {
             "type": "object",
-            "required": [
-                "roleId",
-                "userId"
-            ],
             "properties": {
-                "roleId": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/evaluation.DraftEvaluationListItem"
+                    }
                 },
-                "userId": {
+                "nextCursor": {
+                    "description": "NextCursor is only set on keyset-paginated responses that have more rows",
                     "type": "string"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "pageSize": {
+                    "type": "integer"
+                },
+                "totalCount": {
+                    "type": "integer"
+                },
+                "totalPages": {
+                    "type": "integer"
                 }
             }
         },
-        "rbac.CreateRoleRequest": {
+        "resp.PaginationResponse-evaluation_EvaluationRecordListItem": {
             "type": "object",
-            "required": [
-                "name"
-            ],
             "properties": {
-                "description": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "permissionIds": {
+                "data": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/evaluation.EvaluationRecordListItem"
                     }
+                },
+                "nextCursor": {
+                    "description": "NextCursor is only set on keyset-paginated responses that have more rows",
+                    "type": "string"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "pageSize": {
+                    "type": "integer"
+                },
+                "totalCount": {
+                    "type": "integer"
+                },
+                "totalPages": {
+                    "type": "integer"
                 }
             }
         },
-        "rbac.CreateRoleResponse": {
+        "resp.PaginationResponse-evaluation_GlobalRecentEvaluationItem": {
             "type": "object",
             "properties": {
-                "description": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/evaluation.GlobalRecentEvaluationItem"
+                    }
                 },
-                "id": {
+                "nextCursor": {
+                    "description": "NextCursor is only set on keyset-paginated responses that have more rows",
                     "type": "string"
                 },
-                "name": {
-                    "type": "string"
+                "page": {
+                    "type": "integer"
+                },
+                "pageSize": {
+                    "type": "integer"
+                },
+                "totalCount": {
+                    "type": "integer"
+                },
+                "totalPages": {
+                    "type": "integer"
                 }
             }
         },
-        "rbac.PermissionResponse": {
+        "resp.PaginationResponse-evaluation_OverdueEvaluationItem": {
             "type": "object",
             "properties": {
-                "action": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/evaluation.OverdueEvaluationItem"
+                    }
                 },
-                "description": {
+                "nextCursor": {
+                    "description": "NextCursor is only set on keyset-paginated responses that have more rows",
                     "type": "string"
                 },
-                "id": {
-                    "type": "string"
+                "page": {
+                    "type": "integer"
                 },
-                "resource": {
-                    "type": "string"
+                "pageSize": {
+                    "type": "integer"
+                },
+                "totalCount": {
+                    "type": "integer"
+                },
+                "totalPages": {
+                    "type": "integer"
                 }
             }
         },
-        "rbac.RoleListItem": {
+        "resp.PaginationResponse-evaluation_UpcomingEvaluationItem": {
             "type": "object",
             "properties": {
-                "description": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/evaluation.UpcomingEvaluationItem"
+                    }
                 },
-                "id": {
+                "nextCursor": {
+                    "description": "NextCursor is only set on keyset-paginated responses that have more rows",
                     "type": "string"
                 },
-                "name": {
-                    "type": "string"
+                "page": {
+                    "type": "integer"
                 },
-                "permissionCount": {
+                "pageSize": {
                     "type": "integer"
                 },
-                "userCount": {
+                "totalCount": {
+                    "type": "integer"
+                },
+                "totalPages": {
                     "type": "integer"
                 }
             }
         },
-        "rbac.RoleResponse": {
+        "resp.PaginationResponse-intake_ListIntakeFormsResponse": {
             "type": "object",
             "properties": {
-                "description": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/intake.ListIntakeFormsResponse"
+                    }
                 },
-                "id": {
+                "nextCursor": {
+                    "description": "NextCursor is only set on keyset-paginated responses that have more rows",
                     "type": "string"
                 },
-                "name": {
-                    "type": "string"
+                "page": {
+                    "type": "integer"
                 },
-                "permissions": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/rbac.PermissionResponse"
-                    }
+                "pageSize": {
+                    "type": "integer"
+                },
+                "totalCount": {
+                    "type": "integer"
+                },
+                "totalPages": {
+                    "type": "integer"
                 }
             }
         },
-        "rbac.UpdateRoleRequest": {
+        "resp.PaginationResponse-location_transfer_ListLocationTransfersResponse": {
             "type": "object",
-            "required": [
-                "name"
-            ],
             "properties": {
-                "description": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "permissionIds": {
+                "data": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/location_transfer.ListLocationTransfersResponse"
                     }
+                },
+                "nextCursor": {
+                    "description": "NextCursor is only set on keyset-paginated responses that have more rows",
+                    "type": "string"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "pageSize": {
+                    "type": "integer"
+                },
+                "totalCount": {
+                    "type": "integer"
+                },
+                "totalPages": {
+                    "type": "integer"
                 }
             }
         },
-        "referring_orgs.CreateReferringOrgRequest": {
+        "resp.PaginationResponse-rbac_PermissionResponse": {
             "type": "object",
-            "required": [
-                "contactPerson",
-                "email",
-                "name",
-                "phoneNumber"
-            ],
             "properties": {
-                "contactPerson": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/rbac.PermissionResponse"
+                    }
                 },
-                "email": {
+                "nextCursor": {
+                    "description": "NextCursor is only set on keyset-paginated responses that have more rows",
                     "type": "string"
                 },
-                "name": {
-                    "type": "string"
+                "page": {
+                    "type": "integer"
                 },
-                "phoneNumber": {
-                    "type": "string"
+                "pageSize": {
+                    "type": "integer"
+                },
+                "totalCount": {
+                    "type": "integer"
+                },
+                "totalPages": {
+                    "type": "integer"
                 }
             }
         },
-        "referring_orgs.CreateReferringOrgResponse": {
+        "resp.PaginationResponse-rbac_RoleListItem": {
             "type": "object",
             "properties": {
-                "id": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/rbac.RoleListItem"
+                    }
+                },
+                "nextCursor": {
+                    "description": "NextCursor is only set on keyset-paginated responses that have more rows",
                     "type": "string"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "pageSize": {
+                    "type": "integer"
+                },
+                "totalCount": {
+                    "type": "integer"
+                },
+                "totalPages": {
+                    "type": "integer"
                 }
             }
         },
-        "referring_orgs.GetReferringOrgStatsResponse": {
+        "resp.PaginationResponse-referring_orgs_ListReferringOrgsResponse": {
             "type": "object",
             "properties": {
-                "orgsWithInCareClients": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/referring_orgs.ListReferringOrgsResponse"
+                    }
+                },
+                "nextCursor": {
+                    "description": "NextCursor is only set on keyset-paginated responses that have more rows",
+                    "type": "string"
+                },
+                "page": {
                     "type": "integer"
                 },
-                "orgsWithWaitlistClients": {
+                "pageSize": {
                     "type": "integer"
                 },
-                "totalClientsReferred": {
+                "totalCount": {
                     "type": "integer"
                 },
-                "totalOrgs": {
+                "totalPages": {
                     "type": "integer"
                 }
             }
         },
-        "referring_orgs.ListReferringOrgsResponse": {
+        "resp.PaginationResponse-registration_ListDeletedRegistrationFormsResponse": {
             "type": "object",
             "properties": {
-                "contactPerson": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/registration.ListDeletedRegistrationFormsResponse"
+                    }
                 },
-                "createdAt": {
+                "nextCursor": {
+                    "description": "NextCursor is only set on keyset-paginated responses that have more rows",
                     "type": "string"
                 },
-                "dischargedCount": {
+                "page": {
                     "type": "integer"
                 },
-                "email": {
-                    "type": "string"
+                "pageSize": {
+                    "type": "integer"
                 },
-                "id": {
-                    "type": "string"
+                "totalCount": {
+                    "type": "integer"
                 },
-                "inCareCount": {
+                "totalPages": {
                     "type": "integer"
+                }
+            }
+        },
+        "resp.PaginationResponse-registration_ListRegistrationFormsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/registration.ListRegistrationFormsResponse"
+                    }
                 },
-                "name": {
+                "nextCursor": {
+                    "description": "NextCursor is only set on keyset-paginated responses that have more rows",
                     "type": "string"
                 },
-                "phoneNumber": {
-                    "type": "string"
+                "page": {
+                    "type": "integer"
                 },
-                "updatedAt": {
-                    "type": "string"
+                "pageSize": {
+                    "type": "integer"
                 },
-                "waitingListCount": {
+                "totalCount": {
+                    "type": "integer"
+                },
+                "totalPages": {
                     "type": "integer"
                 }
             }
         },
-        "referring_orgs.UpdateReferringOrgRequest": {
+        "resp.SuccessResponse-any": {
             "type": "object",
             "properties": {
-                "contactPerson": {
-                    "type": "string"
+                "data": {},
+                "message": {
+                    "type": "string",
+                    "example": "success message"
                 },
-                "email": {
-                    "type": "string"
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "resp.SuccessResponse-array_attachments_AttachmentAccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/attachments.AttachmentAccessResponse"
+                    }
                 },
-                "name": {
-                    "type": "string"
+                "message": {
+                    "type": "string",
+                    "example": "success message"
                 },
-                "phoneNumber": {
-                    "type": "string"
+                "success": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "referring_orgs.UpdateReferringOrgResponse": {
+        "resp.SuccessResponse-array_calendar_CalendarEvent": {
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/calendar.CalendarEvent"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "success message"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "registration.CreateRegistrationFormRequest": {
+        "resp.SuccessResponse-array_calendar_ReminderResponse": {
             "type": "object",
-            "required": [
-                "bsn",
-                "careType",
-                "dateOfBirth",
-                "firstName",
-                "gender",
-                "lastName",
-                "refferingOrgId",
-                "registrationDate",
-                "registrationReason"
-            ],
             "properties": {
-                "additionalNotes": {
-                    "type": "string"
-                },
-                "attachmentIds": {
+                "data": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/calendar.ReminderResponse"
                     }
                 },
-                "bsn": {
-                    "type": "string"
-                },
-                "careType": {
+                "message": {
                     "type": "string",
-                    "enum": [
-                        "protected_living",
-                        "semi_independent_living",
-                        "independent_assisted_living",
-                        "ambulatory_care"
-                    ]
+                    "example": "success message"
                 },
-                "dateOfBirth": {
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "resp.SuccessResponse-array_client_ClientNoteResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/client.ClientNoteResponse"
+                    }
+                },
+                "message": {
                     "type": "string",
-                    "format": "2006-01-02"
+                    "example": "success message"
                 },
-                "firstName": {
-                    "type": "string"
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "resp.SuccessResponse-array_client_DischargeTrendMonth": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/client.DischargeTrendMonth"
+                    }
                 },
-                "gender": {
+                "message": {
                     "type": "string",
-                    "enum": [
-                        "male",
-                        "female",
-                        "other"
-                    ]
+                    "example": "success message"
                 },
-                "lastName": {
-                    "type": "string"
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "resp.SuccessResponse-array_client_ListClientGoalsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/client.ListClientGoalsResponse"
+                    }
                 },
-                "phoneNumber": {
-                    "type": "string"
+                "message": {
+                    "type": "string",
+                    "example": "success message"
                 },
-                "refferingOrgId": {
-                    "type": "string"
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "resp.SuccessResponse-array_client_PlacementSuggestionResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/client.PlacementSuggestionResponse"
+                    }
                 },
-                "registrationDate": {
+                "message": {
                     "type": "string",
-                    "format": "2006-01-02"
+                    "example": "success message"
                 },
-                "registrationReason": {
-                    "type": "string"
+                "success": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "registration.CreateRegistrationFormResponse": {
+        "resp.SuccessResponse-array_evaluation_EvaluationHistoryItem": {
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/evaluation.EvaluationHistoryItem"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "success message"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "registration.DeleteRegistrationFormResponse": {
+        "resp.SuccessResponse-array_incident_IncidentTrendMonth": {
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/incident.IncidentTrendMonth"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "success message"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "registration.GetRegistrationFormResponse": {
+        "resp.SuccessResponse-array_intake_IntakeDocumentResponse": {
             "type": "object",
             "properties": {
-                "additionalNotes": {
-                    "type": "string"
-                },
-                "attachmentIds": {
+                "data": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/intake.IntakeDocumentResponse"
                     }
                 },
-                "bsn": {
-                    "type": "string"
-                },
-                "careType": {
-                    "type": "string"
-                },
-                "dateOfBirth": {
-                    "type": "string"
-                },
-                "firstName": {
-                    "type": "string"
-                },
-                "gender": {
-                    "type": "string"
-                },
-                "hasClient": {
-                    "type": "boolean"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "intakeCompleted": {
-                    "type": "boolean"
-                },
-                "lastName": {
-                    "type": "string"
-                },
-                "orgContactPerson": {
-                    "type": "string"
-                },
-                "orgEmail": {
-                    "type": "string"
-                },
-                "orgName": {
-                    "type": "string"
-                },
-                "orgPhoneNumber": {
-                    "type": "string"
-                },
-                "refferingOrgId": {
-                    "type": "string"
-                },
-                "registrationDate": {
-                    "type": "string"
-                },
-                "registrationReason": {
-                    "type": "string"
+                "message": {
+                    "type": "string",
+                    "example": "success message"
                 },
-                "status": {
-                    "type": "string"
+                "success": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "registration.GetRegistrationStatsResponse": {
+        "resp.SuccessResponse-array_intake_IntakeRescheduleResponse": {
             "type": "object",
             "properties": {
-                "approvedCount": {
-                    "type": "integer"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/intake.IntakeRescheduleResponse"
+                    }
                 },
-                "inReviewCount": {
-                    "type": "integer"
+                "message": {
+                    "type": "string",
+                    "example": "success message"
                 },
-                "totalCount": {
-                    "type": "integer"
+                "success": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "registration.ListRegistrationFormsResponse": {
+        "resp.SuccessResponse-array_locations_LocationCapacityPoint": {
             "type": "object",
             "properties": {
-                "additionalNotes": {
-                    "type": "string"
-                },
-                "bsn": {
-                    "type": "string"
-                },
-                "careType": {
-                    "type": "string"
-                },
-                "dateOfBirth": {
-                    "type": "string"
-                },
-                "firstName": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "intakeCompleted": {
-                    "type": "boolean"
-                },
-                "lastName": {
-                    "type": "string"
-                },
-                "numberOfAttachments": {
-                    "type": "integer"
-                },
-                "orgContactPerson": {
-                    "type": "string"
-                },
-                "orgEmail": {
-                    "type": "string"
-                },
-                "orgName": {
-                    "type": "string"
-                },
-                "orgPhoneNumber": {
-                    "type": "string"
-                },
-                "refferingOrgId": {
-                    "type": "string"
-                },
-                "registrationDate": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/locations.LocationCapacityPoint"
+                    }
                 },
-                "registrationReason": {
-                    "type": "string"
+                "message": {
+                    "type": "string",
+                    "example": "success message"
                 },
-                "status": {
-                    "type": "string"
+                "success": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "registration.UpdateRegistrationFormRequest": {
+        "resp.SuccessResponse-array_registration_RegistrationStatusChangeResponse": {
             "type": "object",
             "properties": {
-                "additionalNotes": {
-                    "type": "string"
-                },
-                "attachmentIds": {
+                "data": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/registration.RegistrationStatusChangeResponse"
                     }
                 },
-                "bsn": {
-                    "type": "string"
-                },
-                "careType": {
-                    "type": "string",
-                    "enum": [
-                        "protected_living",
-                        "semi_independent_living",
-                        "independent_assisted_living",
-                        "ambulatory_care"
-                    ]
-                },
-                "dateOfBirth": {
-                    "type": "string",
-                    "format": "2006-01-02"
-                },
-                "firstName": {
-                    "type": "string"
-                },
-                "gender": {
-                    "type": "string",
-                    "enum": [
-                        "male",
-                        "female",
-                        "other"
-                    ]
-                },
-                "lastName": {
-                    "type": "string"
-                },
-                "phoneNumber": {
-                    "type": "string"
-                },
-                "refferingOrgId": {
-                    "type": "string"
-                },
-                "registrationDate": {
+                "message": {
                     "type": "string",
-                    "format": "2006-01-02"
-                },
-                "registrationReason": {
-                    "type": "string"
+                    "example": "success message"
                 },
-                "status": {
-                    "type": "string",
-                    "enum": [
-                        "pending",
-                        "approved",
-                        "rejected",
-                        "in_review"
-                    ]
+                "success": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "registration.UpdateRegistrationFormResponse": {
+        "resp.SuccessResponse-attachments_DownloadAttachmentResponse": {
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "string"
+                "data": {
+                    "$ref": "#/definitions/attachments.DownloadAttachmentResponse"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "success message"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "resp.ErrorResponse": {
+        "resp.SuccessResponse-audit_AuditLogResponse": {
             "type": "object",
             "properties": {
-                "error": {
+                "data": {
+                    "$ref": "#/definitions/audit.AuditLogResponse"
+                },
+                "message": {
                     "type": "string",
-                    "example": "error message"
+                    "example": "success message"
                 },
                 "success": {
                     "type": "boolean",
@@ -9582,9 +14507,12 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.MessageResponse": {
+        "resp.SuccessResponse-audit_AuditLogStatsResponse": {
             "type": "object",
             "properties": {
+                "data": {
+                    "$ref": "#/definitions/audit.AuditLogStatsResponse"
+                },
                 "message": {
                     "type": "string",
                     "example": "success message"
@@ -9595,425 +14523,300 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.PaginationResponse-array_audit_AuditLogResponse": {
+        "resp.SuccessResponse-audit_ChainVerificationResult": {
             "type": "object",
             "properties": {
                 "data": {
-                    "type": "array",
-                    "items": {
-                        "type": "array",
-                        "items": {
-                            "$ref": "#/definitions/audit.AuditLogResponse"
-                        }
-                    }
-                },
-                "page": {
-                    "type": "integer"
-                },
-                "pageSize": {
-                    "type": "integer"
+                    "$ref": "#/definitions/audit.ChainVerificationResult"
                 },
-                "totalCount": {
-                    "type": "integer"
+                "message": {
+                    "type": "string",
+                    "example": "success message"
                 },
-                "totalPages": {
-                    "type": "integer"
+                "success": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "resp.PaginationResponse-array_client_ListDischargedClientsResponse": {
+        "resp.SuccessResponse-auth_EnableMFAResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "type": "array",
-                    "items": {
-                        "type": "array",
-                        "items": {
-                            "$ref": "#/definitions/client.ListDischargedClientsResponse"
-                        }
-                    }
-                },
-                "page": {
-                    "type": "integer"
-                },
-                "pageSize": {
-                    "type": "integer"
+                    "$ref": "#/definitions/auth.EnableMFAResponse"
                 },
-                "totalCount": {
-                    "type": "integer"
+                "message": {
+                    "type": "string",
+                    "example": "success message"
                 },
-                "totalPages": {
-                    "type": "integer"
+                "success": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "resp.PaginationResponse-array_client_ListInCareClientsResponse": {
+        "resp.SuccessResponse-auth_LoginResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "type": "array",
-                    "items": {
-                        "type": "array",
-                        "items": {
-                            "$ref": "#/definitions/client.ListInCareClientsResponse"
-                        }
-                    }
-                },
-                "page": {
-                    "type": "integer"
-                },
-                "pageSize": {
-                    "type": "integer"
+                    "$ref": "#/definitions/auth.LoginResponse"
                 },
-                "totalCount": {
-                    "type": "integer"
+                "message": {
+                    "type": "string",
+                    "example": "success message"
                 },
-                "totalPages": {
-                    "type": "integer"
+                "success": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "resp.PaginationResponse-array_client_ListWaitingListClientsResponse": {
+        "resp.SuccessResponse-auth_RefreshTokensResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "type": "array",
-                    "items": {
-                        "type": "array",
-                        "items": {
-                            "$ref": "#/definitions/client.ListWaitingListClientsResponse"
-                        }
-                    }
-                },
-                "page": {
-                    "type": "integer"
-                },
-                "pageSize": {
-                    "type": "integer"
+                    "$ref": "#/definitions/auth.RefreshTokensResponse"
                 },
-                "totalCount": {
-                    "type": "integer"
+                "message": {
+                    "type": "string",
+                    "example": "success message"
                 },
-                "totalPages": {
-                    "type": "integer"
+                "success": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "resp.PaginationResponse-array_employee_ListEmployeesResponse": {
+        "resp.SuccessResponse-auth_SetupMFAResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "type": "array",
-                    "items": {
-                        "type": "array",
-                        "items": {
-                            "$ref": "#/definitions/employee.ListEmployeesResponse"
-                        }
-                    }
-                },
-                "page": {
-                    "type": "integer"
-                },
-                "pageSize": {
-                    "type": "integer"
+                    "$ref": "#/definitions/auth.SetupMFAResponse"
                 },
-                "totalCount": {
-                    "type": "integer"
+                "message": {
+                    "type": "string",
+                    "example": "success message"
                 },
-                "totalPages": {
-                    "type": "integer"
+                "success": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "resp.PaginationResponse-array_incident_ListIncidentsResponse": {
+        "resp.SuccessResponse-auth_VerifyMFAResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "type": "array",
-                    "items": {
-                        "type": "array",
-                        "items": {
-                            "$ref": "#/definitions/incident.ListIncidentsResponse"
-                        }
-                    }
-                },
-                "page": {
-                    "type": "integer"
-                },
-                "pageSize": {
-                    "type": "integer"
+                    "$ref": "#/definitions/auth.VerifyMFAResponse"
                 },
-                "totalCount": {
-                    "type": "integer"
+                "message": {
+                    "type": "string",
+                    "example": "success message"
                 },
-                "totalPages": {
-                    "type": "integer"
+                "success": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "resp.PaginationResponse-array_locations_ListLocationsResponse": {
+        "resp.SuccessResponse-calendar_AppointmentResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "type": "array",
-                    "items": {
-                        "type": "array",
-                        "items": {
-                            "$ref": "#/definitions/locations.ListLocationsResponse"
-                        }
-                    }
-                },
-                "page": {
-                    "type": "integer"
-                },
-                "pageSize": {
-                    "type": "integer"
+                    "$ref": "#/definitions/calendar.AppointmentResponse"
                 },
-                "totalCount": {
-                    "type": "integer"
+                "message": {
+                    "type": "string",
+                    "example": "success message"
                 },
-                "totalPages": {
-                    "type": "integer"
+                "success": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "resp.PaginationResponse-array_notification_NotificationResponse": {
+        "resp.SuccessResponse-calendar_ReminderResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "type": "array",
-                    "items": {
-                        "type": "array",
-                        "items": {
-                            "$ref": "#/definitions/notification.NotificationResponse"
-                        }
-                    }
-                },
-                "page": {
-                    "type": "integer"
-                },
-                "pageSize": {
-                    "type": "integer"
+                    "$ref": "#/definitions/calendar.ReminderResponse"
                 },
-                "totalCount": {
-                    "type": "integer"
+                "message": {
+                    "type": "string",
+                    "example": "success message"
                 },
-                "totalPages": {
-                    "type": "integer"
+                "success": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "resp.PaginationResponse-evaluation_DraftEvaluationListItem": {
+        "resp.SuccessResponse-client_ClientNoteResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/evaluation.DraftEvaluationListItem"
-                    }
-                },
-                "page": {
-                    "type": "integer"
-                },
-                "pageSize": {
-                    "type": "integer"
+                    "$ref": "#/definitions/client.ClientNoteResponse"
                 },
-                "totalCount": {
-                    "type": "integer"
+                "message": {
+                    "type": "string",
+                    "example": "success message"
                 },
-                "totalPages": {
-                    "type": "integer"
+                "success": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "resp.PaginationResponse-evaluation_GlobalRecentEvaluationItem": {
+        "resp.SuccessResponse-client_CompleteDischargeResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/evaluation.GlobalRecentEvaluationItem"
-                    }
-                },
-                "page": {
-                    "type": "integer"
-                },
-                "pageSize": {
-                    "type": "integer"
+                    "$ref": "#/definitions/client.CompleteDischargeResponse"
                 },
-                "totalCount": {
-                    "type": "integer"
+                "message": {
+                    "type": "string",
+                    "example": "success message"
                 },
-                "totalPages": {
-                    "type": "integer"
+                "success": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "resp.PaginationResponse-evaluation_UpcomingEvaluationItem": {
+        "resp.SuccessResponse-client_GetClientResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/evaluation.UpcomingEvaluationItem"
-                    }
-                },
-                "page": {
-                    "type": "integer"
-                },
-                "pageSize": {
-                    "type": "integer"
+                    "$ref": "#/definitions/client.GetClientResponse"
                 },
-                "totalCount": {
-                    "type": "integer"
+                "message": {
+                    "type": "string",
+                    "example": "success message"
                 },
-                "totalPages": {
-                    "type": "integer"
+                "success": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "resp.PaginationResponse-intake_ListIntakeFormsResponse": {
+        "resp.SuccessResponse-client_GetDischargeStatsResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/intake.ListIntakeFormsResponse"
-                    }
-                },
-                "page": {
-                    "type": "integer"
-                },
-                "pageSize": {
-                    "type": "integer"
+                    "$ref": "#/definitions/client.GetDischargeStatsResponse"
                 },
-                "totalCount": {
-                    "type": "integer"
+                "message": {
+                    "type": "string",
+                    "example": "success message"
                 },
-                "totalPages": {
-                    "type": "integer"
+                "success": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "resp.PaginationResponse-location_transfer_ListLocationTransfersResponse": {
+        "resp.SuccessResponse-client_GetInCareStatsResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/location_transfer.ListLocationTransfersResponse"
-                    }
-                },
-                "page": {
-                    "type": "integer"
-                },
-                "pageSize": {
-                    "type": "integer"
+                    "$ref": "#/definitions/client.GetInCareStatsResponse"
                 },
-                "totalCount": {
-                    "type": "integer"
+                "message": {
+                    "type": "string",
+                    "example": "success message"
                 },
-                "totalPages": {
-                    "type": "integer"
+                "success": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "resp.PaginationResponse-rbac_PermissionResponse": {
+        "resp.SuccessResponse-client_GetWaitlistStatsResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/rbac.PermissionResponse"
-                    }
-                },
-                "page": {
-                    "type": "integer"
-                },
-                "pageSize": {
-                    "type": "integer"
+                    "$ref": "#/definitions/client.GetWaitlistStatsResponse"
                 },
-                "totalCount": {
-                    "type": "integer"
+                "message": {
+                    "type": "string",
+                    "example": "success message"
                 },
-                "totalPages": {
-                    "type": "integer"
+                "success": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "resp.PaginationResponse-rbac_RoleListItem": {
+        "resp.SuccessResponse-client_MoveClientInCareResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/rbac.RoleListItem"
-                    }
-                },
-                "page": {
-                    "type": "integer"
-                },
-                "pageSize": {
-                    "type": "integer"
+                    "$ref": "#/definitions/client.MoveClientInCareResponse"
                 },
-                "totalCount": {
-                    "type": "integer"
+                "message": {
+                    "type": "string",
+                    "example": "success message"
                 },
-                "totalPages": {
-                    "type": "integer"
+                "success": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "resp.PaginationResponse-referring_orgs_ListReferringOrgsResponse": {
+        "resp.SuccessResponse-client_MoveClientToWaitingListResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/referring_orgs.ListReferringOrgsResponse"
-                    }
-                },
-                "page": {
-                    "type": "integer"
-                },
-                "pageSize": {
-                    "type": "integer"
+                    "$ref": "#/definitions/client.MoveClientToWaitingListResponse"
                 },
-                "totalCount": {
-                    "type": "integer"
+                "message": {
+                    "type": "string",
+                    "example": "success message"
                 },
-                "totalPages": {
-                    "type": "integer"
+                "success": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "resp.PaginationResponse-registration_ListRegistrationFormsResponse": {
+        "resp.SuccessResponse-client_StartDischargeResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/registration.ListRegistrationFormsResponse"
-                    }
+                    "$ref": "#/definitions/client.StartDischargeResponse"
                 },
-                "page": {
-                    "type": "integer"
+                "message": {
+                    "type": "string",
+                    "example": "success message"
                 },
-                "pageSize": {
-                    "type": "integer"
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "resp.SuccessResponse-dashboard_CareTypeDistributionResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dashboard.CareTypeDistributionResponse"
                 },
-                "totalCount": {
-                    "type": "integer"
+                "message": {
+                    "type": "string",
+                    "example": "success message"
                 },
-                "totalPages": {
-                    "type": "integer"
+                "success": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "resp.SuccessResponse-any": {
+        "resp.SuccessResponse-dashboard_ClientAgeDistributionResponse": {
             "type": "object",
             "properties": {
-                "data": {},
+                "data": {
+                    "$ref": "#/definitions/dashboard.ClientAgeDistributionResponse"
+                },
                 "message": {
                     "type": "string",
                     "example": "success message"
@@ -10024,14 +14827,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-array_calendar_AppointmentResponse": {
+        "resp.SuccessResponse-dashboard_CoordinatorClientsResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/calendar.AppointmentResponse"
-                    }
+                    "$ref": "#/definitions/dashboard.CoordinatorClientsResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10043,14 +14843,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-array_calendar_CalendarEvent": {
+        "resp.SuccessResponse-dashboard_CoordinatorGoalsProgressResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/calendar.CalendarEvent"
-                    }
+                    "$ref": "#/definitions/dashboard.CoordinatorGoalsProgressResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10062,14 +14859,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-array_calendar_ReminderResponse": {
+        "resp.SuccessResponse-dashboard_CoordinatorIncidentsResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/calendar.ReminderResponse"
-                    }
+                    "$ref": "#/definitions/dashboard.CoordinatorIncidentsResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10081,14 +14875,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-array_client_ListClientGoalsResponse": {
+        "resp.SuccessResponse-dashboard_CoordinatorRemindersResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/client.ListClientGoalsResponse"
-                    }
+                    "$ref": "#/definitions/dashboard.CoordinatorRemindersResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10100,14 +14891,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-array_evaluation_EvaluationHistoryItem": {
+        "resp.SuccessResponse-dashboard_CoordinatorStatsResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/evaluation.EvaluationHistoryItem"
-                    }
+                    "$ref": "#/definitions/dashboard.CoordinatorStatsResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10119,11 +14907,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-audit_AuditLogResponse": {
+        "resp.SuccessResponse-dashboard_CoordinatorTodayScheduleResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/audit.AuditLogResponse"
+                    "$ref": "#/definitions/dashboard.CoordinatorTodayScheduleResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10135,11 +14923,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-audit_AuditLogStatsResponse": {
+        "resp.SuccessResponse-dashboard_CoordinatorUrgentAlertsResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/audit.AuditLogStatsResponse"
+                    "$ref": "#/definitions/dashboard.CoordinatorUrgentAlertsResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10151,11 +14939,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-audit_ChainVerificationResult": {
+        "resp.SuccessResponse-dashboard_CriticalAlertsResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/audit.ChainVerificationResult"
+                    "$ref": "#/definitions/dashboard.CriticalAlertsResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10167,11 +14955,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-auth_EnableMFAResponse": {
+        "resp.SuccessResponse-dashboard_DashboardResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/auth.EnableMFAResponse"
+                    "$ref": "#/definitions/dashboard.DashboardResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10183,11 +14971,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-auth_LoginResponse": {
+        "resp.SuccessResponse-dashboard_DischargeStatsResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/auth.LoginResponse"
+                    "$ref": "#/definitions/dashboard.DischargeStatsResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10199,11 +14987,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-auth_RefreshTokensResponse": {
+        "resp.SuccessResponse-dashboard_EvaluationStatsResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/auth.RefreshTokensResponse"
+                    "$ref": "#/definitions/dashboard.EvaluationStatsResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10215,11 +15003,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-auth_SetupMFAResponse": {
+        "resp.SuccessResponse-dashboard_FeaturesResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/auth.SetupMFAResponse"
+                    "$ref": "#/definitions/dashboard.FeaturesResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10231,11 +15019,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-auth_VerifyMFAResponse": {
+        "resp.SuccessResponse-dashboard_IncidentCategoryStatsResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/auth.VerifyMFAResponse"
+                    "$ref": "#/definitions/dashboard.IncidentCategoryStatsResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10247,11 +15035,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-calendar_AppointmentResponse": {
+        "resp.SuccessResponse-dashboard_LocationCapacityResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/calendar.AppointmentResponse"
+                    "$ref": "#/definitions/dashboard.LocationCapacityResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10263,11 +15051,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-calendar_ReminderResponse": {
+        "resp.SuccessResponse-dashboard_OverviewResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/calendar.ReminderResponse"
+                    "$ref": "#/definitions/dashboard.OverviewResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10279,11 +15067,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-client_CompleteDischargeResponse": {
+        "resp.SuccessResponse-dashboard_PipelineStatsResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/client.CompleteDischargeResponse"
+                    "$ref": "#/definitions/dashboard.PipelineStatsResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10295,11 +15083,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-client_GetDischargeStatsResponse": {
+        "resp.SuccessResponse-dashboard_TodayAppointmentsResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/client.GetDischargeStatsResponse"
+                    "$ref": "#/definitions/dashboard.TodayAppointmentsResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10311,11 +15099,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-client_GetInCareStatsResponse": {
+        "resp.SuccessResponse-employee_AvailabilityResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/client.GetInCareStatsResponse"
+                    "$ref": "#/definitions/employee.AvailabilityResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10327,11 +15115,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-client_GetWaitlistStatsResponse": {
+        "resp.SuccessResponse-employee_CreateEmployeeResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/client.GetWaitlistStatsResponse"
+                    "$ref": "#/definitions/employee.CreateEmployeeResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10343,11 +15131,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-client_MoveClientInCareResponse": {
+        "resp.SuccessResponse-employee_GetEmployeeByIDResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/client.MoveClientInCareResponse"
+                    "$ref": "#/definitions/employee.GetEmployeeByIDResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10359,11 +15147,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-client_MoveClientToWaitingListResponse": {
+        "resp.SuccessResponse-employee_GetMyProfileResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/client.MoveClientToWaitingListResponse"
+                    "$ref": "#/definitions/employee.GetMyProfileResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10375,11 +15163,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-client_StartDischargeResponse": {
+        "resp.SuccessResponse-employee_ReplacementCoordinatorResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/client.StartDischargeResponse"
+                    "$ref": "#/definitions/employee.ReplacementCoordinatorResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10391,11 +15179,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-dashboard_CareTypeDistributionResponse": {
+        "resp.SuccessResponse-employee_UpdateEmployeeResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/dashboard.CareTypeDistributionResponse"
+                    "$ref": "#/definitions/employee.UpdateEmployeeResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10407,11 +15195,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-dashboard_CoordinatorClientsResponse": {
+        "resp.SuccessResponse-evaluation_CompleteEvaluationRecordResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/dashboard.CoordinatorClientsResponse"
+                    "$ref": "#/definitions/evaluation.CompleteEvaluationRecordResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10423,11 +15211,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-dashboard_CoordinatorGoalsProgressResponse": {
+        "resp.SuccessResponse-evaluation_CreateEvaluationResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/dashboard.CoordinatorGoalsProgressResponse"
+                    "$ref": "#/definitions/evaluation.CreateEvaluationResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10439,11 +15227,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-dashboard_CoordinatorIncidentsResponse": {
+        "resp.SuccessResponse-evaluation_DraftEvaluationResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/dashboard.CoordinatorIncidentsResponse"
+                    "$ref": "#/definitions/evaluation.DraftEvaluationResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10455,11 +15243,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-dashboard_CoordinatorRemindersResponse": {
+        "resp.SuccessResponse-evaluation_EvaluationRecordResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/dashboard.CoordinatorRemindersResponse"
+                    "$ref": "#/definitions/evaluation.EvaluationRecordResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10471,11 +15259,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-dashboard_CoordinatorStatsResponse": {
+        "resp.SuccessResponse-evaluation_EvaluationResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/dashboard.CoordinatorStatsResponse"
+                    "$ref": "#/definitions/evaluation.EvaluationResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10487,11 +15275,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-dashboard_CoordinatorTodayScheduleResponse": {
+        "resp.SuccessResponse-evaluation_LastEvaluationItem": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/dashboard.CoordinatorTodayScheduleResponse"
+                    "$ref": "#/definitions/evaluation.LastEvaluationItem"
                 },
                 "message": {
                     "type": "string",
@@ -10503,11 +15291,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-dashboard_CoordinatorUrgentAlertsResponse": {
+        "resp.SuccessResponse-evaluation_SaveDraftResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/dashboard.CoordinatorUrgentAlertsResponse"
+                    "$ref": "#/definitions/evaluation.SaveDraftResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10519,11 +15307,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-dashboard_CriticalAlertsResponse": {
+        "resp.SuccessResponse-evaluation_UpdateEvaluationResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/dashboard.CriticalAlertsResponse"
+                    "$ref": "#/definitions/evaluation.UpdateEvaluationResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10535,11 +15323,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-dashboard_DischargeStatsResponse": {
+        "resp.SuccessResponse-health_WorkerHealthResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/dashboard.DischargeStatsResponse"
+                    "$ref": "#/definitions/health.WorkerHealthResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10551,11 +15339,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-dashboard_EvaluationStatsResponse": {
+        "resp.SuccessResponse-incident_CreateIncidentResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/dashboard.EvaluationStatsResponse"
+                    "$ref": "#/definitions/incident.CreateIncidentResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10567,11 +15355,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-dashboard_LocationCapacityResponse": {
+        "resp.SuccessResponse-incident_DeleteIncidentResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/dashboard.LocationCapacityResponse"
+                    "$ref": "#/definitions/incident.DeleteIncidentResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10583,11 +15371,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-dashboard_OverviewResponse": {
+        "resp.SuccessResponse-incident_GetIncidentResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/dashboard.OverviewResponse"
+                    "$ref": "#/definitions/incident.GetIncidentResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10599,11 +15387,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-dashboard_PipelineStatsResponse": {
+        "resp.SuccessResponse-incident_GetIncidentStatsResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/dashboard.PipelineStatsResponse"
+                    "$ref": "#/definitions/incident.GetIncidentStatsResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10615,11 +15403,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-dashboard_TodayAppointmentsResponse": {
+        "resp.SuccessResponse-incident_UpdateIncidentResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/dashboard.TodayAppointmentsResponse"
+                    "$ref": "#/definitions/incident.UpdateIncidentResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10631,11 +15419,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-employee_CreateEmployeeResponse": {
+        "resp.SuccessResponse-intake_CreateIntakeFormResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/employee.CreateEmployeeResponse"
+                    "$ref": "#/definitions/intake.CreateIntakeFormResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10647,11 +15435,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-employee_GetEmployeeByIDResponse": {
+        "resp.SuccessResponse-intake_DeleteIntakeFormResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/employee.GetEmployeeByIDResponse"
+                    "$ref": "#/definitions/intake.DeleteIntakeFormResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10663,11 +15451,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-employee_GetMyProfileResponse": {
+        "resp.SuccessResponse-intake_GetAvailableIntakeSlotsResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/employee.GetMyProfileResponse"
+                    "$ref": "#/definitions/intake.GetAvailableIntakeSlotsResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10679,11 +15467,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-employee_UpdateEmployeeResponse": {
+        "resp.SuccessResponse-intake_GetIntakeFormResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/employee.UpdateEmployeeResponse"
+                    "$ref": "#/definitions/intake.GetIntakeFormResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10695,11 +15483,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-evaluation_CreateEvaluationResponse": {
+        "resp.SuccessResponse-intake_GetIntakeStatsResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/evaluation.CreateEvaluationResponse"
+                    "$ref": "#/definitions/intake.GetIntakeStatsResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10711,11 +15499,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-evaluation_DraftEvaluationResponse": {
+        "resp.SuccessResponse-intake_IntakeDocumentResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/evaluation.DraftEvaluationResponse"
+                    "$ref": "#/definitions/intake.IntakeDocumentResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10727,11 +15515,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-evaluation_EvaluationResponse": {
+        "resp.SuccessResponse-intake_UpdateIntakeFormResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/evaluation.EvaluationResponse"
+                    "$ref": "#/definitions/intake.UpdateIntakeFormResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10743,11 +15531,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-evaluation_LastEvaluationItem": {
+        "resp.SuccessResponse-location_transfer_GetLocationTransferStatsResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/evaluation.LastEvaluationItem"
+                    "$ref": "#/definitions/location_transfer.GetLocationTransferStatsResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10759,11 +15547,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-evaluation_SaveDraftResponse": {
+        "resp.SuccessResponse-location_transfer_ListLocationTransfersResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/evaluation.SaveDraftResponse"
+                    "$ref": "#/definitions/location_transfer.ListLocationTransfersResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10775,11 +15563,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-evaluation_UpdateEvaluationResponse": {
+        "resp.SuccessResponse-location_transfer_RegisterLocationTransferResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/evaluation.UpdateEvaluationResponse"
+                    "$ref": "#/definitions/location_transfer.RegisterLocationTransferResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10791,11 +15579,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-incident_CreateIncidentResponse": {
+        "resp.SuccessResponse-locations_CreateLocationResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/incident.CreateIncidentResponse"
+                    "$ref": "#/definitions/locations.CreateLocationResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10807,11 +15595,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-incident_DeleteIncidentResponse": {
+        "resp.SuccessResponse-locations_DeleteLocationResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/incident.DeleteIncidentResponse"
+                    "$ref": "#/definitions/locations.DeleteLocationResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10823,11 +15611,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-incident_GetIncidentResponse": {
+        "resp.SuccessResponse-locations_GetLocationCapacityStatsResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/incident.GetIncidentResponse"
+                    "$ref": "#/definitions/locations.GetLocationCapacityStatsResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10839,11 +15627,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-incident_GetIncidentStatsResponse": {
+        "resp.SuccessResponse-locations_UpdateLocationResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/incident.GetIncidentStatsResponse"
+                    "$ref": "#/definitions/locations.UpdateLocationResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10855,11 +15643,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-incident_UpdateIncidentResponse": {
+        "resp.SuccessResponse-metadata_EnumOptionsResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/incident.UpdateIncidentResponse"
+                    "$ref": "#/definitions/metadata.EnumOptionsResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10871,11 +15659,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-intake_CreateIntakeFormResponse": {
+        "resp.SuccessResponse-notification_QuietHoursResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/intake.CreateIntakeFormResponse"
+                    "$ref": "#/definitions/notification.QuietHoursResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10887,11 +15675,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-intake_GetIntakeFormResponse": {
+        "resp.SuccessResponse-notification_UnreadCountResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/intake.GetIntakeFormResponse"
+                    "$ref": "#/definitions/notification.UnreadCountResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10903,11 +15691,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-intake_GetIntakeStatsResponse": {
+        "resp.SuccessResponse-notification_WSAuthResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/intake.GetIntakeStatsResponse"
+                    "$ref": "#/definitions/notification.WSAuthResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10919,11 +15707,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-intake_UpdateIntakeFormResponse": {
+        "resp.SuccessResponse-rbac_CreateRoleResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/intake.UpdateIntakeFormResponse"
+                    "$ref": "#/definitions/rbac.CreateRoleResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10935,11 +15723,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-location_transfer_GetLocationTransferStatsResponse": {
+        "resp.SuccessResponse-rbac_RemovePermissionFromAllRolesResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/location_transfer.GetLocationTransferStatsResponse"
+                    "$ref": "#/definitions/rbac.RemovePermissionFromAllRolesResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10951,11 +15739,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-location_transfer_ListLocationTransfersResponse": {
+        "resp.SuccessResponse-rbac_RoleResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/location_transfer.ListLocationTransfersResponse"
+                    "$ref": "#/definitions/rbac.RoleResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10967,11 +15755,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-location_transfer_RegisterLocationTransferResponse": {
+        "resp.SuccessResponse-referring_orgs_GetReferringOrgStatsResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/location_transfer.RegisterLocationTransferResponse"
+                    "$ref": "#/definitions/referring_orgs.GetReferringOrgStatsResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10983,11 +15771,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-locations_CreateLocationResponse": {
+        "resp.SuccessResponse-registration_BatchUpdateRegistrationStatusResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/locations.CreateLocationResponse"
+                    "$ref": "#/definitions/registration.BatchUpdateRegistrationStatusResponse"
                 },
                 "message": {
                     "type": "string",
@@ -10999,11 +15787,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-locations_DeleteLocationResponse": {
+        "resp.SuccessResponse-registration_CreateRegistrationFormResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/locations.DeleteLocationResponse"
+                    "$ref": "#/definitions/registration.CreateRegistrationFormResponse"
                 },
                 "message": {
                     "type": "string",
@@ -11015,11 +15803,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-locations_GetLocationCapacityStatsResponse": {
+        "resp.SuccessResponse-registration_DeleteRegistrationFormResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/locations.GetLocationCapacityStatsResponse"
+                    "$ref": "#/definitions/registration.DeleteRegistrationFormResponse"
                 },
                 "message": {
                     "type": "string",
@@ -11031,11 +15819,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-locations_UpdateLocationResponse": {
+        "resp.SuccessResponse-registration_GetRegistrationFormResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/locations.UpdateLocationResponse"
+                    "$ref": "#/definitions/registration.GetRegistrationFormResponse"
                 },
                 "message": {
                     "type": "string",
@@ -11047,11 +15835,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-notification_UnreadCountResponse": {
+        "resp.SuccessResponse-registration_GetRegistrationStatsResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/notification.UnreadCountResponse"
+                    "$ref": "#/definitions/registration.GetRegistrationStatsResponse"
                 },
                 "message": {
                     "type": "string",
@@ -11063,11 +15851,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-notification_WSAuthResponse": {
+        "resp.SuccessResponse-registration_RestoreRegistrationFormResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/notification.WSAuthResponse"
+                    "$ref": "#/definitions/registration.RestoreRegistrationFormResponse"
                 },
                 "message": {
                     "type": "string",
@@ -11079,11 +15867,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-rbac_CreateRoleResponse": {
+        "resp.SuccessResponse-registration_UpdateRegistrationFormResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/rbac.CreateRoleResponse"
+                    "$ref": "#/definitions/registration.UpdateRegistrationFormResponse"
                 },
                 "message": {
                     "type": "string",
@@ -11095,11 +15883,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-rbac_RoleResponse": {
+        "resp.SuccessResponse-resp_PaginationResponse-array_audit_AuditLogResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/rbac.RoleResponse"
+                    "$ref": "#/definitions/resp.PaginationResponse-array_audit_AuditLogResponse"
                 },
                 "message": {
                     "type": "string",
@@ -11111,11 +15899,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-referring_orgs_GetReferringOrgStatsResponse": {
+        "resp.SuccessResponse-resp_PaginationResponse-array_calendar_ListAppointmentsResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/referring_orgs.GetReferringOrgStatsResponse"
+                    "$ref": "#/definitions/resp.PaginationResponse-array_calendar_ListAppointmentsResponse"
                 },
                 "message": {
                     "type": "string",
@@ -11127,11 +15915,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-registration_CreateRegistrationFormResponse": {
+        "resp.SuccessResponse-resp_PaginationResponse-array_client_ListDischargedClientsResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/registration.CreateRegistrationFormResponse"
+                    "$ref": "#/definitions/resp.PaginationResponse-array_client_ListDischargedClientsResponse"
                 },
                 "message": {
                     "type": "string",
@@ -11143,11 +15931,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-registration_DeleteRegistrationFormResponse": {
+        "resp.SuccessResponse-resp_PaginationResponse-array_client_ListInCareClientsResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/registration.DeleteRegistrationFormResponse"
+                    "$ref": "#/definitions/resp.PaginationResponse-array_client_ListInCareClientsResponse"
                 },
                 "message": {
                     "type": "string",
@@ -11159,11 +15947,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-registration_GetRegistrationFormResponse": {
+        "resp.SuccessResponse-resp_PaginationResponse-array_client_ListWaitingListClientsResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/registration.GetRegistrationFormResponse"
+                    "$ref": "#/definitions/resp.PaginationResponse-array_client_ListWaitingListClientsResponse"
                 },
                 "message": {
                     "type": "string",
@@ -11175,11 +15963,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-registration_GetRegistrationStatsResponse": {
+        "resp.SuccessResponse-resp_PaginationResponse-array_client_SearchClientsResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/registration.GetRegistrationStatsResponse"
+                    "$ref": "#/definitions/resp.PaginationResponse-array_client_SearchClientsResponse"
                 },
                 "message": {
                     "type": "string",
@@ -11191,11 +15979,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-registration_UpdateRegistrationFormResponse": {
+        "resp.SuccessResponse-resp_PaginationResponse-array_employee_ListEmployeesResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/registration.UpdateRegistrationFormResponse"
+                    "$ref": "#/definitions/resp.PaginationResponse-array_employee_ListEmployeesResponse"
                 },
                 "message": {
                     "type": "string",
@@ -11207,11 +15995,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-resp_PaginationResponse-array_audit_AuditLogResponse": {
+        "resp.SuccessResponse-resp_PaginationResponse-array_incident_ListIncidentsResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/resp.PaginationResponse-array_audit_AuditLogResponse"
+                    "$ref": "#/definitions/resp.PaginationResponse-array_incident_ListIncidentsResponse"
                 },
                 "message": {
                     "type": "string",
@@ -11223,11 +16011,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-resp_PaginationResponse-array_client_ListDischargedClientsResponse": {
+        "resp.SuccessResponse-resp_PaginationResponse-array_locations_ListLocationsResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/resp.PaginationResponse-array_client_ListDischargedClientsResponse"
+                    "$ref": "#/definitions/resp.PaginationResponse-array_locations_ListLocationsResponse"
                 },
                 "message": {
                     "type": "string",
@@ -11239,11 +16027,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-resp_PaginationResponse-array_client_ListInCareClientsResponse": {
+        "resp.SuccessResponse-resp_PaginationResponse-array_notification_NotificationResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/resp.PaginationResponse-array_client_ListInCareClientsResponse"
+                    "$ref": "#/definitions/resp.PaginationResponse-array_notification_NotificationResponse"
                 },
                 "message": {
                     "type": "string",
@@ -11255,11 +16043,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-resp_PaginationResponse-array_client_ListWaitingListClientsResponse": {
+        "resp.SuccessResponse-resp_PaginationResponse-evaluation_DraftEvaluationListItem": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/resp.PaginationResponse-array_client_ListWaitingListClientsResponse"
+                    "$ref": "#/definitions/resp.PaginationResponse-evaluation_DraftEvaluationListItem"
                 },
                 "message": {
                     "type": "string",
@@ -11271,11 +16059,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-resp_PaginationResponse-array_employee_ListEmployeesResponse": {
+        "resp.SuccessResponse-resp_PaginationResponse-evaluation_EvaluationRecordListItem": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/resp.PaginationResponse-array_employee_ListEmployeesResponse"
+                    "$ref": "#/definitions/resp.PaginationResponse-evaluation_EvaluationRecordListItem"
                 },
                 "message": {
                     "type": "string",
@@ -11287,11 +16075,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-resp_PaginationResponse-array_incident_ListIncidentsResponse": {
+        "resp.SuccessResponse-resp_PaginationResponse-evaluation_GlobalRecentEvaluationItem": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/resp.PaginationResponse-array_incident_ListIncidentsResponse"
+                    "$ref": "#/definitions/resp.PaginationResponse-evaluation_GlobalRecentEvaluationItem"
                 },
                 "message": {
                     "type": "string",
@@ -11303,11 +16091,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-resp_PaginationResponse-array_locations_ListLocationsResponse": {
+        "resp.SuccessResponse-resp_PaginationResponse-evaluation_OverdueEvaluationItem": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/resp.PaginationResponse-array_locations_ListLocationsResponse"
+                    "$ref": "#/definitions/resp.PaginationResponse-evaluation_OverdueEvaluationItem"
                 },
                 "message": {
                     "type": "string",
@@ -11319,11 +16107,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-resp_PaginationResponse-array_notification_NotificationResponse": {
+        "resp.SuccessResponse-resp_PaginationResponse-evaluation_UpcomingEvaluationItem": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/resp.PaginationResponse-array_notification_NotificationResponse"
+                    "$ref": "#/definitions/resp.PaginationResponse-evaluation_UpcomingEvaluationItem"
                 },
                 "message": {
                     "type": "string",
@@ -11335,11 +16123,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-resp_PaginationResponse-evaluation_DraftEvaluationListItem": {
+        "resp.SuccessResponse-resp_PaginationResponse-intake_ListIntakeFormsResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/resp.PaginationResponse-evaluation_DraftEvaluationListItem"
+                    "$ref": "#/definitions/resp.PaginationResponse-intake_ListIntakeFormsResponse"
                 },
                 "message": {
                     "type": "string",
@@ -11351,11 +16139,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-resp_PaginationResponse-evaluation_GlobalRecentEvaluationItem": {
+        "resp.SuccessResponse-resp_PaginationResponse-location_transfer_ListLocationTransfersResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/resp.PaginationResponse-evaluation_GlobalRecentEvaluationItem"
+                    "$ref": "#/definitions/resp.PaginationResponse-location_transfer_ListLocationTransfersResponse"
                 },
                 "message": {
                     "type": "string",
@@ -11367,11 +16155,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-resp_PaginationResponse-evaluation_UpcomingEvaluationItem": {
+        "resp.SuccessResponse-resp_PaginationResponse-rbac_PermissionResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/resp.PaginationResponse-evaluation_UpcomingEvaluationItem"
+                    "$ref": "#/definitions/resp.PaginationResponse-rbac_PermissionResponse"
                 },
                 "message": {
                     "type": "string",
@@ -11383,11 +16171,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-resp_PaginationResponse-intake_ListIntakeFormsResponse": {
+        "resp.SuccessResponse-resp_PaginationResponse-rbac_RoleListItem": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/resp.PaginationResponse-intake_ListIntakeFormsResponse"
+                    "$ref": "#/definitions/resp.PaginationResponse-rbac_RoleListItem"
                 },
                 "message": {
                     "type": "string",
@@ -11399,11 +16187,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-resp_PaginationResponse-location_transfer_ListLocationTransfersResponse": {
+        "resp.SuccessResponse-resp_PaginationResponse-registration_ListDeletedRegistrationFormsResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/resp.PaginationResponse-location_transfer_ListLocationTransfersResponse"
+                    "$ref": "#/definitions/resp.PaginationResponse-registration_ListDeletedRegistrationFormsResponse"
                 },
                 "message": {
                     "type": "string",
@@ -11415,11 +16203,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-resp_PaginationResponse-rbac_PermissionResponse": {
+        "resp.SuccessResponse-resp_PaginationResponse-registration_ListRegistrationFormsResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/resp.PaginationResponse-rbac_PermissionResponse"
+                    "$ref": "#/definitions/resp.PaginationResponse-registration_ListRegistrationFormsResponse"
                 },
                 "message": {
                     "type": "string",
@@ -11431,11 +16219,11 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-resp_PaginationResponse-rbac_RoleListItem": {
+        "resp.SuccessResponse-version_Info": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/resp.PaginationResponse-rbac_RoleListItem"
+                    "$ref": "#/definitions/version.Info"
                 },
                 "message": {
                     "type": "string",
@@ -11447,19 +16235,20 @@ const docTemplate = `{
                 }
             }
         },
-        "resp.SuccessResponse-resp_PaginationResponse-registration_ListRegistrationFormsResponse": {
+        "version.Info": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/resp.PaginationResponse-registration_ListRegistrationFormsResponse"
+                "buildTime": {
+                    "type": "string",
+                    "example": "2026-01-22T10:00:00Z"
                 },
-                "message": {
+                "commit": {
                     "type": "string",
-                    "example": "success message"
+                    "example": "a1b2c3d"
                 },
-                "success": {
-                    "type": "boolean",
-                    "example": true
+                "version": {
+                    "type": "string",
+                    "example": "v1.2.0"
                 }
             }
         }
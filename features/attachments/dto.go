@@ -1,5 +1,27 @@
 package attachments
 
+import "time"
+
 type UploadAttachmentResponse struct {
 	ID string `json:"id"`
 }
+
+type DownloadAttachmentResponse struct {
+	// URL is a presigned object storage link, valid until ExpiresAt
+	URL         string    `json:"url"`
+	ContentType string    `json:"contentType"`
+	ExpiresAt   time.Time `json:"expiresAt"`
+}
+
+type AttachmentAccessResponse struct {
+	ID        string  `json:"id"`
+	UserID    *string `json:"userId"` // Nil once the user is deleted
+	UserEmail *string `json:"userEmail"`
+	// ResourceType and ResourceID name the registration form or client the
+	// attachment belonged to when it was downloaded
+	ResourceType *string   `json:"resourceType"`
+	ResourceID   *string   `json:"resourceId"`
+	IPAddress    *string   `json:"ipAddress"`
+	UserAgent    *string   `json:"userAgent"`
+	AccessedAt   time.Time `json:"accessedAt"`
+}
@@ -6,4 +6,6 @@ var (
 	ErrInvalidRequest = errors.New("invalid request")
 	ErrInternal       = errors.New("internal server error")
 	ErrInvalidFile    = errors.New("invalid file")
+	ErrFileTooLarge   = errors.New("file exceeds the upload size limit")
+	ErrNotFound       = errors.New("attachment not found")
 )
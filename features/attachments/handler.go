@@ -3,23 +3,32 @@ package attachments
 import (
 	"care-cordination/lib/middleware"
 	"care-cordination/lib/resp"
+	"errors"
+	"io"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 )
 
+// multipartOverhead is the slack allowed on top of the file size for the
+// multipart boundaries and part headers
+const multipartOverhead = 64 << 10
+
 type AttachmentsHandler struct {
 	attachmentsService AttachmentsService
 	mdw                *middleware.Middleware
+	limits             UploadLimits
 }
 
 func NewAttachmentsHandler(
 	attachmentsService AttachmentsService,
 	mdw *middleware.Middleware,
+	limits UploadLimits,
 ) *AttachmentsHandler {
 	return &AttachmentsHandler{
 		attachmentsService: attachmentsService,
 		mdw:                mdw,
+		limits:             limits,
 	}
 }
 
@@ -27,6 +36,13 @@ func (h *AttachmentsHandler) SetupAttachmentsRoutes(router *gin.Engine) {
 	attachments := router.Group("/attachments")
 
 	attachments.POST("", h.mdw.AuthMdw(), h.UploadAttachment)
+	attachments.GET("/:id/download", h.mdw.AuthMdw(), h.DownloadAttachment)
+	attachments.GET(
+		"/:id/access",
+		h.mdw.AuthMdw(),
+		h.mdw.RequirePermission("admin", "manage"),
+		h.ListAttachmentAccess,
+	)
 }
 
 // @Summary Upload an attachment
@@ -38,27 +54,110 @@ func (h *AttachmentsHandler) SetupAttachmentsRoutes(router *gin.Engine) {
 // @Success 200 {object} UploadAttachmentResponse
 // @Failure 400 {object} resp.ErrorResponse
 // @Failure 401 {object} resp.ErrorResponse
+// @Failure 413 {object} resp.ErrorResponse
 // @Failure 500 {object} resp.ErrorResponse
 // @Router /attachments [post]
 func (h *AttachmentsHandler) UploadAttachment(ctx *gin.Context) {
-	file, err := ctx.FormFile("file")
+	maxBody := h.limits.MaxSize + multipartOverhead
+	// Reject declared oversized bodies before reading any of them
+	if ctx.Request.ContentLength > maxBody {
+		ctx.JSON(http.StatusRequestEntityTooLarge, resp.Error(ErrFileTooLarge))
+		return
+	}
+	ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxBody)
+
+	reader, err := ctx.Request.MultipartReader()
 	if err != nil {
 		ctx.JSON(http.StatusBadRequest, resp.Error(ErrInvalidRequest))
 		return
 	}
 
-	result, err := h.attachmentsService.UploadAttachment(ctx.Request.Context(), file)
+	// Stream the "file" part instead of buffering the whole form
+	var file io.Reader
+	var filename, contentType string
+	for {
+		part, err := reader.NextPart()
+		if err != nil {
+			if isTooLarge(err) {
+				ctx.JSON(http.StatusRequestEntityTooLarge, resp.Error(ErrFileTooLarge))
+			} else {
+				ctx.JSON(http.StatusBadRequest, resp.Error(ErrInvalidRequest))
+			}
+			return
+		}
+		if part.FormName() == "file" && part.FileName() != "" {
+			defer part.Close()
+			file = part
+			filename = part.FileName()
+			contentType = part.Header.Get("Content-Type")
+			break
+		}
+		part.Close()
+	}
+
+	result, err := h.attachmentsService.UploadAttachment(ctx.Request.Context(), file, filename, contentType)
 	if err != nil {
-		switch err {
-		case ErrInvalidFile, ErrInvalidRequest:
+		switch {
+		case errors.Is(err, ErrFileTooLarge), isTooLarge(err):
+			ctx.JSON(http.StatusRequestEntityTooLarge, resp.Error(ErrFileTooLarge))
+		case errors.Is(err, ErrInvalidFile), errors.Is(err, ErrInvalidRequest):
 			ctx.JSON(http.StatusBadRequest, resp.Error(err))
-		case ErrInternal:
-			ctx.JSON(http.StatusInternalServerError, resp.Error(err))
 		default:
-			ctx.JSON(http.StatusInternalServerError, resp.Error(err))
+			ctx.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
 		}
 		return
 	}
 
 	ctx.JSON(http.StatusOK, result)
 }
+
+// @Summary Download an attachment
+// @Description Record the download and return a short-lived presigned URL for the file. The file must belong to the caller's organization and the caller needs read access to the registration form or client it is attached to; otherwise it is reported as not found.
+// @Tags Attachments
+// @Produce json
+// @Param id path string true "Attachment ID"
+// @Success 200 {object} resp.SuccessResponse[DownloadAttachmentResponse]
+// @Failure 401 {object} resp.ErrorResponse
+// @Failure 404 {object} resp.ErrorResponse
+// @Failure 500 {object} resp.ErrorResponse
+// @Router /attachments/{id}/download [get]
+func (h *AttachmentsHandler) DownloadAttachment(ctx *gin.Context) {
+	result, err := h.attachmentsService.DownloadAttachment(ctx, ctx.Param("id"))
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrNotFound):
+			ctx.JSON(http.StatusNotFound, resp.Error(err))
+		default:
+			ctx.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp.Success(result, "Attachment download URL created"))
+}
+
+// @Summary List attachment access
+// @Description List who downloaded an attachment and when, newest first. Admin only.
+// @Tags Attachments
+// @Produce json
+// @Param id path string true "Attachment ID"
+// @Success 200 {object} resp.SuccessResponse[[]AttachmentAccessResponse]
+// @Failure 401 {object} resp.ErrorResponse
+// @Failure 403 {object} resp.ErrorResponse
+// @Failure 500 {object} resp.ErrorResponse
+// @Router /attachments/{id}/access [get]
+func (h *AttachmentsHandler) ListAttachmentAccess(ctx *gin.Context) {
+	result, err := h.attachmentsService.ListAttachmentAccess(ctx, ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp.Success(result, "Attachment access retrieved successfully"))
+}
+
+// isTooLarge reports whether err came from the request body limit
+func isTooLarge(err error) bool {
+	var maxBytesErr *http.MaxBytesError
+	return errors.As(err, &maxBytesErr)
+}
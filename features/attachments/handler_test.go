@@ -0,0 +1,112 @@
+package attachments_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"care-cordination/features/attachments"
+	"care-cordination/internal/mocks"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func setupHandlerTest(t *testing.T, limits attachments.UploadLimits) (*gin.Engine, *mocks.MockAttachmentsService) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	mockService := mocks.NewMockAttachmentsService(ctrl)
+
+	handler := attachments.NewAttachmentsHandler(mockService, nil, limits)
+
+	router := gin.New()
+	router.POST("/attachments", handler.UploadAttachment)
+
+	return router, mockService
+}
+
+func multipartBody(t *testing.T, content []byte) (*bytes.Buffer, string) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("file", "report.pdf")
+	require.NoError(t, err)
+	_, err = part.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+	return body, writer.FormDataContentType()
+}
+
+func TestUploadAttachment(t *testing.T) {
+	limits := attachments.UploadLimits{MaxSize: 1024}
+
+	t.Run("streams_file_to_service", func(t *testing.T) {
+		router, mockService := setupHandlerTest(t, limits)
+		mockService.EXPECT().
+			UploadAttachment(gomock.Any(), gomock.Any(), "report.pdf", "application/octet-stream").
+			DoAndReturn(func(_ any, file io.Reader, _, _ string) (*attachments.UploadAttachmentResponse, error) {
+				data, err := io.ReadAll(file)
+				require.NoError(t, err)
+				assert.Equal(t, "hello", string(data))
+				return &attachments.UploadAttachmentResponse{ID: "att-1"}, nil
+			})
+
+		body, contentType := multipartBody(t, []byte("hello"))
+		req := httptest.NewRequest(http.MethodPost, "/attachments", body)
+		req.Header.Set("Content-Type", contentType)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var resp attachments.UploadAttachmentResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, "att-1", resp.ID)
+	})
+
+	t.Run("oversized_body_rejected_before_service", func(t *testing.T) {
+		router, _ := setupHandlerTest(t, limits)
+
+		body, contentType := multipartBody(t, bytes.Repeat([]byte("x"), 200<<10))
+		req := httptest.NewRequest(http.MethodPost, "/attachments", body)
+		req.Header.Set("Content-Type", contentType)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	})
+
+	t.Run("service_limit_maps_to_413", func(t *testing.T) {
+		router, mockService := setupHandlerTest(t, limits)
+		mockService.EXPECT().
+			UploadAttachment(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, attachments.ErrFileTooLarge)
+
+		body, contentType := multipartBody(t, []byte("hello"))
+		req := httptest.NewRequest(http.MethodPost, "/attachments", body)
+		req.Header.Set("Content-Type", contentType)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	})
+
+	t.Run("missing_file", func(t *testing.T) {
+		router, _ := setupHandlerTest(t, limits)
+
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		require.NoError(t, writer.WriteField("note", "no file"))
+		require.NoError(t, writer.Close())
+		req := httptest.NewRequest(http.MethodPost, "/attachments", body)
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -2,12 +2,22 @@ package attachments
 
 import (
 	"context"
-	"mime/multipart"
+	"io"
 )
 
+//go:generate mockgen -destination=../../internal/mocks/mock_attachments_service.go -package=mocks care-cordination/features/attachments AttachmentsService
 type AttachmentsService interface {
+	// UploadAttachment streams file to object storage, failing with ErrFileTooLarge
+	// once it exceeds the size limit for its content type. filename is kept for
+	// display only.
 	UploadAttachment(
 		ctx context.Context,
-		file *multipart.FileHeader,
+		file io.Reader,
+		filename string,
+		contentType string,
 	) (*UploadAttachmentResponse, error)
+	// DownloadAttachment records the access and returns a short-lived download URL
+	DownloadAttachment(ctx context.Context, id string) (*DownloadAttachmentResponse, error)
+	// ListAttachmentAccess returns every recorded download of the attachment, newest first
+	ListAttachmentAccess(ctx context.Context, id string) ([]AttachmentAccessResponse, error)
 }
@@ -0,0 +1,49 @@
+package attachments
+
+import (
+	"io"
+	"mime"
+	"strings"
+)
+
+// UploadLimits caps attachment sizes in bytes. MaxSize applies to every upload;
+// PerContentType holds tighter caps keyed by lower-case media type.
+type UploadLimits struct {
+	MaxSize        int64
+	PerContentType map[string]int64
+}
+
+// For returns the size cap for an upload of the given content type
+func (l UploadLimits) For(contentType string) int64 {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType = strings.ToLower(strings.TrimSpace(contentType))
+	}
+	if limit, ok := l.PerContentType[mediaType]; ok && limit < l.MaxSize {
+		return limit
+	}
+	return l.MaxSize
+}
+
+// sizeLimitedReader fails with ErrFileTooLarge once more than limit bytes are
+// read, so an oversized stream is cut off instead of being stored.
+type sizeLimitedReader struct {
+	r        io.Reader
+	limit    int64
+	read     int64
+	exceeded bool
+}
+
+func (l *sizeLimitedReader) Read(p []byte) (int, error) {
+	// Read one byte past the limit to tell "exactly at the limit" from "over it"
+	if remaining := l.limit + 1 - l.read; int64(len(p)) > remaining {
+		p = p[:remaining]
+	}
+	n, err := l.r.Read(p)
+	l.read += int64(n)
+	if l.read > l.limit {
+		l.exceeded = true
+		return n, ErrFileTooLarge
+	}
+	return n, err
+}
@@ -1,50 +1,66 @@
 package attachments
 
 import (
+	"care-cordination/lib/audit"
 	"care-cordination/lib/bucket"
 	db "care-cordination/lib/db/sqlc"
 	"care-cordination/lib/logger"
 	"care-cordination/lib/nanoid"
+	"care-cordination/lib/util"
 	"context"
-	"mime/multipart"
+	"errors"
+	"io"
+	"net/http"
+	"time"
 
+	"github.com/jackc/pgx/v5"
 	"go.uber.org/zap"
 )
 
+// downloadURLExpiry is how long a presigned download URL stays valid. It is
+// kept short so every download goes through DownloadAttachment and is logged.
+const downloadURLExpiry = 5 * time.Minute
+
 type attachmentsService struct {
-	db     *db.Store
+	db     db.StoreInterface
 	bucket bucket.ObjectStorage
 	logger logger.Logger
+	limits UploadLimits
 }
 
 func NewAttachmentsService(
-	db *db.Store,
+	db db.StoreInterface,
 	bucket bucket.ObjectStorage,
 	logger logger.Logger,
+	limits UploadLimits,
 ) AttachmentsService {
 	return &attachmentsService{
 		db:     db,
 		bucket: bucket,
 		logger: logger,
+		limits: limits,
 	}
 }
 
 func (s *attachmentsService) UploadAttachment(
 	ctx context.Context,
-	file *multipart.FileHeader,
+	file io.Reader,
+	filename string,
+	contentType string,
 ) (*UploadAttachmentResponse, error) {
 	id := nanoid.Generate()
 
-	// Open the file
-	src, err := file.Open()
-	if err != nil {
-		s.logger.Error(ctx, "UploadAttachment", "Failed to open file", zap.Error(err))
-		return nil, ErrInvalidFile
+	// Stream straight to object storage; the limit aborts the upload as soon as
+	// the file grows past its cap, so an oversized file is never stored
+	src := &sizeLimitedReader{r: file, limit: s.limits.For(contentType)}
+	fileKey, err := s.bucket.UploadObject(ctx, id, src, contentType)
+	if src.exceeded {
+		return nil, ErrFileTooLarge
+	}
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return nil, ErrFileTooLarge
 	}
-	defer src.Close()
-
-	// Upload to object storage
-	fileKey, err := s.bucket.UploadObject(ctx, id, src, file.Header.Get("Content-Type"))
 	if err != nil {
 		s.logger.Error(
 			ctx,
@@ -55,11 +71,14 @@ func (s *attachmentsService) UploadAttachment(
 		return nil, ErrInternal
 	}
 
-	// Save attachment metadata to database
+	// Save attachment metadata to database, recording the uploader so the
+	// attachment can only be linked to forms by the same user
 	err = s.db.CreateAttachment(ctx, db.CreateAttachmentParams{
 		ID:          id,
 		Filekey:     fileKey,
-		ContentType: file.Header.Get("Content-Type"),
+		ContentType: contentType,
+		UploadedBy:  util.GetUserIDPtr(ctx),
+		Filename:    audit.StrToPtr(filename),
 	})
 	if err != nil {
 		s.logger.Error(
@@ -75,3 +94,123 @@ func (s *attachmentsService) UploadAttachment(
 		ID: id,
 	}, nil
 }
+
+func (s *attachmentsService) DownloadAttachment(
+	ctx context.Context,
+	id string,
+) (*DownloadAttachmentResponse, error) {
+	attachment, err := s.db.GetAttachmentForDownload(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		s.logger.Error(ctx, "DownloadAttachment", "Failed to get attachment", zap.Error(err))
+		return nil, ErrInternal
+	}
+	allowed, err := s.canDownload(ctx, attachment)
+	if err != nil {
+		s.logger.Error(ctx, "DownloadAttachment", "Failed to check attachment access", zap.Error(err))
+		return nil, ErrInternal
+	}
+	// Files the caller may not see are reported as missing, so their IDs
+	// cannot be probed
+	if !allowed {
+		return nil, ErrNotFound
+	}
+
+	url, err := s.bucket.PresignGetObject(ctx, attachment.Filekey, downloadURLExpiry)
+	if err != nil {
+		s.logger.Error(ctx, "DownloadAttachment", "Failed to presign download URL", zap.Error(err))
+		return nil, ErrInternal
+	}
+	expiresAt := time.Now().Add(downloadURLExpiry)
+
+	// Record what the file belongs to, so auditors can tie the access to a client
+	var resourceType, resourceID *string
+	switch {
+	case attachment.RegistrationFormID != nil:
+		resourceType = util.StrPtr(audit.ResourceTypeRegistration)
+		resourceID = attachment.RegistrationFormID
+	case attachment.ClientID != nil:
+		resourceType = util.StrPtr(audit.ResourceTypeClient)
+		resourceID = attachment.ClientID
+		util.SetClientID(ctx, *attachment.ClientID)
+	}
+
+	// The URL is only handed out once the access is on record
+	err = s.db.CreateAttachmentAccess(ctx, db.CreateAttachmentAccessParams{
+		ID:           nanoid.Generate(),
+		AttachmentID: attachment.ID,
+		UserID:       util.GetUserIDPtr(ctx),
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		IpAddress:    audit.StrToPtr(util.GetIPAddress(ctx)),
+		UserAgent:    audit.StrToPtr(util.GetUserAgent(ctx)),
+	})
+	if err != nil {
+		s.logger.Error(ctx, "DownloadAttachment", "Failed to record attachment access", zap.Error(err))
+		return nil, ErrInternal
+	}
+
+	return &DownloadAttachmentResponse{
+		URL:         url,
+		ContentType: attachment.ContentType,
+		ExpiresAt:   expiresAt,
+	}, nil
+}
+
+// canDownload reports whether the caller may download the attachment: it must
+// belong to the caller's organization, and the caller needs read access to the
+// registration form or client it is attached to. A file not attached to
+// anything yet is only visible to its uploader.
+func (s *attachmentsService) canDownload(
+	ctx context.Context,
+	attachment db.GetAttachmentForDownloadRow,
+) (bool, error) {
+	organizationID := util.GetOrganizationID(ctx)
+	if organizationID == "" || attachment.OrganizationID == nil || *attachment.OrganizationID != organizationID {
+		return false, nil
+	}
+
+	userID := util.GetUserID(ctx)
+	var resource string
+	switch {
+	case attachment.RegistrationFormID != nil:
+		resource = "registration"
+	case attachment.ClientID != nil:
+		resource = "client"
+	default:
+		return attachment.UploadedBy != nil && *attachment.UploadedBy == userID, nil
+	}
+	return s.db.HasPermission(ctx, db.HasPermissionParams{
+		UserID:   userID,
+		Resource: resource,
+		Action:   "read",
+	})
+}
+
+func (s *attachmentsService) ListAttachmentAccess(
+	ctx context.Context,
+	id string,
+) ([]AttachmentAccessResponse, error) {
+	rows, err := s.db.ListAttachmentAccess(ctx, id)
+	if err != nil {
+		s.logger.Error(ctx, "ListAttachmentAccess", "Failed to list attachment access", zap.Error(err))
+		return nil, ErrInternal
+	}
+
+	result := make([]AttachmentAccessResponse, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, AttachmentAccessResponse{
+			ID:           row.ID,
+			UserID:       row.UserID,
+			UserEmail:    row.UserEmail,
+			ResourceType: row.ResourceType,
+			ResourceID:   row.ResourceID,
+			IPAddress:    row.IpAddress,
+			UserAgent:    row.UserAgent,
+			AccessedAt:   row.AccessedAt.Time,
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,242 @@
+package attachments
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	db "care-cordination/lib/db/sqlc"
+	dbmocks "care-cordination/lib/db/sqlc/mocks"
+	loggermocks "care-cordination/lib/logger/mocks"
+	"care-cordination/lib/util"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// fakeStorage consumes uploads like the real client and records what it stored
+type fakeStorage struct {
+	stored map[string][]byte
+}
+
+func (f *fakeStorage) UploadObject(_ context.Context, fileKey string, file io.Reader, _ string) (string, error) {
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return "", err
+	}
+	f.stored[fileKey] = data
+	return fileKey, nil
+}
+
+func (f *fakeStorage) PresignGetObject(_ context.Context, fileKey string, _ time.Duration) (string, error) {
+	return "https://storage.test/" + fileKey, nil
+}
+
+func TestUploadLimitsFor(t *testing.T) {
+	limits := UploadLimits{
+		MaxSize:        100,
+		PerContentType: map[string]int64{"image/png": 10, "video/mp4": 500},
+	}
+
+	assert.Equal(t, int64(10), limits.For("image/png"))
+	assert.Equal(t, int64(10), limits.For("Image/PNG; charset=binary"))
+	assert.Equal(t, int64(100), limits.For("application/pdf"))
+	// A per-type limit can only tighten the global cap
+	assert.Equal(t, int64(100), limits.For("video/mp4"))
+}
+
+func TestUploadAttachment_TooLarge(t *testing.T) {
+	storage := &fakeStorage{stored: map[string][]byte{}}
+	service := NewAttachmentsService(nil, storage, nil, UploadLimits{
+		MaxSize:        100,
+		PerContentType: map[string]int64{"image/png": 10},
+	})
+
+	_, err := service.UploadAttachment(context.Background(), strings.NewReader(strings.Repeat("x", 11)), "photo.png", "image/png")
+	require.ErrorIs(t, err, ErrFileTooLarge)
+	assert.Empty(t, storage.stored)
+}
+
+func TestDownloadAttachment(t *testing.T) {
+	ctx := context.WithValue(context.Background(), util.UserIDKey, "user-1")
+	ctx = context.WithValue(ctx, util.OrganizationIDKey, "org-1")
+	attachment := db.GetAttachmentForDownloadRow{
+		ID:                 "att-1",
+		Filekey:            "att-1",
+		ContentType:        "application/pdf",
+		RegistrationFormID: util.StrPtr("reg-1"),
+		OrganizationID:     util.StrPtr("org-1"),
+	}
+	registrationRead := db.HasPermissionParams{UserID: "user-1", Resource: "registration", Action: "read"}
+
+	t.Run("records_access_for_user", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := dbmocks.NewMockStoreInterface(ctrl)
+		mockStore.EXPECT().GetAttachmentForDownload(gomock.Any(), "att-1").Return(attachment, nil)
+		mockStore.EXPECT().HasPermission(gomock.Any(), registrationRead).Return(true, nil)
+		mockStore.EXPECT().
+			CreateAttachmentAccess(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, arg db.CreateAttachmentAccessParams) error {
+				assert.NotEmpty(t, arg.ID)
+				assert.Equal(t, "att-1", arg.AttachmentID)
+				require.NotNil(t, arg.UserID)
+				assert.Equal(t, "user-1", *arg.UserID)
+				require.NotNil(t, arg.ResourceType)
+				assert.Equal(t, "registration", *arg.ResourceType)
+				assert.Equal(t, util.StrPtr("reg-1"), arg.ResourceID)
+				return nil
+			})
+
+		service := NewAttachmentsService(mockStore, &fakeStorage{}, loggermocks.NewMockLogger(ctrl), UploadLimits{})
+		resp, err := service.DownloadAttachment(ctx, "att-1")
+		require.NoError(t, err)
+		assert.Equal(t, "https://storage.test/att-1", resp.URL)
+		assert.Equal(t, "application/pdf", resp.ContentType)
+		assert.WithinDuration(t, time.Now().Add(downloadURLExpiry), resp.ExpiresAt, time.Minute)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := dbmocks.NewMockStoreInterface(ctrl)
+		mockStore.EXPECT().
+			GetAttachmentForDownload(gomock.Any(), "missing").
+			Return(db.GetAttachmentForDownloadRow{}, pgx.ErrNoRows)
+
+		service := NewAttachmentsService(mockStore, &fakeStorage{}, loggermocks.NewMockLogger(ctrl), UploadLimits{})
+		_, err := service.DownloadAttachment(ctx, "missing")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("no_url_without_access_record", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := dbmocks.NewMockStoreInterface(ctrl)
+		mockLogger := loggermocks.NewMockLogger(ctrl)
+		mockLogger.EXPECT().Error(gomock.Any(), "DownloadAttachment", gomock.Any(), gomock.Any())
+		mockStore.EXPECT().GetAttachmentForDownload(gomock.Any(), "att-1").Return(attachment, nil)
+		mockStore.EXPECT().HasPermission(gomock.Any(), registrationRead).Return(true, nil)
+		mockStore.EXPECT().
+			CreateAttachmentAccess(gomock.Any(), gomock.Any()).
+			Return(errors.New("db down"))
+
+		service := NewAttachmentsService(mockStore, &fakeStorage{}, mockLogger, UploadLimits{})
+		resp, err := service.DownloadAttachment(ctx, "att-1")
+		assert.ErrorIs(t, err, ErrInternal)
+		assert.Nil(t, resp)
+	})
+}
+
+func TestDownloadAttachment_Access(t *testing.T) {
+	ctx := context.WithValue(context.Background(), util.UserIDKey, "user-1")
+	ctx = context.WithValue(ctx, util.OrganizationIDKey, "org-1")
+
+	tests := []struct {
+		name       string
+		attachment db.GetAttachmentForDownloadRow
+		setup      func(mockStore *dbmocks.MockStoreInterface)
+		wantErr    error
+	}{
+		{
+			name: "client_file_with_client_read",
+			attachment: db.GetAttachmentForDownloadRow{
+				ClientID:       util.StrPtr("client-1"),
+				OrganizationID: util.StrPtr("org-1"),
+			},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					HasPermission(gomock.Any(), db.HasPermissionParams{UserID: "user-1", Resource: "client", Action: "read"}).
+					Return(true, nil)
+				mockStore.EXPECT().CreateAttachmentAccess(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "registration_file_without_registration_read",
+			attachment: db.GetAttachmentForDownloadRow{
+				RegistrationFormID: util.StrPtr("reg-1"),
+				OrganizationID:     util.StrPtr("org-1"),
+			},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					HasPermission(gomock.Any(), db.HasPermissionParams{UserID: "user-1", Resource: "registration", Action: "read"}).
+					Return(false, nil)
+			},
+			wantErr: ErrNotFound,
+		},
+		{
+			name: "file_of_another_organization",
+			attachment: db.GetAttachmentForDownloadRow{
+				ClientID:       util.StrPtr("client-1"),
+				OrganizationID: util.StrPtr("org-2"),
+			},
+			setup:   func(mockStore *dbmocks.MockStoreInterface) {},
+			wantErr: ErrNotFound,
+		},
+		{
+			name: "file_without_organization",
+			attachment: db.GetAttachmentForDownloadRow{
+				ClientID: util.StrPtr("client-1"),
+			},
+			setup:   func(mockStore *dbmocks.MockStoreInterface) {},
+			wantErr: ErrNotFound,
+		},
+		{
+			name: "unattached_file_of_the_caller",
+			attachment: db.GetAttachmentForDownloadRow{
+				UploadedBy:     util.StrPtr("user-1"),
+				OrganizationID: util.StrPtr("org-1"),
+			},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().CreateAttachmentAccess(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "unattached_file_of_a_colleague",
+			attachment: db.GetAttachmentForDownloadRow{
+				UploadedBy:     util.StrPtr("user-2"),
+				OrganizationID: util.StrPtr("org-1"),
+			},
+			setup:   func(mockStore *dbmocks.MockStoreInterface) {},
+			wantErr: ErrNotFound,
+		},
+		{
+			name: "permission_check_error",
+			attachment: db.GetAttachmentForDownloadRow{
+				ClientID:       util.StrPtr("client-1"),
+				OrganizationID: util.StrPtr("org-1"),
+			},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().HasPermission(gomock.Any(), gomock.Any()).Return(false, errors.New("db down"))
+			},
+			wantErr: ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockStore := dbmocks.NewMockStoreInterface(ctrl)
+			mockLogger := loggermocks.NewMockLogger(ctrl)
+			mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+			tt.attachment.ID = "att-1"
+			tt.attachment.Filekey = "att-1"
+			mockStore.EXPECT().GetAttachmentForDownload(gomock.Any(), "att-1").Return(tt.attachment, nil)
+			tt.setup(mockStore)
+
+			service := NewAttachmentsService(mockStore, &fakeStorage{}, mockLogger, UploadLimits{})
+			resp, err := service.DownloadAttachment(ctx, "att-1")
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, resp)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "https://storage.test/att-1", resp.URL)
+		})
+	}
+}
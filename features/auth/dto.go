@@ -10,6 +10,9 @@ type LoginResponse struct {
 	RefreshToken string `json:"refreshToken"`
 	MFARequired  bool   `json:"mfaRequired"`
 	PreAuthToken string `json:"preAuthToken"`
+	// Role and Permissions are empty while MFA verification is pending
+	Role        string   `json:"role"`
+	Permissions []string `json:"permissions"`
 }
 
 type RefreshTokensRequest struct {
@@ -17,8 +20,10 @@ type RefreshTokensRequest struct {
 }
 
 type RefreshTokensResponse struct {
-	AccessToken  string `json:"accessToken"`
-	RefreshToken string `json:"refreshToken"`
+	AccessToken  string   `json:"accessToken"`
+	RefreshToken string   `json:"refreshToken"`
+	Role         string   `json:"role"`
+	Permissions  []string `json:"permissions"`
 }
 
 type LogoutRequest struct {
@@ -27,7 +32,7 @@ type LogoutRequest struct {
 
 type ResetPasswordRequest struct {
 	CurrentPassword string `json:"currentPassword" binding:"required"`
-	NewPassword     string `json:"newPassword" binding:"required,min=6"`
+	NewPassword     string `json:"newPassword" binding:"required"`
 }
 
 type SetupMFAResponse struct {
@@ -49,8 +54,10 @@ type VerifyMFARequest struct {
 }
 
 type VerifyMFAResponse struct {
-	AccessToken  string `json:"accessToken"`
-	RefreshToken string `json:"refreshToken"`
+	AccessToken  string   `json:"accessToken"`
+	RefreshToken string   `json:"refreshToken"`
+	Role         string   `json:"role"`
+	Permissions  []string `json:"permissions"`
 }
 
 type DisableMFARequest struct {
@@ -9,5 +9,6 @@ var (
 	ErrInvalidMFACode     = errors.New("invalid_mfa_code")
 	ErrMFANotSetup        = errors.New("mfa_not_setup")
 	ErrMFAAlreadyEnabled  = errors.New("mfa_already_enabled")
+	ErrAccountLocked      = errors.New("account_temporarily_locked")
 	ErrInternal           = errors.New("internal")
 )
@@ -2,8 +2,11 @@ package auth
 
 import (
 	"care-cordination/lib/middleware"
+	"care-cordination/lib/password"
 	"care-cordination/lib/ratelimit"
 	"care-cordination/lib/resp"
+	"care-cordination/lib/util"
+	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -57,6 +60,7 @@ func (h *AuthHandler) SetupAuthRoutes(
 // @Success 200 {object} resp.SuccessResponse[LoginResponse]
 // @Failure 400 {object} resp.ErrorResponse
 // @Failure 401 {object} resp.ErrorResponse
+// @Failure 423 {object} resp.ErrorResponse
 // @Failure 500 {object} resp.ErrorResponse
 // @Router /auth/login [post]
 // @Security -
@@ -68,11 +72,13 @@ func (h *AuthHandler) Login(ctx *gin.Context) {
 		return
 	}
 
-	user, err := h.authService.Login(ctx, &req, ctx.Request.UserAgent(), ctx.ClientIP())
+	user, err := h.authService.Login(ctx, &req, ctx.Request.UserAgent(), util.GetIPAddress(ctx))
 	if err != nil {
 		switch err {
 		case ErrInvalidCredentials:
 			ctx.JSON(http.StatusUnauthorized, resp.Error(err))
+		case ErrAccountLocked:
+			ctx.JSON(http.StatusLocked, resp.Error(err))
 		case ErrInternal:
 			ctx.JSON(http.StatusInternalServerError, resp.Error(err))
 		default:
@@ -100,7 +106,7 @@ func (h *AuthHandler) RefreshTokens(ctx *gin.Context) {
 		ctx.JSON(http.StatusBadRequest, resp.Error(ErrInvalidRequest))
 		return
 	}
-	tokens, err := h.authService.RefreshTokens(ctx, &req, ctx.Request.UserAgent(), ctx.ClientIP())
+	tokens, err := h.authService.RefreshTokens(ctx, &req, ctx.Request.UserAgent(), util.GetIPAddress(ctx))
 	if err != nil {
 		switch err {
 		case ErrInvalidToken:
@@ -148,7 +154,7 @@ func (h *AuthHandler) Logout(ctx *gin.Context) {
 }
 
 // @Summary Reset password
-// @Description Reset password for the authenticated user
+// @Description Reset password for the authenticated user; the new password must meet the password policy
 // @Tags Auth
 // @Accept json
 // @Produce json
@@ -166,6 +172,10 @@ func (h *AuthHandler) ResetPassword(ctx *gin.Context) {
 	}
 
 	if err := h.authService.ResetPassword(ctx, &req); err != nil {
+		if errors.Is(err, password.ErrWeakPassword) {
+			ctx.JSON(http.StatusBadRequest, resp.Error(err))
+			return
+		}
 		switch err {
 		case ErrInvalidCredentials, ErrInvalidToken:
 			ctx.JSON(http.StatusUnauthorized, resp.Error(err))
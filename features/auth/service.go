@@ -4,24 +4,41 @@ import (
 	db "care-cordination/lib/db/sqlc"
 	"care-cordination/lib/logger"
 	"care-cordination/lib/nanoid"
+	"care-cordination/lib/password"
 	"care-cordination/lib/token"
 	"care-cordination/lib/util"
 	"context"
 	"encoding/json"
+	"errors"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/pquerna/otp/totp"
 	"go.uber.org/zap"
 	"golang.org/x/crypto/bcrypt"
 )
 
+// LockoutPolicy controls how many consecutive failed password attempts an
+// account tolerates before it is locked, and for how long.
+type LockoutPolicy struct {
+	MaxAttempts int
+	Duration    time.Duration
+}
+
+var DefaultLockoutPolicy = LockoutPolicy{
+	MaxAttempts: 5,
+	Duration:    15 * time.Minute,
+}
+
 type authService struct {
 	db           db.StoreInterface
 	tokenManager token.TokenManager
 	logger       logger.Logger
 	mfaSecretKey string
 	mfaIssuer    string
+	lockout      LockoutPolicy
+	passwords    password.Policy
 }
 
 func NewAuthService(
@@ -34,6 +51,8 @@ func NewAuthService(
 		tokenManager: tokenManager,
 		logger:       logger,
 		mfaIssuer:    "care-coordination",
+		lockout:      DefaultLockoutPolicy,
+		passwords:    password.DefaultPolicy,
 	}
 }
 
@@ -43,6 +62,8 @@ func NewAuthServiceWithMFA(
 	logger logger.Logger,
 	mfaSecretKey string,
 	mfaIssuer string,
+	lockout LockoutPolicy,
+	passwords password.Policy,
 ) AuthService {
 	return &authService{
 		db:           db,
@@ -50,6 +71,8 @@ func NewAuthServiceWithMFA(
 		logger:       logger,
 		mfaSecretKey: mfaSecretKey,
 		mfaIssuer:    mfaIssuer,
+		lockout:      lockout,
+		passwords:    passwords,
 	}
 }
 
@@ -65,9 +88,21 @@ func (s *authService) Login(
 		return nil, ErrInvalidCredentials
 	}
 
+	if isLocked(user.LockedUntil, time.Now()) {
+		s.logger.Error(ctx, "Login", "Account temporarily locked", zap.String("email", req.Email))
+		return nil, ErrAccountLocked
+	}
+
 	if err := s.comparePassword(user.PasswordHash, req.Password); err != nil {
 		s.logger.Error(ctx, "Login", "Invalid password", zap.String("email", req.Email))
-		return nil, ErrInvalidCredentials
+		return nil, s.recordFailedLogin(ctx, user.ID)
+	}
+
+	if user.FailedLoginAttempts > 0 || user.LockedUntil.Valid {
+		if err := s.db.ResetFailedLogins(ctx, user.ID); err != nil {
+			s.logger.Error(ctx, "Login", "Failed to reset failed login attempts", zap.Error(err))
+			return nil, ErrInternal
+		}
 	}
 
 	employee, err := s.db.GetEmployeeByUserID(ctx, user.ID)
@@ -93,7 +128,17 @@ func (s *authService) Login(
 		}, nil
 	}
 
-	accessToken, err := s.tokenManager.GenerateAccessToken(user.ID, employee.ID, time.Now())
+	role, permissions, err := s.getUserAccess(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken, err := s.tokenManager.GenerateAccessToken(
+		user.ID,
+		employee.ID,
+		util.HandleNilString(employee.OrganizationID),
+		time.Now(),
+	)
 	if err != nil {
 		s.logger.Error(
 			ctx,
@@ -125,13 +170,69 @@ func (s *authService) Login(
 		}); err != nil {
 		return nil, ErrInternal
 	}
+	// The session is already stored; a missed last-login timestamp must not fail the login
+	if err := s.db.RecordLogin(ctx, user.ID); err != nil {
+		s.logger.Error(ctx, "Login", "Failed to record login", zap.Error(err))
+	}
 	return &LoginResponse{
 		AccessToken:  accessToken,
 		RefreshToken: refreshToken,
+		Role:         role,
+		Permissions:  permissions,
 	}, nil
 
 }
 
+// recordFailedLogin counts a wrong password against the user and returns the
+// error Login should report: ErrAccountLocked when this attempt triggered the
+// lockout, ErrInvalidCredentials otherwise.
+func (s *authService) recordFailedLogin(ctx context.Context, userID string) error {
+	result, err := s.db.RecordFailedLogin(ctx, db.RecordFailedLoginParams{
+		MaxAttempts:    int32(s.lockout.MaxAttempts),
+		LockoutSeconds: int32(s.lockout.Duration / time.Second),
+		ID:             userID,
+	})
+	if err != nil {
+		s.logger.Error(ctx, "Login", "Failed to record failed login", zap.Error(err))
+		return ErrInvalidCredentials
+	}
+	if isLocked(result.LockedUntil, time.Now()) {
+		return ErrAccountLocked
+	}
+	return ErrInvalidCredentials
+}
+
+func isLocked(lockedUntil pgtype.Timestamptz, now time.Time) bool {
+	return lockedUntil.Valid && lockedUntil.Time.After(now)
+}
+
+// getUserAccess returns the user's role name and resource:action permissions so
+// the frontend can gate actions without a second call. They stay out of the
+// token claims, which only identify the user. A user without a role gets an
+// empty role and no permissions.
+func (s *authService) getUserAccess(ctx context.Context, userID string) (string, []string, error) {
+	role := ""
+	userRole, err := s.db.GetRoleForUser(ctx, userID)
+	switch {
+	case err == nil:
+		role = userRole.Name
+	case !errors.Is(err, pgx.ErrNoRows):
+		s.logger.Error(ctx, "getUserAccess", "Failed to get role for user", zap.String("userID", userID), zap.Error(err))
+		return "", nil, ErrInternal
+	}
+
+	permissions, err := s.db.ListPermissionKeysForUser(ctx, userID)
+	if err != nil {
+		s.logger.Error(ctx, "getUserAccess", "Failed to list permissions for user", zap.String("userID", userID), zap.Error(err))
+		return "", nil, ErrInternal
+	}
+	// The query yields nil without rows; clients expect [] rather than null
+	if permissions == nil {
+		permissions = []string{}
+	}
+	return role, permissions, nil
+}
+
 func (s *authService) RefreshTokens(
 	ctx context.Context,
 	req *RefreshTokensRequest,
@@ -168,15 +269,22 @@ func (s *authService) RefreshTokens(
 		return nil, ErrInvalidToken
 	}
 	// Try to get employee ID
-	employeeID := ""
+	employeeID, organizationID := "", ""
 	employee, err := s.db.GetEmployeeByUserID(ctx, userSession.UserID)
 	if err == nil {
 		employeeID = employee.ID
+		organizationID = util.HandleNilString(employee.OrganizationID)
+	}
+
+	role, permissions, err := s.getUserAccess(ctx, userSession.UserID)
+	if err != nil {
+		return nil, err
 	}
 
 	accessToken, err := s.tokenManager.GenerateAccessToken(
 		userSession.UserID,
 		employeeID,
+		organizationID,
 		time.Now(),
 	)
 	if err != nil {
@@ -221,6 +329,8 @@ func (s *authService) RefreshTokens(
 	return &RefreshTokensResponse{
 		AccessToken:  accessToken,
 		RefreshToken: refreshToken,
+		Role:         role,
+		Permissions:  permissions,
 	}, nil
 }
 
@@ -257,6 +367,10 @@ func (s *authService) ResetPassword(ctx context.Context, req *ResetPasswordReque
 		return ErrInvalidCredentials
 	}
 
+	if err := s.passwords.Validate(req.NewPassword); err != nil {
+		return err
+	}
+
 	passwordHash, err := s.hashPassword(req.NewPassword)
 	if err != nil {
 		s.logger.Error(ctx, "ResetPassword", "Failed to generate password hash", zap.Error(err))
@@ -393,13 +507,19 @@ func (s *authService) VerifyMFA(ctx context.Context, req *VerifyMFARequest) (*Ve
 		return nil, ErrInvalidMFACode
 	}
 
-	employeeID := ""
+	employeeID, organizationID := "", ""
 	employee, err := s.db.GetEmployeeByUserID(ctx, userID)
 	if err == nil {
 		employeeID = employee.ID
+		organizationID = util.HandleNilString(employee.OrganizationID)
 	}
 
-	accessToken, err := s.tokenManager.GenerateAccessToken(userID, employeeID, time.Now())
+	role, permissions, err := s.getUserAccess(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken, err := s.tokenManager.GenerateAccessToken(userID, employeeID, organizationID, time.Now())
 	if err != nil {
 		s.logger.Error(ctx, "VerifyMFA", "Failed to generate access token", zap.String("userID", userID))
 		return nil, ErrInternal
@@ -425,10 +545,16 @@ func (s *authService) VerifyMFA(ctx context.Context, req *VerifyMFARequest) (*Ve
 		s.logger.Error(ctx, "VerifyMFA", "Failed to store user session", zap.String("userID", userID), zap.Error(err))
 		return nil, ErrInternal
 	}
+	// The session is already stored; a missed last-login timestamp must not fail the login
+	if err := s.db.RecordLogin(ctx, userID); err != nil {
+		s.logger.Error(ctx, "VerifyMFA", "Failed to record login", zap.String("userID", userID), zap.Error(err))
+	}
 
 	return &VerifyMFAResponse{
 		AccessToken:  accessToken,
 		RefreshToken: refreshToken,
+		Role:         role,
+		Permissions:  permissions,
 	}, nil
 }
 
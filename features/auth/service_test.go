@@ -2,6 +2,7 @@ package auth
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"testing"
 	"time"
@@ -9,6 +10,7 @@ import (
 	db "care-cordination/lib/db/sqlc"
 	dbmocks "care-cordination/lib/db/sqlc/mocks"
 	loggermocks "care-cordination/lib/logger/mocks"
+	"care-cordination/lib/password"
 	"care-cordination/lib/token"
 	tokenmocks "care-cordination/lib/token/mocks"
 
@@ -41,6 +43,21 @@ func createTestRefreshClaims(tokenHash, tokenFamily string) *token.RefreshTokenC
 	}
 }
 
+// coordinatorPermissions are the permissions seeded for the coordinator role
+var coordinatorPermissions = []string{
+	"client:read", "client:write", "employee:read", "incident:read", "incident:write",
+	"intake:read", "intake:write", "location:read", "registration:read", "registration:write",
+}
+
+func expectUserAccess(mockStore *dbmocks.MockStoreInterface, userID string) {
+	mockStore.EXPECT().
+		GetRoleForUser(gomock.Any(), userID).
+		Return(db.Role{ID: "role_coordinator", Name: "coordinator"}, nil)
+	mockStore.EXPECT().
+		ListPermissionKeysForUser(gomock.Any(), userID).
+		Return(coordinatorPermissions, nil)
+}
+
 // ============================================================
 // Test: Login
 // ============================================================
@@ -85,8 +102,61 @@ func TestLogin(t *testing.T) {
 					GetEmployeeByUserID(gomock.Any(), "user-123").
 					Return(db.GetEmployeeByUserIDRow{ID: "employee-123"}, nil)
 
+				expectUserAccess(mockStore, "user-123")
+
+				mockToken.EXPECT().
+					GenerateAccessToken("user-123", "employee-123", "", gomock.Any()).
+					Return("access-token-123", nil)
+
+				mockToken.EXPECT().
+					GenerateRefreshToken("user-123", gomock.Any()).
+					Return("refresh-token-123", createTestRefreshClaims("token-hash", "token-family"), nil)
+
+				mockStore.EXPECT().
+					CreateUserSession(gomock.Any(), gomock.Any()).
+					Return(nil)
+
+				mockStore.EXPECT().
+					RecordLogin(gomock.Any(), "user-123").
+					Return(nil)
+			},
+			wantErr: false,
+			validate: func(t *testing.T, resp *LoginResponse) {
+				assert.Equal(t, "access-token-123", resp.AccessToken)
+				assert.Equal(t, "refresh-token-123", resp.RefreshToken)
+				assert.Equal(t, "coordinator", resp.Role)
+				assert.Equal(t, coordinatorPermissions, resp.Permissions)
+			},
+		},
+		{
+			name: "record_login_error_does_not_fail_login",
+			req: &LoginRequest{
+				Email:    "test@example.com",
+				Password: "password123",
+			},
+			userAgent: "Mozilla/5.0",
+			ipAddress: "127.0.0.1",
+			setup: func(
+				mockStore *dbmocks.MockStoreInterface,
+				mockToken *tokenmocks.MockTokenManager,
+				hashedPassword string,
+			) {
+				mockStore.EXPECT().
+					GetUserByEmail(gomock.Any(), "test@example.com").
+					Return(db.User{
+						ID:           "user-123",
+						Email:        "test@example.com",
+						PasswordHash: hashedPassword,
+					}, nil)
+
+				mockStore.EXPECT().
+					GetEmployeeByUserID(gomock.Any(), "user-123").
+					Return(db.GetEmployeeByUserIDRow{ID: "employee-123"}, nil)
+
+				expectUserAccess(mockStore, "user-123")
+
 				mockToken.EXPECT().
-					GenerateAccessToken("user-123", "employee-123", gomock.Any()).
+					GenerateAccessToken("user-123", "employee-123", "", gomock.Any()).
 					Return("access-token-123", nil)
 
 				mockToken.EXPECT().
@@ -96,6 +166,10 @@ func TestLogin(t *testing.T) {
 				mockStore.EXPECT().
 					CreateUserSession(gomock.Any(), gomock.Any()).
 					Return(nil)
+
+				mockStore.EXPECT().
+					RecordLogin(gomock.Any(), "user-123").
+					Return(errors.New("database error"))
 			},
 			wantErr: false,
 			validate: func(t *testing.T, resp *LoginResponse) {
@@ -103,6 +177,52 @@ func TestLogin(t *testing.T) {
 				assert.Equal(t, "refresh-token-123", resp.RefreshToken)
 			},
 		},
+		{
+			name: "token_carries_organization",
+			req: &LoginRequest{
+				Email:    "test@example.com",
+				Password: "password123",
+			},
+			userAgent: "Mozilla/5.0",
+			ipAddress: "127.0.0.1",
+			setup: func(
+				mockStore *dbmocks.MockStoreInterface,
+				mockToken *tokenmocks.MockTokenManager,
+				hashedPassword string,
+			) {
+				mockStore.EXPECT().
+					GetUserByEmail(gomock.Any(), "test@example.com").
+					Return(db.User{
+						ID:           "user-123",
+						Email:        "test@example.com",
+						PasswordHash: hashedPassword,
+					}, nil)
+
+				orgID := "org-1"
+				mockStore.EXPECT().
+					GetEmployeeByUserID(gomock.Any(), "user-123").
+					Return(db.GetEmployeeByUserIDRow{ID: "employee-123", OrganizationID: &orgID}, nil)
+
+				expectUserAccess(mockStore, "user-123")
+
+				mockToken.EXPECT().
+					GenerateAccessToken("user-123", "employee-123", "org-1", gomock.Any()).
+					Return("access-token-123", nil)
+
+				mockToken.EXPECT().
+					GenerateRefreshToken("user-123", gomock.Any()).
+					Return("refresh-token-123", createTestRefreshClaims("token-hash", "token-family"), nil)
+
+				mockStore.EXPECT().
+					CreateUserSession(gomock.Any(), gomock.Any()).
+					Return(nil)
+
+				mockStore.EXPECT().
+					RecordLogin(gomock.Any(), "user-123").
+					Return(nil)
+			},
+			wantErr: false,
+		},
 		{
 			name: "user_not_found",
 			req: &LoginRequest{
@@ -143,10 +263,230 @@ func TestLogin(t *testing.T) {
 						Email:        "test@example.com",
 						PasswordHash: hashedPassword,
 					}, nil)
+
+				mockStore.EXPECT().
+					RecordFailedLogin(gomock.Any(), db.RecordFailedLoginParams{
+						MaxAttempts:    5,
+						LockoutSeconds: 900,
+						ID:             "user-123",
+					}).
+					Return(db.RecordFailedLoginRow{FailedLoginAttempts: 1}, nil)
 			},
 			wantErr:     true,
 			expectedErr: ErrInvalidCredentials,
 		},
+		{
+			name: "invalid_password_reaching_threshold_locks_account",
+			req: &LoginRequest{
+				Email:    "test@example.com",
+				Password: "wrongpassword",
+			},
+			userAgent: "Mozilla/5.0",
+			ipAddress: "127.0.0.1",
+			setup: func(
+				mockStore *dbmocks.MockStoreInterface,
+				mockToken *tokenmocks.MockTokenManager,
+				hashedPassword string,
+			) {
+				mockStore.EXPECT().
+					GetUserByEmail(gomock.Any(), "test@example.com").
+					Return(db.User{
+						ID:                  "user-123",
+						Email:               "test@example.com",
+						PasswordHash:        hashedPassword,
+						FailedLoginAttempts: 4,
+					}, nil)
+
+				mockStore.EXPECT().
+					RecordFailedLogin(gomock.Any(), gomock.Any()).
+					Return(db.RecordFailedLoginRow{
+						LockedUntil: pgtype.Timestamptz{
+							Time:  time.Now().Add(15 * time.Minute),
+							Valid: true,
+						},
+					}, nil)
+			},
+			wantErr:     true,
+			expectedErr: ErrAccountLocked,
+		},
+		{
+			name: "locked_account_rejects_correct_password",
+			req: &LoginRequest{
+				Email:    "test@example.com",
+				Password: "password123",
+			},
+			userAgent: "Mozilla/5.0",
+			ipAddress: "127.0.0.1",
+			setup: func(
+				mockStore *dbmocks.MockStoreInterface,
+				mockToken *tokenmocks.MockTokenManager,
+				hashedPassword string,
+			) {
+				mockStore.EXPECT().
+					GetUserByEmail(gomock.Any(), "test@example.com").
+					Return(db.User{
+						ID:           "user-123",
+						Email:        "test@example.com",
+						PasswordHash: hashedPassword,
+						LockedUntil: pgtype.Timestamptz{
+							Time:  time.Now().Add(10 * time.Minute),
+							Valid: true,
+						},
+					}, nil)
+			},
+			wantErr:     true,
+			expectedErr: ErrAccountLocked,
+		},
+		{
+			name: "success_after_failures_clears_lockout",
+			req: &LoginRequest{
+				Email:    "test@example.com",
+				Password: "password123",
+			},
+			userAgent: "Mozilla/5.0",
+			ipAddress: "127.0.0.1",
+			setup: func(
+				mockStore *dbmocks.MockStoreInterface,
+				mockToken *tokenmocks.MockTokenManager,
+				hashedPassword string,
+			) {
+				mockStore.EXPECT().
+					GetUserByEmail(gomock.Any(), "test@example.com").
+					Return(db.User{
+						ID:                  "user-123",
+						Email:               "test@example.com",
+						PasswordHash:        hashedPassword,
+						FailedLoginAttempts: 2,
+						LockedUntil: pgtype.Timestamptz{
+							Time:  time.Now().Add(-time.Minute),
+							Valid: true,
+						},
+					}, nil)
+
+				mockStore.EXPECT().
+					ResetFailedLogins(gomock.Any(), "user-123").
+					Return(nil)
+
+				mockStore.EXPECT().
+					GetEmployeeByUserID(gomock.Any(), "user-123").
+					Return(db.GetEmployeeByUserIDRow{ID: "employee-123"}, nil)
+
+				expectUserAccess(mockStore, "user-123")
+
+				mockToken.EXPECT().
+					GenerateAccessToken("user-123", "employee-123", "", gomock.Any()).
+					Return("access-token-123", nil)
+
+				mockToken.EXPECT().
+					GenerateRefreshToken("user-123", gomock.Any()).
+					Return("refresh-token-123", createTestRefreshClaims("token-hash", "token-family"), nil)
+
+				mockStore.EXPECT().
+					CreateUserSession(gomock.Any(), gomock.Any()).
+					Return(nil)
+
+				mockStore.EXPECT().
+					RecordLogin(gomock.Any(), "user-123").
+					Return(nil)
+			},
+			wantErr: false,
+			validate: func(t *testing.T, resp *LoginResponse) {
+				assert.Equal(t, "access-token-123", resp.AccessToken)
+			},
+		},
+		{
+			name: "user_without_role_gets_no_permissions",
+			req: &LoginRequest{
+				Email:    "test@example.com",
+				Password: "password123",
+			},
+			userAgent: "Mozilla/5.0",
+			ipAddress: "127.0.0.1",
+			setup: func(
+				mockStore *dbmocks.MockStoreInterface,
+				mockToken *tokenmocks.MockTokenManager,
+				hashedPassword string,
+			) {
+				mockStore.EXPECT().
+					GetUserByEmail(gomock.Any(), "test@example.com").
+					Return(db.User{
+						ID:           "user-123",
+						Email:        "test@example.com",
+						PasswordHash: hashedPassword,
+					}, nil)
+
+				mockStore.EXPECT().
+					GetEmployeeByUserID(gomock.Any(), "user-123").
+					Return(db.GetEmployeeByUserIDRow{ID: "employee-123"}, nil)
+
+				mockStore.EXPECT().
+					GetRoleForUser(gomock.Any(), "user-123").
+					Return(db.Role{}, pgx.ErrNoRows)
+
+				mockStore.EXPECT().
+					ListPermissionKeysForUser(gomock.Any(), "user-123").
+					Return(nil, nil)
+
+				mockToken.EXPECT().
+					GenerateAccessToken("user-123", "employee-123", "", gomock.Any()).
+					Return("access-token-123", nil)
+
+				mockToken.EXPECT().
+					GenerateRefreshToken("user-123", gomock.Any()).
+					Return("refresh-token-123", createTestRefreshClaims("token-hash", "token-family"), nil)
+
+				mockStore.EXPECT().
+					CreateUserSession(gomock.Any(), gomock.Any()).
+					Return(nil)
+
+				mockStore.EXPECT().
+					RecordLogin(gomock.Any(), "user-123").
+					Return(nil)
+			},
+			wantErr: false,
+			validate: func(t *testing.T, resp *LoginResponse) {
+				assert.Empty(t, resp.Role)
+				body, err := json.Marshal(resp)
+				require.NoError(t, err)
+				assert.Contains(t, string(body), `"permissions":[]`)
+			},
+		},
+		{
+			name: "permission_lookup_error",
+			req: &LoginRequest{
+				Email:    "test@example.com",
+				Password: "password123",
+			},
+			userAgent: "Mozilla/5.0",
+			ipAddress: "127.0.0.1",
+			setup: func(
+				mockStore *dbmocks.MockStoreInterface,
+				mockToken *tokenmocks.MockTokenManager,
+				hashedPassword string,
+			) {
+				mockStore.EXPECT().
+					GetUserByEmail(gomock.Any(), "test@example.com").
+					Return(db.User{
+						ID:           "user-123",
+						Email:        "test@example.com",
+						PasswordHash: hashedPassword,
+					}, nil)
+
+				mockStore.EXPECT().
+					GetEmployeeByUserID(gomock.Any(), "user-123").
+					Return(db.GetEmployeeByUserIDRow{ID: "employee-123"}, nil)
+
+				mockStore.EXPECT().
+					GetRoleForUser(gomock.Any(), "user-123").
+					Return(db.Role{ID: "role_coordinator", Name: "coordinator"}, nil)
+
+				mockStore.EXPECT().
+					ListPermissionKeysForUser(gomock.Any(), "user-123").
+					Return(nil, errors.New("connection refused"))
+			},
+			wantErr:     true,
+			expectedErr: ErrInternal,
+		},
 		{
 			name: "employee_not_found",
 			req: &LoginRequest{
@@ -200,8 +540,10 @@ func TestLogin(t *testing.T) {
 					GetEmployeeByUserID(gomock.Any(), "user-123").
 					Return(db.GetEmployeeByUserIDRow{ID: "employee-123"}, nil)
 
+				expectUserAccess(mockStore, "user-123")
+
 				mockToken.EXPECT().
-					GenerateAccessToken("user-123", "employee-123", gomock.Any()).
+					GenerateAccessToken("user-123", "employee-123", "", gomock.Any()).
 					Return("", errors.New("token generation failed"))
 			},
 			wantErr:     true,
@@ -232,8 +574,10 @@ func TestLogin(t *testing.T) {
 					GetEmployeeByUserID(gomock.Any(), "user-123").
 					Return(db.GetEmployeeByUserIDRow{ID: "employee-123"}, nil)
 
+				expectUserAccess(mockStore, "user-123")
+
 				mockToken.EXPECT().
-					GenerateAccessToken("user-123", "employee-123", gomock.Any()).
+					GenerateAccessToken("user-123", "employee-123", "", gomock.Any()).
 					Return("access-token", nil)
 
 				mockToken.EXPECT().
@@ -268,8 +612,10 @@ func TestLogin(t *testing.T) {
 					GetEmployeeByUserID(gomock.Any(), "user-123").
 					Return(db.GetEmployeeByUserIDRow{ID: "employee-123"}, nil)
 
+				expectUserAccess(mockStore, "user-123")
+
 				mockToken.EXPECT().
-					GenerateAccessToken("user-123", "employee-123", gomock.Any()).
+					GenerateAccessToken("user-123", "employee-123", "", gomock.Any()).
 					Return("access-token", nil)
 
 				mockToken.EXPECT().
@@ -361,8 +707,10 @@ func TestRefreshTokens(t *testing.T) {
 					GetEmployeeByUserID(gomock.Any(), "user-123").
 					Return(db.GetEmployeeByUserIDRow{ID: "employee-123"}, nil)
 
+				expectUserAccess(mockStore, "user-123")
+
 				mockToken.EXPECT().
-					GenerateAccessToken("user-123", "employee-123", gomock.Any()).
+					GenerateAccessToken("user-123", "employee-123", "", gomock.Any()).
 					Return("new-access-token", nil)
 
 				mockToken.EXPECT().
@@ -377,6 +725,8 @@ func TestRefreshTokens(t *testing.T) {
 			validate: func(t *testing.T, resp *RefreshTokensResponse) {
 				assert.Equal(t, "new-access-token", resp.AccessToken)
 				assert.Equal(t, "new-refresh-token", resp.RefreshToken)
+				assert.Equal(t, "coordinator", resp.Role)
+				assert.Equal(t, coordinatorPermissions, resp.Permissions)
 			},
 		},
 		{
@@ -552,3 +902,113 @@ func TestLogout(t *testing.T) {
 		})
 	}
 }
+
+// ============================================================
+// Test: ResetPassword
+// ============================================================
+
+func TestResetPassword(t *testing.T) {
+	userCtx := context.WithValue(context.Background(), "user_id", "user-123")
+
+	tests := []struct {
+		name        string
+		req         *ResetPasswordRequest
+		setup       func(mockStore *dbmocks.MockStoreInterface, hashedPassword string)
+		wantErr     bool
+		expectedErr error
+	}{
+		{
+			name: "success",
+			req: &ResetPasswordRequest{
+				CurrentPassword: "password123",
+				NewPassword:     "Correct-Horse-42",
+			},
+			setup: func(mockStore *dbmocks.MockStoreInterface, hashedPassword string) {
+				mockStore.EXPECT().
+					GetUserByID(gomock.Any(), "user-123").
+					Return(db.User{ID: "user-123", PasswordHash: hashedPassword}, nil)
+				mockStore.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg db.UpdateUserParams) error {
+						require.NotNil(t, arg.PasswordHash)
+						assert.NoError(t, bcrypt.CompareHashAndPassword(
+							[]byte(*arg.PasswordHash), []byte("Correct-Horse-42"),
+						))
+						return nil
+					})
+			},
+		},
+		{
+			name: "new_password_violates_policy",
+			req: &ResetPasswordRequest{
+				CurrentPassword: "password123",
+				NewPassword:     "short",
+			},
+			setup: func(mockStore *dbmocks.MockStoreInterface, hashedPassword string) {
+				mockStore.EXPECT().
+					GetUserByID(gomock.Any(), "user-123").
+					Return(db.User{ID: "user-123", PasswordHash: hashedPassword}, nil)
+				mockStore.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantErr:     true,
+			expectedErr: password.ErrTooShort,
+		},
+		{
+			name: "new_password_is_common",
+			req: &ResetPasswordRequest{
+				CurrentPassword: "password123",
+				NewPassword:     "Password1234",
+			},
+			setup: func(mockStore *dbmocks.MockStoreInterface, hashedPassword string) {
+				mockStore.EXPECT().
+					GetUserByID(gomock.Any(), "user-123").
+					Return(db.User{ID: "user-123", PasswordHash: hashedPassword}, nil)
+				mockStore.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantErr:     true,
+			expectedErr: password.ErrCommon,
+		},
+		{
+			name: "wrong_current_password",
+			req: &ResetPasswordRequest{
+				CurrentPassword: "wrongpassword",
+				NewPassword:     "Correct-Horse-42",
+			},
+			setup: func(mockStore *dbmocks.MockStoreInterface, hashedPassword string) {
+				mockStore.EXPECT().
+					GetUserByID(gomock.Any(), "user-123").
+					Return(db.User{ID: "user-123", PasswordHash: hashedPassword}, nil)
+			},
+			wantErr:     true,
+			expectedErr: ErrInvalidCredentials,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := dbmocks.NewMockStoreInterface(ctrl)
+			mockLogger := loggermocks.NewMockLogger(ctrl)
+
+			mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+			tt.setup(mockStore, hashPassword(t, "password123"))
+
+			service := NewAuthService(mockStore, nil, mockLogger)
+
+			err := service.ResetPassword(userCtx, tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				if tt.expectedErr != nil {
+					assert.ErrorIs(t, err, tt.expectedErr)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -56,6 +56,10 @@ type UpdateAppointmentRequest struct {
 	Participants   []Participant      `json:"participants"`
 }
 
+type CancelAppointmentRequest struct {
+	Reason string `json:"reason" binding:"required"`
+}
+
 type AppointmentResponse struct {
 	ID             string            `json:"id"`
 	Title          string            `json:"title"`
@@ -70,6 +74,38 @@ type AppointmentResponse struct {
 	Participants   []Participant     `json:"participants"`
 	CreatedAt      time.Time         `json:"createdAt"`
 	UpdatedAt      time.Time         `json:"updatedAt"`
+	// Set only for cancelled appointments
+	CancellationReason *string `json:"cancellationReason,omitempty"`
+}
+
+// ListAppointmentsRequest filters the appointment list; From is inclusive and To
+// exclusive, both compared with the appointment's start time. EmployeeID matches
+// appointments the employee organizes or attends.
+type ListAppointmentsRequest struct {
+	EmployeeID  *string          `form:"employeeId"`
+	ClientID    *string          `form:"clientId"`
+	OrganizerID *string          `form:"organizerId"`
+	From        *time.Time       `form:"from" time_format:"2006-01-02T15:04:05Z07:00"`
+	To          *time.Time       `form:"to" time_format:"2006-01-02T15:04:05Z07:00"`
+	Type        *AppointmentType `form:"type" binding:"omitempty,oneof=general intake ambulatory"`
+	Location    *string          `form:"location"`
+}
+
+type ListAppointmentsResponse struct {
+	ID              string            `json:"id"`
+	Title           string            `json:"title"`
+	Description     string            `json:"description"`
+	StartTime       time.Time         `json:"startTime"`
+	EndTime         time.Time         `json:"endTime"`
+	Location        string            `json:"location"`
+	OrganizerID     string            `json:"organizerId"`
+	Status          AppointmentStatus `json:"status"`
+	Type            AppointmentType   `json:"type"`
+	RecurrenceRule  string            `json:"recurrenceRule"`
+	Participants    []Participant     `json:"participants"`
+	ClientID        string            `json:"clientId"`
+	ClientFirstName string            `json:"clientFirstName"`
+	ClientLastName  string            `json:"clientLastName"`
 }
 
 type CreateReminderRequest struct {
@@ -3,9 +3,12 @@ package calendar
 import "errors"
 
 var (
-	ErrAppointmentNotFound = errors.New("appointment not found")
-	ErrReminderNotFound    = errors.New("reminder not found")
-	ErrUnauthorized        = errors.New("unauthorized")
-	ErrInternal            = errors.New("internal server error")
-	ErrInvalidRequest      = errors.New("invalid request")
+	ErrAppointmentNotFound         = errors.New("appointment not found")
+	ErrReminderNotFound            = errors.New("reminder not found")
+	ErrUnauthorized                = errors.New("unauthorized")
+	ErrInternal                    = errors.New("internal server error")
+	ErrInvalidRequest              = errors.New("invalid request")
+	ErrInvalidAppointmentType      = errors.New("invalid appointment type")
+	ErrInvalidDateRange            = errors.New("from must be before to")
+	ErrAppointmentAlreadyCancelled = errors.New("appointment is already cancelled")
 )
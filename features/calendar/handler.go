@@ -26,10 +26,11 @@ func (h *CalendarHandler) SetupRoutes(router *gin.Engine) {
 	calendar.Use(h.mdw.AuthMdw())
 	{
 		calendar.POST("/appointments", h.CreateAppointment)
-		calendar.GET("/appointments", h.ListAppointments)
+		calendar.GET("/appointments", h.mdw.PaginationMdw(), h.ListAppointments)
 		calendar.GET("/appointments/:id", h.GetAppointment)
 		calendar.PATCH("/appointments/:id", h.UpdateAppointment)
 		calendar.DELETE("/appointments/:id", h.DeleteAppointment)
+		calendar.POST("/appointments/:id/cancel", h.CancelAppointment)
 
 		calendar.POST("/reminders", h.CreateReminder)
 		calendar.GET("/reminders", h.ListReminders)
@@ -46,7 +47,7 @@ func (h *CalendarHandler) SetupRoutes(router *gin.Engine) {
 // @Tags Calendar
 // @Accept json
 // @Produce json
-// @Security BearerAuth
+// @Security Bearer
 // @Param start query string true "Start time (RFC3339 format)"
 // @Param end query string true "End time (RFC3339 format)"
 // @Param employee_id query string false "Employee ID (defaults to current user)"
@@ -83,7 +84,7 @@ func (h *CalendarHandler) GetCalendarView(ctx *gin.Context) {
 // @Tags Calendar - Appointments
 // @Accept json
 // @Produce json
-// @Security BearerAuth
+// @Security Bearer
 // @Param request body CreateAppointmentRequest true "Appointment details"
 // @Success 201 {object} resp.SuccessResponse[AppointmentResponse]
 // @Failure 400 {object} resp.ErrorResponse
@@ -112,7 +113,7 @@ func (h *CalendarHandler) CreateAppointment(ctx *gin.Context) {
 // @Tags Calendar - Appointments
 // @Accept json
 // @Produce json
-// @Security BearerAuth
+// @Security Bearer
 // @Param id path string true "Appointment ID"
 // @Success 200 {object} resp.SuccessResponse[AppointmentResponse]
 // @Failure 401 {object} resp.ErrorResponse
@@ -131,22 +132,37 @@ func (h *CalendarHandler) GetAppointment(ctx *gin.Context) {
 }
 
 // @Summary List appointments
-// @Description List all appointments for an employee
+// @Description List appointments ordered by start time, optionally filtered by employee, client, organizer, start time range, type and location. Without an employee, client or organizer filter the current user's appointments are listed. Breaking change: the response used to be a bare array of appointments and is now a paginated object whose items are in data.data, and the employee filter is employeeId instead of employee_id.
 // @Tags Calendar - Appointments
 // @Accept json
 // @Produce json
-// @Security BearerAuth
-// @Param employee_id query string false "Employee ID (defaults to current user)"
-// @Success 200 {object} resp.SuccessResponse[[]AppointmentResponse]
+// @Security Bearer
+// @Param page query int false "Page number"
+// @Param page_size query int false "Page size"
+// @Param employeeId query string false "Employee organizing or attending (defaults to current user)"
+// @Param clientId query string false "Client participating in the appointment"
+// @Param organizerId query string false "Organizing employee"
+// @Param from query string false "Earliest start time, inclusive (RFC3339 format)"
+// @Param to query string false "Latest start time, exclusive (RFC3339 format)"
+// @Param type query string false "Appointment type" Enums(general, intake, ambulatory)
+// @Param location query string false "Location (case-insensitive exact match)"
+// @Success 200 {object} resp.SuccessResponse[resp.PaginationResponse[[]ListAppointmentsResponse]]
+// @Failure 400 {object} resp.ErrorResponse
 // @Failure 401 {object} resp.ErrorResponse
 // @Failure 500 {object} resp.ErrorResponse
 // @Router /calendar/appointments [get]
 func (h *CalendarHandler) ListAppointments(ctx *gin.Context) {
-	targetEmployeeID := ctx.Query("employee_id")
-	if targetEmployeeID == "" {
-		targetEmployeeID = util.GetEmployeeID(ctx)
+	var req ListAppointmentsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, resp.Error(ErrInvalidRequest))
+		return
+	}
+	if req.EmployeeID == nil && req.ClientID == nil && req.OrganizerID == nil {
+		employeeID := util.GetEmployeeID(ctx)
+		req.EmployeeID = &employeeID
 	}
-	res, err := h.service.ListAppointments(ctx, targetEmployeeID)
+
+	res, err := h.service.ListAppointments(ctx, &req)
 	if err != nil {
 		h.handleError(ctx, err)
 		return
@@ -160,7 +176,7 @@ func (h *CalendarHandler) ListAppointments(ctx *gin.Context) {
 // @Tags Calendar - Appointments
 // @Accept json
 // @Produce json
-// @Security BearerAuth
+// @Security Bearer
 // @Param id path string true "Appointment ID"
 // @Param request body UpdateAppointmentRequest true "Updated appointment details"
 // @Success 200 {object} resp.SuccessResponse[AppointmentResponse]
@@ -191,7 +207,7 @@ func (h *CalendarHandler) UpdateAppointment(ctx *gin.Context) {
 // @Tags Calendar - Appointments
 // @Accept json
 // @Produce json
-// @Security BearerAuth
+// @Security Bearer
 // @Param id path string true "Appointment ID"
 // @Success 200 {object} resp.MessageResponse
 // @Failure 401 {object} resp.ErrorResponse
@@ -209,6 +225,38 @@ func (h *CalendarHandler) DeleteAppointment(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, resp.MessageResonse("Appointment deleted successfully"))
 }
 
+// @Summary Cancel appointment
+// @Description Cancel an appointment with a reason. Cancelled appointments are kept but no longer appear on the dashboard or trigger reminders; the organizer is notified.
+// @Tags Calendar - Appointments
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Appointment ID"
+// @Param request body CancelAppointmentRequest true "Cancellation reason"
+// @Success 200 {object} resp.SuccessResponse[AppointmentResponse]
+// @Failure 400 {object} resp.ErrorResponse
+// @Failure 401 {object} resp.ErrorResponse
+// @Failure 404 {object} resp.ErrorResponse
+// @Failure 409 {object} resp.ErrorResponse
+// @Failure 500 {object} resp.ErrorResponse
+// @Router /calendar/appointments/{id}/cancel [post]
+func (h *CalendarHandler) CancelAppointment(ctx *gin.Context) {
+	id := ctx.Param("id")
+	var req CancelAppointmentRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, resp.Error(ErrInvalidRequest))
+		return
+	}
+
+	res, err := h.service.CancelAppointment(ctx, id, req)
+	if err != nil {
+		h.handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp.Success(res, "Appointment cancelled successfully"))
+}
+
 // Reminder handlers
 
 // @Summary Create reminder
@@ -216,7 +264,7 @@ func (h *CalendarHandler) DeleteAppointment(ctx *gin.Context) {
 // @Tags Calendar - Reminders
 // @Accept json
 // @Produce json
-// @Security BearerAuth
+// @Security Bearer
 // @Param request body CreateReminderRequest true "Reminder details"
 // @Success 201 {object} resp.SuccessResponse[ReminderResponse]
 // @Failure 400 {object} resp.ErrorResponse
@@ -245,7 +293,7 @@ func (h *CalendarHandler) CreateReminder(ctx *gin.Context) {
 // @Tags Calendar - Reminders
 // @Accept json
 // @Produce json
-// @Security BearerAuth
+// @Security Bearer
 // @Param id path string true "Reminder ID"
 // @Success 200 {object} resp.SuccessResponse[ReminderResponse]
 // @Failure 401 {object} resp.ErrorResponse
@@ -268,7 +316,7 @@ func (h *CalendarHandler) GetReminder(ctx *gin.Context) {
 // @Tags Calendar - Reminders
 // @Accept json
 // @Produce json
-// @Security BearerAuth
+// @Security Bearer
 // @Param employee_id query string false "Employee ID (defaults to current user)"
 // @Success 200 {object} resp.SuccessResponse[[]ReminderResponse]
 // @Failure 401 {object} resp.ErrorResponse
@@ -293,7 +341,7 @@ func (h *CalendarHandler) ListReminders(ctx *gin.Context) {
 // @Tags Calendar - Reminders
 // @Accept json
 // @Produce json
-// @Security BearerAuth
+// @Security Bearer
 // @Param id path string true "Reminder ID"
 // @Param request body UpdateReminderRequest true "Updated reminder details"
 // @Success 200 {object} resp.SuccessResponse[ReminderResponse]
@@ -326,7 +374,7 @@ func (h *CalendarHandler) UpdateReminder(ctx *gin.Context) {
 // @Tags Calendar - Reminders
 // @Accept json
 // @Produce json
-// @Security BearerAuth
+// @Security Bearer
 // @Param id path string true "Reminder ID"
 // @Success 200 {object} resp.MessageResponse
 // @Failure 401 {object} resp.ErrorResponse
@@ -348,6 +396,10 @@ func (h *CalendarHandler) handleError(ctx *gin.Context, err error) {
 	switch err {
 	case ErrAppointmentNotFound, ErrReminderNotFound:
 		ctx.JSON(http.StatusNotFound, resp.Error(err))
+	case ErrInvalidAppointmentType, ErrInvalidDateRange, ErrInvalidRequest:
+		ctx.JSON(http.StatusBadRequest, resp.Error(err))
+	case ErrAppointmentAlreadyCancelled:
+		ctx.JSON(http.StatusConflict, resp.Error(err))
 	case ErrUnauthorized:
 		ctx.JSON(http.StatusUnauthorized, resp.Error(err))
 	case ErrInternal:
@@ -118,6 +118,20 @@ func TestCreateAppointmentHandler(t *testing.T) {
 			setup:          func(mockService *mocks.MockCalendarService) {},
 			expectedStatus: http.StatusBadRequest,
 		},
+		{
+			name: "invalid_type",
+			requestBody: calendar.CreateAppointmentRequest{
+				Title:     "Test Appointment",
+				StartTime: time.Now().Add(time.Hour),
+				EndTime:   time.Now().Add(2 * time.Hour),
+				Type:      calendar.AppointmentType("home_visit"),
+				Participants: []calendar.Participant{
+					{ID: "client-1", Type: calendar.ParticipantClient},
+				},
+			},
+			setup:          func(mockService *mocks.MockCalendarService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
 		{
 			name: "internal_error",
 			requestBody: calendar.CreateAppointmentRequest{
@@ -207,16 +221,91 @@ func TestGetAppointmentHandler(t *testing.T) {
 // ============================================================
 
 func TestListAppointmentsHandler(t *testing.T) {
-	router, mockService, ctrl := setupHandlerTest(t)
-	defer ctrl.Finish()
+	tests := []struct {
+		name           string
+		queryParams    string
+		setup          func(mockService *mocks.MockCalendarService)
+		expectedStatus int
+	}{
+		{
+			name:        "success_with_filters",
+			queryParams: "?clientId=client-1&from=2026-04-01T00:00:00Z&to=2026-04-08T00:00:00Z&type=intake",
+			setup: func(mockService *mocks.MockCalendarService) {
+				mockService.EXPECT().
+					ListAppointments(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, req *calendar.ListAppointmentsRequest) (*resp.PaginationResponse[calendar.ListAppointmentsResponse], error) {
+						require.NotNil(t, req.ClientID)
+						assert.Equal(t, "client-1", *req.ClientID)
+						assert.Nil(t, req.EmployeeID, "a client filter replaces the current-user default")
+						require.NotNil(t, req.From)
+						assert.Equal(t, time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC), req.From.UTC())
+						require.NotNil(t, req.Type)
+						assert.Equal(t, calendar.TypeIntake, *req.Type)
+						result := resp.PagRespWithParams([]calendar.ListAppointmentsResponse{{ID: "app-1"}}, 1, 1, 10)
+						return &result, nil
+					})
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:        "defaults_to_current_employee",
+			queryParams: "",
+			setup: func(mockService *mocks.MockCalendarService) {
+				mockService.EXPECT().
+					ListAppointments(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, req *calendar.ListAppointmentsRequest) (*resp.PaginationResponse[calendar.ListAppointmentsResponse], error) {
+						require.NotNil(t, req.EmployeeID)
+						assert.Equal(t, "test-employee-id", *req.EmployeeID)
+						result := resp.PagRespWithParams([]calendar.ListAppointmentsResponse{}, 0, 1, 10)
+						return &result, nil
+					})
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:        "other_employee",
+			queryParams: "?employeeId=emp-2",
+			setup: func(mockService *mocks.MockCalendarService) {
+				mockService.EXPECT().
+					ListAppointments(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, req *calendar.ListAppointmentsRequest) (*resp.PaginationResponse[calendar.ListAppointmentsResponse], error) {
+						require.NotNil(t, req.EmployeeID)
+						assert.Equal(t, "emp-2", *req.EmployeeID)
+						result := resp.PagRespWithParams([]calendar.ListAppointmentsResponse{}, 0, 1, 10)
+						return &result, nil
+					})
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "invalid_type",
+			queryParams:    "?type=party",
+			setup:          func(mockService *mocks.MockCalendarService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "inverted_range",
+			queryParams: "?from=2026-04-08T00:00:00Z&to=2026-04-01T00:00:00Z",
+			setup: func(mockService *mocks.MockCalendarService) {
+				mockService.EXPECT().
+					ListAppointments(gomock.Any(), gomock.Any()).
+					Return(nil, calendar.ErrInvalidDateRange)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
 
-	mockService.EXPECT().
-		ListAppointments(gomock.Any(), gomock.Any()).
-		Return([]calendar.AppointmentResponse{{ID: "app-1"}, {ID: "app-2"}}, nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService, ctrl := setupHandlerTest(t)
+			defer ctrl.Finish()
 
-	w := performRequest(router, "GET", "/api/v1/calendar/appointments", nil)
+			tt.setup(mockService)
+			w := performRequest(router, "GET", "/api/v1/calendar/appointments"+tt.queryParams, nil)
 
-	assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
 }
 
 // ============================================================
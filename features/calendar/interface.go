@@ -1,17 +1,23 @@
 package calendar
 
 import (
+	"care-cordination/lib/resp"
 	"context"
 	"time"
 )
 
+//go:generate mockgen -destination=../../internal/mocks/mock_calendar_service.go -package=mocks care-cordination/features/calendar CalendarService
 type CalendarService interface {
 	// Appointment methods
 	CreateAppointment(ctx context.Context, organizerID string, req CreateAppointmentRequest) (*AppointmentResponse, error)
 	GetAppointment(ctx context.Context, id string) (*AppointmentResponse, error)
 	UpdateAppointment(ctx context.Context, id string, req UpdateAppointmentRequest) (*AppointmentResponse, error)
 	DeleteAppointment(ctx context.Context, id string) error
-	ListAppointments(ctx context.Context, userID string) ([]AppointmentResponse, error)
+	CancelAppointment(ctx context.Context, id string, req CancelAppointmentRequest) (*AppointmentResponse, error)
+	ListAppointments(
+		ctx context.Context,
+		req *ListAppointmentsRequest,
+	) (*resp.PaginationResponse[ListAppointmentsResponse], error)
 
 	// Reminder methods
 	CreateReminder(ctx context.Context, userID string, req CreateReminderRequest) (*ReminderResponse, error)
@@ -1,33 +1,56 @@
 package calendar
 
 import (
+	"care-cordination/features/notification"
 	db "care-cordination/lib/db/sqlc"
 	"care-cordination/lib/logger"
+	"care-cordination/lib/middleware"
 	"care-cordination/lib/nanoid"
 	"care-cordination/lib/recurrence"
+	"care-cordination/lib/resp"
 	"care-cordination/lib/util"
 	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"go.uber.org/zap"
 )
 
 type calendarService struct {
-	store  db.StoreInterface
-	logger logger.Logger
+	store               db.StoreInterface
+	logger              logger.Logger
+	notificationService notification.NotificationService
 }
 
-func NewCalendarService(store db.StoreInterface, logger logger.Logger) CalendarService {
+func NewCalendarService(
+	store db.StoreInterface,
+	logger logger.Logger,
+	notificationService notification.NotificationService,
+) CalendarService {
 	return &calendarService{
-		store:  store,
-		logger: logger,
+		store:               store,
+		logger:              logger,
+		notificationService: notificationService,
 	}
 }
 
 // Appointment methods
 
+// validAppointmentType reports whether t is one of the appointment_type_enum values
+func validAppointmentType(t AppointmentType) bool {
+	return slices.Contains(db.AllAppointmentTypeEnumValues(), db.AppointmentTypeEnum(t))
+}
+
 func (s *calendarService) CreateAppointment(ctx context.Context, organizerID string, req CreateAppointmentRequest) (*AppointmentResponse, error) {
+	if !validAppointmentType(req.Type) {
+		return nil, ErrInvalidAppointmentType
+	}
+
 	id := nanoid.Generate()
 
 	err := s.store.ExecTx(ctx, func(q *db.Queries) error {
@@ -92,19 +115,20 @@ func (s *calendarService) GetAppointment(ctx context.Context, id string) (*Appoi
 		}
 
 		response = &AppointmentResponse{
-			ID:             appointment.ID,
-			Title:          appointment.Title,
-			Description:    util.HandleNilString(appointment.Description),
-			StartTime:      appointment.StartTime.Time,
-			EndTime:        appointment.EndTime.Time,
-			Location:       util.HandleNilString(appointment.Location),
-			OrganizerID:    appointment.OrganizerID,
-			Status:         AppointmentStatus(appointment.Status.AppointmentStatusEnum),
-			Type:           AppointmentType(appointment.Type),
-			RecurrenceRule: util.HandleNilString(appointment.RecurrenceRule),
-			Participants:   participants,
-			CreatedAt:      appointment.CreatedAt.Time,
-			UpdatedAt:      appointment.UpdatedAt.Time,
+			ID:                 appointment.ID,
+			Title:              appointment.Title,
+			Description:        util.HandleNilString(appointment.Description),
+			StartTime:          appointment.StartTime.Time,
+			EndTime:            appointment.EndTime.Time,
+			Location:           util.HandleNilString(appointment.Location),
+			OrganizerID:        appointment.OrganizerID,
+			Status:             AppointmentStatus(appointment.Status.AppointmentStatusEnum),
+			Type:               AppointmentType(appointment.Type),
+			RecurrenceRule:     util.HandleNilString(appointment.RecurrenceRule),
+			Participants:       participants,
+			CreatedAt:          appointment.CreatedAt.Time,
+			UpdatedAt:          appointment.UpdatedAt.Time,
+			CancellationReason: appointment.CancellationReason,
 		}
 		return nil
 	})
@@ -121,6 +145,10 @@ func (s *calendarService) GetAppointment(ctx context.Context, id string) (*Appoi
 }
 
 func (s *calendarService) UpdateAppointment(ctx context.Context, id string, req UpdateAppointmentRequest) (*AppointmentResponse, error) {
+	if req.Type != nil && !validAppointmentType(*req.Type) {
+		return nil, ErrInvalidAppointmentType
+	}
+
 	err := s.store.ExecTx(ctx, func(q *db.Queries) error {
 		params := db.UpdateAppointmentParams{
 			ID: id,
@@ -183,6 +211,87 @@ func (s *calendarService) UpdateAppointment(ctx context.Context, id string, req
 	return s.GetAppointment(ctx, id)
 }
 
+// CancelAppointment marks an appointment cancelled with a reason and notifies
+// its organizer, unless the organizer cancelled it themselves
+func (s *calendarService) CancelAppointment(
+	ctx context.Context,
+	id string,
+	req CancelAppointmentRequest,
+) (*AppointmentResponse, error) {
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		return nil, ErrInvalidRequest
+	}
+
+	var cancelled db.Appointment
+	err := s.store.ExecTx(ctx, func(q *db.Queries) error {
+		appointment, err := q.GetAppointment(ctx, id)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrAppointmentNotFound
+			}
+			return err
+		}
+		if appointment.Status.AppointmentStatusEnum == db.AppointmentStatusEnumCancelled {
+			return ErrAppointmentAlreadyCancelled
+		}
+
+		cancelled, err = q.CancelAppointment(ctx, db.CancelAppointmentParams{
+			ID:                 id,
+			CancellationReason: reason,
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			// Cancelled concurrently since it was read
+			return ErrAppointmentAlreadyCancelled
+		}
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, ErrAppointmentNotFound) || errors.Is(err, ErrAppointmentAlreadyCancelled) {
+			return nil, err
+		}
+		s.logger.Error(ctx, "CancelAppointment", "Failed to cancel appointment", zap.Error(err))
+		return nil, ErrInternal
+	}
+
+	s.notifyOrganizerOfCancellation(ctx, cancelled, reason)
+
+	return s.GetAppointment(ctx, id)
+}
+
+func (s *calendarService) notifyOrganizerOfCancellation(
+	ctx context.Context,
+	appointment db.Appointment,
+	reason string,
+) {
+	if s.notificationService == nil || appointment.OrganizerID == util.GetEmployeeID(ctx) {
+		return
+	}
+
+	organizer, err := s.store.GetEmployeeByID(ctx, appointment.OrganizerID)
+	if err != nil {
+		s.logger.Error(ctx, "CancelAppointment", "Failed to get organizer", zap.Error(err))
+		return
+	}
+
+	resourceType := notification.ResourceTypeAppointment
+	resourceID := appointment.ID
+	s.notificationService.Enqueue(&notification.CreateNotificationRequest{
+		UserID:   organizer.UserID,
+		Type:     notification.TypeAppointmentCancelled,
+		Priority: notification.PriorityNormal,
+		Title:    "Appointment Cancelled",
+		Message: fmt.Sprintf(
+			"%s on %s was cancelled: %s",
+			appointment.Title,
+			appointment.StartTime.Time.Format("2006-01-02 15:04"),
+			reason,
+		),
+		ResourceType: &resourceType,
+		ResourceID:   &resourceID,
+	})
+}
+
 func (s *calendarService) DeleteAppointment(ctx context.Context, id string) error {
 	err := s.store.ExecTx(ctx, func(q *db.Queries) error {
 		return q.DeleteAppointment(ctx, id)
@@ -194,55 +303,87 @@ func (s *calendarService) DeleteAppointment(ctx context.Context, id string) erro
 	return nil
 }
 
-func (s *calendarService) ListAppointments(ctx context.Context, userID string) ([]AppointmentResponse, error) {
-	var responses []AppointmentResponse
-	err := s.store.ExecTx(ctx, func(q *db.Queries) error {
-		appointments, err := q.ListAppointmentsByOrganizer(ctx, userID)
-		if err != nil {
-			return err
-		}
-
-		responses = make([]AppointmentResponse, 0, len(appointments))
-		for _, a := range appointments {
-			// Note: We avoid calling s.GetAppointment here to stay within the same transaction/queries object
-			dbParticipants, err := q.ListAppointmentParticipants(ctx, a.ID)
-			if err != nil {
-				return err
-			}
+func (s *calendarService) ListAppointments(
+	ctx context.Context,
+	req *ListAppointmentsRequest,
+) (*resp.PaginationResponse[ListAppointmentsResponse], error) {
+	if req.From != nil && req.To != nil && !req.From.Before(*req.To) {
+		return nil, ErrInvalidDateRange
+	}
 
-			participants := make([]Participant, len(dbParticipants))
-			for i, p := range dbParticipants {
-				participants[i] = Participant{
-					ID:   p.ParticipantID,
-					Type: ParticipantType(p.ParticipantType),
-				}
-			}
+	limit, offset, page, pageSize := middleware.GetPaginationParams(ctx)
 
-			responses = append(responses, AppointmentResponse{
-				ID:             a.ID,
-				Title:          a.Title,
-				Description:    util.HandleNilString(a.Description),
-				StartTime:      a.StartTime.Time,
-				EndTime:        a.EndTime.Time,
-				Location:       util.HandleNilString(a.Location),
-				OrganizerID:    a.OrganizerID,
-				Status:         AppointmentStatus(a.Status.AppointmentStatusEnum),
-				Type:           AppointmentType(a.Type),
-				RecurrenceRule: util.HandleNilString(a.RecurrenceRule),
-				Participants:   participants,
-				CreatedAt:      a.CreatedAt.Time,
-				UpdatedAt:      a.UpdatedAt.Time,
-			})
-		}
-		return nil
-	})
+	params := db.ListAppointmentsParams{
+		Limit:       limit,
+		Offset:      offset,
+		ClientID:    req.ClientID,
+		OrganizerID: req.OrganizerID,
+		Location:    req.Location,
+		EmployeeID:  req.EmployeeID,
+	}
+	if req.From != nil {
+		params.StartFrom = pgtype.Timestamptz{Time: *req.From, Valid: true}
+	}
+	if req.To != nil {
+		params.StartBefore = pgtype.Timestamptz{Time: *req.To, Valid: true}
+	}
+	if req.Type != nil {
+		params.Type = db.NullAppointmentTypeEnum{AppointmentTypeEnum: db.AppointmentTypeEnum(*req.Type), Valid: true}
+	}
 
+	appointments, err := s.store.ListAppointments(ctx, params)
 	if err != nil {
 		s.logger.Error(ctx, "ListAppointments", "Failed to list appointments", zap.Error(err))
 		return nil, ErrInternal
 	}
 
-	return responses, nil
+	participants := map[string][]Participant{}
+	if len(appointments) > 0 {
+		ids := util.Map(appointments, func(a db.ListAppointmentsRow) string { return a.ID })
+		rows, err := s.store.ListAppointmentParticipantsByAppointmentIDs(ctx, ids)
+		if err != nil {
+			s.logger.Error(ctx, "ListAppointments", "Failed to list appointment participants", zap.Error(err))
+			return nil, ErrInternal
+		}
+		for _, p := range rows {
+			participants[p.AppointmentID] = append(participants[p.AppointmentID], Participant{
+				ID:   p.ParticipantID,
+				Type: ParticipantType(p.ParticipantType),
+			})
+		}
+	}
+
+	listAppointmentsResponse := []ListAppointmentsResponse{}
+	totalCount := 0
+
+	for _, a := range appointments {
+		appointmentParticipants := participants[a.ID]
+		if appointmentParticipants == nil {
+			appointmentParticipants = []Participant{}
+		}
+		listAppointmentsResponse = append(listAppointmentsResponse, ListAppointmentsResponse{
+			ID:              a.ID,
+			Title:           a.Title,
+			Description:     util.HandleNilString(a.Description),
+			StartTime:       a.StartTime.Time,
+			EndTime:         a.EndTime.Time,
+			Location:        util.HandleNilString(a.Location),
+			OrganizerID:     a.OrganizerID,
+			Status:          AppointmentStatus(a.Status.AppointmentStatusEnum),
+			Type:            AppointmentType(a.Type),
+			RecurrenceRule:  util.HandleNilString(a.RecurrenceRule),
+			Participants:    appointmentParticipants,
+			ClientID:        util.HandleNilString(a.ClientID),
+			ClientFirstName: util.HandleNilString(a.ClientFirstName),
+			ClientLastName:  util.HandleNilString(a.ClientLastName),
+		})
+		if totalCount == 0 {
+			totalCount = int(a.TotalCount)
+		}
+	}
+
+	result := resp.PagRespWithParams(listAppointmentsResponse, totalCount, page, pageSize)
+	return &result, nil
 }
 
 // Reminder methods
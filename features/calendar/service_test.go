@@ -9,6 +9,7 @@ import (
 	db "care-cordination/lib/db/sqlc"
 	dbmocks "care-cordination/lib/db/sqlc/mocks"
 	loggermocks "care-cordination/lib/logger/mocks"
+	"care-cordination/lib/util"
 
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
@@ -75,6 +76,23 @@ func TestCreateAppointment(t *testing.T) {
 			wantErr:     true,
 			expectedErr: ErrInternal,
 		},
+		{
+			name:        "invalid_type",
+			organizerID: "org-123",
+			req: CreateAppointmentRequest{
+				Title:     "Test Appointment",
+				StartTime: time.Now().Add(time.Hour),
+				EndTime:   time.Now().Add(2 * time.Hour),
+				Type:      AppointmentType("home_visit"),
+				Participants: []Participant{
+					{ID: "client-1", Type: ParticipantClient},
+				},
+			},
+			// Rejected before anything is written
+			setup:       func(mockStore *dbmocks.MockStoreInterface) {},
+			wantErr:     true,
+			expectedErr: ErrInvalidAppointmentType,
+		},
 	}
 
 	for _, tt := range tests {
@@ -88,7 +106,7 @@ func TestCreateAppointment(t *testing.T) {
 
 			tt.setup(mockStore)
 
-			service := NewCalendarService(mockStore, mockLogger)
+			service := NewCalendarService(mockStore, mockLogger, nil)
 			_, err := service.CreateAppointment(context.Background(), tt.organizerID, tt.req)
 
 			if tt.wantErr {
@@ -151,7 +169,7 @@ func TestGetAppointmentService(t *testing.T) {
 
 			tt.setup(mockStore)
 
-			service := NewCalendarService(mockStore, mockLogger)
+			service := NewCalendarService(mockStore, mockLogger, nil)
 			_, err := service.GetAppointment(context.Background(), tt.id)
 
 			if tt.wantErr {
@@ -179,31 +197,248 @@ func TestDeleteAppointmentService(t *testing.T) {
 		ExecTx(gomock.Any(), gomock.Any()).
 		Return(nil)
 
-	service := NewCalendarService(mockStore, mockLogger)
+	service := NewCalendarService(mockStore, mockLogger, nil)
 	err := service.DeleteAppointment(context.Background(), "app-123")
 
 	require.NoError(t, err)
 }
 
+// ============================================================
+// Test: CancelAppointment
+// ============================================================
+
+func TestCancelAppointmentService(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         CancelAppointmentRequest
+		setup       func(mockStore *dbmocks.MockStoreInterface)
+		wantErr     bool
+		expectedErr error
+	}{
+		{
+			name: "success",
+			req:  CancelAppointmentRequest{Reason: "Client is ill"},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				// Cancel, then reload the appointment for the response
+				mockStore.EXPECT().
+					ExecTx(gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(2)
+			},
+			wantErr: false,
+		},
+		{
+			name:        "blank_reason",
+			req:         CancelAppointmentRequest{Reason: "   "},
+			setup:       func(mockStore *dbmocks.MockStoreInterface) {},
+			wantErr:     true,
+			expectedErr: ErrInvalidRequest,
+		},
+		{
+			name: "not_found",
+			req:  CancelAppointmentRequest{Reason: "Client is ill"},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					ExecTx(gomock.Any(), gomock.Any()).
+					Return(ErrAppointmentNotFound)
+			},
+			wantErr:     true,
+			expectedErr: ErrAppointmentNotFound,
+		},
+		{
+			name: "already_cancelled",
+			req:  CancelAppointmentRequest{Reason: "Client is ill"},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					ExecTx(gomock.Any(), gomock.Any()).
+					Return(ErrAppointmentAlreadyCancelled)
+			},
+			wantErr:     true,
+			expectedErr: ErrAppointmentAlreadyCancelled,
+		},
+		{
+			name: "db_error",
+			req:  CancelAppointmentRequest{Reason: "Client is ill"},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					ExecTx(gomock.Any(), gomock.Any()).
+					Return(errors.New("db error"))
+			},
+			wantErr:     true,
+			expectedErr: ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := dbmocks.NewMockStoreInterface(ctrl)
+			mockLogger := loggermocks.NewMockLogger(ctrl)
+			mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+			tt.setup(mockStore)
+
+			service := NewCalendarService(mockStore, mockLogger, nil)
+			_, err := service.CancelAppointment(context.Background(), "app-123", tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 // ============================================================
 // Test: ListAppointments
 // ============================================================
 
 func TestListAppointmentsService(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
+	from := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+	clientID := "client-1"
+	employeeID := "emp-1"
+	intake := TypeIntake
 
-	mockStore := dbmocks.NewMockStoreInterface(ctrl)
-	mockLogger := loggermocks.NewMockLogger(ctrl)
+	tests := []struct {
+		name        string
+		req         *ListAppointmentsRequest
+		setup       func(mockStore *dbmocks.MockStoreInterface)
+		wantErr     error
+		wantTotal   int
+		wantClients []string
+		check       func(t *testing.T, data []ListAppointmentsResponse)
+	}{
+		{
+			name: "date_range",
+			req:  &ListAppointmentsRequest{From: &from, To: &to},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					ListAppointments(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg db.ListAppointmentsParams) ([]db.ListAppointmentsRow, error) {
+						assert.Equal(t, pgtype.Timestamptz{Time: from, Valid: true}, arg.StartFrom)
+						assert.Equal(t, pgtype.Timestamptz{Time: to, Valid: true}, arg.StartBefore)
+						assert.Nil(t, arg.ClientID)
+						assert.False(t, arg.Type.Valid)
+						return []db.ListAppointmentsRow{
+							{ID: "app-1", Description: util.StrPtr("Weekly check-in"), RecurrenceRule: util.StrPtr("FREQ=WEEKLY"), TotalCount: 2},
+							{ID: "app-2", ClientID: &clientID, ClientFirstName: util.StrPtr("Jan"), ClientLastName: util.StrPtr("Jansen"), TotalCount: 2},
+						}, nil
+					})
+				mockStore.EXPECT().
+					ListAppointmentParticipantsByAppointmentIDs(gomock.Any(), []string{"app-1", "app-2"}).
+					Return([]db.AppointmentParticipant{
+						{AppointmentID: "app-2", ParticipantID: "client-1", ParticipantType: db.ParticipantTypeEnumClient},
+						{AppointmentID: "app-2", ParticipantID: "emp-1", ParticipantType: db.ParticipantTypeEnumEmployee},
+					}, nil)
+			},
+			wantTotal:   2,
+			wantClients: []string{"", "client-1"},
+			check: func(t *testing.T, data []ListAppointmentsResponse) {
+				assert.Equal(t, "Weekly check-in", data[0].Description)
+				assert.Equal(t, "FREQ=WEEKLY", data[0].RecurrenceRule)
+				assert.Equal(t, []Participant{}, data[0].Participants)
+				assert.Equal(t, []Participant{
+					{ID: "client-1", Type: ParticipantClient},
+					{ID: "emp-1", Type: ParticipantEmployee},
+				}, data[1].Participants)
+			},
+		},
+		{
+			name: "employee",
+			req:  &ListAppointmentsRequest{EmployeeID: &employeeID},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					ListAppointments(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg db.ListAppointmentsParams) ([]db.ListAppointmentsRow, error) {
+						assert.Equal(t, &employeeID, arg.EmployeeID)
+						assert.Nil(t, arg.OrganizerID)
+						return []db.ListAppointmentsRow{}, nil
+					})
+			},
+			wantTotal:   0,
+			wantClients: []string{},
+		},
+		{
+			name: "client_and_type",
+			req:  &ListAppointmentsRequest{ClientID: &clientID, Type: &intake},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					ListAppointments(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg db.ListAppointmentsParams) ([]db.ListAppointmentsRow, error) {
+						assert.Equal(t, &clientID, arg.ClientID)
+						assert.Equal(t, db.NullAppointmentTypeEnum{AppointmentTypeEnum: db.AppointmentTypeEnumIntake, Valid: true}, arg.Type)
+						assert.False(t, arg.StartFrom.Valid)
+						return []db.ListAppointmentsRow{}, nil
+					})
+			},
+			wantTotal:   0,
+			wantClients: []string{},
+		},
+		{
+			name:    "inverted_range",
+			req:     &ListAppointmentsRequest{From: &to, To: &from},
+			setup:   func(mockStore *dbmocks.MockStoreInterface) {},
+			wantErr: ErrInvalidDateRange,
+		},
+		{
+			name: "store_error",
+			req:  &ListAppointmentsRequest{},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					ListAppointments(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("db error"))
+			},
+			wantErr: ErrInternal,
+		},
+		{
+			name: "participants_error",
+			req:  &ListAppointmentsRequest{},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					ListAppointments(gomock.Any(), gomock.Any()).
+					Return([]db.ListAppointmentsRow{{ID: "app-1", TotalCount: 1}}, nil)
+				mockStore.EXPECT().
+					ListAppointmentParticipantsByAppointmentIDs(gomock.Any(), []string{"app-1"}).
+					Return(nil, errors.New("db error"))
+			},
+			wantErr: ErrInternal,
+		},
+	}
 
-	mockStore.EXPECT().
-		ExecTx(gomock.Any(), gomock.Any()).
-		Return(nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
 
-	service := NewCalendarService(mockStore, mockLogger)
-	_, err := service.ListAppointments(context.Background(), "user-123")
+			mockStore := dbmocks.NewMockStoreInterface(ctrl)
+			mockLogger := loggermocks.NewMockLogger(ctrl)
+			mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			tt.setup(mockStore)
 
-	require.NoError(t, err)
+			service := NewCalendarService(mockStore, mockLogger, nil)
+			result, err := service.ListAppointments(context.Background(), tt.req)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantTotal, result.TotalCount)
+			clients := []string{}
+			for _, a := range result.Data {
+				clients = append(clients, a.ClientID)
+			}
+			assert.Equal(t, tt.wantClients, clients)
+			if tt.check != nil {
+				tt.check(t, result.Data)
+			}
+		})
+	}
 }
 
 // ============================================================
@@ -263,7 +498,7 @@ func TestCreateReminderService(t *testing.T) {
 
 			tt.setup(mockStore)
 
-			service := NewCalendarService(mockStore, mockLogger)
+			service := NewCalendarService(mockStore, mockLogger, nil)
 			_, err := service.CreateReminder(context.Background(), tt.userID, tt.req)
 
 			if tt.wantErr {
@@ -324,7 +559,7 @@ func TestGetReminderService(t *testing.T) {
 
 			tt.setup(mockStore)
 
-			service := NewCalendarService(mockStore, mockLogger)
+			service := NewCalendarService(mockStore, mockLogger, nil)
 			_, err := service.GetReminder(context.Background(), tt.id)
 
 			if tt.wantErr {
@@ -352,7 +587,7 @@ func TestDeleteReminderService(t *testing.T) {
 		ExecTx(gomock.Any(), gomock.Any()).
 		Return(nil)
 
-	service := NewCalendarService(mockStore, mockLogger)
+	service := NewCalendarService(mockStore, mockLogger, nil)
 	err := service.DeleteReminder(context.Background(), "rem-123")
 
 	require.NoError(t, err)
@@ -373,7 +608,7 @@ func TestListRemindersService(t *testing.T) {
 		ExecTx(gomock.Any(), gomock.Any()).
 		Return(nil)
 
-	service := NewCalendarService(mockStore, mockLogger)
+	service := NewCalendarService(mockStore, mockLogger, nil)
 	_, err := service.ListReminders(context.Background(), "user-123")
 
 	require.NoError(t, err)
@@ -433,7 +668,7 @@ func TestGetCalendarViewService(t *testing.T) {
 
 			tt.setup(mockStore)
 
-			service := NewCalendarService(mockStore, mockLogger)
+			service := NewCalendarService(mockStore, mockLogger, nil)
 			_, err := service.GetCalendarView(context.Background(), tt.userID, tt.startTime, tt.endTime)
 
 			if tt.wantErr {
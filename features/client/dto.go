@@ -3,6 +3,8 @@ package client
 type MoveClientToWaitingListRequest struct {
 	IntakeFormID        string `json:"intakeFormId"`
 	WaitingListPriority string `json:"waitingListPriority" binding:"required,oneof=low normal high"`
+	// Admin only: assign the coordinator even when they are at their caseload cap
+	OverrideCaseload bool `json:"overrideCaseload"`
 }
 
 type MoveClientToWaitingListResponse struct {
@@ -40,8 +42,26 @@ type CompleteDischargeResponse struct {
 	ClientID string `json:"clientId"`
 }
 
+type SearchClientsRequest struct {
+	Search string  `form:"search" binding:"required"`
+	Status *string `form:"status" binding:"omitempty,oneof=waiting_list in_care discharged"`
+}
+
+type SearchClientsResponse struct {
+	ID           string `json:"id"`
+	FirstName    string `json:"firstName"`
+	LastName     string `json:"lastName"`
+	Bsn          string `json:"bsn"`
+	DateOfBirth  string `json:"dateOfBirth"`
+	Status       string `json:"status"`
+	LocationID   string `json:"locationId"`
+	LocationName string `json:"locationName"`
+}
+
 type ListWaitingListClientsRequest struct {
 	Search *string `form:"search"`
+	// Sort is "field" or "field:desc" with field one of waitingListSortFields
+	Sort string `form:"sort"`
 }
 
 type ListWaitingListClientsResponse struct {
@@ -68,6 +88,9 @@ type ListWaitingListClientsResponse struct {
 type ListInCareClientsRequest struct {
 	Search   *string `form:"search"`
 	CareType *string `form:"careType" binding:"omitempty,oneof=protected_living semi_independent_living independent_assisted_living ambulatory_care"`
+	// Cursor switches to keyset pagination. Send it empty for the first page,
+	// then pass back nextCursor from the previous response.
+	Cursor *string `form:"cursor"`
 }
 
 type ListInCareClientsResponse struct {
@@ -93,6 +116,32 @@ type ListInCareClientsResponse struct {
 	UsedAmbulatoryHours *int `json:"usedAmbulatoryHours,omitempty"`
 }
 
+type ExportClientsRequest struct {
+	// Redact masks the BSN, reduces names to initials and the date of birth to
+	// the year, and leaves out gender and the coordinator, for lists shared
+	// with external parties
+	Redact bool `form:"redact"`
+}
+
+// ExportClientResponse is one element of the streamed GET /clients/export array.
+type ExportClientResponse struct {
+	ID                   string `json:"id"`
+	FirstName            string `json:"firstName"`
+	LastName             string `json:"lastName"`
+	Bsn                  string `json:"bsn"`
+	DateOfBirth          string `json:"dateOfBirth"`
+	Gender               string `json:"gender"`
+	Status               string `json:"status"`
+	CareType             string `json:"careType"`
+	CareStartDate        string `json:"careStartDate"`
+	CareEndDate          string `json:"careEndDate"`
+	DischargeDate        string `json:"dischargeDate"`
+	LocationName         string `json:"locationName"`
+	CoordinatorFirstName string `json:"coordinatorFirstName"`
+	CoordinatorLastName  string `json:"coordinatorLastName"`
+	CreatedAt            string `json:"createdAt"`
+}
+
 type ListDischargedClientsRequest struct {
 	Search          *string `form:"search"`
 	DischargeStatus *string `form:"dischargeStatus" binding:"omitempty,oneof=in_progress completed"`
@@ -142,8 +191,10 @@ type CareTypeCounts struct {
 }
 
 type GetInCareStatsResponse struct {
-	TotalCount        int            `json:"totalCount"`
+	TotalCount int `json:"totalCount"`
+	// AverageDaysInCare and LongestStayDays count from care start to today
 	AverageDaysInCare float64        `json:"averageDaysInCare"`
+	LongestStayDays   int            `json:"longestStayDays"`
 	CountsByCareType  CareTypeCounts `json:"countsByCareType"`
 }
 
@@ -155,6 +206,18 @@ type GetDischargeStatsResponse struct {
 	AverageDaysInCare       float64 `json:"averageDaysInCare"`
 }
 
+type GetDischargeTrendRequest struct {
+	Months   int    `form:"months"   binding:"omitempty,min=1,max=60"`
+	Timezone string `form:"timezone"`
+}
+
+type DischargeTrendMonth struct {
+	Month               string `json:"month"` // YYYY-MM
+	PlannedDischarges   int    `json:"plannedDischarges"`
+	PrematureDischarges int    `json:"prematureDischarges"`
+	TotalCount          int    `json:"totalCount"`
+}
+
 type ListClientGoalsResponse struct {
 	ID          string  `json:"id"`
 	ClientID    string  `json:"clientId"`
@@ -163,3 +226,71 @@ type ListClientGoalsResponse struct {
 	CreatedAt   string  `json:"createdAt"`
 	UpdatedAt   string  `json:"updatedAt"`
 }
+
+type AddClientNoteRequest struct {
+	Body string `json:"body" binding:"required"`
+}
+
+type ClientNoteResponse struct {
+	ID              string `json:"id"`
+	Body            string `json:"body"`
+	AuthorID        string `json:"authorId"`
+	AuthorFirstName string `json:"authorFirstName,omitempty"`
+	AuthorLastName  string `json:"authorLastName,omitempty"`
+	CreatedAt       string `json:"createdAt"`
+}
+
+type GetClientRequest struct {
+	// Fields is a comma-separated list of top-level response fields to return
+	Fields string `form:"fields"`
+}
+
+// GetClientByBSNRequest carries the BSN in the body so it stays out of URLs
+// and the audit log's request paths
+type GetClientByBSNRequest struct {
+	BSN string `json:"bsn" binding:"required"`
+}
+
+type GetClientResponse struct {
+	ID                      string  `json:"id"`
+	FirstName               string  `json:"firstName"`
+	LastName                string  `json:"lastName"`
+	Bsn                     string  `json:"bsn"`
+	DateOfBirth             string  `json:"dateOfBirth"`
+	PhoneNumber             *string `json:"phoneNumber"`
+	Gender                  string  `json:"gender"`
+	CareType                string  `json:"careType"`
+	AmbulatoryWeeklyHours   *int32  `json:"ambulatoryWeeklyHours"`
+	Status                  string  `json:"status"`
+	WaitingListPriority     string  `json:"waitingListPriority"`
+	CareStartDate           string  `json:"careStartDate"`
+	CareEndDate             string  `json:"careEndDate"`
+	DischargeDate           string  `json:"dischargeDate"`
+	ReasonForDischarge      *string `json:"reasonForDischarge"`
+	DischargeStatus         *string `json:"dischargeStatus"`
+	ClosingReport           *string `json:"closingReport"`
+	EvaluationReport        *string `json:"evaluationReport"`
+	RegistrationFormID      string  `json:"registrationFormId"`
+	IntakeFormID            string  `json:"intakeFormId"`
+	ReferringOrgID          *string `json:"referringOrgId"`
+	LocationID              string  `json:"locationId"`
+	CoordinatorID           string  `json:"coordinatorId"`
+	FamilySituation         *string `json:"familySituation"`
+	Limitations             *string `json:"limitations"`
+	FocusAreas              *string `json:"focusAreas"`
+	Notes                   *string `json:"notes"`
+	EvaluationIntervalWeeks *int32  `json:"evaluationIntervalWeeks"`
+	NextEvaluationDate      string  `json:"nextEvaluationDate"`
+	CreatedAt               string  `json:"createdAt"`
+	UpdatedAt               string  `json:"updatedAt"`
+}
+
+type PlacementSuggestionResponse struct {
+	LocationID string `json:"locationId"`
+	Name       string `json:"name"`
+	PostalCode string `json:"postalCode"`
+	Address    string `json:"address"`
+	Capacity   int32  `json:"capacity"`
+	Occupied   int32  `json:"occupied"`
+	Available  int32  `json:"available"`
+}
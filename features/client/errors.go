@@ -9,6 +9,7 @@ var (
 	ErrFailedToCreateClient     = errors.New("failed to create client")
 	ErrInternal                 = errors.New("internal server error")
 	ErrClientNotFound           = errors.New("client not found")
+	ErrActiveClientExists       = errors.New("an active client with this BSN already exists")
 	ErrInvalidClientStatus      = errors.New("client must be on waiting list to move to in care")
 	ErrAmbulatoryHoursRequired  = errors.New(
 		"ambulatory weekly hours required for ambulatory care",
@@ -16,7 +17,16 @@ var (
 	ErrAmbulatoryHoursNotAllowed = errors.New(
 		"ambulatory weekly hours should only be set for ambulatory care",
 	)
-	ErrClientNotInCare         = errors.New("client must be in care to be discharged")
-	ErrDischargeAlreadyStarted = errors.New("discharge has already been started for this client")
-	ErrDischargeNotStarted     = errors.New("discharge must be started before completing")
+	ErrClientNotInCare           = errors.New("client must be in care to be discharged")
+	ErrDischargeAlreadyStarted   = errors.New("discharge has already been started for this client")
+	ErrDischargeNotStarted       = errors.New("discharge must be started before completing")
+	ErrInvalidFields             = errors.New("invalid fields")
+	ErrInvalidCursor             = errors.New("invalid pagination cursor")
+	ErrInvalidEvaluationInterval = errors.New(
+		"evaluation interval must be between 1 and 52 weeks",
+	)
+	ErrTextTooLong            = errors.New("a text field exceeds the maximum length")
+	ErrIntakeDocumentsMissing = errors.New(
+		"required intake documents must be received before moving to the waiting list",
+	)
 )
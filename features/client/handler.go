@@ -1,10 +1,16 @@
 package client
 
 import (
+	"care-cordination/lib/assignment"
+	"care-cordination/lib/exportlimit"
 	"care-cordination/lib/middleware"
 	"care-cordination/lib/resp"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
+	"reflect"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 )
@@ -12,12 +18,19 @@ import (
 type ClientHandler struct {
 	clientService ClientService
 	mdw           *middleware.Middleware
+	// exportLimiter bounds concurrent exports; nil leaves them unbounded
+	exportLimiter *exportlimit.Limiter
 }
 
-func NewClientHandler(clientService ClientService, mdw *middleware.Middleware) *ClientHandler {
+func NewClientHandler(
+	clientService ClientService,
+	mdw *middleware.Middleware,
+	exportLimiter *exportlimit.Limiter,
+) *ClientHandler {
 	return &ClientHandler{
 		clientService: clientService,
 		mdw:           mdw,
+		exportLimiter: exportLimiter,
 	}
 }
 
@@ -28,13 +41,21 @@ func (h *ClientHandler) SetupClientRoutes(router *gin.Engine) {
 	clients.POST("/:id/move-to-care", h.mdw.AuthMdw(), h.MoveClientInCare)
 	clients.POST("/:id/start-discharge", h.mdw.AuthMdw(), h.StartDischarge)
 	clients.POST("/:id/complete-discharge", h.mdw.AuthMdw(), h.CompleteDischarge)
+	clients.GET("/search", h.mdw.AuthMdw(), h.mdw.PaginationMdw(), h.mdw.SearchMdw(), h.SearchClients)
 	clients.GET("/waiting-list/stats", h.mdw.AuthMdw(), h.GetWaitlistStats)
-	clients.GET("/waiting-list", h.mdw.AuthMdw(), h.mdw.PaginationMdw(), h.ListWaitingListClients)
+	clients.GET("/waiting-list", h.mdw.AuthMdw(), h.mdw.PaginationMdw(), h.mdw.SearchMdw(), h.ListWaitingListClients)
 	clients.GET("/in-care/stats", h.mdw.AuthMdw(), h.GetInCareStats)
-	clients.GET("/in-care", h.mdw.AuthMdw(), h.mdw.PaginationMdw(), h.ListInCareClients)
+	clients.GET("/in-care", h.mdw.AuthMdw(), h.mdw.PaginationMdw(), h.mdw.SearchMdw(), h.ListInCareClients)
 	clients.GET("/discharged/stats", h.mdw.AuthMdw(), h.GetDischargeStats)
-	clients.GET("/discharged", h.mdw.AuthMdw(), h.mdw.PaginationMdw(), h.ListDischargedClients)
+	clients.GET("/discharged/trend", h.mdw.AuthMdw(), h.GetDischargeTrend)
+	clients.GET("/discharged", h.mdw.AuthMdw(), h.mdw.PaginationMdw(), h.mdw.SearchMdw(), h.ListDischargedClients)
+	clients.GET("/export", h.mdw.AuthMdw(), h.mdw.RequirePermission("client", "export"), h.mdw.ExportLimitMdw(h.exportLimiter), h.ExportClients)
+	clients.POST("/lookup-by-bsn", h.mdw.AuthMdw(), h.GetClientByBSN)
+	clients.GET("/:id", h.mdw.AuthMdw(), h.GetClient)
 	clients.GET("/:id/goals", h.mdw.AuthMdw(), h.ListClientGoals)
+	clients.GET("/:id/placement-suggestions", h.mdw.AuthMdw(), h.SuggestPlacements)
+	clients.POST("/:id/notes", h.mdw.AuthMdw(), h.AddClientNote)
+	clients.GET("/:id/notes", h.mdw.AuthMdw(), h.ListClientNotes)
 }
 
 // @Summary Move client to waiting list
@@ -46,7 +67,9 @@ func (h *ClientHandler) SetupClientRoutes(router *gin.Engine) {
 // @Success 200 {object} resp.SuccessResponse[MoveClientToWaitingListResponse]
 // @Failure 400 {object} resp.ErrorResponse
 // @Failure 401 {object} resp.ErrorResponse
+// @Failure 403 {object} resp.ErrorResponse
 // @Failure 404 {object} resp.ErrorResponse
+// @Failure 409 {object} resp.ErrorResponse
 // @Failure 500 {object} resp.ErrorResponse
 // @Router /clients/move-to-waiting-list [post]
 func (h *ClientHandler) MoveClientToWaitingList(ctx *gin.Context) {
@@ -61,9 +84,18 @@ func (h *ClientHandler) MoveClientToWaitingList(ctx *gin.Context) {
 		switch {
 		case errors.Is(err, ErrInvalidRequest):
 			ctx.JSON(http.StatusBadRequest, resp.Error(err))
-		case errors.Is(err, ErrIntakeFormNotFound):
-			ctx.JSON(http.StatusNotFound, resp.Error(err))
-		case errors.Is(err, ErrRegistrationFormNotFound):
+		case errors.Is(err, ErrInvalidEvaluationInterval):
+			ctx.JSON(http.StatusBadRequest, resp.Error(err))
+		case errors.Is(err, ErrIntakeDocumentsMissing),
+			errors.Is(err, ErrActiveClientExists),
+			errors.Is(err, assignment.ErrCoordinatorLocationMismatch),
+			errors.Is(err, assignment.ErrCoordinatorAtCapacity):
+			ctx.JSON(http.StatusConflict, resp.Error(err))
+		case errors.Is(err, assignment.ErrCaseloadOverrideForbidden):
+			ctx.JSON(http.StatusForbidden, resp.Error(err))
+		case errors.Is(err, ErrIntakeFormNotFound),
+			errors.Is(err, ErrRegistrationFormNotFound),
+			errors.Is(err, assignment.ErrCoordinatorNotFound):
 			ctx.JSON(http.StatusNotFound, resp.Error(err))
 		case errors.Is(err, ErrFailedToCreateClient):
 			ctx.JSON(http.StatusInternalServerError, resp.Error(err))
@@ -205,7 +237,7 @@ func (h *ClientHandler) CompleteDischarge(ctx *gin.Context) {
 	result, err := h.clientService.CompleteDischarge(ctx, clientID, &req)
 	if err != nil {
 		switch {
-		case errors.Is(err, ErrInvalidRequest):
+		case errors.Is(err, ErrInvalidRequest), errors.Is(err, ErrTextTooLong):
 			ctx.JSON(http.StatusBadRequest, resp.Error(err))
 		case errors.Is(err, ErrClientNotFound):
 			ctx.JSON(http.StatusNotFound, resp.Error(err))
@@ -224,6 +256,36 @@ func (h *ClientHandler) CompleteDischarge(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, resp.Success(result, "Client discharged successfully"))
 }
 
+// @Summary Search clients
+// @Description Search clients in every status by name or BSN, optionally filtered by status
+// @Tags Client
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number (default: 1)"
+// @Param page_size query int false "Page size (default: 10, max: 100)"
+// @Param search query string true "Client first name, last name or BSN"
+// @Param status query string false "Filter by status (waiting_list, in_care, discharged)"
+// @Success 200 {object} resp.SuccessResponse[resp.PaginationResponse[[]SearchClientsResponse]]
+// @Failure 400 {object} resp.ErrorResponse
+// @Failure 401 {object} resp.ErrorResponse
+// @Failure 500 {object} resp.ErrorResponse
+// @Router /clients/search [get]
+func (h *ClientHandler) SearchClients(ctx *gin.Context) {
+	var req SearchClientsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, resp.Error(err))
+		return
+	}
+
+	result, err := h.clientService.SearchClients(ctx, &req)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp.Success(result, "Clients retrieved successfully"))
+}
+
 // @Summary List waiting list clients
 // @Description List all clients on the waiting list with pagination and search
 // @Tags Client
@@ -232,6 +294,7 @@ func (h *ClientHandler) CompleteDischarge(ctx *gin.Context) {
 // @Param page query int false "Page number (default: 1)"
 // @Param page_size query int false "Page size (default: 10, max: 100)"
 // @Param search query string false "Search by client first name or last name"
+// @Param sort query string false "Sort as field or field:desc; field is priority, name or created_at. priority:desc lists high priority first (default: priority:desc, then longest waiting)"
 // @Success 200 {object} resp.SuccessResponse[resp.PaginationResponse[[]ListWaitingListClientsResponse]]
 // @Failure 400 {object} resp.ErrorResponse
 // @Failure 401 {object} resp.ErrorResponse
@@ -247,6 +310,8 @@ func (h *ClientHandler) ListWaitingListClients(ctx *gin.Context) {
 	result, err := h.clientService.ListWaitingListClients(ctx, &req)
 	if err != nil {
 		switch {
+		case errors.Is(err, ErrInvalidRequest):
+			ctx.JSON(http.StatusBadRequest, resp.Error(err))
 		case errors.Is(err, ErrInternal):
 			ctx.JSON(http.StatusInternalServerError, resp.Error(err))
 		default:
@@ -267,6 +332,7 @@ func (h *ClientHandler) ListWaitingListClients(ctx *gin.Context) {
 // @Param page_size query int false "Page size (default: 10, max: 100)"
 // @Param search query string false "Search by client first name or last name"
 // @Param careType query string false "Filter by care type (protected_living, semi_independent_living, independent_assisted_living, ambulatory_care)"
+// @Param cursor query string false "Keyset pagination cursor; send empty for the first page, then nextCursor from the previous response. page is ignored when set"
 // @Success 200 {object} resp.SuccessResponse[resp.PaginationResponse[[]ListInCareClientsResponse]]
 // @Failure 400 {object} resp.ErrorResponse
 // @Failure 401 {object} resp.ErrorResponse
@@ -282,6 +348,8 @@ func (h *ClientHandler) ListInCareClients(ctx *gin.Context) {
 	result, err := h.clientService.ListInCareClients(ctx, &req)
 	if err != nil {
 		switch {
+		case errors.Is(err, ErrInvalidCursor):
+			ctx.JSON(http.StatusBadRequest, resp.Error(err))
 		case errors.Is(err, ErrInternal):
 			ctx.JSON(http.StatusInternalServerError, resp.Error(err))
 		default:
@@ -328,6 +396,37 @@ func (h *ClientHandler) ListDischargedClients(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, resp.Success(result, "Clients listed successfully"))
 }
 
+// @Summary Export all clients
+// @Description Stream every client of the caller's organization, in any status, as a JSON array. Requires the client export permission. Rows are written as they are read from the database, so the response is not wrapped in the usual success envelope. Only a few exports run at once; the rest wait in a short queue and are refused with 429 once it is full.
+// @Tags Client
+// @Produce json
+// @Param redact query bool false "Mask the BSN (last two digits kept), reduce names to initials and the date of birth to the year, and leave out gender and the coordinator"
+// @Success 200 {array} ExportClientResponse
+// @Failure 400 {object} resp.ErrorResponse
+// @Failure 401 {object} resp.ErrorResponse
+// @Failure 403 {object} resp.ErrorResponse
+// @Failure 429 {object} resp.ErrorResponse
+// @Failure 500 {object} resp.ErrorResponse
+// @Router /clients/export [get]
+func (h *ClientHandler) ExportClients(ctx *gin.Context) {
+	var req ExportClientsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, resp.Error(ErrInvalidRequest))
+		return
+	}
+
+	ctx.Header("Content-Type", "application/json; charset=utf-8")
+	ctx.Status(http.StatusOK)
+
+	if err := h.clientService.ExportClients(ctx, ctx.Writer, &req); err != nil {
+		// Once the array has started the status is already sent; the client
+		// sees a truncated body instead.
+		if !ctx.Writer.Written() {
+			ctx.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		}
+	}
+}
+
 // @Summary Get waitlist statistics
 // @Description Get comprehensive statistics for clients on the waiting list including total count, average wait time, and priority breakdowns
 // @Tags Client
@@ -379,6 +478,37 @@ func (h *ClientHandler) GetDischargeStats(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, resp.Success(result, "Discharge statistics retrieved successfully"))
 }
 
+// @Summary Get monthly discharge trend
+// @Description Get planned and premature discharge counts per month for the last months (12 by default), oldest first
+// @Tags Client
+// @Produce json
+// @Param months query int false "Number of months, including the current one (1-60, default 12)"
+// @Param timezone query string false "IANA timezone deciding the current month (default APP_TIMEZONE)"
+// @Success 200 {object} resp.SuccessResponse[[]DischargeTrendMonth]
+// @Failure 400 {object} resp.ErrorResponse
+// @Failure 401 {object} resp.ErrorResponse
+// @Failure 500 {object} resp.ErrorResponse
+// @Router /clients/discharged/trend [get]
+func (h *ClientHandler) GetDischargeTrend(ctx *gin.Context) {
+	var req GetDischargeTrendRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, resp.Error(ErrInvalidRequest))
+		return
+	}
+
+	result, err := h.clientService.GetDischargeTrend(ctx, &req)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrInvalidRequest):
+			ctx.JSON(http.StatusBadRequest, resp.Error(err))
+		default:
+			ctx.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		}
+		return
+	}
+	ctx.JSON(http.StatusOK, resp.Success(result, "Discharge trend retrieved successfully"))
+}
+
 // @Summary List client goals
 // @Description Get all goals for a specific client
 // @Tags Client
@@ -398,8 +528,274 @@ func (h *ClientHandler) ListClientGoals(ctx *gin.Context) {
 
 	result, err := h.clientService.ListClientGoals(ctx, clientID)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		switch {
+		case errors.Is(err, ErrClientNotFound):
+			ctx.JSON(http.StatusNotFound, resp.Error(err))
+		default:
+			ctx.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		}
 		return
 	}
 	ctx.JSON(http.StatusOK, resp.Success(result, "Client goals retrieved successfully"))
 }
+
+// @Summary Add client note
+// @Description Append a timestamped note to a client's history, authored by the current employee
+// @Tags Client
+// @Accept json
+// @Produce json
+// @Param id path string true "Client ID"
+// @Param request body AddClientNoteRequest true "Note body"
+// @Success 201 {object} resp.SuccessResponse[ClientNoteResponse]
+// @Failure 400 {object} resp.ErrorResponse
+// @Failure 401 {object} resp.ErrorResponse
+// @Failure 404 {object} resp.ErrorResponse
+// @Failure 500 {object} resp.ErrorResponse
+// @Router /clients/{id}/notes [post]
+func (h *ClientHandler) AddClientNote(ctx *gin.Context) {
+	clientID := ctx.Param("id")
+	if clientID == "" {
+		ctx.JSON(http.StatusBadRequest, resp.Error(ErrInvalidRequest))
+		return
+	}
+
+	var req AddClientNoteRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, resp.Error(ErrInvalidRequest))
+		return
+	}
+
+	result, err := h.clientService.AddClientNote(ctx, clientID, &req)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrInvalidRequest), errors.Is(err, ErrTextTooLong):
+			ctx.JSON(http.StatusBadRequest, resp.Error(err))
+		case errors.Is(err, ErrClientNotFound):
+			ctx.JSON(http.StatusNotFound, resp.Error(err))
+		default:
+			ctx.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, resp.Success(result, "Client note added successfully"))
+}
+
+// @Summary List client notes
+// @Description List a client's notes, newest first, with their authors
+// @Tags Client
+// @Produce json
+// @Param id path string true "Client ID"
+// @Success 200 {object} resp.SuccessResponse[[]ClientNoteResponse]
+// @Failure 400 {object} resp.ErrorResponse
+// @Failure 401 {object} resp.ErrorResponse
+// @Failure 404 {object} resp.ErrorResponse
+// @Failure 500 {object} resp.ErrorResponse
+// @Router /clients/{id}/notes [get]
+func (h *ClientHandler) ListClientNotes(ctx *gin.Context) {
+	clientID := ctx.Param("id")
+	if clientID == "" {
+		ctx.JSON(http.StatusBadRequest, resp.Error(ErrInvalidRequest))
+		return
+	}
+
+	result, err := h.clientService.ListClientNotes(ctx, clientID)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrClientNotFound):
+			ctx.JSON(http.StatusNotFound, resp.Error(err))
+		default:
+			ctx.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		}
+		return
+	}
+	ctx.JSON(http.StatusOK, resp.Success(result, "Client notes retrieved successfully"))
+}
+
+// @Summary Get client
+// @Description Get a client's details. Pass fields to return only the listed top-level fields.
+// @Tags Client
+// @Produce json
+// @Param id path string true "Client ID"
+// @Param fields query string false "Comma-separated response fields, e.g. id,firstName,status"
+// @Success 200 {object} resp.SuccessResponse[GetClientResponse]
+// @Failure 400 {object} resp.ErrorResponse
+// @Failure 401 {object} resp.ErrorResponse
+// @Failure 404 {object} resp.ErrorResponse
+// @Failure 500 {object} resp.ErrorResponse
+// @Router /clients/{id} [get]
+func (h *ClientHandler) GetClient(ctx *gin.Context) {
+	clientID := ctx.Param("id")
+	if clientID == "" {
+		ctx.JSON(http.StatusBadRequest, resp.Error(ErrInvalidRequest))
+		return
+	}
+
+	var req GetClientRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, resp.Error(err))
+		return
+	}
+	fields, err := parseFields(req.Fields, clientDetailFields)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, resp.Error(err))
+		return
+	}
+
+	result, err := h.clientService.GetClient(ctx, clientID)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrClientNotFound):
+			ctx.JSON(http.StatusNotFound, resp.Error(err))
+		default:
+			ctx.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		}
+		return
+	}
+
+	if len(fields) == 0 {
+		ctx.JSON(http.StatusOK, resp.Success(result, "Client retrieved successfully"))
+		return
+	}
+
+	partial, err := selectFields(result, fields)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp.Success(partial, "Client retrieved successfully"))
+}
+
+// @Summary Look up a client by BSN
+// @Description Get the active (not discharged) client with the given BSN. Discharged clients are found through the client search.
+// @Tags Client
+// @Accept json
+// @Produce json
+// @Param request body GetClientByBSNRequest true "BSN"
+// @Success 200 {object} resp.SuccessResponse[GetClientResponse]
+// @Failure 400 {object} resp.ErrorResponse
+// @Failure 401 {object} resp.ErrorResponse
+// @Failure 404 {object} resp.ErrorResponse
+// @Failure 500 {object} resp.ErrorResponse
+// @Router /clients/lookup-by-bsn [post]
+func (h *ClientHandler) GetClientByBSN(ctx *gin.Context) {
+	var req GetClientByBSNRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, resp.Error(ErrInvalidRequest))
+		return
+	}
+
+	result, err := h.clientService.GetClientByBSN(ctx, strings.TrimSpace(req.BSN))
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrClientNotFound):
+			ctx.JSON(http.StatusNotFound, resp.Error(err))
+		default:
+			ctx.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp.Success(result, "Client retrieved successfully"))
+}
+
+// @Summary Suggest placements for a waiting-list client
+// @Description List active locations with a free bed that offer the client's care type, most available beds first. Locations that list no care types accept every care type.
+// @Tags Client
+// @Produce json
+// @Param id path string true "Client ID"
+// @Success 200 {object} resp.SuccessResponse[[]PlacementSuggestionResponse]
+// @Failure 400 {object} resp.ErrorResponse
+// @Failure 401 {object} resp.ErrorResponse
+// @Failure 404 {object} resp.ErrorResponse
+// @Failure 500 {object} resp.ErrorResponse
+// @Router /clients/{id}/placement-suggestions [get]
+func (h *ClientHandler) SuggestPlacements(ctx *gin.Context) {
+	clientID := ctx.Param("id")
+	if clientID == "" {
+		ctx.JSON(http.StatusBadRequest, resp.Error(ErrInvalidRequest))
+		return
+	}
+
+	result, err := h.clientService.SuggestPlacements(ctx, clientID)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrClientNotFound):
+			ctx.JSON(http.StatusNotFound, resp.Error(err))
+		case errors.Is(err, ErrInvalidClientStatus):
+			ctx.JSON(http.StatusBadRequest, resp.Error(err))
+		default:
+			ctx.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp.Success(result, "Placement suggestions retrieved successfully"))
+}
+
+// parseFields splits a comma-separated ?fields= value and checks every name
+// against the allowlist. An empty value selects all fields.
+func parseFields(raw string, allowed map[string]bool) ([]string, error) {
+	if strings.TrimSpace(raw) == "" {
+		return nil, nil
+	}
+
+	var fields, invalid []string
+	for _, field := range strings.Split(raw, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		if !allowed[field] {
+			invalid = append(invalid, field)
+			continue
+		}
+		fields = append(fields, field)
+	}
+
+	if len(invalid) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidFields, strings.Join(invalid, ", "))
+	}
+	return fields, nil
+}
+
+// clientDetailFields are the GetClientResponse fields that may be requested via ?fields=
+var clientDetailFields = jsonFieldNames(reflect.TypeFor[GetClientResponse]())
+
+// jsonFieldNames returns the JSON names of a struct type's top-level fields
+func jsonFieldNames(t reflect.Type) map[string]bool {
+	names := make(map[string]bool, t.NumField())
+	for i := range t.NumField() {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" || !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		names[name] = true
+	}
+	return names
+}
+
+// selectFields returns only the given top-level JSON fields of v
+func selectFields(v any, fields []string) (map[string]json.RawMessage, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, err
+	}
+
+	partial := make(map[string]json.RawMessage, len(fields))
+	for _, field := range fields {
+		if value, ok := all[field]; ok {
+			partial[field] = value
+		}
+	}
+	return partial, nil
+}
@@ -2,7 +2,9 @@ package client_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -26,20 +28,25 @@ func setupHandlerTest(t *testing.T) (*gin.Engine, *mocks.MockClientService, *gom
 	ctrl := gomock.NewController(t)
 	mockService := mocks.NewMockClientService(ctrl)
 
-	handler := client.NewClientHandler(mockService, nil)
+	handler := client.NewClientHandler(mockService, nil, nil)
 
 	router := gin.New()
 	router.POST("/clients/move-to-waiting-list", handler.MoveClientToWaitingList)
 	router.POST("/clients/:id/move-to-care", handler.MoveClientInCare)
 	router.POST("/clients/:id/start-discharge", handler.StartDischarge)
 	router.POST("/clients/:id/complete-discharge", handler.CompleteDischarge)
+	router.GET("/clients/search", handler.SearchClients)
 	router.GET("/clients/waiting-list/stats", handler.GetWaitlistStats)
 	router.GET("/clients/waiting-list", handler.ListWaitingListClients)
 	router.GET("/clients/in-care/stats", handler.GetInCareStats)
 	router.GET("/clients/in-care", handler.ListInCareClients)
 	router.GET("/clients/discharged/stats", handler.GetDischargeStats)
 	router.GET("/clients/discharged", handler.ListDischargedClients)
+	router.GET("/clients/export", handler.ExportClients)
+	router.GET("/clients/:id", handler.GetClient)
 	router.GET("/clients/:id/goals", handler.ListClientGoals)
+	router.POST("/clients/:id/notes", handler.AddClientNote)
+	router.GET("/clients/:id/notes", handler.ListClientNotes)
 
 	return router, mockService, ctrl
 }
@@ -316,6 +323,37 @@ func TestGetStatsHandlers(t *testing.T) {
 // ============================================================
 
 func TestListClientsHandlers(t *testing.T) {
+	t.Run("SearchClients", func(t *testing.T) {
+		router, mockService, ctrl := setupHandlerTest(t)
+		defer ctrl.Finish()
+
+		mockService.EXPECT().
+			SearchClients(gomock.Any(), &client.SearchClientsRequest{Search: "Jansen"}).
+			Return(&resp.PaginationResponse[client.SearchClientsResponse]{}, nil)
+
+		w := performRequest(router, "GET", "/clients/search?search=Jansen", nil)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("SearchClients_MissingSearch", func(t *testing.T) {
+		router, _, ctrl := setupHandlerTest(t)
+		defer ctrl.Finish()
+
+		w := performRequest(router, "GET", "/clients/search", nil)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("SearchClients_InvalidStatus", func(t *testing.T) {
+		router, _, ctrl := setupHandlerTest(t)
+		defer ctrl.Finish()
+
+		w := performRequest(router, "GET", "/clients/search?search=Jansen&status=archived", nil)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
 	t.Run("ListWaitingListClients", func(t *testing.T) {
 		router, mockService, ctrl := setupHandlerTest(t)
 		defer ctrl.Finish()
@@ -342,6 +380,19 @@ func TestListClientsHandlers(t *testing.T) {
 		assert.Equal(t, http.StatusOK, w.Code)
 	})
 
+	t.Run("ListInCareClients_InvalidCursor", func(t *testing.T) {
+		router, mockService, ctrl := setupHandlerTest(t)
+		defer ctrl.Finish()
+
+		mockService.EXPECT().
+			ListInCareClients(gomock.Any(), gomock.Any()).
+			Return(nil, client.ErrInvalidCursor)
+
+		w := performRequest(router, "GET", "/clients/in-care?cursor=bogus", nil)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
 	t.Run("ListDischargedClients", func(t *testing.T) {
 		router, mockService, ctrl := setupHandlerTest(t)
 		defer ctrl.Finish()
@@ -356,6 +407,65 @@ func TestListClientsHandlers(t *testing.T) {
 	})
 }
 
+// ============================================================
+// Test: ExportClients
+// ============================================================
+
+func TestExportClientsHandler(t *testing.T) {
+	t.Run("streams_service_output", func(t *testing.T) {
+		router, mockService, ctrl := setupHandlerTest(t)
+		defer ctrl.Finish()
+
+		mockService.EXPECT().
+			ExportClients(gomock.Any(), gomock.Any(), &client.ExportClientsRequest{}).
+			DoAndReturn(func(_ context.Context, w io.Writer, _ *client.ExportClientsRequest) error {
+				_, err := io.WriteString(w, `[{"id":"client-1"}]`)
+				return err
+			})
+
+		w := performRequest(router, "GET", "/clients/export", nil)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/json; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.JSONEq(t, `[{"id":"client-1"}]`, w.Body.String())
+	})
+
+	t.Run("error_before_stream_starts", func(t *testing.T) {
+		router, mockService, ctrl := setupHandlerTest(t)
+		defer ctrl.Finish()
+
+		mockService.EXPECT().
+			ExportClients(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(client.ErrInternal)
+
+		w := performRequest(router, "GET", "/clients/export", nil)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("redact_flag_is_passed_on", func(t *testing.T) {
+		router, mockService, ctrl := setupHandlerTest(t)
+		defer ctrl.Finish()
+
+		mockService.EXPECT().
+			ExportClients(gomock.Any(), gomock.Any(), &client.ExportClientsRequest{Redact: true}).
+			Return(nil)
+
+		w := performRequest(router, "GET", "/clients/export?redact=true", nil)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("invalid_redact_flag", func(t *testing.T) {
+		router, _, ctrl := setupHandlerTest(t)
+		defer ctrl.Finish()
+
+		w := performRequest(router, "GET", "/clients/export?redact=maybe", nil)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
 // ============================================================
 // Test: ListClientGoals
 // ============================================================
@@ -372,3 +482,181 @@ func TestListClientGoalsHandler(t *testing.T) {
 
 	assert.Equal(t, http.StatusOK, w.Code)
 }
+
+// ============================================================
+// Test: GetClient
+// ============================================================
+
+func TestGetClientHandler(t *testing.T) {
+	phone := "0612345678"
+	fullClient := &client.GetClientResponse{
+		ID:          "client-123",
+		FirstName:   "Jan",
+		LastName:    "Jansen",
+		Bsn:         "123456789",
+		PhoneNumber: &phone,
+		Status:      "in_care",
+		LocationID:  "loc-1",
+	}
+
+	tests := []struct {
+		name           string
+		path           string
+		setup          func(mockService *mocks.MockClientService)
+		expectedStatus int
+		validateBody   func(t *testing.T, body []byte)
+	}{
+		{
+			name: "full_payload",
+			path: "/clients/client-123",
+			setup: func(mockService *mocks.MockClientService) {
+				mockService.EXPECT().GetClient(gomock.Any(), "client-123").Return(fullClient, nil)
+			},
+			expectedStatus: http.StatusOK,
+			validateBody: func(t *testing.T, body []byte) {
+				var response resp.SuccessResponse[map[string]any]
+				require.NoError(t, json.Unmarshal(body, &response))
+				assert.Contains(t, response.Data, "bsn")
+				assert.Contains(t, response.Data, "createdAt")
+			},
+		},
+		{
+			name: "field_subset",
+			path: "/clients/client-123?fields=id,firstName,%20status",
+			setup: func(mockService *mocks.MockClientService) {
+				mockService.EXPECT().GetClient(gomock.Any(), "client-123").Return(fullClient, nil)
+			},
+			expectedStatus: http.StatusOK,
+			validateBody: func(t *testing.T, body []byte) {
+				var response resp.SuccessResponse[map[string]any]
+				require.NoError(t, json.Unmarshal(body, &response))
+				assert.Equal(t, map[string]any{
+					"id":        "client-123",
+					"firstName": "Jan",
+					"status":    "in_care",
+				}, response.Data)
+			},
+		},
+		{
+			// The allowed fields come from GetClientResponse's JSON tags
+			name: "any_response_field",
+			path: "/clients/client-123?fields=evaluationIntervalWeeks,updatedAt",
+			setup: func(mockService *mocks.MockClientService) {
+				mockService.EXPECT().GetClient(gomock.Any(), "client-123").Return(fullClient, nil)
+			},
+			expectedStatus: http.StatusOK,
+			validateBody: func(t *testing.T, body []byte) {
+				var response resp.SuccessResponse[map[string]any]
+				require.NoError(t, json.Unmarshal(body, &response))
+				assert.Len(t, response.Data, 2)
+				assert.Contains(t, response.Data, "evaluationIntervalWeeks")
+				assert.Contains(t, response.Data, "updatedAt")
+			},
+		},
+		{
+			name:           "invalid_fields",
+			path:           "/clients/client-123?fields=id,password,secret",
+			setup:          func(mockService *mocks.MockClientService) {},
+			expectedStatus: http.StatusBadRequest,
+			validateBody: func(t *testing.T, body []byte) {
+				var response resp.ErrorResponse
+				require.NoError(t, json.Unmarshal(body, &response))
+				assert.Contains(t, response.Error, "password, secret")
+			},
+		},
+		{
+			name: "not_found",
+			path: "/clients/missing",
+			setup: func(mockService *mocks.MockClientService) {
+				mockService.EXPECT().GetClient(gomock.Any(), "missing").Return(nil, client.ErrClientNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService, ctrl := setupHandlerTest(t)
+			defer ctrl.Finish()
+
+			tt.setup(mockService)
+
+			w := performRequest(router, "GET", tt.path, nil)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.validateBody != nil {
+				tt.validateBody(t, w.Body.Bytes())
+			}
+		})
+	}
+}
+
+// ============================================================
+// Test: Client notes
+// ============================================================
+
+func TestAddClientNoteHandler(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           interface{}
+		setup          func(mockService *mocks.MockClientService)
+		expectedStatus int
+	}{
+		{
+			name: "created",
+			body: map[string]string{"body": "Spoke with family"},
+			setup: func(mockService *mocks.MockClientService) {
+				mockService.EXPECT().
+					AddClientNote(gomock.Any(), "client-123", &client.AddClientNoteRequest{Body: "Spoke with family"}).
+					Return(&client.ClientNoteResponse{ID: "note-1", Body: "Spoke with family"}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "missing_body",
+			body:           map[string]string{},
+			setup:          func(mockService *mocks.MockClientService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "client_not_found",
+			body: map[string]string{"body": "note"},
+			setup: func(mockService *mocks.MockClientService) {
+				mockService.EXPECT().
+					AddClientNote(gomock.Any(), "client-123", gomock.Any()).
+					Return(nil, client.ErrClientNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService, ctrl := setupHandlerTest(t)
+			defer ctrl.Finish()
+
+			tt.setup(mockService)
+
+			w := performRequest(router, "POST", "/clients/client-123/notes", tt.body)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestListClientNotesHandler(t *testing.T) {
+	router, mockService, ctrl := setupHandlerTest(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().
+		ListClientNotes(gomock.Any(), "client-123").
+		Return([]client.ClientNoteResponse{{ID: "note-2"}, {ID: "note-1"}}, nil)
+
+	w := performRequest(router, "GET", "/clients/client-123/notes", nil)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response resp.SuccessResponse[[]client.ClientNoteResponse]
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	require.Len(t, response.Data, 2)
+	assert.Equal(t, "note-2", response.Data[0].ID)
+}
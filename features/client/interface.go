@@ -3,6 +3,7 @@ package client
 import (
 	"care-cordination/lib/resp"
 	"context"
+	"io"
 )
 
 //go:generate mockgen -destination=../../internal/mocks/mock_client_service.go -package=mocks care-cordination/features/client ClientService
@@ -26,6 +27,10 @@ type ClientService interface {
 		clientID string,
 		req *CompleteDischargeRequest,
 	) (*CompleteDischargeResponse, error)
+	SearchClients(
+		ctx context.Context,
+		req *SearchClientsRequest,
+	) (*resp.PaginationResponse[SearchClientsResponse], error)
 	ListWaitingListClients(
 		ctx context.Context,
 		req *ListWaitingListClientsRequest,
@@ -38,10 +43,21 @@ type ClientService interface {
 		ctx context.Context,
 		req *ListDischargedClientsRequest,
 	) (*resp.PaginationResponse[ListDischargedClientsResponse], error)
+	ExportClients(ctx context.Context, w io.Writer, req *ExportClientsRequest) error
 
 	GetWaitlistStats(ctx context.Context) (*GetWaitlistStatsResponse, error)
 	GetInCareStats(ctx context.Context) (*GetInCareStatsResponse, error)
 	GetDischargeStats(ctx context.Context) (*GetDischargeStatsResponse, error)
+	GetDischargeTrend(ctx context.Context, req *GetDischargeTrendRequest) ([]DischargeTrendMonth, error)
 
 	ListClientGoals(ctx context.Context, clientID string) ([]ListClientGoalsResponse, error)
+	AddClientNote(
+		ctx context.Context,
+		clientID string,
+		req *AddClientNoteRequest,
+	) (*ClientNoteResponse, error)
+	ListClientNotes(ctx context.Context, clientID string) ([]ClientNoteResponse, error)
+	GetClient(ctx context.Context, clientID string) (*GetClientResponse, error)
+	GetClientByBSN(ctx context.Context, bsn string) (*GetClientResponse, error)
+	SuggestPlacements(ctx context.Context, clientID string) ([]PlacementSuggestionResponse, error)
 }
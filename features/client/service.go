@@ -1,26 +1,56 @@
 package client
 
 import (
+	"care-cordination/lib/assignment"
 	"care-cordination/lib/middleware"
+	"care-cordination/lib/db/pool"
 	db "care-cordination/lib/db/sqlc"
 	"care-cordination/lib/logger"
 	"care-cordination/lib/nanoid"
 	"care-cordination/lib/resp"
 	"care-cordination/lib/util"
 	"context"
+	"encoding/json"
+	"errors"
+	"io"
 	"math/rand"
+	"net/http"
+	"strings"
 	"time"
+	"unicode"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"go.uber.org/zap"
 )
 
+// exportBatchSize is how many clients ExportClients reads per query.
+const exportBatchSize = 500
+
 type clientService struct {
-	db     db.StoreInterface
-	logger logger.Logger
+	db                       db.StoreInterface
+	logger                   logger.Logger
+	exportBatchSize          int32
+	maxTextLength            int
+	coordinatorLocationCheck assignment.Mode
+	caseloadLimit            assignment.CaseloadLimit
 }
 
-func NewClientService(db db.StoreInterface, logger logger.Logger) ClientService {
-	return &clientService{db: db, logger: logger}
+func NewClientService(
+	db db.StoreInterface,
+	logger logger.Logger,
+	maxTextLength int,
+	coordinatorLocationCheck assignment.Mode,
+	caseloadLimit assignment.CaseloadLimit,
+) ClientService {
+	return &clientService{
+		db:                       db,
+		logger:                   logger,
+		exportBatchSize:          exportBatchSize,
+		maxTextLength:            maxTextLength,
+		coordinatorLocationCheck: coordinatorLocationCheck,
+		caseloadLimit:            caseloadLimit,
+	}
 }
 
 func (s *clientService) MoveClientToWaitingList(
@@ -49,6 +79,74 @@ func (s *clientService) MoveClientToWaitingList(
 		return nil, ErrRegistrationFormNotFound
 	}
 
+	// Intakes saved before the interval was bounded may still carry an
+	// out-of-range value; refuse to copy it onto the client.
+	if !util.ValidEvaluationInterval(util.PointerInt32ToInt(intakeForm.EvaluationIntervalWeeks)) {
+		s.logger.Error(
+			ctx,
+			"MoveClientToWaitingList",
+			"Intake form has an out-of-range evaluation interval",
+			zap.Int32p("evaluationIntervalWeeks", intakeForm.EvaluationIntervalWeeks),
+		)
+		return nil, ErrInvalidEvaluationInterval
+	}
+
+	// Moving to the waiting list completes the intake, so it is held to the
+	// same document checklist as completing the intake directly.
+	missingDocuments, err := s.db.CountMissingIntakeDocuments(ctx, intakeForm.ID)
+	if err != nil {
+		s.logger.Error(
+			ctx,
+			"MoveClientToWaitingList",
+			"Failed to count missing intake documents",
+			zap.Error(err),
+		)
+		return nil, ErrInternal
+	}
+	if missingDocuments > 0 {
+		return nil, ErrIntakeDocumentsMissing
+	}
+
+	if err := s.coordinatorLocationCheck.CheckCoordinatorLocation(
+		ctx,
+		s.db,
+		s.logger,
+		"MoveClientToWaitingList",
+		intakeForm.CoordinatorID,
+		intakeForm.LocationID,
+	); err != nil {
+		if errors.Is(err, assignment.ErrCoordinatorLocationMismatch) {
+			return nil, err
+		}
+		s.logger.Error(
+			ctx,
+			"MoveClientToWaitingList",
+			"Failed to check coordinator location",
+			zap.Error(err),
+		)
+		return nil, ErrInternal
+	}
+
+	caseload, err := s.caseloadLimit.CaseloadCheck(
+		ctx,
+		s.db,
+		intakeForm.CoordinatorID,
+		"",
+		req.OverrideCaseload,
+	)
+	if err != nil {
+		if errors.Is(err, assignment.ErrCaseloadOverrideForbidden) {
+			return nil, err
+		}
+		s.logger.Error(
+			ctx,
+			"MoveClientToWaitingList",
+			"Failed to check caseload override",
+			zap.Error(err),
+		)
+		return nil, ErrInternal
+	}
+
 	// Generate unique client ID
 	clientID := nanoid.Generate()
 
@@ -74,6 +172,8 @@ func (s *clientService) MoveClientToWaitingList(
 		FocusAreas:              intakeForm.FocusAreas,
 		Notes:                   intakeForm.Notes,
 		EvaluationIntervalWeeks: intakeForm.EvaluationIntervalWeeks,
+		CreatedByUserID:         util.GetUserIDPtr(ctx),
+		OrganizationID:          util.GetOrganizationIDPtr(ctx),
 	}
 
 	// Create the client and update intake form status in a transaction
@@ -83,8 +183,17 @@ func (s *clientService) MoveClientToWaitingList(
 		IntakeFormNewStatus:       db.IntakeStatusEnumCompleted,
 		RegistrationFormID:        registrationForm.ID,
 		RegistrationFormNewStatus: db.RegistrationStatusEnumApproved,
+		ChangedBy:                 util.GetUserID(ctx),
+		Caseload:                  caseload,
 	})
 	if err != nil {
+		if db.IsUniqueViolationOf(err, "uq_clients_active_bsn") {
+			return nil, ErrActiveClientExists
+		}
+		if errors.Is(err, assignment.ErrCoordinatorAtCapacity) ||
+			errors.Is(err, assignment.ErrCoordinatorNotFound) {
+			return nil, err
+		}
 		s.logger.Error(
 			ctx,
 			"MoveClientToWaitingList",
@@ -112,7 +221,8 @@ func (s *clientService) MoveClientInCare(
 	clientID string,
 	req *MoveClientInCareRequest,
 ) (*MoveClientInCareResponse, error) {
-	client, err := s.db.GetClientByID(ctx, clientID)
+	scoped := s.db.ForOrganization(util.GetOrganizationID(ctx))
+	client, err := scoped.GetClient(ctx, clientID)
 	if err != nil {
 		s.logger.Error(ctx, "MoveClientInCare", "Failed to get client", zap.Error(err))
 		return nil, ErrClientNotFound
@@ -172,7 +282,11 @@ func (s *clientService) MoveClientInCare(
 		}
 	}
 
-	updatedClient, err := s.db.UpdateClient(ctx, updateParams)
+	result, err := s.db.MoveClientInCareTx(ctx, db.MoveClientInCareTxParams{
+		OrganizationID: scoped.OrganizationID(),
+		Client:         updateParams,
+		CoordinatorID:  client.CoordinatorID,
+	})
 	if err != nil {
 		s.logger.Error(ctx, "MoveClientInCare", "Failed to update client status", zap.Error(err))
 		return nil, ErrInternal
@@ -182,11 +296,11 @@ func (s *clientService) MoveClientInCare(
 		ctx,
 		"MoveClientInCare",
 		"Client moved to in care successfully",
-		zap.String("clientId", updatedClient),
+		zap.String("clientId", result.ClientID),
 	)
 
 	return &MoveClientInCareResponse{
-		ClientID: updatedClient,
+		ClientID: result.ClientID,
 	}, nil
 }
 
@@ -195,7 +309,8 @@ func (s *clientService) StartDischarge(
 	clientID string,
 	req *StartDischargeRequest,
 ) (*StartDischargeResponse, error) {
-	client, err := s.db.GetClientByID(ctx, clientID)
+	scoped := s.db.ForOrganization(util.GetOrganizationID(ctx))
+	client, err := scoped.GetClient(ctx, clientID)
 	if err != nil {
 		s.logger.Error(ctx, "StartDischarge", "Failed to get client", zap.Error(err))
 		return nil, ErrClientNotFound
@@ -241,7 +356,7 @@ func (s *clientService) StartDischarge(
 		},
 	}
 
-	updatedClient, err := s.db.UpdateClient(ctx, updateParams)
+	updatedClient, err := scoped.UpdateClient(ctx, updateParams)
 	if err != nil {
 		s.logger.Error(ctx, "StartDischarge", "Failed to update client", zap.Error(err))
 		return nil, ErrInternal
@@ -264,7 +379,12 @@ func (s *clientService) CompleteDischarge(
 	clientID string,
 	req *CompleteDischargeRequest,
 ) (*CompleteDischargeResponse, error) {
-	client, err := s.db.GetClientByID(ctx, clientID)
+	if !util.TextWithinLimit(s.maxTextLength, &req.ClosingReport, &req.EvaluationReport) {
+		return nil, ErrTextTooLong
+	}
+
+	scoped := s.db.ForOrganization(util.GetOrganizationID(ctx))
+	client, err := scoped.GetClient(ctx, clientID)
 	if err != nil {
 		s.logger.Error(ctx, "CompleteDischarge", "Failed to get client", zap.Error(err))
 		return nil, ErrClientNotFound
@@ -304,7 +424,7 @@ func (s *clientService) CompleteDischarge(
 		},
 	}
 
-	updatedClient, err := s.db.UpdateClient(ctx, updateParams)
+	updatedClient, err := scoped.UpdateClient(ctx, updateParams)
 	if err != nil {
 		s.logger.Error(ctx, "CompleteDischarge", "Failed to update client", zap.Error(err))
 		return nil, ErrInternal
@@ -322,30 +442,75 @@ func (s *clientService) CompleteDischarge(
 	}, nil
 }
 
+func (s *clientService) SearchClients(
+	ctx context.Context,
+	req *SearchClientsRequest,
+) (*resp.PaginationResponse[SearchClientsResponse], error) {
+	limit, offset, page, pageSize := middleware.GetPaginationParams(ctx)
+
+	var status db.NullClientStatusEnum
+	if req.Status != nil {
+		status = db.NullClientStatusEnum{
+			ClientStatusEnum: db.ClientStatusEnum(*req.Status),
+			Valid:            true,
+		}
+	}
+
+	scoped := s.db.ForOrganization(util.GetOrganizationID(ctx))
+	clients, err := scoped.SearchClients(ctx, db.SearchClientsParams{
+		Limit:  limit,
+		Offset: offset,
+		Search: req.Search,
+		Status: status,
+	})
+	if err != nil {
+		s.logger.Error(ctx, "SearchClients", "Failed to search clients", zap.Error(err))
+		return nil, ErrInternal
+	}
+
+	searchResponse := []SearchClientsResponse{}
+	totalCount := 0
+
+	for _, client := range clients {
+		searchResponse = append(searchResponse, SearchClientsResponse{
+			ID:           client.ID,
+			FirstName:    client.FirstName,
+			LastName:     client.LastName,
+			Bsn:          client.Bsn,
+			DateOfBirth:  util.PgtypeDateToStr(client.DateOfBirth),
+			Status:       string(client.Status),
+			LocationID:   client.LocationID,
+			LocationName: client.LocationName,
+		})
+		if totalCount == 0 {
+			totalCount = int(client.TotalCount)
+		}
+	}
+
+	result := resp.PagRespWithParams(searchResponse, totalCount, page, pageSize)
+	return &result, nil
+}
+
+// waitingListSortFields are the fields the waiting list can be sorted by;
+// without a sort it is ordered by priority, then by time on the list
+var waitingListSortFields = []string{"priority", "name", "created_at"}
+
 func (s *clientService) ListWaitingListClients(
 	ctx context.Context,
 	req *ListWaitingListClientsRequest,
 ) (*resp.PaginationResponse[ListWaitingListClientsResponse], error) {
+	sort, err := util.ParseSort(req.Sort, waitingListSortFields...)
+	if err != nil {
+		return nil, ErrInvalidRequest
+	}
 	limit, offset, page, pageSize := middleware.GetPaginationParams(ctx)
 
-	var clients []db.ListWaitingListClientsRow
-	var err error
-	err = s.db.ExecTx(ctx, func(tx *db.Queries) error {
-		clients, err = tx.ListWaitingListClients(ctx, db.ListWaitingListClientsParams{
-			Limit:  limit,
-			Offset: offset,
-			Search: req.Search,
-		})
-		if err != nil {
-			s.logger.Error(
-				ctx,
-				"ListWaitingListClients",
-				"Failed to list waiting list clients",
-				zap.Error(err),
-			)
-			return ErrInternal
-		}
-		return nil
+	scoped := s.db.ForOrganization(util.GetOrganizationID(ctx))
+	clients, err := scoped.ListWaitingListClients(ctx, db.ListWaitingListClientsParams{
+		Limit:  limit,
+		Offset: offset,
+		Search: req.Search,
+		Sort:   sort.Key(),
 	})
 	if err != nil {
 		s.logger.Error(
@@ -394,8 +559,6 @@ func (s *clientService) ListInCareClients(
 	ctx context.Context,
 	req *ListInCareClientsRequest,
 ) (*resp.PaginationResponse[ListInCareClientsResponse], error) {
-	limit, offset, page, pageSize := middleware.GetPaginationParams(ctx)
-
 	// Build care type filter
 	var careTypeFilter db.NullCareTypeEnum
 	if req.CareType != nil {
@@ -405,20 +568,18 @@ func (s *clientService) ListInCareClients(
 		}
 	}
 
-	var clients []db.ListInCareClientsRow
-	var err error
-	err = s.db.ExecTx(ctx, func(tx *db.Queries) error {
-		clients, err = tx.ListInCareClients(ctx, db.ListInCareClientsParams{
-			Limit:    limit,
-			Offset:   offset,
-			Search:   req.Search,
-			CareType: careTypeFilter,
-		})
-		if err != nil {
-			s.logger.Error(ctx, "ListInCareClients", "Failed to list in care clients", zap.Error(err))
-			return ErrInternal
-		}
-		return nil
+	if req.Cursor != nil {
+		return s.listInCareClientsByCursor(ctx, req, careTypeFilter)
+	}
+
+	limit, offset, page, pageSize := middleware.GetPaginationParams(ctx)
+
+	scoped := s.db.ForOrganization(util.GetOrganizationID(ctx))
+	clients, err := scoped.ListInCareClients(ctx, db.ListInCareClientsParams{
+		Limit:    limit,
+		Offset:   offset,
+		Search:   req.Search,
+		CareType: careTypeFilter,
 	})
 	if err != nil {
 		s.logger.Error(ctx, "ListInCareClients", "Failed to list in care clients", zap.Error(err))
@@ -430,42 +591,7 @@ func (s *clientService) ListInCareClients(
 	now := time.Now()
 
 	for _, client := range clients {
-		response := ListInCareClientsResponse{
-			ID:                   client.ID,
-			FirstName:            client.FirstName,
-			LastName:             client.LastName,
-			Bsn:                  client.Bsn,
-			DateOfBirth:          util.PgtypeDateToStr(client.DateOfBirth),
-			PhoneNumber:          client.PhoneNumber,
-			Gender:               string(client.Gender),
-			CareType:             string(client.CareType),
-			CareStartDate:        util.PgtypeDateToStr(client.CareStartDate),
-			CareEndDate:          util.PgtypeDateToStr(client.CareEndDate),
-			LocationID:           client.LocationID,
-			LocationName:         client.LocationName,
-			CoordinatorID:        client.CoordinatorID,
-			CoordinatorFirstName: client.CoordinatorFirstName,
-			CoordinatorLastName:  client.CoordinatorLastName,
-			ReferringOrgName:     client.ReferringOrgName,
-		}
-
-		// Calculate weeks in accommodation or used ambulatory hours based on care type
-		if client.CareType == db.CareTypeEnumAmbulatoryCare {
-			// For ambulatory care: generate random used hours for demo purposes
-			randomHours := rand.Intn(100) + 1 // 1-100 hours
-			response.UsedAmbulatoryHours = &randomHours
-		} else {
-			// For protected_living, semi_independent_living, independent_assisted_living
-			// Calculate weeks from care start date
-			if client.CareStartDate.Valid {
-				startDate := client.CareStartDate.Time
-				duration := now.Sub(startDate)
-				weeks := int(duration.Hours() / 24 / 7)
-				response.WeeksInAccommodation = &weeks
-			}
-		}
-
-		listClientsResponse = append(listClientsResponse, response)
+		listClientsResponse = append(listClientsResponse, toInCareClientResponse(client, now))
 		if totalCount == 0 {
 			totalCount = int(client.TotalCount)
 		}
@@ -475,6 +601,112 @@ func (s *clientService) ListInCareClients(
 	return &result, nil
 }
 
+// listInCareClientsByCursor is the keyset-paginated path of ListInCareClients.
+// One extra row is fetched to tell whether a next page exists.
+func (s *clientService) listInCareClientsByCursor(
+	ctx context.Context,
+	req *ListInCareClientsRequest,
+	careTypeFilter db.NullCareTypeEnum,
+) (*resp.PaginationResponse[ListInCareClientsResponse], error) {
+	_, _, _, pageSize := middleware.GetPaginationParams(ctx)
+
+	params := db.ListInCareClientsByCursorParams{
+		Search:   req.Search,
+		CareType: careTypeFilter,
+		PageSize: pageSize + 1,
+	}
+	if *req.Cursor != "" {
+		createdAt, id, err := util.DecodeCursor(*req.Cursor)
+		if err != nil {
+			return nil, ErrInvalidCursor
+		}
+		params.CursorCreatedAt = pgtype.Timestamp{Time: createdAt, Valid: true}
+		params.CursorID = &id
+	}
+
+	clients, err := s.db.ForOrganization(util.GetOrganizationID(ctx)).ListInCareClientsByCursor(ctx, params)
+	if err != nil {
+		s.logger.Error(ctx, "ListInCareClients", "Failed to list in care clients by cursor", zap.Error(err))
+		return nil, ErrInternal
+	}
+
+	nextCursor := ""
+	if len(clients) > int(pageSize) {
+		clients = clients[:pageSize]
+		last := clients[len(clients)-1]
+		nextCursor = util.EncodeCursor(last.CreatedAt.Time, last.ID)
+	}
+
+	listClientsResponse := []ListInCareClientsResponse{}
+	now := time.Now()
+	for _, client := range clients {
+		listClientsResponse = append(
+			listClientsResponse,
+			toInCareClientResponse(db.ListInCareClientsRow{
+				ID:                    client.ID,
+				FirstName:             client.FirstName,
+				LastName:              client.LastName,
+				Bsn:                   client.Bsn,
+				DateOfBirth:           client.DateOfBirth,
+				PhoneNumber:           client.PhoneNumber,
+				Gender:                client.Gender,
+				CareType:              client.CareType,
+				CareStartDate:         client.CareStartDate,
+				CareEndDate:           client.CareEndDate,
+				AmbulatoryWeeklyHours: client.AmbulatoryWeeklyHours,
+				CreatedAt:             client.CreatedAt,
+				LocationID:            client.LocationID,
+				LocationName:          client.LocationName,
+				CoordinatorID:         client.CoordinatorID,
+				CoordinatorFirstName:  client.CoordinatorFirstName,
+				CoordinatorLastName:   client.CoordinatorLastName,
+				ReferringOrgName:      client.ReferringOrgName,
+			}, now),
+		)
+	}
+
+	result := resp.CursorResp(listClientsResponse, pageSize, nextCursor)
+	return &result, nil
+}
+
+func toInCareClientResponse(client db.ListInCareClientsRow, now time.Time) ListInCareClientsResponse {
+	response := ListInCareClientsResponse{
+		ID:                   client.ID,
+		FirstName:            client.FirstName,
+		LastName:             client.LastName,
+		Bsn:                  client.Bsn,
+		DateOfBirth:          util.PgtypeDateToStr(client.DateOfBirth),
+		PhoneNumber:          client.PhoneNumber,
+		Gender:               string(client.Gender),
+		CareType:             string(client.CareType),
+		CareStartDate:        util.PgtypeDateToStr(client.CareStartDate),
+		CareEndDate:          util.PgtypeDateToStr(client.CareEndDate),
+		LocationID:           client.LocationID,
+		LocationName:         client.LocationName,
+		CoordinatorID:        client.CoordinatorID,
+		CoordinatorFirstName: client.CoordinatorFirstName,
+		CoordinatorLastName:  client.CoordinatorLastName,
+		ReferringOrgName:     client.ReferringOrgName,
+	}
+
+	// Calculate weeks in accommodation or used ambulatory hours based on care type
+	if client.CareType == db.CareTypeEnumAmbulatoryCare {
+		// For ambulatory care: generate random used hours for demo purposes
+		randomHours := rand.Intn(100) + 1 // 1-100 hours
+		response.UsedAmbulatoryHours = &randomHours
+	} else {
+		// For protected_living, semi_independent_living, independent_assisted_living
+		// Calculate weeks from care start date
+		if client.CareStartDate.Valid {
+			startDate := client.CareStartDate.Time
+			duration := now.Sub(startDate)
+			weeks := int(duration.Hours() / 24 / 7)
+			response.WeeksInAccommodation = &weeks
+		}
+	}
+	return response
+}
+
 func (s *clientService) ListDischargedClients(
 	ctx context.Context,
 	req *ListDischargedClientsRequest,
@@ -489,25 +721,12 @@ func (s *clientService) ListDischargedClients(
 			Valid:               true,
 		}
 	}
-	var clients []db.ListDischargedClientsRow
-	var err error
-	err = s.db.ExecTx(ctx, func(tx *db.Queries) error {
-		clients, err = tx.ListDischargedClients(ctx, db.ListDischargedClientsParams{
-			Limit:           limit,
-			Offset:          offset,
-			Search:          req.Search,
-			DischargeStatus: dischargeStatusFilter,
-		})
-		if err != nil {
-			s.logger.Error(
-				ctx,
-				"ListDischargedClients",
-				"Failed to list discharged clients",
-				zap.Error(err),
-			)
-			return ErrInternal
-		}
-		return nil
+	scoped := s.db.ForOrganization(util.GetOrganizationID(ctx))
+	clients, err := scoped.ListDischargedClients(ctx, db.ListDischargedClientsParams{
+		Limit:           limit,
+		Offset:          offset,
+		Search:          req.Search,
+		DischargeStatus: dischargeStatusFilter,
 	})
 	if err != nil {
 		s.logger.Error(
@@ -556,6 +775,126 @@ func (s *clientService) ListDischargedClients(
 	return &result, nil
 }
 
+// ExportClients writes every client to w as a JSON array. Clients are read in
+// keyset batches and each batch is written, and flushed when w supports it,
+// before the next one is fetched, so memory stays flat however many clients
+// there are. Nothing is written when the first batch cannot be read. With
+// req.Redact set, client PII is masked (see redactExportClient). The batch
+// queries are exempt from the database statement timeout.
+func (s *clientService) ExportClients(ctx context.Context, w io.Writer, req *ExportClientsRequest) error {
+	ctx = pool.WithStatementTimeout(ctx, 0)
+	scoped := s.db.ForOrganization(util.GetOrganizationID(ctx))
+	flusher, _ := w.(http.Flusher)
+	var afterID *string
+	opened := false
+	count := 0
+
+	for {
+		rows, err := scoped.ListClientsForExport(ctx, db.ListClientsForExportParams{
+			AfterID:   afterID,
+			BatchSize: s.exportBatchSize,
+		})
+		if err != nil {
+			s.logger.Error(ctx, "ExportClients", "Failed to list clients", zap.Error(err))
+			return ErrInternal
+		}
+
+		if !opened {
+			if _, err := io.WriteString(w, "["); err != nil {
+				return err
+			}
+			opened = true
+		}
+
+		for _, row := range rows {
+			exported := toExportClientResponse(row)
+			if req.Redact {
+				redactExportClient(&exported)
+			}
+			data, err := json.Marshal(exported)
+			if err != nil {
+				s.logger.Error(ctx, "ExportClients", "Failed to encode client", zap.Error(err))
+				return ErrInternal
+			}
+			if count > 0 {
+				if _, err := io.WriteString(w, ","); err != nil {
+					return err
+				}
+			}
+			if _, err := w.Write(data); err != nil {
+				return err
+			}
+			count++
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+
+		if len(rows) < int(s.exportBatchSize) {
+			break
+		}
+		afterID = &rows[len(rows)-1].ID
+	}
+
+	_, err := io.WriteString(w, "]")
+	return err
+}
+
+func toExportClientResponse(row db.ListClientsForExportRow) ExportClientResponse {
+	return ExportClientResponse{
+		ID:                   row.ID,
+		FirstName:            row.FirstName,
+		LastName:             row.LastName,
+		Bsn:                  row.Bsn,
+		DateOfBirth:          util.PgtypeDateToStr(row.DateOfBirth),
+		Gender:               string(row.Gender),
+		Status:               string(row.Status),
+		CareType:             string(row.CareType),
+		CareStartDate:        util.PgtypeDateToStr(row.CareStartDate),
+		CareEndDate:          util.PgtypeDateToStr(row.CareEndDate),
+		DischargeDate:        util.PgtypeDateToStr(row.DischargeDate),
+		LocationName:         row.LocationName,
+		CoordinatorFirstName: row.CoordinatorFirstName,
+		CoordinatorLastName:  row.CoordinatorLastName,
+		CreatedAt:            util.PgtypeTimestampToStr(row.CreatedAt),
+	}
+}
+
+// redactExportClient masks what identifies the client: the BSN keeps only its
+// last two digits, names are reduced to initials, the date of birth to the
+// birth year, and gender and the coordinator's name are left out. Care type,
+// status, location and care dates are kept; the export carries no notes to
+// strip.
+func redactExportClient(c *ExportClientResponse) {
+	c.Bsn = maskBSN(c.Bsn)
+	c.FirstName = initial(c.FirstName)
+	c.LastName = initial(c.LastName)
+	c.DateOfBirth = birthYear(c.DateOfBirth)
+	c.Gender = ""
+	c.CoordinatorFirstName = ""
+	c.CoordinatorLastName = ""
+}
+
+// birthYear keeps the year of a YYYY-MM-DD date
+func birthYear(date string) string {
+	year, _, _ := strings.Cut(date, "-")
+	return year
+}
+
+func maskBSN(bsn string) string {
+	if len(bsn) <= 2 {
+		return strings.Repeat("*", len(bsn))
+	}
+	return strings.Repeat("*", len(bsn)-2) + bsn[len(bsn)-2:]
+}
+
+func initial(name string) string {
+	for _, r := range strings.TrimSpace(name) {
+		return string(unicode.ToUpper(r)) + "."
+	}
+	return ""
+}
+
 func (s *clientService) GetWaitlistStats(
 	ctx context.Context,
 ) (*GetWaitlistStatsResponse, error) {
@@ -598,13 +937,14 @@ func (s *clientService) GetInCareStats(
 		}
 		return nil
 	})
-
-	// Type assert avg_days_in_care from numeric/interface{}
-	avgDays, _ := stats.AvgDaysInCare.(float64)
+	if err != nil {
+		return nil, ErrInternal
+	}
 
 	return &GetInCareStatsResponse{
 		TotalCount:        int(stats.TotalCount),
-		AverageDaysInCare: avgDays,
+		AverageDaysInCare: stats.AvgDaysInCare,
+		LongestStayDays:   int(stats.LongestStayDays),
 		CountsByCareType: CareTypeCounts{
 			ProtectedLiving:           int(stats.ProtectedLivingCount),
 			SemiIndependentLiving:     int(stats.SemiIndependentLivingCount),
@@ -645,10 +985,61 @@ func (s *clientService) GetDischargeStats(
 	}, nil
 }
 
+// GetDischargeTrend counts discharges per month for the last req.Months months
+// (12 by default). The current month is taken in req.Timezone (the application
+// timezone by default) so a discharge late on the last day of a month is not
+// shifted into the next one.
+func (s *clientService) GetDischargeTrend(
+	ctx context.Context,
+	req *GetDischargeTrendRequest,
+) ([]DischargeTrendMonth, error) {
+	months := req.Months
+	if months == 0 {
+		months = 12
+	}
+	loc := util.AppLocation()
+	if req.Timezone != "" {
+		var err error
+		if loc, err = time.LoadLocation(req.Timezone); err != nil {
+			return nil, ErrInvalidRequest
+		}
+	}
+
+	now := time.Now().In(loc)
+	currentMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	rows, err := s.db.GetDischargeTrendByMonth(ctx, db.GetDischargeTrendByMonthParams{
+		CurrentMonth: util.TimeToPgtypeDate(currentMonth),
+		Months:       int32(months),
+	})
+	if err != nil {
+		s.logger.Error(ctx, "GetDischargeTrend", "Failed to get discharge trend", zap.Error(err))
+		return nil, ErrInternal
+	}
+
+	trend := make([]DischargeTrendMonth, 0, len(rows))
+	for _, row := range rows {
+		trend = append(trend, DischargeTrendMonth{
+			Month:               row.Month.Time.Format("2006-01"),
+			PlannedDischarges:   int(row.PlannedDischarges),
+			PrematureDischarges: int(row.PrematureDischarges),
+			TotalCount:          int(row.TotalCount),
+		})
+	}
+	return trend, nil
+}
+
 func (s *clientService) ListClientGoals(
 	ctx context.Context,
 	clientID string,
 ) ([]ListClientGoalsResponse, error) {
+	if _, err := s.db.ForOrganization(util.GetOrganizationID(ctx)).GetClient(ctx, clientID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrClientNotFound
+		}
+		s.logger.Error(ctx, "ListClientGoals", "Failed to get client", zap.Error(err))
+		return nil, ErrInternal
+	}
 	util.SetClientID(ctx, clientID)
 	goals, err := s.db.ListGoalsByClientID(ctx, &clientID)
 	if err != nil {
@@ -675,3 +1066,198 @@ func (s *clientService) ListClientGoals(
 
 	return goalsResponse, nil
 }
+
+// AddClientNote appends a note to the client's history. Notes are never
+// edited in place; clients.notes remains the free-form summary.
+func (s *clientService) AddClientNote(
+	ctx context.Context,
+	clientID string,
+	req *AddClientNoteRequest,
+) (*ClientNoteResponse, error) {
+	body := strings.TrimSpace(req.Body)
+	if body == "" {
+		return nil, ErrInvalidRequest
+	}
+	if !util.TextWithinLimit(s.maxTextLength, &body) {
+		return nil, ErrTextTooLong
+	}
+
+	if _, err := s.db.ForOrganization(util.GetOrganizationID(ctx)).GetClient(ctx, clientID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrClientNotFound
+		}
+		s.logger.Error(ctx, "AddClientNote", "Failed to get client", zap.Error(err))
+		return nil, ErrInternal
+	}
+	util.SetClientID(ctx, clientID)
+
+	note, err := s.db.AddClientNote(ctx, db.AddClientNoteParams{
+		ID:       nanoid.Generate(),
+		ClientID: clientID,
+		AuthorID: util.GetEmployeeID(ctx),
+		Body:     body,
+	})
+	if err != nil {
+		s.logger.Error(ctx, "AddClientNote", "Failed to add client note", zap.Error(err))
+		return nil, ErrInternal
+	}
+
+	return &ClientNoteResponse{
+		ID:        note.ID,
+		Body:      note.Body,
+		AuthorID:  note.AuthorID,
+		CreatedAt: note.CreatedAt.Time.Format("2006-01-02T15:04:05Z07:00"),
+	}, nil
+}
+
+func (s *clientService) ListClientNotes(
+	ctx context.Context,
+	clientID string,
+) ([]ClientNoteResponse, error) {
+	if _, err := s.db.ForOrganization(util.GetOrganizationID(ctx)).GetClient(ctx, clientID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrClientNotFound
+		}
+		s.logger.Error(ctx, "ListClientNotes", "Failed to get client", zap.Error(err))
+		return nil, ErrInternal
+	}
+	util.SetClientID(ctx, clientID)
+	notes, err := s.db.ListClientNotes(ctx, clientID)
+	if err != nil {
+		s.logger.Error(ctx, "ListClientNotes", "Failed to list client notes", zap.Error(err))
+		return nil, ErrInternal
+	}
+
+	notesResponse := make([]ClientNoteResponse, 0, len(notes))
+	for _, note := range notes {
+		notesResponse = append(notesResponse, ClientNoteResponse{
+			ID:              note.ID,
+			Body:            note.Body,
+			AuthorID:        note.AuthorID,
+			AuthorFirstName: note.AuthorFirstName,
+			AuthorLastName:  note.AuthorLastName,
+			CreatedAt:       note.CreatedAt.Time.Format("2006-01-02T15:04:05Z07:00"),
+		})
+	}
+
+	return notesResponse, nil
+}
+
+func (s *clientService) GetClient(ctx context.Context, clientID string) (*GetClientResponse, error) {
+	// Clients of another organization are reported as not found
+	client, err := s.db.ForOrganization(util.GetOrganizationID(ctx)).GetClient(ctx, clientID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrClientNotFound
+		}
+		s.logger.Error(ctx, "GetClient", "Failed to get client", zap.Error(err))
+		return nil, ErrInternal
+	}
+	util.SetClientID(ctx, clientID)
+
+	return toGetClientResponse(client), nil
+}
+
+func (s *clientService) GetClientByBSN(ctx context.Context, bsn string) (*GetClientResponse, error) {
+	// Only active clients of the caller's organization match
+	client, err := s.db.ForOrganization(util.GetOrganizationID(ctx)).GetClientByBSN(ctx, bsn)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrClientNotFound
+		}
+		s.logger.Error(ctx, "GetClientByBSN", "Failed to get client by BSN", zap.Error(err))
+		return nil, ErrInternal
+	}
+	util.SetClientID(ctx, client.ID)
+
+	return toGetClientResponse(client), nil
+}
+
+// SuggestPlacements lists the locations a waiting-list client could be placed
+// at: active locations of their organization with a free bed that offer the
+// client's care type, most available beds first.
+func (s *clientService) SuggestPlacements(
+	ctx context.Context,
+	clientID string,
+) ([]PlacementSuggestionResponse, error) {
+	scoped := s.db.ForOrganization(util.GetOrganizationID(ctx))
+	client, err := scoped.GetClient(ctx, clientID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrClientNotFound
+		}
+		s.logger.Error(ctx, "SuggestPlacements", "Failed to get client", zap.Error(err))
+		return nil, ErrInternal
+	}
+	util.SetClientID(ctx, clientID)
+
+	if client.Status != db.ClientStatusEnumWaitingList {
+		return nil, ErrInvalidClientStatus
+	}
+
+	candidates, err := scoped.ListPlacementCandidates(ctx, client.CareType)
+	if err != nil {
+		s.logger.Error(ctx, "SuggestPlacements", "Failed to list placement candidates", zap.Error(err))
+		return nil, ErrInternal
+	}
+
+	suggestions := make([]PlacementSuggestionResponse, 0, len(candidates))
+	for _, candidate := range candidates {
+		suggestions = append(suggestions, PlacementSuggestionResponse{
+			LocationID: candidate.ID,
+			Name:       candidate.Name,
+			PostalCode: candidate.PostalCode,
+			Address:    candidate.Address,
+			Capacity:   candidate.Capacity,
+			Occupied:   candidate.Occupied,
+			Available:  candidate.Available,
+		})
+	}
+	return suggestions, nil
+}
+
+func toGetClientResponse(client db.Client) *GetClientResponse {
+	var reasonForDischarge, dischargeStatus *string
+	if client.ReasonForDischarge.Valid {
+		reason := string(client.ReasonForDischarge.DischargeReasonEnum)
+		reasonForDischarge = &reason
+	}
+	if client.DischargeStatus.Valid {
+		status := string(client.DischargeStatus.DischargeStatusEnum)
+		dischargeStatus = &status
+	}
+
+	return &GetClientResponse{
+		ID:                      client.ID,
+		FirstName:               client.FirstName,
+		LastName:                client.LastName,
+		Bsn:                     client.Bsn,
+		DateOfBirth:             util.PgtypeDateToStr(client.DateOfBirth),
+		PhoneNumber:             client.PhoneNumber,
+		Gender:                  string(client.Gender),
+		CareType:                string(client.CareType),
+		AmbulatoryWeeklyHours:   client.AmbulatoryWeeklyHours,
+		Status:                  string(client.Status),
+		WaitingListPriority:     string(client.WaitingListPriority),
+		CareStartDate:           util.PgtypeDateToStr(client.CareStartDate),
+		CareEndDate:             util.PgtypeDateToStr(client.CareEndDate),
+		DischargeDate:           util.PgtypeDateToStr(client.DischargeDate),
+		ReasonForDischarge:      reasonForDischarge,
+		DischargeStatus:         dischargeStatus,
+		ClosingReport:           client.ClosingReport,
+		EvaluationReport:        client.EvaluationReport,
+		RegistrationFormID:      client.RegistrationFormID,
+		IntakeFormID:            client.IntakeFormID,
+		ReferringOrgID:          client.ReferringOrgID,
+		LocationID:              client.AssignedLocationID,
+		CoordinatorID:           client.CoordinatorID,
+		FamilySituation:         client.FamilySituation,
+		Limitations:             client.Limitations,
+		FocusAreas:              client.FocusAreas,
+		Notes:                   client.Notes,
+		EvaluationIntervalWeeks: client.EvaluationIntervalWeeks,
+		NextEvaluationDate:      util.PgtypeDateToStr(client.NextEvaluationDate),
+		CreatedAt:               util.PgtypeTimestampToStr(client.CreatedAt),
+		UpdatedAt:               util.PgtypeTimestampToStr(client.UpdatedAt),
+	}
+}
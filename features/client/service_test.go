@@ -1,16 +1,23 @@
 package client
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
+	"care-cordination/lib/assignment"
 	db "care-cordination/lib/db/sqlc"
 	dbmocks "care-cordination/lib/db/sqlc/mocks"
 	loggermocks "care-cordination/lib/logger/mocks"
+	"care-cordination/lib/util"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -52,6 +59,10 @@ func TestMoveClientToWaitingList(t *testing.T) {
 						DateOfBirth: pgtype.Date{Time: time.Now(), Valid: true},
 					}, nil)
 
+				mockStore.EXPECT().
+					CountMissingIntakeDocuments(gomock.Any(), "intake-123").
+					Return(int64(0), nil)
+
 				mockStore.EXPECT().
 					MoveClientToWaitingListTx(gomock.Any(), gomock.Any()).
 					Return(db.MoveClientToWaitingListTxResult{ClientID: "client-123"}, nil)
@@ -61,6 +72,82 @@ func TestMoveClientToWaitingList(t *testing.T) {
 				assert.Equal(t, "client-123", resp.ClientID)
 			},
 		},
+		{
+			name: "evaluation_interval_below_min",
+			req: &MoveClientToWaitingListRequest{
+				IntakeFormID:        "intake-123",
+				WaitingListPriority: "normal",
+			},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				weeks := int32(0)
+				mockStore.EXPECT().
+					GetIntakeForm(gomock.Any(), "intake-123").
+					Return(db.IntakeForm{
+						ID:                      "intake-123",
+						RegistrationFormID:      "reg-123",
+						EvaluationIntervalWeeks: &weeks,
+					}, nil)
+				mockStore.EXPECT().
+					GetRegistrationForm(gomock.Any(), "reg-123").
+					Return(db.RegistrationForm{ID: "reg-123"}, nil)
+			},
+			wantErr:     true,
+			expectedErr: ErrInvalidEvaluationInterval,
+		},
+		{
+			name: "evaluation_interval_above_max",
+			req: &MoveClientToWaitingListRequest{
+				IntakeFormID:        "intake-123",
+				WaitingListPriority: "normal",
+			},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				weeks := int32(520)
+				mockStore.EXPECT().
+					GetIntakeForm(gomock.Any(), "intake-123").
+					Return(db.IntakeForm{
+						ID:                      "intake-123",
+						RegistrationFormID:      "reg-123",
+						EvaluationIntervalWeeks: &weeks,
+					}, nil)
+				mockStore.EXPECT().
+					GetRegistrationForm(gomock.Any(), "reg-123").
+					Return(db.RegistrationForm{ID: "reg-123"}, nil)
+			},
+			wantErr:     true,
+			expectedErr: ErrInvalidEvaluationInterval,
+		},
+		{
+			name: "evaluation_interval_valid",
+			req: &MoveClientToWaitingListRequest{
+				IntakeFormID:        "intake-123",
+				WaitingListPriority: "normal",
+			},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				weeks := int32(6)
+				mockStore.EXPECT().
+					GetIntakeForm(gomock.Any(), "intake-123").
+					Return(db.IntakeForm{
+						ID:                      "intake-123",
+						RegistrationFormID:      "reg-123",
+						EvaluationIntervalWeeks: &weeks,
+					}, nil)
+				mockStore.EXPECT().
+					GetRegistrationForm(gomock.Any(), "reg-123").
+					Return(db.RegistrationForm{ID: "reg-123"}, nil)
+				mockStore.EXPECT().
+					CountMissingIntakeDocuments(gomock.Any(), "intake-123").
+					Return(int64(0), nil)
+
+				mockStore.EXPECT().
+					MoveClientToWaitingListTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg db.MoveClientToWaitingListTxParams) (db.MoveClientToWaitingListTxResult, error) {
+						require.NotNil(t, arg.Client.EvaluationIntervalWeeks)
+						assert.Equal(t, int32(6), *arg.Client.EvaluationIntervalWeeks)
+						return db.MoveClientToWaitingListTxResult{ClientID: "client-123"}, nil
+					})
+			},
+			wantErr: false,
+		},
 		{
 			name: "missing_intake_form_id",
 			req: &MoveClientToWaitingListRequest{
@@ -100,6 +187,48 @@ func TestMoveClientToWaitingList(t *testing.T) {
 			wantErr:     true,
 			expectedErr: ErrRegistrationFormNotFound,
 		},
+		{
+			name: "required_documents_missing",
+			req: &MoveClientToWaitingListRequest{
+				IntakeFormID: "intake-123",
+			},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					GetIntakeForm(gomock.Any(), "intake-123").
+					Return(db.IntakeForm{ID: "intake-123", RegistrationFormID: "reg-123"}, nil)
+
+				mockStore.EXPECT().
+					GetRegistrationForm(gomock.Any(), "reg-123").
+					Return(db.RegistrationForm{ID: "reg-123"}, nil)
+
+				mockStore.EXPECT().
+					CountMissingIntakeDocuments(gomock.Any(), "intake-123").
+					Return(int64(2), nil)
+			},
+			wantErr:     true,
+			expectedErr: ErrIntakeDocumentsMissing,
+		},
+		{
+			name: "missing_documents_count_error",
+			req: &MoveClientToWaitingListRequest{
+				IntakeFormID: "intake-123",
+			},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					GetIntakeForm(gomock.Any(), "intake-123").
+					Return(db.IntakeForm{ID: "intake-123", RegistrationFormID: "reg-123"}, nil)
+
+				mockStore.EXPECT().
+					GetRegistrationForm(gomock.Any(), "reg-123").
+					Return(db.RegistrationForm{ID: "reg-123"}, nil)
+
+				mockStore.EXPECT().
+					CountMissingIntakeDocuments(gomock.Any(), "intake-123").
+					Return(int64(0), errors.New("db error"))
+			},
+			wantErr:     true,
+			expectedErr: ErrInternal,
+		},
 		{
 			name: "tx_error",
 			req: &MoveClientToWaitingListRequest{
@@ -114,6 +243,10 @@ func TestMoveClientToWaitingList(t *testing.T) {
 					GetRegistrationForm(gomock.Any(), "reg-123").
 					Return(db.RegistrationForm{ID: "reg-123"}, nil)
 
+				mockStore.EXPECT().
+					CountMissingIntakeDocuments(gomock.Any(), "intake-123").
+					Return(int64(0), nil)
+
 				mockStore.EXPECT().
 					MoveClientToWaitingListTx(gomock.Any(), gomock.Any()).
 					Return(db.MoveClientToWaitingListTxResult{}, errors.New("db error"))
@@ -121,6 +254,34 @@ func TestMoveClientToWaitingList(t *testing.T) {
 			wantErr:     true,
 			expectedErr: ErrFailedToCreateClient,
 		},
+		{
+			name: "active_client_with_bsn_exists",
+			req: &MoveClientToWaitingListRequest{
+				IntakeFormID: "intake-123",
+			},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					GetIntakeForm(gomock.Any(), "intake-123").
+					Return(db.IntakeForm{ID: "intake-123", RegistrationFormID: "reg-123"}, nil)
+
+				mockStore.EXPECT().
+					GetRegistrationForm(gomock.Any(), "reg-123").
+					Return(db.RegistrationForm{ID: "reg-123", Bsn: "123456789"}, nil)
+
+				mockStore.EXPECT().
+					CountMissingIntakeDocuments(gomock.Any(), "intake-123").
+					Return(int64(0), nil)
+
+				mockStore.EXPECT().
+					MoveClientToWaitingListTx(gomock.Any(), gomock.Any()).
+					Return(db.MoveClientToWaitingListTxResult{}, fmt.Errorf("create client: %w", &pgconn.PgError{
+						Code:           "23505",
+						ConstraintName: "uq_clients_active_bsn",
+					}))
+			},
+			wantErr:     true,
+			expectedErr: ErrActiveClientExists,
+		},
 	}
 
 	for _, tt := range tests {
@@ -136,7 +297,7 @@ func TestMoveClientToWaitingList(t *testing.T) {
 
 			tt.setup(mockStore)
 
-			service := NewClientService(mockStore, mockLogger)
+			service := NewClientService(mockStore, mockLogger, util.DefaultTextFieldLength, assignment.ModeOff, 0)
 
 			resp, err := service.MoveClientToWaitingList(context.Background(), tt.req)
 
@@ -156,8 +317,210 @@ func TestMoveClientToWaitingList(t *testing.T) {
 	}
 }
 
+func TestMoveClientToWaitingList_RecordsCreator(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := dbmocks.NewMockStoreInterface(ctrl)
+	mockLogger := loggermocks.NewMockLogger(ctrl)
+
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	mockStore.EXPECT().
+		GetIntakeForm(gomock.Any(), "intake-123").
+		Return(db.IntakeForm{ID: "intake-123", RegistrationFormID: "reg-123"}, nil)
+	mockStore.EXPECT().
+		GetRegistrationForm(gomock.Any(), "reg-123").
+		Return(db.RegistrationForm{ID: "reg-123"}, nil)
+	mockStore.EXPECT().
+		CountMissingIntakeDocuments(gomock.Any(), "intake-123").
+		Return(int64(0), nil)
+	mockStore.EXPECT().
+		MoveClientToWaitingListTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, arg db.MoveClientToWaitingListTxParams) (db.MoveClientToWaitingListTxResult, error) {
+			require.NotNil(t, arg.Client.CreatedByUserID)
+			assert.Equal(t, "user-1", *arg.Client.CreatedByUserID)
+			return db.MoveClientToWaitingListTxResult{ClientID: arg.Client.ID}, nil
+		})
+
+	service := NewClientService(mockStore, mockLogger, util.DefaultTextFieldLength, assignment.ModeOff, 0)
+	ctx := context.WithValue(context.Background(), util.UserIDKey, "user-1")
+
+	_, err := service.MoveClientToWaitingList(ctx, &MoveClientToWaitingListRequest{
+		IntakeFormID:        "intake-123",
+		WaitingListPriority: "normal",
+	})
+	require.NoError(t, err)
+}
+
+func TestMoveClientToWaitingList_CoordinatorLocation(t *testing.T) {
+	tests := []struct {
+		name                string
+		mode                assignment.Mode
+		coordinatorLocation string
+		wantWarning         bool
+		expectedErr         error
+	}{
+		{name: "match", mode: assignment.ModeEnforce, coordinatorLocation: "loc-123"},
+		{
+			name:                "mismatch_enforced",
+			mode:                assignment.ModeEnforce,
+			coordinatorLocation: "loc-999",
+			expectedErr:         assignment.ErrCoordinatorLocationMismatch,
+		},
+		{name: "mismatch_warned", mode: assignment.ModeWarn, coordinatorLocation: "loc-999", wantWarning: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockStore := dbmocks.NewMockStoreInterface(ctrl)
+			mockLogger := loggermocks.NewMockLogger(ctrl)
+
+			mockLogger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+			mockStore.EXPECT().
+				GetIntakeForm(gomock.Any(), "intake-123").
+				Return(db.IntakeForm{
+					ID:                 "intake-123",
+					RegistrationFormID: "reg-123",
+					LocationID:         "loc-123",
+					CoordinatorID:      "coord-123",
+				}, nil)
+			mockStore.EXPECT().
+				GetRegistrationForm(gomock.Any(), "reg-123").
+				Return(db.RegistrationForm{ID: "reg-123"}, nil)
+			mockStore.EXPECT().
+				CountMissingIntakeDocuments(gomock.Any(), "intake-123").
+				Return(int64(0), nil)
+			mockStore.EXPECT().
+				GetEmployeeByID(gomock.Any(), "coord-123").
+				Return(db.GetEmployeeByIDRow{ID: "coord-123", LocationID: tt.coordinatorLocation}, nil)
+			if tt.expectedErr == nil {
+				mockStore.EXPECT().
+					MoveClientToWaitingListTx(gomock.Any(), gomock.Any()).
+					Return(db.MoveClientToWaitingListTxResult{ClientID: "client-123"}, nil)
+			}
+			if tt.wantWarning {
+				mockLogger.EXPECT().
+					Warn(gomock.Any(), "MoveClientToWaitingList", gomock.Any(), gomock.Any()).
+					Times(1)
+			}
+
+			service := NewClientService(mockStore, mockLogger, util.DefaultTextFieldLength, tt.mode, 0)
+
+			resp, err := service.MoveClientToWaitingList(context.Background(), &MoveClientToWaitingListRequest{
+				IntakeFormID:        "intake-123",
+				WaitingListPriority: "normal",
+			})
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "client-123", resp.ClientID)
+		})
+	}
+}
+
+func TestMoveClientToWaitingList_CaseloadCap(t *testing.T) {
+	tests := []struct {
+		name          string
+		override      bool
+		isAdmin       bool
+		wantCheck     *db.CaseloadCheck
+		txErr         error
+		expectedErr   error
+		expectTxCalls bool
+	}{
+		{
+			name:          "checked_in_tx",
+			wantCheck:     &db.CaseloadCheck{CoordinatorID: "coord-123", DefaultLimit: 3},
+			expectTxCalls: true,
+		},
+		{
+			name:          "at_cap",
+			wantCheck:     &db.CaseloadCheck{CoordinatorID: "coord-123", DefaultLimit: 3},
+			txErr:         db.ErrCoordinatorAtCapacity,
+			expectedErr:   assignment.ErrCoordinatorAtCapacity,
+			expectTxCalls: true,
+		},
+		{
+			name:          "unknown_coordinator",
+			wantCheck:     &db.CaseloadCheck{CoordinatorID: "coord-123", DefaultLimit: 3},
+			txErr:         db.ErrCoordinatorNotFound,
+			expectedErr:   assignment.ErrCoordinatorNotFound,
+			expectTxCalls: true,
+		},
+		{name: "admin_override", override: true, isAdmin: true, expectTxCalls: true},
+		{
+			name:        "override_not_admin",
+			override:    true,
+			expectedErr: assignment.ErrCaseloadOverrideForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockStore := dbmocks.NewMockStoreInterface(ctrl)
+			mockLogger := loggermocks.NewMockLogger(ctrl)
+
+			mockLogger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+			mockStore.EXPECT().
+				GetIntakeForm(gomock.Any(), "intake-123").
+				Return(db.IntakeForm{
+					ID:                 "intake-123",
+					RegistrationFormID: "reg-123",
+					LocationID:         "loc-123",
+					CoordinatorID:      "coord-123",
+				}, nil)
+			mockStore.EXPECT().
+				GetRegistrationForm(gomock.Any(), "reg-123").
+				Return(db.RegistrationForm{ID: "reg-123"}, nil)
+			mockStore.EXPECT().
+				CountMissingIntakeDocuments(gomock.Any(), "intake-123").
+				Return(int64(0), nil)
+			if tt.override {
+				mockStore.EXPECT().
+					HasPermission(gomock.Any(), db.HasPermissionParams{
+						UserID:   "user-1",
+						Resource: "admin",
+						Action:   "manage",
+					}).
+					Return(tt.isAdmin, nil)
+			}
+			if tt.expectTxCalls {
+				mockStore.EXPECT().
+					MoveClientToWaitingListTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg db.MoveClientToWaitingListTxParams) (db.MoveClientToWaitingListTxResult, error) {
+						assert.Equal(t, tt.wantCheck, arg.Caseload)
+						return db.MoveClientToWaitingListTxResult{ClientID: "client-123"}, tt.txErr
+					})
+			}
+
+			service := NewClientService(mockStore, mockLogger, util.DefaultTextFieldLength, assignment.ModeOff, 3)
+			ctx := context.WithValue(context.Background(), util.UserIDKey, "user-1")
+
+			resp, err := service.MoveClientToWaitingList(ctx, &MoveClientToWaitingListRequest{
+				IntakeFormID:        "intake-123",
+				WaitingListPriority: "normal",
+				OverrideCaseload:    tt.override,
+			})
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "client-123", resp.ClientID)
+		})
+	}
+}
+
 func TestMoveClientInCare(t *testing.T) {
 	hours := int32(20)
+	interval := int32(2)
 	tests := []struct {
 		name        string
 		clientID    string
@@ -177,16 +540,24 @@ func TestMoveClientInCare(t *testing.T) {
 			},
 			setup: func(mockStore *dbmocks.MockStoreInterface) {
 				mockStore.EXPECT().
-					GetClientByID(gomock.Any(), "client-123").
+					GetClientByID(gomock.Any(), db.GetClientByIDParams{ID: "client-123", OrganizationID: util.StrPtr("org-1")}).
 					Return(db.Client{
-						ID:       "client-123",
-						Status:   db.ClientStatusEnumWaitingList,
-						CareType: db.CareTypeEnumAmbulatoryCare,
+						ID:                      "client-123",
+						Status:                  db.ClientStatusEnumWaitingList,
+						CareType:                db.CareTypeEnumAmbulatoryCare,
+						CoordinatorID:           "coord-1",
+						EvaluationIntervalWeeks: &interval,
 					}, nil)
 
+				// The first evaluation is scheduled an interval after care starts
 				mockStore.EXPECT().
-					UpdateClient(gomock.Any(), gomock.Any()).
-					Return("client-123", nil)
+					MoveClientInCareTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg db.MoveClientInCareTxParams) (db.MoveClientInCareTxResult, error) {
+						assert.Equal(t, "org-1", arg.OrganizationID)
+						assert.Equal(t, "coord-1", arg.CoordinatorID)
+						assert.Equal(t, "2023-01-15", arg.Client.NextEvaluationDate.Time.Format(time.DateOnly))
+						return db.MoveClientInCareTxResult{ClientID: "client-123"}, nil
+					})
 			},
 			wantErr: false,
 			validate: func(t *testing.T, resp *MoveClientInCareResponse) {
@@ -202,7 +573,7 @@ func TestMoveClientInCare(t *testing.T) {
 			},
 			setup: func(mockStore *dbmocks.MockStoreInterface) {
 				mockStore.EXPECT().
-					GetClientByID(gomock.Any(), "client-123").
+					GetClientByID(gomock.Any(), db.GetClientByIDParams{ID: "client-123", OrganizationID: util.StrPtr("org-1")}).
 					Return(db.Client{
 						ID:       "client-123",
 						Status:   db.ClientStatusEnumWaitingList,
@@ -210,8 +581,8 @@ func TestMoveClientInCare(t *testing.T) {
 					}, nil)
 
 				mockStore.EXPECT().
-					UpdateClient(gomock.Any(), gomock.Any()).
-					Return("client-123", nil)
+					MoveClientInCareTx(gomock.Any(), gomock.Any()).
+					Return(db.MoveClientInCareTxResult{ClientID: "client-123"}, nil)
 			},
 			wantErr: false,
 		},
@@ -221,7 +592,7 @@ func TestMoveClientInCare(t *testing.T) {
 			req:      &MoveClientInCareRequest{},
 			setup: func(mockStore *dbmocks.MockStoreInterface) {
 				mockStore.EXPECT().
-					GetClientByID(gomock.Any(), "notfound").
+					GetClientByID(gomock.Any(), db.GetClientByIDParams{ID: "notfound", OrganizationID: util.StrPtr("org-1")}).
 					Return(db.Client{}, pgx.ErrNoRows)
 			},
 			wantErr:     true,
@@ -233,7 +604,7 @@ func TestMoveClientInCare(t *testing.T) {
 			req:      &MoveClientInCareRequest{},
 			setup: func(mockStore *dbmocks.MockStoreInterface) {
 				mockStore.EXPECT().
-					GetClientByID(gomock.Any(), "client-123").
+					GetClientByID(gomock.Any(), db.GetClientByIDParams{ID: "client-123", OrganizationID: util.StrPtr("org-1")}).
 					Return(db.Client{
 						ID:     "client-123",
 						Status: db.ClientStatusEnumInCare,
@@ -251,7 +622,7 @@ func TestMoveClientInCare(t *testing.T) {
 			},
 			setup: func(mockStore *dbmocks.MockStoreInterface) {
 				mockStore.EXPECT().
-					GetClientByID(gomock.Any(), "client-123").
+					GetClientByID(gomock.Any(), db.GetClientByIDParams{ID: "client-123", OrganizationID: util.StrPtr("org-1")}).
 					Return(db.Client{
 						ID:       "client-123",
 						Status:   db.ClientStatusEnumWaitingList,
@@ -271,7 +642,7 @@ func TestMoveClientInCare(t *testing.T) {
 			},
 			setup: func(mockStore *dbmocks.MockStoreInterface) {
 				mockStore.EXPECT().
-					GetClientByID(gomock.Any(), "client-123").
+					GetClientByID(gomock.Any(), db.GetClientByIDParams{ID: "client-123", OrganizationID: util.StrPtr("org-1")}).
 					Return(db.Client{
 						ID:       "client-123",
 						Status:   db.ClientStatusEnumWaitingList,
@@ -294,11 +665,15 @@ func TestMoveClientInCare(t *testing.T) {
 			mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 			mockLogger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 
+			mockStore.EXPECT().
+				ForOrganization("org-1").
+				Return(db.NewScopedStore(mockStore, "org-1"))
 			tt.setup(mockStore)
 
-			service := NewClientService(mockStore, mockLogger)
+			service := NewClientService(mockStore, mockLogger, util.DefaultTextFieldLength, assignment.ModeOff, 0)
 
-			resp, err := service.MoveClientInCare(context.Background(), tt.clientID, tt.req)
+			ctx := context.WithValue(context.Background(), util.OrganizationIDKey, "org-1")
+			resp, err := service.MoveClientInCare(ctx, tt.clientID, tt.req)
 
 			if tt.wantErr {
 				require.Error(t, err)
@@ -334,7 +709,7 @@ func TestStartDischarge(t *testing.T) {
 			},
 			setup: func(mockStore *dbmocks.MockStoreInterface) {
 				mockStore.EXPECT().
-					GetClientByID(gomock.Any(), "client-123").
+					GetClientByID(gomock.Any(), db.GetClientByIDParams{ID: "client-123", OrganizationID: util.StrPtr("org-1")}).
 					Return(db.Client{
 						ID:     "client-123",
 						Status: db.ClientStatusEnumInCare,
@@ -352,7 +727,7 @@ func TestStartDischarge(t *testing.T) {
 			req:      &StartDischargeRequest{},
 			setup: func(mockStore *dbmocks.MockStoreInterface) {
 				mockStore.EXPECT().
-					GetClientByID(gomock.Any(), "notfound").
+					GetClientByID(gomock.Any(), db.GetClientByIDParams{ID: "notfound", OrganizationID: util.StrPtr("org-1")}).
 					Return(db.Client{}, pgx.ErrNoRows)
 			},
 			wantErr:     true,
@@ -364,7 +739,7 @@ func TestStartDischarge(t *testing.T) {
 			req:      &StartDischargeRequest{},
 			setup: func(mockStore *dbmocks.MockStoreInterface) {
 				mockStore.EXPECT().
-					GetClientByID(gomock.Any(), "client-123").
+					GetClientByID(gomock.Any(), db.GetClientByIDParams{ID: "client-123", OrganizationID: util.StrPtr("org-1")}).
 					Return(db.Client{
 						ID:     "client-123",
 						Status: db.ClientStatusEnumWaitingList,
@@ -379,7 +754,7 @@ func TestStartDischarge(t *testing.T) {
 			req:      &StartDischargeRequest{},
 			setup: func(mockStore *dbmocks.MockStoreInterface) {
 				mockStore.EXPECT().
-					GetClientByID(gomock.Any(), "client-123").
+					GetClientByID(gomock.Any(), db.GetClientByIDParams{ID: "client-123", OrganizationID: util.StrPtr("org-1")}).
 					Return(db.Client{
 						ID:     "client-123",
 						Status: db.ClientStatusEnumInCare,
@@ -405,11 +780,15 @@ func TestStartDischarge(t *testing.T) {
 			mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 			mockLogger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 
+			mockStore.EXPECT().
+				ForOrganization("org-1").
+				Return(db.NewScopedStore(mockStore, "org-1"))
 			tt.setup(mockStore)
 
-			service := NewClientService(mockStore, mockLogger)
+			service := NewClientService(mockStore, mockLogger, util.DefaultTextFieldLength, assignment.ModeOff, 0)
 
-			resp, err := service.StartDischarge(context.Background(), tt.clientID, tt.req)
+			ctx := context.WithValue(context.Background(), util.OrganizationIDKey, "org-1")
+			resp, err := service.StartDischarge(ctx, tt.clientID, tt.req)
 
 			if tt.wantErr {
 				require.Error(t, err)
@@ -443,7 +822,7 @@ func TestCompleteDischarge(t *testing.T) {
 			},
 			setup: func(mockStore *dbmocks.MockStoreInterface) {
 				mockStore.EXPECT().
-					GetClientByID(gomock.Any(), "client-123").
+					GetClientByID(gomock.Any(), db.GetClientByIDParams{ID: "client-123", OrganizationID: util.StrPtr("org-1")}).
 					Return(db.Client{
 						ID:     "client-123",
 						Status: db.ClientStatusEnumInCare,
@@ -465,7 +844,7 @@ func TestCompleteDischarge(t *testing.T) {
 			req:      &CompleteDischargeRequest{},
 			setup: func(mockStore *dbmocks.MockStoreInterface) {
 				mockStore.EXPECT().
-					GetClientByID(gomock.Any(), "notfound").
+					GetClientByID(gomock.Any(), db.GetClientByIDParams{ID: "notfound", OrganizationID: util.StrPtr("org-1")}).
 					Return(db.Client{}, pgx.ErrNoRows)
 			},
 			wantErr:     true,
@@ -477,7 +856,7 @@ func TestCompleteDischarge(t *testing.T) {
 			req:      &CompleteDischargeRequest{},
 			setup: func(mockStore *dbmocks.MockStoreInterface) {
 				mockStore.EXPECT().
-					GetClientByID(gomock.Any(), "client-123").
+					GetClientByID(gomock.Any(), db.GetClientByIDParams{ID: "client-123", OrganizationID: util.StrPtr("org-1")}).
 					Return(db.Client{
 						ID:     "client-123",
 						Status: db.ClientStatusEnumWaitingList,
@@ -492,7 +871,7 @@ func TestCompleteDischarge(t *testing.T) {
 			req:      &CompleteDischargeRequest{},
 			setup: func(mockStore *dbmocks.MockStoreInterface) {
 				mockStore.EXPECT().
-					GetClientByID(gomock.Any(), "client-123").
+					GetClientByID(gomock.Any(), db.GetClientByIDParams{ID: "client-123", OrganizationID: util.StrPtr("org-1")}).
 					Return(db.Client{
 						ID:     "client-123",
 						Status: db.ClientStatusEnumInCare,
@@ -517,11 +896,15 @@ func TestCompleteDischarge(t *testing.T) {
 			mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 			mockLogger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 
+			mockStore.EXPECT().
+				ForOrganization("org-1").
+				Return(db.NewScopedStore(mockStore, "org-1"))
 			tt.setup(mockStore)
 
-			service := NewClientService(mockStore, mockLogger)
+			service := NewClientService(mockStore, mockLogger, util.DefaultTextFieldLength, assignment.ModeOff, 0)
 
-			resp, err := service.CompleteDischarge(context.Background(), tt.clientID, tt.req)
+			ctx := context.WithValue(context.Background(), util.OrganizationIDKey, "org-1")
+			resp, err := service.CompleteDischarge(ctx, tt.clientID, tt.req)
 
 			if tt.wantErr {
 				require.Error(t, err)
@@ -537,23 +920,16 @@ func TestCompleteDischarge(t *testing.T) {
 	}
 }
 
-func TestListWaitingListClients(t *testing.T) {
+func TestCompleteDischarge_TextLimit(t *testing.T) {
+	const limit = 20
+
 	tests := []struct {
 		name    string
-		req     *ListWaitingListClientsRequest
-		setup   func(mockStore *dbmocks.MockStoreInterface)
-		wantErr bool
+		report  string
+		wantErr error
 	}{
-		{
-			name: "success",
-			req:  &ListWaitingListClientsRequest{},
-			setup: func(mockStore *dbmocks.MockStoreInterface) {
-				mockStore.EXPECT().
-					ExecTx(gomock.Any(), gomock.Any()).
-					Return(nil)
-			},
-			wantErr: false,
-		},
+		{name: "at_limit", report: strings.Repeat("a", limit)},
+		{name: "over_limit", report: strings.Repeat("a", limit+1), wantErr: ErrTextTooLong},
 	}
 
 	for _, tt := range tests {
@@ -564,44 +940,105 @@ func TestListWaitingListClients(t *testing.T) {
 			mockStore := dbmocks.NewMockStoreInterface(ctrl)
 			mockLogger := loggermocks.NewMockLogger(ctrl)
 
-			mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
-
-			tt.setup(mockStore)
+			if tt.wantErr == nil {
+				mockStore.EXPECT().
+					ForOrganization("org-1").
+					Return(db.NewScopedStore(mockStore, "org-1"))
+				mockStore.EXPECT().
+					GetClientByID(gomock.Any(), db.GetClientByIDParams{ID: "client-123", OrganizationID: util.StrPtr("org-1")}).
+					Return(db.Client{
+						ID:     "client-123",
+						Status: db.ClientStatusEnumInCare,
+						DischargeStatus: db.NullDischargeStatusEnum{
+							DischargeStatusEnum: db.DischargeStatusEnumInProgress,
+							Valid:               true,
+						},
+					}, nil)
+				mockStore.EXPECT().
+					UpdateClient(gomock.Any(), gomock.Any()).
+					Return("client-123", nil)
+			}
 
-			service := NewClientService(mockStore, mockLogger)
+			mockLogger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 
-			// Add pagination params to context
-			ctx := context.WithValue(context.Background(), "limit", int32(10))
-			ctx = context.WithValue(ctx, "offset", int32(0))
-			ctx = context.WithValue(ctx, "page", 1)
-			ctx = context.WithValue(ctx, "pageSize", 10)
+			service := NewClientService(mockStore, mockLogger, limit, assignment.ModeOff, 0)
 
-			_, err := service.ListWaitingListClients(ctx, tt.req)
+			_, err := service.CompleteDischarge(
+				context.WithValue(context.Background(), util.OrganizationIDKey, "org-1"),
+				"client-123",
+				&CompleteDischargeRequest{ClosingReport: tt.report, EvaluationReport: "Evaluation"},
+			)
 
-			if tt.wantErr {
-				require.Error(t, err)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
 				return
 			}
-
 			require.NoError(t, err)
 		})
 	}
 }
 
-func TestGetWaitlistStats(t *testing.T) {
+func TestSearchClients(t *testing.T) {
 	tests := []struct {
-		name    string
-		setup   func(mockStore *dbmocks.MockStoreInterface)
-		wantErr bool
+		name        string
+		req         *SearchClientsRequest
+		setup       func(mockStore *dbmocks.MockStoreInterface)
+		wantErr     bool
+		expectedErr error
+		validate    func(t *testing.T, resp []SearchClientsResponse)
 	}{
 		{
-			name: "success",
+			name: "matches_across_statuses",
+			req:  &SearchClientsRequest{Search: "Jansen"},
 			setup: func(mockStore *dbmocks.MockStoreInterface) {
 				mockStore.EXPECT().
-					ExecTx(gomock.Any(), gomock.Any()).
-					Return(nil)
+					SearchClients(gomock.Any(), db.SearchClientsParams{
+						Limit:          10,
+						Offset:         0,
+						OrganizationID: util.StrPtr("org-1"),
+						Search:         "Jansen",
+					}).
+					Return([]db.SearchClientsRow{
+						{ID: "client-1", LastName: "Jansen", Status: db.ClientStatusEnumWaitingList, LocationName: "North", TotalCount: 2},
+						{ID: "client-2", LastName: "Jansen", Status: db.ClientStatusEnumDischarged, LocationName: "South", TotalCount: 2},
+					}, nil)
 			},
-			wantErr: false,
+			validate: func(t *testing.T, resp []SearchClientsResponse) {
+				require.Len(t, resp, 2)
+				assert.Equal(t, "waiting_list", resp[0].Status)
+				assert.Equal(t, "North", resp[0].LocationName)
+				assert.Equal(t, "discharged", resp[1].Status)
+				assert.Equal(t, "South", resp[1].LocationName)
+			},
+		},
+		{
+			name: "status_filter",
+			req:  &SearchClientsRequest{Search: "Jansen", Status: util.StrPtr("in_care")},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					SearchClients(gomock.Any(), db.SearchClientsParams{
+						Limit:          10,
+						Offset:         0,
+						OrganizationID: util.StrPtr("org-1"),
+						Search:         "Jansen",
+						Status:         db.NullClientStatusEnum{ClientStatusEnum: db.ClientStatusEnumInCare, Valid: true},
+					}).
+					Return([]db.SearchClientsRow{}, nil)
+			},
+			validate: func(t *testing.T, resp []SearchClientsResponse) {
+				assert.Empty(t, resp)
+			},
+		},
+		{
+			name: "db_error",
+			req:  &SearchClientsRequest{Search: "Jansen"},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					SearchClients(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("db error"))
+			},
+			wantErr:     true,
+			expectedErr: ErrInternal,
 		},
 	}
 
@@ -615,11 +1052,170 @@ func TestGetWaitlistStats(t *testing.T) {
 
 			mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 
+			mockStore.EXPECT().
+				ForOrganization("org-1").
+				Return(db.NewScopedStore(mockStore, "org-1"))
 			tt.setup(mockStore)
 
-			service := NewClientService(mockStore, mockLogger)
+			service := NewClientService(mockStore, mockLogger, util.DefaultTextFieldLength, assignment.ModeOff, 0)
 
-			_, err := service.GetWaitlistStats(context.Background())
+			// Add pagination params to context
+			ctx := context.WithValue(context.Background(), "limit", int32(10))
+			ctx = context.WithValue(ctx, "offset", int32(0))
+			ctx = context.WithValue(ctx, "page", 1)
+			ctx = context.WithValue(ctx, "pageSize", 10)
+			ctx = context.WithValue(ctx, util.OrganizationIDKey, "org-1")
+
+			result, err := service.SearchClients(ctx, tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				if tt.expectedErr != nil {
+					assert.ErrorIs(t, err, tt.expectedErr)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			if tt.validate != nil {
+				tt.validate(t, result.Data)
+			}
+		})
+	}
+}
+
+func TestListWaitingListClients(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *ListWaitingListClientsRequest
+		setup   func(mockStore *dbmocks.MockStoreInterface)
+		wantErr error
+	}{
+		{
+			name: "success",
+			req:  &ListWaitingListClientsRequest{},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					ForOrganization("org-1").
+					Return(db.NewScopedStore(mockStore, "org-1"))
+				mockStore.EXPECT().
+					ListWaitingListClients(gomock.Any(), db.ListWaitingListClientsParams{
+						Limit:          10,
+						Offset:         0,
+						OrganizationID: util.StrPtr("org-1"),
+					}).
+					Return([]db.ListWaitingListClientsRow{}, nil)
+			},
+		},
+		{
+			name: "sorted_by_name_descending",
+			req:  &ListWaitingListClientsRequest{Sort: "name:desc"},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					ForOrganization("org-1").
+					Return(db.NewScopedStore(mockStore, "org-1"))
+				mockStore.EXPECT().
+					ListWaitingListClients(gomock.Any(), db.ListWaitingListClientsParams{
+						Limit:          10,
+						Offset:         0,
+						OrganizationID: util.StrPtr("org-1"),
+						Sort:           "name_desc",
+					}).
+					Return([]db.ListWaitingListClientsRow{}, nil)
+			},
+		},
+		{
+			name: "db_error",
+			req:  &ListWaitingListClientsRequest{},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					ForOrganization("org-1").
+					Return(db.NewScopedStore(mockStore, "org-1"))
+				mockStore.EXPECT().
+					ListWaitingListClients(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("connection refused"))
+			},
+			wantErr: ErrInternal,
+		},
+		{
+			name:    "unknown_sort_field",
+			req:     &ListWaitingListClientsRequest{Sort: "bsn"},
+			setup:   func(mockStore *dbmocks.MockStoreInterface) {},
+			wantErr: ErrInvalidRequest,
+		},
+		{
+			name:    "unknown_sort_direction",
+			req:     &ListWaitingListClientsRequest{Sort: "name:sideways"},
+			setup:   func(mockStore *dbmocks.MockStoreInterface) {},
+			wantErr: ErrInvalidRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := dbmocks.NewMockStoreInterface(ctrl)
+			mockLogger := loggermocks.NewMockLogger(ctrl)
+
+			mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+			tt.setup(mockStore)
+
+			service := NewClientService(mockStore, mockLogger, util.DefaultTextFieldLength, assignment.ModeOff, 0)
+
+			// Add pagination params to context
+			ctx := context.WithValue(context.Background(), "limit", int32(10))
+			ctx = context.WithValue(ctx, "offset", int32(0))
+			ctx = context.WithValue(ctx, "page", 1)
+			ctx = context.WithValue(ctx, "pageSize", 10)
+			ctx = context.WithValue(ctx, util.OrganizationIDKey, "org-1")
+
+			_, err := service.ListWaitingListClients(ctx, tt.req)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGetWaitlistStats(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(mockStore *dbmocks.MockStoreInterface)
+		wantErr bool
+	}{
+		{
+			name: "success",
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					ExecTx(gomock.Any(), gomock.Any()).
+					Return(nil)
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := dbmocks.NewMockStoreInterface(ctrl)
+			mockLogger := loggermocks.NewMockLogger(ctrl)
+
+			mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+			tt.setup(mockStore)
+
+			service := NewClientService(mockStore, mockLogger, util.DefaultTextFieldLength, assignment.ModeOff, 0)
+
+			_, err := service.GetWaitlistStats(context.Background())
 
 			if tt.wantErr {
 				require.Error(t, err)
@@ -631,6 +1227,22 @@ func TestGetWaitlistStats(t *testing.T) {
 	}
 }
 
+func TestGetInCareStats_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := dbmocks.NewMockStoreInterface(ctrl)
+	mockLogger := loggermocks.NewMockLogger(ctrl)
+
+	mockStore.EXPECT().
+		ExecTx(gomock.Any(), gomock.Any()).
+		Return(errors.New("db error"))
+
+	service := NewClientService(mockStore, mockLogger, util.DefaultTextFieldLength, assignment.ModeOff, 0)
+	stats, err := service.GetInCareStats(context.Background())
+
+	assert.ErrorIs(t, err, ErrInternal)
+	assert.Nil(t, stats)
+}
+
 func TestListClientGoals(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -642,12 +1254,25 @@ func TestListClientGoals(t *testing.T) {
 			name:     "success",
 			clientID: "client-123",
 			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					GetClientByID(gomock.Any(), db.GetClientByIDParams{ID: "client-123", OrganizationID: util.StrPtr("org-1")}).
+					Return(db.Client{ID: "client-123"}, nil)
 				mockStore.EXPECT().
 					ListGoalsByClientID(gomock.Any(), gomock.Any()).
 					Return([]db.ClientGoal{}, nil)
 			},
 			wantErr: false,
 		},
+		{
+			name:     "client_of_another_organization",
+			clientID: "client-other-org",
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					GetClientByID(gomock.Any(), db.GetClientByIDParams{ID: "client-other-org", OrganizationID: util.StrPtr("org-1")}).
+					Return(db.Client{}, pgx.ErrNoRows)
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -660,18 +1285,823 @@ func TestListClientGoals(t *testing.T) {
 
 			mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 
+			mockStore.EXPECT().
+				ForOrganization("org-1").
+				Return(db.NewScopedStore(mockStore, "org-1"))
 			tt.setup(mockStore)
 
-			service := NewClientService(mockStore, mockLogger)
+			service := NewClientService(mockStore, mockLogger, util.DefaultTextFieldLength, assignment.ModeOff, 0)
 
-			_, err := service.ListClientGoals(context.Background(), tt.clientID)
+			ctx := context.WithValue(context.Background(), util.OrganizationIDKey, "org-1")
+			_, err := service.ListClientGoals(ctx, tt.clientID)
 
 			if tt.wantErr {
-				require.Error(t, err)
+				require.ErrorIs(t, err, ErrClientNotFound)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+// ============================================================
+// Test: GetClient
+// ============================================================
+
+func TestGetClient(t *testing.T) {
+	tests := []struct {
+		name      string
+		clientID  string
+		setup     func(mockStore *dbmocks.MockStoreInterface)
+		wantErr   error
+		checkResp func(t *testing.T, resp *GetClientResponse)
+	}{
+		{
+			name:     "success",
+			clientID: "client-123",
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					GetClientByID(gomock.Any(), db.GetClientByIDParams{
+						ID:             "client-123",
+						OrganizationID: util.StrPtr("org-1"),
+					}).
+					Return(db.Client{
+						ID:                 "client-123",
+						FirstName:          "Jan",
+						LastName:           "Jansen",
+						Status:             db.ClientStatusEnumInCare,
+						AssignedLocationID: "loc-1",
+						CareStartDate:      pgtype.Date{Time: time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC), Valid: true},
+					}, nil)
+			},
+			checkResp: func(t *testing.T, resp *GetClientResponse) {
+				assert.Equal(t, "in_care", resp.Status)
+				assert.Equal(t, "loc-1", resp.LocationID)
+				assert.Equal(t, "2026-01-05", resp.CareStartDate)
+				assert.Empty(t, resp.CareEndDate)
+				assert.Nil(t, resp.ReasonForDischarge)
+			},
+		},
+		{
+			name:     "not_found",
+			clientID: "missing",
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					GetClientByID(gomock.Any(), db.GetClientByIDParams{
+						ID:             "missing",
+						OrganizationID: util.StrPtr("org-1"),
+					}).
+					Return(db.Client{}, pgx.ErrNoRows)
+			},
+			wantErr: ErrClientNotFound,
+		},
+		{
+			name:     "db_error",
+			clientID: "client-123",
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					GetClientByID(gomock.Any(), db.GetClientByIDParams{
+						ID:             "client-123",
+						OrganizationID: util.StrPtr("org-1"),
+					}).
+					Return(db.Client{}, errors.New("connection refused"))
+			},
+			wantErr: ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := dbmocks.NewMockStoreInterface(ctrl)
+			mockLogger := loggermocks.NewMockLogger(ctrl)
+
+			mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+			mockStore.EXPECT().
+				ForOrganization("org-1").
+				Return(db.NewScopedStore(mockStore, "org-1"))
+			tt.setup(mockStore)
+
+			service := NewClientService(mockStore, mockLogger, util.DefaultTextFieldLength, assignment.ModeOff, 0)
+
+			ctx := context.WithValue(context.Background(), util.OrganizationIDKey, "org-1")
+			resp, err := service.GetClient(ctx, tt.clientID)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			tt.checkResp(t, resp)
+		})
+	}
+}
+
+func TestGetClientByBSN(t *testing.T) {
+	tests := []struct {
+		name      string
+		bsn       string
+		setup     func(mockStore *dbmocks.MockStoreInterface)
+		wantErr   error
+		checkResp func(t *testing.T, resp *GetClientResponse)
+	}{
+		{
+			name: "found",
+			bsn:  "123456789",
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					GetClientByBSNForOrganization(gomock.Any(), db.GetClientByBSNForOrganizationParams{
+						Bsn:            "123456789",
+						OrganizationID: "org-1",
+					}).
+					Return(db.Client{
+						ID:                 "client-123",
+						FirstName:          "Jan",
+						LastName:           "Jansen",
+						Bsn:                "123456789",
+						Status:             db.ClientStatusEnumWaitingList,
+						AssignedLocationID: "loc-1",
+					}, nil)
+			},
+			checkResp: func(t *testing.T, resp *GetClientResponse) {
+				assert.Equal(t, "client-123", resp.ID)
+				assert.Equal(t, "123456789", resp.Bsn)
+				assert.Equal(t, "waiting_list", resp.Status)
+			},
+		},
+		{
+			name: "not_found",
+			bsn:  "999999999",
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					GetClientByBSNForOrganization(gomock.Any(), gomock.Any()).
+					Return(db.Client{}, pgx.ErrNoRows)
+			},
+			wantErr: ErrClientNotFound,
+		},
+		{
+			name: "db_error",
+			bsn:  "123456789",
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					GetClientByBSNForOrganization(gomock.Any(), gomock.Any()).
+					Return(db.Client{}, errors.New("connection refused"))
+			},
+			wantErr: ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := dbmocks.NewMockStoreInterface(ctrl)
+			mockLogger := loggermocks.NewMockLogger(ctrl)
+
+			mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+			mockStore.EXPECT().
+				ForOrganization("org-1").
+				Return(db.NewScopedStore(mockStore, "org-1"))
+			tt.setup(mockStore)
+
+			service := NewClientService(mockStore, mockLogger, util.DefaultTextFieldLength, assignment.ModeOff, 0)
+
+			ctx := context.WithValue(context.Background(), util.OrganizationIDKey, "org-1")
+			resp, err := service.GetClientByBSN(ctx, tt.bsn)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			tt.checkResp(t, resp)
+		})
+	}
+}
+
+// flushRecorder is an io.Writer that also implements http.Flusher, recording
+// how much output had been written at each flush.
+type flushRecorder struct {
+	bytes.Buffer
+	flushedAt []int
+}
+
+func (f *flushRecorder) Flush() {
+	f.flushedAt = append(f.flushedAt, f.Len())
+}
+
+func TestExportClients(t *testing.T) {
+	orgCtx := context.WithValue(context.Background(), util.OrganizationIDKey, "org-1")
+	exportRows := func(n int) []db.ListClientsForExportRow {
+		rows := make([]db.ListClientsForExportRow, n)
+		for i := range rows {
+			rows[i] = db.ListClientsForExportRow{
+				ID:          fmt.Sprintf("client-%04d", i),
+				FirstName:   "Jan",
+				LastName:    fmt.Sprintf("Jansen %d", i),
+				Bsn:         "123456789",
+				DateOfBirth: pgtype.Date{Time: time.Date(2000, 1, 2, 0, 0, 0, 0, time.UTC), Valid: true},
+				Gender:      db.GenderEnumMale,
+				Status:      db.ClientStatusEnumInCare,
+				CareType:    db.CareTypeEnumAmbulatoryCare,
+			}
+		}
+		return rows
+	}
+
+	t.Run("streams_batches_as_valid_json", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := dbmocks.NewMockStoreInterface(ctrl)
+		mockLogger := loggermocks.NewMockLogger(ctrl)
+
+		const total, batchSize = 350, 100
+		rows := exportRows(total)
+		out := &flushRecorder{}
+		calls := 0
+
+		mockStore.EXPECT().
+			ForOrganization("org-1").
+			Return(db.NewScopedStore(mockStore, "org-1"))
+		mockStore.EXPECT().
+			ListClientsForExport(gomock.Any(), gomock.Any()).
+			Times(4).
+			DoAndReturn(func(_ context.Context, arg db.ListClientsForExportParams) ([]db.ListClientsForExportRow, error) {
+				assert.Equal(t, util.StrPtr("org-1"), arg.OrganizationID)
+				assert.Equal(t, int32(batchSize), arg.BatchSize)
+				start := calls * batchSize
+				if calls == 0 {
+					assert.Nil(t, arg.AfterID)
+				} else {
+					require.NotNil(t, arg.AfterID)
+					assert.Equal(t, rows[start-1].ID, *arg.AfterID)
+					// The previous batch has already been written and flushed,
+					// so rows are not accumulated before the next fetch.
+					assert.Len(t, out.flushedAt, calls)
+					assert.Contains(t, out.String(), rows[start-1].ID)
+				}
+				calls++
+				return rows[start:min(start+batchSize, total)], nil
+			})
+
+		service := &clientService{db: mockStore, logger: mockLogger, exportBatchSize: batchSize}
+		err := service.ExportClients(orgCtx, out, &ExportClientsRequest{})
+		require.NoError(t, err)
+
+		var decoded []ExportClientResponse
+		require.NoError(t, json.Unmarshal(out.Bytes(), &decoded))
+		require.Len(t, decoded, total)
+		for i, c := range decoded {
+			assert.Equal(t, rows[i].ID, c.ID)
+		}
+		assert.Equal(t, "2000-01-02", decoded[0].DateOfBirth)
+		assert.Equal(t, "in_care", decoded[0].Status)
+	})
+
+	t.Run("empty_table_writes_empty_array", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := dbmocks.NewMockStoreInterface(ctrl)
+		mockLogger := loggermocks.NewMockLogger(ctrl)
+
+		mockStore.EXPECT().
+			ForOrganization("org-1").
+			Return(db.NewScopedStore(mockStore, "org-1"))
+		mockStore.EXPECT().
+			ListClientsForExport(gomock.Any(), gomock.Any()).
+			Return([]db.ListClientsForExportRow{}, nil)
+
+		var out bytes.Buffer
+		service := NewClientService(mockStore, mockLogger, util.DefaultTextFieldLength, assignment.ModeOff, 0)
+		require.NoError(t, service.ExportClients(orgCtx, &out, &ExportClientsRequest{}))
+		assert.Equal(t, "[]", out.String())
+	})
+
+	t.Run("first_batch_error_writes_nothing", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := dbmocks.NewMockStoreInterface(ctrl)
+		mockLogger := loggermocks.NewMockLogger(ctrl)
+		mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+		mockStore.EXPECT().
+			ForOrganization("org-1").
+			Return(db.NewScopedStore(mockStore, "org-1"))
+		mockStore.EXPECT().
+			ListClientsForExport(gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("db error"))
+
+		var out bytes.Buffer
+		service := NewClientService(mockStore, mockLogger, util.DefaultTextFieldLength, assignment.ModeOff, 0)
+		err := service.ExportClients(orgCtx, &out, &ExportClientsRequest{})
+		assert.ErrorIs(t, err, ErrInternal)
+		assert.Zero(t, out.Len())
+	})
+
+	t.Run("redacted_export_masks_pii_and_keeps_care_type", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := dbmocks.NewMockStoreInterface(ctrl)
+		mockLogger := loggermocks.NewMockLogger(ctrl)
+
+		row := exportRows(1)[0]
+		row.FirstName = "jan"
+		row.LastName = "Jansen"
+		row.LocationName = "De Linde"
+		row.CoordinatorFirstName = "Petra"
+		row.CoordinatorLastName = "de Vries"
+		mockStore.EXPECT().
+			ForOrganization("org-1").
+			Return(db.NewScopedStore(mockStore, "org-1"))
+		mockStore.EXPECT().
+			ListClientsForExport(gomock.Any(), gomock.Any()).
+			Return([]db.ListClientsForExportRow{row}, nil)
+
+		var out bytes.Buffer
+		service := NewClientService(mockStore, mockLogger, util.DefaultTextFieldLength, assignment.ModeOff, 0)
+		require.NoError(t, service.ExportClients(orgCtx, &out, &ExportClientsRequest{Redact: true}))
+
+		var decoded []ExportClientResponse
+		require.NoError(t, json.Unmarshal(out.Bytes(), &decoded))
+		require.Len(t, decoded, 1)
+		assert.Equal(t, "*******89", decoded[0].Bsn)
+		assert.Equal(t, "J.", decoded[0].FirstName)
+		assert.Equal(t, "J.", decoded[0].LastName)
+		assert.NotContains(t, out.String(), "123456789")
+		assert.NotContains(t, out.String(), "Jansen")
+		assert.Equal(t, "2000", decoded[0].DateOfBirth)
+		assert.NotContains(t, out.String(), "2000-01-02")
+		assert.Empty(t, decoded[0].Gender)
+		assert.Empty(t, decoded[0].CoordinatorFirstName)
+		assert.Empty(t, decoded[0].CoordinatorLastName)
+		assert.NotContains(t, out.String(), "de Vries")
+		assert.Equal(t, "ambulatory_care", decoded[0].CareType)
+		assert.Equal(t, "in_care", decoded[0].Status)
+		assert.Equal(t, "De Linde", decoded[0].LocationName)
+	})
+}
+
+func TestListInCareClients_Cursor(t *testing.T) {
+	// 25 seeded rows, newest first, with pairs sharing created_at so the id
+	// tie-breaker matters.
+	base := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	seeded := make([]db.ListInCareClientsByCursorRow, 25)
+	for i := range seeded {
+		seeded[i] = db.ListInCareClientsByCursorRow{
+			ID:        fmt.Sprintf("client-%02d", 99-i),
+			FirstName: "Jan",
+			CareType:  db.CareTypeEnumProtectedLiving,
+			CreatedAt: pgtype.Timestamp{Time: base.Add(-time.Duration(i/2) * time.Hour), Valid: true},
+		}
+	}
+	// fakeKeyset mimics the query: rows strictly after the cursor, limited.
+	fakeKeyset := func(_ context.Context, arg db.ListInCareClientsByCursorParams) ([]db.ListInCareClientsByCursorRow, error) {
+		out := []db.ListInCareClientsByCursorRow{}
+		for _, row := range seeded {
+			if arg.CursorCreatedAt.Valid {
+				after := row.CreatedAt.Time.Before(arg.CursorCreatedAt.Time) ||
+					(row.CreatedAt.Time.Equal(arg.CursorCreatedAt.Time) && row.ID < *arg.CursorID)
+				if !after {
+					continue
+				}
+			}
+			if len(out) == int(arg.PageSize) {
+				break
+			}
+			out = append(out, row)
+		}
+		return out, nil
+	}
+
+	orgCtx := context.WithValue(context.Background(), util.OrganizationIDKey, "org-1")
+
+	t.Run("pages_through_all_rows_without_gaps_or_duplicates", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := dbmocks.NewMockStoreInterface(ctrl)
+		mockLogger := loggermocks.NewMockLogger(ctrl)
+		mockStore.EXPECT().
+			ForOrganization("org-1").
+			Return(db.NewScopedStore(mockStore, "org-1")).
+			Times(3)
+		mockStore.EXPECT().
+			ListInCareClientsByCursor(gomock.Any(), gomock.Any()).
+			DoAndReturn(fakeKeyset).
+			Times(3)
+
+		service := NewClientService(mockStore, mockLogger, util.DefaultTextFieldLength, assignment.ModeOff, 0)
+
+		cursor := ""
+		var ids []string
+		for pages := 0; pages < 10; pages++ {
+			result, err := service.ListInCareClients(orgCtx, &ListInCareClientsRequest{Cursor: &cursor})
+			require.NoError(t, err)
+			assert.LessOrEqual(t, len(result.Data), 10)
+			for _, c := range result.Data {
+				ids = append(ids, c.ID)
+			}
+			if result.NextCursor == "" {
+				break
+			}
+			cursor = result.NextCursor
+		}
+
+		require.Len(t, ids, len(seeded))
+		for i, row := range seeded {
+			assert.Equal(t, row.ID, ids[i])
+		}
+	})
+
+	t.Run("first_page_has_no_cursor_params", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := dbmocks.NewMockStoreInterface(ctrl)
+		mockLogger := loggermocks.NewMockLogger(ctrl)
+		mockStore.EXPECT().
+			ForOrganization("org-1").
+			Return(db.NewScopedStore(mockStore, "org-1"))
+		mockStore.EXPECT().
+			ListInCareClientsByCursor(gomock.Any(), db.ListInCareClientsByCursorParams{
+				OrganizationID: util.StrPtr("org-1"),
+				PageSize:       11,
+			}).
+			Return([]db.ListInCareClientsByCursorRow{seeded[0]}, nil)
+
+		empty := ""
+		service := NewClientService(mockStore, mockLogger, util.DefaultTextFieldLength, assignment.ModeOff, 0)
+		result, err := service.ListInCareClients(orgCtx, &ListInCareClientsRequest{Cursor: &empty})
+		require.NoError(t, err)
+		assert.Len(t, result.Data, 1)
+		assert.Empty(t, result.NextCursor)
+		assert.Equal(t, 10, result.PageSize)
+	})
+
+	t.Run("invalid_cursor", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := dbmocks.NewMockStoreInterface(ctrl)
+		mockLogger := loggermocks.NewMockLogger(ctrl)
+
+		bad := "not-a-cursor"
+		service := NewClientService(mockStore, mockLogger, util.DefaultTextFieldLength, assignment.ModeOff, 0)
+		_, err := service.ListInCareClients(orgCtx, &ListInCareClientsRequest{Cursor: &bad})
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+	})
+}
+
+// ============================================================
+// Test: Client notes
+// ============================================================
+
+func TestAddClientNote(t *testing.T) {
+	createdAt := time.Date(2026, 3, 2, 9, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		req       *AddClientNoteRequest
+		setup     func(mockStore *dbmocks.MockStoreInterface)
+		wantErr   error
+		checkResp func(t *testing.T, resp *ClientNoteResponse)
+	}{
+		{
+			name: "success",
+			req:  &AddClientNoteRequest{Body: "  Spoke with family about weekend leave.  "},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					ForOrganization("org-1").
+					Return(db.NewScopedStore(mockStore, "org-1"))
+				mockStore.EXPECT().
+					GetClientByID(gomock.Any(), db.GetClientByIDParams{ID: "client-123", OrganizationID: util.StrPtr("org-1")}).
+					Return(db.Client{ID: "client-123"}, nil)
+				mockStore.EXPECT().
+					AddClientNote(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg db.AddClientNoteParams) (db.ClientNote, error) {
+						assert.NotEmpty(t, arg.ID)
+						assert.Equal(t, "client-123", arg.ClientID)
+						assert.Equal(t, "emp-1", arg.AuthorID)
+						assert.Equal(t, "Spoke with family about weekend leave.", arg.Body)
+						return db.ClientNote{
+							ID:        arg.ID,
+							ClientID:  arg.ClientID,
+							AuthorID:  arg.AuthorID,
+							Body:      arg.Body,
+							CreatedAt: pgtype.Timestamptz{Time: createdAt, Valid: true},
+						}, nil
+					})
+			},
+			checkResp: func(t *testing.T, resp *ClientNoteResponse) {
+				assert.Equal(t, "emp-1", resp.AuthorID)
+				assert.Equal(t, "Spoke with family about weekend leave.", resp.Body)
+				assert.Equal(t, "2026-03-02T09:30:00Z", resp.CreatedAt)
+			},
+		},
+		{
+			name:    "blank_body",
+			req:     &AddClientNoteRequest{Body: "   "},
+			setup:   func(mockStore *dbmocks.MockStoreInterface) {},
+			wantErr: ErrInvalidRequest,
+		},
+		{
+			name:    "body_too_long",
+			req:     &AddClientNoteRequest{Body: strings.Repeat("a", util.DefaultTextFieldLength+1)},
+			setup:   func(mockStore *dbmocks.MockStoreInterface) {},
+			wantErr: ErrTextTooLong,
+		},
+		{
+			name: "client_not_found",
+			req:  &AddClientNoteRequest{Body: "note"},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					ForOrganization("org-1").
+					Return(db.NewScopedStore(mockStore, "org-1"))
+				mockStore.EXPECT().
+					GetClientByID(gomock.Any(), db.GetClientByIDParams{ID: "client-123", OrganizationID: util.StrPtr("org-1")}).
+					Return(db.Client{}, pgx.ErrNoRows)
+			},
+			wantErr: ErrClientNotFound,
+		},
+		{
+			name: "insert_error",
+			req:  &AddClientNoteRequest{Body: "note"},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					ForOrganization("org-1").
+					Return(db.NewScopedStore(mockStore, "org-1"))
+				mockStore.EXPECT().
+					GetClientByID(gomock.Any(), db.GetClientByIDParams{ID: "client-123", OrganizationID: util.StrPtr("org-1")}).
+					Return(db.Client{ID: "client-123"}, nil)
+				mockStore.EXPECT().
+					AddClientNote(gomock.Any(), gomock.Any()).
+					Return(db.ClientNote{}, errors.New("connection refused"))
+			},
+			wantErr: ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := dbmocks.NewMockStoreInterface(ctrl)
+			mockLogger := loggermocks.NewMockLogger(ctrl)
+
+			mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+			tt.setup(mockStore)
+
+			service := NewClientService(mockStore, mockLogger, util.DefaultTextFieldLength, assignment.ModeOff, 0)
+			ctx := context.WithValue(context.Background(), util.EmployeeIDKey, "emp-1")
+			ctx = context.WithValue(ctx, util.OrganizationIDKey, "org-1")
+
+			resp, err := service.AddClientNote(ctx, "client-123", tt.req)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, resp)
+				return
+			}
+
+			require.NoError(t, err)
+			tt.checkResp(t, resp)
+		})
+	}
+}
+
+func TestListClientNotes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := dbmocks.NewMockStoreInterface(ctrl)
+	mockLogger := loggermocks.NewMockLogger(ctrl)
+
+	newer := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	older := newer.Add(-time.Hour)
+	mockStore.EXPECT().
+		ForOrganization("org-1").
+		Return(db.NewScopedStore(mockStore, "org-1"))
+	mockStore.EXPECT().
+		GetClientByID(gomock.Any(), db.GetClientByIDParams{ID: "client-123", OrganizationID: util.StrPtr("org-1")}).
+		Return(db.Client{ID: "client-123"}, nil)
+	mockStore.EXPECT().
+		ListClientNotes(gomock.Any(), "client-123").
+		Return([]db.ListClientNotesRow{
+			{
+				ID:              "note-2",
+				Body:            "Second",
+				AuthorID:        "emp-2",
+				AuthorFirstName: "Piet",
+				AuthorLastName:  "de Vries",
+				CreatedAt:       pgtype.Timestamptz{Time: newer, Valid: true},
+			},
+			{
+				ID:              "note-1",
+				Body:            "First",
+				AuthorID:        "emp-1",
+				AuthorFirstName: "Anna",
+				AuthorLastName:  "Bakker",
+				CreatedAt:       pgtype.Timestamptz{Time: older, Valid: true},
+			},
+		}, nil)
+
+	service := NewClientService(mockStore, mockLogger, util.DefaultTextFieldLength, assignment.ModeOff, 0)
+	ctx := context.WithValue(context.Background(), util.OrganizationIDKey, "org-1")
+	notes, err := service.ListClientNotes(ctx, "client-123")
+
+	require.NoError(t, err)
+	require.Len(t, notes, 2)
+	assert.Equal(t, "note-2", notes[0].ID)
+	assert.Equal(t, "Piet", notes[0].AuthorFirstName)
+	assert.Equal(t, "note-1", notes[1].ID)
+	assert.Equal(t, "Bakker", notes[1].AuthorLastName)
+}
+
+func TestListClientNotes_ClientOfAnotherOrganization(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := dbmocks.NewMockStoreInterface(ctrl)
+	mockLogger := loggermocks.NewMockLogger(ctrl)
+
+	mockStore.EXPECT().
+		ForOrganization("org-1").
+		Return(db.NewScopedStore(mockStore, "org-1"))
+	mockStore.EXPECT().
+		GetClientByID(gomock.Any(), db.GetClientByIDParams{ID: "client-other-org", OrganizationID: util.StrPtr("org-1")}).
+		Return(db.Client{}, pgx.ErrNoRows)
+
+	service := NewClientService(mockStore, mockLogger, util.DefaultTextFieldLength, assignment.ModeOff, 0)
+	ctx := context.WithValue(context.Background(), util.OrganizationIDKey, "org-1")
+	notes, err := service.ListClientNotes(ctx, "client-other-org")
+
+	assert.ErrorIs(t, err, ErrClientNotFound)
+	assert.Nil(t, notes)
+}
+
+func TestGetDischargeTrend(t *testing.T) {
+	t.Run("current_month_in_timezone", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := dbmocks.NewMockStoreInterface(ctrl)
+		mockLogger := loggermocks.NewMockLogger(ctrl)
+
+		// Far east of UTC, so the month rolls over there first
+		loc, err := time.LoadLocation("Pacific/Kiritimati")
+		require.NoError(t, err)
+		now := time.Now().In(loc)
+		wantMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+		mockStore.EXPECT().
+			GetDischargeTrendByMonth(gomock.Any(), db.GetDischargeTrendByMonthParams{
+				CurrentMonth: pgtype.Date{Time: wantMonth, Valid: true},
+				Months:       12,
+			}).
+			Return([]db.GetDischargeTrendByMonthRow{
+				{
+					Month:               pgtype.Date{Time: wantMonth.AddDate(0, -1, 0), Valid: true},
+					PlannedDischarges:   2,
+					PrematureDischarges: 1,
+					TotalCount:          3,
+				},
+				{Month: pgtype.Date{Time: wantMonth, Valid: true}},
+			}, nil)
+
+		service := NewClientService(mockStore, mockLogger, util.DefaultTextFieldLength, assignment.ModeOff, 0)
+		trend, err := service.GetDischargeTrend(context.Background(), &GetDischargeTrendRequest{
+			Timezone: "Pacific/Kiritimati",
+		})
+
+		require.NoError(t, err)
+		require.Len(t, trend, 2)
+		assert.Equal(t, wantMonth.AddDate(0, -1, 0).Format("2006-01"), trend[0].Month)
+		assert.Equal(t, 2, trend[0].PlannedDischarges)
+		assert.Equal(t, 1, trend[0].PrematureDischarges)
+		assert.Equal(t, 3, trend[0].TotalCount)
+		assert.Equal(t, wantMonth.Format("2006-01"), trend[1].Month)
+		assert.Zero(t, trend[1].TotalCount)
+	})
+
+	t.Run("unknown_timezone", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		service := NewClientService(
+			dbmocks.NewMockStoreInterface(ctrl),
+			loggermocks.NewMockLogger(ctrl),
+			util.DefaultTextFieldLength,
+			assignment.ModeOff,
+			0,
+		)
+
+		_, err := service.GetDischargeTrend(context.Background(), &GetDischargeTrendRequest{
+			Months:   6,
+			Timezone: "Mars/Olympus",
+		})
+		assert.ErrorIs(t, err, ErrInvalidRequest)
+	})
+}
+
+// ============================================================
+// Test: SuggestPlacements
+// ============================================================
+
+func TestSuggestPlacements(t *testing.T) {
+	waitingClient := db.Client{
+		ID:       "client-123",
+		Status:   db.ClientStatusEnumWaitingList,
+		CareType: db.CareTypeEnumProtectedLiving,
+	}
+
+	tests := []struct {
+		name      string
+		setup     func(mockStore *dbmocks.MockStoreInterface)
+		wantErr   error
+		checkResp func(t *testing.T, resp []PlacementSuggestionResponse)
+	}{
+		{
+			name: "ranked_by_available_beds",
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					GetClientByID(gomock.Any(), gomock.Any()).
+					Return(waitingClient, nil)
+				mockStore.EXPECT().
+					ListPlacementCandidatesForOrganization(gomock.Any(), db.ListPlacementCandidatesForOrganizationParams{
+						OrganizationID: "org-1",
+						CareType:       db.CareTypeEnumProtectedLiving,
+					}).
+					Return([]db.ListPlacementCandidatesForOrganizationRow{
+						{ID: "loc-roomy", Name: "De Linde", Capacity: 10, Occupied: 2, Available: 8},
+						{ID: "loc-tight", Name: "De Eik", Capacity: 5, Occupied: 3, Available: 2},
+					}, nil)
+			},
+			checkResp: func(t *testing.T, resp []PlacementSuggestionResponse) {
+				require.Len(t, resp, 2)
+				assert.Equal(t, "loc-roomy", resp[0].LocationID)
+				assert.Equal(t, int32(8), resp[0].Available)
+				assert.Equal(t, "loc-tight", resp[1].LocationID)
+				assert.Equal(t, int32(2), resp[1].Available)
+			},
+		},
+		{
+			name: "no_candidates",
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					GetClientByID(gomock.Any(), gomock.Any()).
+					Return(waitingClient, nil)
+				mockStore.EXPECT().
+					ListPlacementCandidatesForOrganization(gomock.Any(), gomock.Any()).
+					Return([]db.ListPlacementCandidatesForOrganizationRow{}, nil)
+			},
+			checkResp: func(t *testing.T, resp []PlacementSuggestionResponse) {
+				assert.NotNil(t, resp)
+				assert.Empty(t, resp)
+			},
+		},
+		{
+			name: "client_not_on_waiting_list",
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					GetClientByID(gomock.Any(), gomock.Any()).
+					Return(db.Client{ID: "client-123", Status: db.ClientStatusEnumInCare}, nil)
+			},
+			wantErr: ErrInvalidClientStatus,
+		},
+		{
+			name: "client_not_found",
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					GetClientByID(gomock.Any(), gomock.Any()).
+					Return(db.Client{}, pgx.ErrNoRows)
+			},
+			wantErr: ErrClientNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := dbmocks.NewMockStoreInterface(ctrl)
+			mockLogger := loggermocks.NewMockLogger(ctrl)
+
+			mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+			mockStore.EXPECT().
+				ForOrganization("org-1").
+				Return(db.NewScopedStore(mockStore, "org-1"))
+			tt.setup(mockStore)
+
+			service := NewClientService(mockStore, mockLogger, util.DefaultTextFieldLength, assignment.ModeOff, 0)
+
+			ctx := context.WithValue(context.Background(), util.OrganizationIDKey, "org-1")
+			resp, err := service.SuggestPlacements(ctx, "client-123")
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
 				return
 			}
 
 			require.NoError(t, err)
+			tt.checkResp(t, resp)
 		})
 	}
 }
@@ -1,5 +1,10 @@
 package dashboard
 
+type FeaturesResponse struct {
+	DigestNotifications bool `json:"digestNotifications"`
+	GeoPlacement        bool `json:"geoPlacement"`
+}
+
 type OverviewResponse struct {
 	TotalActiveClients   int `json:"totalActiveClients"`
 	WaitingListCount     int `json:"waitingListCount"`
@@ -21,11 +26,12 @@ const (
 type AlertType string
 
 const (
-	AlertTypeEvaluation AlertType = "evaluation"
-	AlertTypeCareEnd    AlertType = "care_end"
-	AlertTypeIncident   AlertType = "incident"
-	AlertTypeWaitlist   AlertType = "waitlist"
-	AlertTypeTransfer   AlertType = "transfer"
+	AlertTypeEvaluation        AlertType = "evaluation"
+	AlertTypeCareEnd           AlertType = "care_end"
+	AlertTypeIncident          AlertType = "incident"
+	AlertTypeIncidentEscalated AlertType = "incident_escalated"
+	AlertTypeWaitlist          AlertType = "waitlist"
+	AlertTypeTransfer          AlertType = "transfer"
 )
 
 type AlertItem struct {
@@ -38,10 +44,22 @@ type AlertItem struct {
 	Link        string        `json:"link"`
 }
 
+type CriticalAlertsRequest struct {
+	Scope string `form:"scope,default=all" binding:"omitempty,oneof=all own"`
+}
+
 type CriticalAlertsResponse struct {
 	Alerts []AlertItem `json:"alerts"`
 }
 
+// PipelineStatsRequest optionally scopes the pipeline counts to a date range.
+// Both ends are inclusive and either may be left open; without a range the
+// counts are current totals.
+type PipelineStatsRequest struct {
+	From *string `form:"from" binding:"omitempty,datetime=2006-01-02"`
+	To   *string `form:"to"   binding:"omitempty,datetime=2006-01-02"`
+}
+
 type PipelineStatsResponse struct {
 	Registrations int `json:"registrations"`
 	Intakes       int `json:"intakes"`
@@ -62,6 +80,28 @@ type CareTypeDistributionResponse struct {
 	Total        int                        `json:"total"`
 }
 
+// DefaultAgeBands are the lower bounds used when no bands are requested: 18-25, 26-40, 41-64 and 65+.
+// Younger clients are reported in a <18 band before them.
+var DefaultAgeBands = []int{18, 26, 41, 65}
+
+type ClientAgeDistributionRequest struct {
+	// Bands are the inclusive lower bounds of each age band, e.g. ?bands=18&bands=26&bands=41&bands=65
+	Bands []int `form:"bands" binding:"omitempty,max=20,dive,min=0,max=150"`
+}
+
+type AgeBandItem struct {
+	Label      string  `json:"label"`
+	MinAge     *int    `json:"minAge"` // nil for the unknown bucket
+	MaxAge     *int    `json:"maxAge"` // nil for the open-ended highest band and the unknown bucket
+	Count      int     `json:"count"`
+	Percentage float64 `json:"percentage"`
+}
+
+type ClientAgeDistributionResponse struct {
+	Distribution []AgeBandItem `json:"distribution"`
+	Total        int           `json:"total"`
+}
+
 type LocationCapacityRequest struct {
 	Limit int    `form:"limit,default=4" binding:"min=1,max=100"`
 	Sort  string `form:"sort,default=occupancy_desc" binding:"omitempty,oneof=occupancy_desc occupancy_asc name"`
@@ -74,13 +114,26 @@ type LocationCapacityItem struct {
 	Occupied   int     `json:"occupied"`
 	Available  int     `json:"available"`
 	Percentage float64 `json:"percentage"`
+	// WaitingCount is the number of waiting-list clients assigned to this location
+	WaitingCount int `json:"waitingCount"`
+	// Status is ok, warning (at or above the configured warning percentage) or full
+	Status string `json:"status"`
 }
 
+const (
+	LocationStatusOK      = "ok"
+	LocationStatusWarning = "warning"
+	LocationStatusFull    = "full"
+)
+
 type LocationCapacityTotals struct {
 	TotalCapacity     int     `json:"totalCapacity"`
 	TotalOccupied     int     `json:"totalOccupied"`
 	TotalAvailable    int     `json:"totalAvailable"`
 	OverallPercentage float64 `json:"overallPercentage"`
+	// AtRiskLocations counts every location with status warning or full, not
+	// only the ones returned in the (limited) list
+	AtRiskLocations int `json:"atRiskLocations"`
 }
 
 type LocationCapacityResponse struct {
@@ -119,6 +172,41 @@ type DischargeStatsResponse struct {
 	AverageDaysInCare int `json:"averageDaysInCare"`
 }
 
+type IncidentCategoryStatsItem struct {
+	Category   string  `json:"category"`
+	Label      string  `json:"label"`
+	Count      int     `json:"count"`
+	Percentage float64 `json:"percentage"`
+}
+
+// IncidentCategoryStatsResponse lists categories with at least one incident, most frequent first
+type IncidentCategoryStatsResponse struct {
+	Categories []IncidentCategoryStatsItem `json:"categories"`
+	Total      int                         `json:"total"`
+}
+
+// DashboardSectionErrors flags the sections of the combined dashboard that
+// failed to load; their data is null in the response
+type DashboardSectionErrors struct {
+	Overview       bool `json:"overview"`
+	CriticalAlerts bool `json:"criticalAlerts"`
+	Pipeline       bool `json:"pipeline"`
+	CareTypes      bool `json:"careTypes"`
+	Capacity       bool `json:"capacity"`
+	Evaluations    bool `json:"evaluations"`
+}
+
+// DashboardResponse combines the admin dashboard sections in one response
+type DashboardResponse struct {
+	Overview       *OverviewResponse             `json:"overview"`
+	CriticalAlerts *CriticalAlertsResponse       `json:"criticalAlerts"`
+	Pipeline       *PipelineStatsResponse        `json:"pipeline"`
+	CareTypes      *CareTypeDistributionResponse `json:"careTypes"`
+	Capacity       *LocationCapacityResponse     `json:"capacity"`
+	Evaluations    *EvaluationStatsResponse      `json:"evaluations"`
+	Errors         DashboardSectionErrors        `json:"errors"`
+}
+
 // Coordinator Dashboard DTOs
 
 type CoordinatorAlertSeverity string
@@ -132,11 +220,13 @@ const (
 type CoordinatorAlertType string
 
 const (
-	CoordinatorAlertTypeEvaluation  CoordinatorAlertType = "evaluation"
-	CoordinatorAlertTypeContract    CoordinatorAlertType = "contract"
-	CoordinatorAlertTypeDraft       CoordinatorAlertType = "draft"
-	CoordinatorAlertTypeIncident    CoordinatorAlertType = "incident"
-	CoordinatorAlertTypeWaitingLong CoordinatorAlertType = "waiting_long"
+	CoordinatorAlertTypeEvaluation   CoordinatorAlertType = "evaluation"
+	CoordinatorAlertTypeContract     CoordinatorAlertType = "contract"
+	CoordinatorAlertTypeDraft        CoordinatorAlertType = "draft"
+	CoordinatorAlertTypeIncident     CoordinatorAlertType = "incident"
+	CoordinatorAlertTypeWaitingLong  CoordinatorAlertType = "waiting_long"
+	CoordinatorAlertTypeTransfer     CoordinatorAlertType = "transfer"
+	CoordinatorAlertTypeHighPriority CoordinatorAlertType = "high_priority"
 )
 
 type CoordinatorUrgentAlertItem struct {
@@ -0,0 +1,275 @@
+package dashboard_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"care-cordination/features/dashboard"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// jsonKeys marshals v and returns its top-level object keys
+func jsonKeys(t *testing.T, v any) []string {
+	t.Helper()
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+
+	var obj map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(data, &obj))
+
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// TestResponseJSONKeys pins the key names the dashboard frontend reads.
+// Renaming a field or dropping its tag must fail here rather than show up
+// as nulls in the browser.
+func TestResponseJSONKeys(t *testing.T) {
+	minAge, maxAge := 18, 25
+
+	tests := []struct {
+		name     string
+		value    any
+		wantKeys []string
+	}{
+		{
+			name:     "FeaturesResponse",
+			value:    dashboard.FeaturesResponse{},
+			wantKeys: []string{"digestNotifications", "geoPlacement"},
+		},
+		{
+			name:  "OverviewResponse",
+			value: dashboard.OverviewResponse{},
+			wantKeys: []string{
+				"totalActiveClients", "waitingListCount", "pendingRegistrations",
+				"totalCoordinators", "totalEmployees", "openIncidents",
+			},
+		},
+		{
+			name:     "AlertItem",
+			value:    dashboard.AlertItem{},
+			wantKeys: []string{"id", "type", "title", "description", "severity", "count", "link"},
+		},
+		{
+			name:     "CriticalAlertsResponse",
+			value:    dashboard.CriticalAlertsResponse{},
+			wantKeys: []string{"alerts"},
+		},
+		{
+			name:     "PipelineStatsResponse",
+			value:    dashboard.PipelineStatsResponse{},
+			wantKeys: []string{"registrations", "intakes", "waitingList", "inCare", "discharged"},
+		},
+		{
+			name:     "CareTypeDistributionItem",
+			value:    dashboard.CareTypeDistributionItem{},
+			wantKeys: []string{"careType", "label", "count", "percentage"},
+		},
+		{
+			name:     "CareTypeDistributionResponse",
+			value:    dashboard.CareTypeDistributionResponse{},
+			wantKeys: []string{"distribution", "total"},
+		},
+		{
+			name:     "AgeBandItem",
+			value:    dashboard.AgeBandItem{MinAge: &minAge, MaxAge: &maxAge},
+			wantKeys: []string{"label", "minAge", "maxAge", "count", "percentage"},
+		},
+		{
+			name:     "ClientAgeDistributionResponse",
+			value:    dashboard.ClientAgeDistributionResponse{},
+			wantKeys: []string{"distribution", "total"},
+		},
+		{
+			name:     "LocationCapacityItem",
+			value:    dashboard.LocationCapacityItem{},
+			wantKeys: []string{"id", "name", "capacity", "occupied", "available", "percentage", "waitingCount", "status"},
+		},
+		{
+			name:     "LocationCapacityTotals",
+			value:    dashboard.LocationCapacityTotals{},
+			wantKeys: []string{"totalCapacity", "totalOccupied", "totalAvailable", "overallPercentage", "atRiskLocations"},
+		},
+		{
+			name:     "LocationCapacityResponse",
+			value:    dashboard.LocationCapacityResponse{},
+			wantKeys: []string{"locations", "totals"},
+		},
+		{
+			name: "TodayAppointmentItem",
+			value: dashboard.TodayAppointmentItem{
+				ClientID:     "client-1",
+				ClientName:   "Sam Jansen",
+				LocationName: "Utrecht",
+			},
+			wantKeys: []string{
+				"id", "type", "title", "clientId", "clientName",
+				"startTime", "endTime", "locationName",
+			},
+		},
+		{
+			name:     "TodayAppointmentsResponse",
+			value:    dashboard.TodayAppointmentsResponse{},
+			wantKeys: []string{"appointments", "count"},
+		},
+		{
+			name:     "EvaluationStatsResponse",
+			value:    dashboard.EvaluationStatsResponse{},
+			wantKeys: []string{"completionRate", "completed", "total", "overdue", "dueSoon"},
+		},
+		{
+			name:     "DischargeStatsResponse",
+			value:    dashboard.DischargeStatsResponse{},
+			wantKeys: []string{"thisMonth", "thisYear", "plannedRate", "averageDaysInCare"},
+		},
+		{
+			name:     "IncidentCategoryStatsItem",
+			value:    dashboard.IncidentCategoryStatsItem{},
+			wantKeys: []string{"category", "label", "count", "percentage"},
+		},
+		{
+			name:     "IncidentCategoryStatsResponse",
+			value:    dashboard.IncidentCategoryStatsResponse{},
+			wantKeys: []string{"categories", "total"},
+		},
+		{
+			name:     "CoordinatorUrgentAlertItem",
+			value:    dashboard.CoordinatorUrgentAlertItem{},
+			wantKeys: []string{"id", "type", "title", "description", "severity", "count", "clientIds", "link"},
+		},
+		{
+			name:     "CoordinatorUrgentAlertsResponse",
+			value:    dashboard.CoordinatorUrgentAlertsResponse{},
+			wantKeys: []string{"alerts"},
+		},
+		{
+			name: "CoordinatorScheduleItem",
+			value: dashboard.CoordinatorScheduleItem{
+				ClientID:   "client-1",
+				LocationID: "loc-1",
+			},
+			wantKeys: []string{
+				"id", "time", "endTime", "type", "clientId", "clientName",
+				"locationId", "locationName", "status",
+			},
+		},
+		{
+			name:     "CoordinatorTodayScheduleResponse",
+			value:    dashboard.CoordinatorTodayScheduleResponse{},
+			wantKeys: []string{"date", "appointments", "count"},
+		},
+		{
+			name:  "CoordinatorStatsResponse",
+			value: dashboard.CoordinatorStatsResponse{},
+			wantKeys: []string{
+				"myActiveClients", "myUpcomingEvaluations", "myPendingIntakes", "myWaitingListClients",
+			},
+		},
+		{
+			name:     "ReminderItem",
+			value:    dashboard.ReminderItem{},
+			wantKeys: []string{"id", "title", "client", "dueDate", "priority"},
+		},
+		{
+			name:     "CoordinatorRemindersResponse",
+			value:    dashboard.CoordinatorRemindersResponse{},
+			wantKeys: []string{"reminders"},
+		},
+		{
+			name:  "CoordinatorClientItem",
+			value: dashboard.CoordinatorClientItem{},
+			wantKeys: []string{
+				"id", "firstName", "lastName", "careType", "location",
+				"daysUntilEnd", "status", "nextEvaluation", "evaluationStatus",
+			},
+		},
+		{
+			name:     "CoordinatorClientsResponse",
+			value:    dashboard.CoordinatorClientsResponse{},
+			wantKeys: []string{"clients"},
+		},
+		{
+			name:     "CoordinatorGoalsProgressResponse",
+			value:    dashboard.CoordinatorGoalsProgressResponse{},
+			wantKeys: []string{"onTrack", "delayed", "achieved", "notStarted", "total"},
+		},
+		{
+			name:     "CoordinatorIncidentItem",
+			value:    dashboard.CoordinatorIncidentItem{},
+			wantKeys: []string{"id", "client", "type", "severity", "date", "status"},
+		},
+		{
+			name:     "CoordinatorIncidentsResponse",
+			value:    dashboard.CoordinatorIncidentsResponse{},
+			wantKeys: []string{"incidents"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ElementsMatch(t, tt.wantKeys, jsonKeys(t, tt.value))
+		})
+	}
+}
+
+var camelCaseKey = regexp.MustCompile(`^[a-z][a-zA-Z0-9]*$`)
+
+// TestResponseFieldsHaveCamelCaseTags guards response types added later:
+// every exported field needs an explicit camelCase json tag.
+func TestResponseFieldsHaveCamelCaseTags(t *testing.T) {
+	types := []any{
+		dashboard.FeaturesResponse{},
+		dashboard.OverviewResponse{},
+		dashboard.AlertItem{},
+		dashboard.CriticalAlertsResponse{},
+		dashboard.PipelineStatsResponse{},
+		dashboard.CareTypeDistributionItem{},
+		dashboard.CareTypeDistributionResponse{},
+		dashboard.AgeBandItem{},
+		dashboard.ClientAgeDistributionResponse{},
+		dashboard.LocationCapacityItem{},
+		dashboard.LocationCapacityTotals{},
+		dashboard.LocationCapacityResponse{},
+		dashboard.TodayAppointmentItem{},
+		dashboard.TodayAppointmentsResponse{},
+		dashboard.EvaluationStatsResponse{},
+		dashboard.DischargeStatsResponse{},
+		dashboard.IncidentCategoryStatsItem{},
+		dashboard.IncidentCategoryStatsResponse{},
+		dashboard.DashboardSectionErrors{},
+		dashboard.DashboardResponse{},
+		dashboard.CoordinatorUrgentAlertItem{},
+		dashboard.CoordinatorUrgentAlertsResponse{},
+		dashboard.CoordinatorScheduleItem{},
+		dashboard.CoordinatorTodayScheduleResponse{},
+		dashboard.CoordinatorStatsResponse{},
+		dashboard.ReminderItem{},
+		dashboard.CoordinatorRemindersResponse{},
+		dashboard.CoordinatorClientItem{},
+		dashboard.CoordinatorClientsResponse{},
+		dashboard.CoordinatorGoalsProgressResponse{},
+		dashboard.CoordinatorIncidentItem{},
+		dashboard.CoordinatorIncidentsResponse{},
+	}
+
+	for _, v := range types {
+		typ := reflect.TypeOf(v)
+		for i := range typ.NumField() {
+			field := typ.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+			assert.Truef(t, camelCaseKey.MatchString(name),
+				"%s.%s has json key %q, want an explicit camelCase key", typ.Name(), field.Name, name)
+		}
+	}
+}
@@ -3,5 +3,7 @@ package dashboard
 import "errors"
 
 var (
-	ErrInternal = errors.New("internal")
+	ErrInternal         = errors.New("internal")
+	ErrInvalidAgeBands  = errors.New("age bands must be strictly ascending")
+	ErrInvalidDateRange = errors.New("from must not be after to")
 )
@@ -26,18 +26,23 @@ func NewDashboardHandler(
 func (h *DashboardHandler) SetupDashboardRoutes(router *gin.Engine) {
 	dashboard := router.Group("/dashboard")
 	dashboard.Use(h.mdw.AuthMdw())
+	dashboard.GET("/features", h.GetFeatures)
+	// Without dashboard:read, callers only see alerts for their own caseload
+	dashboard.GET("/critical-alerts", h.mdw.LoadPermission("dashboard", "read"), h.GetCriticalAlerts)
 
 	// Admin Dashboard
 	admin := dashboard.Group("")
 	admin.Use(h.mdw.RequirePermission("dashboard", "read"))
+	admin.GET("", h.GetDashboard)
 	admin.GET("/overview-stats", h.GetOverviewStats)
-	admin.GET("/critical-alerts", h.GetCriticalAlerts)
 	admin.GET("/pipeline-stats", h.GetPipelineStats)
 	admin.GET("/care-type-distribution", h.GetCareTypeDistribution)
+	admin.GET("/age-distribution", h.GetClientAgeDistribution)
 	admin.GET("/location-capacity", h.GetLocationCapacity)
 	admin.GET("/today-appointments", h.GetTodayAppointments)
 	admin.GET("/evaluation-stats", h.GetEvaluationStats)
 	admin.GET("/discharge-stats", h.GetDischargeStats)
+	admin.GET("/incident-categories", h.GetIncidentCategoryStats)
 
 	// Coordinator Dashboard
 	coordinator := dashboard.Group("/coordinator")
@@ -50,6 +55,45 @@ func (h *DashboardHandler) SetupDashboardRoutes(router *gin.Engine) {
 	coordinator.GET("/incidents", h.GetCoordinatorIncidents)
 }
 
+// @Summary Get experimental feature toggles
+// @Description Report which experimental features are enabled through feature flags
+// @Tags Dashboard
+// @Produce json
+// @Success 200 {object} resp.SuccessResponse[FeaturesResponse]
+// @Failure 401 {object} resp.ErrorResponse
+// @Router /dashboard/features [get]
+func (h *DashboardHandler) GetFeatures(ctx *gin.Context) {
+	features := h.dashboardService.GetFeatures(ctx)
+	ctx.JSON(http.StatusOK, resp.Success(features, "Features retrieved successfully"))
+}
+
+// @Summary Get the admin dashboard
+// @Description Load overview, critical alerts, pipeline, care types, location capacity and evaluation stats in one call.
+// @Description Sections that fail to load are null and flagged in errors; the call only fails when every section does.
+// @Tags Dashboard
+// @Produce json
+// @Param limit query int false "Number of locations in the capacity section" default(4)
+// @Param sort query string false "Capacity sort order" Enums(occupancy_desc, occupancy_asc, name) default(occupancy_desc)
+// @Success 200 {object} resp.SuccessResponse[DashboardResponse]
+// @Failure 400 {object} resp.ErrorResponse
+// @Failure 401 {object} resp.ErrorResponse
+// @Failure 500 {object} resp.ErrorResponse
+// @Router /dashboard [get]
+func (h *DashboardHandler) GetDashboard(ctx *gin.Context) {
+	var req LocationCapacityRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, resp.Error(err))
+		return
+	}
+
+	dashboard, err := h.dashboardService.GetDashboard(ctx, &req)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, resp.Error(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp.Success(dashboard, "Dashboard retrieved successfully"))
+}
+
 // @Summary Get dashboard overview stats
 // @Description Get overview statistics for the admin dashboard
 // @Tags Dashboard
@@ -73,15 +117,39 @@ func (h *DashboardHandler) GetOverviewStats(ctx *gin.Context) {
 }
 
 // @Summary Get critical alerts
-// @Description Get critical alerts for the admin dashboard
+// @Description Get critical alerts for the admin dashboard. Use scope=own to limit alerts to the caller's own caseload.
+// @Description Callers without the dashboard:read permission always get their own caseload.
 // @Tags Dashboard
 // @Produce json
+// @Param scope query string false "Alert scope" Enums(all, own) default(all)
 // @Success 200 {object} resp.SuccessResponse[CriticalAlertsResponse]
+// @Failure 400 {object} resp.ErrorResponse
 // @Failure 401 {object} resp.ErrorResponse
 // @Failure 500 {object} resp.ErrorResponse
 // @Router /dashboard/critical-alerts [get]
 func (h *DashboardHandler) GetCriticalAlerts(ctx *gin.Context) {
-	alerts, err := h.dashboardService.GetCriticalAlerts(ctx)
+	var req CriticalAlertsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, resp.Error(err))
+		return
+	}
+
+	if !ctx.GetBool(middleware.PermissionKey("dashboard", "read")) {
+		req.Scope = "own"
+	}
+
+	var coordinatorID *string
+	if req.Scope == "own" {
+		employeeID, exists := ctx.Get(middleware.EmployeeIDKey)
+		if !exists {
+			ctx.JSON(http.StatusUnauthorized, resp.Error(ErrInternal))
+			return
+		}
+		id := employeeID.(string)
+		coordinatorID = &id
+	}
+
+	alerts, err := h.dashboardService.GetCriticalAlerts(ctx, coordinatorID)
 	if err != nil {
 		switch err {
 		case ErrInternal:
@@ -95,17 +163,28 @@ func (h *DashboardHandler) GetCriticalAlerts(ctx *gin.Context) {
 }
 
 // @Summary Get pipeline stats
-// @Description Get pipeline statistics showing client journey through the care system
+// @Description Get pipeline statistics showing client journey through the care system. With from and/or to, forms only count when created in the range and clients when their record was last updated in it.
 // @Tags Dashboard
 // @Produce json
+// @Param from query string false "Start of the range, inclusive (YYYY-MM-DD)"
+// @Param to query string false "End of the range, inclusive (YYYY-MM-DD)"
 // @Success 200 {object} resp.SuccessResponse[PipelineStatsResponse]
+// @Failure 400 {object} resp.ErrorResponse
 // @Failure 401 {object} resp.ErrorResponse
 // @Failure 500 {object} resp.ErrorResponse
 // @Router /dashboard/pipeline-stats [get]
 func (h *DashboardHandler) GetPipelineStats(ctx *gin.Context) {
-	stats, err := h.dashboardService.GetPipelineStats(ctx)
+	var req PipelineStatsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, resp.Error(err))
+		return
+	}
+
+	stats, err := h.dashboardService.GetPipelineStats(ctx, &req)
 	if err != nil {
 		switch err {
+		case ErrInvalidDateRange:
+			ctx.JSON(http.StatusBadRequest, resp.Error(err))
 		case ErrInternal:
 			ctx.JSON(http.StatusInternalServerError, resp.Error(err))
 		default:
@@ -138,6 +217,36 @@ func (h *DashboardHandler) GetCareTypeDistribution(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, resp.Success(distribution, "Care type distribution retrieved successfully"))
 }
 
+// @Summary Get client age distribution
+// @Description Get distribution of in-care clients by age band. Bands are given by their lower bounds in ascending order; the highest band is open-ended.
+// @Tags Dashboard
+// @Produce json
+// @Param bands query []int false "Lower bound of each age band (default: 18, 26, 41, 65)" collectionFormat(multi)
+// @Success 200 {object} resp.SuccessResponse[ClientAgeDistributionResponse]
+// @Failure 400 {object} resp.ErrorResponse
+// @Failure 401 {object} resp.ErrorResponse
+// @Failure 500 {object} resp.ErrorResponse
+// @Router /dashboard/age-distribution [get]
+func (h *DashboardHandler) GetClientAgeDistribution(ctx *gin.Context) {
+	var req ClientAgeDistributionRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, resp.Error(err))
+		return
+	}
+
+	distribution, err := h.dashboardService.GetClientAgeDistribution(ctx, &req)
+	if err != nil {
+		switch err {
+		case ErrInvalidAgeBands:
+			ctx.JSON(http.StatusBadRequest, resp.Error(err))
+		default:
+			ctx.JSON(http.StatusInternalServerError, resp.Error(err))
+		}
+		return
+	}
+	ctx.JSON(http.StatusOK, resp.Success(distribution, "Client age distribution retrieved successfully"))
+}
+
 // @Summary Get location capacity
 // @Description Get location capacity statistics with optional limit and sorting
 // @Tags Dashboard
@@ -241,6 +350,23 @@ func (h *DashboardHandler) GetDischargeStats(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, resp.Success(stats, "Discharge stats retrieved successfully"))
 }
 
+// @Summary Get incident stats by category
+// @Description Count non-deleted incidents per category, most frequent first
+// @Tags Dashboard
+// @Produce json
+// @Success 200 {object} resp.SuccessResponse[IncidentCategoryStatsResponse]
+// @Failure 401 {object} resp.ErrorResponse
+// @Failure 500 {object} resp.ErrorResponse
+// @Router /dashboard/incident-categories [get]
+func (h *DashboardHandler) GetIncidentCategoryStats(ctx *gin.Context) {
+	stats, err := h.dashboardService.GetIncidentCategoryStats(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, resp.Error(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp.Success(stats, "Incident category stats retrieved successfully"))
+}
+
 // Coordinator Dashboard Handlers
 
 // @Summary Get coordinator urgent alerts
@@ -0,0 +1,110 @@
+package dashboard_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"care-cordination/features/dashboard"
+	"care-cordination/internal/mocks"
+	"care-cordination/lib/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// ============================================================
+// Test Helpers
+// ============================================================
+
+// setupHandlerTest simulates an authenticated user; canReadDashboard stands in
+// for the dashboard:read permission LoadPermission would have looked up.
+func setupHandlerTest(t *testing.T, canReadDashboard bool) (*gin.Engine, *mocks.MockDashboardService) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	mockService := mocks.NewMockDashboardService(ctrl)
+
+	handler := dashboard.NewDashboardHandler(mockService, nil)
+
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set("user_id", "test-user-id")
+		c.Set("employee_id", "test-employee-id")
+		c.Set(middleware.PermissionKey("dashboard", "read"), canReadDashboard)
+		c.Next()
+	})
+	router.GET("/dashboard/critical-alerts", handler.GetCriticalAlerts)
+
+	return router, mockService
+}
+
+// ============================================================
+// Test: GetCriticalAlerts
+// ============================================================
+
+func TestGetCriticalAlertsHandler(t *testing.T) {
+	employeeID := "test-employee-id"
+	ownCaseload := gomock.Eq(&employeeID)
+
+	tests := []struct {
+		name             string
+		canReadDashboard bool
+		query            string
+		wantCoordinator  gomock.Matcher
+		expectedStatus   int
+	}{
+		{
+			name:             "admin_all",
+			canReadDashboard: true,
+			query:            "",
+			wantCoordinator:  gomock.Nil(),
+			expectedStatus:   http.StatusOK,
+		},
+		{
+			name:             "admin_own",
+			canReadDashboard: true,
+			query:            "?scope=own",
+			wantCoordinator:  ownCaseload,
+			expectedStatus:   http.StatusOK,
+		},
+		{
+			name:             "coordinator_own",
+			canReadDashboard: false,
+			query:            "?scope=own",
+			wantCoordinator:  ownCaseload,
+			expectedStatus:   http.StatusOK,
+		},
+		{
+			// A coordinator asking for every alert still only gets their caseload
+			name:             "coordinator_all_is_narrowed",
+			canReadDashboard: false,
+			query:            "?scope=all",
+			wantCoordinator:  ownCaseload,
+			expectedStatus:   http.StatusOK,
+		},
+		{
+			name:             "invalid_scope",
+			canReadDashboard: false,
+			query:            "?scope=everyone",
+			expectedStatus:   http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService := setupHandlerTest(t, tt.canReadDashboard)
+			if tt.wantCoordinator != nil {
+				mockService.EXPECT().
+					GetCriticalAlerts(gomock.Any(), tt.wantCoordinator).
+					Return(&dashboard.CriticalAlertsResponse{Alerts: []dashboard.AlertItem{}}, nil)
+			}
+
+			req, _ := http.NewRequest(http.MethodGet, "/dashboard/critical-alerts"+tt.query, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
@@ -4,15 +4,24 @@ import "context"
 
 //go:generate mockgen -destination=../../internal/mocks/mock_dashboard_service.go -package=mocks care-cordination/features/dashboard DashboardService
 type DashboardService interface {
+	// GetFeatures reports which experimental features are switched on, so the frontend can show them
+	GetFeatures(ctx context.Context) *FeaturesResponse
 	// Admin Dashboard
 	GetOverviewStats(ctx context.Context) (*OverviewResponse, error)
-	GetCriticalAlerts(ctx context.Context) (*CriticalAlertsResponse, error)
-	GetPipelineStats(ctx context.Context) (*PipelineStatsResponse, error)
+	// GetCriticalAlerts returns org-wide alerts, or only the given coordinator's caseload when coordinatorID is set
+	GetCriticalAlerts(ctx context.Context, coordinatorID *string) (*CriticalAlertsResponse, error)
+	// GetPipelineStats counts records in each pipeline stage, scoped to req's date range when one is set
+	GetPipelineStats(ctx context.Context, req *PipelineStatsRequest) (*PipelineStatsResponse, error)
 	GetCareTypeDistribution(ctx context.Context) (*CareTypeDistributionResponse, error)
+	GetClientAgeDistribution(ctx context.Context, req *ClientAgeDistributionRequest) (*ClientAgeDistributionResponse, error)
 	GetLocationCapacity(ctx context.Context, req *LocationCapacityRequest) (*LocationCapacityResponse, error)
 	GetTodayAppointments(ctx context.Context, employeeID string) (*TodayAppointmentsResponse, error)
 	GetEvaluationStats(ctx context.Context) (*EvaluationStatsResponse, error)
 	GetDischargeStats(ctx context.Context) (*DischargeStatsResponse, error)
+	GetIncidentCategoryStats(ctx context.Context) (*IncidentCategoryStatsResponse, error)
+	// GetDashboard loads the admin dashboard sections concurrently. A failing section is
+	// flagged in Errors; only when every section fails is an error returned.
+	GetDashboard(ctx context.Context, capacity *LocationCapacityRequest) (*DashboardResponse, error)
 	// Coordinator Dashboard
 	GetCoordinatorUrgentAlerts(ctx context.Context, employeeID string) (*CoordinatorUrgentAlertsResponse, error)
 	GetCoordinatorTodaySchedule(ctx context.Context, employeeID string) (*CoordinatorTodayScheduleResponse, error)
@@ -1,30 +1,58 @@
 package dashboard
 
 import (
+	"care-cordination/features/metadata"
 	db "care-cordination/lib/db/sqlc"
+	"care-cordination/lib/featureflags"
 	"care-cordination/lib/logger"
+	"care-cordination/lib/urgency"
 	"care-cordination/lib/util"
 	"context"
 	"fmt"
 	"math"
+	"slices"
 	"strings"
 	"time"
 
+	"github.com/jackc/pgx/v5/pgtype"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 )
 
 type dashboardService struct {
 	db     db.StoreInterface
 	logger logger.Logger
+	// careEndingSoonDays is how far ahead a care end date raises the care-end alert
+	careEndingSoonDays int
+	// evaluationUrgency decides when an evaluation counts as due soon
+	evaluationUrgency urgency.Thresholds
+	// capacityWarningPercent is the occupancy percentage at which a location is flagged
+	capacityWarningPercent int
+	flags                  featureflags.FeatureFlags
 }
 
 func NewDashboardService(
 	db db.StoreInterface,
 	logger logger.Logger,
+	careEndingSoonDays int,
+	evaluationUrgency urgency.Thresholds,
+	capacityWarningPercent int,
+	flags featureflags.FeatureFlags,
 ) DashboardService {
 	return &dashboardService{
-		db:     db,
-		logger: logger,
+		db:                     db,
+		logger:                 logger,
+		careEndingSoonDays:     careEndingSoonDays,
+		evaluationUrgency:      evaluationUrgency,
+		capacityWarningPercent: capacityWarningPercent,
+		flags:                  flags,
+	}
+}
+
+func (s *dashboardService) GetFeatures(ctx context.Context) *FeaturesResponse {
+	return &FeaturesResponse{
+		DigestNotifications: s.flags.IsEnabled(ctx, featureflags.DigestNotifications),
+		GeoPlacement:        s.flags.IsEnabled(ctx, featureflags.GeoPlacement),
 	}
 }
 
@@ -45,8 +73,12 @@ func (s *dashboardService) GetOverviewStats(ctx context.Context) (*OverviewRespo
 	}, nil
 }
 
-func (s *dashboardService) GetCriticalAlerts(ctx context.Context) (*CriticalAlertsResponse, error) {
-	data, err := s.db.GetCriticalAlertsData(ctx)
+func (s *dashboardService) GetCriticalAlerts(ctx context.Context, coordinatorID *string) (*CriticalAlertsResponse, error) {
+	data, err := s.db.GetCriticalAlertsData(ctx, db.GetCriticalAlertsDataParams{
+		Today:              util.Today(),
+		CoordinatorID:      coordinatorID,
+		CareEndingSoonDays: int32(s.careEndingSoonDays),
+	})
 	if err != nil {
 		s.logger.Error(ctx, "GetCriticalAlerts", "Failed to get critical alerts data", zap.Error(err))
 		return nil, ErrInternal
@@ -72,7 +104,7 @@ func (s *dashboardService) GetCriticalAlerts(ctx context.Context) (*CriticalAler
 		alerts = append(alerts, AlertItem{
 			ID:          "alert-care-end",
 			Type:        AlertTypeCareEnd,
-			Title:       fmt.Sprintf("%d zorgtrajecten eindigen binnen 30 dagen", data.CareEndingSoon),
+			Title:       fmt.Sprintf("%d zorgtrajecten eindigen binnen %d dagen", data.CareEndingSoon, s.careEndingSoonDays),
 			Description: "Herindicatie nodig",
 			Severity:    AlertSeverityWarning,
 			Count:       int(data.CareEndingSoon),
@@ -99,6 +131,19 @@ func (s *dashboardService) GetCriticalAlerts(ctx context.Context) (*CriticalAler
 		})
 	}
 
+	// Incidents escalated for staying unresolved past their SLA - critical severity
+	if data.EscalatedIncidents > 0 {
+		alerts = append(alerts, AlertItem{
+			ID:          "alert-incident-escalated",
+			Type:        AlertTypeIncidentEscalated,
+			Title:       fmt.Sprintf("%d incidenten geëscaleerd", data.EscalatedIncidents),
+			Description: "Onopgelost na de afhandeltermijn",
+			Severity:    AlertSeverityCritical,
+			Count:       int(data.EscalatedIncidents),
+			Link:        "/incidenten",
+		})
+	}
+
 	// High priority waiting list - warning severity
 	if data.HighPriorityWaiting > 0 {
 		alerts = append(alerts, AlertItem{
@@ -147,8 +192,22 @@ func (s *dashboardService) buildIncidentDescription(severe, moderate int) string
 	return parts[0] + ", " + parts[1]
 }
 
-func (s *dashboardService) GetPipelineStats(ctx context.Context) (*PipelineStatsResponse, error) {
-	stats, err := s.db.GetPipelineStats(ctx)
+func (s *dashboardService) GetPipelineStats(
+	ctx context.Context,
+	req *PipelineStatsRequest,
+) (*PipelineStatsResponse, error) {
+	var params db.GetPipelineStatsParams
+	if req.From != nil {
+		params.From = util.StrToPgtypeDate(*req.From)
+	}
+	if req.To != nil {
+		params.To = util.StrToPgtypeDate(*req.To)
+	}
+	if params.From.Valid && params.To.Valid && params.To.Time.Before(params.From.Time) {
+		return nil, ErrInvalidDateRange
+	}
+
+	stats, err := s.db.GetPipelineStats(ctx, params)
 	if err != nil {
 		s.logger.Error(ctx, "GetPipelineStats", "Failed to get pipeline stats", zap.Error(err))
 		return nil, ErrInternal
@@ -186,7 +245,7 @@ func (s *dashboardService) GetCareTypeDistribution(ctx context.Context) (*CareTy
 	if data.ProtectedLiving > 0 {
 		distribution = append(distribution, CareTypeDistributionItem{
 			CareType:   "protected_living",
-			Label:      "Beschermd Wonen",
+			Label:      metadata.CareTypeLabels[db.CareTypeEnumProtectedLiving],
 			Count:      int(data.ProtectedLiving),
 			Percentage: calcPercentage(data.ProtectedLiving),
 		})
@@ -195,7 +254,7 @@ func (s *dashboardService) GetCareTypeDistribution(ctx context.Context) (*CareTy
 	if data.SemiIndependentLiving > 0 {
 		distribution = append(distribution, CareTypeDistributionItem{
 			CareType:   "semi_independent_living",
-			Label:      "Semi-zelfstandig Wonen",
+			Label:      metadata.CareTypeLabels[db.CareTypeEnumSemiIndependentLiving],
 			Count:      int(data.SemiIndependentLiving),
 			Percentage: calcPercentage(data.SemiIndependentLiving),
 		})
@@ -204,7 +263,7 @@ func (s *dashboardService) GetCareTypeDistribution(ctx context.Context) (*CareTy
 	if data.IndependentAssistedLiving > 0 {
 		distribution = append(distribution, CareTypeDistributionItem{
 			CareType:   "independent_assisted_living",
-			Label:      "Begeleid Zelfstandig Wonen",
+			Label:      metadata.CareTypeLabels[db.CareTypeEnumIndependentAssistedLiving],
 			Count:      int(data.IndependentAssistedLiving),
 			Percentage: calcPercentage(data.IndependentAssistedLiving),
 		})
@@ -213,7 +272,7 @@ func (s *dashboardService) GetCareTypeDistribution(ctx context.Context) (*CareTy
 	if data.AmbulatoryCare > 0 {
 		distribution = append(distribution, CareTypeDistributionItem{
 			CareType:   "ambulatory_care",
-			Label:      "Ambulante Zorg",
+			Label:      metadata.CareTypeLabels[db.CareTypeEnumAmbulatoryCare],
 			Count:      int(data.AmbulatoryCare),
 			Percentage: calcPercentage(data.AmbulatoryCare),
 		})
@@ -225,6 +284,85 @@ func (s *dashboardService) GetCareTypeDistribution(ctx context.Context) (*CareTy
 	}, nil
 }
 
+func (s *dashboardService) GetClientAgeDistribution(ctx context.Context, req *ClientAgeDistributionRequest) (*ClientAgeDistributionResponse, error) {
+	bands := DefaultAgeBands
+	if len(req.Bands) > 0 {
+		bands = req.Bands
+	}
+
+	bandStarts := make([]int32, 0, len(bands)+1)
+	// Clients younger than the first band get a band of their own, so the
+	// unknown bucket only holds clients without a date of birth
+	if bands[0] > 0 {
+		bandStarts = append(bandStarts, 0)
+	}
+	for i, start := range bands {
+		if i > 0 && start <= bands[i-1] {
+			return nil, ErrInvalidAgeBands
+		}
+		bandStarts = append(bandStarts, int32(start))
+	}
+
+	rows, err := s.db.GetClientAgeDistribution(ctx, db.GetClientAgeDistributionParams{
+		Today:      util.Today(),
+		BandStarts: bandStarts,
+	})
+	if err != nil {
+		s.logger.Error(ctx, "GetClientAgeDistribution", "Failed to get client age distribution", zap.Error(err))
+		return nil, ErrInternal
+	}
+
+	total := 0
+	for _, row := range rows {
+		total += int(row.ClientCount)
+	}
+
+	calcPercentage := func(count int64) float64 {
+		if total == 0 {
+			return 0
+		}
+		val := float64(count) / float64(total) * 100
+		return math.Round(val*100) / 100
+	}
+
+	distribution := []AgeBandItem{}
+	for _, row := range rows {
+		// Clients without a date of birth are only reported when there are any
+		if row.BandStart == nil {
+			if row.ClientCount > 0 {
+				distribution = append(distribution, AgeBandItem{
+					Label:      "Onbekend",
+					Count:      int(row.ClientCount),
+					Percentage: calcPercentage(row.ClientCount),
+				})
+			}
+			continue
+		}
+
+		minAge := int(*row.BandStart)
+		item := AgeBandItem{
+			Label:      fmt.Sprintf("%d+", minAge),
+			MinAge:     &minAge,
+			Count:      int(row.ClientCount),
+			Percentage: calcPercentage(row.ClientCount),
+		}
+		if next := slices.Index(bands, minAge) + 1; next < len(bands) {
+			maxAge := bands[next] - 1
+			item.MaxAge = &maxAge
+			item.Label = fmt.Sprintf("%d-%d", minAge, maxAge)
+			if next == 0 {
+				item.Label = fmt.Sprintf("<%d", bands[0])
+			}
+		}
+		distribution = append(distribution, item)
+	}
+
+	return &ClientAgeDistributionResponse{
+		Distribution: distribution,
+		Total:        total,
+	}, nil
+}
+
 func (s *dashboardService) GetLocationCapacity(ctx context.Context, req *LocationCapacityRequest) (*LocationCapacityResponse, error) {
 	// Get all locations
 	locations, err := s.db.GetLocationCapacityList(ctx)
@@ -242,6 +380,7 @@ func (s *dashboardService) GetLocationCapacity(ctx context.Context, req *Locatio
 
 	// Convert to DTOs
 	items := make([]LocationCapacityItem, len(locations))
+	atRisk := 0
 	for i, loc := range locations {
 		capacity := int(loc.Capacity)
 		occupied := int(loc.Occupied)
@@ -252,12 +391,17 @@ func (s *dashboardService) GetLocationCapacity(ctx context.Context, req *Locatio
 			percentage = math.Round(val*100) / 100
 		}
 		items[i] = LocationCapacityItem{
-			ID:         loc.ID,
-			Name:       loc.Name,
-			Capacity:   capacity,
-			Occupied:   occupied,
-			Available:  available,
-			Percentage: percentage,
+			ID:           loc.ID,
+			Name:         loc.Name,
+			Capacity:     capacity,
+			Occupied:     occupied,
+			Available:    available,
+			Percentage:   percentage,
+			WaitingCount: int(loc.WaitingCount),
+			Status:       s.locationStatus(capacity, occupied),
+		}
+		if items[i].Status != LocationStatusOK {
+			atRisk++
 		}
 	}
 
@@ -295,10 +439,25 @@ func (s *dashboardService) GetLocationCapacity(ctx context.Context, req *Locatio
 			TotalOccupied:     totalOccupied,
 			TotalAvailable:    totalAvailable,
 			OverallPercentage: overallPercentage,
+			AtRiskLocations:   atRisk,
 		},
 	}, nil
 }
 
+// locationStatus flags a location as full once no beds are left and as
+// warning once occupancy reaches the configured percentage. The comparison is
+// exact, so 89.99% never rounds up into the warning band.
+func (s *dashboardService) locationStatus(capacity, occupied int) string {
+	switch {
+	case occupied >= capacity:
+		return LocationStatusFull
+	case occupied*100 >= capacity*s.capacityWarningPercent:
+		return LocationStatusWarning
+	default:
+		return LocationStatusOK
+	}
+}
+
 func (s *dashboardService) sortLocationItems(items []LocationCapacityItem, sortBy string) {
 	switch sortBy {
 	case "occupancy_desc":
@@ -317,7 +476,12 @@ func (s *dashboardService) sortLocationItems(items []LocationCapacityItem, sortB
 }
 
 func (s *dashboardService) GetTodayAppointments(ctx context.Context, employeeID string) (*TodayAppointmentsResponse, error) {
-	appointments, err := s.db.GetTodayAppointmentsForEmployee(ctx, employeeID)
+	dayStart := util.StartOfDay(time.Now())
+	appointments, err := s.db.GetTodayAppointmentsForEmployee(ctx, db.GetTodayAppointmentsForEmployeeParams{
+		OrganizerID: employeeID,
+		DayStart:    pgtype.Timestamptz{Time: dayStart, Valid: true},
+		DayEnd:      pgtype.Timestamptz{Time: dayStart.AddDate(0, 0, 1), Valid: true},
+	})
 	if err != nil {
 		s.logger.Error(ctx, "GetTodayAppointments", "Failed to get today's appointments", zap.Error(err))
 		return nil, ErrInternal
@@ -335,8 +499,8 @@ func (s *dashboardService) GetTodayAppointments(ctx context.Context, employeeID
 			Title:        apt.Title,
 			ClientID:     apt.ClientID,
 			ClientName:   apt.ClientName,
-			StartTime:    apt.StartTime.Time.Format("15:04"),
-			EndTime:      apt.EndTime.Time.Format("15:04"),
+			StartTime:    util.FormatClock(apt.StartTime.Time),
+			EndTime:      util.FormatClock(apt.EndTime.Time),
 			LocationName: locationName,
 		}
 	}
@@ -348,7 +512,10 @@ func (s *dashboardService) GetTodayAppointments(ctx context.Context, employeeID
 }
 
 func (s *dashboardService) GetEvaluationStats(ctx context.Context) (*EvaluationStatsResponse, error) {
-	stats, err := s.db.GetEvaluationStats(ctx)
+	stats, err := s.db.GetEvaluationStats(ctx, db.GetEvaluationStatsParams{
+		Today:       util.Today(),
+		DueSoonDays: int32(s.evaluationUrgency.DueSoonDays),
+	})
 	if err != nil {
 		s.logger.Error(ctx, "GetEvaluationStats", "Failed to get evaluation stats", zap.Error(err))
 		return nil, ErrInternal
@@ -371,7 +538,7 @@ func (s *dashboardService) GetEvaluationStats(ctx context.Context) (*EvaluationS
 }
 
 func (s *dashboardService) GetDischargeStats(ctx context.Context) (*DischargeStatsResponse, error) {
-	stats, err := s.db.GetDashboardDischargeStats(ctx)
+	stats, err := s.db.GetDashboardDischargeStats(ctx, util.Today())
 	if err != nil {
 		s.logger.Error(ctx, "GetDischargeStats", "Failed to get discharge stats", zap.Error(err))
 		return nil, ErrInternal
@@ -392,11 +559,97 @@ func (s *dashboardService) GetDischargeStats(ctx context.Context) (*DischargeSta
 	}, nil
 }
 
+func (s *dashboardService) GetIncidentCategoryStats(ctx context.Context) (*IncidentCategoryStatsResponse, error) {
+	rows, err := s.db.GetIncidentStatsByCategory(ctx)
+	if err != nil {
+		s.logger.Error(ctx, "GetIncidentCategoryStats", "Failed to get incident stats by category", zap.Error(err))
+		return nil, ErrInternal
+	}
+
+	total := 0
+	for _, row := range rows {
+		total += int(row.IncidentCount)
+	}
+
+	categories := []IncidentCategoryStatsItem{}
+	for _, row := range rows {
+		val := float64(row.IncidentCount) / float64(total) * 100
+		categories = append(categories, IncidentCategoryStatsItem{
+			Category:   string(row.IncidentCategory),
+			Label:      metadata.IncidentCategoryLabels[row.IncidentCategory],
+			Count:      int(row.IncidentCount),
+			Percentage: math.Round(val*100) / 100,
+		})
+	}
+
+	return &IncidentCategoryStatsResponse{
+		Categories: categories,
+		Total:      total,
+	}, nil
+}
+
+func (s *dashboardService) GetDashboard(ctx context.Context, capacity *LocationCapacityRequest) (*DashboardResponse, error) {
+	result := &DashboardResponse{}
+
+	// Each goroutine writes only its own fields, and every section logs its own
+	// failure, so the group never returns an error
+	var g errgroup.Group
+	g.Go(func() error {
+		var err error
+		result.Overview, err = s.GetOverviewStats(ctx)
+		result.Errors.Overview = err != nil
+		return nil
+	})
+	g.Go(func() error {
+		var err error
+		result.CriticalAlerts, err = s.GetCriticalAlerts(ctx, nil)
+		result.Errors.CriticalAlerts = err != nil
+		return nil
+	})
+	g.Go(func() error {
+		var err error
+		result.Pipeline, err = s.GetPipelineStats(ctx, &PipelineStatsRequest{})
+		result.Errors.Pipeline = err != nil
+		return nil
+	})
+	g.Go(func() error {
+		var err error
+		result.CareTypes, err = s.GetCareTypeDistribution(ctx)
+		result.Errors.CareTypes = err != nil
+		return nil
+	})
+	g.Go(func() error {
+		var err error
+		result.Capacity, err = s.GetLocationCapacity(ctx, capacity)
+		result.Errors.Capacity = err != nil
+		return nil
+	})
+	g.Go(func() error {
+		var err error
+		result.Evaluations, err = s.GetEvaluationStats(ctx)
+		result.Errors.Evaluations = err != nil
+		return nil
+	})
+	_ = g.Wait()
+
+	errs := result.Errors
+	if errs.Overview && errs.CriticalAlerts && errs.Pipeline && errs.CareTypes && errs.Capacity && errs.Evaluations {
+		return nil, ErrInternal
+	}
+
+	return result, nil
+}
+
 // Coordinator Dashboard Methods
 
 func (s *dashboardService) GetCoordinatorUrgentAlerts(ctx context.Context, employeeID string) (*CoordinatorUrgentAlertsResponse, error) {
+	today := util.Today()
+
 	// Get counts
-	data, err := s.db.GetCoordinatorUrgentAlertsData(ctx, employeeID)
+	data, err := s.db.GetCoordinatorUrgentAlertsData(ctx, db.GetCoordinatorUrgentAlertsDataParams{
+		CoordinatorID: employeeID,
+		Today:         today,
+	})
 	if err != nil {
 		s.logger.Error(ctx, "GetCoordinatorUrgentAlerts", "Failed to get coordinator urgent alerts data", zap.Error(err))
 		return nil, ErrInternal
@@ -406,7 +659,10 @@ func (s *dashboardService) GetCoordinatorUrgentAlerts(ctx context.Context, emplo
 
 	// Overdue evaluations (critical)
 	if data.OverdueEvaluations > 0 {
-		clients, _ := s.db.GetCoordinatorOverdueEvaluationClients(ctx, employeeID)
+		clients, _ := s.db.GetCoordinatorOverdueEvaluationClients(ctx, db.GetCoordinatorOverdueEvaluationClientsParams{
+			CoordinatorID: employeeID,
+			Today:         today,
+		})
 		clientIDs, description := s.buildClientInfo(clients)
 		alerts = append(alerts, CoordinatorUrgentAlertItem{
 			ID:          "alert-evaluation",
@@ -422,7 +678,10 @@ func (s *dashboardService) GetCoordinatorUrgentAlerts(ctx context.Context, emplo
 
 	// Expiring contracts (warning)
 	if data.ExpiringContracts > 0 {
-		clients, _ := s.db.GetCoordinatorExpiringContractClients(ctx, employeeID)
+		clients, _ := s.db.GetCoordinatorExpiringContractClients(ctx, db.GetCoordinatorExpiringContractClientsParams{
+			CoordinatorID: employeeID,
+			Today:         today,
+		})
 		clientIDs, description := s.buildClientInfo(clients)
 		alerts = append(alerts, CoordinatorUrgentAlertItem{
 			ID:          "alert-contract",
@@ -470,7 +729,10 @@ func (s *dashboardService) GetCoordinatorUrgentAlerts(ctx context.Context, emplo
 
 	// Long waiting clients (warning)
 	if data.LongWaiting > 0 {
-		clients, _ := s.db.GetCoordinatorLongWaitingClients(ctx, employeeID)
+		clients, _ := s.db.GetCoordinatorLongWaitingClients(ctx, db.GetCoordinatorLongWaitingClientsParams{
+			CoordinatorID: employeeID,
+			Today:         today,
+		})
 		clientIDs, description := s.buildClientInfo(clients)
 		alerts = append(alerts, CoordinatorUrgentAlertItem{
 			ID:          "alert-waiting",
@@ -484,6 +746,38 @@ func (s *dashboardService) GetCoordinatorUrgentAlerts(ctx context.Context, emplo
 		})
 	}
 
+	// Transfers awaiting this coordinator's acceptance (warning)
+	if data.PendingTransfers > 0 {
+		clients, _ := s.db.GetCoordinatorPendingTransferClients(ctx, employeeID)
+		clientIDs, description := s.buildClientInfo(clients)
+		alerts = append(alerts, CoordinatorUrgentAlertItem{
+			ID:          "alert-transfer",
+			Type:        CoordinatorAlertTypeTransfer,
+			Title:       "pending_transfers",
+			Description: description,
+			Severity:    CoordinatorAlertSeverityWarning,
+			Count:       int(data.PendingTransfers),
+			ClientIDs:   clientIDs,
+			Link:        "/verplaatsingen",
+		})
+	}
+
+	// High-priority waiting clients (critical)
+	if data.HighPriorityWaiting > 0 {
+		clients, _ := s.db.GetCoordinatorHighPriorityWaitingClients(ctx, employeeID)
+		clientIDs, description := s.buildClientInfo(clients)
+		alerts = append(alerts, CoordinatorUrgentAlertItem{
+			ID:          "alert-high-priority",
+			Type:        CoordinatorAlertTypeHighPriority,
+			Title:       "high_priority_waiting",
+			Description: description,
+			Severity:    CoordinatorAlertSeverityCritical,
+			Count:       int(data.HighPriorityWaiting),
+			ClientIDs:   clientIDs,
+			Link:        "/wachtlijst",
+		})
+	}
+
 	return &CoordinatorUrgentAlertsResponse{Alerts: alerts}, nil
 }
 
@@ -523,6 +817,16 @@ func (s *dashboardService) buildClientInfo(clients any) ([]string, string) {
 			clientIDs = append(clientIDs, c.ID)
 			names = append(names, c.FirstName+" "+c.LastName)
 		}
+	case []db.GetCoordinatorPendingTransferClientsRow:
+		for _, c := range v {
+			clientIDs = append(clientIDs, c.ID)
+			names = append(names, c.FirstName+" "+c.LastName)
+		}
+	case []db.GetCoordinatorHighPriorityWaitingClientsRow:
+		for _, c := range v {
+			clientIDs = append(clientIDs, c.ID)
+			names = append(names, c.FirstName+" "+c.LastName)
+		}
 	}
 
 	description := ""
@@ -538,14 +842,19 @@ func (s *dashboardService) buildClientInfo(clients any) ([]string, string) {
 }
 
 func (s *dashboardService) GetCoordinatorTodaySchedule(ctx context.Context, employeeID string) (*CoordinatorTodayScheduleResponse, error) {
-	appointments, err := s.db.GetCoordinatorTodaySchedule(ctx, employeeID)
+	dayStart := util.StartOfDay(time.Now())
+	appointments, err := s.db.GetCoordinatorTodaySchedule(ctx, db.GetCoordinatorTodayScheduleParams{
+		OrganizerID: employeeID,
+		DayStart:    pgtype.Timestamptz{Time: dayStart, Valid: true},
+		DayEnd:      pgtype.Timestamptz{Time: dayStart.AddDate(0, 0, 1), Valid: true},
+	})
 	if err != nil {
 		s.logger.Error(ctx, "GetCoordinatorTodaySchedule", "Failed to get coordinator today schedule", zap.Error(err))
 		return nil, ErrInternal
 	}
 
 	now := time.Now()
-	today := now.Format("2006-01-02")
+	today := util.FormatDate(now)
 	items := make([]CoordinatorScheduleItem, len(appointments))
 
 	for i, apt := range appointments {
@@ -562,8 +871,8 @@ func (s *dashboardService) GetCoordinatorTodaySchedule(ctx context.Context, empl
 
 		items[i] = CoordinatorScheduleItem{
 			ID:           apt.ID,
-			Time:         apt.StartTime.Time.Format("15:04"),
-			EndTime:      apt.EndTime.Time.Format("15:04"),
+			Time:         util.FormatClock(apt.StartTime.Time),
+			EndTime:      util.FormatClock(apt.EndTime.Time),
 			Type:         string(apt.Type),
 			ClientID:     apt.ClientID,
 			ClientName:   apt.ClientName,
@@ -581,7 +890,10 @@ func (s *dashboardService) GetCoordinatorTodaySchedule(ctx context.Context, empl
 }
 
 func (s *dashboardService) GetCoordinatorStats(ctx context.Context, employeeID string) (*CoordinatorStatsResponse, error) {
-	stats, err := s.db.GetCoordinatorStats(ctx, employeeID)
+	stats, err := s.db.GetCoordinatorStats(ctx, db.GetCoordinatorStatsParams{
+		CoordinatorID: employeeID,
+		Today:         util.Today(),
+	})
 	if err != nil {
 		s.logger.Error(ctx, "GetCoordinatorStats", "Failed to get coordinator stats", zap.Error(err))
 		return nil, ErrInternal
@@ -608,7 +920,7 @@ func (s *dashboardService) GetCoordinatorReminders(ctx context.Context, employee
 			ID:       r.ID,
 			Title:    r.Title,
 			Client:   "",
-			DueDate:  r.DueTime.Time.Format("2006-01-02"),
+			DueDate:  util.FormatDate(r.DueTime.Time),
 			Priority: "medium",
 		}
 	}
@@ -623,16 +935,14 @@ func (s *dashboardService) GetCoordinatorClients(ctx context.Context, employeeID
 		return nil, ErrInternal
 	}
 
-	now := time.Now()
-	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	today := util.StartOfDay(time.Now())
 
 	items := make([]CoordinatorClientItem, len(clients))
 	for i, c := range clients {
 		daysUntilEnd := 0
 		status := "active"
 		if c.CareEndDate.Valid {
-			endDate := c.CareEndDate.Time
-			daysUntilEnd = int(endDate.Sub(today).Hours() / 24)
+			daysUntilEnd = urgency.DaysUntil(c.CareEndDate.Time, today)
 			if daysUntilEnd <= 30 {
 				status = "expiring"
 			}
@@ -665,20 +975,21 @@ func (s *dashboardService) GetCoordinatorClients(ctx context.Context, employeeID
 	return &CoordinatorClientsResponse{Clients: items}, nil
 }
 
+// calculateEvaluationStatus classifies the next evaluation with the same
+// thresholds the worker uses to prioritise evaluation reminders
 func (s *dashboardService) calculateEvaluationStatus(evalDate time.Time, valid bool, today time.Time) string {
 	if !valid {
 		return "upcoming"
 	}
 
-	evalDay := time.Date(evalDate.Year(), evalDate.Month(), evalDate.Day(), 0, 0, 0, 0, evalDate.Location())
-	daysUntil := int(evalDay.Sub(today).Hours() / 24)
-
-	if daysUntil <= 0 {
+	switch s.evaluationUrgency.Classify(urgency.DaysUntil(evalDate, today)) {
+	case urgency.Overdue:
 		return "overdue"
-	} else if daysUntil <= 7 {
+	case urgency.High, urgency.Normal:
 		return "due_soon"
+	default:
+		return "upcoming"
 	}
-	return "upcoming"
 }
 
 func (s *dashboardService) GetCoordinatorGoalsProgress(ctx context.Context, employeeID string) (*CoordinatorGoalsProgressResponse, error) {
@@ -0,0 +1,530 @@
+package dashboard
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	db "care-cordination/lib/db/sqlc"
+	dbmocks "care-cordination/lib/db/sqlc/mocks"
+	flagmocks "care-cordination/lib/featureflags/mocks"
+	loggermocks "care-cordination/lib/logger/mocks"
+	"care-cordination/lib/urgency"
+	"care-cordination/lib/util"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var testEvaluationUrgency = urgency.Thresholds{HighDays: 1, DueSoonDays: 3}
+
+// expectDashboardSections sets up every store call behind GetDashboard; the
+// section named in failing returns a database error instead.
+func expectDashboardSections(mockStore *dbmocks.MockStoreInterface, failing string) {
+	errFor := func(section string) error {
+		if section == failing {
+			return errors.New("connection refused")
+		}
+		return nil
+	}
+
+	mockStore.EXPECT().
+		GetDashboardOverviewStats(gomock.Any()).
+		Return(db.GetDashboardOverviewStatsRow{TotalActiveClients: 12, WaitingListCount: 4}, errFor("overview"))
+	mockStore.EXPECT().
+		GetCriticalAlertsData(gomock.Any(), gomock.Any()).
+		Return(db.GetCriticalAlertsDataRow{}, errFor("criticalAlerts"))
+	mockStore.EXPECT().
+		GetPipelineStats(gomock.Any(), gomock.Any()).
+		Return(db.GetPipelineStatsRow{Registrations: 3, InCare: 12}, errFor("pipeline"))
+	mockStore.EXPECT().
+		GetCareTypeDistribution(gomock.Any()).
+		Return(db.GetCareTypeDistributionRow{ProtectedLiving: 12, Total: 12}, errFor("careTypes"))
+	mockStore.EXPECT().
+		GetLocationCapacityList(gomock.Any()).
+		Return([]db.GetLocationCapacityListRow{{ID: "loc-1", Name: "North", Capacity: 10, Occupied: 8, WaitingCount: 3}}, errFor("capacity"))
+	if failing != "capacity" {
+		mockStore.EXPECT().
+			GetLocationCapacityTotals(gomock.Any()).
+			Return(db.GetLocationCapacityTotalsRow{TotalCapacity: 10, TotalOccupied: 8}, nil)
+	}
+	mockStore.EXPECT().
+		GetEvaluationStats(gomock.Any(), db.GetEvaluationStatsParams{Today: util.Today(), DueSoonDays: 3}).
+		Return(db.GetEvaluationStatsRow{Total: 4, Completed: 2}, errFor("evaluations"))
+}
+
+func TestGetDashboard(t *testing.T) {
+	capacity := &LocationCapacityRequest{Limit: 4, Sort: "occupancy_desc"}
+
+	t.Run("combines_all_sections", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := dbmocks.NewMockStoreInterface(ctrl)
+		mockLogger := loggermocks.NewMockLogger(ctrl)
+		expectDashboardSections(mockStore, "")
+
+		service := NewDashboardService(mockStore, mockLogger, 30, testEvaluationUrgency, 90, flagmocks.NewMockFeatureFlags(ctrl))
+		resp, err := service.GetDashboard(context.Background(), capacity)
+		require.NoError(t, err)
+
+		assert.Equal(t, 12, resp.Overview.TotalActiveClients)
+		assert.NotNil(t, resp.CriticalAlerts)
+		assert.Equal(t, 3, resp.Pipeline.Registrations)
+		assert.Equal(t, 12, resp.CareTypes.Total)
+		require.Len(t, resp.Capacity.Locations, 1)
+		assert.Equal(t, 2, resp.Capacity.Locations[0].Available)
+		assert.Equal(t, 3, resp.Capacity.Locations[0].WaitingCount)
+		assert.Equal(t, 50, resp.Evaluations.CompletionRate)
+		assert.Equal(t, DashboardSectionErrors{}, resp.Errors)
+
+		data, err := json.Marshal(resp)
+		require.NoError(t, err)
+		var keys map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(data, &keys))
+		for _, key := range []string{"overview", "criticalAlerts", "pipeline", "careTypes", "capacity", "evaluations", "errors"} {
+			assert.Contains(t, keys, key)
+		}
+	})
+
+	t.Run("failing_section_is_flagged", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := dbmocks.NewMockStoreInterface(ctrl)
+		mockLogger := loggermocks.NewMockLogger(ctrl)
+		mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+		expectDashboardSections(mockStore, "pipeline")
+
+		service := NewDashboardService(mockStore, mockLogger, 30, testEvaluationUrgency, 90, flagmocks.NewMockFeatureFlags(ctrl))
+		resp, err := service.GetDashboard(context.Background(), capacity)
+		require.NoError(t, err)
+
+		assert.Nil(t, resp.Pipeline)
+		assert.Equal(t, DashboardSectionErrors{Pipeline: true}, resp.Errors)
+		assert.Equal(t, 12, resp.Overview.TotalActiveClients)
+		assert.NotNil(t, resp.Capacity)
+		assert.NotNil(t, resp.Evaluations)
+	})
+
+	t.Run("every_section_failing_is_an_error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := dbmocks.NewMockStoreInterface(ctrl)
+		mockLogger := loggermocks.NewMockLogger(ctrl)
+		mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+		dbErr := errors.New("connection refused")
+		mockStore.EXPECT().GetDashboardOverviewStats(gomock.Any()).Return(db.GetDashboardOverviewStatsRow{}, dbErr)
+		mockStore.EXPECT().GetCriticalAlertsData(gomock.Any(), gomock.Any()).Return(db.GetCriticalAlertsDataRow{}, dbErr)
+		mockStore.EXPECT().GetPipelineStats(gomock.Any(), gomock.Any()).Return(db.GetPipelineStatsRow{}, dbErr)
+		mockStore.EXPECT().GetCareTypeDistribution(gomock.Any()).Return(db.GetCareTypeDistributionRow{}, dbErr)
+		mockStore.EXPECT().GetLocationCapacityList(gomock.Any()).Return(nil, dbErr)
+		mockStore.EXPECT().GetEvaluationStats(gomock.Any(), db.GetEvaluationStatsParams{Today: util.Today(), DueSoonDays: 3}).Return(db.GetEvaluationStatsRow{}, dbErr)
+
+		service := NewDashboardService(mockStore, mockLogger, 30, testEvaluationUrgency, 90, flagmocks.NewMockFeatureFlags(ctrl))
+		_, err := service.GetDashboard(context.Background(), capacity)
+		require.ErrorIs(t, err, ErrInternal)
+	})
+}
+
+func TestGetOverviewStats(t *testing.T) {
+	t.Run("maps_every_count", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := dbmocks.NewMockStoreInterface(ctrl)
+		mockStore.EXPECT().
+			GetDashboardOverviewStats(gomock.Any()).
+			Return(db.GetDashboardOverviewStatsRow{
+				TotalActiveClients:   12,
+				WaitingListCount:     4,
+				PendingRegistrations: 3,
+				TotalCoordinators:    2,
+				TotalEmployees:       9,
+				OpenIncidents:        1,
+			}, nil)
+
+		service := NewDashboardService(mockStore, loggermocks.NewMockLogger(ctrl), 30, testEvaluationUrgency, 90, flagmocks.NewMockFeatureFlags(ctrl))
+		resp, err := service.GetOverviewStats(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, &OverviewResponse{
+			TotalActiveClients:   12,
+			WaitingListCount:     4,
+			PendingRegistrations: 3,
+			TotalCoordinators:    2,
+			TotalEmployees:       9,
+			OpenIncidents:        1,
+		}, resp)
+	})
+
+	t.Run("db_error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := dbmocks.NewMockStoreInterface(ctrl)
+		mockLogger := loggermocks.NewMockLogger(ctrl)
+		mockLogger.EXPECT().Error(gomock.Any(), "GetOverviewStats", gomock.Any(), gomock.Any()).Times(1)
+		mockStore.EXPECT().
+			GetDashboardOverviewStats(gomock.Any()).
+			Return(db.GetDashboardOverviewStatsRow{}, errors.New("connection refused"))
+
+		service := NewDashboardService(mockStore, mockLogger, 30, testEvaluationUrgency, 90, flagmocks.NewMockFeatureFlags(ctrl))
+		resp, err := service.GetOverviewStats(context.Background())
+		require.ErrorIs(t, err, ErrInternal)
+		assert.Nil(t, resp)
+	})
+}
+
+func TestGetPipelineStats_DateRange(t *testing.T) {
+	from, to := "2026-01-05", "2026-01-11"
+
+	t.Run("range_passed_to_query", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := dbmocks.NewMockStoreInterface(ctrl)
+		mockStore.EXPECT().
+			GetPipelineStats(gomock.Any(), db.GetPipelineStatsParams{
+				From: pgtype.Date{Time: time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC), Valid: true},
+				To:   pgtype.Date{Time: time.Date(2026, 1, 11, 0, 0, 0, 0, time.UTC), Valid: true},
+			}).
+			Return(db.GetPipelineStatsRow{Registrations: 4, InCare: 2}, nil)
+
+		service := NewDashboardService(mockStore, loggermocks.NewMockLogger(ctrl), 30, testEvaluationUrgency, 90, flagmocks.NewMockFeatureFlags(ctrl))
+		resp, err := service.GetPipelineStats(context.Background(), &PipelineStatsRequest{From: &from, To: &to})
+		require.NoError(t, err)
+		assert.Equal(t, 4, resp.Registrations)
+		assert.Equal(t, 2, resp.InCare)
+	})
+
+	t.Run("from_after_to", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		service := NewDashboardService(dbmocks.NewMockStoreInterface(ctrl), loggermocks.NewMockLogger(ctrl), 30, testEvaluationUrgency, 90, flagmocks.NewMockFeatureFlags(ctrl))
+		_, err := service.GetPipelineStats(context.Background(), &PipelineStatsRequest{From: &to, To: &from})
+		assert.ErrorIs(t, err, ErrInvalidDateRange)
+	})
+}
+
+func TestGetCriticalAlerts_EscalatedIncidents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := dbmocks.NewMockStoreInterface(ctrl)
+	mockStore.EXPECT().
+		GetCriticalAlertsData(gomock.Any(), gomock.Any()).
+		Return(db.GetCriticalAlertsDataRow{OpenIncidents: 3, SevereIncidents: 1, EscalatedIncidents: 2}, nil)
+
+	service := NewDashboardService(mockStore, loggermocks.NewMockLogger(ctrl), 30, testEvaluationUrgency, 90, flagmocks.NewMockFeatureFlags(ctrl))
+	resp, err := service.GetCriticalAlerts(context.Background(), nil)
+	require.NoError(t, err)
+
+	require.Len(t, resp.Alerts, 2)
+	assert.Equal(t, AlertTypeIncident, resp.Alerts[0].Type)
+	escalated := resp.Alerts[1]
+	assert.Equal(t, AlertTypeIncidentEscalated, escalated.Type)
+	assert.Equal(t, AlertSeverityCritical, escalated.Severity)
+	assert.Equal(t, 2, escalated.Count)
+}
+
+func TestGetIncidentCategoryStats(t *testing.T) {
+	t.Run("counts_and_percentages_per_category", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := dbmocks.NewMockStoreInterface(ctrl)
+		mockStore.EXPECT().
+			GetIncidentStatsByCategory(gomock.Any()).
+			Return([]db.GetIncidentStatsByCategoryRow{
+				{IncidentCategory: db.IncidentCategoryEnumAggression, IncidentCount: 2},
+				{IncidentCategory: db.IncidentCategoryEnumFall, IncidentCount: 1},
+			}, nil)
+
+		service := NewDashboardService(mockStore, loggermocks.NewMockLogger(ctrl), 30, testEvaluationUrgency, 90, flagmocks.NewMockFeatureFlags(ctrl))
+		resp, err := service.GetIncidentCategoryStats(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, 3, resp.Total)
+		assert.Equal(t, []IncidentCategoryStatsItem{
+			{Category: "aggression", Label: "Agressie", Count: 2, Percentage: 66.67},
+			{Category: "fall", Label: "Val", Count: 1, Percentage: 33.33},
+		}, resp.Categories)
+	})
+
+	t.Run("no_incidents", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := dbmocks.NewMockStoreInterface(ctrl)
+		mockStore.EXPECT().GetIncidentStatsByCategory(gomock.Any()).Return([]db.GetIncidentStatsByCategoryRow{}, nil)
+
+		service := NewDashboardService(mockStore, loggermocks.NewMockLogger(ctrl), 30, testEvaluationUrgency, 90, flagmocks.NewMockFeatureFlags(ctrl))
+		resp, err := service.GetIncidentCategoryStats(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, 0, resp.Total)
+		assert.Empty(t, resp.Categories)
+	})
+
+	t.Run("db_error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := dbmocks.NewMockStoreInterface(ctrl)
+		mockLogger := loggermocks.NewMockLogger(ctrl)
+		mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+		mockStore.EXPECT().GetIncidentStatsByCategory(gomock.Any()).Return(nil, errors.New("connection refused"))
+
+		service := NewDashboardService(mockStore, mockLogger, 30, testEvaluationUrgency, 90, flagmocks.NewMockFeatureFlags(ctrl))
+		_, err := service.GetIncidentCategoryStats(context.Background())
+		require.ErrorIs(t, err, ErrInternal)
+	})
+}
+
+func TestGetLocationCapacity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := dbmocks.NewMockStoreInterface(ctrl)
+	mockStore.EXPECT().
+		GetLocationCapacityList(gomock.Any()).
+		Return([]db.GetLocationCapacityListRow{
+			{ID: "loc-half", Name: "Half", Capacity: 10, Occupied: 5},
+			{ID: "loc-warning", Name: "Warning", Capacity: 20, Occupied: 19},
+			{ID: "loc-full", Name: "Full", Capacity: 10, Occupied: 10},
+			{ID: "loc-below", Name: "Below", Capacity: 100, Occupied: 89},
+		}, nil)
+	mockStore.EXPECT().
+		GetLocationCapacityTotals(gomock.Any()).
+		Return(db.GetLocationCapacityTotalsRow{TotalCapacity: 140, TotalOccupied: 123}, nil)
+
+	service := NewDashboardService(mockStore, loggermocks.NewMockLogger(ctrl), 30, testEvaluationUrgency, 90, flagmocks.NewMockFeatureFlags(ctrl))
+	resp, err := service.GetLocationCapacity(context.Background(), &LocationCapacityRequest{Limit: 4, Sort: "name"})
+	require.NoError(t, err)
+
+	statuses := map[string]string{}
+	for _, loc := range resp.Locations {
+		statuses[loc.ID] = loc.Status
+	}
+	assert.Equal(t, map[string]string{
+		"loc-half":    LocationStatusOK,
+		"loc-warning": LocationStatusWarning, // 95%
+		"loc-full":    LocationStatusFull,    // 100%
+		"loc-below":   LocationStatusOK,      // 89%
+	}, statuses)
+	assert.Equal(t, 2, resp.Totals.AtRiskLocations)
+
+	t.Run("at_risk_counts_locations_beyond_the_limit", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := dbmocks.NewMockStoreInterface(ctrl)
+		mockStore.EXPECT().
+			GetLocationCapacityList(gomock.Any()).
+			Return([]db.GetLocationCapacityListRow{
+				{ID: "loc-over", Name: "Over", Capacity: 10, Occupied: 11},
+				{ID: "loc-warning", Name: "Warning", Capacity: 20, Occupied: 19},
+				{ID: "loc-empty", Name: "Empty", Capacity: 10, Occupied: 0},
+			}, nil)
+		mockStore.EXPECT().
+			GetLocationCapacityTotals(gomock.Any()).
+			Return(db.GetLocationCapacityTotalsRow{TotalCapacity: 40, TotalOccupied: 30}, nil)
+
+		service := NewDashboardService(mockStore, loggermocks.NewMockLogger(ctrl), 30, testEvaluationUrgency, 90, flagmocks.NewMockFeatureFlags(ctrl))
+		resp, err := service.GetLocationCapacity(context.Background(), &LocationCapacityRequest{Limit: 1, Sort: "occupancy_desc"})
+		require.NoError(t, err)
+
+		require.Len(t, resp.Locations, 1)
+		assert.Equal(t, LocationStatusFull, resp.Locations[0].Status)
+		assert.Equal(t, 2, resp.Totals.AtRiskLocations)
+	})
+}
+
+func TestGetCoordinatorUrgentAlerts(t *testing.T) {
+	const employeeID = "emp-1"
+
+	tests := []struct {
+		name      string
+		setup     func(mockStore *dbmocks.MockStoreInterface)
+		wantErr   error
+		checkResp func(t *testing.T, resp *CoordinatorUrgentAlertsResponse)
+	}{
+		{
+			name: "maps_counts_per_alert",
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				// Every query is keyed on the coordinator's own employee ID
+				mockStore.EXPECT().
+					GetCoordinatorUrgentAlertsData(gomock.Any(), db.GetCoordinatorUrgentAlertsDataParams{CoordinatorID: employeeID, Today: util.Today()}).
+					Return(db.GetCoordinatorUrgentAlertsDataRow{
+						OverdueEvaluations:  3,
+						UnresolvedIncidents: 1,
+						PendingTransfers:    2,
+						HighPriorityWaiting: 4,
+					}, nil)
+				mockStore.EXPECT().
+					GetCoordinatorOverdueEvaluationClients(gomock.Any(), db.GetCoordinatorOverdueEvaluationClientsParams{CoordinatorID: employeeID, Today: util.Today()}).
+					Return([]db.GetCoordinatorOverdueEvaluationClientsRow{
+						{ID: "c-1", FirstName: "Jan", LastName: "Jansen"},
+					}, nil)
+				mockStore.EXPECT().
+					GetCoordinatorUnresolvedIncidentClients(gomock.Any(), employeeID).
+					Return([]db.GetCoordinatorUnresolvedIncidentClientsRow{
+						{ID: "c-2", FirstName: "Piet", LastName: "de Vries"},
+					}, nil)
+				mockStore.EXPECT().
+					GetCoordinatorPendingTransferClients(gomock.Any(), employeeID).
+					Return([]db.GetCoordinatorPendingTransferClientsRow{
+						{ID: "c-3", FirstName: "Anna", LastName: "Bakker"},
+						{ID: "c-4", FirstName: "Eva", LastName: "Smit"},
+					}, nil)
+				mockStore.EXPECT().
+					GetCoordinatorHighPriorityWaitingClients(gomock.Any(), employeeID).
+					Return([]db.GetCoordinatorHighPriorityWaitingClientsRow{
+						{ID: "c-5", FirstName: "Sara", LastName: "Visser"},
+						{ID: "c-6", FirstName: "Tom", LastName: "Mulder"},
+						{ID: "c-7", FirstName: "Lisa", LastName: "Bos"},
+						{ID: "c-8", FirstName: "Noah", LastName: "Peters"},
+					}, nil)
+			},
+			checkResp: func(t *testing.T, resp *CoordinatorUrgentAlertsResponse) {
+				require.Len(t, resp.Alerts, 4)
+
+				byType := map[CoordinatorAlertType]CoordinatorUrgentAlertItem{}
+				for _, alert := range resp.Alerts {
+					byType[alert.Type] = alert
+				}
+
+				evaluation := byType[CoordinatorAlertTypeEvaluation]
+				assert.Equal(t, 3, evaluation.Count)
+				assert.Equal(t, CoordinatorAlertSeverityCritical, evaluation.Severity)
+				assert.Equal(t, []string{"c-1"}, evaluation.ClientIDs)
+
+				incident := byType[CoordinatorAlertTypeIncident]
+				assert.Equal(t, 1, incident.Count)
+				assert.Equal(t, "Piet de Vries", incident.Description)
+
+				transfer := byType[CoordinatorAlertTypeTransfer]
+				assert.Equal(t, 2, transfer.Count)
+				assert.Equal(t, []string{"c-3", "c-4"}, transfer.ClientIDs)
+				assert.Equal(t, "Anna Bakker, Eva Smit", transfer.Description)
+
+				highPriority := byType[CoordinatorAlertTypeHighPriority]
+				assert.Equal(t, 4, highPriority.Count)
+				assert.Equal(t, CoordinatorAlertSeverityCritical, highPriority.Severity)
+				assert.Equal(t, "Sara Visser, Tom Mulder, Lisa Bos +1", highPriority.Description)
+
+				assert.NotContains(t, byType, CoordinatorAlertTypeContract)
+				assert.NotContains(t, byType, CoordinatorAlertTypeDraft)
+				assert.NotContains(t, byType, CoordinatorAlertTypeWaitingLong)
+			},
+		},
+		{
+			name: "no_alerts",
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					GetCoordinatorUrgentAlertsData(gomock.Any(), db.GetCoordinatorUrgentAlertsDataParams{CoordinatorID: employeeID, Today: util.Today()}).
+					Return(db.GetCoordinatorUrgentAlertsDataRow{}, nil)
+			},
+			checkResp: func(t *testing.T, resp *CoordinatorUrgentAlertsResponse) {
+				assert.NotNil(t, resp.Alerts)
+				assert.Empty(t, resp.Alerts)
+			},
+		},
+		{
+			name: "db_error",
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					GetCoordinatorUrgentAlertsData(gomock.Any(), db.GetCoordinatorUrgentAlertsDataParams{CoordinatorID: employeeID, Today: util.Today()}).
+					Return(db.GetCoordinatorUrgentAlertsDataRow{}, errors.New("connection refused"))
+			},
+			wantErr: ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := dbmocks.NewMockStoreInterface(ctrl)
+			mockLogger := loggermocks.NewMockLogger(ctrl)
+			mockFlags := flagmocks.NewMockFeatureFlags(ctrl)
+
+			mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+			tt.setup(mockStore)
+
+			service := NewDashboardService(mockStore, mockLogger, 30, testEvaluationUrgency, 90, mockFlags)
+
+			resp, err := service.GetCoordinatorUrgentAlerts(context.Background(), employeeID)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			tt.checkResp(t, resp)
+		})
+	}
+}
+
+func TestCalculateEvaluationStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	service := NewDashboardService(
+		dbmocks.NewMockStoreInterface(ctrl),
+		loggermocks.NewMockLogger(ctrl),
+		30,
+		urgency.Thresholds{HighDays: 1, DueSoonDays: 5},
+		90,
+		flagmocks.NewMockFeatureFlags(ctrl),
+	).(*dashboardService)
+	today := time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		daysUntil int
+		want      string
+	}{
+		{daysUntil: -1, want: "overdue"},
+		// Due today is not overdue yet, matching the evaluation stats query
+		{daysUntil: 0, want: "due_soon"},
+		{daysUntil: 5, want: "due_soon"},
+		{daysUntil: 6, want: "upcoming"},
+	}
+
+	for _, tt := range tests {
+		got := service.calculateEvaluationStatus(today.AddDate(0, 0, tt.daysUntil), true, today)
+		assert.Equal(t, tt.want, got, "daysUntil=%d", tt.daysUntil)
+	}
+	assert.Equal(t, "upcoming", service.calculateEvaluationStatus(time.Time{}, false, today))
+}
+
+func TestGetClientAgeDistribution(t *testing.T) {
+	ptr := func(v int32) *int32 { return &v }
+
+	t.Run("younger_clients_get_their_own_band", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := dbmocks.NewMockStoreInterface(ctrl)
+		mockStore.EXPECT().
+			GetClientAgeDistribution(gomock.Any(), db.GetClientAgeDistributionParams{Today: util.Today(), BandStarts: []int32{0, 18, 65}}).
+			Return([]db.GetClientAgeDistributionRow{
+				{BandStart: ptr(0), ClientCount: 1},
+				{BandStart: ptr(18), ClientCount: 2},
+				{BandStart: ptr(65), ClientCount: 0},
+				{BandStart: nil, ClientCount: 1},
+			}, nil)
+
+		service := NewDashboardService(mockStore, loggermocks.NewMockLogger(ctrl), 30, testEvaluationUrgency, 90, flagmocks.NewMockFeatureFlags(ctrl))
+		result, err := service.GetClientAgeDistribution(context.Background(), &ClientAgeDistributionRequest{Bands: []int{18, 65}})
+		require.NoError(t, err)
+
+		labels := []string{}
+		for _, item := range result.Distribution {
+			labels = append(labels, item.Label)
+		}
+		assert.Equal(t, []string{"<18", "18-64", "65+", "Onbekend"}, labels)
+		assert.Equal(t, 17, *result.Distribution[0].MaxAge)
+		assert.Nil(t, result.Distribution[3].MinAge, "the unknown bucket is for a missing date of birth")
+		assert.Equal(t, 4, result.Total)
+	})
+
+	t.Run("bands_from_zero_need_no_extra_band", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := dbmocks.NewMockStoreInterface(ctrl)
+		mockStore.EXPECT().
+			GetClientAgeDistribution(gomock.Any(), db.GetClientAgeDistributionParams{Today: util.Today(), BandStarts: []int32{0, 18}}).
+			Return([]db.GetClientAgeDistributionRow{
+				{BandStart: ptr(0), ClientCount: 1},
+				{BandStart: ptr(18), ClientCount: 1},
+				{BandStart: nil, ClientCount: 0},
+			}, nil)
+
+		service := NewDashboardService(mockStore, loggermocks.NewMockLogger(ctrl), 30, testEvaluationUrgency, 90, flagmocks.NewMockFeatureFlags(ctrl))
+		result, err := service.GetClientAgeDistribution(context.Background(), &ClientAgeDistributionRequest{Bands: []int{0, 18}})
+		require.NoError(t, err)
+		require.Len(t, result.Distribution, 2)
+		assert.Equal(t, "0-17", result.Distribution[0].Label)
+	})
+}
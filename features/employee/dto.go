@@ -8,7 +8,7 @@ type CreateEmployeeRequest struct {
 	BSN           string  `json:"bsn"         binding:"required"`
 	DateOfBirth   string  `json:"dateOfBirth" binding:"required,datetime=2006-01-02"`
 	PhoneNumber   string  `json:"phoneNumber" binding:"required"`
-	Gender        string  `json:"gender"      binding:"required,oneof=male female other"`
+	Gender        string  `json:"gender"      binding:"required,oneof=male female other non_binary unknown prefer_not_to_say"`
 	Role          string  `json:"role"        binding:"required"`
 	LocationID    string  `json:"locationId"  binding:"required"`
 	ContractHours *int32  `json:"contractHours"`
@@ -57,6 +57,9 @@ type GetEmployeeByIDResponse struct {
 	RoleID        *string `json:"roleId"`
 	RoleName      *string `json:"roleName"`
 	ClientCount   int64   `json:"clientCount"`
+	// MaxCaseload is the employee's own cap on active clients; nil means the
+	// configured default applies
+	MaxCaseload *int32 `json:"maxCaseload"`
 }
 
 type RoleResponse struct {
@@ -91,22 +94,52 @@ type GetMyProfileResponse struct {
 	LocationAddress    string               `json:"locationAddress"`
 	LocationPostalCode string               `json:"locationPostalCode"`
 	Permissions        []PermissionResponse `json:"permissions"`
+	LastLoginAt        *string              `json:"lastLoginAt"` // Nil until the first completed sign-in
 }
 
 type UpdateEmployeeRequest struct {
 	Email         *string `json:"email"         binding:"omitempty,email"`
-	Password      *string `json:"password"      binding:"omitempty,min=6"`
+	Password      *string `json:"password"      binding:"omitempty"`
 	FirstName     *string `json:"firstName"     binding:"omitempty"`
 	LastName      *string `json:"lastName"      binding:"omitempty"`
-	BSN           *string `json:"bsn"           binding:"omitempty"`
+	BSN           *string `json:"bsn"           binding:"omitempty"` // Immutable; only accepted when unchanged
 	DateOfBirth   *string `json:"dateOfBirth"   binding:"omitempty"`
 	PhoneNumber   *string `json:"phoneNumber"   binding:"omitempty"`
-	Gender        *string `json:"gender"        binding:"omitempty,oneof=male female other"`
+	Gender        *string `json:"gender"        binding:"omitempty,oneof=male female other non_binary unknown prefer_not_to_say"`
 	LocationID    *string `json:"locationId"    binding:"omitempty"`
 	ContractHours *int32  `json:"contractHours"`
 	ContractType  *string `json:"contractType" binding:"omitempty,oneof=self_employed payroll_service"`
+	MaxCaseload   *int32  `json:"maxCaseload"  binding:"omitempty,min=1"`
+	// ClearMaxCaseload drops the employee's own cap so the default applies
+	// again; it cannot be combined with MaxCaseload
+	ClearMaxCaseload bool `json:"clearMaxCaseload"`
 }
 
 type UpdateEmployeeResponse struct {
 	ID string `json:"id"`
 }
+
+type ReplacementCoordinatorResponse struct {
+	EmployeeID string `json:"employeeId"`
+	FirstName  string `json:"firstName"`
+	LastName   string `json:"lastName"`
+	LocationID string `json:"locationId"`
+	// Caseload is the number of in-care clients the coordinator already has
+	Caseload int64 `json:"caseload"`
+}
+
+type AvailabilityItem struct {
+	// Weekday follows time.Weekday: 0 = Sunday ... 6 = Saturday
+	Weekday   int32  `json:"weekday"   binding:"min=0,max=6"`
+	StartTime string `json:"startTime" binding:"required,datetime=15:04"`
+	EndTime   string `json:"endTime"   binding:"required,datetime=15:04"`
+}
+
+type SetAvailabilityRequest struct {
+	Availability []AvailabilityItem `json:"availability" binding:"dive"`
+}
+
+type AvailabilityResponse struct {
+	EmployeeID   string             `json:"employeeId"`
+	Availability []AvailabilityItem `json:"availability"`
+}
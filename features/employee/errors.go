@@ -6,4 +6,15 @@ var (
 	ErrInvalidRequest = errors.New("invalid request")
 	ErrInternal       = errors.New("internal server error")
 	ErrUnauthorized   = errors.New("unauthorized")
+	ErrEmailTaken     = errors.New("email is already in use")
+	ErrBSNImmutable   = errors.New("bsn cannot be changed")
+	ErrNotFound       = errors.New("employee not found")
+
+	ErrNoReplacementCoordinator = errors.New(
+		"no other active coordinator works at this employee's location",
+	)
+
+	ErrInvalidAvailability = errors.New(
+		"availability must have one window per weekday with start time before end time",
+	)
 )
@@ -2,6 +2,7 @@ package employee
 
 import (
 	"care-cordination/lib/middleware"
+	"care-cordination/lib/password"
 	"care-cordination/lib/resp"
 	"errors"
 	"net/http"
@@ -25,19 +26,28 @@ func NewEmployeeHandler(
 }
 
 func (h *EmployeeHandler) SetupEmployeeRoutes(router *gin.Engine) {
+	router.GET("/me", h.mdw.AuthMdw(), h.GetMyProfile)
+
 	employee := router.Group("/employees")
 	employee.Use(h.mdw.AuthMdw())
 
 	employee.GET("/me", h.GetMyProfile)
-	employee.GET("", h.mdw.PaginationMdw(), h.ListEmployees)
+	employee.GET("", h.mdw.PaginationMdw(), h.mdw.SearchMdw(), h.ListEmployees)
 	employee.GET("/:id", h.GetEmployeeByID)
 	employee.POST("", h.mdw.RequirePermission("employee", "write"), h.CreateEmployee)
 	employee.PUT("/:id", h.mdw.RequirePermission("employee", "write"), h.UpdateEmployee)
 	employee.DELETE("/:id", h.mdw.RequirePermission("employee", "delete"), h.DeleteEmployee)
+	employee.GET(
+		"/:id/replacement-coordinator",
+		h.mdw.RequirePermission("employee", "delete"),
+		h.SuggestReplacementCoordinator,
+	)
+	employee.GET("/:id/availability", h.GetAvailability)
+	employee.PUT("/:id/availability", h.mdw.RequirePermission("employee", "write"), h.SetAvailability)
 }
 
 // @Summary Create an employee
-// @Description Create a new employee
+// @Description Create a new employee; the password must meet the password policy
 // @Tags Employee
 // @Accept json
 // @Produce json
@@ -55,6 +65,10 @@ func (h *EmployeeHandler) CreateEmployee(ctx *gin.Context) {
 	}
 	result, err := h.employeeService.CreateEmployee(ctx, &req)
 	if err != nil {
+		if errors.Is(err, password.ErrWeakPassword) {
+			ctx.JSON(http.StatusBadRequest, resp.Error(err))
+			return
+		}
 		switch err {
 		case ErrInvalidRequest:
 			ctx.JSON(http.StatusBadRequest, resp.Error(err))
@@ -131,18 +145,21 @@ func (h *EmployeeHandler) GetEmployeeByID(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, resp.Success(result, "Employee retrieved successfully"))
 }
 
-// @Summary Get logged-in user's employee profile
-// @Description Get the employee profile of the currently authenticated user
+// @Summary Get logged-in user's profile
+// @Description Get the email, employee details, role, permissions and last login of the currently authenticated user
 // @Tags Employee
 // @Produce json
 // @Success 200 {object} resp.SuccessResponse[GetMyProfileResponse]
 // @Failure 401 {object} resp.ErrorResponse
 // @Failure 500 {object} resp.ErrorResponse
+// @Router /me [get]
 // @Router /employees/me [get]
 func (h *EmployeeHandler) GetMyProfile(ctx *gin.Context) {
 	result, err := h.employeeService.GetMyProfile(ctx)
 	if err != nil {
 		switch {
+		case errors.Is(err, ErrUnauthorized):
+			ctx.JSON(http.StatusUnauthorized, resp.Error(err))
 		case errors.Is(err, ErrInternal):
 			ctx.JSON(http.StatusInternalServerError, resp.Error(err))
 		default:
@@ -154,7 +171,7 @@ func (h *EmployeeHandler) GetMyProfile(ctx *gin.Context) {
 }
 
 // @Summary Update an employee
-// @Description Update an existing employee's details including password
+// @Description Update an existing employee's details, email and password. BSN cannot be changed, a new password must meet the password policy and roles are managed through RBAC.
 // @Tags Employee
 // @Accept json
 // @Produce json
@@ -163,6 +180,8 @@ func (h *EmployeeHandler) GetMyProfile(ctx *gin.Context) {
 // @Success 200 {object} resp.SuccessResponse[UpdateEmployeeResponse]
 // @Failure 400 {object} resp.ErrorResponse
 // @Failure 401 {object} resp.ErrorResponse
+// @Failure 404 {object} resp.ErrorResponse
+// @Failure 409 {object} resp.ErrorResponse
 // @Failure 500 {object} resp.ErrorResponse
 // @Router /employees/{id} [put]
 func (h *EmployeeHandler) UpdateEmployee(ctx *gin.Context) {
@@ -181,6 +200,14 @@ func (h *EmployeeHandler) UpdateEmployee(ctx *gin.Context) {
 	result, err := h.employeeService.UpdateEmployee(ctx, id, &req)
 	if err != nil {
 		switch {
+		case errors.Is(err, ErrInvalidRequest),
+			errors.Is(err, ErrBSNImmutable),
+			errors.Is(err, password.ErrWeakPassword):
+			ctx.JSON(http.StatusBadRequest, resp.Error(err))
+		case errors.Is(err, ErrNotFound):
+			ctx.JSON(http.StatusNotFound, resp.Error(err))
+		case errors.Is(err, ErrEmailTaken):
+			ctx.JSON(http.StatusConflict, resp.Error(err))
 		case errors.Is(err, ErrInternal):
 			ctx.JSON(http.StatusInternalServerError, resp.Error(err))
 		default:
@@ -220,3 +247,100 @@ func (h *EmployeeHandler) DeleteEmployee(ctx *gin.Context) {
 	}
 	ctx.JSON(http.StatusOK, resp.Success(struct{}{}, "Employee deleted successfully"))
 }
+
+// @Summary Suggest a replacement coordinator
+// @Description Suggest who should take over an employee's clients before deactivating them: the active coordinator at the same location with the lowest in-care caseload
+// @Tags Employee
+// @Produce json
+// @Param id path string true "Employee ID"
+// @Success 200 {object} resp.SuccessResponse[ReplacementCoordinatorResponse]
+// @Failure 400 {object} resp.ErrorResponse
+// @Failure 401 {object} resp.ErrorResponse
+// @Failure 404 {object} resp.ErrorResponse
+// @Failure 500 {object} resp.ErrorResponse
+// @Router /employees/{id}/replacement-coordinator [get]
+func (h *EmployeeHandler) SuggestReplacementCoordinator(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, resp.Error(ErrInvalidRequest))
+		return
+	}
+
+	result, err := h.employeeService.SuggestReplacementCoordinator(ctx, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrNoReplacementCoordinator):
+			ctx.JSON(http.StatusNotFound, resp.Error(err))
+		default:
+			ctx.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		}
+		return
+	}
+	ctx.JSON(http.StatusOK, resp.Success(result, "Replacement coordinator suggested successfully"))
+}
+
+// @Summary Get employee availability
+// @Description Get the weekly working hours within which intakes can be booked with this coordinator
+// @Tags Employee
+// @Produce json
+// @Param id path string true "Employee ID"
+// @Success 200 {object} resp.SuccessResponse[AvailabilityResponse]
+// @Failure 400 {object} resp.ErrorResponse
+// @Failure 401 {object} resp.ErrorResponse
+// @Failure 500 {object} resp.ErrorResponse
+// @Router /employees/{id}/availability [get]
+func (h *EmployeeHandler) GetAvailability(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, resp.Error(ErrInvalidRequest))
+		return
+	}
+
+	result, err := h.employeeService.GetAvailability(ctx, id)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, resp.Error(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp.Success(result, "Availability retrieved successfully"))
+}
+
+// @Summary Set employee availability
+// @Description Replace the weekly working hours within which intakes can be booked with this coordinator. An empty list clears the schedule.
+// @Tags Employee
+// @Accept json
+// @Produce json
+// @Param id path string true "Employee ID"
+// @Param availability body SetAvailabilityRequest true "Weekly availability"
+// @Success 200 {object} resp.SuccessResponse[AvailabilityResponse]
+// @Failure 400 {object} resp.ErrorResponse
+// @Failure 401 {object} resp.ErrorResponse
+// @Failure 404 {object} resp.ErrorResponse
+// @Failure 500 {object} resp.ErrorResponse
+// @Router /employees/{id}/availability [put]
+func (h *EmployeeHandler) SetAvailability(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, resp.Error(ErrInvalidRequest))
+		return
+	}
+
+	var req SetAvailabilityRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, resp.Error(ErrInvalidRequest))
+		return
+	}
+
+	result, err := h.employeeService.SetAvailability(ctx, id, &req)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrInvalidAvailability):
+			ctx.JSON(http.StatusBadRequest, resp.Error(err))
+		case errors.Is(err, ErrNotFound):
+			ctx.JSON(http.StatusNotFound, resp.Error(err))
+		default:
+			ctx.JSON(http.StatusInternalServerError, resp.Error(err))
+		}
+		return
+	}
+	ctx.JSON(http.StatusOK, resp.Success(result, "Availability updated successfully"))
+}
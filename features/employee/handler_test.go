@@ -2,14 +2,19 @@ package employee_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"care-cordination/features/employee"
 	"care-cordination/internal/mocks"
+	"care-cordination/lib/middleware"
 	"care-cordination/lib/resp"
+	"care-cordination/lib/token"
+	"care-cordination/lib/util"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -95,6 +100,27 @@ func TestCreateEmployeeHandler(t *testing.T) {
 				assert.Equal(t, "emp-123", response.Data.ID)
 			},
 		},
+		{
+			name: "success_added_gender_value",
+			requestBody: employee.CreateEmployeeRequest{
+				Email:       "test@example.com",
+				Password:    "password123",
+				FirstName:   "Robin",
+				LastName:    "de Vries",
+				BSN:         "123456789",
+				DateOfBirth: "1990-01-01",
+				PhoneNumber: "0612345678",
+				Gender:      "prefer_not_to_say",
+				Role:        "admin",
+				LocationID:  "loc-123",
+			},
+			setup: func(mockService *mocks.MockEmployeeService) {
+				mockService.EXPECT().
+					CreateEmployee(gomock.Any(), gomock.Any()).
+					Return(employee.CreateEmployeeResponse{ID: "emp-124"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
 		{
 			name: "invalid_request",
 			requestBody: map[string]string{
@@ -229,6 +255,87 @@ func TestGetMyProfileHandler(t *testing.T) {
 	}
 }
 
+// ============================================================
+// Test: GET /me
+// ============================================================
+
+func TestMeHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tokenManager := token.NewTokenManager("access-secret", "refresh-secret", time.Hour, time.Hour, time.Minute)
+	accessToken, err := tokenManager.GenerateAccessToken("user-123", "emp-123", "org-1", time.Now())
+	require.NoError(t, err)
+
+	lastLogin := "2026-03-02T09:30:00Z"
+	profile := &employee.GetMyProfileResponse{
+		ID:          "emp-123",
+		UserID:      "user-123",
+		Email:       "coordinator@example.com",
+		FirstName:   "Jane",
+		LastName:    "Doe",
+		Role:        "coordinator",
+		Permissions: []employee.PermissionResponse{{ID: "perm_client_read", Resource: "client", Action: "read"}},
+		LastLoginAt: &lastLogin,
+	}
+
+	tests := []struct {
+		name           string
+		authorization  string
+		setup          func(mockService *mocks.MockEmployeeService)
+		expectedStatus int
+	}{
+		{
+			name:          "success",
+			authorization: "Bearer " + accessToken,
+			setup: func(mockService *mocks.MockEmployeeService) {
+				mockService.EXPECT().
+					GetMyProfile(gomock.Any()).
+					DoAndReturn(func(ctx context.Context) (*employee.GetMyProfileResponse, error) {
+						// The profile is looked up for the token's user
+						assert.Equal(t, "user-123", util.GetUserID(ctx))
+						return profile, nil
+					})
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "no_token",
+			setup:          func(mockService *mocks.MockEmployeeService) {},
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockService := mocks.NewMockEmployeeService(ctrl)
+			tt.setup(mockService)
+
+			mdw, err := middleware.NewMiddleware(tokenManager, nil, nil, nil, nil, 2, nil)
+			require.NoError(t, err)
+			router := gin.New()
+			employee.NewEmployeeHandler(mockService, mdw).SetupEmployeeRoutes(router)
+
+			req, _ := http.NewRequest("GET", "/me", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var response resp.SuccessResponse[employee.GetMyProfileResponse]
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, *profile, response.Data)
+		})
+	}
+}
+
 // ============================================================
 // Test: UpdateEmployee
 // ============================================================
@@ -254,6 +361,32 @@ func TestUpdateEmployeeHandler(t *testing.T) {
 			},
 			expectedStatus: http.StatusOK,
 		},
+		{
+			name: "email_taken",
+			id:   "emp-123",
+			requestBody: employee.UpdateEmployeeRequest{
+				Email: func() *string { s := "taken@example.com"; return &s }(),
+			},
+			setup: func(mockService *mocks.MockEmployeeService) {
+				mockService.EXPECT().
+					UpdateEmployee(gomock.Any(), "emp-123", gomock.Any()).
+					Return(nil, employee.ErrEmailTaken)
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name: "bsn_change_rejected",
+			id:   "emp-123",
+			requestBody: employee.UpdateEmployeeRequest{
+				BSN: func() *string { s := "987654321"; return &s }(),
+			},
+			setup: func(mockService *mocks.MockEmployeeService) {
+				mockService.EXPECT().
+					UpdateEmployee(gomock.Any(), "emp-123", gomock.Any()).
+					Return(nil, employee.ErrBSNImmutable)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
 	}
 
 	for _, tt := range tests {
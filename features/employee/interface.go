@@ -16,4 +16,12 @@ type EmployeeService interface {
 	GetMyProfile(ctx context.Context) (*GetMyProfileResponse, error)
 	UpdateEmployee(ctx context.Context, id string, req *UpdateEmployeeRequest) (*UpdateEmployeeResponse, error)
 	DeleteEmployee(ctx context.Context, id string) error
+	// SuggestReplacementCoordinator picks who should take over the caseload of an
+	// employee that is about to be deactivated
+	SuggestReplacementCoordinator(
+		ctx context.Context,
+		forEmployeeID string,
+	) (*ReplacementCoordinatorResponse, error)
+	GetAvailability(ctx context.Context, id string) (*AvailabilityResponse, error)
+	SetAvailability(ctx context.Context, id string, req *SetAvailabilityRequest) (*AvailabilityResponse, error)
 }
@@ -5,23 +5,32 @@ import (
 	"care-cordination/lib/logger"
 	"care-cordination/lib/middleware"
 	"care-cordination/lib/nanoid"
+	"care-cordination/lib/password"
 	"care-cordination/lib/resp"
 	"care-cordination/lib/util"
 	"context"
+	"errors"
 
+	"github.com/jackc/pgx/v5"
 	"go.uber.org/zap"
 	"golang.org/x/crypto/bcrypt"
 )
 
 type employeeService struct {
-	store  db.StoreInterface
-	logger logger.Logger
+	store     db.StoreInterface
+	logger    logger.Logger
+	passwords password.Policy
 }
 
-func NewEmployeeService(store db.StoreInterface, logger logger.Logger) EmployeeService {
+func NewEmployeeService(
+	store db.StoreInterface,
+	logger logger.Logger,
+	passwords password.Policy,
+) EmployeeService {
 	return &employeeService{
-		store:  store,
-		logger: logger,
+		store:     store,
+		logger:    logger,
+		passwords: passwords,
 	}
 }
 
@@ -29,6 +38,10 @@ func (s *employeeService) CreateEmployee(
 	ctx context.Context,
 	req *CreateEmployeeRequest,
 ) (CreateEmployeeResponse, error) {
+	if err := s.passwords.Validate(req.Password); err != nil {
+		return CreateEmployeeResponse{}, err
+	}
+
 	passwordHash, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
 	if err != nil {
 		s.logger.Error(ctx, "CreateEmployee", "Failed to generate password hash", zap.Error(err))
@@ -57,11 +70,13 @@ func (s *employeeService) CreateEmployee(
 					Valid: false,
 				}
 			}(),
+			OrganizationID: util.GetOrganizationIDPtr(ctx),
 		},
 		User: db.CreateUserParams{
-			ID:           nanoid.Generate(),
-			Email:        req.Email,
-			PasswordHash: string(passwordHash),
+			ID:             nanoid.Generate(),
+			Email:          req.Email,
+			PasswordHash:   string(passwordHash),
+			OrganizationID: util.GetOrganizationIDPtr(ctx),
 		},
 	})
 	if err != nil {
@@ -154,6 +169,7 @@ func (s *employeeService) GetEmployeeByID(ctx context.Context, id string) (*GetE
 		RoleID:      employee.RoleID,
 		RoleName:    employee.RoleName,
 		ClientCount: employee.ClientCount.(int64),
+		MaxCaseload: employee.MaxCaseload,
 	}, nil
 }
 
@@ -206,6 +222,12 @@ func (s *employeeService) GetMyProfile(ctx context.Context) (*GetMyProfileRespon
 		clientCount = int64(count)
 	}
 
+	var lastLoginAt *string
+	if employee.LastLoginAt.Valid {
+		value := util.PgtypeTimestamptzToStr(employee.LastLoginAt)
+		lastLoginAt = &value
+	}
+
 	// Fetch permissions for the user's role
 	permissionsResponse := []PermissionResponse{}
 	if employee.RoleID != nil {
@@ -248,6 +270,7 @@ func (s *employeeService) GetMyProfile(ctx context.Context) (*GetMyProfileRespon
 		LocationAddress:    locationAddress,
 		LocationPostalCode: locationPostalCode,
 		Permissions:        permissionsResponse,
+		LastLoginAt:        lastLoginAt,
 	}, nil
 }
 
@@ -256,22 +279,56 @@ func (s *employeeService) UpdateEmployee(
 	id string,
 	req *UpdateEmployeeRequest,
 ) (*UpdateEmployeeResponse, error) {
+	if req.Password != nil {
+		if err := s.passwords.Validate(*req.Password); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.ClearMaxCaseload && req.MaxCaseload != nil {
+		return nil, ErrInvalidRequest
+	}
+
 	// Get UserID for user table update
 	currentEmployee, err := s.store.GetEmployeeByID(ctx, id)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
 		s.logger.Error(ctx, "UpdateEmployee", "Failed to get employee", zap.Error(err))
 		return nil, ErrInternal
 	}
 
+	// BSN is fixed after onboarding; clients may echo it back but not change it
+	if req.BSN != nil && *req.BSN != currentEmployee.Bsn {
+		s.logger.Error(ctx, "UpdateEmployee", "Attempt to change employee BSN", zap.String("employeeID", id))
+		return nil, ErrBSNImmutable
+	}
+
+	// Email must stay unique across users
+	emailChanged := req.Email != nil && *req.Email != currentEmployee.Email
+	if emailChanged {
+		existing, err := s.store.GetUserByEmail(ctx, *req.Email)
+		if err == nil && existing.ID != currentEmployee.UserID {
+			s.logger.Error(ctx, "UpdateEmployee", "Email already in use", zap.String("employeeID", id))
+			return nil, ErrEmailTaken
+		}
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			s.logger.Error(ctx, "UpdateEmployee", "Failed to check email uniqueness", zap.Error(err))
+			return nil, ErrInternal
+		}
+	}
+
 	// Build update params - COALESCE in SQL will keep existing values for nil fields
 	updateParams := db.UpdateEmployeeParams{
-		ID:            id,
-		FirstName:     req.FirstName,
-		LastName:      req.LastName,
-		Bsn:           req.BSN,
-		PhoneNumber:   req.PhoneNumber,
-		ContractHours: req.ContractHours,
-		LocationID:    req.LocationID,
+		ID:               id,
+		FirstName:        req.FirstName,
+		LastName:         req.LastName,
+		PhoneNumber:      req.PhoneNumber,
+		ContractHours:    req.ContractHours,
+		LocationID:       req.LocationID,
+		ClearMaxCaseload: req.ClearMaxCaseload,
+		MaxCaseload:      req.MaxCaseload,
 	}
 
 	// Handle DateOfBirth
@@ -302,22 +359,33 @@ func (s *employeeService) UpdateEmployee(
 		return nil, ErrInternal
 	}
 
-	// Update user (email/password) if provided
-	if req.Email != nil || req.Password != nil {
-		userParams := db.UpdateUserParams{
+	// Update login email if it changed
+	if emailChanged {
+		err = s.store.UpdateUserEmail(ctx, db.UpdateUserEmailParams{
 			ID:    currentEmployee.UserID,
-			Email: req.Email,
-		}
-		if req.Password != nil {
-			passwordHash, err := bcrypt.GenerateFromPassword([]byte(*req.Password), bcrypt.DefaultCost)
-			if err != nil {
-				s.logger.Error(ctx, "UpdateEmployee", "Failed to generate password hash", zap.Error(err))
-				return nil, ErrInternal
+			Email: *req.Email,
+		})
+		if err != nil {
+			if db.IsUniqueViolation(err) {
+				return nil, ErrEmailTaken
 			}
-			hash := string(passwordHash)
-			userParams.PasswordHash = &hash
+			s.logger.Error(ctx, "UpdateEmployee", "Failed to update user email", zap.Error(err))
+			return nil, ErrInternal
 		}
-		err = s.store.UpdateUser(ctx, userParams)
+	}
+
+	// Update password if provided
+	if req.Password != nil {
+		passwordHash, err := bcrypt.GenerateFromPassword([]byte(*req.Password), bcrypt.DefaultCost)
+		if err != nil {
+			s.logger.Error(ctx, "UpdateEmployee", "Failed to generate password hash", zap.Error(err))
+			return nil, ErrInternal
+		}
+		hash := string(passwordHash)
+		err = s.store.UpdateUser(ctx, db.UpdateUserParams{
+			ID:           currentEmployee.UserID,
+			PasswordHash: &hash,
+		})
 		if err != nil {
 			s.logger.Error(ctx, "UpdateEmployee", "Failed to update user", zap.Error(err))
 			return nil, ErrInternal
@@ -337,3 +405,93 @@ func (s *employeeService) DeleteEmployee(ctx context.Context, id string) error {
 	}
 	return nil
 }
+
+func (s *employeeService) SuggestReplacementCoordinator(
+	ctx context.Context,
+	forEmployeeID string,
+) (*ReplacementCoordinatorResponse, error) {
+	coordinator, err := s.store.SuggestReplacementCoordinator(ctx, forEmployeeID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNoReplacementCoordinator
+		}
+		s.logger.Error(
+			ctx,
+			"SuggestReplacementCoordinator",
+			"Failed to suggest replacement coordinator",
+			zap.Error(err),
+		)
+		return nil, ErrInternal
+	}
+
+	return &ReplacementCoordinatorResponse{
+		EmployeeID: coordinator.ID,
+		FirstName:  coordinator.FirstName,
+		LastName:   coordinator.LastName,
+		LocationID: coordinator.LocationID,
+		Caseload:   coordinator.Caseload,
+	}, nil
+}
+
+func (s *employeeService) GetAvailability(ctx context.Context, id string) (*AvailabilityResponse, error) {
+	rows, err := s.store.ListCoordinatorAvailability(ctx, id)
+	if err != nil {
+		s.logger.Error(ctx, "GetAvailability", "Failed to list availability", zap.Error(err))
+		return nil, ErrInternal
+	}
+
+	return &AvailabilityResponse{
+		EmployeeID: id,
+		Availability: util.Map(rows, func(row db.CoordinatorAvailability) AvailabilityItem {
+			return AvailabilityItem{
+				Weekday:   row.Weekday,
+				StartTime: util.PgtypeTimeToString(row.StartTime),
+				EndTime:   util.PgtypeTimeToString(row.EndTime),
+			}
+		}),
+	}, nil
+}
+
+func (s *employeeService) SetAvailability(
+	ctx context.Context,
+	id string,
+	req *SetAvailabilityRequest,
+) (*AvailabilityResponse, error) {
+	seen := make(map[int32]bool, len(req.Availability))
+	params := make([]db.CreateCoordinatorAvailabilityParams, 0, len(req.Availability))
+	for _, item := range req.Availability {
+		start := util.StrToPgtypeTime(item.StartTime)
+		end := util.StrToPgtypeTime(item.EndTime)
+		if seen[item.Weekday] || !start.Valid || !end.Valid || start.Microseconds >= end.Microseconds {
+			return nil, ErrInvalidAvailability
+		}
+		seen[item.Weekday] = true
+
+		params = append(params, db.CreateCoordinatorAvailabilityParams{
+			ID:        nanoid.Generate(),
+			Weekday:   item.Weekday,
+			StartTime: start,
+			EndTime:   end,
+		})
+	}
+
+	if err := s.store.SetCoordinatorAvailabilityTx(ctx, db.SetCoordinatorAvailabilityTxParams{
+		EmployeeID:   id,
+		Availability: params,
+	}); err != nil {
+		if db.IsForeignKeyViolation(err) {
+			return nil, ErrNotFound
+		}
+		s.logger.Error(ctx, "SetAvailability", "Failed to set availability", zap.Error(err))
+		return nil, ErrInternal
+	}
+
+	availability := req.Availability
+	if availability == nil {
+		availability = []AvailabilityItem{}
+	}
+	return &AvailabilityResponse{
+		EmployeeID:   id,
+		Availability: availability,
+	}, nil
+}
@@ -9,8 +9,11 @@ import (
 	db "care-cordination/lib/db/sqlc"
 	dbmocks "care-cordination/lib/db/sqlc/mocks"
 	loggermocks "care-cordination/lib/logger/mocks"
+	"care-cordination/lib/password"
 	"care-cordination/lib/util"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -20,16 +23,17 @@ import (
 func TestCreateEmployee(t *testing.T) {
 	hours := int32(40)
 	tests := []struct {
-		name    string
-		req     *employee.CreateEmployeeRequest
-		setup   func(mockStore *dbmocks.MockStoreInterface)
-		wantErr bool
+		name        string
+		req         *employee.CreateEmployeeRequest
+		setup       func(mockStore *dbmocks.MockStoreInterface)
+		wantErr     bool
+		expectedErr error
 	}{
 		{
 			name: "success",
 			req: &employee.CreateEmployeeRequest{
 				Email:         "test@example.com",
-				Password:      "password123",
+				Password:      "Correct-Horse-42",
 				FirstName:     "John",
 				LastName:      "Doe",
 				BSN:           "123456789",
@@ -50,7 +54,7 @@ func TestCreateEmployee(t *testing.T) {
 			name: "db_error",
 			req: &employee.CreateEmployeeRequest{
 				Email:       "test@example.com",
-				Password:    "password123",
+				Password:    "Correct-Horse-42",
 				FirstName:   "John",
 				LastName:    "Doe",
 				BSN:         "123456789",
@@ -66,6 +70,25 @@ func TestCreateEmployee(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "weak_password",
+			req: &employee.CreateEmployeeRequest{
+				Email:       "test@example.com",
+				Password:    "password123",
+				FirstName:   "John",
+				LastName:    "Doe",
+				BSN:         "123456789",
+				DateOfBirth: "1990-01-01",
+				PhoneNumber: "0612345678",
+				Gender:      "male",
+				LocationID:  "loc-123",
+			},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().CreateEmployeeTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantErr:     true,
+			expectedErr: password.ErrWeakPassword,
+		},
 	}
 
 	for _, tt := range tests {
@@ -80,12 +103,15 @@ func TestCreateEmployee(t *testing.T) {
 
 			tt.setup(mockStore)
 
-			service := employee.NewEmployeeService(mockStore, mockLogger)
+			service := employee.NewEmployeeService(mockStore, mockLogger, password.DefaultPolicy)
 
 			resp, err := service.CreateEmployee(context.Background(), tt.req)
 
 			if tt.wantErr {
 				require.Error(t, err)
+				if tt.expectedErr != nil {
+					assert.ErrorIs(t, err, tt.expectedErr)
+				}
 				return
 			}
 
@@ -136,7 +162,7 @@ func TestListEmployees(t *testing.T) {
 
 			tt.setup(mockStore)
 
-			service := employee.NewEmployeeService(mockStore, mockLogger)
+			service := employee.NewEmployeeService(mockStore, mockLogger, password.DefaultPolicy)
 
 			// Add pagination params to context
 			ctx := context.WithValue(context.Background(), "limit", int32(10))
@@ -194,7 +220,7 @@ func TestGetEmployeeByID(t *testing.T) {
 
 			tt.setup(mockStore)
 
-			service := employee.NewEmployeeService(mockStore, mockLogger)
+			service := employee.NewEmployeeService(mockStore, mockLogger, password.DefaultPolicy)
 
 			resp, err := service.GetEmployeeByID(context.Background(), tt.id)
 
@@ -256,7 +282,7 @@ func TestGetMyProfile(t *testing.T) {
 
 			tt.setup(mockStore)
 
-			service := employee.NewEmployeeService(mockStore, mockLogger)
+			service := employee.NewEmployeeService(mockStore, mockLogger, password.DefaultPolicy)
 
 			ctx := context.Background()
 			if tt.userID != "" {
@@ -277,12 +303,22 @@ func TestGetMyProfile(t *testing.T) {
 }
 
 func TestUpdateEmployee(t *testing.T) {
+	currentEmployee := db.GetEmployeeByIDRow{
+		ID:          "emp-123",
+		UserID:      "user-123",
+		Bsn:         "123456789",
+		Email:       "jane@example.com",
+		PhoneNumber: "0612345678",
+	}
+	maxCaseload := int32(5)
+
 	tests := []struct {
-		name    string
-		id      string
-		req     *employee.UpdateEmployeeRequest
-		setup   func(mockStore *dbmocks.MockStoreInterface)
-		wantErr bool
+		name        string
+		id          string
+		req         *employee.UpdateEmployeeRequest
+		setup       func(mockStore *dbmocks.MockStoreInterface)
+		wantErr     bool
+		expectedErr error
 	}{
 		{
 			name: "success",
@@ -301,6 +337,195 @@ func TestUpdateEmployee(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "phone_update",
+			id:   "emp-123",
+			req: &employee.UpdateEmployeeRequest{
+				PhoneNumber: util.StrPtr("0687654321"),
+			},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					GetEmployeeByID(gomock.Any(), "emp-123").
+					Return(currentEmployee, nil)
+
+				mockStore.EXPECT().
+					UpdateEmployee(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg db.UpdateEmployeeParams) error {
+						require.NotNil(t, arg.PhoneNumber)
+						assert.Equal(t, "0687654321", *arg.PhoneNumber)
+						assert.Nil(t, arg.FirstName)
+						return nil
+					})
+			},
+			wantErr: false,
+		},
+		{
+			name: "clear_max_caseload",
+			id:   "emp-123",
+			req:  &employee.UpdateEmployeeRequest{ClearMaxCaseload: true},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					GetEmployeeByID(gomock.Any(), "emp-123").
+					Return(currentEmployee, nil)
+
+				mockStore.EXPECT().
+					UpdateEmployee(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg db.UpdateEmployeeParams) error {
+						assert.True(t, arg.ClearMaxCaseload)
+						assert.Nil(t, arg.MaxCaseload)
+						return nil
+					})
+			},
+			wantErr: false,
+		},
+		{
+			name: "clear_and_set_max_caseload",
+			id:   "emp-123",
+			req: &employee.UpdateEmployeeRequest{
+				MaxCaseload:      &maxCaseload,
+				ClearMaxCaseload: true,
+			},
+			setup:       func(mockStore *dbmocks.MockStoreInterface) {},
+			wantErr:     true,
+			expectedErr: employee.ErrInvalidRequest,
+		},
+		{
+			name: "unknown_employee",
+			id:   "emp-missing",
+			req:  &employee.UpdateEmployeeRequest{FirstName: util.StrPtr("Jane")},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					GetEmployeeByID(gomock.Any(), "emp-missing").
+					Return(db.GetEmployeeByIDRow{}, pgx.ErrNoRows)
+			},
+			wantErr:     true,
+			expectedErr: employee.ErrNotFound,
+		},
+		{
+			name: "email_changed_and_unique",
+			id:   "emp-123",
+			req: &employee.UpdateEmployeeRequest{
+				Email: util.StrPtr("jane.new@example.com"),
+			},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					GetEmployeeByID(gomock.Any(), "emp-123").
+					Return(currentEmployee, nil)
+
+				mockStore.EXPECT().
+					GetUserByEmail(gomock.Any(), "jane.new@example.com").
+					Return(db.User{}, pgx.ErrNoRows)
+
+				mockStore.EXPECT().
+					UpdateEmployee(gomock.Any(), gomock.Any()).
+					Return(nil)
+
+				mockStore.EXPECT().
+					UpdateUserEmail(gomock.Any(), db.UpdateUserEmailParams{
+						ID:    "user-123",
+						Email: "jane.new@example.com",
+					}).
+					Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "email_already_in_use",
+			id:   "emp-123",
+			req: &employee.UpdateEmployeeRequest{
+				Email: util.StrPtr("taken@example.com"),
+			},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					GetEmployeeByID(gomock.Any(), "emp-123").
+					Return(currentEmployee, nil)
+
+				mockStore.EXPECT().
+					GetUserByEmail(gomock.Any(), "taken@example.com").
+					Return(db.User{ID: "user-999", Email: "taken@example.com"}, nil)
+
+				// Nothing may be written when the email is taken
+				mockStore.EXPECT().UpdateEmployee(gomock.Any(), gomock.Any()).Times(0)
+				mockStore.EXPECT().UpdateUserEmail(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantErr:     true,
+			expectedErr: employee.ErrEmailTaken,
+		},
+		{
+			name: "bsn_change_rejected",
+			id:   "emp-123",
+			req: &employee.UpdateEmployeeRequest{
+				BSN:         util.StrPtr("987654321"),
+				PhoneNumber: util.StrPtr("0687654321"),
+			},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					GetEmployeeByID(gomock.Any(), "emp-123").
+					Return(currentEmployee, nil)
+
+				mockStore.EXPECT().UpdateEmployee(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantErr:     true,
+			expectedErr: employee.ErrBSNImmutable,
+		},
+		{
+			name: "unchanged_bsn_accepted",
+			id:   "emp-123",
+			req: &employee.UpdateEmployeeRequest{
+				BSN:       util.StrPtr("123456789"),
+				FirstName: util.StrPtr("Janet"),
+			},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					GetEmployeeByID(gomock.Any(), "emp-123").
+					Return(currentEmployee, nil)
+
+				mockStore.EXPECT().
+					UpdateEmployee(gomock.Any(), gomock.Any()).
+					Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "weak_password_rejected",
+			id:   "emp-123",
+			req: &employee.UpdateEmployeeRequest{
+				FirstName: util.StrPtr("Janet"),
+				Password:  util.StrPtr("alllowercase"),
+			},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				// Nothing is read or written when the new password is rejected
+				mockStore.EXPECT().GetEmployeeByID(gomock.Any(), gomock.Any()).Times(0)
+				mockStore.EXPECT().UpdateEmployee(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantErr:     true,
+			expectedErr: password.ErrMissingUpper,
+		},
+		{
+			name: "password_updated",
+			id:   "emp-123",
+			req: &employee.UpdateEmployeeRequest{
+				Password: util.StrPtr("Correct-Horse-42"),
+			},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					GetEmployeeByID(gomock.Any(), "emp-123").
+					Return(currentEmployee, nil)
+
+				mockStore.EXPECT().
+					UpdateEmployee(gomock.Any(), gomock.Any()).
+					Return(nil)
+
+				mockStore.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg db.UpdateUserParams) error {
+						assert.Equal(t, "user-123", arg.ID)
+						require.NotNil(t, arg.PasswordHash)
+						return nil
+					})
+			},
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -315,12 +540,15 @@ func TestUpdateEmployee(t *testing.T) {
 
 			tt.setup(mockStore)
 
-			service := employee.NewEmployeeService(mockStore, mockLogger)
+			service := employee.NewEmployeeService(mockStore, mockLogger, password.DefaultPolicy)
 
 			resp, err := service.UpdateEmployee(context.Background(), tt.id, tt.req)
 
 			if tt.wantErr {
 				require.Error(t, err)
+				if tt.expectedErr != nil {
+					assert.ErrorIs(t, err, tt.expectedErr)
+				}
 				return
 			}
 
@@ -361,7 +589,7 @@ func TestDeleteEmployee(t *testing.T) {
 
 			tt.setup(mockStore)
 
-			service := employee.NewEmployeeService(mockStore, mockLogger)
+			service := employee.NewEmployeeService(mockStore, mockLogger, password.DefaultPolicy)
 
 			err := service.DeleteEmployee(context.Background(), tt.id)
 
@@ -374,3 +602,108 @@ func TestDeleteEmployee(t *testing.T) {
 		})
 	}
 }
+
+func TestSetAvailability(t *testing.T) {
+	weekday := func(day int32, start, end string) employee.AvailabilityItem {
+		return employee.AvailabilityItem{Weekday: day, StartTime: start, EndTime: end}
+	}
+
+	tests := []struct {
+		name    string
+		req     *employee.SetAvailabilityRequest
+		setup   func(mockStore *dbmocks.MockStoreInterface)
+		wantErr error
+	}{
+		{
+			name: "success",
+			req: &employee.SetAvailabilityRequest{
+				Availability: []employee.AvailabilityItem{
+					weekday(1, "09:00", "17:00"),
+					weekday(3, "13:00", "17:30"),
+				},
+			},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					SetCoordinatorAvailabilityTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg db.SetCoordinatorAvailabilityTxParams) error {
+						assert.Equal(t, "emp-123", arg.EmployeeID)
+						require.Len(t, arg.Availability, 2)
+						assert.Equal(t, int32(3), arg.Availability[1].Weekday)
+						assert.Equal(t, util.StrToPgtypeTime("13:00"), arg.Availability[1].StartTime)
+						assert.NotEmpty(t, arg.Availability[0].ID)
+						return nil
+					})
+			},
+		},
+		{
+			name: "clear_schedule",
+			req:  &employee.SetAvailabilityRequest{},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					SetCoordinatorAvailabilityTx(gomock.Any(), db.SetCoordinatorAvailabilityTxParams{
+						EmployeeID:   "emp-123",
+						Availability: []db.CreateCoordinatorAvailabilityParams{},
+					}).
+					Return(nil)
+			},
+		},
+		{
+			name: "start_after_end",
+			req: &employee.SetAvailabilityRequest{
+				Availability: []employee.AvailabilityItem{weekday(1, "17:00", "09:00")},
+			},
+			setup:   func(mockStore *dbmocks.MockStoreInterface) {},
+			wantErr: employee.ErrInvalidAvailability,
+		},
+		{
+			name: "duplicate_weekday",
+			req: &employee.SetAvailabilityRequest{
+				Availability: []employee.AvailabilityItem{
+					weekday(2, "09:00", "12:00"),
+					weekday(2, "13:00", "17:00"),
+				},
+			},
+			setup:   func(mockStore *dbmocks.MockStoreInterface) {},
+			wantErr: employee.ErrInvalidAvailability,
+		},
+		{
+			name: "unknown_employee",
+			req: &employee.SetAvailabilityRequest{
+				Availability: []employee.AvailabilityItem{weekday(1, "09:00", "17:00")},
+			},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					SetCoordinatorAvailabilityTx(gomock.Any(), gomock.Any()).
+					Return(&pgconn.PgError{Code: "23503"})
+			},
+			wantErr: employee.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := dbmocks.NewMockStoreInterface(ctrl)
+			mockLogger := loggermocks.NewMockLogger(ctrl)
+
+			mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+			tt.setup(mockStore)
+
+			service := employee.NewEmployeeService(mockStore, mockLogger, password.DefaultPolicy)
+
+			result, err := service.SetAvailability(context.Background(), "emp-123", tt.req)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "emp-123", result.EmployeeID)
+			assert.Len(t, result.Availability, len(tt.req.Availability))
+		})
+	}
+}
@@ -96,6 +96,22 @@ type UpcomingEvaluationItem struct {
 	DraftID                 *string   `json:"draftId,omitempty"`
 }
 
+type ListOverdueEvaluationsRequest struct {
+	CoordinatorID *string `form:"coordinatorId"`
+}
+
+type OverdueEvaluationItem struct {
+	ID                   string    `json:"id"`
+	FirstName            string    `json:"firstName"`
+	LastName             string    `json:"lastName"`
+	NextEvaluationDate   time.Time `json:"nextEvaluationDate"`
+	DaysOverdue          int       `json:"daysOverdue"`
+	LocationName         string    `json:"locationName"`
+	CoordinatorID        string    `json:"coordinatorId"`
+	CoordinatorFirstName string    `json:"coordinatorFirstName"`
+	CoordinatorLastName  string    `json:"coordinatorLastName"`
+}
+
 type GlobalRecentEvaluationItem struct {
 	EvaluationID         string    `json:"evaluationId"`
 	ClientID             string    `json:"clientId"`
@@ -135,3 +151,45 @@ type EvaluationResponse struct {
 	CreatedAt            time.Time          `json:"createdAt"`
 	UpdatedAt            time.Time          `json:"updatedAt"`
 }
+
+type CreateEvaluationRecordRequest struct {
+	ClientID      string  `json:"clientId"      binding:"required"`
+	CoordinatorID string  `json:"coordinatorId" binding:"required"`
+	ScheduledDate string  `json:"scheduledDate" binding:"required,datetime=2006-01-02"`
+	Notes         *string `json:"notes"`
+}
+
+type CompleteEvaluationRecordRequest struct {
+	CompletedDate string  `json:"completedDate" binding:"required,datetime=2006-01-02"`
+	Outcome       string  `json:"outcome"       binding:"required,oneof=on_track needs_attention plan_adjusted"`
+	Notes         *string `json:"notes"`
+}
+
+type CompleteEvaluationRecordResponse struct {
+	Evaluation         EvaluationRecordResponse `json:"evaluation"`
+	NextEvaluationID   *string                  `json:"nextEvaluationId,omitempty"`
+	NextEvaluationDate *time.Time               `json:"nextEvaluationDate,omitempty"`
+}
+
+type EvaluationRecordResponse struct {
+	ID            string     `json:"id"`
+	ClientID      string     `json:"clientId"`
+	CoordinatorID string     `json:"coordinatorId"`
+	ScheduledDate time.Time  `json:"scheduledDate"`
+	CompletedDate *time.Time `json:"completedDate"`
+	Notes         *string    `json:"notes"`
+	Outcome       *string    `json:"outcome"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	UpdatedAt     time.Time  `json:"updatedAt"`
+}
+
+type EvaluationRecordListItem struct {
+	ID                   string     `json:"id"`
+	ScheduledDate        time.Time  `json:"scheduledDate"`
+	CompletedDate        *time.Time `json:"completedDate"`
+	Notes                *string    `json:"notes"`
+	Outcome              *string    `json:"outcome"`
+	CoordinatorID        string     `json:"coordinatorId"`
+	CoordinatorFirstName string     `json:"coordinatorFirstName"`
+	CoordinatorLastName  string     `json:"coordinatorLastName"`
+}
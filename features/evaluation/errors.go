@@ -0,0 +1,8 @@
+package evaluation
+
+import "errors"
+
+var (
+	ErrInternal                 = errors.New("internal server error")
+	ErrEvaluationRecordNotFound = errors.New("evaluation record not found or already completed")
+)
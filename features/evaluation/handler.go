@@ -3,6 +3,7 @@ package evaluation
 import (
 	"care-cordination/lib/middleware"
 	"care-cordination/lib/resp"
+	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -30,6 +31,7 @@ func (h *EvaluationHandler) SetupEvaluationRoutes(router *gin.Engine) {
 	ev.PUT("/:id", h.UpdateEvaluation)
 	ev.GET("/critical", h.GetCritical)
 	ev.GET("/scheduled", h.GetScheduled)
+	ev.GET("/overdue", h.ListOverdue)
 	ev.GET("/recent", h.GetRecent)
 	ev.GET("/history/:clientId", h.GetEvaluationHistory)
 	ev.GET("/last/:clientId", h.GetLastEvaluation)
@@ -40,6 +42,11 @@ func (h *EvaluationHandler) SetupEvaluationRoutes(router *gin.Engine) {
 	ev.GET("/drafts/:id", h.GetDraftById)
 	ev.POST("/drafts/:id/submit", h.SubmitDraft)
 	ev.DELETE("/drafts/:id", h.DeleteDraft)
+
+	// Evaluation record endpoints
+	ev.POST("/records", h.CreateEvaluationRecord)
+	ev.POST("/records/:id/complete", h.CompleteEvaluationRecord)
+	ev.GET("/records/client/:clientId", h.ListEvaluationRecords)
 }
 
 // @Summary Create a client evaluation
@@ -166,6 +173,34 @@ func (h *EvaluationHandler) GetScheduled(c *gin.Context) {
 	c.JSON(http.StatusOK, resp.Success(result, "Scheduled evaluations retrieved successfully"))
 }
 
+// @Summary List overdue evaluations
+// @Description List in-care clients whose next evaluation date has passed, grouped per coordinator with days overdue.
+// @Tags Evaluation
+// @Produce json
+// @Param coordinatorId query string false "Filter by coordinator (employee) ID"
+// @Param page query int false "Page number"
+// @Param page_size query int false "Page size"
+// @Success 200 {object} resp.SuccessResponse[resp.PaginationResponse[OverdueEvaluationItem]]
+// @Failure 400 {object} resp.ErrorResponse
+// @Failure 401 {object} resp.ErrorResponse
+// @Failure 500 {object} resp.ErrorResponse
+// @Router /evaluations/overdue [get]
+func (h *EvaluationHandler) ListOverdue(c *gin.Context) {
+	var req ListOverdueEvaluationsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, resp.Error(err))
+		return
+	}
+
+	result, err := h.service.ListOverdueEvaluations(c, &req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		return
+	}
+
+	c.JSON(http.StatusOK, resp.Success(result, "Overdue evaluations retrieved successfully"))
+}
+
 // @Summary Get recent evaluations (Dashboard)
 // @Description List the last 20 evaluations submitted across all clients.
 // @Tags Evaluation
@@ -385,3 +420,98 @@ func (h *EvaluationHandler) GetEvaluationById(c *gin.Context) {
 
 	c.JSON(http.StatusOK, resp.Success(result, "Evaluation retrieved successfully"))
 }
+
+// @Summary Schedule an evaluation record
+// @Description Schedule an evaluation for a client on a given date.
+// @Tags Evaluation
+// @Accept json
+// @Produce json
+// @Param request body CreateEvaluationRecordRequest true "Evaluation Record Details"
+// @Success 200 {object} resp.SuccessResponse[EvaluationRecordResponse]
+// @Failure 400 {object} resp.ErrorResponse
+// @Failure 401 {object} resp.ErrorResponse
+// @Failure 500 {object} resp.ErrorResponse
+// @Router /evaluations/records [post]
+func (h *EvaluationHandler) CreateEvaluationRecord(c *gin.Context) {
+	var req CreateEvaluationRecordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, resp.Error(err))
+		return
+	}
+
+	result, err := h.service.CreateEvaluationRecord(c.Request.Context(), &req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		return
+	}
+
+	c.JSON(http.StatusOK, resp.Success(result, "Evaluation record created successfully"))
+}
+
+// @Summary Complete an evaluation record
+// @Description Record the outcome of an open evaluation, roll the client's next evaluation date forward and schedule the next evaluation.
+// @Tags Evaluation
+// @Accept json
+// @Produce json
+// @Param id path string true "Evaluation Record ID"
+// @Param request body CompleteEvaluationRecordRequest true "Completion Details"
+// @Success 200 {object} resp.SuccessResponse[CompleteEvaluationRecordResponse]
+// @Failure 400 {object} resp.ErrorResponse
+// @Failure 401 {object} resp.ErrorResponse
+// @Failure 404 {object} resp.ErrorResponse
+// @Failure 500 {object} resp.ErrorResponse
+// @Router /evaluations/records/{id}/complete [post]
+func (h *EvaluationHandler) CompleteEvaluationRecord(c *gin.Context) {
+	evaluationID := c.Param("id")
+	if evaluationID == "" {
+		c.JSON(http.StatusBadRequest, resp.Error(nil))
+		return
+	}
+
+	var req CompleteEvaluationRecordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, resp.Error(err))
+		return
+	}
+
+	result, err := h.service.CompleteEvaluationRecord(c.Request.Context(), evaluationID, &req)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrEvaluationRecordNotFound):
+			c.JSON(http.StatusNotFound, resp.Error(err))
+		default:
+			c.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, resp.Success(result, "Evaluation record completed successfully"))
+}
+
+// @Summary List evaluation records for a client
+// @Description List a client's scheduled and completed evaluations, most recently scheduled first.
+// @Tags Evaluation
+// @Produce json
+// @Param clientId path string true "Client ID"
+// @Param page query int false "Page number (default: 1)"
+// @Param page_size query int false "Page size (default: 10, max: 100)"
+// @Success 200 {object} resp.SuccessResponse[resp.PaginationResponse[EvaluationRecordListItem]]
+// @Failure 400 {object} resp.ErrorResponse
+// @Failure 401 {object} resp.ErrorResponse
+// @Failure 500 {object} resp.ErrorResponse
+// @Router /evaluations/records/client/{clientId} [get]
+func (h *EvaluationHandler) ListEvaluationRecords(c *gin.Context) {
+	clientID := c.Param("clientId")
+	if clientID == "" {
+		c.JSON(http.StatusBadRequest, resp.Error(nil))
+		return
+	}
+
+	result, err := h.service.ListEvaluationRecords(c, clientID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		return
+	}
+
+	c.JSON(http.StatusOK, resp.Success(result, "Evaluation records retrieved successfully"))
+}
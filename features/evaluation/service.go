@@ -9,7 +9,10 @@ import (
 	"care-cordination/lib/util"
 	"context"
 	"errors"
+	"time"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"go.uber.org/zap"
 )
 
@@ -19,6 +22,7 @@ type EvaluationService interface {
 	GetEvaluationHistory(ctx context.Context, clientID string) ([]EvaluationHistoryItem, error)
 	GetCriticalEvaluations(ctx context.Context) (*resp.PaginationResponse[UpcomingEvaluationItem], error)
 	GetScheduledEvaluations(ctx context.Context) (*resp.PaginationResponse[UpcomingEvaluationItem], error)
+	ListOverdueEvaluations(ctx context.Context, req *ListOverdueEvaluationsRequest) (*resp.PaginationResponse[OverdueEvaluationItem], error)
 	GetRecentEvaluations(ctx context.Context) (*resp.PaginationResponse[GlobalRecentEvaluationItem], error)
 	GetLastEvaluation(ctx context.Context, clientID string) (*LastEvaluationItem, error)
 	GetEvaluationDetails(ctx context.Context, evaluationID string) (*EvaluationResponse, error)
@@ -28,19 +32,28 @@ type EvaluationService interface {
 	GetDraft(ctx context.Context, evaluationID string) (*DraftEvaluationResponse, error)
 	SubmitDraft(ctx context.Context, evaluationID string) (*CreateEvaluationResponse, error)
 	DeleteDraft(ctx context.Context, evaluationID string) error
+	// Evaluation record methods
+	CreateEvaluationRecord(ctx context.Context, req *CreateEvaluationRecordRequest) (*EvaluationRecordResponse, error)
+	CompleteEvaluationRecord(ctx context.Context, evaluationID string, req *CompleteEvaluationRecordRequest) (*CompleteEvaluationRecordResponse, error)
+	ListEvaluationRecords(ctx context.Context, clientID string) (*resp.PaginationResponse[EvaluationRecordListItem], error)
 }
 
 type evaluationService struct {
-	db     *db.Store
-	logger logger.Logger
+	db                      *db.Store
+	logger                  logger.Logger
+	evaluationIntervalWeeks int32
 }
 
-func NewEvaluationService(db *db.Store, logger logger.Logger) EvaluationService {
-	return &evaluationService{db: db, logger: logger}
+func NewEvaluationService(db *db.Store, logger logger.Logger, evaluationIntervalWeeks int) EvaluationService {
+	return &evaluationService{
+		db:                      db,
+		logger:                  logger,
+		evaluationIntervalWeeks: int32(evaluationIntervalWeeks),
+	}
 }
 
 func (s *evaluationService) CreateEvaluation(ctx context.Context, req *CreateEvaluationRequest) (*CreateEvaluationResponse, error) {
-	client, err := s.db.GetClientByID(ctx, req.ClientID)
+	client, err := s.db.GetClientByID(ctx, db.GetClientByIDParams{ID: req.ClientID})
 	if err != nil {
 		s.logger.Error(ctx, "CreateEvaluation", "Failed to get client", zap.Error(err))
 		return nil, err
@@ -128,6 +141,7 @@ func (s *evaluationService) GetCriticalEvaluations(ctx context.Context) (*resp.P
 	rows, err := s.db.GetCriticalEvaluations(ctx, db.GetCriticalEvaluationsParams{
 		Limit:  limit,
 		Offset: offset,
+		Today:  util.Today(),
 	})
 	if err != nil {
 		s.logger.Error(ctx, "GetCriticalEvaluations", "Failed to get critical evaluations", zap.Error(err))
@@ -169,6 +183,7 @@ func (s *evaluationService) GetScheduledEvaluations(ctx context.Context) (*resp.
 	rows, err := s.db.GetScheduledEvaluations(ctx, db.GetScheduledEvaluationsParams{
 		Limit:  limit,
 		Offset: offset,
+		Today:  util.Today(),
 	})
 	if err != nil {
 		s.logger.Error(ctx, "GetScheduledEvaluations", "Failed to get scheduled evaluations", zap.Error(err))
@@ -204,6 +219,49 @@ func (s *evaluationService) GetScheduledEvaluations(ctx context.Context) (*resp.
 	return &pag, nil
 }
 
+// ListOverdueEvaluations lists in-care clients whose evaluation is overdue, grouped per coordinator
+func (s *evaluationService) ListOverdueEvaluations(ctx context.Context, req *ListOverdueEvaluationsRequest) (*resp.PaginationResponse[OverdueEvaluationItem], error) {
+	limit, offset, page, pageSize := middleware.GetPaginationParams(ctx)
+
+	var coordinatorID *string
+	if req.CoordinatorID != nil && *req.CoordinatorID != "" {
+		coordinatorID = req.CoordinatorID
+	}
+
+	rows, err := s.db.ListOverdueEvaluations(ctx, db.ListOverdueEvaluationsParams{
+		Limit:         limit,
+		Offset:        offset,
+		Today:         util.Today(),
+		CoordinatorID: coordinatorID,
+	})
+	if err != nil {
+		s.logger.Error(ctx, "ListOverdueEvaluations", "Failed to list overdue evaluations", zap.Error(err))
+		return nil, ErrInternal
+	}
+
+	var totalCount int64
+	if len(rows) > 0 {
+		totalCount = rows[0].TotalCount
+	}
+
+	result := util.Map(rows, func(row db.ListOverdueEvaluationsRow) OverdueEvaluationItem {
+		return OverdueEvaluationItem{
+			ID:                   row.ID,
+			FirstName:            row.FirstName,
+			LastName:             row.LastName,
+			NextEvaluationDate:   row.NextEvaluationDate.Time,
+			DaysOverdue:          int(row.DaysOverdue),
+			LocationName:         row.LocationName,
+			CoordinatorID:        row.CoordinatorID,
+			CoordinatorFirstName: row.CoordinatorFirstName,
+			CoordinatorLastName:  row.CoordinatorLastName,
+		}
+	})
+
+	pag := resp.PagResp(result, int(totalCount), int(page), int(pageSize))
+	return &pag, nil
+}
+
 func (s *evaluationService) GetRecentEvaluations(ctx context.Context) (*resp.PaginationResponse[GlobalRecentEvaluationItem], error) {
 	limit, offset, page, pageSize := middleware.GetPaginationParams(ctx)
 
@@ -433,39 +491,30 @@ func (s *evaluationService) SubmitDraft(ctx context.Context, evaluationID string
 	}
 
 	// Get client to calculate next evaluation date
-	client, err := s.db.GetClientByID(ctx, draft.ClientID)
+	client, err := s.db.GetClientByID(ctx, db.GetClientByIDParams{ID: draft.ClientID})
 	if err != nil {
 		s.logger.Error(ctx, "SubmitDraft", "Failed to get client", zap.Error(err))
 		return nil, err
 	}
 
-	// Submit the draft
-	submittedEval, err := s.db.SubmitDraftEvaluation(ctx, evaluationID)
-	if err != nil {
-		s.logger.Error(ctx, "SubmitDraft", "Failed to submit draft", zap.Error(err))
-		return nil, err
-	}
-
-	// Calculate and update next evaluation date
 	interval := int32(5) // Default
 	if client.EvaluationIntervalWeeks != nil {
 		interval = *client.EvaluationIntervalWeeks
 	}
 
-	nextDate := submittedEval.EvaluationDate.Time.AddDate(0, 0, int(interval)*7)
-	nextEvalDate := util.TimeToPgtypeDate(nextDate)
-
-	if err := s.db.UpdateClientNextEvaluationDate(ctx, db.UpdateClientNextEvaluationDateParams{
-		ID:                 client.ID,
-		NextEvaluationDate: nextEvalDate,
-	}); err != nil {
-		s.logger.Error(ctx, "SubmitDraft", "Failed to update next evaluation date", zap.Error(err))
+	// Submit the draft and move the client's next evaluation date forward
+	result, err := s.db.SubmitDraftEvaluationTx(ctx, db.SubmitDraftEvaluationTxParams{
+		EvaluationID:  evaluationID,
+		IntervalWeeks: interval,
+	})
+	if err != nil {
+		s.logger.Error(ctx, "SubmitDraft", "Failed to submit draft", zap.Error(err))
 		return nil, err
 	}
 
 	return &CreateEvaluationResponse{
-		ID:                 submittedEval.ID,
-		NextEvaluationDate: &nextDate,
+		ID:                 result.Evaluation.ID,
+		NextEvaluationDate: &result.NextEvaluationDate.Time,
 		IsDraft:            false,
 	}, nil
 }
@@ -549,3 +598,116 @@ func (s *evaluationService) GetEvaluationDetails(ctx context.Context, evaluation
 		UpdatedAt:            firstRow.UpdatedAt.Time,
 	}, nil
 }
+
+// CreateEvaluationRecord schedules an evaluation for a client
+func (s *evaluationService) CreateEvaluationRecord(ctx context.Context, req *CreateEvaluationRecordRequest) (*EvaluationRecordResponse, error) {
+	eval, err := s.db.CreateEvaluationRecord(ctx, db.CreateEvaluationRecordParams{
+		ID:            nanoid.Generate(),
+		ClientID:      req.ClientID,
+		CoordinatorID: req.CoordinatorID,
+		ScheduledDate: util.StrToPgtypeDate(req.ScheduledDate),
+		Notes:         req.Notes,
+	})
+	if err != nil {
+		s.logger.Error(ctx, "CreateEvaluationRecord", "Failed to create evaluation record", zap.Error(err))
+		return nil, ErrInternal
+	}
+
+	return toEvaluationRecordResponse(eval), nil
+}
+
+// CompleteEvaluationRecord records the outcome of an evaluation and schedules the next one.
+// Returns ErrEvaluationRecordNotFound when the evaluation does not exist or has already been completed.
+func (s *evaluationService) CompleteEvaluationRecord(ctx context.Context, evaluationID string, req *CompleteEvaluationRecordRequest) (*CompleteEvaluationRecordResponse, error) {
+	result, err := s.db.CompleteEvaluationRecordTx(ctx, db.CompleteEvaluationRecordTxParams{
+		Evaluation: db.CompleteEvaluationRecordParams{
+			ID:            evaluationID,
+			CompletedDate: util.StrToPgtypeDate(req.CompletedDate),
+			Outcome:       db.EvaluationOutcomeEnum(req.Outcome),
+			Notes:         req.Notes,
+		},
+		DefaultIntervalWeeks: s.evaluationIntervalWeeks,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrEvaluationRecordNotFound
+		}
+		s.logger.Error(ctx, "CompleteEvaluationRecord", "Failed to complete evaluation record", zap.Error(err))
+		return nil, ErrInternal
+	}
+
+	response := &CompleteEvaluationRecordResponse{
+		Evaluation:       *toEvaluationRecordResponse(result.Evaluation),
+		NextEvaluationID: result.NextEvaluationID,
+	}
+	if result.NextEvaluationDate.Valid {
+		response.NextEvaluationDate = &result.NextEvaluationDate.Time
+	}
+
+	return response, nil
+}
+
+// ListEvaluationRecords lists a client's evaluation records, most recently scheduled first
+func (s *evaluationService) ListEvaluationRecords(ctx context.Context, clientID string) (*resp.PaginationResponse[EvaluationRecordListItem], error) {
+	limit, offset, page, pageSize := middleware.GetPaginationParams(ctx)
+
+	rows, err := s.db.ListEvaluationRecordsByClient(ctx, db.ListEvaluationRecordsByClientParams{
+		ClientID: clientID,
+		Limit:    limit,
+		Offset:   offset,
+	})
+	if err != nil {
+		s.logger.Error(ctx, "ListEvaluationRecords", "Failed to list evaluation records", zap.Error(err))
+		return nil, ErrInternal
+	}
+
+	var totalCount int64
+	if len(rows) > 0 {
+		totalCount = rows[0].TotalCount
+	}
+
+	result := util.Map(rows, func(row db.ListEvaluationRecordsByClientRow) EvaluationRecordListItem {
+		return EvaluationRecordListItem{
+			ID:                   row.ID,
+			ScheduledDate:        row.ScheduledDate.Time,
+			CompletedDate:        dateToPtr(row.CompletedDate),
+			Notes:                row.Notes,
+			Outcome:              outcomeToPtr(row.Outcome),
+			CoordinatorID:        row.CoordinatorID,
+			CoordinatorFirstName: row.CoordinatorFirstName,
+			CoordinatorLastName:  row.CoordinatorLastName,
+		}
+	})
+
+	pag := resp.PagResp(result, int(totalCount), int(page), int(pageSize))
+	return &pag, nil
+}
+
+func toEvaluationRecordResponse(eval db.Evaluation) *EvaluationRecordResponse {
+	return &EvaluationRecordResponse{
+		ID:            eval.ID,
+		ClientID:      eval.ClientID,
+		CoordinatorID: eval.CoordinatorID,
+		ScheduledDate: eval.ScheduledDate.Time,
+		CompletedDate: dateToPtr(eval.CompletedDate),
+		Notes:         eval.Notes,
+		Outcome:       outcomeToPtr(eval.Outcome),
+		CreatedAt:     eval.CreatedAt.Time,
+		UpdatedAt:     eval.UpdatedAt.Time,
+	}
+}
+
+func dateToPtr(d pgtype.Date) *time.Time {
+	if !d.Valid {
+		return nil
+	}
+	return &d.Time
+}
+
+func outcomeToPtr(o db.NullEvaluationOutcomeEnum) *string {
+	if !o.Valid {
+		return nil
+	}
+	outcome := string(o.EvaluationOutcomeEnum)
+	return &outcome
+}
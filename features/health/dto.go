@@ -0,0 +1,26 @@
+package health
+
+import "time"
+
+const (
+	StatusHealthy   = "healthy"
+	StatusUnhealthy = "unhealthy"
+)
+
+type ComponentHealth struct {
+	Status string `json:"status"`
+	Detail string `json:"detail,omitempty"`
+}
+
+type ReadinessResponse struct {
+	Status     string                     `json:"status"`
+	Components map[string]ComponentHealth `json:"components"`
+}
+
+type WorkerHealthResponse struct {
+	WorkerName string     `json:"workerName"`
+	Healthy    bool       `json:"healthy"`
+	LastRunAt  *time.Time `json:"lastRunAt"` // nil when the worker has never run
+	// StaleAfterSeconds is how old the last heartbeat may get before the worker counts as down
+	StaleAfterSeconds int `json:"staleAfterSeconds"`
+}
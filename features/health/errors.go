@@ -0,0 +1,7 @@
+package health
+
+import "errors"
+
+var (
+	ErrInternal = errors.New("internal server error")
+)
@@ -0,0 +1,68 @@
+package health
+
+import (
+	"care-cordination/lib/middleware"
+	"care-cordination/lib/resp"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type HealthHandler struct {
+	healthService HealthService
+	mdw           *middleware.Middleware
+}
+
+func NewHealthHandler(
+	healthService HealthService,
+	mdw *middleware.Middleware,
+) *HealthHandler {
+	return &HealthHandler{
+		healthService: healthService,
+		mdw:           mdw,
+	}
+}
+
+func (h *HealthHandler) SetupHealthRoutes(router *gin.Engine) {
+	// Probes call /readyz without credentials
+	router.GET("/readyz", h.Readyz)
+
+	health := router.Group("/health")
+	health.Use(h.mdw.AuthMdw(), h.mdw.RequirePermission("admin", "manage"))
+	health.GET("/worker", h.GetWorkerHealth)
+}
+
+// @Summary Readiness probe
+// @Description Report the health of the database and the notification worker.
+// @Description The worker is unhealthy when its last heartbeat is older than twice its tick interval.
+// @Tags System
+// @Produce json
+// @Success 200 {object} ReadinessResponse
+// @Failure 503 {object} ReadinessResponse
+// @Router /readyz [get]
+func (h *HealthHandler) Readyz(ctx *gin.Context) {
+	readiness := h.healthService.GetReadiness(ctx)
+	if readiness.Status != StatusHealthy {
+		ctx.JSON(http.StatusServiceUnavailable, readiness)
+		return
+	}
+	ctx.JSON(http.StatusOK, readiness)
+}
+
+// @Summary Get notification worker health
+// @Description Get the notification worker's last heartbeat and whether it is considered down (admin only)
+// @Tags System
+// @Produce json
+// @Success 200 {object} resp.SuccessResponse[WorkerHealthResponse]
+// @Failure 401 {object} resp.ErrorResponse
+// @Failure 403 {object} resp.ErrorResponse
+// @Failure 500 {object} resp.ErrorResponse
+// @Router /health/worker [get]
+func (h *HealthHandler) GetWorkerHealth(ctx *gin.Context) {
+	worker, err := h.healthService.GetWorkerHealth(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, resp.Error(err))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp.Success(worker, "Worker health retrieved successfully"))
+}
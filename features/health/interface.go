@@ -0,0 +1,11 @@
+package health
+
+import "context"
+
+type HealthService interface {
+	// GetReadiness reports each component the API depends on; the API is ready
+	// only when every component is healthy
+	GetReadiness(ctx context.Context) *ReadinessResponse
+	// GetWorkerHealth returns the notification worker's last heartbeat and whether it is stale
+	GetWorkerHealth(ctx context.Context) (*WorkerHealthResponse, error)
+}
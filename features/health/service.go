@@ -0,0 +1,99 @@
+package health
+
+import (
+	db "care-cordination/lib/db/sqlc"
+	"care-cordination/lib/logger"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// NotificationWorkerName is the heartbeat key written by the notification worker
+const NotificationWorkerName = "notification"
+
+// Readiness component names
+const (
+	ComponentDatabase           = "database"
+	ComponentNotificationWorker = "notificationWorker"
+)
+
+type healthService struct {
+	store  db.StoreInterface
+	logger logger.Logger
+	// workerTickInterval is how often the worker runs; two missed runs mark it unhealthy
+	workerTickInterval time.Duration
+}
+
+func NewHealthService(store db.StoreInterface, logger logger.Logger, workerTickInterval time.Duration) HealthService {
+	return &healthService{
+		store:              store,
+		logger:             logger,
+		workerTickInterval: workerTickInterval,
+	}
+}
+
+// heartbeatStale reports whether a heartbeat is older than twice the tick
+// interval, so one slow run does not flag the worker
+func heartbeatStale(lastRunAt, now time.Time, tickInterval time.Duration) bool {
+	return now.Sub(lastRunAt) > 2*tickInterval
+}
+
+func (s *healthService) GetWorkerHealth(ctx context.Context) (*WorkerHealthResponse, error) {
+	result := &WorkerHealthResponse{
+		WorkerName:        NotificationWorkerName,
+		StaleAfterSeconds: int((2 * s.workerTickInterval).Seconds()),
+	}
+
+	heartbeat, err := s.store.GetWorkerHeartbeat(ctx, NotificationWorkerName)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return result, nil
+	}
+	if err != nil {
+		s.logger.Error(ctx, "GetWorkerHealth", "Failed to get worker heartbeat", zap.Error(err))
+		return nil, ErrInternal
+	}
+
+	lastRunAt := heartbeat.LastRunAt.Time
+	result.LastRunAt = &lastRunAt
+	result.Healthy = !heartbeatStale(lastRunAt, time.Now(), s.workerTickInterval)
+	return result, nil
+}
+
+func (s *healthService) GetReadiness(ctx context.Context) *ReadinessResponse {
+	components := map[string]ComponentHealth{}
+
+	worker, err := s.GetWorkerHealth(ctx)
+	switch {
+	case err != nil:
+		components[ComponentDatabase] = ComponentHealth{Status: StatusUnhealthy, Detail: "database query failed"}
+		components[ComponentNotificationWorker] = ComponentHealth{Status: StatusUnhealthy, Detail: "heartbeat unavailable"}
+	case worker.LastRunAt == nil:
+		components[ComponentDatabase] = ComponentHealth{Status: StatusHealthy}
+		components[ComponentNotificationWorker] = ComponentHealth{Status: StatusUnhealthy, Detail: "no heartbeat recorded"}
+	case !worker.Healthy:
+		components[ComponentDatabase] = ComponentHealth{Status: StatusHealthy}
+		components[ComponentNotificationWorker] = ComponentHealth{
+			Status: StatusUnhealthy,
+			Detail: fmt.Sprintf("last heartbeat at %s", worker.LastRunAt.UTC().Format(time.RFC3339)),
+		}
+	default:
+		components[ComponentDatabase] = ComponentHealth{Status: StatusHealthy}
+		components[ComponentNotificationWorker] = ComponentHealth{Status: StatusHealthy}
+	}
+
+	status := StatusHealthy
+	for _, component := range components {
+		if component.Status != StatusHealthy {
+			status = StatusUnhealthy
+		}
+	}
+
+	return &ReadinessResponse{
+		Status:     status,
+		Components: components,
+	}
+}
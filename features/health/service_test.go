@@ -0,0 +1,136 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	db "care-cordination/lib/db/sqlc"
+	dbmocks "care-cordination/lib/db/sqlc/mocks"
+	loggermocks "care-cordination/lib/logger/mocks"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHeartbeatStale(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	tick := 5 * time.Minute
+
+	tests := []struct {
+		name      string
+		lastRunAt time.Time
+		want      bool
+	}{
+		{name: "just_ran", lastRunAt: now.Add(-time.Second), want: false},
+		{name: "one_missed_tick", lastRunAt: now.Add(-9 * time.Minute), want: false},
+		{name: "exactly_two_ticks", lastRunAt: now.Add(-10 * time.Minute), want: false},
+		{name: "older_than_two_ticks", lastRunAt: now.Add(-10*time.Minute - time.Second), want: true},
+		{name: "hours_old", lastRunAt: now.Add(-3 * time.Hour), want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, heartbeatStale(tt.lastRunAt, now, tick))
+		})
+	}
+}
+
+func TestGetReadiness(t *testing.T) {
+	heartbeat := func(age time.Duration) db.WorkerHeartbeat {
+		return db.WorkerHeartbeat{
+			WorkerName: NotificationWorkerName,
+			LastRunAt:  pgtype.Timestamptz{Time: time.Now().Add(-age), Valid: true},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		heartbeat      db.WorkerHeartbeat
+		heartbeatErr   error
+		wantStatus     string
+		wantDatabase   string
+		wantWorker     string
+		wantWorkerInfo string
+	}{
+		{
+			name:         "fresh_heartbeat",
+			heartbeat:    heartbeat(2 * time.Minute),
+			wantStatus:   StatusHealthy,
+			wantDatabase: StatusHealthy,
+			wantWorker:   StatusHealthy,
+		},
+		{
+			name:         "stale_heartbeat",
+			heartbeat:    heartbeat(11 * time.Minute),
+			wantStatus:   StatusUnhealthy,
+			wantDatabase: StatusHealthy,
+			wantWorker:   StatusUnhealthy,
+		},
+		{
+			name:           "worker_never_ran",
+			heartbeatErr:   pgx.ErrNoRows,
+			wantStatus:     StatusUnhealthy,
+			wantDatabase:   StatusHealthy,
+			wantWorker:     StatusUnhealthy,
+			wantWorkerInfo: "no heartbeat recorded",
+		},
+		{
+			name:         "database_down",
+			heartbeatErr: errors.New("connection refused"),
+			wantStatus:   StatusUnhealthy,
+			wantDatabase: StatusUnhealthy,
+			wantWorker:   StatusUnhealthy,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockStore := dbmocks.NewMockStoreInterface(ctrl)
+			mockLogger := loggermocks.NewMockLogger(ctrl)
+			mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			mockStore.EXPECT().
+				GetWorkerHeartbeat(gomock.Any(), NotificationWorkerName).
+				Return(tt.heartbeat, tt.heartbeatErr)
+
+			service := NewHealthService(mockStore, mockLogger, 5*time.Minute)
+			result := service.GetReadiness(context.Background())
+
+			assert.Equal(t, tt.wantStatus, result.Status)
+			require.Contains(t, result.Components, ComponentDatabase)
+			require.Contains(t, result.Components, ComponentNotificationWorker)
+			assert.Equal(t, tt.wantDatabase, result.Components[ComponentDatabase].Status)
+			assert.Equal(t, tt.wantWorker, result.Components[ComponentNotificationWorker].Status)
+			if tt.wantWorkerInfo != "" {
+				assert.Equal(t, tt.wantWorkerInfo, result.Components[ComponentNotificationWorker].Detail)
+			}
+		})
+	}
+}
+
+func TestGetWorkerHealth(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := dbmocks.NewMockStoreInterface(ctrl)
+	lastRunAt := time.Now().Add(-20 * time.Minute)
+	mockStore.EXPECT().
+		GetWorkerHeartbeat(gomock.Any(), NotificationWorkerName).
+		Return(db.WorkerHeartbeat{
+			WorkerName: NotificationWorkerName,
+			LastRunAt:  pgtype.Timestamptz{Time: lastRunAt, Valid: true},
+		}, nil)
+
+	service := NewHealthService(mockStore, loggermocks.NewMockLogger(ctrl), 5*time.Minute)
+	result, err := service.GetWorkerHealth(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, NotificationWorkerName, result.WorkerName)
+	assert.False(t, result.Healthy)
+	require.NotNil(t, result.LastRunAt)
+	assert.True(t, lastRunAt.Equal(*result.LastRunAt))
+	assert.Equal(t, 600, result.StaleAfterSeconds)
+}
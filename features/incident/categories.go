@@ -0,0 +1,20 @@
+package incident
+
+import (
+	db "care-cordination/lib/db/sqlc"
+	"slices"
+)
+
+// CategoriesByType lists the incident categories that fit each incident type
+var CategoriesByType = map[db.IncidentTypeEnum][]db.IncidentCategoryEnum{
+	db.IncidentTypeEnumAggression:       {db.IncidentCategoryEnumAggression, db.IncidentCategoryEnumPropertyDamage},
+	db.IncidentTypeEnumMedicalEmergency: {db.IncidentCategoryEnumMedication, db.IncidentCategoryEnumFall, db.IncidentCategoryEnumSelfHarm},
+	db.IncidentTypeEnumSafetyConcern:    {db.IncidentCategoryEnumAbsconding, db.IncidentCategoryEnumSubstanceUse, db.IncidentCategoryEnumFall},
+	db.IncidentTypeEnumUnwantedBehavior: {db.IncidentCategoryEnumSubstanceUse, db.IncidentCategoryEnumPropertyDamage, db.IncidentCategoryEnumAbsconding},
+	db.IncidentTypeEnumOther:            {db.IncidentCategoryEnumOther},
+}
+
+// categoryFitsType reports whether category is one of the categories of incidentType
+func categoryFitsType(incidentType db.IncidentTypeEnum, category db.IncidentCategoryEnum) bool {
+	return slices.Contains(CategoriesByType[incidentType], category)
+}
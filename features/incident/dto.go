@@ -8,6 +8,7 @@ type CreateIncidentRequest struct {
 	IncidentTime        string `json:"incidentTime"   binding:"required,datetime=15:04"`
 	IncidentType        string `json:"incidentType"        binding:"required,oneof=aggression medical_emergency safety_concern unwanted_behavior other"`
 	IncidentSeverity    string `json:"incidentSeverity"    binding:"required,oneof=minor moderate severe"`
+	IncidentCategory    string `json:"incidentCategory"    binding:"required,oneof=medication aggression fall absconding self_harm substance_use property_damage other"`
 	LocationID          string `json:"locationId"          binding:"required"`
 	CoordinatorID       string `json:"coordinatorId"       binding:"required"`
 	IncidentDescription string `json:"incidentDescription" binding:"required"`
@@ -33,6 +34,7 @@ type ListIncidentsResponse struct {
 	IncidentTime         string    `json:"incidentTime"`
 	IncidentType         string    `json:"incidentType"`
 	IncidentSeverity     string    `json:"incidentSeverity"`
+	IncidentCategory     string    `json:"incidentCategory"`
 	LocationID           string    `json:"locationId"`
 	LocationName         string    `json:"locationName"`
 	CoordinatorID        string    `json:"coordinatorId"`
@@ -72,6 +74,16 @@ type GetIncidentStatsResponse struct {
 	CountsByType     IncidentTypeCounts     `json:"countsByType"`
 }
 
+type GetIncidentTrendRequest struct {
+	Months int `form:"months" binding:"omitempty,min=1,max=60"`
+}
+
+type IncidentTrendMonth struct {
+	Month            string                 `json:"month"` // YYYY-MM
+	CountsBySeverity IncidentSeverityCounts `json:"countsBySeverity"`
+	TotalCount       int                    `json:"totalCount"`
+}
+
 type GetIncidentResponse struct {
 	ID                   string    `json:"id"`
 	ClientID             string    `json:"clientId"`
@@ -81,6 +93,7 @@ type GetIncidentResponse struct {
 	IncidentTime         string    `json:"incidentTime"`
 	IncidentType         string    `json:"incidentType"`
 	IncidentSeverity     string    `json:"incidentSeverity"`
+	IncidentCategory     string    `json:"incidentCategory"`
 	LocationID           string    `json:"locationId"`
 	LocationName         string    `json:"locationName"`
 	CoordinatorID        string    `json:"coordinatorId"`
@@ -91,6 +104,7 @@ type GetIncidentResponse struct {
 	OtherParties         *string   `json:"otherParties"`
 	Status               string    `json:"status"`
 	CreatedAt            time.Time `json:"createdAt"`
+	CreatedByUserID      *string   `json:"createdByUserId"`
 }
 
 type UpdateIncidentRequest struct {
@@ -98,6 +112,7 @@ type UpdateIncidentRequest struct {
 	IncidentTime        *string `json:"incidentTime"   binding:"omitempty,datetime=15:04"`
 	IncidentType        *string `json:"incidentType"        binding:"omitempty,oneof=aggression medical_emergency safety_concern unwanted_behavior other"`
 	IncidentSeverity    *string `json:"incidentSeverity"    binding:"omitempty,oneof=minor moderate severe"`
+	IncidentCategory    *string `json:"incidentCategory"    binding:"omitempty,oneof=medication aggression fall absconding self_harm substance_use property_damage other"`
 	LocationID          *string `json:"locationId"`
 	CoordinatorID       *string `json:"coordinatorId"`
 	IncidentDescription *string `json:"incidentDescription"`
@@ -110,6 +125,10 @@ type UpdateIncidentResponse struct {
 	Success bool `json:"success"`
 }
 
+type DeleteIncidentRequest struct {
+	Reason string `json:"reason" binding:"required"`
+}
+
 type DeleteIncidentResponse struct {
 	Success bool `json:"success"`
 }
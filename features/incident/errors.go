@@ -6,4 +6,5 @@ var (
 	ErrInvalidRequest = errors.New("invalid request")
 	ErrInternal       = errors.New("internal server error")
 	ErrNotFound       = errors.New("incident not found")
+	ErrReasonRequired = errors.New("deletion reason is required")
 )
@@ -29,14 +29,20 @@ func (h *IncidentHandler) SetupIncidentRoutes(router *gin.Engine) {
 
 	incident.POST("", h.mdw.AuthMdw(), h.CreateIncident)
 	incident.GET("/stats", h.mdw.AuthMdw(), h.GetIncidentStats)
-	incident.GET("", h.mdw.AuthMdw(), h.mdw.PaginationMdw(), h.ListIncidents)
+	incident.GET("/stats/trend", h.mdw.AuthMdw(), h.GetIncidentTrend)
+	incident.GET("", h.mdw.AuthMdw(), h.mdw.PaginationMdw(), h.mdw.SearchMdw(), h.ListIncidents)
 	incident.GET("/:id", h.mdw.AuthMdw(), h.GetIncident)
 	incident.PATCH("/:id", h.mdw.AuthMdw(), h.UpdateIncident)
-	incident.DELETE("/:id", h.mdw.AuthMdw(), h.DeleteIncident)
+	incident.DELETE(
+		"/:id",
+		h.mdw.AuthMdw(),
+		h.mdw.RequirePermission("incident", "delete"),
+		h.DeleteIncident,
+	)
 }
 
 // @Summary Create an incident
-// @Description Create a new incident
+// @Description Create a new incident. The category must be one that fits the incident type
 // @Tags Incident
 // @Accept json
 // @Produce json
@@ -100,7 +106,7 @@ func (h *IncidentHandler) GetIncident(ctx *gin.Context) {
 }
 
 // @Summary Update an incident
-// @Description Update an existing incident by ID
+// @Description Update an existing incident by ID. The resulting category must fit the resulting incident type
 // @Tags Incident
 // @Accept json
 // @Produce json
@@ -109,6 +115,7 @@ func (h *IncidentHandler) GetIncident(ctx *gin.Context) {
 // @Success 200 {object} resp.SuccessResponse[UpdateIncidentResponse]
 // @Failure 400 {object} resp.ErrorResponse
 // @Failure 401 {object} resp.ErrorResponse
+// @Failure 404 {object} resp.ErrorResponse
 // @Failure 500 {object} resp.ErrorResponse
 // @Router /incidents/{id} [patch]
 func (h *IncidentHandler) UpdateIncident(ctx *gin.Context) {
@@ -127,6 +134,8 @@ func (h *IncidentHandler) UpdateIncident(ctx *gin.Context) {
 		switch err {
 		case ErrInvalidRequest:
 			ctx.JSON(http.StatusBadRequest, resp.Error(err))
+		case ErrNotFound:
+			ctx.JSON(http.StatusNotFound, resp.Error(err))
 		case ErrInternal:
 			ctx.JSON(http.StatusInternalServerError, resp.Error(err))
 		default:
@@ -138,13 +147,17 @@ func (h *IncidentHandler) UpdateIncident(ctx *gin.Context) {
 }
 
 // @Summary Delete an incident
-// @Description Soft delete an incident by ID
+// @Description Soft delete an incident by ID. Admin only; the reason is recorded in the audit log.
 // @Tags Incident
+// @Accept json
 // @Produce json
 // @Param id path string true "Incident ID"
+// @Param request body DeleteIncidentRequest true "Deletion reason"
 // @Success 200 {object} resp.SuccessResponse[DeleteIncidentResponse]
 // @Failure 400 {object} resp.ErrorResponse
 // @Failure 401 {object} resp.ErrorResponse
+// @Failure 403 {object} resp.ErrorResponse
+// @Failure 404 {object} resp.ErrorResponse
 // @Failure 500 {object} resp.ErrorResponse
 // @Router /incidents/{id} [delete]
 func (h *IncidentHandler) DeleteIncident(ctx *gin.Context) {
@@ -153,9 +166,18 @@ func (h *IncidentHandler) DeleteIncident(ctx *gin.Context) {
 		ctx.JSON(http.StatusBadRequest, resp.Error(ErrInvalidRequest))
 		return
 	}
-	result, err := h.incidentService.DeleteIncident(ctx, id)
+	var req DeleteIncidentRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, resp.Error(ErrReasonRequired))
+		return
+	}
+	result, err := h.incidentService.DeleteIncident(ctx, id, &req)
 	if err != nil {
 		switch err {
+		case ErrReasonRequired:
+			ctx.JSON(http.StatusBadRequest, resp.Error(err))
+		case ErrNotFound:
+			ctx.JSON(http.StatusNotFound, resp.Error(err))
 		case ErrInternal:
 			ctx.JSON(http.StatusInternalServerError, resp.Error(err))
 		default:
@@ -214,3 +236,28 @@ func (h *IncidentHandler) GetIncidentStats(ctx *gin.Context) {
 	}
 	ctx.JSON(http.StatusOK, resp.Success(result, "Incident statistics retrieved successfully"))
 }
+
+// @Summary Get monthly incident trend
+// @Description Get incident counts per severity for each of the last months (12 by default), oldest first. Deleted incidents are not counted
+// @Tags Incident
+// @Produce json
+// @Param months query int false "Number of months, including the current one (1-60, default 12)"
+// @Success 200 {object} resp.SuccessResponse[[]IncidentTrendMonth]
+// @Failure 400 {object} resp.ErrorResponse
+// @Failure 401 {object} resp.ErrorResponse
+// @Failure 500 {object} resp.ErrorResponse
+// @Router /incidents/stats/trend [get]
+func (h *IncidentHandler) GetIncidentTrend(ctx *gin.Context) {
+	var req GetIncidentTrendRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, resp.Error(ErrInvalidRequest))
+		return
+	}
+
+	result, err := h.incidentService.GetIncidentTrendByMonth(ctx, req.Months)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp.Success(result, "Incident trend retrieved successfully"))
+}
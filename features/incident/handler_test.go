@@ -0,0 +1,119 @@
+package incident_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"care-cordination/features/incident"
+	"care-cordination/internal/mocks"
+	"care-cordination/lib/resp"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// ============================================================
+// Test Helpers
+// ============================================================
+
+func setupHandlerTest(t *testing.T) (*gin.Engine, *mocks.MockIncidentService, *gomock.Controller) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	mockService := mocks.NewMockIncidentService(ctrl)
+
+	handler := incident.NewIncidentHandler(mockService, nil)
+
+	router := gin.New()
+	router.POST("/incidents", handler.CreateIncident)
+
+	return router, mockService, ctrl
+}
+
+func performRequest(router *gin.Engine, method, path string, body interface{}) *httptest.ResponseRecorder {
+	jsonBytes, _ := json.Marshal(body)
+	req, _ := http.NewRequest(method, path, bytes.NewBuffer(jsonBytes))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func validCreateIncidentBody() map[string]string {
+	return map[string]string{
+		"clientId":            "client-1",
+		"incidentDate":        "2026-03-01",
+		"incidentTime":        "14:30",
+		"incidentType":        "aggression",
+		"incidentSeverity":    "moderate",
+		"incidentCategory":    "aggression",
+		"locationId":          "loc-1",
+		"coordinatorId":       "emp-1",
+		"incidentDescription": "Client threw a chair",
+		"actionTaken":         "De-escalation talk",
+		"status":              "pending",
+	}
+}
+
+// ============================================================
+// Test: CreateIncident
+// ============================================================
+
+func TestCreateIncidentHandler(t *testing.T) {
+	tests := []struct {
+		name           string
+		modify         func(body map[string]string)
+		setup          func(mockService *mocks.MockIncidentService)
+		expectedStatus int
+	}{
+		{
+			name:   "success",
+			modify: func(body map[string]string) {},
+			setup: func(mockService *mocks.MockIncidentService) {
+				mockService.EXPECT().
+					CreateIncident(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, req *incident.CreateIncidentRequest) (incident.CreateIncidentResponse, error) {
+						assert.Equal(t, "aggression", req.IncidentCategory)
+						return incident.CreateIncidentResponse{ID: "inc-1"}, nil
+					})
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "missing_category",
+			modify:         func(body map[string]string) { delete(body, "incidentCategory") },
+			setup:          func(mockService *mocks.MockIncidentService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "unknown_category",
+			modify:         func(body map[string]string) { body["incidentCategory"] = "weather" },
+			setup:          func(mockService *mocks.MockIncidentService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService, ctrl := setupHandlerTest(t)
+			defer ctrl.Finish()
+
+			tt.setup(mockService)
+			body := validCreateIncidentBody()
+			tt.modify(body)
+
+			w := performRequest(router, "POST", "/incidents", body)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusBadRequest {
+				var response resp.ErrorResponse
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, incident.ErrInvalidRequest.Error(), response.Error)
+			}
+		})
+	}
+}
@@ -5,15 +5,21 @@ import (
 	"context"
 )
 
+//go:generate mockgen -destination=../../internal/mocks/mock_incident_service.go -package=mocks care-cordination/features/incident IncidentService
 type IncidentService interface {
 	CreateIncident(ctx context.Context, req *CreateIncidentRequest) (CreateIncidentResponse, error)
 	GetIncident(ctx context.Context, id string) (*GetIncidentResponse, error)
 	UpdateIncident(ctx context.Context, id string, req *UpdateIncidentRequest) (*UpdateIncidentResponse, error)
-	DeleteIncident(ctx context.Context, id string) (*DeleteIncidentResponse, error)
+	DeleteIncident(
+		ctx context.Context,
+		id string,
+		req *DeleteIncidentRequest,
+	) (*DeleteIncidentResponse, error)
 	ListIncidents(
 		ctx context.Context,
 		req *ListIncidentsRequest,
 	) (*resp.PaginationResponse[ListIncidentsResponse], error)
 
 	GetIncidentStats(ctx context.Context) (*GetIncidentStatsResponse, error)
+	GetIncidentTrendByMonth(ctx context.Context, months int) ([]IncidentTrendMonth, error)
 }
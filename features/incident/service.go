@@ -1,35 +1,44 @@
 package incident
 
 import (
-	"care-cordination/lib/middleware"
 	"care-cordination/features/notification"
+	"care-cordination/lib/audit"
 	db "care-cordination/lib/db/sqlc"
 	"care-cordination/lib/logger"
+	"care-cordination/lib/middleware"
 	"care-cordination/lib/nanoid"
 	"care-cordination/lib/resp"
 	"care-cordination/lib/util"
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"go.uber.org/zap"
 )
 
 type incidentService struct {
-	store               *db.Store
+	store               db.StoreInterface
 	logger              logger.Logger
 	notificationService notification.NotificationService
+	auditLogger         audit.AuditLogger
 }
 
 func NewIncidentService(
-	store *db.Store,
+	store db.StoreInterface,
 	logger logger.Logger,
 	notificationService notification.NotificationService,
+	auditLogger audit.AuditLogger,
 ) IncidentService {
 	return &incidentService{
 		store:               store,
 		logger:              logger,
 		notificationService: notificationService,
+		auditLogger:         auditLogger,
 	}
 }
 
@@ -37,6 +46,10 @@ func (s *incidentService) CreateIncident(
 	ctx context.Context,
 	req *CreateIncidentRequest,
 ) (CreateIncidentResponse, error) {
+	if !categoryFitsType(db.IncidentTypeEnum(req.IncidentType), db.IncidentCategoryEnum(req.IncidentCategory)) {
+		return CreateIncidentResponse{}, ErrInvalidRequest
+	}
+
 	id := nanoid.Generate()
 
 	// Handle optional other_parties
@@ -52,46 +65,89 @@ func (s *incidentService) CreateIncident(
 		IncidentTime:        util.StrToPgtypeTime(req.IncidentTime),
 		IncidentType:        db.IncidentTypeEnum(req.IncidentType),
 		IncidentSeverity:    db.IncidentSeverityEnum(req.IncidentSeverity),
+		IncidentCategory:    db.IncidentCategoryEnum(req.IncidentCategory),
 		LocationID:          req.LocationID,
 		CoordinatorID:       req.CoordinatorID,
 		IncidentDescription: req.IncidentDescription,
 		ActionTaken:         req.ActionTaken,
 		OtherParties:        otherParties,
 		Status:              db.IncidentStatusEnum(req.Status),
+		CreatedByUserID:     util.GetUserIDPtr(ctx),
 	})
 	if err != nil {
 		s.logger.Error(ctx, "CreateIncident", "Failed to create incident", zap.Error(err))
 		return CreateIncidentResponse{}, ErrInternal
 	}
 
-	// Trigger: Notify all admins about new incident
-	if s.notificationService != nil {
-		resourceType := notification.ResourceTypeIncident
-		resourceID := id
-
-		// Map severity to priority
-		priority := notification.PriorityNormal
-		if req.IncidentSeverity == "severe" {
-			priority = notification.PriorityUrgent
-		} else if req.IncidentSeverity == "moderate" {
-			priority = notification.PriorityHigh
-		}
-
-		s.notificationService.EnqueueForRole(ctx, "admin", &notification.CreateNotificationRequest{
-			Type:         notification.TypeIncidentCreated,
-			Priority:     priority,
-			Title:        "New Incident Reported",
-			Message:      fmt.Sprintf("%s incident at location", req.IncidentType),
-			ResourceType: &resourceType,
-			ResourceID:   &resourceID,
-		})
-	}
+	s.notifyIncidentCreated(ctx, id, req)
 
 	return CreateIncidentResponse{
 		ID: id,
 	}, nil
 }
 
+// incidentManagerRole receives severe incidents on top of the client's coordinator
+const incidentManagerRole = "admin"
+
+// notifyIncidentCreated tells the affected client's coordinator about a new
+// incident and escalates severe ones to managers. Each user is notified once,
+// even when the coordinator is also a manager.
+func (s *incidentService) notifyIncidentCreated(ctx context.Context, incidentID string, req *CreateIncidentRequest) {
+	if s.notificationService == nil {
+		return
+	}
+
+	userIDs := []string{}
+	message := fmt.Sprintf("%s incident reported", req.IncidentType)
+	client, err := s.store.GetClientByID(ctx, db.GetClientByIDParams{ID: req.ClientID})
+	if err != nil {
+		s.logger.Error(ctx, "CreateIncident", "Failed to get client for incident notification", zap.Error(err))
+	} else {
+		message = fmt.Sprintf("%s incident reported for %s %s", req.IncidentType, client.FirstName, client.LastName)
+		coordinator, err := s.store.GetEmployeeByID(ctx, client.CoordinatorID)
+		if err != nil {
+			s.logger.Error(ctx, "CreateIncident", "Failed to get coordinator for incident notification", zap.Error(err))
+		} else {
+			userIDs = append(userIDs, coordinator.UserID)
+		}
+	}
+
+	if req.IncidentSeverity == string(db.IncidentSeverityEnumSevere) {
+		managerIDs, err := s.store.GetUserIDsByRoleName(ctx, incidentManagerRole)
+		if err != nil {
+			s.logger.Error(ctx, "CreateIncident", "Failed to get managers for incident notification", zap.Error(err))
+		}
+		for _, userID := range managerIDs {
+			if !slices.Contains(userIDs, userID) {
+				userIDs = append(userIDs, userID)
+			}
+		}
+	}
+
+	if len(userIDs) == 0 {
+		return
+	}
+
+	// Map severity to priority
+	priority := notification.PriorityNormal
+	if req.IncidentSeverity == string(db.IncidentSeverityEnumSevere) {
+		priority = notification.PriorityUrgent
+	} else if req.IncidentSeverity == string(db.IncidentSeverityEnumModerate) {
+		priority = notification.PriorityHigh
+	}
+
+	resourceType := notification.ResourceTypeIncident
+	resourceID := incidentID
+	s.notificationService.EnqueueForUsers(userIDs, &notification.CreateNotificationRequest{
+		Type:         notification.TypeIncidentCreated,
+		Priority:     priority,
+		Title:        "New Incident Reported",
+		Message:      message,
+		ResourceType: &resourceType,
+		ResourceID:   &resourceID,
+	})
+}
+
 func (s *incidentService) ListIncidents(
 	ctx context.Context,
 	req *ListIncidentsRequest,
@@ -130,6 +186,7 @@ func (s *incidentService) ListIncidents(
 			IncidentTime:         util.PgtypeTimeToString(incident.IncidentTime),
 			IncidentType:         string(incident.IncidentType),
 			IncidentSeverity:     string(incident.IncidentSeverity),
+			IncidentCategory:     string(incident.IncidentCategory),
 			LocationID:           incident.LocationID,
 			LocationName:         incident.LocationName,
 			CoordinatorID:        incident.CoordinatorID,
@@ -190,6 +247,43 @@ func (s *incidentService) GetIncidentStats(
 	}, nil
 }
 
+// GetIncidentTrendByMonth counts non-deleted incidents per month and severity
+// for the last months months (12 when zero), oldest first. The current month is
+// taken in the application timezone, the same one incident dates are recorded in.
+func (s *incidentService) GetIncidentTrendByMonth(
+	ctx context.Context,
+	months int,
+) ([]IncidentTrendMonth, error) {
+	if months == 0 {
+		months = 12
+	}
+	start := util.StartOfMonth(time.Now())
+	currentMonth := time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	rows, err := s.store.GetIncidentTrendByMonth(ctx, db.GetIncidentTrendByMonthParams{
+		CurrentMonth: util.TimeToPgtypeDate(currentMonth),
+		Months:       int32(months),
+	})
+	if err != nil {
+		s.logger.Error(ctx, "GetIncidentTrendByMonth", "Failed to get incident trend", zap.Error(err))
+		return nil, ErrInternal
+	}
+
+	trend := make([]IncidentTrendMonth, 0, len(rows))
+	for _, row := range rows {
+		trend = append(trend, IncidentTrendMonth{
+			Month: row.Month.Time.Format("2006-01"),
+			CountsBySeverity: IncidentSeverityCounts{
+				Minor:    int(row.MinorCount),
+				Moderate: int(row.ModerateCount),
+				Severe:   int(row.SevereCount),
+			},
+			TotalCount: int(row.TotalCount),
+		})
+	}
+	return trend, nil
+}
+
 func (s *incidentService) GetIncident(
 	ctx context.Context,
 	id string,
@@ -208,6 +302,24 @@ func (s *incidentService) GetIncident(
 		return nil, err
 	}
 
+	// Opening the incident settles the user's notifications about it. The fetch
+	// itself already succeeded, so a failure here is only logged.
+	if userID := util.GetUserID(ctx); userID != "" {
+		if err := s.notificationService.MarkNotificationsReadByResource(
+			ctx,
+			userID,
+			notification.ResourceTypeIncident,
+			id,
+		); err != nil {
+			s.logger.Error(
+				ctx,
+				"GetIncident",
+				"Failed to mark incident notifications as read",
+				zap.Error(err),
+			)
+		}
+	}
+
 	return &GetIncidentResponse{
 		ID:                   incident.ID,
 		ClientID:             incident.ClientID,
@@ -217,6 +329,7 @@ func (s *incidentService) GetIncident(
 		IncidentTime:         util.PgtypeTimeToString(incident.IncidentTime),
 		IncidentType:         string(incident.IncidentType),
 		IncidentSeverity:     string(incident.IncidentSeverity),
+		IncidentCategory:     string(incident.IncidentCategory),
 		LocationID:           incident.LocationID,
 		LocationName:         incident.LocationName,
 		CoordinatorID:        incident.CoordinatorID,
@@ -227,6 +340,7 @@ func (s *incidentService) GetIncident(
 		OtherParties:         incident.OtherParties,
 		Status:               string(incident.Status),
 		CreatedAt:            incident.CreatedAt.Time,
+		CreatedByUserID:      incident.CreatedByUserID,
 	}, nil
 }
 
@@ -261,6 +375,37 @@ func (s *incidentService) UpdateIncident(
 		}
 	}
 
+	var incidentCategory db.NullIncidentCategoryEnum
+	if req.IncidentCategory != nil {
+		incidentCategory = db.NullIncidentCategoryEnum{
+			IncidentCategoryEnum: db.IncidentCategoryEnum(*req.IncidentCategory),
+			Valid:                true,
+		}
+	}
+
+	if incidentType.Valid || incidentCategory.Valid {
+		// A change to one side is checked against the stored other side
+		if !incidentType.Valid || !incidentCategory.Valid {
+			current, err := s.store.GetIncident(ctx, id)
+			if err != nil {
+				if errors.Is(err, pgx.ErrNoRows) {
+					return nil, ErrNotFound
+				}
+				s.logger.Error(ctx, "UpdateIncident", "Failed to get incident", zap.Error(err))
+				return nil, ErrInternal
+			}
+			if !incidentType.Valid {
+				incidentType.IncidentTypeEnum = current.IncidentType
+			}
+			if !incidentCategory.Valid {
+				incidentCategory.IncidentCategoryEnum = current.IncidentCategory
+			}
+		}
+		if !categoryFitsType(incidentType.IncidentTypeEnum, incidentCategory.IncidentCategoryEnum) {
+			return nil, ErrInvalidRequest
+		}
+	}
+
 	var status db.NullIncidentStatusEnum
 	if req.Status != nil {
 		status = db.NullIncidentStatusEnum{
@@ -276,6 +421,7 @@ func (s *incidentService) UpdateIncident(
 			IncidentTime:        incidentTime,
 			IncidentType:        incidentType,
 			IncidentSeverity:    incidentSeverity,
+			IncidentCategory:    incidentCategory,
 			LocationID:          req.LocationID,
 			CoordinatorID:       req.CoordinatorID,
 			IncidentDescription: req.IncidentDescription,
@@ -301,13 +447,47 @@ func (s *incidentService) UpdateIncident(
 func (s *incidentService) DeleteIncident(
 	ctx context.Context,
 	id string,
+	req *DeleteIncidentRequest,
 ) (*DeleteIncidentResponse, error) {
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		return nil, ErrReasonRequired
+	}
+
+	userID := util.GetUserID(ctx)
 	err := s.store.ExecTx(ctx, func(tx *db.Queries) error {
-		err := tx.SoftDeleteIncident(ctx, id)
+		clientID, err := tx.SoftDeleteIncident(ctx, db.SoftDeleteIncidentParams{
+			ID:        id,
+			DeletedBy: audit.StrToPtr(userID),
+		})
 		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrNotFound
+			}
 			s.logger.Error(ctx, "DeleteIncident", "Failed to delete incident", zap.Error(err))
 			return ErrInternal
 		}
+		util.SetClientID(ctx, clientID)
+
+		// The audit middleware only records that a delete happened, so the reason
+		// for retracting the incident gets its own entry. It is written before the
+		// delete commits: an incident is never retracted without its reason.
+		if err := s.auditLogger.LogEntry(ctx, audit.AuditEntry{
+			UserID:       userID,
+			EmployeeID:   util.GetEmployeeID(ctx),
+			ClientID:     clientID,
+			Action:       audit.ActionDelete,
+			ResourceType: audit.ResourceTypeIncident,
+			ResourceID:   id,
+			NewValue:     map[string]string{"reason": reason},
+			IPAddress:    util.GetIPAddress(ctx),
+			UserAgent:    util.GetUserAgent(ctx),
+			RequestID:    util.GetRequestID(ctx),
+			Status:       audit.StatusSuccess,
+		}); err != nil {
+			s.logger.Error(ctx, "DeleteIncident", "Failed to record deletion in audit log", zap.Error(err))
+			return ErrInternal
+		}
 		return nil
 	})
 	if err != nil {
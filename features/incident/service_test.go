@@ -0,0 +1,426 @@
+package incident_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"care-cordination/features/incident"
+	"care-cordination/features/notification"
+	notificationmocks "care-cordination/features/notification/mocks"
+	"care-cordination/lib/audit"
+	auditmocks "care-cordination/lib/audit/mocks"
+	db "care-cordination/lib/db/sqlc"
+	dbmocks "care-cordination/lib/db/sqlc/mocks"
+	loggermocks "care-cordination/lib/logger/mocks"
+	"care-cordination/lib/util"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// softDeleteTx stands in for the transaction ExecTx hands DeleteIncident. Its
+// only query is SoftDeleteIncident, which returns clientID or err.
+type softDeleteTx struct {
+	clientID string
+	err      error
+	args     *[]interface{}
+}
+
+func (tx softDeleteTx) Exec(context.Context, string, ...interface{}) (pgconn.CommandTag, error) {
+	return pgconn.CommandTag{}, errors.New("unexpected exec")
+}
+
+func (tx softDeleteTx) Query(context.Context, string, ...interface{}) (pgx.Rows, error) {
+	return nil, errors.New("unexpected query")
+}
+
+func (tx softDeleteTx) QueryRow(_ context.Context, _ string, args ...interface{}) pgx.Row {
+	if tx.args != nil {
+		*tx.args = args
+	}
+	return tx
+}
+
+func (tx softDeleteTx) Scan(dest ...any) error {
+	if tx.err != nil {
+		return tx.err
+	}
+	*dest[0].(*string) = tx.clientID
+	return nil
+}
+
+// expectDeleteTx makes ExecTx run DeleteIncident's transaction against tx and
+// return its error, as the store does after rolling back or committing.
+func expectDeleteTx(mockStore *dbmocks.MockStoreInterface, tx softDeleteTx) {
+	mockStore.EXPECT().
+		ExecTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, fn func(*db.Queries) error) error {
+			return fn(db.New(tx))
+		})
+}
+
+func TestDeleteIncident(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         *incident.DeleteIncidentRequest
+		setup       func(t *testing.T, mockStore *dbmocks.MockStoreInterface, mockAudit *auditmocks.MockAuditLogger)
+		wantErr     bool
+		expectedErr error
+	}{
+		{
+			name: "success_records_reason_in_audit_log",
+			req:  &incident.DeleteIncidentRequest{Reason: "  Filed for the wrong client  "},
+			setup: func(t *testing.T, mockStore *dbmocks.MockStoreInterface, mockAudit *auditmocks.MockAuditLogger) {
+				var args []interface{}
+				expectDeleteTx(mockStore, softDeleteTx{clientID: "client-1", args: &args})
+				t.Cleanup(func() {
+					assert.Equal(t, []interface{}{"inc-1", util.StrPtr("admin-1")}, args)
+				})
+				mockAudit.EXPECT().
+					LogEntry(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, entry audit.AuditEntry) error {
+						assert.Equal(t, audit.ActionDelete, entry.Action)
+						assert.Equal(t, audit.ResourceTypeIncident, entry.ResourceType)
+						assert.Equal(t, "inc-1", entry.ResourceID)
+						assert.Equal(t, "client-1", entry.ClientID)
+						assert.Equal(t, "admin-1", entry.UserID)
+						assert.Equal(t, audit.StatusSuccess, entry.Status)
+						assert.Equal(t, map[string]string{"reason": "Filed for the wrong client"}, entry.NewValue)
+						return nil
+					})
+			},
+			wantErr: false,
+		},
+		{
+			name:        "missing_reason",
+			req:         &incident.DeleteIncidentRequest{Reason: "   "},
+			setup:       func(t *testing.T, mockStore *dbmocks.MockStoreInterface, mockAudit *auditmocks.MockAuditLogger) {},
+			wantErr:     true,
+			expectedErr: incident.ErrReasonRequired,
+		},
+		{
+			name: "not_found_or_already_deleted",
+			req:  &incident.DeleteIncidentRequest{Reason: "Duplicate report"},
+			setup: func(t *testing.T, mockStore *dbmocks.MockStoreInterface, mockAudit *auditmocks.MockAuditLogger) {
+				expectDeleteTx(mockStore, softDeleteTx{err: pgx.ErrNoRows})
+			},
+			wantErr:     true,
+			expectedErr: incident.ErrNotFound,
+		},
+		{
+			name: "db_error",
+			req:  &incident.DeleteIncidentRequest{Reason: "Duplicate report"},
+			setup: func(t *testing.T, mockStore *dbmocks.MockStoreInterface, mockAudit *auditmocks.MockAuditLogger) {
+				expectDeleteTx(mockStore, softDeleteTx{err: assert.AnError})
+			},
+			wantErr:     true,
+			expectedErr: incident.ErrInternal,
+		},
+		{
+			// The failed audit write fails the transaction, so the delete rolls back
+			name: "audit_failure_fails_delete",
+			req:  &incident.DeleteIncidentRequest{Reason: "Duplicate report"},
+			setup: func(t *testing.T, mockStore *dbmocks.MockStoreInterface, mockAudit *auditmocks.MockAuditLogger) {
+				expectDeleteTx(mockStore, softDeleteTx{clientID: "client-1"})
+				mockAudit.EXPECT().
+					LogEntry(gomock.Any(), gomock.Any()).
+					Return(assert.AnError)
+			},
+			wantErr:     true,
+			expectedErr: incident.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := dbmocks.NewMockStoreInterface(ctrl)
+			mockLogger := loggermocks.NewMockLogger(ctrl)
+			mockAudit := auditmocks.NewMockAuditLogger(ctrl)
+
+			mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+			tt.setup(t, mockStore, mockAudit)
+
+			service := incident.NewIncidentService(mockStore, mockLogger, nil, mockAudit)
+			ctx := context.WithValue(context.Background(), util.UserIDKey, "admin-1")
+
+			resp, err := service.DeleteIncident(ctx, "inc-1", tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				if tt.expectedErr != nil {
+					assert.ErrorIs(t, err, tt.expectedErr)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, resp.Success)
+		})
+	}
+}
+
+func TestCreateIncident_Notifications(t *testing.T) {
+	tests := []struct {
+		name          string
+		severity      string
+		managers      []string
+		wantUserIDs   []string
+		wantPriority  string
+		expectManager bool
+	}{
+		{
+			name:          "severe_notifies_coordinator_and_managers",
+			severity:      "severe",
+			managers:      []string{"manager-user", "coord-user"},
+			wantUserIDs:   []string{"coord-user", "manager-user"},
+			wantPriority:  notification.PriorityUrgent,
+			expectManager: true,
+		},
+		{
+			name:         "minor_notifies_only_coordinator",
+			severity:     "minor",
+			wantUserIDs:  []string{"coord-user"},
+			wantPriority: notification.PriorityNormal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := dbmocks.NewMockStoreInterface(ctrl)
+			mockLogger := loggermocks.NewMockLogger(ctrl)
+			mockAudit := auditmocks.NewMockAuditLogger(ctrl)
+			mockNotifier := notificationmocks.NewMockNotificationService(ctrl)
+
+			mockStore.EXPECT().CreateIncident(gomock.Any(), gomock.Any()).Return(nil)
+			mockStore.EXPECT().
+				GetClientByID(gomock.Any(), db.GetClientByIDParams{ID: "client-1"}).
+				Return(db.Client{ID: "client-1", FirstName: "Jan", LastName: "Jansen", CoordinatorID: "emp-1"}, nil)
+			mockStore.EXPECT().
+				GetEmployeeByID(gomock.Any(), "emp-1").
+				Return(db.GetEmployeeByIDRow{ID: "emp-1", UserID: "coord-user"}, nil)
+			if tt.expectManager {
+				mockStore.EXPECT().
+					GetUserIDsByRoleName(gomock.Any(), "admin").
+					Return(tt.managers, nil)
+			}
+
+			// A coordinator who is also a manager is notified once
+			mockNotifier.EXPECT().
+				EnqueueForUsers(tt.wantUserIDs, gomock.Any()).
+				Do(func(_ []string, req *notification.CreateNotificationRequest) {
+					assert.Equal(t, notification.TypeIncidentCreated, req.Type)
+					assert.Equal(t, tt.wantPriority, req.Priority)
+					require.NotNil(t, req.ResourceType)
+					assert.Equal(t, notification.ResourceTypeIncident, *req.ResourceType)
+					require.NotNil(t, req.ResourceID)
+					assert.NotEmpty(t, *req.ResourceID)
+				})
+
+			service := incident.NewIncidentService(mockStore, mockLogger, mockNotifier, mockAudit)
+
+			resp, err := service.CreateIncident(context.Background(), &incident.CreateIncidentRequest{
+				ClientID:         "client-1",
+				IncidentDate:     "2026-03-01",
+				IncidentTime:     "14:30",
+				IncidentType:     "aggression",
+				IncidentCategory: "property_damage",
+				IncidentSeverity: tt.severity,
+				LocationID:       "loc-1",
+				CoordinatorID:    "emp-1",
+				Status:           "pending",
+			})
+
+			require.NoError(t, err)
+			assert.NotEmpty(t, resp.ID)
+		})
+	}
+}
+
+func TestCreateIncident_CategoryMustFitType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Rejected before anything is stored
+	mockStore := dbmocks.NewMockStoreInterface(ctrl)
+	service := incident.NewIncidentService(mockStore, loggermocks.NewMockLogger(ctrl), nil, auditmocks.NewMockAuditLogger(ctrl))
+
+	_, err := service.CreateIncident(context.Background(), &incident.CreateIncidentRequest{
+		ClientID:         "client-1",
+		IncidentDate:     "2026-03-01",
+		IncidentTime:     "14:30",
+		IncidentType:     "medical_emergency",
+		IncidentCategory: "absconding",
+		IncidentSeverity: "minor",
+		LocationID:       "loc-1",
+		CoordinatorID:    "emp-1",
+		Status:           "pending",
+	})
+
+	assert.ErrorIs(t, err, incident.ErrInvalidRequest)
+}
+
+func TestUpdateIncident_CategoryMustFitType(t *testing.T) {
+	stored := db.GetIncidentRow{
+		ID:               "inc-1",
+		IncidentType:     db.IncidentTypeEnumAggression,
+		IncidentCategory: db.IncidentCategoryEnumPropertyDamage,
+	}
+
+	tests := []struct {
+		name        string
+		req         *incident.UpdateIncidentRequest
+		loadsStored bool
+		getErr      error
+		wantErr     error
+	}{
+		{
+			name: "matching_pair",
+			req:  &incident.UpdateIncidentRequest{IncidentType: util.StrPtr("medical_emergency"), IncidentCategory: util.StrPtr("fall")},
+		},
+		{
+			name:    "mismatched_pair",
+			req:     &incident.UpdateIncidentRequest{IncidentType: util.StrPtr("other"), IncidentCategory: util.StrPtr("fall")},
+			wantErr: incident.ErrInvalidRequest,
+		},
+		{
+			name:        "category_fits_stored_type",
+			req:         &incident.UpdateIncidentRequest{IncidentCategory: util.StrPtr("aggression")},
+			loadsStored: true,
+		},
+		{
+			name:        "category_does_not_fit_stored_type",
+			req:         &incident.UpdateIncidentRequest{IncidentCategory: util.StrPtr("medication")},
+			loadsStored: true,
+			wantErr:     incident.ErrInvalidRequest,
+		},
+		{
+			name:        "type_does_not_fit_stored_category",
+			req:         &incident.UpdateIncidentRequest{IncidentType: util.StrPtr("medical_emergency")},
+			loadsStored: true,
+			wantErr:     incident.ErrInvalidRequest,
+		},
+		{
+			name:        "missing_incident",
+			req:         &incident.UpdateIncidentRequest{IncidentType: util.StrPtr("other")},
+			loadsStored: true,
+			getErr:      pgx.ErrNoRows,
+			wantErr:     incident.ErrNotFound,
+		},
+		{
+			name: "neither_changed",
+			req:  &incident.UpdateIncidentRequest{ActionTaken: util.StrPtr("Called the family")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := dbmocks.NewMockStoreInterface(ctrl)
+			if tt.loadsStored {
+				mockStore.EXPECT().GetIncident(gomock.Any(), "inc-1").Return(stored, tt.getErr)
+			}
+			if tt.wantErr == nil {
+				mockStore.EXPECT().ExecTx(gomock.Any(), gomock.Any()).Return(nil)
+			}
+			service := incident.NewIncidentService(mockStore, loggermocks.NewMockLogger(ctrl), nil, auditmocks.NewMockAuditLogger(ctrl))
+
+			_, err := service.UpdateIncident(context.Background(), "inc-1", tt.req)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGetIncident_MarksNotificationsRead(t *testing.T) {
+	tests := []struct {
+		name    string
+		markErr error
+	}{
+		{name: "success"},
+		// Failing to clear notifications doesn't fail the fetch
+		{name: "mark_read_error", markErr: notification.ErrInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := dbmocks.NewMockStoreInterface(ctrl)
+			mockLogger := loggermocks.NewMockLogger(ctrl)
+			mockAudit := auditmocks.NewMockAuditLogger(ctrl)
+			mockNotifier := notificationmocks.NewMockNotificationService(ctrl)
+
+			mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			mockStore.EXPECT().ExecTx(gomock.Any(), gomock.Any()).Return(nil)
+			mockNotifier.EXPECT().
+				MarkNotificationsReadByResource(gomock.Any(), "user-1", notification.ResourceTypeIncident, "inc-1").
+				Return(tt.markErr)
+
+			service := incident.NewIncidentService(mockStore, mockLogger, mockNotifier, mockAudit)
+			ctx := context.WithValue(context.Background(), util.UserIDKey, "user-1")
+
+			resp, err := service.GetIncident(ctx, "inc-1")
+
+			require.NoError(t, err)
+			assert.NotNil(t, resp)
+		})
+	}
+}
+
+func TestGetIncidentTrendByMonth(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := dbmocks.NewMockStoreInterface(ctrl)
+	mockLogger := loggermocks.NewMockLogger(ctrl)
+
+	start := util.StartOfMonth(time.Now())
+	currentMonth := time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, time.UTC)
+	previousMonth := currentMonth.AddDate(0, -1, 0)
+
+	mockStore.EXPECT().
+		GetIncidentTrendByMonth(gomock.Any(), db.GetIncidentTrendByMonthParams{
+			CurrentMonth: util.TimeToPgtypeDate(currentMonth),
+			Months:       12,
+		}).
+		Return([]db.GetIncidentTrendByMonthRow{
+			{Month: util.TimeToPgtypeDate(previousMonth), MinorCount: 2, SevereCount: 1, TotalCount: 3},
+			{Month: util.TimeToPgtypeDate(currentMonth), ModerateCount: 1, TotalCount: 1},
+		}, nil)
+
+	service := incident.NewIncidentService(mockStore, mockLogger, nil, nil)
+
+	trend, err := service.GetIncidentTrendByMonth(context.Background(), 0)
+	require.NoError(t, err)
+	assert.Equal(t, []incident.IncidentTrendMonth{
+		{
+			Month:            previousMonth.Format("2006-01"),
+			CountsBySeverity: incident.IncidentSeverityCounts{Minor: 2, Severe: 1},
+			TotalCount:       3,
+		},
+		{
+			Month:            currentMonth.Format("2006-01"),
+			CountsBySeverity: incident.IncidentSeverityCounts{Moderate: 1},
+			TotalCount:       1,
+		},
+	}, trend)
+}
@@ -22,7 +22,7 @@ type CreateIntakeFormRequest struct {
 	FocusAreas         *string    `json:"focusAreas"`
 	Goals              []GoalItem `json:"goals"              binding:"min=1"`
 	Notes              *string    `json:"notes"`
-	EvaluationInterval *int       `json:"evaluationIntervalWeeks" binding:"omitempty,min=1"`
+	EvaluationInterval *int       `json:"evaluationIntervalWeeks" binding:"omitempty,min=1,max=52"`
 }
 
 type CreateIntakeFormResponse struct {
@@ -31,6 +31,8 @@ type CreateIntakeFormResponse struct {
 
 type ListIntakeFormsRequest struct {
 	Search *string `form:"search"`
+	// Sort is "field" or "field:desc" with field one of intakeSortFields
+	Sort string `form:"sort"`
 }
 
 type ListIntakeFormsResponse struct {
@@ -77,6 +79,10 @@ type GetIntakeFormResponse struct {
 	CoordinatorFirstName *string    `json:"coordinatorFirstName"`
 	CoordinatorLastName  *string    `json:"coordinatorLastName"`
 	HasClient            bool       `json:"hasClient"`
+	CreatedByUserID      *string    `json:"createdByUserId"`
+	// DocumentsComplete is false while any required document is still missing
+	DocumentsComplete bool                     `json:"documentsComplete"`
+	Documents         []IntakeDocumentResponse `json:"documents"`
 }
 
 type UpdateIntakeFormRequest struct {
@@ -90,16 +96,60 @@ type UpdateIntakeFormRequest struct {
 	FocusAreas         *string    `json:"focusAreas"`
 	Goals              []GoalItem `json:"goals"`
 	Notes              *string    `json:"notes"`
-	EvaluationInterval *int       `json:"evaluationIntervalWeeks" binding:"omitempty,min=1"`
+	EvaluationInterval *int       `json:"evaluationIntervalWeeks" binding:"omitempty,min=1,max=52"`
 	Status             *string    `json:"status"          binding:"omitempty,oneof=completed pending"`
+	// Admin only: move the client to the coordinator even when they are at their caseload cap
+	OverrideCaseload bool `json:"overrideCaseload"`
+}
+
+type IntakeDocumentResponse struct {
+	DocumentType string     `json:"documentType"`
+	Status       string     `json:"status"`
+	ReceivedAt   *time.Time `json:"receivedAt"`
+	UpdatedAt    time.Time  `json:"updatedAt"`
+}
+
+// IntakeRescheduleResponse is one move of an intake appointment
+type IntakeRescheduleResponse struct {
+	ID            string    `json:"id"`
+	OldIntakeDate time.Time `json:"oldIntakeDate"`
+	OldIntakeTime string    `json:"oldIntakeTime"`
+	NewIntakeDate time.Time `json:"newIntakeDate"`
+	NewIntakeTime string    `json:"newIntakeTime"`
+	ChangedBy     *string   `json:"changedBy"`
+	ChangedAt     time.Time `json:"changedAt"`
+}
+
+type UpdateIntakeDocumentRequest struct {
+	Status string `json:"status" binding:"required,oneof=missing received"`
 }
 
 type UpdateIntakeFormResponse struct {
 	ID string `json:"id"`
 }
 
+type DeleteIntakeFormResponse struct {
+	ID string `json:"id"`
+}
+
 type GetIntakeStatsResponse struct {
 	TotalCount           int     `json:"totalCount"`
 	PendingCount         int     `json:"pendingCount"`
 	ConversionPercentage float64 `json:"conversionPercentage"`
 }
+
+type GetAvailableIntakeSlotsRequest struct {
+	CoordinatorID string `form:"coordinatorId" binding:"required"`
+	Date          string `form:"date"          binding:"required,datetime=2006-01-02"`
+}
+
+type IntakeSlot struct {
+	StartTime string `json:"startTime"`
+	EndTime   string `json:"endTime"`
+}
+
+type GetAvailableIntakeSlotsResponse struct {
+	CoordinatorID string       `json:"coordinatorId"`
+	Date          string       `json:"date"`
+	Slots         []IntakeSlot `json:"slots"`
+}
@@ -4,3 +4,14 @@ import "errors"
 
 var ErrInternal = errors.New("internal server error")
 var ErrInvalidRequest = errors.New("invalid request")
+var ErrIntakeNotFound = errors.New("intake form not found")
+var ErrIntakeHasClient = errors.New("intake form has already been converted into a client")
+var ErrInvalidEvaluationInterval = errors.New("evaluation interval must be between 1 and 52 weeks")
+var ErrTextTooLong = errors.New("a text field exceeds the maximum length")
+var ErrIntakeDocumentNotFound = errors.New("document is not on this intake's checklist")
+var ErrRequiredDocumentsMissing = errors.New(
+	"intake cannot be completed while required documents are missing",
+)
+var ErrIntakeSlotUnavailable = errors.New(
+	"intake time is outside the coordinator's availability or already booked",
+)
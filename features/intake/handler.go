@@ -1,8 +1,10 @@
 package intake
 
 import (
+	"care-cordination/lib/assignment"
 	"care-cordination/lib/middleware"
 	"care-cordination/lib/resp"
+	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -26,14 +28,19 @@ func (h *IntakeHandler) SetupIntakeRoutes(router *gin.Engine) {
 	intake.Use(h.mdw.PaginationMdw())
 
 	intake.POST("", h.CreateIntakeForm)
-	intake.GET("", h.ListIntakeForms)
+	intake.GET("", h.mdw.SearchMdw(), h.ListIntakeForms)
 	intake.GET("/stats", h.GetIntakeStats)
+	intake.GET("/slots", h.GetAvailableIntakeSlots)
 	intake.GET("/:id", h.GetIntakeForm)
 	intake.PUT("/:id", h.UpdateIntakeForm)
+	intake.DELETE("/:id", h.DeleteIntakeForm)
+	intake.GET("/:id/documents", h.ListIntakeDocuments)
+	intake.GET("/:id/reschedule-history", h.ListIntakeRescheduleHistory)
+	intake.PUT("/:id/documents/:documentType", h.UpdateIntakeDocument)
 }
 
 // @Summary Create an intake form
-// @Description Create a new intake form
+// @Description Create a new intake form. The intake time must be one of the coordinator's open slots on that date.
 // @Tags Intake
 // @Accept json
 // @Produce json
@@ -41,6 +48,8 @@ func (h *IntakeHandler) SetupIntakeRoutes(router *gin.Engine) {
 // @Success 200 {object} resp.SuccessResponse[CreateIntakeFormResponse]
 // @Failure 400 {object} resp.ErrorResponse
 // @Failure 401 {object} resp.ErrorResponse
+// @Failure 404 {object} resp.ErrorResponse
+// @Failure 409 {object} resp.ErrorResponse
 // @Failure 500 {object} resp.ErrorResponse
 // @Router /intakes [post]
 func (h *IntakeHandler) CreateIntakeForm(ctx *gin.Context) {
@@ -52,7 +61,16 @@ func (h *IntakeHandler) CreateIntakeForm(ctx *gin.Context) {
 
 	result, err := h.intakeService.CreateIntakeForm(ctx, &req)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		switch {
+		case errors.Is(err, ErrInvalidEvaluationInterval), errors.Is(err, ErrTextTooLong):
+			ctx.JSON(http.StatusBadRequest, resp.Error(err))
+		case errors.Is(err, ErrIntakeSlotUnavailable):
+			ctx.JSON(http.StatusConflict, resp.Error(err))
+		case errors.Is(err, assignment.ErrCoordinatorNotFound):
+			ctx.JSON(http.StatusNotFound, resp.Error(err))
+		default:
+			ctx.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		}
 		return
 	}
 
@@ -65,6 +83,7 @@ func (h *IntakeHandler) CreateIntakeForm(ctx *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param search query string false "Search by client first name or last name"
+// @Param sort query string false "Sort as field or field:desc; field is intake_date, name or created_at (default: newest first)"
 // @Success 200 {object} resp.SuccessResponse[resp.PaginationResponse[ListIntakeFormsResponse]]
 // @Failure 400 {object} resp.ErrorResponse
 // @Failure 401 {object} resp.ErrorResponse
@@ -82,7 +101,12 @@ func (h *IntakeHandler) ListIntakeForms(ctx *gin.Context) {
 	}
 	result, err := h.intakeService.ListIntakeForms(ctx, &req)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		switch {
+		case errors.Is(err, ErrInvalidRequest):
+			ctx.JSON(http.StatusBadRequest, resp.Error(err))
+		default:
+			ctx.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		}
 		return
 	}
 	ctx.JSON(http.StatusOK, resp.Success(result, "Intake forms listed successfully"))
@@ -116,7 +140,7 @@ func (h *IntakeHandler) GetIntakeForm(ctx *gin.Context) {
 }
 
 // @Summary Update an intake form
-// @Description Update an existing intake form
+// @Description Update an existing intake form. A new date, time or coordinator must be one of the coordinator's open slots.
 // @Tags Intake
 // @Accept json
 // @Produce json
@@ -125,6 +149,9 @@ func (h *IntakeHandler) GetIntakeForm(ctx *gin.Context) {
 // @Success 200 {object} resp.SuccessResponse[UpdateIntakeFormResponse]
 // @Failure 400 {object} resp.ErrorResponse
 // @Failure 401 {object} resp.ErrorResponse
+// @Failure 403 {object} resp.ErrorResponse
+// @Failure 404 {object} resp.ErrorResponse
+// @Failure 409 {object} resp.ErrorResponse
 // @Failure 500 {object} resp.ErrorResponse
 // @Router /intakes/{id} [put]
 func (h *IntakeHandler) UpdateIntakeForm(ctx *gin.Context) {
@@ -142,13 +169,63 @@ func (h *IntakeHandler) UpdateIntakeForm(ctx *gin.Context) {
 
 	result, err := h.intakeService.UpdateIntakeForm(ctx, id, &req)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		switch {
+		case errors.Is(err, ErrInvalidEvaluationInterval), errors.Is(err, ErrTextTooLong):
+			ctx.JSON(http.StatusBadRequest, resp.Error(err))
+		case errors.Is(err, ErrRequiredDocumentsMissing),
+			errors.Is(err, ErrIntakeSlotUnavailable),
+			errors.Is(err, assignment.ErrCoordinatorLocationMismatch),
+			errors.Is(err, assignment.ErrCoordinatorAtCapacity):
+			ctx.JSON(http.StatusConflict, resp.Error(err))
+		case errors.Is(err, assignment.ErrCaseloadOverrideForbidden):
+			ctx.JSON(http.StatusForbidden, resp.Error(err))
+		case errors.Is(err, assignment.ErrCoordinatorNotFound):
+			ctx.JSON(http.StatusNotFound, resp.Error(err))
+		default:
+			ctx.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		}
 		return
 	}
 
 	ctx.JSON(http.StatusOK, resp.Success(result, "Intake form updated successfully"))
 }
 
+// @Summary Delete an intake form
+// @Description Soft delete an intake form so a new intake can be created for its registration.
+// @Description Intakes that were already converted into a client cannot be deleted.
+// @Tags Intake
+// @Produce json
+// @Param id path string true "Intake Form ID"
+// @Success 200 {object} resp.SuccessResponse[DeleteIntakeFormResponse]
+// @Failure 400 {object} resp.ErrorResponse
+// @Failure 401 {object} resp.ErrorResponse
+// @Failure 404 {object} resp.ErrorResponse
+// @Failure 409 {object} resp.ErrorResponse
+// @Failure 500 {object} resp.ErrorResponse
+// @Router /intakes/{id} [delete]
+func (h *IntakeHandler) DeleteIntakeForm(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, resp.Error(ErrInvalidRequest))
+		return
+	}
+
+	result, err := h.intakeService.DeleteIntakeForm(ctx, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrIntakeNotFound):
+			ctx.JSON(http.StatusNotFound, resp.Error(err))
+		case errors.Is(err, ErrIntakeHasClient):
+			ctx.JSON(http.StatusConflict, resp.Error(err))
+		default:
+			ctx.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp.Success(result, "Intake form deleted successfully"))
+}
+
 // @Summary Get intake statistics
 // @Description Get total count, pending count, and conversion percentage of intake forms
 // @Tags Intake
@@ -165,3 +242,120 @@ func (h *IntakeHandler) GetIntakeStats(ctx *gin.Context) {
 	}
 	ctx.JSON(http.StatusOK, resp.Success(result, "Intake statistics retrieved successfully"))
 }
+
+// @Summary Get available intake slots
+// @Description List the open intake slots for a coordinator on a date, based on their weekly availability and existing intakes and appointments
+// @Tags Intake
+// @Produce json
+// @Param coordinatorId query string true "Coordinator (employee) ID"
+// @Param date query string true "Date (YYYY-MM-DD)"
+// @Success 200 {object} resp.SuccessResponse[GetAvailableIntakeSlotsResponse]
+// @Failure 400 {object} resp.ErrorResponse
+// @Failure 401 {object} resp.ErrorResponse
+// @Failure 500 {object} resp.ErrorResponse
+// @Router /intakes/slots [get]
+func (h *IntakeHandler) GetAvailableIntakeSlots(ctx *gin.Context) {
+	var req GetAvailableIntakeSlotsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, resp.Error(err))
+		return
+	}
+
+	result, err := h.intakeService.GetAvailableIntakeSlots(ctx, &req)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp.Success(result, "Available intake slots retrieved successfully"))
+}
+
+// @Summary List an intake's required documents
+// @Description List the document checklist of an intake form. The required documents depend on the care type.
+// @Tags Intake
+// @Produce json
+// @Param id path string true "Intake Form ID"
+// @Success 200 {object} resp.SuccessResponse[[]IntakeDocumentResponse]
+// @Failure 401 {object} resp.ErrorResponse
+// @Failure 404 {object} resp.ErrorResponse
+// @Failure 500 {object} resp.ErrorResponse
+// @Router /intakes/{id}/documents [get]
+func (h *IntakeHandler) ListIntakeDocuments(ctx *gin.Context) {
+	result, err := h.intakeService.ListIntakeDocuments(ctx, ctx.Param("id"))
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrIntakeNotFound):
+			ctx.JSON(http.StatusNotFound, resp.Error(err))
+		default:
+			ctx.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp.Success(result, "Intake documents retrieved successfully"))
+}
+
+// @Summary List an intake's reschedules
+// @Description List every change of an intake's date or time, oldest first
+// @Tags Intake
+// @Produce json
+// @Param id path string true "Intake Form ID"
+// @Success 200 {object} resp.SuccessResponse[[]IntakeRescheduleResponse]
+// @Failure 401 {object} resp.ErrorResponse
+// @Failure 404 {object} resp.ErrorResponse
+// @Failure 500 {object} resp.ErrorResponse
+// @Router /intakes/{id}/reschedule-history [get]
+func (h *IntakeHandler) ListIntakeRescheduleHistory(ctx *gin.Context) {
+	result, err := h.intakeService.ListIntakeRescheduleHistory(ctx, ctx.Param("id"))
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrIntakeNotFound):
+			ctx.JSON(http.StatusNotFound, resp.Error(err))
+		default:
+			ctx.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp.Success(result, "Intake reschedule history retrieved successfully"))
+}
+
+// @Summary Update a required document
+// @Description Mark a document on the intake's checklist as received or missing
+// @Tags Intake
+// @Accept json
+// @Produce json
+// @Param id path string true "Intake Form ID"
+// @Param documentType path string true "Document type" Enums(identification, care_indication, consent)
+// @Param request body UpdateIntakeDocumentRequest true "New document status"
+// @Success 200 {object} resp.SuccessResponse[IntakeDocumentResponse]
+// @Failure 400 {object} resp.ErrorResponse
+// @Failure 401 {object} resp.ErrorResponse
+// @Failure 404 {object} resp.ErrorResponse
+// @Failure 500 {object} resp.ErrorResponse
+// @Router /intakes/{id}/documents/{documentType} [put]
+func (h *IntakeHandler) UpdateIntakeDocument(ctx *gin.Context) {
+	var req UpdateIntakeDocumentRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, resp.Error(ErrInvalidRequest))
+		return
+	}
+
+	result, err := h.intakeService.UpdateIntakeDocument(
+		ctx,
+		ctx.Param("id"),
+		ctx.Param("documentType"),
+		&req,
+	)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrIntakeDocumentNotFound):
+			ctx.JSON(http.StatusNotFound, resp.Error(err))
+		default:
+			ctx.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp.Success(result, "Intake document updated successfully"))
+}
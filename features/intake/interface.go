@@ -24,5 +24,23 @@ type IntakeService interface {
 		req *UpdateIntakeFormRequest,
 	) (*UpdateIntakeFormResponse, error)
 
+	DeleteIntakeForm(ctx context.Context, id string) (*DeleteIntakeFormResponse, error)
+
 	GetIntakeStats(ctx context.Context) (*GetIntakeStatsResponse, error)
+
+	GetAvailableIntakeSlots(
+		ctx context.Context,
+		req *GetAvailableIntakeSlotsRequest,
+	) (*GetAvailableIntakeSlotsResponse, error)
+
+	ListIntakeDocuments(ctx context.Context, id string) ([]IntakeDocumentResponse, error)
+
+	ListIntakeRescheduleHistory(ctx context.Context, id string) ([]IntakeRescheduleResponse, error)
+
+	UpdateIntakeDocument(
+		ctx context.Context,
+		id string,
+		documentType string,
+		req *UpdateIntakeDocumentRequest,
+	) (*IntakeDocumentResponse, error)
 }
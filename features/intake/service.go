@@ -1,6 +1,7 @@
 package intake
 
 import (
+	"care-cordination/lib/assignment"
 	"care-cordination/lib/middleware"
 	db "care-cordination/lib/db/sqlc"
 	"care-cordination/lib/logger"
@@ -8,19 +9,39 @@ import (
 	"care-cordination/lib/resp"
 	"care-cordination/lib/util"
 	"context"
+	"errors"
+	"slices"
+	"time"
 
+	"github.com/jackc/pgx/v5"
 	"go.uber.org/zap"
 )
 
+// intakeSlotMinutes is the length of an intake appointment and of the slots
+// offered within a coordinator's availability
+const intakeSlotMinutes = 60
+
 type intakeService struct {
-	db     *db.Store
-	logger logger.Logger
+	db                       *db.Store
+	logger                   logger.Logger
+	maxTextLength            int
+	coordinatorLocationCheck assignment.Mode
+	caseloadLimit            assignment.CaseloadLimit
 }
 
-func NewIntakeService(db *db.Store, logger logger.Logger) IntakeService {
+func NewIntakeService(
+	db *db.Store,
+	logger logger.Logger,
+	maxTextLength int,
+	coordinatorLocationCheck assignment.Mode,
+	caseloadLimit assignment.CaseloadLimit,
+) IntakeService {
 	return &intakeService{
-		db:     db,
-		logger: logger,
+		db:                       db,
+		logger:                   logger,
+		maxTextLength:            maxTextLength,
+		coordinatorLocationCheck: coordinatorLocationCheck,
+		caseloadLimit:            caseloadLimit,
 	}
 }
 
@@ -28,13 +49,25 @@ func (s *intakeService) CreateIntakeForm(
 	ctx context.Context,
 	req *CreateIntakeFormRequest,
 ) (*CreateIntakeFormResponse, error) {
+	if !util.ValidEvaluationInterval(req.EvaluationInterval) {
+		return nil, ErrInvalidEvaluationInterval
+	}
+	if !util.TextWithinLimit(
+		s.maxTextLength, req.FamilySituation, req.Limitations, req.FocusAreas, req.Notes,
+	) {
+		return nil, ErrTextTooLong
+	}
+
+	intakeDate := util.StrToPgtypeDate(req.IntakeDate)
+	intakeTime := util.StrToPgtypeTime(req.IntakeTime)
+
 	id := nanoid.Generate()
 	_, err := s.db.CreateIntakeFormTx(ctx, db.CreateIntakeFormTxParams{
 		IntakeForm: db.CreateIntakeFormParams{
 			ID:                      id,
 			RegistrationFormID:      req.RegistrationFormID,
-			IntakeDate:              util.StrToPgtypeDate(req.IntakeDate),
-			IntakeTime:              util.StrToPgtypeTime(req.IntakeTime),
+			IntakeDate:              intakeDate,
+			IntakeTime:              intakeTime,
 			LocationID:              req.LocationID,
 			CoordinatorID:           req.CoordinatorID,
 			FamilySituation:         req.FamilySituation,
@@ -43,6 +76,7 @@ func (s *intakeService) CreateIntakeForm(
 			FocusAreas:              req.FocusAreas,
 			Notes:                   req.Notes,
 			EvaluationIntervalWeeks: util.IntToPointerInt32(req.EvaluationInterval),
+			CreatedByUserID:         util.GetUserIDPtr(ctx),
 		},
 		RegistrationFormID: req.RegistrationFormID,
 		RegistrationFormStatus: db.NullRegistrationStatusEnum{
@@ -57,8 +91,20 @@ func (s *intakeService) CreateIntakeForm(
 				Description:  g.Description,
 			}
 		}),
+		Slot: &db.IntakeSlotCheck{
+			CoordinatorID: req.CoordinatorID,
+			Date:          intakeDate,
+			Time:          intakeTime,
+			SlotMinutes:   intakeSlotMinutes,
+		},
 	})
 	if err != nil {
+		if errors.Is(err, db.ErrIntakeSlotTaken) {
+			return nil, ErrIntakeSlotUnavailable
+		}
+		if errors.Is(err, assignment.ErrCoordinatorNotFound) {
+			return nil, err
+		}
 		s.logger.Error(ctx, "CreateIntakeForm", "Failed to create intake form", zap.Error(err))
 		return nil, ErrInternal
 	}
@@ -67,19 +113,28 @@ func (s *intakeService) CreateIntakeForm(
 	}, nil
 }
 
+// intakeSortFields are the fields the intake list can be sorted by; without a
+// sort the newest intake comes first
+var intakeSortFields = []string{"intake_date", "name", "created_at"}
+
 func (s *intakeService) ListIntakeForms(
 	ctx context.Context,
 	req *ListIntakeFormsRequest,
 ) (*resp.PaginationResponse[ListIntakeFormsResponse], error) {
+	sort, err := util.ParseSort(req.Sort, intakeSortFields...)
+	if err != nil {
+		return nil, ErrInvalidRequest
+	}
 	limit, offset, page, pageSize := middleware.GetPaginationParams(ctx)
 
 	var intakeForms []db.ListIntakeFormsRow
-	err := s.db.ExecTx(ctx, func(q *db.Queries) error {
+	err = s.db.ExecTx(ctx, func(q *db.Queries) error {
 		var err error
 		intakeForms, err = q.ListIntakeForms(ctx, db.ListIntakeFormsParams{
 			Limit:   limit,
 			Offset:  offset,
 			Column3: *req.Search,
+			Sort:    sort.Key(),
 		})
 		return err
 	})
@@ -138,6 +193,12 @@ func (s *intakeService) GetIntakeForm(
 		return nil, ErrInternal
 	}
 
+	documents, err := s.db.ListIntakeDocuments(ctx, id)
+	if err != nil {
+		s.logger.Error(ctx, "GetIntakeForm", "Failed to list intake documents", zap.Error(err))
+		return nil, ErrInternal
+	}
+
 	var careType *string
 	if intakeForm.CareType.Valid {
 		ct := string(intakeForm.CareType.CareTypeEnum)
@@ -167,6 +228,9 @@ func (s *intakeService) GetIntakeForm(
 		CoordinatorFirstName: intakeForm.CoordinatorFirstName,
 		CoordinatorLastName:  intakeForm.CoordinatorLastName,
 		HasClient:            intakeForm.HasClient,
+		CreatedByUserID:      intakeForm.CreatedByUserID,
+		DocumentsComplete:    intakeForm.DocumentsComplete,
+		Documents:            util.Map(documents, toIntakeDocumentResponse),
 		Goals: util.Map(intakeGoals, func(g db.ClientGoal) GoalItem {
 			return GoalItem{
 				ID:          &g.ID,
@@ -182,6 +246,15 @@ func (s *intakeService) UpdateIntakeForm(
 	id string,
 	req *UpdateIntakeFormRequest,
 ) (*UpdateIntakeFormResponse, error) {
+	if !util.ValidEvaluationInterval(req.EvaluationInterval) {
+		return nil, ErrInvalidEvaluationInterval
+	}
+	if !util.TextWithinLimit(
+		s.maxTextLength, req.FamilySituation, req.Limitations, req.FocusAreas, req.Notes,
+	) {
+		return nil, ErrTextTooLong
+	}
+
 	// Check if a client exists for this intake form
 	intakeFormDetails, err := s.db.GetIntakeFormWithDetails(ctx, id)
 	if err != nil {
@@ -189,6 +262,16 @@ func (s *intakeService) UpdateIntakeForm(
 		return nil, ErrInternal
 	}
 
+	// The client takes over the new coordinator or location, so the pair it
+	// ends up with has to pass the coordinator location and caseload checks
+	var caseload *db.CaseloadCheck
+	if intakeFormDetails.HasClient && (req.CoordinatorID != nil || req.LocationID != nil) {
+		caseload, err = s.checkClientAssignment(ctx, intakeFormDetails.ClientID, req)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Build the update params
 	params := db.UpdateIntakeFormParams{
 		ID:                      id,
@@ -210,8 +293,32 @@ func (s *intakeService) UpdateIntakeForm(
 		params.IntakeTime = util.StrToPgtypeTime(*req.IntakeTime)
 	}
 
+	// Moving the intake to another slot or coordinator needs an open slot,
+	// just like booking it
+	var slot *db.IntakeSlotCheck
+	if req.IntakeDate != nil || req.IntakeTime != nil || req.CoordinatorID != nil {
+		slot = &db.IntakeSlotCheck{
+			CoordinatorID: intakeFormDetails.CoordinatorID,
+			Date:          intakeFormDetails.IntakeDate,
+			Time:          intakeFormDetails.IntakeTime,
+			SlotMinutes:   intakeSlotMinutes,
+		}
+		if req.CoordinatorID != nil {
+			slot.CoordinatorID = *req.CoordinatorID
+		}
+		if params.IntakeDate.Valid {
+			slot.Date = params.IntakeDate
+		}
+		if params.IntakeTime.Valid {
+			slot.Time = params.IntakeTime
+		}
+	}
+
 	// Handle status enum field
 	if req.Status != nil {
+		if completionBlocked(*req.Status, intakeFormDetails) {
+			return nil, ErrRequiredDocumentsMissing
+		}
 		params.Status = db.NullIntakeStatusEnum{
 			IntakeStatusEnum: db.IntakeStatusEnum(*req.Status),
 			Valid:            true,
@@ -222,8 +329,19 @@ func (s *intakeService) UpdateIntakeForm(
 	err = s.db.UpdateIntakeFormTx(ctx, db.UpdateIntakeFormTxParams{
 		IntakeForm:   params,
 		UpdateClient: intakeFormDetails.HasClient,
+		ClientID:     intakeFormDetails.ClientID,
+		ChangedBy:    util.GetUserID(ctx),
+		Slot:         slot,
+		Caseload:     caseload,
 	})
 	if err != nil {
+		if errors.Is(err, db.ErrIntakeSlotTaken) {
+			return nil, ErrIntakeSlotUnavailable
+		}
+		if errors.Is(err, assignment.ErrCoordinatorAtCapacity) ||
+			errors.Is(err, assignment.ErrCoordinatorNotFound) {
+			return nil, err
+		}
 		s.logger.Error(ctx, "UpdateIntakeForm", "Failed to update intake form", zap.Error(err))
 		return nil, ErrInternal
 	}
@@ -267,6 +385,95 @@ func (s *intakeService) UpdateIntakeForm(
 	}, nil
 }
 
+// checkClientAssignment runs the coordinator location check against the
+// client's assignment as it will be after req is applied. When the client
+// moves to another coordinator it returns the caseload check for the update
+// transaction to run.
+func (s *intakeService) checkClientAssignment(
+	ctx context.Context,
+	clientID string,
+	req *UpdateIntakeFormRequest,
+) (*db.CaseloadCheck, error) {
+	client, err := s.db.GetClientByID(ctx, db.GetClientByIDParams{ID: clientID})
+	if err != nil {
+		s.logger.Error(ctx, "UpdateIntakeForm", "Failed to get client", zap.Error(err))
+		return nil, ErrInternal
+	}
+	coordinatorID := client.CoordinatorID
+	if req.CoordinatorID != nil {
+		coordinatorID = *req.CoordinatorID
+	}
+	locationID := client.AssignedLocationID
+	if req.LocationID != nil {
+		locationID = *req.LocationID
+	}
+
+	if err := s.coordinatorLocationCheck.CheckCoordinatorLocation(
+		ctx, s.db, s.logger, "UpdateIntakeForm", coordinatorID, locationID,
+	); err != nil {
+		if errors.Is(err, assignment.ErrCoordinatorLocationMismatch) {
+			return nil, err
+		}
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, assignment.ErrCoordinatorNotFound
+		}
+		s.logger.Error(ctx, "UpdateIntakeForm", "Failed to check coordinator location", zap.Error(err))
+		return nil, ErrInternal
+	}
+
+	if coordinatorID == client.CoordinatorID {
+		return nil, nil
+	}
+	caseload, err := s.caseloadLimit.CaseloadCheck(
+		ctx, s.db, coordinatorID, clientID, req.OverrideCaseload,
+	)
+	if err != nil {
+		if errors.Is(err, assignment.ErrCaseloadOverrideForbidden) {
+			return nil, err
+		}
+		s.logger.Error(ctx, "UpdateIntakeForm", "Failed to check caseload override", zap.Error(err))
+		return nil, ErrInternal
+	}
+	return caseload, nil
+}
+
+func (s *intakeService) DeleteIntakeForm(
+	ctx context.Context,
+	id string,
+) (*DeleteIntakeFormResponse, error) {
+	intakeForm, err := s.db.GetIntakeFormWithDetails(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrIntakeNotFound
+		}
+		s.logger.Error(ctx, "DeleteIntakeForm", "Failed to get intake form", zap.Error(err))
+		return nil, ErrInternal
+	}
+
+	// Once converted, the client references the intake and it must stay
+	if intakeForm.HasClient {
+		return nil, ErrIntakeHasClient
+	}
+
+	result, err := s.db.DeleteIntakeFormTx(ctx, db.DeleteIntakeFormTxParams{
+		IntakeFormID:       id,
+		RegistrationFormID: intakeForm.RegistrationFormID,
+		ChangedBy:          util.GetUserID(ctx),
+	})
+	if err != nil {
+		s.logger.Error(ctx, "DeleteIntakeForm", "Failed to delete intake form", zap.Error(err))
+		return nil, ErrInternal
+	}
+	// Deleted or converted by a concurrent request since it was read
+	if !result.Deleted {
+		return nil, ErrIntakeNotFound
+	}
+
+	return &DeleteIntakeFormResponse{
+		ID: id,
+	}, nil
+}
+
 func (s *intakeService) GetIntakeStats(
 	ctx context.Context,
 ) (*GetIntakeStatsResponse, error) {
@@ -285,3 +492,136 @@ func (s *intakeService) GetIntakeStats(
 		ConversionPercentage: conversionPct,
 	}, nil
 }
+
+func (s *intakeService) GetAvailableIntakeSlots(
+	ctx context.Context,
+	req *GetAvailableIntakeSlotsRequest,
+) (*GetAvailableIntakeSlotsResponse, error) {
+	slots, err := s.db.GetAvailableIntakeSlots(ctx, db.GetAvailableIntakeSlotsParams{
+		SlotMinutes:   intakeSlotMinutes,
+		Date:          util.StrToPgtypeDate(req.Date),
+		CoordinatorID: req.CoordinatorID,
+		Timezone:      util.AppLocation().String(),
+	})
+	if err != nil {
+		s.logger.Error(ctx, "GetAvailableIntakeSlots", "Failed to get available intake slots", zap.Error(err))
+		return nil, ErrInternal
+	}
+
+	return &GetAvailableIntakeSlotsResponse{
+		CoordinatorID: req.CoordinatorID,
+		Date:          req.Date,
+		Slots: util.Map(slots, func(slot db.GetAvailableIntakeSlotsRow) IntakeSlot {
+			return IntakeSlot{
+				StartTime: util.PgtypeTimeToString(slot.StartTime),
+				EndTime:   util.PgtypeTimeToString(slot.EndTime),
+			}
+		}),
+	}, nil
+}
+
+// completionBlocked reports whether moving the intake to newStatus would complete
+// it while required documents are still missing
+func completionBlocked(newStatus string, intake db.GetIntakeFormWithDetailsRow) bool {
+	return newStatus == string(db.IntakeStatusEnumCompleted) &&
+		intake.Status != db.IntakeStatusEnumCompleted &&
+		!intake.DocumentsComplete
+}
+
+func (s *intakeService) ListIntakeDocuments(
+	ctx context.Context,
+	id string,
+) ([]IntakeDocumentResponse, error) {
+	if _, err := s.db.GetIntakeForm(ctx, id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrIntakeNotFound
+		}
+		s.logger.Error(ctx, "ListIntakeDocuments", "Failed to get intake form", zap.Error(err))
+		return nil, ErrInternal
+	}
+
+	documents, err := s.db.ListIntakeDocuments(ctx, id)
+	if err != nil {
+		s.logger.Error(ctx, "ListIntakeDocuments", "Failed to list intake documents", zap.Error(err))
+		return nil, ErrInternal
+	}
+
+	return util.Map(documents, toIntakeDocumentResponse), nil
+}
+
+func (s *intakeService) ListIntakeRescheduleHistory(
+	ctx context.Context,
+	id string,
+) ([]IntakeRescheduleResponse, error) {
+	if _, err := s.db.GetIntakeForm(ctx, id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrIntakeNotFound
+		}
+		s.logger.Error(ctx, "ListIntakeRescheduleHistory", "Failed to get intake form", zap.Error(err))
+		return nil, ErrInternal
+	}
+
+	history, err := s.db.ListIntakeRescheduleHistory(ctx, id)
+	if err != nil {
+		s.logger.Error(
+			ctx,
+			"ListIntakeRescheduleHistory",
+			"Failed to list intake reschedule history",
+			zap.Error(err),
+		)
+		return nil, ErrInternal
+	}
+
+	return util.Map(history, func(h db.ListIntakeRescheduleHistoryRow) IntakeRescheduleResponse {
+		return IntakeRescheduleResponse{
+			ID:            h.ID,
+			OldIntakeDate: h.OldIntakeDate.Time,
+			OldIntakeTime: util.PgtypeTimeToString(h.OldIntakeTime),
+			NewIntakeDate: h.NewIntakeDate.Time,
+			NewIntakeTime: util.PgtypeTimeToString(h.NewIntakeTime),
+			ChangedBy:     h.ChangedBy,
+			ChangedAt:     h.ChangedAt.Time,
+		}
+	}), nil
+}
+
+func (s *intakeService) UpdateIntakeDocument(
+	ctx context.Context,
+	id string,
+	documentType string,
+	req *UpdateIntakeDocumentRequest,
+) (*IntakeDocumentResponse, error) {
+	if !slices.Contains(db.AllIntakeDocumentTypeEnumValues(), db.IntakeDocumentTypeEnum(documentType)) {
+		return nil, ErrIntakeDocumentNotFound
+	}
+
+	document, err := s.db.UpdateIntakeDocumentStatus(ctx, db.UpdateIntakeDocumentStatusParams{
+		Status:          db.IntakeDocumentStatusEnum(req.Status),
+		UpdatedByUserID: util.GetUserIDPtr(ctx),
+		IntakeFormID:    id,
+		DocumentType:    db.IntakeDocumentTypeEnum(documentType),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrIntakeDocumentNotFound
+		}
+		s.logger.Error(ctx, "UpdateIntakeDocument", "Failed to update intake document", zap.Error(err))
+		return nil, ErrInternal
+	}
+
+	response := toIntakeDocumentResponse(document)
+	return &response, nil
+}
+
+func toIntakeDocumentResponse(d db.IntakeRequiredDocument) IntakeDocumentResponse {
+	var receivedAt *time.Time
+	if d.ReceivedAt.Valid {
+		receivedAt = &d.ReceivedAt.Time
+	}
+	return IntakeDocumentResponse{
+		DocumentType: string(d.DocumentType),
+		Status:       string(d.Status),
+		ReceivedAt:   receivedAt,
+		UpdatedAt:    d.UpdatedAt.Time,
+	}
+}
@@ -0,0 +1,90 @@
+package intake
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"care-cordination/lib/assignment"
+	db "care-cordination/lib/db/sqlc"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompletionBlocked(t *testing.T) {
+	tests := []struct {
+		name          string
+		newStatus     string
+		currentStatus db.IntakeStatusEnum
+		complete      bool
+		want          bool
+	}{
+		{
+			name:          "complete_with_missing_documents",
+			newStatus:     "completed",
+			currentStatus: db.IntakeStatusEnumPending,
+			complete:      false,
+			want:          true,
+		},
+		{
+			name:          "complete_with_all_documents",
+			newStatus:     "completed",
+			currentStatus: db.IntakeStatusEnumPending,
+			complete:      true,
+			want:          false,
+		},
+		{
+			name:          "already_completed",
+			newStatus:     "completed",
+			currentStatus: db.IntakeStatusEnumCompleted,
+			complete:      false,
+			want:          false,
+		},
+		{
+			name:          "other_status_with_missing_documents",
+			newStatus:     "pending",
+			currentStatus: db.IntakeStatusEnumPending,
+			complete:      false,
+			want:          false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			intake := db.GetIntakeFormWithDetailsRow{
+				Status:            tt.currentStatus,
+				DocumentsComplete: tt.complete,
+			}
+			assert.Equal(t, tt.want, completionBlocked(tt.newStatus, intake))
+		})
+	}
+}
+
+func TestIntakeForm_TextTooLong(t *testing.T) {
+	const limit = 20
+	// Length validation runs before any query, so no store is needed.
+	service := NewIntakeService(nil, nil, limit, assignment.ModeOff, 0)
+	tooLong := strings.Repeat("a", limit+1)
+
+	_, err := service.CreateIntakeForm(
+		context.Background(), &CreateIntakeFormRequest{FocusAreas: &tooLong},
+	)
+	assert.ErrorIs(t, err, ErrTextTooLong)
+
+	_, err = service.UpdateIntakeForm(
+		context.Background(), "intake-1", &UpdateIntakeFormRequest{Notes: &tooLong},
+	)
+	assert.ErrorIs(t, err, ErrTextTooLong)
+}
+
+func TestListIntakeForms_InvalidSort(t *testing.T) {
+	// The sort is checked before any query, so no store is needed.
+	service := NewIntakeService(nil, nil, 0, assignment.ModeOff, 0)
+
+	for _, sort := range []string{"bsn", "name:sideways", "name:desc:extra"} {
+		t.Run(sort, func(t *testing.T) {
+			_, err := service.ListIntakeForms(context.Background(), &ListIntakeFormsRequest{Sort: sort})
+			assert.ErrorIs(t, err, ErrInvalidRequest)
+		})
+	}
+}
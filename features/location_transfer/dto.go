@@ -25,6 +25,8 @@ type ListLocationTransfersResponse struct {
 	Reason                      *string `json:"reason"`
 	Status                      string  `json:"status"`
 	RejectionReason             *string `json:"rejectionReason"`
+	CancellationReason          *string `json:"cancellationReason"`
+	HandoverNotes               *string `json:"handoverNotes"`
 	ClientFirstName             string  `json:"clientFirstName"`
 	ClientLastName              string  `json:"clientLastName"`
 	FromLocationName            *string `json:"fromLocationName"`
@@ -33,12 +35,28 @@ type ListLocationTransfersResponse struct {
 	CurrentCoordinatorLastName  *string `json:"currentCoordinatorLastName"`
 	NewCoordinatorFirstName     *string `json:"newCoordinatorFirstName"`
 	NewCoordinatorLastName      *string `json:"newCoordinatorLastName"`
+	CreatedByUserID             *string `json:"createdByUserId"`
+	// Set on a single transfer once it is decided; TimeToDecisionHours is how
+	// long it waited for approval or rejection
+	ApprovedAt          *string  `json:"approvedAt,omitempty"`
+	RejectedAt          *string  `json:"rejectedAt,omitempty"`
+	TimeToDecisionHours *float64 `json:"timeToDecisionHours,omitempty"`
+}
+
+type ConfirmLocationTransferRequest struct {
+	HandoverNotes *string `json:"handoverNotes"`
+	// Admin only: approve even when the new coordinator is at their caseload cap
+	OverrideCaseload bool `json:"overrideCaseload"`
 }
 
 type RefuseLocationTransferRequest struct {
 	Reason string `json:"reason" binding:"required"`
 }
 
+type CancelLocationTransferRequest struct {
+	Reason *string `json:"reason"`
+}
+
 type UpdateLocationTransferRequest struct {
 	NewLocationID    *string `json:"newLocationId"`
 	NewCoordinatorID *string `json:"newCoordinatorId"`
@@ -46,9 +64,10 @@ type UpdateLocationTransferRequest struct {
 }
 
 type TransferStatusCounts struct {
-	Pending  int `json:"pending"`
-	Approved int `json:"approved"`
-	Rejected int `json:"rejected"`
+	Pending   int `json:"pending"`
+	Approved  int `json:"approved"`
+	Rejected  int `json:"rejected"`
+	Cancelled int `json:"cancelled"`
 }
 
 type GetLocationTransferStatsResponse struct {
@@ -56,4 +75,6 @@ type GetLocationTransferStatsResponse struct {
 	PendingCount   int                  `json:"pendingCount"`
 	ApprovalRate   float64              `json:"approvalRate"`
 	CountsByStatus TransferStatusCounts `json:"countsByStatus"`
+	// Average hours from request to approval or rejection
+	AvgTimeToDecisionHours float64 `json:"avgTimeToDecisionHours"`
 }
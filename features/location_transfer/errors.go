@@ -19,4 +19,7 @@ var (
 
 	// ErrTransferAlreadyProcessed is returned when trying to confirm/refuse an already processed transfer.
 	ErrTransferAlreadyProcessed = errors.New("transfer already processed")
+
+	// ErrNotTransferRequester is returned when someone other than the requester or an admin tries to cancel a transfer.
+	ErrNotTransferRequester = errors.New("only the requester or an admin can cancel this transfer")
 )
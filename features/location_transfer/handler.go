@@ -1,9 +1,11 @@
 package locTransfer
 
 import (
+	"care-cordination/lib/assignment"
 	"care-cordination/lib/middleware"
 	"care-cordination/lib/resp"
 	"errors"
+	"io"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -30,10 +32,11 @@ func (h *LocTransferHandler) SetupLocTransferRoutes(router *gin.Engine) {
 
 	locTransfers.POST("", h.mdw.RequirePermission("location_transfer", "write"), h.RegisterLocationTransfer)
 	locTransfers.GET("/stats", h.mdw.RequirePermission("location_transfer", "read"), h.GetLocationTransferStats)
-	locTransfers.GET("", h.mdw.RequirePermission("location_transfer", "read"), h.mdw.PaginationMdw(), h.ListLocationTransfers)
+	locTransfers.GET("", h.mdw.RequirePermission("location_transfer", "read"), h.mdw.PaginationMdw(), h.mdw.SearchMdw(), h.ListLocationTransfers)
 	locTransfers.GET("/:id", h.mdw.RequirePermission("location_transfer", "read"), h.GetLocationTransferByID)
 	locTransfers.POST("/:id/confirm", h.mdw.RequirePermission("location_transfer", "write"), h.ConfirmLocationTransfer)
 	locTransfers.POST("/:id/refuse", h.mdw.RequirePermission("location_transfer", "write"), h.RefuseLocationTransfer)
+	locTransfers.POST("/:id/cancel", h.mdw.RequirePermission("location_transfer", "write"), h.CancelLocationTransfer)
 	locTransfers.PUT("/:id", h.mdw.RequirePermission("location_transfer", "write"), h.UpdateLocationTransfer)
 }
 
@@ -135,25 +138,43 @@ func (h *LocTransferHandler) GetLocationTransferByID(ctx *gin.Context) {
 }
 
 // @Summary Confirm a location transfer
-// @Description Confirm a pending location transfer, updating the client's location and coordinator
+// @Description Confirm a pending location transfer, updating the client's location and coordinator.
+// @Description The new coordinator is notified of the overdue evaluations and unresolved incidents they inherit.
 // @Tags LocationTransfer
+// @Accept json
 // @Produce json
 // @Param id path string true "Transfer ID"
+// @Param request body ConfirmLocationTransferRequest false "Handover notes for the new coordinator"
 // @Success 200 {object} resp.SuccessResponse[any]
 // @Failure 400 {object} resp.ErrorResponse
+// @Failure 403 {object} resp.ErrorResponse
 // @Failure 404 {object} resp.ErrorResponse
+// @Failure 409 {object} resp.ErrorResponse
 // @Failure 500 {object} resp.ErrorResponse
 // @Router /location-transfers/{id}/confirm [post]
 func (h *LocTransferHandler) ConfirmLocationTransfer(ctx *gin.Context) {
 	transferID := ctx.Param("id")
 
-	err := h.locTransferService.ConfirmLocationTransfer(ctx, transferID)
+	// The body is optional; approving without handover notes is allowed
+	var req ConfirmLocationTransferRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		ctx.JSON(http.StatusBadRequest, resp.Error(ErrInvalidRequest))
+		return
+	}
+
+	err := h.locTransferService.ConfirmLocationTransfer(ctx, transferID, &req)
 	if err != nil {
 		switch {
-		case errors.Is(err, ErrTransferNotFound):
+		case errors.Is(err, ErrTransferNotFound),
+			errors.Is(err, assignment.ErrCoordinatorNotFound):
 			ctx.JSON(http.StatusNotFound, resp.Error(err))
 		case errors.Is(err, ErrTransferAlreadyProcessed):
 			ctx.JSON(http.StatusBadRequest, resp.Error(err))
+		case errors.Is(err, assignment.ErrCoordinatorLocationMismatch),
+			errors.Is(err, assignment.ErrCoordinatorAtCapacity):
+			ctx.JSON(http.StatusConflict, resp.Error(err))
+		case errors.Is(err, assignment.ErrCaseloadOverrideForbidden):
+			ctx.JSON(http.StatusForbidden, resp.Error(err))
 		default:
 			ctx.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
 		}
@@ -200,6 +221,47 @@ func (h *LocTransferHandler) RefuseLocationTransfer(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, resp.MessageResonse("Location transfer refused successfully"))
 }
 
+// @Summary Cancel a location transfer
+// @Description Withdraw a pending location transfer. Only the coordinator who requested it or an admin can cancel it.
+// @Tags LocationTransfer
+// @Accept json
+// @Produce json
+// @Param id path string true "Transfer ID"
+// @Param request body CancelLocationTransferRequest false "Cancellation reason"
+// @Success 200 {object} resp.SuccessResponse[any]
+// @Failure 400 {object} resp.ErrorResponse
+// @Failure 403 {object} resp.ErrorResponse
+// @Failure 404 {object} resp.ErrorResponse
+// @Failure 500 {object} resp.ErrorResponse
+// @Router /location-transfers/{id}/cancel [post]
+func (h *LocTransferHandler) CancelLocationTransfer(ctx *gin.Context) {
+	transferID := ctx.Param("id")
+
+	// The body is optional; a transfer can be cancelled without a reason
+	var req CancelLocationTransferRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		ctx.JSON(http.StatusBadRequest, resp.Error(ErrInvalidRequest))
+		return
+	}
+
+	err := h.locTransferService.CancelLocationTransfer(ctx, transferID, &req)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrTransferNotFound):
+			ctx.JSON(http.StatusNotFound, resp.Error(err))
+		case errors.Is(err, ErrNotTransferRequester):
+			ctx.JSON(http.StatusForbidden, resp.Error(err))
+		case errors.Is(err, ErrTransferAlreadyProcessed):
+			ctx.JSON(http.StatusBadRequest, resp.Error(err))
+		default:
+			ctx.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp.MessageResonse("Location transfer cancelled successfully"))
+}
+
 // @Summary Update a location transfer
 // @Description Update a pending location transfer (new location, coordinator, or reason)
 // @Tags LocationTransfer
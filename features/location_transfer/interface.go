@@ -21,12 +21,19 @@ type LocationTransferService interface {
 	ConfirmLocationTransfer(
 		ctx context.Context,
 		transferID string,
+		req *ConfirmLocationTransferRequest,
 	) error
 	RefuseLocationTransfer(
 		ctx context.Context,
 		transferID string,
 		req *RefuseLocationTransferRequest,
 	) error
+	// CancelLocationTransfer withdraws a pending transfer; only its requester or an admin may do so
+	CancelLocationTransfer(
+		ctx context.Context,
+		transferID string,
+		req *CancelLocationTransferRequest,
+	) error
 	UpdateLocationTransfer(
 		ctx context.Context,
 		transferID string,
@@ -1,6 +1,7 @@
 package locTransfer
 
 import (
+	"care-cordination/lib/assignment"
 	"care-cordination/lib/middleware"
 	"care-cordination/features/notification"
 	db "care-cordination/lib/db/sqlc"
@@ -9,28 +10,37 @@ import (
 	"care-cordination/lib/resp"
 	"care-cordination/lib/util"
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"go.uber.org/zap"
 )
 
 type locTransferService struct {
-	logger              logger.Logger
-	db                  *db.Store
-	notificationService notification.NotificationService
+	logger                   logger.Logger
+	db                       db.StoreInterface
+	notificationService      notification.NotificationService
+	coordinatorLocationCheck assignment.Mode
+	caseloadLimit            assignment.CaseloadLimit
 }
 
 func NewLocationTransferService(
-	db *db.Store,
+	db db.StoreInterface,
 	logger logger.Logger,
 	notificationService notification.NotificationService,
+	coordinatorLocationCheck assignment.Mode,
+	caseloadLimit assignment.CaseloadLimit,
 ) LocationTransferService {
 	return &locTransferService{
-		logger:              logger,
-		db:                  db,
-		notificationService: notificationService,
+		logger:                   logger,
+		db:                       db,
+		notificationService:      notificationService,
+		coordinatorLocationCheck: coordinatorLocationCheck,
+		caseloadLimit:            caseloadLimit,
 	}
 }
 
@@ -38,7 +48,7 @@ func (s *locTransferService) RegisterLocationTransfer(
 	ctx context.Context,
 	req *RegisterLocationTransferRequest,
 ) (*RegisterLocationTransferResponse, error) {
-	client, err := s.db.GetClientByID(ctx, req.ClientID)
+	client, err := s.db.GetClientByID(ctx, db.GetClientByIDParams{ID: req.ClientID})
 	if err != nil {
 		s.logger.Error(ctx, "RegisterLocationTransfer", "Failed to get client", zap.Error(err))
 		return nil, ErrClientNotFound
@@ -52,6 +62,7 @@ func (s *locTransferService) RegisterLocationTransfer(
 		ToLocationID:         req.NewLocationID,
 		NewCoordinatorID:     req.NewCoordinatorID,
 		CurrentCoordinatorID: client.CoordinatorID,
+		CreatedByUserID:      util.GetUserIDPtr(ctx),
 	})
 	if err != nil {
 		s.logger.Error(
@@ -104,6 +115,8 @@ func (s *locTransferService) ListLocationTransfers(
 			Reason:                      transfer.Reason,
 			Status:                      string(transfer.Status),
 			RejectionReason:             transfer.RejectionReason,
+			CancellationReason:          transfer.CancellationReason,
+			HandoverNotes:               transfer.HandoverNotes,
 			ClientFirstName:             transfer.ClientFirstName,
 			ClientLastName:              transfer.ClientLastName,
 			FromLocationName:            transfer.FromLocationName,
@@ -148,6 +161,8 @@ func (s *locTransferService) GetLocationTransferByID(
 		Reason:                      transfer.Reason,
 		Status:                      string(transfer.Status),
 		RejectionReason:             transfer.RejectionReason,
+		CancellationReason:          transfer.CancellationReason,
+		HandoverNotes:               transfer.HandoverNotes,
 		ClientFirstName:             transfer.ClientFirstName,
 		ClientLastName:              transfer.ClientLastName,
 		FromLocationName:            transfer.FromLocationName,
@@ -156,12 +171,40 @@ func (s *locTransferService) GetLocationTransferByID(
 		CurrentCoordinatorLastName:  transfer.CurrentCoordinatorLastName,
 		NewCoordinatorFirstName:     transfer.NewCoordinatorFirstName,
 		NewCoordinatorLastName:      transfer.NewCoordinatorLastName,
+		CreatedByUserID:             transfer.CreatedByUserID,
+		ApprovedAt:                  timestampPtr(transfer.ApprovedAt),
+		RejectedAt:                  timestampPtr(transfer.RejectedAt),
+		TimeToDecisionHours:         timeToDecisionHours(transfer),
 	}, nil
 }
 
+// timestampPtr formats a set timestamp as RFC3339; an unset one gives nil
+func timestampPtr(ts pgtype.Timestamp) *string {
+	if !ts.Valid {
+		return nil
+	}
+	formatted := util.PgtypeTimestampToStr(ts)
+	return &formatted
+}
+
+// timeToDecisionHours is how long the transfer waited between being requested
+// and being approved or rejected; nil while it is undecided
+func timeToDecisionHours(transfer db.GetLocationTransferByIDRow) *float64 {
+	decidedAt := transfer.ApprovedAt
+	if !decidedAt.Valid {
+		decidedAt = transfer.RejectedAt
+	}
+	if !decidedAt.Valid || !transfer.CreatedAt.Valid {
+		return nil
+	}
+	hours := decidedAt.Time.Sub(transfer.CreatedAt.Time).Hours()
+	return &hours
+}
+
 func (s *locTransferService) ConfirmLocationTransfer(
 	ctx context.Context,
 	transferID string,
+	req *ConfirmLocationTransferRequest,
 ) error {
 	// First, get the transfer to check status and get details
 	transfer, err := s.db.GetLocationTransferByID(ctx, transferID)
@@ -179,14 +222,68 @@ func (s *locTransferService) ConfirmLocationTransfer(
 		return ErrTransferAlreadyProcessed
 	}
 
-	// Execute all updates in a transaction
-	err = s.db.ExecTx(ctx, func(q *db.Queries) error {
-		// 1. Confirm the transfer
-		if err := q.ConfirmLocationTransfer(ctx, transferID); err != nil {
+	if err := s.coordinatorLocationCheck.CheckCoordinatorLocation(
+		ctx,
+		s.db,
+		s.logger,
+		"ConfirmLocationTransfer",
+		transfer.NewCoordinatorID,
+		transfer.ToLocationID,
+	); err != nil {
+		if errors.Is(err, assignment.ErrCoordinatorLocationMismatch) {
 			return err
 		}
+		if errors.Is(err, pgx.ErrNoRows) {
+			return assignment.ErrCoordinatorNotFound
+		}
+		s.logger.Error(
+			ctx,
+			"ConfirmLocationTransfer",
+			"Failed to check coordinator location",
+			zap.Error(err),
+		)
+		return ErrInternal
+	}
 
-		// 2. Update client's location and coordinator
+	caseload, err := s.caseloadLimit.CaseloadCheck(
+		ctx,
+		s.db,
+		transfer.NewCoordinatorID,
+		transfer.ClientID,
+		req.OverrideCaseload,
+	)
+	if err != nil {
+		if errors.Is(err, assignment.ErrCaseloadOverrideForbidden) {
+			return err
+		}
+		s.logger.Error(
+			ctx,
+			"ConfirmLocationTransfer",
+			"Failed to check caseload override",
+			zap.Error(err),
+		)
+		return ErrInternal
+	}
+
+	// Execute all updates in a transaction, retried if a concurrent update to
+	// the same client or locations conflicts with it
+	err = s.db.ExecTxRetry(ctx, func(q *db.Queries) error {
+		// 1. Make sure the new coordinator has room for the client
+		if caseload != nil {
+			if err := q.CheckCaseload(ctx, *caseload); err != nil {
+				return err
+			}
+		}
+
+		// 2. Confirm the transfer
+		if err := q.ConfirmLocationTransfer(ctx, db.ConfirmLocationTransferParams{
+			ID:            transferID,
+			HandoverNotes: req.HandoverNotes,
+		}); err != nil {
+			return err
+		}
+
+		// 3. Update client's location and coordinator
 		if _, err := q.UpdateClient(ctx, db.UpdateClientParams{
 			ID:                 transfer.ClientID,
 			AssignedLocationID: &transfer.ToLocationID,
@@ -195,14 +292,23 @@ func (s *locTransferService) ConfirmLocationTransfer(
 			return err
 		}
 
-		// 3. Decrement old location's occupied count (if from_location exists)
+		// 4. Record the new assignment in the client's history
+		if err := q.RecordClientAssignment(ctx, db.RecordClientAssignmentParams{
+			ID:        nanoid.Generate(),
+			ClientID:  transfer.ClientID,
+			ChangedBy: util.GetUserID(ctx),
+		}); err != nil {
+			return err
+		}
+
+		// 5. Decrement old location's occupied count (if from_location exists)
 		if transfer.FromLocationID != nil {
 			if err := q.DecrementLocationOccupied(ctx, *transfer.FromLocationID); err != nil {
 				return err
 			}
 		}
 
-		// 4. Increment new location's occupied count
+		// 6. Increment new location's occupied count
 		if err := q.IncrementLocationOccupied(ctx, transfer.ToLocationID); err != nil {
 			return err
 		}
@@ -211,6 +317,10 @@ func (s *locTransferService) ConfirmLocationTransfer(
 	})
 
 	if err != nil {
+		if errors.Is(err, assignment.ErrCoordinatorAtCapacity) ||
+			errors.Is(err, assignment.ErrCoordinatorNotFound) {
+			return err
+		}
 		s.logger.Error(ctx, "ConfirmLocationTransfer", "Transaction failed", zap.Error(err))
 		return ErrInternal
 	}
@@ -248,6 +358,8 @@ func (s *locTransferService) ConfirmLocationTransfer(
 		}
 	}
 
+	s.notifyHandover(ctx, transfer, req.HandoverNotes)
+
 	return nil
 }
 
@@ -304,6 +416,60 @@ func (s *locTransferService) RefuseLocationTransfer(
 	return nil
 }
 
+func (s *locTransferService) CancelLocationTransfer(
+	ctx context.Context,
+	transferID string,
+	req *CancelLocationTransferRequest,
+) error {
+	transfer, err := s.db.GetLocationTransferByID(ctx, transferID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return ErrTransferNotFound
+		}
+		s.logger.Error(ctx, "CancelLocationTransfer", "Failed to get transfer", zap.Error(err))
+		return ErrInternal
+	}
+
+	util.SetClientID(ctx, transfer.ClientID)
+
+	userID := util.GetUserID(ctx)
+	isRequester := transfer.CreatedByUserID != nil && *transfer.CreatedByUserID == userID
+	if !isRequester {
+		isAdmin, err := s.db.HasPermission(ctx, db.HasPermissionParams{
+			UserID:   userID,
+			Resource: "admin",
+			Action:   "manage",
+		})
+		if err != nil {
+			s.logger.Error(ctx, "CancelLocationTransfer", "Failed to check admin permission", zap.Error(err))
+			return ErrInternal
+		}
+		if !isAdmin {
+			return ErrNotTransferRequester
+		}
+	}
+
+	// Only pending transfers can be withdrawn
+	if transfer.Status != db.LocationTransferStatusEnumPending {
+		return ErrTransferAlreadyProcessed
+	}
+
+	cancelled, err := s.db.CancelLocationTransfer(ctx, db.CancelLocationTransferParams{
+		ID:                 transferID,
+		CancellationReason: req.Reason,
+	})
+	if err != nil {
+		s.logger.Error(ctx, "CancelLocationTransfer", "Failed to cancel transfer", zap.Error(err))
+		return ErrInternal
+	}
+	// Approved, refused or cancelled since it was read
+	if cancelled == 0 {
+		return ErrTransferAlreadyProcessed
+	}
+
+	return nil
+}
+
 func (s *locTransferService) UpdateLocationTransfer(
 	ctx context.Context,
 	transferID string,
@@ -357,10 +523,12 @@ func (s *locTransferService) GetLocationTransferStats(
 		PendingCount: int(stats.PendingCount),
 		ApprovalRate: approvalRate,
 		CountsByStatus: TransferStatusCounts{
-			Pending:  int(stats.PendingCount),
-			Approved: int(stats.ApprovedCount),
-			Rejected: int(stats.RejectedCount),
+			Pending:   int(stats.PendingCount),
+			Approved:  int(stats.ApprovedCount),
+			Rejected:  int(stats.RejectedCount),
+			Cancelled: int(stats.CancelledCount),
 		},
+		AvgTimeToDecisionHours: stats.AvgDecisionHours,
 	}, nil
 }
 
@@ -372,3 +540,90 @@ func (s *locTransferService) getEmployeeUserID(ctx context.Context, employeeID s
 	}
 	return employee.UserID
 }
+
+// notifyHandover tells the incoming coordinator what they inherit from the
+// outgoing one: the client's overdue evaluations, unresolved incidents and
+// any handover notes
+func (s *locTransferService) notifyHandover(
+	ctx context.Context,
+	transfer db.GetLocationTransferByIDRow,
+	handoverNotes *string,
+) {
+	if s.notificationService == nil || transfer.NewCoordinatorID == transfer.CurrentCoordinatorID {
+		return
+	}
+
+	newCoordUserID := s.getEmployeeUserID(ctx, transfer.NewCoordinatorID)
+	if newCoordUserID == "" {
+		return
+	}
+
+	evaluations, err := s.db.ListOverdueEvaluationRecordsByClient(ctx, db.ListOverdueEvaluationRecordsByClientParams{
+		ClientID: transfer.ClientID,
+		Today:    util.Today(),
+	})
+	if err != nil {
+		s.logger.Error(ctx, "ConfirmLocationTransfer", "Failed to list overdue evaluations", zap.Error(err))
+		return
+	}
+	incidents, err := s.db.ListUnresolvedIncidentsByClient(ctx, transfer.ClientID)
+	if err != nil {
+		s.logger.Error(ctx, "ConfirmLocationTransfer", "Failed to list unresolved incidents", zap.Error(err))
+		return
+	}
+
+	priority := notification.PriorityNormal
+	if len(evaluations) > 0 || len(incidents) > 0 {
+		priority = notification.PriorityHigh
+	}
+
+	resourceType := notification.ResourceTypeClient
+	resourceID := transfer.ClientID
+	s.notificationService.Enqueue(&notification.CreateNotificationRequest{
+		UserID:       newCoordUserID,
+		Type:         notification.TypeCoordinatorHandover,
+		Priority:     priority,
+		Title:        "Client Handover",
+		Message:      buildHandoverMessage(transfer, evaluations, incidents, handoverNotes),
+		ResourceType: &resourceType,
+		ResourceID:   &resourceID,
+	})
+}
+
+func buildHandoverMessage(
+	transfer db.GetLocationTransferByIDRow,
+	evaluations []db.ListOverdueEvaluationRecordsByClientRow,
+	incidents []db.ListUnresolvedIncidentsByClientRow,
+	handoverNotes *string,
+) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "You are now coordinating %s %s.", transfer.ClientFirstName, transfer.ClientLastName)
+
+	if len(evaluations) == 0 && len(incidents) == 0 {
+		b.WriteString(" No overdue evaluations or unresolved incidents.")
+	}
+	if len(evaluations) > 0 {
+		dates := make([]string, len(evaluations))
+		for i, evaluation := range evaluations {
+			dates[i] = util.PgtypeDateToStr(evaluation.ScheduledDate)
+		}
+		fmt.Fprintf(&b, " Overdue evaluations (%d): scheduled %s.", len(evaluations), strings.Join(dates, ", "))
+	}
+	if len(incidents) > 0 {
+		summaries := make([]string, len(incidents))
+		for i, incident := range incidents {
+			summaries[i] = fmt.Sprintf(
+				"%s (%s) on %s",
+				incident.IncidentType,
+				incident.IncidentSeverity,
+				util.PgtypeDateToStr(incident.IncidentDate),
+			)
+		}
+		fmt.Fprintf(&b, " Unresolved incidents (%d): %s.", len(incidents), strings.Join(summaries, ", "))
+	}
+	if handoverNotes != nil && *handoverNotes != "" {
+		fmt.Fprintf(&b, " Handover notes: %s", *handoverNotes)
+	}
+
+	return b.String()
+}
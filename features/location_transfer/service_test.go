@@ -0,0 +1,455 @@
+package locTransfer_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	locTransfer "care-cordination/features/location_transfer"
+	"care-cordination/features/notification"
+	notificationmocks "care-cordination/features/notification/mocks"
+	"care-cordination/lib/assignment"
+	db "care-cordination/lib/db/sqlc"
+	dbmocks "care-cordination/lib/db/sqlc/mocks"
+	loggermocks "care-cordination/lib/logger/mocks"
+	"care-cordination/lib/util"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestConfirmLocationTransfer(t *testing.T) {
+	pendingTransfer := func(newCoordinatorID string) db.GetLocationTransferByIDRow {
+		return db.GetLocationTransferByIDRow{
+			ID:                   "transfer-1",
+			ClientID:             "client-1",
+			ToLocationID:         "loc-2",
+			CurrentCoordinatorID: "coord-old",
+			NewCoordinatorID:     newCoordinatorID,
+			Status:               db.LocationTransferStatusEnumPending,
+			ClientFirstName:      "John",
+			ClientLastName:       "Doe",
+		}
+	}
+	overdueDate := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		req         *locTransfer.ConfirmLocationTransferRequest
+		setup       func(t *testing.T, mockStore *dbmocks.MockStoreInterface, mockNotify *notificationmocks.MockNotificationService)
+		wantErr     bool
+		expectedErr error
+	}{
+		{
+			name: "new_coordinator_receives_handover",
+			req: &locTransfer.ConfirmLocationTransferRequest{
+				HandoverNotes: util.StrPtr("Prefers morning appointments"),
+			},
+			setup: func(t *testing.T, mockStore *dbmocks.MockStoreInterface, mockNotify *notificationmocks.MockNotificationService) {
+				mockStore.EXPECT().
+					GetLocationTransferByID(gomock.Any(), "transfer-1").
+					Return(pendingTransfer("coord-new"), nil)
+				mockStore.EXPECT().
+					ExecTxRetry(gomock.Any(), gomock.Any()).
+					Return(nil)
+				mockStore.EXPECT().
+					GetEmployeeByID(gomock.Any(), "coord-old").
+					Return(db.GetEmployeeByIDRow{UserID: "user-old"}, nil)
+				mockStore.EXPECT().
+					GetEmployeeByID(gomock.Any(), "coord-new").
+					Return(db.GetEmployeeByIDRow{UserID: "user-new"}, nil).
+					Times(2)
+				mockStore.EXPECT().
+					ListOverdueEvaluationRecordsByClient(gomock.Any(), db.ListOverdueEvaluationRecordsByClientParams{ClientID: "client-1", Today: util.Today()}).
+					Return([]db.ListOverdueEvaluationRecordsByClientRow{
+						{ID: "eval-1", CoordinatorID: "coord-old", ScheduledDate: util.TimeToPgtypeDate(overdueDate)},
+					}, nil)
+				mockStore.EXPECT().
+					ListUnresolvedIncidentsByClient(gomock.Any(), "client-1").
+					Return([]db.ListUnresolvedIncidentsByClientRow{}, nil)
+
+				mockNotify.EXPECT().
+					EnqueueForUsers([]string{"user-old", "user-new"}, gomock.Any())
+				mockNotify.EXPECT().
+					Enqueue(gomock.Any()).
+					Do(func(req *notification.CreateNotificationRequest) {
+						assert.Equal(t, "user-new", req.UserID)
+						assert.Equal(t, notification.TypeCoordinatorHandover, req.Type)
+						assert.Equal(t, notification.PriorityHigh, req.Priority)
+						assert.Contains(t, req.Message, "Overdue evaluations (1): scheduled 2026-09-01")
+						assert.Contains(t, req.Message, "Prefers morning appointments")
+						require.NotNil(t, req.ResourceID)
+						assert.Equal(t, "client-1", *req.ResourceID)
+					})
+			},
+		},
+		{
+			name: "same_coordinator_no_handover",
+			req:  &locTransfer.ConfirmLocationTransferRequest{},
+			setup: func(t *testing.T, mockStore *dbmocks.MockStoreInterface, mockNotify *notificationmocks.MockNotificationService) {
+				mockStore.EXPECT().
+					GetLocationTransferByID(gomock.Any(), "transfer-1").
+					Return(pendingTransfer("coord-old"), nil)
+				mockStore.EXPECT().
+					ExecTxRetry(gomock.Any(), gomock.Any()).
+					Return(nil)
+				mockStore.EXPECT().
+					GetEmployeeByID(gomock.Any(), "coord-old").
+					Return(db.GetEmployeeByIDRow{UserID: "user-old"}, nil).
+					Times(2)
+
+				mockNotify.EXPECT().
+					EnqueueForUsers([]string{"user-old"}, gomock.Any())
+			},
+		},
+		{
+			name: "already_processed",
+			req:  &locTransfer.ConfirmLocationTransferRequest{},
+			setup: func(t *testing.T, mockStore *dbmocks.MockStoreInterface, mockNotify *notificationmocks.MockNotificationService) {
+				transfer := pendingTransfer("coord-new")
+				transfer.Status = db.LocationTransferStatusEnumApproved
+				mockStore.EXPECT().
+					GetLocationTransferByID(gomock.Any(), "transfer-1").
+					Return(transfer, nil)
+			},
+			wantErr:     true,
+			expectedErr: locTransfer.ErrTransferAlreadyProcessed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := dbmocks.NewMockStoreInterface(ctrl)
+			mockLogger := loggermocks.NewMockLogger(ctrl)
+			mockNotify := notificationmocks.NewMockNotificationService(ctrl)
+
+			mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+			tt.setup(t, mockStore, mockNotify)
+
+			service := locTransfer.NewLocationTransferService(mockStore, mockLogger, mockNotify, assignment.ModeOff, 0)
+
+			err := service.ConfirmLocationTransfer(context.Background(), "transfer-1", tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				if tt.expectedErr != nil {
+					assert.ErrorIs(t, err, tt.expectedErr)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestConfirmLocationTransfer_CoordinatorLocation(t *testing.T) {
+	tests := []struct {
+		name                string
+		mode                assignment.Mode
+		coordinatorLocation string
+		wantWarning         bool
+		expectedErr         error
+	}{
+		{name: "match", mode: assignment.ModeEnforce, coordinatorLocation: "loc-2"},
+		{
+			name:                "mismatch_enforced",
+			mode:                assignment.ModeEnforce,
+			coordinatorLocation: "loc-1",
+			expectedErr:         assignment.ErrCoordinatorLocationMismatch,
+		},
+		{name: "mismatch_warned", mode: assignment.ModeWarn, coordinatorLocation: "loc-1", wantWarning: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockStore := dbmocks.NewMockStoreInterface(ctrl)
+			mockLogger := loggermocks.NewMockLogger(ctrl)
+
+			mockStore.EXPECT().
+				GetLocationTransferByID(gomock.Any(), "transfer-1").
+				Return(db.GetLocationTransferByIDRow{
+					ID:                   "transfer-1",
+					ClientID:             "client-1",
+					ToLocationID:         "loc-2",
+					CurrentCoordinatorID: "coord-old",
+					NewCoordinatorID:     "coord-new",
+					Status:               db.LocationTransferStatusEnumPending,
+				}, nil)
+			mockStore.EXPECT().
+				GetEmployeeByID(gomock.Any(), "coord-new").
+				Return(db.GetEmployeeByIDRow{ID: "coord-new", LocationID: tt.coordinatorLocation}, nil)
+			if tt.expectedErr == nil {
+				mockStore.EXPECT().
+					ExecTxRetry(gomock.Any(), gomock.Any()).
+					Return(nil)
+			}
+			if tt.wantWarning {
+				mockLogger.EXPECT().
+					Warn(gomock.Any(), "ConfirmLocationTransfer", gomock.Any(), gomock.Any()).
+					Times(1)
+			}
+
+			service := locTransfer.NewLocationTransferService(mockStore, mockLogger, nil, tt.mode, 0)
+
+			err := service.ConfirmLocationTransfer(
+				context.Background(),
+				"transfer-1",
+				&locTransfer.ConfirmLocationTransferRequest{},
+			)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestConfirmLocationTransfer_CaseloadCap(t *testing.T) {
+	tests := []struct {
+		name        string
+		override    bool
+		txErr       error
+		expectedErr error
+	}{
+		// The cap is checked inside the transaction, under a lock on the coordinator
+		{name: "blocked_at_cap", txErr: db.ErrCoordinatorAtCapacity, expectedErr: assignment.ErrCoordinatorAtCapacity},
+		{name: "unknown_coordinator", txErr: db.ErrCoordinatorNotFound, expectedErr: assignment.ErrCoordinatorNotFound},
+		{name: "admin_override", override: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockStore := dbmocks.NewMockStoreInterface(ctrl)
+			mockLogger := loggermocks.NewMockLogger(ctrl)
+
+			mockStore.EXPECT().
+				GetLocationTransferByID(gomock.Any(), "transfer-1").
+				Return(db.GetLocationTransferByIDRow{
+					ID:                   "transfer-1",
+					ClientID:             "client-1",
+					ToLocationID:         "loc-2",
+					CurrentCoordinatorID: "coord-old",
+					NewCoordinatorID:     "coord-new",
+					Status:               db.LocationTransferStatusEnumPending,
+				}, nil)
+			if tt.override {
+				mockStore.EXPECT().
+					HasPermission(gomock.Any(), db.HasPermissionParams{UserID: "admin-1", Resource: "admin", Action: "manage"}).
+					Return(true, nil)
+			}
+			mockStore.EXPECT().
+				ExecTxRetry(gomock.Any(), gomock.Any()).
+				Return(tt.txErr)
+
+			service := locTransfer.NewLocationTransferService(mockStore, mockLogger, nil, assignment.ModeOff, 5)
+			ctx := context.WithValue(context.Background(), util.UserIDKey, "admin-1")
+
+			err := service.ConfirmLocationTransfer(
+				ctx,
+				"transfer-1",
+				&locTransfer.ConfirmLocationTransferRequest{OverrideCaseload: tt.override},
+			)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCancelLocationTransfer(t *testing.T) {
+	transferWithStatus := func(status db.LocationTransferStatusEnum) db.GetLocationTransferByIDRow {
+		return db.GetLocationTransferByIDRow{
+			ID:              "transfer-1",
+			ClientID:        "client-1",
+			Status:          status,
+			CreatedByUserID: util.StrPtr("requester-1"),
+		}
+	}
+
+	tests := []struct {
+		name        string
+		userID      string
+		setup       func(mockStore *dbmocks.MockStoreInterface)
+		wantErr     bool
+		expectedErr error
+	}{
+		{
+			name:   "requester_cancels_pending",
+			userID: "requester-1",
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					GetLocationTransferByID(gomock.Any(), "transfer-1").
+					Return(transferWithStatus(db.LocationTransferStatusEnumPending), nil)
+				mockStore.EXPECT().
+					CancelLocationTransfer(gomock.Any(), db.CancelLocationTransferParams{
+						ID:                 "transfer-1",
+						CancellationReason: util.StrPtr("Client moved out of region"),
+					}).
+					Return(int64(1), nil)
+			},
+		},
+		{
+			name:   "admin_cancels_pending",
+			userID: "admin-1",
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					GetLocationTransferByID(gomock.Any(), "transfer-1").
+					Return(transferWithStatus(db.LocationTransferStatusEnumPending), nil)
+				mockStore.EXPECT().
+					HasPermission(gomock.Any(), db.HasPermissionParams{UserID: "admin-1", Resource: "admin", Action: "manage"}).
+					Return(true, nil)
+				mockStore.EXPECT().
+					CancelLocationTransfer(gomock.Any(), gomock.Any()).
+					Return(int64(1), nil)
+			},
+		},
+		{
+			name:   "other_coordinator_forbidden",
+			userID: "other-1",
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					GetLocationTransferByID(gomock.Any(), "transfer-1").
+					Return(transferWithStatus(db.LocationTransferStatusEnumPending), nil)
+				mockStore.EXPECT().
+					HasPermission(gomock.Any(), gomock.Any()).
+					Return(false, nil)
+			},
+			wantErr:     true,
+			expectedErr: locTransfer.ErrNotTransferRequester,
+		},
+		{
+			name:   "already_approved",
+			userID: "requester-1",
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					GetLocationTransferByID(gomock.Any(), "transfer-1").
+					Return(transferWithStatus(db.LocationTransferStatusEnumApproved), nil)
+			},
+			wantErr:     true,
+			expectedErr: locTransfer.ErrTransferAlreadyProcessed,
+		},
+		{
+			// Decided between the read and the update
+			name:   "processed_concurrently",
+			userID: "requester-1",
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					GetLocationTransferByID(gomock.Any(), "transfer-1").
+					Return(transferWithStatus(db.LocationTransferStatusEnumPending), nil)
+				mockStore.EXPECT().
+					CancelLocationTransfer(gomock.Any(), gomock.Any()).
+					Return(int64(0), nil)
+			},
+			wantErr:     true,
+			expectedErr: locTransfer.ErrTransferAlreadyProcessed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := dbmocks.NewMockStoreInterface(ctrl)
+			mockLogger := loggermocks.NewMockLogger(ctrl)
+			mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+			tt.setup(mockStore)
+
+			service := locTransfer.NewLocationTransferService(mockStore, mockLogger, nil, assignment.ModeOff, 0)
+
+			ctx := context.WithValue(context.Background(), util.UserIDKey, tt.userID)
+			err := service.CancelLocationTransfer(ctx, "transfer-1", &locTransfer.CancelLocationTransferRequest{
+				Reason: util.StrPtr("Client moved out of region"),
+			})
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGetLocationTransferByID_TimeToDecision(t *testing.T) {
+	requestedAt := time.Date(2026, time.March, 2, 9, 0, 0, 0, time.UTC)
+	timestamp := func(at time.Time) pgtype.Timestamp {
+		return pgtype.Timestamp{Time: at, Valid: true}
+	}
+
+	tests := []struct {
+		name         string
+		transfer     db.GetLocationTransferByIDRow
+		wantApproved bool
+		wantRejected bool
+		wantHours    *float64
+	}{
+		{
+			name: "pending_has_no_decision",
+			transfer: db.GetLocationTransferByIDRow{
+				Status:    db.LocationTransferStatusEnumPending,
+				CreatedAt: timestamp(requestedAt),
+			},
+		},
+		{
+			name: "approved_after_a_day_and_a_half",
+			transfer: db.GetLocationTransferByIDRow{
+				Status:     db.LocationTransferStatusEnumApproved,
+				CreatedAt:  timestamp(requestedAt),
+				ApprovedAt: timestamp(requestedAt.Add(36 * time.Hour)),
+			},
+			wantApproved: true,
+			wantHours:    func() *float64 { h := 36.0; return &h }(),
+		},
+		{
+			name: "rejected_after_two_hours",
+			transfer: db.GetLocationTransferByIDRow{
+				Status:     db.LocationTransferStatusEnumRejected,
+				CreatedAt:  timestamp(requestedAt),
+				RejectedAt: timestamp(requestedAt.Add(2 * time.Hour)),
+			},
+			wantRejected: true,
+			wantHours:    func() *float64 { h := 2.0; return &h }(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := dbmocks.NewMockStoreInterface(ctrl)
+			mockLogger := loggermocks.NewMockLogger(ctrl)
+
+			tt.transfer.ID = "transfer-1"
+			tt.transfer.ClientID = "client-1"
+			mockStore.EXPECT().
+				GetLocationTransferByID(gomock.Any(), "transfer-1").
+				Return(tt.transfer, nil)
+
+			service := locTransfer.NewLocationTransferService(mockStore, mockLogger, nil, assignment.ModeOff, 0)
+
+			result, err := service.GetLocationTransferByID(context.Background(), "transfer-1")
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantApproved, result.ApprovedAt != nil)
+			assert.Equal(t, tt.wantRejected, result.RejectedAt != nil)
+			assert.Equal(t, tt.wantHours, result.TimeToDecisionHours)
+		})
+	}
+}
@@ -6,6 +6,8 @@ type CreateLocationRequest struct {
 	Address    string `json:"address"    binding:"required"`
 	Capacity   int32  `json:"capacity"   binding:"min=1"`
 	Occupied   int32  `json:"occupied"   binding:"min=0"`
+	// Care types the location offers; empty means it accepts every care type
+	CareTypes []string `json:"careTypes" binding:"omitempty,dive,oneof=protected_living semi_independent_living independent_assisted_living ambulatory_care"`
 }
 
 type CreateLocationResponse struct {
@@ -19,6 +21,8 @@ type ListLocationsResponse struct {
 	Address    string `json:"address"`
 	Capacity   int32  `json:"capacity"`
 	Occupied   int32  `json:"occupied"`
+	// Care types the location offers; empty means it accepts every care type
+	CareTypes []string `json:"careTypes"`
 }
 
 type ListLocationsRequest struct {
@@ -31,6 +35,8 @@ type UpdateLocationRequest struct {
 	Address    *string `json:"address"`
 	Capacity   *int32  `json:"capacity" binding:"omitempty,min=1"`
 	Occupied   *int32  `json:"occupied" binding:"omitempty,min=0"`
+	// Replaces the location's care types when set; an empty list accepts every care type
+	CareTypes *[]string `json:"careTypes" binding:"omitempty,dive,oneof=protected_living semi_independent_living independent_assisted_living ambulatory_care"`
 }
 
 type UpdateLocationResponse struct {
@@ -46,3 +52,14 @@ type GetLocationCapacityStatsResponse struct {
 	CapacityUsed  int `json:"capacityUsed"`
 	FreeCapacity  int `json:"freeCapacity"`
 }
+
+type GetLocationCapacityTrendRequest struct {
+	From string `form:"from" binding:"required,datetime=2006-01-02"`
+	To   string `form:"to"   binding:"required,datetime=2006-01-02"`
+}
+
+type LocationCapacityPoint struct {
+	Date     string `json:"date"`
+	Capacity int32  `json:"capacity"`
+	Occupied int32  `json:"occupied"`
+}
@@ -6,4 +6,5 @@ var (
 	ErrInvalidRequest = errors.New("invalid request")
 	ErrInternal       = errors.New("internal server error")
 	ErrNotFound       = errors.New("location not found")
+	ErrNameTaken      = errors.New("an active location with this name already exists")
 )
@@ -28,8 +28,9 @@ func (h *LocationHandler) SetupLocationRoutes(router *gin.Engine) {
 	location := router.Group("/locations")
 
 	location.POST("", h.mdw.AuthMdw(), h.CreateLocation)
-	location.GET("", h.mdw.AuthMdw(), h.mdw.PaginationMdw(), h.ListLocations)
+	location.GET("", h.mdw.AuthMdw(), h.mdw.PaginationMdw(), h.mdw.SearchMdw(), h.ListLocations)
 	location.GET("/capacity-stats", h.mdw.AuthMdw(), h.GetLocationCapacityStats)
+	location.GET("/:id/capacity-trend", h.mdw.AuthMdw(), h.GetLocationCapacityTrend)
 	location.PUT("/:id", h.mdw.AuthMdw(), h.UpdateLocation)
 	location.DELETE("/:id", h.mdw.AuthMdw(), h.DeleteLocation)
 }
@@ -43,6 +44,7 @@ func (h *LocationHandler) SetupLocationRoutes(router *gin.Engine) {
 // @Success 200 {object} resp.SuccessResponse[CreateLocationResponse]
 // @Failure 400 {object} resp.ErrorResponse
 // @Failure 401 {object} resp.ErrorResponse
+// @Failure 409 {object} resp.ErrorResponse
 // @Failure 500 {object} resp.ErrorResponse
 // @Router /locations [post]
 func (h *LocationHandler) CreateLocation(ctx *gin.Context) {
@@ -56,6 +58,8 @@ func (h *LocationHandler) CreateLocation(ctx *gin.Context) {
 		switch {
 		case errors.Is(err, ErrInvalidRequest):
 			ctx.JSON(http.StatusBadRequest, resp.Error(err))
+		case errors.Is(err, ErrNameTaken):
+			ctx.JSON(http.StatusConflict, resp.Error(err))
 		case errors.Is(err, ErrInternal):
 			ctx.JSON(http.StatusInternalServerError, resp.Error(err))
 		default:
@@ -109,6 +113,7 @@ func (h *LocationHandler) ListLocations(ctx *gin.Context) {
 // @Failure 400 {object} resp.ErrorResponse
 // @Failure 401 {object} resp.ErrorResponse
 // @Failure 404 {object} resp.ErrorResponse
+// @Failure 409 {object} resp.ErrorResponse
 // @Failure 500 {object} resp.ErrorResponse
 // @Router /locations/{id} [patch]
 func (h *LocationHandler) UpdateLocation(ctx *gin.Context) {
@@ -131,6 +136,8 @@ func (h *LocationHandler) UpdateLocation(ctx *gin.Context) {
 			ctx.JSON(http.StatusBadRequest, resp.Error(err))
 		case errors.Is(err, ErrNotFound):
 			ctx.JSON(http.StatusNotFound, resp.Error(err))
+		case errors.Is(err, ErrNameTaken):
+			ctx.JSON(http.StatusConflict, resp.Error(err))
 		case errors.Is(err, ErrInternal):
 			ctx.JSON(http.StatusInternalServerError, resp.Error(err))
 		default:
@@ -178,7 +185,7 @@ func (h *LocationHandler) DeleteLocation(ctx *gin.Context) {
 }
 
 // @Summary Get location capacity statistics
-// @Description Get total capacity, capacity used (clients in care), and free capacity across all locations
+// @Description Get total capacity, capacity used (clients in care), and free capacity across the organization's locations
 // @Tags Location
 // @Produce json
 // @Success 200 {object} resp.SuccessResponse[GetLocationCapacityStatsResponse]
@@ -193,3 +200,38 @@ func (h *LocationHandler) GetLocationCapacityStats(ctx *gin.Context) {
 	}
 	ctx.JSON(http.StatusOK, resp.Success(result, "Location capacity statistics retrieved successfully"))
 }
+
+// @Summary Get location capacity trend
+// @Description Get the daily capacity and occupancy of a location between two dates (inclusive), oldest first
+// @Tags Location
+// @Produce json
+// @Param id path string true "Location ID"
+// @Param from query string true "First day (YYYY-MM-DD)"
+// @Param to query string true "Last day (YYYY-MM-DD)"
+// @Success 200 {object} resp.SuccessResponse[[]LocationCapacityPoint]
+// @Failure 400 {object} resp.ErrorResponse
+// @Failure 401 {object} resp.ErrorResponse
+// @Failure 404 {object} resp.ErrorResponse
+// @Failure 500 {object} resp.ErrorResponse
+// @Router /locations/{id}/capacity-trend [get]
+func (h *LocationHandler) GetLocationCapacityTrend(ctx *gin.Context) {
+	var req GetLocationCapacityTrendRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, resp.Error(ErrInvalidRequest))
+		return
+	}
+
+	result, err := h.locationService.GetLocationCapacityTrend(ctx, ctx.Param("id"), &req)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrInvalidRequest):
+			ctx.JSON(http.StatusBadRequest, resp.Error(err))
+		case errors.Is(err, ErrNotFound):
+			ctx.JSON(http.StatusNotFound, resp.Error(err))
+		default:
+			ctx.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		}
+		return
+	}
+	ctx.JSON(http.StatusOK, resp.Success(result, "Location capacity trend retrieved successfully"))
+}
@@ -14,4 +14,9 @@ type LocationService interface {
 	UpdateLocation(ctx context.Context, id string, req *UpdateLocationRequest) (UpdateLocationResponse, error)
 	DeleteLocation(ctx context.Context, id string) (DeleteLocationResponse, error)
 	GetLocationCapacityStats(ctx context.Context) (GetLocationCapacityStatsResponse, error)
+	GetLocationCapacityTrend(
+		ctx context.Context,
+		id string,
+		req *GetLocationCapacityTrendRequest,
+	) ([]LocationCapacityPoint, error)
 }
@@ -6,8 +6,11 @@ import (
 	"care-cordination/lib/logger"
 	"care-cordination/lib/nanoid"
 	"care-cordination/lib/resp"
+	"care-cordination/lib/util"
 	"context"
+	"errors"
 
+	"github.com/jackc/pgx/v5"
 	"go.uber.org/zap"
 )
 
@@ -28,15 +31,32 @@ func (s *locationService) CreateLocation(
 	req *CreateLocationRequest,
 ) (CreateLocationResponse, error) {
 	id := nanoid.Generate()
-	err := s.store.CreateLocation(ctx, db.CreateLocationParams{
-		ID:         id,
-		Name:       req.Name,
-		PostalCode: req.PostalCode,
-		Address:    req.Address,
-		Capacity:   req.Capacity,
-		Occupied:   req.Occupied,
+	err := s.store.ExecTx(ctx, func(q *db.Queries) error {
+		err := q.CreateLocation(ctx, db.CreateLocationParams{
+			ID:         id,
+			Name:       req.Name,
+			PostalCode: req.PostalCode,
+			Address:    req.Address,
+			Capacity:   req.Capacity,
+			Occupied:   req.Occupied,
+			// New locations belong to the creator's organization
+			OrganizationID: util.GetOrganizationIDPtr(ctx),
+		})
+		if err != nil {
+			return err
+		}
+		if len(req.CareTypes) == 0 {
+			return nil
+		}
+		return q.AddLocationCareTypes(ctx, db.AddLocationCareTypesParams{
+			LocationID: id,
+			CareTypes:  req.CareTypes,
+		})
 	})
 	if err != nil {
+		if db.IsUniqueViolationOf(err, "uq_locations_active_name") {
+			return CreateLocationResponse{}, ErrNameTaken
+		}
 		s.logger.Error(ctx, "CreateLocation", "Failed to create location", zap.Error(err))
 		return CreateLocationResponse{}, ErrInternal
 	}
@@ -51,7 +71,8 @@ func (s *locationService) ListLocations(
 ) (*resp.PaginationResponse[ListLocationsResponse], error) {
 	limit, offset, page, pageSize := middleware.GetPaginationParams(ctx)
 
-	locations, err := s.store.ListLocations(ctx, db.ListLocationsParams{
+	scoped := s.store.ForOrganization(util.GetOrganizationID(ctx))
+	locations, err := scoped.ListLocations(ctx, db.ListLocationsParams{
 		Limit:  limit,
 		Offset: offset,
 		Search: req.Search,
@@ -72,6 +93,7 @@ func (s *locationService) ListLocations(
 			Address:    location.Address,
 			Capacity:   location.Capacity,
 			Occupied:   location.Occupied,
+			CareTypes:  location.CareTypes,
 		})
 		if totalCount == 0 {
 			totalCount = int(location.TotalCount)
@@ -87,15 +109,42 @@ func (s *locationService) UpdateLocation(
 	id string,
 	req *UpdateLocationRequest,
 ) (UpdateLocationResponse, error) {
-	err := s.store.UpdateLocation(ctx, db.UpdateLocationParams{
-		ID:         id,
-		Name:       req.Name,
-		PostalCode: req.PostalCode,
-		Address:    req.Address,
-		Capacity:   req.Capacity,
-		Occupied:   req.Occupied,
+	err := s.store.ExecTx(ctx, func(q *db.Queries) error {
+		updated, err := db.NewScopedStore(q, util.GetOrganizationID(ctx)).UpdateLocation(ctx, db.UpdateLocationParams{
+			ID:         id,
+			Name:       req.Name,
+			PostalCode: req.PostalCode,
+			Address:    req.Address,
+			Capacity:   req.Capacity,
+			Occupied:   req.Occupied,
+		})
+		if err != nil {
+			return err
+		}
+		if updated == 0 {
+			return ErrNotFound
+		}
+		if req.CareTypes == nil {
+			return nil
+		}
+		if err := q.DeleteLocationCareTypes(ctx, id); err != nil {
+			return err
+		}
+		if len(*req.CareTypes) == 0 {
+			return nil
+		}
+		return q.AddLocationCareTypes(ctx, db.AddLocationCareTypesParams{
+			LocationID: id,
+			CareTypes:  *req.CareTypes,
+		})
 	})
 	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return UpdateLocationResponse{}, ErrNotFound
+		}
+		if db.IsUniqueViolationOf(err, "uq_locations_active_name") {
+			return UpdateLocationResponse{}, ErrNameTaken
+		}
 		s.logger.Error(ctx, "UpdateLocation", "Failed to update location", zap.Error(err))
 		return UpdateLocationResponse{}, ErrInternal
 	}
@@ -108,11 +157,14 @@ func (s *locationService) DeleteLocation(
 	ctx context.Context,
 	id string,
 ) (DeleteLocationResponse, error) {
-	err := s.store.SoftDeleteLocation(ctx, id)
+	deleted, err := s.store.ForOrganization(util.GetOrganizationID(ctx)).SoftDeleteLocation(ctx, id)
 	if err != nil {
 		s.logger.Error(ctx, "DeleteLocation", "Failed to delete location", zap.Error(err))
 		return DeleteLocationResponse{}, ErrInternal
 	}
+	if deleted == 0 {
+		return DeleteLocationResponse{}, ErrNotFound
+	}
 	return DeleteLocationResponse{
 		Success: true,
 	}, nil
@@ -121,7 +173,7 @@ func (s *locationService) DeleteLocation(
 func (s *locationService) GetLocationCapacityStats(
 	ctx context.Context,
 ) (GetLocationCapacityStatsResponse, error) {
-	stats, err := s.store.GetLocationCapacityStats(ctx)
+	stats, err := s.store.ForOrganization(util.GetOrganizationID(ctx)).GetLocationCapacityStats(ctx)
 	if err != nil {
 		s.logger.Error(ctx, "GetLocationCapacityStats", "Failed to get capacity statistics", zap.Error(err))
 		return GetLocationCapacityStatsResponse{}, ErrInternal
@@ -137,3 +189,43 @@ func (s *locationService) GetLocationCapacityStats(
 		FreeCapacity:  int(stats.FreeCapacity),
 	}, nil
 }
+
+func (s *locationService) GetLocationCapacityTrend(
+	ctx context.Context,
+	id string,
+	req *GetLocationCapacityTrendRequest,
+) ([]LocationCapacityPoint, error) {
+	from := util.StrToPgtypeDate(req.From)
+	to := util.StrToPgtypeDate(req.To)
+	if !from.Valid || !to.Valid || to.Time.Before(from.Time) {
+		return nil, ErrInvalidRequest
+	}
+
+	if _, err := s.store.ForOrganization(util.GetOrganizationID(ctx)).GetLocation(ctx, id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		s.logger.Error(ctx, "GetLocationCapacityTrend", "Failed to get location", zap.Error(err))
+		return nil, ErrInternal
+	}
+
+	rows, err := s.store.GetLocationCapacityTrend(ctx, db.GetLocationCapacityTrendParams{
+		LocationID: id,
+		StartDate:  from,
+		EndDate:    to,
+	})
+	if err != nil {
+		s.logger.Error(ctx, "GetLocationCapacityTrend", "Failed to get capacity trend", zap.Error(err))
+		return nil, ErrInternal
+	}
+
+	points := make([]LocationCapacityPoint, 0, len(rows))
+	for _, row := range rows {
+		points = append(points, LocationCapacityPoint{
+			Date:     util.PgtypeDateToStr(row.SnapshotDate),
+			Capacity: row.Capacity,
+			Occupied: row.Occupied,
+		})
+	}
+	return points, nil
+}
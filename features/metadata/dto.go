@@ -0,0 +1,13 @@
+package metadata
+
+type EnumOption struct {
+	Value string `json:"value"`
+	Label string `json:"label"`
+	// Color is a suggested hex color for enums shown as colored badges
+	Color string `json:"color,omitempty"`
+}
+
+type EnumOptionsResponse struct {
+	// Enums maps the enum name (e.g. careType) to its options in declaration order
+	Enums map[string][]EnumOption `json:"enums"`
+}
@@ -0,0 +1,42 @@
+package metadata
+
+import (
+	"care-cordination/lib/middleware"
+	"care-cordination/lib/resp"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type MetadataHandler struct {
+	metadataService MetadataService
+	mdw             *middleware.Middleware
+}
+
+func NewMetadataHandler(
+	metadataService MetadataService,
+	mdw *middleware.Middleware,
+) *MetadataHandler {
+	return &MetadataHandler{
+		metadataService: metadataService,
+		mdw:             mdw,
+	}
+}
+
+func (h *MetadataHandler) SetupMetadataRoutes(router *gin.Engine) {
+	metadata := router.Group("/metadata")
+	metadata.Use(h.mdw.AuthMdw())
+
+	metadata.GET("/enums", h.GetEnumOptions)
+}
+
+// @Summary Get enum options
+// @Description Get the allowed values and Dutch labels of every enum used in forms and filters
+// @Tags Metadata
+// @Produce json
+// @Success 200 {object} resp.SuccessResponse[EnumOptionsResponse]
+// @Failure 401 {object} resp.ErrorResponse
+// @Router /metadata/enums [get]
+func (h *MetadataHandler) GetEnumOptions(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, resp.Success(h.metadataService.GetEnumOptions(ctx), "Enum options retrieved successfully"))
+}
@@ -0,0 +1,8 @@
+package metadata
+
+import "context"
+
+type MetadataService interface {
+	// GetEnumOptions returns the allowed values of every user-facing enum with their Dutch labels
+	GetEnumOptions(ctx context.Context) *EnumOptionsResponse
+}
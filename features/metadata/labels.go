@@ -0,0 +1,158 @@
+package metadata
+
+import db "care-cordination/lib/db/sqlc"
+
+// Dutch display labels for enum values. Values without a label are shown as-is,
+// so a new enum value is listed before it has been translated.
+
+var CareTypeLabels = map[db.CareTypeEnum]string{
+	db.CareTypeEnumProtectedLiving:           "Beschermd Wonen",
+	db.CareTypeEnumSemiIndependentLiving:     "Semi-zelfstandig Wonen",
+	db.CareTypeEnumIndependentAssistedLiving: "Begeleid Zelfstandig Wonen",
+	db.CareTypeEnumAmbulatoryCare:            "Ambulante Zorg",
+}
+
+var ClientStatusLabels = map[db.ClientStatusEnum]string{
+	db.ClientStatusEnumWaitingList: "Wachtlijst",
+	db.ClientStatusEnumInCare:      "In zorg",
+	db.ClientStatusEnumDischarged:  "Uitgestroomd",
+}
+
+var DischargeReasonLabels = map[db.DischargeReasonEnum]string{
+	db.DischargeReasonEnumTreatmentCompleted:             "Behandeling afgerond",
+	db.DischargeReasonEnumTerminatedByMutualAgreement:    "Beëindigd in onderling overleg",
+	db.DischargeReasonEnumTerminatedByClient:             "Beëindigd door cliënt",
+	db.DischargeReasonEnumTerminatedByProvider:           "Beëindigd door zorgaanbieder",
+	db.DischargeReasonEnumTerminatedDueToExternalFactors: "Beëindigd door externe factoren",
+	db.DischargeReasonEnumOther:                          "Anders",
+}
+
+var DischargeStatusLabels = map[db.DischargeStatusEnum]string{
+	db.DischargeStatusEnumInProgress: "In behandeling",
+	db.DischargeStatusEnumCompleted:  "Afgerond",
+}
+
+var GenderLabels = map[db.GenderEnum]string{
+	db.GenderEnumMale:           "Man",
+	db.GenderEnumFemale:         "Vrouw",
+	db.GenderEnumOther:          "Anders",
+	db.GenderEnumNonBinary:      "Non-binair",
+	db.GenderEnumUnknown:        "Onbekend",
+	db.GenderEnumPreferNotToSay: "Zeg ik liever niet",
+}
+
+var WaitingListPriorityLabels = map[db.WaitingListPriorityEnum]string{
+	db.WaitingListPriorityEnumLow:    "Laag",
+	db.WaitingListPriorityEnumNormal: "Normaal",
+	db.WaitingListPriorityEnumHigh:   "Hoog",
+}
+
+var ContractTypeLabels = map[db.ContractTypeEnum]string{
+	db.ContractTypeEnumSelfEmployed:   "ZZP",
+	db.ContractTypeEnumPayrollService: "Payroll",
+}
+
+var RegistrationStatusLabels = map[db.RegistrationStatusEnum]string{
+	db.RegistrationStatusEnumPending:  "In afwachting",
+	db.RegistrationStatusEnumApproved: "Goedgekeurd",
+	db.RegistrationStatusEnumRejected: "Afgewezen",
+	db.RegistrationStatusEnumInReview: "In beoordeling",
+}
+
+var IntakeStatusLabels = map[db.IntakeStatusEnum]string{
+	db.IntakeStatusEnumCompleted: "Afgerond",
+	db.IntakeStatusEnumPending:   "In afwachting",
+	db.IntakeStatusEnumRejected:  "Afgewezen",
+}
+
+var IntakeDocumentTypeLabels = map[db.IntakeDocumentTypeEnum]string{
+	db.IntakeDocumentTypeEnumIdentification: "Identiteitsbewijs",
+	db.IntakeDocumentTypeEnumCareIndication: "Zorgindicatie",
+	db.IntakeDocumentTypeEnumConsent:        "Toestemmingsverklaring",
+}
+
+var IntakeDocumentStatusLabels = map[db.IntakeDocumentStatusEnum]string{
+	db.IntakeDocumentStatusEnumMissing:  "Ontbreekt",
+	db.IntakeDocumentStatusEnumReceived: "Ontvangen",
+}
+
+var IncidentTypeLabels = map[db.IncidentTypeEnum]string{
+	db.IncidentTypeEnumAggression:       "Agressie",
+	db.IncidentTypeEnumMedicalEmergency: "Medisch noodgeval",
+	db.IncidentTypeEnumSafetyConcern:    "Veiligheidsrisico",
+	db.IncidentTypeEnumUnwantedBehavior: "Ongewenst gedrag",
+	db.IncidentTypeEnumOther:            "Anders",
+}
+
+var IncidentCategoryLabels = map[db.IncidentCategoryEnum]string{
+	db.IncidentCategoryEnumMedication:     "Medicatie",
+	db.IncidentCategoryEnumAggression:     "Agressie",
+	db.IncidentCategoryEnumFall:           "Val",
+	db.IncidentCategoryEnumAbsconding:     "Weglopen",
+	db.IncidentCategoryEnumSelfHarm:       "Zelfbeschadiging",
+	db.IncidentCategoryEnumSubstanceUse:   "Middelengebruik",
+	db.IncidentCategoryEnumPropertyDamage: "Vernieling",
+	db.IncidentCategoryEnumOther:          "Anders",
+}
+
+var IncidentSeverityLabels = map[db.IncidentSeverityEnum]string{
+	db.IncidentSeverityEnumMinor:    "Licht",
+	db.IncidentSeverityEnumModerate: "Matig",
+	db.IncidentSeverityEnumSevere:   "Ernstig",
+}
+
+var IncidentStatusLabels = map[db.IncidentStatusEnum]string{
+	db.IncidentStatusEnumPending:            "Open",
+	db.IncidentStatusEnumUnderInvestigation: "In onderzoek",
+	db.IncidentStatusEnumCompleted:          "Afgehandeld",
+}
+
+var LocationTransferStatusLabels = map[db.LocationTransferStatusEnum]string{
+	db.LocationTransferStatusEnumPending:   "In afwachting",
+	db.LocationTransferStatusEnumApproved:  "Goedgekeurd",
+	db.LocationTransferStatusEnumRejected:  "Afgewezen",
+	db.LocationTransferStatusEnumCancelled: "Geannuleerd",
+}
+
+var AppointmentTypeLabels = map[db.AppointmentTypeEnum]string{
+	db.AppointmentTypeEnumGeneral:    "Algemeen",
+	db.AppointmentTypeEnumIntake:     "Intake",
+	db.AppointmentTypeEnumAmbulatory: "Ambulant",
+}
+
+// Suggested calendar colors per appointment type
+var AppointmentTypeColors = map[db.AppointmentTypeEnum]string{
+	db.AppointmentTypeEnumGeneral:    "#6B7280",
+	db.AppointmentTypeEnumIntake:     "#2563EB",
+	db.AppointmentTypeEnumAmbulatory: "#16A34A",
+}
+
+var AppointmentStatusLabels = map[db.AppointmentStatusEnum]string{
+	db.AppointmentStatusEnumConfirmed: "Bevestigd",
+	db.AppointmentStatusEnumCancelled: "Geannuleerd",
+	db.AppointmentStatusEnumTentative: "Voorlopig",
+}
+
+var EvaluationStatusLabels = map[db.EvaluationStatusEnum]string{
+	db.EvaluationStatusEnumDraft:     "Concept",
+	db.EvaluationStatusEnumSubmitted: "Ingediend",
+}
+
+var EvaluationOutcomeLabels = map[db.EvaluationOutcomeEnum]string{
+	db.EvaluationOutcomeEnumOnTrack:        "Op schema",
+	db.EvaluationOutcomeEnumNeedsAttention: "Aandacht nodig",
+	db.EvaluationOutcomeEnumPlanAdjusted:   "Plan bijgesteld",
+}
+
+var GoalProgressStatusLabels = map[db.GoalProgressStatus]string{
+	db.GoalProgressStatusNotStarted:    "Niet gestart",
+	db.GoalProgressStatusStarting:      "Opstartfase",
+	db.GoalProgressStatusInProgress:    "Bezig",
+	db.GoalProgressStatusOnTrack:       "Op schema",
+	db.GoalProgressStatusDelayed:       "Vertraagd",
+	db.GoalProgressStatusStagnant:      "Stagnatie",
+	db.GoalProgressStatusDeteriorating: "Achteruitgang",
+	db.GoalProgressStatusAdjusted:      "Bijgesteld",
+	db.GoalProgressStatusNotApplicable: "Niet van toepassing",
+	db.GoalProgressStatusAchieved:      "Behaald",
+}
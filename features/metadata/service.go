@@ -0,0 +1,64 @@
+package metadata
+
+import (
+	db "care-cordination/lib/db/sqlc"
+	"context"
+)
+
+type metadataService struct {
+	enums map[string][]EnumOption
+}
+
+// NewMetadataService builds the option lists once; they only change with a new build.
+func NewMetadataService() MetadataService {
+	return &metadataService{
+		enums: map[string][]EnumOption{
+			"careType":               options(db.AllCareTypeEnumValues(), CareTypeLabels),
+			"clientStatus":           options(db.AllClientStatusEnumValues(), ClientStatusLabels),
+			"dischargeReason":        options(db.AllDischargeReasonEnumValues(), DischargeReasonLabels),
+			"dischargeStatus":        options(db.AllDischargeStatusEnumValues(), DischargeStatusLabels),
+			"gender":                 options(db.AllGenderEnumValues(), GenderLabels),
+			"waitingListPriority":    options(db.AllWaitingListPriorityEnumValues(), WaitingListPriorityLabels),
+			"contractType":           options(db.AllContractTypeEnumValues(), ContractTypeLabels),
+			"registrationStatus":     options(db.AllRegistrationStatusEnumValues(), RegistrationStatusLabels),
+			"intakeStatus":           options(db.AllIntakeStatusEnumValues(), IntakeStatusLabels),
+			"intakeDocumentType":     options(db.AllIntakeDocumentTypeEnumValues(), IntakeDocumentTypeLabels),
+			"intakeDocumentStatus":   options(db.AllIntakeDocumentStatusEnumValues(), IntakeDocumentStatusLabels),
+			"incidentType":           options(db.AllIncidentTypeEnumValues(), IncidentTypeLabels),
+			"incidentCategory":       options(db.AllIncidentCategoryEnumValues(), IncidentCategoryLabels),
+			"incidentSeverity":       options(db.AllIncidentSeverityEnumValues(), IncidentSeverityLabels),
+			"incidentStatus":         options(db.AllIncidentStatusEnumValues(), IncidentStatusLabels),
+			"locationTransferStatus": options(db.AllLocationTransferStatusEnumValues(), LocationTransferStatusLabels),
+			"appointmentType":        withColors(options(db.AllAppointmentTypeEnumValues(), AppointmentTypeLabels), AppointmentTypeColors),
+			"appointmentStatus":      options(db.AllAppointmentStatusEnumValues(), AppointmentStatusLabels),
+			"evaluationStatus":       options(db.AllEvaluationStatusEnumValues(), EvaluationStatusLabels),
+			"evaluationOutcome":      options(db.AllEvaluationOutcomeEnumValues(), EvaluationOutcomeLabels),
+			"goalProgressStatus":     options(db.AllGoalProgressStatusValues(), GoalProgressStatusLabels),
+		},
+	}
+}
+
+func (s *metadataService) GetEnumOptions(ctx context.Context) *EnumOptionsResponse {
+	return &EnumOptionsResponse{Enums: s.enums}
+}
+
+// options lists every value of an enum, falling back to the raw value when it has no label
+func options[T ~string](values []T, labels map[T]string) []EnumOption {
+	result := make([]EnumOption, 0, len(values))
+	for _, value := range values {
+		label, ok := labels[value]
+		if !ok {
+			label = string(value)
+		}
+		result = append(result, EnumOption{Value: string(value), Label: label})
+	}
+	return result
+}
+
+// withColors sets the suggested color on each option that has one
+func withColors[T ~string](options []EnumOption, colors map[T]string) []EnumOption {
+	for i := range options {
+		options[i].Color = colors[T(options[i].Value)]
+	}
+	return options
+}
@@ -0,0 +1,86 @@
+package metadata_test
+
+import (
+	"context"
+	"testing"
+
+	"care-cordination/features/metadata"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetEnumOptions_CareType(t *testing.T) {
+	service := metadata.NewMetadataService()
+
+	result := service.GetEnumOptions(context.Background())
+
+	require.Contains(t, result.Enums, "careType")
+	assert.Equal(t, []metadata.EnumOption{
+		{Value: "protected_living", Label: "Beschermd Wonen"},
+		{Value: "semi_independent_living", Label: "Semi-zelfstandig Wonen"},
+		{Value: "independent_assisted_living", Label: "Begeleid Zelfstandig Wonen"},
+		{Value: "ambulatory_care", Label: "Ambulante Zorg"},
+	}, result.Enums["careType"])
+}
+
+func TestGetEnumOptions_AllValuesLabelled(t *testing.T) {
+	service := metadata.NewMetadataService()
+
+	result := service.GetEnumOptions(context.Background())
+
+	// A new enum value is still listed without a label; this catches the missing translation
+	for name, options := range result.Enums {
+		require.NotEmpty(t, options, name)
+		for _, option := range options {
+			assert.NotEqual(t, option.Value, option.Label, "%s.%s has no Dutch label", name, option.Value)
+		}
+	}
+}
+
+func TestGetEnumOptions_AppointmentType(t *testing.T) {
+	service := metadata.NewMetadataService()
+
+	result := service.GetEnumOptions(context.Background())
+
+	require.Contains(t, result.Enums, "appointmentType")
+	assert.Equal(t, []metadata.EnumOption{
+		{Value: "general", Label: "Algemeen", Color: "#6B7280"},
+		{Value: "intake", Label: "Intake", Color: "#2563EB"},
+		{Value: "ambulatory", Label: "Ambulant", Color: "#16A34A"},
+	}, result.Enums["appointmentType"])
+}
+
+func TestGetEnumOptions_Gender(t *testing.T) {
+	service := metadata.NewMetadataService()
+
+	result := service.GetEnumOptions(context.Background())
+
+	require.Contains(t, result.Enums, "gender")
+	assert.Equal(t, []metadata.EnumOption{
+		{Value: "male", Label: "Man"},
+		{Value: "female", Label: "Vrouw"},
+		{Value: "other", Label: "Anders"},
+		{Value: "non_binary", Label: "Non-binair"},
+		{Value: "unknown", Label: "Onbekend"},
+		{Value: "prefer_not_to_say", Label: "Zeg ik liever niet"},
+	}, result.Enums["gender"])
+}
+
+func TestGetEnumOptions_IncidentCategory(t *testing.T) {
+	service := metadata.NewMetadataService()
+
+	result := service.GetEnumOptions(context.Background())
+
+	require.Contains(t, result.Enums, "incidentCategory")
+	assert.Equal(t, []metadata.EnumOption{
+		{Value: "medication", Label: "Medicatie"},
+		{Value: "aggression", Label: "Agressie"},
+		{Value: "fall", Label: "Val"},
+		{Value: "absconding", Label: "Weglopen"},
+		{Value: "self_harm", Label: "Zelfbeschadiging"},
+		{Value: "substance_use", Label: "Middelengebruik"},
+		{Value: "property_damage", Label: "Vernieling"},
+		{Value: "other", Label: "Anders"},
+	}, result.Enums["incidentCategory"])
+}
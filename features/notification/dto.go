@@ -40,6 +40,22 @@ type MarkReadRequest struct {
 	NotificationID string `json:"notification_id"`
 }
 
+// UpdateQuietHoursRequest sets the current user's quiet hours as HH:MM times
+// of day; an end before the start wraps past midnight
+type UpdateQuietHoursRequest struct {
+	Start    string `json:"start" binding:"required" example:"22:00"`
+	End      string `json:"end" binding:"required" example:"07:00"`
+	Timezone string `json:"timezone,omitempty" example:"Europe/Amsterdam"`
+}
+
+// QuietHoursResponse is the current user's quiet hours preference
+type QuietHoursResponse struct {
+	Enabled  bool   `json:"enabled"`
+	Start    string `json:"start,omitempty"`
+	End      string `json:"end,omitempty"`
+	Timezone string `json:"timezone"`
+}
+
 // WSAuthRequest is the request for WebSocket auth ticket exchange
 type WSAuthRequest struct {
 	Token string `json:"token" binding:"required"`
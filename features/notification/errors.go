@@ -3,11 +3,15 @@ package notification
 import "errors"
 
 var (
-	ErrInvalidRequest = errors.New("invalid request")
-	ErrInternal       = errors.New("internal server error")
-	ErrNotFound       = errors.New("notification not found")
-	ErrUnauthorized   = errors.New("unauthorized")
-	ErrInvalidToken   = errors.New("invalid or expired token")
-	ErrMissingToken   = errors.New("missing authentication token")
-	ErrInvalidTicket  = errors.New("invalid or expired ticket")
+	ErrInvalidRequest    = errors.New("invalid request")
+	ErrInternal          = errors.New("internal server error")
+	ErrNotFound          = errors.New("notification not found")
+	ErrUnauthorized      = errors.New("unauthorized")
+	ErrInvalidToken      = errors.New("invalid or expired token")
+	ErrMissingToken      = errors.New("missing authentication token")
+	ErrInvalidTicket     = errors.New("invalid or expired ticket")
+	ErrInvalidQuietHours = errors.New("invalid quiet hours")
+	// ErrResourceNotFound is returned when a notification links to a record
+	// that does not exist or was deleted
+	ErrResourceNotFound = errors.New("linked resource not found")
 )
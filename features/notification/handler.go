@@ -5,6 +5,7 @@ import (
 	"care-cordination/lib/resp"
 	"care-cordination/lib/token"
 	"care-cordination/lib/websocket"
+	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -55,6 +56,9 @@ func (h *NotificationHandler) SetupRoutes(router *gin.Engine) {
 	notifications.PATCH("/:id/read", h.mdw.AuthMdw(), h.MarkAsRead)
 	notifications.PATCH("/read-all", h.mdw.AuthMdw(), h.MarkAllAsRead)
 	notifications.DELETE("/:id", h.mdw.AuthMdw(), h.DeleteNotification)
+	notifications.GET("/quiet-hours", h.mdw.AuthMdw(), h.GetQuietHours)
+	notifications.PUT("/quiet-hours", h.mdw.AuthMdw(), h.UpdateQuietHours)
+	notifications.DELETE("/quiet-hours", h.mdw.AuthMdw(), h.DeleteQuietHours)
 
 	// WebSocket auth ticket endpoint
 	router.POST("/ws/auth", h.mdw.AuthMdw(), h.CreateWSTicket)
@@ -110,6 +114,75 @@ func (h *NotificationHandler) GetUnreadCount(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, resp.Success(UnreadCountResponse{Count: count}, "Unread count retrieved"))
 }
 
+// @Summary Get quiet hours
+// @Description Get the current user's quiet hours, during which non-critical notifications are held back
+// @Tags Notifications
+// @Produce json
+// @Success 200 {object} resp.SuccessResponse[QuietHoursResponse]
+// @Failure 401 {object} resp.ErrorResponse
+// @Failure 500 {object} resp.ErrorResponse
+// @Security Bearer
+// @Router /notifications/quiet-hours [get]
+func (h *NotificationHandler) GetQuietHours(ctx *gin.Context) {
+	result, err := h.service.GetQuietHours(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, resp.Error(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp.Success(result, "Quiet hours retrieved"))
+}
+
+// @Summary Update quiet hours
+// @Description Set the current user's quiet hours. High and urgent notifications are still delivered during them.
+// @Tags Notifications
+// @Accept json
+// @Produce json
+// @Param request body UpdateQuietHoursRequest true "Quiet hours"
+// @Success 200 {object} resp.SuccessResponse[QuietHoursResponse]
+// @Failure 400 {object} resp.ErrorResponse
+// @Failure 401 {object} resp.ErrorResponse
+// @Failure 500 {object} resp.ErrorResponse
+// @Security Bearer
+// @Router /notifications/quiet-hours [put]
+func (h *NotificationHandler) UpdateQuietHours(ctx *gin.Context) {
+	var req UpdateQuietHoursRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, resp.Error(ErrInvalidRequest))
+		return
+	}
+
+	result, err := h.service.UpdateQuietHours(ctx, &req)
+	if err != nil {
+		if errors.Is(err, ErrInvalidQuietHours) {
+			ctx.JSON(http.StatusBadRequest, resp.Error(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, resp.Error(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp.Success(result, "Quiet hours updated"))
+}
+
+// @Summary Delete quiet hours
+// @Description Turn quiet hours off for the current user
+// @Tags Notifications
+// @Produce json
+// @Success 200 {object} resp.MessageResponse
+// @Failure 401 {object} resp.ErrorResponse
+// @Failure 500 {object} resp.ErrorResponse
+// @Security Bearer
+// @Router /notifications/quiet-hours [delete]
+func (h *NotificationHandler) DeleteQuietHours(ctx *gin.Context) {
+	if err := h.service.DeleteQuietHours(ctx); err != nil {
+		ctx.JSON(http.StatusInternalServerError, resp.Error(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp.MessageResonse("Quiet hours deleted"))
+}
+
 // @Summary Mark notification as read
 // @Description Mark a single notification as read
 // @Tags Notifications
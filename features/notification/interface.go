@@ -1,10 +1,13 @@
 package notification
 
 import (
+	db "care-cordination/lib/db/sqlc"
 	"care-cordination/lib/resp"
 	"context"
 )
 
+//go:generate mockgen -destination=mocks/mock_notification_service.go -package=mocks care-cordination/features/notification NotificationService
+
 // NotificationService defines the interface for notification operations
 type NotificationService interface {
 	// Create creates a new notification and broadcasts it via WebSocket (synchronous)
@@ -14,6 +17,10 @@ type NotificationService interface {
 	// This is the preferred method for service triggers
 	Enqueue(req *CreateNotificationRequest)
 
+	// Deliver sends notifications the caller already stored, e.g. in its own
+	// transaction, over the channels their priority is routed to
+	Deliver(ctx context.Context, notifications []db.Notification)
+
 	// EnqueueForRole creates notifications for all users with the specified role (async)
 	EnqueueForRole(ctx context.Context, roleName string, req *CreateNotificationRequest)
 
@@ -29,9 +36,22 @@ type NotificationService interface {
 	// MarkAllAsRead marks all notifications as read for the current user
 	MarkAllAsRead(ctx context.Context) error
 
+	// MarkNotificationsReadByResource marks all of the user's notifications that
+	// point at the given resource as read, e.g. when the user opens it
+	MarkNotificationsReadByResource(ctx context.Context, userID, resourceType, resourceID string) error
+
 	// GetUnreadCount returns the count of unread notifications for the current user
 	GetUnreadCount(ctx context.Context) (int64, error)
 
 	// Delete deletes a notification
 	Delete(ctx context.Context, notificationID string) error
+
+	// GetQuietHours returns the current user's quiet hours
+	GetQuietHours(ctx context.Context) (*QuietHoursResponse, error)
+
+	// UpdateQuietHours sets the current user's quiet hours
+	UpdateQuietHours(ctx context.Context, req *UpdateQuietHoursRequest) (*QuietHoursResponse, error)
+
+	// DeleteQuietHours turns quiet hours off for the current user
+	DeleteQuietHours(ctx context.Context) error
 }
@@ -0,0 +1,237 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: care-cordination/features/notification (interfaces: NotificationService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/mock_notification_service.go -package=mocks care-cordination/features/notification NotificationService
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	notification "care-cordination/features/notification"
+	db "care-cordination/lib/db/sqlc"
+	resp "care-cordination/lib/resp"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNotificationService is a mock of NotificationService interface.
+type MockNotificationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockNotificationServiceMockRecorder is the mock recorder for MockNotificationService.
+type MockNotificationServiceMockRecorder struct {
+	mock *MockNotificationService
+}
+
+// NewMockNotificationService creates a new mock instance.
+func NewMockNotificationService(ctrl *gomock.Controller) *MockNotificationService {
+	mock := &MockNotificationService{ctrl: ctrl}
+	mock.recorder = &MockNotificationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationService) EXPECT() *MockNotificationServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockNotificationService) Create(ctx context.Context, req *notification.CreateNotificationRequest) (*notification.NotificationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, req)
+	ret0, _ := ret[0].(*notification.NotificationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockNotificationServiceMockRecorder) Create(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockNotificationService)(nil).Create), ctx, req)
+}
+
+// Delete mocks base method.
+func (m *MockNotificationService) Delete(ctx context.Context, notificationID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, notificationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockNotificationServiceMockRecorder) Delete(ctx, notificationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockNotificationService)(nil).Delete), ctx, notificationID)
+}
+
+// DeleteQuietHours mocks base method.
+func (m *MockNotificationService) DeleteQuietHours(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteQuietHours", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteQuietHours indicates an expected call of DeleteQuietHours.
+func (mr *MockNotificationServiceMockRecorder) DeleteQuietHours(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteQuietHours", reflect.TypeOf((*MockNotificationService)(nil).DeleteQuietHours), ctx)
+}
+
+// Deliver mocks base method.
+func (m *MockNotificationService) Deliver(ctx context.Context, notifications []db.Notification) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Deliver", ctx, notifications)
+}
+
+// Deliver indicates an expected call of Deliver.
+func (mr *MockNotificationServiceMockRecorder) Deliver(ctx, notifications any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deliver", reflect.TypeOf((*MockNotificationService)(nil).Deliver), ctx, notifications)
+}
+
+// Enqueue mocks base method.
+func (m *MockNotificationService) Enqueue(req *notification.CreateNotificationRequest) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Enqueue", req)
+}
+
+// Enqueue indicates an expected call of Enqueue.
+func (mr *MockNotificationServiceMockRecorder) Enqueue(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueue", reflect.TypeOf((*MockNotificationService)(nil).Enqueue), req)
+}
+
+// EnqueueForRole mocks base method.
+func (m *MockNotificationService) EnqueueForRole(ctx context.Context, roleName string, req *notification.CreateNotificationRequest) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "EnqueueForRole", ctx, roleName, req)
+}
+
+// EnqueueForRole indicates an expected call of EnqueueForRole.
+func (mr *MockNotificationServiceMockRecorder) EnqueueForRole(ctx, roleName, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueForRole", reflect.TypeOf((*MockNotificationService)(nil).EnqueueForRole), ctx, roleName, req)
+}
+
+// EnqueueForUsers mocks base method.
+func (m *MockNotificationService) EnqueueForUsers(userIDs []string, req *notification.CreateNotificationRequest) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "EnqueueForUsers", userIDs, req)
+}
+
+// EnqueueForUsers indicates an expected call of EnqueueForUsers.
+func (mr *MockNotificationServiceMockRecorder) EnqueueForUsers(userIDs, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueForUsers", reflect.TypeOf((*MockNotificationService)(nil).EnqueueForUsers), userIDs, req)
+}
+
+// GetQuietHours mocks base method.
+func (m *MockNotificationService) GetQuietHours(ctx context.Context) (*notification.QuietHoursResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuietHours", ctx)
+	ret0, _ := ret[0].(*notification.QuietHoursResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuietHours indicates an expected call of GetQuietHours.
+func (mr *MockNotificationServiceMockRecorder) GetQuietHours(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuietHours", reflect.TypeOf((*MockNotificationService)(nil).GetQuietHours), ctx)
+}
+
+// GetUnreadCount mocks base method.
+func (m *MockNotificationService) GetUnreadCount(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnreadCount", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnreadCount indicates an expected call of GetUnreadCount.
+func (mr *MockNotificationServiceMockRecorder) GetUnreadCount(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnreadCount", reflect.TypeOf((*MockNotificationService)(nil).GetUnreadCount), ctx)
+}
+
+// List mocks base method.
+func (m *MockNotificationService) List(ctx context.Context, req *notification.ListNotificationsRequest) (*resp.PaginationResponse[notification.NotificationResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, req)
+	ret0, _ := ret[0].(*resp.PaginationResponse[notification.NotificationResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockNotificationServiceMockRecorder) List(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockNotificationService)(nil).List), ctx, req)
+}
+
+// MarkAllAsRead mocks base method.
+func (m *MockNotificationService) MarkAllAsRead(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAllAsRead", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkAllAsRead indicates an expected call of MarkAllAsRead.
+func (mr *MockNotificationServiceMockRecorder) MarkAllAsRead(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllAsRead", reflect.TypeOf((*MockNotificationService)(nil).MarkAllAsRead), ctx)
+}
+
+// MarkAsRead mocks base method.
+func (m *MockNotificationService) MarkAsRead(ctx context.Context, notificationID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAsRead", ctx, notificationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkAsRead indicates an expected call of MarkAsRead.
+func (mr *MockNotificationServiceMockRecorder) MarkAsRead(ctx, notificationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAsRead", reflect.TypeOf((*MockNotificationService)(nil).MarkAsRead), ctx, notificationID)
+}
+
+// MarkNotificationsReadByResource mocks base method.
+func (m *MockNotificationService) MarkNotificationsReadByResource(ctx context.Context, userID, resourceType, resourceID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkNotificationsReadByResource", ctx, userID, resourceType, resourceID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkNotificationsReadByResource indicates an expected call of MarkNotificationsReadByResource.
+func (mr *MockNotificationServiceMockRecorder) MarkNotificationsReadByResource(ctx, userID, resourceType, resourceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotificationsReadByResource", reflect.TypeOf((*MockNotificationService)(nil).MarkNotificationsReadByResource), ctx, userID, resourceType, resourceID)
+}
+
+// UpdateQuietHours mocks base method.
+func (m *MockNotificationService) UpdateQuietHours(ctx context.Context, req *notification.UpdateQuietHoursRequest) (*notification.QuietHoursResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateQuietHours", ctx, req)
+	ret0, _ := ret[0].(*notification.QuietHoursResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateQuietHours indicates an expected call of UpdateQuietHours.
+func (mr *MockNotificationServiceMockRecorder) UpdateQuietHours(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateQuietHours", reflect.TypeOf((*MockNotificationService)(nil).UpdateQuietHours), ctx, req)
+}
@@ -0,0 +1,94 @@
+package notification
+
+import (
+	"fmt"
+	"time"
+	// Quiet hours are evaluated in the user's zone, so the zone database must
+	// be available even on minimal images
+	_ "time/tzdata"
+
+	db "care-cordination/lib/db/sqlc"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// DefaultQuietHoursTimezone is used when a user does not pick a timezone.
+const DefaultQuietHoursTimezone = "Europe/Amsterdam"
+
+// quietHoursReleaseInterval is how often notifications held back by quiet
+// hours are checked for release
+const quietHoursReleaseInterval = time.Minute
+
+// clockLayout is the HH:MM format quiet hours are exchanged in
+const clockLayout = "15:04"
+
+// QuietHours is a daily window in which non-critical notifications are held
+// back. A window whose End is before its Start wraps past midnight.
+type QuietHours struct {
+	Start    time.Duration // Offset from local midnight
+	End      time.Duration // Offset from local midnight
+	Location *time.Location
+}
+
+// Until reports whether now falls within the window and, if so, when the
+// window ends.
+func (q QuietHours) Until(now time.Time) (time.Time, bool) {
+	local := now.In(q.Location)
+	clock := time.Duration(local.Hour())*time.Hour +
+		time.Duration(local.Minute())*time.Minute +
+		time.Duration(local.Second())*time.Second
+
+	var inside bool
+	days := 0
+	if q.Start < q.End {
+		inside = clock >= q.Start && clock < q.End
+	} else {
+		inside = clock >= q.Start || clock < q.End
+		if clock >= q.Start {
+			// The window ends tomorrow morning
+			days = 1
+		}
+	}
+	if !inside {
+		return time.Time{}, false
+	}
+
+	end := time.Date(local.Year(), local.Month(), local.Day()+days,
+		int(q.End/time.Hour), int(q.End%time.Hour/time.Minute), 0, 0, q.Location)
+	return end, true
+}
+
+// quietHoursFromRow converts a stored preference, falling back to
+// DefaultQuietHoursTimezone for zones the runtime does not know
+func quietHoursFromRow(row db.NotificationQuietHour) QuietHours {
+	loc, err := time.LoadLocation(row.Timezone)
+	if err != nil {
+		loc, _ = time.LoadLocation(DefaultQuietHoursTimezone)
+	}
+	return QuietHours{
+		Start:    time.Duration(row.StartTime.Microseconds) * time.Microsecond,
+		End:      time.Duration(row.EndTime.Microseconds) * time.Microsecond,
+		Location: loc,
+	}
+}
+
+// parseClock parses an HH:MM time of day
+func parseClock(val string) (pgtype.Time, error) {
+	t, err := time.Parse(clockLayout, val)
+	if err != nil {
+		return pgtype.Time{}, fmt.Errorf("invalid time %q, expected HH:MM", val)
+	}
+	offset := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+	return pgtype.Time{Microseconds: offset.Microseconds(), Valid: true}, nil
+}
+
+// formatClock renders a stored time of day as HH:MM
+func formatClock(t pgtype.Time) string {
+	offset := time.Duration(t.Microseconds) * time.Microsecond
+	return fmt.Sprintf("%02d:%02d", int(offset/time.Hour), int(offset%time.Hour/time.Minute))
+}
+
+// bypassesQuietHours reports whether a priority is delivered during quiet hours
+func bypassesQuietHours(priority string) bool {
+	return priority == PriorityHigh || priority == PriorityUrgent
+}
@@ -0,0 +1,47 @@
+package notification
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuietHoursUntil(t *testing.T) {
+	amsterdam, err := time.LoadLocation(DefaultQuietHoursTimezone)
+	require.NoError(t, err)
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2026, 3, day, hour, minute, 0, 0, amsterdam)
+	}
+
+	overnight := QuietHours{Start: 22 * time.Hour, End: 7 * time.Hour, Location: amsterdam}
+	daytime := QuietHours{Start: 12 * time.Hour, End: 13*time.Hour + 30*time.Minute, Location: amsterdam}
+
+	tests := []struct {
+		name      string
+		hours     QuietHours
+		now       time.Time
+		wantQuiet bool
+		wantUntil time.Time
+	}{
+		{name: "overnight_before_midnight", hours: overnight, now: at(10, 23, 15), wantQuiet: true, wantUntil: at(11, 7, 0)},
+		{name: "overnight_after_midnight", hours: overnight, now: at(10, 3, 0), wantQuiet: true, wantUntil: at(10, 7, 0)},
+		{name: "overnight_at_end", hours: overnight, now: at(10, 7, 0), wantQuiet: false},
+		{name: "overnight_daytime", hours: overnight, now: at(10, 15, 0), wantQuiet: false},
+		{name: "daytime_inside", hours: daytime, now: at(10, 12, 45), wantQuiet: true, wantUntil: at(10, 13, 30)},
+		{name: "daytime_outside", hours: daytime, now: at(10, 11, 59), wantQuiet: false},
+		// 02:30 UTC is 03:30 in Amsterdam, inside the window
+		{name: "evaluated_in_user_zone", hours: overnight, now: time.Date(2026, 3, 10, 2, 30, 0, 0, time.UTC), wantQuiet: true, wantUntil: at(10, 7, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			until, quiet := tt.hours.Until(tt.now)
+			assert.Equal(t, tt.wantQuiet, quiet)
+			if tt.wantQuiet {
+				assert.True(t, tt.wantUntil.Equal(until), "until = %v, want %v", until, tt.wantUntil)
+			}
+		})
+	}
+}
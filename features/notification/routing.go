@@ -0,0 +1,132 @@
+package notification
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"care-cordination/lib/email"
+)
+
+// Channel is a way a notification reaches its recipient. Every notification is
+// stored in the database regardless of its channels.
+type Channel string
+
+const (
+	// ChannelWebSocket pushes the notification to connected clients right away
+	ChannelWebSocket Channel = "websocket"
+	// ChannelEmail emails the notification to the recipient
+	ChannelEmail Channel = "email"
+	// ChannelDigest holds the notification back for the periodic digest
+	ChannelDigest Channel = "digest"
+)
+
+// DefaultRouting is the routing used when none is configured, in the format
+// read by ParseRoutingPolicy.
+const DefaultRouting = "urgent=websocket+email,high=websocket+email,normal=websocket,low=digest"
+
+// DefaultDigestInterval is how often deferred notifications are delivered.
+const DefaultDigestInterval = time.Hour
+
+// RoutingPolicy maps a notification priority to its delivery channels.
+type RoutingPolicy map[string][]Channel
+
+// DefaultRoutingPolicy returns the policy described by DefaultRouting.
+func DefaultRoutingPolicy() RoutingPolicy {
+	return RoutingPolicy{
+		PriorityUrgent: {ChannelWebSocket, ChannelEmail},
+		PriorityHigh:   {ChannelWebSocket, ChannelEmail},
+		PriorityNormal: {ChannelWebSocket},
+		PriorityLow:    {ChannelDigest},
+	}
+}
+
+// ParseRoutingPolicy reads a comma-separated list of priority=channels pairs,
+// where channels are joined with "+", e.g. "high=websocket+email,low=digest".
+// Priorities that are not listed keep the default routing.
+func ParseRoutingPolicy(val string) (RoutingPolicy, error) {
+	policy := DefaultRoutingPolicy()
+
+	for _, part := range strings.Split(val, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		priority, channels, ok := strings.Cut(part, "=")
+		priority = strings.TrimSpace(priority)
+		if !ok || !isPriority(priority) {
+			return nil, fmt.Errorf("invalid routing entry %q", part)
+		}
+
+		var parsed []Channel
+		for _, name := range strings.Split(channels, "+") {
+			ch := Channel(strings.TrimSpace(name))
+			switch ch {
+			case ChannelWebSocket, ChannelEmail, ChannelDigest:
+			default:
+				return nil, fmt.Errorf("unknown channel %q for priority %s", name, priority)
+			}
+			if !slices.Contains(parsed, ch) {
+				parsed = append(parsed, ch)
+			}
+		}
+		policy[priority] = parsed
+	}
+	return policy, nil
+}
+
+// Channels returns the delivery channels for a priority.
+func (p RoutingPolicy) Channels(priority string) []Channel {
+	return p[priority]
+}
+
+func isPriority(p string) bool {
+	switch p {
+	case PriorityLow, PriorityNormal, PriorityHigh, PriorityUrgent:
+		return true
+	}
+	return false
+}
+
+// DeliveryConfig controls how notifications are delivered beyond the
+// database row.
+type DeliveryConfig struct {
+	Routing RoutingPolicy
+	// Email sends ChannelEmail notifications; nil disables that channel
+	Email email.Sender
+	// DigestInterval is how often deferred notifications are flushed; zero
+	// disables the background flush
+	DigestInterval time.Duration
+}
+
+// DefaultDeliveryConfig routes by DefaultRouting without email.
+func DefaultDeliveryConfig() DeliveryConfig {
+	return DeliveryConfig{
+		Routing:        DefaultRoutingPolicy(),
+		DigestInterval: DefaultDigestInterval,
+	}
+}
+
+// NewDeliveryConfig builds a DeliveryConfig from configuration values. The
+// email channel is enabled only when smtp.Host is set; failed sends are
+// retried according to retry before the notification is dead-lettered.
+func NewDeliveryConfig(
+	routing string,
+	digestInterval time.Duration,
+	smtp email.SMTPConfig,
+	retry email.RetryPolicy,
+) (DeliveryConfig, error) {
+	policy, err := ParseRoutingPolicy(routing)
+	if err != nil {
+		return DeliveryConfig{}, err
+	}
+	delivery := DeliveryConfig{
+		Routing:        policy,
+		DigestInterval: digestInterval,
+	}
+	if smtp.Host != "" {
+		delivery.Email = email.WithRetry(email.NewSMTPSender(smtp), retry)
+	}
+	return delivery, nil
+}
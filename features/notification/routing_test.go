@@ -0,0 +1,37 @@
+package notification
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRoutingPolicy(t *testing.T) {
+	t.Run("empty_uses_defaults", func(t *testing.T) {
+		policy, err := ParseRoutingPolicy("")
+		require.NoError(t, err)
+		assert.Equal(t, DefaultRoutingPolicy(), policy)
+	})
+
+	t.Run("default_string_matches_default_policy", func(t *testing.T) {
+		policy, err := ParseRoutingPolicy(DefaultRouting)
+		require.NoError(t, err)
+		assert.Equal(t, DefaultRoutingPolicy(), policy)
+	})
+
+	t.Run("overrides_listed_priorities_only", func(t *testing.T) {
+		policy, err := ParseRoutingPolicy(" normal = websocket+email , low=websocket")
+		require.NoError(t, err)
+		assert.Equal(t, []Channel{ChannelWebSocket, ChannelEmail}, policy.Channels(PriorityNormal))
+		assert.Equal(t, []Channel{ChannelWebSocket}, policy.Channels(PriorityLow))
+		assert.Equal(t, []Channel{ChannelWebSocket, ChannelEmail}, policy.Channels(PriorityHigh))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, val := range []string{"high", "critical=email", "high=sms", "low="} {
+			_, err := ParseRoutingPolicy(val)
+			assert.Error(t, err, val)
+		}
+	})
+}
@@ -3,14 +3,19 @@ package notification
 import (
 	"care-cordination/lib/middleware"
 	db "care-cordination/lib/db/sqlc"
+	"care-cordination/lib/email"
 	"care-cordination/lib/logger"
 	"care-cordination/lib/nanoid"
 	"care-cordination/lib/resp"
 	"care-cordination/lib/util"
 	"care-cordination/lib/websocket"
 	"context"
+	"errors"
+	"fmt"
 	"sync"
+	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"go.uber.org/zap"
 )
@@ -27,28 +32,73 @@ type notificationService struct {
 	hub    *websocket.Hub
 	logger logger.Logger
 
+	// Delivery routing
+	routing RoutingPolicy
+	mailer  email.Sender
+	// Notifications waiting to be emailed; sending retries with backoff, so
+	// it runs on its own goroutine instead of the caller's
+	emails chan emailJob
+
+	// Notifications held back for the next digest, per user
+	digestMu sync.Mutex
+	digest   map[string][]websocket.NotificationPayload
+
+	// Notifications held back until the user's quiet hours end, per user
+	deferredMu sync.Mutex
+	deferred   map[string][]deferredNotification
+	now        func() time.Time
+
 	// Async queue
 	queue      chan *CreateNotificationRequest
 	workerWg   sync.WaitGroup
 	workerDone chan struct{}
 }
 
-// NewNotificationService creates a new notification service
+// NewNotificationService creates a new notification service with the default
+// delivery routing and no email channel
 func NewNotificationService(
 	store db.StoreInterface,
 	hub *websocket.Hub,
 	logger logger.Logger,
 ) NotificationService {
+	return NewNotificationServiceWithDelivery(store, hub, logger, DefaultDeliveryConfig())
+}
+
+// NewNotificationServiceWithDelivery creates a notification service that
+// routes notifications to channels by priority
+func NewNotificationServiceWithDelivery(
+	store db.StoreInterface,
+	hub *websocket.Hub,
+	logger logger.Logger,
+	delivery DeliveryConfig,
+) NotificationService {
+	routing := delivery.Routing
+	if routing == nil {
+		routing = DefaultRoutingPolicy()
+	}
 	s := &notificationService{
 		store:      store,
 		hub:        hub,
 		logger:     logger,
+		routing:    routing,
+		mailer:     delivery.Email,
+		digest:     map[string][]websocket.NotificationPayload{},
+		deferred:   map[string][]deferredNotification{},
+		now:        time.Now,
 		queue:      make(chan *CreateNotificationRequest, defaultQueueCapacity),
 		workerDone: make(chan struct{}),
 	}
 
 	// Start background workers
 	s.startWorkers(defaultWorkerCount)
+	if s.mailer != nil {
+		s.emails = make(chan emailJob, defaultQueueCapacity)
+		s.startEmailWorker()
+	}
+	if delivery.DigestInterval > 0 {
+		s.startDigest(delivery.DigestInterval)
+	}
+	s.startQuietHoursRelease(quietHoursReleaseInterval)
 
 	return s
 }
@@ -79,7 +129,13 @@ func (s *notificationService) worker(id int) {
 			}
 			// Process the notification
 			_, err := s.createInternal(ctx, req)
-			if err != nil {
+			if errors.Is(err, ErrResourceNotFound) {
+				s.logger.Warn(ctx, "NotificationWorker", "Notification dropped, linked resource not found",
+					zap.String("userID", req.UserID),
+					zap.String("resourceType", *req.ResourceType),
+					zap.String("resourceID", *req.ResourceID),
+				)
+			} else if err != nil {
 				s.logger.Error(ctx, "NotificationWorker", "Failed to create notification",
 					zap.Int("workerID", id),
 					zap.Error(err),
@@ -106,6 +162,14 @@ func (s *notificationService) Enqueue(req *CreateNotificationRequest) {
 	}
 }
 
+// Deliver sends notifications stored outside the queue like the queue's
+// workers send the ones they create
+func (s *notificationService) Deliver(ctx context.Context, notifications []db.Notification) {
+	for _, n := range notifications {
+		s.deliver(ctx, n.UserID, string(n.Priority), s.mapToResponse(n))
+	}
+}
+
 // EnqueueForRole creates notifications for all users with the specified role (async)
 func (s *notificationService) EnqueueForRole(ctx context.Context, roleName string, req *CreateNotificationRequest) {
 	// Get all user IDs with the role
@@ -160,6 +224,20 @@ func (s *notificationService) createInternal(ctx context.Context, req *CreateNot
 		priority = PriorityNormal
 	}
 
+	// A deep link to a missing record would make the frontend 404
+	if req.ResourceType != nil && req.ResourceID != nil {
+		exists, err := s.store.NotificationResourceExists(ctx, db.NotificationResourceExistsParams{
+			ResourceType: *req.ResourceType,
+			ResourceID:   *req.ResourceID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, ErrResourceNotFound
+		}
+	}
+
 	// Create the notification in the database
 	notification, err := s.store.CreateNotification(ctx, db.CreateNotificationParams{
 		ID:           nanoid.Generate(),
@@ -179,30 +257,262 @@ func (s *notificationService) createInternal(ctx context.Context, req *CreateNot
 	// Build response
 	response := s.mapToResponse(notification)
 
-	// Broadcast via WebSocket if hub is available
-	if s.hub != nil {
-		s.hub.SendToUser(req.UserID, &websocket.Message{
-			Type: websocket.MessageTypeNotification,
-			Payload: websocket.NotificationPayload{
-				ID:           response.ID,
-				Type:         response.Type,
-				Priority:     response.Priority,
-				Title:        response.Title,
-				Message:      response.Message,
-				ResourceType: response.ResourceType,
-				ResourceID:   response.ResourceID,
-				CreatedAt:    response.CreatedAt,
+	s.deliver(ctx, req.UserID, priority, response)
+
+	return response, nil
+}
+
+// deferredNotification is a stored notification whose delivery waits for the
+// end of the recipient's quiet hours
+type deferredNotification struct {
+	until    time.Time
+	priority string
+	response *NotificationResponse
+}
+
+// deliver sends a stored notification over the channels its priority is
+// routed to, holding it back while the recipient is in quiet hours unless the
+// priority is high enough. Delivery failures are logged; the notification
+// stays stored.
+func (s *notificationService) deliver(ctx context.Context, userID, priority string, response *NotificationResponse) {
+	if !bypassesQuietHours(priority) {
+		if until, ok := s.quietUntil(ctx, userID); ok {
+			s.deferredMu.Lock()
+			s.deferred[userID] = append(s.deferred[userID], deferredNotification{
+				until:    until,
+				priority: priority,
+				response: response,
+			})
+			s.deferredMu.Unlock()
+			return
+		}
+	}
+	s.deliverNow(ctx, userID, priority, response)
+}
+
+// quietUntil returns when the user's quiet hours end if they are in them now.
+// Lookup failures deliver right away rather than lose the notification.
+func (s *notificationService) quietUntil(ctx context.Context, userID string) (time.Time, bool) {
+	row, err := s.store.GetNotificationQuietHours(ctx, userID)
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			s.logger.Error(ctx, "NotificationQuietHours", "Failed to look up quiet hours",
+				zap.String("userID", userID),
+				zap.Error(err),
+			)
+		}
+		return time.Time{}, false
+	}
+	return quietHoursFromRow(row).Until(s.now())
+}
+
+// deliverNow sends a notification over its routed channels
+func (s *notificationService) deliverNow(ctx context.Context, userID, priority string, response *NotificationResponse) {
+	for _, channel := range s.routing.Channels(priority) {
+		switch channel {
+		case ChannelWebSocket:
+			if s.hub != nil {
+				s.hub.SendToUser(userID, &websocket.Message{
+					Type:    websocket.MessageTypeNotification,
+					Payload: toPayload(response),
+				})
+			}
+		case ChannelEmail:
+			s.queueEmail(userID, response)
+		case ChannelDigest:
+			s.digestMu.Lock()
+			s.digest[userID] = append(s.digest[userID], toPayload(response))
+			s.digestMu.Unlock()
+		}
+	}
+}
+
+// emailJob is a stored notification waiting to be emailed
+type emailJob struct {
+	userID   string
+	response *NotificationResponse
+}
+
+// errEmailQueueFull is dead-lettered for emails dropped because the email
+// queue is full
+var errEmailQueueFull = errors.New("email queue full")
+
+// queueEmail hands a notification to the email worker without waiting for the
+// send. When the queue is full the email is dead-lettered instead.
+func (s *notificationService) queueEmail(userID string, response *NotificationResponse) {
+	if s.mailer == nil {
+		return
+	}
+	select {
+	case s.emails <- emailJob{userID: userID, response: response}:
+	default:
+		ctx := context.Background()
+		s.logger.Warn(ctx, "NotificationEmail", "Email queue full, notification email dropped",
+			zap.String("notificationID", response.ID),
+		)
+		s.deadLetter(ctx, userID, response.ID, ChannelEmail, errEmailQueueFull)
+	}
+}
+
+// startEmailWorker sends queued emails until the service stops. It uses its
+// own context so a send and its dead letter outlive the request that
+// created the notification.
+func (s *notificationService) startEmailWorker() {
+	go func() {
+		ctx := context.Background()
+		for {
+			select {
+			case job := <-s.emails:
+				s.sendEmail(ctx, job.userID, job.response)
+			case <-s.workerDone:
+				return
+			}
+		}
+	}()
+}
+
+func (s *notificationService) sendEmail(ctx context.Context, userID string, response *NotificationResponse) {
+	user, err := s.store.GetUserByID(ctx, userID)
+	if err != nil {
+		s.logger.Error(ctx, "NotificationEmail", "Failed to look up recipient",
+			zap.String("userID", userID),
+			zap.Error(err),
+		)
+		return
+	}
+	if err := s.mailer.Send(ctx, user.Email, response.Title, response.Message); err != nil {
+		s.logger.Error(ctx, "NotificationEmail", "Failed to send notification email",
+			zap.String("notificationID", response.ID),
+			zap.Error(err),
+		)
+		s.deadLetter(ctx, userID, response.ID, ChannelEmail, err)
+	}
+}
+
+// deadLetter records a notification a channel gave up delivering
+func (s *notificationService) deadLetter(ctx context.Context, userID, notificationID string, channel Channel, sendErr error) {
+	err := s.store.CreateNotificationDeadLetter(ctx, db.CreateNotificationDeadLetterParams{
+		ID:             nanoid.Generate(),
+		NotificationID: notificationID,
+		UserID:         userID,
+		Channel:        string(channel),
+		Error:          sendErr.Error(),
+	})
+	if err != nil {
+		s.logger.Error(ctx, "NotificationDeadLetter", "Failed to record undelivered notification",
+			zap.String("notificationID", notificationID),
+			zap.Error(err),
+		)
+	}
+}
+
+// startDigest flushes the digest every interval until the service stops
+func (s *notificationService) startDigest(interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				s.flushDigest()
+			case <-s.workerDone:
+				return
+			}
+		}
+	}()
+}
+
+// startQuietHoursRelease delivers held-back notifications every interval
+// until the service stops
+func (s *notificationService) startQuietHoursRelease(interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				s.releaseDeferred()
+			case <-s.workerDone:
+				return
+			}
+		}
+	}()
+}
+
+// releaseDeferred delivers the notifications whose quiet hours have ended
+func (s *notificationService) releaseDeferred() {
+	now := s.now()
+	due := map[string][]deferredNotification{}
+
+	s.deferredMu.Lock()
+	for userID, pending := range s.deferred {
+		var waiting []deferredNotification
+		for _, n := range pending {
+			if n.until.After(now) {
+				waiting = append(waiting, n)
+			} else {
+				due[userID] = append(due[userID], n)
+			}
+		}
+		if len(waiting) == 0 {
+			delete(s.deferred, userID)
+		} else {
+			s.deferred[userID] = waiting
+		}
+	}
+	s.deferredMu.Unlock()
+
+	ctx := context.Background()
+	for userID, notifications := range due {
+		for _, n := range notifications {
+			s.deliverNow(ctx, userID, n.priority, n.response)
+		}
+	}
+}
+
+// flushDigest sends every user their held-back notifications as a single
+// WebSocket message and empties the digest
+func (s *notificationService) flushDigest() {
+	s.digestMu.Lock()
+	pending := s.digest
+	s.digest = map[string][]websocket.NotificationPayload{}
+	s.digestMu.Unlock()
+
+	if s.hub == nil {
+		return
+	}
+	for userID, notifications := range pending {
+		s.hub.SendToUser(userID, &websocket.Message{
+			Type: websocket.MessageTypeNotificationDigest,
+			Payload: websocket.NotificationDigestPayload{
+				Count:         len(notifications),
+				Notifications: notifications,
 			},
 		})
 	}
+}
 
-	return response, nil
+func toPayload(response *NotificationResponse) websocket.NotificationPayload {
+	return websocket.NotificationPayload{
+		ID:           response.ID,
+		Type:         response.Type,
+		Priority:     response.Priority,
+		Title:        response.Title,
+		Message:      response.Message,
+		ResourceType: response.ResourceType,
+		ResourceID:   response.ResourceID,
+		CreatedAt:    response.CreatedAt,
+	}
 }
 
 // Create creates a new notification and broadcasts it via WebSocket (synchronous)
-// Use Enqueue for async non-blocking creation
+// Use Enqueue for async non-blocking creation. A notification linking to a
+// missing or deleted resource is rejected with ErrResourceNotFound.
 func (s *notificationService) Create(ctx context.Context, req *CreateNotificationRequest) (*NotificationResponse, error) {
 	response, err := s.createInternal(ctx, req)
+	if errors.Is(err, ErrResourceNotFound) {
+		return nil, err
+	}
 	if err != nil {
 		s.logger.Error(ctx, "CreateNotification", "Failed to create notification", zap.Error(err))
 		return nil, ErrInternal
@@ -277,6 +587,29 @@ func (s *notificationService) MarkAllAsRead(ctx context.Context) error {
 	return nil
 }
 
+// MarkNotificationsReadByResource marks the user's notifications for a resource as read
+func (s *notificationService) MarkNotificationsReadByResource(
+	ctx context.Context,
+	userID, resourceType, resourceID string,
+) error {
+	err := s.store.MarkNotificationsReadByResource(ctx, db.MarkNotificationsReadByResourceParams{
+		UserID:       userID,
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+	})
+	if err != nil {
+		s.logger.Error(
+			ctx,
+			"MarkNotificationsReadByResource",
+			"Failed to mark notifications for resource as read",
+			zap.Error(err),
+		)
+		return ErrInternal
+	}
+
+	return nil
+}
+
 // GetUnreadCount returns the count of unread notifications for the current user
 func (s *notificationService) GetUnreadCount(ctx context.Context) (int64, error) {
 	userID := util.GetUserID(ctx)
@@ -306,6 +639,80 @@ func (s *notificationService) Delete(ctx context.Context, notificationID string)
 	return nil
 }
 
+// GetQuietHours returns the current user's quiet hours
+func (s *notificationService) GetQuietHours(ctx context.Context) (*QuietHoursResponse, error) {
+	userID := util.GetUserID(ctx)
+
+	row, err := s.store.GetNotificationQuietHours(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &QuietHoursResponse{Timezone: DefaultQuietHoursTimezone}, nil
+		}
+		s.logger.Error(ctx, "GetQuietHours", "Failed to get quiet hours", zap.Error(err))
+		return nil, ErrInternal
+	}
+
+	return toQuietHoursResponse(row), nil
+}
+
+// UpdateQuietHours sets the current user's quiet hours
+func (s *notificationService) UpdateQuietHours(ctx context.Context, req *UpdateQuietHoursRequest) (*QuietHoursResponse, error) {
+	userID := util.GetUserID(ctx)
+
+	start, err := parseClock(req.Start)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidQuietHours, err)
+	}
+	end, err := parseClock(req.End)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidQuietHours, err)
+	}
+	if start == end {
+		return nil, fmt.Errorf("%w: start and end must differ", ErrInvalidQuietHours)
+	}
+	timezone := req.Timezone
+	if timezone == "" {
+		timezone = DefaultQuietHoursTimezone
+	}
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return nil, fmt.Errorf("%w: unknown timezone %q", ErrInvalidQuietHours, timezone)
+	}
+
+	row, err := s.store.UpsertNotificationQuietHours(ctx, db.UpsertNotificationQuietHoursParams{
+		UserID:    userID,
+		StartTime: start,
+		EndTime:   end,
+		Timezone:  timezone,
+	})
+	if err != nil {
+		s.logger.Error(ctx, "UpdateQuietHours", "Failed to save quiet hours", zap.Error(err))
+		return nil, ErrInternal
+	}
+
+	return toQuietHoursResponse(row), nil
+}
+
+// DeleteQuietHours turns quiet hours off for the current user
+func (s *notificationService) DeleteQuietHours(ctx context.Context) error {
+	userID := util.GetUserID(ctx)
+
+	if err := s.store.DeleteNotificationQuietHours(ctx, userID); err != nil {
+		s.logger.Error(ctx, "DeleteQuietHours", "Failed to delete quiet hours", zap.Error(err))
+		return ErrInternal
+	}
+
+	return nil
+}
+
+func toQuietHoursResponse(row db.NotificationQuietHour) *QuietHoursResponse {
+	return &QuietHoursResponse{
+		Enabled:  true,
+		Start:    formatClock(row.StartTime),
+		End:      formatClock(row.EndTime),
+		Timezone: row.Timezone,
+	}
+}
+
 // mapToResponse maps a database notification to response DTO
 func (s *notificationService) mapToResponse(n db.Notification) *NotificationResponse {
 	resp := &NotificationResponse{
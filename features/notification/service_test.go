@@ -2,14 +2,18 @@ package notification
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	db "care-cordination/lib/db/sqlc"
 	dbmocks "care-cordination/lib/db/sqlc/mocks"
+	emailmocks "care-cordination/lib/email/mocks"
 	loggermocks "care-cordination/lib/logger/mocks"
+	"care-cordination/lib/util"
 	"care-cordination/lib/websocket"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -31,6 +35,9 @@ func setupTestService(t *testing.T) (*notificationService, *dbmocks.MockStoreInt
 	mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 
+	// No user has quiet hours unless a test sets them up
+	expectNoQuietHours(mockStore)
+
 	// Create a real hub for testing WebSocket delivery
 	hub := websocket.NewHub(mockLogger)
 	go hub.Run()
@@ -40,6 +47,31 @@ func setupTestService(t *testing.T) (*notificationService, *dbmocks.MockStoreInt
 	return service, mockStore, mockLogger, hub, ctrl
 }
 
+func expectNoQuietHours(mockStore *dbmocks.MockStoreInterface) {
+	mockStore.EXPECT().
+		GetNotificationQuietHours(gomock.Any(), gomock.Any()).
+		Return(db.NotificationQuietHour{}, pgx.ErrNoRows).
+		AnyTimes()
+}
+
+// echoCreateNotification makes CreateNotification return the stored row
+func echoCreateNotification(mockStore *dbmocks.MockStoreInterface) {
+	mockStore.EXPECT().
+		CreateNotification(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, params db.CreateNotificationParams) (db.Notification, error) {
+			return db.Notification{
+				ID:        params.ID,
+				UserID:    params.UserID,
+				Type:      params.Type,
+				Priority:  params.Priority,
+				Title:     params.Title,
+				Message:   params.Message,
+				CreatedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+			}, nil
+		}).
+		AnyTimes()
+}
+
 // ============================================================
 // Test: Create (synchronous)
 // ============================================================
@@ -141,6 +173,77 @@ func TestCreate(t *testing.T) {
 	}
 }
 
+// ============================================================
+// Test: Deep-link validation
+// ============================================================
+
+func TestCreate_ValidatesLinkedResource(t *testing.T) {
+	resourceType := ResourceTypeAppointment
+	resourceID := "apt-missing"
+	req := &CreateNotificationRequest{
+		UserID:       "user-123",
+		Type:         TypeAppointmentCancelled,
+		Title:        "Appointment Cancelled",
+		Message:      "Intake on 2026-03-02 10:00 was cancelled",
+		ResourceType: &resourceType,
+		ResourceID:   &resourceID,
+	}
+
+	t.Run("missing_appointment_is_rejected", func(t *testing.T) {
+		service, mockStore, _, hub, ctrl := setupTestService(t)
+		defer ctrl.Finish()
+		defer hub.Stop()
+
+		mockStore.EXPECT().
+			NotificationResourceExists(gomock.Any(), db.NotificationResourceExistsParams{
+				ResourceType: ResourceTypeAppointment,
+				ResourceID:   "apt-missing",
+			}).
+			Return(false, nil)
+		// No CreateNotification: nothing is stored
+
+		_, err := service.Create(context.Background(), req)
+		assert.ErrorIs(t, err, ErrResourceNotFound)
+	})
+
+	t.Run("missing_appointment_is_dropped_from_queue", func(t *testing.T) {
+		service, mockStore, _, hub, ctrl := setupTestService(t)
+		defer ctrl.Finish()
+		defer hub.Stop()
+
+		checked := make(chan struct{})
+		mockStore.EXPECT().
+			NotificationResourceExists(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(context.Context, db.NotificationResourceExistsParams) (bool, error) {
+				close(checked)
+				return false, nil
+			})
+
+		service.Enqueue(req)
+
+		select {
+		case <-checked:
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for the worker to check the resource")
+		}
+	})
+
+	t.Run("existing_resource_is_stored", func(t *testing.T) {
+		service, mockStore, _, hub, ctrl := setupTestService(t)
+		defer ctrl.Finish()
+		defer hub.Stop()
+
+		mockStore.EXPECT().
+			NotificationResourceExists(gomock.Any(), gomock.Any()).
+			Return(true, nil)
+		echoCreateNotification(mockStore)
+
+		resp, err := service.Create(context.Background(), req)
+		require.NoError(t, err)
+		assert.NotEmpty(t, resp.ID)
+	})
+}
+
 // ============================================================
 // Test: Enqueue (async)
 // ============================================================
@@ -327,6 +430,330 @@ func TestWebSocketDelivery(t *testing.T) {
 	}
 }
 
+// ============================================================
+// Test: Priority Routing
+// ============================================================
+
+func TestPriorityRouting(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := dbmocks.NewMockStoreInterface(ctrl)
+	mockLogger := loggermocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	mockMailer := emailmocks.NewMockSender(ctrl)
+	expectNoQuietHours(mockStore)
+
+	hub := websocket.NewHub(mockLogger)
+	go hub.Run()
+	defer hub.Stop()
+
+	client := &websocket.Client{UserID: "user-123"}
+	client.SetSendChannel(make(chan *websocket.Message, 16))
+	hub.Register(client)
+	time.Sleep(50 * time.Millisecond)
+
+	// No background flush; the test flushes the digest itself
+	service := NewNotificationServiceWithDelivery(mockStore, hub, mockLogger, DeliveryConfig{
+		Routing: DefaultRoutingPolicy(),
+		Email:   mockMailer,
+	}).(*notificationService)
+
+	mockStore.EXPECT().
+		CreateNotification(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, params db.CreateNotificationParams) (db.Notification, error) {
+			return db.Notification{
+				ID:        params.ID,
+				UserID:    params.UserID,
+				Type:      params.Type,
+				Priority:  params.Priority,
+				Title:     params.Title,
+				Message:   params.Message,
+				CreatedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+			}, nil
+		}).
+		AnyTimes()
+
+	receive := func() *websocket.Message {
+		select {
+		case msg := <-client.GetSendChannel():
+			return msg
+		case <-time.After(100 * time.Millisecond):
+			return nil
+		}
+	}
+
+	t.Run("high_priority_uses_websocket_and_email", func(t *testing.T) {
+		mockStore.EXPECT().
+			GetUserByID(gomock.Any(), "user-123").
+			Return(db.User{ID: "user-123", Email: "jan@example.com"}, nil)
+		sent := make(chan struct{})
+		mockMailer.EXPECT().
+			Send(gomock.Any(), "jan@example.com", "Incident reported", "A serious incident was reported").
+			DoAndReturn(func(ctx context.Context, to, subject, body string) error {
+				close(sent)
+				return nil
+			})
+
+		// The request's context ends as soon as Create returns; the email is
+		// sent afterwards on the service's own context
+		ctx, cancel := context.WithCancel(context.Background())
+		_, err := service.Create(ctx, &CreateNotificationRequest{
+			UserID:   "user-123",
+			Type:     TypeIncidentCreated,
+			Priority: PriorityHigh,
+			Title:    "Incident reported",
+			Message:  "A serious incident was reported",
+		})
+		cancel()
+		require.NoError(t, err)
+
+		msg := receive()
+		require.NotNil(t, msg, "expected an immediate WebSocket message")
+		assert.Equal(t, websocket.MessageTypeNotification, msg.Type)
+
+		select {
+		case <-sent:
+		case <-time.After(time.Second):
+			t.Fatal("expected the email to be sent")
+		}
+	})
+
+	t.Run("failed_email_is_dead_lettered", func(t *testing.T) {
+		mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+		mockStore.EXPECT().
+			GetUserByID(gomock.Any(), "user-123").
+			Return(db.User{ID: "user-123", Email: "jan@example.com"}, nil)
+		mockMailer.EXPECT().
+			Send(gomock.Any(), "jan@example.com", gomock.Any(), gomock.Any()).
+			Return(errors.New("gave up after 3 attempt(s): 421 service not available"))
+
+		deadLetters := make(chan db.CreateNotificationDeadLetterParams, 1)
+		mockStore.EXPECT().
+			CreateNotificationDeadLetter(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, params db.CreateNotificationDeadLetterParams) error {
+				assert.NoError(t, ctx.Err(), "the dead letter must not use a finished request context")
+				deadLetters <- params
+				return nil
+			})
+
+		created, err := service.Create(context.Background(), &CreateNotificationRequest{
+			UserID:   "user-123",
+			Type:     TypeIncidentCreated,
+			Priority: PriorityHigh,
+			Title:    "Incident reported",
+			Message:  "A serious incident was reported",
+		})
+		require.NoError(t, err, "a failed email must not fail the notification")
+		require.NotNil(t, receive(), "the WebSocket channel still delivers")
+
+		var deadLetter db.CreateNotificationDeadLetterParams
+		select {
+		case deadLetter = <-deadLetters:
+		case <-time.After(time.Second):
+			t.Fatal("expected the failed email to be dead-lettered")
+		}
+		assert.Equal(t, created.ID, deadLetter.NotificationID)
+		assert.Equal(t, "user-123", deadLetter.UserID)
+		assert.Equal(t, string(ChannelEmail), deadLetter.Channel)
+		assert.Contains(t, deadLetter.Error, "421 service not available")
+	})
+
+	t.Run("normal_priority_uses_websocket_only", func(t *testing.T) {
+		_, err := service.Create(context.Background(), &CreateNotificationRequest{
+			UserID:   "user-123",
+			Type:     TypeClientStatusChange,
+			Priority: PriorityNormal,
+			Title:    "Client moved",
+			Message:  "Client moved to care",
+		})
+		require.NoError(t, err)
+
+		msg := receive()
+		require.NotNil(t, msg)
+		assert.Equal(t, websocket.MessageTypeNotification, msg.Type)
+	})
+
+	t.Run("low_priority_is_deferred_to_digest", func(t *testing.T) {
+		for _, title := range []string{"First", "Second"} {
+			_, err := service.Create(context.Background(), &CreateNotificationRequest{
+				UserID:   "user-123",
+				Type:     TypeSystemAlert,
+				Priority: PriorityLow,
+				Title:    title,
+				Message:  "Low priority",
+			})
+			require.NoError(t, err)
+		}
+		assert.Nil(t, receive(), "low priority must not be pushed immediately")
+
+		service.flushDigest()
+
+		msg := receive()
+		require.NotNil(t, msg, "expected the digest after flushing")
+		assert.Equal(t, websocket.MessageTypeNotificationDigest, msg.Type)
+		payload, ok := msg.Payload.(websocket.NotificationDigestPayload)
+		require.True(t, ok)
+		assert.Equal(t, 2, payload.Count)
+		assert.Equal(t, "First", payload.Notifications[0].Title)
+		assert.Equal(t, "Second", payload.Notifications[1].Title)
+
+		// The digest is emptied once sent
+		service.flushDigest()
+		assert.Nil(t, receive())
+	})
+}
+
+// ============================================================
+// Test: Quiet Hours
+// ============================================================
+
+func TestQuietHoursDelivery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := dbmocks.NewMockStoreInterface(ctrl)
+	mockLogger := loggermocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	hub := websocket.NewHub(mockLogger)
+	go hub.Run()
+	defer hub.Stop()
+
+	client := &websocket.Client{UserID: "user-123"}
+	client.SetSendChannel(make(chan *websocket.Message, 16))
+	hub.Register(client)
+	time.Sleep(50 * time.Millisecond)
+
+	service := NewNotificationServiceWithDelivery(mockStore, hub, mockLogger, DeliveryConfig{
+		Routing: DefaultRoutingPolicy(),
+	}).(*notificationService)
+
+	// 03:00 in Amsterdam, inside the user's 22:00-07:00 window
+	amsterdam, err := time.LoadLocation(DefaultQuietHoursTimezone)
+	require.NoError(t, err)
+	now := time.Date(2026, 3, 10, 3, 0, 0, 0, amsterdam)
+	service.now = func() time.Time { return now }
+
+	mockStore.EXPECT().
+		GetNotificationQuietHours(gomock.Any(), "user-123").
+		Return(db.NotificationQuietHour{
+			UserID:    "user-123",
+			StartTime: pgtype.Time{Microseconds: (22 * time.Hour).Microseconds(), Valid: true},
+			EndTime:   pgtype.Time{Microseconds: (7 * time.Hour).Microseconds(), Valid: true},
+			Timezone:  DefaultQuietHoursTimezone,
+		}, nil).
+		AnyTimes()
+	echoCreateNotification(mockStore)
+
+	receive := func() *websocket.Message {
+		select {
+		case msg := <-client.GetSendChannel():
+			return msg
+		case <-time.After(100 * time.Millisecond):
+			return nil
+		}
+	}
+
+	t.Run("normal_priority_is_deferred", func(t *testing.T) {
+		_, err := service.Create(context.Background(), &CreateNotificationRequest{
+			UserID:   "user-123",
+			Type:     TypeAppointmentReminder,
+			Priority: PriorityNormal,
+			Title:    "Appointment tomorrow",
+			Message:  "Intake at 09:00",
+		})
+		require.NoError(t, err)
+		assert.Nil(t, receive(), "normal priority must not be pushed during quiet hours")
+
+		// Still inside the window: nothing is released
+		service.releaseDeferred()
+		assert.Nil(t, receive())
+
+		now = time.Date(2026, 3, 10, 7, 0, 0, 0, amsterdam)
+		service.releaseDeferred()
+
+		msg := receive()
+		require.NotNil(t, msg, "expected delivery once quiet hours end")
+		payload, ok := msg.Payload.(websocket.NotificationPayload)
+		require.True(t, ok)
+		assert.Equal(t, "Appointment tomorrow", payload.Title)
+		assert.Empty(t, service.deferred)
+	})
+
+	t.Run("high_priority_is_delivered", func(t *testing.T) {
+		now = time.Date(2026, 3, 10, 23, 30, 0, 0, amsterdam)
+
+		_, err := service.Create(context.Background(), &CreateNotificationRequest{
+			UserID:   "user-123",
+			Type:     TypeIncidentCreated,
+			Priority: PriorityHigh,
+			Title:    "Incident reported",
+			Message:  "A serious incident was reported",
+		})
+		require.NoError(t, err)
+
+		msg := receive()
+		require.NotNil(t, msg, "high priority must be delivered during quiet hours")
+		assert.Equal(t, websocket.MessageTypeNotification, msg.Type)
+		assert.Empty(t, service.deferred)
+	})
+}
+
+func TestUpdateQuietHours(t *testing.T) {
+	service, mockStore, _, hub, ctrl := setupTestService(t)
+	defer ctrl.Finish()
+	defer hub.Stop()
+
+	ctx := context.WithValue(context.Background(), util.UserIDKey, "user-123")
+
+	t.Run("defaults_to_amsterdam", func(t *testing.T) {
+		mockStore.EXPECT().
+			UpsertNotificationQuietHours(gomock.Any(), db.UpsertNotificationQuietHoursParams{
+				UserID:    "user-123",
+				StartTime: pgtype.Time{Microseconds: (22 * time.Hour).Microseconds(), Valid: true},
+				EndTime:   pgtype.Time{Microseconds: (7*time.Hour + 30*time.Minute).Microseconds(), Valid: true},
+				Timezone:  DefaultQuietHoursTimezone,
+			}).
+			DoAndReturn(func(_ context.Context, arg db.UpsertNotificationQuietHoursParams) (db.NotificationQuietHour, error) {
+				return db.NotificationQuietHour{
+					UserID:    arg.UserID,
+					StartTime: arg.StartTime,
+					EndTime:   arg.EndTime,
+					Timezone:  arg.Timezone,
+				}, nil
+			})
+
+		result, err := service.UpdateQuietHours(ctx, &UpdateQuietHoursRequest{Start: "22:00", End: "07:30"})
+		require.NoError(t, err)
+		assert.Equal(t, &QuietHoursResponse{
+			Enabled:  true,
+			Start:    "22:00",
+			End:      "07:30",
+			Timezone: DefaultQuietHoursTimezone,
+		}, result)
+	})
+
+	invalid := []struct {
+		name string
+		req  UpdateQuietHoursRequest
+	}{
+		{name: "bad_time", req: UpdateQuietHoursRequest{Start: "25:00", End: "07:00"}},
+		{name: "empty_window", req: UpdateQuietHoursRequest{Start: "22:00", End: "22:00"}},
+		{name: "unknown_timezone", req: UpdateQuietHoursRequest{Start: "22:00", End: "07:00", Timezone: "Mars/Olympus"}},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.UpdateQuietHours(ctx, &tt.req)
+			assert.ErrorIs(t, err, ErrInvalidQuietHours)
+		})
+	}
+
+	t.Run("unset_returns_disabled", func(t *testing.T) {
+		result, err := service.GetQuietHours(ctx)
+		require.NoError(t, err)
+		assert.False(t, result.Enabled)
+		assert.Equal(t, DefaultQuietHoursTimezone, result.Timezone)
+	})
+}
+
 // ============================================================
 // Test: List
 // ============================================================
@@ -400,6 +827,23 @@ func TestMarkAsRead(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestMarkNotificationsReadByResource(t *testing.T) {
+	service, mockStore, _, hub, ctrl := setupTestService(t)
+	defer ctrl.Finish()
+	defer hub.Stop()
+
+	mockStore.EXPECT().
+		MarkNotificationsReadByResource(gomock.Any(), db.MarkNotificationsReadByResourceParams{
+			UserID:       "user-123",
+			ResourceType: ResourceTypeIncident,
+			ResourceID:   "inc-1",
+		}).
+		Return(nil)
+
+	err := service.MarkNotificationsReadByResource(context.Background(), "user-123", ResourceTypeIncident, "inc-1")
+	require.NoError(t, err)
+}
+
 // ============================================================
 // Test: GetUnreadCount
 // ============================================================
@@ -4,13 +4,16 @@ package notification
 const (
 	TypeEvaluationDue            = "evaluation_due"
 	TypeAppointmentReminder      = "appointment_reminder"
+	TypeAppointmentCancelled     = "appointment_cancelled"
 	TypeIncidentCreated          = "incident_created"
 	TypeLocationTransferRequest  = "location_transfer_request"
 	TypeLocationTransferApproved = "location_transfer_approved"
 	TypeLocationTransferRejected = "location_transfer_rejected"
+	TypeCoordinatorHandover      = "coordinator_handover"
 	TypeClientStatusChange       = "client_status_change"
 	TypeRegistrationStatusChange = "registration_status_change"
 	TypeSystemAlert              = "system_alert"
+	TypeIncidentEscalated        = "incident_escalated"
 )
 
 // Notification priority constants matching the database enum
@@ -67,6 +67,17 @@ type RemovePermissionRequest struct {
 	PermissionID string `json:"permissionId" binding:"required"`
 }
 
+type RemovePermissionFromAllRolesRequest struct {
+	// Also delete the permission once no role holds it
+	DeletePermission bool `form:"deletePermission"`
+}
+
+type RemovePermissionFromAllRolesResponse struct {
+	PermissionID      string `json:"permissionId"`
+	RolesAffected     int    `json:"rolesAffected"`
+	PermissionDeleted bool   `json:"permissionDeleted"`
+}
+
 // ============================================================
 // User-Role Assignment DTOs
 // ============================================================
@@ -36,9 +36,10 @@ func (h *RBACHandler) SetupRBACRoutes(router *gin.Engine) {
 	roles.POST("/:id/permissions", h.mdw.RequirePermission("rbac", "write"), h.AssignPermissionToRole)
 	roles.DELETE("/:id/permissions/:permissionId", h.mdw.RequirePermission("rbac", "delete"), h.RemovePermissionFromRole)
 
-	// Permissions (read-only)
+	// Permissions
 	permissions := admin.Group("/permissions")
 	permissions.GET("", h.mdw.PaginationMdw(), h.mdw.RequirePermission("rbac", "read"), h.ListPermissions)
+	permissions.DELETE("/:id/roles", h.mdw.RequirePermission("rbac", "delete"), h.RemovePermissionFromAllRoles)
 
 	// User-Role assignments
 	userRoles := admin.Group("/user-roles")
@@ -268,6 +269,37 @@ func (h *RBACHandler) RemovePermissionFromRole(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, resp.MessageResonse("Permission removed successfully"))
 }
 
+// @Summary Remove permission from all roles
+// @Description Remove a permission from every role that holds it, optionally deleting the permission afterwards
+// @Tags RBAC - Role Permissions
+// @Produce json
+// @Param id path string true "Permission ID"
+// @Param deletePermission query bool false "Also delete the permission"
+// @Success 200 {object} resp.SuccessResponse[RemovePermissionFromAllRolesResponse]
+// @Failure 400 {object} resp.ErrorResponse
+// @Failure 401 {object} resp.ErrorResponse
+// @Failure 404 {object} resp.ErrorResponse
+// @Failure 500 {object} resp.ErrorResponse
+// @Router /admin/permissions/{id}/roles [delete]
+func (h *RBACHandler) RemovePermissionFromAllRoles(ctx *gin.Context) {
+	var req RemovePermissionFromAllRolesRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, resp.Error(ErrInvalidRequest))
+		return
+	}
+	result, err := h.rbacService.RemovePermissionFromAllRoles(ctx, ctx.Param("id"), req.DeletePermission)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrPermissionNotFound):
+			ctx.JSON(http.StatusNotFound, resp.Error(err))
+		default:
+			ctx.JSON(http.StatusInternalServerError, resp.Error(err))
+		}
+		return
+	}
+	ctx.JSON(http.StatusOK, resp.Success(result, "Permission removed from all roles"))
+}
+
 // ============================================================
 // User-Role Assignment Handlers
 // ============================================================
@@ -25,6 +25,11 @@ type RBACService interface {
 	// Role-Permission assignments
 	AssignPermissionToRole(ctx context.Context, roleID string, permissionID string) error
 	RemovePermissionFromRole(ctx context.Context, roleID string, permissionID string) error
+	RemovePermissionFromAllRoles(
+		ctx context.Context,
+		permissionID string,
+		deletePermission bool,
+	) (*RemovePermissionFromAllRolesResponse, error)
 	ListPermissionsForRole(ctx context.Context, roleID string) ([]PermissionResponse, error)
 
 	// User-Role assignments
@@ -1,25 +1,35 @@
 package rbac
 
 import (
+	"care-cordination/lib/audit"
 	"care-cordination/lib/middleware"
 	db "care-cordination/lib/db/sqlc"
 	"care-cordination/lib/logger"
 	"care-cordination/lib/nanoid"
 	"care-cordination/lib/resp"
+	"care-cordination/lib/util"
 	"context"
+	"errors"
 
+	"github.com/jackc/pgx/v5"
 	"go.uber.org/zap"
 )
 
 type rbacService struct {
-	store  *db.Store
-	logger logger.Logger
+	store       db.StoreInterface
+	logger      logger.Logger
+	auditLogger audit.AuditLogger
 }
 
-func NewRBACService(store *db.Store, logger logger.Logger) RBACService {
+func NewRBACService(
+	store db.StoreInterface,
+	logger logger.Logger,
+	auditLogger audit.AuditLogger,
+) RBACService {
 	return &rbacService{
-		store:  store,
-		logger: logger,
+		store:       store,
+		logger:      logger,
+		auditLogger: auditLogger,
 	}
 }
 
@@ -253,6 +263,65 @@ func (s *rbacService) RemovePermissionFromRole(
 	return nil
 }
 
+// RemovePermissionFromAllRoles strips a permission from every role that holds
+// it, optionally deleting the permission itself in the same transaction. Each
+// affected role gets its own audit entry.
+func (s *rbacService) RemovePermissionFromAllRoles(
+	ctx context.Context,
+	permissionID string,
+	deletePermission bool,
+) (*RemovePermissionFromAllRolesResponse, error) {
+	if _, err := s.store.GetPermissionByID(ctx, permissionID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrPermissionNotFound
+		}
+		s.logger.Error(ctx, "RemovePermissionFromAllRoles", "Failed to get permission", zap.Error(err))
+		return nil, ErrInternal
+	}
+
+	result, err := s.store.RemovePermissionFromAllRolesTx(ctx, db.RemovePermissionFromAllRolesTxParams{
+		PermissionID:     permissionID,
+		DeletePermission: deletePermission,
+	})
+	if err != nil {
+		s.logger.Error(
+			ctx,
+			"RemovePermissionFromAllRoles",
+			"Failed to remove permission from roles",
+			zap.Error(err),
+		)
+		return nil, ErrInternal
+	}
+
+	for _, roleID := range result.RoleIDs {
+		if err := s.auditLogger.LogEntry(ctx, audit.AuditEntry{
+			UserID:       util.GetUserID(ctx),
+			EmployeeID:   util.GetEmployeeID(ctx),
+			Action:       audit.ActionDelete,
+			ResourceType: audit.ResourceTypeRBAC,
+			ResourceID:   roleID,
+			OldValue:     map[string]string{"permissionId": permissionID},
+			IPAddress:    util.GetIPAddress(ctx),
+			UserAgent:    util.GetUserAgent(ctx),
+			RequestID:    util.GetRequestID(ctx),
+			Status:       audit.StatusSuccess,
+		}); err != nil {
+			s.logger.Error(
+				ctx,
+				"RemovePermissionFromAllRoles",
+				"Failed to record permission removal in audit log",
+				zap.Error(err),
+			)
+		}
+	}
+
+	return &RemovePermissionFromAllRolesResponse{
+		PermissionID:      permissionID,
+		RolesAffected:     len(result.RoleIDs),
+		PermissionDeleted: deletePermission,
+	}, nil
+}
+
 func (s *rbacService) ListPermissionsForRole(
 	ctx context.Context,
 	roleID string,
@@ -0,0 +1,126 @@
+package rbac_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"care-cordination/features/rbac"
+	"care-cordination/lib/audit"
+	auditmocks "care-cordination/lib/audit/mocks"
+	db "care-cordination/lib/db/sqlc"
+	dbmocks "care-cordination/lib/db/sqlc/mocks"
+	loggermocks "care-cordination/lib/logger/mocks"
+	"care-cordination/lib/util"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRemovePermissionFromAllRoles(t *testing.T) {
+	tests := []struct {
+		name        string
+		setup       func(mockStore *dbmocks.MockStoreInterface, mockAudit *auditmocks.MockAuditLogger, mockLogger *loggermocks.MockLogger)
+		wantErr     error
+		wantRoles   int
+		wantDeleted bool
+	}{
+		{
+			name: "each_role_is_audited",
+			setup: func(mockStore *dbmocks.MockStoreInterface, mockAudit *auditmocks.MockAuditLogger, _ *loggermocks.MockLogger) {
+				mockStore.EXPECT().
+					GetPermissionByID(gomock.Any(), "perm-1").
+					Return(db.Permission{ID: "perm-1"}, nil)
+				mockStore.EXPECT().
+					RemovePermissionFromAllRolesTx(gomock.Any(), db.RemovePermissionFromAllRolesTxParams{
+						PermissionID:     "perm-1",
+						DeletePermission: true,
+					}).
+					Return(db.RemovePermissionFromAllRolesTxResult{RoleIDs: []string{"role-1", "role-2"}}, nil)
+				for _, roleID := range []string{"role-1", "role-2"} {
+					mockAudit.EXPECT().
+						LogEntry(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(_ context.Context, entry audit.AuditEntry) error {
+							assert.Equal(t, "admin-1", entry.UserID)
+							assert.Equal(t, audit.ActionDelete, entry.Action)
+							assert.Equal(t, audit.ResourceTypeRBAC, entry.ResourceType)
+							assert.Equal(t, roleID, entry.ResourceID)
+							assert.Equal(t, map[string]string{"permissionId": "perm-1"}, entry.OldValue)
+							assert.Equal(t, audit.StatusSuccess, entry.Status)
+							return nil
+						})
+				}
+			},
+			wantRoles:   2,
+			wantDeleted: true,
+		},
+		{
+			name: "audit_failure_does_not_fail_the_removal",
+			setup: func(mockStore *dbmocks.MockStoreInterface, mockAudit *auditmocks.MockAuditLogger, mockLogger *loggermocks.MockLogger) {
+				mockStore.EXPECT().
+					GetPermissionByID(gomock.Any(), "perm-1").
+					Return(db.Permission{ID: "perm-1"}, nil)
+				mockStore.EXPECT().
+					RemovePermissionFromAllRolesTx(gomock.Any(), gomock.Any()).
+					Return(db.RemovePermissionFromAllRolesTxResult{RoleIDs: []string{"role-1"}}, nil)
+				mockAudit.EXPECT().
+					LogEntry(gomock.Any(), gomock.Any()).
+					Return(errors.New("audit log unavailable"))
+				mockLogger.EXPECT().Error(gomock.Any(), "RemovePermissionFromAllRoles", gomock.Any(), gomock.Any())
+			},
+			wantRoles:   1,
+			wantDeleted: true,
+		},
+		{
+			name: "unknown_permission_is_not_audited",
+			setup: func(mockStore *dbmocks.MockStoreInterface, _ *auditmocks.MockAuditLogger, _ *loggermocks.MockLogger) {
+				mockStore.EXPECT().
+					GetPermissionByID(gomock.Any(), "perm-1").
+					Return(db.Permission{}, pgx.ErrNoRows)
+			},
+			wantErr: rbac.ErrPermissionNotFound,
+		},
+		{
+			name: "failed_removal_is_not_audited",
+			setup: func(mockStore *dbmocks.MockStoreInterface, _ *auditmocks.MockAuditLogger, mockLogger *loggermocks.MockLogger) {
+				mockStore.EXPECT().
+					GetPermissionByID(gomock.Any(), "perm-1").
+					Return(db.Permission{ID: "perm-1"}, nil)
+				mockStore.EXPECT().
+					RemovePermissionFromAllRolesTx(gomock.Any(), gomock.Any()).
+					Return(db.RemovePermissionFromAllRolesTxResult{}, errors.New("connection refused"))
+				mockLogger.EXPECT().Error(gomock.Any(), "RemovePermissionFromAllRoles", gomock.Any(), gomock.Any())
+			},
+			wantErr: rbac.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := dbmocks.NewMockStoreInterface(ctrl)
+			mockAudit := auditmocks.NewMockAuditLogger(ctrl)
+			mockLogger := loggermocks.NewMockLogger(ctrl)
+			tt.setup(mockStore, mockAudit, mockLogger)
+
+			service := rbac.NewRBACService(mockStore, mockLogger, mockAudit)
+
+			ctx := context.WithValue(context.Background(), util.UserIDKey, "admin-1")
+			result, err := service.RemovePermissionFromAllRoles(ctx, "perm-1", true)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "perm-1", result.PermissionID)
+			assert.Equal(t, tt.wantRoles, result.RolesAffected)
+			assert.Equal(t, tt.wantDeleted, result.PermissionDeleted)
+		})
+	}
+}
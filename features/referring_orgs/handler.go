@@ -28,7 +28,7 @@ func (h *ReferringOrgHandler) SetupReferringOrgRoutes(router *gin.Engine) {
 
 	orgs.POST("", h.mdw.AuthMdw(), h.CreateReferringOrg)
 	orgs.GET("/stats", h.mdw.AuthMdw(), h.GetReferringOrgStats)
-	orgs.GET("", h.mdw.AuthMdw(), h.ListReferringOrgs)
+	orgs.GET("", h.mdw.AuthMdw(), h.mdw.SearchMdw(), h.ListReferringOrgs)
 	orgs.PUT("/:id", h.mdw.AuthMdw(), h.UpdateReferringOrg)
 }
 
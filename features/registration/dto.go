@@ -10,7 +10,7 @@ type CreateRegistrationFormRequest struct {
 	BSN                string   `json:"bsn"                binding:"required"`
 	DateOfBirth        string   `json:"dateOfBirth"        binding:"required"                                                                                            format:"2006-01-02"`
 	PhoneNumber        *string  `json:"phoneNumber"`
-	Gender             string   `json:"gender"             binding:"required,oneof=male female other"`
+	Gender             string   `json:"gender"             binding:"required,oneof=male female other non_binary unknown prefer_not_to_say"`
 	RefferingOrgID     *string  `json:"refferingOrgId"     binding:"required"`
 	CareType           string   `json:"careType"           binding:"required,oneof=protected_living semi_independent_living independent_assisted_living ambulatory_care"`
 	RegistrationDate   string   `json:"registrationDate"   binding:"required"                                                                                            format:"2006-01-02"`
@@ -21,6 +21,18 @@ type CreateRegistrationFormRequest struct {
 
 type CreateRegistrationFormResponse struct {
 	ID string `json:"id"`
+	// ExistingFormIDs lists the active registration with the same BSN when
+	// the create is refused
+	ExistingFormIDs []string `json:"existingFormIds,omitempty"`
+}
+
+// DuplicateBSNResponse is returned instead of creating or restoring a form
+// whose BSN is held by another active form. The existing form has to be
+// deleted before the BSN can be registered again.
+type DuplicateBSNResponse struct {
+	Error           string   `json:"error"           example:"an active registration form with this BSN already exists"`
+	Success         bool     `json:"success"         example:"false"`
+	ExistingFormIDs []string `json:"existingFormIds"`
 }
 
 type ListRegistrationFormsRequest struct {
@@ -55,7 +67,7 @@ type UpdateRegistrationFormRequest struct {
 	BSN                *string  `json:"bsn"`
 	DateOfBirth        *string  `json:"dateOfBirth"        format:"2006-01-02"`
 	PhoneNumber        *string  `json:"phoneNumber"`
-	Gender             *string  `json:"gender"                                 binding:"omitempty,oneof=male female other"`
+	Gender             *string  `json:"gender"                                 binding:"omitempty,oneof=male female other non_binary unknown prefer_not_to_say"`
 	RefferingOrgID     *string  `json:"refferingOrgId"`
 	CareType           *string  `json:"careType"                               binding:"omitempty,oneof=protected_living semi_independent_living independent_assisted_living ambulatory_care"`
 	RegistrationDate   *string  `json:"registrationDate"   format:"2006-01-02"`
@@ -70,33 +82,88 @@ type UpdateRegistrationFormResponse struct {
 }
 
 type GetRegistrationFormResponse struct {
-	ID                 string    `json:"id"`
-	FirstName          string    `json:"firstName"`
-	LastName           string    `json:"lastName"`
-	Bsn                string    `json:"bsn"`
-	Gender             string    `json:"gender"`
-	DateOfBirth        time.Time `json:"dateOfBirth"`
-	RefferingOrgID     *string   `json:"refferingOrgId"`
-	OrgName            *string   `json:"orgName"`
-	OrgContactPerson   *string   `json:"orgContactPerson"`
-	OrgPhoneNumber     *string   `json:"orgPhoneNumber"`
-	OrgEmail           *string   `json:"orgEmail"`
-	CareType           string    `json:"careType"`
-	RegistrationDate   time.Time `json:"registrationDate"`
-	RegistrationReason string    `json:"registrationReason"`
-	AdditionalNotes    *string   `json:"additionalNotes"`
-	AttachmentIDs      []string  `json:"attachmentIds"`
-	Status             *string   `json:"status"`
-	IntakeCompleted    bool      `json:"intakeCompleted"`
-	HasClient          bool      `json:"hasClient"`
+	ID                 string                           `json:"id"`
+	FirstName          string                           `json:"firstName"`
+	LastName           string                           `json:"lastName"`
+	Bsn                string                           `json:"bsn"`
+	Gender             string                           `json:"gender"`
+	DateOfBirth        time.Time                        `json:"dateOfBirth"`
+	RefferingOrgID     *string                          `json:"refferingOrgId"`
+	OrgName            *string                          `json:"orgName"`
+	OrgContactPerson   *string                          `json:"orgContactPerson"`
+	OrgPhoneNumber     *string                          `json:"orgPhoneNumber"`
+	OrgEmail           *string                          `json:"orgEmail"`
+	CareType           string                           `json:"careType"`
+	RegistrationDate   time.Time                        `json:"registrationDate"`
+	RegistrationReason string                           `json:"registrationReason"`
+	AdditionalNotes    *string                          `json:"additionalNotes"`
+	AttachmentIDs      []string                         `json:"attachmentIds"`
+	Attachments        []RegistrationAttachmentResponse `json:"attachments"`
+	Status             *string                          `json:"status"`
+	IntakeCompleted    bool                             `json:"intakeCompleted"`
+	HasClient          bool                             `json:"hasClient"`
+	CreatedByUserID    *string                          `json:"createdByUserId"`
+}
+
+// RegistrationAttachmentResponse is a linked attachment, listed in display order
+type RegistrationAttachmentResponse struct {
+	ID          string    `json:"id"`
+	Filename    *string   `json:"filename"`
+	Caption     *string   `json:"caption"`
+	SortOrder   int       `json:"sortOrder"`
+	ContentType string    `json:"contentType"`
+	UploadedAt  time.Time `json:"uploadedAt"`
+}
+
+type ReorderRegistrationAttachmentsRequest struct {
+	AttachmentIDs []string `json:"attachmentIds" binding:"required,min=1,dive,required"`
+}
+
+type UpdateRegistrationAttachmentCaptionRequest struct {
+	Caption *string `json:"caption" binding:"omitempty,max=500"`
 }
 
 type DeleteRegistrationFormResponse struct {
 	ID string `json:"id"`
 }
 
+type ListDeletedRegistrationFormsResponse struct {
+	ID               string    `json:"id"`
+	FirstName        string    `json:"firstName"`
+	LastName         string    `json:"lastName"`
+	Bsn              string    `json:"bsn"`
+	DateOfBirth      time.Time `json:"dateOfBirth"`
+	CareType         string    `json:"careType"`
+	RegistrationDate time.Time `json:"registrationDate"`
+	Status           *string   `json:"status"`
+	DeletedAt        time.Time `json:"deletedAt"`
+}
+
+type RestoreRegistrationFormResponse struct {
+	ID string `json:"id"`
+	// ExistingFormIDs lists the active forms that block the restore
+	ExistingFormIDs []string `json:"existingFormIds,omitempty"`
+}
+
 type GetRegistrationStatsResponse struct {
 	TotalCount    int `json:"totalCount"`
 	ApprovedCount int `json:"approvedCount"`
 	InReviewCount int `json:"inReviewCount"`
 }
+
+type RegistrationStatusChangeResponse struct {
+	ID        string    `json:"id"`
+	OldStatus *string   `json:"oldStatus"`
+	NewStatus *string   `json:"newStatus"`
+	ChangedBy *string   `json:"changedBy"`
+	ChangedAt time.Time `json:"changedAt"`
+}
+
+type BatchUpdateRegistrationStatusRequest struct {
+	IDs    []string `json:"ids"    binding:"required,min=1,dive,required"`
+	Status string   `json:"status" binding:"required,oneof=pending approved rejected in_review"`
+}
+
+type BatchUpdateRegistrationStatusResponse struct {
+	UpdatedCount int `json:"updatedCount"`
+}
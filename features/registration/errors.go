@@ -4,3 +4,11 @@ import "errors"
 
 var ErrInternal = errors.New("internal server error")
 var ErrInvalidRequest = errors.New("invalid request")
+var ErrInvalidAttachments = errors.New("invalid attachment ids")
+var ErrTooManyAttachments = errors.New("too many attachments")
+var ErrInvalidAttachmentOrder = errors.New("attachment order must list every linked attachment exactly once")
+var ErrRegistrationFormNotFound = errors.New("registration form not found")
+var ErrAttachmentNotFound = errors.New("attachment not linked to this registration form")
+var ErrDuplicateBSN = errors.New("an active registration form with this BSN already exists")
+var ErrDeletedFormNotFound = errors.New("deleted registration form not found")
+var ErrRestoreBSNConflict = errors.New("another active registration form has this BSN")
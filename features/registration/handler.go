@@ -3,6 +3,7 @@ package registration
 import (
 	"care-cordination/lib/middleware"
 	"care-cordination/lib/resp"
+	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -29,11 +30,18 @@ func (h *RegistrationHandler) SetupRegistrationRoutes(router *gin.Engine) {
 	registration.Use(h.mdw.PaginationMdw())
 
 	registration.POST("", h.CreateRegistrationForm)
-	registration.GET("", h.mdw.PaginationMdw(), h.ListRegistrationForms)
+	registration.GET("", h.mdw.PaginationMdw(), h.mdw.SearchMdw(), h.ListRegistrationForms)
 	registration.GET("/stats", h.GetRegistrationStats)
+	registration.PATCH("/status", h.BatchUpdateRegistrationFormStatus)
+	// Only admins review and restore soft-deleted forms
+	registration.GET("/deleted", h.mdw.RequirePermission("admin", "manage"), h.mdw.PaginationMdw(), h.ListDeletedRegistrationForms)
+	registration.POST("/:id/restore", h.mdw.RequirePermission("admin", "manage"), h.RestoreRegistrationForm)
 	registration.GET("/:id", h.GetRegistrationForm)
+	registration.GET("/:id/status-history", h.GetRegistrationStatusHistory)
 	registration.PUT("/:id", h.UpdateRegistrationForm)
 	registration.DELETE("/:id", h.DeleteRegistrationForm)
+	registration.PUT("/:id/attachments/order", h.ReorderRegistrationAttachments)
+	registration.PATCH("/:id/attachments/:attachmentId", h.UpdateRegistrationAttachmentCaption)
 }
 
 // @Summary Create a registration form
@@ -45,6 +53,7 @@ func (h *RegistrationHandler) SetupRegistrationRoutes(router *gin.Engine) {
 // @Success 200 {object} resp.SuccessResponse[CreateRegistrationFormResponse]
 // @Failure 400 {object} resp.ErrorResponse
 // @Failure 401 {object} resp.ErrorResponse
+// @Failure 409 {object} DuplicateBSNResponse
 // @Failure 500 {object} resp.ErrorResponse
 // @Router /registrations [post]
 func (h *RegistrationHandler) CreateRegistrationForm(ctx *gin.Context) {
@@ -56,7 +65,17 @@ func (h *RegistrationHandler) CreateRegistrationForm(ctx *gin.Context) {
 
 	result, err := h.rgstService.CreateRegistrationForm(ctx, &req)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		switch {
+		case errors.Is(err, ErrInvalidAttachments), errors.Is(err, ErrTooManyAttachments):
+			ctx.JSON(http.StatusBadRequest, resp.Error(err))
+		case errors.Is(err, ErrDuplicateBSN):
+			ctx.JSON(http.StatusConflict, DuplicateBSNResponse{
+				Error:           err.Error(),
+				ExistingFormIDs: result.ExistingFormIDs,
+			})
+		default:
+			ctx.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		}
 		return
 	}
 
@@ -127,6 +146,7 @@ func (h *RegistrationHandler) GetRegistrationForm(ctx *gin.Context) {
 // @Success 200 {object} resp.SuccessResponse[UpdateRegistrationFormResponse]
 // @Failure 400 {object} resp.ErrorResponse
 // @Failure 401 {object} resp.ErrorResponse
+// @Failure 409 {object} resp.ErrorResponse
 // @Failure 500 {object} resp.ErrorResponse
 // @Router /registrations/{id} [put]
 func (h *RegistrationHandler) UpdateRegistrationForm(ctx *gin.Context) {
@@ -144,7 +164,14 @@ func (h *RegistrationHandler) UpdateRegistrationForm(ctx *gin.Context) {
 
 	result, err := h.rgstService.UpdateRegistrationForm(ctx, id, &req)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		switch {
+		case errors.Is(err, ErrInvalidAttachments), errors.Is(err, ErrTooManyAttachments):
+			ctx.JSON(http.StatusBadRequest, resp.Error(err))
+		case errors.Is(err, ErrDuplicateBSN):
+			ctx.JSON(http.StatusConflict, resp.Error(err))
+		default:
+			ctx.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		}
 		return
 	}
 
@@ -177,6 +204,69 @@ func (h *RegistrationHandler) DeleteRegistrationForm(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, resp.Success(result, "Registration form deleted successfully"))
 }
 
+// @Summary List deleted registration forms
+// @Description List soft-deleted registration forms, most recently deleted first (admin only)
+// @Tags Registration
+// @Produce json
+// @Param page query int false "Page number"
+// @Param page_size query int false "Page size"
+// @Success 200 {object} resp.SuccessResponse[resp.PaginationResponse[ListDeletedRegistrationFormsResponse]]
+// @Failure 401 {object} resp.ErrorResponse
+// @Failure 403 {object} resp.ErrorResponse
+// @Failure 500 {object} resp.ErrorResponse
+// @Router /registrations/deleted [get]
+func (h *RegistrationHandler) ListDeletedRegistrationForms(ctx *gin.Context) {
+	result, err := h.rgstService.ListDeletedRegistrationForms(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		return
+	}
+	ctx.JSON(http.StatusOK, resp.Success(result, "Deleted registration forms fetched successfully"))
+}
+
+// @Summary Restore a deleted registration form
+// @Description Undo a soft delete. Rejected while another active form has the same BSN (admin only).
+// @Tags Registration
+// @Produce json
+// @Param id path string true "Registration Form ID"
+// @Success 200 {object} resp.SuccessResponse[RestoreRegistrationFormResponse]
+// @Failure 400 {object} resp.ErrorResponse
+// @Failure 401 {object} resp.ErrorResponse
+// @Failure 403 {object} resp.ErrorResponse
+// @Failure 404 {object} resp.ErrorResponse
+// @Failure 409 {object} DuplicateBSNResponse
+// @Failure 500 {object} resp.ErrorResponse
+// @Router /registrations/{id}/restore [post]
+func (h *RegistrationHandler) RestoreRegistrationForm(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, resp.Error(ErrInvalidRequest))
+		return
+	}
+
+	result, err := h.rgstService.RestoreRegistrationForm(ctx, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrDeletedFormNotFound):
+			ctx.JSON(http.StatusNotFound, resp.Error(err))
+		case errors.Is(err, ErrRestoreBSNConflict):
+			var existingIDs []string
+			if result != nil {
+				existingIDs = result.ExistingFormIDs
+			}
+			ctx.JSON(http.StatusConflict, DuplicateBSNResponse{
+				Error:           err.Error(),
+				ExistingFormIDs: existingIDs,
+			})
+		default:
+			ctx.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp.Success(result, "Registration form restored successfully"))
+}
+
 // @Summary Get registration statistics
 // @Description Get counts of total, approved, and in-review registration forms
 // @Tags Registration
@@ -194,3 +284,140 @@ func (h *RegistrationHandler) GetRegistrationStats(ctx *gin.Context) {
 
 	ctx.JSON(http.StatusOK, resp.Success(result, "Registration statistics retrieved successfully"))
 }
+
+// @Summary Get registration status history
+// @Description List every status change of a registration form, oldest first
+// @Tags Registration
+// @Produce json
+// @Param id path string true "Registration Form ID"
+// @Success 200 {object} resp.SuccessResponse[[]RegistrationStatusChangeResponse]
+// @Failure 400 {object} resp.ErrorResponse
+// @Failure 401 {object} resp.ErrorResponse
+// @Failure 500 {object} resp.ErrorResponse
+// @Router /registrations/{id}/status-history [get]
+func (h *RegistrationHandler) GetRegistrationStatusHistory(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, resp.Error(ErrInvalidRequest))
+		return
+	}
+
+	result, err := h.rgstService.GetRegistrationStatusHistory(ctx, id)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp.Success(result, "Registration status history retrieved successfully"))
+}
+
+// @Summary Update the status of multiple registration forms
+// @Description Set the same status on several registration forms at once. Soft-deleted forms are skipped.
+// @Tags Registration
+// @Accept json
+// @Produce json
+// @Param request body BatchUpdateRegistrationStatusRequest true "Registration form IDs and new status"
+// @Success 200 {object} resp.SuccessResponse[BatchUpdateRegistrationStatusResponse]
+// @Failure 400 {object} resp.ErrorResponse
+// @Failure 401 {object} resp.ErrorResponse
+// @Failure 500 {object} resp.ErrorResponse
+// @Router /registrations/status [patch]
+func (h *RegistrationHandler) BatchUpdateRegistrationFormStatus(ctx *gin.Context) {
+	var req BatchUpdateRegistrationStatusRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, resp.Error(err))
+		return
+	}
+
+	result, err := h.rgstService.BatchUpdateRegistrationFormStatus(ctx, &req)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp.Success(result, "Registration form statuses updated successfully"))
+}
+
+// @Summary Reorder registration form attachments
+// @Description Set the display order of the attachments linked to a registration form. The list must contain every linked attachment exactly once.
+// @Tags Registration
+// @Accept json
+// @Produce json
+// @Param id path string true "Registration Form ID"
+// @Param request body ReorderRegistrationAttachmentsRequest true "Attachment IDs in display order"
+// @Success 200 {object} resp.MessageResponse
+// @Failure 400 {object} resp.ErrorResponse
+// @Failure 401 {object} resp.ErrorResponse
+// @Failure 404 {object} resp.ErrorResponse
+// @Failure 500 {object} resp.ErrorResponse
+// @Router /registrations/{id}/attachments/order [put]
+func (h *RegistrationHandler) ReorderRegistrationAttachments(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(http.StatusBadRequest, resp.Error(ErrInvalidRequest))
+		return
+	}
+
+	var req ReorderRegistrationAttachmentsRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, resp.Error(err))
+		return
+	}
+
+	err := h.rgstService.ReorderRegistrationAttachments(ctx, id, &req)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrInvalidAttachmentOrder):
+			ctx.JSON(http.StatusBadRequest, resp.Error(err))
+		case errors.Is(err, ErrRegistrationFormNotFound):
+			ctx.JSON(http.StatusNotFound, resp.Error(err))
+		default:
+			ctx.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp.MessageResonse("Attachments reordered successfully"))
+}
+
+// @Summary Update a registration form attachment caption
+// @Description Set or clear the caption of an attachment linked to a registration form
+// @Tags Registration
+// @Accept json
+// @Produce json
+// @Param id path string true "Registration Form ID"
+// @Param attachmentId path string true "Attachment ID"
+// @Param request body UpdateRegistrationAttachmentCaptionRequest true "Caption"
+// @Success 200 {object} resp.MessageResponse
+// @Failure 400 {object} resp.ErrorResponse
+// @Failure 401 {object} resp.ErrorResponse
+// @Failure 404 {object} resp.ErrorResponse
+// @Failure 500 {object} resp.ErrorResponse
+// @Router /registrations/{id}/attachments/{attachmentId} [patch]
+func (h *RegistrationHandler) UpdateRegistrationAttachmentCaption(ctx *gin.Context) {
+	id := ctx.Param("id")
+	attachmentID := ctx.Param("attachmentId")
+	if id == "" || attachmentID == "" {
+		ctx.JSON(http.StatusBadRequest, resp.Error(ErrInvalidRequest))
+		return
+	}
+
+	var req UpdateRegistrationAttachmentCaptionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, resp.Error(err))
+		return
+	}
+
+	err := h.rgstService.UpdateRegistrationAttachmentCaption(ctx, id, attachmentID, &req)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrAttachmentNotFound):
+			ctx.JSON(http.StatusNotFound, resp.Error(err))
+		default:
+			ctx.JSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp.MessageResonse("Attachment caption updated successfully"))
+}
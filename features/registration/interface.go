@@ -21,5 +21,25 @@ type RegistrationService interface {
 	) (*UpdateRegistrationFormResponse, error)
 	GetRegistrationForm(ctx context.Context, id string) (*GetRegistrationFormResponse, error)
 	DeleteRegistrationForm(ctx context.Context, id string) (*DeleteRegistrationFormResponse, error)
+	ListDeletedRegistrationForms(
+		ctx context.Context,
+	) (*resp.PaginationResponse[ListDeletedRegistrationFormsResponse], error)
+	RestoreRegistrationForm(ctx context.Context, id string) (*RestoreRegistrationFormResponse, error)
 	GetRegistrationStats(ctx context.Context) (*GetRegistrationStatsResponse, error)
+	GetRegistrationStatusHistory(ctx context.Context, id string) ([]RegistrationStatusChangeResponse, error)
+	BatchUpdateRegistrationFormStatus(
+		ctx context.Context,
+		req *BatchUpdateRegistrationStatusRequest,
+	) (*BatchUpdateRegistrationStatusResponse, error)
+	ReorderRegistrationAttachments(
+		ctx context.Context,
+		id string,
+		req *ReorderRegistrationAttachmentsRequest,
+	) error
+	UpdateRegistrationAttachmentCaption(
+		ctx context.Context,
+		id string,
+		attachmentID string,
+		req *UpdateRegistrationAttachmentCaptionRequest,
+	) error
 }
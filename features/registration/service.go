@@ -1,26 +1,42 @@
 package registration
 
 import (
-	"care-cordination/lib/middleware"
+	"care-cordination/features/notification"
 	db "care-cordination/lib/db/sqlc"
 	"care-cordination/lib/logger"
+	"care-cordination/lib/middleware"
 	"care-cordination/lib/nanoid"
 	"care-cordination/lib/resp"
 	"care-cordination/lib/util"
 	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
 
+	"github.com/jackc/pgx/v5"
 	"go.uber.org/zap"
 )
 
 type registrationService struct {
-	db     *db.Store
-	logger logger.Logger
+	db                  db.StoreInterface
+	logger              logger.Logger
+	notificationService notification.NotificationService
+	// maxAttachments caps how many attachments one form links
+	maxAttachments int
 }
 
-func NewRegistrationService(db *db.Store, logger logger.Logger) RegistrationService {
+func NewRegistrationService(
+	db db.StoreInterface,
+	logger logger.Logger,
+	notificationService notification.NotificationService,
+	maxAttachments int,
+) RegistrationService {
 	return &registrationService{
-		db:     db,
-		logger: logger,
+		db:                  db,
+		logger:              logger,
+		notificationService: notificationService,
+		maxAttachments:      maxAttachments,
 	}
 }
 
@@ -28,23 +44,53 @@ func (s *registrationService) CreateRegistrationForm(
 	ctx context.Context,
 	req *CreateRegistrationFormRequest,
 ) (*CreateRegistrationFormResponse, error) {
+	if err := s.checkAttachmentCount(req.AttachmentIDs); err != nil {
+		return nil, err
+	}
+	if err := s.validateAttachments(ctx, "CreateRegistrationForm", req.AttachmentIDs); err != nil {
+		return nil, err
+	}
+
+	// A person has one active registration at a time; the conflict names it so
+	// the caller can update it, or delete it before re-registering
+	existingIDs, err := s.db.GetRegistrationFormsByBSN(ctx, req.BSN)
+	if err != nil {
+		s.logger.Error(
+			ctx,
+			"CreateRegistrationForm",
+			"Failed to look up registration forms by BSN",
+			zap.Error(err),
+		)
+		return nil, ErrInternal
+	}
+	if len(existingIDs) > 0 {
+		return &CreateRegistrationFormResponse{ExistingFormIDs: existingIDs}, ErrDuplicateBSN
+	}
+
 	id := nanoid.Generate()
-	err := s.db.CreateRegistrationForm(ctx, db.CreateRegistrationFormParams{
-		ID:                 id,
-		FirstName:          req.FirstName,
-		LastName:           req.LastName,
-		Bsn:                req.BSN,
-		DateOfBirth:        util.StrToPgtypeDate(req.DateOfBirth),
-		PhoneNumber:        req.PhoneNumber,
-		RefferingOrgID:     req.RefferingOrgID,
-		Gender:             db.GenderEnum(req.Gender),
-		CareType:           db.CareTypeEnum(req.CareType),
-		RegistrationDate:   util.StrToPgtypeDate(req.RegistrationDate),
-		RegistrationReason: req.RegistrationReason,
-		AdditionalNotes:    req.AdditionalNotes,
-		AttachmentIds:      req.AttachmentIDs,
+	err = s.db.CreateRegistrationFormTx(ctx, db.CreateRegistrationFormTxParams{
+		RegistrationForm: db.CreateRegistrationFormParams{
+			ID:                 id,
+			FirstName:          req.FirstName,
+			LastName:           req.LastName,
+			Bsn:                req.BSN,
+			DateOfBirth:        util.StrToPgtypeDate(req.DateOfBirth),
+			PhoneNumber:        req.PhoneNumber,
+			RefferingOrgID:     req.RefferingOrgID,
+			Gender:             db.GenderEnum(req.Gender),
+			CareType:           db.CareTypeEnum(req.CareType),
+			RegistrationDate:   util.StrToPgtypeDate(req.RegistrationDate),
+			RegistrationReason: req.RegistrationReason,
+			AdditionalNotes:    req.AdditionalNotes,
+			CreatedByUserID:    util.GetUserIDPtr(ctx),
+		},
+		AttachmentIDs: uniqueIDs(req.AttachmentIDs),
 	})
 	if err != nil {
+		// Lost a race with a concurrent registration for the same BSN
+		if db.IsUniqueViolationOf(err, "uq_registration_forms_active_bsn") {
+			return &CreateRegistrationFormResponse{}, ErrDuplicateBSN
+		}
 		s.logger.Error(
 			ctx,
 			"CreateRegistrationForm",
@@ -109,7 +155,7 @@ func (s *registrationService) ListRegistrationForms(
 				RegistrationDate:    registrationForm.RegistrationDate.Time,
 				RegistrationReason:  registrationForm.RegistrationReason,
 				AdditionalNotes:     registrationForm.AdditionalNotes,
-				NumberOfAttachments: len(registrationForm.AttachmentIds),
+				NumberOfAttachments: int(registrationForm.AttachmentCount),
 				Status:              &status,
 				IntakeCompleted:     registrationForm.IntakeCompleted,
 			},
@@ -129,6 +175,11 @@ func (s *registrationService) UpdateRegistrationForm(
 	id string,
 	req *UpdateRegistrationFormRequest,
 ) (*UpdateRegistrationFormResponse, error) {
+	// The attachment list replaces the linked attachments, so it is the new total
+	if err := s.checkAttachmentCount(req.AttachmentIDs); err != nil {
+		return nil, err
+	}
+
 	// Check if a client exists for this registration form
 	regFormDetails, err := s.db.GetRegistrationFormWithDetails(ctx, id)
 	if err != nil {
@@ -141,6 +192,34 @@ func (s *registrationService) UpdateRegistrationForm(
 		return nil, ErrInternal
 	}
 
+	// Attachments already linked to the form were validated when they were added
+	var attachmentIDs []string
+	if req.AttachmentIDs != nil {
+		linked, err := s.db.ListRegistrationFormAttachments(ctx, id)
+		if err != nil {
+			s.logger.Error(
+				ctx,
+				"UpdateRegistrationForm",
+				"Failed to list registration form attachments",
+				zap.Error(err),
+			)
+			return nil, ErrInternal
+		}
+
+		attachmentIDs = uniqueIDs(req.AttachmentIDs)
+		newAttachmentIDs := []string{}
+		for _, attachmentID := range attachmentIDs {
+			if !slices.ContainsFunc(linked, func(a db.ListRegistrationFormAttachmentsRow) bool {
+				return a.AttachmentID == attachmentID
+			}) {
+				newAttachmentIDs = append(newAttachmentIDs, attachmentID)
+			}
+		}
+		if err := s.validateAttachments(ctx, "UpdateRegistrationForm", newAttachmentIDs); err != nil {
+			return nil, err
+		}
+	}
+
 	// Build the update params - only set fields that are provided
 	params := db.UpdateRegistrationFormParams{
 		ID:                 id,
@@ -151,7 +230,6 @@ func (s *registrationService) UpdateRegistrationForm(
 		RefferingOrgID:     req.RefferingOrgID,
 		RegistrationReason: req.RegistrationReason,
 		AdditionalNotes:    req.AdditionalNotes,
-		AttachmentIds:      req.AttachmentIDs,
 	}
 
 	// Handle date fields
@@ -186,8 +264,13 @@ func (s *registrationService) UpdateRegistrationForm(
 	err = s.db.UpdateRegistrationFormTx(ctx, db.UpdateRegistrationFormTxParams{
 		RegistrationForm: params,
 		UpdateClient:     regFormDetails.HasClient,
+		AttachmentIDs:    attachmentIDs,
+		ChangedBy:        util.GetUserID(ctx),
 	})
 	if err != nil {
+		if db.IsUniqueViolationOf(err, "uq_registration_forms_active_bsn") {
+			return nil, ErrDuplicateBSN
+		}
 		s.logger.Error(
 			ctx,
 			"UpdateRegistrationForm",
@@ -217,6 +300,31 @@ func (s *registrationService) GetRegistrationForm(
 		return nil, ErrInternal
 	}
 
+	attachments, err := s.db.ListRegistrationFormAttachments(ctx, id)
+	if err != nil {
+		s.logger.Error(
+			ctx,
+			"GetRegistrationForm",
+			"Failed to list registration form attachments",
+			zap.Error(err),
+		)
+		return nil, ErrInternal
+	}
+
+	attachmentIDs := make([]string, 0, len(attachments))
+	attachmentResponses := make([]RegistrationAttachmentResponse, 0, len(attachments))
+	for _, attachment := range attachments {
+		attachmentIDs = append(attachmentIDs, attachment.AttachmentID)
+		attachmentResponses = append(attachmentResponses, RegistrationAttachmentResponse{
+			ID:          attachment.AttachmentID,
+			Filename:    attachment.Filename,
+			Caption:     attachment.Caption,
+			SortOrder:   int(attachment.SortOrder),
+			ContentType: attachment.ContentType,
+			UploadedAt:  attachment.UploadedAt.Time,
+		})
+	}
+
 	status := ""
 	if regForm.Status.Valid {
 		status = string(regForm.Status.RegistrationStatusEnum)
@@ -238,10 +346,12 @@ func (s *registrationService) GetRegistrationForm(
 		RegistrationDate:   regForm.RegistrationDate.Time,
 		RegistrationReason: regForm.RegistrationReason,
 		AdditionalNotes:    regForm.AdditionalNotes,
-		AttachmentIDs:      regForm.AttachmentIds,
+		AttachmentIDs:      attachmentIDs,
+		Attachments:        attachmentResponses,
 		Status:             &status,
 		IntakeCompleted:    regForm.IntakeCompleted,
 		HasClient:          regForm.HasClient,
+		CreatedByUserID:    regForm.CreatedByUserID,
 	}, nil
 }
 
@@ -264,6 +374,183 @@ func (s *registrationService) DeleteRegistrationForm(
 	}, nil
 }
 
+func (s *registrationService) ListDeletedRegistrationForms(
+	ctx context.Context,
+) (*resp.PaginationResponse[ListDeletedRegistrationFormsResponse], error) {
+	limit, offset, page, pageSize := middleware.GetPaginationParams(ctx)
+
+	forms, err := s.db.ListDeletedRegistrationForms(ctx, db.ListDeletedRegistrationFormsParams{
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		s.logger.Error(
+			ctx,
+			"ListDeletedRegistrationForms",
+			"Failed to list deleted registration forms",
+			zap.Error(err),
+		)
+		return nil, ErrInternal
+	}
+
+	items := []ListDeletedRegistrationFormsResponse{}
+	for _, form := range forms {
+		status := ""
+		if form.Status.Valid {
+			status = string(form.Status.RegistrationStatusEnum)
+		}
+		items = append(items, ListDeletedRegistrationFormsResponse{
+			ID:               form.ID,
+			FirstName:        form.FirstName,
+			LastName:         form.LastName,
+			Bsn:              form.Bsn,
+			DateOfBirth:      form.DateOfBirth.Time,
+			CareType:         string(form.CareType),
+			RegistrationDate: form.RegistrationDate.Time,
+			Status:           &status,
+			DeletedAt:        form.DeletedAt.Time,
+		})
+	}
+	totalCount := 0
+	if len(forms) > 0 {
+		totalCount = int(forms[0].TotalCount)
+	}
+	result := resp.PagRespWithParams(items, totalCount, page, pageSize)
+	return &result, nil
+}
+
+func (s *registrationService) RestoreRegistrationForm(
+	ctx context.Context,
+	id string,
+) (*RestoreRegistrationFormResponse, error) {
+	form, err := s.db.GetRegistrationForm(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrDeletedFormNotFound
+		}
+		s.logger.Error(ctx, "RestoreRegistrationForm", "Failed to get registration form", zap.Error(err))
+		return nil, ErrInternal
+	}
+	if form.IsDeleted == nil || !*form.IsDeleted {
+		return nil, ErrDeletedFormNotFound
+	}
+
+	// The BSN may have been registered again after the delete; only one
+	// active form can hold it
+	existingIDs, err := s.db.GetRegistrationFormsByBSN(ctx, form.Bsn)
+	if err != nil {
+		s.logger.Error(
+			ctx,
+			"RestoreRegistrationForm",
+			"Failed to look up registration forms by BSN",
+			zap.Error(err),
+		)
+		return nil, ErrInternal
+	}
+	if len(existingIDs) > 0 {
+		return &RestoreRegistrationFormResponse{ExistingFormIDs: existingIDs}, ErrRestoreBSNConflict
+	}
+
+	restored, err := s.db.RestoreRegistrationForm(ctx, id)
+	if err != nil {
+		if db.IsUniqueViolationOf(err, "uq_registration_forms_active_bsn") {
+			return nil, ErrRestoreBSNConflict
+		}
+		s.logger.Error(ctx, "RestoreRegistrationForm", "Failed to restore registration form", zap.Error(err))
+		return nil, ErrInternal
+	}
+	if restored == 0 {
+		// Lost a race with a concurrent restore or a new form for the same BSN
+		return nil, ErrRestoreBSNConflict
+	}
+
+	return &RestoreRegistrationFormResponse{ID: id}, nil
+}
+
+func (s *registrationService) BatchUpdateRegistrationFormStatus(
+	ctx context.Context,
+	req *BatchUpdateRegistrationStatusRequest,
+) (*BatchUpdateRegistrationStatusResponse, error) {
+	status := db.RegistrationStatusEnum(req.Status)
+	historyIDs := make([]string, len(req.IDs))
+	for i := range historyIDs {
+		historyIDs[i] = nanoid.Generate()
+	}
+	updated, err := s.db.BatchUpdateRegistrationFormStatus(ctx, db.BatchUpdateRegistrationFormStatusParams{
+		Ids:        req.IDs,
+		HistoryIds: historyIDs,
+		Status:     status,
+		ChangedBy:  util.GetUserID(ctx),
+	})
+	if err != nil {
+		s.logger.Error(
+			ctx,
+			"BatchUpdateRegistrationFormStatus",
+			"Failed to update registration form statuses",
+			zap.Error(err),
+		)
+		return nil, ErrInternal
+	}
+
+	// Trigger: Notify the assigned coordinator about each approved form
+	if status == db.RegistrationStatusEnumApproved && s.notificationService != nil {
+		for _, form := range updated {
+			if form.CoordinatorUserID == nil {
+				continue
+			}
+			resourceType := notification.ResourceTypeRegistration
+			resourceID := form.ID
+			s.notificationService.Enqueue(&notification.CreateNotificationRequest{
+				UserID:       *form.CoordinatorUserID,
+				Type:         notification.TypeRegistrationStatusChange,
+				Priority:     notification.PriorityNormal,
+				Title:        "Registration Approved",
+				Message:      fmt.Sprintf("Registration for %s %s was approved", form.FirstName, form.LastName),
+				ResourceType: &resourceType,
+				ResourceID:   &resourceID,
+			})
+		}
+	}
+
+	return &BatchUpdateRegistrationStatusResponse{
+		UpdatedCount: len(updated),
+	}, nil
+}
+
+func (s *registrationService) GetRegistrationStatusHistory(
+	ctx context.Context,
+	id string,
+) ([]RegistrationStatusChangeResponse, error) {
+	history, err := s.db.GetRegistrationStatusHistory(ctx, id)
+	if err != nil {
+		s.logger.Error(
+			ctx,
+			"GetRegistrationStatusHistory",
+			"Failed to get registration status history",
+			zap.Error(err),
+		)
+		return nil, ErrInternal
+	}
+
+	statusPtr := func(status db.NullRegistrationStatusEnum) *string {
+		if !status.Valid {
+			return nil
+		}
+		value := string(status.RegistrationStatusEnum)
+		return &value
+	}
+
+	return util.Map(history, func(h db.GetRegistrationStatusHistoryRow) RegistrationStatusChangeResponse {
+		return RegistrationStatusChangeResponse{
+			ID:        h.ID,
+			OldStatus: statusPtr(h.OldStatus),
+			NewStatus: statusPtr(h.NewStatus),
+			ChangedBy: h.ChangedBy,
+			ChangedAt: h.ChangedAt.Time,
+		}
+	}), nil
+}
+
 func (s *registrationService) GetRegistrationStats(
 	ctx context.Context,
 ) (*GetRegistrationStatsResponse, error) {
@@ -284,3 +571,151 @@ func (s *registrationService) GetRegistrationStats(
 		InReviewCount: int(stats.InReviewCount),
 	}, nil
 }
+
+func (s *registrationService) ReorderRegistrationAttachments(
+	ctx context.Context,
+	id string,
+	req *ReorderRegistrationAttachmentsRequest,
+) error {
+	linked, err := s.db.ListRegistrationFormAttachments(ctx, id)
+	if err != nil {
+		s.logger.Error(
+			ctx,
+			"ReorderRegistrationAttachments",
+			"Failed to list registration form attachments",
+			zap.Error(err),
+		)
+		return ErrInternal
+	}
+	// Without linked attachments the form itself may be missing
+	if len(linked) == 0 {
+		form, err := s.db.GetRegistrationForm(ctx, id)
+		if errors.Is(err, pgx.ErrNoRows) || (err == nil && form.IsDeleted != nil && *form.IsDeleted) {
+			return ErrRegistrationFormNotFound
+		}
+		if err != nil {
+			s.logger.Error(
+				ctx,
+				"ReorderRegistrationAttachments",
+				"Failed to get registration form",
+				zap.Error(err),
+			)
+			return ErrInternal
+		}
+	}
+
+	// The new order must name every linked attachment exactly once
+	if len(req.AttachmentIDs) != len(linked) || len(uniqueIDs(req.AttachmentIDs)) != len(linked) {
+		return ErrInvalidAttachmentOrder
+	}
+	for _, attachment := range linked {
+		if !slices.Contains(req.AttachmentIDs, attachment.AttachmentID) {
+			return ErrInvalidAttachmentOrder
+		}
+	}
+
+	_, err = s.db.ReorderRegistrationFormAttachments(ctx, db.ReorderRegistrationFormAttachmentsParams{
+		RegistrationFormID: id,
+		AttachmentIds:      req.AttachmentIDs,
+	})
+	if err != nil {
+		s.logger.Error(
+			ctx,
+			"ReorderRegistrationAttachments",
+			"Failed to reorder registration form attachments",
+			zap.Error(err),
+		)
+		return ErrInternal
+	}
+	return nil
+}
+
+func (s *registrationService) UpdateRegistrationAttachmentCaption(
+	ctx context.Context,
+	id string,
+	attachmentID string,
+	req *UpdateRegistrationAttachmentCaptionRequest,
+) error {
+	// An empty caption removes it
+	caption := req.Caption
+	if caption != nil && strings.TrimSpace(*caption) == "" {
+		caption = nil
+	}
+
+	affected, err := s.db.UpdateRegistrationFormAttachmentCaption(ctx, db.UpdateRegistrationFormAttachmentCaptionParams{
+		RegistrationFormID: id,
+		AttachmentID:       attachmentID,
+		Caption:            caption,
+	})
+	if err != nil {
+		s.logger.Error(
+			ctx,
+			"UpdateRegistrationAttachmentCaption",
+			"Failed to update attachment caption",
+			zap.Error(err),
+		)
+		return ErrInternal
+	}
+	if affected == 0 {
+		return ErrAttachmentNotFound
+	}
+	return nil
+}
+
+// uniqueIDs drops repeated IDs, keeping the first occurrence of each
+func uniqueIDs(ids []string) []string {
+	if ids == nil {
+		return nil
+	}
+	unique := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if !slices.Contains(unique, id) {
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
+
+// checkAttachmentCount rejects a form that would link more than the
+// configured number of attachments; repeated IDs count once
+func (s *registrationService) checkAttachmentCount(attachmentIDs []string) error {
+	if len(uniqueIDs(attachmentIDs)) > s.maxAttachments {
+		return fmt.Errorf("%w: a form can have at most %d", ErrTooManyAttachments, s.maxAttachments)
+	}
+	return nil
+}
+
+// validateAttachments checks that every attachment ID exists and was uploaded by
+// the current user. The returned error lists the IDs that failed the check.
+func (s *registrationService) validateAttachments(
+	ctx context.Context,
+	operation string,
+	attachmentIDs []string,
+) error {
+	if len(attachmentIDs) == 0 {
+		return nil
+	}
+
+	attachments, err := s.db.GetAttachmentsByIDs(ctx, attachmentIDs)
+	if err != nil {
+		s.logger.Error(ctx, operation, "Failed to get attachments", zap.Error(err))
+		return ErrInternal
+	}
+
+	userID := util.GetUserID(ctx)
+	owned := make(map[string]bool, len(attachments))
+	for _, attachment := range attachments {
+		owned[attachment.ID] = attachment.UploadedBy != nil && *attachment.UploadedBy == userID
+	}
+
+	invalid := []string{}
+	for _, attachmentID := range attachmentIDs {
+		if !owned[attachmentID] && !slices.Contains(invalid, attachmentID) {
+			invalid = append(invalid, attachmentID)
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidAttachments, strings.Join(invalid, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,905 @@
+package registration_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"care-cordination/features/notification"
+	notificationmocks "care-cordination/features/notification/mocks"
+	"care-cordination/features/registration"
+	db "care-cordination/lib/db/sqlc"
+	dbmocks "care-cordination/lib/db/sqlc/mocks"
+	loggermocks "care-cordination/lib/logger/mocks"
+	"care-cordination/lib/util"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func ownedBy(userID string) *string {
+	return &userID
+}
+
+func TestCreateRegistrationForm(t *testing.T) {
+	orgID := "org-123"
+	newRequest := func(attachmentIDs ...string) *registration.CreateRegistrationFormRequest {
+		return &registration.CreateRegistrationFormRequest{
+			FirstName:          "John",
+			LastName:           "Doe",
+			BSN:                "123456789",
+			DateOfBirth:        "1990-01-01",
+			Gender:             "male",
+			RefferingOrgID:     &orgID,
+			CareType:           "protected_living",
+			RegistrationDate:   "2024-01-01",
+			RegistrationReason: "Needs support",
+			AttachmentIDs:      attachmentIDs,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		req         *registration.CreateRegistrationFormRequest
+		setup       func(mockStore *dbmocks.MockStoreInterface)
+		wantErr     bool
+		expectedErr error
+		errContains []string
+	}{
+		{
+			name: "success_with_valid_attachment",
+			req:  newRequest("att-1"),
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					GetAttachmentsByIDs(gomock.Any(), []string{"att-1"}).
+					Return([]db.GetAttachmentsByIDsRow{{ID: "att-1", UploadedBy: ownedBy("user-1")}}, nil)
+				mockStore.EXPECT().
+					GetRegistrationFormsByBSN(gomock.Any(), "123456789").
+					Return([]string{}, nil)
+				mockStore.EXPECT().
+					CreateRegistrationFormTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg db.CreateRegistrationFormTxParams) error {
+						assert.Equal(t, []string{"att-1"}, arg.AttachmentIDs)
+						return nil
+					})
+			},
+			wantErr: false,
+		},
+		{
+			name: "success_without_attachments",
+			req:  newRequest(),
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					GetRegistrationFormsByBSN(gomock.Any(), "123456789").
+					Return([]string{}, nil)
+				mockStore.EXPECT().
+					CreateRegistrationFormTx(gomock.Any(), gomock.Any()).
+					Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "unknown_attachment_id",
+			req:  newRequest("att-1", "bogus-id"),
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					GetAttachmentsByIDs(gomock.Any(), []string{"att-1", "bogus-id"}).
+					Return([]db.GetAttachmentsByIDsRow{{ID: "att-1", UploadedBy: ownedBy("user-1")}}, nil)
+			},
+			wantErr:     true,
+			expectedErr: registration.ErrInvalidAttachments,
+			errContains: []string{"bogus-id"},
+		},
+		{
+			name: "attachment_uploaded_by_other_user",
+			req:  newRequest("att-2"),
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					GetAttachmentsByIDs(gomock.Any(), []string{"att-2"}).
+					Return([]db.GetAttachmentsByIDsRow{{ID: "att-2", UploadedBy: ownedBy("user-2")}}, nil)
+			},
+			wantErr:     true,
+			expectedErr: registration.ErrInvalidAttachments,
+			errContains: []string{"att-2"},
+		},
+		{
+			name: "attachment_lookup_error",
+			req:  newRequest("att-1"),
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					GetAttachmentsByIDs(gomock.Any(), gomock.Any()).
+					Return(nil, assert.AnError)
+			},
+			wantErr:     true,
+			expectedErr: registration.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := dbmocks.NewMockStoreInterface(ctrl)
+			mockLogger := loggermocks.NewMockLogger(ctrl)
+
+			mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+			tt.setup(mockStore)
+
+			service := registration.NewRegistrationService(mockStore, mockLogger, nil, util.DefaultMaxAttachmentsPerForm)
+			ctx := context.WithValue(context.Background(), util.UserIDKey, "user-1")
+
+			resp, err := service.CreateRegistrationForm(ctx, tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				if tt.expectedErr != nil {
+					assert.ErrorIs(t, err, tt.expectedErr)
+				}
+				for _, s := range tt.errContains {
+					assert.Contains(t, err.Error(), s)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			assert.NotEmpty(t, resp.ID)
+		})
+	}
+}
+
+func TestRegistrationForm_AttachmentLimit(t *testing.T) {
+	const maxAttachments = 2
+	ctx := context.WithValue(context.Background(), util.UserIDKey, "user-1")
+	owned := func(ids ...string) []db.GetAttachmentsByIDsRow {
+		rows := make([]db.GetAttachmentsByIDsRow, len(ids))
+		for i, id := range ids {
+			rows[i] = db.GetAttachmentsByIDsRow{ID: id, UploadedBy: ownedBy("user-1")}
+		}
+		return rows
+	}
+	newRequest := func(attachmentIDs ...string) *registration.CreateRegistrationFormRequest {
+		return &registration.CreateRegistrationFormRequest{
+			FirstName:          "John",
+			LastName:           "Doe",
+			BSN:                "123456789",
+			DateOfBirth:        "1990-01-01",
+			Gender:             "male",
+			CareType:           "protected_living",
+			RegistrationDate:   "2024-01-01",
+			RegistrationReason: "Needs support",
+			AttachmentIDs:      attachmentIDs,
+		}
+	}
+
+	t.Run("create_at_limit", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := dbmocks.NewMockStoreInterface(ctrl)
+		mockStore.EXPECT().
+			GetAttachmentsByIDs(gomock.Any(), []string{"att-1", "att-2"}).
+			Return(owned("att-1", "att-2"), nil)
+		mockStore.EXPECT().GetRegistrationFormsByBSN(gomock.Any(), "123456789").Return([]string{}, nil)
+		mockStore.EXPECT().CreateRegistrationFormTx(gomock.Any(), gomock.Any()).Return(nil)
+
+		service := registration.NewRegistrationService(mockStore, loggermocks.NewMockLogger(ctrl), nil, maxAttachments)
+		_, err := service.CreateRegistrationForm(ctx, newRequest("att-1", "att-2"))
+		require.NoError(t, err)
+	})
+
+	t.Run("create_repeated_ids_count_once", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := dbmocks.NewMockStoreInterface(ctrl)
+		mockStore.EXPECT().
+			GetAttachmentsByIDs(gomock.Any(), gomock.Any()).
+			Return(owned("att-1", "att-2"), nil)
+		mockStore.EXPECT().GetRegistrationFormsByBSN(gomock.Any(), "123456789").Return([]string{}, nil)
+		mockStore.EXPECT().CreateRegistrationFormTx(gomock.Any(), gomock.Any()).Return(nil)
+
+		service := registration.NewRegistrationService(mockStore, loggermocks.NewMockLogger(ctrl), nil, maxAttachments)
+		_, err := service.CreateRegistrationForm(ctx, newRequest("att-1", "att-2", "att-1"))
+		require.NoError(t, err)
+	})
+
+	t.Run("create_over_limit", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		// Rejected before anything is looked up
+		mockStore := dbmocks.NewMockStoreInterface(ctrl)
+
+		service := registration.NewRegistrationService(mockStore, loggermocks.NewMockLogger(ctrl), nil, maxAttachments)
+		_, err := service.CreateRegistrationForm(ctx, newRequest("att-1", "att-2", "att-3"))
+		require.ErrorIs(t, err, registration.ErrTooManyAttachments)
+		assert.Contains(t, err.Error(), "at most 2")
+	})
+
+	t.Run("update_at_limit", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := dbmocks.NewMockStoreInterface(ctrl)
+		mockStore.EXPECT().
+			GetRegistrationFormWithDetails(gomock.Any(), "reg-1").
+			Return(db.GetRegistrationFormWithDetailsRow{ID: "reg-1"}, nil)
+		mockStore.EXPECT().
+			ListRegistrationFormAttachments(gomock.Any(), "reg-1").
+			Return([]db.ListRegistrationFormAttachmentsRow{{AttachmentID: "att-1"}}, nil)
+		// Only the newly linked attachment is checked for ownership
+		mockStore.EXPECT().
+			GetAttachmentsByIDs(gomock.Any(), []string{"att-2"}).
+			Return(owned("att-2"), nil)
+		mockStore.EXPECT().UpdateRegistrationFormTx(gomock.Any(), gomock.Any()).Return(nil)
+
+		service := registration.NewRegistrationService(mockStore, loggermocks.NewMockLogger(ctrl), nil, maxAttachments)
+		_, err := service.UpdateRegistrationForm(ctx, "reg-1", &registration.UpdateRegistrationFormRequest{
+			AttachmentIDs: []string{"att-1", "att-2"},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("update_over_limit", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := dbmocks.NewMockStoreInterface(ctrl)
+
+		service := registration.NewRegistrationService(mockStore, loggermocks.NewMockLogger(ctrl), nil, maxAttachments)
+		_, err := service.UpdateRegistrationForm(ctx, "reg-1", &registration.UpdateRegistrationFormRequest{
+			AttachmentIDs: []string{"att-1", "att-2", "att-3"},
+		})
+		require.ErrorIs(t, err, registration.ErrTooManyAttachments)
+	})
+}
+
+func TestCreateRegistrationForm_DuplicateBSN(t *testing.T) {
+	newRequest := func() *registration.CreateRegistrationFormRequest {
+		return &registration.CreateRegistrationFormRequest{
+			FirstName:          "John",
+			LastName:           "Doe",
+			BSN:                "123456789",
+			DateOfBirth:        "1990-01-01",
+			Gender:             "male",
+			CareType:           "protected_living",
+			RegistrationDate:   "2024-01-01",
+			RegistrationReason: "Re-referral",
+		}
+	}
+
+	t.Run("rejected_with_existing_ids", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := dbmocks.NewMockStoreInterface(ctrl)
+		mockLogger := loggermocks.NewMockLogger(ctrl)
+
+		mockStore.EXPECT().
+			GetRegistrationFormsByBSN(gomock.Any(), "123456789").
+			Return([]string{"reg-1"}, nil)
+		// Nothing is created while another active form holds the BSN
+
+		service := registration.NewRegistrationService(mockStore, mockLogger, nil, util.DefaultMaxAttachmentsPerForm)
+		resp, err := service.CreateRegistrationForm(context.Background(), newRequest())
+
+		require.ErrorIs(t, err, registration.ErrDuplicateBSN)
+		require.NotNil(t, resp)
+		assert.Empty(t, resp.ID)
+		assert.Equal(t, []string{"reg-1"}, resp.ExistingFormIDs)
+	})
+
+	t.Run("concurrent_create_conflicts", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := dbmocks.NewMockStoreInterface(ctrl)
+		mockLogger := loggermocks.NewMockLogger(ctrl)
+
+		mockStore.EXPECT().
+			GetRegistrationFormsByBSN(gomock.Any(), "123456789").
+			Return([]string{}, nil)
+		mockStore.EXPECT().
+			CreateRegistrationFormTx(gomock.Any(), gomock.Any()).
+			Return(&pgconn.PgError{Code: "23505", ConstraintName: "uq_registration_forms_active_bsn"})
+
+		service := registration.NewRegistrationService(mockStore, mockLogger, nil, util.DefaultMaxAttachmentsPerForm)
+		resp, err := service.CreateRegistrationForm(context.Background(), newRequest())
+
+		require.ErrorIs(t, err, registration.ErrDuplicateBSN)
+		require.NotNil(t, resp)
+		assert.Empty(t, resp.ID)
+	})
+
+	t.Run("lookup_error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := dbmocks.NewMockStoreInterface(ctrl)
+		mockLogger := loggermocks.NewMockLogger(ctrl)
+		mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any())
+
+		mockStore.EXPECT().
+			GetRegistrationFormsByBSN(gomock.Any(), gomock.Any()).
+			Return(nil, assert.AnError)
+
+		service := registration.NewRegistrationService(mockStore, mockLogger, nil, util.DefaultMaxAttachmentsPerForm)
+		_, err := service.CreateRegistrationForm(context.Background(), newRequest())
+		require.ErrorIs(t, err, registration.ErrInternal)
+	})
+}
+
+func TestUpdateRegistrationForm_Attachments(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         *registration.UpdateRegistrationFormRequest
+		setup       func(mockStore *dbmocks.MockStoreInterface)
+		wantErr     bool
+		expectedErr error
+		errContains []string
+	}{
+		{
+			name: "existing_attachments_not_revalidated",
+			req:  &registration.UpdateRegistrationFormRequest{AttachmentIDs: []string{"att-old", "att-new"}},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					GetRegistrationFormWithDetails(gomock.Any(), "reg-1").
+					Return(db.GetRegistrationFormWithDetailsRow{ID: "reg-1"}, nil)
+				mockStore.EXPECT().
+					ListRegistrationFormAttachments(gomock.Any(), "reg-1").
+					Return([]db.ListRegistrationFormAttachmentsRow{{AttachmentID: "att-old"}}, nil)
+				mockStore.EXPECT().
+					GetAttachmentsByIDs(gomock.Any(), []string{"att-new"}).
+					Return([]db.GetAttachmentsByIDsRow{{ID: "att-new", UploadedBy: ownedBy("user-1")}}, nil)
+				mockStore.EXPECT().
+					UpdateRegistrationFormTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg db.UpdateRegistrationFormTxParams) error {
+						assert.Equal(t, []string{"att-old", "att-new"}, arg.AttachmentIDs)
+						return nil
+					})
+			},
+			wantErr: false,
+		},
+		{
+			name: "bogus_attachment_id",
+			req:  &registration.UpdateRegistrationFormRequest{AttachmentIDs: []string{"bogus-id"}},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					GetRegistrationFormWithDetails(gomock.Any(), "reg-1").
+					Return(db.GetRegistrationFormWithDetailsRow{ID: "reg-1"}, nil)
+				mockStore.EXPECT().
+					ListRegistrationFormAttachments(gomock.Any(), "reg-1").
+					Return([]db.ListRegistrationFormAttachmentsRow{}, nil)
+				mockStore.EXPECT().
+					GetAttachmentsByIDs(gomock.Any(), []string{"bogus-id"}).
+					Return([]db.GetAttachmentsByIDsRow{}, nil)
+			},
+			wantErr:     true,
+			expectedErr: registration.ErrInvalidAttachments,
+			errContains: []string{"bogus-id"},
+		},
+		{
+			name: "attachments_omitted_left_unchanged",
+			req:  &registration.UpdateRegistrationFormRequest{FirstName: util.StrPtr("Jan")},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					GetRegistrationFormWithDetails(gomock.Any(), "reg-1").
+					Return(db.GetRegistrationFormWithDetailsRow{ID: "reg-1"}, nil)
+				mockStore.EXPECT().
+					UpdateRegistrationFormTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg db.UpdateRegistrationFormTxParams) error {
+						assert.Nil(t, arg.AttachmentIDs)
+						return nil
+					})
+			},
+			wantErr: false,
+		},
+		{
+			name: "status_change_recorded_for_user",
+			req:  &registration.UpdateRegistrationFormRequest{Status: util.StrPtr("approved")},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					GetRegistrationFormWithDetails(gomock.Any(), "reg-1").
+					Return(db.GetRegistrationFormWithDetailsRow{ID: "reg-1"}, nil)
+				mockStore.EXPECT().
+					UpdateRegistrationFormTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg db.UpdateRegistrationFormTxParams) error {
+						assert.Equal(t, db.NullRegistrationStatusEnum{
+							RegistrationStatusEnum: db.RegistrationStatusEnumApproved,
+							Valid:                  true,
+						}, arg.RegistrationForm.Status)
+						assert.Equal(t, "user-1", arg.ChangedBy)
+						return nil
+					})
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := dbmocks.NewMockStoreInterface(ctrl)
+			mockLogger := loggermocks.NewMockLogger(ctrl)
+
+			mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+			tt.setup(mockStore)
+
+			service := registration.NewRegistrationService(mockStore, mockLogger, nil, util.DefaultMaxAttachmentsPerForm)
+			ctx := context.WithValue(context.Background(), util.UserIDKey, "user-1")
+
+			resp, err := service.UpdateRegistrationForm(ctx, "reg-1", tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				if tt.expectedErr != nil {
+					assert.ErrorIs(t, err, tt.expectedErr)
+				}
+				for _, s := range tt.errContains {
+					assert.Contains(t, err.Error(), s)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "reg-1", resp.ID)
+		})
+	}
+}
+
+func TestGetRegistrationForm_Attachments(t *testing.T) {
+	tests := []struct {
+		name        string
+		attachments []db.ListRegistrationFormAttachmentsRow
+		check       func(t *testing.T, resp *registration.GetRegistrationFormResponse)
+	}{
+		{
+			name: "attachments_with_metadata",
+			attachments: []db.ListRegistrationFormAttachmentsRow{
+				{AttachmentID: "att-1", Filename: util.StrPtr("indicatie.pdf"), ContentType: "application/pdf", SortOrder: 0},
+				{AttachmentID: "att-2", Filename: util.StrPtr("paspoort.jpg"), ContentType: "image/jpeg", SortOrder: 1},
+			},
+			check: func(t *testing.T, resp *registration.GetRegistrationFormResponse) {
+				assert.Equal(t, []string{"att-1", "att-2"}, resp.AttachmentIDs)
+				require.Len(t, resp.Attachments, 2)
+				assert.Equal(t, "att-1", resp.Attachments[0].ID)
+				require.NotNil(t, resp.Attachments[0].Filename)
+				assert.Equal(t, "indicatie.pdf", *resp.Attachments[0].Filename)
+				assert.Equal(t, "application/pdf", resp.Attachments[0].ContentType)
+				assert.Equal(t, "image/jpeg", resp.Attachments[1].ContentType)
+			},
+		},
+		{
+			name:        "no_attachments",
+			attachments: []db.ListRegistrationFormAttachmentsRow{},
+			check: func(t *testing.T, resp *registration.GetRegistrationFormResponse) {
+				assert.NotNil(t, resp.Attachments)
+				assert.Empty(t, resp.Attachments)
+				assert.NotNil(t, resp.AttachmentIDs)
+				assert.Empty(t, resp.AttachmentIDs)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := dbmocks.NewMockStoreInterface(ctrl)
+			mockLogger := loggermocks.NewMockLogger(ctrl)
+
+			mockStore.EXPECT().
+				GetRegistrationFormWithDetails(gomock.Any(), "reg-1").
+				Return(db.GetRegistrationFormWithDetailsRow{ID: "reg-1"}, nil)
+			mockStore.EXPECT().
+				ListRegistrationFormAttachments(gomock.Any(), "reg-1").
+				Return(tt.attachments, nil)
+
+			service := registration.NewRegistrationService(mockStore, mockLogger, nil, util.DefaultMaxAttachmentsPerForm)
+			resp, err := service.GetRegistrationForm(context.Background(), "reg-1")
+
+			require.NoError(t, err)
+			tt.check(t, resp)
+		})
+	}
+}
+
+func TestBatchUpdateRegistrationFormStatus(t *testing.T) {
+	tests := []struct {
+		name          string
+		req           *registration.BatchUpdateRegistrationStatusRequest
+		setup         func(mockStore *dbmocks.MockStoreInterface, mockNotify *notificationmocks.MockNotificationService)
+		wantErr       bool
+		expectedErr   error
+		expectedCount int
+	}{
+		{
+			name: "approved_notifies_assigned_coordinators",
+			req: &registration.BatchUpdateRegistrationStatusRequest{
+				IDs:    []string{"reg-1", "reg-2", "reg-deleted"},
+				Status: "approved",
+			},
+			setup: func(mockStore *dbmocks.MockStoreInterface, mockNotify *notificationmocks.MockNotificationService) {
+				mockStore.EXPECT().
+					BatchUpdateRegistrationFormStatus(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg db.BatchUpdateRegistrationFormStatusParams) ([]db.BatchUpdateRegistrationFormStatusRow, error) {
+						assert.Equal(t, db.RegistrationStatusEnumApproved, arg.Status)
+						assert.Equal(t, []string{"reg-1", "reg-2", "reg-deleted"}, arg.Ids)
+						// One history ID per form, recorded against the caller
+						assert.Len(t, arg.HistoryIds, 3)
+						assert.Equal(t, "user-1", arg.ChangedBy)
+						return []db.BatchUpdateRegistrationFormStatusRow{
+							{ID: "reg-1", FirstName: "John", LastName: "Doe", CoordinatorUserID: util.StrPtr("coord-user-1")},
+							{ID: "reg-2", FirstName: "Jane", LastName: "Roe"},
+						}, nil
+					})
+				mockNotify.EXPECT().
+					Enqueue(gomock.Any()).
+					Do(func(req *notification.CreateNotificationRequest) {
+						assert.Equal(t, "coord-user-1", req.UserID)
+						assert.Equal(t, notification.TypeRegistrationStatusChange, req.Type)
+						require.NotNil(t, req.ResourceID)
+						assert.Equal(t, "reg-1", *req.ResourceID)
+					})
+			},
+			expectedCount: 2,
+		},
+		{
+			name: "rejected_does_not_notify",
+			req: &registration.BatchUpdateRegistrationStatusRequest{
+				IDs:    []string{"reg-1"},
+				Status: "rejected",
+			},
+			setup: func(mockStore *dbmocks.MockStoreInterface, mockNotify *notificationmocks.MockNotificationService) {
+				mockStore.EXPECT().
+					BatchUpdateRegistrationFormStatus(gomock.Any(), gomock.Any()).
+					Return([]db.BatchUpdateRegistrationFormStatusRow{
+						{ID: "reg-1", CoordinatorUserID: util.StrPtr("coord-user-1")},
+					}, nil)
+			},
+			expectedCount: 1,
+		},
+		{
+			name: "all_forms_soft_deleted",
+			req: &registration.BatchUpdateRegistrationStatusRequest{
+				IDs:    []string{"reg-deleted"},
+				Status: "approved",
+			},
+			setup: func(mockStore *dbmocks.MockStoreInterface, mockNotify *notificationmocks.MockNotificationService) {
+				mockStore.EXPECT().
+					BatchUpdateRegistrationFormStatus(gomock.Any(), gomock.Any()).
+					Return([]db.BatchUpdateRegistrationFormStatusRow{}, nil)
+			},
+			expectedCount: 0,
+		},
+		{
+			name: "db_error",
+			req: &registration.BatchUpdateRegistrationStatusRequest{
+				IDs:    []string{"reg-1"},
+				Status: "approved",
+			},
+			setup: func(mockStore *dbmocks.MockStoreInterface, mockNotify *notificationmocks.MockNotificationService) {
+				mockStore.EXPECT().
+					BatchUpdateRegistrationFormStatus(gomock.Any(), gomock.Any()).
+					Return(nil, assert.AnError)
+			},
+			wantErr:     true,
+			expectedErr: registration.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := dbmocks.NewMockStoreInterface(ctrl)
+			mockLogger := loggermocks.NewMockLogger(ctrl)
+			mockNotify := notificationmocks.NewMockNotificationService(ctrl)
+
+			mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+			tt.setup(mockStore, mockNotify)
+
+			service := registration.NewRegistrationService(mockStore, mockLogger, mockNotify, util.DefaultMaxAttachmentsPerForm)
+
+			ctx := context.WithValue(context.Background(), util.UserIDKey, "user-1")
+			resp, err := service.BatchUpdateRegistrationFormStatus(ctx, tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				if tt.expectedErr != nil {
+					assert.ErrorIs(t, err, tt.expectedErr)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedCount, resp.UpdatedCount)
+		})
+	}
+}
+
+func TestReorderRegistrationAttachments(t *testing.T) {
+	linked := []db.ListRegistrationFormAttachmentsRow{
+		{AttachmentID: "att-1", SortOrder: 0},
+		{AttachmentID: "att-2", SortOrder: 1},
+		{AttachmentID: "att-3", SortOrder: 2},
+	}
+
+	tests := []struct {
+		name          string
+		attachmentIDs []string
+		setup         func(mockStore *dbmocks.MockStoreInterface)
+		wantErr       bool
+		expectedErr   error
+	}{
+		{
+			name:          "success",
+			attachmentIDs: []string{"att-3", "att-1", "att-2"},
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					ReorderRegistrationFormAttachments(gomock.Any(), db.ReorderRegistrationFormAttachmentsParams{
+						RegistrationFormID: "reg-1",
+						AttachmentIds:      []string{"att-3", "att-1", "att-2"},
+					}).
+					Return(int64(3), nil)
+			},
+		},
+		{
+			name:          "missing_attachment",
+			attachmentIDs: []string{"att-3", "att-1"},
+			wantErr:       true,
+			expectedErr:   registration.ErrInvalidAttachmentOrder,
+		},
+		{
+			name:          "duplicate_attachment",
+			attachmentIDs: []string{"att-1", "att-1", "att-2"},
+			wantErr:       true,
+			expectedErr:   registration.ErrInvalidAttachmentOrder,
+		},
+		{
+			name:          "unlinked_attachment",
+			attachmentIDs: []string{"att-1", "att-2", "att-9"},
+			wantErr:       true,
+			expectedErr:   registration.ErrInvalidAttachmentOrder,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := dbmocks.NewMockStoreInterface(ctrl)
+			mockLogger := loggermocks.NewMockLogger(ctrl)
+			mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+			mockStore.EXPECT().
+				ListRegistrationFormAttachments(gomock.Any(), "reg-1").
+				Return(linked, nil)
+			if tt.setup != nil {
+				tt.setup(mockStore)
+			}
+
+			service := registration.NewRegistrationService(mockStore, mockLogger, nil, util.DefaultMaxAttachmentsPerForm)
+
+			err := service.ReorderRegistrationAttachments(context.Background(), "reg-1",
+				&registration.ReorderRegistrationAttachmentsRequest{AttachmentIDs: tt.attachmentIDs})
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestUpdateRegistrationAttachmentCaption(t *testing.T) {
+	tests := []struct {
+		name            string
+		caption         *string
+		affected        int64
+		expectedCaption *string
+		expectedErr     error
+	}{
+		{
+			name:            "sets_caption",
+			caption:         util.StrPtr("Verwijsbrief huisarts"),
+			affected:        1,
+			expectedCaption: util.StrPtr("Verwijsbrief huisarts"),
+		},
+		{
+			name:            "blank_caption_cleared",
+			caption:         util.StrPtr("  "),
+			affected:        1,
+			expectedCaption: nil,
+		},
+		{
+			name:            "attachment_not_linked",
+			caption:         util.StrPtr("Verwijsbrief"),
+			affected:        0,
+			expectedCaption: util.StrPtr("Verwijsbrief"),
+			expectedErr:     registration.ErrAttachmentNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := dbmocks.NewMockStoreInterface(ctrl)
+			mockLogger := loggermocks.NewMockLogger(ctrl)
+			mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+			mockStore.EXPECT().
+				UpdateRegistrationFormAttachmentCaption(gomock.Any(), db.UpdateRegistrationFormAttachmentCaptionParams{
+					RegistrationFormID: "reg-1",
+					AttachmentID:       "att-1",
+					Caption:            tt.expectedCaption,
+				}).
+				Return(tt.affected, nil)
+
+			service := registration.NewRegistrationService(mockStore, mockLogger, nil, util.DefaultMaxAttachmentsPerForm)
+
+			err := service.UpdateRegistrationAttachmentCaption(context.Background(), "reg-1", "att-1",
+				&registration.UpdateRegistrationAttachmentCaptionRequest{Caption: tt.caption})
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestListDeletedRegistrationForms(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := dbmocks.NewMockStoreInterface(ctrl)
+	mockLogger := loggermocks.NewMockLogger(ctrl)
+
+	deletedAt := time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC)
+	mockStore.EXPECT().
+		ListDeletedRegistrationForms(gomock.Any(), gomock.Any()).
+		Return([]db.ListDeletedRegistrationFormsRow{
+			{
+				ID:        "reg-2",
+				FirstName: "Jane",
+				LastName:  "Doe",
+				Bsn:       "987654321",
+				CareType:  db.CareTypeEnumProtectedLiving,
+				Status: db.NullRegistrationStatusEnum{
+					RegistrationStatusEnum: db.RegistrationStatusEnumRejected,
+					Valid:                  true,
+				},
+				DeletedAt:  pgtype.Timestamptz{Time: deletedAt, Valid: true},
+				TotalCount: 1,
+			},
+		}, nil)
+
+	service := registration.NewRegistrationService(mockStore, mockLogger, nil, util.DefaultMaxAttachmentsPerForm)
+	result, err := service.ListDeletedRegistrationForms(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, result.Data, 1)
+	assert.Equal(t, 1, result.TotalCount)
+	form := result.Data[0]
+	assert.Equal(t, "reg-2", form.ID)
+	assert.Equal(t, "protected_living", form.CareType)
+	assert.Equal(t, "rejected", *form.Status)
+	assert.Equal(t, deletedAt, form.DeletedAt)
+}
+
+func TestRestoreRegistrationForm(t *testing.T) {
+	isDeleted, notDeleted := true, false
+	deletedForm := db.RegistrationForm{
+		ID:        "reg-1",
+		Bsn:       "123456789",
+		IsDeleted: &isDeleted,
+	}
+
+	t.Run("restores", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := dbmocks.NewMockStoreInterface(ctrl)
+		mockLogger := loggermocks.NewMockLogger(ctrl)
+
+		mockStore.EXPECT().GetRegistrationForm(gomock.Any(), "reg-1").Return(deletedForm, nil)
+		mockStore.EXPECT().GetRegistrationFormsByBSN(gomock.Any(), "123456789").Return([]string{}, nil)
+		mockStore.EXPECT().RestoreRegistrationForm(gomock.Any(), "reg-1").Return(int64(1), nil)
+
+		service := registration.NewRegistrationService(mockStore, mockLogger, nil, util.DefaultMaxAttachmentsPerForm)
+		result, err := service.RestoreRegistrationForm(context.Background(), "reg-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, "reg-1", result.ID)
+	})
+
+	t.Run("blocked_by_active_bsn", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := dbmocks.NewMockStoreInterface(ctrl)
+		mockLogger := loggermocks.NewMockLogger(ctrl)
+
+		mockStore.EXPECT().GetRegistrationForm(gomock.Any(), "reg-1").Return(deletedForm, nil)
+		mockStore.EXPECT().
+			GetRegistrationFormsByBSN(gomock.Any(), "123456789").
+			Return([]string{"reg-3"}, nil)
+		// The form stays deleted
+
+		service := registration.NewRegistrationService(mockStore, mockLogger, nil, util.DefaultMaxAttachmentsPerForm)
+		result, err := service.RestoreRegistrationForm(context.Background(), "reg-1")
+
+		require.ErrorIs(t, err, registration.ErrRestoreBSNConflict)
+		require.NotNil(t, result)
+		assert.Empty(t, result.ID)
+		assert.Equal(t, []string{"reg-3"}, result.ExistingFormIDs)
+	})
+
+	t.Run("not_deleted", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := dbmocks.NewMockStoreInterface(ctrl)
+		mockLogger := loggermocks.NewMockLogger(ctrl)
+
+		active := deletedForm
+		active.IsDeleted = &notDeleted
+		mockStore.EXPECT().GetRegistrationForm(gomock.Any(), "reg-1").Return(active, nil)
+
+		service := registration.NewRegistrationService(mockStore, mockLogger, nil, util.DefaultMaxAttachmentsPerForm)
+		_, err := service.RestoreRegistrationForm(context.Background(), "reg-1")
+		require.ErrorIs(t, err, registration.ErrDeletedFormNotFound)
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := dbmocks.NewMockStoreInterface(ctrl)
+		mockLogger := loggermocks.NewMockLogger(ctrl)
+
+		mockStore.EXPECT().
+			GetRegistrationForm(gomock.Any(), "reg-404").
+			Return(db.RegistrationForm{}, pgx.ErrNoRows)
+
+		service := registration.NewRegistrationService(mockStore, mockLogger, nil, util.DefaultMaxAttachmentsPerForm)
+		_, err := service.RestoreRegistrationForm(context.Background(), "reg-404")
+		require.ErrorIs(t, err, registration.ErrDeletedFormNotFound)
+	})
+
+	t.Run("lost_race", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := dbmocks.NewMockStoreInterface(ctrl)
+		mockLogger := loggermocks.NewMockLogger(ctrl)
+
+		mockStore.EXPECT().GetRegistrationForm(gomock.Any(), "reg-1").Return(deletedForm, nil)
+		mockStore.EXPECT().GetRegistrationFormsByBSN(gomock.Any(), "123456789").Return([]string{}, nil)
+		mockStore.EXPECT().RestoreRegistrationForm(gomock.Any(), "reg-1").Return(int64(0), nil)
+
+		service := registration.NewRegistrationService(mockStore, mockLogger, nil, util.DefaultMaxAttachmentsPerForm)
+		_, err := service.RestoreRegistrationForm(context.Background(), "reg-1")
+		require.ErrorIs(t, err, registration.ErrRestoreBSNConflict)
+	})
+}
+
+func TestReorderRegistrationAttachments_FormNotFound(t *testing.T) {
+	deleted := true
+	tests := []struct {
+		name string
+		form db.RegistrationForm
+		err  error
+	}{
+		{name: "missing", err: pgx.ErrNoRows},
+		{name: "deleted", form: db.RegistrationForm{ID: "reg-1", IsDeleted: &deleted}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockStore := dbmocks.NewMockStoreInterface(ctrl)
+			mockStore.EXPECT().ListRegistrationFormAttachments(gomock.Any(), "reg-1").Return(nil, nil)
+			mockStore.EXPECT().GetRegistrationForm(gomock.Any(), "reg-1").Return(tt.form, tt.err)
+
+			service := registration.NewRegistrationService(mockStore, loggermocks.NewMockLogger(ctrl), nil, util.DefaultMaxAttachmentsPerForm)
+			err := service.ReorderRegistrationAttachments(context.Background(), "reg-1",
+				&registration.ReorderRegistrationAttachmentsRequest{AttachmentIDs: []string{"att-1"}})
+
+			require.ErrorIs(t, err, registration.ErrRegistrationFormNotFound)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: care-cordination/features/attachments (interfaces: AttachmentsService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../internal/mocks/mock_attachments_service.go -package=mocks care-cordination/features/attachments AttachmentsService
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	attachments "care-cordination/features/attachments"
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAttachmentsService is a mock of AttachmentsService interface.
+type MockAttachmentsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttachmentsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAttachmentsServiceMockRecorder is the mock recorder for MockAttachmentsService.
+type MockAttachmentsServiceMockRecorder struct {
+	mock *MockAttachmentsService
+}
+
+// NewMockAttachmentsService creates a new mock instance.
+func NewMockAttachmentsService(ctrl *gomock.Controller) *MockAttachmentsService {
+	mock := &MockAttachmentsService{ctrl: ctrl}
+	mock.recorder = &MockAttachmentsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttachmentsService) EXPECT() *MockAttachmentsServiceMockRecorder {
+	return m.recorder
+}
+
+// DownloadAttachment mocks base method.
+func (m *MockAttachmentsService) DownloadAttachment(ctx context.Context, id string) (*attachments.DownloadAttachmentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadAttachment", ctx, id)
+	ret0, _ := ret[0].(*attachments.DownloadAttachmentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DownloadAttachment indicates an expected call of DownloadAttachment.
+func (mr *MockAttachmentsServiceMockRecorder) DownloadAttachment(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadAttachment", reflect.TypeOf((*MockAttachmentsService)(nil).DownloadAttachment), ctx, id)
+}
+
+// ListAttachmentAccess mocks base method.
+func (m *MockAttachmentsService) ListAttachmentAccess(ctx context.Context, id string) ([]attachments.AttachmentAccessResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAttachmentAccess", ctx, id)
+	ret0, _ := ret[0].([]attachments.AttachmentAccessResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAttachmentAccess indicates an expected call of ListAttachmentAccess.
+func (mr *MockAttachmentsServiceMockRecorder) ListAttachmentAccess(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttachmentAccess", reflect.TypeOf((*MockAttachmentsService)(nil).ListAttachmentAccess), ctx, id)
+}
+
+// UploadAttachment mocks base method.
+func (m *MockAttachmentsService) UploadAttachment(ctx context.Context, file io.Reader, filename, contentType string) (*attachments.UploadAttachmentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadAttachment", ctx, file, filename, contentType)
+	ret0, _ := ret[0].(*attachments.UploadAttachmentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadAttachment indicates an expected call of UploadAttachment.
+func (mr *MockAttachmentsServiceMockRecorder) UploadAttachment(ctx, file, filename, contentType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadAttachment", reflect.TypeOf((*MockAttachmentsService)(nil).UploadAttachment), ctx, file, filename, contentType)
+}
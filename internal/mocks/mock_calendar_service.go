@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -destination=internal/mocks/mock_calendar_service.go -package=mocks care-cordination/features/calendar CalendarService
+//	mockgen -destination=../../internal/mocks/mock_calendar_service.go -package=mocks care-cordination/features/calendar CalendarService
 //
 
 // Package mocks is a generated GoMock package.
@@ -11,6 +11,7 @@ package mocks
 
 import (
 	calendar "care-cordination/features/calendar"
+	resp "care-cordination/lib/resp"
 	context "context"
 	reflect "reflect"
 	time "time"
@@ -42,6 +43,21 @@ func (m *MockCalendarService) EXPECT() *MockCalendarServiceMockRecorder {
 	return m.recorder
 }
 
+// CancelAppointment mocks base method.
+func (m *MockCalendarService) CancelAppointment(ctx context.Context, id string, req calendar.CancelAppointmentRequest) (*calendar.AppointmentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelAppointment", ctx, id, req)
+	ret0, _ := ret[0].(*calendar.AppointmentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelAppointment indicates an expected call of CancelAppointment.
+func (mr *MockCalendarServiceMockRecorder) CancelAppointment(ctx, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelAppointment", reflect.TypeOf((*MockCalendarService)(nil).CancelAppointment), ctx, id, req)
+}
+
 // CreateAppointment mocks base method.
 func (m *MockCalendarService) CreateAppointment(ctx context.Context, organizerID string, req calendar.CreateAppointmentRequest) (*calendar.AppointmentResponse, error) {
 	m.ctrl.T.Helper()
@@ -146,18 +162,18 @@ func (mr *MockCalendarServiceMockRecorder) GetReminder(ctx, id any) *gomock.Call
 }
 
 // ListAppointments mocks base method.
-func (m *MockCalendarService) ListAppointments(ctx context.Context, userID string) ([]calendar.AppointmentResponse, error) {
+func (m *MockCalendarService) ListAppointments(ctx context.Context, req *calendar.ListAppointmentsRequest) (*resp.PaginationResponse[calendar.ListAppointmentsResponse], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAppointments", ctx, userID)
-	ret0, _ := ret[0].([]calendar.AppointmentResponse)
+	ret := m.ctrl.Call(m, "ListAppointments", ctx, req)
+	ret0, _ := ret[0].(*resp.PaginationResponse[calendar.ListAppointmentsResponse])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListAppointments indicates an expected call of ListAppointments.
-func (mr *MockCalendarServiceMockRecorder) ListAppointments(ctx, userID any) *gomock.Call {
+func (mr *MockCalendarServiceMockRecorder) ListAppointments(ctx, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAppointments", reflect.TypeOf((*MockCalendarService)(nil).ListAppointments), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAppointments", reflect.TypeOf((*MockCalendarService)(nil).ListAppointments), ctx, req)
 }
 
 // ListReminders mocks base method.
@@ -13,6 +13,7 @@ import (
 	client "care-cordination/features/client"
 	resp "care-cordination/lib/resp"
 	context "context"
+	io "io"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -42,6 +43,21 @@ func (m *MockClientService) EXPECT() *MockClientServiceMockRecorder {
 	return m.recorder
 }
 
+// AddClientNote mocks base method.
+func (m *MockClientService) AddClientNote(ctx context.Context, clientID string, req *client.AddClientNoteRequest) (*client.ClientNoteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddClientNote", ctx, clientID, req)
+	ret0, _ := ret[0].(*client.ClientNoteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddClientNote indicates an expected call of AddClientNote.
+func (mr *MockClientServiceMockRecorder) AddClientNote(ctx, clientID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddClientNote", reflect.TypeOf((*MockClientService)(nil).AddClientNote), ctx, clientID, req)
+}
+
 // CompleteDischarge mocks base method.
 func (m *MockClientService) CompleteDischarge(ctx context.Context, clientID string, req *client.CompleteDischargeRequest) (*client.CompleteDischargeResponse, error) {
 	m.ctrl.T.Helper()
@@ -57,6 +73,50 @@ func (mr *MockClientServiceMockRecorder) CompleteDischarge(ctx, clientID, req an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteDischarge", reflect.TypeOf((*MockClientService)(nil).CompleteDischarge), ctx, clientID, req)
 }
 
+// ExportClients mocks base method.
+func (m *MockClientService) ExportClients(ctx context.Context, w io.Writer, req *client.ExportClientsRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportClients", ctx, w, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportClients indicates an expected call of ExportClients.
+func (mr *MockClientServiceMockRecorder) ExportClients(ctx, w, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportClients", reflect.TypeOf((*MockClientService)(nil).ExportClients), ctx, w, req)
+}
+
+// GetClient mocks base method.
+func (m *MockClientService) GetClient(ctx context.Context, clientID string) (*client.GetClientResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClient", ctx, clientID)
+	ret0, _ := ret[0].(*client.GetClientResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClient indicates an expected call of GetClient.
+func (mr *MockClientServiceMockRecorder) GetClient(ctx, clientID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClient", reflect.TypeOf((*MockClientService)(nil).GetClient), ctx, clientID)
+}
+
+// GetClientByBSN mocks base method.
+func (m *MockClientService) GetClientByBSN(ctx context.Context, bsn string) (*client.GetClientResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClientByBSN", ctx, bsn)
+	ret0, _ := ret[0].(*client.GetClientResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClientByBSN indicates an expected call of GetClientByBSN.
+func (mr *MockClientServiceMockRecorder) GetClientByBSN(ctx, bsn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClientByBSN", reflect.TypeOf((*MockClientService)(nil).GetClientByBSN), ctx, bsn)
+}
+
 // GetDischargeStats mocks base method.
 func (m *MockClientService) GetDischargeStats(ctx context.Context) (*client.GetDischargeStatsResponse, error) {
 	m.ctrl.T.Helper()
@@ -72,6 +132,21 @@ func (mr *MockClientServiceMockRecorder) GetDischargeStats(ctx any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDischargeStats", reflect.TypeOf((*MockClientService)(nil).GetDischargeStats), ctx)
 }
 
+// GetDischargeTrend mocks base method.
+func (m *MockClientService) GetDischargeTrend(ctx context.Context, req *client.GetDischargeTrendRequest) ([]client.DischargeTrendMonth, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDischargeTrend", ctx, req)
+	ret0, _ := ret[0].([]client.DischargeTrendMonth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDischargeTrend indicates an expected call of GetDischargeTrend.
+func (mr *MockClientServiceMockRecorder) GetDischargeTrend(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDischargeTrend", reflect.TypeOf((*MockClientService)(nil).GetDischargeTrend), ctx, req)
+}
+
 // GetInCareStats mocks base method.
 func (m *MockClientService) GetInCareStats(ctx context.Context) (*client.GetInCareStatsResponse, error) {
 	m.ctrl.T.Helper()
@@ -117,6 +192,21 @@ func (mr *MockClientServiceMockRecorder) ListClientGoals(ctx, clientID any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClientGoals", reflect.TypeOf((*MockClientService)(nil).ListClientGoals), ctx, clientID)
 }
 
+// ListClientNotes mocks base method.
+func (m *MockClientService) ListClientNotes(ctx context.Context, clientID string) ([]client.ClientNoteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClientNotes", ctx, clientID)
+	ret0, _ := ret[0].([]client.ClientNoteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClientNotes indicates an expected call of ListClientNotes.
+func (mr *MockClientServiceMockRecorder) ListClientNotes(ctx, clientID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClientNotes", reflect.TypeOf((*MockClientService)(nil).ListClientNotes), ctx, clientID)
+}
+
 // ListDischargedClients mocks base method.
 func (m *MockClientService) ListDischargedClients(ctx context.Context, req *client.ListDischargedClientsRequest) (*resp.PaginationResponse[client.ListDischargedClientsResponse], error) {
 	m.ctrl.T.Helper()
@@ -192,6 +282,21 @@ func (mr *MockClientServiceMockRecorder) MoveClientToWaitingList(ctx, req any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveClientToWaitingList", reflect.TypeOf((*MockClientService)(nil).MoveClientToWaitingList), ctx, req)
 }
 
+// SearchClients mocks base method.
+func (m *MockClientService) SearchClients(ctx context.Context, req *client.SearchClientsRequest) (*resp.PaginationResponse[client.SearchClientsResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchClients", ctx, req)
+	ret0, _ := ret[0].(*resp.PaginationResponse[client.SearchClientsResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchClients indicates an expected call of SearchClients.
+func (mr *MockClientServiceMockRecorder) SearchClients(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchClients", reflect.TypeOf((*MockClientService)(nil).SearchClients), ctx, req)
+}
+
 // StartDischarge mocks base method.
 func (m *MockClientService) StartDischarge(ctx context.Context, clientID string, req *client.StartDischargeRequest) (*client.StartDischargeResponse, error) {
 	m.ctrl.T.Helper()
@@ -206,3 +311,18 @@ func (mr *MockClientServiceMockRecorder) StartDischarge(ctx, clientID, req any)
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartDischarge", reflect.TypeOf((*MockClientService)(nil).StartDischarge), ctx, clientID, req)
 }
+
+// SuggestPlacements mocks base method.
+func (m *MockClientService) SuggestPlacements(ctx context.Context, clientID string) ([]client.PlacementSuggestionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuggestPlacements", ctx, clientID)
+	ret0, _ := ret[0].([]client.PlacementSuggestionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuggestPlacements indicates an expected call of SuggestPlacements.
+func (mr *MockClientServiceMockRecorder) SuggestPlacements(ctx, clientID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestPlacements", reflect.TypeOf((*MockClientService)(nil).SuggestPlacements), ctx, clientID)
+}
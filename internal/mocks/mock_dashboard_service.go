@@ -56,6 +56,21 @@ func (mr *MockDashboardServiceMockRecorder) GetCareTypeDistribution(ctx any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCareTypeDistribution", reflect.TypeOf((*MockDashboardService)(nil).GetCareTypeDistribution), ctx)
 }
 
+// GetClientAgeDistribution mocks base method.
+func (m *MockDashboardService) GetClientAgeDistribution(ctx context.Context, req *dashboard.ClientAgeDistributionRequest) (*dashboard.ClientAgeDistributionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClientAgeDistribution", ctx, req)
+	ret0, _ := ret[0].(*dashboard.ClientAgeDistributionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClientAgeDistribution indicates an expected call of GetClientAgeDistribution.
+func (mr *MockDashboardServiceMockRecorder) GetClientAgeDistribution(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClientAgeDistribution", reflect.TypeOf((*MockDashboardService)(nil).GetClientAgeDistribution), ctx, req)
+}
+
 // GetCoordinatorClients mocks base method.
 func (m *MockDashboardService) GetCoordinatorClients(ctx context.Context, employeeID string) (*dashboard.CoordinatorClientsResponse, error) {
 	m.ctrl.T.Helper()
@@ -162,18 +177,33 @@ func (mr *MockDashboardServiceMockRecorder) GetCoordinatorUrgentAlerts(ctx, empl
 }
 
 // GetCriticalAlerts mocks base method.
-func (m *MockDashboardService) GetCriticalAlerts(ctx context.Context) (*dashboard.CriticalAlertsResponse, error) {
+func (m *MockDashboardService) GetCriticalAlerts(ctx context.Context, coordinatorID *string) (*dashboard.CriticalAlertsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCriticalAlerts", ctx)
+	ret := m.ctrl.Call(m, "GetCriticalAlerts", ctx, coordinatorID)
 	ret0, _ := ret[0].(*dashboard.CriticalAlertsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCriticalAlerts indicates an expected call of GetCriticalAlerts.
-func (mr *MockDashboardServiceMockRecorder) GetCriticalAlerts(ctx any) *gomock.Call {
+func (mr *MockDashboardServiceMockRecorder) GetCriticalAlerts(ctx, coordinatorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCriticalAlerts", reflect.TypeOf((*MockDashboardService)(nil).GetCriticalAlerts), ctx, coordinatorID)
+}
+
+// GetDashboard mocks base method.
+func (m *MockDashboardService) GetDashboard(ctx context.Context, capacity *dashboard.LocationCapacityRequest) (*dashboard.DashboardResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDashboard", ctx, capacity)
+	ret0, _ := ret[0].(*dashboard.DashboardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDashboard indicates an expected call of GetDashboard.
+func (mr *MockDashboardServiceMockRecorder) GetDashboard(ctx, capacity any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCriticalAlerts", reflect.TypeOf((*MockDashboardService)(nil).GetCriticalAlerts), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDashboard", reflect.TypeOf((*MockDashboardService)(nil).GetDashboard), ctx, capacity)
 }
 
 // GetDischargeStats mocks base method.
@@ -206,6 +236,35 @@ func (mr *MockDashboardServiceMockRecorder) GetEvaluationStats(ctx any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvaluationStats", reflect.TypeOf((*MockDashboardService)(nil).GetEvaluationStats), ctx)
 }
 
+// GetFeatures mocks base method.
+func (m *MockDashboardService) GetFeatures(ctx context.Context) *dashboard.FeaturesResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeatures", ctx)
+	ret0, _ := ret[0].(*dashboard.FeaturesResponse)
+	return ret0
+}
+
+// GetFeatures indicates an expected call of GetFeatures.
+func (mr *MockDashboardServiceMockRecorder) GetFeatures(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeatures", reflect.TypeOf((*MockDashboardService)(nil).GetFeatures), ctx)
+}
+
+// GetIncidentCategoryStats mocks base method.
+func (m *MockDashboardService) GetIncidentCategoryStats(ctx context.Context) (*dashboard.IncidentCategoryStatsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIncidentCategoryStats", ctx)
+	ret0, _ := ret[0].(*dashboard.IncidentCategoryStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIncidentCategoryStats indicates an expected call of GetIncidentCategoryStats.
+func (mr *MockDashboardServiceMockRecorder) GetIncidentCategoryStats(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIncidentCategoryStats", reflect.TypeOf((*MockDashboardService)(nil).GetIncidentCategoryStats), ctx)
+}
+
 // GetLocationCapacity mocks base method.
 func (m *MockDashboardService) GetLocationCapacity(ctx context.Context, req *dashboard.LocationCapacityRequest) (*dashboard.LocationCapacityResponse, error) {
 	m.ctrl.T.Helper()
@@ -237,18 +296,18 @@ func (mr *MockDashboardServiceMockRecorder) GetOverviewStats(ctx any) *gomock.Ca
 }
 
 // GetPipelineStats mocks base method.
-func (m *MockDashboardService) GetPipelineStats(ctx context.Context) (*dashboard.PipelineStatsResponse, error) {
+func (m *MockDashboardService) GetPipelineStats(ctx context.Context, req *dashboard.PipelineStatsRequest) (*dashboard.PipelineStatsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPipelineStats", ctx)
+	ret := m.ctrl.Call(m, "GetPipelineStats", ctx, req)
 	ret0, _ := ret[0].(*dashboard.PipelineStatsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPipelineStats indicates an expected call of GetPipelineStats.
-func (mr *MockDashboardServiceMockRecorder) GetPipelineStats(ctx any) *gomock.Call {
+func (mr *MockDashboardServiceMockRecorder) GetPipelineStats(ctx, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipelineStats", reflect.TypeOf((*MockDashboardService)(nil).GetPipelineStats), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipelineStats", reflect.TypeOf((*MockDashboardService)(nil).GetPipelineStats), ctx, req)
 }
 
 // GetTodayAppointments mocks base method.
@@ -71,6 +71,21 @@ func (mr *MockEmployeeServiceMockRecorder) DeleteEmployee(ctx, id any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEmployee", reflect.TypeOf((*MockEmployeeService)(nil).DeleteEmployee), ctx, id)
 }
 
+// GetAvailability mocks base method.
+func (m *MockEmployeeService) GetAvailability(ctx context.Context, id string) (*employee.AvailabilityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAvailability", ctx, id)
+	ret0, _ := ret[0].(*employee.AvailabilityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAvailability indicates an expected call of GetAvailability.
+func (mr *MockEmployeeServiceMockRecorder) GetAvailability(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailability", reflect.TypeOf((*MockEmployeeService)(nil).GetAvailability), ctx, id)
+}
+
 // GetEmployeeByID mocks base method.
 func (m *MockEmployeeService) GetEmployeeByID(ctx context.Context, id string) (*employee.GetEmployeeByIDResponse, error) {
 	m.ctrl.T.Helper()
@@ -116,6 +131,36 @@ func (mr *MockEmployeeServiceMockRecorder) ListEmployees(ctx, req any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEmployees", reflect.TypeOf((*MockEmployeeService)(nil).ListEmployees), ctx, req)
 }
 
+// SetAvailability mocks base method.
+func (m *MockEmployeeService) SetAvailability(ctx context.Context, id string, req *employee.SetAvailabilityRequest) (*employee.AvailabilityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAvailability", ctx, id, req)
+	ret0, _ := ret[0].(*employee.AvailabilityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetAvailability indicates an expected call of SetAvailability.
+func (mr *MockEmployeeServiceMockRecorder) SetAvailability(ctx, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAvailability", reflect.TypeOf((*MockEmployeeService)(nil).SetAvailability), ctx, id, req)
+}
+
+// SuggestReplacementCoordinator mocks base method.
+func (m *MockEmployeeService) SuggestReplacementCoordinator(ctx context.Context, forEmployeeID string) (*employee.ReplacementCoordinatorResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuggestReplacementCoordinator", ctx, forEmployeeID)
+	ret0, _ := ret[0].(*employee.ReplacementCoordinatorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuggestReplacementCoordinator indicates an expected call of SuggestReplacementCoordinator.
+func (mr *MockEmployeeServiceMockRecorder) SuggestReplacementCoordinator(ctx, forEmployeeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestReplacementCoordinator", reflect.TypeOf((*MockEmployeeService)(nil).SuggestReplacementCoordinator), ctx, forEmployeeID)
+}
+
 // UpdateEmployee mocks base method.
 func (m *MockEmployeeService) UpdateEmployee(ctx context.Context, id string, req *employee.UpdateEmployeeRequest) (*employee.UpdateEmployeeResponse, error) {
 	m.ctrl.T.Helper()
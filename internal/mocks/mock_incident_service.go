@@ -0,0 +1,148 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: care-cordination/features/incident (interfaces: IncidentService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../internal/mocks/mock_incident_service.go -package=mocks care-cordination/features/incident IncidentService
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	incident "care-cordination/features/incident"
+	resp "care-cordination/lib/resp"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIncidentService is a mock of IncidentService interface.
+type MockIncidentService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIncidentServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockIncidentServiceMockRecorder is the mock recorder for MockIncidentService.
+type MockIncidentServiceMockRecorder struct {
+	mock *MockIncidentService
+}
+
+// NewMockIncidentService creates a new mock instance.
+func NewMockIncidentService(ctrl *gomock.Controller) *MockIncidentService {
+	mock := &MockIncidentService{ctrl: ctrl}
+	mock.recorder = &MockIncidentServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIncidentService) EXPECT() *MockIncidentServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateIncident mocks base method.
+func (m *MockIncidentService) CreateIncident(ctx context.Context, req *incident.CreateIncidentRequest) (incident.CreateIncidentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIncident", ctx, req)
+	ret0, _ := ret[0].(incident.CreateIncidentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIncident indicates an expected call of CreateIncident.
+func (mr *MockIncidentServiceMockRecorder) CreateIncident(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIncident", reflect.TypeOf((*MockIncidentService)(nil).CreateIncident), ctx, req)
+}
+
+// DeleteIncident mocks base method.
+func (m *MockIncidentService) DeleteIncident(ctx context.Context, id string, req *incident.DeleteIncidentRequest) (*incident.DeleteIncidentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIncident", ctx, id, req)
+	ret0, _ := ret[0].(*incident.DeleteIncidentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteIncident indicates an expected call of DeleteIncident.
+func (mr *MockIncidentServiceMockRecorder) DeleteIncident(ctx, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIncident", reflect.TypeOf((*MockIncidentService)(nil).DeleteIncident), ctx, id, req)
+}
+
+// GetIncident mocks base method.
+func (m *MockIncidentService) GetIncident(ctx context.Context, id string) (*incident.GetIncidentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIncident", ctx, id)
+	ret0, _ := ret[0].(*incident.GetIncidentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIncident indicates an expected call of GetIncident.
+func (mr *MockIncidentServiceMockRecorder) GetIncident(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIncident", reflect.TypeOf((*MockIncidentService)(nil).GetIncident), ctx, id)
+}
+
+// GetIncidentStats mocks base method.
+func (m *MockIncidentService) GetIncidentStats(ctx context.Context) (*incident.GetIncidentStatsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIncidentStats", ctx)
+	ret0, _ := ret[0].(*incident.GetIncidentStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIncidentStats indicates an expected call of GetIncidentStats.
+func (mr *MockIncidentServiceMockRecorder) GetIncidentStats(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIncidentStats", reflect.TypeOf((*MockIncidentService)(nil).GetIncidentStats), ctx)
+}
+
+// GetIncidentTrendByMonth mocks base method.
+func (m *MockIncidentService) GetIncidentTrendByMonth(ctx context.Context, months int) ([]incident.IncidentTrendMonth, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIncidentTrendByMonth", ctx, months)
+	ret0, _ := ret[0].([]incident.IncidentTrendMonth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIncidentTrendByMonth indicates an expected call of GetIncidentTrendByMonth.
+func (mr *MockIncidentServiceMockRecorder) GetIncidentTrendByMonth(ctx, months any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIncidentTrendByMonth", reflect.TypeOf((*MockIncidentService)(nil).GetIncidentTrendByMonth), ctx, months)
+}
+
+// ListIncidents mocks base method.
+func (m *MockIncidentService) ListIncidents(ctx context.Context, req *incident.ListIncidentsRequest) (*resp.PaginationResponse[incident.ListIncidentsResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIncidents", ctx, req)
+	ret0, _ := ret[0].(*resp.PaginationResponse[incident.ListIncidentsResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIncidents indicates an expected call of ListIncidents.
+func (mr *MockIncidentServiceMockRecorder) ListIncidents(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIncidents", reflect.TypeOf((*MockIncidentService)(nil).ListIncidents), ctx, req)
+}
+
+// UpdateIncident mocks base method.
+func (m *MockIncidentService) UpdateIncident(ctx context.Context, id string, req *incident.UpdateIncidentRequest) (*incident.UpdateIncidentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIncident", ctx, id, req)
+	ret0, _ := ret[0].(*incident.UpdateIncidentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateIncident indicates an expected call of UpdateIncident.
+func (mr *MockIncidentServiceMockRecorder) UpdateIncident(ctx, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIncident", reflect.TypeOf((*MockIncidentService)(nil).UpdateIncident), ctx, id, req)
+}
@@ -0,0 +1,81 @@
+// Package assignment checks that a client's coordinator works at the client's
+// assigned location and has room in their caseload, so client creation,
+// intake updates and transfer approval apply the same rules.
+package assignment
+
+import (
+	db "care-cordination/lib/db/sqlc"
+	"care-cordination/lib/logger"
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+)
+
+// ErrCoordinatorLocationMismatch is returned in ModeEnforce when the
+// coordinator works at a different location than the client is assigned to
+var ErrCoordinatorLocationMismatch = errors.New("coordinator does not work at the client's location")
+
+// Mode sets how a coordinator/location mismatch is handled
+type Mode string
+
+const (
+	// ModeOff skips the check
+	ModeOff Mode = "off"
+	// ModeWarn logs a mismatch and lets the change through
+	ModeWarn Mode = "warn"
+	// ModeEnforce rejects a mismatch with ErrCoordinatorLocationMismatch
+	ModeEnforce Mode = "enforce"
+)
+
+// Valid reports whether m is one of the known modes
+func (m Mode) Valid() bool {
+	switch m {
+	case ModeOff, ModeWarn, ModeEnforce:
+		return true
+	}
+	return false
+}
+
+// EmployeeGetter is the store method the check needs
+type EmployeeGetter interface {
+	GetEmployeeByID(ctx context.Context, id string) (db.GetEmployeeByIDRow, error)
+}
+
+// CheckCoordinatorLocation compares the coordinator's location with
+// locationID. A mismatch is logged under operation in ModeWarn and returned
+// as ErrCoordinatorLocationMismatch in ModeEnforce; ModeOff skips the lookup.
+func (m Mode) CheckCoordinatorLocation(
+	ctx context.Context,
+	store EmployeeGetter,
+	log logger.Logger,
+	operation string,
+	coordinatorID string,
+	locationID string,
+) error {
+	if m != ModeWarn && m != ModeEnforce {
+		return nil
+	}
+
+	coordinator, err := store.GetEmployeeByID(ctx, coordinatorID)
+	if err != nil {
+		return fmt.Errorf("get coordinator: %w", err)
+	}
+	if coordinator.LocationID == locationID {
+		return nil
+	}
+
+	if m == ModeEnforce {
+		return ErrCoordinatorLocationMismatch
+	}
+	log.Warn(
+		ctx,
+		operation,
+		"Coordinator does not work at the client's location",
+		zap.String("coordinatorId", coordinatorID),
+		zap.String("coordinatorLocationId", coordinator.LocationID),
+		zap.String("clientLocationId", locationID),
+	)
+	return nil
+}
@@ -0,0 +1,73 @@
+package assignment
+
+import (
+	db "care-cordination/lib/db/sqlc"
+	dbmocks "care-cordination/lib/db/sqlc/mocks"
+	loggermocks "care-cordination/lib/logger/mocks"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCheckCoordinatorLocation(t *testing.T) {
+	tests := []struct {
+		name     string
+		mode     Mode
+		location string
+		lookup   bool
+		warn     bool
+		want     error
+	}{
+		{name: "match_enforce", mode: ModeEnforce, location: "loc-1", lookup: true},
+		{name: "match_warn", mode: ModeWarn, location: "loc-1", lookup: true},
+		{name: "mismatch_enforce", mode: ModeEnforce, location: "loc-2", lookup: true, want: ErrCoordinatorLocationMismatch},
+		{name: "mismatch_warn", mode: ModeWarn, location: "loc-2", lookup: true, warn: true},
+		{name: "mismatch_off", mode: ModeOff, location: "loc-2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockStore := dbmocks.NewMockStoreInterface(ctrl)
+			mockLogger := loggermocks.NewMockLogger(ctrl)
+
+			if tt.lookup {
+				mockStore.EXPECT().
+					GetEmployeeByID(gomock.Any(), "coord-1").
+					Return(db.GetEmployeeByIDRow{ID: "coord-1", LocationID: "loc-1"}, nil)
+			}
+			if tt.warn {
+				mockLogger.EXPECT().
+					Warn(gomock.Any(), "Op", gomock.Any(), gomock.Any()).
+					Times(1)
+			}
+
+			err := tt.mode.CheckCoordinatorLocation(
+				context.Background(), mockStore, mockLogger, "Op", "coord-1", tt.location,
+			)
+			if tt.want != nil {
+				assert.ErrorIs(t, err, tt.want)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+
+	t.Run("lookup_error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := dbmocks.NewMockStoreInterface(ctrl)
+		lookupErr := errors.New("db down")
+		mockStore.EXPECT().
+			GetEmployeeByID(gomock.Any(), "coord-1").
+			Return(db.GetEmployeeByIDRow{}, lookupErr)
+
+		err := ModeEnforce.CheckCoordinatorLocation(
+			context.Background(), mockStore, loggermocks.NewMockLogger(ctrl), "Op", "coord-1", "loc-1",
+		)
+		assert.ErrorIs(t, err, lookupErr)
+		assert.NotErrorIs(t, err, ErrCoordinatorLocationMismatch)
+	})
+}
@@ -0,0 +1,64 @@
+package assignment
+
+import (
+	db "care-cordination/lib/db/sqlc"
+	"care-cordination/lib/util"
+	"context"
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrCoordinatorAtCapacity is returned when the coordinator already holds
+	// as many active clients as their caseload cap allows
+	ErrCoordinatorAtCapacity = db.ErrCoordinatorAtCapacity
+	// ErrCoordinatorNotFound is returned when the coordinator being assigned
+	// does not exist
+	ErrCoordinatorNotFound = db.ErrCoordinatorNotFound
+	// ErrCaseloadOverrideForbidden is returned when someone other than an
+	// admin asks to assign past the caseload cap
+	ErrCaseloadOverrideForbidden = errors.New("only admins can override the caseload cap")
+)
+
+// CaseloadLimit is the number of active clients a coordinator may hold when
+// their employee record sets no max_caseload of its own; 0 means no cap
+type CaseloadLimit int32
+
+// PermissionChecker is the store method the caseload override needs
+type PermissionChecker interface {
+	HasPermission(ctx context.Context, arg db.HasPermissionParams) (bool, error)
+}
+
+// CaseloadCheck returns the check the assignment transaction runs so that
+// assigning clientID cannot take the coordinator past their cap. The client
+// itself is not counted, so moving a client the coordinator already holds
+// never trips the cap. With override set admins get no check (nil) and anyone
+// else gets ErrCaseloadOverrideForbidden.
+func (l CaseloadLimit) CaseloadCheck(
+	ctx context.Context,
+	store PermissionChecker,
+	coordinatorID string,
+	clientID string,
+	override bool,
+) (*db.CaseloadCheck, error) {
+	if override {
+		isAdmin, err := store.HasPermission(ctx, db.HasPermissionParams{
+			UserID:   util.GetUserID(ctx),
+			Resource: "admin",
+			Action:   "manage",
+		})
+		if err != nil {
+			return nil, fmt.Errorf("check admin permission: %w", err)
+		}
+		if !isAdmin {
+			return nil, ErrCaseloadOverrideForbidden
+		}
+		return nil, nil
+	}
+
+	return &db.CaseloadCheck{
+		CoordinatorID: coordinatorID,
+		ClientID:      clientID,
+		DefaultLimit:  int32(l),
+	}, nil
+}
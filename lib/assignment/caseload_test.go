@@ -0,0 +1,62 @@
+package assignment
+
+import (
+	db "care-cordination/lib/db/sqlc"
+	dbmocks "care-cordination/lib/db/sqlc/mocks"
+	"care-cordination/lib/util"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCaseloadCheck(t *testing.T) {
+	ctx := context.WithValue(context.Background(), util.UserIDKey, "user-1")
+	isAdmin := db.HasPermissionParams{UserID: "user-1", Resource: "admin", Action: "manage"}
+
+	t.Run("without_override", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		// The count happens in the assignment transaction, not here
+		mockStore := dbmocks.NewMockStoreInterface(ctrl)
+
+		check, err := CaseloadLimit(5).CaseloadCheck(ctx, mockStore, "coord-1", "client-1", false)
+		require.NoError(t, err)
+		assert.Equal(t, &db.CaseloadCheck{
+			CoordinatorID: "coord-1",
+			ClientID:      "client-1",
+			DefaultLimit:  5,
+		}, check)
+	})
+
+	t.Run("admin_override", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := dbmocks.NewMockStoreInterface(ctrl)
+		mockStore.EXPECT().HasPermission(gomock.Any(), isAdmin).Return(true, nil)
+
+		check, err := CaseloadLimit(5).CaseloadCheck(ctx, mockStore, "coord-1", "client-1", true)
+		require.NoError(t, err)
+		assert.Nil(t, check)
+	})
+
+	t.Run("override_not_admin", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := dbmocks.NewMockStoreInterface(ctrl)
+		mockStore.EXPECT().HasPermission(gomock.Any(), isAdmin).Return(false, nil)
+
+		_, err := CaseloadLimit(5).CaseloadCheck(ctx, mockStore, "coord-1", "client-1", true)
+		assert.ErrorIs(t, err, ErrCaseloadOverrideForbidden)
+	})
+
+	t.Run("permission_lookup_error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStore := dbmocks.NewMockStoreInterface(ctrl)
+		lookupErr := errors.New("db down")
+		mockStore.EXPECT().HasPermission(gomock.Any(), gomock.Any()).Return(false, lookupErr)
+
+		_, err := CaseloadLimit(5).CaseloadCheck(ctx, mockStore, "coord-1", "client-1", true)
+		assert.ErrorIs(t, err, lookupErr)
+	})
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: care-cordination/lib/audit (interfaces: AuditLogger)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/mock_audit_logger.go -package=mocks care-cordination/lib/audit AuditLogger
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	audit "care-cordination/lib/audit"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAuditLogger is a mock of AuditLogger interface.
+type MockAuditLogger struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditLoggerMockRecorder
+	isgomock struct{}
+}
+
+// MockAuditLoggerMockRecorder is the mock recorder for MockAuditLogger.
+type MockAuditLoggerMockRecorder struct {
+	mock *MockAuditLogger
+}
+
+// NewMockAuditLogger creates a new mock instance.
+func NewMockAuditLogger(ctrl *gomock.Controller) *MockAuditLogger {
+	mock := &MockAuditLogger{ctrl: ctrl}
+	mock.recorder = &MockAuditLoggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditLogger) EXPECT() *MockAuditLoggerMockRecorder {
+	return m.recorder
+}
+
+// LogEntry mocks base method.
+func (m *MockAuditLogger) LogEntry(ctx context.Context, entry audit.AuditEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogEntry", ctx, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LogEntry indicates an expected call of LogEntry.
+func (mr *MockAuditLoggerMockRecorder) LogEntry(ctx, entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogEntry", reflect.TypeOf((*MockAuditLogger)(nil).LogEntry), ctx, entry)
+}
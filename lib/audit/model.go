@@ -42,6 +42,8 @@ type AuditEntry struct {
 }
 
 // AuditLogger is an interface for logging audit entries
+//
+//go:generate mockgen -destination=mocks/mock_audit_logger.go -package=mocks care-cordination/lib/audit AuditLogger
 type AuditLogger interface {
 	LogEntry(ctx context.Context, entry AuditEntry) error
 }
@@ -75,7 +75,9 @@ func StrToPtr(s string) *string {
 	return &s
 }
 
-// Log creates a tamper-evident audit log entry
+// Log creates a tamper-evident audit log entry. A failed write is logged and
+// returned; callers that only observe may ignore it, but a change whose audit
+// record is required should not go ahead without one.
 func (s *AuditLoggerService) Log(ctx context.Context, entry AuditEntry) error {
 	// Lock to ensure hash chain integrity (entries must be sequential)
 	s.hashLock.Lock()
@@ -96,7 +98,7 @@ func (s *AuditLoggerService) Log(ctx context.Context, entry AuditEntry) error {
 			prevHash = GenesisHash
 		} else {
 			s.logger.Error(ctx, "AuditLoggerService.Log", "Failed to get latest audit log", zap.Error(err))
-			return nil // Don't break main flow
+			return err
 		}
 	} else {
 		prevHash = latestLog.CurrentHash
@@ -144,7 +146,7 @@ func (s *AuditLoggerService) Log(ctx context.Context, entry AuditEntry) error {
 
 	if err != nil {
 		s.logger.Error(ctx, "AuditLoggerService.Log", "Failed to create audit log", zap.Error(err))
-		return nil
+		return err
 	}
 
 	return nil
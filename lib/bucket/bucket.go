@@ -2,12 +2,26 @@ package bucket
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"io"
+	"net/url"
+	"time"
 
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
 )
 
+// ErrRetriesExhausted is returned when an object storage operation keeps failing
+// after all configured attempts.
+var ErrRetriesExhausted = errors.New("object storage unavailable: retries exhausted")
+
+const (
+	DefaultOperationTimeout = 30 * time.Second
+	DefaultMaxRetries       = 3
+	DefaultRetryBackoff     = 500 * time.Millisecond
+)
+
 type ObjectStorage interface {
 	UploadObject(
 		ctx context.Context,
@@ -15,17 +29,48 @@ type ObjectStorage interface {
 		file io.Reader,
 		contentType string,
 	) (string, error)
+	PresignGetObject(ctx context.Context, fileKey string, expiry time.Duration) (string, error)
+}
+
+// Options controls per-operation timeouts and the bounded retry applied to
+// every call against the object storage server.
+type Options struct {
+	OperationTimeout time.Duration // Timeout for a single attempt
+	MaxRetries       int           // Retries after the first attempt
+	RetryBackoff     time.Duration // Initial backoff, doubled after every failed attempt
+}
+
+// minioAPI is the subset of *minio.Client used by objectStorageClient.
+// It exists so tests can substitute a fake client.
+type minioAPI interface {
+	BucketExists(ctx context.Context, bucketName string) (bool, error)
+	MakeBucket(ctx context.Context, bucketName string, opts minio.MakeBucketOptions) error
+	PutObject(
+		ctx context.Context,
+		bucketName, objectName string,
+		reader io.Reader,
+		objectSize int64,
+		opts minio.PutObjectOptions,
+	) (minio.UploadInfo, error)
+	PresignedGetObject(
+		ctx context.Context,
+		bucketName, objectName string,
+		expires time.Duration,
+		reqParams url.Values,
+	) (*url.URL, error)
 }
 
 type objectStorageClient struct {
-	Client *minio.Client
+	Client minioAPI
 	name   string
+	opts   Options
 }
 
 func NewObjectStorageClient(
 	endpoint, accessKeyID, secretAccessKey string,
 	secure bool,
 	name string,
+	opts Options,
 ) (*objectStorageClient, error) {
 	client, err := minio.New(endpoint, &minio.Options{
 		Creds:  credentials.NewStaticV4(accessKeyID, secretAccessKey, ""),
@@ -34,24 +79,36 @@ func NewObjectStorageClient(
 	if err != nil {
 		return nil, err
 	}
-	return &objectStorageClient{Client: client, name: name}, nil
+	return newObjectStorageClient(client, name, opts), nil
+}
+
+func newObjectStorageClient(client minioAPI, name string, opts Options) *objectStorageClient {
+	if opts.OperationTimeout <= 0 {
+		opts.OperationTimeout = DefaultOperationTimeout
+	}
+	if opts.MaxRetries < 0 {
+		opts.MaxRetries = 0
+	}
+	if opts.RetryBackoff <= 0 {
+		opts.RetryBackoff = DefaultRetryBackoff
+	}
+	return &objectStorageClient{Client: client, name: name, opts: opts}
 }
 
 func (o *objectStorageClient) GetOrCreateBucket(ctx context.Context) error {
 	if o.name == "" {
 		return nil
 	}
-	exists, err := o.Client.BucketExists(ctx, o.name)
-	if err != nil {
-		return err
-	}
-	if !exists {
-		err = o.Client.MakeBucket(ctx, o.name, minio.MakeBucketOptions{})
+	return o.withRetry(ctx, func(ctx context.Context) error {
+		exists, err := o.Client.BucketExists(ctx, o.name)
 		if err != nil {
 			return err
 		}
-	}
-	return nil
+		if !exists {
+			return o.Client.MakeBucket(ctx, o.name, minio.MakeBucketOptions{})
+		}
+		return nil
+	})
 }
 
 func (o *objectStorageClient) UploadObject(
@@ -60,16 +117,105 @@ func (o *objectStorageClient) UploadObject(
 	file io.Reader,
 	contentType string,
 ) (string, error) {
-	uploadinfo, err := o.Client.PutObject(
-		ctx,
-		o.name,
-		fileKey,
-		file,
-		-1,
-		minio.PutObjectOptions{ContentType: contentType},
-	)
+	// A partially consumed reader cannot be replayed, so only retry uploads
+	// whose source can be rewound (multipart files and in-memory readers can).
+	seeker, canRewind := file.(io.Seeker)
+
+	var key string
+	attempt := 0
+	err := o.withRetry(ctx, func(ctx context.Context) error {
+		if attempt > 0 {
+			if !canRewind {
+				return errNotRetryable
+			}
+			if _, err := seeker.Seek(0, io.SeekStart); err != nil {
+				return err
+			}
+		}
+		attempt++
+
+		uploadinfo, err := o.Client.PutObject(
+			ctx,
+			o.name,
+			fileKey,
+			file,
+			-1,
+			minio.PutObjectOptions{ContentType: contentType},
+		)
+		if err != nil {
+			return err
+		}
+		key = uploadinfo.Key
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return key, nil
+}
+
+// PresignGetObject returns a time-limited download URL for the given object.
+func (o *objectStorageClient) PresignGetObject(
+	ctx context.Context,
+	fileKey string,
+	expiry time.Duration,
+) (string, error) {
+	var presigned string
+	err := o.withRetry(ctx, func(ctx context.Context) error {
+		u, err := o.Client.PresignedGetObject(ctx, o.name, fileKey, expiry, nil)
+		if err != nil {
+			return err
+		}
+		presigned = u.String()
+		return nil
+	})
 	if err != nil {
 		return "", err
 	}
-	return uploadinfo.Key, nil
+	return presigned, nil
+}
+
+// errNotRetryable stops the retry loop when an operation cannot be safely repeated.
+var errNotRetryable = errors.New("operation cannot be retried")
+
+// withRetry runs op with a per-attempt timeout, retrying with exponential backoff
+// until it succeeds, the retries are exhausted, or the parent context is done.
+// An operation that cannot be repeated fails with its own error rather than
+// ErrRetriesExhausted.
+func (o *objectStorageClient) withRetry(
+	ctx context.Context,
+	op func(ctx context.Context) error,
+) error {
+	backoff := o.opts.RetryBackoff
+	var lastErr error
+	attempts := 0
+
+	for attempt := 0; attempt <= o.opts.MaxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("%w: %v", ErrRetriesExhausted, ctx.Err())
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+		}
+
+		attemptCtx, cancel := context.WithTimeout(ctx, o.opts.OperationTimeout)
+		err := op(attemptCtx)
+		cancel()
+		if err == nil {
+			return nil
+		}
+		if errors.Is(err, errNotRetryable) {
+			// Only one attempt was possible, so its failure is the error to report
+			if lastErr == nil {
+				return err
+			}
+			return lastErr
+		}
+		attempts++
+		lastErr = err
+	}
+
+	return fmt.Errorf("%w after %d attempt(s): %v", ErrRetriesExhausted, attempts, lastErr)
 }
@@ -0,0 +1,177 @@
+package bucket
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================
+// Test Helpers
+// ============================================================
+
+// flakyMinio fails the first `failures` calls of every operation, then succeeds.
+type flakyMinio struct {
+	failures     int
+	putCalls     int
+	existsCalls  int
+	presignCalls int
+	uploaded     []byte
+}
+
+var errFlaky = errors.New("connection reset by peer")
+
+func (f *flakyMinio) BucketExists(ctx context.Context, bucketName string) (bool, error) {
+	f.existsCalls++
+	if f.existsCalls <= f.failures {
+		return false, errFlaky
+	}
+	return true, nil
+}
+
+func (f *flakyMinio) MakeBucket(ctx context.Context, bucketName string, opts minio.MakeBucketOptions) error {
+	return nil
+}
+
+func (f *flakyMinio) PutObject(
+	ctx context.Context,
+	bucketName, objectName string,
+	reader io.Reader,
+	objectSize int64,
+	opts minio.PutObjectOptions,
+) (minio.UploadInfo, error) {
+	f.putCalls++
+	data, _ := io.ReadAll(reader)
+	if f.putCalls <= f.failures {
+		return minio.UploadInfo{}, errFlaky
+	}
+	f.uploaded = data
+	return minio.UploadInfo{Key: objectName}, nil
+}
+
+func (f *flakyMinio) PresignedGetObject(
+	ctx context.Context,
+	bucketName, objectName string,
+	expires time.Duration,
+	reqParams url.Values,
+) (*url.URL, error) {
+	f.presignCalls++
+	if f.presignCalls <= f.failures {
+		return nil, errFlaky
+	}
+	return &url.URL{Scheme: "http", Host: "minio:9000", Path: "/" + bucketName + "/" + objectName}, nil
+}
+
+func testOptions(maxRetries int) Options {
+	return Options{
+		OperationTimeout: time.Second,
+		MaxRetries:       maxRetries,
+		RetryBackoff:     time.Millisecond,
+	}
+}
+
+// ============================================================
+// Test: Retry behaviour
+// ============================================================
+
+func TestUploadObjectRetry(t *testing.T) {
+	tests := []struct {
+		name         string
+		failures     int
+		maxRetries   int
+		reader       func() io.Reader
+		wantErr      error
+		wantPutCalls int
+	}{
+		{
+			name:         "recovers_after_one_failure",
+			failures:     1,
+			maxRetries:   3,
+			reader:       func() io.Reader { return bytes.NewReader([]byte("file-content")) },
+			wantPutCalls: 2,
+		},
+		{
+			name:         "exhausts_retries",
+			failures:     10,
+			maxRetries:   2,
+			reader:       func() io.Reader { return bytes.NewReader([]byte("file-content")) },
+			wantErr:      ErrRetriesExhausted,
+			wantPutCalls: 3,
+		},
+		{
+			name:         "non_seekable_reader_not_retried",
+			failures:     1,
+			maxRetries:   3,
+			reader:       func() io.Reader { return io.LimitReader(bytes.NewReader([]byte("file-content")), 100) },
+			wantErr:      errFlaky, // the upload's own failure, not an exhaustion
+			wantPutCalls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &flakyMinio{failures: tt.failures}
+			client := newObjectStorageClient(fake, "test-bucket", testOptions(tt.maxRetries))
+
+			key, err := client.UploadObject(context.Background(), "obj-1", tt.reader(), "text/plain")
+
+			assert.Equal(t, tt.wantPutCalls, fake.putCalls)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "obj-1", key)
+			// The retried upload must send the full content, not the remainder
+			assert.Equal(t, []byte("file-content"), fake.uploaded)
+		})
+	}
+}
+
+func TestGetOrCreateBucketRetry(t *testing.T) {
+	fake := &flakyMinio{failures: 1}
+	client := newObjectStorageClient(fake, "test-bucket", testOptions(3))
+
+	err := client.GetOrCreateBucket(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, fake.existsCalls)
+}
+
+func TestPresignGetObjectRetry(t *testing.T) {
+	fake := &flakyMinio{failures: 1}
+	client := newObjectStorageClient(fake, "test-bucket", testOptions(3))
+
+	presigned, err := client.PresignGetObject(context.Background(), "obj-1", time.Minute)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, fake.presignCalls)
+	assert.Contains(t, presigned, "/test-bucket/obj-1")
+}
+
+func TestWithRetryStopsOnContextCancel(t *testing.T) {
+	fake := &flakyMinio{failures: 10}
+	client := newObjectStorageClient(fake, "test-bucket", Options{
+		OperationTimeout: time.Second,
+		MaxRetries:       5,
+		RetryBackoff:     time.Hour,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := client.GetOrCreateBucket(ctx)
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrRetriesExhausted)
+	assert.Equal(t, 1, fake.existsCalls)
+}
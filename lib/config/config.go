@@ -2,14 +2,30 @@ package config
 
 import (
 	"errors"
+	"fmt"
+	"net"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 
+	"care-cordination/lib/assignment"
+	"care-cordination/lib/db/pool"
+	"care-cordination/lib/email"
+	"care-cordination/lib/middleware"
+	"care-cordination/lib/password"
+	"care-cordination/lib/util"
+
 	"github.com/joho/godotenv"
 )
 
 type Config struct {
 	DBSource           string
+	DBConnectAttempts  int
+	DBConnectBackoff   time.Duration
+	DBTxMaxRetries     int
+	DBTxRetryBackoff   time.Duration
+	DBStatementTimeout time.Duration
 	AccessTokenSecret  string
 	RefreshTokenSecret string
 	AccessTokenTTL     time.Duration
@@ -20,6 +36,15 @@ type Config struct {
 	Environment        string
 	ServerAddress      string
 	Url                string
+	// IANA timezone for date formatting and day and month boundaries; database
+	// sessions stay in UTC
+	AppTimezone string
+
+	// HTTP server timeouts; these bound slow or idle clients (slowloris)
+	ServerReadHeaderTimeout time.Duration
+	ServerReadTimeout       time.Duration
+	ServerWriteTimeout      time.Duration
+	ServerIdleTimeout       time.Duration
 
 	// Rate Limiting
 	RedisURL                  string
@@ -29,16 +54,129 @@ type Config struct {
 	LoginRateLimitPerEmail    int
 	LoginRateLimitWindowEmail time.Duration
 
+	// Account lockout after consecutive failed password attempts
+	LoginLockoutThreshold int
+	LoginLockoutDuration  time.Duration
+
+	// Password policy for new and changed passwords. PasswordCommonListFile
+	// replaces the built-in common-password list used by PasswordRejectCommon.
+	PasswordMinLength      int
+	PasswordRequireUpper   bool
+	PasswordRequireLower   bool
+	PasswordRequireDigit   bool
+	PasswordRequireSymbol  bool
+	PasswordRejectCommon   bool
+	PasswordCommonListFile string
+
 	// Object Storage (MinIO)
 	MinioEndpoint        string
 	MinioAccessKeyID     string
 	MinioSecretAccessKey string
 	MinioUseSSL          bool
 	MinioBucketName      string
+	MinioOpTimeout       time.Duration
+	MinioMaxRetries      int
+	MinioRetryBackoff    time.Duration
+
+	// Attachment uploads: MaxUploadSize caps every upload in bytes; UploadSizeLimits
+	// sets tighter caps per content type (UPLOAD_SIZE_LIMITS="image/png=5242880,...").
+	// MaxAttachmentsPerForm caps how many attachments one registration form links.
+	MaxUploadSize         int64
+	UploadSizeLimits      map[string]int64
+	MaxAttachmentsPerForm int
+
+	// Notification Worker: appointment reminders go out at each lead time before
+	// the start; evaluation reminders cover evaluations due within EvaluationDueSoonDays
+	AppointmentReminderLeadTimes []time.Duration
+	EvaluationDueSoonDays        int
+	// Evaluations due within EvaluationHighPriorityDays are sent as high priority
+	// reminders; the dashboard's due-soon classification uses the same thresholds
+	EvaluationHighPriorityDays int
+	// EvaluationIntervalWeeks spaces the next evaluation after one is completed
+	// for clients without an evaluation interval of their own
+	EvaluationIntervalWeeks int
+	// WorkerTickInterval is how often the worker runs; the API reports the worker
+	// unhealthy when its last heartbeat is older than twice this interval
+	WorkerTickInterval time.Duration
+	// Unresolved incidents open longer than their severity's SLA (in hours) are
+	// escalated once to the users with IncidentEscalationRole; 0 disables
+	// escalation for that severity
+	IncidentEscalationSevereHours   int
+	IncidentEscalationModerateHours int
+	IncidentEscalationMinorHours    int
+	IncidentEscalationRole          string
+
+	// Notification delivery: priority routing (see notification.ParseRoutingPolicy),
+	// digest flush interval and the SMTP server for the email channel
+	NotificationRouting        string
+	NotificationDigestInterval time.Duration
+	// NotificationBatchWindow coalesces WebSocket notifications to a connection
+	// arriving this close together into one notifications_batch frame; 0 is off
+	NotificationBatchWindow time.Duration
+	SMTPHost                string
+	SMTPPort                int
+	SMTPUsername            string
+	SMTPPassword            string
+	SMTPFrom                string
+	// Tries per notification email, doubling SMTPRetryBackoff between them,
+	// before it is moved to the dead-letter table
+	SMTPSendAttempts int
+	SMTPRetryBackoff time.Duration
+
+	// Feature flags are re-read from the database after this long
+	FeatureFlagCacheTTL time.Duration
 
 	// Admin Seeding
 	AdminEmail    string
 	AdminPassword string
+	// Name of the first organization, created by the admin bootstrap
+	AdminOrganizationName string
+
+	// IP Allowlist for sensitive routes (disabled when IPAllowlist is empty)
+	IPAllowlist       []string
+	IPAllowlistRoutes []string
+	// Proxies (CIDRs) whose X-Forwarded-For/X-Real-IP headers are honored by
+	// the allowlist and the rate limiter, and whose X-Forwarded-Proto decides HSTS
+	TrustedProxies []string
+
+	// Response compression
+	CompressionEnabled bool
+	CompressionMinSize int
+
+	// Security headers; disabled for local development when needed
+	SecurityHeadersEnabled bool
+	ContentSecurityPolicy  string
+	// HSTSMaxAge is sent in Strict-Transport-Security on TLS requests; 0 omits it
+	HSTSMaxAge time.Duration
+
+	// Dashboard
+	CareEndingSoonDays int
+	// Locations at or above this occupancy percentage are flagged as nearing capacity
+	CapacityWarningPercent int
+
+	// How a coordinator who works at a different location than their client
+	// is handled: "off", "warn" (log and allow) or "enforce" (reject)
+	CoordinatorLocationCheck assignment.Mode
+	// Active clients a coordinator may hold when their employee record sets
+	// no max_caseload of its own; 0 means no cap
+	DefaultMaxCaseload int
+
+	// List search terms shorter than this (after trimming) are rejected
+	SearchMinLength int
+
+	// Large free-text fields (notes, focus areas, closing reports) longer
+	// than this many characters are rejected
+	TextFieldMaxLength int
+
+	// Client exports running at once; further exports wait in a queue of
+	// ExportQueueSize and are turned away with 429 once it is full
+	ExportConcurrency int
+	ExportQueueSize   int
+
+	// Data retention: personal data of clients discharged more than this many
+	// months ago is purged by `admin purge-discharged`. 0 leaves it unset and
+	// the purge refuses to run.
+	ClientRetentionMonths int
 }
 
 func LoadConfig() (*Config, error) {
@@ -60,6 +198,40 @@ func LoadConfig() (*Config, error) {
 		}
 	}
 
+	// Parse HTTP server timeouts with defaults
+	serverReadHeaderTimeout := 5 * time.Second
+	if val := os.Getenv("SERVER_READ_HEADER_TIMEOUT"); val != "" {
+		if parsed, err := time.ParseDuration(val); err == nil {
+			serverReadHeaderTimeout = parsed
+		}
+	}
+
+	appTimezone := util.DefaultTimezone
+	if val := os.Getenv("APP_TIMEZONE"); val != "" {
+		appTimezone = val
+	}
+
+	serverReadTimeout := 5 * time.Second
+	if val := os.Getenv("SERVER_READ_TIMEOUT"); val != "" {
+		if parsed, err := time.ParseDuration(val); err == nil {
+			serverReadTimeout = parsed
+		}
+	}
+
+	serverWriteTimeout := 10 * time.Second
+	if val := os.Getenv("SERVER_WRITE_TIMEOUT"); val != "" {
+		if parsed, err := time.ParseDuration(val); err == nil {
+			serverWriteTimeout = parsed
+		}
+	}
+
+	serverIdleTimeout := 60 * time.Second
+	if val := os.Getenv("SERVER_IDLE_TIMEOUT"); val != "" {
+		if parsed, err := time.ParseDuration(val); err == nil {
+			serverIdleTimeout = parsed
+		}
+	}
+
 	// Parse rate limit windows with defaults
 	loginRateLimitWindowIP := 15 * time.Minute
 	if val := os.Getenv("LOGIN_RATE_LIMIT_WINDOW_IP"); val != "" {
@@ -90,6 +262,38 @@ func LoadConfig() (*Config, error) {
 		}
 	}
 
+	loginLockoutThreshold := 5
+	if val := os.Getenv("LOGIN_LOCKOUT_THRESHOLD"); val != "" {
+		if parsed, err := strconv.Atoi(val); err == nil {
+			loginLockoutThreshold = parsed
+		}
+	}
+
+	loginLockoutDuration := 15 * time.Minute
+	if val := os.Getenv("LOGIN_LOCKOUT_DURATION"); val != "" {
+		if parsed, err := time.ParseDuration(val); err == nil {
+			loginLockoutDuration = parsed
+		}
+	}
+
+	passwordMinLength := 12
+	if val := os.Getenv("PASSWORD_MIN_LENGTH"); val != "" {
+		if parsed, err := strconv.Atoi(val); err == nil {
+			passwordMinLength = parsed
+		}
+	}
+
+	passwordRequireUpper := os.Getenv("PASSWORD_REQUIRE_UPPER") != "false"
+	passwordRequireLower := os.Getenv("PASSWORD_REQUIRE_LOWER") != "false"
+	passwordRequireDigit := os.Getenv("PASSWORD_REQUIRE_DIGIT") != "false"
+	passwordRequireSymbol := os.Getenv("PASSWORD_REQUIRE_SYMBOL") == "true"
+	passwordRejectCommon := os.Getenv("PASSWORD_REJECT_COMMON") != "false"
+
+	adminOrganizationName := "Default Organization"
+	if val := os.Getenv("ADMIN_ORGANIZATION_NAME"); val != "" {
+		adminOrganizationName = val
+	}
+
 	rateLimitEnabled := true
 	if val := os.Getenv("RATE_LIMIT_ENABLED"); val == "false" {
 		rateLimitEnabled = false
@@ -100,8 +304,301 @@ func LoadConfig() (*Config, error) {
 		minioUseSSL = true
 	}
 
+	// Parse database connection retry settings with defaults
+	dbConnectAttempts := 10
+	if val := os.Getenv("DB_CONNECT_MAX_ATTEMPTS"); val != "" {
+		if parsed, err := strconv.Atoi(val); err == nil {
+			dbConnectAttempts = parsed
+		}
+	}
+
+	dbConnectBackoff := time.Second
+	if val := os.Getenv("DB_CONNECT_RETRY_BACKOFF"); val != "" {
+		if parsed, err := time.ParseDuration(val); err == nil {
+			dbConnectBackoff = parsed
+		}
+	}
+
+	// Parse transaction retry settings with defaults
+	dbTxMaxRetries := 3
+	if val := os.Getenv("DB_TX_MAX_RETRIES"); val != "" {
+		if parsed, err := strconv.Atoi(val); err == nil {
+			dbTxMaxRetries = parsed
+		}
+	}
+
+	dbTxRetryBackoff := 50 * time.Millisecond
+	if val := os.Getenv("DB_TX_RETRY_BACKOFF"); val != "" {
+		if parsed, err := time.ParseDuration(val); err == nil {
+			dbTxRetryBackoff = parsed
+		}
+	}
+
+	dbStatementTimeout := pool.DefaultStatementTimeout
+	if val := os.Getenv("DB_STATEMENT_TIMEOUT"); val != "" {
+		if parsed, err := time.ParseDuration(val); err == nil {
+			dbStatementTimeout = parsed
+		}
+	}
+
+	// Parse object storage timeout and retry settings with defaults
+	minioOpTimeout := 30 * time.Second
+	if val := os.Getenv("MINIO_OPERATION_TIMEOUT"); val != "" {
+		if parsed, err := time.ParseDuration(val); err == nil {
+			minioOpTimeout = parsed
+		}
+	}
+
+	minioMaxRetries := 3
+	if val := os.Getenv("MINIO_MAX_RETRIES"); val != "" {
+		if parsed, err := strconv.Atoi(val); err == nil {
+			minioMaxRetries = parsed
+		}
+	}
+
+	minioRetryBackoff := 500 * time.Millisecond
+	if val := os.Getenv("MINIO_RETRY_BACKOFF"); val != "" {
+		if parsed, err := time.ParseDuration(val); err == nil {
+			minioRetryBackoff = parsed
+		}
+	}
+
+	maxUploadSize := int64(10 << 20)
+	if val := os.Getenv("MAX_UPLOAD_SIZE"); val != "" {
+		if parsed, err := strconv.ParseInt(val, 10, 64); err == nil {
+			maxUploadSize = parsed
+		}
+	}
+
+	maxAttachmentsPerForm := util.DefaultMaxAttachmentsPerForm
+	if val := os.Getenv("MAX_ATTACHMENTS_PER_FORM"); val != "" {
+		if parsed, err := strconv.Atoi(val); err == nil {
+			maxAttachmentsPerForm = parsed
+		}
+	}
+
+	uploadSizeLimits, err := parseSizeLimits(os.Getenv("UPLOAD_SIZE_LIMITS"))
+	if err != nil {
+		return nil, fmt.Errorf("UPLOAD_SIZE_LIMITS: %w", err)
+	}
+
+	// Parse appointment reminder lead times (comma-separated durations)
+	appointmentReminderLeadTimes := []time.Duration{24 * time.Hour, time.Hour}
+	if val := os.Getenv("APPOINTMENT_REMINDER_LEAD_TIMES"); val != "" {
+		if parsed, err := parseDurationList(val); err == nil {
+			appointmentReminderLeadTimes = parsed
+		}
+	}
+
+	evaluationDueSoonDays := 3
+	if val := os.Getenv("EVALUATION_DUE_SOON_DAYS"); val != "" {
+		if parsed, err := strconv.Atoi(val); err == nil {
+			evaluationDueSoonDays = parsed
+		}
+	}
+
+	evaluationHighPriorityDays := 1
+	if val := os.Getenv("EVALUATION_HIGH_PRIORITY_DAYS"); val != "" {
+		if parsed, err := strconv.Atoi(val); err == nil {
+			evaluationHighPriorityDays = parsed
+		}
+	}
+
+	evaluationIntervalWeeks := 5
+	if val := os.Getenv("EVALUATION_INTERVAL_WEEKS"); val != "" {
+		if parsed, err := strconv.Atoi(val); err == nil && parsed > 0 {
+			evaluationIntervalWeeks = parsed
+		}
+	}
+
+	workerTickInterval := 5 * time.Minute
+	if val := os.Getenv("WORKER_TICK_INTERVAL"); val != "" {
+		if parsed, err := time.ParseDuration(val); err == nil {
+			workerTickInterval = parsed
+		}
+	}
+
+	incidentEscalationSevereHours := 24
+	if val := os.Getenv("INCIDENT_ESCALATION_SEVERE_HOURS"); val != "" {
+		if parsed, err := strconv.Atoi(val); err == nil {
+			incidentEscalationSevereHours = parsed
+		}
+	}
+
+	incidentEscalationModerateHours := 72
+	if val := os.Getenv("INCIDENT_ESCALATION_MODERATE_HOURS"); val != "" {
+		if parsed, err := strconv.Atoi(val); err == nil {
+			incidentEscalationModerateHours = parsed
+		}
+	}
+
+	incidentEscalationMinorHours := 0
+	if val := os.Getenv("INCIDENT_ESCALATION_MINOR_HOURS"); val != "" {
+		if parsed, err := strconv.Atoi(val); err == nil {
+			incidentEscalationMinorHours = parsed
+		}
+	}
+
+	incidentEscalationRole := "manager"
+	if val := os.Getenv("INCIDENT_ESCALATION_ROLE"); val != "" {
+		incidentEscalationRole = val
+	}
+
+	notificationDigestInterval := time.Hour
+	if val := os.Getenv("NOTIFICATION_DIGEST_INTERVAL"); val != "" {
+		if parsed, err := time.ParseDuration(val); err == nil {
+			notificationDigestInterval = parsed
+		}
+	}
+
+	notificationBatchWindow := time.Duration(0)
+	if val := os.Getenv("NOTIFICATION_BATCH_WINDOW"); val != "" {
+		if parsed, err := time.ParseDuration(val); err == nil {
+			notificationBatchWindow = parsed
+		}
+	}
+
+	smtpPort := 587
+	if val := os.Getenv("SMTP_PORT"); val != "" {
+		if parsed, err := strconv.Atoi(val); err == nil {
+			smtpPort = parsed
+		}
+	}
+
+	defaultSMTPRetry := email.DefaultRetryPolicy()
+	smtpSendAttempts := defaultSMTPRetry.Attempts
+	if val := os.Getenv("SMTP_SEND_ATTEMPTS"); val != "" {
+		if parsed, err := strconv.Atoi(val); err == nil {
+			smtpSendAttempts = parsed
+		}
+	}
+
+	smtpRetryBackoff := defaultSMTPRetry.Backoff
+	if val := os.Getenv("SMTP_RETRY_BACKOFF"); val != "" {
+		if parsed, err := time.ParseDuration(val); err == nil {
+			smtpRetryBackoff = parsed
+		}
+	}
+
+	featureFlagCacheTTL := time.Minute
+	if val := os.Getenv("FEATURE_FLAG_CACHE_TTL"); val != "" {
+		if parsed, err := time.ParseDuration(val); err == nil {
+			featureFlagCacheTTL = parsed
+		}
+	}
+
+	// Parse IP allowlist settings (comma-separated lists)
+	ipAllowlistRoutes := []string{"POST /admin", "PUT /admin", "DELETE /admin"}
+	if val := os.Getenv("IP_ALLOWLIST_ROUTES"); val != "" {
+		ipAllowlistRoutes = parseList(val)
+	}
+
+	// Parse response compression settings
+	compressionEnabled := true
+	if val := os.Getenv("COMPRESSION_ENABLED"); val == "false" {
+		compressionEnabled = false
+	}
+
+	compressionMinSize := middleware.DefaultGzipMinSize
+	if val := os.Getenv("COMPRESSION_MIN_SIZE"); val != "" {
+		if parsed, err := strconv.Atoi(val); err == nil {
+			compressionMinSize = parsed
+		}
+	}
+
+	// Parse security header settings
+	securityHeadersEnabled := true
+	if val := os.Getenv("SECURITY_HEADERS_ENABLED"); val == "false" {
+		securityHeadersEnabled = false
+	}
+
+	contentSecurityPolicy := middleware.DefaultContentSecurityPolicy
+	if val, ok := os.LookupEnv("CONTENT_SECURITY_POLICY"); ok {
+		contentSecurityPolicy = val
+	}
+
+	hstsMaxAge := 365 * 24 * time.Hour
+	if val := os.Getenv("HSTS_MAX_AGE"); val != "" {
+		if parsed, err := time.ParseDuration(val); err == nil {
+			hstsMaxAge = parsed
+		}
+	}
+
+	// Parse dashboard settings
+	careEndingSoonDays := 30
+	if val := os.Getenv("CARE_ENDING_SOON_DAYS"); val != "" {
+		if parsed, err := strconv.Atoi(val); err == nil {
+			careEndingSoonDays = parsed
+		}
+	}
+
+	capacityWarningPercent := 90
+	if val := os.Getenv("CAPACITY_WARNING_PERCENT"); val != "" {
+		if parsed, err := strconv.Atoi(val); err == nil {
+			capacityWarningPercent = parsed
+		}
+	}
+
+	coordinatorLocationCheck := assignment.ModeWarn
+	if val := os.Getenv("COORDINATOR_LOCATION_CHECK"); val != "" {
+		coordinatorLocationCheck = assignment.Mode(val)
+	}
+
+	defaultMaxCaseload := 0
+	if val := os.Getenv("DEFAULT_MAX_CASELOAD"); val != "" {
+		if parsed, err := strconv.Atoi(val); err == nil {
+			defaultMaxCaseload = parsed
+		}
+	}
+
+	// Parse search settings
+	searchMinLength := 2
+	if val := os.Getenv("SEARCH_MIN_LENGTH"); val != "" {
+		if parsed, err := strconv.Atoi(val); err == nil {
+			searchMinLength = parsed
+		}
+	}
+
+	// Parse free-text limits
+	textFieldMaxLength := util.DefaultTextFieldLength
+	if val := os.Getenv("TEXT_FIELD_MAX_LENGTH"); val != "" {
+		if parsed, err := strconv.Atoi(val); err == nil {
+			textFieldMaxLength = parsed
+		}
+	}
+
+	// Parse export limits
+	exportConcurrency := 2
+	if val := os.Getenv("EXPORT_CONCURRENCY"); val != "" {
+		if parsed, err := strconv.Atoi(val); err == nil {
+			exportConcurrency = parsed
+		}
+	}
+
+	exportQueueSize := 4
+	if val := os.Getenv("EXPORT_QUEUE_SIZE"); val != "" {
+		if parsed, err := strconv.Atoi(val); err == nil {
+			exportQueueSize = parsed
+		}
+	}
+
+	// Parse data retention settings; there is deliberately no default
+	clientRetentionMonths := 0
+	if val := os.Getenv("CLIENT_RETENTION_MONTHS"); val != "" {
+		parsed, err := strconv.Atoi(val)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CLIENT_RETENTION_MONTHS: %w", err)
+		}
+		clientRetentionMonths = parsed
+	}
+
 	config := &Config{
 		DBSource:           os.Getenv("DB_SOURCE"),
+		DBConnectAttempts:  dbConnectAttempts,
+		DBConnectBackoff:   dbConnectBackoff,
+		DBTxMaxRetries:     dbTxMaxRetries,
+		DBTxRetryBackoff:   dbTxRetryBackoff,
+		DBStatementTimeout: dbStatementTimeout,
 		AccessTokenSecret:  os.Getenv("ACCESS_TOKEN_SECRET"),
 		RefreshTokenSecret: os.Getenv("REFRESH_TOKEN_SECRET"),
 		AccessTokenTTL:     accessTokenTTL,
@@ -112,6 +609,13 @@ func LoadConfig() (*Config, error) {
 		Environment:        os.Getenv("ENVIRONMENT"),
 		ServerAddress:      os.Getenv("SERVER_ADDRESS"),
 		Url:                os.Getenv("URL"),
+		AppTimezone:        appTimezone,
+
+		// HTTP server timeouts
+		ServerReadHeaderTimeout: serverReadHeaderTimeout,
+		ServerReadTimeout:       serverReadTimeout,
+		ServerWriteTimeout:      serverWriteTimeout,
+		ServerIdleTimeout:       serverIdleTimeout,
 
 		// Rate Limiting
 		RedisURL:                  os.Getenv("REDIS_URL"),
@@ -121,16 +625,101 @@ func LoadConfig() (*Config, error) {
 		LoginRateLimitPerEmail:    loginRateLimitPerEmail,
 		LoginRateLimitWindowEmail: loginRateLimitWindowEmail,
 
+		// Account lockout
+		LoginLockoutThreshold: loginLockoutThreshold,
+		LoginLockoutDuration:  loginLockoutDuration,
+
+		// Password policy
+		PasswordMinLength:      passwordMinLength,
+		PasswordRequireUpper:   passwordRequireUpper,
+		PasswordRequireLower:   passwordRequireLower,
+		PasswordRequireDigit:   passwordRequireDigit,
+		PasswordRequireSymbol:  passwordRequireSymbol,
+		PasswordRejectCommon:   passwordRejectCommon,
+		PasswordCommonListFile: os.Getenv("PASSWORD_COMMON_LIST_FILE"),
+
 		// Object Storage
 		MinioEndpoint:        os.Getenv("MINIO_ENDPOINT"),
 		MinioAccessKeyID:     os.Getenv("MINIO_ACCESS_KEY_ID"),
 		MinioSecretAccessKey: os.Getenv("MINIO_SECRET_ACCESS_KEY"),
 		MinioUseSSL:          minioUseSSL,
 		MinioBucketName:      os.Getenv("MINIO_BUCKET_NAME"),
+		MinioOpTimeout:       minioOpTimeout,
+		MinioMaxRetries:      minioMaxRetries,
+		MinioRetryBackoff:    minioRetryBackoff,
+
+		// Attachment uploads
+		MaxUploadSize:         maxUploadSize,
+		UploadSizeLimits:      uploadSizeLimits,
+		MaxAttachmentsPerForm: maxAttachmentsPerForm,
+
+		// Notification Worker
+		AppointmentReminderLeadTimes: appointmentReminderLeadTimes,
+		EvaluationDueSoonDays:        evaluationDueSoonDays,
+		EvaluationHighPriorityDays:   evaluationHighPriorityDays,
+		EvaluationIntervalWeeks:      evaluationIntervalWeeks,
+		WorkerTickInterval:           workerTickInterval,
+
+		// Incident escalation
+		IncidentEscalationSevereHours:   incidentEscalationSevereHours,
+		IncidentEscalationModerateHours: incidentEscalationModerateHours,
+		IncidentEscalationMinorHours:    incidentEscalationMinorHours,
+		IncidentEscalationRole:          incidentEscalationRole,
+
+		// Notification delivery
+		NotificationRouting:        os.Getenv("NOTIFICATION_ROUTING"),
+		NotificationDigestInterval: notificationDigestInterval,
+		NotificationBatchWindow:    notificationBatchWindow,
+		SMTPHost:                   os.Getenv("SMTP_HOST"),
+		SMTPPort:                   smtpPort,
+		SMTPUsername:               os.Getenv("SMTP_USERNAME"),
+		SMTPPassword:               os.Getenv("SMTP_PASSWORD"),
+		SMTPFrom:                   os.Getenv("SMTP_FROM"),
+		SMTPSendAttempts:           smtpSendAttempts,
+		SMTPRetryBackoff:           smtpRetryBackoff,
+
+		// Feature flags
+		FeatureFlagCacheTTL: featureFlagCacheTTL,
 
 		// Admin Seeding
-		AdminEmail:    os.Getenv("ADMIN_EMAIL"),
-		AdminPassword: os.Getenv("ADMIN_PASSWORD"),
+		AdminEmail:            os.Getenv("ADMIN_EMAIL"),
+		AdminPassword:         os.Getenv("ADMIN_PASSWORD"),
+		AdminOrganizationName: adminOrganizationName,
+
+		// IP Allowlist
+		IPAllowlist:       parseList(os.Getenv("IP_ALLOWLIST")),
+		IPAllowlistRoutes: ipAllowlistRoutes,
+		TrustedProxies:    parseList(os.Getenv("TRUSTED_PROXIES")),
+
+		// Response compression
+		CompressionEnabled: compressionEnabled,
+		CompressionMinSize: compressionMinSize,
+
+		// Security headers
+		SecurityHeadersEnabled: securityHeadersEnabled,
+		ContentSecurityPolicy:  contentSecurityPolicy,
+		HSTSMaxAge:             hstsMaxAge,
+
+		// Dashboard
+		CareEndingSoonDays:     careEndingSoonDays,
+		CapacityWarningPercent: capacityWarningPercent,
+
+		// Client assignment
+		CoordinatorLocationCheck: coordinatorLocationCheck,
+		DefaultMaxCaseload:       defaultMaxCaseload,
+
+		// Search
+		SearchMinLength: searchMinLength,
+
+		// Free-text limits
+		TextFieldMaxLength: textFieldMaxLength,
+
+		// Exports
+		ExportConcurrency: exportConcurrency,
+		ExportQueueSize:   exportQueueSize,
+
+		// Data retention
+		ClientRetentionMonths: clientRetentionMonths,
 	}
 
 	if err := config.validate(); err != nil {
@@ -140,10 +729,39 @@ func LoadConfig() (*Config, error) {
 	return config, nil
 }
 
+// PasswordPolicy builds the configured password policy, shared by the API and
+// the admin bootstrap so both enforce the same rules
+func (c *Config) PasswordPolicy() (password.Policy, error) {
+	policy := password.Policy{
+		MinLength:     c.PasswordMinLength,
+		RequireUpper:  c.PasswordRequireUpper,
+		RequireLower:  c.PasswordRequireLower,
+		RequireDigit:  c.PasswordRequireDigit,
+		RequireSymbol: c.PasswordRequireSymbol,
+	}
+	if c.PasswordRejectCommon {
+		common, err := password.LoadCommonList(c.PasswordCommonListFile)
+		if err != nil {
+			return password.Policy{}, fmt.Errorf("cannot load common password list: %w", err)
+		}
+		policy.Common = common
+	}
+	return policy, nil
+}
+
 func (c *Config) validate() error {
 	if c.DBSource == "" {
 		return errors.New("DB_SOURCE is not set")
 	}
+	if c.DBConnectAttempts < 1 {
+		return errors.New("DB_CONNECT_MAX_ATTEMPTS must be at least 1")
+	}
+	if c.DBTxMaxRetries < 0 {
+		return errors.New("DB_TX_MAX_RETRIES must not be negative")
+	}
+	if c.DBStatementTimeout < 0 {
+		return errors.New("DB_STATEMENT_TIMEOUT must not be negative")
+	}
 	if c.AccessTokenSecret == "" {
 		return errors.New("ACCESS_TOKEN_SECRET is not set")
 	}
@@ -166,10 +784,38 @@ func (c *Config) validate() error {
 		return errors.New("MFA_ISSUER is not set")
 	}
 
+	if c.ServerReadHeaderTimeout <= 0 {
+		return errors.New("SERVER_READ_HEADER_TIMEOUT must be positive")
+	}
+	if c.ServerReadTimeout <= 0 {
+		return errors.New("SERVER_READ_TIMEOUT must be positive")
+	}
+	if c.ServerWriteTimeout <= 0 {
+		return errors.New("SERVER_WRITE_TIMEOUT must be positive")
+	}
+	if c.ServerIdleTimeout <= 0 {
+		return errors.New("SERVER_IDLE_TIMEOUT must be positive")
+	}
+	if _, err := time.LoadLocation(c.AppTimezone); err != nil {
+		return errors.New("APP_TIMEZONE must be a valid IANA timezone, e.g. Europe/Amsterdam")
+	}
+
 	// Rate limiting validation (only if enabled)
 	if c.RateLimitEnabled && c.RedisURL == "" {
 		return errors.New("REDIS_URL is required when rate limiting is enabled")
 	}
+	if c.LoginLockoutThreshold < 1 {
+		return errors.New("LOGIN_LOCKOUT_THRESHOLD must be at least 1")
+	}
+	if c.LoginLockoutDuration < time.Second {
+		return errors.New("LOGIN_LOCKOUT_DURATION must be at least 1s")
+	}
+	if c.PasswordMinLength < 8 {
+		return errors.New("PASSWORD_MIN_LENGTH must be at least 8")
+	}
+	if c.PasswordCommonListFile != "" && !c.PasswordRejectCommon {
+		return errors.New("PASSWORD_COMMON_LIST_FILE is set but PASSWORD_REJECT_COMMON is false")
+	}
 
 	// Object Storage validation
 	if c.MinioEndpoint == "" {
@@ -184,6 +830,160 @@ func (c *Config) validate() error {
 	if c.MinioBucketName == "" {
 		return errors.New("MINIO_BUCKET_NAME is not set")
 	}
+	if c.MinioMaxRetries < 0 {
+		return errors.New("MINIO_MAX_RETRIES must not be negative")
+	}
+	if c.MaxUploadSize < 1 {
+		return errors.New("MAX_UPLOAD_SIZE must be at least 1 byte")
+	}
+	for contentType, limit := range c.UploadSizeLimits {
+		if limit < 1 || limit > c.MaxUploadSize {
+			return fmt.Errorf("UPLOAD_SIZE_LIMITS for %q must be between 1 and MAX_UPLOAD_SIZE", contentType)
+		}
+	}
+	if c.MaxAttachmentsPerForm < 1 {
+		return errors.New("MAX_ATTACHMENTS_PER_FORM must be at least 1")
+	}
+	if len(c.AppointmentReminderLeadTimes) == 0 {
+		return errors.New("APPOINTMENT_REMINDER_LEAD_TIMES must contain at least one duration")
+	}
+	if c.EvaluationDueSoonDays < 1 {
+		return errors.New("EVALUATION_DUE_SOON_DAYS must be at least 1")
+	}
+	if c.EvaluationHighPriorityDays < 0 || c.EvaluationHighPriorityDays > c.EvaluationDueSoonDays {
+		return errors.New("EVALUATION_HIGH_PRIORITY_DAYS must be between 0 and EVALUATION_DUE_SOON_DAYS")
+	}
+	if c.WorkerTickInterval <= 0 {
+		return errors.New("WORKER_TICK_INTERVAL must be positive")
+	}
+	if c.IncidentEscalationSevereHours < 0 || c.IncidentEscalationModerateHours < 0 || c.IncidentEscalationMinorHours < 0 {
+		return errors.New("INCIDENT_ESCALATION_*_HOURS must not be negative")
+	}
+	if c.NotificationDigestInterval <= 0 {
+		return errors.New("NOTIFICATION_DIGEST_INTERVAL must be positive")
+	}
+	if c.NotificationBatchWindow < 0 {
+		return errors.New("NOTIFICATION_BATCH_WINDOW must not be negative")
+	}
+	if c.SMTPHost != "" && c.SMTPFrom == "" {
+		return errors.New("SMTP_FROM is required when SMTP_HOST is set")
+	}
+	if c.SMTPSendAttempts < 1 {
+		return errors.New("SMTP_SEND_ATTEMPTS must be at least 1")
+	}
+	if c.SMTPRetryBackoff <= 0 {
+		return errors.New("SMTP_RETRY_BACKOFF must be positive")
+	}
+	if c.FeatureFlagCacheTTL <= 0 {
+		return errors.New("FEATURE_FLAG_CACHE_TTL must be positive")
+	}
+
+	// IP allowlist validation
+	for _, cidr := range c.IPAllowlist {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return fmt.Errorf("IP_ALLOWLIST contains invalid CIDR %q", cidr)
+		}
+	}
+	for _, cidr := range c.TrustedProxies {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return fmt.Errorf("TRUSTED_PROXIES contains invalid CIDR %q", cidr)
+		}
+	}
+
+	if c.CompressionMinSize < 0 {
+		return errors.New("COMPRESSION_MIN_SIZE must not be negative")
+	}
+
+	if c.HSTSMaxAge < 0 {
+		return errors.New("HSTS_MAX_AGE must not be negative")
+	}
+
+	if c.CareEndingSoonDays < 1 {
+		return errors.New("CARE_ENDING_SOON_DAYS must be at least 1")
+	}
+	if c.CapacityWarningPercent < 1 || c.CapacityWarningPercent > 100 {
+		return errors.New("CAPACITY_WARNING_PERCENT must be between 1 and 100")
+	}
+
+	if !c.CoordinatorLocationCheck.Valid() {
+		return errors.New("COORDINATOR_LOCATION_CHECK must be off, warn or enforce")
+	}
+
+	if c.DefaultMaxCaseload < 0 {
+		return errors.New("DEFAULT_MAX_CASELOAD must not be negative")
+	}
+
+	if c.SearchMinLength < 1 {
+		return errors.New("SEARCH_MIN_LENGTH must be at least 1")
+	}
+
+	if c.TextFieldMaxLength < 1 || c.TextFieldMaxLength > util.MaxTextFieldLength {
+		return fmt.Errorf(
+			"TEXT_FIELD_MAX_LENGTH must be between 1 and %d", util.MaxTextFieldLength,
+		)
+	}
+
+	if c.ExportConcurrency < 1 {
+		return errors.New("EXPORT_CONCURRENCY must be at least 1")
+	}
+	if c.ExportQueueSize < 0 {
+		return errors.New("EXPORT_QUEUE_SIZE must not be negative")
+	}
+
+	if c.ClientRetentionMonths < 0 {
+		return errors.New("CLIENT_RETENTION_MONTHS must not be negative")
+	}
 
 	return nil
 }
+
+// parseDurationList parses a comma-separated list of positive durations, e.g. "24h,1h".
+func parseDurationList(val string) ([]time.Duration, error) {
+	var durations []time.Duration
+	for _, part := range strings.Split(val, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		d, err := time.ParseDuration(part)
+		if err != nil {
+			return nil, err
+		}
+		if d <= 0 {
+			return nil, errors.New("duration must be positive")
+		}
+		durations = append(durations, d)
+	}
+	if len(durations) == 0 {
+		return nil, errors.New("no durations given")
+	}
+	return durations, nil
+}
+
+// parseList splits a comma-separated value into trimmed, non-empty entries.
+func parseList(val string) []string {
+	var items []string
+	for _, part := range strings.Split(val, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			items = append(items, part)
+		}
+	}
+	return items
+}
+
+// parseSizeLimits parses comma-separated content-type=bytes pairs, e.g. "image/png=5242880".
+func parseSizeLimits(val string) (map[string]int64, error) {
+	limits := map[string]int64{}
+	for _, part := range parseList(val) {
+		contentType, size, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("%q is not content-type=bytes", part)
+		}
+		limit, err := strconv.ParseInt(strings.TrimSpace(size), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q has an invalid size", part)
+		}
+		limits[strings.ToLower(strings.TrimSpace(contentType))] = limit
+	}
+	return limits, nil
+}
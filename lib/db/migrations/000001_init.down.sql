@@ -2,6 +2,8 @@
 -- Most dependent tables first, then their dependencies
 
 -- First, drop all RLS policies that depend on user_roles
+DROP POLICY IF EXISTS coordinator_evaluation_records ON evaluations;
+DROP POLICY IF EXISTS admin_all_evaluation_records ON evaluations;
 DROP POLICY IF EXISTS coordinator_progress_logs ON goal_progress_logs;
 DROP POLICY IF EXISTS admin_all_progress_logs ON goal_progress_logs;
 DROP POLICY IF EXISTS coordinator_evaluations ON client_evaluations;
@@ -22,10 +24,19 @@ DROP POLICY IF EXISTS user_own_reminders ON reminders;
 -- Drop notification RLS policy
 DROP POLICY IF EXISTS user_own_notifications ON notifications;
 
+-- Drop worker heartbeats and leases
+DROP TABLE IF EXISTS worker_leases;
+DROP TABLE IF EXISTS worker_heartbeats;
+
+-- Drop feature flags
+DROP TABLE IF EXISTS feature_flags;
+
 -- Drop audit logs (must be before clients, employees, users)
 DROP TABLE IF EXISTS audit_logs;
 
 -- Drop notifications table
+DROP TABLE IF EXISTS notification_dead_letters;
+DROP TABLE IF EXISTS notification_quiet_hours;
 DROP TABLE IF EXISTS notifications;
 DROP TYPE IF EXISTS notification_priority_enum;
 DROP TYPE IF EXISTS notification_type_enum;
@@ -45,24 +56,39 @@ DROP TYPE IF EXISTS appointment_type_enum;
 DROP TYPE IF EXISTS appointment_status_enum;
 
 DROP TABLE IF EXISTS goal_progress_logs;
+DROP TABLE IF EXISTS evaluations;
 DROP TABLE IF EXISTS client_evaluations;
 
 DROP TABLE IF EXISTS client_goals;
 DROP TABLE IF EXISTS incidents;
+DROP TABLE IF EXISTS client_pii_purges;
+DROP TABLE IF EXISTS client_notes;
+DROP TABLE IF EXISTS client_assignment_history;
 DROP TABLE IF EXISTS client_location_transfers;
 DROP TABLE IF EXISTS clients;
+DROP TABLE IF EXISTS coordinator_availability;
+DROP TABLE IF EXISTS intake_required_documents;
+DROP TABLE IF EXISTS intake_document_requirements;
+DROP TABLE IF EXISTS intake_reschedule_history;
 DROP TABLE IF EXISTS intake_forms;
+DROP TABLE IF EXISTS registration_status_history;
+DROP TABLE IF EXISTS attachment_access_log;
+DROP TABLE IF EXISTS registration_form_attachments;
 DROP TABLE IF EXISTS registration_forms;
 DROP TABLE IF EXISTS employees;
 DROP TABLE IF EXISTS sessions;
 DROP TABLE IF EXISTS referring_orgs;
+DROP TABLE IF EXISTS location_care_types;
+DROP TABLE IF EXISTS location_capacity_snapshots;
 DROP TABLE IF EXISTS locations;
-DROP TABLE IF EXISTS users;
 DROP TABLE IF EXISTS attachments;
+DROP TABLE IF EXISTS users;
+DROP TABLE IF EXISTS organizations;
 
 -- Drop enums
 DROP TYPE IF EXISTS audit_status_enum CASCADE;
 DROP TYPE IF EXISTS audit_action_enum CASCADE;
+DROP TYPE IF EXISTS incident_category_enum CASCADE;
 DROP TYPE IF EXISTS incident_severity_enum CASCADE;
 DROP TYPE IF EXISTS incident_type_enum CASCADE;
 DROP TYPE IF EXISTS incident_status_enum CASCADE;
@@ -71,6 +97,8 @@ DROP TYPE IF EXISTS discharge_status_enum CASCADE;
 DROP TYPE IF EXISTS discharge_reason_enum CASCADE;
 DROP TYPE IF EXISTS waiting_list_priority_enum CASCADE;
 DROP TYPE IF EXISTS client_status_enum CASCADE;
+DROP TYPE IF EXISTS intake_document_status_enum CASCADE;
+DROP TYPE IF EXISTS intake_document_type_enum CASCADE;
 DROP TYPE IF EXISTS intake_status_enum CASCADE;
 DROP TYPE IF EXISTS registration_status_enum CASCADE;
 DROP TYPE IF EXISTS care_type_enum CASCADE;
@@ -78,3 +106,4 @@ DROP TYPE IF EXISTS gender_enum CASCADE;
 DROP TYPE IF EXISTS location_transfer_status_enum CASCADE;
 DROP TYPE IF EXISTS contract_type_enum CASCADE;
 DROP TYPE IF EXISTS evaluation_status_enum CASCADE;
+DROP TYPE IF EXISTS evaluation_outcome_enum CASCADE;
@@ -1,14 +1,15 @@
-CREATE TABLE attachments (
-    id TEXT PRIMARY KEY,
-    filekey TEXT NOT NULL,
-    content_type TEXT NOT NULL,
-    uploaded_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
-);
-
 CREATE TYPE gender_enum AS ENUM ('male', 'female', 'other');
 CREATE TYPE contract_type_enum AS ENUM ('self_employed', 'payroll_service');
 
 
+-- Tenants. Every user, employee, location and client belongs to one
+-- organization; reads go through organization-scoped queries.
+CREATE TABLE organizations (
+    id TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
 CREATE TABLE users (
     id TEXT PRIMARY KEY,
     email TEXT UNIQUE NOT NULL,
@@ -16,8 +17,22 @@ CREATE TABLE users (
     is_mfa_enabled BOOLEAN NOT NULL DEFAULT FALSE,
     mfa_secret TEXT,
     mfa_backup_codes TEXT,
+    failed_login_attempts INT NOT NULL DEFAULT 0,
+    locked_until TIMESTAMP WITH TIME ZONE,
+    last_login_at TIMESTAMP WITH TIME ZONE,
     created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
-    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    organization_id TEXT REFERENCES organizations(id)
+);
+
+CREATE TABLE attachments (
+    id TEXT PRIMARY KEY,
+    filekey TEXT NOT NULL,
+    content_type TEXT NOT NULL,
+    uploaded_by TEXT REFERENCES users(id) ON DELETE SET NULL,
+    uploaded_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    -- Name of the file as uploaded, for display only; the object is stored under filekey
+    filename TEXT
 );
 
 CREATE TABLE roles (
@@ -79,7 +94,21 @@ CREATE TABLE locations (
     occupied INTEGER NOT NULL DEFAULT 0,
     created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
-    is_deleted BOOLEAN DEFAULT FALSE
+    is_deleted BOOLEAN DEFAULT FALSE,
+    organization_id TEXT REFERENCES organizations(id)
+);
+
+CREATE INDEX idx_locations_organization ON locations(organization_id);
+
+-- One row per location per day with its capacity and occupancy at the last
+-- worker run that day, for occupancy trend charts
+CREATE TABLE location_capacity_snapshots (
+    location_id TEXT NOT NULL REFERENCES locations(id) ON DELETE CASCADE,
+    snapshot_date DATE NOT NULL,
+    capacity INTEGER NOT NULL,
+    occupied INTEGER NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (location_id, snapshot_date)
 );
 
 
@@ -109,11 +138,23 @@ CREATE TABLE  employees (
     location_id TEXT NOT NULL REFERENCES locations(id),
     created_at TIMESTAMP  DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP  DEFAULT CURRENT_TIMESTAMP,
-    is_deleted BOOLEAN DEFAULT FALSE
+    is_deleted BOOLEAN DEFAULT FALSE,
+    organization_id TEXT REFERENCES organizations(id),
+    -- Cap on active (waiting list or in care) clients; NULL falls back to DEFAULT_MAX_CASELOAD
+    max_caseload INT CHECK (max_caseload IS NULL OR max_caseload > 0)
 );
  
 
 CREATE TYPE care_type_enum AS ENUM ('protected_living', 'semi_independent_living', 'independent_assisted_living', 'ambulatory_care');
+
+-- Care types a location offers, used to suggest placements for waiting-list
+-- clients. A location without rows here accepts every care type.
+CREATE TABLE location_care_types (
+    location_id TEXT NOT NULL REFERENCES locations(id) ON DELETE CASCADE,
+    care_type care_type_enum NOT NULL,
+    PRIMARY KEY (location_id, care_type)
+);
+
 CREATE TYPE registration_status_enum AS ENUM ('pending', 'approved', 'rejected', 'in_review');
 CREATE TYPE goal_progress_status AS ENUM ('not_started','starting', 'in_progress', 'on_track', 'delayed', 'stagnant', 'deteriorating', 'adjusted', 'not_applicable', 'achieved');
 CREATE TYPE evaluation_status_enum AS ENUM ('draft', 'submitted');
@@ -123,7 +164,8 @@ CREATE TYPE evaluation_status_enum AS ENUM ('draft', 'submitted');
     id TEXT PRIMARY KEY,
     first_name TEXT NOT NULL,
     last_name TEXT NOT NULL,
-    bsn TEXT UNIQUE NOT NULL,
+    -- emptied when the form's personal data is purged
+    bsn TEXT NOT NULL,
     date_of_birth DATE NOT NULL,
     phone_number TEXT,
     gender gender_enum NOT NULL,
@@ -136,30 +178,145 @@ CREATE TYPE evaluation_status_enum AS ENUM ('draft', 'submitted');
     registration_reason TEXT NOT NULL,
     additional_notes TEXT,
     status registration_status_enum DEFAULT 'pending',
-    attachment_ids TEXT[],
     created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
-    is_deleted BOOLEAN DEFAULT FALSE
+    is_deleted BOOLEAN DEFAULT FALSE,
+    -- Set by the soft delete and cleared when the form is restored
+    deleted_at TIMESTAMP WITH TIME ZONE,
+    -- NULL for rows created before creators were tracked
+    created_by_user_id TEXT REFERENCES users(id)
+);
+CREATE INDEX idx_registration_forms_bsn ON registration_forms(bsn);
+
+-- Files attached to a registration form, shown to reviewers in sort_order
+CREATE TABLE registration_form_attachments (
+    registration_form_id TEXT NOT NULL REFERENCES registration_forms(id) ON DELETE CASCADE,
+    attachment_id TEXT NOT NULL REFERENCES attachments(id) ON DELETE CASCADE,
+    caption TEXT,
+    sort_order INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (registration_form_id, attachment_id)
 );
 
+-- Every attachment download, written before the presigned URL is handed out.
+-- resource_type/resource_id name what the file belongs to (a registration
+-- form or a discharged client) so auditors can tie an access to a client.
+CREATE TABLE attachment_access_log (
+    id TEXT PRIMARY KEY,
+    attachment_id TEXT NOT NULL REFERENCES attachments(id),
+    user_id TEXT REFERENCES users(id) ON DELETE SET NULL,
+    resource_type TEXT,
+    resource_id TEXT,
+    ip_address TEXT,
+    user_agent TEXT,
+    accessed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_attachment_access_log_attachment ON attachment_access_log(attachment_id, accessed_at DESC);
+
+-- Every status change of a registration form, written by UpdateRegistrationFormStatus
+CREATE TABLE registration_status_history (
+    id TEXT PRIMARY KEY,
+    registration_form_id TEXT NOT NULL REFERENCES registration_forms(id) ON DELETE CASCADE,
+    old_status registration_status_enum,
+    new_status registration_status_enum,
+    changed_by TEXT REFERENCES users(id),
+    -- clock_timestamp() keeps changes made in the same transaction ordered
+    changed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT clock_timestamp()
+);
+
+CREATE INDEX idx_registration_status_history_form ON registration_status_history(registration_form_id, changed_at);
+
 
 CREATE TYPE intake_status_enum AS ENUM ('completed', 'pending', 'rejected');
 CREATE TABLE intake_forms (
     id TEXT PRIMARY KEY,
-    registration_form_id TEXT NOT NULL UNIQUE REFERENCES registration_forms(id),
+    registration_form_id TEXT NOT NULL REFERENCES registration_forms(id),
     intake_date DATE NOT NULL,
     intake_Time TIME NOT NULL,
     location_id TEXT NOT NULL REFERENCES locations(id),
     coordinator_id TEXT NOT NULL REFERENCES employees(id),
-    family_situation TEXT,
+    -- Free-text lengths are capped at util.MaxTextFieldLength
+    family_situation TEXT CHECK (char_length(family_situation) <= 20000),
     main_provider TEXT,
-    limitations TEXT,
-    focus_areas TEXT,
-    notes TEXT,
-    evaluation_interval_weeks INTEGER DEFAULT 5,
+    limitations TEXT CHECK (char_length(limitations) <= 20000),
+    focus_areas TEXT CHECK (char_length(focus_areas) <= 20000),
+    notes TEXT CHECK (char_length(notes) <= 20000),
+    evaluation_interval_weeks INTEGER DEFAULT 5 CHECK (evaluation_interval_weeks BETWEEN 1 AND 52),
     status intake_status_enum NOT NULL DEFAULT 'pending',
     created_at TIMESTAMP  DEFAULT CURRENT_TIMESTAMP,
-    updated_at TIMESTAMP  DEFAULT CURRENT_TIMESTAMP
+    updated_at TIMESTAMP  DEFAULT CURRENT_TIMESTAMP,
+    created_by_user_id TEXT REFERENCES users(id),
+    is_deleted BOOLEAN DEFAULT FALSE
+);
+
+-- One live intake per registration; a soft-deleted intake frees the registration for a new one
+CREATE UNIQUE INDEX uq_intake_forms_registration ON intake_forms(registration_form_id) WHERE is_deleted = FALSE;
+CREATE INDEX idx_intake_forms_coordinator_date ON intake_forms(coordinator_id, intake_date);
+
+-- Every change of an intake's appointment, for no-show analysis
+CREATE TABLE intake_reschedule_history (
+    id TEXT PRIMARY KEY,
+    intake_form_id TEXT NOT NULL REFERENCES intake_forms(id) ON DELETE CASCADE,
+    old_intake_date DATE NOT NULL,
+    old_intake_time TIME NOT NULL,
+    new_intake_date DATE NOT NULL,
+    new_intake_time TIME NOT NULL,
+    changed_by TEXT REFERENCES users(id),
+    -- clock_timestamp() keeps changes made in the same transaction ordered
+    changed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT clock_timestamp()
+);
+
+CREATE INDEX idx_intake_reschedule_history_form ON intake_reschedule_history(intake_form_id, changed_at);
+
+-- Documents an intake must have before it can be completed
+CREATE TYPE intake_document_type_enum AS ENUM ('identification', 'care_indication', 'consent');
+CREATE TYPE intake_document_status_enum AS ENUM ('missing', 'received');
+
+-- Which documents are required per care type; an intake's checklist is built from
+-- these rows when the intake is created
+CREATE TABLE intake_document_requirements (
+    care_type care_type_enum NOT NULL,
+    document_type intake_document_type_enum NOT NULL,
+    PRIMARY KEY (care_type, document_type)
+);
+
+INSERT INTO intake_document_requirements (care_type, document_type) VALUES
+('protected_living', 'identification'),
+('protected_living', 'care_indication'),
+('protected_living', 'consent'),
+('semi_independent_living', 'identification'),
+('semi_independent_living', 'care_indication'),
+('semi_independent_living', 'consent'),
+('independent_assisted_living', 'identification'),
+('independent_assisted_living', 'care_indication'),
+('independent_assisted_living', 'consent'),
+('ambulatory_care', 'identification'),
+('ambulatory_care', 'consent');
+
+CREATE TABLE intake_required_documents (
+    intake_form_id TEXT NOT NULL REFERENCES intake_forms(id) ON DELETE CASCADE,
+    document_type intake_document_type_enum NOT NULL,
+    status intake_document_status_enum NOT NULL DEFAULT 'missing',
+    received_at TIMESTAMPTZ,
+    updated_by_user_id TEXT REFERENCES users(id),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (intake_form_id, document_type)
+);
+
+-- Weekly working hours per coordinator; intakes can only be booked inside them.
+-- weekday follows EXTRACT(DOW): 0 = Sunday ... 6 = Saturday
+CREATE TABLE coordinator_availability (
+    id TEXT PRIMARY KEY,
+    employee_id TEXT NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    weekday INTEGER NOT NULL CHECK (weekday BETWEEN 0 AND 6),
+    start_time TIME NOT NULL,
+    end_time TIME NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT chk_availability_window CHECK (start_time < end_time),
+    CONSTRAINT uq_coordinator_availability_weekday UNIQUE (employee_id, weekday)
 );
 
 
@@ -203,8 +360,8 @@ CREATE TABLE clients (
 
     -- Discharge information
     discharge_date DATE NULL,
-    closing_report TEXT NULL,
-    evaluation_report TEXT NULL,
+    closing_report TEXT NULL CHECK (char_length(closing_report) <= 20000),
+    evaluation_report TEXT NULL CHECK (char_length(evaluation_report) <= 20000),
     reason_for_discharge discharge_reason_enum NULL,
     discharge_attachment_ids TEXT[] NULL,
     discharge_status discharge_status_enum NULL,
@@ -217,16 +374,18 @@ CREATE TABLE clients (
     -- Care team
     coordinator_id TEXT NOT NULL REFERENCES employees(id),
     
-    -- Additional information
-    family_situation TEXT,
-    limitations TEXT,
-    focus_areas TEXT,
-    notes TEXT,
-    evaluation_interval_weeks INTEGER DEFAULT 5,
+    -- Additional information; free-text lengths are capped at util.MaxTextFieldLength
+    family_situation TEXT CHECK (char_length(family_situation) <= 20000),
+    limitations TEXT CHECK (char_length(limitations) <= 20000),
+    focus_areas TEXT CHECK (char_length(focus_areas) <= 20000),
+    notes TEXT CHECK (char_length(notes) <= 20000),
+    evaluation_interval_weeks INTEGER DEFAULT 5 CHECK (evaluation_interval_weeks BETWEEN 1 AND 52),
     next_evaluation_date DATE,
     
     created_at TIMESTAMP  DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP  DEFAULT CURRENT_TIMESTAMP,
+    created_by_user_id TEXT REFERENCES users(id),
+    organization_id TEXT REFERENCES organizations(id),
     
     -- Constraint: ambulatory_weekly_hours only allowed for ambulatory_care
     CONSTRAINT chk_ambulatory_hours CHECK (
@@ -276,9 +435,16 @@ CREATE TABLE clients (
     )
 );
 
+-- Keyset pagination of in-care clients (ListInCareClientsByCursor)
+CREATE INDEX idx_clients_status_created ON clients(status, created_at DESC, id DESC);
+CREATE INDEX idx_clients_organization ON clients(organization_id);
+-- A person has at most one active (not discharged) client per organization;
+-- re-referral after discharge creates a new client with the same BSN
+CREATE UNIQUE INDEX uq_clients_active_bsn ON clients(organization_id, bsn) WHERE status != 'discharged';
+
 
 
-CREATE TYPE location_transfer_status_enum AS ENUM ('pending', 'approved', 'rejected');
+CREATE TYPE location_transfer_status_enum AS ENUM ('pending', 'approved', 'rejected', 'cancelled');
 
 CREATE TABLE client_location_transfers (
     id TEXT PRIMARY KEY,
@@ -291,8 +457,50 @@ CREATE TABLE client_location_transfers (
     reason TEXT,
     status location_transfer_status_enum NOT NULL DEFAULT 'pending',
     rejection_reason TEXT,
+    -- Why the requester withdrew the transfer before it was approved
+    cancellation_reason TEXT,
+    -- Notes from the outgoing coordinator, captured when the transfer is approved
+    handover_notes TEXT,
+    -- When the transfer was decided; the time to decision runs from created_at
+    approved_at TIMESTAMP,
+    rejected_at TIMESTAMP,
     created_at TIMESTAMP  DEFAULT CURRENT_TIMESTAMP,
-    updated_at TIMESTAMP  DEFAULT CURRENT_TIMESTAMP
+    updated_at TIMESTAMP  DEFAULT CURRENT_TIMESTAMP,
+    created_by_user_id TEXT REFERENCES users(id)
+);
+
+-- Every coordinator/location combination a client has been assigned to.
+-- Unlike client_location_transfers this also covers coordinator-only changes.
+CREATE TABLE client_assignment_history (
+    id TEXT PRIMARY KEY,
+    client_id TEXT NOT NULL REFERENCES clients(id) ON DELETE CASCADE,
+    coordinator_id TEXT NOT NULL REFERENCES employees(id),
+    location_id TEXT NOT NULL REFERENCES locations(id),
+    changed_by TEXT REFERENCES users(id),
+    -- clock_timestamp() keeps rows written in the same transaction ordered
+    changed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT clock_timestamp()
+);
+
+CREATE INDEX idx_client_assignment_history_client ON client_assignment_history(client_id, changed_at);
+
+-- Append-only, attributed notes on a client. clients.notes stays as the summary.
+CREATE TABLE client_notes (
+    id TEXT PRIMARY KEY,
+    client_id TEXT NOT NULL REFERENCES clients(id) ON DELETE CASCADE,
+    author_id TEXT NOT NULL REFERENCES employees(id),
+    body TEXT NOT NULL CHECK (btrim(body) <> '' AND char_length(body) <= 20000),
+    -- clock_timestamp() keeps notes added in the same transaction ordered
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT clock_timestamp()
+);
+
+CREATE INDEX idx_client_notes_client ON client_notes(client_id, created_at DESC);
+
+-- Discharged clients whose personal data was removed after the retention period.
+-- The client row stays for statistics; this records when and under which policy.
+CREATE TABLE client_pii_purges (
+    client_id TEXT PRIMARY KEY REFERENCES clients(id) ON DELETE CASCADE,
+    retention_months INTEGER NOT NULL,
+    purged_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
 );
 
 
@@ -300,6 +508,7 @@ CREATE TABLE client_location_transfers (
 CREATE TYPE incident_status_enum AS ENUM ('pending', 'under_investigation', 'completed');
 CREATE TYPE incident_type_enum AS ENUM ('aggression', 'medical_emergency', 'safety_concern', 'unwanted_behavior', 'other');
 CREATE TYPE incident_severity_enum AS ENUM ('minor', 'moderate', 'severe');
+CREATE TYPE incident_category_enum AS ENUM ('medication', 'aggression', 'fall', 'absconding', 'self_harm', 'substance_use', 'property_damage', 'other');
 CREATE TABLE incidents (
     id TEXT PRIMARY KEY,
     client_id TEXT NOT NULL REFERENCES clients(id),
@@ -307,6 +516,7 @@ CREATE TABLE incidents (
     incident_time TIME NOT NULL,
     incident_type incident_type_enum NOT NULL,
     incident_severity incident_severity_enum NOT NULL,
+    incident_category incident_category_enum NOT NULL,
     location_id TEXT NOT NULL REFERENCES locations(id),
     coordinator_id TEXT NOT NULL REFERENCES employees(id),
     incident_description TEXT NOT NULL,
@@ -315,7 +525,13 @@ CREATE TABLE incidents (
     status incident_status_enum NOT NULL,
     created_at TIMESTAMP  DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP  DEFAULT CURRENT_TIMESTAMP,
-    is_deleted BOOLEAN DEFAULT FALSE
+    is_deleted BOOLEAN DEFAULT FALSE,
+    deleted_at TIMESTAMP WITH TIME ZONE,
+    deleted_by TEXT REFERENCES users(id),
+    created_by_user_id TEXT REFERENCES users(id),
+    -- Set when the worker escalates the incident for staying unresolved past its
+    -- severity's SLA; an incident is escalated at most once
+    escalated_at TIMESTAMP WITH TIME ZONE
 );
 
 CREATE TABLE client_goals (
@@ -405,6 +621,28 @@ CREATE TABLE goal_progress_logs (
     updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
 );
 
+-- Evaluations: one row per scheduled evaluation and when it was actually completed.
+-- The assessment content itself (notes, goal progress) lives in client_evaluations.
+CREATE TYPE evaluation_outcome_enum AS ENUM ('on_track', 'needs_attention', 'plan_adjusted');
+
+CREATE TABLE evaluations (
+    id TEXT PRIMARY KEY,
+    client_id TEXT NOT NULL REFERENCES clients(id) ON DELETE CASCADE,
+    coordinator_id TEXT NOT NULL REFERENCES employees(id),
+    scheduled_date DATE NOT NULL,
+    completed_date DATE,
+    notes TEXT,
+    outcome evaluation_outcome_enum,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    -- An outcome is recorded exactly when the evaluation is completed
+    CONSTRAINT chk_evaluation_completion CHECK ((completed_date IS NULL) = (outcome IS NULL))
+);
+
+CREATE INDEX idx_evaluations_client_scheduled ON evaluations(client_id, scheduled_date DESC);
+CREATE INDEX idx_evaluations_open ON evaluations(scheduled_date) WHERE completed_date IS NULL;
+
 -- RLS for Client Evaluations
 ALTER TABLE client_evaluations ENABLE ROW LEVEL SECURITY;
 
@@ -462,6 +700,40 @@ CREATE POLICY coordinator_progress_logs ON goal_progress_logs
         )
     );
 
+-- RLS for Evaluations
+ALTER TABLE evaluations ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY admin_all_evaluation_records ON evaluations
+    FOR ALL TO PUBLIC
+    USING (
+        EXISTS (
+            SELECT 1 FROM user_roles ur
+            JOIN roles r ON ur.role_id = r.id
+            WHERE ur.user_id = current_setting('app.current_user_id', true)::text
+            AND r.name = 'admin'
+        )
+    );
+
+CREATE POLICY coordinator_evaluation_records ON evaluations
+    FOR ALL TO PUBLIC
+    USING (
+        EXISTS (
+            SELECT 1 FROM user_roles ur
+            JOIN roles r ON ur.role_id = r.id
+            WHERE ur.user_id = current_setting('app.current_user_id', true)::text
+            AND r.name = 'coordinator'
+        )
+        AND EXISTS (
+            SELECT 1 FROM clients c
+            WHERE c.id = client_id
+            AND c.coordinator_id = (
+                SELECT id FROM employees 
+                WHERE user_id = current_setting('app.current_user_id', true)::text
+                LIMIT 1
+            )
+        )
+    );
+
 -- RLS Policies
 ALTER TABLE clients ENABLE ROW LEVEL SECURITY;
 
@@ -514,6 +786,7 @@ INSERT INTO permissions (id, resource, action, description) VALUES
     ('perm_client_read', 'client', 'read', 'View clients'),
     ('perm_client_write', 'client', 'write', 'Create and update clients'),
     ('perm_client_delete', 'client', 'delete', 'Delete clients'),
+    ('perm_client_export', 'client', 'export', 'Export all clients of the organization'),
     -- Employee permissions
     ('perm_employee_read', 'employee', 'read', 'View employees'),
     ('perm_employee_write', 'employee', 'write', 'Create and update employees'),
@@ -567,6 +840,7 @@ INSERT INTO role_permissions (role_id, permission_id) VALUES
     ('role_admin', 'perm_client_read'),
     ('role_admin', 'perm_client_write'),
     ('role_admin', 'perm_client_delete'),
+    ('role_admin', 'perm_client_export'),
     ('role_admin', 'perm_employee_read'),
     ('role_admin', 'perm_employee_write'),
     ('role_admin', 'perm_employee_delete'),
@@ -582,6 +856,7 @@ INSERT INTO role_permissions (role_id, permission_id) VALUES
     ('role_admin', 'perm_intake_write'),
     ('role_admin', 'perm_incident_read'),
     ('role_admin', 'perm_incident_write'),
+    ('role_admin', 'perm_incident_delete'),
     ('role_admin', 'perm_evaluation_read'),
     ('role_admin', 'perm_evaluation_write'),
     ('role_admin', 'perm_evaluation_delete'),
@@ -626,7 +901,8 @@ CREATE TABLE appointments (
     type appointment_type_enum NOT NULL DEFAULT 'general',
     recurrence_rule TEXT, -- iCalendar RRULE string
     created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
-    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    cancellation_reason TEXT -- Set when the appointment is cancelled
 );
 
 CREATE TABLE appointment_participants (
@@ -772,13 +1048,16 @@ CREATE POLICY coordinator_reminders ON reminders
 CREATE TYPE notification_type_enum AS ENUM (
     'evaluation_due',
     'appointment_reminder',
+    'appointment_cancelled',
     'incident_created',
     'location_transfer_request',
     'location_transfer_approved',
     'location_transfer_rejected',
+    'coordinator_handover',
     'client_status_change',
     'registration_status_change',
-    'system_alert'
+    'system_alert',
+    'incident_escalated'
 );
 
 CREATE TYPE notification_priority_enum AS ENUM ('low', 'normal', 'high', 'urgent');
@@ -812,6 +1091,30 @@ CREATE POLICY user_own_notifications ON notifications
     FOR ALL TO PUBLIC
     USING (user_id = current_setting('app.current_user_id', true)::text);
 
+-- Per-user window in which non-critical notifications are held back.
+-- A window whose end is before its start wraps past midnight.
+CREATE TABLE notification_quiet_hours (
+    user_id TEXT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    start_time TIME NOT NULL,
+    end_time TIME NOT NULL,
+    timezone TEXT NOT NULL DEFAULT 'Europe/Amsterdam',
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_quiet_hours_window CHECK (start_time <> end_time)
+);
+
+-- Notifications a delivery channel gave up on after its retries, with the
+-- last error, so failed emails are not silently lost
+CREATE TABLE notification_dead_letters (
+    id TEXT PRIMARY KEY,
+    notification_id TEXT NOT NULL REFERENCES notifications(id) ON DELETE CASCADE,
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    channel TEXT NOT NULL,
+    error TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_notification_dead_letters_created ON notification_dead_letters(created_at DESC);
+
 -- ============================================================
 -- Audit Logging (NEN7510 / ISO27001 Compliance)
 -- ============================================================
@@ -852,3 +1155,33 @@ CREATE INDEX idx_audit_logs_user_resource_time ON audit_logs(user_id, resource_t
 
 -- Unique index on sequence_number for integrity
 CREATE UNIQUE INDEX idx_audit_logs_sequence_unique ON audit_logs(sequence_number);
+
+-- ============================================================
+-- Feature flags
+-- ============================================================
+
+-- Runtime toggles for experimental features; unknown keys are treated as disabled
+CREATE TABLE feature_flags (
+    key TEXT PRIMARY KEY,
+    enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    value JSONB,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- ============================================================
+-- Worker heartbeats
+-- ============================================================
+
+-- Background workers record every completed run so the API can tell when one has stopped
+CREATE TABLE worker_heartbeats (
+    worker_name TEXT PRIMARY KEY,
+    last_run_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+-- Only the replica holding a worker's lease runs its checks; the lease is
+-- renewed every run and taken over by another replica once it expires
+CREATE TABLE worker_leases (
+    worker_name TEXT PRIMARY KEY,
+    holder TEXT NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
@@ -0,0 +1,14 @@
+-- PostgreSQL cannot drop enum values, so the type is rebuilt with the original
+-- values; rows using the added ones fall back to 'other'
+UPDATE employees SET gender = 'other' WHERE gender::text IN ('non_binary', 'unknown', 'prefer_not_to_say');
+UPDATE registration_forms SET gender = 'other' WHERE gender::text IN ('non_binary', 'unknown', 'prefer_not_to_say');
+UPDATE clients SET gender = 'other' WHERE gender::text IN ('non_binary', 'unknown', 'prefer_not_to_say');
+
+ALTER TYPE gender_enum RENAME TO gender_enum_old;
+CREATE TYPE gender_enum AS ENUM ('male', 'female', 'other');
+
+ALTER TABLE employees ALTER COLUMN gender TYPE gender_enum USING gender::text::gender_enum;
+ALTER TABLE registration_forms ALTER COLUMN gender TYPE gender_enum USING gender::text::gender_enum;
+ALTER TABLE clients ALTER COLUMN gender TYPE gender_enum USING gender::text::gender_enum;
+
+DROP TYPE gender_enum_old;
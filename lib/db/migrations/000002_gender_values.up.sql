@@ -0,0 +1,4 @@
+-- Gender values beyond male/female/other for employees, registrations and clients
+ALTER TYPE gender_enum ADD VALUE IF NOT EXISTS 'non_binary';
+ALTER TYPE gender_enum ADD VALUE IF NOT EXISTS 'unknown';
+ALTER TYPE gender_enum ADD VALUE IF NOT EXISTS 'prefer_not_to_say';
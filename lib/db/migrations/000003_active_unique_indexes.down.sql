@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS uq_locations_active_name;
+
+DROP INDEX IF EXISTS uq_registration_forms_active_bsn;
+CREATE INDEX idx_registration_forms_bsn ON registration_forms(bsn);
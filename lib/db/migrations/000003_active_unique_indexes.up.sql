@@ -0,0 +1,9 @@
+-- One active registration per person; a soft-deleted form does not block
+-- re-registering the same BSN. Purged forms keep an empty BSN and are left out,
+-- so any number of them can exist.
+DROP INDEX IF EXISTS idx_registration_forms_bsn;
+CREATE UNIQUE INDEX uq_registration_forms_active_bsn ON registration_forms(bsn) WHERE is_deleted = FALSE AND bsn <> '';
+
+-- Location names are unique per organization among active locations, so a
+-- deleted location's name can be reused
+CREATE UNIQUE INDEX uq_locations_active_name ON locations(organization_id, name) WHERE is_deleted = FALSE;
@@ -0,0 +1,98 @@
+package pool
+
+import (
+	"care-cordination/lib/logger"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+// ErrConnectFailed is returned when the database is still unreachable after
+// all configured attempts.
+var ErrConnectFailed = errors.New("database unavailable: connection attempts exhausted")
+
+const (
+	DefaultMaxAttempts  = 10
+	DefaultRetryBackoff = time.Second
+	// MaxRetryBackoff caps the exponential backoff between attempts
+	MaxRetryBackoff = 30 * time.Second
+)
+
+// Options controls the bounded retry applied while opening the initial pool.
+type Options struct {
+	MaxAttempts  int           // Total attempts, including the first
+	RetryBackoff time.Duration // Initial backoff, doubled after every failed attempt
+}
+
+// dialFunc opens a pool and verifies that the database answers
+type dialFunc func(ctx context.Context) (*pgxpool.Pool, error)
+
+// Connect opens a connection pool and pings the database, retrying with
+// exponential backoff so the services survive Postgres starting after them.
+func Connect(
+	ctx context.Context,
+	poolConfig *pgxpool.Config,
+	opts Options,
+	l logger.Logger,
+) (*pgxpool.Pool, error) {
+	return connectWithRetry(ctx, func(ctx context.Context) (*pgxpool.Pool, error) {
+		connPool, err := pgxpool.NewWithConfig(ctx, poolConfig)
+		if err != nil {
+			return nil, err
+		}
+		if err := connPool.Ping(ctx); err != nil {
+			connPool.Close()
+			return nil, err
+		}
+		return connPool, nil
+	}, opts, l)
+}
+
+func connectWithRetry(
+	ctx context.Context,
+	dial dialFunc,
+	opts Options,
+	l logger.Logger,
+) (*pgxpool.Pool, error) {
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = DefaultMaxAttempts
+	}
+	if opts.RetryBackoff <= 0 {
+		opts.RetryBackoff = DefaultRetryBackoff
+	}
+
+	backoff := opts.RetryBackoff
+	var lastErr error
+
+	for attempt := 1; attempt <= opts.MaxAttempts; attempt++ {
+		connPool, err := dial(ctx)
+		if err == nil {
+			return connPool, nil
+		}
+		lastErr = err
+
+		if attempt == opts.MaxAttempts {
+			break
+		}
+
+		l.Warn(ctx, "Connect", "Database not ready, retrying",
+			zap.Int("attempt", attempt),
+			zap.Int("maxAttempts", opts.MaxAttempts),
+			zap.Duration("backoff", backoff),
+			zap.Error(err),
+		)
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("%w: %v", ErrConnectFailed, ctx.Err())
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, MaxRetryBackoff)
+	}
+
+	return nil, fmt.Errorf("%w after %d attempt(s): %v", ErrConnectFailed, opts.MaxAttempts, lastErr)
+}
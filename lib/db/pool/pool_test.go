@@ -0,0 +1,105 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	loggermocks "care-cordination/lib/logger/mocks"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var errRefused = errors.New("dial tcp 127.0.0.1:5432: connect: connection refused")
+
+// flakyDial fails the first `failures` calls, then returns a pool.
+type flakyDial struct {
+	failures int
+	calls    int
+}
+
+func (f *flakyDial) dial(ctx context.Context) (*pgxpool.Pool, error) {
+	f.calls++
+	if f.calls <= f.failures {
+		return nil, errRefused
+	}
+	return &pgxpool.Pool{}, nil
+}
+
+func TestConnectWithRetry(t *testing.T) {
+	tests := []struct {
+		name        string
+		failures    int
+		maxAttempts int
+		wantErr     bool
+		wantCalls   int
+	}{
+		{
+			name:        "first_attempt_succeeds",
+			failures:    0,
+			maxAttempts: 5,
+			wantCalls:   1,
+		},
+		{
+			name:        "recovers_after_failures",
+			failures:    3,
+			maxAttempts: 5,
+			wantCalls:   4,
+		},
+		{
+			name:        "exhausts_attempts",
+			failures:    10,
+			maxAttempts: 3,
+			wantErr:     true,
+			wantCalls:   3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockLogger := loggermocks.NewMockLogger(ctrl)
+			mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+			fake := &flakyDial{failures: tt.failures}
+			opts := Options{MaxAttempts: tt.maxAttempts, RetryBackoff: time.Millisecond}
+
+			connPool, err := connectWithRetry(context.Background(), fake.dial, opts, mockLogger)
+
+			assert.Equal(t, tt.wantCalls, fake.calls)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, ErrConnectFailed)
+				assert.ErrorContains(t, err, errRefused.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.NotNil(t, connPool)
+		})
+	}
+}
+
+func TestConnectWithRetry_StopsWhenContextCancelled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := loggermocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	fake := &flakyDial{failures: 10}
+	_, err := connectWithRetry(ctx, fake.dial, Options{MaxAttempts: 5, RetryBackoff: time.Hour}, mockLogger)
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrConnectFailed)
+	assert.Equal(t, 1, fake.calls)
+}
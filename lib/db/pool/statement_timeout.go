@@ -0,0 +1,127 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// DefaultStatementTimeout stops a runaway query from holding a connection
+const DefaultStatementTimeout = 30 * time.Second
+
+// StatementTimeoutHitKey is the gin context key under which the flag set by
+// WithStatementTimeoutHit is also stored, for handlers that pass the gin
+// context rather than the request context to the store
+const StatementTimeoutHitKey = "db_statement_timeout_hit"
+
+type statementTimeoutHitKey struct{}
+
+// WithStatementTimeoutHit returns a context whose queries set hit when they
+// fail because they exceeded the statement timeout
+func WithStatementTimeoutHit(ctx context.Context, hit *atomic.Bool) context.Context {
+	return context.WithValue(ctx, statementTimeoutHitKey{}, hit)
+}
+
+// StatementTimeoutHit returns the flag installed by WithStatementTimeoutHit, or
+// stored on a gin context under StatementTimeoutHitKey; nil if there is none
+func StatementTimeoutHit(ctx context.Context) *atomic.Bool {
+	if hit, ok := ctx.Value(statementTimeoutHitKey{}).(*atomic.Bool); ok {
+		return hit
+	}
+	hit, _ := ctx.Value(StatementTimeoutHitKey).(*atomic.Bool)
+	return hit
+}
+
+// overrideMarker flags, in the connection's custom data, a connection whose
+// statement timeout was overridden and has to be reset before reuse
+const overrideMarker = "statement_timeout_overridden"
+
+// resetTimeout bounds restoring the statement timeout of a released connection
+const resetTimeout = 5 * time.Second
+
+type statementTimeoutKey struct{}
+
+// WithStatementTimeout returns a context whose queries run with timeout instead
+// of the pool's statement timeout; zero lifts the limit. It is meant for the
+// few queries, such as exports, that are expected to run long.
+func WithStatementTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, statementTimeoutKey{}, timeout)
+}
+
+// ConfigureStatementTimeout sets timeout as the statement_timeout of every
+// connection in poolConfig, zero meaning no limit. It also installs the hooks
+// that apply WithStatementTimeout overrides and report timeouts through
+// WithStatementTimeoutHit.
+func ConfigureStatementTimeout(poolConfig *pgxpool.Config, timeout time.Duration) {
+	poolConfig.ConnConfig.RuntimeParams["statement_timeout"] = milliseconds(timeout)
+	poolConfig.ConnConfig.Tracer = statementTimeoutTracer{}
+
+	poolConfig.PrepareConn = func(ctx context.Context, conn *pgx.Conn) (bool, error) {
+		override, ok := ctx.Value(statementTimeoutKey{}).(time.Duration)
+		if !ok {
+			return true, nil
+		}
+		if _, err := conn.Exec(ctx, "SELECT set_config('statement_timeout', $1, false)", milliseconds(override)); err != nil {
+			return false, err
+		}
+		conn.PgConn().CustomData()[overrideMarker] = true
+		return true, nil
+	}
+
+	poolConfig.AfterRelease = func(conn *pgx.Conn) bool {
+		data := conn.PgConn().CustomData()
+		if overridden, _ := data[overrideMarker].(bool); !overridden {
+			return true
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), resetTimeout)
+		defer cancel()
+		// RESET goes back to the value sent when the connection was opened
+		if _, err := conn.Exec(ctx, "RESET statement_timeout"); err != nil {
+			return false
+		}
+		delete(data, overrideMarker)
+		return true
+	}
+}
+
+// IsStatementTimeout reports whether err is Postgres cancelling a query for
+// running longer than the statement timeout. Cancellations by the client,
+// which share the error code, are not included.
+func IsStatementTimeout(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) &&
+		pgErr.Code == "57014" && // query_canceled
+		strings.Contains(pgErr.Message, "statement timeout")
+}
+
+func milliseconds(d time.Duration) string {
+	return strconv.FormatInt(d.Milliseconds(), 10)
+}
+
+// statementTimeoutTracer flags the query's context when Postgres cancelled the
+// query for exceeding the statement timeout
+type statementTimeoutTracer struct{}
+
+func (statementTimeoutTracer) TraceQueryStart(
+	ctx context.Context,
+	_ *pgx.Conn,
+	_ pgx.TraceQueryStartData,
+) context.Context {
+	return ctx
+}
+
+func (statementTimeoutTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	if !IsStatementTimeout(data.Err) {
+		return
+	}
+	if hit := StatementTimeoutHit(ctx); hit != nil {
+		hit.Store(true)
+	}
+}
@@ -6,7 +6,73 @@
 INSERT INTO attachments (
     id,
     filekey,
-    content_type
+    content_type,
+    uploaded_by,
+    filename
 ) VALUES (
-    $1, $2, $3
-);
\ No newline at end of file
+    $1, $2, $3, $4, $5
+);
+
+-- name: GetAttachmentsByIDs :many
+SELECT id, uploaded_by
+FROM attachments
+WHERE id = ANY(sqlc.arg(ids)::text[]);
+-- name: GetAttachmentForDownload :one
+-- The attachment with the registration form or discharged client it belongs
+-- to, recorded as the resource context of each download. organization_id is
+-- the owner's organization: the client's, else that of the registration
+-- form's creator, else the uploader's for a file not attached to anything yet.
+SELECT
+    a.id,
+    a.filekey,
+    a.content_type,
+    a.uploaded_by,
+    rfa.registration_form_id,
+    c.id AS client_id,
+    COALESCE(c.organization_id, rfa.organization_id, u.organization_id)::text AS organization_id
+FROM attachments a
+LEFT JOIN users u ON u.id = a.uploaded_by
+LEFT JOIN LATERAL (
+    SELECT rfa.registration_form_id, creator.organization_id
+    FROM registration_form_attachments rfa
+    JOIN registration_forms rf ON rf.id = rfa.registration_form_id
+    LEFT JOIN users creator ON creator.id = rf.created_by_user_id
+    WHERE rfa.attachment_id = a.id
+    ORDER BY rfa.created_at
+    LIMIT 1
+) rfa ON TRUE
+LEFT JOIN LATERAL (
+    SELECT id, organization_id FROM clients
+    WHERE a.id = ANY(discharge_attachment_ids)
+    LIMIT 1
+) c ON TRUE
+WHERE a.id = $1;
+
+-- name: CreateAttachmentAccess :exec
+INSERT INTO attachment_access_log (
+    id,
+    attachment_id,
+    user_id,
+    resource_type,
+    resource_id,
+    ip_address,
+    user_agent
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+);
+
+-- name: ListAttachmentAccess :many
+-- Who downloaded the attachment and when, newest first
+SELECT
+    aal.id,
+    aal.user_id,
+    u.email AS user_email,
+    aal.resource_type,
+    aal.resource_id,
+    aal.ip_address,
+    aal.user_agent,
+    aal.accessed_at
+FROM attachment_access_log aal
+LEFT JOIN users u ON aal.user_id = u.id
+WHERE aal.attachment_id = $1
+ORDER BY aal.accessed_at DESC, aal.id;
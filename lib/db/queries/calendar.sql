@@ -22,9 +22,59 @@ SET title = CASE WHEN sqlc.arg('title')::text <> '' THEN sqlc.arg('title')::text
 WHERE id = sqlc.arg('id')
 RETURNING *;
 
+-- name: CancelAppointment :one
+-- Cancelled appointments are kept with their reason but no longer show up on
+-- the dashboard or trigger reminders. Returns no row when already cancelled.
+UPDATE appointments
+SET status = 'cancelled',
+    cancellation_reason = sqlc.arg('cancellation_reason')::text,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg('id')
+  AND status IS DISTINCT FROM 'cancelled'
+RETURNING *;
+
 -- name: DeleteAppointment :exec
 DELETE FROM appointments WHERE id = $1;
 
+-- name: ListAppointments :many
+-- Lists appointments matching the optional filters, ordered by start time. The client
+-- is the filtered client, or otherwise the first client participant by name. The
+-- employee filter matches appointments the employee organizes or attends.
+SELECT a.*,
+       cl.id AS client_id,
+       cl.first_name AS client_first_name,
+       cl.last_name AS client_last_name,
+       COUNT(*) OVER() AS total_count
+FROM appointments a
+LEFT JOIN LATERAL (
+    SELECT c.id, c.first_name, c.last_name
+    FROM appointment_participants ap
+    JOIN clients c ON c.id = ap.participant_id
+    WHERE ap.appointment_id = a.id
+      AND ap.participant_type = 'client'
+      AND (sqlc.narg('client_id')::text IS NULL OR ap.participant_id = sqlc.narg('client_id')::text)
+    ORDER BY c.last_name, c.first_name
+    LIMIT 1
+) cl ON TRUE
+WHERE (sqlc.narg('client_id')::text IS NULL OR cl.id IS NOT NULL)
+  AND (sqlc.narg('organizer_id')::text IS NULL OR a.organizer_id = sqlc.narg('organizer_id')::text)
+  AND (sqlc.narg('start_from')::timestamptz IS NULL OR a.start_time >= sqlc.narg('start_from')::timestamptz)
+  AND (sqlc.narg('start_before')::timestamptz IS NULL OR a.start_time < sqlc.narg('start_before')::timestamptz)
+  AND (sqlc.narg('type')::appointment_type_enum IS NULL OR a.type = sqlc.narg('type')::appointment_type_enum)
+  AND (sqlc.narg('location')::text IS NULL OR LOWER(a.location) = LOWER(sqlc.narg('location')::text))
+  AND (
+      sqlc.narg('employee_id')::text IS NULL
+      OR a.organizer_id = sqlc.narg('employee_id')::text
+      OR EXISTS (
+          SELECT 1 FROM appointment_participants ep
+          WHERE ep.appointment_id = a.id
+            AND ep.participant_id = sqlc.narg('employee_id')::text
+            AND ep.participant_type = 'employee'
+      )
+  )
+ORDER BY a.start_time ASC, a.id
+LIMIT $1 OFFSET $2;
+
 -- name: ListAppointmentsByOrganizer :many
 SELECT * FROM appointments WHERE organizer_id = $1 ORDER BY start_time ASC;
 
@@ -47,6 +97,12 @@ DELETE FROM appointment_participants WHERE appointment_id = $1;
 -- name: ListAppointmentParticipants :many
 SELECT * FROM appointment_participants WHERE appointment_id = $1;
 
+-- name: ListAppointmentParticipantsByAppointmentIDs :many
+-- Participants of a page of appointments, fetched in one round trip
+SELECT * FROM appointment_participants
+WHERE appointment_id = ANY(sqlc.arg('appointment_ids')::text[])
+ORDER BY appointment_id;
+
 -- name: CreateReminder :one
 INSERT INTO reminders (
     id, user_id, title, description, due_time, is_completed
@@ -96,15 +152,15 @@ AND start_time <= sqlc.arg('end_time')::timestamptz
 ORDER BY start_time ASC;
 
 -- name: GetUpcomingAppointments :many
--- Get appointments starting in the next hour for reminder notifications
+-- Get confirmed appointments starting before window_end for reminder notifications
 SELECT 
     a.*,
     e.user_id as organizer_user_id
 FROM appointments a
 JOIN employees e ON a.organizer_id = e.id
 WHERE a.start_time >= CURRENT_TIMESTAMP 
-AND a.start_time <= CURRENT_TIMESTAMP + INTERVAL '1 hour'
-AND a.status = 'scheduled'
+AND a.start_time <= sqlc.arg(window_end)::timestamptz
+AND a.status = 'confirmed'
 ORDER BY a.start_time ASC;
 
 -- name: GetPendingRemindersByDueTime :many
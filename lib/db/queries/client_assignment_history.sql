@@ -0,0 +1,50 @@
+-- ============================================================
+-- Client Assignment History
+-- ============================================================
+
+-- name: RecordClientAssignment :exec
+-- Snapshots the client's current coordinator and location. Nothing is written
+-- when the assignment is unchanged since the latest history row.
+INSERT INTO client_assignment_history (
+    id,
+    client_id,
+    coordinator_id,
+    location_id,
+    changed_by
+)
+SELECT
+    sqlc.arg(id)::text,
+    c.id,
+    c.coordinator_id,
+    c.assigned_location_id,
+    NULLIF(sqlc.arg(changed_by)::text, '')
+FROM clients c
+WHERE c.id = sqlc.arg(client_id)
+  AND NOT EXISTS (
+      SELECT 1
+      FROM (
+          SELECT h.coordinator_id, h.location_id
+          FROM client_assignment_history h
+          WHERE h.client_id = c.id
+          ORDER BY h.changed_at DESC
+          LIMIT 1
+      ) latest
+      WHERE latest.coordinator_id = c.coordinator_id
+        AND latest.location_id = c.assigned_location_id
+  );
+
+-- name: GetClientAssignmentHistory :many
+SELECT
+    h.id,
+    h.coordinator_id,
+    e.first_name AS coordinator_first_name,
+    e.last_name AS coordinator_last_name,
+    h.location_id,
+    l.name AS location_name,
+    h.changed_by,
+    h.changed_at
+FROM client_assignment_history h
+JOIN employees e ON h.coordinator_id = e.id
+JOIN locations l ON h.location_id = l.id
+WHERE h.client_id = $1
+ORDER BY h.changed_at DESC;
\ No newline at end of file
@@ -44,6 +44,9 @@ JOIN employees emp ON e.coordinator_id = emp.id
 WHERE e.client_id = $1
 ORDER BY e.evaluation_date DESC, g.title ASC;
 
+-- today is the current date in the application timezone, passed by the
+-- caller because the session itself stays in UTC
+
 -- name: GetCriticalEvaluations :many
 SELECT 
     c.id,
@@ -65,7 +68,7 @@ JOIN locations l ON c.assigned_location_id = l.id
 JOIN employees e ON c.coordinator_id = e.id
 WHERE c.status = 'in_care' 
   AND c.next_evaluation_date IS NOT NULL
-  AND c.next_evaluation_date <= (CURRENT_DATE + INTERVAL '7 days')::date
+  AND c.next_evaluation_date <= sqlc.arg('today')::date + 7
 ORDER BY c.next_evaluation_date ASC
 LIMIT $1 OFFSET $2;
 
@@ -90,11 +93,35 @@ JOIN locations l ON c.assigned_location_id = l.id
 JOIN employees e ON c.coordinator_id = e.id
 WHERE c.status = 'in_care' 
   AND c.next_evaluation_date IS NOT NULL
-  AND c.next_evaluation_date > (CURRENT_DATE + INTERVAL '7 days')::date
-  AND c.next_evaluation_date <= (CURRENT_DATE + INTERVAL '30 days')::date
+  AND c.next_evaluation_date > sqlc.arg('today')::date + 7
+  AND c.next_evaluation_date <= sqlc.arg('today')::date + 30
 ORDER BY c.next_evaluation_date ASC
 LIMIT $1 OFFSET $2;
 
+-- name: ListOverdueEvaluations :many
+-- In-care clients whose next evaluation date has passed, grouped per coordinator
+-- with the longest overdue first. Matches the overdue count in GetCriticalAlertsData.
+SELECT
+    c.id,
+    c.first_name,
+    c.last_name,
+    c.next_evaluation_date,
+    (sqlc.arg('today')::date - c.next_evaluation_date)::int as days_overdue,
+    l.name as location_name,
+    c.coordinator_id,
+    e.first_name as coordinator_first_name,
+    e.last_name as coordinator_last_name,
+    COUNT(*) OVER() as total_count
+FROM clients c
+JOIN locations l ON c.assigned_location_id = l.id
+JOIN employees e ON c.coordinator_id = e.id
+WHERE c.status = 'in_care'
+  AND c.next_evaluation_date IS NOT NULL
+  AND c.next_evaluation_date < sqlc.arg('today')::date
+  AND (sqlc.narg('coordinator_id')::text IS NULL OR c.coordinator_id = sqlc.narg('coordinator_id')::text)
+ORDER BY e.last_name, e.first_name, c.coordinator_id, c.next_evaluation_date ASC, c.id
+LIMIT $1 OFFSET $2;
+
 -- name: GetRecentEvaluationsGlobal :many
 SELECT 
     e.id as evaluation_id,
@@ -236,7 +263,7 @@ WHERE e.id = $1
 ORDER BY g.title ASC;
 
 -- name: GetEvaluationsDueSoon :many
--- Get clients with evaluations due in the next 3 days for reminder notifications
+-- Get clients with evaluations due within due_soon_days days for reminder notifications
 SELECT 
     c.id as client_id,
     c.first_name,
@@ -250,6 +277,6 @@ JOIN employees e ON c.coordinator_id = e.id
 JOIN locations l ON c.assigned_location_id = l.id
 WHERE c.status = 'in_care' 
   AND c.next_evaluation_date IS NOT NULL
-  AND c.next_evaluation_date <= (CURRENT_DATE + INTERVAL '3 days')::date
-  AND c.next_evaluation_date >= CURRENT_DATE
+  AND c.next_evaluation_date <= sqlc.arg('today')::date + sqlc.arg('due_soon_days')::int
+  AND c.next_evaluation_date >= sqlc.arg('today')::date
 ORDER BY c.next_evaluation_date ASC;
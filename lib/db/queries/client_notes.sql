@@ -0,0 +1,22 @@
+-- ============================================================
+-- Client Notes
+-- ============================================================
+
+-- name: AddClientNote :one
+INSERT INTO client_notes (id, client_id, author_id, body)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: ListClientNotes :many
+-- Newest first, with the author's name.
+SELECT
+    n.id,
+    n.body,
+    n.author_id,
+    e.first_name AS author_first_name,
+    e.last_name AS author_last_name,
+    n.created_at
+FROM client_notes n
+JOIN employees e ON n.author_id = e.id
+WHERE n.client_id = $1
+ORDER BY n.created_at DESC, n.id DESC;
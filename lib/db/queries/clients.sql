@@ -23,16 +23,31 @@ INSERT INTO clients (
     limitations,
     focus_areas,
     notes,
-    evaluation_interval_weeks
+    evaluation_interval_weeks,
+    created_by_user_id,
+    organization_id
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22
 )
-RETURNING id, first_name, last_name, bsn, date_of_birth, phone_number, gender, registration_form_id, intake_form_id, care_type, referring_org_id, status, assigned_location_id, coordinator_id, family_situation, limitations, focus_areas, notes, evaluation_interval_weeks, next_evaluation_date, created_at, updated_at;
+RETURNING id, first_name, last_name, bsn, date_of_birth, phone_number, gender, registration_form_id, intake_form_id, care_type, referring_org_id, status, assigned_location_id, coordinator_id, family_situation, limitations, focus_areas, notes, evaluation_interval_weeks, next_evaluation_date, created_at, updated_at, created_by_user_id;
 
 
 
 -- name: GetClientByID :one
-SELECT * FROM clients WHERE id = $1;
+-- A NULL organization_id matches a client of any organization; ScopedStore
+-- always passes one.
+SELECT * FROM clients
+WHERE id = $1
+  AND (sqlc.narg('organization_id')::text IS NULL OR organization_id = sqlc.narg('organization_id')::text);
+
+-- name: GetClientByBSNForOrganization :one
+-- Discharged clients are no longer active and are not matched; the same
+-- person may have several discharged records but at most one active client
+-- (uq_clients_active_bsn).
+SELECT * FROM clients
+WHERE bsn = $1
+  AND organization_id = sqlc.arg('organization_id')::text
+  AND status != 'discharged';
 
 -- name: UpdateClient :one
 UPDATE clients SET
@@ -67,6 +82,7 @@ UPDATE clients SET
     discharge_status = COALESCE(sqlc.narg('discharge_status')::discharge_status_enum, discharge_status),
     updated_at = NOW()
 WHERE id = $1
+  AND (sqlc.narg('organization_id')::text IS NULL OR organization_id = sqlc.narg('organization_id')::text)
 RETURNING id;
 
 -- name: ListWaitingListClients :many
@@ -99,13 +115,39 @@ WHERE c.status = 'waiting_list'
          LOWER(c.first_name) LIKE LOWER('%' || sqlc.narg('search')::text || '%') OR
          LOWER(c.last_name) LIKE LOWER('%' || sqlc.narg('search')::text || '%') OR
          LOWER(c.first_name || ' ' || c.last_name) LIKE LOWER('%' || sqlc.narg('search')::text || '%'))
+    AND (sqlc.narg('organization_id')::text IS NULL OR c.organization_id = sqlc.narg('organization_id')::text)
+-- @sort is a key from util.SortOrder; the CASE arms only compare it against
+-- fixed strings. Unmatched arms are NULL for every row, so the default order
+-- (highest priority first, then longest waiting) decides. Priorities rank
+-- low < normal < high, so priority_desc lists high first.
 ORDER BY
+    CASE WHEN sqlc.arg('sort')::text = 'name_asc' THEN c.last_name END ASC,
+    CASE WHEN sqlc.arg('sort')::text = 'name_asc' THEN c.first_name END ASC,
+    CASE WHEN sqlc.arg('sort')::text = 'name_desc' THEN c.last_name END DESC,
+    CASE WHEN sqlc.arg('sort')::text = 'name_desc' THEN c.first_name END DESC,
+    CASE WHEN sqlc.arg('sort')::text = 'created_at_asc' THEN c.created_at END ASC,
+    CASE WHEN sqlc.arg('sort')::text = 'created_at_desc' THEN c.created_at END DESC,
+    CASE WHEN sqlc.arg('sort')::text = 'priority_asc' THEN
+        CASE c.waiting_list_priority
+            WHEN 'low' THEN 1
+            WHEN 'normal' THEN 2
+            WHEN 'high' THEN 3
+        END
+    END ASC,
+    CASE WHEN sqlc.arg('sort')::text = 'priority_desc' THEN
+        CASE c.waiting_list_priority
+            WHEN 'low' THEN 1
+            WHEN 'normal' THEN 2
+            WHEN 'high' THEN 3
+        END
+    END DESC,
     CASE c.waiting_list_priority
-        WHEN 'high' THEN 1
+        WHEN 'low' THEN 1
         WHEN 'normal' THEN 2
-        WHEN 'low' THEN 3
-    END,
-    c.created_at ASC
+        WHEN 'high' THEN 3
+    END DESC,
+    c.created_at ASC,
+    c.id
 LIMIT $1 OFFSET $2;
 
 -- name: ListInCareClients :many
@@ -140,9 +182,50 @@ WHERE c.status = 'in_care'
          LOWER(c.first_name || ' ' || c.last_name) LIKE LOWER('%' || sqlc.narg('search')::text || '%'))
     AND (sqlc.narg('care_type')::care_type_enum IS NULL OR
          c.care_type = sqlc.narg('care_type')::care_type_enum)
+    AND (sqlc.narg('organization_id')::text IS NULL OR c.organization_id = sqlc.narg('organization_id')::text)
 ORDER BY c.care_start_date DESC
 LIMIT $1 OFFSET $2;
 
+-- name: ListInCareClientsByCursor :many
+-- Keyset variant of ListInCareClients ordered by (created_at, id), newest
+-- first. Pass the last row's created_at and id as the cursor (NULL for the
+-- first page). No total count is computed.
+SELECT
+    c.id,
+    c.first_name,
+    c.last_name,
+    c.bsn,
+    c.date_of_birth,
+    c.phone_number,
+    c.gender,
+    c.care_type,
+    c.care_start_date,
+    c.care_end_date,
+    c.ambulatory_weekly_hours,
+    c.created_at,
+    l.id AS location_id,
+    l.name AS location_name,
+    e.id AS coordinator_id,
+    e.first_name AS coordinator_first_name,
+    e.last_name AS coordinator_last_name,
+    ro.name AS referring_org_name
+FROM clients c
+JOIN locations l ON c.assigned_location_id = l.id
+JOIN employees e ON c.coordinator_id = e.id
+LEFT JOIN referring_orgs ro ON c.referring_org_id = ro.id
+WHERE c.status = 'in_care'
+    AND (sqlc.narg('search')::text IS NULL OR
+         LOWER(c.first_name) LIKE LOWER('%' || sqlc.narg('search')::text || '%') OR
+         LOWER(c.last_name) LIKE LOWER('%' || sqlc.narg('search')::text || '%') OR
+         LOWER(c.first_name || ' ' || c.last_name) LIKE LOWER('%' || sqlc.narg('search')::text || '%'))
+    AND (sqlc.narg('care_type')::care_type_enum IS NULL OR
+         c.care_type = sqlc.narg('care_type')::care_type_enum)
+    AND (sqlc.narg('cursor_created_at')::timestamp IS NULL OR
+         (c.created_at, c.id) < (sqlc.narg('cursor_created_at')::timestamp, sqlc.narg('cursor_id')::text))
+    AND (sqlc.narg('organization_id')::text IS NULL OR c.organization_id = sqlc.narg('organization_id')::text)
+ORDER BY c.created_at DESC, c.id DESC
+LIMIT sqlc.arg('page_size');
+
 -- name: ListDischargedClients :many
 SELECT
     c.id,
@@ -178,9 +261,62 @@ WHERE c.discharge_status IS NOT NULL
          LOWER(c.first_name || ' ' || c.last_name) LIKE LOWER('%' || sqlc.narg('search')::text || '%'))
     AND (sqlc.narg('discharge_status')::discharge_status_enum IS NULL OR
          c.discharge_status = sqlc.narg('discharge_status')::discharge_status_enum)
+    AND (sqlc.narg('organization_id')::text IS NULL OR c.organization_id = sqlc.narg('organization_id')::text)
 ORDER BY c.discharge_date DESC
 LIMIT $1 OFFSET $2;
 
+-- name: ListClientsForExport :many
+-- Keyset page over every client, or one organization's clients, ordered by
+-- id. Pass the last id of the previous page as after_id (NULL for the first
+-- page).
+SELECT
+    c.id,
+    c.first_name,
+    c.last_name,
+    c.bsn,
+    c.date_of_birth,
+    c.gender,
+    c.status,
+    c.care_type,
+    c.care_start_date,
+    c.care_end_date,
+    c.discharge_date,
+    c.created_at,
+    l.name AS location_name,
+    e.first_name AS coordinator_first_name,
+    e.last_name AS coordinator_last_name
+FROM clients c
+JOIN locations l ON c.assigned_location_id = l.id
+JOIN employees e ON c.coordinator_id = e.id
+WHERE (sqlc.narg('after_id')::text IS NULL OR c.id > sqlc.narg('after_id')::text)
+    AND (sqlc.narg('organization_id')::text IS NULL OR c.organization_id = sqlc.narg('organization_id')::text)
+ORDER BY c.id
+LIMIT sqlc.arg('batch_size');
+
+-- name: SearchClients :many
+-- Searches clients in every status by name or BSN prefix, with an optional
+-- status filter. Clients are never soft-deleted, so every match is returned.
+SELECT
+    c.id,
+    c.first_name,
+    c.last_name,
+    c.bsn,
+    c.date_of_birth,
+    c.status,
+    l.id AS location_id,
+    l.name AS location_name,
+    COUNT(*) OVER() AS total_count
+FROM clients c
+JOIN locations l ON c.assigned_location_id = l.id
+WHERE (sqlc.narg('status')::client_status_enum IS NULL OR c.status = sqlc.narg('status')::client_status_enum)
+    AND (LOWER(c.first_name) LIKE LOWER('%' || sqlc.arg('search')::text || '%') OR
+         LOWER(c.last_name) LIKE LOWER('%' || sqlc.arg('search')::text || '%') OR
+         LOWER(c.first_name || ' ' || c.last_name) LIKE LOWER('%' || sqlc.arg('search')::text || '%') OR
+         c.bsn LIKE sqlc.arg('search')::text || '%')
+    AND (sqlc.narg('organization_id')::text IS NULL OR c.organization_id = sqlc.narg('organization_id')::text)
+ORDER BY c.last_name, c.first_name, c.id
+LIMIT $1 OFFSET $2;
+
 -- name: UpdateClientByRegistrationFormID :exec
 UPDATE clients SET
     first_name = COALESCE(sqlc.narg('first_name'), first_name),
@@ -218,7 +354,8 @@ WHERE status = 'waiting_list';
 -- name: GetInCareStats :one
 SELECT 
     COUNT(*) as total_count,
-    COALESCE(AVG(EXTRACT(EPOCH FROM (CURRENT_TIMESTAMP - care_start_date)) / 86400), 0) as avg_days_in_care,
+    COALESCE(AVG(CURRENT_DATE - care_start_date), 0)::DOUBLE PRECISION as avg_days_in_care,
+    COALESCE(MAX(CURRENT_DATE - care_start_date), 0)::INT as longest_stay_days,
     COUNT(*) FILTER (WHERE care_type = 'protected_living') as protected_living_count,
     COUNT(*) FILTER (WHERE care_type = 'semi_independent_living') as semi_independent_living_count,
     COUNT(*) FILTER (WHERE care_type = 'independent_assisted_living') as independent_assisted_living_count,
@@ -238,4 +375,24 @@ SELECT
     END as discharge_completion_rate,
     COALESCE(AVG(discharge_date - care_start_date) FILTER (WHERE discharge_date IS NOT NULL AND care_start_date IS NOT NULL), 0)::DOUBLE PRECISION as avg_days_in_care
 FROM clients
-WHERE discharge_status IS NOT NULL;
\ No newline at end of file
+WHERE discharge_status IS NOT NULL;
+
+-- name: GetDischargeTrendByMonth :many
+-- Discharges per calendar month over the last @months months, ending with the month
+-- starting at @current_month, oldest first. Months without discharges are included.
+SELECT
+    m.month::date AS month,
+    COUNT(c.id) FILTER (WHERE c.reason_for_discharge = 'treatment_completed') AS planned_discharges,
+    COUNT(c.id) FILTER (WHERE c.reason_for_discharge IS NOT NULL AND c.reason_for_discharge != 'treatment_completed') AS premature_discharges,
+    COUNT(c.id) AS total_count
+FROM generate_series(
+    @current_month::date - make_interval(months => @months::int - 1),
+    @current_month::date,
+    INTERVAL '1 month'
+) AS m(month)
+LEFT JOIN clients c
+    ON c.status = 'discharged'
+   AND c.discharge_date >= m.month
+   AND c.discharge_date < m.month + INTERVAL '1 month'
+GROUP BY m.month
+ORDER BY m.month ASC;
\ No newline at end of file
@@ -0,0 +1,67 @@
+-- ============================================================
+-- Coordinator Availability
+-- ============================================================
+
+-- name: CreateCoordinatorAvailability :exec
+INSERT INTO coordinator_availability (
+    id,
+    employee_id,
+    weekday,
+    start_time,
+    end_time
+) VALUES (
+    $1, $2, $3, $4, $5
+);
+
+-- name: DeleteCoordinatorAvailability :exec
+DELETE FROM coordinator_availability WHERE employee_id = $1;
+
+-- name: ListCoordinatorAvailability :many
+SELECT * FROM coordinator_availability
+WHERE employee_id = $1
+ORDER BY weekday;
+
+-- name: GetAvailableIntakeSlots :many
+-- Open intake slots for a coordinator on a date: slot_minutes-long slots inside
+-- their working hours for that weekday that overlap neither a non-rejected
+-- intake nor a non-cancelled appointment they organize or attend. An intake
+-- being rescheduled passes its own id as exclude_intake_id so it does not block
+-- its current slot. Slots are wall-clock times in timezone, which is how they
+-- are compared against appointment timestamps.
+SELECT
+    slot.slot_start::time AS start_time,
+    (slot.slot_start + make_interval(mins => sqlc.arg('slot_minutes')::int))::time AS end_time
+FROM coordinator_availability ca
+CROSS JOIN LATERAL generate_series(
+    sqlc.arg('date')::date + ca.start_time,
+    sqlc.arg('date')::date + ca.end_time - make_interval(mins => sqlc.arg('slot_minutes')::int),
+    make_interval(mins => sqlc.arg('slot_minutes')::int)
+) AS slot(slot_start)
+WHERE ca.employee_id = sqlc.arg('coordinator_id')::text
+  AND ca.weekday = EXTRACT(DOW FROM sqlc.arg('date')::date)::int
+  AND NOT EXISTS (
+      SELECT 1 FROM intake_forms i
+      WHERE i.coordinator_id = ca.employee_id
+        AND i.intake_date = sqlc.arg('date')::date
+        AND i.status <> 'rejected'
+        AND i.is_deleted = FALSE
+        AND (sqlc.narg('exclude_intake_id')::text IS NULL OR i.id <> sqlc.narg('exclude_intake_id')::text)
+        AND i.intake_date + i.intake_time < slot.slot_start + make_interval(mins => sqlc.arg('slot_minutes')::int)
+        AND i.intake_date + i.intake_time + make_interval(mins => sqlc.arg('slot_minutes')::int) > slot.slot_start
+  )
+  AND NOT EXISTS (
+      SELECT 1 FROM appointments a
+      WHERE a.status IS DISTINCT FROM 'cancelled'
+        AND (
+            a.organizer_id = ca.employee_id
+            OR EXISTS (
+                SELECT 1 FROM appointment_participants ap
+                WHERE ap.appointment_id = a.id
+                  AND ap.participant_id = ca.employee_id
+                  AND ap.participant_type = 'employee'
+            )
+        )
+        AND a.start_time < (slot.slot_start + make_interval(mins => sqlc.arg('slot_minutes')::int)) AT TIME ZONE sqlc.arg('timezone')::text
+        AND a.end_time > slot.slot_start AT TIME ZONE sqlc.arg('timezone')::text
+  )
+ORDER BY slot.slot_start;
@@ -1,6 +1,8 @@
 -- ============================================================
 -- Dashboard
 -- ============================================================
+-- today is the current date in the application timezone, passed by the
+-- caller because the session itself stays in UTC
 
 -- name: GetDashboardOverviewStats :one
 SELECT
@@ -15,53 +17,83 @@ SELECT
     (SELECT COUNT(*) FROM incidents WHERE (status = 'pending' OR status = 'under_investigation') AND is_deleted = FALSE) as open_incidents;
 
 -- name: GetCriticalAlertsData :one
+-- Counts are org-wide when coordinator_id is NULL, otherwise limited to that coordinator's caseload
 SELECT
     -- Overdue evaluations (next_evaluation_date < today)
     (SELECT COUNT(*) FROM clients 
      WHERE status = 'in_care' 
      AND next_evaluation_date IS NOT NULL 
-     AND next_evaluation_date < CURRENT_DATE) as overdue_evaluations,
+     AND next_evaluation_date < sqlc.arg('today')::date
+     AND (sqlc.narg('coordinator_id')::text IS NULL OR coordinator_id = sqlc.narg('coordinator_id')::text)) as overdue_evaluations,
     
-    -- Care end date approaching (within 30 days)
+    -- Care end date approaching (within care_ending_soon_days days, inclusive)
     (SELECT COUNT(*) FROM clients 
      WHERE status = 'in_care' 
      AND care_end_date IS NOT NULL 
-     AND care_end_date <= (CURRENT_DATE + INTERVAL '30 days')::date
-     AND care_end_date >= CURRENT_DATE) as care_ending_soon,
+     AND care_end_date <= sqlc.arg('today')::date + sqlc.arg('care_ending_soon_days')::int
+     AND care_end_date >= sqlc.arg('today')::date
+     AND (sqlc.narg('coordinator_id')::text IS NULL OR coordinator_id = sqlc.narg('coordinator_id')::text)) as care_ending_soon,
     
     -- Open incidents (pending or under_investigation)
     (SELECT COUNT(*) FROM incidents 
      WHERE (status = 'pending' OR status = 'under_investigation') 
-     AND is_deleted = FALSE) as open_incidents,
+     AND is_deleted = FALSE
+     AND (sqlc.narg('coordinator_id')::text IS NULL OR coordinator_id = sqlc.narg('coordinator_id')::text)) as open_incidents,
     
     -- Severe incidents count (for description)
     (SELECT COUNT(*) FROM incidents 
      WHERE (status = 'pending' OR status = 'under_investigation') 
      AND incident_severity = 'severe'
-     AND is_deleted = FALSE) as severe_incidents,
+     AND is_deleted = FALSE
+     AND (sqlc.narg('coordinator_id')::text IS NULL OR coordinator_id = sqlc.narg('coordinator_id')::text)) as severe_incidents,
     
     -- Moderate incidents count (for description)
     (SELECT COUNT(*) FROM incidents 
      WHERE (status = 'pending' OR status = 'under_investigation') 
      AND incident_severity = 'moderate'
-     AND is_deleted = FALSE) as moderate_incidents,
+     AND is_deleted = FALSE
+     AND (sqlc.narg('coordinator_id')::text IS NULL OR coordinator_id = sqlc.narg('coordinator_id')::text)) as moderate_incidents,
+    
+    -- Open incidents the worker escalated for passing their severity's SLA
+    (SELECT COUNT(*) FROM incidents 
+     WHERE (status = 'pending' OR status = 'under_investigation') 
+     AND escalated_at IS NOT NULL
+     AND is_deleted = FALSE
+     AND (sqlc.narg('coordinator_id')::text IS NULL OR coordinator_id = sqlc.narg('coordinator_id')::text)) as escalated_incidents,
     
     -- High priority waiting list
     (SELECT COUNT(*) FROM clients 
      WHERE status = 'waiting_list' 
-     AND waiting_list_priority = 'high') as high_priority_waiting,
+     AND waiting_list_priority = 'high'
+     AND (sqlc.narg('coordinator_id')::text IS NULL OR coordinator_id = sqlc.narg('coordinator_id')::text)) as high_priority_waiting,
     
     -- Pending location transfers
     (SELECT COUNT(*) FROM client_location_transfers 
-     WHERE status = 'pending') as pending_transfers;
+     WHERE status = 'pending'
+     AND (sqlc.narg('coordinator_id')::text IS NULL
+          OR current_coordinator_id = sqlc.narg('coordinator_id')::text
+          OR new_coordinator_id = sqlc.narg('coordinator_id')::text)) as pending_transfers;
 
 -- name: GetPipelineStats :one
+-- Pipeline totals. With a from/to date range (inclusive, either end may be
+-- left open) forms only count when created in it and clients only when their
+-- record was last updated in it; without one the counts are current totals
 SELECT
-    (SELECT COUNT(*) FROM registration_forms WHERE is_deleted = FALSE) as registrations,
-    (SELECT COUNT(*) FROM intake_forms) as intakes,
-    (SELECT COUNT(*) FROM clients WHERE status = 'waiting_list') as waiting_list,
-    (SELECT COUNT(*) FROM clients WHERE status = 'in_care') as in_care,
-    (SELECT COUNT(*) FROM clients WHERE status = 'discharged') as discharged;
+    (SELECT COUNT(*) FROM registration_forms WHERE is_deleted = FALSE
+        AND (sqlc.narg('from')::date IS NULL OR created_at::date >= sqlc.narg('from')::date)
+        AND (sqlc.narg('to')::date IS NULL OR created_at::date <= sqlc.narg('to')::date)) as registrations,
+    (SELECT COUNT(*) FROM intake_forms WHERE is_deleted = FALSE
+        AND (sqlc.narg('from')::date IS NULL OR created_at::date >= sqlc.narg('from')::date)
+        AND (sqlc.narg('to')::date IS NULL OR created_at::date <= sqlc.narg('to')::date)) as intakes,
+    (SELECT COUNT(*) FROM clients WHERE status = 'waiting_list'
+        AND (sqlc.narg('from')::date IS NULL OR updated_at::date >= sqlc.narg('from')::date)
+        AND (sqlc.narg('to')::date IS NULL OR updated_at::date <= sqlc.narg('to')::date)) as waiting_list,
+    (SELECT COUNT(*) FROM clients WHERE status = 'in_care'
+        AND (sqlc.narg('from')::date IS NULL OR updated_at::date >= sqlc.narg('from')::date)
+        AND (sqlc.narg('to')::date IS NULL OR updated_at::date <= sqlc.narg('to')::date)) as in_care,
+    (SELECT COUNT(*) FROM clients WHERE status = 'discharged'
+        AND (sqlc.narg('from')::date IS NULL OR updated_at::date >= sqlc.narg('from')::date)
+        AND (sqlc.narg('to')::date IS NULL OR updated_at::date <= sqlc.narg('to')::date)) as discharged;
 
 -- name: GetCareTypeDistribution :one
 SELECT
@@ -71,12 +103,46 @@ SELECT
     (SELECT COUNT(*) FROM clients WHERE status = 'in_care' AND care_type = 'ambulatory_care') as ambulatory_care,
     (SELECT COUNT(*) FROM clients WHERE status = 'in_care') as total;
 
+-- name: GetClientAgeDistribution :many
+-- Buckets in-care clients by age. band_starts holds the inclusive lower bound of each band;
+-- a band ends where the next one starts and the highest band is open-ended. Clients without a
+-- date of birth are counted in a final row with a NULL band_start.
+WITH client_ages AS (
+    SELECT DATE_PART('year', AGE(sqlc.arg('today')::date, date_of_birth))::int AS age
+    FROM clients
+    WHERE status = 'in_care'
+),
+bands AS (
+    SELECT
+        band_start,
+        LEAD(band_start) OVER (ORDER BY band_start) AS band_end
+    FROM UNNEST(sqlc.arg('band_starts')::int[]) AS band_start
+)
+SELECT
+    b.band_start,
+    COUNT(ca.age) AS client_count
+FROM bands b
+LEFT JOIN client_ages ca
+    ON ca.age >= b.band_start
+    AND (b.band_end IS NULL OR ca.age < b.band_end)
+GROUP BY b.band_start
+UNION ALL
+SELECT
+    NULL,
+    COUNT(*)
+FROM client_ages ca
+WHERE ca.age IS NULL
+ORDER BY band_start NULLS LAST;
+
 -- name: GetLocationCapacityList :many
 SELECT
     l.id,
     l.name,
     l.capacity,
-    l.occupied
+    l.occupied,
+    -- Waiting-list clients already targeted at this location
+    (SELECT COUNT(*) FROM clients c
+     WHERE c.assigned_location_id = l.id AND c.status = 'waiting_list') AS waiting_count
 FROM locations l
 WHERE l.is_deleted = FALSE;
 
@@ -88,6 +154,8 @@ FROM locations
 WHERE is_deleted = FALSE;
 
 -- name: GetTodayAppointmentsForEmployee :many
+-- The day runs from day_start up to day_end, both computed by the caller in the
+-- application timezone; the session itself stays in UTC
 SELECT
     a.id,
     a.title,
@@ -112,7 +180,9 @@ SELECT
     )::text as client_name
 FROM appointments a
 WHERE 
-    DATE(a.start_time AT TIME ZONE 'UTC') = CURRENT_DATE
+    a.start_time >= sqlc.arg('day_start')::timestamptz
+    AND a.start_time < sqlc.arg('day_end')::timestamptz
+    AND a.status IS DISTINCT FROM 'cancelled'
     AND (
         a.organizer_id = $1
         OR EXISTS (
@@ -126,21 +196,30 @@ ORDER BY a.start_time ASC;
 
 -- name: GetEvaluationStats :one
 SELECT
-    -- Total in-care clients that need evaluations
-    (SELECT COUNT(*) FROM clients WHERE status = 'in_care')::bigint as total,
-    -- Completed evaluations (submitted status)
-    (SELECT COUNT(DISTINCT client_id) FROM client_evaluations WHERE status = 'submitted')::bigint as completed,
-    -- Overdue evaluations (next_evaluation_date < today)
-    (SELECT COUNT(*) FROM clients 
-     WHERE status = 'in_care' 
-     AND next_evaluation_date IS NOT NULL 
-     AND next_evaluation_date < CURRENT_DATE)::bigint as overdue,
-    -- Due soon (next_evaluation_date within 7 days)
-    (SELECT COUNT(*) FROM clients 
-     WHERE status = 'in_care' 
-     AND next_evaluation_date IS NOT NULL 
-     AND next_evaluation_date >= CURRENT_DATE
-     AND next_evaluation_date <= (CURRENT_DATE + INTERVAL '7 days')::date)::bigint as due_soon;
+    -- Evaluations of in-care clients that have come due
+    (SELECT COUNT(*) FROM evaluations e
+     JOIN clients c ON e.client_id = c.id
+     WHERE c.status = 'in_care'
+     AND e.scheduled_date <= sqlc.arg('today')::date)::bigint as total,
+    -- Of those, evaluations that have been completed
+    (SELECT COUNT(*) FROM evaluations e
+     JOIN clients c ON e.client_id = c.id
+     WHERE c.status = 'in_care'
+     AND e.scheduled_date <= sqlc.arg('today')::date
+     AND e.completed_date IS NOT NULL)::bigint as completed,
+    -- Open evaluations past their scheduled date
+    (SELECT COUNT(*) FROM evaluations e
+     JOIN clients c ON e.client_id = c.id
+     WHERE c.status = 'in_care'
+     AND e.completed_date IS NULL
+     AND e.scheduled_date < sqlc.arg('today')::date)::bigint as overdue,
+    -- Open evaluations scheduled within the next due_soon_days days
+    (SELECT COUNT(*) FROM evaluations e
+     JOIN clients c ON e.client_id = c.id
+     WHERE c.status = 'in_care'
+     AND e.completed_date IS NULL
+     AND e.scheduled_date >= sqlc.arg('today')::date
+     AND e.scheduled_date <= sqlc.arg('today')::date + sqlc.arg('due_soon_days')::int)::bigint as due_soon;
 
 -- name: GetDashboardDischargeStats :one
 SELECT
@@ -148,13 +227,13 @@ SELECT
     (SELECT COUNT(*) FROM clients 
      WHERE status = 'discharged' 
      AND discharge_date IS NOT NULL
-     AND EXTRACT(MONTH FROM discharge_date) = EXTRACT(MONTH FROM CURRENT_DATE)
-     AND EXTRACT(YEAR FROM discharge_date) = EXTRACT(YEAR FROM CURRENT_DATE))::bigint as this_month,
+     AND EXTRACT(MONTH FROM discharge_date) = EXTRACT(MONTH FROM sqlc.arg('today')::date)
+     AND EXTRACT(YEAR FROM discharge_date) = EXTRACT(YEAR FROM sqlc.arg('today')::date))::bigint as this_month,
     -- Discharged this year
     (SELECT COUNT(*) FROM clients 
      WHERE status = 'discharged' 
      AND discharge_date IS NOT NULL
-     AND EXTRACT(YEAR FROM discharge_date) = EXTRACT(YEAR FROM CURRENT_DATE))::bigint as this_year,
+     AND EXTRACT(YEAR FROM discharge_date) = EXTRACT(YEAR FROM sqlc.arg('today')::date))::bigint as this_year,
     -- Total discharged clients
     (SELECT COUNT(*) FROM clients WHERE status = 'discharged')::bigint as total_discharged,
     -- Planned discharges (those with a reason indicating planned)
@@ -180,15 +259,15 @@ SELECT
      WHERE c1.coordinator_id = $1
      AND c1.status = 'in_care' 
      AND c1.next_evaluation_date IS NOT NULL 
-     AND c1.next_evaluation_date < CURRENT_DATE)::bigint as overdue_evaluations,
+     AND c1.next_evaluation_date < sqlc.arg('today')::date)::bigint as overdue_evaluations,
     
     -- Contracts expiring within 7 days for coordinator's clients
     (SELECT COUNT(*) FROM clients c2
      WHERE c2.coordinator_id = $1
      AND c2.status = 'in_care' 
      AND c2.care_end_date IS NOT NULL 
-     AND c2.care_end_date >= CURRENT_DATE
-     AND c2.care_end_date <= (CURRENT_DATE + INTERVAL '7 days')::date)::bigint as expiring_contracts,
+     AND c2.care_end_date >= sqlc.arg('today')::date
+     AND c2.care_end_date <= sqlc.arg('today')::date + 7)::bigint as expiring_contracts,
     
     -- Draft evaluations not completed by coordinator
     (SELECT COUNT(*) FROM client_evaluations ce
@@ -206,7 +285,18 @@ SELECT
      WHERE c3.coordinator_id = $1
      AND c3.status = 'waiting_list' 
      AND c3.created_at IS NOT NULL
-     AND c3.created_at < (CURRENT_DATE - INTERVAL '60 days')::date)::bigint as long_waiting;
+     AND c3.created_at < sqlc.arg('today')::date - 60)::bigint as long_waiting,
+    
+    -- Pending transfers that would hand clients to this coordinator
+    (SELECT COUNT(*) FROM client_location_transfers t
+     WHERE t.new_coordinator_id = $1
+     AND t.status = 'pending')::bigint as pending_transfers,
+    
+    -- High-priority waiting list clients for coordinator
+    (SELECT COUNT(*) FROM clients c4
+     WHERE c4.coordinator_id = $1
+     AND c4.status = 'waiting_list'
+     AND c4.waiting_list_priority = 'high')::bigint as high_priority_waiting;
 
 
 -- name: GetCoordinatorOverdueEvaluationClients :many
@@ -215,7 +305,7 @@ FROM clients
 WHERE coordinator_id = $1
 AND status = 'in_care'
 AND next_evaluation_date IS NOT NULL
-AND next_evaluation_date < CURRENT_DATE
+AND next_evaluation_date < sqlc.arg('today')::date
 LIMIT 5;
 
 -- name: GetCoordinatorExpiringContractClients :many
@@ -224,8 +314,8 @@ FROM clients
 WHERE coordinator_id = $1
 AND status = 'in_care'
 AND care_end_date IS NOT NULL
-AND care_end_date >= CURRENT_DATE
-AND care_end_date <= (CURRENT_DATE + INTERVAL '7 days')::date
+AND care_end_date >= sqlc.arg('today')::date
+AND care_end_date <= sqlc.arg('today')::date + 7
 LIMIT 5;
 
 -- name: GetCoordinatorDraftEvaluationClients :many
@@ -251,10 +341,28 @@ FROM clients
 WHERE coordinator_id = $1
 AND status = 'waiting_list'
 AND created_at IS NOT NULL
-AND created_at < (CURRENT_DATE - INTERVAL '60 days')::date
+AND created_at < sqlc.arg('today')::date - 60
+LIMIT 5;
+
+-- name: GetCoordinatorPendingTransferClients :many
+SELECT DISTINCT c.id, c.first_name, c.last_name
+FROM clients c
+JOIN client_location_transfers t ON t.client_id = c.id
+WHERE t.new_coordinator_id = $1
+AND t.status = 'pending'
+LIMIT 5;
+
+-- name: GetCoordinatorHighPriorityWaitingClients :many
+SELECT id, first_name, last_name
+FROM clients
+WHERE coordinator_id = $1
+AND status = 'waiting_list'
+AND waiting_list_priority = 'high'
 LIMIT 5;
 
 -- name: GetCoordinatorTodaySchedule :many
+-- The day runs from day_start up to day_end, both computed by the caller in the
+-- application timezone; the session itself stays in UTC
 SELECT
     a.id,
     a.title,
@@ -286,7 +394,8 @@ SELECT
     COALESCE(a.location, '')::text as location_name
 FROM appointments a
 WHERE 
-    DATE(a.start_time AT TIME ZONE 'UTC') = CURRENT_DATE
+    a.start_time >= sqlc.arg('day_start')::timestamptz
+    AND a.start_time < sqlc.arg('day_end')::timestamptz
     AND (
         a.organizer_id = $1
         OR EXISTS (
@@ -308,12 +417,13 @@ SELECT
      WHERE c2.coordinator_id = $1 
      AND c2.status = 'in_care' 
      AND c2.next_evaluation_date IS NOT NULL 
-     AND c2.next_evaluation_date >= CURRENT_DATE
-     AND c2.next_evaluation_date <= (CURRENT_DATE + INTERVAL '30 days')::date)::bigint as my_upcoming_evaluations,
+     AND c2.next_evaluation_date >= sqlc.arg('today')::date
+     AND c2.next_evaluation_date <= sqlc.arg('today')::date + 30)::bigint as my_upcoming_evaluations,
     
     (SELECT COUNT(*) FROM intake_forms i
      WHERE i.coordinator_id = $1 
-     AND i.status = 'pending')::bigint as my_pending_intakes,
+     AND i.status = 'pending'
+     AND i.is_deleted = FALSE)::bigint as my_pending_intakes,
     
     (SELECT COUNT(*) FROM clients c3
      WHERE c3.coordinator_id = $1 
@@ -14,9 +14,10 @@ INSERT INTO employees (
     gender,
     contract_hours,
     contract_type,
-    location_id
+    location_id,
+    organization_id
 ) VALUES (
- $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+ $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
 );
 
 -- name: ListEmployees :many
@@ -67,6 +68,7 @@ SELECT
     e.contract_hours,
     e.contract_type,
     e.location_id,
+    e.max_caseload,
     l.name as location_name,
     u.email,
     r.id as role_id,
@@ -81,7 +83,7 @@ LEFT JOIN clients c ON c.coordinator_id = e.id
 WHERE e.id = $1
 GROUP BY e.id, e.user_id, e.first_name, e.last_name, e.bsn, e.date_of_birth, 
          e.phone_number, e.gender, e.contract_hours, e.contract_type, e.location_id,
-         l.name, u.email, r.id, r.name
+         e.max_caseload, l.name, u.email, r.id, r.name
 LIMIT 1;
 
 -- name: GetEmployeeByUserID :one
@@ -100,7 +102,9 @@ SELECT
     e.created_at,
     e.updated_at,
     e.is_deleted,
+    e.organization_id,
     u.email,
+    u.last_login_at,
     r.id as role_id,
     r.name as role_name,
     l.name as location_name,
@@ -116,23 +120,69 @@ LEFT JOIN clients c ON c.coordinator_id = e.id
 WHERE e.user_id = $1
 GROUP BY e.id, e.user_id, e.first_name, e.last_name, e.bsn, e.date_of_birth,
          e.phone_number, e.gender, e.contract_hours, e.contract_type, e.location_id,
-         e.created_at, e.updated_at, e.is_deleted, u.email, r.id, r.name,
-         l.name, l.address, l.postal_code
+         e.created_at, e.updated_at, e.is_deleted, e.organization_id, u.email, u.last_login_at,
+         r.id, r.name, l.name, l.address, l.postal_code
 LIMIT 1;
 
 -- name: UpdateEmployee :exec
+-- BSN is immutable after onboarding and intentionally not updatable here.
+-- clear_max_caseload resets the employee's cap to the default.
 UPDATE employees SET
     first_name = COALESCE(sqlc.narg('first_name'), first_name),
     last_name = COALESCE(sqlc.narg('last_name'), last_name),
-    bsn = COALESCE(sqlc.narg('bsn'), bsn),
     date_of_birth = COALESCE(sqlc.narg('date_of_birth'), date_of_birth),
     phone_number = COALESCE(sqlc.narg('phone_number'), phone_number),
     gender = COALESCE(sqlc.narg('gender'), gender),
     contract_hours = COALESCE(sqlc.narg('contract_hours'), contract_hours),
     contract_type = COALESCE(sqlc.narg('contract_type'), contract_type),
     location_id = COALESCE(sqlc.narg('location_id'), location_id),
+    max_caseload = CASE
+        WHEN sqlc.arg('clear_max_caseload')::boolean THEN NULL
+        ELSE COALESCE(sqlc.narg('max_caseload'), max_caseload)
+    END,
     updated_at = now()
 WHERE id = $1;
 
 -- name: SoftDeleteEmployee :exec
 UPDATE employees SET is_deleted = true, updated_at = now() WHERE id = $1;
+
+-- name: SuggestReplacementCoordinator :one
+-- Active coordinator at the employee's location with the fewest in-care clients
+SELECT
+    e.id,
+    e.first_name,
+    e.last_name,
+    e.location_id,
+    COUNT(c.id) AS caseload
+FROM employees e
+LEFT JOIN clients c ON c.coordinator_id = e.id AND c.status = 'in_care'
+WHERE e.is_deleted = FALSE
+  AND e.id <> sqlc.arg('employee_id')::text
+  AND e.location_id = (SELECT location_id FROM employees WHERE id = sqlc.arg('employee_id')::text)
+  AND EXISTS (
+      SELECT 1 FROM user_roles ur
+      JOIN roles r ON ur.role_id = r.id
+      WHERE ur.user_id = e.user_id AND r.name = 'coordinator'
+  )
+GROUP BY e.id, e.first_name, e.last_name, e.location_id
+ORDER BY caseload, e.last_name, e.first_name, e.id
+LIMIT 1;
+
+-- name: GetCoordinatorCaseload :one
+-- The coordinator's own cap and active (waiting list or in care) client count,
+-- leaving out the client being assigned so a reassignment isn't counted twice
+SELECT
+    e.max_caseload,
+    COUNT(c.id) AS caseload
+FROM employees e
+LEFT JOIN clients c ON c.coordinator_id = e.id
+    AND c.status IN ('waiting_list', 'in_care')
+    AND c.id <> sqlc.arg('exclude_client_id')::text
+WHERE e.id = sqlc.arg('coordinator_id')::text
+GROUP BY e.id, e.max_caseload;
+
+-- name: LockEmployee :one
+-- Locks the employee row for the rest of the transaction so checks on their
+-- schedule or caseload and the write that depends on them are not interleaved
+-- with another transaction doing the same
+SELECT id FROM employees WHERE id = $1 FOR UPDATE;
@@ -0,0 +1,79 @@
+-- ============================================================
+-- Evaluations
+-- ============================================================
+
+-- name: CreateEvaluationRecord :one
+INSERT INTO evaluations (
+    id,
+    client_id,
+    coordinator_id,
+    scheduled_date,
+    notes
+) VALUES (
+    $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: CompleteEvaluationRecord :one
+-- Returns pgx.ErrNoRows when the evaluation does not exist or is already completed.
+UPDATE evaluations
+SET
+    completed_date = sqlc.arg('completed_date')::date,
+    outcome = sqlc.arg('outcome')::evaluation_outcome_enum,
+    notes = COALESCE(sqlc.narg('notes'), notes),
+    updated_at = NOW()
+WHERE id = $1 AND completed_date IS NULL
+RETURNING *;
+
+-- name: ListEvaluationRecordsByClient :many
+SELECT
+    e.*,
+    emp.first_name AS coordinator_first_name,
+    emp.last_name AS coordinator_last_name,
+    COUNT(*) OVER() AS total_count
+FROM evaluations e
+JOIN employees emp ON e.coordinator_id = emp.id
+WHERE e.client_id = $1
+ORDER BY e.scheduled_date DESC
+LIMIT $2 OFFSET $3;
+
+-- name: ListOverdueEvaluationRecordsByClient :many
+-- Open evaluation records scheduled before today
+SELECT id, coordinator_id, scheduled_date
+FROM evaluations
+WHERE client_id = $1
+  AND completed_date IS NULL
+  AND scheduled_date < sqlc.arg('today')::date
+ORDER BY scheduled_date;
+
+-- name: RecordSubmittedEvaluation :exec
+-- Completes the client's open evaluation records with a submitted assessment,
+-- or records a completed one when none was scheduled. The outcome follows the
+-- assessment's goal progress: an adjusted goal gives plan_adjusted, a delayed,
+-- stagnant or deteriorating one needs_attention, anything else on_track.
+WITH outcome AS (
+    SELECT CASE
+        WHEN bool_or(status = 'adjusted') THEN 'plan_adjusted'
+        WHEN bool_or(status IN ('delayed', 'stagnant', 'deteriorating')) THEN 'needs_attention'
+        ELSE 'on_track'
+    END::evaluation_outcome_enum AS outcome
+    FROM goal_progress_logs
+    WHERE evaluation_id = sqlc.arg('client_evaluation_id')::text
+),
+completed AS (
+    UPDATE evaluations
+    SET
+        completed_date = sqlc.arg('completed_date')::date,
+        outcome = (SELECT outcome FROM outcome),
+        updated_at = NOW()
+    WHERE client_id = sqlc.arg('client_id')::text AND completed_date IS NULL
+    RETURNING id
+)
+INSERT INTO evaluations (id, client_id, coordinator_id, scheduled_date, completed_date, outcome)
+SELECT
+    sqlc.arg('id')::text,
+    sqlc.arg('client_id')::text,
+    sqlc.arg('coordinator_id')::text,
+    sqlc.arg('completed_date')::date,
+    sqlc.arg('completed_date')::date,
+    (SELECT outcome FROM outcome)
+WHERE NOT EXISTS (SELECT 1 FROM completed);
@@ -0,0 +1,15 @@
+-- ============================================================
+-- Feature Flags
+-- ============================================================
+
+-- name: GetFeatureFlag :one
+SELECT * FROM feature_flags WHERE key = $1;
+
+-- name: SetFeatureFlag :one
+INSERT INTO feature_flags (key, enabled, value)
+VALUES ($1, $2, $3)
+ON CONFLICT (key) DO UPDATE SET
+    enabled = EXCLUDED.enabled,
+    value = EXCLUDED.value,
+    updated_at = NOW()
+RETURNING *;
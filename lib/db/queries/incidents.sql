@@ -10,17 +10,18 @@ INSERT INTO incidents (
     incident_time,
     incident_type,
     incident_severity,
+    incident_category,
     location_id,
     coordinator_id,
     incident_description,
     action_taken,
     other_parties,
-    status
+    status,
+    created_by_user_id
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
 );
 
-
 -- name: ListIncidents :many
 SELECT i.*,
        c.first_name AS client_first_name,
@@ -43,6 +44,49 @@ AND (
 ORDER BY i.incident_date DESC
 LIMIT $1 OFFSET $2;
 
+-- name: ListOverdueIncidents :many
+-- Lists unresolved incidents that have been open longer than their severity's
+-- SLA and are not escalated yet, oldest first. An SLA of 0 hours never
+-- escalates that severity.
+SELECT i.id, i.client_id, i.incident_severity, i.status, i.created_at,
+       c.first_name AS client_first_name,
+       c.last_name AS client_last_name,
+       c.organization_id
+FROM incidents i
+JOIN clients c ON c.id = i.client_id
+WHERE i.escalated_at IS NULL
+  AND i.is_deleted = FALSE
+  AND i.status <> 'completed'
+  AND (
+    (i.incident_severity = 'severe' AND sqlc.arg('severe_hours')::int > 0
+      AND i.created_at <= NOW() - make_interval(hours => sqlc.arg('severe_hours')::int)) OR
+    (i.incident_severity = 'moderate' AND sqlc.arg('moderate_hours')::int > 0
+      AND i.created_at <= NOW() - make_interval(hours => sqlc.arg('moderate_hours')::int)) OR
+    (i.incident_severity = 'minor' AND sqlc.arg('minor_hours')::int > 0
+      AND i.created_at <= NOW() - make_interval(hours => sqlc.arg('minor_hours')::int))
+  )
+ORDER BY i.created_at, i.id;
+
+-- name: LockUnescalatedIncident :one
+-- Locks an incident that is not escalated yet for the rest of the transaction.
+-- Incidents that are escalated or locked by another replica return no rows.
+SELECT id FROM incidents
+WHERE id = $1 AND escalated_at IS NULL
+FOR UPDATE SKIP LOCKED;
+
+-- name: MarkIncidentEscalated :exec
+UPDATE incidents
+SET escalated_at = CURRENT_TIMESTAMP
+WHERE id = $1;
+
+-- name: ListUnresolvedIncidentsByClient :many
+SELECT id, incident_date, incident_type, incident_severity, status
+FROM incidents
+WHERE client_id = $1
+  AND status <> 'completed'
+  AND is_deleted = FALSE
+ORDER BY incident_date;
+
 -- name: GetIncidentStats :one
 SELECT 
     COUNT(*) as total_count,
@@ -63,6 +107,36 @@ SELECT
 FROM incidents
 WHERE is_deleted = FALSE;
 
+-- name: GetIncidentStatsByCategory :many
+-- Counts non-deleted incidents per category; categories without incidents are not returned
+SELECT incident_category, COUNT(*) AS incident_count
+FROM incidents
+WHERE is_deleted = FALSE
+GROUP BY incident_category
+ORDER BY incident_count DESC, incident_category;
+
+-- name: GetIncidentTrendByMonth :many
+-- Non-deleted incidents per calendar month and severity over the last @months months,
+-- ending with the month starting at @current_month, oldest first. Months without
+-- incidents are included.
+SELECT
+    m.month::date AS month,
+    COUNT(i.id) FILTER (WHERE i.incident_severity = 'minor') AS minor_count,
+    COUNT(i.id) FILTER (WHERE i.incident_severity = 'moderate') AS moderate_count,
+    COUNT(i.id) FILTER (WHERE i.incident_severity = 'severe') AS severe_count,
+    COUNT(i.id) AS total_count
+FROM generate_series(
+    @current_month::date - make_interval(months => @months::int - 1),
+    @current_month::date,
+    INTERVAL '1 month'
+) AS m(month)
+LEFT JOIN incidents i
+    ON i.is_deleted = FALSE
+   AND i.incident_date >= m.month
+   AND i.incident_date < m.month + INTERVAL '1 month'
+GROUP BY m.month
+ORDER BY m.month ASC;
+
 -- name: GetIncident :one
 SELECT i.*,
        c.first_name AS client_first_name,
@@ -83,6 +157,7 @@ SET
     incident_time = COALESCE(sqlc.narg('incident_time')::TIME, incident_time),
     incident_type = COALESCE(sqlc.narg('incident_type')::incident_type_enum, incident_type),
     incident_severity = COALESCE(sqlc.narg('incident_severity')::incident_severity_enum, incident_severity),
+    incident_category = COALESCE(sqlc.narg('incident_category')::incident_category_enum, incident_category),
     location_id = COALESCE(sqlc.narg('location_id')::TEXT, location_id),
     coordinator_id = COALESCE(sqlc.narg('coordinator_id')::TEXT, coordinator_id),
     incident_description = COALESCE(sqlc.narg('incident_description')::TEXT, incident_description),
@@ -95,9 +170,13 @@ SET
     updated_at = CURRENT_TIMESTAMP
 WHERE id = $1 AND is_deleted = FALSE;
 
--- name: SoftDeleteIncident :exec
+-- name: SoftDeleteIncident :one
+-- Returns pgx.ErrNoRows when the incident does not exist or is already deleted
 UPDATE incidents
 SET 
     is_deleted = TRUE,
+    deleted_at = CURRENT_TIMESTAMP,
+    deleted_by = $2,
     updated_at = CURRENT_TIMESTAMP
-WHERE id = $1;
\ No newline at end of file
+WHERE id = $1 AND is_deleted = FALSE
+RETURNING client_id;
\ No newline at end of file
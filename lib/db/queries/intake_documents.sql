@@ -0,0 +1,33 @@
+-- ============================================================
+-- Intake Document Checklist
+-- ============================================================
+
+-- name: CreateIntakeDocumentChecklist :exec
+-- Adds a missing item for every document the intake's care type requires
+INSERT INTO intake_required_documents (intake_form_id, document_type)
+SELECT i.id, req.document_type
+FROM intake_forms i
+JOIN registration_forms r ON r.id = i.registration_form_id
+JOIN intake_document_requirements req ON req.care_type = r.care_type
+WHERE i.id = $1
+ON CONFLICT DO NOTHING;
+
+-- name: ListIntakeDocuments :many
+SELECT * FROM intake_required_documents
+WHERE intake_form_id = $1
+ORDER BY document_type;
+
+-- name: UpdateIntakeDocumentStatus :one
+-- received_at keeps the first time the document was marked received
+UPDATE intake_required_documents
+SET status = sqlc.arg('status'),
+    received_at = CASE WHEN sqlc.arg('status') = 'received' THEN COALESCE(received_at, NOW()) END,
+    updated_by_user_id = sqlc.narg('updated_by_user_id'),
+    updated_at = NOW()
+WHERE intake_form_id = sqlc.arg('intake_form_id')
+  AND document_type = sqlc.arg('document_type')
+RETURNING *;
+
+-- name: CountMissingIntakeDocuments :one
+SELECT COUNT(*) FROM intake_required_documents
+WHERE intake_form_id = $1 AND status = 'missing';
@@ -17,9 +17,10 @@ INSERT INTO intake_forms (
     limitations,
     focus_areas,
     notes,
-    evaluation_interval_weeks
+    evaluation_interval_weeks,
+    created_by_user_id
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
 );
 
 -- name: ListIntakeForms :many
@@ -47,7 +48,8 @@ LEFT JOIN referring_orgs ro ON r.reffering_org_id = ro.id
 LEFT JOIN locations l ON i.location_id = l.id
 LEFT JOIN employees e ON i.coordinator_id = e.id
 WHERE
-    (
+    i.is_deleted = FALSE
+    AND (
         -- If search term is NULL or empty, ignore filters
         $3::text IS NULL OR $3::text = '' OR
         -- Search by client first name
@@ -57,13 +59,27 @@ WHERE
         -- Search by org name
         ro.name ILIKE '%' || $3 || '%'
     )
-ORDER BY i.created_at DESC  
+-- @sort is a key from util.SortOrder; the CASE arms only compare it against
+-- fixed strings. Unmatched arms are NULL for every row, so the default order
+-- (newest first) decides.
+ORDER BY
+    CASE WHEN sqlc.arg('sort')::text = 'intake_date_asc' THEN i.intake_date END ASC,
+    CASE WHEN sqlc.arg('sort')::text = 'intake_date_asc' THEN i.intake_time END ASC,
+    CASE WHEN sqlc.arg('sort')::text = 'intake_date_desc' THEN i.intake_date END DESC,
+    CASE WHEN sqlc.arg('sort')::text = 'intake_date_desc' THEN i.intake_time END DESC,
+    CASE WHEN sqlc.arg('sort')::text = 'name_asc' THEN r.last_name END ASC,
+    CASE WHEN sqlc.arg('sort')::text = 'name_asc' THEN r.first_name END ASC,
+    CASE WHEN sqlc.arg('sort')::text = 'name_desc' THEN r.last_name END DESC,
+    CASE WHEN sqlc.arg('sort')::text = 'name_desc' THEN r.first_name END DESC,
+    CASE WHEN sqlc.arg('sort')::text = 'created_at_asc' THEN i.created_at END ASC,
+    i.created_at DESC,
+    i.id
 LIMIT $1 OFFSET $2;
 
 
 
 -- name: GetIntakeForm :one
-SELECT * FROM intake_forms WHERE id = $1;
+SELECT * FROM intake_forms WHERE id = $1 AND is_deleted = FALSE;
 
 -- name: GetIntakeFormWithDetails :one
 SELECT
@@ -82,6 +98,7 @@ SELECT
     i.status,
     i.created_at,
     i.updated_at,
+    i.created_by_user_id,
     r.first_name as client_first_name,
     r.last_name as client_last_name,
     r.bsn as client_bsn,
@@ -91,13 +108,17 @@ SELECT
     e.first_name as coordinator_first_name,
     e.last_name as coordinator_last_name,
     (SELECT c.id FROM clients c WHERE c.intake_form_id = i.id LIMIT 1) AS client_id,
-    EXISTS (SELECT 1 FROM clients c WHERE c.intake_form_id = i.id) AS has_client
+    EXISTS (SELECT 1 FROM clients c WHERE c.intake_form_id = i.id) AS has_client,
+    NOT EXISTS (
+        SELECT 1 FROM intake_required_documents d
+        WHERE d.intake_form_id = i.id AND d.status = 'missing'
+    ) AS documents_complete
 FROM intake_forms i
 LEFT JOIN registration_forms r ON i.registration_form_id = r.id
 LEFT JOIN referring_orgs ro ON r.reffering_org_id = ro.id
 LEFT JOIN locations l ON i.location_id = l.id
 LEFT JOIN employees e ON i.coordinator_id = e.id
-WHERE i.id = $1;
+WHERE i.id = $1 AND i.is_deleted = FALSE;
 
 -- name: UpdateIntakeFormStatus :exec
 UPDATE intake_forms SET status = $2, updated_at = NOW() WHERE id = $1;
@@ -116,7 +137,7 @@ UPDATE intake_forms SET
     evaluation_interval_weeks = COALESCE(sqlc.narg('evaluation_interval_weeks'), evaluation_interval_weeks),
     status = COALESCE(sqlc.narg('status'), status),
     updated_at = NOW()
-WHERE id = $1;
+WHERE id = $1 AND is_deleted = FALSE;
 
 -- name: GetIntakeStats :one
 SELECT 
@@ -127,4 +148,55 @@ SELECT
             ROUND((COUNT(*) FILTER (WHERE status = 'completed')::DECIMAL / COUNT(*)::DECIMAL) * 100, 2)
         ELSE 0.0
     END)::DOUBLE PRECISION as conversion_percentage
-FROM intake_forms;
\ No newline at end of file
+FROM intake_forms
+WHERE is_deleted = FALSE;
+
+-- name: SoftDeleteIntakeForm :execrows
+-- Intakes that were already converted into a client are left untouched; zero
+-- rows means the intake is missing, already deleted or converted
+UPDATE intake_forms SET is_deleted = TRUE, updated_at = NOW()
+WHERE id = $1
+  AND is_deleted = FALSE
+  AND NOT EXISTS (SELECT 1 FROM clients c WHERE c.intake_form_id = intake_forms.id);
+
+-- name: RecordIntakeReschedule :exec
+-- Call before UpdateIntakeForm with the new date and time; a NULL keeps the
+-- current value. Nothing is written when the appointment does not move.
+INSERT INTO intake_reschedule_history (
+    id,
+    intake_form_id,
+    old_intake_date,
+    old_intake_time,
+    new_intake_date,
+    new_intake_time,
+    changed_by
+)
+SELECT
+    sqlc.arg(id)::text,
+    i.id,
+    i.intake_date,
+    i.intake_time,
+    COALESCE(sqlc.narg(intake_date)::date, i.intake_date),
+    COALESCE(sqlc.narg(intake_time)::time, i.intake_time),
+    NULLIF(sqlc.arg(changed_by)::text, '')
+FROM intake_forms i
+WHERE i.id = sqlc.arg(intake_form_id)
+  AND i.is_deleted = FALSE
+  AND (i.intake_date, i.intake_time) IS DISTINCT FROM (
+      COALESCE(sqlc.narg(intake_date)::date, i.intake_date),
+      COALESCE(sqlc.narg(intake_time)::time, i.intake_time)
+  );
+
+-- name: ListIntakeRescheduleHistory :many
+-- Oldest change first
+SELECT
+    h.id,
+    h.old_intake_date,
+    h.old_intake_time,
+    h.new_intake_date,
+    h.new_intake_time,
+    h.changed_by,
+    h.changed_at
+FROM intake_reschedule_history h
+WHERE h.intake_form_id = $1
+ORDER BY h.changed_at, h.id;
@@ -12,9 +12,10 @@ INSERT INTO client_location_transfers (
     current_coordinator_id,
     new_coordinator_id,
     transfer_date,
-    reason
+    reason,
+    created_by_user_id
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
 )
 RETURNING id, client_id, from_location_id, to_location_id, current_coordinator_id, new_coordinator_id, transfer_date;
 
@@ -31,6 +32,8 @@ SELECT
     clt.reason,
     clt.status,
     clt.rejection_reason,
+    clt.cancellation_reason,
+    clt.handover_notes,
     c.first_name AS client_first_name,
     c.last_name AS client_last_name,
     l_from.name AS from_location_name,
@@ -67,6 +70,12 @@ SELECT
     clt.reason,
     clt.status,
     clt.rejection_reason,
+    clt.cancellation_reason,
+    clt.handover_notes,
+    clt.created_by_user_id,
+    clt.created_at,
+    clt.approved_at,
+    clt.rejected_at,
     c.first_name AS client_first_name,
     c.last_name AS client_last_name,
     l_from.name AS from_location_name,
@@ -85,12 +94,17 @@ WHERE clt.id = $1;
 
 -- name: ConfirmLocationTransfer :exec
 UPDATE client_location_transfers
-SET status = 'approved', transfer_date = NOW(), updated_at = NOW()
+SET status = 'approved', handover_notes = $2, transfer_date = NOW(), approved_at = NOW(), updated_at = NOW()
 WHERE id = $1 AND status = 'pending';
 
 -- name: RefuseLocationTransfer :exec
 UPDATE client_location_transfers
-SET status = 'rejected', rejection_reason = $2, updated_at = NOW()
+SET status = 'rejected', rejection_reason = $2, rejected_at = NOW(), updated_at = NOW()
+WHERE id = $1 AND status = 'pending';
+
+-- name: CancelLocationTransfer :execrows
+UPDATE client_location_transfers
+SET status = 'cancelled', cancellation_reason = $2, updated_at = NOW()
 WHERE id = $1 AND status = 'pending';
 
 -- name: UpdateLocationTransfer :exec
@@ -108,9 +122,15 @@ SELECT
     COUNT(*) FILTER (WHERE status = 'pending') as pending_count,
     COUNT(*) FILTER (WHERE status = 'approved') as approved_count,
     COUNT(*) FILTER (WHERE status = 'rejected') as rejected_count,
+    COUNT(*) FILTER (WHERE status = 'cancelled') as cancelled_count,
     CASE 
         WHEN COUNT(*) FILTER (WHERE status IN ('approved', 'rejected')) > 0 THEN 
             ROUND((COUNT(*) FILTER (WHERE status = 'approved')::DECIMAL / COUNT(*) FILTER (WHERE status IN ('approved', 'rejected'))::DECIMAL) * 100, 2)
         ELSE 0
-    END as approval_rate
+    END as approval_rate,
+    -- Average hours from request to approval or rejection; 0 before any decision
+    COALESCE(
+        EXTRACT(EPOCH FROM AVG(COALESCE(approved_at, rejected_at) - created_at)) / 3600,
+        0
+    )::DOUBLE PRECISION as avg_decision_hours
 FROM client_location_transfers;
\ No newline at end of file
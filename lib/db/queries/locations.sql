@@ -8,9 +8,10 @@ INSERT INTO locations (
    postal_code,
    address,
    capacity,
-   occupied
+   occupied,
+   organization_id
    )
-VALUES ($1, $2, $3, $4, $5, $6);
+VALUES ($1, $2, $3, $4, $5, $6, $7);
 
 -- name: ListLocations :many
 SELECT
@@ -20,6 +21,12 @@ SELECT
     l.address,
     l.capacity,
     l.occupied,
+    ARRAY(
+        SELECT lct.care_type::text
+        FROM location_care_types lct
+        WHERE lct.location_id = l.id
+        ORDER BY lct.care_type
+    )::text[] AS care_types,
     COUNT(*) OVER() as total_count
 FROM locations l
 WHERE
@@ -28,6 +35,7 @@ WHERE
      LOWER(l.name) LIKE LOWER('%' || sqlc.narg('search')::text || '%') OR
      LOWER(l.postal_code) LIKE LOWER('%' || sqlc.narg('search')::text || '%') OR
      LOWER(l.address) LIKE LOWER('%' || sqlc.narg('search')::text || '%'))
+    AND (sqlc.narg('organization_id')::text IS NULL OR l.organization_id = sqlc.narg('organization_id')::text)
 ORDER BY l.name
 LIMIT $1 OFFSET $2;
 
@@ -41,7 +49,16 @@ UPDATE locations
 SET occupied = occupied - 1, updated_at = NOW()
 WHERE id = $1 AND occupied > 0;
 
--- name: UpdateLocation :exec
+-- name: ReserveLocationCapacity :one
+-- Increment occupancy only while the location has free capacity; returns no rows when it is full
+UPDATE locations
+SET occupied = occupied + 1, updated_at = NOW()
+WHERE id = $1 AND occupied < capacity AND is_deleted = FALSE
+RETURNING occupied;
+
+-- name: UpdateLocation :execrows
+-- Zero rows means the location is missing, deleted or, when organization_id
+-- is set, owned by another organization
 UPDATE locations SET
     name = COALESCE(sqlc.narg('name'), name),
     postal_code = COALESCE(sqlc.narg('postal_code'), postal_code),
@@ -49,10 +66,32 @@ UPDATE locations SET
     capacity = COALESCE(sqlc.narg('capacity'), capacity),
     occupied = COALESCE(sqlc.narg('occupied'), occupied),
     updated_at = NOW()
-WHERE id = $1;
+WHERE id = $1
+  AND is_deleted = FALSE
+  AND (sqlc.narg('organization_id')::text IS NULL OR organization_id = sqlc.narg('organization_id')::text);
 
--- name: SoftDeleteLocation :exec
-UPDATE locations SET is_deleted = TRUE, updated_at = NOW() WHERE id = $1;
+-- name: SoftDeleteLocation :execrows
+-- Zero rows means the location is missing, already deleted or, when
+-- organization_id is set, owned by another organization
+UPDATE locations SET is_deleted = TRUE, updated_at = NOW()
+WHERE id = $1
+  AND is_deleted = FALSE
+  AND (sqlc.narg('organization_id')::text IS NULL OR organization_id = sqlc.narg('organization_id')::text);
+
+-- name: GetLocationByID :one
+-- No rows when the location is missing, deleted or, when organization_id is
+-- set, owned by another organization
+SELECT
+    l.id,
+    l.name,
+    l.postal_code,
+    l.address,
+    l.capacity,
+    l.occupied
+FROM locations l
+WHERE l.id = $1
+  AND l.is_deleted = FALSE
+  AND (sqlc.narg('organization_id')::text IS NULL OR l.organization_id = sqlc.narg('organization_id')::text);
 
 -- name: GetLocationCapacityStats :one
 SELECT 
@@ -61,4 +100,57 @@ SELECT
     COALESCE(SUM(l.capacity), 0) - COALESCE(COUNT(c.id) FILTER (WHERE c.status = 'in_care'), 0) as free_capacity
 FROM locations l
 LEFT JOIN clients c ON c.assigned_location_id = l.id
-WHERE l.is_deleted = FALSE;
\ No newline at end of file
+WHERE l.is_deleted = FALSE
+  AND (sqlc.narg('organization_id')::text IS NULL OR l.organization_id = sqlc.narg('organization_id')::text);
+
+-- name: SnapshotLocationCapacity :execrows
+-- Records today's capacity and occupancy for every active location; later runs
+-- on the same day overwrite that day's snapshot
+INSERT INTO location_capacity_snapshots (location_id, snapshot_date, capacity, occupied)
+SELECT id, CURRENT_DATE, capacity, occupied
+FROM locations
+WHERE is_deleted = FALSE
+ON CONFLICT (location_id, snapshot_date) DO UPDATE SET
+    capacity = EXCLUDED.capacity,
+    occupied = EXCLUDED.occupied;
+
+-- name: GetLocationCapacityTrend :many
+-- Daily snapshots of one location between two dates (inclusive), oldest first
+SELECT snapshot_date, capacity, occupied
+FROM location_capacity_snapshots
+WHERE location_id = @location_id
+  AND snapshot_date BETWEEN @start_date::date AND @end_date::date
+ORDER BY snapshot_date ASC;
+
+-- name: AddLocationCareTypes :exec
+INSERT INTO location_care_types (location_id, care_type)
+SELECT sqlc.arg('location_id'), unnest(sqlc.arg('care_types')::text[])::care_type_enum
+ON CONFLICT DO NOTHING;
+
+-- name: DeleteLocationCareTypes :exec
+DELETE FROM location_care_types WHERE location_id = $1;
+
+-- name: ListPlacementCandidatesForOrganization :many
+-- Active locations of the organization with a free bed that offer the care type
+-- (or list no care types at all), most available beds first
+SELECT
+    l.id,
+    l.name,
+    l.postal_code,
+    l.address,
+    l.capacity,
+    l.occupied,
+    (l.capacity - l.occupied)::int AS available
+FROM locations l
+WHERE
+    l.organization_id = sqlc.arg('organization_id')::text
+    AND l.is_deleted = FALSE
+    AND l.occupied < l.capacity
+    AND (
+        NOT EXISTS (SELECT 1 FROM location_care_types lct WHERE lct.location_id = l.id)
+        OR EXISTS (
+            SELECT 1 FROM location_care_types lct
+            WHERE lct.location_id = l.id AND lct.care_type = sqlc.arg('care_type')::care_type_enum
+        )
+    )
+ORDER BY available DESC, l.name;
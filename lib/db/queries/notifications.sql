@@ -41,6 +41,15 @@ UPDATE notifications
 SET is_read = TRUE, read_at = CURRENT_TIMESTAMP
 WHERE user_id = $1 AND is_read = FALSE;
 
+-- name: MarkNotificationsReadByResource :exec
+-- Marks every unread notification of the user that points at the resource
+UPDATE notifications
+SET is_read = TRUE, read_at = CURRENT_TIMESTAMP
+WHERE user_id = sqlc.arg('user_id')
+    AND resource_type = sqlc.arg('resource_type')::text
+    AND resource_id = sqlc.arg('resource_id')::text
+    AND is_read = FALSE;
+
 -- name: DeleteNotification :exec
 DELETE FROM notifications
 WHERE id = $1 AND user_id = $2;
@@ -48,3 +57,59 @@ WHERE id = $1 AND user_id = $2;
 -- name: DeleteExpiredNotifications :exec
 DELETE FROM notifications
 WHERE expires_at IS NOT NULL AND expires_at < CURRENT_TIMESTAMP;
+
+-- name: GetNotificationQuietHours :one
+SELECT * FROM notification_quiet_hours
+WHERE user_id = $1;
+
+-- name: UpsertNotificationQuietHours :one
+INSERT INTO notification_quiet_hours (
+    user_id,
+    start_time,
+    end_time,
+    timezone
+) VALUES (
+    $1, $2, $3, $4
+)
+ON CONFLICT (user_id) DO UPDATE SET
+    start_time = EXCLUDED.start_time,
+    end_time = EXCLUDED.end_time,
+    timezone = EXCLUDED.timezone,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING *;
+
+-- name: DeleteNotificationQuietHours :exec
+DELETE FROM notification_quiet_hours
+WHERE user_id = $1;
+
+-- name: CreateNotificationDeadLetter :exec
+INSERT INTO notification_dead_letters (
+    id,
+    notification_id,
+    user_id,
+    channel,
+    error
+) VALUES (
+    $1, $2, $3, $4, $5
+);
+
+-- name: NotificationResourceExists :one
+-- Reports whether the record a notification deep-links to exists and is not
+-- soft-deleted. Unknown resource types are reported as missing, so a new type
+-- must be added here before notifications can link to it.
+SELECT (CASE @resource_type::text
+    WHEN 'client' THEN EXISTS (SELECT 1 FROM clients WHERE id = @resource_id::text)
+    -- Evaluation reminders link to the client being evaluated
+    WHEN 'evaluation' THEN EXISTS (SELECT 1 FROM clients WHERE id = @resource_id::text)
+    WHEN 'incident' THEN EXISTS (
+        SELECT 1 FROM incidents WHERE id = @resource_id::text AND is_deleted = FALSE
+    )
+    WHEN 'appointment' THEN EXISTS (SELECT 1 FROM appointments WHERE id = @resource_id::text)
+    WHEN 'location_transfer' THEN EXISTS (
+        SELECT 1 FROM client_location_transfers WHERE id = @resource_id::text
+    )
+    WHEN 'registration' THEN EXISTS (
+        SELECT 1 FROM registration_forms WHERE id = @resource_id::text AND is_deleted = FALSE
+    )
+    ELSE FALSE
+END)::boolean AS resource_exists;
@@ -0,0 +1,7 @@
+-- ============================================================
+-- Organizations
+-- ============================================================
+
+-- name: CreateOrganization :exec
+INSERT INTO organizations (id, name)
+VALUES ($1, $2);
@@ -10,3 +10,12 @@ SELECT EXISTS (
       AND p.resource = $2
       AND p.action = $3
 );
+
+-- name: ListPermissionKeysForUser :many
+-- Returns the user's permissions as resource:action, deduplicated across their roles
+SELECT DISTINCT (p.resource || ':' || p.action)::text AS permission
+FROM user_roles ur
+JOIN role_permissions rp ON ur.role_id = rp.role_id
+JOIN permissions p ON rp.permission_id = p.id
+WHERE ur.user_id = $1
+ORDER BY permission;
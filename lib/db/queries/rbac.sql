@@ -20,7 +20,7 @@ SELECT
     (SELECT COUNT(*) FROM role_permissions rp WHERE rp.role_id = r.id) as permission_count,
     (SELECT COUNT(*) FROM user_roles ur WHERE ur.role_id = r.id) as user_count
 FROM roles r
-ORDER BY r.name
+ORDER BY r.name, r.id
 LIMIT $1 OFFSET $2;
 
 -- name: UpdateRole :one
@@ -32,6 +32,12 @@ RETURNING *;
 -- name: DeleteRole :exec
 DELETE FROM roles WHERE id = $1;
 
+-- name: EnsureRole :exec
+-- Creates a preset role if it is missing; an existing role is left as is.
+INSERT INTO roles (id, name, description)
+VALUES ($1, $2, $3)
+ON CONFLICT DO NOTHING;
+
 -- ============================================================
 -- Permissions
 -- ============================================================
@@ -47,7 +53,7 @@ SELECT * FROM permissions WHERE id = $1;
 -- name: ListPermissions :many
 SELECT *, COUNT(*) OVER() as total_count
 FROM permissions
-ORDER BY resource, action
+ORDER BY resource, action, id
 LIMIT $1 OFFSET $2;
 
 -- name: DeletePermission :exec
@@ -63,8 +69,20 @@ VALUES ($1, $2)
 ON CONFLICT DO NOTHING;
 
 -- name: BatchAssignPermissionsToRole :exec
+-- Permission IDs are deduplicated and inserted in sorted order so concurrent
+-- batches with overlapping IDs acquire row locks in the same order.
 INSERT INTO role_permissions (role_id, permission_id)
-SELECT @role_id::text, UNNEST(@permission_ids::text[])
+SELECT @role_id::text, p.permission_id
+FROM (SELECT DISTINCT UNNEST(@permission_ids::text[]) AS permission_id) p
+ORDER BY p.permission_id
+ON CONFLICT DO NOTHING;
+
+-- name: AssignAllPermissionsToRole :exec
+-- Grants the role every permission it does not hold yet.
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT @role_id::text, p.id
+FROM permissions p
+ORDER BY p.id
 ON CONFLICT DO NOTHING;
 
 -- name: DeleteAllPermissionsFromRole :exec
@@ -74,6 +92,12 @@ DELETE FROM role_permissions WHERE role_id = $1;
 DELETE FROM role_permissions
 WHERE role_id = $1 AND permission_id = $2;
 
+-- name: RemovePermissionFromAllRoles :many
+-- Returns the roles that held the permission
+DELETE FROM role_permissions
+WHERE permission_id = $1
+RETURNING role_id;
+
 -- name: ListPermissionsForRole :many
 SELECT p.*
 FROM permissions p
@@ -101,11 +125,25 @@ JOIN user_roles ur ON r.id = ur.role_id
 WHERE ur.user_id = $1;
 
 -- name: ListUsersWithRole :many
-SELECT u.id, u.email
+-- Users holding a role, a page at a time; search matches the email or the
+-- linked employee's name
+SELECT
+    u.id,
+    u.email,
+    e.first_name,
+    e.last_name,
+    COUNT(*) OVER() AS total_count
 FROM users u
 JOIN user_roles ur ON u.id = ur.user_id
+LEFT JOIN employees e ON e.user_id = u.id AND e.is_deleted = FALSE
 WHERE ur.role_id = $1
-ORDER BY u.email;
+  AND (
+    sqlc.narg('search')::text IS NULL OR
+    u.email ILIKE '%' || sqlc.narg('search')::text || '%' OR
+    CONCAT(e.first_name, ' ', e.last_name) ILIKE '%' || sqlc.narg('search')::text || '%'
+  )
+ORDER BY u.email
+LIMIT $2 OFFSET $3;
 
 -- name: GetUserIDsByRoleName :many
 SELECT u.id
@@ -113,3 +151,12 @@ FROM users u
 JOIN user_roles ur ON u.id = ur.user_id
 JOIN roles r ON ur.role_id = r.id
 WHERE r.name = $1;
+
+-- name: GetOrganizationUserIDsByRoleName :many
+-- Returns the users holding a role within one organization
+SELECT u.id
+FROM users u
+JOIN user_roles ur ON u.id = ur.user_id
+JOIN roles r ON ur.role_id = r.id
+WHERE r.name = sqlc.arg('role_name')
+  AND u.organization_id IS NOT DISTINCT FROM sqlc.narg('organization_id')::text;
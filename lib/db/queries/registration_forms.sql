@@ -12,7 +12,7 @@ INSERT INTO registration_forms (
     registration_date,
     registration_reason,
     additional_notes,
-    attachment_ids
+    created_by_user_id
 ) VALUES (
     $1, $2, $3, $4, $5, $6, $7,
     $8, $9, $10, $11, $12, $13
@@ -30,13 +30,13 @@ SELECT r.id,
         r.registration_date,
         r.registration_reason,
         r.additional_notes,
-        r.attachment_ids,
+        (SELECT COUNT(*) FROM registration_form_attachments rfa WHERE rfa.registration_form_id = r.id) AS attachment_count,
         r.status,
         ro.name as org_name,
         ro.contact_person as org_contact_person,
         ro.phone_number as org_phone_number,
         ro.email as org_email,
-        EXISTS (SELECT 1 FROM intake_forms inf WHERE inf.registration_form_id = r.id) AS intake_completed,
+        EXISTS (SELECT 1 FROM intake_forms inf WHERE inf.registration_form_id = r.id AND inf.is_deleted = FALSE) AS intake_completed,
         COUNT(r.id) OVER () AS total_count
 FROM registration_forms r
 LEFT JOIN referring_orgs ro ON r.reffering_org_id = ro.id
@@ -61,7 +61,7 @@ WHERE
         -- If intake_completed is NULL, ignore filter
         sqlc.narg('intake_completed')::boolean IS NULL OR
         -- Filter by intake completion status
-        EXISTS (SELECT 1 FROM intake_forms inf WHERE inf.registration_form_id = r.id) = sqlc.narg('intake_completed')::boolean
+        EXISTS (SELECT 1 FROM intake_forms inf WHERE inf.registration_form_id = r.id AND inf.is_deleted = FALSE) = sqlc.narg('intake_completed')::boolean
     )
 ORDER BY r.created_at DESC
 LIMIT $1 OFFSET $2;
@@ -70,6 +70,12 @@ LIMIT $1 OFFSET $2;
 -- name: GetRegistrationForm :one
 SELECT * FROM registration_forms WHERE id = $1;
 
+-- name: GetRegistrationFormsByBSN :many
+-- Existing registrations for the same person, used to warn about duplicates
+SELECT id FROM registration_forms
+WHERE bsn = $1 AND is_deleted = FALSE
+ORDER BY created_at, id;
+
 -- name: GetRegistrationFormWithDetails :one
 SELECT r.id,
         r.first_name,
@@ -82,20 +88,99 @@ SELECT r.id,
         r.registration_date,
         r.registration_reason,
         r.additional_notes,
-        r.attachment_ids,
         r.status,
+        r.created_by_user_id,
         ro.name as org_name,
         ro.contact_person as org_contact_person,
         ro.phone_number as org_phone_number,
         ro.email as org_email,
-        EXISTS (SELECT 1 FROM intake_forms inf WHERE inf.registration_form_id = r.id) AS intake_completed,
+        EXISTS (SELECT 1 FROM intake_forms inf WHERE inf.registration_form_id = r.id AND inf.is_deleted = FALSE) AS intake_completed,
         EXISTS (SELECT 1 FROM clients c WHERE c.registration_form_id = r.id) AS has_client
 FROM registration_forms r
 LEFT JOIN referring_orgs ro ON r.reffering_org_id = ro.id
 WHERE r.id = $1;
 
 -- name: UpdateRegistrationFormStatus :exec
-UPDATE registration_forms SET status = $2, updated_at = NOW() WHERE id = $1;
+-- Also appends the change to registration_status_history; nothing is recorded
+-- when the status is unchanged.
+WITH updated AS (
+    UPDATE registration_forms r
+    SET status = sqlc.narg(status), updated_at = NOW()
+    FROM registration_forms prev
+    WHERE r.id = sqlc.arg(id) AND prev.id = r.id
+    RETURNING r.id, prev.status AS old_status, r.status AS new_status
+)
+INSERT INTO registration_status_history (
+    id,
+    registration_form_id,
+    old_status,
+    new_status,
+    changed_by
+)
+SELECT
+    sqlc.arg(history_id)::text,
+    u.id,
+    u.old_status,
+    u.new_status,
+    NULLIF(sqlc.arg(changed_by)::text, '')
+FROM updated u
+WHERE u.old_status IS DISTINCT FROM u.new_status;
+
+-- name: GetRegistrationStatusHistory :many
+-- Oldest change first
+SELECT
+    h.id,
+    h.old_status,
+    h.new_status,
+    h.changed_by,
+    h.changed_at
+FROM registration_status_history h
+WHERE h.registration_form_id = $1
+ORDER BY h.changed_at, h.id;
+
+-- name: BatchUpdateRegistrationFormStatus :many
+-- Soft-deleted forms are skipped. history_ids pairs one history row ID with
+-- each form ID; every form whose status changes gets a
+-- registration_status_history entry. Returns one row per updated form with
+-- the user ID of the coordinator assigned through its intake form, if any.
+WITH targets AS (
+    SELECT t.id, t.history_id
+    FROM unnest(sqlc.arg('ids')::text[], sqlc.arg('history_ids')::text[]) AS t(id, history_id)
+),
+updated AS (
+    UPDATE registration_forms r
+    SET status = sqlc.arg('status')::registration_status_enum, updated_at = NOW()
+    FROM registration_forms prev, targets t
+    WHERE r.id = t.id
+      AND prev.id = r.id
+      AND r.is_deleted = FALSE
+    RETURNING r.id, r.first_name, r.last_name, t.history_id, prev.status AS old_status, r.status AS new_status
+),
+history AS (
+    INSERT INTO registration_status_history (
+        id,
+        registration_form_id,
+        old_status,
+        new_status,
+        changed_by
+    )
+    SELECT
+        u.history_id,
+        u.id,
+        u.old_status,
+        u.new_status,
+        NULLIF(sqlc.arg('changed_by')::text, '')
+    FROM updated u
+    WHERE u.old_status IS DISTINCT FROM u.new_status
+)
+SELECT
+    u.id,
+    u.first_name,
+    u.last_name,
+    e.user_id AS coordinator_user_id
+FROM updated u
+LEFT JOIN intake_forms i ON i.registration_form_id = u.id AND i.is_deleted = FALSE
+LEFT JOIN employees e ON e.id = i.coordinator_id;
 
 -- name: UpdateRegistrationForm :exec
 UPDATE registration_forms SET
@@ -111,12 +196,38 @@ UPDATE registration_forms SET
     registration_reason = COALESCE(sqlc.narg('registration_reason'), registration_reason),
     additional_notes = COALESCE(sqlc.narg('additional_notes'), additional_notes),
     status = COALESCE(sqlc.narg('status'), status),
-    attachment_ids = COALESCE(sqlc.narg('attachment_ids'), attachment_ids),
     updated_at = NOW()
 WHERE id = $1;
 
 -- name: SoftDeleteRegistrationForm :exec
-UPDATE registration_forms SET is_deleted = TRUE, updated_at = NOW() WHERE id = $1;
+UPDATE registration_forms SET is_deleted = TRUE, deleted_at = NOW(), updated_at = NOW() WHERE id = $1;
+
+-- name: ListDeletedRegistrationForms :many
+-- Soft-deleted forms for admin review, most recently deleted first
+SELECT r.id,
+        r.first_name,
+        r.last_name,
+        r.bsn,
+        r.date_of_birth,
+        r.care_type,
+        r.registration_date,
+        r.status,
+        r.deleted_at,
+        COUNT(r.id) OVER () AS total_count
+FROM registration_forms r
+WHERE r.is_deleted = TRUE
+ORDER BY r.deleted_at DESC NULLS LAST, r.id
+LIMIT $1 OFFSET $2;
+
+-- name: RestoreRegistrationForm :execrows
+-- Undoes a soft delete unless another active form has taken the BSN since
+UPDATE registration_forms r SET is_deleted = FALSE, deleted_at = NULL, updated_at = NOW()
+WHERE r.id = $1
+  AND r.is_deleted = TRUE
+  AND NOT EXISTS (
+      SELECT 1 FROM registration_forms o
+      WHERE o.bsn = r.bsn AND o.is_deleted = FALSE
+  );
 
 -- name: GetRegistrationStats :one
 SELECT 
@@ -125,3 +236,43 @@ SELECT
     COUNT(*) FILTER (WHERE status = 'in_review') as in_review_count
 FROM registration_forms
 WHERE is_deleted = FALSE;
+
+-- name: ListRegistrationFormAttachments :many
+SELECT
+    rfa.attachment_id,
+    rfa.caption,
+    rfa.sort_order,
+    a.content_type,
+    a.uploaded_at,
+    a.filename
+FROM registration_form_attachments rfa
+JOIN attachments a ON a.id = rfa.attachment_id
+WHERE rfa.registration_form_id = $1
+ORDER BY rfa.sort_order, rfa.created_at;
+
+-- name: SetRegistrationFormAttachments :exec
+-- Links exactly attachment_ids to the form, in that order. Attachments that stay
+-- linked keep their caption; the rest are unlinked.
+WITH removed AS (
+    DELETE FROM registration_form_attachments
+    WHERE registration_form_id = sqlc.arg('registration_form_id')::text
+      AND attachment_id <> ALL(sqlc.arg('attachment_ids')::text[])
+)
+INSERT INTO registration_form_attachments (registration_form_id, attachment_id, sort_order)
+SELECT sqlc.arg('registration_form_id')::text, t.attachment_id, (t.ord - 1)::int
+FROM UNNEST(sqlc.arg('attachment_ids')::text[]) WITH ORDINALITY AS t(attachment_id, ord)
+ON CONFLICT (registration_form_id, attachment_id) DO UPDATE SET sort_order = EXCLUDED.sort_order;
+
+-- name: ReorderRegistrationFormAttachments :execrows
+-- Sets sort_order to each attachment's position in attachment_ids.
+-- IDs that are not linked to the form are ignored.
+UPDATE registration_form_attachments rfa
+SET sort_order = (t.ord - 1)::int
+FROM UNNEST(sqlc.arg('attachment_ids')::text[]) WITH ORDINALITY AS t(attachment_id, ord)
+WHERE rfa.registration_form_id = sqlc.arg('registration_form_id')::text
+  AND rfa.attachment_id = t.attachment_id;
+
+-- name: UpdateRegistrationFormAttachmentCaption :execrows
+UPDATE registration_form_attachments
+SET caption = $3
+WHERE registration_form_id = $1 AND attachment_id = $2;
@@ -0,0 +1,58 @@
+-- ============================================================
+-- Data Retention
+-- ============================================================
+
+-- name: ListClientsDueForPurge :many
+-- Discharged clients past the retention period whose personal data is still stored
+SELECT c.id, c.registration_form_id, c.intake_form_id, c.discharge_date
+FROM clients c
+WHERE c.status = 'discharged'
+  AND c.discharge_date < (CURRENT_DATE - make_interval(months => sqlc.arg('retention_months')::int))::date
+  AND NOT EXISTS (SELECT 1 FROM client_pii_purges p WHERE p.client_id = c.id)
+ORDER BY c.discharge_date, c.id;
+
+-- name: PurgeClientPII :exec
+-- Keeps gender, care type, dates, location and discharge reason for statistics;
+-- the date of birth is reduced to the birth year
+UPDATE clients SET
+    first_name = '[verwijderd]',
+    last_name = '[verwijderd]',
+    bsn = '',
+    date_of_birth = date_trunc('year', date_of_birth)::date,
+    phone_number = NULL,
+    closing_report = NULL,
+    evaluation_report = NULL,
+    family_situation = NULL,
+    limitations = NULL,
+    focus_areas = NULL,
+    notes = NULL,
+    updated_at = NOW()
+WHERE id = $1;
+
+-- name: PurgeRegistrationFormPII :exec
+UPDATE registration_forms SET
+    first_name = '[verwijderd]',
+    last_name = '[verwijderd]',
+    bsn = '',
+    date_of_birth = date_trunc('year', date_of_birth)::date,
+    phone_number = NULL,
+    registration_reason = '[verwijderd]',
+    additional_notes = NULL,
+    updated_at = NOW()
+WHERE id = $1;
+
+-- name: PurgeIntakeFormPII :exec
+UPDATE intake_forms SET
+    family_situation = NULL,
+    main_provider = NULL,
+    limitations = NULL,
+    focus_areas = NULL,
+    notes = NULL,
+    updated_at = NOW()
+WHERE id = $1;
+
+-- name: DeleteClientNotesByClient :exec
+DELETE FROM client_notes WHERE client_id = $1;
+
+-- name: RecordClientPIIPurge :exec
+INSERT INTO client_pii_purges (client_id, retention_months) VALUES ($1, $2);
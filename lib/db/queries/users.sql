@@ -3,8 +3,8 @@
 -- ============================================================
 
 -- name: CreateUser :one
-INSERT INTO users (id, email, password_hash) 
-VALUES ($1, $2, $3)
+INSERT INTO users (id, email, password_hash, organization_id)
+VALUES ($1, $2, $3, $4)
 RETURNING id;
 
 -- name: GetUserByEmail :one
@@ -47,3 +47,40 @@ UPDATE users SET
     password_hash = COALESCE(sqlc.narg('password_hash'), password_hash),
     updated_at = now() 
 WHERE id = $1;
+
+-- name: UpdateUserEmail :exec
+UPDATE users SET
+    email = $2,
+    updated_at = now()
+WHERE id = $1;
+
+-- name: RecordFailedLogin :one
+-- Counts a failed password attempt. Once max_attempts is reached the account
+-- is locked for lockout_seconds and the counter starts over.
+UPDATE users SET
+    failed_login_attempts = CASE
+        WHEN failed_login_attempts + 1 >= sqlc.arg('max_attempts')::int THEN 0
+        ELSE failed_login_attempts + 1
+    END,
+    locked_until = CASE
+        WHEN failed_login_attempts + 1 >= sqlc.arg('max_attempts')::int
+            THEN now() + make_interval(secs => sqlc.arg('lockout_seconds')::int)
+        ELSE locked_until
+    END,
+    updated_at = now()
+WHERE id = sqlc.arg('id')
+RETURNING failed_login_attempts, locked_until;
+
+-- name: RecordLogin :exec
+-- Stamps a completed sign-in; a password login pending MFA does not count.
+UPDATE users SET
+    last_login_at = now()
+WHERE id = $1;
+
+-- name: ResetFailedLogins :exec
+UPDATE users SET
+    failed_login_attempts = 0,
+    locked_until = NULL,
+    updated_at = now()
+WHERE id = $1
+  AND (failed_login_attempts > 0 OR locked_until IS NOT NULL);
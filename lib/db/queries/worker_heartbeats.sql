@@ -0,0 +1,12 @@
+-- ============================================================
+-- Worker Heartbeats
+-- ============================================================
+
+-- name: RecordWorkerHeartbeat :exec
+INSERT INTO worker_heartbeats (worker_name, last_run_at)
+VALUES ($1, NOW())
+ON CONFLICT (worker_name) DO UPDATE SET
+    last_run_at = EXCLUDED.last_run_at;
+
+-- name: GetWorkerHeartbeat :one
+SELECT * FROM worker_heartbeats WHERE worker_name = $1;
@@ -0,0 +1,20 @@
+-- ============================================================
+-- Worker Leases
+-- ============================================================
+
+-- name: AcquireWorkerLease :one
+-- Takes or renews the lease for holder. Returns no rows while another
+-- holder's lease has not yet expired.
+INSERT INTO worker_leases (worker_name, holder, expires_at)
+VALUES (@worker_name, @holder, NOW() + @lease_seconds::int * INTERVAL '1 second')
+ON CONFLICT (worker_name) DO UPDATE SET
+    holder = EXCLUDED.holder,
+    expires_at = EXCLUDED.expires_at
+WHERE worker_leases.holder = EXCLUDED.holder
+   OR worker_leases.expires_at <= NOW()
+RETURNING *;
+
+-- name: ReleaseWorkerLease :exec
+-- Gives up the lease on shutdown so another replica can take over immediately
+DELETE FROM worker_leases
+WHERE worker_name = @worker_name AND holder = @holder;
@@ -0,0 +1,150 @@
+package db
+
+import (
+	"care-cordination/lib/nanoid"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// AdminRoleID is the preset admin role created by the migration
+const AdminRoleID = "role_admin"
+
+type BootstrapAdminTxParams struct {
+	Email            string
+	PasswordHash     string
+	OrganizationName string
+}
+
+type BootstrapAdminTxResult struct {
+	UserID string
+	// Created is false when the admin user already existed and only its
+	// password and role were brought up to date
+	Created bool
+}
+
+// BootstrapAdminTx makes sure the admin role holds every permission and that
+// the admin user exists with the given password and the admin role. It is safe
+// to run repeatedly: the first run creates the organization, user, system
+// location and employee, later runs only update what drifted.
+func (s *Store) BootstrapAdminTx(
+	ctx context.Context,
+	arg BootstrapAdminTxParams,
+) (BootstrapAdminTxResult, error) {
+	var result BootstrapAdminTxResult
+	err := s.ExecTx(ctx, func(q *Queries) error {
+		var err error
+		result, err = bootstrapAdmin(ctx, q, arg)
+		return err
+	})
+	return result, err
+}
+
+func bootstrapAdmin(
+	ctx context.Context,
+	q *Queries,
+	arg BootstrapAdminTxParams,
+) (BootstrapAdminTxResult, error) {
+	description := "Full system access"
+	if err := q.EnsureRole(ctx, EnsureRoleParams{
+		ID:          AdminRoleID,
+		Name:        "admin",
+		Description: &description,
+	}); err != nil {
+		return BootstrapAdminTxResult{}, err
+	}
+	if err := q.AssignAllPermissionsToRole(ctx, AdminRoleID); err != nil {
+		return BootstrapAdminTxResult{}, err
+	}
+
+	var result BootstrapAdminTxResult
+	user, err := q.GetUserByEmail(ctx, arg.Email)
+	switch {
+	case err == nil:
+		result.UserID = user.ID
+		if err := q.UpdateUser(ctx, UpdateUserParams{
+			ID:           user.ID,
+			PasswordHash: &arg.PasswordHash,
+		}); err != nil {
+			return BootstrapAdminTxResult{}, err
+		}
+	case errors.Is(err, pgx.ErrNoRows):
+		userID, err := createAdminUser(ctx, q, arg)
+		if err != nil {
+			return BootstrapAdminTxResult{}, err
+		}
+		result = BootstrapAdminTxResult{UserID: userID, Created: true}
+	default:
+		return BootstrapAdminTxResult{}, err
+	}
+
+	role, err := q.GetRoleForUser(ctx, result.UserID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return BootstrapAdminTxResult{}, err
+	}
+	if err != nil || role.ID != AdminRoleID {
+		if err := q.AssignRoleToUser(ctx, AssignRoleToUserParams{
+			UserID: result.UserID,
+			RoleID: AdminRoleID,
+		}); err != nil {
+			return BootstrapAdminTxResult{}, err
+		}
+	}
+
+	return result, nil
+}
+
+// createAdminUser creates the first organization with the admin user, a system
+// location and the admin's employee record
+func createAdminUser(ctx context.Context, q *Queries, arg BootstrapAdminTxParams) (string, error) {
+	organizationID := nanoid.Generate()
+	if err := q.CreateOrganization(ctx, CreateOrganizationParams{
+		ID:   organizationID,
+		Name: arg.OrganizationName,
+	}); err != nil {
+		return "", err
+	}
+
+	userID, err := q.CreateUser(ctx, CreateUserParams{
+		ID:             nanoid.Generate(),
+		Email:          arg.Email,
+		PasswordHash:   arg.PasswordHash,
+		OrganizationID: &organizationID,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	locationID := nanoid.Generate()
+	if err := q.CreateLocation(ctx, CreateLocationParams{
+		ID:             locationID,
+		Name:           "System Location",
+		PostalCode:     "0000AA",
+		Address:        "System",
+		Capacity:       0,
+		Occupied:       0,
+		OrganizationID: &organizationID,
+	}); err != nil {
+		return "", err
+	}
+
+	if err := q.CreateEmployee(ctx, CreateEmployeeParams{
+		ID:             nanoid.Generate(),
+		UserID:         userID,
+		FirstName:      "System",
+		LastName:       "Admin",
+		Bsn:            "000000000",
+		DateOfBirth:    pgtype.Date{Time: time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+		PhoneNumber:    "0000000000",
+		Gender:         GenderEnumOther,
+		LocationID:     locationID,
+		OrganizationID: &organizationID,
+	}); err != nil {
+		return "", err
+	}
+
+	return userID, nil
+}
@@ -0,0 +1,89 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBootstrapAdmin_Idempotent(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		arg := BootstrapAdminTxParams{
+			Email:            "bootstrap-admin@example.com",
+			PasswordHash:     "first-hash",
+			OrganizationName: "Bootstrap Org",
+		}
+
+		first, err := bootstrapAdmin(ctx, q, arg)
+		require.NoError(t, err)
+		assert.True(t, first.Created)
+
+		// A second run with a new password updates the same user
+		arg.PasswordHash = "second-hash"
+		second, err := bootstrapAdmin(ctx, q, arg)
+		require.NoError(t, err)
+		assert.False(t, second.Created)
+		assert.Equal(t, first.UserID, second.UserID)
+
+		admins, err := q.ListUsersWithRole(ctx, ListUsersWithRoleParams{
+			RoleID: AdminRoleID,
+			Limit:  10,
+		})
+		require.NoError(t, err)
+		require.Len(t, admins, 1)
+		assert.Equal(t, first.UserID, admins[0].ID)
+
+		user, err := q.GetUserByEmail(ctx, arg.Email)
+		require.NoError(t, err)
+		assert.Equal(t, "second-hash", user.PasswordHash)
+
+		role, err := q.GetRoleForUser(ctx, first.UserID)
+		require.NoError(t, err)
+		assert.Equal(t, AdminRoleID, role.ID)
+	})
+}
+
+func TestBootstrapAdmin_RestoresRoleAndPermissions(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		arg := BootstrapAdminTxParams{
+			Email:            "bootstrap-admin@example.com",
+			PasswordHash:     "hash",
+			OrganizationName: "Bootstrap Org",
+		}
+
+		result, err := bootstrapAdmin(ctx, q, arg)
+		require.NoError(t, err)
+
+		// Drift: the admin loses its role and the role loses a permission
+		require.NoError(t, q.RemoveRoleFromUser(ctx, result.UserID))
+		require.NoError(t, q.RemovePermissionFromRole(ctx, RemovePermissionFromRoleParams{
+			RoleID:       AdminRoleID,
+			PermissionID: "perm_client_read",
+		}))
+
+		_, err = bootstrapAdmin(ctx, q, arg)
+		require.NoError(t, err)
+
+		role, err := q.GetRoleForUser(ctx, result.UserID)
+		require.NoError(t, err)
+		assert.Equal(t, AdminRoleID, role.ID)
+
+		allowed, err := q.HasPermission(ctx, HasPermissionParams{
+			UserID:   result.UserID,
+			Resource: "client",
+			Action:   "read",
+		})
+		require.NoError(t, err)
+		assert.True(t, allowed)
+
+		granted, err := q.ListPermissionsForRole(ctx, AdminRoleID)
+		require.NoError(t, err)
+		all, err := q.ListPermissions(ctx, ListPermissionsParams{Limit: 1000, Offset: 0})
+		require.NoError(t, err)
+		assert.Len(t, granted, len(all))
+	})
+}
@@ -7,6 +7,8 @@ package db
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const createAttachment = `-- name: CreateAttachment :exec
@@ -14,22 +16,214 @@ const createAttachment = `-- name: CreateAttachment :exec
 INSERT INTO attachments (
     id,
     filekey,
-    content_type
+    content_type,
+    uploaded_by,
+    filename
 ) VALUES (
-    $1, $2, $3
+    $1, $2, $3, $4, $5
 )
 `
 
 type CreateAttachmentParams struct {
-	ID          string `json:"id"`
-	Filekey     string `json:"filekey"`
-	ContentType string `json:"content_type"`
+	ID          string  `json:"id"`
+	Filekey     string  `json:"filekey"`
+	ContentType string  `json:"content_type"`
+	UploadedBy  *string `json:"uploaded_by"`
+	Filename    *string `json:"filename"`
 }
 
 // ============================================================
 // Attachments
 // ============================================================
 func (q *Queries) CreateAttachment(ctx context.Context, arg CreateAttachmentParams) error {
-	_, err := q.db.Exec(ctx, createAttachment, arg.ID, arg.Filekey, arg.ContentType)
+	_, err := q.db.Exec(ctx, createAttachment,
+		arg.ID,
+		arg.Filekey,
+		arg.ContentType,
+		arg.UploadedBy,
+		arg.Filename,
+	)
 	return err
 }
+
+const createAttachmentAccess = `-- name: CreateAttachmentAccess :exec
+INSERT INTO attachment_access_log (
+    id,
+    attachment_id,
+    user_id,
+    resource_type,
+    resource_id,
+    ip_address,
+    user_agent
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+)
+`
+
+type CreateAttachmentAccessParams struct {
+	ID           string  `json:"id"`
+	AttachmentID string  `json:"attachment_id"`
+	UserID       *string `json:"user_id"`
+	ResourceType *string `json:"resource_type"`
+	ResourceID   *string `json:"resource_id"`
+	IpAddress    *string `json:"ip_address"`
+	UserAgent    *string `json:"user_agent"`
+}
+
+func (q *Queries) CreateAttachmentAccess(ctx context.Context, arg CreateAttachmentAccessParams) error {
+	_, err := q.db.Exec(ctx, createAttachmentAccess,
+		arg.ID,
+		arg.AttachmentID,
+		arg.UserID,
+		arg.ResourceType,
+		arg.ResourceID,
+		arg.IpAddress,
+		arg.UserAgent,
+	)
+	return err
+}
+
+const getAttachmentForDownload = `-- name: GetAttachmentForDownload :one
+SELECT
+    a.id,
+    a.filekey,
+    a.content_type,
+    a.uploaded_by,
+    rfa.registration_form_id,
+    c.id AS client_id,
+    COALESCE(c.organization_id, rfa.organization_id, u.organization_id)::text AS organization_id
+FROM attachments a
+LEFT JOIN users u ON u.id = a.uploaded_by
+LEFT JOIN LATERAL (
+    SELECT rfa.registration_form_id, creator.organization_id
+    FROM registration_form_attachments rfa
+    JOIN registration_forms rf ON rf.id = rfa.registration_form_id
+    LEFT JOIN users creator ON creator.id = rf.created_by_user_id
+    WHERE rfa.attachment_id = a.id
+    ORDER BY rfa.created_at
+    LIMIT 1
+) rfa ON TRUE
+LEFT JOIN LATERAL (
+    SELECT id, organization_id FROM clients
+    WHERE a.id = ANY(discharge_attachment_ids)
+    LIMIT 1
+) c ON TRUE
+WHERE a.id = $1
+`
+
+type GetAttachmentForDownloadRow struct {
+	ID                 string  `json:"id"`
+	Filekey            string  `json:"filekey"`
+	ContentType        string  `json:"content_type"`
+	UploadedBy         *string `json:"uploaded_by"`
+	RegistrationFormID *string `json:"registration_form_id"`
+	ClientID           *string `json:"client_id"`
+	OrganizationID     *string `json:"organization_id"`
+}
+
+// The attachment with the registration form or discharged client it belongs
+// to, recorded as the resource context of each download. organization_id is
+// the owner's organization: the client's, else that of the registration
+// form's creator, else the uploader's for a file not attached to anything yet.
+func (q *Queries) GetAttachmentForDownload(ctx context.Context, id string) (GetAttachmentForDownloadRow, error) {
+	row := q.db.QueryRow(ctx, getAttachmentForDownload, id)
+	var i GetAttachmentForDownloadRow
+	err := row.Scan(
+		&i.ID,
+		&i.Filekey,
+		&i.ContentType,
+		&i.UploadedBy,
+		&i.RegistrationFormID,
+		&i.ClientID,
+		&i.OrganizationID,
+	)
+	return i, err
+}
+
+const getAttachmentsByIDs = `-- name: GetAttachmentsByIDs :many
+SELECT id, uploaded_by
+FROM attachments
+WHERE id = ANY($1::text[])
+`
+
+type GetAttachmentsByIDsRow struct {
+	ID         string  `json:"id"`
+	UploadedBy *string `json:"uploaded_by"`
+}
+
+func (q *Queries) GetAttachmentsByIDs(ctx context.Context, ids []string) ([]GetAttachmentsByIDsRow, error) {
+	rows, err := q.db.Query(ctx, getAttachmentsByIDs, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetAttachmentsByIDsRow{}
+	for rows.Next() {
+		var i GetAttachmentsByIDsRow
+		if err := rows.Scan(&i.ID, &i.UploadedBy); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAttachmentAccess = `-- name: ListAttachmentAccess :many
+SELECT
+    aal.id,
+    aal.user_id,
+    u.email AS user_email,
+    aal.resource_type,
+    aal.resource_id,
+    aal.ip_address,
+    aal.user_agent,
+    aal.accessed_at
+FROM attachment_access_log aal
+LEFT JOIN users u ON aal.user_id = u.id
+WHERE aal.attachment_id = $1
+ORDER BY aal.accessed_at DESC, aal.id
+`
+
+type ListAttachmentAccessRow struct {
+	ID           string             `json:"id"`
+	UserID       *string            `json:"user_id"`
+	UserEmail    *string            `json:"user_email"`
+	ResourceType *string            `json:"resource_type"`
+	ResourceID   *string            `json:"resource_id"`
+	IpAddress    *string            `json:"ip_address"`
+	UserAgent    *string            `json:"user_agent"`
+	AccessedAt   pgtype.Timestamptz `json:"accessed_at"`
+}
+
+// Who downloaded the attachment and when, newest first
+func (q *Queries) ListAttachmentAccess(ctx context.Context, attachmentID string) ([]ListAttachmentAccessRow, error) {
+	rows, err := q.db.Query(ctx, listAttachmentAccess, attachmentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListAttachmentAccessRow{}
+	for rows.Next() {
+		var i ListAttachmentAccessRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.UserEmail,
+			&i.ResourceType,
+			&i.ResourceID,
+			&i.IpAddress,
+			&i.UserAgent,
+			&i.AccessedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,86 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAttachmentForDownload(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		uploaderOrg := CreateTestOrganization(t, q)
+		creatorOrg := CreateTestOrganization(t, q)
+		userID := CreateTestUser(t, q, CreateTestUserOptions{})
+		creatorID := CreateTestUser(t, q, CreateTestUserOptions{})
+		_, err := q.db.Exec(ctx, `UPDATE users SET organization_id = $2 WHERE id = $1`, userID, uploaderOrg)
+		require.NoError(t, err)
+		_, err = q.db.Exec(ctx, `UPDATE users SET organization_id = $2 WHERE id = $1`, creatorID, creatorOrg)
+		require.NoError(t, err)
+
+		loose := CreateTestAttachment(t, q, &userID)
+		attachment, err := q.GetAttachmentForDownload(ctx, loose)
+		require.NoError(t, err)
+		assert.Nil(t, attachment.RegistrationFormID)
+		assert.Nil(t, attachment.ClientID)
+		assert.Equal(t, &userID, attachment.UploadedBy)
+		// A file not attached to anything belongs to the uploader's organization
+		assert.Equal(t, &uploaderOrg, attachment.OrganizationID)
+
+		linked := CreateTestAttachment(t, q, &userID)
+		regFormID := CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{})
+		_, err = q.db.Exec(ctx, `UPDATE registration_forms SET created_by_user_id = $2 WHERE id = $1`, regFormID, creatorID)
+		require.NoError(t, err)
+		_, err = q.db.Exec(ctx, `
+			INSERT INTO registration_form_attachments (registration_form_id, attachment_id)
+			VALUES ($1, $2)`, regFormID, linked)
+		require.NoError(t, err)
+
+		attachment, err = q.GetAttachmentForDownload(ctx, linked)
+		require.NoError(t, err)
+		require.NotNil(t, attachment.RegistrationFormID)
+		assert.Equal(t, regFormID, *attachment.RegistrationFormID)
+		// The form's organization wins over the uploader's
+		assert.Equal(t, &creatorOrg, attachment.OrganizationID)
+	})
+}
+
+func TestListAttachmentAccess(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		uploader := CreateTestUser(t, q, CreateTestUserOptions{})
+		reader := CreateTestUser(t, q, CreateTestUserOptions{})
+		attachmentID := CreateTestAttachment(t, q, &uploader)
+		otherAttachmentID := CreateTestAttachment(t, q, &uploader)
+
+		record := func(id, attachment, user string, at time.Time) {
+			require.NoError(t, q.CreateAttachmentAccess(ctx, CreateAttachmentAccessParams{
+				ID:           id,
+				AttachmentID: attachment,
+				UserID:       &user,
+				ResourceType: strPtr("registration"),
+				ResourceID:   strPtr("reg-1"),
+				IpAddress:    strPtr("10.0.0.1"),
+			}))
+			_, err := q.db.Exec(ctx, `UPDATE attachment_access_log SET accessed_at = $2 WHERE id = $1`, id, at)
+			require.NoError(t, err)
+		}
+		now := time.Now()
+		record("access-1", attachmentID, uploader, now.Add(-time.Hour))
+		record("access-2", attachmentID, reader, now)
+		record("access-3", otherAttachmentID, reader, now)
+
+		rows, err := q.ListAttachmentAccess(ctx, attachmentID)
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+		assert.Equal(t, "access-2", rows[0].ID)
+		require.NotNil(t, rows[0].UserID)
+		assert.Equal(t, reader, *rows[0].UserID)
+		assert.NotNil(t, rows[0].UserEmail)
+		assert.Equal(t, "access-1", rows[1].ID)
+		assert.Equal(t, strPtr("10.0.0.1"), rows[1].IpAddress)
+	})
+}
@@ -30,12 +30,50 @@ func (q *Queries) AddAppointmentParticipant(ctx context.Context, arg AddAppointm
 	return err
 }
 
+const cancelAppointment = `-- name: CancelAppointment :one
+UPDATE appointments
+SET status = 'cancelled',
+    cancellation_reason = $1::text,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $2
+  AND status IS DISTINCT FROM 'cancelled'
+RETURNING id, title, description, start_time, end_time, location, organizer_id, status, type, recurrence_rule, created_at, updated_at, cancellation_reason
+`
+
+type CancelAppointmentParams struct {
+	CancellationReason string `json:"cancellation_reason"`
+	ID                 string `json:"id"`
+}
+
+// Cancelled appointments are kept with their reason but no longer show up on
+// the dashboard or trigger reminders. Returns no row when already cancelled.
+func (q *Queries) CancelAppointment(ctx context.Context, arg CancelAppointmentParams) (Appointment, error) {
+	row := q.db.QueryRow(ctx, cancelAppointment, arg.CancellationReason, arg.ID)
+	var i Appointment
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Description,
+		&i.StartTime,
+		&i.EndTime,
+		&i.Location,
+		&i.OrganizerID,
+		&i.Status,
+		&i.Type,
+		&i.RecurrenceRule,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CancellationReason,
+	)
+	return i, err
+}
+
 const createAppointment = `-- name: CreateAppointment :one
 INSERT INTO appointments (
     id, title, description, start_time, end_time, location, organizer_id, status, type, recurrence_rule
 ) VALUES (
     $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
-) RETURNING id, title, description, start_time, end_time, location, organizer_id, status, type, recurrence_rule, created_at, updated_at
+) RETURNING id, title, description, start_time, end_time, location, organizer_id, status, type, recurrence_rule, created_at, updated_at, cancellation_reason
 `
 
 type CreateAppointmentParams struct {
@@ -78,6 +116,7 @@ func (q *Queries) CreateAppointment(ctx context.Context, arg CreateAppointmentPa
 		&i.RecurrenceRule,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.CancellationReason,
 	)
 	return i, err
 }
@@ -141,7 +180,7 @@ func (q *Queries) DeleteReminder(ctx context.Context, id string) error {
 }
 
 const getAppointment = `-- name: GetAppointment :one
-SELECT id, title, description, start_time, end_time, location, organizer_id, status, type, recurrence_rule, created_at, updated_at FROM appointments WHERE id = $1
+SELECT id, title, description, start_time, end_time, location, organizer_id, status, type, recurrence_rule, created_at, updated_at, cancellation_reason FROM appointments WHERE id = $1
 `
 
 func (q *Queries) GetAppointment(ctx context.Context, id string) (Appointment, error) {
@@ -160,6 +199,7 @@ func (q *Queries) GetAppointment(ctx context.Context, id string) (Appointment, e
 		&i.RecurrenceRule,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.CancellationReason,
 	)
 	return i, err
 }
@@ -226,35 +266,36 @@ func (q *Queries) GetReminder(ctx context.Context, id string) (Reminder, error)
 
 const getUpcomingAppointments = `-- name: GetUpcomingAppointments :many
 SELECT 
-    a.id, a.title, a.description, a.start_time, a.end_time, a.location, a.organizer_id, a.status, a.type, a.recurrence_rule, a.created_at, a.updated_at,
+    a.id, a.title, a.description, a.start_time, a.end_time, a.location, a.organizer_id, a.status, a.type, a.recurrence_rule, a.created_at, a.updated_at, a.cancellation_reason,
     e.user_id as organizer_user_id
 FROM appointments a
 JOIN employees e ON a.organizer_id = e.id
 WHERE a.start_time >= CURRENT_TIMESTAMP 
-AND a.start_time <= CURRENT_TIMESTAMP + INTERVAL '1 hour'
-AND a.status = 'scheduled'
+AND a.start_time <= $1::timestamptz
+AND a.status = 'confirmed'
 ORDER BY a.start_time ASC
 `
 
 type GetUpcomingAppointmentsRow struct {
-	ID              string                    `json:"id"`
-	Title           string                    `json:"title"`
-	Description     *string                   `json:"description"`
-	StartTime       pgtype.Timestamptz        `json:"start_time"`
-	EndTime         pgtype.Timestamptz        `json:"end_time"`
-	Location        *string                   `json:"location"`
-	OrganizerID     string                    `json:"organizer_id"`
-	Status          NullAppointmentStatusEnum `json:"status"`
-	Type            AppointmentTypeEnum       `json:"type"`
-	RecurrenceRule  *string                   `json:"recurrence_rule"`
-	CreatedAt       pgtype.Timestamptz        `json:"created_at"`
-	UpdatedAt       pgtype.Timestamptz        `json:"updated_at"`
-	OrganizerUserID string                    `json:"organizer_user_id"`
-}
-
-// Get appointments starting in the next hour for reminder notifications
-func (q *Queries) GetUpcomingAppointments(ctx context.Context) ([]GetUpcomingAppointmentsRow, error) {
-	rows, err := q.db.Query(ctx, getUpcomingAppointments)
+	ID                 string                    `json:"id"`
+	Title              string                    `json:"title"`
+	Description        *string                   `json:"description"`
+	StartTime          pgtype.Timestamptz        `json:"start_time"`
+	EndTime            pgtype.Timestamptz        `json:"end_time"`
+	Location           *string                   `json:"location"`
+	OrganizerID        string                    `json:"organizer_id"`
+	Status             NullAppointmentStatusEnum `json:"status"`
+	Type               AppointmentTypeEnum       `json:"type"`
+	RecurrenceRule     *string                   `json:"recurrence_rule"`
+	CreatedAt          pgtype.Timestamptz        `json:"created_at"`
+	UpdatedAt          pgtype.Timestamptz        `json:"updated_at"`
+	CancellationReason *string                   `json:"cancellation_reason"`
+	OrganizerUserID    string                    `json:"organizer_user_id"`
+}
+
+// Get confirmed appointments starting before window_end for reminder notifications
+func (q *Queries) GetUpcomingAppointments(ctx context.Context, windowEnd pgtype.Timestamptz) ([]GetUpcomingAppointmentsRow, error) {
+	rows, err := q.db.Query(ctx, getUpcomingAppointments, windowEnd)
 	if err != nil {
 		return nil, err
 	}
@@ -275,6 +316,7 @@ func (q *Queries) GetUpcomingAppointments(ctx context.Context) ([]GetUpcomingApp
 			&i.RecurrenceRule,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.CancellationReason,
 			&i.OrganizerUserID,
 		); err != nil {
 			return nil, err
@@ -311,8 +353,155 @@ func (q *Queries) ListAppointmentParticipants(ctx context.Context, appointmentID
 	return items, nil
 }
 
+const listAppointmentParticipantsByAppointmentIDs = `-- name: ListAppointmentParticipantsByAppointmentIDs :many
+SELECT appointment_id, participant_id, participant_type FROM appointment_participants
+WHERE appointment_id = ANY($1::text[])
+ORDER BY appointment_id
+`
+
+// Participants of a page of appointments, fetched in one round trip
+func (q *Queries) ListAppointmentParticipantsByAppointmentIDs(ctx context.Context, appointmentIds []string) ([]AppointmentParticipant, error) {
+	rows, err := q.db.Query(ctx, listAppointmentParticipantsByAppointmentIDs, appointmentIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AppointmentParticipant{}
+	for rows.Next() {
+		var i AppointmentParticipant
+		if err := rows.Scan(&i.AppointmentID, &i.ParticipantID, &i.ParticipantType); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAppointments = `-- name: ListAppointments :many
+SELECT a.id, a.title, a.description, a.start_time, a.end_time, a.location, a.organizer_id, a.status, a.type, a.recurrence_rule, a.created_at, a.updated_at, a.cancellation_reason,
+       cl.id AS client_id,
+       cl.first_name AS client_first_name,
+       cl.last_name AS client_last_name,
+       COUNT(*) OVER() AS total_count
+FROM appointments a
+LEFT JOIN LATERAL (
+    SELECT c.id, c.first_name, c.last_name
+    FROM appointment_participants ap
+    JOIN clients c ON c.id = ap.participant_id
+    WHERE ap.appointment_id = a.id
+      AND ap.participant_type = 'client'
+      AND ($3::text IS NULL OR ap.participant_id = $3::text)
+    ORDER BY c.last_name, c.first_name
+    LIMIT 1
+) cl ON TRUE
+WHERE ($3::text IS NULL OR cl.id IS NOT NULL)
+  AND ($4::text IS NULL OR a.organizer_id = $4::text)
+  AND ($5::timestamptz IS NULL OR a.start_time >= $5::timestamptz)
+  AND ($6::timestamptz IS NULL OR a.start_time < $6::timestamptz)
+  AND ($7::appointment_type_enum IS NULL OR a.type = $7::appointment_type_enum)
+  AND ($8::text IS NULL OR LOWER(a.location) = LOWER($8::text))
+  AND (
+      $9::text IS NULL
+      OR a.organizer_id = $9::text
+      OR EXISTS (
+          SELECT 1 FROM appointment_participants ep
+          WHERE ep.appointment_id = a.id
+            AND ep.participant_id = $9::text
+            AND ep.participant_type = 'employee'
+      )
+  )
+ORDER BY a.start_time ASC, a.id
+LIMIT $1 OFFSET $2
+`
+
+type ListAppointmentsParams struct {
+	Limit       int32                   `json:"limit"`
+	Offset      int32                   `json:"offset"`
+	ClientID    *string                 `json:"client_id"`
+	OrganizerID *string                 `json:"organizer_id"`
+	StartFrom   pgtype.Timestamptz      `json:"start_from"`
+	StartBefore pgtype.Timestamptz      `json:"start_before"`
+	Type        NullAppointmentTypeEnum `json:"type"`
+	Location    *string                 `json:"location"`
+	EmployeeID  *string                 `json:"employee_id"`
+}
+
+type ListAppointmentsRow struct {
+	ID                 string                    `json:"id"`
+	Title              string                    `json:"title"`
+	Description        *string                   `json:"description"`
+	StartTime          pgtype.Timestamptz        `json:"start_time"`
+	EndTime            pgtype.Timestamptz        `json:"end_time"`
+	Location           *string                   `json:"location"`
+	OrganizerID        string                    `json:"organizer_id"`
+	Status             NullAppointmentStatusEnum `json:"status"`
+	Type               AppointmentTypeEnum       `json:"type"`
+	RecurrenceRule     *string                   `json:"recurrence_rule"`
+	CreatedAt          pgtype.Timestamptz        `json:"created_at"`
+	UpdatedAt          pgtype.Timestamptz        `json:"updated_at"`
+	CancellationReason *string                   `json:"cancellation_reason"`
+	ClientID           *string                   `json:"client_id"`
+	ClientFirstName    *string                   `json:"client_first_name"`
+	ClientLastName     *string                   `json:"client_last_name"`
+	TotalCount         int64                     `json:"total_count"`
+}
+
+// Lists appointments matching the optional filters, ordered by start time. The client
+// is the filtered client, or otherwise the first client participant by name. The
+// employee filter matches appointments the employee organizes or attends.
+func (q *Queries) ListAppointments(ctx context.Context, arg ListAppointmentsParams) ([]ListAppointmentsRow, error) {
+	rows, err := q.db.Query(ctx, listAppointments,
+		arg.Limit,
+		arg.Offset,
+		arg.ClientID,
+		arg.OrganizerID,
+		arg.StartFrom,
+		arg.StartBefore,
+		arg.Type,
+		arg.Location,
+		arg.EmployeeID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListAppointmentsRow{}
+	for rows.Next() {
+		var i ListAppointmentsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Description,
+			&i.StartTime,
+			&i.EndTime,
+			&i.Location,
+			&i.OrganizerID,
+			&i.Status,
+			&i.Type,
+			&i.RecurrenceRule,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.CancellationReason,
+			&i.ClientID,
+			&i.ClientFirstName,
+			&i.ClientLastName,
+			&i.TotalCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listAppointmentsByOrganizer = `-- name: ListAppointmentsByOrganizer :many
-SELECT id, title, description, start_time, end_time, location, organizer_id, status, type, recurrence_rule, created_at, updated_at FROM appointments WHERE organizer_id = $1 ORDER BY start_time ASC
+SELECT id, title, description, start_time, end_time, location, organizer_id, status, type, recurrence_rule, created_at, updated_at, cancellation_reason FROM appointments WHERE organizer_id = $1 ORDER BY start_time ASC
 `
 
 func (q *Queries) ListAppointmentsByOrganizer(ctx context.Context, organizerID string) ([]Appointment, error) {
@@ -337,6 +526,7 @@ func (q *Queries) ListAppointmentsByOrganizer(ctx context.Context, organizerID s
 			&i.RecurrenceRule,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.CancellationReason,
 		); err != nil {
 			return nil, err
 		}
@@ -349,7 +539,7 @@ func (q *Queries) ListAppointmentsByOrganizer(ctx context.Context, organizerID s
 }
 
 const listAppointmentsByParticipant = `-- name: ListAppointmentsByParticipant :many
-SELECT a.id, a.title, a.description, a.start_time, a.end_time, a.location, a.organizer_id, a.status, a.type, a.recurrence_rule, a.created_at, a.updated_at FROM appointments a
+SELECT a.id, a.title, a.description, a.start_time, a.end_time, a.location, a.organizer_id, a.status, a.type, a.recurrence_rule, a.created_at, a.updated_at, a.cancellation_reason FROM appointments a
 JOIN appointment_participants ap ON a.id = ap.appointment_id
 WHERE ap.participant_id = $1 AND ap.participant_type = $2
 ORDER BY a.start_time ASC
@@ -382,6 +572,7 @@ func (q *Queries) ListAppointmentsByParticipant(ctx context.Context, arg ListApp
 			&i.RecurrenceRule,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.CancellationReason,
 		); err != nil {
 			return nil, err
 		}
@@ -394,7 +585,7 @@ func (q *Queries) ListAppointmentsByParticipant(ctx context.Context, arg ListApp
 }
 
 const listAppointmentsByRange = `-- name: ListAppointmentsByRange :many
-SELECT id, title, description, start_time, end_time, location, organizer_id, status, type, recurrence_rule, created_at, updated_at FROM appointments 
+SELECT id, title, description, start_time, end_time, location, organizer_id, status, type, recurrence_rule, created_at, updated_at, cancellation_reason FROM appointments 
 WHERE organizer_id = $1 
 AND start_time >= $2::timestamptz 
 AND start_time <= $3::timestamptz
@@ -429,6 +620,7 @@ func (q *Queries) ListAppointmentsByRange(ctx context.Context, arg ListAppointme
 			&i.RecurrenceRule,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.CancellationReason,
 		); err != nil {
 			return nil, err
 		}
@@ -441,7 +633,7 @@ func (q *Queries) ListAppointmentsByRange(ctx context.Context, arg ListAppointme
 }
 
 const listRecurringAppointments = `-- name: ListRecurringAppointments :many
-SELECT id, title, description, start_time, end_time, location, organizer_id, status, type, recurrence_rule, created_at, updated_at FROM appointments 
+SELECT id, title, description, start_time, end_time, location, organizer_id, status, type, recurrence_rule, created_at, updated_at, cancellation_reason FROM appointments 
 WHERE organizer_id = $1 
 AND recurrence_rule IS NOT NULL 
 AND recurrence_rule <> ''
@@ -476,6 +668,7 @@ func (q *Queries) ListRecurringAppointments(ctx context.Context, arg ListRecurri
 			&i.RecurrenceRule,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.CancellationReason,
 		); err != nil {
 			return nil, err
 		}
@@ -584,7 +777,7 @@ SET title = CASE WHEN $1::text <> '' THEN $1::text ELSE title END,
     recurrence_rule = COALESCE($8, recurrence_rule),
     updated_at = CURRENT_TIMESTAMP
 WHERE id = $9
-RETURNING id, title, description, start_time, end_time, location, organizer_id, status, type, recurrence_rule, created_at, updated_at
+RETURNING id, title, description, start_time, end_time, location, organizer_id, status, type, recurrence_rule, created_at, updated_at, cancellation_reason
 `
 
 type UpdateAppointmentParams struct {
@@ -625,6 +818,7 @@ func (q *Queries) UpdateAppointment(ctx context.Context, arg UpdateAppointmentPa
 		&i.RecurrenceRule,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.CancellationReason,
 	)
 	return i, err
 }
@@ -242,6 +242,168 @@ func TestDeleteAppointment(t *testing.T) {
 	}
 }
 
+// ============================================================
+// Test: ListAppointments
+// ============================================================
+
+func TestListAppointments(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		userID := CreateTestUser(t, q, CreateTestUserOptions{})
+		employeeID := CreateTestEmployee(t, q, CreateTestEmployeeOptions{UserID: userID})
+		clientID, _ := CreateTestClientWithDependencies(t, q)
+		otherClientID, _ := CreateTestClientWithDependencies(t, q)
+		client, err := q.GetClientByID(ctx, GetClientByIDParams{ID: clientID})
+		require.NoError(t, err)
+
+		base := time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC)
+
+		// Client appointment inside the range
+		inRange := CreateTestAppointment(t, q, CreateTestAppointmentOptions{
+			OrganizerID: employeeID,
+			StartTime:   strPtrTime(base.Add(24 * time.Hour)),
+			Location:    strPtr("Locatie Noord"),
+		})
+		CreateTestAppointmentParticipant(t, q, inRange, clientID, ParticipantTypeEnumClient)
+		CreateTestAppointmentParticipant(t, q, inRange, employeeID, ParticipantTypeEnumEmployee)
+
+		// Client appointment before the range
+		before := CreateTestAppointment(t, q, CreateTestAppointmentOptions{
+			OrganizerID: employeeID,
+			StartTime:   strPtrTime(base.Add(-24 * time.Hour)),
+		})
+		CreateTestAppointmentParticipant(t, q, before, clientID, ParticipantTypeEnumClient)
+
+		// Another client's appointment inside the range
+		other := CreateTestAppointment(t, q, CreateTestAppointmentOptions{
+			OrganizerID: employeeID,
+			StartTime:   strPtrTime(base),
+		})
+		CreateTestAppointmentParticipant(t, q, other, otherClientID, ParticipantTypeEnumClient)
+
+		// Appointment starting exactly at the (exclusive) end of the range
+		atEnd := CreateTestAppointment(t, q, CreateTestAppointmentOptions{
+			OrganizerID: employeeID,
+			StartTime:   strPtrTime(base.Add(7 * 24 * time.Hour)),
+		})
+
+		rangeFrom := pgtype.Timestamptz{Time: base, Valid: true}
+		rangeTo := pgtype.Timestamptz{Time: base.Add(7 * 24 * time.Hour), Valid: true}
+
+		t.Run("date_range", func(t *testing.T) {
+			results, err := q.ListAppointments(ctx, ListAppointmentsParams{
+				Limit:       10,
+				Offset:      0,
+				OrganizerID: &employeeID,
+				StartFrom:   rangeFrom,
+				StartBefore: rangeTo,
+			})
+			require.NoError(t, err)
+			require.Len(t, results, 2)
+			// Ordered by start time
+			assert.Equal(t, other, results[0].ID)
+			assert.Equal(t, inRange, results[1].ID)
+			assert.Equal(t, int64(2), results[0].TotalCount)
+		})
+
+		t.Run("client", func(t *testing.T) {
+			results, err := q.ListAppointments(ctx, ListAppointmentsParams{
+				Limit:    10,
+				Offset:   0,
+				ClientID: &clientID,
+			})
+			require.NoError(t, err)
+			require.Len(t, results, 2)
+			assert.Equal(t, before, results[0].ID)
+			assert.Equal(t, inRange, results[1].ID)
+			for _, r := range results {
+				require.NotNil(t, r.ClientID)
+				assert.Equal(t, clientID, *r.ClientID)
+				assert.Equal(t, client.FirstName, *r.ClientFirstName)
+				assert.Equal(t, client.LastName, *r.ClientLastName)
+			}
+		})
+
+		t.Run("client_and_date_range", func(t *testing.T) {
+			results, err := q.ListAppointments(ctx, ListAppointmentsParams{
+				Limit:       10,
+				Offset:      0,
+				ClientID:    &clientID,
+				StartFrom:   rangeFrom,
+				StartBefore: rangeTo,
+			})
+			require.NoError(t, err)
+			require.Len(t, results, 1)
+			assert.Equal(t, inRange, results[0].ID)
+			assert.Equal(t, "Locatie Noord", *results[0].Location)
+		})
+
+		t.Run("location_and_type", func(t *testing.T) {
+			results, err := q.ListAppointments(ctx, ListAppointmentsParams{
+				Limit:       10,
+				Offset:      0,
+				OrganizerID: &employeeID,
+				Location:    strPtr("locatie noord"),
+				Type:        NullAppointmentTypeEnum{AppointmentTypeEnum: AppointmentTypeEnumGeneral, Valid: true},
+			})
+			require.NoError(t, err)
+			require.Len(t, results, 1)
+			assert.Equal(t, inRange, results[0].ID)
+
+			results, err = q.ListAppointments(ctx, ListAppointmentsParams{
+				Limit:       10,
+				Offset:      0,
+				OrganizerID: &employeeID,
+				Type:        NullAppointmentTypeEnum{AppointmentTypeEnum: AppointmentTypeEnumIntake, Valid: true},
+			})
+			require.NoError(t, err)
+			assert.Empty(t, results)
+		})
+
+		t.Run("without_client", func(t *testing.T) {
+			results, err := q.ListAppointments(ctx, ListAppointmentsParams{
+				Limit:       10,
+				Offset:      0,
+				StartFrom:   rangeTo,
+				OrganizerID: &employeeID,
+			})
+			require.NoError(t, err)
+			require.Len(t, results, 1)
+			assert.Equal(t, atEnd, results[0].ID)
+			assert.Nil(t, results[0].ClientID)
+		})
+
+		t.Run("employee_organizes_or_attends", func(t *testing.T) {
+			attendeeUserID := CreateTestUser(t, q, CreateTestUserOptions{})
+			attendeeID := CreateTestEmployee(t, q, CreateTestEmployeeOptions{UserID: attendeeUserID})
+			CreateTestAppointmentParticipant(t, q, other, attendeeID, ParticipantTypeEnumEmployee)
+			organized := CreateTestAppointment(t, q, CreateTestAppointmentOptions{
+				OrganizerID: attendeeID,
+				StartTime:   strPtrTime(base.Add(2 * 24 * time.Hour)),
+			})
+
+			results, err := q.ListAppointments(ctx, ListAppointmentsParams{
+				Limit:      10,
+				Offset:     0,
+				EmployeeID: &attendeeID,
+			})
+			require.NoError(t, err)
+			require.Len(t, results, 2)
+			assert.Equal(t, other, results[0].ID)
+			assert.Equal(t, organized, results[1].ID)
+		})
+
+		t.Run("participants_by_appointment_ids", func(t *testing.T) {
+			participants, err := q.ListAppointmentParticipantsByAppointmentIDs(ctx, []string{inRange, atEnd})
+			require.NoError(t, err)
+			require.Len(t, participants, 2)
+			for _, p := range participants {
+				assert.Equal(t, inRange, p.AppointmentID)
+			}
+		})
+	})
+}
+
 // ============================================================
 // Test: ListAppointmentsByOrganizer
 // ============================================================
@@ -511,6 +673,99 @@ func TestListRemindersByRange(t *testing.T) {
 	})
 }
 
+// ============================================================
+// Test: CancelAppointment
+// ============================================================
+
+func TestCancelAppointment(t *testing.T) {
+	t.Run("sets_status_and_reason", func(t *testing.T) {
+		runTestWithTx(t, func(t *testing.T, q *Queries) {
+			ctx := context.Background()
+			userID := CreateTestUser(t, q, CreateTestUserOptions{})
+			employeeID := CreateTestEmployee(t, q, CreateTestEmployeeOptions{UserID: userID})
+			id := CreateTestAppointment(t, q, CreateTestAppointmentOptions{OrganizerID: employeeID})
+
+			res, err := q.CancelAppointment(ctx, CancelAppointmentParams{
+				ID:                 id,
+				CancellationReason: "Client is ill",
+			})
+			require.NoError(t, err)
+			assert.Equal(t, AppointmentStatusEnumCancelled, res.Status.AppointmentStatusEnum)
+			require.NotNil(t, res.CancellationReason)
+			assert.Equal(t, "Client is ill", *res.CancellationReason)
+		})
+	})
+
+	t.Run("already_cancelled", func(t *testing.T) {
+		runTestWithTx(t, func(t *testing.T, q *Queries) {
+			ctx := context.Background()
+			userID := CreateTestUser(t, q, CreateTestUserOptions{})
+			employeeID := CreateTestEmployee(t, q, CreateTestEmployeeOptions{UserID: userID})
+			cancelled := AppointmentStatusEnumCancelled
+			id := CreateTestAppointment(t, q, CreateTestAppointmentOptions{
+				OrganizerID: employeeID,
+				Status:      &cancelled,
+			})
+
+			_, err := q.CancelAppointment(ctx, CancelAppointmentParams{
+				ID:                 id,
+				CancellationReason: "Again",
+			})
+			require.ErrorIs(t, err, pgx.ErrNoRows)
+		})
+	})
+
+	t.Run("excluded_from_today_and_reminders", func(t *testing.T) {
+		runTestWithTx(t, func(t *testing.T, q *Queries) {
+			ctx := context.Background()
+			userID := CreateTestUser(t, q, CreateTestUserOptions{})
+			employeeID := CreateTestEmployee(t, q, CreateTestEmployeeOptions{UserID: userID})
+
+			// Starts shortly so it falls both on today and within the reminder window
+			start := time.Now().Add(time.Minute)
+			kept := CreateTestAppointment(t, q, CreateTestAppointmentOptions{
+				OrganizerID: employeeID,
+				StartTime:   &start,
+			})
+			cancelledID := CreateTestAppointment(t, q, CreateTestAppointmentOptions{
+				OrganizerID: employeeID,
+				StartTime:   &start,
+			})
+
+			_, err := q.CancelAppointment(ctx, CancelAppointmentParams{
+				ID:                 cancelledID,
+				CancellationReason: "Organizer unavailable",
+			})
+			require.NoError(t, err)
+
+			today, err := q.GetTodayAppointmentsForEmployee(ctx, GetTodayAppointmentsForEmployeeParams{
+				OrganizerID: employeeID,
+				DayStart:    pgtype.Timestamptz{Time: start.Add(-time.Hour), Valid: true},
+				DayEnd:      pgtype.Timestamptz{Time: start.Add(time.Hour), Valid: true},
+			})
+			require.NoError(t, err)
+			todayIDs := make([]string, 0, len(today))
+			for _, a := range today {
+				todayIDs = append(todayIDs, a.ID)
+			}
+			assert.Contains(t, todayIDs, kept)
+			assert.NotContains(t, todayIDs, cancelledID)
+
+			upcoming, err := q.GetUpcomingAppointments(ctx, pgtype.Timestamptz{
+				Time:  start.Add(time.Hour),
+				Valid: true,
+			})
+			require.NoError(t, err)
+			upcomingIDs := make([]string, 0, len(upcoming))
+			for _, a := range upcoming {
+				upcomingIDs = append(upcomingIDs, a.ID)
+			}
+			assert.Contains(t, upcomingIDs, kept)
+			assert.NotContains(t, upcomingIDs, cancelledID)
+		})
+	})
+}
+
 // Helper
 func strPtrTime(t time.Time) *time.Time {
 	return &t
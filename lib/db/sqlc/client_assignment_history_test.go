@@ -0,0 +1,93 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================
+// Test: RecordClientAssignment
+// ============================================================
+
+func TestRecordClientAssignment(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		clientID, deps := CreateTestClientWithDependencies(t, q)
+
+		params := RecordClientAssignmentParams{
+			ID:        generateTestID(),
+			ClientID:  clientID,
+			ChangedBy: deps.UserID,
+		}
+		require.NoError(t, q.RecordClientAssignment(ctx, params))
+
+		// Recording the same assignment again must not add a row
+		params.ID = generateTestID()
+		require.NoError(t, q.RecordClientAssignment(ctx, params))
+
+		history, err := q.GetClientAssignmentHistory(ctx, clientID)
+		require.NoError(t, err)
+		require.Len(t, history, 1)
+		assert.Equal(t, deps.EmployeeID, history[0].CoordinatorID)
+		assert.Equal(t, deps.LocationID, history[0].LocationID)
+		require.NotNil(t, history[0].ChangedBy)
+		assert.Equal(t, deps.UserID, *history[0].ChangedBy)
+		assert.True(t, history[0].ChangedAt.Valid)
+	})
+}
+
+// ============================================================
+// Test: UpdateIntakeFormTx assignment history
+// ============================================================
+
+// UpdateIntakeFormTx opens its own transaction, so this test runs against
+// testStore directly instead of inside runTestWithTx and deletes what it
+// commits. The history rows go with the client, which is deleted first.
+func TestUpdateIntakeFormTx_CoordinatorOnlyChangeRecordsHistory(t *testing.T) {
+	ctx := context.Background()
+	q := testStore.Queries
+
+	actorID := CreateTestUser(t, q, CreateTestUserOptions{})
+	deleteAfterTest(t, "users", actorID)
+	newCoordinatorUserID := CreateTestUser(t, q, CreateTestUserOptions{})
+	deleteAfterTest(t, "users", newCoordinatorUserID)
+	newLocationID := CreateTestLocation(t, q, CreateTestLocationOptions{})
+	deleteAfterTest(t, "locations", newLocationID)
+	newCoordinatorID := CreateTestEmployee(t, q, CreateTestEmployeeOptions{UserID: newCoordinatorUserID, LocationID: &newLocationID})
+	deleteAfterTest(t, "employees", newCoordinatorID)
+
+	clientID, deps := createCommittedClient(t, ClientStatusEnumWaitingList, nil)
+	require.NoError(t, q.RecordClientAssignment(ctx, RecordClientAssignmentParams{
+		ID:       generateTestID(),
+		ClientID: clientID,
+	}))
+
+	err := testStore.UpdateIntakeFormTx(ctx, UpdateIntakeFormTxParams{
+		IntakeForm: UpdateIntakeFormParams{
+			ID:            deps.IntakeFormID,
+			CoordinatorID: &newCoordinatorID,
+		},
+		UpdateClient: true,
+		ClientID:     clientID,
+		ChangedBy:    actorID,
+	})
+	require.NoError(t, err)
+
+	history, err := q.GetClientAssignmentHistory(ctx, clientID)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+
+	latest, initial := history[0], history[1]
+	assert.Equal(t, newCoordinatorID, latest.CoordinatorID)
+	assert.Equal(t, deps.LocationID, latest.LocationID, "location must be unchanged")
+	require.NotNil(t, latest.ChangedBy)
+	assert.Equal(t, actorID, *latest.ChangedBy)
+
+	assert.Equal(t, deps.EmployeeID, initial.CoordinatorID)
+	assert.Equal(t, deps.LocationID, initial.LocationID)
+	assert.Nil(t, initial.ChangedBy)
+	assert.True(t, latest.ChangedAt.Time.After(initial.ChangedAt.Time))
+}
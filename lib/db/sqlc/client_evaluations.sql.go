@@ -257,14 +257,15 @@ JOIN locations l ON c.assigned_location_id = l.id
 JOIN employees e ON c.coordinator_id = e.id
 WHERE c.status = 'in_care' 
   AND c.next_evaluation_date IS NOT NULL
-  AND c.next_evaluation_date <= (CURRENT_DATE + INTERVAL '7 days')::date
+  AND c.next_evaluation_date <= $3::date + 7
 ORDER BY c.next_evaluation_date ASC
 LIMIT $1 OFFSET $2
 `
 
 type GetCriticalEvaluationsParams struct {
-	Limit  int32 `json:"limit"`
-	Offset int32 `json:"offset"`
+	Limit  int32       `json:"limit"`
+	Offset int32       `json:"offset"`
+	Today  pgtype.Date `json:"today"`
 }
 
 type GetCriticalEvaluationsRow struct {
@@ -281,7 +282,7 @@ type GetCriticalEvaluationsRow struct {
 }
 
 func (q *Queries) GetCriticalEvaluations(ctx context.Context, arg GetCriticalEvaluationsParams) ([]GetCriticalEvaluationsRow, error) {
-	rows, err := q.db.Query(ctx, getCriticalEvaluations, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, getCriticalEvaluations, arg.Limit, arg.Offset, arg.Today)
 	if err != nil {
 		return nil, err
 	}
@@ -524,11 +525,16 @@ JOIN employees e ON c.coordinator_id = e.id
 JOIN locations l ON c.assigned_location_id = l.id
 WHERE c.status = 'in_care' 
   AND c.next_evaluation_date IS NOT NULL
-  AND c.next_evaluation_date <= (CURRENT_DATE + INTERVAL '3 days')::date
-  AND c.next_evaluation_date >= CURRENT_DATE
+  AND c.next_evaluation_date <= $1::date + $2::int
+  AND c.next_evaluation_date >= $1::date
 ORDER BY c.next_evaluation_date ASC
 `
 
+type GetEvaluationsDueSoonParams struct {
+	Today       pgtype.Date `json:"today"`
+	DueSoonDays int32       `json:"due_soon_days"`
+}
+
 type GetEvaluationsDueSoonRow struct {
 	ClientID           string      `json:"client_id"`
 	FirstName          string      `json:"first_name"`
@@ -539,9 +545,9 @@ type GetEvaluationsDueSoonRow struct {
 	LocationName       string      `json:"location_name"`
 }
 
-// Get clients with evaluations due in the next 3 days for reminder notifications
-func (q *Queries) GetEvaluationsDueSoon(ctx context.Context) ([]GetEvaluationsDueSoonRow, error) {
-	rows, err := q.db.Query(ctx, getEvaluationsDueSoon)
+// Get clients with evaluations due within due_soon_days days for reminder notifications
+func (q *Queries) GetEvaluationsDueSoon(ctx context.Context, arg GetEvaluationsDueSoonParams) ([]GetEvaluationsDueSoonRow, error) {
+	rows, err := q.db.Query(ctx, getEvaluationsDueSoon, arg.Today, arg.DueSoonDays)
 	if err != nil {
 		return nil, err
 	}
@@ -721,15 +727,16 @@ JOIN locations l ON c.assigned_location_id = l.id
 JOIN employees e ON c.coordinator_id = e.id
 WHERE c.status = 'in_care' 
   AND c.next_evaluation_date IS NOT NULL
-  AND c.next_evaluation_date > (CURRENT_DATE + INTERVAL '7 days')::date
-  AND c.next_evaluation_date <= (CURRENT_DATE + INTERVAL '30 days')::date
+  AND c.next_evaluation_date > $3::date + 7
+  AND c.next_evaluation_date <= $3::date + 30
 ORDER BY c.next_evaluation_date ASC
 LIMIT $1 OFFSET $2
 `
 
 type GetScheduledEvaluationsParams struct {
-	Limit  int32 `json:"limit"`
-	Offset int32 `json:"offset"`
+	Limit  int32       `json:"limit"`
+	Offset int32       `json:"offset"`
+	Today  pgtype.Date `json:"today"`
 }
 
 type GetScheduledEvaluationsRow struct {
@@ -746,7 +753,7 @@ type GetScheduledEvaluationsRow struct {
 }
 
 func (q *Queries) GetScheduledEvaluations(ctx context.Context, arg GetScheduledEvaluationsParams) ([]GetScheduledEvaluationsRow, error) {
-	rows, err := q.db.Query(ctx, getScheduledEvaluations, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, getScheduledEvaluations, arg.Limit, arg.Offset, arg.Today)
 	if err != nil {
 		return nil, err
 	}
@@ -776,6 +783,82 @@ func (q *Queries) GetScheduledEvaluations(ctx context.Context, arg GetScheduledE
 	return items, nil
 }
 
+const listOverdueEvaluations = `-- name: ListOverdueEvaluations :many
+SELECT
+    c.id,
+    c.first_name,
+    c.last_name,
+    c.next_evaluation_date,
+    ($3::date - c.next_evaluation_date)::int as days_overdue,
+    l.name as location_name,
+    c.coordinator_id,
+    e.first_name as coordinator_first_name,
+    e.last_name as coordinator_last_name,
+    COUNT(*) OVER() as total_count
+FROM clients c
+JOIN locations l ON c.assigned_location_id = l.id
+JOIN employees e ON c.coordinator_id = e.id
+WHERE c.status = 'in_care'
+  AND c.next_evaluation_date IS NOT NULL
+  AND c.next_evaluation_date < $3::date
+  AND ($4::text IS NULL OR c.coordinator_id = $4::text)
+ORDER BY e.last_name, e.first_name, c.coordinator_id, c.next_evaluation_date ASC, c.id
+LIMIT $1 OFFSET $2
+`
+
+type ListOverdueEvaluationsParams struct {
+	Limit         int32       `json:"limit"`
+	Offset        int32       `json:"offset"`
+	Today         pgtype.Date `json:"today"`
+	CoordinatorID *string     `json:"coordinator_id"`
+}
+
+type ListOverdueEvaluationsRow struct {
+	ID                   string      `json:"id"`
+	FirstName            string      `json:"first_name"`
+	LastName             string      `json:"last_name"`
+	NextEvaluationDate   pgtype.Date `json:"next_evaluation_date"`
+	DaysOverdue          int32       `json:"days_overdue"`
+	LocationName         string      `json:"location_name"`
+	CoordinatorID        string      `json:"coordinator_id"`
+	CoordinatorFirstName string      `json:"coordinator_first_name"`
+	CoordinatorLastName  string      `json:"coordinator_last_name"`
+	TotalCount           int64       `json:"total_count"`
+}
+
+// In-care clients whose next evaluation date has passed, grouped per coordinator
+// with the longest overdue first. Matches the overdue count in GetCriticalAlertsData.
+func (q *Queries) ListOverdueEvaluations(ctx context.Context, arg ListOverdueEvaluationsParams) ([]ListOverdueEvaluationsRow, error) {
+	rows, err := q.db.Query(ctx, listOverdueEvaluations, arg.Limit, arg.Offset, arg.Today, arg.CoordinatorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListOverdueEvaluationsRow{}
+	for rows.Next() {
+		var i ListOverdueEvaluationsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.FirstName,
+			&i.LastName,
+			&i.NextEvaluationDate,
+			&i.DaysOverdue,
+			&i.LocationName,
+			&i.CoordinatorID,
+			&i.CoordinatorFirstName,
+			&i.CoordinatorLastName,
+			&i.TotalCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const submitDraftEvaluation = `-- name: SubmitDraftEvaluation :one
 UPDATE client_evaluations 
 SET status = 'submitted', updated_at = NOW()
@@ -0,0 +1,74 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================
+// Test: AddClientNote / ListClientNotes
+// ============================================================
+
+func TestClientNotes_AppendAndListNewestFirst(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		clientID, deps := CreateTestClientWithDependencies(t, q)
+
+		firstName, lastName := "Anna", "Bakker"
+		authorUserID := CreateTestUser(t, q, CreateTestUserOptions{})
+		authorID := CreateTestEmployee(t, q, CreateTestEmployeeOptions{
+			UserID:    authorUserID,
+			FirstName: &firstName,
+			LastName:  &lastName,
+		})
+
+		bodies := []struct {
+			author string
+			body   string
+		}{
+			{deps.EmployeeID, "Intake call completed"},
+			{authorID, "Family visit scheduled"},
+			{deps.EmployeeID, "Visit went well"},
+		}
+		for _, n := range bodies {
+			note, err := q.AddClientNote(ctx, AddClientNoteParams{
+				ID:       generateTestID(),
+				ClientID: clientID,
+				AuthorID: n.author,
+				Body:     n.body,
+			})
+			require.NoError(t, err)
+			assert.True(t, note.CreatedAt.Valid)
+		}
+
+		notes, err := q.ListClientNotes(ctx, clientID)
+		require.NoError(t, err)
+		require.Len(t, notes, 3)
+
+		assert.Equal(t, "Visit went well", notes[0].Body)
+		assert.Equal(t, "Family visit scheduled", notes[1].Body)
+		assert.Equal(t, "Intake call completed", notes[2].Body)
+		for i := 1; i < len(notes); i++ {
+			assert.False(t, notes[i].CreatedAt.Time.After(notes[i-1].CreatedAt.Time))
+		}
+
+		assert.Equal(t, authorID, notes[1].AuthorID)
+		assert.Equal(t, "Anna", notes[1].AuthorFirstName)
+		assert.Equal(t, "Bakker", notes[1].AuthorLastName)
+		assert.Equal(t, deps.EmployeeID, notes[0].AuthorID)
+		assert.NotEmpty(t, notes[0].AuthorFirstName)
+	})
+}
+
+func TestListClientNotes_Empty(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		clientID, _ := CreateTestClientWithDependencies(t, q)
+
+		notes, err := q.ListClientNotes(context.Background(), clientID)
+		require.NoError(t, err)
+		assert.Empty(t, notes)
+	})
+}
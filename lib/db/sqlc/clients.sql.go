@@ -33,11 +33,13 @@ INSERT INTO clients (
     limitations,
     focus_areas,
     notes,
-    evaluation_interval_weeks
+    evaluation_interval_weeks,
+    created_by_user_id,
+    organization_id
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22
 )
-RETURNING id, first_name, last_name, bsn, date_of_birth, phone_number, gender, registration_form_id, intake_form_id, care_type, referring_org_id, status, assigned_location_id, coordinator_id, family_situation, limitations, focus_areas, notes, evaluation_interval_weeks, next_evaluation_date, created_at, updated_at
+RETURNING id, first_name, last_name, bsn, date_of_birth, phone_number, gender, registration_form_id, intake_form_id, care_type, referring_org_id, status, assigned_location_id, coordinator_id, family_situation, limitations, focus_areas, notes, evaluation_interval_weeks, next_evaluation_date, created_at, updated_at, created_by_user_id
 `
 
 type CreateClientParams struct {
@@ -61,6 +63,8 @@ type CreateClientParams struct {
 	FocusAreas              *string                 `json:"focus_areas"`
 	Notes                   *string                 `json:"notes"`
 	EvaluationIntervalWeeks *int32                  `json:"evaluation_interval_weeks"`
+	CreatedByUserID         *string                 `json:"created_by_user_id"`
+	OrganizationID          *string                 `json:"organization_id"`
 }
 
 type CreateClientRow struct {
@@ -86,6 +90,7 @@ type CreateClientRow struct {
 	NextEvaluationDate      pgtype.Date      `json:"next_evaluation_date"`
 	CreatedAt               pgtype.Timestamp `json:"created_at"`
 	UpdatedAt               pgtype.Timestamp `json:"updated_at"`
+	CreatedByUserID         *string          `json:"created_by_user_id"`
 }
 
 // ============================================================
@@ -113,6 +118,8 @@ func (q *Queries) CreateClient(ctx context.Context, arg CreateClientParams) (Cre
 		arg.FocusAreas,
 		arg.Notes,
 		arg.EvaluationIntervalWeeks,
+		arg.CreatedByUserID,
+		arg.OrganizationID,
 	)
 	var i CreateClientRow
 	err := row.Scan(
@@ -138,16 +145,83 @@ func (q *Queries) CreateClient(ctx context.Context, arg CreateClientParams) (Cre
 		&i.NextEvaluationDate,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.CreatedByUserID,
+	)
+	return i, err
+}
+
+const getClientByBSNForOrganization = `-- name: GetClientByBSNForOrganization :one
+SELECT id, first_name, last_name, bsn, date_of_birth, phone_number, gender, registration_form_id, intake_form_id, care_type, ambulatory_weekly_hours, referring_org_id, status, waiting_list_priority, care_start_date, care_end_date, discharge_date, closing_report, evaluation_report, reason_for_discharge, discharge_attachment_ids, discharge_status, assigned_location_id, coordinator_id, family_situation, limitations, focus_areas, notes, evaluation_interval_weeks, next_evaluation_date, created_at, updated_at, created_by_user_id, organization_id FROM clients
+WHERE bsn = $1
+  AND organization_id = $2::text
+  AND status != 'discharged'
+`
+
+type GetClientByBSNForOrganizationParams struct {
+	Bsn            string `json:"bsn"`
+	OrganizationID string `json:"organization_id"`
+}
+
+// Discharged clients are no longer active and are not matched; the same
+// person may have several discharged records but at most one active client
+// (uq_clients_active_bsn).
+func (q *Queries) GetClientByBSNForOrganization(ctx context.Context, arg GetClientByBSNForOrganizationParams) (Client, error) {
+	row := q.db.QueryRow(ctx, getClientByBSNForOrganization, arg.Bsn, arg.OrganizationID)
+	var i Client
+	err := row.Scan(
+		&i.ID,
+		&i.FirstName,
+		&i.LastName,
+		&i.Bsn,
+		&i.DateOfBirth,
+		&i.PhoneNumber,
+		&i.Gender,
+		&i.RegistrationFormID,
+		&i.IntakeFormID,
+		&i.CareType,
+		&i.AmbulatoryWeeklyHours,
+		&i.ReferringOrgID,
+		&i.Status,
+		&i.WaitingListPriority,
+		&i.CareStartDate,
+		&i.CareEndDate,
+		&i.DischargeDate,
+		&i.ClosingReport,
+		&i.EvaluationReport,
+		&i.ReasonForDischarge,
+		&i.DischargeAttachmentIds,
+		&i.DischargeStatus,
+		&i.AssignedLocationID,
+		&i.CoordinatorID,
+		&i.FamilySituation,
+		&i.Limitations,
+		&i.FocusAreas,
+		&i.Notes,
+		&i.EvaluationIntervalWeeks,
+		&i.NextEvaluationDate,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CreatedByUserID,
+		&i.OrganizationID,
 	)
 	return i, err
 }
 
 const getClientByID = `-- name: GetClientByID :one
-SELECT id, first_name, last_name, bsn, date_of_birth, phone_number, gender, registration_form_id, intake_form_id, care_type, ambulatory_weekly_hours, referring_org_id, status, waiting_list_priority, care_start_date, care_end_date, discharge_date, closing_report, evaluation_report, reason_for_discharge, discharge_attachment_ids, discharge_status, assigned_location_id, coordinator_id, family_situation, limitations, focus_areas, notes, evaluation_interval_weeks, next_evaluation_date, created_at, updated_at FROM clients WHERE id = $1
+SELECT id, first_name, last_name, bsn, date_of_birth, phone_number, gender, registration_form_id, intake_form_id, care_type, ambulatory_weekly_hours, referring_org_id, status, waiting_list_priority, care_start_date, care_end_date, discharge_date, closing_report, evaluation_report, reason_for_discharge, discharge_attachment_ids, discharge_status, assigned_location_id, coordinator_id, family_situation, limitations, focus_areas, notes, evaluation_interval_weeks, next_evaluation_date, created_at, updated_at, created_by_user_id, organization_id FROM clients
+WHERE id = $1
+  AND ($2::text IS NULL OR organization_id = $2::text)
 `
 
-func (q *Queries) GetClientByID(ctx context.Context, id string) (Client, error) {
-	row := q.db.QueryRow(ctx, getClientByID, id)
+type GetClientByIDParams struct {
+	ID             string  `json:"id"`
+	OrganizationID *string `json:"organization_id"`
+}
+
+// A NULL organization_id matches a client of any organization; ScopedStore
+// always passes one.
+func (q *Queries) GetClientByID(ctx context.Context, arg GetClientByIDParams) (Client, error) {
+	row := q.db.QueryRow(ctx, getClientByID, arg.ID, arg.OrganizationID)
 	var i Client
 	err := row.Scan(
 		&i.ID,
@@ -182,6 +256,8 @@ func (q *Queries) GetClientByID(ctx context.Context, id string) (Client, error)
 		&i.NextEvaluationDate,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.CreatedByUserID,
+		&i.OrganizationID,
 	)
 	return i, err
 }
@@ -222,10 +298,69 @@ func (q *Queries) GetDischargeStats(ctx context.Context) (GetDischargeStatsRow,
 	return i, err
 }
 
+const getDischargeTrendByMonth = `-- name: GetDischargeTrendByMonth :many
+SELECT
+    m.month::date AS month,
+    COUNT(c.id) FILTER (WHERE c.reason_for_discharge = 'treatment_completed') AS planned_discharges,
+    COUNT(c.id) FILTER (WHERE c.reason_for_discharge IS NOT NULL AND c.reason_for_discharge != 'treatment_completed') AS premature_discharges,
+    COUNT(c.id) AS total_count
+FROM generate_series(
+    $1::date - make_interval(months => $2::int - 1),
+    $1::date,
+    INTERVAL '1 month'
+) AS m(month)
+LEFT JOIN clients c
+    ON c.status = 'discharged'
+   AND c.discharge_date >= m.month
+   AND c.discharge_date < m.month + INTERVAL '1 month'
+GROUP BY m.month
+ORDER BY m.month ASC
+`
+
+type GetDischargeTrendByMonthParams struct {
+	CurrentMonth pgtype.Date `json:"current_month"`
+	Months       int32       `json:"months"`
+}
+
+type GetDischargeTrendByMonthRow struct {
+	Month               pgtype.Date `json:"month"`
+	PlannedDischarges   int64       `json:"planned_discharges"`
+	PrematureDischarges int64       `json:"premature_discharges"`
+	TotalCount          int64       `json:"total_count"`
+}
+
+// Discharges per calendar month over the last @months months, ending with the month
+// starting at @current_month, oldest first. Months without discharges are included.
+func (q *Queries) GetDischargeTrendByMonth(ctx context.Context, arg GetDischargeTrendByMonthParams) ([]GetDischargeTrendByMonthRow, error) {
+	rows, err := q.db.Query(ctx, getDischargeTrendByMonth, arg.CurrentMonth, arg.Months)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetDischargeTrendByMonthRow{}
+	for rows.Next() {
+		var i GetDischargeTrendByMonthRow
+		if err := rows.Scan(
+			&i.Month,
+			&i.PlannedDischarges,
+			&i.PrematureDischarges,
+			&i.TotalCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getInCareStats = `-- name: GetInCareStats :one
 SELECT 
     COUNT(*) as total_count,
-    COALESCE(AVG(EXTRACT(EPOCH FROM (CURRENT_TIMESTAMP - care_start_date)) / 86400), 0) as avg_days_in_care,
+    COALESCE(AVG(CURRENT_DATE - care_start_date), 0)::DOUBLE PRECISION as avg_days_in_care,
+    COALESCE(MAX(CURRENT_DATE - care_start_date), 0)::INT as longest_stay_days,
     COUNT(*) FILTER (WHERE care_type = 'protected_living') as protected_living_count,
     COUNT(*) FILTER (WHERE care_type = 'semi_independent_living') as semi_independent_living_count,
     COUNT(*) FILTER (WHERE care_type = 'independent_assisted_living') as independent_assisted_living_count,
@@ -235,12 +370,13 @@ WHERE status = 'in_care'
 `
 
 type GetInCareStatsRow struct {
-	TotalCount                     int64       `json:"total_count"`
-	AvgDaysInCare                  interface{} `json:"avg_days_in_care"`
-	ProtectedLivingCount           int64       `json:"protected_living_count"`
-	SemiIndependentLivingCount     int64       `json:"semi_independent_living_count"`
-	IndependentAssistedLivingCount int64       `json:"independent_assisted_living_count"`
-	AmbulatoryCareCount            int64       `json:"ambulatory_care_count"`
+	TotalCount                     int64   `json:"total_count"`
+	AvgDaysInCare                  float64 `json:"avg_days_in_care"`
+	LongestStayDays                int32   `json:"longest_stay_days"`
+	ProtectedLivingCount           int64   `json:"protected_living_count"`
+	SemiIndependentLivingCount     int64   `json:"semi_independent_living_count"`
+	IndependentAssistedLivingCount int64   `json:"independent_assisted_living_count"`
+	AmbulatoryCareCount            int64   `json:"ambulatory_care_count"`
 }
 
 func (q *Queries) GetInCareStats(ctx context.Context) (GetInCareStatsRow, error) {
@@ -249,6 +385,7 @@ func (q *Queries) GetInCareStats(ctx context.Context) (GetInCareStatsRow, error)
 	err := row.Scan(
 		&i.TotalCount,
 		&i.AvgDaysInCare,
+		&i.LongestStayDays,
 		&i.ProtectedLivingCount,
 		&i.SemiIndependentLivingCount,
 		&i.IndependentAssistedLivingCount,
@@ -289,6 +426,95 @@ func (q *Queries) GetWaitlistStats(ctx context.Context) (GetWaitlistStatsRow, er
 	return i, err
 }
 
+const listClientsForExport = `-- name: ListClientsForExport :many
+SELECT
+    c.id,
+    c.first_name,
+    c.last_name,
+    c.bsn,
+    c.date_of_birth,
+    c.gender,
+    c.status,
+    c.care_type,
+    c.care_start_date,
+    c.care_end_date,
+    c.discharge_date,
+    c.created_at,
+    l.name AS location_name,
+    e.first_name AS coordinator_first_name,
+    e.last_name AS coordinator_last_name
+FROM clients c
+JOIN locations l ON c.assigned_location_id = l.id
+JOIN employees e ON c.coordinator_id = e.id
+WHERE ($1::text IS NULL OR c.id > $1::text)
+    AND ($2::text IS NULL OR c.organization_id = $2::text)
+ORDER BY c.id
+LIMIT $3
+`
+
+type ListClientsForExportParams struct {
+	AfterID        *string `json:"after_id"`
+	OrganizationID *string `json:"organization_id"`
+	BatchSize      int32   `json:"batch_size"`
+}
+
+type ListClientsForExportRow struct {
+	ID                   string           `json:"id"`
+	FirstName            string           `json:"first_name"`
+	LastName             string           `json:"last_name"`
+	Bsn                  string           `json:"bsn"`
+	DateOfBirth          pgtype.Date      `json:"date_of_birth"`
+	Gender               GenderEnum       `json:"gender"`
+	Status               ClientStatusEnum `json:"status"`
+	CareType             CareTypeEnum     `json:"care_type"`
+	CareStartDate        pgtype.Date      `json:"care_start_date"`
+	CareEndDate          pgtype.Date      `json:"care_end_date"`
+	DischargeDate        pgtype.Date      `json:"discharge_date"`
+	CreatedAt            pgtype.Timestamp `json:"created_at"`
+	LocationName         string           `json:"location_name"`
+	CoordinatorFirstName string           `json:"coordinator_first_name"`
+	CoordinatorLastName  string           `json:"coordinator_last_name"`
+}
+
+// Keyset page over every client, or one organization's clients, ordered by
+// id. Pass the last id of the previous page as after_id (NULL for the first
+// page).
+func (q *Queries) ListClientsForExport(ctx context.Context, arg ListClientsForExportParams) ([]ListClientsForExportRow, error) {
+	rows, err := q.db.Query(ctx, listClientsForExport, arg.AfterID, arg.OrganizationID, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListClientsForExportRow{}
+	for rows.Next() {
+		var i ListClientsForExportRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.FirstName,
+			&i.LastName,
+			&i.Bsn,
+			&i.DateOfBirth,
+			&i.Gender,
+			&i.Status,
+			&i.CareType,
+			&i.CareStartDate,
+			&i.CareEndDate,
+			&i.DischargeDate,
+			&i.CreatedAt,
+			&i.LocationName,
+			&i.CoordinatorFirstName,
+			&i.CoordinatorLastName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listDischargedClients = `-- name: ListDischargedClients :many
 SELECT
     c.id,
@@ -324,6 +550,7 @@ WHERE c.discharge_status IS NOT NULL
          LOWER(c.first_name || ' ' || c.last_name) LIKE LOWER('%' || $3::text || '%'))
     AND ($4::discharge_status_enum IS NULL OR
          c.discharge_status = $4::discharge_status_enum)
+    AND ($5::text IS NULL OR c.organization_id = $5::text)
 ORDER BY c.discharge_date DESC
 LIMIT $1 OFFSET $2
 `
@@ -333,6 +560,7 @@ type ListDischargedClientsParams struct {
 	Offset          int32                   `json:"offset"`
 	Search          *string                 `json:"search"`
 	DischargeStatus NullDischargeStatusEnum `json:"discharge_status"`
+	OrganizationID  *string                 `json:"organization_id"`
 }
 
 type ListDischargedClientsRow struct {
@@ -366,6 +594,7 @@ func (q *Queries) ListDischargedClients(ctx context.Context, arg ListDischargedC
 		arg.Offset,
 		arg.Search,
 		arg.DischargeStatus,
+		arg.OrganizationID,
 	)
 	if err != nil {
 		return nil, err
@@ -440,15 +669,17 @@ WHERE c.status = 'in_care'
          LOWER(c.first_name || ' ' || c.last_name) LIKE LOWER('%' || $3::text || '%'))
     AND ($4::care_type_enum IS NULL OR
          c.care_type = $4::care_type_enum)
+    AND ($5::text IS NULL OR c.organization_id = $5::text)
 ORDER BY c.care_start_date DESC
 LIMIT $1 OFFSET $2
 `
 
 type ListInCareClientsParams struct {
-	Limit    int32            `json:"limit"`
-	Offset   int32            `json:"offset"`
-	Search   *string          `json:"search"`
-	CareType NullCareTypeEnum `json:"care_type"`
+	Limit          int32            `json:"limit"`
+	Offset         int32            `json:"offset"`
+	Search         *string          `json:"search"`
+	CareType       NullCareTypeEnum `json:"care_type"`
+	OrganizationID *string          `json:"organization_id"`
 }
 
 type ListInCareClientsRow struct {
@@ -479,6 +710,7 @@ func (q *Queries) ListInCareClients(ctx context.Context, arg ListInCareClientsPa
 		arg.Offset,
 		arg.Search,
 		arg.CareType,
+		arg.OrganizationID,
 	)
 	if err != nil {
 		return nil, err
@@ -518,6 +750,123 @@ func (q *Queries) ListInCareClients(ctx context.Context, arg ListInCareClientsPa
 	return items, nil
 }
 
+const listInCareClientsByCursor = `-- name: ListInCareClientsByCursor :many
+SELECT
+    c.id,
+    c.first_name,
+    c.last_name,
+    c.bsn,
+    c.date_of_birth,
+    c.phone_number,
+    c.gender,
+    c.care_type,
+    c.care_start_date,
+    c.care_end_date,
+    c.ambulatory_weekly_hours,
+    c.created_at,
+    l.id AS location_id,
+    l.name AS location_name,
+    e.id AS coordinator_id,
+    e.first_name AS coordinator_first_name,
+    e.last_name AS coordinator_last_name,
+    ro.name AS referring_org_name
+FROM clients c
+JOIN locations l ON c.assigned_location_id = l.id
+JOIN employees e ON c.coordinator_id = e.id
+LEFT JOIN referring_orgs ro ON c.referring_org_id = ro.id
+WHERE c.status = 'in_care'
+    AND ($1::text IS NULL OR
+         LOWER(c.first_name) LIKE LOWER('%' || $1::text || '%') OR
+         LOWER(c.last_name) LIKE LOWER('%' || $1::text || '%') OR
+         LOWER(c.first_name || ' ' || c.last_name) LIKE LOWER('%' || $1::text || '%'))
+    AND ($2::care_type_enum IS NULL OR
+         c.care_type = $2::care_type_enum)
+    AND ($3::timestamp IS NULL OR
+         (c.created_at, c.id) < ($3::timestamp, $4::text))
+    AND ($5::text IS NULL OR c.organization_id = $5::text)
+ORDER BY c.created_at DESC, c.id DESC
+LIMIT $6
+`
+
+type ListInCareClientsByCursorParams struct {
+	Search          *string          `json:"search"`
+	CareType        NullCareTypeEnum `json:"care_type"`
+	CursorCreatedAt pgtype.Timestamp `json:"cursor_created_at"`
+	CursorID        *string          `json:"cursor_id"`
+	OrganizationID  *string          `json:"organization_id"`
+	PageSize        int32            `json:"page_size"`
+}
+
+type ListInCareClientsByCursorRow struct {
+	ID                    string           `json:"id"`
+	FirstName             string           `json:"first_name"`
+	LastName              string           `json:"last_name"`
+	Bsn                   string           `json:"bsn"`
+	DateOfBirth           pgtype.Date      `json:"date_of_birth"`
+	PhoneNumber           *string          `json:"phone_number"`
+	Gender                GenderEnum       `json:"gender"`
+	CareType              CareTypeEnum     `json:"care_type"`
+	CareStartDate         pgtype.Date      `json:"care_start_date"`
+	CareEndDate           pgtype.Date      `json:"care_end_date"`
+	AmbulatoryWeeklyHours *int32           `json:"ambulatory_weekly_hours"`
+	CreatedAt             pgtype.Timestamp `json:"created_at"`
+	LocationID            string           `json:"location_id"`
+	LocationName          string           `json:"location_name"`
+	CoordinatorID         string           `json:"coordinator_id"`
+	CoordinatorFirstName  string           `json:"coordinator_first_name"`
+	CoordinatorLastName   string           `json:"coordinator_last_name"`
+	ReferringOrgName      *string          `json:"referring_org_name"`
+}
+
+// Keyset variant of ListInCareClients ordered by (created_at, id), newest
+// first. Pass the last row's created_at and id as the cursor (NULL for the
+// first page). No total count is computed.
+func (q *Queries) ListInCareClientsByCursor(ctx context.Context, arg ListInCareClientsByCursorParams) ([]ListInCareClientsByCursorRow, error) {
+	rows, err := q.db.Query(ctx, listInCareClientsByCursor,
+		arg.Search,
+		arg.CareType,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.OrganizationID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListInCareClientsByCursorRow{}
+	for rows.Next() {
+		var i ListInCareClientsByCursorRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.FirstName,
+			&i.LastName,
+			&i.Bsn,
+			&i.DateOfBirth,
+			&i.PhoneNumber,
+			&i.Gender,
+			&i.CareType,
+			&i.CareStartDate,
+			&i.CareEndDate,
+			&i.AmbulatoryWeeklyHours,
+			&i.CreatedAt,
+			&i.LocationID,
+			&i.LocationName,
+			&i.CoordinatorID,
+			&i.CoordinatorFirstName,
+			&i.CoordinatorLastName,
+			&i.ReferringOrgName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listWaitingListClients = `-- name: ListWaitingListClients :many
 SELECT
     c.id,
@@ -548,20 +897,48 @@ WHERE c.status = 'waiting_list'
          LOWER(c.first_name) LIKE LOWER('%' || $3::text || '%') OR
          LOWER(c.last_name) LIKE LOWER('%' || $3::text || '%') OR
          LOWER(c.first_name || ' ' || c.last_name) LIKE LOWER('%' || $3::text || '%'))
+    AND ($4::text IS NULL OR c.organization_id = $4::text)
+-- @sort is a key from util.SortOrder; the CASE arms only compare it against
+-- fixed strings. Unmatched arms are NULL for every row, so the default order
+-- (highest priority first, then longest waiting) decides. Priorities rank
+-- low < normal < high, so priority_desc lists high first.
 ORDER BY
+    CASE WHEN $5::text = 'name_asc' THEN c.last_name END ASC,
+    CASE WHEN $5::text = 'name_asc' THEN c.first_name END ASC,
+    CASE WHEN $5::text = 'name_desc' THEN c.last_name END DESC,
+    CASE WHEN $5::text = 'name_desc' THEN c.first_name END DESC,
+    CASE WHEN $5::text = 'created_at_asc' THEN c.created_at END ASC,
+    CASE WHEN $5::text = 'created_at_desc' THEN c.created_at END DESC,
+    CASE WHEN $5::text = 'priority_asc' THEN
+        CASE c.waiting_list_priority
+            WHEN 'low' THEN 1
+            WHEN 'normal' THEN 2
+            WHEN 'high' THEN 3
+        END
+    END ASC,
+    CASE WHEN $5::text = 'priority_desc' THEN
+        CASE c.waiting_list_priority
+            WHEN 'low' THEN 1
+            WHEN 'normal' THEN 2
+            WHEN 'high' THEN 3
+        END
+    END DESC,
     CASE c.waiting_list_priority
-        WHEN 'high' THEN 1
+        WHEN 'low' THEN 1
         WHEN 'normal' THEN 2
-        WHEN 'low' THEN 3
-    END,
-    c.created_at ASC
+        WHEN 'high' THEN 3
+    END DESC,
+    c.created_at ASC,
+    c.id
 LIMIT $1 OFFSET $2
 `
 
 type ListWaitingListClientsParams struct {
-	Limit  int32   `json:"limit"`
-	Offset int32   `json:"offset"`
-	Search *string `json:"search"`
+	Limit          int32   `json:"limit"`
+	Offset         int32   `json:"offset"`
+	Search         *string `json:"search"`
+	OrganizationID *string `json:"organization_id"`
+	Sort           string  `json:"sort"`
 }
 
 type ListWaitingListClientsRow struct {
@@ -587,7 +964,13 @@ type ListWaitingListClientsRow struct {
 }
 
 func (q *Queries) ListWaitingListClients(ctx context.Context, arg ListWaitingListClientsParams) ([]ListWaitingListClientsRow, error) {
-	rows, err := q.db.Query(ctx, listWaitingListClients, arg.Limit, arg.Offset, arg.Search)
+	rows, err := q.db.Query(ctx, listWaitingListClients,
+		arg.Limit,
+		arg.Offset,
+		arg.Search,
+		arg.OrganizationID,
+		arg.Sort,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -626,6 +1009,87 @@ func (q *Queries) ListWaitingListClients(ctx context.Context, arg ListWaitingLis
 	return items, nil
 }
 
+const searchClients = `-- name: SearchClients :many
+SELECT
+    c.id,
+    c.first_name,
+    c.last_name,
+    c.bsn,
+    c.date_of_birth,
+    c.status,
+    l.id AS location_id,
+    l.name AS location_name,
+    COUNT(*) OVER() AS total_count
+FROM clients c
+JOIN locations l ON c.assigned_location_id = l.id
+WHERE ($4::client_status_enum IS NULL OR c.status = $4::client_status_enum)
+    AND (LOWER(c.first_name) LIKE LOWER('%' || $3::text || '%') OR
+         LOWER(c.last_name) LIKE LOWER('%' || $3::text || '%') OR
+         LOWER(c.first_name || ' ' || c.last_name) LIKE LOWER('%' || $3::text || '%') OR
+         c.bsn LIKE $3::text || '%')
+    AND ($5::text IS NULL OR c.organization_id = $5::text)
+ORDER BY c.last_name, c.first_name, c.id
+LIMIT $1 OFFSET $2
+`
+
+type SearchClientsParams struct {
+	Limit          int32                `json:"limit"`
+	Offset         int32                `json:"offset"`
+	Search         string               `json:"search"`
+	Status         NullClientStatusEnum `json:"status"`
+	OrganizationID *string              `json:"organization_id"`
+}
+
+type SearchClientsRow struct {
+	ID           string           `json:"id"`
+	FirstName    string           `json:"first_name"`
+	LastName     string           `json:"last_name"`
+	Bsn          string           `json:"bsn"`
+	DateOfBirth  pgtype.Date      `json:"date_of_birth"`
+	Status       ClientStatusEnum `json:"status"`
+	LocationID   string           `json:"location_id"`
+	LocationName string           `json:"location_name"`
+	TotalCount   int64            `json:"total_count"`
+}
+
+// Searches clients in every status by name or BSN prefix, with an optional
+// status filter. Clients are never soft-deleted, so every match is returned.
+func (q *Queries) SearchClients(ctx context.Context, arg SearchClientsParams) ([]SearchClientsRow, error) {
+	rows, err := q.db.Query(ctx, searchClients,
+		arg.Limit,
+		arg.Offset,
+		arg.Search,
+		arg.Status,
+		arg.OrganizationID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SearchClientsRow{}
+	for rows.Next() {
+		var i SearchClientsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.FirstName,
+			&i.LastName,
+			&i.Bsn,
+			&i.DateOfBirth,
+			&i.Status,
+			&i.LocationID,
+			&i.LocationName,
+			&i.TotalCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateClient = `-- name: UpdateClient :one
 UPDATE clients SET
     first_name = COALESCE($2, first_name),
@@ -659,6 +1123,7 @@ UPDATE clients SET
     discharge_status = COALESCE($30::discharge_status_enum, discharge_status),
     updated_at = NOW()
 WHERE id = $1
+  AND ($31::text IS NULL OR organization_id = $31::text)
 RETURNING id
 `
 
@@ -693,6 +1158,7 @@ type UpdateClientParams struct {
 	ReasonForDischarge      NullDischargeReasonEnum     `json:"reason_for_discharge"`
 	DischargeAttachmentIds  []string                    `json:"discharge_attachment_ids"`
 	DischargeStatus         NullDischargeStatusEnum     `json:"discharge_status"`
+	OrganizationID          *string                     `json:"organization_id"`
 }
 
 func (q *Queries) UpdateClient(ctx context.Context, arg UpdateClientParams) (string, error) {
@@ -727,6 +1193,7 @@ func (q *Queries) UpdateClient(ctx context.Context, arg UpdateClientParams) (str
 		arg.ReasonForDischarge,
 		arg.DischargeAttachmentIds,
 		arg.DischargeStatus,
+		arg.OrganizationID,
 	)
 	var id string
 	err := row.Scan(&id)
@@ -3,10 +3,12 @@ package db
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -51,7 +53,7 @@ func TestCreateClient(t *testing.T) {
 			wantErr: false,
 			validate: func(t *testing.T, q *Queries, params CreateClientParams) {
 				ctx := context.Background()
-				client, err := q.GetClientByID(ctx, params.ID)
+				client, err := q.GetClientByID(ctx, GetClientByIDParams{ID: params.ID})
 				require.NoError(t, err)
 				assert.Equal(t, params.FirstName, client.FirstName)
 				assert.Equal(t, params.LastName, client.LastName)
@@ -59,6 +61,64 @@ func TestCreateClient(t *testing.T) {
 				assert.Equal(t, params.Gender, client.Gender)
 				assert.Equal(t, params.CareType, client.CareType)
 				assert.Equal(t, params.Status, client.Status)
+				assert.Nil(t, client.CreatedByUserID)
+			},
+		},
+		{
+			name: "success_records_creator",
+			setup: func(t *testing.T, q *Queries) CreateClientParams {
+				deps := CreateFullClientDependencyChain(t, q)
+				return CreateClientParams{
+					ID:                  generateTestID(),
+					FirstName:           "Sam",
+					LastName:            "Jansen",
+					Bsn:                 generateTestID()[:9],
+					DateOfBirth:         toPgDate(time.Date(1992, 3, 10, 0, 0, 0, 0, time.UTC)),
+					Gender:              GenderEnumOther,
+					RegistrationFormID:  deps.RegistrationFormID,
+					IntakeFormID:        deps.IntakeFormID,
+					CareType:            CareTypeEnumProtectedLiving,
+					WaitingListPriority: WaitingListPriorityEnumNormal,
+					Status:              ClientStatusEnumWaitingList,
+					AssignedLocationID:  deps.LocationID,
+					CoordinatorID:       deps.EmployeeID,
+					CreatedByUserID:     &deps.UserID,
+				}
+			},
+			wantErr: false,
+			validate: func(t *testing.T, q *Queries, params CreateClientParams) {
+				ctx := context.Background()
+				client, err := q.GetClientByID(ctx, GetClientByIDParams{ID: params.ID})
+				require.NoError(t, err)
+				require.NotNil(t, client.CreatedByUserID)
+				assert.Equal(t, *params.CreatedByUserID, *client.CreatedByUserID)
+			},
+		},
+		{
+			name: "success_with_added_gender_value",
+			setup: func(t *testing.T, q *Queries) CreateClientParams {
+				deps := CreateFullClientDependencyChain(t, q)
+				return CreateClientParams{
+					ID:                  generateTestID(),
+					FirstName:           "Robin",
+					LastName:            "de Vries",
+					Bsn:                 generateTestID()[:9],
+					DateOfBirth:         toPgDate(time.Date(1998, 7, 21, 0, 0, 0, 0, time.UTC)),
+					Gender:              GenderEnumNonBinary,
+					RegistrationFormID:  deps.RegistrationFormID,
+					IntakeFormID:        deps.IntakeFormID,
+					CareType:            CareTypeEnumProtectedLiving,
+					WaitingListPriority: WaitingListPriorityEnumNormal,
+					Status:              ClientStatusEnumWaitingList,
+					AssignedLocationID:  deps.LocationID,
+					CoordinatorID:       deps.EmployeeID,
+				}
+			},
+			wantErr: false,
+			validate: func(t *testing.T, q *Queries, params CreateClientParams) {
+				client, err := q.GetClientByID(context.Background(), GetClientByIDParams{ID: params.ID})
+				require.NoError(t, err)
+				assert.Equal(t, GenderEnumNonBinary, client.Gender)
 			},
 		},
 		{
@@ -86,7 +146,7 @@ func TestCreateClient(t *testing.T) {
 			wantErr: false,
 			validate: func(t *testing.T, q *Queries, params CreateClientParams) {
 				ctx := context.Background()
-				client, err := q.GetClientByID(ctx, params.ID)
+				client, err := q.GetClientByID(ctx, GetClientByIDParams{ID: params.ID})
 				require.NoError(t, err)
 				assert.NotNil(t, client.ReferringOrgID)
 				assert.Equal(t, *params.ReferringOrgID, *client.ReferringOrgID)
@@ -267,7 +327,7 @@ func TestGetClientByID(t *testing.T) {
 				ctx := context.Background()
 				id := tt.setup(t, q)
 
-				client, err := q.GetClientByID(ctx, id)
+				client, err := q.GetClientByID(ctx, GetClientByIDParams{ID: id})
 
 				if tt.wantErr {
 					require.Error(t, err)
@@ -286,6 +346,126 @@ func TestGetClientByID(t *testing.T) {
 	}
 }
 
+// ============================================================
+// Test: GetClientByBSNForOrganization
+// ============================================================
+
+func TestGetClientByBSNForOrganization(t *testing.T) {
+	createClient := func(t *testing.T, q *Queries, orgID, bsn string, discharged bool) string {
+		deps := CreateFullClientDependencyChain(t, q)
+		opts := CreateTestClientOptions{
+			RegistrationFormID: deps.RegistrationFormID,
+			IntakeFormID:       deps.IntakeFormID,
+			AssignedLocationID: deps.LocationID,
+			CoordinatorID:      deps.EmployeeID,
+			Bsn:                &bsn,
+			OrganizationID:     &orgID,
+		}
+		if discharged {
+			status := ClientStatusEnumDischarged
+			reason := DischargeReasonEnumTreatmentCompleted
+			dischargeStatus := DischargeStatusEnumCompleted
+			start := time.Now().AddDate(0, -6, 0)
+			end := time.Now().AddDate(0, -1, 0)
+			opts.Status = &status
+			opts.CareStartDate = &start
+			opts.DischargeDate = &end
+			opts.ReasonForDischarge = &reason
+			opts.DischargeStatus = &dischargeStatus
+		}
+		return CreateTestClient(t, q, opts)
+	}
+
+	t.Run("found", func(t *testing.T) {
+		runTestWithTx(t, func(t *testing.T, q *Queries) {
+			orgID := CreateTestOrganization(t, q)
+			bsn := generateTestID()[:9]
+			clientID := createClient(t, q, orgID, bsn, false)
+
+			client, err := q.GetClientByBSNForOrganization(context.Background(), GetClientByBSNForOrganizationParams{
+				Bsn:            bsn,
+				OrganizationID: orgID,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, clientID, client.ID)
+			assert.Equal(t, bsn, client.Bsn)
+		})
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		runTestWithTx(t, func(t *testing.T, q *Queries) {
+			orgID := CreateTestOrganization(t, q)
+			otherOrgID := CreateTestOrganization(t, q)
+			bsn := generateTestID()[:9]
+			createClient(t, q, orgID, bsn, false)
+
+			_, err := q.GetClientByBSNForOrganization(context.Background(), GetClientByBSNForOrganizationParams{
+				Bsn:            "000000000",
+				OrganizationID: orgID,
+			})
+			assert.ErrorIs(t, err, pgx.ErrNoRows)
+
+			// Another organization's client does not match
+			_, err = q.GetClientByBSNForOrganization(context.Background(), GetClientByBSNForOrganizationParams{
+				Bsn:            bsn,
+				OrganizationID: otherOrgID,
+			})
+			assert.ErrorIs(t, err, pgx.ErrNoRows)
+		})
+	})
+
+	t.Run("discharged_client_does_not_match", func(t *testing.T) {
+		runTestWithTx(t, func(t *testing.T, q *Queries) {
+			orgID := CreateTestOrganization(t, q)
+			bsn := generateTestID()[:9]
+			createClient(t, q, orgID, bsn, true)
+
+			_, err := q.GetClientByBSNForOrganization(context.Background(), GetClientByBSNForOrganizationParams{
+				Bsn:            bsn,
+				OrganizationID: orgID,
+			})
+			assert.ErrorIs(t, err, pgx.ErrNoRows)
+
+			// A re-referred client with the same BSN is the one found
+			activeID := createClient(t, q, orgID, bsn, false)
+			client, err := q.GetClientByBSNForOrganization(context.Background(), GetClientByBSNForOrganizationParams{
+				Bsn:            bsn,
+				OrganizationID: orgID,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, activeID, client.ID)
+		})
+	})
+
+	t.Run("bsn_unique_among_active_clients", func(t *testing.T) {
+		runTestWithTx(t, func(t *testing.T, q *Queries) {
+			orgID := CreateTestOrganization(t, q)
+			bsn := generateTestID()[:9]
+			createClient(t, q, orgID, bsn, false)
+
+			deps := CreateFullClientDependencyChain(t, q)
+			_, err := q.CreateClient(context.Background(), CreateClientParams{
+				ID:                  generateTestID(),
+				FirstName:           "Second",
+				LastName:            "Client",
+				Bsn:                 bsn,
+				DateOfBirth:         toPgDate(time.Date(1985, 6, 15, 0, 0, 0, 0, time.UTC)),
+				Gender:              GenderEnumOther,
+				RegistrationFormID:  deps.RegistrationFormID,
+				IntakeFormID:        deps.IntakeFormID,
+				CareType:            CareTypeEnumProtectedLiving,
+				WaitingListPriority: WaitingListPriorityEnumNormal,
+				Status:              ClientStatusEnumWaitingList,
+				AssignedLocationID:  deps.LocationID,
+				CoordinatorID:       deps.EmployeeID,
+				OrganizationID:      &orgID,
+			})
+			require.Error(t, err)
+			assert.True(t, IsUniqueViolationOf(err, "uq_clients_active_bsn"), "got: %v", err)
+		})
+	})
+}
+
 // ============================================================
 // Test: UpdateClient
 // ============================================================
@@ -311,7 +491,7 @@ func TestUpdateClient(t *testing.T) {
 			wantErr: false,
 			validate: func(t *testing.T, q *Queries, id string) {
 				ctx := context.Background()
-				client, err := q.GetClientByID(ctx, id)
+				client, err := q.GetClientByID(ctx, GetClientByIDParams{ID: id})
 				require.NoError(t, err)
 				assert.Equal(t, "UpdatedFirst", client.FirstName)
 				assert.Equal(t, "UpdatedLast", client.LastName)
@@ -332,7 +512,7 @@ func TestUpdateClient(t *testing.T) {
 			wantErr: false,
 			validate: func(t *testing.T, q *Queries, id string) {
 				ctx := context.Background()
-				client, err := q.GetClientByID(ctx, id)
+				client, err := q.GetClientByID(ctx, GetClientByIDParams{ID: id})
 				require.NoError(t, err)
 				assert.Equal(t, ClientStatusEnumInCare, client.Status)
 				assert.True(t, client.CareStartDate.Valid)
@@ -361,7 +541,7 @@ func TestUpdateClient(t *testing.T) {
 			wantErr: false,
 			validate: func(t *testing.T, q *Queries, id string) {
 				ctx := context.Background()
-				client, err := q.GetClientByID(ctx, id)
+				client, err := q.GetClientByID(ctx, GetClientByIDParams{ID: id})
 				require.NoError(t, err)
 				assert.Equal(t, ClientStatusEnumDischarged, client.Status)
 				assert.True(t, client.DischargeDate.Valid)
@@ -383,7 +563,7 @@ func TestUpdateClient(t *testing.T) {
 			wantErr: false,
 			validate: func(t *testing.T, q *Queries, id string) {
 				ctx := context.Background()
-				client, err := q.GetClientByID(ctx, id)
+				client, err := q.GetClientByID(ctx, GetClientByIDParams{ID: id})
 				require.NoError(t, err)
 				assert.NotNil(t, client.EvaluationIntervalWeeks)
 				assert.Equal(t, int32(4), *client.EvaluationIntervalWeeks)
@@ -431,6 +611,26 @@ func TestUpdateClient(t *testing.T) {
 // Test: ListWaitingListClients
 // ============================================================
 
+// createWaitingClientsByPriority creates one waiting-list client per priority,
+// named after it, in an order that matches neither sort direction.
+func createWaitingClientsByPriority(t *testing.T, q *Queries) {
+	t.Helper()
+	ctx := context.Background()
+	for _, priority := range []WaitingListPriorityEnum{
+		WaitingListPriorityEnumNormal,
+		WaitingListPriorityEnumHigh,
+		WaitingListPriorityEnumLow,
+	} {
+		c, _ := CreateTestClientWithDependencies(t, q)
+		_, err := q.UpdateClient(ctx, UpdateClientParams{
+			ID:                  c,
+			FirstName:           strPtr(string(priority)),
+			WaitingListPriority: NullWaitingListPriorityEnum{WaitingListPriorityEnum: priority, Valid: true},
+		})
+		require.NoError(t, err)
+	}
+}
+
 func TestListWaitingListClients(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -535,6 +735,48 @@ func TestListWaitingListClients(t *testing.T) {
 				assert.Equal(t, "LowPriority", results[1].FirstName)
 			},
 		},
+		{
+			name:   "sorted_by_priority_ascending",
+			setup:  createWaitingClientsByPriority,
+			params: ListWaitingListClientsParams{Limit: 10, Offset: 0, Sort: "priority_asc"},
+			validate: func(t *testing.T, results []ListWaitingListClientsRow) {
+				require.Len(t, results, 3)
+				assert.Equal(t, []string{"low", "normal", "high"},
+					[]string{results[0].FirstName, results[1].FirstName, results[2].FirstName})
+			},
+		},
+		{
+			name:   "sorted_by_priority_descending",
+			setup:  createWaitingClientsByPriority,
+			params: ListWaitingListClientsParams{Limit: 10, Offset: 0, Sort: "priority_desc"},
+			validate: func(t *testing.T, results []ListWaitingListClientsRow) {
+				require.Len(t, results, 3)
+				assert.Equal(t, []string{"high", "normal", "low"},
+					[]string{results[0].FirstName, results[1].FirstName, results[2].FirstName})
+			},
+		},
+		{
+			name: "ordered_by_name_descending",
+			setup: func(t *testing.T, q *Queries) {
+				ctx := context.Background()
+				for _, last := range []string{"Bakker", "Visser", "Jansen"} {
+					c, _ := CreateTestClientWithDependencies(t, q)
+					_, err := q.UpdateClient(ctx, UpdateClientParams{
+						ID:        c,
+						FirstName: strPtr(last),
+						LastName:  strPtr(last),
+					})
+					require.NoError(t, err)
+				}
+			},
+			params: ListWaitingListClientsParams{Limit: 10, Offset: 0, Sort: "name_desc"},
+			validate: func(t *testing.T, results []ListWaitingListClientsRow) {
+				require.Len(t, results, 3)
+				assert.Equal(t, "Visser", results[0].FirstName)
+				assert.Equal(t, "Jansen", results[1].FirstName)
+				assert.Equal(t, "Bakker", results[2].FirstName)
+			},
+		},
 		{
 			name: "excludes_in_care_clients",
 			setup: func(t *testing.T, q *Queries) {
@@ -691,6 +933,69 @@ func TestListInCareClients(t *testing.T) {
 	}
 }
 
+// ============================================================
+// Test: ListInCareClientsByCursor
+// ============================================================
+
+func TestListInCareClientsByCursor(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		created := map[string]bool{}
+		for i := 0; i < 7; i++ {
+			clientID, _ := CreateTestClientWithDependencies(t, q)
+			_, err := q.UpdateClient(ctx, UpdateClientParams{
+				ID:            clientID,
+				Status:        NullClientStatusEnum{ClientStatusEnum: ClientStatusEnumInCare, Valid: true},
+				CareStartDate: toPgDate(time.Now()),
+			})
+			require.NoError(t, err)
+			created[clientID] = true
+			// Rows inserted in one transaction share created_at; spread some of
+			// them out so both the timestamp and the id tie-breaker are exercised.
+			if i%2 == 0 {
+				_, err = q.db.Exec(ctx,
+					"UPDATE clients SET created_at = created_at - make_interval(mins => $2::int) WHERE id = $1",
+					clientID, i)
+				require.NoError(t, err)
+			}
+		}
+		// A waiting-list client must never show up
+		CreateTestClientWithDependencies(t, q)
+
+		const pageSize = 3
+		var cursorAt pgtype.Timestamp
+		var cursorID *string
+		var seen []ListInCareClientsByCursorRow
+		for {
+			page, err := q.ListInCareClientsByCursor(ctx, ListInCareClientsByCursorParams{
+				CursorCreatedAt: cursorAt,
+				CursorID:        cursorID,
+				PageSize:        pageSize,
+			})
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(page), pageSize)
+			seen = append(seen, page...)
+			if len(page) < pageSize {
+				break
+			}
+			last := page[len(page)-1]
+			cursorAt = last.CreatedAt
+			cursorID = &last.ID
+		}
+
+		require.Len(t, seen, len(created))
+		unique := map[string]bool{}
+		for i, row := range seen {
+			assert.True(t, created[row.ID], "unexpected client %s", row.ID)
+			assert.False(t, unique[row.ID], "client %s returned twice", row.ID)
+			unique[row.ID] = true
+			if i > 0 {
+				assert.False(t, row.CreatedAt.Time.After(seen[i-1].CreatedAt.Time), "rows must be newest first")
+			}
+		}
+	})
+}
+
 // ============================================================
 // Test: ListDischargedClients
 // ============================================================
@@ -823,6 +1128,52 @@ func TestListDischargedClients(t *testing.T) {
 	}
 }
 
+// ============================================================
+// Test: ListClientsForExport
+// ============================================================
+
+func TestListClientsForExport(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		created := map[string]bool{}
+		for i := 0; i < 5; i++ {
+			clientID, _ := CreateTestClientWithDependencies(t, q)
+			created[clientID] = true
+		}
+
+		var afterID *string
+		var seen []string
+		for {
+			page, err := q.ListClientsForExport(ctx, ListClientsForExportParams{
+				AfterID:   afterID,
+				BatchSize: 2,
+			})
+			require.NoError(t, err)
+			assert.LessOrEqual(t, len(page), 2)
+			for _, row := range page {
+				seen = append(seen, row.ID)
+				assert.NotEmpty(t, row.LocationName)
+				assert.NotEmpty(t, row.CoordinatorFirstName)
+			}
+			if len(page) < 2 {
+				break
+			}
+			afterID = &page[len(page)-1].ID
+		}
+
+		unique := map[string]bool{}
+		found := 0
+		for _, id := range seen {
+			assert.False(t, unique[id], "client %s returned twice", id)
+			unique[id] = true
+			if created[id] {
+				found++
+			}
+		}
+		assert.Equal(t, len(created), found)
+	})
+}
+
 // ============================================================
 // Test: GetWaitlistStats
 // ============================================================
@@ -980,6 +1331,43 @@ func TestGetInCareStats(t *testing.T) {
 				assert.Equal(t, int64(1), stats.TotalCount)
 			},
 		},
+		{
+			name: "length_of_stay_excludes_discharged",
+			setup: func(t *testing.T, q *Queries) {
+				ctx := context.Background()
+				for _, daysAgo := range []int{10, 20, 30} {
+					c, _ := CreateTestClientWithDependencies(t, q)
+					_, err := q.UpdateClient(ctx, UpdateClientParams{
+						ID:            c,
+						Status:        NullClientStatusEnum{ClientStatusEnum: ClientStatusEnumInCare, Valid: true},
+						CareStartDate: toPgDate(time.Now().AddDate(0, 0, -daysAgo)),
+					})
+					require.NoError(t, err)
+				}
+				// A long, discharged stay must not count
+				discharged, _ := CreateTestClientWithDependencies(t, q)
+				_, err := q.UpdateClient(ctx, UpdateClientParams{
+					ID:            discharged,
+					Status:        NullClientStatusEnum{ClientStatusEnum: ClientStatusEnumInCare, Valid: true},
+					CareStartDate: toPgDate(time.Now().AddDate(0, 0, -400)),
+				})
+				require.NoError(t, err)
+				_, err = q.UpdateClient(ctx, UpdateClientParams{
+					ID:                 discharged,
+					Status:             NullClientStatusEnum{ClientStatusEnum: ClientStatusEnumDischarged, Valid: true},
+					DischargeDate:      toPgDate(time.Now()),
+					ReasonForDischarge: NullDischargeReasonEnum{DischargeReasonEnum: DischargeReasonEnumTreatmentCompleted, Valid: true},
+					DischargeStatus:    NullDischargeStatusEnum{DischargeStatusEnum: DischargeStatusEnumCompleted, Valid: true},
+				})
+				require.NoError(t, err)
+			},
+			validate: func(t *testing.T, stats GetInCareStatsRow) {
+				assert.Equal(t, int64(3), stats.TotalCount)
+				// Go and Postgres may disagree on "today" around midnight
+				assert.InDelta(t, 20.0, stats.AvgDaysInCare, 1)
+				assert.InDelta(t, 30, stats.LongestStayDays, 1)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -1166,6 +1554,68 @@ func TestGetDischargeStats(t *testing.T) {
 	}
 }
 
+// ============================================================
+// Test: GetDischargeTrendByMonth
+// ============================================================
+
+func TestGetDischargeTrendByMonth(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+
+		discharge := func(on time.Time, reason DischargeReasonEnum) {
+			clientID, _ := CreateTestClientWithDependencies(t, q)
+			_, err := q.UpdateClient(ctx, UpdateClientParams{
+				ID:            clientID,
+				Status:        NullClientStatusEnum{ClientStatusEnum: ClientStatusEnumInCare, Valid: true},
+				CareStartDate: toPgDate(on.AddDate(0, -6, 0)),
+			})
+			require.NoError(t, err)
+			_, err = q.UpdateClient(ctx, UpdateClientParams{
+				ID:                 clientID,
+				Status:             NullClientStatusEnum{ClientStatusEnum: ClientStatusEnumDischarged, Valid: true},
+				DischargeDate:      toPgDate(on),
+				DischargeStatus:    NullDischargeStatusEnum{DischargeStatusEnum: DischargeStatusEnumCompleted, Valid: true},
+				ReasonForDischarge: NullDischargeReasonEnum{DischargeReasonEnum: reason, Valid: true},
+			})
+			require.NoError(t, err)
+		}
+		day := func(month time.Month, d int) time.Time {
+			return time.Date(2025, month, d, 0, 0, 0, 0, time.UTC)
+		}
+
+		discharge(day(time.January, 15), DischargeReasonEnumTreatmentCompleted)
+		// First and last day of March land in the same bucket
+		discharge(day(time.March, 1), DischargeReasonEnumTerminatedByClient)
+		discharge(day(time.March, 31), DischargeReasonEnumTreatmentCompleted)
+		// Outside the three requested months
+		discharge(time.Date(2024, time.December, 31, 0, 0, 0, 0, time.UTC), DischargeReasonEnumTreatmentCompleted)
+		discharge(day(time.April, 1), DischargeReasonEnumTreatmentCompleted)
+
+		rows, err := q.GetDischargeTrendByMonth(ctx, GetDischargeTrendByMonthParams{
+			CurrentMonth: toPgDate(day(time.March, 1)),
+			Months:       3,
+		})
+		require.NoError(t, err)
+		require.Len(t, rows, 3)
+
+		want := []struct {
+			month     time.Time
+			planned   int64
+			premature int64
+		}{
+			{day(time.January, 1), 1, 0},
+			{day(time.February, 1), 0, 0},
+			{day(time.March, 1), 1, 1},
+		}
+		for i, w := range want {
+			assert.Equal(t, w.month, rows[i].Month.Time)
+			assert.Equal(t, w.planned, rows[i].PlannedDischarges)
+			assert.Equal(t, w.premature, rows[i].PrematureDischarges)
+			assert.Equal(t, w.planned+w.premature, rows[i].TotalCount)
+		}
+	})
+}
+
 // ============================================================
 // Test: UpdateClientByIntakeFormID
 // ============================================================
@@ -1294,3 +1744,62 @@ func TestUpdateClientByRegistrationFormID(t *testing.T) {
 		})
 	}
 }
+
+// ============================================================
+// Test: SearchClients
+// ============================================================
+
+func TestSearchClients(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		lastName := "Search" + generateTestID()[:8]
+
+		createClient := func(firstName string) string {
+			deps := CreateFullClientDependencyChain(t, q)
+			return CreateTestClient(t, q, CreateTestClientOptions{
+				FirstName:          strPtr(firstName),
+				LastName:           strPtr(lastName),
+				RegistrationFormID: deps.RegistrationFormID,
+				IntakeFormID:       deps.IntakeFormID,
+				AssignedLocationID: deps.LocationID,
+				CoordinatorID:      deps.EmployeeID,
+			})
+		}
+		waitingID := createClient("Anna")
+		inCareID := createClient("Bram")
+		_, err := q.UpdateClient(ctx, UpdateClientParams{
+			ID:            inCareID,
+			Status:        NullClientStatusEnum{ClientStatusEnum: ClientStatusEnumInCare, Valid: true},
+			CareStartDate: toPgDate(time.Now()),
+		})
+		require.NoError(t, err)
+		CreateTestClientWithDependencies(t, q) // unrelated client
+
+		results, err := q.SearchClients(ctx, SearchClientsParams{
+			Limit:  10,
+			Offset: 0,
+			Search: strings.ToLower(lastName),
+		})
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.Equal(t, int64(2), results[0].TotalCount)
+
+		statuses := map[string]ClientStatusEnum{}
+		for _, r := range results {
+			statuses[r.ID] = r.Status
+			assert.NotEmpty(t, r.LocationName)
+		}
+		assert.Equal(t, ClientStatusEnumWaitingList, statuses[waitingID])
+		assert.Equal(t, ClientStatusEnumInCare, statuses[inCareID])
+
+		filtered, err := q.SearchClients(ctx, SearchClientsParams{
+			Limit:  10,
+			Offset: 0,
+			Search: lastName,
+			Status: NullClientStatusEnum{ClientStatusEnum: ClientStatusEnumInCare, Valid: true},
+		})
+		require.NoError(t, err)
+		require.Len(t, filtered, 1)
+		assert.Equal(t, inCareID, filtered[0].ID)
+	})
+}
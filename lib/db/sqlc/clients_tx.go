@@ -1,6 +1,12 @@
 package db
 
-import "context"
+import (
+	"care-cordination/lib/nanoid"
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+)
 
 type MoveClientToWaitingListTxParams struct {
 	Client                    CreateClientParams
@@ -8,6 +14,10 @@ type MoveClientToWaitingListTxParams struct {
 	IntakeFormNewStatus       IntakeStatusEnum
 	RegistrationFormID        string
 	RegistrationFormNewStatus RegistrationStatusEnum
+	// User creating the client, recorded in the client's assignment history
+	ChangedBy string
+	// If set, the coordinator must have room in their caseload
+	Caseload *CaseloadCheck
 }
 
 type MoveClientToWaitingListTxResult struct {
@@ -21,14 +31,21 @@ func (s *Store) MoveClientToWaitingListTx(
 	var result MoveClientToWaitingListTxResult
 
 	err := s.ExecTx(ctx, func(q *Queries) error {
-		// 1. Create the client
+		// 1. Make sure the coordinator has room for the client
+		if arg.Caseload != nil {
+			if err := q.CheckCaseload(ctx, *arg.Caseload); err != nil {
+				return err
+			}
+		}
+
+		// 2. Create the client
 		client, err := q.CreateClient(ctx, arg.Client)
 		if err != nil {
 			return err
 		}
 		result.ClientID = client.ID
 
-		// 2. Update the intake form status
+		// 3. Update the intake form status
 		if err := q.UpdateIntakeFormStatus(ctx, UpdateIntakeFormStatusParams{
 			ID:     arg.IntakeFormID,
 			Status: arg.IntakeFormNewStatus,
@@ -36,9 +53,11 @@ func (s *Store) MoveClientToWaitingListTx(
 			return err
 		}
 
-		// 3. Update the registration form status to approved
+		// 4. Update the registration form status to approved
 		if err := q.UpdateRegistrationFormStatus(ctx, UpdateRegistrationFormStatusParams{
-			ID: arg.RegistrationFormID,
+			ID:        arg.RegistrationFormID,
+			HistoryID: nanoid.Generate(),
+			ChangedBy: arg.ChangedBy,
 			Status: NullRegistrationStatusEnum{
 				RegistrationStatusEnum: arg.RegistrationFormNewStatus,
 				Valid:                  true,
@@ -47,7 +66,7 @@ func (s *Store) MoveClientToWaitingListTx(
 			return err
 		}
 
-		// 4. Link goals to the new client
+		// 5. Link goals to the new client
 		if err := q.LinkGoalsToClient(ctx, LinkGoalsToClientParams{
 			ClientID:     &client.ID,
 			IntakeFormID: arg.IntakeFormID,
@@ -55,8 +74,154 @@ func (s *Store) MoveClientToWaitingListTx(
 			return err
 		}
 
+		// 6. Record the initial coordinator/location assignment
+		if err := q.RecordClientAssignment(ctx, RecordClientAssignmentParams{
+			ID:        nanoid.Generate(),
+			ClientID:  client.ID,
+			ChangedBy: arg.ChangedBy,
+		}); err != nil {
+			return err
+		}
+
 		return nil
 	})
 
 	return result, err
 }
+
+// ErrLocationAtCapacity is returned when a client cannot be placed because the
+// assigned location has no free capacity left.
+var ErrLocationAtCapacity = errors.New("location has no free capacity")
+
+type MoveClientInCareTxParams struct {
+	OrganizationID string
+	Client         UpdateClientParams
+	// Coordinator responsible for the client's first evaluation
+	CoordinatorID string
+}
+
+type MoveClientInCareTxResult struct {
+	ClientID string
+}
+
+// MoveClientInCareTx starts the client's care and, when the update sets a next
+// evaluation date, schedules the first evaluation record for that date.
+func (s *Store) MoveClientInCareTx(
+	ctx context.Context,
+	arg MoveClientInCareTxParams,
+) (MoveClientInCareTxResult, error) {
+	var result MoveClientInCareTxResult
+
+	err := s.ExecTx(ctx, func(q *Queries) error {
+		// 1. Update the client within the caller's organization
+		clientID, err := NewScopedStore(q, arg.OrganizationID).UpdateClient(ctx, arg.Client)
+		if err != nil {
+			return err
+		}
+		result.ClientID = clientID
+
+		// 2. Schedule the first evaluation
+		if !arg.Client.NextEvaluationDate.Valid {
+			return nil
+		}
+		_, err = q.CreateEvaluationRecord(ctx, CreateEvaluationRecordParams{
+			ID:            nanoid.Generate(),
+			ClientID:      clientID,
+			CoordinatorID: arg.CoordinatorID,
+			ScheduledDate: arg.Client.NextEvaluationDate,
+		})
+		return err
+	})
+
+	return result, err
+}
+
+type ConvertIntakeToClientTxParams struct {
+	Client             CreateClientParams
+	IntakeFormID       string
+	RegistrationFormID string
+	// User creating the client, recorded in the client's assignment history
+	ChangedBy string
+}
+
+type ConvertIntakeToClientTxResult struct {
+	ClientID string
+	// Occupancy of the assigned location after the client was placed
+	LocationOccupied int32
+}
+
+// ConvertIntakeToClientTx turns an intake into a client in a single transaction:
+// the client is created, the intake is completed, the registration is approved,
+// the assigned location's occupancy is incremented and the initial assignment is
+// recorded. Any failure rolls back every step; a conflict with a concurrent
+// placement reruns the transaction.
+func (s *Store) ConvertIntakeToClientTx(
+	ctx context.Context,
+	arg ConvertIntakeToClientTxParams,
+) (ConvertIntakeToClientTxResult, error) {
+	var result ConvertIntakeToClientTxResult
+
+	err := s.ExecTxRetry(ctx, func(q *Queries) error {
+		// 1. Create the client
+		client, err := q.CreateClient(ctx, arg.Client)
+		if err != nil {
+			return err
+		}
+		result.ClientID = client.ID
+
+		// 2. Mark the intake form as completed
+		if err := q.UpdateIntakeFormStatus(ctx, UpdateIntakeFormStatusParams{
+			ID:     arg.IntakeFormID,
+			Status: IntakeStatusEnumCompleted,
+		}); err != nil {
+			return err
+		}
+
+		// 3. Update the registration form status to approved
+		if err := q.UpdateRegistrationFormStatus(ctx, UpdateRegistrationFormStatusParams{
+			ID:        arg.RegistrationFormID,
+			HistoryID: nanoid.Generate(),
+			ChangedBy: arg.ChangedBy,
+			Status: NullRegistrationStatusEnum{
+				RegistrationStatusEnum: RegistrationStatusEnumApproved,
+				Valid:                  true,
+			},
+		}); err != nil {
+			return err
+		}
+
+		// 4. Link goals to the new client
+		if err := q.LinkGoalsToClient(ctx, LinkGoalsToClientParams{
+			ClientID:     &client.ID,
+			IntakeFormID: arg.IntakeFormID,
+		}); err != nil {
+			return err
+		}
+
+		// 5. Take a place at the assigned location
+		occupied, err := q.ReserveLocationCapacity(ctx, arg.Client.AssignedLocationID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrLocationAtCapacity
+			}
+			return err
+		}
+		result.LocationOccupied = occupied
+
+		// 6. Record the initial coordinator/location assignment
+		if err := q.RecordClientAssignment(ctx, RecordClientAssignmentParams{
+			ID:        nanoid.Generate(),
+			ClientID:  client.ID,
+			ChangedBy: arg.ChangedBy,
+		}); err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return ConvertIntakeToClientTxResult{}, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,172 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ConvertIntakeToClientTx opens its own transaction, so these tests run against
+// testStore directly instead of inside runTestWithTx and delete what they commit.
+
+type convertIntakeFixture struct {
+	locationID         string
+	locationName       string
+	coordinatorID      string
+	registrationFormID string
+	intakeFormID       string
+}
+
+func setupConvertIntakeFixture(t *testing.T, q *Queries, capacity, occupied int32) convertIntakeFixture {
+	t.Helper()
+
+	userID := CreateTestUser(t, q, CreateTestUserOptions{})
+	deleteAfterTest(t, "users", userID)
+	locationName := "Convert Location " + generateTestID()
+	locationID := CreateTestLocation(t, q, CreateTestLocationOptions{
+		Name:     &locationName,
+		Capacity: &capacity,
+		Occupied: &occupied,
+	})
+	deleteAfterTest(t, "locations", locationID)
+	coordinatorID := CreateTestEmployee(t, q, CreateTestEmployeeOptions{UserID: userID, LocationID: &locationID})
+	deleteAfterTest(t, "employees", coordinatorID)
+	registrationFormID := CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{})
+	deleteAfterTest(t, "registration_forms", registrationFormID)
+	intakeFormID := CreateTestIntakeForm(t, q, CreateTestIntakeFormOptions{
+		RegistrationFormID: registrationFormID,
+		LocationID:         locationID,
+		CoordinatorID:      coordinatorID,
+	})
+	deleteAfterTest(t, "intake_forms", intakeFormID)
+
+	return convertIntakeFixture{
+		locationID:         locationID,
+		locationName:       locationName,
+		coordinatorID:      coordinatorID,
+		registrationFormID: registrationFormID,
+		intakeFormID:       intakeFormID,
+	}
+}
+
+func (f convertIntakeFixture) params() ConvertIntakeToClientTxParams {
+	return ConvertIntakeToClientTxParams{
+		Client: CreateClientParams{
+			ID:                  generateTestID(),
+			FirstName:           "Convert",
+			LastName:            "Client",
+			Bsn:                 generateTestID()[:9],
+			DateOfBirth:         toPgDate(time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC)),
+			Gender:              GenderEnumOther,
+			RegistrationFormID:  f.registrationFormID,
+			IntakeFormID:        f.intakeFormID,
+			CareType:            CareTypeEnumProtectedLiving,
+			WaitingListPriority: WaitingListPriorityEnumNormal,
+			Status:              ClientStatusEnumWaitingList,
+			AssignedLocationID:  f.locationID,
+			CoordinatorID:       f.coordinatorID,
+		},
+		IntakeFormID:       f.intakeFormID,
+		RegistrationFormID: f.registrationFormID,
+	}
+}
+
+func getLocationOccupied(t *testing.T, q *Queries, name string) int32 {
+	t.Helper()
+	results, err := q.ListLocations(context.Background(), ListLocationsParams{
+		Limit:  1,
+		Offset: 0,
+		Search: &name,
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	return results[0].Occupied
+}
+
+// ============================================================
+// Test: ConvertIntakeToClientTx
+// ============================================================
+
+func TestConvertIntakeToClientTx(t *testing.T) {
+	tests := []struct {
+		name     string
+		capacity int32
+		occupied int32
+		wantErr  error
+		validate func(t *testing.T, q *Queries, f convertIntakeFixture, params ConvertIntakeToClientTxParams)
+	}{
+		{
+			name:     "success",
+			capacity: 10,
+			occupied: 3,
+			validate: func(t *testing.T, q *Queries, f convertIntakeFixture, params ConvertIntakeToClientTxParams) {
+				ctx := context.Background()
+
+				client, err := q.GetClientByID(ctx, GetClientByIDParams{ID: params.Client.ID})
+				require.NoError(t, err)
+				assert.Equal(t, f.intakeFormID, client.IntakeFormID)
+
+				intake, err := q.GetIntakeForm(ctx, f.intakeFormID)
+				require.NoError(t, err)
+				assert.Equal(t, IntakeStatusEnumCompleted, intake.Status)
+
+				registration, err := q.GetRegistrationForm(ctx, f.registrationFormID)
+				require.NoError(t, err)
+				assert.Equal(t, RegistrationStatusEnumApproved, registration.Status.RegistrationStatusEnum)
+
+				assert.Equal(t, int32(4), getLocationOccupied(t, q, f.locationName))
+			},
+		},
+		{
+			// The location is full, so the final step fails after the client was
+			// created and both forms were updated; all of it must be rolled back.
+			name:     "rolls_back_when_location_full",
+			capacity: 2,
+			occupied: 2,
+			wantErr:  ErrLocationAtCapacity,
+			validate: func(t *testing.T, q *Queries, f convertIntakeFixture, params ConvertIntakeToClientTxParams) {
+				ctx := context.Background()
+
+				_, err := q.GetClientByID(ctx, GetClientByIDParams{ID: params.Client.ID})
+				assert.True(t, errors.Is(err, pgx.ErrNoRows), "client must not be persisted")
+
+				intake, err := q.GetIntakeForm(ctx, f.intakeFormID)
+				require.NoError(t, err)
+				assert.Equal(t, IntakeStatusEnumPending, intake.Status)
+
+				registration, err := q.GetRegistrationForm(ctx, f.registrationFormID)
+				require.NoError(t, err)
+				assert.Equal(t, RegistrationStatusEnumPending, registration.Status.RegistrationStatusEnum)
+
+				assert.Equal(t, int32(2), getLocationOccupied(t, q, f.locationName))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := testStore.Queries
+			f := setupConvertIntakeFixture(t, q, tt.capacity, tt.occupied)
+			params := f.params()
+			deleteAfterTest(t, "clients", params.Client.ID)
+
+			result, err := testStore.ConvertIntakeToClientTx(context.Background(), params)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, result.ClientID)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, params.Client.ID, result.ClientID)
+				assert.Equal(t, tt.occupied+1, result.LocationOccupied)
+			}
+
+			tt.validate(t, q, f, params)
+		})
+	}
+}
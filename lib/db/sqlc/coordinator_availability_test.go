@@ -0,0 +1,309 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// slotDay is a fixed future date so test intakes never collide with the
+// intakes other factories book for today.
+var slotDay = time.Date(2030, 1, 7, 0, 0, 0, 0, time.UTC)
+
+// setupSlotCoordinator creates a coordinator who works 09:00-13:00 on slotDay's weekday.
+func setupSlotCoordinator(t *testing.T, q *Queries) (coordinatorID, locationID string) {
+	t.Helper()
+
+	userID := CreateTestUser(t, q, CreateTestUserOptions{})
+	locationID = CreateTestLocation(t, q, CreateTestLocationOptions{})
+	coordinatorID = CreateTestEmployee(t, q, CreateTestEmployeeOptions{UserID: userID, LocationID: &locationID})
+
+	err := q.CreateCoordinatorAvailability(context.Background(), CreateCoordinatorAvailabilityParams{
+		ID:         generateTestID(),
+		EmployeeID: coordinatorID,
+		Weekday:    int32(slotDay.Weekday()),
+		StartTime:  toPgTime(time.Date(0, 1, 1, 9, 0, 0, 0, time.UTC)),
+		EndTime:    toPgTime(time.Date(0, 1, 1, 13, 0, 0, 0, time.UTC)),
+	})
+	require.NoError(t, err)
+	return coordinatorID, locationID
+}
+
+func bookTestIntake(t *testing.T, q *Queries, coordinatorID, locationID string, hour int) string {
+	t.Helper()
+
+	intakeTime := time.Date(0, 1, 1, hour, 0, 0, 0, time.UTC)
+	return CreateTestIntakeForm(t, q, CreateTestIntakeFormOptions{
+		RegistrationFormID: CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{}),
+		LocationID:         locationID,
+		CoordinatorID:      coordinatorID,
+		IntakeDate:         &slotDay,
+		IntakeTime:         &intakeTime,
+	})
+}
+
+func slotStartTimes(slots []GetAvailableIntakeSlotsRow) []string {
+	starts := make([]string, 0, len(slots))
+	for _, slot := range slots {
+		starts = append(starts, time.Time{}.Add(time.Duration(slot.StartTime.Microseconds)*time.Microsecond).Format("15:04"))
+	}
+	return starts
+}
+
+// ============================================================
+// Test: GetAvailableIntakeSlots
+// ============================================================
+
+func TestGetAvailableIntakeSlots(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(t *testing.T, q *Queries, coordinatorID, locationID string)
+		date     time.Time
+		timezone string
+		want     []string
+	}{
+		{
+			name:  "free_day",
+			setup: func(t *testing.T, q *Queries, coordinatorID, locationID string) {},
+			date:  slotDay,
+			want:  []string{"09:00", "10:00", "11:00", "12:00"},
+		},
+		{
+			name: "fully_booked_day",
+			setup: func(t *testing.T, q *Queries, coordinatorID, locationID string) {
+				for hour := 9; hour < 13; hour++ {
+					bookTestIntake(t, q, coordinatorID, locationID, hour)
+				}
+			},
+			date: slotDay,
+			want: []string{},
+		},
+		{
+			name: "partially_booked_day",
+			setup: func(t *testing.T, q *Queries, coordinatorID, locationID string) {
+				bookTestIntake(t, q, coordinatorID, locationID, 10)
+
+				// A half-hour appointment still blocks the slot it overlaps
+				start := slotDay.Add(11 * time.Hour)
+				end := start.Add(30 * time.Minute)
+				CreateTestAppointment(t, q, CreateTestAppointmentOptions{
+					OrganizerID: coordinatorID,
+					StartTime:   &start,
+					EndTime:     &end,
+				})
+
+				// Cancelled appointments do not block anything
+				cancelled := AppointmentStatusEnumCancelled
+				cancelledStart := slotDay.Add(12 * time.Hour)
+				cancelledEnd := cancelledStart.Add(time.Hour)
+				CreateTestAppointment(t, q, CreateTestAppointmentOptions{
+					OrganizerID: coordinatorID,
+					StartTime:   &cancelledStart,
+					EndTime:     &cancelledEnd,
+					Status:      &cancelled,
+				})
+			},
+			date: slotDay,
+			want: []string{"09:00", "12:00"},
+		},
+		{
+			// 10:00 UTC is 11:00 in Amsterdam, so the appointment blocks the local 11:00 slot
+			name: "appointment_in_local_time",
+			setup: func(t *testing.T, q *Queries, coordinatorID, locationID string) {
+				start := slotDay.Add(10 * time.Hour)
+				end := start.Add(time.Hour)
+				CreateTestAppointment(t, q, CreateTestAppointmentOptions{
+					OrganizerID: coordinatorID,
+					StartTime:   &start,
+					EndTime:     &end,
+				})
+			},
+			date:     slotDay,
+			timezone: "Europe/Amsterdam",
+			want:     []string{"09:00", "10:00", "12:00"},
+		},
+		{
+			name:  "day_without_availability",
+			setup: func(t *testing.T, q *Queries, coordinatorID, locationID string) {},
+			date:  slotDay.AddDate(0, 0, 1),
+			want:  []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runTestWithTx(t, func(t *testing.T, q *Queries) {
+				coordinatorID, locationID := setupSlotCoordinator(t, q)
+				tt.setup(t, q, coordinatorID, locationID)
+
+				timezone := tt.timezone
+				if timezone == "" {
+					timezone = "UTC"
+				}
+				slots, err := q.GetAvailableIntakeSlots(context.Background(), GetAvailableIntakeSlotsParams{
+					SlotMinutes:   60,
+					Date:          toPgDate(tt.date),
+					CoordinatorID: coordinatorID,
+					Timezone:      timezone,
+				})
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, slotStartTimes(slots))
+			})
+		})
+	}
+}
+
+// ============================================================
+// Test: checkIntakeSlot
+// ============================================================
+
+func TestCheckIntakeSlot(t *testing.T) {
+	slotAt := func(coordinatorID string, hour int) IntakeSlotCheck {
+		return IntakeSlotCheck{
+			CoordinatorID: coordinatorID,
+			Date:          toPgDate(slotDay),
+			Time:          toPgTime(time.Date(0, 1, 1, hour, 0, 0, 0, time.UTC)),
+			SlotMinutes:   60,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		check   func(t *testing.T, q *Queries, coordinatorID, locationID string) error
+		wantErr error
+	}{
+		{
+			name: "open_slot",
+			check: func(t *testing.T, q *Queries, coordinatorID, locationID string) error {
+				return q.checkIntakeSlot(context.Background(), slotAt(coordinatorID, 9), nil)
+			},
+		},
+		{
+			name: "booked_slot",
+			check: func(t *testing.T, q *Queries, coordinatorID, locationID string) error {
+				bookTestIntake(t, q, coordinatorID, locationID, 9)
+				return q.checkIntakeSlot(context.Background(), slotAt(coordinatorID, 9), nil)
+			},
+			wantErr: ErrIntakeSlotTaken,
+		},
+		{
+			name: "rescheduled_intake_keeps_its_own_slot",
+			check: func(t *testing.T, q *Queries, coordinatorID, locationID string) error {
+				intakeID := bookTestIntake(t, q, coordinatorID, locationID, 9)
+				return q.checkIntakeSlot(context.Background(), slotAt(coordinatorID, 9), &intakeID)
+			},
+		},
+		{
+			name: "outside_availability",
+			check: func(t *testing.T, q *Queries, coordinatorID, locationID string) error {
+				return q.checkIntakeSlot(context.Background(), slotAt(coordinatorID, 14), nil)
+			},
+			wantErr: ErrIntakeSlotTaken,
+		},
+		{
+			name: "unknown_coordinator",
+			check: func(t *testing.T, q *Queries, coordinatorID, locationID string) error {
+				return q.checkIntakeSlot(context.Background(), slotAt("missing-coordinator", 9), nil)
+			},
+			wantErr: ErrCoordinatorNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runTestWithTx(t, func(t *testing.T, q *Queries) {
+				coordinatorID, locationID := setupSlotCoordinator(t, q)
+
+				err := tt.check(t, q, coordinatorID, locationID)
+				if tt.wantErr != nil {
+					require.ErrorIs(t, err, tt.wantErr)
+					return
+				}
+				require.NoError(t, err)
+			})
+		})
+	}
+}
+
+// CreateIntakeFormTx opens its own transaction, so this test runs against
+// testStore directly instead of inside runTestWithTx and deletes what it commits.
+func TestCreateIntakeFormTx_SlotTaken(t *testing.T) {
+	ctx := context.Background()
+	q := testStore.Queries
+
+	coordinatorID, locationID := setupSlotCoordinator(t, q)
+	deleteAfterTest(t, "locations", locationID)
+	deleteAfterTest(t, "employees", coordinatorID)
+	bookedID := bookTestIntake(t, q, coordinatorID, locationID, 9)
+	deleteAfterTest(t, "intake_forms", bookedID)
+	regFormID := CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{})
+	deleteAfterTest(t, "registration_forms", regFormID)
+
+	slot := IntakeSlotCheck{
+		CoordinatorID: coordinatorID,
+		Date:          toPgDate(slotDay),
+		Time:          toPgTime(time.Date(0, 1, 1, 9, 0, 0, 0, time.UTC)),
+		SlotMinutes:   60,
+	}
+	_, err := testStore.CreateIntakeFormTx(ctx, CreateIntakeFormTxParams{
+		IntakeForm: CreateIntakeFormParams{
+			ID:                 generateTestID(),
+			RegistrationFormID: regFormID,
+			IntakeDate:         slot.Date,
+			IntakeTime:         slot.Time,
+			LocationID:         locationID,
+			CoordinatorID:      coordinatorID,
+		},
+		RegistrationFormID: regFormID,
+		Slot:               &slot,
+	})
+	require.ErrorIs(t, err, ErrIntakeSlotTaken)
+
+	// Nothing of the booking was kept
+	var intakes int
+	err = testStore.ConnPool.QueryRow(ctx,
+		"SELECT COUNT(*) FROM intake_forms WHERE registration_form_id = $1", regFormID,
+	).Scan(&intakes)
+	require.NoError(t, err)
+	assert.Zero(t, intakes)
+}
+
+// ============================================================
+// Test: SetCoordinatorAvailabilityTx
+// ============================================================
+
+// SetCoordinatorAvailabilityTx opens its own transaction, so this test runs
+// against testStore directly instead of inside runTestWithTx and deletes what
+// it commits. The availability rows go with the employee.
+func TestSetCoordinatorAvailabilityTx_ReplacesSchedule(t *testing.T) {
+	ctx := context.Background()
+	q := testStore.Queries
+
+	coordinatorID, locationID := setupSlotCoordinator(t, q)
+	coordinator, err := q.GetEmployeeByID(ctx, coordinatorID)
+	require.NoError(t, err)
+	deleteAfterTest(t, "users", coordinator.UserID)
+	deleteAfterTest(t, "locations", locationID)
+	deleteAfterTest(t, "employees", coordinatorID)
+
+	nine := toPgTime(time.Date(0, 1, 1, 9, 0, 0, 0, time.UTC))
+	five := toPgTime(time.Date(0, 1, 1, 17, 0, 0, 0, time.UTC))
+	err = testStore.SetCoordinatorAvailabilityTx(ctx, SetCoordinatorAvailabilityTxParams{
+		EmployeeID: coordinatorID,
+		Availability: []CreateCoordinatorAvailabilityParams{
+			{ID: generateTestID(), Weekday: 2, StartTime: nine, EndTime: five},
+			{ID: generateTestID(), Weekday: 4, StartTime: nine, EndTime: five},
+		},
+	})
+	require.NoError(t, err)
+
+	availability, err := q.ListCoordinatorAvailability(ctx, coordinatorID)
+	require.NoError(t, err)
+	require.Len(t, availability, 2)
+	assert.Equal(t, int32(2), availability[0].Weekday)
+	assert.Equal(t, int32(4), availability[1].Weekday)
+	assert.Equal(t, coordinatorID, availability[0].EmployeeID)
+}
@@ -41,6 +41,68 @@ func (q *Queries) GetCareTypeDistribution(ctx context.Context) (GetCareTypeDistr
 	return i, err
 }
 
+const getClientAgeDistribution = `-- name: GetClientAgeDistribution :many
+WITH client_ages AS (
+    SELECT DATE_PART('year', AGE($1::date, date_of_birth))::int AS age
+    FROM clients
+    WHERE status = 'in_care'
+),
+bands AS (
+    SELECT
+        band_start,
+        LEAD(band_start) OVER (ORDER BY band_start) AS band_end
+    FROM UNNEST($2::int[]) AS band_start
+)
+SELECT
+    b.band_start,
+    COUNT(ca.age) AS client_count
+FROM bands b
+LEFT JOIN client_ages ca
+    ON ca.age >= b.band_start
+    AND (b.band_end IS NULL OR ca.age < b.band_end)
+GROUP BY b.band_start
+UNION ALL
+SELECT
+    NULL,
+    COUNT(*)
+FROM client_ages ca
+WHERE ca.age IS NULL
+ORDER BY band_start NULLS LAST
+`
+
+type GetClientAgeDistributionParams struct {
+	Today      pgtype.Date `json:"today"`
+	BandStarts []int32     `json:"band_starts"`
+}
+
+type GetClientAgeDistributionRow struct {
+	BandStart   *int32 `json:"band_start"`
+	ClientCount int64  `json:"client_count"`
+}
+
+// Buckets in-care clients by age. band_starts holds the inclusive lower bound of each band;
+// a band ends where the next one starts and the highest band is open-ended. Clients without a
+// date of birth are counted in a final row with a NULL band_start.
+func (q *Queries) GetClientAgeDistribution(ctx context.Context, arg GetClientAgeDistributionParams) ([]GetClientAgeDistributionRow, error) {
+	rows, err := q.db.Query(ctx, getClientAgeDistribution, arg.Today, arg.BandStarts)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetClientAgeDistributionRow{}
+	for rows.Next() {
+		var i GetClientAgeDistributionRow
+		if err := rows.Scan(&i.BandStart, &i.ClientCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getCoordinatorClients = `-- name: GetCoordinatorClients :many
 SELECT
     c.id,
@@ -139,19 +201,24 @@ FROM clients
 WHERE coordinator_id = $1
 AND status = 'in_care'
 AND care_end_date IS NOT NULL
-AND care_end_date >= CURRENT_DATE
-AND care_end_date <= (CURRENT_DATE + INTERVAL '7 days')::date
+AND care_end_date >= $2::date
+AND care_end_date <= $2::date + 7
 LIMIT 5
 `
 
+type GetCoordinatorExpiringContractClientsParams struct {
+	CoordinatorID string      `json:"coordinator_id"`
+	Today         pgtype.Date `json:"today"`
+}
+
 type GetCoordinatorExpiringContractClientsRow struct {
 	ID        string `json:"id"`
 	FirstName string `json:"first_name"`
 	LastName  string `json:"last_name"`
 }
 
-func (q *Queries) GetCoordinatorExpiringContractClients(ctx context.Context, coordinatorID string) ([]GetCoordinatorExpiringContractClientsRow, error) {
-	rows, err := q.db.Query(ctx, getCoordinatorExpiringContractClients, coordinatorID)
+func (q *Queries) GetCoordinatorExpiringContractClients(ctx context.Context, arg GetCoordinatorExpiringContractClientsParams) ([]GetCoordinatorExpiringContractClientsRow, error) {
+	rows, err := q.db.Query(ctx, getCoordinatorExpiringContractClients, arg.CoordinatorID, arg.Today)
 	if err != nil {
 		return nil, err
 	}
@@ -218,6 +285,41 @@ func (q *Queries) GetCoordinatorGoalsProgress(ctx context.Context, coordinatorID
 	return i, err
 }
 
+const getCoordinatorHighPriorityWaitingClients = `-- name: GetCoordinatorHighPriorityWaitingClients :many
+SELECT id, first_name, last_name
+FROM clients
+WHERE coordinator_id = $1
+AND status = 'waiting_list'
+AND waiting_list_priority = 'high'
+LIMIT 5
+`
+
+type GetCoordinatorHighPriorityWaitingClientsRow struct {
+	ID        string `json:"id"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+}
+
+func (q *Queries) GetCoordinatorHighPriorityWaitingClients(ctx context.Context, coordinatorID string) ([]GetCoordinatorHighPriorityWaitingClientsRow, error) {
+	rows, err := q.db.Query(ctx, getCoordinatorHighPriorityWaitingClients, coordinatorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetCoordinatorHighPriorityWaitingClientsRow{}
+	for rows.Next() {
+		var i GetCoordinatorHighPriorityWaitingClientsRow
+		if err := rows.Scan(&i.ID, &i.FirstName, &i.LastName); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getCoordinatorIncidents = `-- name: GetCoordinatorIncidents :many
 SELECT
     i.id,
@@ -279,18 +381,23 @@ FROM clients
 WHERE coordinator_id = $1
 AND status = 'waiting_list'
 AND created_at IS NOT NULL
-AND created_at < (CURRENT_DATE - INTERVAL '60 days')::date
+AND created_at < $2::date - 60
 LIMIT 5
 `
 
+type GetCoordinatorLongWaitingClientsParams struct {
+	CoordinatorID string      `json:"coordinator_id"`
+	Today         pgtype.Date `json:"today"`
+}
+
 type GetCoordinatorLongWaitingClientsRow struct {
 	ID        string `json:"id"`
 	FirstName string `json:"first_name"`
 	LastName  string `json:"last_name"`
 }
 
-func (q *Queries) GetCoordinatorLongWaitingClients(ctx context.Context, coordinatorID string) ([]GetCoordinatorLongWaitingClientsRow, error) {
-	rows, err := q.db.Query(ctx, getCoordinatorLongWaitingClients, coordinatorID)
+func (q *Queries) GetCoordinatorLongWaitingClients(ctx context.Context, arg GetCoordinatorLongWaitingClientsParams) ([]GetCoordinatorLongWaitingClientsRow, error) {
+	rows, err := q.db.Query(ctx, getCoordinatorLongWaitingClients, arg.CoordinatorID, arg.Today)
 	if err != nil {
 		return nil, err
 	}
@@ -315,18 +422,23 @@ FROM clients
 WHERE coordinator_id = $1
 AND status = 'in_care'
 AND next_evaluation_date IS NOT NULL
-AND next_evaluation_date < CURRENT_DATE
+AND next_evaluation_date < $2::date
 LIMIT 5
 `
 
+type GetCoordinatorOverdueEvaluationClientsParams struct {
+	CoordinatorID string      `json:"coordinator_id"`
+	Today         pgtype.Date `json:"today"`
+}
+
 type GetCoordinatorOverdueEvaluationClientsRow struct {
 	ID        string `json:"id"`
 	FirstName string `json:"first_name"`
 	LastName  string `json:"last_name"`
 }
 
-func (q *Queries) GetCoordinatorOverdueEvaluationClients(ctx context.Context, coordinatorID string) ([]GetCoordinatorOverdueEvaluationClientsRow, error) {
-	rows, err := q.db.Query(ctx, getCoordinatorOverdueEvaluationClients, coordinatorID)
+func (q *Queries) GetCoordinatorOverdueEvaluationClients(ctx context.Context, arg GetCoordinatorOverdueEvaluationClientsParams) ([]GetCoordinatorOverdueEvaluationClientsRow, error) {
+	rows, err := q.db.Query(ctx, getCoordinatorOverdueEvaluationClients, arg.CoordinatorID, arg.Today)
 	if err != nil {
 		return nil, err
 	}
@@ -345,6 +457,41 @@ func (q *Queries) GetCoordinatorOverdueEvaluationClients(ctx context.Context, co
 	return items, nil
 }
 
+const getCoordinatorPendingTransferClients = `-- name: GetCoordinatorPendingTransferClients :many
+SELECT DISTINCT c.id, c.first_name, c.last_name
+FROM clients c
+JOIN client_location_transfers t ON t.client_id = c.id
+WHERE t.new_coordinator_id = $1
+AND t.status = 'pending'
+LIMIT 5
+`
+
+type GetCoordinatorPendingTransferClientsRow struct {
+	ID        string `json:"id"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+}
+
+func (q *Queries) GetCoordinatorPendingTransferClients(ctx context.Context, coordinatorID string) ([]GetCoordinatorPendingTransferClientsRow, error) {
+	rows, err := q.db.Query(ctx, getCoordinatorPendingTransferClients, coordinatorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetCoordinatorPendingTransferClientsRow{}
+	for rows.Next() {
+		var i GetCoordinatorPendingTransferClientsRow
+		if err := rows.Scan(&i.ID, &i.FirstName, &i.LastName); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getCoordinatorReminders = `-- name: GetCoordinatorReminders :many
 SELECT
     r.id,
@@ -393,18 +540,24 @@ SELECT
      WHERE c2.coordinator_id = $1 
      AND c2.status = 'in_care' 
      AND c2.next_evaluation_date IS NOT NULL 
-     AND c2.next_evaluation_date >= CURRENT_DATE
-     AND c2.next_evaluation_date <= (CURRENT_DATE + INTERVAL '30 days')::date)::bigint as my_upcoming_evaluations,
+     AND c2.next_evaluation_date >= $2::date
+     AND c2.next_evaluation_date <= $2::date + 30)::bigint as my_upcoming_evaluations,
     
     (SELECT COUNT(*) FROM intake_forms i
      WHERE i.coordinator_id = $1 
-     AND i.status = 'pending')::bigint as my_pending_intakes,
+     AND i.status = 'pending'
+     AND i.is_deleted = FALSE)::bigint as my_pending_intakes,
     
     (SELECT COUNT(*) FROM clients c3
      WHERE c3.coordinator_id = $1 
      AND c3.status = 'waiting_list')::bigint as my_waiting_list_clients
 `
 
+type GetCoordinatorStatsParams struct {
+	CoordinatorID string      `json:"coordinator_id"`
+	Today         pgtype.Date `json:"today"`
+}
+
 type GetCoordinatorStatsRow struct {
 	MyActiveClients       int64 `json:"my_active_clients"`
 	MyUpcomingEvaluations int64 `json:"my_upcoming_evaluations"`
@@ -412,8 +565,8 @@ type GetCoordinatorStatsRow struct {
 	MyWaitingListClients  int64 `json:"my_waiting_list_clients"`
 }
 
-func (q *Queries) GetCoordinatorStats(ctx context.Context, coordinatorID string) (GetCoordinatorStatsRow, error) {
-	row := q.db.QueryRow(ctx, getCoordinatorStats, coordinatorID)
+func (q *Queries) GetCoordinatorStats(ctx context.Context, arg GetCoordinatorStatsParams) (GetCoordinatorStatsRow, error) {
+	row := q.db.QueryRow(ctx, getCoordinatorStats, arg.CoordinatorID, arg.Today)
 	var i GetCoordinatorStatsRow
 	err := row.Scan(
 		&i.MyActiveClients,
@@ -456,7 +609,8 @@ SELECT
     COALESCE(a.location, '')::text as location_name
 FROM appointments a
 WHERE 
-    DATE(a.start_time AT TIME ZONE 'UTC') = CURRENT_DATE
+    a.start_time >= $2::timestamptz
+    AND a.start_time < $3::timestamptz
     AND (
         a.organizer_id = $1
         OR EXISTS (
@@ -469,6 +623,12 @@ WHERE
 ORDER BY a.start_time ASC
 `
 
+type GetCoordinatorTodayScheduleParams struct {
+	OrganizerID string             `json:"organizer_id"`
+	DayStart    pgtype.Timestamptz `json:"day_start"`
+	DayEnd      pgtype.Timestamptz `json:"day_end"`
+}
+
 type GetCoordinatorTodayScheduleRow struct {
 	ID           string                    `json:"id"`
 	Title        string                    `json:"title"`
@@ -483,8 +643,10 @@ type GetCoordinatorTodayScheduleRow struct {
 	LocationName string                    `json:"location_name"`
 }
 
-func (q *Queries) GetCoordinatorTodaySchedule(ctx context.Context, organizerID string) ([]GetCoordinatorTodayScheduleRow, error) {
-	rows, err := q.db.Query(ctx, getCoordinatorTodaySchedule, organizerID)
+// The day runs from day_start up to day_end, both computed by the caller in the
+// application timezone; the session itself stays in UTC
+func (q *Queries) GetCoordinatorTodaySchedule(ctx context.Context, arg GetCoordinatorTodayScheduleParams) ([]GetCoordinatorTodayScheduleRow, error) {
+	rows, err := q.db.Query(ctx, getCoordinatorTodaySchedule, arg.OrganizerID, arg.DayStart, arg.DayEnd)
 	if err != nil {
 		return nil, err
 	}
@@ -559,15 +721,15 @@ SELECT
      WHERE c1.coordinator_id = $1
      AND c1.status = 'in_care' 
      AND c1.next_evaluation_date IS NOT NULL 
-     AND c1.next_evaluation_date < CURRENT_DATE)::bigint as overdue_evaluations,
+     AND c1.next_evaluation_date < $2::date)::bigint as overdue_evaluations,
     
     -- Contracts expiring within 7 days for coordinator's clients
     (SELECT COUNT(*) FROM clients c2
      WHERE c2.coordinator_id = $1
      AND c2.status = 'in_care' 
      AND c2.care_end_date IS NOT NULL 
-     AND c2.care_end_date >= CURRENT_DATE
-     AND c2.care_end_date <= (CURRENT_DATE + INTERVAL '7 days')::date)::bigint as expiring_contracts,
+     AND c2.care_end_date >= $2::date
+     AND c2.care_end_date <= $2::date + 7)::bigint as expiring_contracts,
     
     -- Draft evaluations not completed by coordinator
     (SELECT COUNT(*) FROM client_evaluations ce
@@ -585,22 +747,40 @@ SELECT
      WHERE c3.coordinator_id = $1
      AND c3.status = 'waiting_list' 
      AND c3.created_at IS NOT NULL
-     AND c3.created_at < (CURRENT_DATE - INTERVAL '60 days')::date)::bigint as long_waiting
+     AND c3.created_at < $2::date - 60)::bigint as long_waiting,
+    
+    -- Pending transfers that would hand clients to this coordinator
+    (SELECT COUNT(*) FROM client_location_transfers t
+     WHERE t.new_coordinator_id = $1
+     AND t.status = 'pending')::bigint as pending_transfers,
+    
+    -- High-priority waiting list clients for coordinator
+    (SELECT COUNT(*) FROM clients c4
+     WHERE c4.coordinator_id = $1
+     AND c4.status = 'waiting_list'
+     AND c4.waiting_list_priority = 'high')::bigint as high_priority_waiting
 `
 
+type GetCoordinatorUrgentAlertsDataParams struct {
+	CoordinatorID string      `json:"coordinator_id"`
+	Today         pgtype.Date `json:"today"`
+}
+
 type GetCoordinatorUrgentAlertsDataRow struct {
 	OverdueEvaluations  int64 `json:"overdue_evaluations"`
 	ExpiringContracts   int64 `json:"expiring_contracts"`
 	DraftEvaluations    int64 `json:"draft_evaluations"`
 	UnresolvedIncidents int64 `json:"unresolved_incidents"`
 	LongWaiting         int64 `json:"long_waiting"`
+	PendingTransfers    int64 `json:"pending_transfers"`
+	HighPriorityWaiting int64 `json:"high_priority_waiting"`
 }
 
 // ============================================================
 // Coordinator Dashboard
 // ============================================================
-func (q *Queries) GetCoordinatorUrgentAlertsData(ctx context.Context, coordinatorID string) (GetCoordinatorUrgentAlertsDataRow, error) {
-	row := q.db.QueryRow(ctx, getCoordinatorUrgentAlertsData, coordinatorID)
+func (q *Queries) GetCoordinatorUrgentAlertsData(ctx context.Context, arg GetCoordinatorUrgentAlertsDataParams) (GetCoordinatorUrgentAlertsDataRow, error) {
+	row := q.db.QueryRow(ctx, getCoordinatorUrgentAlertsData, arg.CoordinatorID, arg.Today)
 	var i GetCoordinatorUrgentAlertsDataRow
 	err := row.Scan(
 		&i.OverdueEvaluations,
@@ -608,6 +788,8 @@ func (q *Queries) GetCoordinatorUrgentAlertsData(ctx context.Context, coordinato
 		&i.DraftEvaluations,
 		&i.UnresolvedIncidents,
 		&i.LongWaiting,
+		&i.PendingTransfers,
+		&i.HighPriorityWaiting,
 	)
 	return i, err
 }
@@ -618,54 +800,78 @@ SELECT
     (SELECT COUNT(*) FROM clients 
      WHERE status = 'in_care' 
      AND next_evaluation_date IS NOT NULL 
-     AND next_evaluation_date < CURRENT_DATE) as overdue_evaluations,
+     AND next_evaluation_date < $1::date
+     AND ($2::text IS NULL OR coordinator_id = $2::text)) as overdue_evaluations,
     
-    -- Care end date approaching (within 30 days)
+    -- Care end date approaching (within care_ending_soon_days days, inclusive)
     (SELECT COUNT(*) FROM clients 
      WHERE status = 'in_care' 
      AND care_end_date IS NOT NULL 
-     AND care_end_date <= (CURRENT_DATE + INTERVAL '30 days')::date
-     AND care_end_date >= CURRENT_DATE) as care_ending_soon,
+     AND care_end_date <= $1::date + $3::int
+     AND care_end_date >= $1::date
+     AND ($2::text IS NULL OR coordinator_id = $2::text)) as care_ending_soon,
     
     -- Open incidents (pending or under_investigation)
     (SELECT COUNT(*) FROM incidents 
      WHERE (status = 'pending' OR status = 'under_investigation') 
-     AND is_deleted = FALSE) as open_incidents,
+     AND is_deleted = FALSE
+     AND ($2::text IS NULL OR coordinator_id = $2::text)) as open_incidents,
     
     -- Severe incidents count (for description)
     (SELECT COUNT(*) FROM incidents 
      WHERE (status = 'pending' OR status = 'under_investigation') 
      AND incident_severity = 'severe'
-     AND is_deleted = FALSE) as severe_incidents,
+     AND is_deleted = FALSE
+     AND ($2::text IS NULL OR coordinator_id = $2::text)) as severe_incidents,
     
     -- Moderate incidents count (for description)
     (SELECT COUNT(*) FROM incidents 
      WHERE (status = 'pending' OR status = 'under_investigation') 
      AND incident_severity = 'moderate'
-     AND is_deleted = FALSE) as moderate_incidents,
+     AND is_deleted = FALSE
+     AND ($2::text IS NULL OR coordinator_id = $2::text)) as moderate_incidents,
+    
+    -- Open incidents the worker escalated for passing their severity's SLA
+    (SELECT COUNT(*) FROM incidents 
+     WHERE (status = 'pending' OR status = 'under_investigation') 
+     AND escalated_at IS NOT NULL
+     AND is_deleted = FALSE
+     AND ($2::text IS NULL OR coordinator_id = $2::text)) as escalated_incidents,
     
     -- High priority waiting list
     (SELECT COUNT(*) FROM clients 
      WHERE status = 'waiting_list' 
-     AND waiting_list_priority = 'high') as high_priority_waiting,
+     AND waiting_list_priority = 'high'
+     AND ($2::text IS NULL OR coordinator_id = $2::text)) as high_priority_waiting,
     
     -- Pending location transfers
     (SELECT COUNT(*) FROM client_location_transfers 
-     WHERE status = 'pending') as pending_transfers
+     WHERE status = 'pending'
+     AND ($2::text IS NULL
+          OR current_coordinator_id = $2::text
+          OR new_coordinator_id = $2::text)) as pending_transfers
 `
 
+type GetCriticalAlertsDataParams struct {
+	Today              pgtype.Date `json:"today"`
+	CoordinatorID      *string     `json:"coordinator_id"`
+	CareEndingSoonDays int32       `json:"care_ending_soon_days"`
+}
+
 type GetCriticalAlertsDataRow struct {
 	OverdueEvaluations  int64 `json:"overdue_evaluations"`
 	CareEndingSoon      int64 `json:"care_ending_soon"`
 	OpenIncidents       int64 `json:"open_incidents"`
 	SevereIncidents     int64 `json:"severe_incidents"`
 	ModerateIncidents   int64 `json:"moderate_incidents"`
+	EscalatedIncidents  int64 `json:"escalated_incidents"`
 	HighPriorityWaiting int64 `json:"high_priority_waiting"`
 	PendingTransfers    int64 `json:"pending_transfers"`
 }
 
-func (q *Queries) GetCriticalAlertsData(ctx context.Context) (GetCriticalAlertsDataRow, error) {
-	row := q.db.QueryRow(ctx, getCriticalAlertsData)
+// Counts are org-wide when coordinator_id is NULL, otherwise limited to that coordinator's caseload
+func (q *Queries) GetCriticalAlertsData(ctx context.Context, arg GetCriticalAlertsDataParams) (GetCriticalAlertsDataRow, error) {
+	row := q.db.QueryRow(ctx, getCriticalAlertsData, arg.Today, arg.CoordinatorID, arg.CareEndingSoonDays)
 	var i GetCriticalAlertsDataRow
 	err := row.Scan(
 		&i.OverdueEvaluations,
@@ -673,6 +879,7 @@ func (q *Queries) GetCriticalAlertsData(ctx context.Context) (GetCriticalAlertsD
 		&i.OpenIncidents,
 		&i.SevereIncidents,
 		&i.ModerateIncidents,
+		&i.EscalatedIncidents,
 		&i.HighPriorityWaiting,
 		&i.PendingTransfers,
 	)
@@ -685,13 +892,13 @@ SELECT
     (SELECT COUNT(*) FROM clients 
      WHERE status = 'discharged' 
      AND discharge_date IS NOT NULL
-     AND EXTRACT(MONTH FROM discharge_date) = EXTRACT(MONTH FROM CURRENT_DATE)
-     AND EXTRACT(YEAR FROM discharge_date) = EXTRACT(YEAR FROM CURRENT_DATE))::bigint as this_month,
+     AND EXTRACT(MONTH FROM discharge_date) = EXTRACT(MONTH FROM $1::date)
+     AND EXTRACT(YEAR FROM discharge_date) = EXTRACT(YEAR FROM $1::date))::bigint as this_month,
     -- Discharged this year
     (SELECT COUNT(*) FROM clients 
      WHERE status = 'discharged' 
      AND discharge_date IS NOT NULL
-     AND EXTRACT(YEAR FROM discharge_date) = EXTRACT(YEAR FROM CURRENT_DATE))::bigint as this_year,
+     AND EXTRACT(YEAR FROM discharge_date) = EXTRACT(YEAR FROM $1::date))::bigint as this_year,
     -- Total discharged clients
     (SELECT COUNT(*) FROM clients WHERE status = 'discharged')::bigint as total_discharged,
     -- Planned discharges (those with a reason indicating planned)
@@ -715,8 +922,8 @@ type GetDashboardDischargeStatsRow struct {
 	AvgDaysInCare     int64 `json:"avg_days_in_care"`
 }
 
-func (q *Queries) GetDashboardDischargeStats(ctx context.Context) (GetDashboardDischargeStatsRow, error) {
-	row := q.db.QueryRow(ctx, getDashboardDischargeStats)
+func (q *Queries) GetDashboardDischargeStats(ctx context.Context, today pgtype.Date) (GetDashboardDischargeStatsRow, error) {
+	row := q.db.QueryRow(ctx, getDashboardDischargeStats, today)
 	var i GetDashboardDischargeStatsRow
 	err := row.Scan(
 		&i.ThisMonth,
@@ -770,23 +977,37 @@ func (q *Queries) GetDashboardOverviewStats(ctx context.Context) (GetDashboardOv
 
 const getEvaluationStats = `-- name: GetEvaluationStats :one
 SELECT
-    -- Total in-care clients that need evaluations
-    (SELECT COUNT(*) FROM clients WHERE status = 'in_care')::bigint as total,
-    -- Completed evaluations (submitted status)
-    (SELECT COUNT(DISTINCT client_id) FROM client_evaluations WHERE status = 'submitted')::bigint as completed,
-    -- Overdue evaluations (next_evaluation_date < today)
-    (SELECT COUNT(*) FROM clients 
-     WHERE status = 'in_care' 
-     AND next_evaluation_date IS NOT NULL 
-     AND next_evaluation_date < CURRENT_DATE)::bigint as overdue,
-    -- Due soon (next_evaluation_date within 7 days)
-    (SELECT COUNT(*) FROM clients 
-     WHERE status = 'in_care' 
-     AND next_evaluation_date IS NOT NULL 
-     AND next_evaluation_date >= CURRENT_DATE
-     AND next_evaluation_date <= (CURRENT_DATE + INTERVAL '7 days')::date)::bigint as due_soon
+    -- Evaluations of in-care clients that have come due
+    (SELECT COUNT(*) FROM evaluations e
+     JOIN clients c ON e.client_id = c.id
+     WHERE c.status = 'in_care'
+     AND e.scheduled_date <= $1::date)::bigint as total,
+    -- Of those, evaluations that have been completed
+    (SELECT COUNT(*) FROM evaluations e
+     JOIN clients c ON e.client_id = c.id
+     WHERE c.status = 'in_care'
+     AND e.scheduled_date <= $1::date
+     AND e.completed_date IS NOT NULL)::bigint as completed,
+    -- Open evaluations past their scheduled date
+    (SELECT COUNT(*) FROM evaluations e
+     JOIN clients c ON e.client_id = c.id
+     WHERE c.status = 'in_care'
+     AND e.completed_date IS NULL
+     AND e.scheduled_date < $1::date)::bigint as overdue,
+    -- Open evaluations scheduled within the next due_soon_days days
+    (SELECT COUNT(*) FROM evaluations e
+     JOIN clients c ON e.client_id = c.id
+     WHERE c.status = 'in_care'
+     AND e.completed_date IS NULL
+     AND e.scheduled_date >= $1::date
+     AND e.scheduled_date <= $1::date + $2::int)::bigint as due_soon
 `
 
+type GetEvaluationStatsParams struct {
+	Today       pgtype.Date `json:"today"`
+	DueSoonDays int32       `json:"due_soon_days"`
+}
+
 type GetEvaluationStatsRow struct {
 	Total     int64 `json:"total"`
 	Completed int64 `json:"completed"`
@@ -794,8 +1015,8 @@ type GetEvaluationStatsRow struct {
 	DueSoon   int64 `json:"due_soon"`
 }
 
-func (q *Queries) GetEvaluationStats(ctx context.Context) (GetEvaluationStatsRow, error) {
-	row := q.db.QueryRow(ctx, getEvaluationStats)
+func (q *Queries) GetEvaluationStats(ctx context.Context, arg GetEvaluationStatsParams) (GetEvaluationStatsRow, error) {
+	row := q.db.QueryRow(ctx, getEvaluationStats, arg.Today, arg.DueSoonDays)
 	var i GetEvaluationStatsRow
 	err := row.Scan(
 		&i.Total,
@@ -811,16 +1032,20 @@ SELECT
     l.id,
     l.name,
     l.capacity,
-    l.occupied
+    l.occupied,
+    -- Waiting-list clients already targeted at this location
+    (SELECT COUNT(*) FROM clients c
+     WHERE c.assigned_location_id = l.id AND c.status = 'waiting_list') AS waiting_count
 FROM locations l
 WHERE l.is_deleted = FALSE
 `
 
 type GetLocationCapacityListRow struct {
-	ID       string `json:"id"`
-	Name     string `json:"name"`
-	Capacity int32  `json:"capacity"`
-	Occupied int32  `json:"occupied"`
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Capacity     int32  `json:"capacity"`
+	Occupied     int32  `json:"occupied"`
+	WaitingCount int64  `json:"waiting_count"`
 }
 
 func (q *Queries) GetLocationCapacityList(ctx context.Context) ([]GetLocationCapacityListRow, error) {
@@ -837,6 +1062,7 @@ func (q *Queries) GetLocationCapacityList(ctx context.Context) ([]GetLocationCap
 			&i.Name,
 			&i.Capacity,
 			&i.Occupied,
+			&i.WaitingCount,
 		); err != nil {
 			return nil, err
 		}
@@ -870,13 +1096,28 @@ func (q *Queries) GetLocationCapacityTotals(ctx context.Context) (GetLocationCap
 
 const getPipelineStats = `-- name: GetPipelineStats :one
 SELECT
-    (SELECT COUNT(*) FROM registration_forms WHERE is_deleted = FALSE) as registrations,
-    (SELECT COUNT(*) FROM intake_forms) as intakes,
-    (SELECT COUNT(*) FROM clients WHERE status = 'waiting_list') as waiting_list,
-    (SELECT COUNT(*) FROM clients WHERE status = 'in_care') as in_care,
-    (SELECT COUNT(*) FROM clients WHERE status = 'discharged') as discharged
+    (SELECT COUNT(*) FROM registration_forms WHERE is_deleted = FALSE
+        AND ($1::date IS NULL OR created_at::date >= $1::date)
+        AND ($2::date IS NULL OR created_at::date <= $2::date)) as registrations,
+    (SELECT COUNT(*) FROM intake_forms WHERE is_deleted = FALSE
+        AND ($1::date IS NULL OR created_at::date >= $1::date)
+        AND ($2::date IS NULL OR created_at::date <= $2::date)) as intakes,
+    (SELECT COUNT(*) FROM clients WHERE status = 'waiting_list'
+        AND ($1::date IS NULL OR updated_at::date >= $1::date)
+        AND ($2::date IS NULL OR updated_at::date <= $2::date)) as waiting_list,
+    (SELECT COUNT(*) FROM clients WHERE status = 'in_care'
+        AND ($1::date IS NULL OR updated_at::date >= $1::date)
+        AND ($2::date IS NULL OR updated_at::date <= $2::date)) as in_care,
+    (SELECT COUNT(*) FROM clients WHERE status = 'discharged'
+        AND ($1::date IS NULL OR updated_at::date >= $1::date)
+        AND ($2::date IS NULL OR updated_at::date <= $2::date)) as discharged
 `
 
+type GetPipelineStatsParams struct {
+	From pgtype.Date `json:"from"`
+	To   pgtype.Date `json:"to"`
+}
+
 type GetPipelineStatsRow struct {
 	Registrations int64 `json:"registrations"`
 	Intakes       int64 `json:"intakes"`
@@ -885,8 +1126,11 @@ type GetPipelineStatsRow struct {
 	Discharged    int64 `json:"discharged"`
 }
 
-func (q *Queries) GetPipelineStats(ctx context.Context) (GetPipelineStatsRow, error) {
-	row := q.db.QueryRow(ctx, getPipelineStats)
+// Pipeline totals. With a from/to date range (inclusive, either end may be
+// left open) forms only count when created in it and clients only when their
+// record was last updated in it; without one the counts are current totals
+func (q *Queries) GetPipelineStats(ctx context.Context, arg GetPipelineStatsParams) (GetPipelineStatsRow, error) {
+	row := q.db.QueryRow(ctx, getPipelineStats, arg.From, arg.To)
 	var i GetPipelineStatsRow
 	err := row.Scan(
 		&i.Registrations,
@@ -923,7 +1167,9 @@ SELECT
     )::text as client_name
 FROM appointments a
 WHERE 
-    DATE(a.start_time AT TIME ZONE 'UTC') = CURRENT_DATE
+    a.start_time >= $2::timestamptz
+    AND a.start_time < $3::timestamptz
+    AND a.status IS DISTINCT FROM 'cancelled'
     AND (
         a.organizer_id = $1
         OR EXISTS (
@@ -936,6 +1182,12 @@ WHERE
 ORDER BY a.start_time ASC
 `
 
+type GetTodayAppointmentsForEmployeeParams struct {
+	OrganizerID string             `json:"organizer_id"`
+	DayStart    pgtype.Timestamptz `json:"day_start"`
+	DayEnd      pgtype.Timestamptz `json:"day_end"`
+}
+
 type GetTodayAppointmentsForEmployeeRow struct {
 	ID         string              `json:"id"`
 	Title      string              `json:"title"`
@@ -947,8 +1199,10 @@ type GetTodayAppointmentsForEmployeeRow struct {
 	ClientName string              `json:"client_name"`
 }
 
-func (q *Queries) GetTodayAppointmentsForEmployee(ctx context.Context, organizerID string) ([]GetTodayAppointmentsForEmployeeRow, error) {
-	rows, err := q.db.Query(ctx, getTodayAppointmentsForEmployee, organizerID)
+// The day runs from day_start up to day_end, both computed by the caller in the
+// application timezone; the session itself stays in UTC
+func (q *Queries) GetTodayAppointmentsForEmployee(ctx context.Context, arg GetTodayAppointmentsForEmployeeParams) ([]GetTodayAppointmentsForEmployeeRow, error) {
+	rows, err := q.db.Query(ctx, getTodayAppointmentsForEmployee, arg.OrganizerID, arg.DayStart, arg.DayEnd)
 	if err != nil {
 		return nil, err
 	}
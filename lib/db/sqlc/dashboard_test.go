@@ -0,0 +1,423 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"care-cordination/lib/util"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createInCareClientForCoordinator creates an in-care client assigned to the given coordinator.
+// nextEvaluation and careEnd are optional.
+func createInCareClientForCoordinator(
+	t *testing.T,
+	q *Queries,
+	coordinatorID, locationID string,
+	nextEvaluation, careEnd *time.Time,
+) string {
+	t.Helper()
+
+	regFormID := CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{})
+	intakeFormID := CreateTestIntakeForm(t, q, CreateTestIntakeFormOptions{
+		RegistrationFormID: regFormID,
+		LocationID:         locationID,
+		CoordinatorID:      coordinatorID,
+	})
+
+	status := ClientStatusEnumInCare
+	careStart := time.Now().AddDate(0, -6, 0)
+	clientID := CreateTestClient(t, q, CreateTestClientOptions{
+		RegistrationFormID: regFormID,
+		IntakeFormID:       intakeFormID,
+		AssignedLocationID: locationID,
+		CoordinatorID:      coordinatorID,
+		Status:             &status,
+		CareStartDate:      &careStart,
+		CareEndDate:        careEnd,
+	})
+
+	if nextEvaluation != nil {
+		_, err := q.UpdateClient(context.Background(), UpdateClientParams{
+			ID:                 clientID,
+			NextEvaluationDate: toPgDate(*nextEvaluation),
+		})
+		require.NoError(t, err)
+	}
+
+	return clientID
+}
+
+// ============================================================
+// Test: GetCriticalAlertsData
+// ============================================================
+
+func TestGetCriticalAlertsData_CoordinatorScope(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+
+		before, err := q.GetCriticalAlertsData(ctx, GetCriticalAlertsDataParams{Today: toPgDate(time.Now()), CareEndingSoonDays: 30})
+		require.NoError(t, err)
+
+		depsA := CreateFullClientDependencyChain(t, q)
+		depsB := CreateFullClientDependencyChain(t, q)
+
+		overdue := time.Now().AddDate(0, 0, -7)
+		endingSoon := time.Now().AddDate(0, 0, 10)
+
+		// Coordinator A: two overdue evaluations and one care trajectory ending soon
+		createInCareClientForCoordinator(t, q, depsA.EmployeeID, depsA.LocationID, &overdue, nil)
+		createInCareClientForCoordinator(t, q, depsA.EmployeeID, depsA.LocationID, &overdue, nil)
+		createInCareClientForCoordinator(t, q, depsA.EmployeeID, depsA.LocationID, nil, &endingSoon)
+
+		// Coordinator B: one overdue evaluation
+		createInCareClientForCoordinator(t, q, depsB.EmployeeID, depsB.LocationID, &overdue, nil)
+
+		alertsA, err := q.GetCriticalAlertsData(ctx, GetCriticalAlertsDataParams{Today: toPgDate(time.Now()), CoordinatorID: &depsA.EmployeeID, CareEndingSoonDays: 30})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), alertsA.OverdueEvaluations)
+		assert.Equal(t, int64(1), alertsA.CareEndingSoon)
+
+		alertsB, err := q.GetCriticalAlertsData(ctx, GetCriticalAlertsDataParams{Today: toPgDate(time.Now()), CoordinatorID: &depsB.EmployeeID, CareEndingSoonDays: 30})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), alertsB.OverdueEvaluations)
+		assert.Equal(t, int64(0), alertsB.CareEndingSoon)
+
+		// Without a coordinator the counts stay org-wide
+		all, err := q.GetCriticalAlertsData(ctx, GetCriticalAlertsDataParams{Today: toPgDate(time.Now()), CareEndingSoonDays: 30})
+		require.NoError(t, err)
+		assert.Equal(t, before.OverdueEvaluations+3, all.OverdueEvaluations)
+		assert.Equal(t, before.CareEndingSoon+1, all.CareEndingSoon)
+	})
+}
+
+func TestGetCriticalAlertsData_CareEndingSoonHorizon(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		const horizon = 14
+
+		deps := CreateFullClientDependencyChain(t, q)
+		params := GetCriticalAlertsDataParams{Today: toPgDate(time.Now()), CoordinatorID: &deps.EmployeeID, CareEndingSoonDays: horizon}
+
+		onBoundary := time.Now().AddDate(0, 0, horizon)
+		pastBoundary := time.Now().AddDate(0, 0, horizon+1)
+
+		createInCareClientForCoordinator(t, q, deps.EmployeeID, deps.LocationID, nil, &onBoundary)
+		alerts, err := q.GetCriticalAlertsData(ctx, params)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), alerts.CareEndingSoon, "care ending in exactly N days is counted")
+
+		createInCareClientForCoordinator(t, q, deps.EmployeeID, deps.LocationID, nil, &pastBoundary)
+		// No care end date at all is never counted
+		createInCareClientForCoordinator(t, q, deps.EmployeeID, deps.LocationID, nil, nil)
+		alerts, err = q.GetCriticalAlertsData(ctx, params)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), alerts.CareEndingSoon, "care ending in N+1 days is not counted")
+
+		// Widening the horizon picks up the later client
+		params.CareEndingSoonDays = horizon + 1
+		alerts, err = q.GetCriticalAlertsData(ctx, params)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), alerts.CareEndingSoon)
+	})
+}
+
+func TestDashboardQueries_UseCallerDate(t *testing.T) {
+	// 23:30 UTC on 31 March is already 1 April in the application timezone
+	at := time.Date(2026, time.March, 31, 23, 30, 0, 0, time.UTC)
+	utcToday := toPgDate(at)
+	appToday := toPgDate(util.StartOfDay(at))
+	require.Equal(t, time.April, appToday.Time.Month())
+
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		deps := CreateFullClientDependencyChain(t, q)
+
+		// Due on 31 March: not yet overdue in UTC, overdue locally
+		due := time.Date(2026, time.March, 31, 0, 0, 0, 0, time.UTC)
+		createInCareClientForCoordinator(t, q, deps.EmployeeID, deps.LocationID, &due, nil)
+
+		alerts, err := q.GetCriticalAlertsData(ctx, GetCriticalAlertsDataParams{Today: utcToday, CoordinatorID: &deps.EmployeeID, CareEndingSoonDays: 30})
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), alerts.OverdueEvaluations)
+
+		alerts, err = q.GetCriticalAlertsData(ctx, GetCriticalAlertsDataParams{Today: appToday, CoordinatorID: &deps.EmployeeID, CareEndingSoonDays: 30})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), alerts.OverdueEvaluations)
+
+		// Discharged on 1 April: counted in this month only once the caller's date is in April
+		utcBefore, err := q.GetDashboardDischargeStats(ctx, utcToday)
+		require.NoError(t, err)
+		appBefore, err := q.GetDashboardDischargeStats(ctx, appToday)
+		require.NoError(t, err)
+
+		discharged := ClientStatusEnumDischarged
+		dischargeDate := time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC)
+		regFormID := CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{})
+		intakeFormID := CreateTestIntakeForm(t, q, CreateTestIntakeFormOptions{
+			RegistrationFormID: regFormID,
+			LocationID:         deps.LocationID,
+			CoordinatorID:      deps.EmployeeID,
+		})
+		CreateTestClient(t, q, CreateTestClientOptions{
+			RegistrationFormID: regFormID,
+			IntakeFormID:       intakeFormID,
+			AssignedLocationID: deps.LocationID,
+			CoordinatorID:      deps.EmployeeID,
+			Status:             &discharged,
+			DischargeDate:      &dischargeDate,
+		})
+
+		utcAfter, err := q.GetDashboardDischargeStats(ctx, utcToday)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), utcAfter.ThisMonth-utcBefore.ThisMonth)
+
+		appAfter, err := q.GetDashboardDischargeStats(ctx, appToday)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), appAfter.ThisMonth-appBefore.ThisMonth)
+	})
+}
+
+// ============================================================
+// Test: GetClientAgeDistribution
+// ============================================================
+
+// createClientAged creates a client of the given age (in whole years) with the given status.
+func createClientAged(t *testing.T, q *Queries, deps ClientDependencies, age int, status ClientStatusEnum) {
+	t.Helper()
+
+	regFormID := CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{})
+	intakeFormID := CreateTestIntakeForm(t, q, CreateTestIntakeFormOptions{
+		RegistrationFormID: regFormID,
+		LocationID:         deps.LocationID,
+		CoordinatorID:      deps.EmployeeID,
+	})
+
+	// Stay clear of the birthday so the age does not depend on the time of day
+	dob := time.Now().AddDate(-age, 0, -10)
+	careStart := time.Now().AddDate(0, -1, 0)
+	CreateTestClient(t, q, CreateTestClientOptions{
+		RegistrationFormID: regFormID,
+		IntakeFormID:       intakeFormID,
+		AssignedLocationID: deps.LocationID,
+		CoordinatorID:      deps.EmployeeID,
+		DateOfBirth:        &dob,
+		Status:             &status,
+		CareStartDate:      &careStart,
+	})
+}
+
+// ============================================================
+// Test: GetCoordinatorUrgentAlertsData
+// ============================================================
+
+func TestGetCoordinatorUrgentAlertsData_TransfersAndPriority(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		deps := createLocationTransferDeps(t, q)
+
+		_, err := q.CreateLocationTransfer(ctx, CreateLocationTransferParams{
+			ID:                   generateTestID(),
+			ClientID:             deps.ClientID,
+			FromLocationID:       &deps.FromLocationID,
+			ToLocationID:         deps.ToLocationID,
+			CurrentCoordinatorID: deps.CurrentCoordinatorID,
+			NewCoordinatorID:     deps.NewCoordinatorID,
+			TransferDate:         toPgTimestamp(time.Now()),
+		})
+		require.NoError(t, err)
+
+		// A high-priority waiting client for the current coordinator only
+		high := WaitingListPriorityEnumHigh
+		regFormID := CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{})
+		intakeFormID := CreateTestIntakeForm(t, q, CreateTestIntakeFormOptions{
+			RegistrationFormID: regFormID,
+			LocationID:         deps.FromLocationID,
+			CoordinatorID:      deps.CurrentCoordinatorID,
+		})
+		highID := CreateTestClient(t, q, CreateTestClientOptions{
+			RegistrationFormID:  regFormID,
+			IntakeFormID:        intakeFormID,
+			AssignedLocationID:  deps.FromLocationID,
+			CoordinatorID:       deps.CurrentCoordinatorID,
+			WaitingListPriority: &high,
+		})
+
+		incoming, err := q.GetCoordinatorUrgentAlertsData(ctx, GetCoordinatorUrgentAlertsDataParams{CoordinatorID: deps.NewCoordinatorID, Today: toPgDate(time.Now())})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), incoming.PendingTransfers)
+		assert.Equal(t, int64(0), incoming.HighPriorityWaiting)
+
+		transferClients, err := q.GetCoordinatorPendingTransferClients(ctx, deps.NewCoordinatorID)
+		require.NoError(t, err)
+		require.Len(t, transferClients, 1)
+		assert.Equal(t, deps.ClientID, transferClients[0].ID)
+
+		// The outgoing coordinator is not the one awaiting the transfer
+		current, err := q.GetCoordinatorUrgentAlertsData(ctx, GetCoordinatorUrgentAlertsDataParams{CoordinatorID: deps.CurrentCoordinatorID, Today: toPgDate(time.Now())})
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), current.PendingTransfers)
+		assert.Equal(t, int64(1), current.HighPriorityWaiting)
+
+		priorityClients, err := q.GetCoordinatorHighPriorityWaitingClients(ctx, deps.CurrentCoordinatorID)
+		require.NoError(t, err)
+		require.Len(t, priorityClients, 1)
+		assert.Equal(t, highID, priorityClients[0].ID)
+	})
+}
+
+func TestGetLocationCapacityList_WaitingCount(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		capacity, occupied := int32(10), int32(6)
+		locationID := CreateTestLocation(t, q, CreateTestLocationOptions{
+			Capacity: &capacity,
+			Occupied: &occupied,
+		})
+		userID := CreateTestUser(t, q, CreateTestUserOptions{})
+		coordinatorID := CreateTestEmployee(t, q, CreateTestEmployeeOptions{UserID: userID})
+
+		newClient := func() string {
+			regFormID := CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{})
+			intakeFormID := CreateTestIntakeForm(t, q, CreateTestIntakeFormOptions{
+				RegistrationFormID: regFormID,
+				LocationID:         locationID,
+				CoordinatorID:      coordinatorID,
+			})
+			return CreateTestClient(t, q, CreateTestClientOptions{
+				RegistrationFormID: regFormID,
+				IntakeFormID:       intakeFormID,
+				AssignedLocationID: locationID,
+				CoordinatorID:      coordinatorID,
+			})
+		}
+		// Two waiting clients count; one already in care does not
+		newClient()
+		newClient()
+		createInCareClientForCoordinator(t, q, coordinatorID, locationID, nil, nil)
+
+		rows, err := q.GetLocationCapacityList(ctx)
+		require.NoError(t, err)
+
+		var found *GetLocationCapacityListRow
+		for i := range rows {
+			if rows[i].ID == locationID {
+				found = &rows[i]
+			}
+		}
+		require.NotNil(t, found)
+		assert.Equal(t, capacity-occupied, found.Capacity-found.Occupied)
+		assert.Equal(t, int64(2), found.WaitingCount)
+	})
+}
+
+func TestGetClientAgeDistribution(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		bands := []int32{18, 26, 41, 65}
+
+		countByBand := func() map[int32]int64 {
+			rows, err := q.GetClientAgeDistribution(ctx, GetClientAgeDistributionParams{Today: toPgDate(time.Now()), BandStarts: bands})
+			require.NoError(t, err)
+			// One row per band followed by the unbanded row
+			require.Len(t, rows, len(bands)+1)
+			require.Nil(t, rows[len(rows)-1].BandStart)
+
+			counts := map[int32]int64{}
+			for _, row := range rows {
+				key := int32(-1)
+				if row.BandStart != nil {
+					key = *row.BandStart
+				}
+				counts[key] = row.ClientCount
+			}
+			return counts
+		}
+
+		before := countByBand()
+
+		deps := CreateFullClientDependencyChain(t, q)
+		for _, age := range []int{18, 25, 30, 40, 41, 64, 65, 80, 16} {
+			createClientAged(t, q, deps, age, ClientStatusEnumInCare)
+		}
+		// Not in care, so not counted
+		createClientAged(t, q, deps, 30, ClientStatusEnumWaitingList)
+
+		after := countByBand()
+		assert.Equal(t, int64(2), after[18]-before[18], "18-25")
+		assert.Equal(t, int64(2), after[26]-before[26], "26-40")
+		assert.Equal(t, int64(2), after[41]-before[41], "41-64")
+		assert.Equal(t, int64(2), after[65]-before[65], "65+")
+		assert.Equal(t, int64(0), after[-1]-before[-1], "only clients without a date of birth are unbanded")
+	})
+}
+
+func TestGetPipelineStats_DateRange(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		locationID := CreateTestLocation(t, q, CreateTestLocationOptions{})
+		coordinatorID := CreateTestEmployee(t, q, CreateTestEmployeeOptions{
+			UserID:     CreateTestUser(t, q, CreateTestUserOptions{}),
+			LocationID: &locationID,
+		})
+
+		// seedAt creates a client with its registration and intake forms and
+		// moves all three to the given day
+		seedAt := func(day time.Time, status ClientStatusEnum) {
+			clientID := createInCareClientForCoordinator(t, q, coordinatorID, locationID, nil, nil)
+			_, err := q.db.Exec(ctx, `
+				UPDATE registration_forms SET created_at = $2
+				WHERE id = (SELECT registration_form_id FROM clients WHERE id = $1)`, clientID, day)
+			require.NoError(t, err)
+			_, err = q.db.Exec(ctx, `
+				UPDATE intake_forms SET created_at = $2
+				WHERE id = (SELECT intake_form_id FROM clients WHERE id = $1)`, clientID, day)
+			require.NoError(t, err)
+			_, err = q.db.Exec(ctx,
+				`UPDATE clients SET status = $2, updated_at = $3 WHERE id = $1`, clientID, status, day)
+			require.NoError(t, err)
+		}
+
+		lastWeek := time.Date(2020, 1, 8, 12, 0, 0, 0, time.UTC)
+		thisWeek := time.Date(2020, 1, 15, 12, 0, 0, 0, time.UTC)
+		seedAt(lastWeek, ClientStatusEnumInCare)
+		seedAt(lastWeek, ClientStatusEnumInCare)
+		seedAt(thisWeek, ClientStatusEnumInCare)
+		seedAt(thisWeek, ClientStatusEnumDischarged)
+		seedAt(thisWeek, ClientStatusEnumWaitingList)
+
+		stats, err := q.GetPipelineStats(ctx, GetPipelineStatsParams{
+			From: toPgDate(time.Date(2020, 1, 6, 0, 0, 0, 0, time.UTC)),
+			To:   toPgDate(time.Date(2020, 1, 12, 0, 0, 0, 0, time.UTC)),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, GetPipelineStatsRow{Registrations: 2, Intakes: 2, InCare: 2}, stats)
+
+		stats, err = q.GetPipelineStats(ctx, GetPipelineStatsParams{
+			From: toPgDate(time.Date(2020, 1, 13, 0, 0, 0, 0, time.UTC)),
+			To:   toPgDate(time.Date(2020, 1, 19, 0, 0, 0, 0, time.UTC)),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, GetPipelineStatsRow{
+			Registrations: 3,
+			Intakes:       3,
+			WaitingList:   1,
+			InCare:        1,
+			Discharged:    1,
+		}, stats)
+
+		// An open end keeps counting up to now, and no range is the current total
+		scoped, err := q.GetPipelineStats(ctx, GetPipelineStatsParams{
+			From: toPgDate(time.Date(2020, 1, 6, 0, 0, 0, 0, time.UTC)),
+		})
+		require.NoError(t, err)
+		total, err := q.GetPipelineStats(ctx, GetPipelineStatsParams{})
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, scoped.InCare, int64(3))
+		assert.GreaterOrEqual(t, total.InCare, scoped.InCare)
+		assert.GreaterOrEqual(t, total.Registrations, scoped.Registrations)
+	})
+}
@@ -24,24 +24,26 @@ INSERT INTO employees (
     gender,
     contract_hours,
     contract_type,
-    location_id
+    location_id,
+    organization_id
 ) VALUES (
- $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+ $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
 )
 `
 
 type CreateEmployeeParams struct {
-	ID            string               `json:"id"`
-	UserID        string               `json:"user_id"`
-	FirstName     string               `json:"first_name"`
-	LastName      string               `json:"last_name"`
-	Bsn           string               `json:"bsn"`
-	DateOfBirth   pgtype.Date          `json:"date_of_birth"`
-	PhoneNumber   string               `json:"phone_number"`
-	Gender        GenderEnum           `json:"gender"`
-	ContractHours *int32               `json:"contract_hours"`
-	ContractType  NullContractTypeEnum `json:"contract_type"`
-	LocationID    string               `json:"location_id"`
+	ID             string               `json:"id"`
+	UserID         string               `json:"user_id"`
+	FirstName      string               `json:"first_name"`
+	LastName       string               `json:"last_name"`
+	Bsn            string               `json:"bsn"`
+	DateOfBirth    pgtype.Date          `json:"date_of_birth"`
+	PhoneNumber    string               `json:"phone_number"`
+	Gender         GenderEnum           `json:"gender"`
+	ContractHours  *int32               `json:"contract_hours"`
+	ContractType   NullContractTypeEnum `json:"contract_type"`
+	LocationID     string               `json:"location_id"`
+	OrganizationID *string              `json:"organization_id"`
 }
 
 // ============================================================
@@ -60,10 +62,42 @@ func (q *Queries) CreateEmployee(ctx context.Context, arg CreateEmployeeParams)
 		arg.ContractHours,
 		arg.ContractType,
 		arg.LocationID,
+		arg.OrganizationID,
 	)
 	return err
 }
 
+const getCoordinatorCaseload = `-- name: GetCoordinatorCaseload :one
+SELECT
+    e.max_caseload,
+    COUNT(c.id) AS caseload
+FROM employees e
+LEFT JOIN clients c ON c.coordinator_id = e.id
+    AND c.status IN ('waiting_list', 'in_care')
+    AND c.id <> $1::text
+WHERE e.id = $2::text
+GROUP BY e.id, e.max_caseload
+`
+
+type GetCoordinatorCaseloadParams struct {
+	ExcludeClientID string `json:"exclude_client_id"`
+	CoordinatorID   string `json:"coordinator_id"`
+}
+
+type GetCoordinatorCaseloadRow struct {
+	MaxCaseload *int32 `json:"max_caseload"`
+	Caseload    int64  `json:"caseload"`
+}
+
+// The coordinator's own cap and active (waiting list or in care) client count,
+// leaving out the client being assigned so a reassignment isn't counted twice
+func (q *Queries) GetCoordinatorCaseload(ctx context.Context, arg GetCoordinatorCaseloadParams) (GetCoordinatorCaseloadRow, error) {
+	row := q.db.QueryRow(ctx, getCoordinatorCaseload, arg.ExcludeClientID, arg.CoordinatorID)
+	var i GetCoordinatorCaseloadRow
+	err := row.Scan(&i.MaxCaseload, &i.Caseload)
+	return i, err
+}
+
 const getEmployeeByID = `-- name: GetEmployeeByID :one
 SELECT
     e.id,
@@ -77,6 +111,7 @@ SELECT
     e.contract_hours,
     e.contract_type,
     e.location_id,
+    e.max_caseload,
     l.name as location_name,
     u.email,
     r.id as role_id,
@@ -91,7 +126,7 @@ LEFT JOIN clients c ON c.coordinator_id = e.id
 WHERE e.id = $1
 GROUP BY e.id, e.user_id, e.first_name, e.last_name, e.bsn, e.date_of_birth, 
          e.phone_number, e.gender, e.contract_hours, e.contract_type, e.location_id,
-         l.name, u.email, r.id, r.name
+         e.max_caseload, l.name, u.email, r.id, r.name
 LIMIT 1
 `
 
@@ -107,6 +142,7 @@ type GetEmployeeByIDRow struct {
 	ContractHours *int32               `json:"contract_hours"`
 	ContractType  NullContractTypeEnum `json:"contract_type"`
 	LocationID    string               `json:"location_id"`
+	MaxCaseload   *int32               `json:"max_caseload"`
 	LocationName  string               `json:"location_name"`
 	Email         string               `json:"email"`
 	RoleID        *string              `json:"role_id"`
@@ -129,6 +165,7 @@ func (q *Queries) GetEmployeeByID(ctx context.Context, id string) (GetEmployeeBy
 		&i.ContractHours,
 		&i.ContractType,
 		&i.LocationID,
+		&i.MaxCaseload,
 		&i.LocationName,
 		&i.Email,
 		&i.RoleID,
@@ -154,7 +191,9 @@ SELECT
     e.created_at,
     e.updated_at,
     e.is_deleted,
+    e.organization_id,
     u.email,
+    u.last_login_at,
     r.id as role_id,
     r.name as role_name,
     l.name as location_name,
@@ -170,8 +209,8 @@ LEFT JOIN clients c ON c.coordinator_id = e.id
 WHERE e.user_id = $1
 GROUP BY e.id, e.user_id, e.first_name, e.last_name, e.bsn, e.date_of_birth,
          e.phone_number, e.gender, e.contract_hours, e.contract_type, e.location_id,
-         e.created_at, e.updated_at, e.is_deleted, u.email, r.id, r.name,
-         l.name, l.address, l.postal_code
+         e.created_at, e.updated_at, e.is_deleted, e.organization_id, u.email, u.last_login_at,
+         r.id, r.name, l.name, l.address, l.postal_code
 LIMIT 1
 `
 
@@ -190,7 +229,9 @@ type GetEmployeeByUserIDRow struct {
 	CreatedAt          pgtype.Timestamp     `json:"created_at"`
 	UpdatedAt          pgtype.Timestamp     `json:"updated_at"`
 	IsDeleted          *bool                `json:"is_deleted"`
+	OrganizationID     *string              `json:"organization_id"`
 	Email              string               `json:"email"`
+	LastLoginAt        pgtype.Timestamptz   `json:"last_login_at"`
 	RoleID             *string              `json:"role_id"`
 	RoleName           *string              `json:"role_name"`
 	LocationName       *string              `json:"location_name"`
@@ -217,7 +258,9 @@ func (q *Queries) GetEmployeeByUserID(ctx context.Context, userID string) (GetEm
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.IsDeleted,
+		&i.OrganizationID,
 		&i.Email,
+		&i.LastLoginAt,
 		&i.RoleID,
 		&i.RoleName,
 		&i.LocationName,
@@ -324,6 +367,19 @@ func (q *Queries) ListEmployees(ctx context.Context, arg ListEmployeesParams) ([
 	return items, nil
 }
 
+const lockEmployee = `-- name: LockEmployee :one
+SELECT id FROM employees WHERE id = $1 FOR UPDATE
+`
+
+// Locks the employee row for the rest of the transaction so checks on their
+// schedule or caseload and the write that depends on them are not interleaved
+// with another transaction doing the same
+func (q *Queries) LockEmployee(ctx context.Context, id string) (string, error) {
+	row := q.db.QueryRow(ctx, lockEmployee, id)
+	err := row.Scan(&id)
+	return id, err
+}
+
 const softDeleteEmployee = `-- name: SoftDeleteEmployee :exec
 UPDATE employees SET is_deleted = true, updated_at = now() WHERE id = $1
 `
@@ -333,46 +389,97 @@ func (q *Queries) SoftDeleteEmployee(ctx context.Context, id string) error {
 	return err
 }
 
+const suggestReplacementCoordinator = `-- name: SuggestReplacementCoordinator :one
+SELECT
+    e.id,
+    e.first_name,
+    e.last_name,
+    e.location_id,
+    COUNT(c.id) AS caseload
+FROM employees e
+LEFT JOIN clients c ON c.coordinator_id = e.id AND c.status = 'in_care'
+WHERE e.is_deleted = FALSE
+  AND e.id <> $1::text
+  AND e.location_id = (SELECT location_id FROM employees WHERE id = $1::text)
+  AND EXISTS (
+      SELECT 1 FROM user_roles ur
+      JOIN roles r ON ur.role_id = r.id
+      WHERE ur.user_id = e.user_id AND r.name = 'coordinator'
+  )
+GROUP BY e.id, e.first_name, e.last_name, e.location_id
+ORDER BY caseload, e.last_name, e.first_name, e.id
+LIMIT 1
+`
+
+type SuggestReplacementCoordinatorRow struct {
+	ID         string `json:"id"`
+	FirstName  string `json:"first_name"`
+	LastName   string `json:"last_name"`
+	LocationID string `json:"location_id"`
+	Caseload   int64  `json:"caseload"`
+}
+
+// Active coordinator at the employee's location with the fewest in-care clients
+func (q *Queries) SuggestReplacementCoordinator(ctx context.Context, employeeID string) (SuggestReplacementCoordinatorRow, error) {
+	row := q.db.QueryRow(ctx, suggestReplacementCoordinator, employeeID)
+	var i SuggestReplacementCoordinatorRow
+	err := row.Scan(
+		&i.ID,
+		&i.FirstName,
+		&i.LastName,
+		&i.LocationID,
+		&i.Caseload,
+	)
+	return i, err
+}
+
 const updateEmployee = `-- name: UpdateEmployee :exec
 UPDATE employees SET
     first_name = COALESCE($2, first_name),
     last_name = COALESCE($3, last_name),
-    bsn = COALESCE($4, bsn),
-    date_of_birth = COALESCE($5, date_of_birth),
-    phone_number = COALESCE($6, phone_number),
-    gender = COALESCE($7, gender),
-    contract_hours = COALESCE($8, contract_hours),
-    contract_type = COALESCE($9, contract_type),
-    location_id = COALESCE($10, location_id),
+    date_of_birth = COALESCE($4, date_of_birth),
+    phone_number = COALESCE($5, phone_number),
+    gender = COALESCE($6, gender),
+    contract_hours = COALESCE($7, contract_hours),
+    contract_type = COALESCE($8, contract_type),
+    location_id = COALESCE($9, location_id),
+    max_caseload = CASE
+        WHEN $10::boolean THEN NULL
+        ELSE COALESCE($11, max_caseload)
+    END,
     updated_at = now()
 WHERE id = $1
 `
 
 type UpdateEmployeeParams struct {
-	ID            string               `json:"id"`
-	FirstName     *string              `json:"first_name"`
-	LastName      *string              `json:"last_name"`
-	Bsn           *string              `json:"bsn"`
-	DateOfBirth   pgtype.Date          `json:"date_of_birth"`
-	PhoneNumber   *string              `json:"phone_number"`
-	Gender        NullGenderEnum       `json:"gender"`
-	ContractHours *int32               `json:"contract_hours"`
-	ContractType  NullContractTypeEnum `json:"contract_type"`
-	LocationID    *string              `json:"location_id"`
+	ID               string               `json:"id"`
+	FirstName        *string              `json:"first_name"`
+	LastName         *string              `json:"last_name"`
+	DateOfBirth      pgtype.Date          `json:"date_of_birth"`
+	PhoneNumber      *string              `json:"phone_number"`
+	Gender           NullGenderEnum       `json:"gender"`
+	ContractHours    *int32               `json:"contract_hours"`
+	ContractType     NullContractTypeEnum `json:"contract_type"`
+	LocationID       *string              `json:"location_id"`
+	ClearMaxCaseload bool                 `json:"clear_max_caseload"`
+	MaxCaseload      *int32               `json:"max_caseload"`
 }
 
+// BSN is immutable after onboarding and intentionally not updatable here.
+// clear_max_caseload resets the employee's cap to the default.
 func (q *Queries) UpdateEmployee(ctx context.Context, arg UpdateEmployeeParams) error {
 	_, err := q.db.Exec(ctx, updateEmployee,
 		arg.ID,
 		arg.FirstName,
 		arg.LastName,
-		arg.Bsn,
 		arg.DateOfBirth,
 		arg.PhoneNumber,
 		arg.Gender,
 		arg.ContractHours,
 		arg.ContractType,
 		arg.LocationID,
+		arg.ClearMaxCaseload,
+		arg.MaxCaseload,
 	)
 	return err
 }
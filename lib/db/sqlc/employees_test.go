@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -271,3 +272,195 @@ func TestListEmployees(t *testing.T) {
 		})
 	}
 }
+
+// ============================================================
+// Test: SuggestReplacementCoordinator
+// ============================================================
+
+func TestSuggestReplacementCoordinator(t *testing.T) {
+	// createCoordinator creates an employee with the coordinator role at the location
+	createCoordinator := func(t *testing.T, q *Queries, locationID string) string {
+		userID := CreateTestUser(t, q, CreateTestUserOptions{})
+		AssignTestRoleToUser(t, q, userID, "role_coordinator")
+		return CreateTestEmployee(t, q, CreateTestEmployeeOptions{
+			UserID:     userID,
+			LocationID: &locationID,
+		})
+	}
+
+	t.Run("lightest_caseload_is_suggested", func(t *testing.T) {
+		runTestWithTx(t, func(t *testing.T, q *Queries) {
+			ctx := context.Background()
+			locationID := CreateTestLocation(t, q, CreateTestLocationOptions{})
+			leaving := createCoordinator(t, q, locationID)
+			busy := createCoordinator(t, q, locationID)
+			light := createCoordinator(t, q, locationID)
+
+			for range 3 {
+				createInCareClientForCoordinator(t, q, busy, locationID, nil, nil)
+			}
+			createInCareClientForCoordinator(t, q, light, locationID, nil, nil)
+			// The leaving coordinator's own caseload doesn't make them a candidate
+			createInCareClientForCoordinator(t, q, leaving, locationID, nil, nil)
+
+			suggestion, err := q.SuggestReplacementCoordinator(ctx, leaving)
+			require.NoError(t, err)
+			assert.Equal(t, light, suggestion.ID)
+			assert.Equal(t, int64(1), suggestion.Caseload)
+		})
+	})
+
+	t.Run("ignores_other_locations_and_deleted_coordinators", func(t *testing.T) {
+		runTestWithTx(t, func(t *testing.T, q *Queries) {
+			ctx := context.Background()
+			locationID := CreateTestLocation(t, q, CreateTestLocationOptions{})
+			otherLocationID := CreateTestLocation(t, q, CreateTestLocationOptions{})
+			leaving := createCoordinator(t, q, locationID)
+			createCoordinator(t, q, otherLocationID)
+			deleted := createCoordinator(t, q, locationID)
+			require.NoError(t, q.SoftDeleteEmployee(ctx, deleted))
+
+			_, err := q.SuggestReplacementCoordinator(ctx, leaving)
+			assert.ErrorIs(t, err, pgx.ErrNoRows)
+		})
+	})
+}
+
+func TestGetCoordinatorCaseload(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		locationID := CreateTestLocation(t, q, CreateTestLocationOptions{})
+		coordinatorID := CreateTestEmployee(t, q, CreateTestEmployeeOptions{
+			UserID:     CreateTestUser(t, q, CreateTestUserOptions{}),
+			LocationID: &locationID,
+		})
+
+		first := createInCareClientForCoordinator(t, q, coordinatorID, locationID, nil, nil)
+		createInCareClientForCoordinator(t, q, coordinatorID, locationID, nil, nil)
+		// Discharged clients no longer count toward the caseload
+		discharged := createInCareClientForCoordinator(t, q, coordinatorID, locationID, nil, nil)
+		_, err := q.db.Exec(ctx, `UPDATE clients SET status = 'discharged' WHERE id = $1`, discharged)
+		require.NoError(t, err)
+
+		caseload, err := q.GetCoordinatorCaseload(ctx, GetCoordinatorCaseloadParams{
+			CoordinatorID: coordinatorID,
+		})
+		require.NoError(t, err)
+		assert.Nil(t, caseload.MaxCaseload)
+		assert.Equal(t, int64(2), caseload.Caseload)
+
+		maxCaseload := int32(3)
+		require.NoError(t, q.UpdateEmployee(ctx, UpdateEmployeeParams{
+			ID:          coordinatorID,
+			MaxCaseload: &maxCaseload,
+		}))
+
+		// The client being reassigned is left out of the count
+		caseload, err = q.GetCoordinatorCaseload(ctx, GetCoordinatorCaseloadParams{
+			ExcludeClientID: first,
+			CoordinatorID:   coordinatorID,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, caseload.MaxCaseload)
+		assert.Equal(t, int32(3), *caseload.MaxCaseload)
+		assert.Equal(t, int64(1), caseload.Caseload)
+
+		// Clearing the cap brings back the default
+		require.NoError(t, q.UpdateEmployee(ctx, UpdateEmployeeParams{
+			ID:               coordinatorID,
+			ClearMaxCaseload: true,
+		}))
+		caseload, err = q.GetCoordinatorCaseload(ctx, GetCoordinatorCaseloadParams{
+			CoordinatorID: coordinatorID,
+		})
+		require.NoError(t, err)
+		assert.Nil(t, caseload.MaxCaseload)
+	})
+}
+
+func TestCheckCaseload(t *testing.T) {
+	maxCaseload := func(n int32) *int32 { return &n }
+
+	tests := []struct {
+		name        string
+		maxCaseload *int32
+		check       func(coordinatorID, clientID string) CaseloadCheck
+		wantErr     error
+	}{
+		{
+			name: "under_default_cap",
+			check: func(coordinatorID, _ string) CaseloadCheck {
+				return CaseloadCheck{CoordinatorID: coordinatorID, DefaultLimit: 3}
+			},
+		},
+		{
+			name: "at_default_cap",
+			check: func(coordinatorID, _ string) CaseloadCheck {
+				return CaseloadCheck{CoordinatorID: coordinatorID, DefaultLimit: 2}
+			},
+			wantErr: ErrCoordinatorAtCapacity,
+		},
+		{
+			name: "reassigned_client_not_counted",
+			check: func(coordinatorID, clientID string) CaseloadCheck {
+				return CaseloadCheck{CoordinatorID: coordinatorID, ClientID: clientID, DefaultLimit: 2}
+			},
+		},
+		{
+			name:        "employee_cap_replaces_default",
+			maxCaseload: maxCaseload(5),
+			check: func(coordinatorID, _ string) CaseloadCheck {
+				return CaseloadCheck{CoordinatorID: coordinatorID, DefaultLimit: 2}
+			},
+		},
+		{
+			name:        "employee_cap_without_default",
+			maxCaseload: maxCaseload(2),
+			check: func(coordinatorID, _ string) CaseloadCheck {
+				return CaseloadCheck{CoordinatorID: coordinatorID}
+			},
+			wantErr: ErrCoordinatorAtCapacity,
+		},
+		{
+			name: "no_cap",
+			check: func(coordinatorID, _ string) CaseloadCheck {
+				return CaseloadCheck{CoordinatorID: coordinatorID}
+			},
+		},
+		{
+			name: "unknown_coordinator",
+			check: func(_, _ string) CaseloadCheck {
+				return CaseloadCheck{CoordinatorID: "missing-coordinator", DefaultLimit: 2}
+			},
+			wantErr: ErrCoordinatorNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runTestWithTx(t, func(t *testing.T, q *Queries) {
+				ctx := context.Background()
+				locationID := CreateTestLocation(t, q, CreateTestLocationOptions{})
+				coordinatorID := CreateTestEmployee(t, q, CreateTestEmployeeOptions{
+					UserID:     CreateTestUser(t, q, CreateTestUserOptions{}),
+					LocationID: &locationID,
+				})
+				if tt.maxCaseload != nil {
+					require.NoError(t, q.UpdateEmployee(ctx, UpdateEmployeeParams{
+						ID:          coordinatorID,
+						MaxCaseload: tt.maxCaseload,
+					}))
+				}
+				clientID := createInCareClientForCoordinator(t, q, coordinatorID, locationID, nil, nil)
+				createInCareClientForCoordinator(t, q, coordinatorID, locationID, nil, nil)
+
+				err := q.CheckCaseload(ctx, tt.check(coordinatorID, clientID))
+				if tt.wantErr != nil {
+					require.ErrorIs(t, err, tt.wantErr)
+					return
+				}
+				require.NoError(t, err)
+			})
+		})
+	}
+}
@@ -1,6 +1,61 @@
 package db
 
-import "context"
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+var (
+	// ErrCoordinatorAtCapacity is returned when the coordinator already holds
+	// as many active clients as their caseload cap allows
+	ErrCoordinatorAtCapacity = errors.New("coordinator at capacity")
+	// ErrCoordinatorNotFound is returned when the coordinator being assigned
+	// does not exist
+	ErrCoordinatorNotFound = errors.New("coordinator not found")
+)
+
+// CaseloadCheck is the coordinator a client is assigned to, checked against
+// their caseload cap inside the assignment transaction
+type CaseloadCheck struct {
+	CoordinatorID string
+	// Client being assigned, left out of the count so a reassignment isn't
+	// counted twice; empty for a new client
+	ClientID string
+	// Cap for coordinators without a max_caseload of their own; 0 means no cap
+	DefaultLimit int32
+}
+
+// CheckCaseload locks the coordinator and returns ErrCoordinatorAtCapacity
+// when the assignment would take them past their cap. The lock holds until the
+// transaction ends, so two assignments to the same coordinator cannot both
+// see the last free place.
+func (q *Queries) CheckCaseload(ctx context.Context, check CaseloadCheck) error {
+	if _, err := q.LockEmployee(ctx, check.CoordinatorID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrCoordinatorNotFound
+		}
+		return err
+	}
+
+	caseload, err := q.GetCoordinatorCaseload(ctx, GetCoordinatorCaseloadParams{
+		ExcludeClientID: check.ClientID,
+		CoordinatorID:   check.CoordinatorID,
+	})
+	if err != nil {
+		return err
+	}
+
+	limit := int64(check.DefaultLimit)
+	if caseload.MaxCaseload != nil {
+		limit = int64(*caseload.MaxCaseload)
+	}
+	if limit > 0 && caseload.Caseload >= limit {
+		return ErrCoordinatorAtCapacity
+	}
+	return nil
+}
 
 type CreateEmployeeTxParams struct {
 	Emp  CreateEmployeeParams
@@ -21,3 +76,24 @@ func (s *Store) CreateEmployeeTx(ctx context.Context, arg CreateEmployeeTxParams
 	})
 	return err
 }
+
+type SetCoordinatorAvailabilityTxParams struct {
+	EmployeeID   string
+	Availability []CreateCoordinatorAvailabilityParams
+}
+
+// SetCoordinatorAvailabilityTx replaces a coordinator's weekly working hours
+func (s *Store) SetCoordinatorAvailabilityTx(ctx context.Context, arg SetCoordinatorAvailabilityTxParams) error {
+	return s.ExecTx(ctx, func(q *Queries) error {
+		if err := q.DeleteCoordinatorAvailability(ctx, arg.EmployeeID); err != nil {
+			return err
+		}
+		for _, availability := range arg.Availability {
+			availability.EmployeeID = arg.EmployeeID
+			if err := q.CreateCoordinatorAvailability(ctx, availability); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -1,6 +1,7 @@
 package db
 
 import (
+	"care-cordination/lib/nanoid"
 	"context"
 
 	"github.com/jackc/pgx/v5/pgtype"
@@ -47,6 +48,11 @@ func (s *Store) CreateEvaluationTx(ctx context.Context, arg CreateEvaluationTxPa
 			}); err != nil {
 				return err
 			}
+
+			// 4. Close out the client's evaluation record and schedule the next one
+			if err := closeEvaluationRecord(ctx, q, eval, result.NextEvaluationDate); err != nil {
+				return err
+			}
 		}
 
 		return nil
@@ -55,6 +61,80 @@ func (s *Store) CreateEvaluationTx(ctx context.Context, arg CreateEvaluationTxPa
 	return result, err
 }
 
+type SubmitDraftEvaluationTxParams struct {
+	EvaluationID  string
+	IntervalWeeks int32
+}
+
+type SubmitDraftEvaluationTxResult struct {
+	Evaluation         ClientEvaluation
+	NextEvaluationDate pgtype.Date
+}
+
+func (s *Store) SubmitDraftEvaluationTx(ctx context.Context, arg SubmitDraftEvaluationTxParams) (SubmitDraftEvaluationTxResult, error) {
+	var result SubmitDraftEvaluationTxResult
+
+	err := s.ExecTx(ctx, func(q *Queries) error {
+		// 1. Submit the draft
+		eval, err := q.SubmitDraftEvaluation(ctx, arg.EvaluationID)
+		if err != nil {
+			return err
+		}
+		result.Evaluation = eval
+
+		// 2. Calculate and update next evaluation date
+		nextDate := eval.EvaluationDate.Time.AddDate(0, 0, int(arg.IntervalWeeks)*7)
+		result.NextEvaluationDate = pgtype.Date{Time: nextDate, Valid: true}
+
+		if err := q.UpdateClientNextEvaluationDate(ctx, UpdateClientNextEvaluationDateParams{
+			ID:                 eval.ClientID,
+			NextEvaluationDate: result.NextEvaluationDate,
+		}); err != nil {
+			return err
+		}
+
+		// 3. Close out the client's evaluation record and schedule the next one
+		return closeEvaluationRecord(ctx, q, eval, result.NextEvaluationDate)
+	})
+
+	return result, err
+}
+
+// closeEvaluationRecord keeps the evaluations schedule in step with a
+// submitted assessment: the client's open record is completed, and the next
+// one is scheduled while the client remains in care.
+func closeEvaluationRecord(ctx context.Context, q *Queries, eval ClientEvaluation, next pgtype.Date) error {
+	if err := q.RecordSubmittedEvaluation(ctx, RecordSubmittedEvaluationParams{
+		ClientEvaluationID: eval.ID,
+		CompletedDate:      eval.EvaluationDate,
+		ClientID:           eval.ClientID,
+		ID:                 nanoid.Generate(),
+		CoordinatorID:      eval.CoordinatorID,
+	}); err != nil {
+		return err
+	}
+
+	if !next.Valid {
+		return nil
+	}
+
+	client, err := q.GetClientByID(ctx, GetClientByIDParams{ID: eval.ClientID})
+	if err != nil {
+		return err
+	}
+	if client.Status != ClientStatusEnumInCare {
+		return nil
+	}
+
+	_, err = q.CreateEvaluationRecord(ctx, CreateEvaluationRecordParams{
+		ID:            nanoid.Generate(),
+		ClientID:      client.ID,
+		CoordinatorID: client.CoordinatorID,
+		ScheduledDate: next,
+	})
+	return err
+}
+
 type UpdateEvaluationTxParams struct {
 	EvaluationID   string
 	EvaluationDate pgtype.Date
@@ -93,3 +173,71 @@ func (s *Store) UpdateEvaluationTx(ctx context.Context, arg UpdateEvaluationTxPa
 
 	return result, err
 }
+
+type CompleteEvaluationRecordTxParams struct {
+	Evaluation           CompleteEvaluationRecordParams
+	DefaultIntervalWeeks int32
+}
+
+type CompleteEvaluationRecordTxResult struct {
+	Evaluation         Evaluation
+	NextEvaluationID   *string
+	NextEvaluationDate pgtype.Date
+}
+
+func (s *Store) CompleteEvaluationRecordTx(ctx context.Context, arg CompleteEvaluationRecordTxParams) (CompleteEvaluationRecordTxResult, error) {
+	var result CompleteEvaluationRecordTxResult
+
+	err := s.ExecTx(ctx, func(q *Queries) error {
+		// 1. Complete the evaluation record
+		eval, err := q.CompleteEvaluationRecord(ctx, arg.Evaluation)
+		if err != nil {
+			return err
+		}
+		result.Evaluation = eval
+
+		// 2. Roll the client's next evaluation date forward from the completion date
+		client, err := q.GetClientByID(ctx, GetClientByIDParams{ID: eval.ClientID})
+		if err != nil {
+			return err
+		}
+
+		interval := arg.DefaultIntervalWeeks
+		if client.EvaluationIntervalWeeks != nil {
+			interval = *client.EvaluationIntervalWeeks
+		}
+		if interval <= 0 {
+			return nil
+		}
+
+		nextDate := eval.CompletedDate.Time.AddDate(0, 0, int(interval)*7)
+		result.NextEvaluationDate = pgtype.Date{Time: nextDate, Valid: true}
+
+		if err := q.UpdateClientNextEvaluationDate(ctx, UpdateClientNextEvaluationDateParams{
+			ID:                 client.ID,
+			NextEvaluationDate: result.NextEvaluationDate,
+		}); err != nil {
+			return err
+		}
+
+		// 3. Schedule the next evaluation while the client remains in care
+		if client.Status != ClientStatusEnumInCare {
+			return nil
+		}
+
+		next, err := q.CreateEvaluationRecord(ctx, CreateEvaluationRecordParams{
+			ID:            nanoid.Generate(),
+			ClientID:      client.ID,
+			CoordinatorID: client.CoordinatorID,
+			ScheduledDate: result.NextEvaluationDate,
+		})
+		if err != nil {
+			return err
+		}
+		result.NextEvaluationID = &next.ID
+
+		return nil
+	})
+
+	return result, err
+}
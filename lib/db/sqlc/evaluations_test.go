@@ -0,0 +1,503 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createTestEvaluationRecord schedules an evaluation for the client on the given date.
+func createTestEvaluationRecord(t *testing.T, q *Queries, clientID, coordinatorID string, scheduled time.Time) Evaluation {
+	t.Helper()
+
+	eval, err := q.CreateEvaluationRecord(context.Background(), CreateEvaluationRecordParams{
+		ID:            generateTestID(),
+		ClientID:      clientID,
+		CoordinatorID: coordinatorID,
+		ScheduledDate: toPgDate(scheduled),
+	})
+	require.NoError(t, err)
+	return eval
+}
+
+// createCommittedClient creates a client and its dependencies outside any test
+// transaction, for the *Tx methods that open their own, and deletes them after
+// the test.
+func createCommittedClient(t *testing.T, status ClientStatusEnum, organizationID *string) (string, ClientDependencies) {
+	t.Helper()
+	q := testStore.Queries
+
+	userID := CreateTestUser(t, q, CreateTestUserOptions{})
+	deleteAfterTest(t, "users", userID)
+	locationID := CreateTestLocation(t, q, CreateTestLocationOptions{})
+	deleteAfterTest(t, "locations", locationID)
+	employeeID := CreateTestEmployee(t, q, CreateTestEmployeeOptions{UserID: userID, LocationID: &locationID})
+	deleteAfterTest(t, "employees", employeeID)
+	regFormID := CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{})
+	deleteAfterTest(t, "registration_forms", regFormID)
+	intakeFormID := CreateTestIntakeForm(t, q, CreateTestIntakeFormOptions{
+		RegistrationFormID: regFormID,
+		LocationID:         locationID,
+		CoordinatorID:      employeeID,
+	})
+	deleteAfterTest(t, "intake_forms", intakeFormID)
+
+	careStart := time.Now().AddDate(0, -6, 0)
+	opts := CreateTestClientOptions{
+		RegistrationFormID: regFormID,
+		IntakeFormID:       intakeFormID,
+		AssignedLocationID: locationID,
+		CoordinatorID:      employeeID,
+		Status:             &status,
+		OrganizationID:     organizationID,
+	}
+	if status == ClientStatusEnumInCare {
+		opts.CareStartDate = &careStart
+	}
+	clientID := CreateTestClient(t, q, opts)
+	deleteAfterTest(t, "clients", clientID)
+
+	return clientID, ClientDependencies{
+		UserID:             userID,
+		EmployeeID:         employeeID,
+		LocationID:         locationID,
+		RegistrationFormID: regFormID,
+		IntakeFormID:       intakeFormID,
+	}
+}
+
+// listEvaluationRecords returns the client's evaluation records, latest scheduled first.
+func listEvaluationRecords(t *testing.T, q *Queries, clientID string) []ListEvaluationRecordsByClientRow {
+	t.Helper()
+
+	rows, err := q.ListEvaluationRecordsByClient(context.Background(), ListEvaluationRecordsByClientParams{
+		ClientID: clientID,
+		Limit:    10,
+		Offset:   0,
+	})
+	require.NoError(t, err)
+	return rows
+}
+
+// ============================================================
+// Test: CreateEvaluationRecord
+// ============================================================
+
+func TestCreateEvaluationRecord(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		clientID, deps := CreateTestClientWithDependencies(t, q)
+		scheduled := time.Now().AddDate(0, 0, 14)
+
+		eval, err := q.CreateEvaluationRecord(ctx, CreateEvaluationRecordParams{
+			ID:            generateTestID(),
+			ClientID:      clientID,
+			CoordinatorID: deps.EmployeeID,
+			ScheduledDate: toPgDate(scheduled),
+			Notes:         strPtr("Initial evaluation"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, clientID, eval.ClientID)
+		assert.Equal(t, deps.EmployeeID, eval.CoordinatorID)
+		assert.Equal(t, scheduled.Format("2006-01-02"), eval.ScheduledDate.Time.Format("2006-01-02"))
+		assert.False(t, eval.CompletedDate.Valid)
+		assert.False(t, eval.Outcome.Valid)
+
+		rows, err := q.ListEvaluationRecordsByClient(ctx, ListEvaluationRecordsByClientParams{
+			ClientID: clientID,
+			Limit:    10,
+			Offset:   0,
+		})
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		assert.Equal(t, eval.ID, rows[0].ID)
+		assert.Equal(t, int64(1), rows[0].TotalCount)
+		assert.NotEmpty(t, rows[0].CoordinatorFirstName)
+	})
+}
+
+// ============================================================
+// Test: CompleteEvaluationRecord
+// ============================================================
+
+func TestCompleteEvaluationRecord_AlreadyCompleted(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		clientID, deps := CreateTestClientWithDependencies(t, q)
+		eval := createTestEvaluationRecord(t, q, clientID, deps.EmployeeID, time.Now())
+
+		params := CompleteEvaluationRecordParams{
+			ID:            eval.ID,
+			CompletedDate: toPgDate(time.Now()),
+			Outcome:       EvaluationOutcomeEnumOnTrack,
+		}
+		completed, err := q.CompleteEvaluationRecord(ctx, params)
+		require.NoError(t, err)
+		assert.True(t, completed.CompletedDate.Valid)
+		assert.Equal(t, EvaluationOutcomeEnumOnTrack, completed.Outcome.EvaluationOutcomeEnum)
+
+		_, err = q.CompleteEvaluationRecord(ctx, params)
+		assert.True(t, errors.Is(err, pgx.ErrNoRows))
+	})
+}
+
+// CompleteEvaluationRecordTx opens its own transaction, so this test runs against
+// testStore directly instead of inside runTestWithTx.
+func TestCompleteEvaluationRecordTx_RollsNextDateForward(t *testing.T) {
+	ctx := context.Background()
+	q := testStore.Queries
+
+	clientID, deps := createCommittedClient(t, ClientStatusEnumInCare, nil)
+	_, err := q.UpdateClient(ctx, UpdateClientParams{
+		ID:                      clientID,
+		EvaluationIntervalWeeks: int32Ptr(4),
+	})
+	require.NoError(t, err)
+
+	eval := createTestEvaluationRecord(t, q, clientID, deps.EmployeeID, time.Now().AddDate(0, 0, -3))
+	completedOn := time.Now()
+
+	result, err := testStore.CompleteEvaluationRecordTx(ctx, CompleteEvaluationRecordTxParams{
+		Evaluation: CompleteEvaluationRecordParams{
+			ID:            eval.ID,
+			CompletedDate: toPgDate(completedOn),
+			Outcome:       EvaluationOutcomeEnumNeedsAttention,
+			Notes:         strPtr("Follow up on school attendance"),
+		},
+		DefaultIntervalWeeks: 5,
+	})
+	require.NoError(t, err)
+
+	expectedNext := completedOn.AddDate(0, 0, 28).Format("2006-01-02")
+	assert.Equal(t, expectedNext, result.NextEvaluationDate.Time.Format("2006-01-02"))
+	require.NotNil(t, result.Evaluation.Notes)
+	assert.Equal(t, "Follow up on school attendance", *result.Evaluation.Notes)
+
+	client, err := q.GetClientByID(ctx, GetClientByIDParams{ID: clientID})
+	require.NoError(t, err)
+	assert.Equal(t, expectedNext, client.NextEvaluationDate.Time.Format("2006-01-02"))
+
+	require.NotNil(t, result.NextEvaluationID)
+	rows := listEvaluationRecords(t, q, clientID)
+	require.Len(t, rows, 2)
+	next := rows[0]
+	assert.Equal(t, *result.NextEvaluationID, next.ID)
+	assert.Equal(t, expectedNext, next.ScheduledDate.Time.Format("2006-01-02"))
+	assert.False(t, next.CompletedDate.Valid)
+}
+
+// ============================================================
+// Test: Evaluation records follow the assessment flows
+// ============================================================
+
+func TestCreateEvaluationTx_CompletesScheduledRecord(t *testing.T) {
+	ctx := context.Background()
+	q := testStore.Queries
+
+	clientID, deps := createCommittedClient(t, ClientStatusEnumInCare, nil)
+	scheduled := createTestEvaluationRecord(t, q, clientID, deps.EmployeeID, time.Now().AddDate(0, 0, -3))
+
+	goalID := generateTestID()
+	require.NoError(t, q.CreateClientGoal(ctx, CreateClientGoalParams{
+		ID:           goalID,
+		IntakeFormID: deps.IntakeFormID,
+		ClientID:     &clientID,
+		Title:        "Attend school",
+	}))
+
+	before, err := q.GetEvaluationStats(ctx, GetEvaluationStatsParams{Today: toPgDate(time.Now()), DueSoonDays: 7})
+	require.NoError(t, err)
+
+	evaluatedOn := time.Now()
+	result, err := testStore.CreateEvaluationTx(ctx, CreateEvaluationTxParams{
+		Evaluation: CreateClientEvaluationParams{
+			ID:             generateTestID(),
+			ClientID:       clientID,
+			CoordinatorID:  deps.EmployeeID,
+			EvaluationDate: toPgDate(evaluatedOn),
+			Status:         EvaluationStatusEnumSubmitted,
+		},
+		ProgressLogs: []CreateGoalProgressLogParams{
+			{ID: generateTestID(), GoalID: goalID, Status: GoalProgressStatusDelayed},
+		},
+		IntervalWeeks: 4,
+	})
+	require.NoError(t, err)
+
+	rows := listEvaluationRecords(t, q, clientID)
+	require.Len(t, rows, 2)
+	next, completed := rows[0], rows[1]
+	assert.Equal(t, scheduled.ID, completed.ID)
+	assert.Equal(t, evaluatedOn.Format("2006-01-02"), completed.CompletedDate.Time.Format("2006-01-02"))
+	assert.Equal(t, EvaluationOutcomeEnumNeedsAttention, completed.Outcome.EvaluationOutcomeEnum)
+	assert.Equal(t, result.NextEvaluationDate.Time.Format("2006-01-02"), next.ScheduledDate.Time.Format("2006-01-02"))
+	assert.False(t, next.CompletedDate.Valid)
+
+	// The overdue record is now completed and the next one is not due yet
+	after, err := q.GetEvaluationStats(ctx, GetEvaluationStatsParams{Today: toPgDate(time.Now()), DueSoonDays: 7})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), after.Total-before.Total)
+	assert.Equal(t, int64(1), after.Completed-before.Completed)
+	assert.Equal(t, int64(-1), after.Overdue-before.Overdue)
+}
+
+func TestSubmitDraftEvaluationTx_RecordsUnscheduledEvaluation(t *testing.T) {
+	ctx := context.Background()
+	q := testStore.Queries
+
+	clientID, deps := createCommittedClient(t, ClientStatusEnumInCare, nil)
+
+	evaluatedOn := time.Now().AddDate(0, 0, -1)
+	draft, err := q.CreateClientEvaluation(ctx, CreateClientEvaluationParams{
+		ID:             generateTestID(),
+		ClientID:       clientID,
+		CoordinatorID:  deps.EmployeeID,
+		EvaluationDate: toPgDate(evaluatedOn),
+		Status:         EvaluationStatusEnumDraft,
+	})
+	require.NoError(t, err)
+
+	result, err := testStore.SubmitDraftEvaluationTx(ctx, SubmitDraftEvaluationTxParams{
+		EvaluationID:  draft.ID,
+		IntervalWeeks: 5,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, EvaluationStatusEnumSubmitted, result.Evaluation.Status)
+
+	expectedNext := evaluatedOn.AddDate(0, 0, 35).Format("2006-01-02")
+	assert.Equal(t, expectedNext, result.NextEvaluationDate.Time.Format("2006-01-02"))
+
+	// Nothing was scheduled, so the submission is recorded as its own completed evaluation
+	rows := listEvaluationRecords(t, q, clientID)
+	require.Len(t, rows, 2)
+	next, completed := rows[0], rows[1]
+	assert.Equal(t, evaluatedOn.Format("2006-01-02"), completed.ScheduledDate.Time.Format("2006-01-02"))
+	assert.Equal(t, evaluatedOn.Format("2006-01-02"), completed.CompletedDate.Time.Format("2006-01-02"))
+	assert.Equal(t, EvaluationOutcomeEnumOnTrack, completed.Outcome.EvaluationOutcomeEnum)
+	assert.Equal(t, expectedNext, next.ScheduledDate.Time.Format("2006-01-02"))
+	assert.False(t, next.CompletedDate.Valid)
+
+	// A second submission of the same draft fails and records nothing
+	_, err = testStore.SubmitDraftEvaluationTx(ctx, SubmitDraftEvaluationTxParams{
+		EvaluationID:  draft.ID,
+		IntervalWeeks: 5,
+	})
+	assert.True(t, errors.Is(err, pgx.ErrNoRows))
+	assert.Len(t, listEvaluationRecords(t, q, clientID), 2)
+}
+
+func TestMoveClientInCareTx_SchedulesFirstEvaluation(t *testing.T) {
+	ctx := context.Background()
+	q := testStore.Queries
+
+	organizationID := CreateTestOrganization(t, q)
+	deleteAfterTest(t, "organizations", organizationID)
+	clientID, deps := createCommittedClient(t, ClientStatusEnumWaitingList, &organizationID)
+
+	before, err := q.GetEvaluationStats(ctx, GetEvaluationStatsParams{Today: toPgDate(time.Now()), DueSoonDays: 7})
+	require.NoError(t, err)
+
+	careStart := time.Now().AddDate(0, 0, -35)
+	firstEvaluation := careStart.AddDate(0, 0, 28)
+	result, err := testStore.MoveClientInCareTx(ctx, MoveClientInCareTxParams{
+		OrganizationID: organizationID,
+		Client: UpdateClientParams{
+			ID:                 clientID,
+			Status:             NullClientStatusEnum{ClientStatusEnum: ClientStatusEnumInCare, Valid: true},
+			CareStartDate:      toPgDate(careStart),
+			NextEvaluationDate: toPgDate(firstEvaluation),
+		},
+		CoordinatorID: deps.EmployeeID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, clientID, result.ClientID)
+
+	rows := listEvaluationRecords(t, q, clientID)
+	require.Len(t, rows, 1)
+	assert.Equal(t, deps.EmployeeID, rows[0].CoordinatorID)
+	assert.Equal(t, firstEvaluation.Format("2006-01-02"), rows[0].ScheduledDate.Time.Format("2006-01-02"))
+
+	// The first evaluation is already a week overdue, as the client list reports
+	after, err := q.GetEvaluationStats(ctx, GetEvaluationStatsParams{Today: toPgDate(time.Now()), DueSoonDays: 7})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), after.Total-before.Total)
+	assert.Equal(t, int64(1), after.Overdue-before.Overdue)
+
+	// Another organization cannot move the client, and nothing is scheduled
+	otherOrganizationID := CreateTestOrganization(t, q)
+	deleteAfterTest(t, "organizations", otherOrganizationID)
+	_, err = testStore.MoveClientInCareTx(ctx, MoveClientInCareTxParams{
+		OrganizationID: otherOrganizationID,
+		Client: UpdateClientParams{
+			ID:                 clientID,
+			NextEvaluationDate: toPgDate(firstEvaluation),
+		},
+		CoordinatorID: deps.EmployeeID,
+	})
+	assert.True(t, errors.Is(err, pgx.ErrNoRows))
+	assert.Len(t, listEvaluationRecords(t, q, clientID), 1)
+}
+
+// ============================================================
+// Test: ListOverdueEvaluationRecordsByClient
+// ============================================================
+
+func TestListOverdueEvaluationRecordsByClient(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		clientID, deps := CreateTestClientWithDependencies(t, q)
+
+		overdue := createTestEvaluationRecord(t, q, clientID, deps.EmployeeID, time.Now().AddDate(0, 0, -7))
+		createTestEvaluationRecord(t, q, clientID, deps.EmployeeID, time.Now().AddDate(0, 0, 7))
+		completed := createTestEvaluationRecord(t, q, clientID, deps.EmployeeID, time.Now().AddDate(0, 0, -14))
+		_, err := q.CompleteEvaluationRecord(ctx, CompleteEvaluationRecordParams{
+			ID:            completed.ID,
+			CompletedDate: toPgDate(time.Now()),
+			Outcome:       EvaluationOutcomeEnumOnTrack,
+		})
+		require.NoError(t, err)
+
+		rows, err := q.ListOverdueEvaluationRecordsByClient(ctx, ListOverdueEvaluationRecordsByClientParams{
+			ClientID: clientID,
+			Today:    toPgDate(time.Now()),
+		})
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		assert.Equal(t, overdue.ID, rows[0].ID)
+		assert.Equal(t, deps.EmployeeID, rows[0].CoordinatorID)
+	})
+}
+
+// ============================================================
+// Test: GetEvaluationStats
+// ============================================================
+
+func TestGetEvaluationStats_CountsEvaluationRecords(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+
+		before, err := q.GetEvaluationStats(ctx, GetEvaluationStatsParams{Today: toPgDate(time.Now()), DueSoonDays: 7})
+		require.NoError(t, err)
+		beforeNarrow, err := q.GetEvaluationStats(ctx, GetEvaluationStatsParams{Today: toPgDate(time.Now()), DueSoonDays: 2})
+		require.NoError(t, err)
+
+		deps := CreateFullClientDependencyChain(t, q)
+		clientID := createInCareClientForCoordinator(t, q, deps.EmployeeID, deps.LocationID, nil, nil)
+
+		// One completed, one overdue and one due within the week
+		done := createTestEvaluationRecord(t, q, clientID, deps.EmployeeID, time.Now().AddDate(0, 0, -10))
+		_, err = q.CompleteEvaluationRecord(ctx, CompleteEvaluationRecordParams{
+			ID:            done.ID,
+			CompletedDate: toPgDate(time.Now().AddDate(0, 0, -9)),
+			Outcome:       EvaluationOutcomeEnumOnTrack,
+		})
+		require.NoError(t, err)
+		createTestEvaluationRecord(t, q, clientID, deps.EmployeeID, time.Now().AddDate(0, 0, -2))
+		createTestEvaluationRecord(t, q, clientID, deps.EmployeeID, time.Now().AddDate(0, 0, 3))
+
+		// Evaluations of clients that are not in care are ignored
+		otherClientID, otherDeps := CreateTestClientWithDependencies(t, q)
+		createTestEvaluationRecord(t, q, otherClientID, otherDeps.EmployeeID, time.Now().AddDate(0, 0, -2))
+
+		after, err := q.GetEvaluationStats(ctx, GetEvaluationStatsParams{Today: toPgDate(time.Now()), DueSoonDays: 7})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), after.Total-before.Total)
+		assert.Equal(t, int64(1), after.Completed-before.Completed)
+		assert.Equal(t, int64(1), after.Overdue-before.Overdue)
+		assert.Equal(t, int64(1), after.DueSoon-before.DueSoon)
+
+		// The evaluation three days out falls outside a two-day window
+		afterNarrow, err := q.GetEvaluationStats(ctx, GetEvaluationStatsParams{Today: toPgDate(time.Now()), DueSoonDays: 2})
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), afterNarrow.DueSoon-beforeNarrow.DueSoon)
+		assert.Equal(t, int64(1), afterNarrow.Overdue-beforeNarrow.Overdue)
+	})
+}
+
+// ============================================================
+// Test: GetEvaluationsDueSoon
+// ============================================================
+
+func TestGetEvaluationsDueSoon_Window(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		deps := CreateFullClientDependencyChain(t, q)
+
+		dueIn5 := time.Now().AddDate(0, 0, 5)
+		clientID := createInCareClientForCoordinator(t, q, deps.EmployeeID, deps.LocationID, &dueIn5, nil)
+
+		contains := func(rows []GetEvaluationsDueSoonRow) bool {
+			for _, row := range rows {
+				if row.ClientID == clientID {
+					return true
+				}
+			}
+			return false
+		}
+
+		narrow, err := q.GetEvaluationsDueSoon(ctx, GetEvaluationsDueSoonParams{Today: toPgDate(time.Now()), DueSoonDays: 3})
+		require.NoError(t, err)
+		assert.False(t, contains(narrow))
+
+		wide, err := q.GetEvaluationsDueSoon(ctx, GetEvaluationsDueSoonParams{Today: toPgDate(time.Now()), DueSoonDays: 7})
+		require.NoError(t, err)
+		assert.True(t, contains(wide))
+	})
+}
+
+// ============================================================
+// Test: ListOverdueEvaluations
+// ============================================================
+
+func TestListOverdueEvaluations(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		deps := CreateFullClientDependencyChain(t, q)
+		otherCoordinator := CreateTestEmployee(t, q, CreateTestEmployeeOptions{})
+
+		overdue10 := time.Now().AddDate(0, 0, -10)
+		overdue3 := time.Now().AddDate(0, 0, -3)
+		dueToday := time.Now()
+		dueNextWeek := time.Now().AddDate(0, 0, 7)
+
+		overdue10ID := createInCareClientForCoordinator(t, q, deps.EmployeeID, deps.LocationID, &overdue10, nil)
+		overdue3ID := createInCareClientForCoordinator(t, q, otherCoordinator, deps.LocationID, &overdue3, nil)
+		todayID := createInCareClientForCoordinator(t, q, deps.EmployeeID, deps.LocationID, &dueToday, nil)
+		onTimeID := createInCareClientForCoordinator(t, q, deps.EmployeeID, deps.LocationID, &dueNextWeek, nil)
+
+		rows, err := q.ListOverdueEvaluations(ctx, ListOverdueEvaluationsParams{Limit: 1000, Offset: 0, Today: toPgDate(time.Now())})
+		require.NoError(t, err)
+
+		byID := map[string]ListOverdueEvaluationsRow{}
+		for _, row := range rows {
+			byID[row.ID] = row
+		}
+		require.Contains(t, byID, overdue10ID)
+		require.Contains(t, byID, overdue3ID)
+		assert.NotContains(t, byID, todayID)
+		assert.NotContains(t, byID, onTimeID)
+
+		assert.Equal(t, int32(10), byID[overdue10ID].DaysOverdue)
+		assert.Equal(t, int32(3), byID[overdue3ID].DaysOverdue)
+		assert.Equal(t, deps.EmployeeID, byID[overdue10ID].CoordinatorID)
+		assert.NotEmpty(t, byID[overdue10ID].CoordinatorLastName)
+		assert.Equal(t, int64(len(rows)), rows[0].TotalCount)
+
+		// Filtered to one coordinator
+		rows, err = q.ListOverdueEvaluations(ctx, ListOverdueEvaluationsParams{
+			Limit:         1000,
+			Offset:        0,
+			Today:         toPgDate(time.Now()),
+			CoordinatorID: &otherCoordinator,
+		})
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		assert.Equal(t, overdue3ID, rows[0].ID)
+		assert.Equal(t, int64(1), rows[0].TotalCount)
+	})
+}
@@ -0,0 +1,80 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================
+// Test: GetFeatureFlag / SetFeatureFlag
+// ============================================================
+
+func TestGetFeatureFlag_Unknown(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		_, err := q.GetFeatureFlag(context.Background(), "does_not_exist_"+generateTestID())
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+	})
+}
+
+func TestSetFeatureFlag(t *testing.T) {
+	tests := []struct {
+		name        string
+		initial     *SetFeatureFlagParams
+		set         SetFeatureFlagParams
+		wantEnabled bool
+		wantValue   string
+	}{
+		{
+			name:        "insert_without_value",
+			set:         SetFeatureFlagParams{Enabled: true},
+			wantEnabled: true,
+		},
+		{
+			name:        "insert_with_value",
+			set:         SetFeatureFlagParams{Enabled: true, Value: []byte(`{"hour": 8}`)},
+			wantEnabled: true,
+			wantValue:   `{"hour": 8}`,
+		},
+		{
+			name:        "update_existing",
+			initial:     &SetFeatureFlagParams{Enabled: true, Value: []byte(`{"radius_km": 25}`)},
+			set:         SetFeatureFlagParams{Enabled: false},
+			wantEnabled: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runTestWithTx(t, func(t *testing.T, q *Queries) {
+				ctx := context.Background()
+				key := "test_flag_" + generateTestID()
+
+				if tt.initial != nil {
+					tt.initial.Key = key
+					_, err := q.SetFeatureFlag(ctx, *tt.initial)
+					require.NoError(t, err)
+				}
+
+				tt.set.Key = key
+				saved, err := q.SetFeatureFlag(ctx, tt.set)
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantEnabled, saved.Enabled)
+
+				flag, err := q.GetFeatureFlag(ctx, key)
+				require.NoError(t, err)
+				assert.Equal(t, key, flag.Key)
+				assert.Equal(t, tt.wantEnabled, flag.Enabled)
+				assert.True(t, flag.UpdatedAt.Valid)
+				if tt.wantValue == "" {
+					assert.Nil(t, flag.Value)
+				} else {
+					assert.JSONEq(t, tt.wantValue, string(flag.Value))
+				}
+			})
+		})
+	}
+}
@@ -20,14 +20,16 @@ INSERT INTO incidents (
     incident_time,
     incident_type,
     incident_severity,
+    incident_category,
     location_id,
     coordinator_id,
     incident_description,
     action_taken,
     other_parties,
-    status
+    status,
+    created_by_user_id
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
 )
 `
 
@@ -38,12 +40,14 @@ type CreateIncidentParams struct {
 	IncidentTime        pgtype.Time          `json:"incident_time"`
 	IncidentType        IncidentTypeEnum     `json:"incident_type"`
 	IncidentSeverity    IncidentSeverityEnum `json:"incident_severity"`
+	IncidentCategory    IncidentCategoryEnum `json:"incident_category"`
 	LocationID          string               `json:"location_id"`
 	CoordinatorID       string               `json:"coordinator_id"`
 	IncidentDescription string               `json:"incident_description"`
 	ActionTaken         string               `json:"action_taken"`
 	OtherParties        *string              `json:"other_parties"`
 	Status              IncidentStatusEnum   `json:"status"`
+	CreatedByUserID     *string              `json:"created_by_user_id"`
 }
 
 // ============================================================
@@ -57,18 +61,20 @@ func (q *Queries) CreateIncident(ctx context.Context, arg CreateIncidentParams)
 		arg.IncidentTime,
 		arg.IncidentType,
 		arg.IncidentSeverity,
+		arg.IncidentCategory,
 		arg.LocationID,
 		arg.CoordinatorID,
 		arg.IncidentDescription,
 		arg.ActionTaken,
 		arg.OtherParties,
 		arg.Status,
+		arg.CreatedByUserID,
 	)
 	return err
 }
 
 const getIncident = `-- name: GetIncident :one
-SELECT i.id, i.client_id, i.incident_date, i.incident_time, i.incident_type, i.incident_severity, i.location_id, i.coordinator_id, i.incident_description, i.action_taken, i.other_parties, i.status, i.created_at, i.updated_at, i.is_deleted,
+SELECT i.id, i.client_id, i.incident_date, i.incident_time, i.incident_type, i.incident_severity, i.incident_category, i.location_id, i.coordinator_id, i.incident_description, i.action_taken, i.other_parties, i.status, i.created_at, i.updated_at, i.is_deleted, i.deleted_at, i.deleted_by, i.created_by_user_id, i.escalated_at,
        c.first_name AS client_first_name,
        c.last_name AS client_last_name,
        l.name AS location_name,
@@ -88,6 +94,7 @@ type GetIncidentRow struct {
 	IncidentTime         pgtype.Time          `json:"incident_time"`
 	IncidentType         IncidentTypeEnum     `json:"incident_type"`
 	IncidentSeverity     IncidentSeverityEnum `json:"incident_severity"`
+	IncidentCategory     IncidentCategoryEnum `json:"incident_category"`
 	LocationID           string               `json:"location_id"`
 	CoordinatorID        string               `json:"coordinator_id"`
 	IncidentDescription  string               `json:"incident_description"`
@@ -97,6 +104,10 @@ type GetIncidentRow struct {
 	CreatedAt            pgtype.Timestamp     `json:"created_at"`
 	UpdatedAt            pgtype.Timestamp     `json:"updated_at"`
 	IsDeleted            *bool                `json:"is_deleted"`
+	DeletedAt            pgtype.Timestamptz   `json:"deleted_at"`
+	DeletedBy            *string              `json:"deleted_by"`
+	CreatedByUserID      *string              `json:"created_by_user_id"`
+	EscalatedAt          pgtype.Timestamptz   `json:"escalated_at"`
 	ClientFirstName      string               `json:"client_first_name"`
 	ClientLastName       string               `json:"client_last_name"`
 	LocationName         string               `json:"location_name"`
@@ -114,6 +125,7 @@ func (q *Queries) GetIncident(ctx context.Context, id string) (GetIncidentRow, e
 		&i.IncidentTime,
 		&i.IncidentType,
 		&i.IncidentSeverity,
+		&i.IncidentCategory,
 		&i.LocationID,
 		&i.CoordinatorID,
 		&i.IncidentDescription,
@@ -123,6 +135,10 @@ func (q *Queries) GetIncident(ctx context.Context, id string) (GetIncidentRow, e
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.IsDeleted,
+		&i.DeletedAt,
+		&i.DeletedBy,
+		&i.CreatedByUserID,
+		&i.EscalatedAt,
 		&i.ClientFirstName,
 		&i.ClientLastName,
 		&i.LocationName,
@@ -188,8 +204,104 @@ func (q *Queries) GetIncidentStats(ctx context.Context) (GetIncidentStatsRow, er
 	return i, err
 }
 
+const getIncidentStatsByCategory = `-- name: GetIncidentStatsByCategory :many
+SELECT incident_category, COUNT(*) AS incident_count
+FROM incidents
+WHERE is_deleted = FALSE
+GROUP BY incident_category
+ORDER BY incident_count DESC, incident_category
+`
+
+type GetIncidentStatsByCategoryRow struct {
+	IncidentCategory IncidentCategoryEnum `json:"incident_category"`
+	IncidentCount    int64                `json:"incident_count"`
+}
+
+// Counts non-deleted incidents per category; categories without incidents are not returned
+func (q *Queries) GetIncidentStatsByCategory(ctx context.Context) ([]GetIncidentStatsByCategoryRow, error) {
+	rows, err := q.db.Query(ctx, getIncidentStatsByCategory)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetIncidentStatsByCategoryRow{}
+	for rows.Next() {
+		var i GetIncidentStatsByCategoryRow
+		if err := rows.Scan(&i.IncidentCategory, &i.IncidentCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getIncidentTrendByMonth = `-- name: GetIncidentTrendByMonth :many
+SELECT
+    m.month::date AS month,
+    COUNT(i.id) FILTER (WHERE i.incident_severity = 'minor') AS minor_count,
+    COUNT(i.id) FILTER (WHERE i.incident_severity = 'moderate') AS moderate_count,
+    COUNT(i.id) FILTER (WHERE i.incident_severity = 'severe') AS severe_count,
+    COUNT(i.id) AS total_count
+FROM generate_series(
+    $1::date - make_interval(months => $2::int - 1),
+    $1::date,
+    INTERVAL '1 month'
+) AS m(month)
+LEFT JOIN incidents i
+    ON i.is_deleted = FALSE
+   AND i.incident_date >= m.month
+   AND i.incident_date < m.month + INTERVAL '1 month'
+GROUP BY m.month
+ORDER BY m.month ASC
+`
+
+type GetIncidentTrendByMonthParams struct {
+	CurrentMonth pgtype.Date `json:"current_month"`
+	Months       int32       `json:"months"`
+}
+
+type GetIncidentTrendByMonthRow struct {
+	Month         pgtype.Date `json:"month"`
+	MinorCount    int64       `json:"minor_count"`
+	ModerateCount int64       `json:"moderate_count"`
+	SevereCount   int64       `json:"severe_count"`
+	TotalCount    int64       `json:"total_count"`
+}
+
+// Non-deleted incidents per calendar month and severity over the last @months months,
+// ending with the month starting at @current_month, oldest first. Months without
+// incidents are included.
+func (q *Queries) GetIncidentTrendByMonth(ctx context.Context, arg GetIncidentTrendByMonthParams) ([]GetIncidentTrendByMonthRow, error) {
+	rows, err := q.db.Query(ctx, getIncidentTrendByMonth, arg.CurrentMonth, arg.Months)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetIncidentTrendByMonthRow{}
+	for rows.Next() {
+		var i GetIncidentTrendByMonthRow
+		if err := rows.Scan(
+			&i.Month,
+			&i.MinorCount,
+			&i.ModerateCount,
+			&i.SevereCount,
+			&i.TotalCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listIncidents = `-- name: ListIncidents :many
-SELECT i.id, i.client_id, i.incident_date, i.incident_time, i.incident_type, i.incident_severity, i.location_id, i.coordinator_id, i.incident_description, i.action_taken, i.other_parties, i.status, i.created_at, i.updated_at, i.is_deleted,
+SELECT i.id, i.client_id, i.incident_date, i.incident_time, i.incident_type, i.incident_severity, i.incident_category, i.location_id, i.coordinator_id, i.incident_description, i.action_taken, i.other_parties, i.status, i.created_at, i.updated_at, i.is_deleted, i.deleted_at, i.deleted_by, i.created_by_user_id, i.escalated_at,
        c.first_name AS client_first_name,
        c.last_name AS client_last_name,
        l.name AS location_name,
@@ -224,6 +336,7 @@ type ListIncidentsRow struct {
 	IncidentTime         pgtype.Time          `json:"incident_time"`
 	IncidentType         IncidentTypeEnum     `json:"incident_type"`
 	IncidentSeverity     IncidentSeverityEnum `json:"incident_severity"`
+	IncidentCategory     IncidentCategoryEnum `json:"incident_category"`
 	LocationID           string               `json:"location_id"`
 	CoordinatorID        string               `json:"coordinator_id"`
 	IncidentDescription  string               `json:"incident_description"`
@@ -233,6 +346,10 @@ type ListIncidentsRow struct {
 	CreatedAt            pgtype.Timestamp     `json:"created_at"`
 	UpdatedAt            pgtype.Timestamp     `json:"updated_at"`
 	IsDeleted            *bool                `json:"is_deleted"`
+	DeletedAt            pgtype.Timestamptz   `json:"deleted_at"`
+	DeletedBy            *string              `json:"deleted_by"`
+	CreatedByUserID      *string              `json:"created_by_user_id"`
+	EscalatedAt          pgtype.Timestamptz   `json:"escalated_at"`
 	ClientFirstName      string               `json:"client_first_name"`
 	ClientLastName       string               `json:"client_last_name"`
 	LocationName         string               `json:"location_name"`
@@ -257,6 +374,7 @@ func (q *Queries) ListIncidents(ctx context.Context, arg ListIncidentsParams) ([
 			&i.IncidentTime,
 			&i.IncidentType,
 			&i.IncidentSeverity,
+			&i.IncidentCategory,
 			&i.LocationID,
 			&i.CoordinatorID,
 			&i.IncidentDescription,
@@ -266,6 +384,10 @@ func (q *Queries) ListIncidents(ctx context.Context, arg ListIncidentsParams) ([
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.IsDeleted,
+			&i.DeletedAt,
+			&i.DeletedBy,
+			&i.CreatedByUserID,
+			&i.EscalatedAt,
 			&i.ClientFirstName,
 			&i.ClientLastName,
 			&i.LocationName,
@@ -283,17 +405,166 @@ func (q *Queries) ListIncidents(ctx context.Context, arg ListIncidentsParams) ([
 	return items, nil
 }
 
-const softDeleteIncident = `-- name: SoftDeleteIncident :exec
+const listOverdueIncidents = `-- name: ListOverdueIncidents :many
+SELECT i.id, i.client_id, i.incident_severity, i.status, i.created_at,
+       c.first_name AS client_first_name,
+       c.last_name AS client_last_name,
+       c.organization_id
+FROM incidents i
+JOIN clients c ON c.id = i.client_id
+WHERE i.escalated_at IS NULL
+  AND i.is_deleted = FALSE
+  AND i.status <> 'completed'
+  AND (
+    (i.incident_severity = 'severe' AND $1::int > 0
+      AND i.created_at <= NOW() - make_interval(hours => $1::int)) OR
+    (i.incident_severity = 'moderate' AND $2::int > 0
+      AND i.created_at <= NOW() - make_interval(hours => $2::int)) OR
+    (i.incident_severity = 'minor' AND $3::int > 0
+      AND i.created_at <= NOW() - make_interval(hours => $3::int))
+  )
+ORDER BY i.created_at, i.id
+`
+
+type ListOverdueIncidentsParams struct {
+	SevereHours   int32 `json:"severe_hours"`
+	ModerateHours int32 `json:"moderate_hours"`
+	MinorHours    int32 `json:"minor_hours"`
+}
+
+type ListOverdueIncidentsRow struct {
+	ID               string               `json:"id"`
+	ClientID         string               `json:"client_id"`
+	IncidentSeverity IncidentSeverityEnum `json:"incident_severity"`
+	Status           IncidentStatusEnum   `json:"status"`
+	CreatedAt        pgtype.Timestamp     `json:"created_at"`
+	ClientFirstName  string               `json:"client_first_name"`
+	ClientLastName   string               `json:"client_last_name"`
+	OrganizationID   *string              `json:"organization_id"`
+}
+
+// Lists unresolved incidents that have been open longer than their severity's
+// SLA and are not escalated yet, oldest first. An SLA of 0 hours never
+// escalates that severity.
+func (q *Queries) ListOverdueIncidents(ctx context.Context, arg ListOverdueIncidentsParams) ([]ListOverdueIncidentsRow, error) {
+	rows, err := q.db.Query(ctx, listOverdueIncidents, arg.SevereHours, arg.ModerateHours, arg.MinorHours)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListOverdueIncidentsRow{}
+	for rows.Next() {
+		var i ListOverdueIncidentsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ClientID,
+			&i.IncidentSeverity,
+			&i.Status,
+			&i.CreatedAt,
+			&i.ClientFirstName,
+			&i.ClientLastName,
+			&i.OrganizationID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUnresolvedIncidentsByClient = `-- name: ListUnresolvedIncidentsByClient :many
+SELECT id, incident_date, incident_type, incident_severity, status
+FROM incidents
+WHERE client_id = $1
+  AND status <> 'completed'
+  AND is_deleted = FALSE
+ORDER BY incident_date
+`
+
+type ListUnresolvedIncidentsByClientRow struct {
+	ID               string               `json:"id"`
+	IncidentDate     pgtype.Date          `json:"incident_date"`
+	IncidentType     IncidentTypeEnum     `json:"incident_type"`
+	IncidentSeverity IncidentSeverityEnum `json:"incident_severity"`
+	Status           IncidentStatusEnum   `json:"status"`
+}
+
+func (q *Queries) ListUnresolvedIncidentsByClient(ctx context.Context, clientID string) ([]ListUnresolvedIncidentsByClientRow, error) {
+	rows, err := q.db.Query(ctx, listUnresolvedIncidentsByClient, clientID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListUnresolvedIncidentsByClientRow{}
+	for rows.Next() {
+		var i ListUnresolvedIncidentsByClientRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.IncidentDate,
+			&i.IncidentType,
+			&i.IncidentSeverity,
+			&i.Status,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockUnescalatedIncident = `-- name: LockUnescalatedIncident :one
+SELECT id FROM incidents
+WHERE id = $1 AND escalated_at IS NULL
+FOR UPDATE SKIP LOCKED
+`
+
+// Locks an incident that is not escalated yet for the rest of the transaction.
+// Incidents that are escalated or locked by another replica return no rows.
+func (q *Queries) LockUnescalatedIncident(ctx context.Context, id string) (string, error) {
+	row := q.db.QueryRow(ctx, lockUnescalatedIncident, id)
+	err := row.Scan(&id)
+	return id, err
+}
+
+const markIncidentEscalated = `-- name: MarkIncidentEscalated :exec
+UPDATE incidents
+SET escalated_at = CURRENT_TIMESTAMP
+WHERE id = $1
+`
+
+func (q *Queries) MarkIncidentEscalated(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, markIncidentEscalated, id)
+	return err
+}
+
+const softDeleteIncident = `-- name: SoftDeleteIncident :one
 UPDATE incidents
 SET 
     is_deleted = TRUE,
+    deleted_at = CURRENT_TIMESTAMP,
+    deleted_by = $2,
     updated_at = CURRENT_TIMESTAMP
-WHERE id = $1
+WHERE id = $1 AND is_deleted = FALSE
+RETURNING client_id
 `
 
-func (q *Queries) SoftDeleteIncident(ctx context.Context, id string) error {
-	_, err := q.db.Exec(ctx, softDeleteIncident, id)
-	return err
+type SoftDeleteIncidentParams struct {
+	ID        string  `json:"id"`
+	DeletedBy *string `json:"deleted_by"`
+}
+
+// Returns pgx.ErrNoRows when the incident does not exist or is already deleted
+func (q *Queries) SoftDeleteIncident(ctx context.Context, arg SoftDeleteIncidentParams) (string, error) {
+	row := q.db.QueryRow(ctx, softDeleteIncident, arg.ID, arg.DeletedBy)
+	var client_id string
+	err := row.Scan(&client_id)
+	return client_id, err
 }
 
 const updateIncident = `-- name: UpdateIncident :exec
@@ -303,15 +574,16 @@ SET
     incident_time = COALESCE($3::TIME, incident_time),
     incident_type = COALESCE($4::incident_type_enum, incident_type),
     incident_severity = COALESCE($5::incident_severity_enum, incident_severity),
-    location_id = COALESCE($6::TEXT, location_id),
-    coordinator_id = COALESCE($7::TEXT, coordinator_id),
-    incident_description = COALESCE($8::TEXT, incident_description),
-    action_taken = COALESCE($9::TEXT, action_taken),
+    incident_category = COALESCE($6::incident_category_enum, incident_category),
+    location_id = COALESCE($7::TEXT, location_id),
+    coordinator_id = COALESCE($8::TEXT, coordinator_id),
+    incident_description = COALESCE($9::TEXT, incident_description),
+    action_taken = COALESCE($10::TEXT, action_taken),
     other_parties = CASE 
-        WHEN $10::TEXT = '' THEN NULL
-        ELSE COALESCE($10::TEXT, other_parties)
+        WHEN $11::TEXT = '' THEN NULL
+        ELSE COALESCE($11::TEXT, other_parties)
     END,
-    status = COALESCE($11::incident_status_enum, status),
+    status = COALESCE($12::incident_status_enum, status),
     updated_at = CURRENT_TIMESTAMP
 WHERE id = $1 AND is_deleted = FALSE
 `
@@ -322,6 +594,7 @@ type UpdateIncidentParams struct {
 	IncidentTime        pgtype.Time              `json:"incident_time"`
 	IncidentType        NullIncidentTypeEnum     `json:"incident_type"`
 	IncidentSeverity    NullIncidentSeverityEnum `json:"incident_severity"`
+	IncidentCategory    NullIncidentCategoryEnum `json:"incident_category"`
 	LocationID          *string                  `json:"location_id"`
 	CoordinatorID       *string                  `json:"coordinator_id"`
 	IncidentDescription *string                  `json:"incident_description"`
@@ -337,6 +610,7 @@ func (q *Queries) UpdateIncident(ctx context.Context, arg UpdateIncidentParams)
 		arg.IncidentTime,
 		arg.IncidentType,
 		arg.IncidentSeverity,
+		arg.IncidentCategory,
 		arg.LocationID,
 		arg.CoordinatorID,
 		arg.IncidentDescription,
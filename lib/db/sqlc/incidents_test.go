@@ -34,6 +34,7 @@ func TestCreateIncident(t *testing.T) {
 					IncidentTime:        toPgTime(time.Now()),
 					IncidentType:        IncidentTypeEnumAggression,
 					IncidentSeverity:    IncidentSeverityEnumMinor,
+					IncidentCategory:    IncidentCategoryEnumAggression,
 					LocationID:          deps.LocationID,
 					CoordinatorID:       deps.EmployeeID,
 					IncidentDescription: "Test description",
@@ -49,6 +50,7 @@ func TestCreateIncident(t *testing.T) {
 				assert.Equal(t, params.ID, incident.ID)
 				assert.Equal(t, params.ClientID, incident.ClientID)
 				assert.Equal(t, params.IncidentDescription, incident.IncidentDescription)
+				assert.Equal(t, IncidentCategoryEnumAggression, incident.IncidentCategory)
 			},
 		},
 		{
@@ -127,6 +129,124 @@ func TestCreateIncident(t *testing.T) {
 	}
 }
 
+// ============================================================
+// Test: ListOverdueIncidents
+// ============================================================
+
+func TestListOverdueIncidents(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		clientID, deps := CreateTestClientWithDependencies(t, q)
+		create := func(severity IncidentSeverityEnum, status IncidentStatusEnum, ageHours int) string {
+			id := CreateTestIncident(t, q, CreateTestIncidentOptions{
+				ClientID:         clientID,
+				LocationID:       deps.LocationID,
+				CoordinatorID:    deps.EmployeeID,
+				IncidentSeverity: &severity,
+				Status:           &status,
+			})
+			_, err := q.db.Exec(ctx,
+				"UPDATE incidents SET created_at = NOW() - make_interval(hours => $2::int) WHERE id = $1",
+				id, ageHours)
+			require.NoError(t, err)
+			return id
+		}
+
+		overdueID := create(IncidentSeverityEnumSevere, IncidentStatusEnumPending, 30)
+		// Within the severe SLA
+		create(IncidentSeverityEnumSevere, IncidentStatusEnumUnderInvestigation, 2)
+		// Resolved incidents are never escalated
+		create(IncidentSeverityEnumSevere, IncidentStatusEnumCompleted, 30)
+		// Minor incidents have no SLA here
+		create(IncidentSeverityEnumMinor, IncidentStatusEnumPending, 500)
+		deletedID := create(IncidentSeverityEnumSevere, IncidentStatusEnumPending, 30)
+		_, err := q.SoftDeleteIncident(ctx, SoftDeleteIncidentParams{ID: deletedID})
+		require.NoError(t, err)
+
+		params := ListOverdueIncidentsParams{SevereHours: 24, ModerateHours: 72, MinorHours: 0}
+		overdue, err := q.ListOverdueIncidents(ctx, params)
+		require.NoError(t, err)
+		require.Len(t, overdue, 1)
+		assert.Equal(t, overdueID, overdue[0].ID)
+		assert.Equal(t, IncidentSeverityEnumSevere, overdue[0].IncidentSeverity)
+		assert.NotEmpty(t, overdue[0].ClientFirstName)
+
+		_, err = q.LockUnescalatedIncident(ctx, overdueID)
+		require.NoError(t, err)
+		require.NoError(t, q.MarkIncidentEscalated(ctx, overdueID))
+
+		incident, err := q.GetIncident(ctx, overdueID)
+		require.NoError(t, err)
+		assert.True(t, incident.EscalatedAt.Valid)
+
+		// An escalated incident is neither listed nor locked again
+		overdue, err = q.ListOverdueIncidents(ctx, params)
+		require.NoError(t, err)
+		assert.Empty(t, overdue)
+		_, err = q.LockUnescalatedIncident(ctx, overdueID)
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+	})
+}
+
+// EscalateIncidentTx opens its own transaction, so this test runs against
+// testStore directly instead of inside runTestWithTx.
+func TestEscalateIncidentTx_StoresNotificationsOnce(t *testing.T) {
+	ctx := context.Background()
+	q := testStore.Queries
+
+	clientID, deps := createCommittedClient(t, ClientStatusEnumInCare, nil)
+	roleName := "escalation-" + generateTestID()
+	roleID := CreateTestRole(t, q, CreateTestRoleOptions{Name: &roleName})
+	deleteAfterTest(t, "roles", roleID)
+	require.NoError(t, q.AssignRoleToUser(ctx, AssignRoleToUserParams{UserID: deps.UserID, RoleID: roleID}))
+
+	severity := IncidentSeverityEnumSevere
+	incidentID := CreateTestIncident(t, q, CreateTestIncidentOptions{
+		ClientID:         clientID,
+		LocationID:       deps.LocationID,
+		CoordinatorID:    deps.EmployeeID,
+		IncidentSeverity: &severity,
+	})
+	deleteAfterTest(t, "incidents", incidentID)
+
+	resourceType := "incident"
+	escalate := func() (EscalateIncidentTxResult, error) {
+		return testStore.EscalateIncidentTx(ctx, EscalateIncidentTxParams{
+			IncidentID: incidentID,
+			Role:       roleName,
+			Notification: func(userID string) CreateNotificationParams {
+				return CreateNotificationParams{
+					ID:           generateTestID(),
+					UserID:       userID,
+					Type:         NotificationTypeEnumIncidentEscalated,
+					Priority:     NotificationPriorityEnumUrgent,
+					Title:        "Incident Escalated",
+					Message:      "Incident is still unresolved",
+					ResourceType: &resourceType,
+					ResourceID:   &incidentID,
+				}
+			},
+		})
+	}
+
+	result, err := escalate()
+	require.NoError(t, err)
+	require.Len(t, result.Notifications, 1)
+	assert.Equal(t, deps.UserID, result.Notifications[0].UserID)
+
+	incident, err := q.GetIncident(ctx, incidentID)
+	require.NoError(t, err)
+	assert.True(t, incident.EscalatedAt.Valid)
+
+	// A second run finds the incident escalated and stores nothing
+	_, err = escalate()
+	assert.ErrorIs(t, err, ErrIncidentAlreadyEscalated)
+
+	unread, err := q.GetUnreadCount(ctx, deps.UserID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), unread)
+}
+
 // ============================================================
 // Test: GetIncident
 // ============================================================
@@ -176,7 +296,7 @@ func TestGetIncident(t *testing.T) {
 					LocationID:    deps.LocationID,
 					CoordinatorID: deps.EmployeeID,
 				})
-				err := q.SoftDeleteIncident(context.Background(), id)
+				_, err := q.SoftDeleteIncident(context.Background(), SoftDeleteIncidentParams{ID: id})
 				if err != nil {
 					t.Fatalf("failed to soft delete: %v", err)
 				}
@@ -272,7 +392,7 @@ func TestGetIncidentStats(t *testing.T) {
 					LocationID:    deps.LocationID,
 					CoordinatorID: deps.EmployeeID,
 				})
-				q.SoftDeleteIncident(context.Background(), id)
+				q.SoftDeleteIncident(context.Background(), SoftDeleteIncidentParams{ID: id})
 
 				// 1 Unwanted Behavior
 				CreateTestIncident(t, q, CreateTestIncidentOptions{
@@ -319,6 +439,89 @@ func TestGetIncidentStats(t *testing.T) {
 	}
 }
 
+// ============================================================
+// Test: GetIncidentStatsByCategory
+// ============================================================
+
+func TestGetIncidentStatsByCategory(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		clientID, deps := CreateTestClientWithDependencies(t, q)
+		createWithCategory := func(category IncidentCategoryEnum) string {
+			return CreateTestIncident(t, q, CreateTestIncidentOptions{
+				ClientID:         clientID,
+				LocationID:       deps.LocationID,
+				CoordinatorID:    deps.EmployeeID,
+				IncidentCategory: &category,
+			})
+		}
+
+		createWithCategory(IncidentCategoryEnumFall)
+		createWithCategory(IncidentCategoryEnumFall)
+		createWithCategory(IncidentCategoryEnumMedication)
+		deletedID := createWithCategory(IncidentCategoryEnumAbsconding)
+		_, err := q.SoftDeleteIncident(ctx, SoftDeleteIncidentParams{ID: deletedID})
+		require.NoError(t, err)
+
+		stats, err := q.GetIncidentStatsByCategory(ctx)
+		require.NoError(t, err)
+
+		// Deleted incidents and empty categories are left out, busiest category first
+		assert.Equal(t, []GetIncidentStatsByCategoryRow{
+			{IncidentCategory: IncidentCategoryEnumFall, IncidentCount: 2},
+			{IncidentCategory: IncidentCategoryEnumMedication, IncidentCount: 1},
+		}, stats)
+	})
+}
+
+// ============================================================
+// Test: GetIncidentTrendByMonth
+// ============================================================
+
+func TestGetIncidentTrendByMonth(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		clientID, deps := CreateTestClientWithDependencies(t, q)
+		create := func(on time.Time, severity IncidentSeverityEnum) string {
+			return CreateTestIncident(t, q, CreateTestIncidentOptions{
+				ClientID:         clientID,
+				LocationID:       deps.LocationID,
+				CoordinatorID:    deps.EmployeeID,
+				IncidentDate:     &on,
+				IncidentSeverity: &severity,
+			})
+		}
+		day := func(month time.Month, d int) time.Time {
+			return time.Date(2025, month, d, 0, 0, 0, 0, time.UTC)
+		}
+
+		// First and last day of January land in the same bucket
+		create(day(time.January, 1), IncidentSeverityEnumMinor)
+		create(day(time.January, 31), IncidentSeverityEnumSevere)
+		create(day(time.January, 15), IncidentSeverityEnumSevere)
+		create(day(time.February, 3), IncidentSeverityEnumModerate)
+		create(day(time.February, 28), IncidentSeverityEnumMinor)
+		// Deleted incidents are not counted
+		deletedID := create(day(time.February, 10), IncidentSeverityEnumSevere)
+		_, err := q.SoftDeleteIncident(ctx, SoftDeleteIncidentParams{ID: deletedID})
+		require.NoError(t, err)
+		// Outside the two requested months
+		create(time.Date(2024, time.December, 31, 0, 0, 0, 0, time.UTC), IncidentSeverityEnumSevere)
+		create(day(time.March, 1), IncidentSeverityEnumMinor)
+
+		rows, err := q.GetIncidentTrendByMonth(ctx, GetIncidentTrendByMonthParams{
+			CurrentMonth: toPgDate(day(time.February, 1)),
+			Months:       2,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, []GetIncidentTrendByMonthRow{
+			{Month: toPgDate(day(time.January, 1)), MinorCount: 1, ModerateCount: 0, SevereCount: 2, TotalCount: 3},
+			{Month: toPgDate(day(time.February, 1)), MinorCount: 1, ModerateCount: 1, SevereCount: 0, TotalCount: 2},
+		}, rows)
+	})
+}
+
 // ============================================================
 // Test: ListIncidents
 // ============================================================
@@ -400,13 +603,45 @@ func TestListIncidents(t *testing.T) {
 					LocationID:    deps.LocationID,
 					CoordinatorID: deps.EmployeeID,
 				})
-				q.SoftDeleteIncident(context.Background(), id)
+				q.SoftDeleteIncident(context.Background(), SoftDeleteIncidentParams{ID: id})
 			},
 			params: ListIncidentsParams{Limit: 10, Offset: 0},
 			validate: func(t *testing.T, results []ListIncidentsRow) {
 				assert.Len(t, results, 0)
 			},
 		},
+		{
+			name: "excludes_deleted_keeps_others",
+			setup: func(t *testing.T, q *Queries) {
+				clientID, deps := CreateTestClientWithDependencies(t, q)
+				for i := 0; i < 3; i++ {
+					CreateTestIncident(t, q, CreateTestIncidentOptions{
+						ClientID:      clientID,
+						LocationID:    deps.LocationID,
+						CoordinatorID: deps.EmployeeID,
+					})
+				}
+				id := CreateTestIncident(t, q, CreateTestIncidentOptions{
+					ClientID:      clientID,
+					LocationID:    deps.LocationID,
+					CoordinatorID: deps.EmployeeID,
+				})
+				_, err := q.SoftDeleteIncident(context.Background(), SoftDeleteIncidentParams{
+					ID:        id,
+					DeletedBy: &deps.UserID,
+				})
+				require.NoError(t, err)
+			},
+			params: ListIncidentsParams{Limit: 10, Offset: 0},
+			validate: func(t *testing.T, results []ListIncidentsRow) {
+				assert.Len(t, results, 3)
+				assert.Equal(t, int64(3), results[0].TotalCount)
+				for _, r := range results {
+					assert.False(t, r.DeletedAt.Valid)
+					assert.Nil(t, r.DeletedBy)
+				}
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -434,12 +669,20 @@ func TestSoftDeleteIncident(t *testing.T) {
 			CoordinatorID: deps.EmployeeID,
 		})
 
-		err := q.SoftDeleteIncident(context.Background(), id)
+		deletedClientID, err := q.SoftDeleteIncident(context.Background(), SoftDeleteIncidentParams{
+			ID:        id,
+			DeletedBy: &deps.UserID,
+		})
 		require.NoError(t, err)
+		assert.Equal(t, clientID, deletedClientID)
 
 		// Verify it's not returned by GetIncident
 		_, err = q.GetIncident(context.Background(), id)
 		assert.True(t, errors.Is(err, pgx.ErrNoRows))
+
+		// Deleting it again reports that there is nothing to delete
+		_, err = q.SoftDeleteIncident(context.Background(), SoftDeleteIncidentParams{ID: id})
+		assert.True(t, errors.Is(err, pgx.ErrNoRows))
 	})
 }
 
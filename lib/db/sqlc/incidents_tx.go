@@ -0,0 +1,68 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// ErrIncidentAlreadyEscalated is returned when another run escalated the
+// incident first, or is escalating it right now
+var ErrIncidentAlreadyEscalated = errors.New("incident already escalated")
+
+type EscalateIncidentTxParams struct {
+	IncidentID string
+	// Organization of the incident's client; only its users are notified
+	OrganizationID *string
+	// Role whose users are notified
+	Role string
+	// Notification builds the escalation notification stored for a user
+	// holding Role
+	Notification func(userID string) CreateNotificationParams
+}
+
+type EscalateIncidentTxResult struct {
+	// Notifications stored for the role's users, to deliver once committed
+	Notifications []Notification
+}
+
+// EscalateIncidentTx stores the escalation notifications for an overdue
+// incident's escalation role and marks the incident as escalated in one
+// transaction, so an incident is either escalated with its notifications or
+// not at all. The incident stays locked meanwhile, so concurrent runs cannot
+// both escalate it.
+func (s *Store) EscalateIncidentTx(ctx context.Context, arg EscalateIncidentTxParams) (EscalateIncidentTxResult, error) {
+	var result EscalateIncidentTxResult
+
+	err := s.ExecTx(ctx, func(q *Queries) error {
+		// Step 1: Lock the incident, unless it was escalated in the meantime
+		if _, err := q.LockUnescalatedIncident(ctx, arg.IncidentID); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrIncidentAlreadyEscalated
+			}
+			return err
+		}
+
+		// Step 2: Notify the role's users within the incident's organization
+		userIDs, err := q.GetOrganizationUserIDsByRoleName(ctx, GetOrganizationUserIDsByRoleNameParams{
+			RoleName:       arg.Role,
+			OrganizationID: arg.OrganizationID,
+		})
+		if err != nil {
+			return err
+		}
+		for _, userID := range userIDs {
+			notification, err := q.CreateNotification(ctx, arg.Notification(userID))
+			if err != nil {
+				return err
+			}
+			result.Notifications = append(result.Notifications, notification)
+		}
+
+		// Step 3: Mark the incident as escalated
+		return q.MarkIncidentEscalated(ctx, arg.IncidentID)
+	})
+
+	return result, err
+}
@@ -0,0 +1,255 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createTestIntakeWithChecklist creates an intake for a registration of the given
+// care type and builds its document checklist.
+func createTestIntakeWithChecklist(t *testing.T, q *Queries, careType CareTypeEnum) string {
+	t.Helper()
+
+	userID := CreateTestUser(t, q, CreateTestUserOptions{})
+	locationID := CreateTestLocation(t, q, CreateTestLocationOptions{})
+	employeeID := CreateTestEmployee(t, q, CreateTestEmployeeOptions{UserID: userID})
+	regFormID := CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{
+		CareType: &careType,
+	})
+	intakeID := CreateTestIntakeForm(t, q, CreateTestIntakeFormOptions{
+		RegistrationFormID: regFormID,
+		LocationID:         locationID,
+		CoordinatorID:      employeeID,
+	})
+
+	err := q.CreateIntakeDocumentChecklist(context.Background(), intakeID)
+	require.NoError(t, err)
+
+	return intakeID
+}
+
+// ============================================================
+// Test: CreateIntakeDocumentChecklist
+// ============================================================
+
+func TestCreateIntakeDocumentChecklist(t *testing.T) {
+	tests := []struct {
+		name     string
+		careType CareTypeEnum
+		want     []IntakeDocumentTypeEnum
+	}{
+		{
+			name:     "protected_living_requires_all",
+			careType: CareTypeEnumProtectedLiving,
+			want: []IntakeDocumentTypeEnum{
+				IntakeDocumentTypeEnumIdentification,
+				IntakeDocumentTypeEnumCareIndication,
+				IntakeDocumentTypeEnumConsent,
+			},
+		},
+		{
+			name:     "ambulatory_care_skips_care_indication",
+			careType: CareTypeEnumAmbulatoryCare,
+			want: []IntakeDocumentTypeEnum{
+				IntakeDocumentTypeEnumIdentification,
+				IntakeDocumentTypeEnumConsent,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runTestWithTx(t, func(t *testing.T, q *Queries) {
+				ctx := context.Background()
+				intakeID := createTestIntakeWithChecklist(t, q, tt.careType)
+
+				docs, err := q.ListIntakeDocuments(ctx, intakeID)
+				require.NoError(t, err)
+
+				got := make([]IntakeDocumentTypeEnum, len(docs))
+				for i, doc := range docs {
+					got[i] = doc.DocumentType
+					assert.Equal(t, IntakeDocumentStatusEnumMissing, doc.Status)
+					assert.False(t, doc.ReceivedAt.Valid)
+				}
+				assert.Equal(t, tt.want, got)
+			})
+		})
+	}
+}
+
+func TestCreateIntakeDocumentChecklist_Idempotent(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		intakeID := createTestIntakeWithChecklist(t, q, CareTypeEnumProtectedLiving)
+
+		_, err := q.UpdateIntakeDocumentStatus(ctx, UpdateIntakeDocumentStatusParams{
+			Status:       IntakeDocumentStatusEnumReceived,
+			IntakeFormID: intakeID,
+			DocumentType: IntakeDocumentTypeEnumConsent,
+		})
+		require.NoError(t, err)
+
+		// Rebuilding the checklist neither duplicates nor resets items
+		err = q.CreateIntakeDocumentChecklist(ctx, intakeID)
+		require.NoError(t, err)
+
+		missing, err := q.CountMissingIntakeDocuments(ctx, intakeID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), missing)
+	})
+}
+
+// ============================================================
+// Test: UpdateIntakeDocumentStatus
+// ============================================================
+
+func TestUpdateIntakeDocumentStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		run      func(t *testing.T, q *Queries, intakeID string) (IntakeRequiredDocument, error)
+		wantErr  bool
+		checkErr func(t *testing.T, err error)
+		validate func(t *testing.T, doc IntakeRequiredDocument)
+	}{
+		{
+			name: "mark_received",
+			run: func(t *testing.T, q *Queries, intakeID string) (IntakeRequiredDocument, error) {
+				userID := CreateTestUser(t, q, CreateTestUserOptions{})
+				return q.UpdateIntakeDocumentStatus(context.Background(), UpdateIntakeDocumentStatusParams{
+					Status:          IntakeDocumentStatusEnumReceived,
+					UpdatedByUserID: &userID,
+					IntakeFormID:    intakeID,
+					DocumentType:    IntakeDocumentTypeEnumIdentification,
+				})
+			},
+			validate: func(t *testing.T, doc IntakeRequiredDocument) {
+				assert.Equal(t, IntakeDocumentStatusEnumReceived, doc.Status)
+				assert.True(t, doc.ReceivedAt.Valid)
+				assert.NotNil(t, doc.UpdatedByUserID)
+			},
+		},
+		{
+			name: "received_at_kept_on_repeat",
+			run: func(t *testing.T, q *Queries, intakeID string) (IntakeRequiredDocument, error) {
+				ctx := context.Background()
+				params := UpdateIntakeDocumentStatusParams{
+					Status:       IntakeDocumentStatusEnumReceived,
+					IntakeFormID: intakeID,
+					DocumentType: IntakeDocumentTypeEnumConsent,
+				}
+				first, err := q.UpdateIntakeDocumentStatus(ctx, params)
+				require.NoError(t, err)
+
+				second, err := q.UpdateIntakeDocumentStatus(ctx, params)
+				require.NoError(t, err)
+				assert.Equal(t, first.ReceivedAt.Time, second.ReceivedAt.Time)
+				return second, nil
+			},
+			validate: func(t *testing.T, doc IntakeRequiredDocument) {
+				assert.True(t, doc.ReceivedAt.Valid)
+			},
+		},
+		{
+			name: "mark_missing_clears_received_at",
+			run: func(t *testing.T, q *Queries, intakeID string) (IntakeRequiredDocument, error) {
+				ctx := context.Background()
+				_, err := q.UpdateIntakeDocumentStatus(ctx, UpdateIntakeDocumentStatusParams{
+					Status:       IntakeDocumentStatusEnumReceived,
+					IntakeFormID: intakeID,
+					DocumentType: IntakeDocumentTypeEnumConsent,
+				})
+				require.NoError(t, err)
+
+				return q.UpdateIntakeDocumentStatus(ctx, UpdateIntakeDocumentStatusParams{
+					Status:       IntakeDocumentStatusEnumMissing,
+					IntakeFormID: intakeID,
+					DocumentType: IntakeDocumentTypeEnumConsent,
+				})
+			},
+			validate: func(t *testing.T, doc IntakeRequiredDocument) {
+				assert.Equal(t, IntakeDocumentStatusEnumMissing, doc.Status)
+				assert.False(t, doc.ReceivedAt.Valid)
+			},
+		},
+		{
+			name: "document_not_required_for_care_type",
+			run: func(t *testing.T, q *Queries, _ string) (IntakeRequiredDocument, error) {
+				intakeID := createTestIntakeWithChecklist(t, q, CareTypeEnumAmbulatoryCare)
+				return q.UpdateIntakeDocumentStatus(context.Background(), UpdateIntakeDocumentStatusParams{
+					Status:       IntakeDocumentStatusEnumReceived,
+					IntakeFormID: intakeID,
+					DocumentType: IntakeDocumentTypeEnumCareIndication,
+				})
+			},
+			wantErr: true,
+			checkErr: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, pgx.ErrNoRows))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runTestWithTx(t, func(t *testing.T, q *Queries) {
+				intakeID := createTestIntakeWithChecklist(t, q, CareTypeEnumProtectedLiving)
+
+				doc, err := tt.run(t, q, intakeID)
+				if tt.wantErr {
+					require.Error(t, err)
+					if tt.checkErr != nil {
+						tt.checkErr(t, err)
+					}
+					return
+				}
+
+				require.NoError(t, err)
+				if tt.validate != nil {
+					tt.validate(t, doc)
+				}
+			})
+		})
+	}
+}
+
+// ============================================================
+// Test: completeness indicator
+// ============================================================
+
+func TestIntakeDocumentsComplete(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		intakeID := createTestIntakeWithChecklist(t, q, CareTypeEnumAmbulatoryCare)
+
+		assertState := func(wantMissing int64) {
+			t.Helper()
+			missing, err := q.CountMissingIntakeDocuments(ctx, intakeID)
+			require.NoError(t, err)
+			assert.Equal(t, wantMissing, missing)
+
+			details, err := q.GetIntakeFormWithDetails(ctx, intakeID)
+			require.NoError(t, err)
+			assert.Equal(t, wantMissing == 0, details.DocumentsComplete)
+		}
+
+		assertState(2)
+
+		for i, docType := range []IntakeDocumentTypeEnum{
+			IntakeDocumentTypeEnumIdentification,
+			IntakeDocumentTypeEnumConsent,
+		} {
+			_, err := q.UpdateIntakeDocumentStatus(ctx, UpdateIntakeDocumentStatusParams{
+				Status:       IntakeDocumentStatusEnumReceived,
+				IntakeFormID: intakeID,
+				DocumentType: docType,
+			})
+			require.NoError(t, err)
+			assertState(int64(1 - i))
+		}
+	})
+}
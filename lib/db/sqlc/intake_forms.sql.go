@@ -27,9 +27,10 @@ INSERT INTO intake_forms (
     limitations,
     focus_areas,
     notes,
-    evaluation_interval_weeks
+    evaluation_interval_weeks,
+    created_by_user_id
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
 )
 `
 
@@ -46,6 +47,7 @@ type CreateIntakeFormParams struct {
 	FocusAreas              *string     `json:"focus_areas"`
 	Notes                   *string     `json:"notes"`
 	EvaluationIntervalWeeks *int32      `json:"evaluation_interval_weeks"`
+	CreatedByUserID         *string     `json:"created_by_user_id"`
 }
 
 // ============================================================
@@ -65,12 +67,13 @@ func (q *Queries) CreateIntakeForm(ctx context.Context, arg CreateIntakeFormPara
 		arg.FocusAreas,
 		arg.Notes,
 		arg.EvaluationIntervalWeeks,
+		arg.CreatedByUserID,
 	)
 	return err
 }
 
 const getIntakeForm = `-- name: GetIntakeForm :one
-SELECT id, registration_form_id, intake_date, intake_time, location_id, coordinator_id, family_situation, main_provider, limitations, focus_areas, notes, evaluation_interval_weeks, status, created_at, updated_at FROM intake_forms WHERE id = $1
+SELECT id, registration_form_id, intake_date, intake_time, location_id, coordinator_id, family_situation, main_provider, limitations, focus_areas, notes, evaluation_interval_weeks, status, created_at, updated_at, created_by_user_id, is_deleted FROM intake_forms WHERE id = $1 AND is_deleted = FALSE
 `
 
 func (q *Queries) GetIntakeForm(ctx context.Context, id string) (IntakeForm, error) {
@@ -92,6 +95,8 @@ func (q *Queries) GetIntakeForm(ctx context.Context, id string) (IntakeForm, err
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.CreatedByUserID,
+		&i.IsDeleted,
 	)
 	return i, err
 }
@@ -113,6 +118,7 @@ SELECT
     i.status,
     i.created_at,
     i.updated_at,
+    i.created_by_user_id,
     r.first_name as client_first_name,
     r.last_name as client_last_name,
     r.bsn as client_bsn,
@@ -122,13 +128,17 @@ SELECT
     e.first_name as coordinator_first_name,
     e.last_name as coordinator_last_name,
     (SELECT c.id FROM clients c WHERE c.intake_form_id = i.id LIMIT 1) AS client_id,
-    EXISTS (SELECT 1 FROM clients c WHERE c.intake_form_id = i.id) AS has_client
+    EXISTS (SELECT 1 FROM clients c WHERE c.intake_form_id = i.id) AS has_client,
+    NOT EXISTS (
+        SELECT 1 FROM intake_required_documents d
+        WHERE d.intake_form_id = i.id AND d.status = 'missing'
+    ) AS documents_complete
 FROM intake_forms i
 LEFT JOIN registration_forms r ON i.registration_form_id = r.id
 LEFT JOIN referring_orgs ro ON r.reffering_org_id = ro.id
 LEFT JOIN locations l ON i.location_id = l.id
 LEFT JOIN employees e ON i.coordinator_id = e.id
-WHERE i.id = $1
+WHERE i.id = $1 AND i.is_deleted = FALSE
 `
 
 type GetIntakeFormWithDetailsRow struct {
@@ -147,6 +157,7 @@ type GetIntakeFormWithDetailsRow struct {
 	Status                  IntakeStatusEnum `json:"status"`
 	CreatedAt               pgtype.Timestamp `json:"created_at"`
 	UpdatedAt               pgtype.Timestamp `json:"updated_at"`
+	CreatedByUserID         *string          `json:"created_by_user_id"`
 	ClientFirstName         *string          `json:"client_first_name"`
 	ClientLastName          *string          `json:"client_last_name"`
 	ClientBsn               *string          `json:"client_bsn"`
@@ -157,6 +168,7 @@ type GetIntakeFormWithDetailsRow struct {
 	CoordinatorLastName     *string          `json:"coordinator_last_name"`
 	ClientID                string           `json:"client_id"`
 	HasClient               bool             `json:"has_client"`
+	DocumentsComplete       bool             `json:"documents_complete"`
 }
 
 func (q *Queries) GetIntakeFormWithDetails(ctx context.Context, id string) (GetIntakeFormWithDetailsRow, error) {
@@ -178,6 +190,7 @@ func (q *Queries) GetIntakeFormWithDetails(ctx context.Context, id string) (GetI
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.CreatedByUserID,
 		&i.ClientFirstName,
 		&i.ClientLastName,
 		&i.ClientBsn,
@@ -188,6 +201,7 @@ func (q *Queries) GetIntakeFormWithDetails(ctx context.Context, id string) (GetI
 		&i.CoordinatorLastName,
 		&i.ClientID,
 		&i.HasClient,
+		&i.DocumentsComplete,
 	)
 	return i, err
 }
@@ -202,6 +216,7 @@ SELECT
         ELSE 0.0
     END)::DOUBLE PRECISION as conversion_percentage
 FROM intake_forms
+WHERE is_deleted = FALSE
 `
 
 type GetIntakeStatsRow struct {
@@ -242,7 +257,8 @@ LEFT JOIN referring_orgs ro ON r.reffering_org_id = ro.id
 LEFT JOIN locations l ON i.location_id = l.id
 LEFT JOIN employees e ON i.coordinator_id = e.id
 WHERE
-    (
+    i.is_deleted = FALSE
+    AND (
         -- If search term is NULL or empty, ignore filters
         $3::text IS NULL OR $3::text = '' OR
         -- Search by client first name
@@ -252,7 +268,21 @@ WHERE
         -- Search by org name
         ro.name ILIKE '%' || $3 || '%'
     )
-ORDER BY i.created_at DESC  
+-- @sort is a key from util.SortOrder; the CASE arms only compare it against
+-- fixed strings. Unmatched arms are NULL for every row, so the default order
+-- (newest first) decides.
+ORDER BY
+    CASE WHEN $4::text = 'intake_date_asc' THEN i.intake_date END ASC,
+    CASE WHEN $4::text = 'intake_date_asc' THEN i.intake_time END ASC,
+    CASE WHEN $4::text = 'intake_date_desc' THEN i.intake_date END DESC,
+    CASE WHEN $4::text = 'intake_date_desc' THEN i.intake_time END DESC,
+    CASE WHEN $4::text = 'name_asc' THEN r.last_name END ASC,
+    CASE WHEN $4::text = 'name_asc' THEN r.first_name END ASC,
+    CASE WHEN $4::text = 'name_desc' THEN r.last_name END DESC,
+    CASE WHEN $4::text = 'name_desc' THEN r.first_name END DESC,
+    CASE WHEN $4::text = 'created_at_asc' THEN i.created_at END ASC,
+    i.created_at DESC,
+    i.id
 LIMIT $1 OFFSET $2
 `
 
@@ -260,6 +290,7 @@ type ListIntakeFormsParams struct {
 	Limit   int32  `json:"limit"`
 	Offset  int32  `json:"offset"`
 	Column3 string `json:"column_3"`
+	Sort    string `json:"sort"`
 }
 
 type ListIntakeFormsRow struct {
@@ -283,7 +314,12 @@ type ListIntakeFormsRow struct {
 }
 
 func (q *Queries) ListIntakeForms(ctx context.Context, arg ListIntakeFormsParams) ([]ListIntakeFormsRow, error) {
-	rows, err := q.db.Query(ctx, listIntakeForms, arg.Limit, arg.Offset, arg.Column3)
+	rows, err := q.db.Query(ctx, listIntakeForms,
+		arg.Limit,
+		arg.Offset,
+		arg.Column3,
+		arg.Sort,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -320,6 +356,124 @@ func (q *Queries) ListIntakeForms(ctx context.Context, arg ListIntakeFormsParams
 	return items, nil
 }
 
+const listIntakeRescheduleHistory = `-- name: ListIntakeRescheduleHistory :many
+SELECT
+    h.id,
+    h.old_intake_date,
+    h.old_intake_time,
+    h.new_intake_date,
+    h.new_intake_time,
+    h.changed_by,
+    h.changed_at
+FROM intake_reschedule_history h
+WHERE h.intake_form_id = $1
+ORDER BY h.changed_at, h.id
+`
+
+type ListIntakeRescheduleHistoryRow struct {
+	ID            string             `json:"id"`
+	OldIntakeDate pgtype.Date        `json:"old_intake_date"`
+	OldIntakeTime pgtype.Time        `json:"old_intake_time"`
+	NewIntakeDate pgtype.Date        `json:"new_intake_date"`
+	NewIntakeTime pgtype.Time        `json:"new_intake_time"`
+	ChangedBy     *string            `json:"changed_by"`
+	ChangedAt     pgtype.Timestamptz `json:"changed_at"`
+}
+
+// Oldest change first
+func (q *Queries) ListIntakeRescheduleHistory(ctx context.Context, intakeFormID string) ([]ListIntakeRescheduleHistoryRow, error) {
+	rows, err := q.db.Query(ctx, listIntakeRescheduleHistory, intakeFormID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListIntakeRescheduleHistoryRow{}
+	for rows.Next() {
+		var i ListIntakeRescheduleHistoryRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.OldIntakeDate,
+			&i.OldIntakeTime,
+			&i.NewIntakeDate,
+			&i.NewIntakeTime,
+			&i.ChangedBy,
+			&i.ChangedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const recordIntakeReschedule = `-- name: RecordIntakeReschedule :exec
+INSERT INTO intake_reschedule_history (
+    id,
+    intake_form_id,
+    old_intake_date,
+    old_intake_time,
+    new_intake_date,
+    new_intake_time,
+    changed_by
+)
+SELECT
+    $1::text,
+    i.id,
+    i.intake_date,
+    i.intake_time,
+    COALESCE($2::date, i.intake_date),
+    COALESCE($3::time, i.intake_time),
+    NULLIF($4::text, '')
+FROM intake_forms i
+WHERE i.id = $5
+  AND i.is_deleted = FALSE
+  AND (i.intake_date, i.intake_time) IS DISTINCT FROM (
+      COALESCE($2::date, i.intake_date),
+      COALESCE($3::time, i.intake_time)
+  )
+`
+
+type RecordIntakeRescheduleParams struct {
+	ID           string      `json:"id"`
+	IntakeDate   pgtype.Date `json:"intake_date"`
+	IntakeTime   pgtype.Time `json:"intake_time"`
+	ChangedBy    string      `json:"changed_by"`
+	IntakeFormID string      `json:"intake_form_id"`
+}
+
+// Call before UpdateIntakeForm with the new date and time; a NULL keeps the
+// current value. Nothing is written when the appointment does not move.
+func (q *Queries) RecordIntakeReschedule(ctx context.Context, arg RecordIntakeRescheduleParams) error {
+	_, err := q.db.Exec(ctx, recordIntakeReschedule,
+		arg.ID,
+		arg.IntakeDate,
+		arg.IntakeTime,
+		arg.ChangedBy,
+		arg.IntakeFormID,
+	)
+	return err
+}
+
+const softDeleteIntakeForm = `-- name: SoftDeleteIntakeForm :execrows
+UPDATE intake_forms SET is_deleted = TRUE, updated_at = NOW()
+WHERE id = $1
+  AND is_deleted = FALSE
+  AND NOT EXISTS (SELECT 1 FROM clients c WHERE c.intake_form_id = intake_forms.id)
+`
+
+// Intakes that were already converted into a client are left untouched; zero
+// rows means the intake is missing, already deleted or converted
+func (q *Queries) SoftDeleteIntakeForm(ctx context.Context, id string) (int64, error) {
+	result, err := q.db.Exec(ctx, softDeleteIntakeForm, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateIntakeForm = `-- name: UpdateIntakeForm :exec
 UPDATE intake_forms SET
     intake_date = COALESCE($2, intake_date),
@@ -334,7 +488,7 @@ UPDATE intake_forms SET
     evaluation_interval_weeks = COALESCE($11, evaluation_interval_weeks),
     status = COALESCE($12, status),
     updated_at = NOW()
-WHERE id = $1
+WHERE id = $1 AND is_deleted = FALSE
 `
 
 type UpdateIntakeFormParams struct {
@@ -154,6 +154,29 @@ func TestCreateIntakeForm(t *testing.T) {
 				assert.True(t, IsForeignKeyViolation(err), "expected FK violation, got: %v", err)
 			},
 		},
+		{
+			name: "evaluation_interval_out_of_range",
+			setup: func(t *testing.T, q *Queries) CreateIntakeFormParams {
+				userID := CreateTestUser(t, q, CreateTestUserOptions{})
+				locationID := CreateTestLocation(t, q, CreateTestLocationOptions{})
+				employeeID := CreateTestEmployee(t, q, CreateTestEmployeeOptions{UserID: userID})
+				regFormID := CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{})
+
+				return CreateIntakeFormParams{
+					ID:                      generateTestID(),
+					RegistrationFormID:      regFormID,
+					IntakeDate:              toPgDate(time.Now()),
+					IntakeTime:              toPgTime(time.Date(0, 1, 1, 10, 0, 0, 0, time.UTC)),
+					LocationID:              locationID,
+					CoordinatorID:           employeeID,
+					EvaluationIntervalWeeks: int32Ptr(520),
+				}
+			},
+			wantErr: true,
+			checkErr: func(t *testing.T, err error) {
+				assert.True(t, IsCheckViolation(err), "expected check violation, got: %v", err)
+			},
+		},
 		{
 			name: "invalid_coordinator_fk",
 			setup: func(t *testing.T, q *Queries) CreateIntakeFormParams {
@@ -512,6 +535,33 @@ func TestGetIntakeStats(t *testing.T) {
 				assert.Equal(t, float64(100), stats.ConversionPercentage)
 			},
 		},
+		{
+			name: "excludes_deleted",
+			setup: func(t *testing.T, q *Queries) {
+				ctx := context.Background()
+
+				for i := 0; i < 2; i++ {
+					userID := CreateTestUser(t, q, CreateTestUserOptions{})
+					locationID := CreateTestLocation(t, q, CreateTestLocationOptions{})
+					employeeID := CreateTestEmployee(t, q, CreateTestEmployeeOptions{UserID: userID})
+					regFormID := CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{})
+
+					intakeID := CreateTestIntakeForm(t, q, CreateTestIntakeFormOptions{
+						RegistrationFormID: regFormID,
+						LocationID:         locationID,
+						CoordinatorID:      employeeID,
+					})
+					if i == 0 {
+						_, err := q.SoftDeleteIntakeForm(ctx, intakeID)
+						require.NoError(t, err)
+					}
+				}
+			},
+			validate: func(t *testing.T, stats GetIntakeStatsRow) {
+				assert.Equal(t, int64(1), stats.TotalCount)
+				assert.Equal(t, int64(1), stats.PendingCount)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -687,6 +737,57 @@ func TestListIntakeForms(t *testing.T) {
 	}
 }
 
+func TestListIntakeForms_Sort(t *testing.T) {
+	tests := []struct {
+		name string
+		sort string
+		want []string // last names in result order
+	}{
+		{name: "default_newest_first", sort: "", want: []string{"Jansen", "Visser", "Bakker"}},
+		{name: "name_ascending", sort: "name_asc", want: []string{"Bakker", "Jansen", "Visser"}},
+		{name: "name_descending", sort: "name_desc", want: []string{"Visser", "Jansen", "Bakker"}},
+		{name: "created_at_ascending", sort: "created_at_asc", want: []string{"Bakker", "Visser", "Jansen"}},
+		// An unknown key matches no CASE arm, so the default order applies
+		{name: "unknown_key_uses_default", sort: "bsn_asc", want: []string{"Jansen", "Visser", "Bakker"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runTestWithTx(t, func(t *testing.T, q *Queries) {
+				ctx := context.Background()
+				// Created oldest first: Bakker, Visser, Jansen
+				for i, last := range []string{"Bakker", "Visser", "Jansen"} {
+					userID := CreateTestUser(t, q, CreateTestUserOptions{})
+					locationID := CreateTestLocation(t, q, CreateTestLocationOptions{})
+					employeeID := CreateTestEmployee(t, q, CreateTestEmployeeOptions{UserID: userID})
+					regFormID := CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{
+						LastName: strPtr(last),
+					})
+					id := CreateTestIntakeForm(t, q, CreateTestIntakeFormOptions{
+						RegistrationFormID: regFormID,
+						LocationID:         locationID,
+						CoordinatorID:      employeeID,
+					})
+					_, err := q.db.Exec(ctx,
+						"UPDATE intake_forms SET created_at = NOW() - make_interval(hours => $2::int) WHERE id = $1",
+						id, 3-i)
+					require.NoError(t, err)
+				}
+
+				results, err := q.ListIntakeForms(ctx, ListIntakeFormsParams{Limit: 10, Offset: 0, Sort: tt.sort})
+				require.NoError(t, err)
+
+				got := []string{}
+				for _, r := range results {
+					require.NotNil(t, r.LastName)
+					got = append(got, *r.LastName)
+				}
+				assert.Equal(t, tt.want, got)
+			})
+		})
+	}
+}
+
 // ============================================================
 // Test: UpdateIntakeForm
 // ============================================================
@@ -1031,3 +1132,261 @@ func TestUpdateIntakeFormStatus(t *testing.T) {
 		})
 	}
 }
+
+// ============================================================
+// Test: SoftDeleteIntakeForm
+// ============================================================
+
+func TestSoftDeleteIntakeForm(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		userID := CreateTestUser(t, q, CreateTestUserOptions{})
+		locationID := CreateTestLocation(t, q, CreateTestLocationOptions{})
+		employeeID := CreateTestEmployee(t, q, CreateTestEmployeeOptions{UserID: userID})
+		regFormID := CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{})
+
+		intakeID := CreateTestIntakeForm(t, q, CreateTestIntakeFormOptions{
+			RegistrationFormID: regFormID,
+			LocationID:         locationID,
+			CoordinatorID:      employeeID,
+		})
+
+		affected, err := q.SoftDeleteIntakeForm(ctx, intakeID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), affected)
+
+		// Deleting it again changes nothing
+		affected, err = q.SoftDeleteIntakeForm(ctx, intakeID)
+		require.NoError(t, err)
+		assert.Zero(t, affected)
+
+		_, err = q.GetIntakeForm(ctx, intakeID)
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+		_, err = q.GetIntakeFormWithDetails(ctx, intakeID)
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+
+		list, err := q.ListIntakeForms(ctx, ListIntakeFormsParams{Limit: 10, Offset: 0})
+		require.NoError(t, err)
+		for _, row := range list {
+			assert.NotEqual(t, intakeID, row.ID)
+		}
+
+		// The registration no longer counts as having an intake
+		regForm, err := q.GetRegistrationFormWithDetails(ctx, regFormID)
+		require.NoError(t, err)
+		assert.False(t, regForm.IntakeCompleted)
+
+		// A new intake can be created for the same registration
+		newIntakeID := CreateTestIntakeForm(t, q, CreateTestIntakeFormOptions{
+			RegistrationFormID: regFormID,
+			LocationID:         locationID,
+			CoordinatorID:      employeeID,
+		})
+		form, err := q.GetIntakeForm(ctx, newIntakeID)
+		require.NoError(t, err)
+		assert.Equal(t, regFormID, form.RegistrationFormID)
+
+		regForm, err = q.GetRegistrationFormWithDetails(ctx, regFormID)
+		require.NoError(t, err)
+		assert.True(t, regForm.IntakeCompleted)
+	})
+}
+
+func TestSoftDeleteIntakeForm_ConvertedIntakeKept(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		_, deps := CreateTestClientWithDependencies(t, q)
+
+		affected, err := q.SoftDeleteIntakeForm(ctx, deps.IntakeFormID)
+		require.NoError(t, err)
+		assert.Zero(t, affected)
+
+		form, err := q.GetIntakeForm(ctx, deps.IntakeFormID)
+		require.NoError(t, err)
+		require.NotNil(t, form.IsDeleted)
+		assert.False(t, *form.IsDeleted)
+	})
+}
+
+// DeleteIntakeFormTx opens its own transaction, so it runs against testStore
+// directly instead of inside runTestWithTx and deletes what it commits.
+func TestDeleteIntakeFormTx(t *testing.T) {
+	ctx := context.Background()
+	q := testStore.Queries
+
+	newIntake := func(t *testing.T, status RegistrationStatusEnum) (string, string) {
+		userID := CreateTestUser(t, q, CreateTestUserOptions{})
+		deleteAfterTest(t, "users", userID)
+		locationID := CreateTestLocation(t, q, CreateTestLocationOptions{})
+		deleteAfterTest(t, "locations", locationID)
+		employeeID := CreateTestEmployee(t, q, CreateTestEmployeeOptions{UserID: userID, LocationID: &locationID})
+		deleteAfterTest(t, "employees", employeeID)
+		regFormID := CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{})
+		deleteAfterTest(t, "registration_forms", regFormID)
+		intakeID := CreateTestIntakeForm(t, q, CreateTestIntakeFormOptions{
+			RegistrationFormID: regFormID,
+			LocationID:         locationID,
+			CoordinatorID:      employeeID,
+		})
+		deleteAfterTest(t, "intake_forms", intakeID)
+
+		require.NoError(t, q.UpdateRegistrationFormStatus(ctx, UpdateRegistrationFormStatusParams{
+			ID:        regFormID,
+			Status:    NullRegistrationStatusEnum{RegistrationStatusEnum: status, Valid: true},
+			HistoryID: generateTestID(),
+		}))
+		return regFormID, intakeID
+	}
+	registrationStatus := func(t *testing.T, regFormID string) RegistrationStatusEnum {
+		form, err := q.GetRegistrationForm(ctx, regFormID)
+		require.NoError(t, err)
+		return form.Status.RegistrationStatusEnum
+	}
+
+	t.Run("in_review_registration_returns_to_pending", func(t *testing.T) {
+		regFormID, intakeID := newIntake(t, RegistrationStatusEnumInReview)
+
+		result, err := testStore.DeleteIntakeFormTx(ctx, DeleteIntakeFormTxParams{
+			IntakeFormID:       intakeID,
+			RegistrationFormID: regFormID,
+		})
+		require.NoError(t, err)
+		assert.True(t, result.Deleted)
+		assert.Equal(t, RegistrationStatusEnumPending, registrationStatus(t, regFormID))
+	})
+
+	t.Run("decided_registration_is_kept", func(t *testing.T) {
+		regFormID, intakeID := newIntake(t, RegistrationStatusEnumApproved)
+
+		result, err := testStore.DeleteIntakeFormTx(ctx, DeleteIntakeFormTxParams{
+			IntakeFormID:       intakeID,
+			RegistrationFormID: regFormID,
+		})
+		require.NoError(t, err)
+		assert.True(t, result.Deleted)
+		assert.Equal(t, RegistrationStatusEnumApproved, registrationStatus(t, regFormID))
+	})
+
+	t.Run("already_deleted_changes_nothing", func(t *testing.T) {
+		regFormID, intakeID := newIntake(t, RegistrationStatusEnumInReview)
+		_, err := q.SoftDeleteIntakeForm(ctx, intakeID)
+		require.NoError(t, err)
+
+		result, err := testStore.DeleteIntakeFormTx(ctx, DeleteIntakeFormTxParams{
+			IntakeFormID:       intakeID,
+			RegistrationFormID: regFormID,
+		})
+		require.NoError(t, err)
+		assert.False(t, result.Deleted)
+		assert.Equal(t, RegistrationStatusEnumInReview, registrationStatus(t, regFormID))
+	})
+}
+
+// ============================================================
+// Test: UpdateIntakeFormTx reschedule history
+// ============================================================
+
+// UpdateIntakeFormTx opens its own transaction, so these tests run against
+// testStore directly instead of inside runTestWithTx and delete what they commit.
+func TestUpdateIntakeFormTx_RescheduleHistory(t *testing.T) {
+	ctx := context.Background()
+	q := testStore.Queries
+
+	newIntake := func(t *testing.T) string {
+		userID := CreateTestUser(t, q, CreateTestUserOptions{})
+		deleteAfterTest(t, "users", userID)
+		locationID := CreateTestLocation(t, q, CreateTestLocationOptions{})
+		deleteAfterTest(t, "locations", locationID)
+		employeeID := CreateTestEmployee(t, q, CreateTestEmployeeOptions{UserID: userID, LocationID: &locationID})
+		deleteAfterTest(t, "employees", employeeID)
+		regFormID := CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{})
+		deleteAfterTest(t, "registration_forms", regFormID)
+		intakeDate := time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)
+		intakeID := CreateTestIntakeForm(t, q, CreateTestIntakeFormOptions{
+			RegistrationFormID: regFormID,
+			LocationID:         locationID,
+			CoordinatorID:      employeeID,
+			IntakeDate:         &intakeDate,
+		})
+		// Deleting the intake also deletes its reschedule history
+		deleteAfterTest(t, "intake_forms", intakeID)
+		return intakeID
+	}
+
+	t.Run("date_change_records_history", func(t *testing.T) {
+		// Created first so the history row naming it is deleted before it
+		actorID := CreateTestUser(t, q, CreateTestUserOptions{})
+		deleteAfterTest(t, "users", actorID)
+		intakeID := newIntake(t)
+		newDate := time.Date(2026, time.March, 9, 0, 0, 0, 0, time.UTC)
+
+		require.NoError(t, testStore.UpdateIntakeFormTx(ctx, UpdateIntakeFormTxParams{
+			IntakeForm: UpdateIntakeFormParams{
+				ID:         intakeID,
+				IntakeDate: toPgDate(newDate),
+			},
+			ChangedBy: actorID,
+		}))
+
+		history, err := q.ListIntakeRescheduleHistory(ctx, intakeID)
+		require.NoError(t, err)
+		require.Len(t, history, 1)
+		assert.Equal(t, "2026-03-02", history[0].OldIntakeDate.Time.Format(time.DateOnly))
+		assert.Equal(t, "2026-03-09", history[0].NewIntakeDate.Time.Format(time.DateOnly))
+		// The time did not move
+		assert.Equal(t, clockTime(10*time.Hour), history[0].OldIntakeTime)
+		assert.Equal(t, clockTime(10*time.Hour), history[0].NewIntakeTime)
+		require.NotNil(t, history[0].ChangedBy)
+		assert.Equal(t, actorID, *history[0].ChangedBy)
+	})
+
+	t.Run("time_change_records_history", func(t *testing.T) {
+		intakeID := newIntake(t)
+
+		require.NoError(t, testStore.UpdateIntakeFormTx(ctx, UpdateIntakeFormTxParams{
+			IntakeForm: UpdateIntakeFormParams{
+				ID:         intakeID,
+				IntakeTime: clockTime(14*time.Hour + 30*time.Minute),
+			},
+		}))
+
+		history, err := q.ListIntakeRescheduleHistory(ctx, intakeID)
+		require.NoError(t, err)
+		require.Len(t, history, 1)
+		assert.Equal(t, clockTime(10*time.Hour), history[0].OldIntakeTime)
+		assert.Equal(t, clockTime(14*time.Hour+30*time.Minute), history[0].NewIntakeTime)
+		assert.Equal(t, history[0].OldIntakeDate, history[0].NewIntakeDate)
+		assert.Nil(t, history[0].ChangedBy)
+	})
+
+	t.Run("unrelated_update_records_nothing", func(t *testing.T) {
+		intakeID := newIntake(t)
+
+		require.NoError(t, testStore.UpdateIntakeFormTx(ctx, UpdateIntakeFormTxParams{
+			IntakeForm: UpdateIntakeFormParams{
+				ID:    intakeID,
+				Notes: strPtr("Parents will attend"),
+			},
+		}))
+
+		history, err := q.ListIntakeRescheduleHistory(ctx, intakeID)
+		require.NoError(t, err)
+		assert.Empty(t, history)
+	})
+
+	t.Run("same_date_records_nothing", func(t *testing.T) {
+		intakeID := newIntake(t)
+
+		require.NoError(t, testStore.UpdateIntakeFormTx(ctx, UpdateIntakeFormTxParams{
+			IntakeForm: UpdateIntakeFormParams{
+				ID:         intakeID,
+				IntakeDate: toPgDate(time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)),
+				IntakeTime: clockTime(10 * time.Hour),
+			},
+		}))
+
+		history, err := q.ListIntakeRescheduleHistory(ctx, intakeID)
+		require.NoError(t, err)
+		assert.Empty(t, history)
+	})
+}
@@ -1,12 +1,66 @@
 package db
 
-import "context"
+import (
+	"care-cordination/lib/nanoid"
+	"care-cordination/lib/util"
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ErrIntakeSlotTaken is returned when an intake is booked on a time that is
+// not one of the coordinator's open slots.
+var ErrIntakeSlotTaken = errors.New("intake slot is not open")
+
+// IntakeSlotCheck is the slot an intake is booked on, checked against the
+// coordinator's open slots inside the booking transaction
+type IntakeSlotCheck struct {
+	CoordinatorID string
+	Date          pgtype.Date
+	Time          pgtype.Time
+	SlotMinutes   int32
+}
+
+// checkIntakeSlot locks the coordinator and returns ErrIntakeSlotTaken unless
+// the slot is open, or ErrCoordinatorNotFound for an unknown coordinator. The lock holds until the transaction ends, so two bookings
+// for the same coordinator cannot both see the slot as open. An intake being
+// rescheduled passes its own id so its current slot counts as open.
+func (q *Queries) checkIntakeSlot(ctx context.Context, slot IntakeSlotCheck, excludeIntakeID *string) error {
+	if _, err := q.LockEmployee(ctx, slot.CoordinatorID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrCoordinatorNotFound
+		}
+		return err
+	}
+
+	open, err := q.GetAvailableIntakeSlots(ctx, GetAvailableIntakeSlotsParams{
+		SlotMinutes:     slot.SlotMinutes,
+		Date:            slot.Date,
+		CoordinatorID:   slot.CoordinatorID,
+		ExcludeIntakeID: excludeIntakeID,
+		Timezone:        util.AppLocation().String(),
+	})
+	if err != nil {
+		return err
+	}
+	if !slices.ContainsFunc(open, func(o GetAvailableIntakeSlotsRow) bool {
+		return o.StartTime.Microseconds == slot.Time.Microseconds
+	}) {
+		return ErrIntakeSlotTaken
+	}
+	return nil
+}
 
 type CreateIntakeFormTxParams struct {
 	IntakeForm             CreateIntakeFormParams
 	RegistrationFormID     string
 	RegistrationFormStatus NullRegistrationStatusEnum
 	Goals                  []CreateClientGoalParams
+	// If set, the intake's slot must be open; nil books without checking
+	Slot *IntakeSlotCheck
 }
 
 type CreateIntakeFormTxResult struct {
@@ -20,27 +74,45 @@ func (s *Store) CreateIntakeFormTx(
 	var result CreateIntakeFormTxResult
 
 	err := s.ExecTx(ctx, func(q *Queries) error {
-		// 1. Create the intake form
+		// 1. Make sure the slot is still open
+		if arg.Slot != nil {
+			if err := q.checkIntakeSlot(ctx, *arg.Slot, nil); err != nil {
+				return err
+			}
+		}
+
+		// 2. Create the intake form
 		if err := q.CreateIntakeForm(ctx, arg.IntakeForm); err != nil {
 			return err
 		}
 		result.IntakeFormID = arg.IntakeForm.ID
 
-		// 2. Update the registration form status to approved
+		// 3. Update the registration form status to approved
+		var changedBy string
+		if arg.IntakeForm.CreatedByUserID != nil {
+			changedBy = *arg.IntakeForm.CreatedByUserID
+		}
 		if err := q.UpdateRegistrationFormStatus(ctx, UpdateRegistrationFormStatusParams{
-			ID:     arg.RegistrationFormID,
-			Status: arg.RegistrationFormStatus,
+			ID:        arg.RegistrationFormID,
+			Status:    arg.RegistrationFormStatus,
+			HistoryID: nanoid.Generate(),
+			ChangedBy: changedBy,
 		}); err != nil {
 			return err
 		}
 
-		// 3. Create the goals
+		// 4. Create the goals
 		for _, goal := range arg.Goals {
 			if err := q.CreateClientGoal(ctx, goal); err != nil {
 				return err
 			}
 		}
 
+		// 5. Start the required document checklist for the care type
+		if err := q.CreateIntakeDocumentChecklist(ctx, arg.IntakeForm.ID); err != nil {
+			return err
+		}
+
 		return nil
 	})
 
@@ -51,16 +123,51 @@ type UpdateIntakeFormTxParams struct {
 	IntakeForm UpdateIntakeFormParams
 	// If true, also update the associated client with the relevant fields
 	UpdateClient bool
+	// Client linked to the intake form; its assignment history is updated with UpdateClient
+	ClientID string
+	// User making the change, recorded in the client's assignment history and
+	// the intake's reschedule history
+	ChangedBy string
+	// If set, the slot the intake moves to must be open
+	Slot *IntakeSlotCheck
+	// If set, the client's new coordinator must have room in their caseload
+	Caseload *CaseloadCheck
 }
 
 func (s *Store) UpdateIntakeFormTx(ctx context.Context, arg UpdateIntakeFormTxParams) error {
 	return s.ExecTx(ctx, func(q *Queries) error {
-		// 1. Update the intake form
+		// 1. Make sure the new slot is open and the new coordinator has room
+		if arg.Slot != nil {
+			if err := q.checkIntakeSlot(ctx, *arg.Slot, &arg.IntakeForm.ID); err != nil {
+				return err
+			}
+		}
+		if arg.Caseload != nil {
+			if err := q.CheckCaseload(ctx, *arg.Caseload); err != nil {
+				return err
+			}
+		}
+
+		// 2. Record the old appointment if the date or time moves; this has to
+		// read the intake before it is updated
+		if arg.IntakeForm.IntakeDate.Valid || arg.IntakeForm.IntakeTime.Valid {
+			if err := q.RecordIntakeReschedule(ctx, RecordIntakeRescheduleParams{
+				ID:           nanoid.Generate(),
+				IntakeDate:   arg.IntakeForm.IntakeDate,
+				IntakeTime:   arg.IntakeForm.IntakeTime,
+				ChangedBy:    arg.ChangedBy,
+				IntakeFormID: arg.IntakeForm.ID,
+			}); err != nil {
+				return err
+			}
+		}
+
+		// 3. Update the intake form
 		if err := q.UpdateIntakeForm(ctx, arg.IntakeForm); err != nil {
 			return err
 		}
 
-		// 2. If requested, update the associated client with relevant fields
+		// 4. If requested, update the associated client with relevant fields
 		if arg.UpdateClient {
 			if err := q.UpdateClientByIntakeFormID(ctx, UpdateClientByIntakeFormIDParams{
 				IntakeFormID:            arg.IntakeForm.ID,
@@ -74,8 +181,72 @@ func (s *Store) UpdateIntakeFormTx(ctx context.Context, arg UpdateIntakeFormTxPa
 			}); err != nil {
 				return err
 			}
+
+			// 5. Record the new coordinator/location if the assignment changed
+			if err := q.RecordClientAssignment(ctx, RecordClientAssignmentParams{
+				ID:        nanoid.Generate(),
+				ClientID:  arg.ClientID,
+				ChangedBy: arg.ChangedBy,
+			}); err != nil {
+				return err
+			}
 		}
 
 		return nil
 	})
 }
+
+type DeleteIntakeFormTxParams struct {
+	IntakeFormID       string
+	RegistrationFormID string
+	// User deleting the intake, recorded in the registration's status history
+	ChangedBy string
+}
+
+type DeleteIntakeFormTxResult struct {
+	// False when there was no live, unconverted intake to delete
+	Deleted bool
+}
+
+// DeleteIntakeFormTx soft-deletes an intake and hands its registration back to
+// pending if it is still in the in_review status the intake put it in
+func (s *Store) DeleteIntakeFormTx(
+	ctx context.Context,
+	arg DeleteIntakeFormTxParams,
+) (DeleteIntakeFormTxResult, error) {
+	var result DeleteIntakeFormTxResult
+
+	err := s.ExecTx(ctx, func(q *Queries) error {
+		// 1. Soft delete the intake form
+		affected, err := q.SoftDeleteIntakeForm(ctx, arg.IntakeFormID)
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return nil
+		}
+		result.Deleted = true
+
+		// 2. Revert the registration status set when the intake was created;
+		// a status changed by hand since then is kept
+		registrationForm, err := q.GetRegistrationForm(ctx, arg.RegistrationFormID)
+		if err != nil {
+			return err
+		}
+		if !registrationForm.Status.Valid ||
+			registrationForm.Status.RegistrationStatusEnum != RegistrationStatusEnumInReview {
+			return nil
+		}
+		return q.UpdateRegistrationFormStatus(ctx, UpdateRegistrationFormStatusParams{
+			ID: arg.RegistrationFormID,
+			Status: NullRegistrationStatusEnum{
+				RegistrationStatusEnum: RegistrationStatusEnumPending,
+				Valid:                  true,
+			},
+			HistoryID: nanoid.Generate(),
+			ChangedBy: arg.ChangedBy,
+		})
+	})
+
+	return result, err
+}
@@ -11,14 +11,38 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const cancelLocationTransfer = `-- name: CancelLocationTransfer :execrows
+UPDATE client_location_transfers
+SET status = 'cancelled', cancellation_reason = $2, updated_at = NOW()
+WHERE id = $1 AND status = 'pending'
+`
+
+type CancelLocationTransferParams struct {
+	ID                 string  `json:"id"`
+	CancellationReason *string `json:"cancellation_reason"`
+}
+
+func (q *Queries) CancelLocationTransfer(ctx context.Context, arg CancelLocationTransferParams) (int64, error) {
+	result, err := q.db.Exec(ctx, cancelLocationTransfer, arg.ID, arg.CancellationReason)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const confirmLocationTransfer = `-- name: ConfirmLocationTransfer :exec
 UPDATE client_location_transfers
-SET status = 'approved', transfer_date = NOW(), updated_at = NOW()
+SET status = 'approved', handover_notes = $2, transfer_date = NOW(), approved_at = NOW(), updated_at = NOW()
 WHERE id = $1 AND status = 'pending'
 `
 
-func (q *Queries) ConfirmLocationTransfer(ctx context.Context, id string) error {
-	_, err := q.db.Exec(ctx, confirmLocationTransfer, id)
+type ConfirmLocationTransferParams struct {
+	ID            string  `json:"id"`
+	HandoverNotes *string `json:"handover_notes"`
+}
+
+func (q *Queries) ConfirmLocationTransfer(ctx context.Context, arg ConfirmLocationTransferParams) error {
+	_, err := q.db.Exec(ctx, confirmLocationTransfer, arg.ID, arg.HandoverNotes)
 	return err
 }
 
@@ -33,9 +57,10 @@ INSERT INTO client_location_transfers (
     current_coordinator_id,
     new_coordinator_id,
     transfer_date,
-    reason
+    reason,
+    created_by_user_id
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
 )
 RETURNING id, client_id, from_location_id, to_location_id, current_coordinator_id, new_coordinator_id, transfer_date
 `
@@ -49,6 +74,7 @@ type CreateLocationTransferParams struct {
 	NewCoordinatorID     string           `json:"new_coordinator_id"`
 	TransferDate         pgtype.Timestamp `json:"transfer_date"`
 	Reason               *string          `json:"reason"`
+	CreatedByUserID      *string          `json:"created_by_user_id"`
 }
 
 type CreateLocationTransferRow struct {
@@ -74,6 +100,7 @@ func (q *Queries) CreateLocationTransfer(ctx context.Context, arg CreateLocation
 		arg.NewCoordinatorID,
 		arg.TransferDate,
 		arg.Reason,
+		arg.CreatedByUserID,
 	)
 	var i CreateLocationTransferRow
 	err := row.Scan(
@@ -100,6 +127,12 @@ SELECT
     clt.reason,
     clt.status,
     clt.rejection_reason,
+    clt.cancellation_reason,
+    clt.handover_notes,
+    clt.created_by_user_id,
+    clt.created_at,
+    clt.approved_at,
+    clt.rejected_at,
     c.first_name AS client_first_name,
     c.last_name AS client_last_name,
     l_from.name AS from_location_name,
@@ -128,6 +161,12 @@ type GetLocationTransferByIDRow struct {
 	Reason                      *string                    `json:"reason"`
 	Status                      LocationTransferStatusEnum `json:"status"`
 	RejectionReason             *string                    `json:"rejection_reason"`
+	CancellationReason          *string                    `json:"cancellation_reason"`
+	HandoverNotes               *string                    `json:"handover_notes"`
+	CreatedByUserID             *string                    `json:"created_by_user_id"`
+	CreatedAt                   pgtype.Timestamp           `json:"created_at"`
+	ApprovedAt                  pgtype.Timestamp           `json:"approved_at"`
+	RejectedAt                  pgtype.Timestamp           `json:"rejected_at"`
 	ClientFirstName             string                     `json:"client_first_name"`
 	ClientLastName              string                     `json:"client_last_name"`
 	FromLocationName            *string                    `json:"from_location_name"`
@@ -152,6 +191,12 @@ func (q *Queries) GetLocationTransferByID(ctx context.Context, id string) (GetLo
 		&i.Reason,
 		&i.Status,
 		&i.RejectionReason,
+		&i.CancellationReason,
+		&i.HandoverNotes,
+		&i.CreatedByUserID,
+		&i.CreatedAt,
+		&i.ApprovedAt,
+		&i.RejectedAt,
 		&i.ClientFirstName,
 		&i.ClientLastName,
 		&i.FromLocationName,
@@ -170,20 +215,28 @@ SELECT
     COUNT(*) FILTER (WHERE status = 'pending') as pending_count,
     COUNT(*) FILTER (WHERE status = 'approved') as approved_count,
     COUNT(*) FILTER (WHERE status = 'rejected') as rejected_count,
+    COUNT(*) FILTER (WHERE status = 'cancelled') as cancelled_count,
     CASE 
         WHEN COUNT(*) FILTER (WHERE status IN ('approved', 'rejected')) > 0 THEN 
             ROUND((COUNT(*) FILTER (WHERE status = 'approved')::DECIMAL / COUNT(*) FILTER (WHERE status IN ('approved', 'rejected'))::DECIMAL) * 100, 2)
         ELSE 0
-    END as approval_rate
+    END as approval_rate,
+    -- Average hours from request to approval or rejection; 0 before any decision
+    COALESCE(
+        EXTRACT(EPOCH FROM AVG(COALESCE(approved_at, rejected_at) - created_at)) / 3600,
+        0
+    )::DOUBLE PRECISION as avg_decision_hours
 FROM client_location_transfers
 `
 
 type GetLocationTransferStatsRow struct {
-	TotalCount    int64 `json:"total_count"`
-	PendingCount  int64 `json:"pending_count"`
-	ApprovedCount int64 `json:"approved_count"`
-	RejectedCount int64 `json:"rejected_count"`
-	ApprovalRate  int32 `json:"approval_rate"`
+	TotalCount       int64   `json:"total_count"`
+	PendingCount     int64   `json:"pending_count"`
+	ApprovedCount    int64   `json:"approved_count"`
+	RejectedCount    int64   `json:"rejected_count"`
+	CancelledCount   int64   `json:"cancelled_count"`
+	ApprovalRate     int32   `json:"approval_rate"`
+	AvgDecisionHours float64 `json:"avg_decision_hours"`
 }
 
 func (q *Queries) GetLocationTransferStats(ctx context.Context) (GetLocationTransferStatsRow, error) {
@@ -194,7 +247,9 @@ func (q *Queries) GetLocationTransferStats(ctx context.Context) (GetLocationTran
 		&i.PendingCount,
 		&i.ApprovedCount,
 		&i.RejectedCount,
+		&i.CancelledCount,
 		&i.ApprovalRate,
+		&i.AvgDecisionHours,
 	)
 	return i, err
 }
@@ -211,6 +266,8 @@ SELECT
     clt.reason,
     clt.status,
     clt.rejection_reason,
+    clt.cancellation_reason,
+    clt.handover_notes,
     c.first_name AS client_first_name,
     c.last_name AS client_last_name,
     l_from.name AS from_location_name,
@@ -253,6 +310,8 @@ type ListLocationTransfersRow struct {
 	Reason                      *string                    `json:"reason"`
 	Status                      LocationTransferStatusEnum `json:"status"`
 	RejectionReason             *string                    `json:"rejection_reason"`
+	CancellationReason          *string                    `json:"cancellation_reason"`
+	HandoverNotes               *string                    `json:"handover_notes"`
 	ClientFirstName             string                     `json:"client_first_name"`
 	ClientLastName              string                     `json:"client_last_name"`
 	FromLocationName            *string                    `json:"from_location_name"`
@@ -284,6 +343,8 @@ func (q *Queries) ListLocationTransfers(ctx context.Context, arg ListLocationTra
 			&i.Reason,
 			&i.Status,
 			&i.RejectionReason,
+			&i.CancellationReason,
+			&i.HandoverNotes,
 			&i.ClientFirstName,
 			&i.ClientLastName,
 			&i.FromLocationName,
@@ -306,7 +367,7 @@ func (q *Queries) ListLocationTransfers(ctx context.Context, arg ListLocationTra
 
 const refuseLocationTransfer = `-- name: RefuseLocationTransfer :exec
 UPDATE client_location_transfers
-SET status = 'rejected', rejection_reason = $2, updated_at = NOW()
+SET status = 'rejected', rejection_reason = $2, rejected_at = NOW(), updated_at = NOW()
 WHERE id = $1 AND status = 'pending'
 `
 
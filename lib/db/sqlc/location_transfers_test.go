@@ -494,6 +494,10 @@ func TestConfirmLocationTransfer(t *testing.T) {
 				require.NoError(t, err)
 				assert.Equal(t, LocationTransferStatusEnumApproved, result.Status)
 				assert.True(t, result.TransferDate.Valid)
+				require.NotNil(t, result.HandoverNotes)
+				assert.Equal(t, "Evaluation due next week", *result.HandoverNotes)
+				assert.True(t, result.ApprovedAt.Valid)
+				assert.False(t, result.RejectedAt.Valid)
 			},
 		},
 		{
@@ -519,7 +523,7 @@ func TestConfirmLocationTransfer(t *testing.T) {
 					TransferDate:         toPgTimestamp(time.Now()),
 				})
 				// Approve first
-				q.ConfirmLocationTransfer(ctx, id)
+				q.ConfirmLocationTransfer(ctx, ConfirmLocationTransferParams{ID: id})
 				return id
 			},
 			wantErr: false, // No error, but status won't change (WHERE status = 'pending')
@@ -569,7 +573,10 @@ func TestConfirmLocationTransfer(t *testing.T) {
 				ctx := context.Background()
 				id := tt.setup(t, q)
 
-				err := q.ConfirmLocationTransfer(ctx, id)
+				err := q.ConfirmLocationTransfer(ctx, ConfirmLocationTransferParams{
+					ID:            id,
+					HandoverNotes: strPtr("Evaluation due next week"),
+				})
 
 				if tt.wantErr {
 					require.Error(t, err)
@@ -623,6 +630,8 @@ func TestRefuseLocationTransfer(t *testing.T) {
 				require.NoError(t, err)
 				assert.Equal(t, LocationTransferStatusEnumRejected, result.Status)
 				assert.Equal(t, "Location at capacity", *result.RejectionReason)
+				assert.True(t, result.RejectedAt.Valid)
+				assert.False(t, result.ApprovedAt.Valid)
 			},
 		},
 		{
@@ -713,6 +722,91 @@ func TestRefuseLocationTransfer(t *testing.T) {
 	}
 }
 
+// ============================================================
+// Test: CancelLocationTransfer
+// ============================================================
+
+func TestCancelLocationTransfer(t *testing.T) {
+	tests := []struct {
+		name         string
+		setup        func(t *testing.T, q *Queries) string
+		wantAffected int64
+		validate     func(t *testing.T, q *Queries, id string)
+	}{
+		{
+			name:         "pending_is_cancelled",
+			wantAffected: 1,
+			setup: func(t *testing.T, q *Queries) string {
+				ctx := context.Background()
+				deps := createLocationTransferDeps(t, q)
+				id := generateTestID()
+				_, err := q.CreateLocationTransfer(ctx, CreateLocationTransferParams{
+					ID:                   id,
+					ClientID:             deps.ClientID,
+					FromLocationID:       &deps.FromLocationID,
+					ToLocationID:         deps.ToLocationID,
+					CurrentCoordinatorID: deps.CurrentCoordinatorID,
+					NewCoordinatorID:     deps.NewCoordinatorID,
+					TransferDate:         toPgTimestamp(time.Now()),
+				})
+				require.NoError(t, err)
+				return id
+			},
+			validate: func(t *testing.T, q *Queries, id string) {
+				result, err := q.GetLocationTransferByID(context.Background(), id)
+				require.NoError(t, err)
+				assert.Equal(t, LocationTransferStatusEnumCancelled, result.Status)
+				require.NotNil(t, result.CancellationReason)
+				assert.Equal(t, "Client moved out of region", *result.CancellationReason)
+			},
+		},
+		{
+			name: "already_approved_unchanged",
+			setup: func(t *testing.T, q *Queries) string {
+				ctx := context.Background()
+				deps := createLocationTransferDeps(t, q)
+				id := generateTestID()
+				_, err := q.CreateLocationTransfer(ctx, CreateLocationTransferParams{
+					ID:                   id,
+					ClientID:             deps.ClientID,
+					FromLocationID:       &deps.FromLocationID,
+					ToLocationID:         deps.ToLocationID,
+					CurrentCoordinatorID: deps.CurrentCoordinatorID,
+					NewCoordinatorID:     deps.NewCoordinatorID,
+					TransferDate:         toPgTimestamp(time.Now()),
+				})
+				require.NoError(t, err)
+				require.NoError(t, q.ConfirmLocationTransfer(ctx, ConfirmLocationTransferParams{ID: id}))
+				return id
+			},
+			validate: func(t *testing.T, q *Queries, id string) {
+				result, err := q.GetLocationTransferByID(context.Background(), id)
+				require.NoError(t, err)
+				// WHERE status = 'pending' leaves approved transfers alone
+				assert.Equal(t, LocationTransferStatusEnumApproved, result.Status)
+				assert.Nil(t, result.CancellationReason)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runTestWithTx(t, func(t *testing.T, q *Queries) {
+				id := tt.setup(t, q)
+
+				affected, err := q.CancelLocationTransfer(context.Background(), CancelLocationTransferParams{
+					ID:                 id,
+					CancellationReason: strPtr("Client moved out of region"),
+				})
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantAffected, affected)
+
+				tt.validate(t, q, id)
+			})
+		})
+	}
+}
+
 // ============================================================
 // Test: UpdateLocationTransfer
 // ============================================================
@@ -838,7 +932,7 @@ func TestUpdateLocationTransfer(t *testing.T) {
 					Reason:               strPtr("Original"),
 				})
 				// Approve it
-				q.ConfirmLocationTransfer(ctx, id)
+				q.ConfirmLocationTransfer(ctx, ConfirmLocationTransferParams{ID: id})
 				return id, UpdateLocationTransferParams{
 					ID:     id,
 					Reason: strPtr("Cannot update this"),
@@ -896,6 +990,7 @@ func TestGetLocationTransferStats(t *testing.T) {
 				assert.Equal(t, int64(0), stats.ApprovedCount)
 				assert.Equal(t, int64(0), stats.RejectedCount)
 				assert.Equal(t, int32(0), stats.ApprovalRate)
+				assert.Equal(t, float64(0), stats.AvgDecisionHours)
 			},
 		},
 		{
@@ -941,7 +1036,7 @@ func TestGetLocationTransferStats(t *testing.T) {
 						NewCoordinatorID:     deps.NewCoordinatorID,
 						TransferDate:         toPgTimestamp(time.Now()),
 					})
-					q.ConfirmLocationTransfer(ctx, id)
+					q.ConfirmLocationTransfer(ctx, ConfirmLocationTransferParams{ID: id})
 				}
 
 				// Create 2 rejected (makes 50% approval rate - a whole number)
@@ -972,6 +1067,48 @@ func TestGetLocationTransferStats(t *testing.T) {
 				assert.Equal(t, int32(50), stats.ApprovalRate)
 			},
 		},
+		{
+			name: "cancelled_excluded_from_approval_rate",
+			setup: func(t *testing.T, q *Queries) {
+				ctx := context.Background()
+				statuses := []LocationTransferStatusEnum{
+					LocationTransferStatusEnumApproved,
+					LocationTransferStatusEnumRejected,
+					LocationTransferStatusEnumCancelled,
+					LocationTransferStatusEnumCancelled,
+				}
+				for _, status := range statuses {
+					deps := createLocationTransferDeps(t, q)
+					id := generateTestID()
+					q.CreateLocationTransfer(ctx, CreateLocationTransferParams{
+						ID:                   id,
+						ClientID:             deps.ClientID,
+						FromLocationID:       &deps.FromLocationID,
+						ToLocationID:         deps.ToLocationID,
+						CurrentCoordinatorID: deps.CurrentCoordinatorID,
+						NewCoordinatorID:     deps.NewCoordinatorID,
+						TransferDate:         toPgTimestamp(time.Now()),
+					})
+					switch status {
+					case LocationTransferStatusEnumApproved:
+						q.ConfirmLocationTransfer(ctx, ConfirmLocationTransferParams{ID: id})
+					case LocationTransferStatusEnumRejected:
+						q.RefuseLocationTransfer(ctx, RefuseLocationTransferParams{ID: id})
+					case LocationTransferStatusEnumCancelled:
+						q.CancelLocationTransfer(ctx, CancelLocationTransferParams{ID: id})
+					}
+				}
+			},
+			validate: func(t *testing.T, stats GetLocationTransferStatsRow) {
+				assert.Equal(t, int64(4), stats.TotalCount)
+				assert.Equal(t, int64(0), stats.PendingCount)
+				assert.Equal(t, int64(1), stats.ApprovedCount)
+				assert.Equal(t, int64(1), stats.RejectedCount)
+				assert.Equal(t, int64(2), stats.CancelledCount)
+				// Approval rate: 1 approved / 2 decided; cancellations are not decisions
+				assert.Equal(t, int32(50), stats.ApprovalRate)
+			},
+		},
 		{
 			name: "all_approved",
 			setup: func(t *testing.T, q *Queries) {
@@ -988,7 +1125,7 @@ func TestGetLocationTransferStats(t *testing.T) {
 						NewCoordinatorID:     deps.NewCoordinatorID,
 						TransferDate:         toPgTimestamp(time.Now()),
 					})
-					q.ConfirmLocationTransfer(ctx, id)
+					q.ConfirmLocationTransfer(ctx, ConfirmLocationTransferParams{ID: id})
 				}
 			},
 			validate: func(t *testing.T, stats GetLocationTransferStatsRow) {
@@ -999,6 +1136,42 @@ func TestGetLocationTransferStats(t *testing.T) {
 				assert.Equal(t, int32(100), stats.ApprovalRate)
 			},
 		},
+		{
+			name: "average_time_to_decision",
+			setup: func(t *testing.T, q *Queries) {
+				ctx := context.Background()
+				for i, hoursAgo := range []int32{24, 48, 96} {
+					deps := createLocationTransferDeps(t, q)
+					id := generateTestID()
+					q.CreateLocationTransfer(ctx, CreateLocationTransferParams{
+						ID:                   id,
+						ClientID:             deps.ClientID,
+						FromLocationID:       &deps.FromLocationID,
+						ToLocationID:         deps.ToLocationID,
+						CurrentCoordinatorID: deps.CurrentCoordinatorID,
+						NewCoordinatorID:     deps.NewCoordinatorID,
+						TransferDate:         toPgTimestamp(time.Now()),
+					})
+					// NOW() is fixed within the test transaction, so backdate the request
+					_, err := q.db.Exec(ctx,
+						`UPDATE client_location_transfers SET created_at = NOW() - make_interval(hours => $2) WHERE id = $1`,
+						id, hoursAgo)
+					require.NoError(t, err)
+					switch i {
+					case 0:
+						q.ConfirmLocationTransfer(ctx, ConfirmLocationTransferParams{ID: id})
+					case 1:
+						q.RefuseLocationTransfer(ctx, RefuseLocationTransferParams{ID: id})
+					}
+					// The third stays pending and is left out of the average
+				}
+			},
+			validate: func(t *testing.T, stats GetLocationTransferStatsRow) {
+				assert.Equal(t, int64(1), stats.PendingCount)
+				// (24 + 48) / 2 decided transfers
+				assert.InDelta(t, 36, stats.AvgDecisionHours, 0.01)
+			},
+		},
 	}
 
 	for _, tt := range tests {
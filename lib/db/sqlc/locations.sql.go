@@ -7,8 +7,26 @@ package db
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const addLocationCareTypes = `-- name: AddLocationCareTypes :exec
+INSERT INTO location_care_types (location_id, care_type)
+SELECT $1, unnest($2::text[])::care_type_enum
+ON CONFLICT DO NOTHING
+`
+
+type AddLocationCareTypesParams struct {
+	LocationID string   `json:"location_id"`
+	CareTypes  []string `json:"care_types"`
+}
+
+func (q *Queries) AddLocationCareTypes(ctx context.Context, arg AddLocationCareTypesParams) error {
+	_, err := q.db.Exec(ctx, addLocationCareTypes, arg.LocationID, arg.CareTypes)
+	return err
+}
+
 const createLocation = `-- name: CreateLocation :exec
 INSERT INTO locations (
    id,
@@ -16,18 +34,20 @@ INSERT INTO locations (
    postal_code,
    address,
    capacity,
-   occupied
+   occupied,
+   organization_id
    )
-VALUES ($1, $2, $3, $4, $5, $6)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
 `
 
 type CreateLocationParams struct {
-	ID         string `json:"id"`
-	Name       string `json:"name"`
-	PostalCode string `json:"postal_code"`
-	Address    string `json:"address"`
-	Capacity   int32  `json:"capacity"`
-	Occupied   int32  `json:"occupied"`
+	ID             string  `json:"id"`
+	Name           string  `json:"name"`
+	PostalCode     string  `json:"postal_code"`
+	Address        string  `json:"address"`
+	Capacity       int32   `json:"capacity"`
+	Occupied       int32   `json:"occupied"`
+	OrganizationID *string `json:"organization_id"`
 }
 
 func (q *Queries) CreateLocation(ctx context.Context, arg CreateLocationParams) error {
@@ -38,6 +58,7 @@ func (q *Queries) CreateLocation(ctx context.Context, arg CreateLocationParams)
 		arg.Address,
 		arg.Capacity,
 		arg.Occupied,
+		arg.OrganizationID,
 	)
 	return err
 }
@@ -53,6 +74,59 @@ func (q *Queries) DecrementLocationOccupied(ctx context.Context, id string) erro
 	return err
 }
 
+const deleteLocationCareTypes = `-- name: DeleteLocationCareTypes :exec
+DELETE FROM location_care_types WHERE location_id = $1
+`
+
+func (q *Queries) DeleteLocationCareTypes(ctx context.Context, locationID string) error {
+	_, err := q.db.Exec(ctx, deleteLocationCareTypes, locationID)
+	return err
+}
+
+const getLocationByID = `-- name: GetLocationByID :one
+SELECT
+    l.id,
+    l.name,
+    l.postal_code,
+    l.address,
+    l.capacity,
+    l.occupied
+FROM locations l
+WHERE l.id = $1
+  AND l.is_deleted = FALSE
+  AND ($2::text IS NULL OR l.organization_id = $2::text)
+`
+
+type GetLocationByIDParams struct {
+	ID             string  `json:"id"`
+	OrganizationID *string `json:"organization_id"`
+}
+
+type GetLocationByIDRow struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	PostalCode string `json:"postal_code"`
+	Address    string `json:"address"`
+	Capacity   int32  `json:"capacity"`
+	Occupied   int32  `json:"occupied"`
+}
+
+// No rows when the location is missing, deleted or, when organization_id is
+// set, owned by another organization
+func (q *Queries) GetLocationByID(ctx context.Context, arg GetLocationByIDParams) (GetLocationByIDRow, error) {
+	row := q.db.QueryRow(ctx, getLocationByID, arg.ID, arg.OrganizationID)
+	var i GetLocationByIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.PostalCode,
+		&i.Address,
+		&i.Capacity,
+		&i.Occupied,
+	)
+	return i, err
+}
+
 const getLocationCapacityStats = `-- name: GetLocationCapacityStats :one
 SELECT 
     COALESCE(SUM(l.capacity), 0) as total_capacity,
@@ -61,6 +135,7 @@ SELECT
 FROM locations l
 LEFT JOIN clients c ON c.assigned_location_id = l.id
 WHERE l.is_deleted = FALSE
+  AND ($1::text IS NULL OR l.organization_id = $1::text)
 `
 
 type GetLocationCapacityStatsRow struct {
@@ -69,13 +144,54 @@ type GetLocationCapacityStatsRow struct {
 	FreeCapacity  int32       `json:"free_capacity"`
 }
 
-func (q *Queries) GetLocationCapacityStats(ctx context.Context) (GetLocationCapacityStatsRow, error) {
-	row := q.db.QueryRow(ctx, getLocationCapacityStats)
+func (q *Queries) GetLocationCapacityStats(ctx context.Context, organizationID *string) (GetLocationCapacityStatsRow, error) {
+	row := q.db.QueryRow(ctx, getLocationCapacityStats, organizationID)
 	var i GetLocationCapacityStatsRow
 	err := row.Scan(&i.TotalCapacity, &i.CapacityUsed, &i.FreeCapacity)
 	return i, err
 }
 
+const getLocationCapacityTrend = `-- name: GetLocationCapacityTrend :many
+SELECT snapshot_date, capacity, occupied
+FROM location_capacity_snapshots
+WHERE location_id = $1
+  AND snapshot_date BETWEEN $2::date AND $3::date
+ORDER BY snapshot_date ASC
+`
+
+type GetLocationCapacityTrendParams struct {
+	LocationID string      `json:"location_id"`
+	StartDate  pgtype.Date `json:"start_date"`
+	EndDate    pgtype.Date `json:"end_date"`
+}
+
+type GetLocationCapacityTrendRow struct {
+	SnapshotDate pgtype.Date `json:"snapshot_date"`
+	Capacity     int32       `json:"capacity"`
+	Occupied     int32       `json:"occupied"`
+}
+
+// Daily snapshots of one location between two dates (inclusive), oldest first
+func (q *Queries) GetLocationCapacityTrend(ctx context.Context, arg GetLocationCapacityTrendParams) ([]GetLocationCapacityTrendRow, error) {
+	rows, err := q.db.Query(ctx, getLocationCapacityTrend, arg.LocationID, arg.StartDate, arg.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetLocationCapacityTrendRow{}
+	for rows.Next() {
+		var i GetLocationCapacityTrendRow
+		if err := rows.Scan(&i.SnapshotDate, &i.Capacity, &i.Occupied); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const incrementLocationOccupied = `-- name: IncrementLocationOccupied :exec
 UPDATE locations
 SET occupied = occupied + 1, updated_at = NOW()
@@ -95,6 +211,12 @@ SELECT
     l.address,
     l.capacity,
     l.occupied,
+    ARRAY(
+        SELECT lct.care_type::text
+        FROM location_care_types lct
+        WHERE lct.location_id = l.id
+        ORDER BY lct.care_type
+    )::text[] AS care_types,
     COUNT(*) OVER() as total_count
 FROM locations l
 WHERE
@@ -103,35 +225,113 @@ WHERE
      LOWER(l.name) LIKE LOWER('%' || $3::text || '%') OR
      LOWER(l.postal_code) LIKE LOWER('%' || $3::text || '%') OR
      LOWER(l.address) LIKE LOWER('%' || $3::text || '%'))
+    AND ($4::text IS NULL OR l.organization_id = $4::text)
 ORDER BY l.name
 LIMIT $1 OFFSET $2
 `
 
 type ListLocationsParams struct {
-	Limit  int32   `json:"limit"`
-	Offset int32   `json:"offset"`
-	Search *string `json:"search"`
+	Limit          int32   `json:"limit"`
+	Offset         int32   `json:"offset"`
+	Search         *string `json:"search"`
+	OrganizationID *string `json:"organization_id"`
 }
 
 type ListLocationsRow struct {
+	ID         string   `json:"id"`
+	Name       string   `json:"name"`
+	PostalCode string   `json:"postal_code"`
+	Address    string   `json:"address"`
+	Capacity   int32    `json:"capacity"`
+	Occupied   int32    `json:"occupied"`
+	CareTypes  []string `json:"care_types"`
+	TotalCount int64    `json:"total_count"`
+}
+
+func (q *Queries) ListLocations(ctx context.Context, arg ListLocationsParams) ([]ListLocationsRow, error) {
+	rows, err := q.db.Query(ctx, listLocations,
+		arg.Limit,
+		arg.Offset,
+		arg.Search,
+		arg.OrganizationID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListLocationsRow{}
+	for rows.Next() {
+		var i ListLocationsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.PostalCode,
+			&i.Address,
+			&i.Capacity,
+			&i.Occupied,
+			&i.CareTypes,
+			&i.TotalCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPlacementCandidatesForOrganization = `-- name: ListPlacementCandidatesForOrganization :many
+SELECT
+    l.id,
+    l.name,
+    l.postal_code,
+    l.address,
+    l.capacity,
+    l.occupied,
+    (l.capacity - l.occupied)::int AS available
+FROM locations l
+WHERE
+    l.organization_id = $1::text
+    AND l.is_deleted = FALSE
+    AND l.occupied < l.capacity
+    AND (
+        NOT EXISTS (SELECT 1 FROM location_care_types lct WHERE lct.location_id = l.id)
+        OR EXISTS (
+            SELECT 1 FROM location_care_types lct
+            WHERE lct.location_id = l.id AND lct.care_type = $2::care_type_enum
+        )
+    )
+ORDER BY available DESC, l.name
+`
+
+type ListPlacementCandidatesForOrganizationParams struct {
+	OrganizationID string       `json:"organization_id"`
+	CareType       CareTypeEnum `json:"care_type"`
+}
+
+type ListPlacementCandidatesForOrganizationRow struct {
 	ID         string `json:"id"`
 	Name       string `json:"name"`
 	PostalCode string `json:"postal_code"`
 	Address    string `json:"address"`
 	Capacity   int32  `json:"capacity"`
 	Occupied   int32  `json:"occupied"`
-	TotalCount int64  `json:"total_count"`
+	Available  int32  `json:"available"`
 }
 
-func (q *Queries) ListLocations(ctx context.Context, arg ListLocationsParams) ([]ListLocationsRow, error) {
-	rows, err := q.db.Query(ctx, listLocations, arg.Limit, arg.Offset, arg.Search)
+// Active locations of the organization with a free bed that offer the care type
+// (or list no care types at all), most available beds first
+func (q *Queries) ListPlacementCandidatesForOrganization(ctx context.Context, arg ListPlacementCandidatesForOrganizationParams) ([]ListPlacementCandidatesForOrganizationRow, error) {
+	rows, err := q.db.Query(ctx, listPlacementCandidatesForOrganization, arg.OrganizationID, arg.CareType)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []ListLocationsRow{}
+	items := []ListPlacementCandidatesForOrganizationRow{}
 	for rows.Next() {
-		var i ListLocationsRow
+		var i ListPlacementCandidatesForOrganizationRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.Name,
@@ -139,7 +339,7 @@ func (q *Queries) ListLocations(ctx context.Context, arg ListLocationsParams) ([
 			&i.Address,
 			&i.Capacity,
 			&i.Occupied,
-			&i.TotalCount,
+			&i.Available,
 		); err != nil {
 			return nil, err
 		}
@@ -151,16 +351,64 @@ func (q *Queries) ListLocations(ctx context.Context, arg ListLocationsParams) ([
 	return items, nil
 }
 
-const softDeleteLocation = `-- name: SoftDeleteLocation :exec
-UPDATE locations SET is_deleted = TRUE, updated_at = NOW() WHERE id = $1
+const reserveLocationCapacity = `-- name: ReserveLocationCapacity :one
+UPDATE locations
+SET occupied = occupied + 1, updated_at = NOW()
+WHERE id = $1 AND occupied < capacity AND is_deleted = FALSE
+RETURNING occupied
 `
 
-func (q *Queries) SoftDeleteLocation(ctx context.Context, id string) error {
-	_, err := q.db.Exec(ctx, softDeleteLocation, id)
-	return err
+// Increment occupancy only while the location has free capacity; returns no rows when it is full
+func (q *Queries) ReserveLocationCapacity(ctx context.Context, id string) (int32, error) {
+	row := q.db.QueryRow(ctx, reserveLocationCapacity, id)
+	var occupied int32
+	err := row.Scan(&occupied)
+	return occupied, err
+}
+
+const snapshotLocationCapacity = `-- name: SnapshotLocationCapacity :execrows
+INSERT INTO location_capacity_snapshots (location_id, snapshot_date, capacity, occupied)
+SELECT id, CURRENT_DATE, capacity, occupied
+FROM locations
+WHERE is_deleted = FALSE
+ON CONFLICT (location_id, snapshot_date) DO UPDATE SET
+    capacity = EXCLUDED.capacity,
+    occupied = EXCLUDED.occupied
+`
+
+// Records today's capacity and occupancy for every active location; later runs
+// on the same day overwrite that day's snapshot
+func (q *Queries) SnapshotLocationCapacity(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, snapshotLocationCapacity)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const softDeleteLocation = `-- name: SoftDeleteLocation :execrows
+UPDATE locations SET is_deleted = TRUE, updated_at = NOW()
+WHERE id = $1
+  AND is_deleted = FALSE
+  AND ($2::text IS NULL OR organization_id = $2::text)
+`
+
+type SoftDeleteLocationParams struct {
+	ID             string  `json:"id"`
+	OrganizationID *string `json:"organization_id"`
+}
+
+// Zero rows means the location is missing, already deleted or, when
+// organization_id is set, owned by another organization
+func (q *Queries) SoftDeleteLocation(ctx context.Context, arg SoftDeleteLocationParams) (int64, error) {
+	result, err := q.db.Exec(ctx, softDeleteLocation, arg.ID, arg.OrganizationID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
-const updateLocation = `-- name: UpdateLocation :exec
+const updateLocation = `-- name: UpdateLocation :execrows
 UPDATE locations SET
     name = COALESCE($2, name),
     postal_code = COALESCE($3, postal_code),
@@ -169,25 +417,34 @@ UPDATE locations SET
     occupied = COALESCE($6, occupied),
     updated_at = NOW()
 WHERE id = $1
+  AND is_deleted = FALSE
+  AND ($7::text IS NULL OR organization_id = $7::text)
 `
 
 type UpdateLocationParams struct {
-	ID         string  `json:"id"`
-	Name       *string `json:"name"`
-	PostalCode *string `json:"postal_code"`
-	Address    *string `json:"address"`
-	Capacity   *int32  `json:"capacity"`
-	Occupied   *int32  `json:"occupied"`
+	ID             string  `json:"id"`
+	Name           *string `json:"name"`
+	PostalCode     *string `json:"postal_code"`
+	Address        *string `json:"address"`
+	Capacity       *int32  `json:"capacity"`
+	Occupied       *int32  `json:"occupied"`
+	OrganizationID *string `json:"organization_id"`
 }
 
-func (q *Queries) UpdateLocation(ctx context.Context, arg UpdateLocationParams) error {
-	_, err := q.db.Exec(ctx, updateLocation,
+// Zero rows means the location is missing, deleted or, when organization_id
+// is set, owned by another organization
+func (q *Queries) UpdateLocation(ctx context.Context, arg UpdateLocationParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateLocation,
 		arg.ID,
 		arg.Name,
 		arg.PostalCode,
 		arg.Address,
 		arg.Capacity,
 		arg.Occupied,
+		arg.OrganizationID,
 	)
-	return err
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
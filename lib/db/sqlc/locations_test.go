@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -83,6 +84,61 @@ func TestCreateLocation(t *testing.T) {
 				assert.True(t, IsUniqueViolation(err), "expected unique violation, got: %v", err)
 			},
 		},
+		{
+			name: "duplicate_active_name_in_organization",
+			setup: func(t *testing.T, q *Queries) CreateLocationParams {
+				orgID := CreateTestOrganization(t, q)
+				CreateTestLocation(t, q, CreateTestLocationOptions{Name: strPtr("North"), OrganizationID: &orgID})
+				return CreateLocationParams{
+					ID:             generateTestID(),
+					Name:           "North",
+					PostalCode:     "1111AA",
+					Address:        "1 North Street",
+					Capacity:       10,
+					OrganizationID: &orgID,
+				}
+			},
+			wantErr: true,
+			checkErr: func(t *testing.T, err error) {
+				assert.True(t, IsUniqueViolationOf(err, "uq_locations_active_name"),
+					"expected active name violation, got: %v", err)
+			},
+		},
+		{
+			name: "name_of_deleted_location",
+			setup: func(t *testing.T, q *Queries) CreateLocationParams {
+				orgID := CreateTestOrganization(t, q)
+				deletedID := CreateTestLocation(t, q, CreateTestLocationOptions{Name: strPtr("North"), OrganizationID: &orgID})
+				_, err := q.SoftDeleteLocation(context.Background(), SoftDeleteLocationParams{ID: deletedID})
+				require.NoError(t, err)
+				return CreateLocationParams{
+					ID:             generateTestID(),
+					Name:           "North",
+					PostalCode:     "1111AA",
+					Address:        "1 North Street",
+					Capacity:       10,
+					OrganizationID: &orgID,
+				}
+			},
+			wantErr: false,
+		},
+		{
+			name: "same_name_in_other_organization",
+			setup: func(t *testing.T, q *Queries) CreateLocationParams {
+				orgID := CreateTestOrganization(t, q)
+				otherOrgID := CreateTestOrganization(t, q)
+				CreateTestLocation(t, q, CreateTestLocationOptions{Name: strPtr("North"), OrganizationID: &otherOrgID})
+				return CreateLocationParams{
+					ID:             generateTestID(),
+					Name:           "North",
+					PostalCode:     "1111AA",
+					Address:        "1 North Street",
+					Capacity:       10,
+					OrganizationID: &orgID,
+				}
+			},
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -141,6 +197,25 @@ func TestListLocations(t *testing.T) {
 				assert.Equal(t, int64(2), results[0].TotalCount)
 			},
 		},
+		{
+			name: "with_care_types",
+			setup: func(t *testing.T, q *Queries) {
+				id := CreateTestLocation(t, q, CreateTestLocationOptions{Name: strPtr("Location A")})
+				CreateTestLocation(t, q, CreateTestLocationOptions{Name: strPtr("Location B")})
+				err := q.AddLocationCareTypes(context.Background(), AddLocationCareTypesParams{
+					LocationID: id,
+					CareTypes:  []string{"semi_independent_living", "protected_living"},
+				})
+				require.NoError(t, err)
+			},
+			params: ListLocationsParams{Limit: 10, Offset: 0},
+			validate: func(t *testing.T, results []ListLocationsRow) {
+				require.Len(t, results, 2)
+				assert.Equal(t, []string{"protected_living", "semi_independent_living"}, results[0].CareTypes)
+				// A location without care types accepts every care type
+				assert.Empty(t, results[1].CareTypes)
+			},
+		},
 		{
 			name: "with_pagination",
 			setup: func(t *testing.T, q *Queries) {
@@ -230,6 +305,19 @@ func TestListLocations(t *testing.T) {
 				assert.Equal(t, "UPPER CASE", results[0].Name)
 			},
 		},
+		{
+			// The API escapes wildcards in search input, so "50%" matches literally
+			name: "search_escaped_wildcard",
+			setup: func(t *testing.T, q *Queries) {
+				CreateTestLocation(t, q, CreateTestLocationOptions{Name: strPtr("50% Zorg")})
+				CreateTestLocation(t, q, CreateTestLocationOptions{Name: strPtr("500 Zorg")})
+			},
+			params: ListLocationsParams{Limit: 10, Offset: 0, Search: strPtr(`50\%`)},
+			validate: func(t *testing.T, results []ListLocationsRow) {
+				require.Len(t, results, 1)
+				assert.Equal(t, "50% Zorg", results[0].Name)
+			},
+		},
 		{
 			name: "search_no_match",
 			setup: func(t *testing.T, q *Queries) {
@@ -246,7 +334,7 @@ func TestListLocations(t *testing.T) {
 				ctx := context.Background()
 				// Create and soft delete one
 				id := CreateTestLocation(t, q, CreateTestLocationOptions{Name: strPtr("Deleted")})
-				q.SoftDeleteLocation(ctx, id)
+				q.SoftDeleteLocation(ctx, SoftDeleteLocationParams{ID: id})
 
 				// Create active one
 				CreateTestLocation(t, q, CreateTestLocationOptions{Name: strPtr("Active")})
@@ -461,7 +549,7 @@ func TestUpdateLocation(t *testing.T) {
 				ctx := context.Background()
 				id, params := tt.setup(t, q)
 
-				err := q.UpdateLocation(ctx, params)
+				updated, err := q.UpdateLocation(ctx, params)
 
 				if tt.wantErr {
 					require.Error(t, err)
@@ -469,6 +557,7 @@ func TestUpdateLocation(t *testing.T) {
 				}
 
 				require.NoError(t, err)
+				assert.Equal(t, int64(1), updated)
 				if tt.validate != nil {
 					tt.validate(t, q, id)
 				}
@@ -477,6 +566,38 @@ func TestUpdateLocation(t *testing.T) {
 	}
 }
 
+// ============================================================
+// Test: GetLocationByID
+// ============================================================
+
+func TestGetLocationByID(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		orgID := CreateTestOrganization(t, q)
+		otherOrgID := CreateTestOrganization(t, q)
+
+		id := CreateTestLocation(t, q, CreateTestLocationOptions{Name: strPtr("North"), OrganizationID: &orgID})
+		deletedID := CreateTestLocation(t, q, CreateTestLocationOptions{OrganizationID: &orgID})
+		_, err := q.SoftDeleteLocation(ctx, SoftDeleteLocationParams{ID: deletedID})
+		require.NoError(t, err)
+
+		location, err := q.GetLocationByID(ctx, GetLocationByIDParams{ID: id, OrganizationID: &orgID})
+		require.NoError(t, err)
+		assert.Equal(t, id, location.ID)
+		assert.Equal(t, "North", location.Name)
+
+		// Unscoped callers see every organization's locations
+		_, err = q.GetLocationByID(ctx, GetLocationByIDParams{ID: id})
+		require.NoError(t, err)
+
+		_, err = q.GetLocationByID(ctx, GetLocationByIDParams{ID: id, OrganizationID: &otherOrgID})
+		assert.ErrorIs(t, err, pgx.ErrNoRows, "another organization's location")
+
+		_, err = q.GetLocationByID(ctx, GetLocationByIDParams{ID: deletedID, OrganizationID: &orgID})
+		assert.ErrorIs(t, err, pgx.ErrNoRows, "deleted location")
+	})
+}
+
 // ============================================================
 // Test: SoftDeleteLocation
 // ============================================================
@@ -486,6 +607,7 @@ func TestSoftDeleteLocation(t *testing.T) {
 		name     string
 		setup    func(t *testing.T, q *Queries) string // returns ID to delete
 		wantErr  bool
+		wantRows int64
 		validate func(t *testing.T, q *Queries, id string)
 	}{
 		{
@@ -493,7 +615,8 @@ func TestSoftDeleteLocation(t *testing.T) {
 			setup: func(t *testing.T, q *Queries) string {
 				return CreateTestLocation(t, q, CreateTestLocationOptions{Name: strPtr("To Delete")})
 			},
-			wantErr: false,
+			wantErr:  false,
+			wantRows: 1,
 			validate: func(t *testing.T, q *Queries, id string) {
 				ctx := context.Background()
 				// Should not appear in list (is_deleted = TRUE)
@@ -521,7 +644,7 @@ func TestSoftDeleteLocation(t *testing.T) {
 			setup: func(t *testing.T, q *Queries) string {
 				ctx := context.Background()
 				id := CreateTestLocation(t, q, CreateTestLocationOptions{})
-				q.SoftDeleteLocation(ctx, id)
+				q.SoftDeleteLocation(ctx, SoftDeleteLocationParams{ID: id})
 				return id
 			},
 			wantErr: false,
@@ -543,7 +666,7 @@ func TestSoftDeleteLocation(t *testing.T) {
 				ctx := context.Background()
 				id := tt.setup(t, q)
 
-				err := q.SoftDeleteLocation(ctx, id)
+				deleted, err := q.SoftDeleteLocation(ctx, SoftDeleteLocationParams{ID: id})
 
 				if tt.wantErr {
 					require.Error(t, err)
@@ -551,6 +674,7 @@ func TestSoftDeleteLocation(t *testing.T) {
 				}
 
 				require.NoError(t, err)
+				assert.Equal(t, tt.wantRows, deleted)
 				if tt.validate != nil {
 					tt.validate(t, q, id)
 				}
@@ -851,7 +975,7 @@ func TestGetLocationCapacityStats(t *testing.T) {
 
 				// Create and soft delete location
 				deletedID := CreateTestLocation(t, q, CreateTestLocationOptions{Capacity: int32Ptr(100)})
-				q.SoftDeleteLocation(ctx, deletedID)
+				q.SoftDeleteLocation(ctx, SoftDeleteLocationParams{ID: deletedID})
 
 				// Create active location
 				CreateTestLocation(t, q, CreateTestLocationOptions{Capacity: int32Ptr(20)})
@@ -869,7 +993,7 @@ func TestGetLocationCapacityStats(t *testing.T) {
 				ctx := context.Background()
 				tt.setup(t, q)
 
-				stats, err := q.GetLocationCapacityStats(ctx)
+				stats, err := q.GetLocationCapacityStats(ctx, nil)
 
 				require.NoError(t, err)
 				tt.validate(t, stats)
@@ -877,3 +1001,145 @@ func TestGetLocationCapacityStats(t *testing.T) {
 		})
 	}
 }
+
+// ============================================================
+// Test: SnapshotLocationCapacity / GetLocationCapacityTrend
+// ============================================================
+
+func TestSnapshotLocationCapacity(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		first := CreateTestLocation(t, q, CreateTestLocationOptions{Capacity: int32Ptr(10), Occupied: int32Ptr(4)})
+		second := CreateTestLocation(t, q, CreateTestLocationOptions{Capacity: int32Ptr(6), Occupied: int32Ptr(6)})
+		deleted := CreateTestLocation(t, q, CreateTestLocationOptions{Capacity: int32Ptr(5)})
+		_, err := q.SoftDeleteLocation(ctx, SoftDeleteLocationParams{ID: deleted})
+		require.NoError(t, err)
+
+		today := toPgDate(time.Now())
+		trend := func(locationID string) []GetLocationCapacityTrendRow {
+			points, err := q.GetLocationCapacityTrend(ctx, GetLocationCapacityTrendParams{
+				LocationID: locationID,
+				StartDate:  today,
+				EndDate:    today,
+			})
+			require.NoError(t, err)
+			return points
+		}
+
+		written, err := q.SnapshotLocationCapacity(ctx)
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, written, int64(2))
+
+		require.Len(t, trend(first), 1)
+		assert.Equal(t, int32(10), trend(first)[0].Capacity)
+		assert.Equal(t, int32(4), trend(first)[0].Occupied)
+		require.Len(t, trend(second), 1)
+		assert.Equal(t, int32(6), trend(second)[0].Occupied)
+		assert.Empty(t, trend(deleted))
+
+		// A second run on the same day replaces the snapshot instead of adding one
+		require.NoError(t, q.IncrementLocationOccupied(ctx, first))
+		_, err = q.SnapshotLocationCapacity(ctx)
+		require.NoError(t, err)
+
+		points := trend(first)
+		require.Len(t, points, 1)
+		assert.Equal(t, int32(5), points[0].Occupied)
+	})
+}
+
+func TestGetLocationCapacityTrend(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		locationID := CreateTestLocation(t, q, CreateTestLocationOptions{Capacity: int32Ptr(10)})
+		otherID := CreateTestLocation(t, q, CreateTestLocationOptions{Capacity: int32Ptr(10)})
+
+		base := time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC)
+		// Inserted out of order; the day before the range must be left out
+		for _, s := range []struct {
+			locationID string
+			days       int
+			occupied   int32
+		}{
+			{locationID, 2, 7},
+			{locationID, 0, 5},
+			{locationID, 1, 6},
+			{locationID, -1, 4},
+			{otherID, 1, 9},
+		} {
+			_, err := q.db.Exec(ctx,
+				`INSERT INTO location_capacity_snapshots (location_id, snapshot_date, capacity, occupied)
+				 VALUES ($1, $2, 10, $3)`,
+				s.locationID, toPgDate(base.AddDate(0, 0, s.days)), s.occupied)
+			require.NoError(t, err)
+		}
+
+		points, err := q.GetLocationCapacityTrend(ctx, GetLocationCapacityTrendParams{
+			LocationID: locationID,
+			StartDate:  toPgDate(base),
+			EndDate:    toPgDate(base.AddDate(0, 0, 2)),
+		})
+		require.NoError(t, err)
+		require.Len(t, points, 3)
+		for i, want := range []int32{5, 6, 7} {
+			assert.Equal(t, base.AddDate(0, 0, i), points[i].SnapshotDate.Time)
+			assert.Equal(t, want, points[i].Occupied)
+			assert.Equal(t, int32(10), points[i].Capacity)
+		}
+	})
+}
+
+func TestListPlacementCandidatesForOrganization(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		orgID := CreateTestOrganization(t, q)
+		otherOrgID := CreateTestOrganization(t, q)
+
+		newLocation := func(organizationID string, capacity, occupied int32, careTypes ...string) string {
+			id := CreateTestLocation(t, q, CreateTestLocationOptions{
+				Capacity:       int32Ptr(capacity),
+				Occupied:       int32Ptr(occupied),
+				OrganizationID: &organizationID,
+			})
+			if len(careTypes) > 0 {
+				require.NoError(t, q.AddLocationCareTypes(ctx, AddLocationCareTypesParams{
+					LocationID: id,
+					CareTypes:  careTypes,
+				}))
+			}
+			return id
+		}
+
+		// A location without care types accepts every care type
+		tight := newLocation(orgID, 5, 3)
+		roomy := newLocation(orgID, 10, 2, "protected_living", "semi_independent_living")
+		newLocation(orgID, 4, 4, "protected_living")
+		newLocation(orgID, 10, 0, "ambulatory_care")
+		deleted := newLocation(orgID, 10, 0)
+		_, err := q.SoftDeleteLocation(ctx, SoftDeleteLocationParams{ID: deleted})
+		require.NoError(t, err)
+		newLocation(otherOrgID, 10, 0)
+
+		candidates, err := q.ListPlacementCandidatesForOrganization(ctx, ListPlacementCandidatesForOrganizationParams{
+			OrganizationID: orgID,
+			CareType:       CareTypeEnumProtectedLiving,
+		})
+		require.NoError(t, err)
+		require.Len(t, candidates, 2)
+		assert.Equal(t, roomy, candidates[0].ID)
+		assert.Equal(t, int32(8), candidates[0].Available)
+		assert.Equal(t, tight, candidates[1].ID)
+		assert.Equal(t, int32(2), candidates[1].Available)
+
+		// Clearing the care types opens the location to every care type again
+		require.NoError(t, q.DeleteLocationCareTypes(ctx, roomy))
+		candidates, err = q.ListPlacementCandidatesForOrganization(ctx, ListPlacementCandidatesForOrganizationParams{
+			OrganizationID: orgID,
+			CareType:       CareTypeEnumIndependentAssistedLiving,
+		})
+		require.NoError(t, err)
+		require.Len(t, candidates, 2)
+		assert.Equal(t, roomy, candidates[0].ID)
+		assert.Equal(t, tight, candidates[1].ID)
+	})
+}
@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
 	"testing"
@@ -70,38 +71,42 @@ func TestMain(m *testing.M) {
 	os.Exit(m.Run())
 }
 
-// runMigrations executes the SQL migration files against the database.
+// runMigrations executes the up migrations against the database in order.
 func runMigrations(ctx context.Context, pool *pgxpool.Pool) error {
 	// Get the path to the migrations directory
 	// This assumes tests are run from the project root or lib/db/sqlc directory
-	migrationPaths := []string{
-		"../migrations/000001_init.up.sql",           // When running from lib/db/sqlc
-		"lib/db/migrations/000001_init.up.sql",       // When running from project root
-		"../../lib/db/migrations/000001_init.up.sql", // Alternative path
+	migrationDirs := []string{
+		"../migrations",           // When running from lib/db/sqlc
+		"lib/db/migrations",       // When running from project root
+		"../../lib/db/migrations", // Alternative path
 	}
 
-	var migrationSQL []byte
-	var err error
-
-	for _, path := range migrationPaths {
-		migrationSQL, err = os.ReadFile(path)
-		if err == nil {
-			break
+	var files []string
+	for _, dir := range migrationDirs {
+		// Glob returns the files sorted, which is their version order
+		matches, err := filepath.Glob(filepath.Join(dir, "*.up.sql"))
+		if err != nil {
+			return err
 		}
-		// Try absolute path resolution
-		absPath, _ := filepath.Abs(path)
-		migrationSQL, err = os.ReadFile(absPath)
-		if err == nil {
+		if len(matches) > 0 {
+			files = matches
 			break
 		}
 	}
-
-	if err != nil {
-		return err
+	if len(files) == 0 {
+		return os.ErrNotExist
 	}
 
-	_, err = pool.Exec(ctx, string(migrationSQL))
-	return err
+	for _, file := range files {
+		migrationSQL, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		if _, err := pool.Exec(ctx, string(migrationSQL)); err != nil {
+			return fmt.Errorf("%s: %w", filepath.Base(file), err)
+		}
+	}
+	return nil
 }
 
 // runTestWithTx runs a test function within a transaction that is always rolled back.
@@ -127,6 +132,19 @@ func runTestWithTx(t *testing.T, fn func(t *testing.T, q *Queries)) {
 	fn(t, New(tx))
 }
 
+// deleteAfterTest deletes a row that a test committed through testStore, for
+// code that opens its own transaction and so cannot run inside runTestWithTx.
+// Cleanups run last-registered first, so register each row right after the
+// rows it references and dependents are deleted before their parents.
+func deleteAfterTest(t *testing.T, table, id string) {
+	t.Helper()
+	t.Cleanup(func() {
+		if _, err := testStore.ConnPool.Exec(context.Background(), "DELETE FROM "+table+" WHERE id = $1", id); err != nil {
+			t.Errorf("failed to delete %s %s after the test: %v", table, id, err)
+		}
+	})
+}
+
 // runTestWithTxAndStore runs a test function with both a transactional Queries
 // instance and access to a Store-like transaction executor.
 // Use this when testing code that needs ExecTx functionality.
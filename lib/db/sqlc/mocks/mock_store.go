@@ -14,6 +14,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	pgtype "github.com/jackc/pgx/v5/pgtype"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -41,6 +42,21 @@ func (m *MockStoreInterface) EXPECT() *MockStoreInterfaceMockRecorder {
 	return m.recorder
 }
 
+// AcquireWorkerLease mocks base method.
+func (m *MockStoreInterface) AcquireWorkerLease(ctx context.Context, arg db.AcquireWorkerLeaseParams) (db.WorkerLease, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcquireWorkerLease", ctx, arg)
+	ret0, _ := ret[0].(db.WorkerLease)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcquireWorkerLease indicates an expected call of AcquireWorkerLease.
+func (mr *MockStoreInterfaceMockRecorder) AcquireWorkerLease(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquireWorkerLease", reflect.TypeOf((*MockStoreInterface)(nil).AcquireWorkerLease), ctx, arg)
+}
+
 // AddAppointmentParticipant mocks base method.
 func (m *MockStoreInterface) AddAppointmentParticipant(ctx context.Context, arg db.AddAppointmentParticipantParams) error {
 	m.ctrl.T.Helper()
@@ -55,6 +71,49 @@ func (mr *MockStoreInterfaceMockRecorder) AddAppointmentParticipant(ctx, arg any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAppointmentParticipant", reflect.TypeOf((*MockStoreInterface)(nil).AddAppointmentParticipant), ctx, arg)
 }
 
+// AddClientNote mocks base method.
+func (m *MockStoreInterface) AddClientNote(ctx context.Context, arg db.AddClientNoteParams) (db.ClientNote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddClientNote", ctx, arg)
+	ret0, _ := ret[0].(db.ClientNote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddClientNote indicates an expected call of AddClientNote.
+func (mr *MockStoreInterfaceMockRecorder) AddClientNote(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddClientNote", reflect.TypeOf((*MockStoreInterface)(nil).AddClientNote), ctx, arg)
+}
+
+// AddLocationCareTypes mocks base method.
+func (m *MockStoreInterface) AddLocationCareTypes(ctx context.Context, arg db.AddLocationCareTypesParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddLocationCareTypes", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddLocationCareTypes indicates an expected call of AddLocationCareTypes.
+func (mr *MockStoreInterfaceMockRecorder) AddLocationCareTypes(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddLocationCareTypes", reflect.TypeOf((*MockStoreInterface)(nil).AddLocationCareTypes), ctx, arg)
+}
+
+// AssignAllPermissionsToRole mocks base method.
+func (m *MockStoreInterface) AssignAllPermissionsToRole(ctx context.Context, roleID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignAllPermissionsToRole", ctx, roleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignAllPermissionsToRole indicates an expected call of AssignAllPermissionsToRole.
+func (mr *MockStoreInterfaceMockRecorder) AssignAllPermissionsToRole(ctx, roleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignAllPermissionsToRole", reflect.TypeOf((*MockStoreInterface)(nil).AssignAllPermissionsToRole), ctx, roleID)
+}
+
 // AssignPermissionToRole mocks base method.
 func (m *MockStoreInterface) AssignPermissionToRole(ctx context.Context, arg db.AssignPermissionToRoleParams) error {
 	m.ctrl.T.Helper()
@@ -97,18 +156,123 @@ func (mr *MockStoreInterfaceMockRecorder) BatchAssignPermissionsToRole(ctx, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchAssignPermissionsToRole", reflect.TypeOf((*MockStoreInterface)(nil).BatchAssignPermissionsToRole), ctx, arg)
 }
 
+// BatchUpdateRegistrationFormStatus mocks base method.
+func (m *MockStoreInterface) BatchUpdateRegistrationFormStatus(ctx context.Context, arg db.BatchUpdateRegistrationFormStatusParams) ([]db.BatchUpdateRegistrationFormStatusRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchUpdateRegistrationFormStatus", ctx, arg)
+	ret0, _ := ret[0].([]db.BatchUpdateRegistrationFormStatusRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchUpdateRegistrationFormStatus indicates an expected call of BatchUpdateRegistrationFormStatus.
+func (mr *MockStoreInterfaceMockRecorder) BatchUpdateRegistrationFormStatus(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchUpdateRegistrationFormStatus", reflect.TypeOf((*MockStoreInterface)(nil).BatchUpdateRegistrationFormStatus), ctx, arg)
+}
+
+// BootstrapAdminTx mocks base method.
+func (m *MockStoreInterface) BootstrapAdminTx(ctx context.Context, arg db.BootstrapAdminTxParams) (db.BootstrapAdminTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BootstrapAdminTx", ctx, arg)
+	ret0, _ := ret[0].(db.BootstrapAdminTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BootstrapAdminTx indicates an expected call of BootstrapAdminTx.
+func (mr *MockStoreInterfaceMockRecorder) BootstrapAdminTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BootstrapAdminTx", reflect.TypeOf((*MockStoreInterface)(nil).BootstrapAdminTx), ctx, arg)
+}
+
+// CancelAppointment mocks base method.
+func (m *MockStoreInterface) CancelAppointment(ctx context.Context, arg db.CancelAppointmentParams) (db.Appointment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelAppointment", ctx, arg)
+	ret0, _ := ret[0].(db.Appointment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelAppointment indicates an expected call of CancelAppointment.
+func (mr *MockStoreInterfaceMockRecorder) CancelAppointment(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelAppointment", reflect.TypeOf((*MockStoreInterface)(nil).CancelAppointment), ctx, arg)
+}
+
+// CancelLocationTransfer mocks base method.
+func (m *MockStoreInterface) CancelLocationTransfer(ctx context.Context, arg db.CancelLocationTransferParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelLocationTransfer", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelLocationTransfer indicates an expected call of CancelLocationTransfer.
+func (mr *MockStoreInterfaceMockRecorder) CancelLocationTransfer(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelLocationTransfer", reflect.TypeOf((*MockStoreInterface)(nil).CancelLocationTransfer), ctx, arg)
+}
+
+// CompleteEvaluationRecord mocks base method.
+func (m *MockStoreInterface) CompleteEvaluationRecord(ctx context.Context, arg db.CompleteEvaluationRecordParams) (db.Evaluation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteEvaluationRecord", ctx, arg)
+	ret0, _ := ret[0].(db.Evaluation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteEvaluationRecord indicates an expected call of CompleteEvaluationRecord.
+func (mr *MockStoreInterfaceMockRecorder) CompleteEvaluationRecord(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteEvaluationRecord", reflect.TypeOf((*MockStoreInterface)(nil).CompleteEvaluationRecord), ctx, arg)
+}
+
+// CompleteEvaluationRecordTx mocks base method.
+func (m *MockStoreInterface) CompleteEvaluationRecordTx(ctx context.Context, arg db.CompleteEvaluationRecordTxParams) (db.CompleteEvaluationRecordTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteEvaluationRecordTx", ctx, arg)
+	ret0, _ := ret[0].(db.CompleteEvaluationRecordTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteEvaluationRecordTx indicates an expected call of CompleteEvaluationRecordTx.
+func (mr *MockStoreInterfaceMockRecorder) CompleteEvaluationRecordTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteEvaluationRecordTx", reflect.TypeOf((*MockStoreInterface)(nil).CompleteEvaluationRecordTx), ctx, arg)
+}
+
 // ConfirmLocationTransfer mocks base method.
-func (m *MockStoreInterface) ConfirmLocationTransfer(ctx context.Context, id string) error {
+func (m *MockStoreInterface) ConfirmLocationTransfer(ctx context.Context, arg db.ConfirmLocationTransferParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ConfirmLocationTransfer", ctx, id)
+	ret := m.ctrl.Call(m, "ConfirmLocationTransfer", ctx, arg)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // ConfirmLocationTransfer indicates an expected call of ConfirmLocationTransfer.
-func (mr *MockStoreInterfaceMockRecorder) ConfirmLocationTransfer(ctx, id any) *gomock.Call {
+func (mr *MockStoreInterfaceMockRecorder) ConfirmLocationTransfer(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmLocationTransfer", reflect.TypeOf((*MockStoreInterface)(nil).ConfirmLocationTransfer), ctx, arg)
+}
+
+// ConvertIntakeToClientTx mocks base method.
+func (m *MockStoreInterface) ConvertIntakeToClientTx(ctx context.Context, arg db.ConvertIntakeToClientTxParams) (db.ConvertIntakeToClientTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConvertIntakeToClientTx", ctx, arg)
+	ret0, _ := ret[0].(db.ConvertIntakeToClientTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConvertIntakeToClientTx indicates an expected call of ConvertIntakeToClientTx.
+func (mr *MockStoreInterfaceMockRecorder) ConvertIntakeToClientTx(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmLocationTransfer", reflect.TypeOf((*MockStoreInterface)(nil).ConfirmLocationTransfer), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConvertIntakeToClientTx", reflect.TypeOf((*MockStoreInterface)(nil).ConvertIntakeToClientTx), ctx, arg)
 }
 
 // CountAuditLogs mocks base method.
@@ -126,6 +290,21 @@ func (mr *MockStoreInterfaceMockRecorder) CountAuditLogs(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAuditLogs", reflect.TypeOf((*MockStoreInterface)(nil).CountAuditLogs), ctx)
 }
 
+// CountMissingIntakeDocuments mocks base method.
+func (m *MockStoreInterface) CountMissingIntakeDocuments(ctx context.Context, intakeFormID string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountMissingIntakeDocuments", ctx, intakeFormID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountMissingIntakeDocuments indicates an expected call of CountMissingIntakeDocuments.
+func (mr *MockStoreInterfaceMockRecorder) CountMissingIntakeDocuments(ctx, intakeFormID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountMissingIntakeDocuments", reflect.TypeOf((*MockStoreInterface)(nil).CountMissingIntakeDocuments), ctx, intakeFormID)
+}
+
 // CreateAppointment mocks base method.
 func (m *MockStoreInterface) CreateAppointment(ctx context.Context, arg db.CreateAppointmentParams) (db.Appointment, error) {
 	m.ctrl.T.Helper()
@@ -155,6 +334,20 @@ func (mr *MockStoreInterfaceMockRecorder) CreateAttachment(ctx, arg any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAttachment", reflect.TypeOf((*MockStoreInterface)(nil).CreateAttachment), ctx, arg)
 }
 
+// CreateAttachmentAccess mocks base method.
+func (m *MockStoreInterface) CreateAttachmentAccess(ctx context.Context, arg db.CreateAttachmentAccessParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAttachmentAccess", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAttachmentAccess indicates an expected call of CreateAttachmentAccess.
+func (mr *MockStoreInterfaceMockRecorder) CreateAttachmentAccess(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAttachmentAccess", reflect.TypeOf((*MockStoreInterface)(nil).CreateAttachmentAccess), ctx, arg)
+}
+
 // CreateAuditLog mocks base method.
 func (m *MockStoreInterface) CreateAuditLog(ctx context.Context, arg db.CreateAuditLogParams) error {
 	m.ctrl.T.Helper()
@@ -213,6 +406,20 @@ func (mr *MockStoreInterfaceMockRecorder) CreateClientGoal(ctx, arg any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClientGoal", reflect.TypeOf((*MockStoreInterface)(nil).CreateClientGoal), ctx, arg)
 }
 
+// CreateCoordinatorAvailability mocks base method.
+func (m *MockStoreInterface) CreateCoordinatorAvailability(ctx context.Context, arg db.CreateCoordinatorAvailabilityParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCoordinatorAvailability", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCoordinatorAvailability indicates an expected call of CreateCoordinatorAvailability.
+func (mr *MockStoreInterfaceMockRecorder) CreateCoordinatorAvailability(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCoordinatorAvailability", reflect.TypeOf((*MockStoreInterface)(nil).CreateCoordinatorAvailability), ctx, arg)
+}
+
 // CreateEmployee mocks base method.
 func (m *MockStoreInterface) CreateEmployee(ctx context.Context, arg db.CreateEmployeeParams) error {
 	m.ctrl.T.Helper()
@@ -241,6 +448,21 @@ func (mr *MockStoreInterfaceMockRecorder) CreateEmployeeTx(ctx, arg any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEmployeeTx", reflect.TypeOf((*MockStoreInterface)(nil).CreateEmployeeTx), ctx, arg)
 }
 
+// CreateEvaluationRecord mocks base method.
+func (m *MockStoreInterface) CreateEvaluationRecord(ctx context.Context, arg db.CreateEvaluationRecordParams) (db.Evaluation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEvaluationRecord", ctx, arg)
+	ret0, _ := ret[0].(db.Evaluation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEvaluationRecord indicates an expected call of CreateEvaluationRecord.
+func (mr *MockStoreInterfaceMockRecorder) CreateEvaluationRecord(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEvaluationRecord", reflect.TypeOf((*MockStoreInterface)(nil).CreateEvaluationRecord), ctx, arg)
+}
+
 // CreateEvaluationTx mocks base method.
 func (m *MockStoreInterface) CreateEvaluationTx(ctx context.Context, params db.CreateEvaluationTxParams) (db.CreateEvaluationTxResult, error) {
 	m.ctrl.T.Helper()
@@ -284,6 +506,20 @@ func (mr *MockStoreInterfaceMockRecorder) CreateIncident(ctx, arg any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIncident", reflect.TypeOf((*MockStoreInterface)(nil).CreateIncident), ctx, arg)
 }
 
+// CreateIntakeDocumentChecklist mocks base method.
+func (m *MockStoreInterface) CreateIntakeDocumentChecklist(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIntakeDocumentChecklist", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateIntakeDocumentChecklist indicates an expected call of CreateIntakeDocumentChecklist.
+func (mr *MockStoreInterfaceMockRecorder) CreateIntakeDocumentChecklist(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIntakeDocumentChecklist", reflect.TypeOf((*MockStoreInterface)(nil).CreateIntakeDocumentChecklist), ctx, id)
+}
+
 // CreateIntakeForm mocks base method.
 func (m *MockStoreInterface) CreateIntakeForm(ctx context.Context, arg db.CreateIntakeFormParams) error {
 	m.ctrl.T.Helper()
@@ -342,6 +578,34 @@ func (mr *MockStoreInterfaceMockRecorder) CreateNotification(ctx, arg any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotification", reflect.TypeOf((*MockStoreInterface)(nil).CreateNotification), ctx, arg)
 }
 
+// CreateNotificationDeadLetter mocks base method.
+func (m *MockStoreInterface) CreateNotificationDeadLetter(ctx context.Context, arg db.CreateNotificationDeadLetterParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNotificationDeadLetter", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateNotificationDeadLetter indicates an expected call of CreateNotificationDeadLetter.
+func (mr *MockStoreInterfaceMockRecorder) CreateNotificationDeadLetter(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotificationDeadLetter", reflect.TypeOf((*MockStoreInterface)(nil).CreateNotificationDeadLetter), ctx, arg)
+}
+
+// CreateOrganization mocks base method.
+func (m *MockStoreInterface) CreateOrganization(ctx context.Context, arg db.CreateOrganizationParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrganization", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOrganization indicates an expected call of CreateOrganization.
+func (mr *MockStoreInterfaceMockRecorder) CreateOrganization(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganization", reflect.TypeOf((*MockStoreInterface)(nil).CreateOrganization), ctx, arg)
+}
+
 // CreatePermission mocks base method.
 func (m *MockStoreInterface) CreatePermission(ctx context.Context, arg db.CreatePermissionParams) (db.Permission, error) {
 	m.ctrl.T.Helper()
@@ -385,6 +649,20 @@ func (mr *MockStoreInterfaceMockRecorder) CreateRegistrationForm(ctx, arg any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRegistrationForm", reflect.TypeOf((*MockStoreInterface)(nil).CreateRegistrationForm), ctx, arg)
 }
 
+// CreateRegistrationFormTx mocks base method.
+func (m *MockStoreInterface) CreateRegistrationFormTx(ctx context.Context, arg db.CreateRegistrationFormTxParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRegistrationFormTx", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRegistrationFormTx indicates an expected call of CreateRegistrationFormTx.
+func (mr *MockStoreInterfaceMockRecorder) CreateRegistrationFormTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRegistrationFormTx", reflect.TypeOf((*MockStoreInterface)(nil).CreateRegistrationFormTx), ctx, arg)
+}
+
 // CreateReminder mocks base method.
 func (m *MockStoreInterface) CreateReminder(ctx context.Context, arg db.CreateReminderParams) (db.Reminder, error) {
 	m.ctrl.T.Helper()
@@ -415,6 +693,21 @@ func (mr *MockStoreInterfaceMockRecorder) CreateRole(ctx, arg any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRole", reflect.TypeOf((*MockStoreInterface)(nil).CreateRole), ctx, arg)
 }
 
+// CreateRoleWithPermissionsTx mocks base method.
+func (m *MockStoreInterface) CreateRoleWithPermissionsTx(ctx context.Context, arg db.CreateRoleWithPermissionsTxParams) (db.CreateRoleWithPermissionsTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRoleWithPermissionsTx", ctx, arg)
+	ret0, _ := ret[0].(db.CreateRoleWithPermissionsTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRoleWithPermissionsTx indicates an expected call of CreateRoleWithPermissionsTx.
+func (mr *MockStoreInterfaceMockRecorder) CreateRoleWithPermissionsTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoleWithPermissionsTx", reflect.TypeOf((*MockStoreInterface)(nil).CreateRoleWithPermissionsTx), ctx, arg)
+}
+
 // CreateUser mocks base method.
 func (m *MockStoreInterface) CreateUser(ctx context.Context, arg db.CreateUserParams) (string, error) {
 	m.ctrl.T.Helper()
@@ -486,6 +779,34 @@ func (mr *MockStoreInterfaceMockRecorder) DeleteAppointment(ctx, id any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAppointment", reflect.TypeOf((*MockStoreInterface)(nil).DeleteAppointment), ctx, id)
 }
 
+// DeleteClientNotesByClient mocks base method.
+func (m *MockStoreInterface) DeleteClientNotesByClient(ctx context.Context, clientID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteClientNotesByClient", ctx, clientID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteClientNotesByClient indicates an expected call of DeleteClientNotesByClient.
+func (mr *MockStoreInterfaceMockRecorder) DeleteClientNotesByClient(ctx, clientID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteClientNotesByClient", reflect.TypeOf((*MockStoreInterface)(nil).DeleteClientNotesByClient), ctx, clientID)
+}
+
+// DeleteCoordinatorAvailability mocks base method.
+func (m *MockStoreInterface) DeleteCoordinatorAvailability(ctx context.Context, employeeID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCoordinatorAvailability", ctx, employeeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCoordinatorAvailability indicates an expected call of DeleteCoordinatorAvailability.
+func (mr *MockStoreInterfaceMockRecorder) DeleteCoordinatorAvailability(ctx, employeeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCoordinatorAvailability", reflect.TypeOf((*MockStoreInterface)(nil).DeleteCoordinatorAvailability), ctx, employeeID)
+}
+
 // DeleteDraftEvaluation mocks base method.
 func (m *MockStoreInterface) DeleteDraftEvaluation(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -542,6 +863,20 @@ func (mr *MockStoreInterfaceMockRecorder) DeleteGoalProgressLogsByEvaluationId(c
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGoalProgressLogsByEvaluationId", reflect.TypeOf((*MockStoreInterface)(nil).DeleteGoalProgressLogsByEvaluationId), ctx, evaluationID)
 }
 
+// DeleteLocationCareTypes mocks base method.
+func (m *MockStoreInterface) DeleteLocationCareTypes(ctx context.Context, locationID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLocationCareTypes", ctx, locationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLocationCareTypes indicates an expected call of DeleteLocationCareTypes.
+func (mr *MockStoreInterfaceMockRecorder) DeleteLocationCareTypes(ctx, locationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLocationCareTypes", reflect.TypeOf((*MockStoreInterface)(nil).DeleteLocationCareTypes), ctx, locationID)
+}
+
 // DeleteNotification mocks base method.
 func (m *MockStoreInterface) DeleteNotification(ctx context.Context, arg db.DeleteNotificationParams) error {
 	m.ctrl.T.Helper()
@@ -556,6 +891,20 @@ func (mr *MockStoreInterfaceMockRecorder) DeleteNotification(ctx, arg any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotification", reflect.TypeOf((*MockStoreInterface)(nil).DeleteNotification), ctx, arg)
 }
 
+// DeleteNotificationQuietHours mocks base method.
+func (m *MockStoreInterface) DeleteNotificationQuietHours(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNotificationQuietHours", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNotificationQuietHours indicates an expected call of DeleteNotificationQuietHours.
+func (mr *MockStoreInterfaceMockRecorder) DeleteNotificationQuietHours(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotificationQuietHours", reflect.TypeOf((*MockStoreInterface)(nil).DeleteNotificationQuietHours), ctx, userID)
+}
+
 // DeletePermission mocks base method.
 func (m *MockStoreInterface) DeletePermission(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -654,6 +1003,35 @@ func (mr *MockStoreInterfaceMockRecorder) EnableUserMFA(ctx, arg any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableUserMFA", reflect.TypeOf((*MockStoreInterface)(nil).EnableUserMFA), ctx, arg)
 }
 
+// EnsureRole mocks base method.
+func (m *MockStoreInterface) EnsureRole(ctx context.Context, arg db.EnsureRoleParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureRole", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnsureRole indicates an expected call of EnsureRole.
+func (mr *MockStoreInterfaceMockRecorder) EnsureRole(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureRole", reflect.TypeOf((*MockStoreInterface)(nil).EnsureRole), ctx, arg)
+}
+
+// EscalateIncidentTx mocks base method.
+func (m *MockStoreInterface) EscalateIncidentTx(ctx context.Context, arg db.EscalateIncidentTxParams) (db.EscalateIncidentTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EscalateIncidentTx", ctx, arg)
+	ret0, _ := ret[0].(db.EscalateIncidentTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EscalateIncidentTx indicates an expected call of EscalateIncidentTx.
+func (mr *MockStoreInterfaceMockRecorder) EscalateIncidentTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EscalateIncidentTx", reflect.TypeOf((*MockStoreInterface)(nil).EscalateIncidentTx), ctx, arg)
+}
+
 // ExecTx mocks base method.
 func (m *MockStoreInterface) ExecTx(ctx context.Context, fn func(*db.Queries) error) error {
 	m.ctrl.T.Helper()
@@ -668,6 +1046,34 @@ func (mr *MockStoreInterfaceMockRecorder) ExecTx(ctx, fn any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecTx", reflect.TypeOf((*MockStoreInterface)(nil).ExecTx), ctx, fn)
 }
 
+// ExecTxRetry mocks base method.
+func (m *MockStoreInterface) ExecTxRetry(ctx context.Context, fn func(*db.Queries) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecTxRetry", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecTxRetry indicates an expected call of ExecTxRetry.
+func (mr *MockStoreInterfaceMockRecorder) ExecTxRetry(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecTxRetry", reflect.TypeOf((*MockStoreInterface)(nil).ExecTxRetry), ctx, fn)
+}
+
+// ForOrganization mocks base method.
+func (m *MockStoreInterface) ForOrganization(organizationID string) *db.ScopedStore {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForOrganization", organizationID)
+	ret0, _ := ret[0].(*db.ScopedStore)
+	return ret0
+}
+
+// ForOrganization indicates an expected call of ForOrganization.
+func (mr *MockStoreInterfaceMockRecorder) ForOrganization(organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForOrganization", reflect.TypeOf((*MockStoreInterface)(nil).ForOrganization), organizationID)
+}
+
 // GetAppointment mocks base method.
 func (m *MockStoreInterface) GetAppointment(ctx context.Context, id string) (db.Appointment, error) {
 	m.ctrl.T.Helper()
@@ -683,6 +1089,36 @@ func (mr *MockStoreInterfaceMockRecorder) GetAppointment(ctx, id any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppointment", reflect.TypeOf((*MockStoreInterface)(nil).GetAppointment), ctx, id)
 }
 
+// GetAttachmentForDownload mocks base method.
+func (m *MockStoreInterface) GetAttachmentForDownload(ctx context.Context, id string) (db.GetAttachmentForDownloadRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachmentForDownload", ctx, id)
+	ret0, _ := ret[0].(db.GetAttachmentForDownloadRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachmentForDownload indicates an expected call of GetAttachmentForDownload.
+func (mr *MockStoreInterfaceMockRecorder) GetAttachmentForDownload(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachmentForDownload", reflect.TypeOf((*MockStoreInterface)(nil).GetAttachmentForDownload), ctx, id)
+}
+
+// GetAttachmentsByIDs mocks base method.
+func (m *MockStoreInterface) GetAttachmentsByIDs(ctx context.Context, ids []string) ([]db.GetAttachmentsByIDsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachmentsByIDs", ctx, ids)
+	ret0, _ := ret[0].([]db.GetAttachmentsByIDsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachmentsByIDs indicates an expected call of GetAttachmentsByIDs.
+func (mr *MockStoreInterfaceMockRecorder) GetAttachmentsByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachmentsByIDs", reflect.TypeOf((*MockStoreInterface)(nil).GetAttachmentsByIDs), ctx, ids)
+}
+
 // GetAuditLogByID mocks base method.
 func (m *MockStoreInterface) GetAuditLogByID(ctx context.Context, id string) (db.GetAuditLogByIDRow, error) {
 	m.ctrl.T.Helper()
@@ -773,6 +1209,21 @@ func (mr *MockStoreInterfaceMockRecorder) GetAuditLogsForVerification(ctx, arg a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditLogsForVerification", reflect.TypeOf((*MockStoreInterface)(nil).GetAuditLogsForVerification), ctx, arg)
 }
 
+// GetAvailableIntakeSlots mocks base method.
+func (m *MockStoreInterface) GetAvailableIntakeSlots(ctx context.Context, arg db.GetAvailableIntakeSlotsParams) ([]db.GetAvailableIntakeSlotsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAvailableIntakeSlots", ctx, arg)
+	ret0, _ := ret[0].([]db.GetAvailableIntakeSlotsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAvailableIntakeSlots indicates an expected call of GetAvailableIntakeSlots.
+func (mr *MockStoreInterfaceMockRecorder) GetAvailableIntakeSlots(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailableIntakeSlots", reflect.TypeOf((*MockStoreInterface)(nil).GetAvailableIntakeSlots), ctx, arg)
+}
+
 // GetCareTypeDistribution mocks base method.
 func (m *MockStoreInterface) GetCareTypeDistribution(ctx context.Context) (db.GetCareTypeDistributionRow, error) {
 	m.ctrl.T.Helper()
@@ -788,25 +1239,70 @@ func (mr *MockStoreInterfaceMockRecorder) GetCareTypeDistribution(ctx any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCareTypeDistribution", reflect.TypeOf((*MockStoreInterface)(nil).GetCareTypeDistribution), ctx)
 }
 
-// GetClientByID mocks base method.
-func (m *MockStoreInterface) GetClientByID(ctx context.Context, id string) (db.Client, error) {
+// GetClientAgeDistribution mocks base method.
+func (m *MockStoreInterface) GetClientAgeDistribution(ctx context.Context, arg db.GetClientAgeDistributionParams) ([]db.GetClientAgeDistributionRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetClientByID", ctx, id)
-	ret0, _ := ret[0].(db.Client)
+	ret := m.ctrl.Call(m, "GetClientAgeDistribution", ctx, arg)
+	ret0, _ := ret[0].([]db.GetClientAgeDistributionRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetClientByID indicates an expected call of GetClientByID.
-func (mr *MockStoreInterfaceMockRecorder) GetClientByID(ctx, id any) *gomock.Call {
+// GetClientAgeDistribution indicates an expected call of GetClientAgeDistribution.
+func (mr *MockStoreInterfaceMockRecorder) GetClientAgeDistribution(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClientByID", reflect.TypeOf((*MockStoreInterface)(nil).GetClientByID), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClientAgeDistribution", reflect.TypeOf((*MockStoreInterface)(nil).GetClientAgeDistribution), ctx, arg)
 }
 
-// GetClientEvaluationHistory mocks base method.
-func (m *MockStoreInterface) GetClientEvaluationHistory(ctx context.Context, clientID string) ([]db.GetClientEvaluationHistoryRow, error) {
+// GetClientAssignmentHistory mocks base method.
+func (m *MockStoreInterface) GetClientAssignmentHistory(ctx context.Context, clientID string) ([]db.GetClientAssignmentHistoryRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetClientEvaluationHistory", ctx, clientID)
+	ret := m.ctrl.Call(m, "GetClientAssignmentHistory", ctx, clientID)
+	ret0, _ := ret[0].([]db.GetClientAssignmentHistoryRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClientAssignmentHistory indicates an expected call of GetClientAssignmentHistory.
+func (mr *MockStoreInterfaceMockRecorder) GetClientAssignmentHistory(ctx, clientID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClientAssignmentHistory", reflect.TypeOf((*MockStoreInterface)(nil).GetClientAssignmentHistory), ctx, clientID)
+}
+
+// GetClientByBSNForOrganization mocks base method.
+func (m *MockStoreInterface) GetClientByBSNForOrganization(ctx context.Context, arg db.GetClientByBSNForOrganizationParams) (db.Client, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClientByBSNForOrganization", ctx, arg)
+	ret0, _ := ret[0].(db.Client)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClientByBSNForOrganization indicates an expected call of GetClientByBSNForOrganization.
+func (mr *MockStoreInterfaceMockRecorder) GetClientByBSNForOrganization(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClientByBSNForOrganization", reflect.TypeOf((*MockStoreInterface)(nil).GetClientByBSNForOrganization), ctx, arg)
+}
+
+// GetClientByID mocks base method.
+func (m *MockStoreInterface) GetClientByID(ctx context.Context, arg db.GetClientByIDParams) (db.Client, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClientByID", ctx, arg)
+	ret0, _ := ret[0].(db.Client)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClientByID indicates an expected call of GetClientByID.
+func (mr *MockStoreInterfaceMockRecorder) GetClientByID(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClientByID", reflect.TypeOf((*MockStoreInterface)(nil).GetClientByID), ctx, arg)
+}
+
+// GetClientEvaluationHistory mocks base method.
+func (m *MockStoreInterface) GetClientEvaluationHistory(ctx context.Context, clientID string) ([]db.GetClientEvaluationHistoryRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClientEvaluationHistory", ctx, clientID)
 	ret0, _ := ret[0].([]db.GetClientEvaluationHistoryRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
@@ -818,6 +1314,21 @@ func (mr *MockStoreInterfaceMockRecorder) GetClientEvaluationHistory(ctx, client
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClientEvaluationHistory", reflect.TypeOf((*MockStoreInterface)(nil).GetClientEvaluationHistory), ctx, clientID)
 }
 
+// GetCoordinatorCaseload mocks base method.
+func (m *MockStoreInterface) GetCoordinatorCaseload(ctx context.Context, arg db.GetCoordinatorCaseloadParams) (db.GetCoordinatorCaseloadRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCoordinatorCaseload", ctx, arg)
+	ret0, _ := ret[0].(db.GetCoordinatorCaseloadRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCoordinatorCaseload indicates an expected call of GetCoordinatorCaseload.
+func (mr *MockStoreInterfaceMockRecorder) GetCoordinatorCaseload(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCoordinatorCaseload", reflect.TypeOf((*MockStoreInterface)(nil).GetCoordinatorCaseload), ctx, arg)
+}
+
 // GetCoordinatorClients mocks base method.
 func (m *MockStoreInterface) GetCoordinatorClients(ctx context.Context, coordinatorID string) ([]db.GetCoordinatorClientsRow, error) {
 	m.ctrl.T.Helper()
@@ -864,18 +1375,18 @@ func (mr *MockStoreInterfaceMockRecorder) GetCoordinatorDrafts(ctx, arg any) *go
 }
 
 // GetCoordinatorExpiringContractClients mocks base method.
-func (m *MockStoreInterface) GetCoordinatorExpiringContractClients(ctx context.Context, coordinatorID string) ([]db.GetCoordinatorExpiringContractClientsRow, error) {
+func (m *MockStoreInterface) GetCoordinatorExpiringContractClients(ctx context.Context, arg db.GetCoordinatorExpiringContractClientsParams) ([]db.GetCoordinatorExpiringContractClientsRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCoordinatorExpiringContractClients", ctx, coordinatorID)
+	ret := m.ctrl.Call(m, "GetCoordinatorExpiringContractClients", ctx, arg)
 	ret0, _ := ret[0].([]db.GetCoordinatorExpiringContractClientsRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCoordinatorExpiringContractClients indicates an expected call of GetCoordinatorExpiringContractClients.
-func (mr *MockStoreInterfaceMockRecorder) GetCoordinatorExpiringContractClients(ctx, coordinatorID any) *gomock.Call {
+func (mr *MockStoreInterfaceMockRecorder) GetCoordinatorExpiringContractClients(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCoordinatorExpiringContractClients", reflect.TypeOf((*MockStoreInterface)(nil).GetCoordinatorExpiringContractClients), ctx, coordinatorID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCoordinatorExpiringContractClients", reflect.TypeOf((*MockStoreInterface)(nil).GetCoordinatorExpiringContractClients), ctx, arg)
 }
 
 // GetCoordinatorGoalsProgress mocks base method.
@@ -893,6 +1404,21 @@ func (mr *MockStoreInterfaceMockRecorder) GetCoordinatorGoalsProgress(ctx, coord
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCoordinatorGoalsProgress", reflect.TypeOf((*MockStoreInterface)(nil).GetCoordinatorGoalsProgress), ctx, coordinatorID)
 }
 
+// GetCoordinatorHighPriorityWaitingClients mocks base method.
+func (m *MockStoreInterface) GetCoordinatorHighPriorityWaitingClients(ctx context.Context, coordinatorID string) ([]db.GetCoordinatorHighPriorityWaitingClientsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCoordinatorHighPriorityWaitingClients", ctx, coordinatorID)
+	ret0, _ := ret[0].([]db.GetCoordinatorHighPriorityWaitingClientsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCoordinatorHighPriorityWaitingClients indicates an expected call of GetCoordinatorHighPriorityWaitingClients.
+func (mr *MockStoreInterfaceMockRecorder) GetCoordinatorHighPriorityWaitingClients(ctx, coordinatorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCoordinatorHighPriorityWaitingClients", reflect.TypeOf((*MockStoreInterface)(nil).GetCoordinatorHighPriorityWaitingClients), ctx, coordinatorID)
+}
+
 // GetCoordinatorIncidents mocks base method.
 func (m *MockStoreInterface) GetCoordinatorIncidents(ctx context.Context, coordinatorID string) ([]db.GetCoordinatorIncidentsRow, error) {
 	m.ctrl.T.Helper()
@@ -909,33 +1435,48 @@ func (mr *MockStoreInterfaceMockRecorder) GetCoordinatorIncidents(ctx, coordinat
 }
 
 // GetCoordinatorLongWaitingClients mocks base method.
-func (m *MockStoreInterface) GetCoordinatorLongWaitingClients(ctx context.Context, coordinatorID string) ([]db.GetCoordinatorLongWaitingClientsRow, error) {
+func (m *MockStoreInterface) GetCoordinatorLongWaitingClients(ctx context.Context, arg db.GetCoordinatorLongWaitingClientsParams) ([]db.GetCoordinatorLongWaitingClientsRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCoordinatorLongWaitingClients", ctx, coordinatorID)
+	ret := m.ctrl.Call(m, "GetCoordinatorLongWaitingClients", ctx, arg)
 	ret0, _ := ret[0].([]db.GetCoordinatorLongWaitingClientsRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCoordinatorLongWaitingClients indicates an expected call of GetCoordinatorLongWaitingClients.
-func (mr *MockStoreInterfaceMockRecorder) GetCoordinatorLongWaitingClients(ctx, coordinatorID any) *gomock.Call {
+func (mr *MockStoreInterfaceMockRecorder) GetCoordinatorLongWaitingClients(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCoordinatorLongWaitingClients", reflect.TypeOf((*MockStoreInterface)(nil).GetCoordinatorLongWaitingClients), ctx, coordinatorID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCoordinatorLongWaitingClients", reflect.TypeOf((*MockStoreInterface)(nil).GetCoordinatorLongWaitingClients), ctx, arg)
 }
 
 // GetCoordinatorOverdueEvaluationClients mocks base method.
-func (m *MockStoreInterface) GetCoordinatorOverdueEvaluationClients(ctx context.Context, coordinatorID string) ([]db.GetCoordinatorOverdueEvaluationClientsRow, error) {
+func (m *MockStoreInterface) GetCoordinatorOverdueEvaluationClients(ctx context.Context, arg db.GetCoordinatorOverdueEvaluationClientsParams) ([]db.GetCoordinatorOverdueEvaluationClientsRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCoordinatorOverdueEvaluationClients", ctx, coordinatorID)
+	ret := m.ctrl.Call(m, "GetCoordinatorOverdueEvaluationClients", ctx, arg)
 	ret0, _ := ret[0].([]db.GetCoordinatorOverdueEvaluationClientsRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCoordinatorOverdueEvaluationClients indicates an expected call of GetCoordinatorOverdueEvaluationClients.
-func (mr *MockStoreInterfaceMockRecorder) GetCoordinatorOverdueEvaluationClients(ctx, coordinatorID any) *gomock.Call {
+func (mr *MockStoreInterfaceMockRecorder) GetCoordinatorOverdueEvaluationClients(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCoordinatorOverdueEvaluationClients", reflect.TypeOf((*MockStoreInterface)(nil).GetCoordinatorOverdueEvaluationClients), ctx, arg)
+}
+
+// GetCoordinatorPendingTransferClients mocks base method.
+func (m *MockStoreInterface) GetCoordinatorPendingTransferClients(ctx context.Context, coordinatorID string) ([]db.GetCoordinatorPendingTransferClientsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCoordinatorPendingTransferClients", ctx, coordinatorID)
+	ret0, _ := ret[0].([]db.GetCoordinatorPendingTransferClientsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCoordinatorPendingTransferClients indicates an expected call of GetCoordinatorPendingTransferClients.
+func (mr *MockStoreInterfaceMockRecorder) GetCoordinatorPendingTransferClients(ctx, coordinatorID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCoordinatorOverdueEvaluationClients", reflect.TypeOf((*MockStoreInterface)(nil).GetCoordinatorOverdueEvaluationClients), ctx, coordinatorID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCoordinatorPendingTransferClients", reflect.TypeOf((*MockStoreInterface)(nil).GetCoordinatorPendingTransferClients), ctx, coordinatorID)
 }
 
 // GetCoordinatorReminders mocks base method.
@@ -954,33 +1495,33 @@ func (mr *MockStoreInterfaceMockRecorder) GetCoordinatorReminders(ctx, userID an
 }
 
 // GetCoordinatorStats mocks base method.
-func (m *MockStoreInterface) GetCoordinatorStats(ctx context.Context, coordinatorID string) (db.GetCoordinatorStatsRow, error) {
+func (m *MockStoreInterface) GetCoordinatorStats(ctx context.Context, arg db.GetCoordinatorStatsParams) (db.GetCoordinatorStatsRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCoordinatorStats", ctx, coordinatorID)
+	ret := m.ctrl.Call(m, "GetCoordinatorStats", ctx, arg)
 	ret0, _ := ret[0].(db.GetCoordinatorStatsRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCoordinatorStats indicates an expected call of GetCoordinatorStats.
-func (mr *MockStoreInterfaceMockRecorder) GetCoordinatorStats(ctx, coordinatorID any) *gomock.Call {
+func (mr *MockStoreInterfaceMockRecorder) GetCoordinatorStats(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCoordinatorStats", reflect.TypeOf((*MockStoreInterface)(nil).GetCoordinatorStats), ctx, coordinatorID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCoordinatorStats", reflect.TypeOf((*MockStoreInterface)(nil).GetCoordinatorStats), ctx, arg)
 }
 
 // GetCoordinatorTodaySchedule mocks base method.
-func (m *MockStoreInterface) GetCoordinatorTodaySchedule(ctx context.Context, organizerID string) ([]db.GetCoordinatorTodayScheduleRow, error) {
+func (m *MockStoreInterface) GetCoordinatorTodaySchedule(ctx context.Context, arg db.GetCoordinatorTodayScheduleParams) ([]db.GetCoordinatorTodayScheduleRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCoordinatorTodaySchedule", ctx, organizerID)
+	ret := m.ctrl.Call(m, "GetCoordinatorTodaySchedule", ctx, arg)
 	ret0, _ := ret[0].([]db.GetCoordinatorTodayScheduleRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCoordinatorTodaySchedule indicates an expected call of GetCoordinatorTodaySchedule.
-func (mr *MockStoreInterfaceMockRecorder) GetCoordinatorTodaySchedule(ctx, organizerID any) *gomock.Call {
+func (mr *MockStoreInterfaceMockRecorder) GetCoordinatorTodaySchedule(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCoordinatorTodaySchedule", reflect.TypeOf((*MockStoreInterface)(nil).GetCoordinatorTodaySchedule), ctx, organizerID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCoordinatorTodaySchedule", reflect.TypeOf((*MockStoreInterface)(nil).GetCoordinatorTodaySchedule), ctx, arg)
 }
 
 // GetCoordinatorUnresolvedIncidentClients mocks base method.
@@ -999,33 +1540,33 @@ func (mr *MockStoreInterfaceMockRecorder) GetCoordinatorUnresolvedIncidentClient
 }
 
 // GetCoordinatorUrgentAlertsData mocks base method.
-func (m *MockStoreInterface) GetCoordinatorUrgentAlertsData(ctx context.Context, coordinatorID string) (db.GetCoordinatorUrgentAlertsDataRow, error) {
+func (m *MockStoreInterface) GetCoordinatorUrgentAlertsData(ctx context.Context, arg db.GetCoordinatorUrgentAlertsDataParams) (db.GetCoordinatorUrgentAlertsDataRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCoordinatorUrgentAlertsData", ctx, coordinatorID)
+	ret := m.ctrl.Call(m, "GetCoordinatorUrgentAlertsData", ctx, arg)
 	ret0, _ := ret[0].(db.GetCoordinatorUrgentAlertsDataRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCoordinatorUrgentAlertsData indicates an expected call of GetCoordinatorUrgentAlertsData.
-func (mr *MockStoreInterfaceMockRecorder) GetCoordinatorUrgentAlertsData(ctx, coordinatorID any) *gomock.Call {
+func (mr *MockStoreInterfaceMockRecorder) GetCoordinatorUrgentAlertsData(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCoordinatorUrgentAlertsData", reflect.TypeOf((*MockStoreInterface)(nil).GetCoordinatorUrgentAlertsData), ctx, coordinatorID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCoordinatorUrgentAlertsData", reflect.TypeOf((*MockStoreInterface)(nil).GetCoordinatorUrgentAlertsData), ctx, arg)
 }
 
 // GetCriticalAlertsData mocks base method.
-func (m *MockStoreInterface) GetCriticalAlertsData(ctx context.Context) (db.GetCriticalAlertsDataRow, error) {
+func (m *MockStoreInterface) GetCriticalAlertsData(ctx context.Context, arg db.GetCriticalAlertsDataParams) (db.GetCriticalAlertsDataRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCriticalAlertsData", ctx)
+	ret := m.ctrl.Call(m, "GetCriticalAlertsData", ctx, arg)
 	ret0, _ := ret[0].(db.GetCriticalAlertsDataRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCriticalAlertsData indicates an expected call of GetCriticalAlertsData.
-func (mr *MockStoreInterfaceMockRecorder) GetCriticalAlertsData(ctx any) *gomock.Call {
+func (mr *MockStoreInterfaceMockRecorder) GetCriticalAlertsData(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCriticalAlertsData", reflect.TypeOf((*MockStoreInterface)(nil).GetCriticalAlertsData), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCriticalAlertsData", reflect.TypeOf((*MockStoreInterface)(nil).GetCriticalAlertsData), ctx, arg)
 }
 
 // GetCriticalEvaluations mocks base method.
@@ -1044,18 +1585,18 @@ func (mr *MockStoreInterfaceMockRecorder) GetCriticalEvaluations(ctx, arg any) *
 }
 
 // GetDashboardDischargeStats mocks base method.
-func (m *MockStoreInterface) GetDashboardDischargeStats(ctx context.Context) (db.GetDashboardDischargeStatsRow, error) {
+func (m *MockStoreInterface) GetDashboardDischargeStats(ctx context.Context, today pgtype.Date) (db.GetDashboardDischargeStatsRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDashboardDischargeStats", ctx)
+	ret := m.ctrl.Call(m, "GetDashboardDischargeStats", ctx, today)
 	ret0, _ := ret[0].(db.GetDashboardDischargeStatsRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetDashboardDischargeStats indicates an expected call of GetDashboardDischargeStats.
-func (mr *MockStoreInterfaceMockRecorder) GetDashboardDischargeStats(ctx any) *gomock.Call {
+func (mr *MockStoreInterfaceMockRecorder) GetDashboardDischargeStats(ctx, today any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDashboardDischargeStats", reflect.TypeOf((*MockStoreInterface)(nil).GetDashboardDischargeStats), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDashboardDischargeStats", reflect.TypeOf((*MockStoreInterface)(nil).GetDashboardDischargeStats), ctx, today)
 }
 
 // GetDashboardOverviewStats mocks base method.
@@ -1088,6 +1629,21 @@ func (mr *MockStoreInterfaceMockRecorder) GetDischargeStats(ctx any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDischargeStats", reflect.TypeOf((*MockStoreInterface)(nil).GetDischargeStats), ctx)
 }
 
+// GetDischargeTrendByMonth mocks base method.
+func (m *MockStoreInterface) GetDischargeTrendByMonth(ctx context.Context, arg db.GetDischargeTrendByMonthParams) ([]db.GetDischargeTrendByMonthRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDischargeTrendByMonth", ctx, arg)
+	ret0, _ := ret[0].([]db.GetDischargeTrendByMonthRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDischargeTrendByMonth indicates an expected call of GetDischargeTrendByMonth.
+func (mr *MockStoreInterfaceMockRecorder) GetDischargeTrendByMonth(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDischargeTrendByMonth", reflect.TypeOf((*MockStoreInterface)(nil).GetDischargeTrendByMonth), ctx, arg)
+}
+
 // GetDraftByClientId mocks base method.
 func (m *MockStoreInterface) GetDraftByClientId(ctx context.Context, clientID string) (db.ClientEvaluation, error) {
 	m.ctrl.T.Helper()
@@ -1179,33 +1735,48 @@ func (mr *MockStoreInterfaceMockRecorder) GetEvaluationDetails(ctx, id any) *gom
 }
 
 // GetEvaluationStats mocks base method.
-func (m *MockStoreInterface) GetEvaluationStats(ctx context.Context) (db.GetEvaluationStatsRow, error) {
+func (m *MockStoreInterface) GetEvaluationStats(ctx context.Context, arg db.GetEvaluationStatsParams) (db.GetEvaluationStatsRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEvaluationStats", ctx)
+	ret := m.ctrl.Call(m, "GetEvaluationStats", ctx, arg)
 	ret0, _ := ret[0].(db.GetEvaluationStatsRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetEvaluationStats indicates an expected call of GetEvaluationStats.
-func (mr *MockStoreInterfaceMockRecorder) GetEvaluationStats(ctx any) *gomock.Call {
+func (mr *MockStoreInterfaceMockRecorder) GetEvaluationStats(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvaluationStats", reflect.TypeOf((*MockStoreInterface)(nil).GetEvaluationStats), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvaluationStats", reflect.TypeOf((*MockStoreInterface)(nil).GetEvaluationStats), ctx, arg)
 }
 
 // GetEvaluationsDueSoon mocks base method.
-func (m *MockStoreInterface) GetEvaluationsDueSoon(ctx context.Context) ([]db.GetEvaluationsDueSoonRow, error) {
+func (m *MockStoreInterface) GetEvaluationsDueSoon(ctx context.Context, arg db.GetEvaluationsDueSoonParams) ([]db.GetEvaluationsDueSoonRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEvaluationsDueSoon", ctx)
+	ret := m.ctrl.Call(m, "GetEvaluationsDueSoon", ctx, arg)
 	ret0, _ := ret[0].([]db.GetEvaluationsDueSoonRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetEvaluationsDueSoon indicates an expected call of GetEvaluationsDueSoon.
-func (mr *MockStoreInterfaceMockRecorder) GetEvaluationsDueSoon(ctx any) *gomock.Call {
+func (mr *MockStoreInterfaceMockRecorder) GetEvaluationsDueSoon(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvaluationsDueSoon", reflect.TypeOf((*MockStoreInterface)(nil).GetEvaluationsDueSoon), ctx, arg)
+}
+
+// GetFeatureFlag mocks base method.
+func (m *MockStoreInterface) GetFeatureFlag(ctx context.Context, key string) (db.FeatureFlag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeatureFlag", ctx, key)
+	ret0, _ := ret[0].(db.FeatureFlag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeatureFlag indicates an expected call of GetFeatureFlag.
+func (mr *MockStoreInterfaceMockRecorder) GetFeatureFlag(ctx, key any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvaluationsDueSoon", reflect.TypeOf((*MockStoreInterface)(nil).GetEvaluationsDueSoon), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeatureFlag", reflect.TypeOf((*MockStoreInterface)(nil).GetFeatureFlag), ctx, key)
 }
 
 // GetInCareStats mocks base method.
@@ -1253,6 +1824,36 @@ func (mr *MockStoreInterfaceMockRecorder) GetIncidentStats(ctx any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIncidentStats", reflect.TypeOf((*MockStoreInterface)(nil).GetIncidentStats), ctx)
 }
 
+// GetIncidentStatsByCategory mocks base method.
+func (m *MockStoreInterface) GetIncidentStatsByCategory(ctx context.Context) ([]db.GetIncidentStatsByCategoryRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIncidentStatsByCategory", ctx)
+	ret0, _ := ret[0].([]db.GetIncidentStatsByCategoryRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIncidentStatsByCategory indicates an expected call of GetIncidentStatsByCategory.
+func (mr *MockStoreInterfaceMockRecorder) GetIncidentStatsByCategory(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIncidentStatsByCategory", reflect.TypeOf((*MockStoreInterface)(nil).GetIncidentStatsByCategory), ctx)
+}
+
+// GetIncidentTrendByMonth mocks base method.
+func (m *MockStoreInterface) GetIncidentTrendByMonth(ctx context.Context, arg db.GetIncidentTrendByMonthParams) ([]db.GetIncidentTrendByMonthRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIncidentTrendByMonth", ctx, arg)
+	ret0, _ := ret[0].([]db.GetIncidentTrendByMonthRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIncidentTrendByMonth indicates an expected call of GetIncidentTrendByMonth.
+func (mr *MockStoreInterfaceMockRecorder) GetIncidentTrendByMonth(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIncidentTrendByMonth", reflect.TypeOf((*MockStoreInterface)(nil).GetIncidentTrendByMonth), ctx, arg)
+}
+
 // GetIntakeForm mocks base method.
 func (m *MockStoreInterface) GetIntakeForm(ctx context.Context, id string) (db.IntakeForm, error) {
 	m.ctrl.T.Helper()
@@ -1328,6 +1929,21 @@ func (mr *MockStoreInterfaceMockRecorder) GetLatestAuditLog(ctx any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestAuditLog", reflect.TypeOf((*MockStoreInterface)(nil).GetLatestAuditLog), ctx)
 }
 
+// GetLocationByID mocks base method.
+func (m *MockStoreInterface) GetLocationByID(ctx context.Context, arg db.GetLocationByIDParams) (db.GetLocationByIDRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLocationByID", ctx, arg)
+	ret0, _ := ret[0].(db.GetLocationByIDRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLocationByID indicates an expected call of GetLocationByID.
+func (mr *MockStoreInterfaceMockRecorder) GetLocationByID(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLocationByID", reflect.TypeOf((*MockStoreInterface)(nil).GetLocationByID), ctx, arg)
+}
+
 // GetLocationCapacityList mocks base method.
 func (m *MockStoreInterface) GetLocationCapacityList(ctx context.Context) ([]db.GetLocationCapacityListRow, error) {
 	m.ctrl.T.Helper()
@@ -1344,18 +1960,18 @@ func (mr *MockStoreInterfaceMockRecorder) GetLocationCapacityList(ctx any) *gomo
 }
 
 // GetLocationCapacityStats mocks base method.
-func (m *MockStoreInterface) GetLocationCapacityStats(ctx context.Context) (db.GetLocationCapacityStatsRow, error) {
+func (m *MockStoreInterface) GetLocationCapacityStats(ctx context.Context, organizationID *string) (db.GetLocationCapacityStatsRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetLocationCapacityStats", ctx)
+	ret := m.ctrl.Call(m, "GetLocationCapacityStats", ctx, organizationID)
 	ret0, _ := ret[0].(db.GetLocationCapacityStatsRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetLocationCapacityStats indicates an expected call of GetLocationCapacityStats.
-func (mr *MockStoreInterfaceMockRecorder) GetLocationCapacityStats(ctx any) *gomock.Call {
+func (mr *MockStoreInterfaceMockRecorder) GetLocationCapacityStats(ctx, organizationID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLocationCapacityStats", reflect.TypeOf((*MockStoreInterface)(nil).GetLocationCapacityStats), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLocationCapacityStats", reflect.TypeOf((*MockStoreInterface)(nil).GetLocationCapacityStats), ctx, organizationID)
 }
 
 // GetLocationCapacityTotals mocks base method.
@@ -1373,6 +1989,21 @@ func (mr *MockStoreInterfaceMockRecorder) GetLocationCapacityTotals(ctx any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLocationCapacityTotals", reflect.TypeOf((*MockStoreInterface)(nil).GetLocationCapacityTotals), ctx)
 }
 
+// GetLocationCapacityTrend mocks base method.
+func (m *MockStoreInterface) GetLocationCapacityTrend(ctx context.Context, arg db.GetLocationCapacityTrendParams) ([]db.GetLocationCapacityTrendRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLocationCapacityTrend", ctx, arg)
+	ret0, _ := ret[0].([]db.GetLocationCapacityTrendRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLocationCapacityTrend indicates an expected call of GetLocationCapacityTrend.
+func (mr *MockStoreInterfaceMockRecorder) GetLocationCapacityTrend(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLocationCapacityTrend", reflect.TypeOf((*MockStoreInterface)(nil).GetLocationCapacityTrend), ctx, arg)
+}
+
 // GetLocationTransferByID mocks base method.
 func (m *MockStoreInterface) GetLocationTransferByID(ctx context.Context, id string) (db.GetLocationTransferByIDRow, error) {
 	m.ctrl.T.Helper()
@@ -1418,6 +2049,36 @@ func (mr *MockStoreInterfaceMockRecorder) GetNotification(ctx, id any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotification", reflect.TypeOf((*MockStoreInterface)(nil).GetNotification), ctx, id)
 }
 
+// GetNotificationQuietHours mocks base method.
+func (m *MockStoreInterface) GetNotificationQuietHours(ctx context.Context, userID string) (db.NotificationQuietHour, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationQuietHours", ctx, userID)
+	ret0, _ := ret[0].(db.NotificationQuietHour)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationQuietHours indicates an expected call of GetNotificationQuietHours.
+func (mr *MockStoreInterfaceMockRecorder) GetNotificationQuietHours(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationQuietHours", reflect.TypeOf((*MockStoreInterface)(nil).GetNotificationQuietHours), ctx, userID)
+}
+
+// GetOrganizationUserIDsByRoleName mocks base method.
+func (m *MockStoreInterface) GetOrganizationUserIDsByRoleName(ctx context.Context, arg db.GetOrganizationUserIDsByRoleNameParams) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationUserIDsByRoleName", ctx, arg)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationUserIDsByRoleName indicates an expected call of GetOrganizationUserIDsByRoleName.
+func (mr *MockStoreInterfaceMockRecorder) GetOrganizationUserIDsByRoleName(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationUserIDsByRoleName", reflect.TypeOf((*MockStoreInterface)(nil).GetOrganizationUserIDsByRoleName), ctx, arg)
+}
+
 // GetPendingRemindersByDueTime mocks base method.
 func (m *MockStoreInterface) GetPendingRemindersByDueTime(ctx context.Context) ([]db.Reminder, error) {
 	m.ctrl.T.Helper()
@@ -1449,18 +2110,18 @@ func (mr *MockStoreInterfaceMockRecorder) GetPermissionByID(ctx, id any) *gomock
 }
 
 // GetPipelineStats mocks base method.
-func (m *MockStoreInterface) GetPipelineStats(ctx context.Context) (db.GetPipelineStatsRow, error) {
+func (m *MockStoreInterface) GetPipelineStats(ctx context.Context, arg db.GetPipelineStatsParams) (db.GetPipelineStatsRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPipelineStats", ctx)
+	ret := m.ctrl.Call(m, "GetPipelineStats", ctx, arg)
 	ret0, _ := ret[0].(db.GetPipelineStatsRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPipelineStats indicates an expected call of GetPipelineStats.
-func (mr *MockStoreInterfaceMockRecorder) GetPipelineStats(ctx any) *gomock.Call {
+func (mr *MockStoreInterfaceMockRecorder) GetPipelineStats(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipelineStats", reflect.TypeOf((*MockStoreInterface)(nil).GetPipelineStats), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipelineStats", reflect.TypeOf((*MockStoreInterface)(nil).GetPipelineStats), ctx, arg)
 }
 
 // GetRecentEvaluationsGlobal mocks base method.
@@ -1538,6 +2199,21 @@ func (mr *MockStoreInterfaceMockRecorder) GetRegistrationFormWithDetails(ctx, id
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegistrationFormWithDetails", reflect.TypeOf((*MockStoreInterface)(nil).GetRegistrationFormWithDetails), ctx, id)
 }
 
+// GetRegistrationFormsByBSN mocks base method.
+func (m *MockStoreInterface) GetRegistrationFormsByBSN(ctx context.Context, bsn string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRegistrationFormsByBSN", ctx, bsn)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRegistrationFormsByBSN indicates an expected call of GetRegistrationFormsByBSN.
+func (mr *MockStoreInterfaceMockRecorder) GetRegistrationFormsByBSN(ctx, bsn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegistrationFormsByBSN", reflect.TypeOf((*MockStoreInterface)(nil).GetRegistrationFormsByBSN), ctx, bsn)
+}
+
 // GetRegistrationStats mocks base method.
 func (m *MockStoreInterface) GetRegistrationStats(ctx context.Context) (db.GetRegistrationStatsRow, error) {
 	m.ctrl.T.Helper()
@@ -1553,6 +2229,21 @@ func (mr *MockStoreInterfaceMockRecorder) GetRegistrationStats(ctx any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegistrationStats", reflect.TypeOf((*MockStoreInterface)(nil).GetRegistrationStats), ctx)
 }
 
+// GetRegistrationStatusHistory mocks base method.
+func (m *MockStoreInterface) GetRegistrationStatusHistory(ctx context.Context, registrationFormID string) ([]db.GetRegistrationStatusHistoryRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRegistrationStatusHistory", ctx, registrationFormID)
+	ret0, _ := ret[0].([]db.GetRegistrationStatusHistoryRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRegistrationStatusHistory indicates an expected call of GetRegistrationStatusHistory.
+func (mr *MockStoreInterfaceMockRecorder) GetRegistrationStatusHistory(ctx, registrationFormID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegistrationStatusHistory", reflect.TypeOf((*MockStoreInterface)(nil).GetRegistrationStatusHistory), ctx, registrationFormID)
+}
+
 // GetReminder mocks base method.
 func (m *MockStoreInterface) GetReminder(ctx context.Context, id string) (db.Reminder, error) {
 	m.ctrl.T.Helper()
@@ -1629,18 +2320,18 @@ func (mr *MockStoreInterfaceMockRecorder) GetScheduledEvaluations(ctx, arg any)
 }
 
 // GetTodayAppointmentsForEmployee mocks base method.
-func (m *MockStoreInterface) GetTodayAppointmentsForEmployee(ctx context.Context, organizerID string) ([]db.GetTodayAppointmentsForEmployeeRow, error) {
+func (m *MockStoreInterface) GetTodayAppointmentsForEmployee(ctx context.Context, arg db.GetTodayAppointmentsForEmployeeParams) ([]db.GetTodayAppointmentsForEmployeeRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTodayAppointmentsForEmployee", ctx, organizerID)
+	ret := m.ctrl.Call(m, "GetTodayAppointmentsForEmployee", ctx, arg)
 	ret0, _ := ret[0].([]db.GetTodayAppointmentsForEmployeeRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetTodayAppointmentsForEmployee indicates an expected call of GetTodayAppointmentsForEmployee.
-func (mr *MockStoreInterfaceMockRecorder) GetTodayAppointmentsForEmployee(ctx, organizerID any) *gomock.Call {
+func (mr *MockStoreInterfaceMockRecorder) GetTodayAppointmentsForEmployee(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTodayAppointmentsForEmployee", reflect.TypeOf((*MockStoreInterface)(nil).GetTodayAppointmentsForEmployee), ctx, organizerID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTodayAppointmentsForEmployee", reflect.TypeOf((*MockStoreInterface)(nil).GetTodayAppointmentsForEmployee), ctx, arg)
 }
 
 // GetUnreadCount mocks base method.
@@ -1659,18 +2350,18 @@ func (mr *MockStoreInterfaceMockRecorder) GetUnreadCount(ctx, userID any) *gomoc
 }
 
 // GetUpcomingAppointments mocks base method.
-func (m *MockStoreInterface) GetUpcomingAppointments(ctx context.Context) ([]db.GetUpcomingAppointmentsRow, error) {
+func (m *MockStoreInterface) GetUpcomingAppointments(ctx context.Context, windowEnd pgtype.Timestamptz) ([]db.GetUpcomingAppointmentsRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUpcomingAppointments", ctx)
+	ret := m.ctrl.Call(m, "GetUpcomingAppointments", ctx, windowEnd)
 	ret0, _ := ret[0].([]db.GetUpcomingAppointmentsRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUpcomingAppointments indicates an expected call of GetUpcomingAppointments.
-func (mr *MockStoreInterfaceMockRecorder) GetUpcomingAppointments(ctx any) *gomock.Call {
+func (mr *MockStoreInterfaceMockRecorder) GetUpcomingAppointments(ctx, windowEnd any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpcomingAppointments", reflect.TypeOf((*MockStoreInterface)(nil).GetUpcomingAppointments), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpcomingAppointments", reflect.TypeOf((*MockStoreInterface)(nil).GetUpcomingAppointments), ctx, windowEnd)
 }
 
 // GetUserByEmail mocks base method.
@@ -1763,6 +2454,21 @@ func (mr *MockStoreInterfaceMockRecorder) GetWaitlistStats(ctx any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWaitlistStats", reflect.TypeOf((*MockStoreInterface)(nil).GetWaitlistStats), ctx)
 }
 
+// GetWorkerHeartbeat mocks base method.
+func (m *MockStoreInterface) GetWorkerHeartbeat(ctx context.Context, workerName string) (db.WorkerHeartbeat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkerHeartbeat", ctx, workerName)
+	ret0, _ := ret[0].(db.WorkerHeartbeat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkerHeartbeat indicates an expected call of GetWorkerHeartbeat.
+func (mr *MockStoreInterfaceMockRecorder) GetWorkerHeartbeat(ctx, workerName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkerHeartbeat", reflect.TypeOf((*MockStoreInterface)(nil).GetWorkerHeartbeat), ctx, workerName)
+}
+
 // HasPermission mocks base method.
 func (m *MockStoreInterface) HasPermission(ctx context.Context, arg db.HasPermissionParams) (bool, error) {
 	m.ctrl.T.Helper()
@@ -1821,6 +2527,36 @@ func (mr *MockStoreInterfaceMockRecorder) ListAppointmentParticipants(ctx, appoi
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAppointmentParticipants", reflect.TypeOf((*MockStoreInterface)(nil).ListAppointmentParticipants), ctx, appointmentID)
 }
 
+// ListAppointmentParticipantsByAppointmentIDs mocks base method.
+func (m *MockStoreInterface) ListAppointmentParticipantsByAppointmentIDs(ctx context.Context, appointmentIds []string) ([]db.AppointmentParticipant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAppointmentParticipantsByAppointmentIDs", ctx, appointmentIds)
+	ret0, _ := ret[0].([]db.AppointmentParticipant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAppointmentParticipantsByAppointmentIDs indicates an expected call of ListAppointmentParticipantsByAppointmentIDs.
+func (mr *MockStoreInterfaceMockRecorder) ListAppointmentParticipantsByAppointmentIDs(ctx, appointmentIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAppointmentParticipantsByAppointmentIDs", reflect.TypeOf((*MockStoreInterface)(nil).ListAppointmentParticipantsByAppointmentIDs), ctx, appointmentIds)
+}
+
+// ListAppointments mocks base method.
+func (m *MockStoreInterface) ListAppointments(ctx context.Context, arg db.ListAppointmentsParams) ([]db.ListAppointmentsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAppointments", ctx, arg)
+	ret0, _ := ret[0].([]db.ListAppointmentsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAppointments indicates an expected call of ListAppointments.
+func (mr *MockStoreInterfaceMockRecorder) ListAppointments(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAppointments", reflect.TypeOf((*MockStoreInterface)(nil).ListAppointments), ctx, arg)
+}
+
 // ListAppointmentsByOrganizer mocks base method.
 func (m *MockStoreInterface) ListAppointmentsByOrganizer(ctx context.Context, organizerID string) ([]db.Appointment, error) {
 	m.ctrl.T.Helper()
@@ -1866,6 +2602,21 @@ func (mr *MockStoreInterfaceMockRecorder) ListAppointmentsByRange(ctx, arg any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAppointmentsByRange", reflect.TypeOf((*MockStoreInterface)(nil).ListAppointmentsByRange), ctx, arg)
 }
 
+// ListAttachmentAccess mocks base method.
+func (m *MockStoreInterface) ListAttachmentAccess(ctx context.Context, attachmentID string) ([]db.ListAttachmentAccessRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAttachmentAccess", ctx, attachmentID)
+	ret0, _ := ret[0].([]db.ListAttachmentAccessRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAttachmentAccess indicates an expected call of ListAttachmentAccess.
+func (mr *MockStoreInterfaceMockRecorder) ListAttachmentAccess(ctx, attachmentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttachmentAccess", reflect.TypeOf((*MockStoreInterface)(nil).ListAttachmentAccess), ctx, attachmentID)
+}
+
 // ListAuditLogs mocks base method.
 func (m *MockStoreInterface) ListAuditLogs(ctx context.Context, arg db.ListAuditLogsParams) ([]db.ListAuditLogsRow, error) {
 	m.ctrl.T.Helper()
@@ -1881,6 +2632,81 @@ func (mr *MockStoreInterfaceMockRecorder) ListAuditLogs(ctx, arg any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditLogs", reflect.TypeOf((*MockStoreInterface)(nil).ListAuditLogs), ctx, arg)
 }
 
+// ListClientNotes mocks base method.
+func (m *MockStoreInterface) ListClientNotes(ctx context.Context, clientID string) ([]db.ListClientNotesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClientNotes", ctx, clientID)
+	ret0, _ := ret[0].([]db.ListClientNotesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClientNotes indicates an expected call of ListClientNotes.
+func (mr *MockStoreInterfaceMockRecorder) ListClientNotes(ctx, clientID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClientNotes", reflect.TypeOf((*MockStoreInterface)(nil).ListClientNotes), ctx, clientID)
+}
+
+// ListClientsDueForPurge mocks base method.
+func (m *MockStoreInterface) ListClientsDueForPurge(ctx context.Context, retentionMonths int32) ([]db.ListClientsDueForPurgeRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClientsDueForPurge", ctx, retentionMonths)
+	ret0, _ := ret[0].([]db.ListClientsDueForPurgeRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClientsDueForPurge indicates an expected call of ListClientsDueForPurge.
+func (mr *MockStoreInterfaceMockRecorder) ListClientsDueForPurge(ctx, retentionMonths any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClientsDueForPurge", reflect.TypeOf((*MockStoreInterface)(nil).ListClientsDueForPurge), ctx, retentionMonths)
+}
+
+// ListClientsForExport mocks base method.
+func (m *MockStoreInterface) ListClientsForExport(ctx context.Context, arg db.ListClientsForExportParams) ([]db.ListClientsForExportRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClientsForExport", ctx, arg)
+	ret0, _ := ret[0].([]db.ListClientsForExportRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClientsForExport indicates an expected call of ListClientsForExport.
+func (mr *MockStoreInterfaceMockRecorder) ListClientsForExport(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClientsForExport", reflect.TypeOf((*MockStoreInterface)(nil).ListClientsForExport), ctx, arg)
+}
+
+// ListCoordinatorAvailability mocks base method.
+func (m *MockStoreInterface) ListCoordinatorAvailability(ctx context.Context, employeeID string) ([]db.CoordinatorAvailability, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCoordinatorAvailability", ctx, employeeID)
+	ret0, _ := ret[0].([]db.CoordinatorAvailability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCoordinatorAvailability indicates an expected call of ListCoordinatorAvailability.
+func (mr *MockStoreInterfaceMockRecorder) ListCoordinatorAvailability(ctx, employeeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCoordinatorAvailability", reflect.TypeOf((*MockStoreInterface)(nil).ListCoordinatorAvailability), ctx, employeeID)
+}
+
+// ListDeletedRegistrationForms mocks base method.
+func (m *MockStoreInterface) ListDeletedRegistrationForms(ctx context.Context, arg db.ListDeletedRegistrationFormsParams) ([]db.ListDeletedRegistrationFormsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeletedRegistrationForms", ctx, arg)
+	ret0, _ := ret[0].([]db.ListDeletedRegistrationFormsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeletedRegistrationForms indicates an expected call of ListDeletedRegistrationForms.
+func (mr *MockStoreInterfaceMockRecorder) ListDeletedRegistrationForms(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeletedRegistrationForms", reflect.TypeOf((*MockStoreInterface)(nil).ListDeletedRegistrationForms), ctx, arg)
+}
+
 // ListDischargedClients mocks base method.
 func (m *MockStoreInterface) ListDischargedClients(ctx context.Context, arg db.ListDischargedClientsParams) ([]db.ListDischargedClientsRow, error) {
 	m.ctrl.T.Helper()
@@ -1911,6 +2737,21 @@ func (mr *MockStoreInterfaceMockRecorder) ListEmployees(ctx, arg any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEmployees", reflect.TypeOf((*MockStoreInterface)(nil).ListEmployees), ctx, arg)
 }
 
+// ListEvaluationRecordsByClient mocks base method.
+func (m *MockStoreInterface) ListEvaluationRecordsByClient(ctx context.Context, arg db.ListEvaluationRecordsByClientParams) ([]db.ListEvaluationRecordsByClientRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEvaluationRecordsByClient", ctx, arg)
+	ret0, _ := ret[0].([]db.ListEvaluationRecordsByClientRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEvaluationRecordsByClient indicates an expected call of ListEvaluationRecordsByClient.
+func (mr *MockStoreInterfaceMockRecorder) ListEvaluationRecordsByClient(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvaluationRecordsByClient", reflect.TypeOf((*MockStoreInterface)(nil).ListEvaluationRecordsByClient), ctx, arg)
+}
+
 // ListGoalsByClientID mocks base method.
 func (m *MockStoreInterface) ListGoalsByClientID(ctx context.Context, clientID *string) ([]db.ClientGoal, error) {
 	m.ctrl.T.Helper()
@@ -1956,6 +2797,21 @@ func (mr *MockStoreInterfaceMockRecorder) ListInCareClients(ctx, arg any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInCareClients", reflect.TypeOf((*MockStoreInterface)(nil).ListInCareClients), ctx, arg)
 }
 
+// ListInCareClientsByCursor mocks base method.
+func (m *MockStoreInterface) ListInCareClientsByCursor(ctx context.Context, arg db.ListInCareClientsByCursorParams) ([]db.ListInCareClientsByCursorRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInCareClientsByCursor", ctx, arg)
+	ret0, _ := ret[0].([]db.ListInCareClientsByCursorRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInCareClientsByCursor indicates an expected call of ListInCareClientsByCursor.
+func (mr *MockStoreInterfaceMockRecorder) ListInCareClientsByCursor(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInCareClientsByCursor", reflect.TypeOf((*MockStoreInterface)(nil).ListInCareClientsByCursor), ctx, arg)
+}
+
 // ListIncidents mocks base method.
 func (m *MockStoreInterface) ListIncidents(ctx context.Context, arg db.ListIncidentsParams) ([]db.ListIncidentsRow, error) {
 	m.ctrl.T.Helper()
@@ -1971,6 +2827,21 @@ func (mr *MockStoreInterfaceMockRecorder) ListIncidents(ctx, arg any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIncidents", reflect.TypeOf((*MockStoreInterface)(nil).ListIncidents), ctx, arg)
 }
 
+// ListIntakeDocuments mocks base method.
+func (m *MockStoreInterface) ListIntakeDocuments(ctx context.Context, intakeFormID string) ([]db.IntakeRequiredDocument, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIntakeDocuments", ctx, intakeFormID)
+	ret0, _ := ret[0].([]db.IntakeRequiredDocument)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIntakeDocuments indicates an expected call of ListIntakeDocuments.
+func (mr *MockStoreInterfaceMockRecorder) ListIntakeDocuments(ctx, intakeFormID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIntakeDocuments", reflect.TypeOf((*MockStoreInterface)(nil).ListIntakeDocuments), ctx, intakeFormID)
+}
+
 // ListIntakeForms mocks base method.
 func (m *MockStoreInterface) ListIntakeForms(ctx context.Context, arg db.ListIntakeFormsParams) ([]db.ListIntakeFormsRow, error) {
 	m.ctrl.T.Helper()
@@ -1986,6 +2857,21 @@ func (mr *MockStoreInterfaceMockRecorder) ListIntakeForms(ctx, arg any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIntakeForms", reflect.TypeOf((*MockStoreInterface)(nil).ListIntakeForms), ctx, arg)
 }
 
+// ListIntakeRescheduleHistory mocks base method.
+func (m *MockStoreInterface) ListIntakeRescheduleHistory(ctx context.Context, intakeFormID string) ([]db.ListIntakeRescheduleHistoryRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIntakeRescheduleHistory", ctx, intakeFormID)
+	ret0, _ := ret[0].([]db.ListIntakeRescheduleHistoryRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIntakeRescheduleHistory indicates an expected call of ListIntakeRescheduleHistory.
+func (mr *MockStoreInterfaceMockRecorder) ListIntakeRescheduleHistory(ctx, intakeFormID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIntakeRescheduleHistory", reflect.TypeOf((*MockStoreInterface)(nil).ListIntakeRescheduleHistory), ctx, intakeFormID)
+}
+
 // ListLocationTransfers mocks base method.
 func (m *MockStoreInterface) ListLocationTransfers(ctx context.Context, arg db.ListLocationTransfersParams) ([]db.ListLocationTransfersRow, error) {
 	m.ctrl.T.Helper()
@@ -2031,34 +2917,109 @@ func (mr *MockStoreInterfaceMockRecorder) ListNotifications(ctx, arg any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotifications", reflect.TypeOf((*MockStoreInterface)(nil).ListNotifications), ctx, arg)
 }
 
-// ListPermissions mocks base method.
-func (m *MockStoreInterface) ListPermissions(ctx context.Context, arg db.ListPermissionsParams) ([]db.ListPermissionsRow, error) {
+// ListOverdueEvaluationRecordsByClient mocks base method.
+func (m *MockStoreInterface) ListOverdueEvaluationRecordsByClient(ctx context.Context, arg db.ListOverdueEvaluationRecordsByClientParams) ([]db.ListOverdueEvaluationRecordsByClientRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListPermissions", ctx, arg)
-	ret0, _ := ret[0].([]db.ListPermissionsRow)
+	ret := m.ctrl.Call(m, "ListOverdueEvaluationRecordsByClient", ctx, arg)
+	ret0, _ := ret[0].([]db.ListOverdueEvaluationRecordsByClientRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListPermissions indicates an expected call of ListPermissions.
-func (mr *MockStoreInterfaceMockRecorder) ListPermissions(ctx, arg any) *gomock.Call {
+// ListOverdueEvaluationRecordsByClient indicates an expected call of ListOverdueEvaluationRecordsByClient.
+func (mr *MockStoreInterfaceMockRecorder) ListOverdueEvaluationRecordsByClient(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPermissions", reflect.TypeOf((*MockStoreInterface)(nil).ListPermissions), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOverdueEvaluationRecordsByClient", reflect.TypeOf((*MockStoreInterface)(nil).ListOverdueEvaluationRecordsByClient), ctx, arg)
 }
 
-// ListPermissionsForRole mocks base method.
-func (m *MockStoreInterface) ListPermissionsForRole(ctx context.Context, roleID string) ([]db.Permission, error) {
+// ListOverdueEvaluations mocks base method.
+func (m *MockStoreInterface) ListOverdueEvaluations(ctx context.Context, arg db.ListOverdueEvaluationsParams) ([]db.ListOverdueEvaluationsRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListPermissionsForRole", ctx, roleID)
-	ret0, _ := ret[0].([]db.Permission)
+	ret := m.ctrl.Call(m, "ListOverdueEvaluations", ctx, arg)
+	ret0, _ := ret[0].([]db.ListOverdueEvaluationsRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListPermissionsForRole indicates an expected call of ListPermissionsForRole.
-func (mr *MockStoreInterfaceMockRecorder) ListPermissionsForRole(ctx, roleID any) *gomock.Call {
+// ListOverdueEvaluations indicates an expected call of ListOverdueEvaluations.
+func (mr *MockStoreInterfaceMockRecorder) ListOverdueEvaluations(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPermissionsForRole", reflect.TypeOf((*MockStoreInterface)(nil).ListPermissionsForRole), ctx, roleID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOverdueEvaluations", reflect.TypeOf((*MockStoreInterface)(nil).ListOverdueEvaluations), ctx, arg)
+}
+
+// ListOverdueIncidents mocks base method.
+func (m *MockStoreInterface) ListOverdueIncidents(ctx context.Context, arg db.ListOverdueIncidentsParams) ([]db.ListOverdueIncidentsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOverdueIncidents", ctx, arg)
+	ret0, _ := ret[0].([]db.ListOverdueIncidentsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOverdueIncidents indicates an expected call of ListOverdueIncidents.
+func (mr *MockStoreInterfaceMockRecorder) ListOverdueIncidents(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOverdueIncidents", reflect.TypeOf((*MockStoreInterface)(nil).ListOverdueIncidents), ctx, arg)
+}
+
+// ListPermissionKeysForUser mocks base method.
+func (m *MockStoreInterface) ListPermissionKeysForUser(ctx context.Context, userID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPermissionKeysForUser", ctx, userID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPermissionKeysForUser indicates an expected call of ListPermissionKeysForUser.
+func (mr *MockStoreInterfaceMockRecorder) ListPermissionKeysForUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPermissionKeysForUser", reflect.TypeOf((*MockStoreInterface)(nil).ListPermissionKeysForUser), ctx, userID)
+}
+
+// ListPermissions mocks base method.
+func (m *MockStoreInterface) ListPermissions(ctx context.Context, arg db.ListPermissionsParams) ([]db.ListPermissionsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPermissions", ctx, arg)
+	ret0, _ := ret[0].([]db.ListPermissionsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPermissions indicates an expected call of ListPermissions.
+func (mr *MockStoreInterfaceMockRecorder) ListPermissions(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPermissions", reflect.TypeOf((*MockStoreInterface)(nil).ListPermissions), ctx, arg)
+}
+
+// ListPermissionsForRole mocks base method.
+func (m *MockStoreInterface) ListPermissionsForRole(ctx context.Context, roleID string) ([]db.Permission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPermissionsForRole", ctx, roleID)
+	ret0, _ := ret[0].([]db.Permission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPermissionsForRole indicates an expected call of ListPermissionsForRole.
+func (mr *MockStoreInterfaceMockRecorder) ListPermissionsForRole(ctx, roleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPermissionsForRole", reflect.TypeOf((*MockStoreInterface)(nil).ListPermissionsForRole), ctx, roleID)
+}
+
+// ListPlacementCandidatesForOrganization mocks base method.
+func (m *MockStoreInterface) ListPlacementCandidatesForOrganization(ctx context.Context, arg db.ListPlacementCandidatesForOrganizationParams) ([]db.ListPlacementCandidatesForOrganizationRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPlacementCandidatesForOrganization", ctx, arg)
+	ret0, _ := ret[0].([]db.ListPlacementCandidatesForOrganizationRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPlacementCandidatesForOrganization indicates an expected call of ListPlacementCandidatesForOrganization.
+func (mr *MockStoreInterfaceMockRecorder) ListPlacementCandidatesForOrganization(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPlacementCandidatesForOrganization", reflect.TypeOf((*MockStoreInterface)(nil).ListPlacementCandidatesForOrganization), ctx, arg)
 }
 
 // ListRecurringAppointments mocks base method.
@@ -2106,6 +3067,21 @@ func (mr *MockStoreInterfaceMockRecorder) ListReferringOrgsWithCounts(ctx, arg a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReferringOrgsWithCounts", reflect.TypeOf((*MockStoreInterface)(nil).ListReferringOrgsWithCounts), ctx, arg)
 }
 
+// ListRegistrationFormAttachments mocks base method.
+func (m *MockStoreInterface) ListRegistrationFormAttachments(ctx context.Context, registrationFormID string) ([]db.ListRegistrationFormAttachmentsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRegistrationFormAttachments", ctx, registrationFormID)
+	ret0, _ := ret[0].([]db.ListRegistrationFormAttachmentsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRegistrationFormAttachments indicates an expected call of ListRegistrationFormAttachments.
+func (mr *MockStoreInterfaceMockRecorder) ListRegistrationFormAttachments(ctx, registrationFormID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRegistrationFormAttachments", reflect.TypeOf((*MockStoreInterface)(nil).ListRegistrationFormAttachments), ctx, registrationFormID)
+}
+
 // ListRegistrationForms mocks base method.
 func (m *MockStoreInterface) ListRegistrationForms(ctx context.Context, arg db.ListRegistrationFormsParams) ([]db.ListRegistrationFormsRow, error) {
 	m.ctrl.T.Helper()
@@ -2166,19 +3142,34 @@ func (mr *MockStoreInterfaceMockRecorder) ListRoles(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoles", reflect.TypeOf((*MockStoreInterface)(nil).ListRoles), ctx, arg)
 }
 
+// ListUnresolvedIncidentsByClient mocks base method.
+func (m *MockStoreInterface) ListUnresolvedIncidentsByClient(ctx context.Context, clientID string) ([]db.ListUnresolvedIncidentsByClientRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUnresolvedIncidentsByClient", ctx, clientID)
+	ret0, _ := ret[0].([]db.ListUnresolvedIncidentsByClientRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUnresolvedIncidentsByClient indicates an expected call of ListUnresolvedIncidentsByClient.
+func (mr *MockStoreInterfaceMockRecorder) ListUnresolvedIncidentsByClient(ctx, clientID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUnresolvedIncidentsByClient", reflect.TypeOf((*MockStoreInterface)(nil).ListUnresolvedIncidentsByClient), ctx, clientID)
+}
+
 // ListUsersWithRole mocks base method.
-func (m *MockStoreInterface) ListUsersWithRole(ctx context.Context, roleID string) ([]db.ListUsersWithRoleRow, error) {
+func (m *MockStoreInterface) ListUsersWithRole(ctx context.Context, arg db.ListUsersWithRoleParams) ([]db.ListUsersWithRoleRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListUsersWithRole", ctx, roleID)
+	ret := m.ctrl.Call(m, "ListUsersWithRole", ctx, arg)
 	ret0, _ := ret[0].([]db.ListUsersWithRoleRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListUsersWithRole indicates an expected call of ListUsersWithRole.
-func (mr *MockStoreInterfaceMockRecorder) ListUsersWithRole(ctx, roleID any) *gomock.Call {
+func (mr *MockStoreInterfaceMockRecorder) ListUsersWithRole(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsersWithRole", reflect.TypeOf((*MockStoreInterface)(nil).ListUsersWithRole), ctx, roleID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsersWithRole", reflect.TypeOf((*MockStoreInterface)(nil).ListUsersWithRole), ctx, arg)
 }
 
 // ListWaitingListClients mocks base method.
@@ -2196,6 +3187,36 @@ func (mr *MockStoreInterfaceMockRecorder) ListWaitingListClients(ctx, arg any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWaitingListClients", reflect.TypeOf((*MockStoreInterface)(nil).ListWaitingListClients), ctx, arg)
 }
 
+// LockEmployee mocks base method.
+func (m *MockStoreInterface) LockEmployee(ctx context.Context, id string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockEmployee", ctx, id)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockEmployee indicates an expected call of LockEmployee.
+func (mr *MockStoreInterfaceMockRecorder) LockEmployee(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockEmployee", reflect.TypeOf((*MockStoreInterface)(nil).LockEmployee), ctx, id)
+}
+
+// LockUnescalatedIncident mocks base method.
+func (m *MockStoreInterface) LockUnescalatedIncident(ctx context.Context, id string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockUnescalatedIncident", ctx, id)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockUnescalatedIncident indicates an expected call of LockUnescalatedIncident.
+func (mr *MockStoreInterfaceMockRecorder) LockUnescalatedIncident(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockUnescalatedIncident", reflect.TypeOf((*MockStoreInterface)(nil).LockUnescalatedIncident), ctx, id)
+}
+
 // MarkAllNotificationsAsRead mocks base method.
 func (m *MockStoreInterface) MarkAllNotificationsAsRead(ctx context.Context, userID string) error {
 	m.ctrl.T.Helper()
@@ -2210,6 +3231,20 @@ func (mr *MockStoreInterfaceMockRecorder) MarkAllNotificationsAsRead(ctx, userID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllNotificationsAsRead", reflect.TypeOf((*MockStoreInterface)(nil).MarkAllNotificationsAsRead), ctx, userID)
 }
 
+// MarkIncidentEscalated mocks base method.
+func (m *MockStoreInterface) MarkIncidentEscalated(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkIncidentEscalated", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkIncidentEscalated indicates an expected call of MarkIncidentEscalated.
+func (mr *MockStoreInterfaceMockRecorder) MarkIncidentEscalated(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkIncidentEscalated", reflect.TypeOf((*MockStoreInterface)(nil).MarkIncidentEscalated), ctx, id)
+}
+
 // MarkNotificationAsRead mocks base method.
 func (m *MockStoreInterface) MarkNotificationAsRead(ctx context.Context, arg db.MarkNotificationAsReadParams) error {
 	m.ctrl.T.Helper()
@@ -2224,6 +3259,35 @@ func (mr *MockStoreInterfaceMockRecorder) MarkNotificationAsRead(ctx, arg any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotificationAsRead", reflect.TypeOf((*MockStoreInterface)(nil).MarkNotificationAsRead), ctx, arg)
 }
 
+// MarkNotificationsReadByResource mocks base method.
+func (m *MockStoreInterface) MarkNotificationsReadByResource(ctx context.Context, arg db.MarkNotificationsReadByResourceParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkNotificationsReadByResource", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkNotificationsReadByResource indicates an expected call of MarkNotificationsReadByResource.
+func (mr *MockStoreInterfaceMockRecorder) MarkNotificationsReadByResource(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotificationsReadByResource", reflect.TypeOf((*MockStoreInterface)(nil).MarkNotificationsReadByResource), ctx, arg)
+}
+
+// MoveClientInCareTx mocks base method.
+func (m *MockStoreInterface) MoveClientInCareTx(ctx context.Context, arg db.MoveClientInCareTxParams) (db.MoveClientInCareTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveClientInCareTx", ctx, arg)
+	ret0, _ := ret[0].(db.MoveClientInCareTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveClientInCareTx indicates an expected call of MoveClientInCareTx.
+func (mr *MockStoreInterfaceMockRecorder) MoveClientInCareTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveClientInCareTx", reflect.TypeOf((*MockStoreInterface)(nil).MoveClientInCareTx), ctx, arg)
+}
+
 // MoveClientToWaitingListTx mocks base method.
 func (m *MockStoreInterface) MoveClientToWaitingListTx(ctx context.Context, arg db.MoveClientToWaitingListTxParams) (db.MoveClientToWaitingListTxResult, error) {
 	m.ctrl.T.Helper()
@@ -2239,6 +3303,176 @@ func (mr *MockStoreInterfaceMockRecorder) MoveClientToWaitingListTx(ctx, arg any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveClientToWaitingListTx", reflect.TypeOf((*MockStoreInterface)(nil).MoveClientToWaitingListTx), ctx, arg)
 }
 
+// NotificationResourceExists mocks base method.
+func (m *MockStoreInterface) NotificationResourceExists(ctx context.Context, arg db.NotificationResourceExistsParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotificationResourceExists", ctx, arg)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NotificationResourceExists indicates an expected call of NotificationResourceExists.
+func (mr *MockStoreInterfaceMockRecorder) NotificationResourceExists(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotificationResourceExists", reflect.TypeOf((*MockStoreInterface)(nil).NotificationResourceExists), ctx, arg)
+}
+
+// PurgeClientPII mocks base method.
+func (m *MockStoreInterface) PurgeClientPII(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeClientPII", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeClientPII indicates an expected call of PurgeClientPII.
+func (mr *MockStoreInterfaceMockRecorder) PurgeClientPII(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeClientPII", reflect.TypeOf((*MockStoreInterface)(nil).PurgeClientPII), ctx, id)
+}
+
+// PurgeClientPIITx mocks base method.
+func (m *MockStoreInterface) PurgeClientPIITx(ctx context.Context, arg db.PurgeClientPIITxParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeClientPIITx", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeClientPIITx indicates an expected call of PurgeClientPIITx.
+func (mr *MockStoreInterfaceMockRecorder) PurgeClientPIITx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeClientPIITx", reflect.TypeOf((*MockStoreInterface)(nil).PurgeClientPIITx), ctx, arg)
+}
+
+// PurgeIntakeFormPII mocks base method.
+func (m *MockStoreInterface) PurgeIntakeFormPII(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeIntakeFormPII", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeIntakeFormPII indicates an expected call of PurgeIntakeFormPII.
+func (mr *MockStoreInterfaceMockRecorder) PurgeIntakeFormPII(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeIntakeFormPII", reflect.TypeOf((*MockStoreInterface)(nil).PurgeIntakeFormPII), ctx, id)
+}
+
+// PurgeRegistrationFormPII mocks base method.
+func (m *MockStoreInterface) PurgeRegistrationFormPII(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeRegistrationFormPII", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeRegistrationFormPII indicates an expected call of PurgeRegistrationFormPII.
+func (mr *MockStoreInterfaceMockRecorder) PurgeRegistrationFormPII(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeRegistrationFormPII", reflect.TypeOf((*MockStoreInterface)(nil).PurgeRegistrationFormPII), ctx, id)
+}
+
+// RecordClientAssignment mocks base method.
+func (m *MockStoreInterface) RecordClientAssignment(ctx context.Context, arg db.RecordClientAssignmentParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordClientAssignment", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordClientAssignment indicates an expected call of RecordClientAssignment.
+func (mr *MockStoreInterfaceMockRecorder) RecordClientAssignment(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordClientAssignment", reflect.TypeOf((*MockStoreInterface)(nil).RecordClientAssignment), ctx, arg)
+}
+
+// RecordClientPIIPurge mocks base method.
+func (m *MockStoreInterface) RecordClientPIIPurge(ctx context.Context, arg db.RecordClientPIIPurgeParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordClientPIIPurge", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordClientPIIPurge indicates an expected call of RecordClientPIIPurge.
+func (mr *MockStoreInterfaceMockRecorder) RecordClientPIIPurge(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordClientPIIPurge", reflect.TypeOf((*MockStoreInterface)(nil).RecordClientPIIPurge), ctx, arg)
+}
+
+// RecordFailedLogin mocks base method.
+func (m *MockStoreInterface) RecordFailedLogin(ctx context.Context, arg db.RecordFailedLoginParams) (db.RecordFailedLoginRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordFailedLogin", ctx, arg)
+	ret0, _ := ret[0].(db.RecordFailedLoginRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordFailedLogin indicates an expected call of RecordFailedLogin.
+func (mr *MockStoreInterfaceMockRecorder) RecordFailedLogin(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordFailedLogin", reflect.TypeOf((*MockStoreInterface)(nil).RecordFailedLogin), ctx, arg)
+}
+
+// RecordIntakeReschedule mocks base method.
+func (m *MockStoreInterface) RecordIntakeReschedule(ctx context.Context, arg db.RecordIntakeRescheduleParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordIntakeReschedule", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordIntakeReschedule indicates an expected call of RecordIntakeReschedule.
+func (mr *MockStoreInterfaceMockRecorder) RecordIntakeReschedule(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordIntakeReschedule", reflect.TypeOf((*MockStoreInterface)(nil).RecordIntakeReschedule), ctx, arg)
+}
+
+// RecordLogin mocks base method.
+func (m *MockStoreInterface) RecordLogin(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordLogin", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordLogin indicates an expected call of RecordLogin.
+func (mr *MockStoreInterfaceMockRecorder) RecordLogin(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordLogin", reflect.TypeOf((*MockStoreInterface)(nil).RecordLogin), ctx, id)
+}
+
+// RecordSubmittedEvaluation mocks base method.
+func (m *MockStoreInterface) RecordSubmittedEvaluation(ctx context.Context, arg db.RecordSubmittedEvaluationParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordSubmittedEvaluation", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordSubmittedEvaluation indicates an expected call of RecordSubmittedEvaluation.
+func (mr *MockStoreInterfaceMockRecorder) RecordSubmittedEvaluation(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordSubmittedEvaluation", reflect.TypeOf((*MockStoreInterface)(nil).RecordSubmittedEvaluation), ctx, arg)
+}
+
+// RecordWorkerHeartbeat mocks base method.
+func (m *MockStoreInterface) RecordWorkerHeartbeat(ctx context.Context, workerName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordWorkerHeartbeat", ctx, workerName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordWorkerHeartbeat indicates an expected call of RecordWorkerHeartbeat.
+func (mr *MockStoreInterfaceMockRecorder) RecordWorkerHeartbeat(ctx, workerName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordWorkerHeartbeat", reflect.TypeOf((*MockStoreInterface)(nil).RecordWorkerHeartbeat), ctx, workerName)
+}
+
 // RefuseLocationTransfer mocks base method.
 func (m *MockStoreInterface) RefuseLocationTransfer(ctx context.Context, arg db.RefuseLocationTransferParams) error {
 	m.ctrl.T.Helper()
@@ -2253,6 +3487,20 @@ func (mr *MockStoreInterfaceMockRecorder) RefuseLocationTransfer(ctx, arg any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefuseLocationTransfer", reflect.TypeOf((*MockStoreInterface)(nil).RefuseLocationTransfer), ctx, arg)
 }
 
+// ReleaseWorkerLease mocks base method.
+func (m *MockStoreInterface) ReleaseWorkerLease(ctx context.Context, arg db.ReleaseWorkerLeaseParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseWorkerLease", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseWorkerLease indicates an expected call of ReleaseWorkerLease.
+func (mr *MockStoreInterfaceMockRecorder) ReleaseWorkerLease(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseWorkerLease", reflect.TypeOf((*MockStoreInterface)(nil).ReleaseWorkerLease), ctx, arg)
+}
+
 // RemoveAppointmentParticipants mocks base method.
 func (m *MockStoreInterface) RemoveAppointmentParticipants(ctx context.Context, appointmentID string) error {
 	m.ctrl.T.Helper()
@@ -2267,6 +3515,36 @@ func (mr *MockStoreInterfaceMockRecorder) RemoveAppointmentParticipants(ctx, app
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAppointmentParticipants", reflect.TypeOf((*MockStoreInterface)(nil).RemoveAppointmentParticipants), ctx, appointmentID)
 }
 
+// RemovePermissionFromAllRoles mocks base method.
+func (m *MockStoreInterface) RemovePermissionFromAllRoles(ctx context.Context, permissionID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePermissionFromAllRoles", ctx, permissionID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemovePermissionFromAllRoles indicates an expected call of RemovePermissionFromAllRoles.
+func (mr *MockStoreInterfaceMockRecorder) RemovePermissionFromAllRoles(ctx, permissionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePermissionFromAllRoles", reflect.TypeOf((*MockStoreInterface)(nil).RemovePermissionFromAllRoles), ctx, permissionID)
+}
+
+// RemovePermissionFromAllRolesTx mocks base method.
+func (m *MockStoreInterface) RemovePermissionFromAllRolesTx(ctx context.Context, arg db.RemovePermissionFromAllRolesTxParams) (db.RemovePermissionFromAllRolesTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePermissionFromAllRolesTx", ctx, arg)
+	ret0, _ := ret[0].(db.RemovePermissionFromAllRolesTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemovePermissionFromAllRolesTx indicates an expected call of RemovePermissionFromAllRolesTx.
+func (mr *MockStoreInterfaceMockRecorder) RemovePermissionFromAllRolesTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePermissionFromAllRolesTx", reflect.TypeOf((*MockStoreInterface)(nil).RemovePermissionFromAllRolesTx), ctx, arg)
+}
+
 // RemovePermissionFromRole mocks base method.
 func (m *MockStoreInterface) RemovePermissionFromRole(ctx context.Context, arg db.RemovePermissionFromRoleParams) error {
 	m.ctrl.T.Helper()
@@ -2295,6 +3573,138 @@ func (mr *MockStoreInterfaceMockRecorder) RemoveRoleFromUser(ctx, userID any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRoleFromUser", reflect.TypeOf((*MockStoreInterface)(nil).RemoveRoleFromUser), ctx, userID)
 }
 
+// ReorderRegistrationFormAttachments mocks base method.
+func (m *MockStoreInterface) ReorderRegistrationFormAttachments(ctx context.Context, arg db.ReorderRegistrationFormAttachmentsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderRegistrationFormAttachments", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReorderRegistrationFormAttachments indicates an expected call of ReorderRegistrationFormAttachments.
+func (mr *MockStoreInterfaceMockRecorder) ReorderRegistrationFormAttachments(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderRegistrationFormAttachments", reflect.TypeOf((*MockStoreInterface)(nil).ReorderRegistrationFormAttachments), ctx, arg)
+}
+
+// ReserveLocationCapacity mocks base method.
+func (m *MockStoreInterface) ReserveLocationCapacity(ctx context.Context, id string) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveLocationCapacity", ctx, id)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReserveLocationCapacity indicates an expected call of ReserveLocationCapacity.
+func (mr *MockStoreInterfaceMockRecorder) ReserveLocationCapacity(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveLocationCapacity", reflect.TypeOf((*MockStoreInterface)(nil).ReserveLocationCapacity), ctx, id)
+}
+
+// ResetFailedLogins mocks base method.
+func (m *MockStoreInterface) ResetFailedLogins(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetFailedLogins", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetFailedLogins indicates an expected call of ResetFailedLogins.
+func (mr *MockStoreInterfaceMockRecorder) ResetFailedLogins(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetFailedLogins", reflect.TypeOf((*MockStoreInterface)(nil).ResetFailedLogins), ctx, id)
+}
+
+// RestoreRegistrationForm mocks base method.
+func (m *MockStoreInterface) RestoreRegistrationForm(ctx context.Context, id string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreRegistrationForm", ctx, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreRegistrationForm indicates an expected call of RestoreRegistrationForm.
+func (mr *MockStoreInterfaceMockRecorder) RestoreRegistrationForm(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreRegistrationForm", reflect.TypeOf((*MockStoreInterface)(nil).RestoreRegistrationForm), ctx, id)
+}
+
+// SearchClients mocks base method.
+func (m *MockStoreInterface) SearchClients(ctx context.Context, arg db.SearchClientsParams) ([]db.SearchClientsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchClients", ctx, arg)
+	ret0, _ := ret[0].([]db.SearchClientsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchClients indicates an expected call of SearchClients.
+func (mr *MockStoreInterfaceMockRecorder) SearchClients(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchClients", reflect.TypeOf((*MockStoreInterface)(nil).SearchClients), ctx, arg)
+}
+
+// SetCoordinatorAvailabilityTx mocks base method.
+func (m *MockStoreInterface) SetCoordinatorAvailabilityTx(ctx context.Context, arg db.SetCoordinatorAvailabilityTxParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCoordinatorAvailabilityTx", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCoordinatorAvailabilityTx indicates an expected call of SetCoordinatorAvailabilityTx.
+func (mr *MockStoreInterfaceMockRecorder) SetCoordinatorAvailabilityTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCoordinatorAvailabilityTx", reflect.TypeOf((*MockStoreInterface)(nil).SetCoordinatorAvailabilityTx), ctx, arg)
+}
+
+// SetFeatureFlag mocks base method.
+func (m *MockStoreInterface) SetFeatureFlag(ctx context.Context, arg db.SetFeatureFlagParams) (db.FeatureFlag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFeatureFlag", ctx, arg)
+	ret0, _ := ret[0].(db.FeatureFlag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetFeatureFlag indicates an expected call of SetFeatureFlag.
+func (mr *MockStoreInterfaceMockRecorder) SetFeatureFlag(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFeatureFlag", reflect.TypeOf((*MockStoreInterface)(nil).SetFeatureFlag), ctx, arg)
+}
+
+// SetRegistrationFormAttachments mocks base method.
+func (m *MockStoreInterface) SetRegistrationFormAttachments(ctx context.Context, arg db.SetRegistrationFormAttachmentsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRegistrationFormAttachments", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRegistrationFormAttachments indicates an expected call of SetRegistrationFormAttachments.
+func (mr *MockStoreInterfaceMockRecorder) SetRegistrationFormAttachments(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRegistrationFormAttachments", reflect.TypeOf((*MockStoreInterface)(nil).SetRegistrationFormAttachments), ctx, arg)
+}
+
+// SnapshotLocationCapacity mocks base method.
+func (m *MockStoreInterface) SnapshotLocationCapacity(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SnapshotLocationCapacity", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SnapshotLocationCapacity indicates an expected call of SnapshotLocationCapacity.
+func (mr *MockStoreInterfaceMockRecorder) SnapshotLocationCapacity(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SnapshotLocationCapacity", reflect.TypeOf((*MockStoreInterface)(nil).SnapshotLocationCapacity), ctx)
+}
+
 // SoftDeleteEmployee mocks base method.
 func (m *MockStoreInterface) SoftDeleteEmployee(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -2310,31 +3720,48 @@ func (mr *MockStoreInterfaceMockRecorder) SoftDeleteEmployee(ctx, id any) *gomoc
 }
 
 // SoftDeleteIncident mocks base method.
-func (m *MockStoreInterface) SoftDeleteIncident(ctx context.Context, id string) error {
+func (m *MockStoreInterface) SoftDeleteIncident(ctx context.Context, arg db.SoftDeleteIncidentParams) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SoftDeleteIncident", ctx, id)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "SoftDeleteIncident", ctx, arg)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // SoftDeleteIncident indicates an expected call of SoftDeleteIncident.
-func (mr *MockStoreInterfaceMockRecorder) SoftDeleteIncident(ctx, id any) *gomock.Call {
+func (mr *MockStoreInterfaceMockRecorder) SoftDeleteIncident(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDeleteIncident", reflect.TypeOf((*MockStoreInterface)(nil).SoftDeleteIncident), ctx, arg)
+}
+
+// SoftDeleteIntakeForm mocks base method.
+func (m *MockStoreInterface) SoftDeleteIntakeForm(ctx context.Context, id string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SoftDeleteIntakeForm", ctx, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SoftDeleteIntakeForm indicates an expected call of SoftDeleteIntakeForm.
+func (mr *MockStoreInterfaceMockRecorder) SoftDeleteIntakeForm(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDeleteIncident", reflect.TypeOf((*MockStoreInterface)(nil).SoftDeleteIncident), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDeleteIntakeForm", reflect.TypeOf((*MockStoreInterface)(nil).SoftDeleteIntakeForm), ctx, id)
 }
 
 // SoftDeleteLocation mocks base method.
-func (m *MockStoreInterface) SoftDeleteLocation(ctx context.Context, id string) error {
+func (m *MockStoreInterface) SoftDeleteLocation(ctx context.Context, arg db.SoftDeleteLocationParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SoftDeleteLocation", ctx, id)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "SoftDeleteLocation", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // SoftDeleteLocation indicates an expected call of SoftDeleteLocation.
-func (mr *MockStoreInterfaceMockRecorder) SoftDeleteLocation(ctx, id any) *gomock.Call {
+func (mr *MockStoreInterfaceMockRecorder) SoftDeleteLocation(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDeleteLocation", reflect.TypeOf((*MockStoreInterface)(nil).SoftDeleteLocation), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDeleteLocation", reflect.TypeOf((*MockStoreInterface)(nil).SoftDeleteLocation), ctx, arg)
 }
 
 // SoftDeleteRegistrationForm mocks base method.
@@ -2366,6 +3793,36 @@ func (mr *MockStoreInterfaceMockRecorder) SubmitDraftEvaluation(ctx, id any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitDraftEvaluation", reflect.TypeOf((*MockStoreInterface)(nil).SubmitDraftEvaluation), ctx, id)
 }
 
+// SubmitDraftEvaluationTx mocks base method.
+func (m *MockStoreInterface) SubmitDraftEvaluationTx(ctx context.Context, arg db.SubmitDraftEvaluationTxParams) (db.SubmitDraftEvaluationTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitDraftEvaluationTx", ctx, arg)
+	ret0, _ := ret[0].(db.SubmitDraftEvaluationTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitDraftEvaluationTx indicates an expected call of SubmitDraftEvaluationTx.
+func (mr *MockStoreInterfaceMockRecorder) SubmitDraftEvaluationTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitDraftEvaluationTx", reflect.TypeOf((*MockStoreInterface)(nil).SubmitDraftEvaluationTx), ctx, arg)
+}
+
+// SuggestReplacementCoordinator mocks base method.
+func (m *MockStoreInterface) SuggestReplacementCoordinator(ctx context.Context, employeeID string) (db.SuggestReplacementCoordinatorRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuggestReplacementCoordinator", ctx, employeeID)
+	ret0, _ := ret[0].(db.SuggestReplacementCoordinatorRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuggestReplacementCoordinator indicates an expected call of SuggestReplacementCoordinator.
+func (mr *MockStoreInterfaceMockRecorder) SuggestReplacementCoordinator(ctx, employeeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestReplacementCoordinator", reflect.TypeOf((*MockStoreInterface)(nil).SuggestReplacementCoordinator), ctx, employeeID)
+}
+
 // UpdateAppointment mocks base method.
 func (m *MockStoreInterface) UpdateAppointment(ctx context.Context, arg db.UpdateAppointmentParams) (db.Appointment, error) {
 	m.ctrl.T.Helper()
@@ -2524,6 +3981,21 @@ func (mr *MockStoreInterfaceMockRecorder) UpdateIncident(ctx, arg any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIncident", reflect.TypeOf((*MockStoreInterface)(nil).UpdateIncident), ctx, arg)
 }
 
+// UpdateIntakeDocumentStatus mocks base method.
+func (m *MockStoreInterface) UpdateIntakeDocumentStatus(ctx context.Context, arg db.UpdateIntakeDocumentStatusParams) (db.IntakeRequiredDocument, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIntakeDocumentStatus", ctx, arg)
+	ret0, _ := ret[0].(db.IntakeRequiredDocument)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateIntakeDocumentStatus indicates an expected call of UpdateIntakeDocumentStatus.
+func (mr *MockStoreInterfaceMockRecorder) UpdateIntakeDocumentStatus(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIntakeDocumentStatus", reflect.TypeOf((*MockStoreInterface)(nil).UpdateIntakeDocumentStatus), ctx, arg)
+}
+
 // UpdateIntakeForm mocks base method.
 func (m *MockStoreInterface) UpdateIntakeForm(ctx context.Context, arg db.UpdateIntakeFormParams) error {
 	m.ctrl.T.Helper()
@@ -2553,11 +4025,12 @@ func (mr *MockStoreInterfaceMockRecorder) UpdateIntakeFormStatus(ctx, arg any) *
 }
 
 // UpdateLocation mocks base method.
-func (m *MockStoreInterface) UpdateLocation(ctx context.Context, arg db.UpdateLocationParams) error {
+func (m *MockStoreInterface) UpdateLocation(ctx context.Context, arg db.UpdateLocationParams) (int64, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdateLocation", ctx, arg)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // UpdateLocation indicates an expected call of UpdateLocation.
@@ -2608,6 +4081,21 @@ func (mr *MockStoreInterfaceMockRecorder) UpdateRegistrationForm(ctx, arg any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRegistrationForm", reflect.TypeOf((*MockStoreInterface)(nil).UpdateRegistrationForm), ctx, arg)
 }
 
+// UpdateRegistrationFormAttachmentCaption mocks base method.
+func (m *MockStoreInterface) UpdateRegistrationFormAttachmentCaption(ctx context.Context, arg db.UpdateRegistrationFormAttachmentCaptionParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRegistrationFormAttachmentCaption", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRegistrationFormAttachmentCaption indicates an expected call of UpdateRegistrationFormAttachmentCaption.
+func (mr *MockStoreInterfaceMockRecorder) UpdateRegistrationFormAttachmentCaption(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRegistrationFormAttachmentCaption", reflect.TypeOf((*MockStoreInterface)(nil).UpdateRegistrationFormAttachmentCaption), ctx, arg)
+}
+
 // UpdateRegistrationFormStatus mocks base method.
 func (m *MockStoreInterface) UpdateRegistrationFormStatus(ctx context.Context, arg db.UpdateRegistrationFormStatusParams) error {
 	m.ctrl.T.Helper()
@@ -2622,6 +4110,20 @@ func (mr *MockStoreInterfaceMockRecorder) UpdateRegistrationFormStatus(ctx, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRegistrationFormStatus", reflect.TypeOf((*MockStoreInterface)(nil).UpdateRegistrationFormStatus), ctx, arg)
 }
 
+// UpdateRegistrationFormTx mocks base method.
+func (m *MockStoreInterface) UpdateRegistrationFormTx(ctx context.Context, arg db.UpdateRegistrationFormTxParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRegistrationFormTx", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRegistrationFormTx indicates an expected call of UpdateRegistrationFormTx.
+func (mr *MockStoreInterfaceMockRecorder) UpdateRegistrationFormTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRegistrationFormTx", reflect.TypeOf((*MockStoreInterface)(nil).UpdateRegistrationFormTx), ctx, arg)
+}
+
 // UpdateReminder mocks base method.
 func (m *MockStoreInterface) UpdateReminder(ctx context.Context, arg db.UpdateReminderParams) (db.Reminder, error) {
 	m.ctrl.T.Helper()
@@ -2652,6 +4154,21 @@ func (mr *MockStoreInterfaceMockRecorder) UpdateRole(ctx, arg any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRole", reflect.TypeOf((*MockStoreInterface)(nil).UpdateRole), ctx, arg)
 }
 
+// UpdateRoleWithPermissionsTx mocks base method.
+func (m *MockStoreInterface) UpdateRoleWithPermissionsTx(ctx context.Context, arg db.UpdateRoleWithPermissionsTxParams) (db.UpdateRoleWithPermissionsTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRoleWithPermissionsTx", ctx, arg)
+	ret0, _ := ret[0].(db.UpdateRoleWithPermissionsTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRoleWithPermissionsTx indicates an expected call of UpdateRoleWithPermissionsTx.
+func (mr *MockStoreInterfaceMockRecorder) UpdateRoleWithPermissionsTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoleWithPermissionsTx", reflect.TypeOf((*MockStoreInterface)(nil).UpdateRoleWithPermissionsTx), ctx, arg)
+}
+
 // UpdateUser mocks base method.
 func (m *MockStoreInterface) UpdateUser(ctx context.Context, arg db.UpdateUserParams) error {
 	m.ctrl.T.Helper()
@@ -2666,6 +4183,20 @@ func (mr *MockStoreInterfaceMockRecorder) UpdateUser(ctx, arg any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockStoreInterface)(nil).UpdateUser), ctx, arg)
 }
 
+// UpdateUserEmail mocks base method.
+func (m *MockStoreInterface) UpdateUserEmail(ctx context.Context, arg db.UpdateUserEmailParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserEmail", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserEmail indicates an expected call of UpdateUserEmail.
+func (mr *MockStoreInterfaceMockRecorder) UpdateUserEmail(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserEmail", reflect.TypeOf((*MockStoreInterface)(nil).UpdateUserEmail), ctx, arg)
+}
+
 // UpdateUserMFASecret mocks base method.
 func (m *MockStoreInterface) UpdateUserMFASecret(ctx context.Context, arg db.UpdateUserMFASecretParams) error {
 	m.ctrl.T.Helper()
@@ -2693,3 +4224,18 @@ func (mr *MockStoreInterfaceMockRecorder) UpdateUserSession(ctx, arg any) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserSession", reflect.TypeOf((*MockStoreInterface)(nil).UpdateUserSession), ctx, arg)
 }
+
+// UpsertNotificationQuietHours mocks base method.
+func (m *MockStoreInterface) UpsertNotificationQuietHours(ctx context.Context, arg db.UpsertNotificationQuietHoursParams) (db.NotificationQuietHour, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertNotificationQuietHours", ctx, arg)
+	ret0, _ := ret[0].(db.NotificationQuietHour)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertNotificationQuietHours indicates an expected call of UpsertNotificationQuietHours.
+func (mr *MockStoreInterfaceMockRecorder) UpsertNotificationQuietHours(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertNotificationQuietHours", reflect.TypeOf((*MockStoreInterface)(nil).UpsertNotificationQuietHours), ctx, arg)
+}
@@ -54,6 +54,14 @@ func (ns NullAppointmentStatusEnum) Value() (driver.Value, error) {
 	return string(ns.AppointmentStatusEnum), nil
 }
 
+func AllAppointmentStatusEnumValues() []AppointmentStatusEnum {
+	return []AppointmentStatusEnum{
+		AppointmentStatusEnumConfirmed,
+		AppointmentStatusEnumCancelled,
+		AppointmentStatusEnumTentative,
+	}
+}
+
 type AppointmentTypeEnum string
 
 const (
@@ -97,6 +105,14 @@ func (ns NullAppointmentTypeEnum) Value() (driver.Value, error) {
 	return string(ns.AppointmentTypeEnum), nil
 }
 
+func AllAppointmentTypeEnumValues() []AppointmentTypeEnum {
+	return []AppointmentTypeEnum{
+		AppointmentTypeEnumGeneral,
+		AppointmentTypeEnumIntake,
+		AppointmentTypeEnumAmbulatory,
+	}
+}
+
 type AuditActionEnum string
 
 const (
@@ -144,6 +160,18 @@ func (ns NullAuditActionEnum) Value() (driver.Value, error) {
 	return string(ns.AuditActionEnum), nil
 }
 
+func AllAuditActionEnumValues() []AuditActionEnum {
+	return []AuditActionEnum{
+		AuditActionEnumRead,
+		AuditActionEnumCreate,
+		AuditActionEnumUpdate,
+		AuditActionEnumDelete,
+		AuditActionEnumLogin,
+		AuditActionEnumLogout,
+		AuditActionEnumExport,
+	}
+}
+
 type AuditStatusEnum string
 
 const (
@@ -186,6 +214,13 @@ func (ns NullAuditStatusEnum) Value() (driver.Value, error) {
 	return string(ns.AuditStatusEnum), nil
 }
 
+func AllAuditStatusEnumValues() []AuditStatusEnum {
+	return []AuditStatusEnum{
+		AuditStatusEnumSuccess,
+		AuditStatusEnumFailure,
+	}
+}
+
 type CareTypeEnum string
 
 const (
@@ -230,6 +265,15 @@ func (ns NullCareTypeEnum) Value() (driver.Value, error) {
 	return string(ns.CareTypeEnum), nil
 }
 
+func AllCareTypeEnumValues() []CareTypeEnum {
+	return []CareTypeEnum{
+		CareTypeEnumProtectedLiving,
+		CareTypeEnumSemiIndependentLiving,
+		CareTypeEnumIndependentAssistedLiving,
+		CareTypeEnumAmbulatoryCare,
+	}
+}
+
 type ClientStatusEnum string
 
 const (
@@ -273,6 +317,14 @@ func (ns NullClientStatusEnum) Value() (driver.Value, error) {
 	return string(ns.ClientStatusEnum), nil
 }
 
+func AllClientStatusEnumValues() []ClientStatusEnum {
+	return []ClientStatusEnum{
+		ClientStatusEnumWaitingList,
+		ClientStatusEnumInCare,
+		ClientStatusEnumDischarged,
+	}
+}
+
 type ContractTypeEnum string
 
 const (
@@ -315,6 +367,13 @@ func (ns NullContractTypeEnum) Value() (driver.Value, error) {
 	return string(ns.ContractTypeEnum), nil
 }
 
+func AllContractTypeEnumValues() []ContractTypeEnum {
+	return []ContractTypeEnum{
+		ContractTypeEnumSelfEmployed,
+		ContractTypeEnumPayrollService,
+	}
+}
+
 type DischargeReasonEnum string
 
 const (
@@ -361,6 +420,17 @@ func (ns NullDischargeReasonEnum) Value() (driver.Value, error) {
 	return string(ns.DischargeReasonEnum), nil
 }
 
+func AllDischargeReasonEnumValues() []DischargeReasonEnum {
+	return []DischargeReasonEnum{
+		DischargeReasonEnumTreatmentCompleted,
+		DischargeReasonEnumTerminatedByMutualAgreement,
+		DischargeReasonEnumTerminatedByClient,
+		DischargeReasonEnumTerminatedByProvider,
+		DischargeReasonEnumTerminatedDueToExternalFactors,
+		DischargeReasonEnumOther,
+	}
+}
+
 type DischargeStatusEnum string
 
 const (
@@ -403,6 +473,64 @@ func (ns NullDischargeStatusEnum) Value() (driver.Value, error) {
 	return string(ns.DischargeStatusEnum), nil
 }
 
+func AllDischargeStatusEnumValues() []DischargeStatusEnum {
+	return []DischargeStatusEnum{
+		DischargeStatusEnumInProgress,
+		DischargeStatusEnumCompleted,
+	}
+}
+
+type EvaluationOutcomeEnum string
+
+const (
+	EvaluationOutcomeEnumOnTrack        EvaluationOutcomeEnum = "on_track"
+	EvaluationOutcomeEnumNeedsAttention EvaluationOutcomeEnum = "needs_attention"
+	EvaluationOutcomeEnumPlanAdjusted   EvaluationOutcomeEnum = "plan_adjusted"
+)
+
+func (e *EvaluationOutcomeEnum) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = EvaluationOutcomeEnum(s)
+	case string:
+		*e = EvaluationOutcomeEnum(s)
+	default:
+		return fmt.Errorf("unsupported scan type for EvaluationOutcomeEnum: %T", src)
+	}
+	return nil
+}
+
+type NullEvaluationOutcomeEnum struct {
+	EvaluationOutcomeEnum EvaluationOutcomeEnum `json:"evaluation_outcome_enum"`
+	Valid                 bool                  `json:"valid"` // Valid is true if EvaluationOutcomeEnum is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullEvaluationOutcomeEnum) Scan(value interface{}) error {
+	if value == nil {
+		ns.EvaluationOutcomeEnum, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.EvaluationOutcomeEnum.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullEvaluationOutcomeEnum) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.EvaluationOutcomeEnum), nil
+}
+
+func AllEvaluationOutcomeEnumValues() []EvaluationOutcomeEnum {
+	return []EvaluationOutcomeEnum{
+		EvaluationOutcomeEnumOnTrack,
+		EvaluationOutcomeEnumNeedsAttention,
+		EvaluationOutcomeEnumPlanAdjusted,
+	}
+}
+
 type EvaluationStatusEnum string
 
 const (
@@ -445,12 +573,22 @@ func (ns NullEvaluationStatusEnum) Value() (driver.Value, error) {
 	return string(ns.EvaluationStatusEnum), nil
 }
 
+func AllEvaluationStatusEnumValues() []EvaluationStatusEnum {
+	return []EvaluationStatusEnum{
+		EvaluationStatusEnumDraft,
+		EvaluationStatusEnumSubmitted,
+	}
+}
+
 type GenderEnum string
 
 const (
-	GenderEnumMale   GenderEnum = "male"
-	GenderEnumFemale GenderEnum = "female"
-	GenderEnumOther  GenderEnum = "other"
+	GenderEnumMale           GenderEnum = "male"
+	GenderEnumFemale         GenderEnum = "female"
+	GenderEnumOther          GenderEnum = "other"
+	GenderEnumNonBinary      GenderEnum = "non_binary"
+	GenderEnumUnknown        GenderEnum = "unknown"
+	GenderEnumPreferNotToSay GenderEnum = "prefer_not_to_say"
 )
 
 func (e *GenderEnum) Scan(src interface{}) error {
@@ -488,6 +626,17 @@ func (ns NullGenderEnum) Value() (driver.Value, error) {
 	return string(ns.GenderEnum), nil
 }
 
+func AllGenderEnumValues() []GenderEnum {
+	return []GenderEnum{
+		GenderEnumMale,
+		GenderEnumFemale,
+		GenderEnumOther,
+		GenderEnumNonBinary,
+		GenderEnumUnknown,
+		GenderEnumPreferNotToSay,
+	}
+}
+
 type GoalProgressStatus string
 
 const (
@@ -538,6 +687,82 @@ func (ns NullGoalProgressStatus) Value() (driver.Value, error) {
 	return string(ns.GoalProgressStatus), nil
 }
 
+func AllGoalProgressStatusValues() []GoalProgressStatus {
+	return []GoalProgressStatus{
+		GoalProgressStatusNotStarted,
+		GoalProgressStatusStarting,
+		GoalProgressStatusInProgress,
+		GoalProgressStatusOnTrack,
+		GoalProgressStatusDelayed,
+		GoalProgressStatusStagnant,
+		GoalProgressStatusDeteriorating,
+		GoalProgressStatusAdjusted,
+		GoalProgressStatusNotApplicable,
+		GoalProgressStatusAchieved,
+	}
+}
+
+type IncidentCategoryEnum string
+
+const (
+	IncidentCategoryEnumMedication     IncidentCategoryEnum = "medication"
+	IncidentCategoryEnumAggression     IncidentCategoryEnum = "aggression"
+	IncidentCategoryEnumFall           IncidentCategoryEnum = "fall"
+	IncidentCategoryEnumAbsconding     IncidentCategoryEnum = "absconding"
+	IncidentCategoryEnumSelfHarm       IncidentCategoryEnum = "self_harm"
+	IncidentCategoryEnumSubstanceUse   IncidentCategoryEnum = "substance_use"
+	IncidentCategoryEnumPropertyDamage IncidentCategoryEnum = "property_damage"
+	IncidentCategoryEnumOther          IncidentCategoryEnum = "other"
+)
+
+func (e *IncidentCategoryEnum) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = IncidentCategoryEnum(s)
+	case string:
+		*e = IncidentCategoryEnum(s)
+	default:
+		return fmt.Errorf("unsupported scan type for IncidentCategoryEnum: %T", src)
+	}
+	return nil
+}
+
+type NullIncidentCategoryEnum struct {
+	IncidentCategoryEnum IncidentCategoryEnum `json:"incident_category_enum"`
+	Valid                bool                 `json:"valid"` // Valid is true if IncidentCategoryEnum is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullIncidentCategoryEnum) Scan(value interface{}) error {
+	if value == nil {
+		ns.IncidentCategoryEnum, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.IncidentCategoryEnum.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullIncidentCategoryEnum) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.IncidentCategoryEnum), nil
+}
+
+func AllIncidentCategoryEnumValues() []IncidentCategoryEnum {
+	return []IncidentCategoryEnum{
+		IncidentCategoryEnumMedication,
+		IncidentCategoryEnumAggression,
+		IncidentCategoryEnumFall,
+		IncidentCategoryEnumAbsconding,
+		IncidentCategoryEnumSelfHarm,
+		IncidentCategoryEnumSubstanceUse,
+		IncidentCategoryEnumPropertyDamage,
+		IncidentCategoryEnumOther,
+	}
+}
+
 type IncidentSeverityEnum string
 
 const (
@@ -581,6 +806,14 @@ func (ns NullIncidentSeverityEnum) Value() (driver.Value, error) {
 	return string(ns.IncidentSeverityEnum), nil
 }
 
+func AllIncidentSeverityEnumValues() []IncidentSeverityEnum {
+	return []IncidentSeverityEnum{
+		IncidentSeverityEnumMinor,
+		IncidentSeverityEnumModerate,
+		IncidentSeverityEnumSevere,
+	}
+}
+
 type IncidentStatusEnum string
 
 const (
@@ -624,6 +857,14 @@ func (ns NullIncidentStatusEnum) Value() (driver.Value, error) {
 	return string(ns.IncidentStatusEnum), nil
 }
 
+func AllIncidentStatusEnumValues() []IncidentStatusEnum {
+	return []IncidentStatusEnum{
+		IncidentStatusEnumPending,
+		IncidentStatusEnumUnderInvestigation,
+		IncidentStatusEnumCompleted,
+	}
+}
+
 type IncidentTypeEnum string
 
 const (
@@ -669,6 +910,116 @@ func (ns NullIncidentTypeEnum) Value() (driver.Value, error) {
 	return string(ns.IncidentTypeEnum), nil
 }
 
+func AllIncidentTypeEnumValues() []IncidentTypeEnum {
+	return []IncidentTypeEnum{
+		IncidentTypeEnumAggression,
+		IncidentTypeEnumMedicalEmergency,
+		IncidentTypeEnumSafetyConcern,
+		IncidentTypeEnumUnwantedBehavior,
+		IncidentTypeEnumOther,
+	}
+}
+
+type IntakeDocumentStatusEnum string
+
+const (
+	IntakeDocumentStatusEnumMissing  IntakeDocumentStatusEnum = "missing"
+	IntakeDocumentStatusEnumReceived IntakeDocumentStatusEnum = "received"
+)
+
+func (e *IntakeDocumentStatusEnum) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = IntakeDocumentStatusEnum(s)
+	case string:
+		*e = IntakeDocumentStatusEnum(s)
+	default:
+		return fmt.Errorf("unsupported scan type for IntakeDocumentStatusEnum: %T", src)
+	}
+	return nil
+}
+
+type NullIntakeDocumentStatusEnum struct {
+	IntakeDocumentStatusEnum IntakeDocumentStatusEnum `json:"intake_document_status_enum"`
+	Valid                    bool                     `json:"valid"` // Valid is true if IntakeDocumentStatusEnum is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullIntakeDocumentStatusEnum) Scan(value interface{}) error {
+	if value == nil {
+		ns.IntakeDocumentStatusEnum, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.IntakeDocumentStatusEnum.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullIntakeDocumentStatusEnum) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.IntakeDocumentStatusEnum), nil
+}
+
+func AllIntakeDocumentStatusEnumValues() []IntakeDocumentStatusEnum {
+	return []IntakeDocumentStatusEnum{
+		IntakeDocumentStatusEnumMissing,
+		IntakeDocumentStatusEnumReceived,
+	}
+}
+
+type IntakeDocumentTypeEnum string
+
+const (
+	IntakeDocumentTypeEnumIdentification IntakeDocumentTypeEnum = "identification"
+	IntakeDocumentTypeEnumCareIndication IntakeDocumentTypeEnum = "care_indication"
+	IntakeDocumentTypeEnumConsent        IntakeDocumentTypeEnum = "consent"
+)
+
+func (e *IntakeDocumentTypeEnum) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = IntakeDocumentTypeEnum(s)
+	case string:
+		*e = IntakeDocumentTypeEnum(s)
+	default:
+		return fmt.Errorf("unsupported scan type for IntakeDocumentTypeEnum: %T", src)
+	}
+	return nil
+}
+
+type NullIntakeDocumentTypeEnum struct {
+	IntakeDocumentTypeEnum IntakeDocumentTypeEnum `json:"intake_document_type_enum"`
+	Valid                  bool                   `json:"valid"` // Valid is true if IntakeDocumentTypeEnum is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullIntakeDocumentTypeEnum) Scan(value interface{}) error {
+	if value == nil {
+		ns.IntakeDocumentTypeEnum, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.IntakeDocumentTypeEnum.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullIntakeDocumentTypeEnum) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.IntakeDocumentTypeEnum), nil
+}
+
+func AllIntakeDocumentTypeEnumValues() []IntakeDocumentTypeEnum {
+	return []IntakeDocumentTypeEnum{
+		IntakeDocumentTypeEnumIdentification,
+		IntakeDocumentTypeEnumCareIndication,
+		IntakeDocumentTypeEnumConsent,
+	}
+}
+
 type IntakeStatusEnum string
 
 const (
@@ -712,12 +1063,21 @@ func (ns NullIntakeStatusEnum) Value() (driver.Value, error) {
 	return string(ns.IntakeStatusEnum), nil
 }
 
+func AllIntakeStatusEnumValues() []IntakeStatusEnum {
+	return []IntakeStatusEnum{
+		IntakeStatusEnumCompleted,
+		IntakeStatusEnumPending,
+		IntakeStatusEnumRejected,
+	}
+}
+
 type LocationTransferStatusEnum string
 
 const (
-	LocationTransferStatusEnumPending  LocationTransferStatusEnum = "pending"
-	LocationTransferStatusEnumApproved LocationTransferStatusEnum = "approved"
-	LocationTransferStatusEnumRejected LocationTransferStatusEnum = "rejected"
+	LocationTransferStatusEnumPending   LocationTransferStatusEnum = "pending"
+	LocationTransferStatusEnumApproved  LocationTransferStatusEnum = "approved"
+	LocationTransferStatusEnumRejected  LocationTransferStatusEnum = "rejected"
+	LocationTransferStatusEnumCancelled LocationTransferStatusEnum = "cancelled"
 )
 
 func (e *LocationTransferStatusEnum) Scan(src interface{}) error {
@@ -755,6 +1115,15 @@ func (ns NullLocationTransferStatusEnum) Value() (driver.Value, error) {
 	return string(ns.LocationTransferStatusEnum), nil
 }
 
+func AllLocationTransferStatusEnumValues() []LocationTransferStatusEnum {
+	return []LocationTransferStatusEnum{
+		LocationTransferStatusEnumPending,
+		LocationTransferStatusEnumApproved,
+		LocationTransferStatusEnumRejected,
+		LocationTransferStatusEnumCancelled,
+	}
+}
+
 type NotificationPriorityEnum string
 
 const (
@@ -799,18 +1168,30 @@ func (ns NullNotificationPriorityEnum) Value() (driver.Value, error) {
 	return string(ns.NotificationPriorityEnum), nil
 }
 
+func AllNotificationPriorityEnumValues() []NotificationPriorityEnum {
+	return []NotificationPriorityEnum{
+		NotificationPriorityEnumLow,
+		NotificationPriorityEnumNormal,
+		NotificationPriorityEnumHigh,
+		NotificationPriorityEnumUrgent,
+	}
+}
+
 type NotificationTypeEnum string
 
 const (
 	NotificationTypeEnumEvaluationDue            NotificationTypeEnum = "evaluation_due"
 	NotificationTypeEnumAppointmentReminder      NotificationTypeEnum = "appointment_reminder"
+	NotificationTypeEnumAppointmentCancelled     NotificationTypeEnum = "appointment_cancelled"
 	NotificationTypeEnumIncidentCreated          NotificationTypeEnum = "incident_created"
 	NotificationTypeEnumLocationTransferRequest  NotificationTypeEnum = "location_transfer_request"
 	NotificationTypeEnumLocationTransferApproved NotificationTypeEnum = "location_transfer_approved"
 	NotificationTypeEnumLocationTransferRejected NotificationTypeEnum = "location_transfer_rejected"
+	NotificationTypeEnumCoordinatorHandover      NotificationTypeEnum = "coordinator_handover"
 	NotificationTypeEnumClientStatusChange       NotificationTypeEnum = "client_status_change"
 	NotificationTypeEnumRegistrationStatusChange NotificationTypeEnum = "registration_status_change"
 	NotificationTypeEnumSystemAlert              NotificationTypeEnum = "system_alert"
+	NotificationTypeEnumIncidentEscalated        NotificationTypeEnum = "incident_escalated"
 )
 
 func (e *NotificationTypeEnum) Scan(src interface{}) error {
@@ -848,6 +1229,23 @@ func (ns NullNotificationTypeEnum) Value() (driver.Value, error) {
 	return string(ns.NotificationTypeEnum), nil
 }
 
+func AllNotificationTypeEnumValues() []NotificationTypeEnum {
+	return []NotificationTypeEnum{
+		NotificationTypeEnumEvaluationDue,
+		NotificationTypeEnumAppointmentReminder,
+		NotificationTypeEnumAppointmentCancelled,
+		NotificationTypeEnumIncidentCreated,
+		NotificationTypeEnumLocationTransferRequest,
+		NotificationTypeEnumLocationTransferApproved,
+		NotificationTypeEnumLocationTransferRejected,
+		NotificationTypeEnumCoordinatorHandover,
+		NotificationTypeEnumClientStatusChange,
+		NotificationTypeEnumRegistrationStatusChange,
+		NotificationTypeEnumSystemAlert,
+		NotificationTypeEnumIncidentEscalated,
+	}
+}
+
 type ParticipantTypeEnum string
 
 const (
@@ -890,6 +1288,13 @@ func (ns NullParticipantTypeEnum) Value() (driver.Value, error) {
 	return string(ns.ParticipantTypeEnum), nil
 }
 
+func AllParticipantTypeEnumValues() []ParticipantTypeEnum {
+	return []ParticipantTypeEnum{
+		ParticipantTypeEnumEmployee,
+		ParticipantTypeEnumClient,
+	}
+}
+
 type RegistrationStatusEnum string
 
 const (
@@ -934,6 +1339,15 @@ func (ns NullRegistrationStatusEnum) Value() (driver.Value, error) {
 	return string(ns.RegistrationStatusEnum), nil
 }
 
+func AllRegistrationStatusEnumValues() []RegistrationStatusEnum {
+	return []RegistrationStatusEnum{
+		RegistrationStatusEnumPending,
+		RegistrationStatusEnumApproved,
+		RegistrationStatusEnumRejected,
+		RegistrationStatusEnumInReview,
+	}
+}
+
 type WaitingListPriorityEnum string
 
 const (
@@ -977,19 +1391,28 @@ func (ns NullWaitingListPriorityEnum) Value() (driver.Value, error) {
 	return string(ns.WaitingListPriorityEnum), nil
 }
 
+func AllWaitingListPriorityEnumValues() []WaitingListPriorityEnum {
+	return []WaitingListPriorityEnum{
+		WaitingListPriorityEnumLow,
+		WaitingListPriorityEnumNormal,
+		WaitingListPriorityEnumHigh,
+	}
+}
+
 type Appointment struct {
-	ID             string                    `json:"id"`
-	Title          string                    `json:"title"`
-	Description    *string                   `json:"description"`
-	StartTime      pgtype.Timestamptz        `json:"start_time"`
-	EndTime        pgtype.Timestamptz        `json:"end_time"`
-	Location       *string                   `json:"location"`
-	OrganizerID    string                    `json:"organizer_id"`
-	Status         NullAppointmentStatusEnum `json:"status"`
-	Type           AppointmentTypeEnum       `json:"type"`
-	RecurrenceRule *string                   `json:"recurrence_rule"`
-	CreatedAt      pgtype.Timestamptz        `json:"created_at"`
-	UpdatedAt      pgtype.Timestamptz        `json:"updated_at"`
+	ID                 string                    `json:"id"`
+	Title              string                    `json:"title"`
+	Description        *string                   `json:"description"`
+	StartTime          pgtype.Timestamptz        `json:"start_time"`
+	EndTime            pgtype.Timestamptz        `json:"end_time"`
+	Location           *string                   `json:"location"`
+	OrganizerID        string                    `json:"organizer_id"`
+	Status             NullAppointmentStatusEnum `json:"status"`
+	Type               AppointmentTypeEnum       `json:"type"`
+	RecurrenceRule     *string                   `json:"recurrence_rule"`
+	CreatedAt          pgtype.Timestamptz        `json:"created_at"`
+	UpdatedAt          pgtype.Timestamptz        `json:"updated_at"`
+	CancellationReason *string                   `json:"cancellation_reason"`
 }
 
 type AppointmentExternalMapping struct {
@@ -1008,7 +1431,20 @@ type Attachment struct {
 	ID          string             `json:"id"`
 	Filekey     string             `json:"filekey"`
 	ContentType string             `json:"content_type"`
+	UploadedBy  *string            `json:"uploaded_by"`
 	UploadedAt  pgtype.Timestamptz `json:"uploaded_at"`
+	Filename    *string            `json:"filename"`
+}
+
+type AttachmentAccessLog struct {
+	ID           string             `json:"id"`
+	AttachmentID string             `json:"attachment_id"`
+	UserID       *string            `json:"user_id"`
+	ResourceType *string            `json:"resource_type"`
+	ResourceID   *string            `json:"resource_id"`
+	IpAddress    *string            `json:"ip_address"`
+	UserAgent    *string            `json:"user_agent"`
+	AccessedAt   pgtype.Timestamptz `json:"accessed_at"`
 }
 
 type AuditLog struct {
@@ -1077,6 +1513,17 @@ type Client struct {
 	NextEvaluationDate      pgtype.Date             `json:"next_evaluation_date"`
 	CreatedAt               pgtype.Timestamp        `json:"created_at"`
 	UpdatedAt               pgtype.Timestamp        `json:"updated_at"`
+	CreatedByUserID         *string                 `json:"created_by_user_id"`
+	OrganizationID          *string                 `json:"organization_id"`
+}
+
+type ClientAssignmentHistory struct {
+	ID            string             `json:"id"`
+	ClientID      string             `json:"client_id"`
+	CoordinatorID string             `json:"coordinator_id"`
+	LocationID    string             `json:"location_id"`
+	ChangedBy     *string            `json:"changed_by"`
+	ChangedAt     pgtype.Timestamptz `json:"changed_at"`
 }
 
 type ClientEvaluation struct {
@@ -1111,25 +1558,75 @@ type ClientLocationTransfer struct {
 	Reason               *string                    `json:"reason"`
 	Status               LocationTransferStatusEnum `json:"status"`
 	RejectionReason      *string                    `json:"rejection_reason"`
+	CancellationReason   *string                    `json:"cancellation_reason"`
+	HandoverNotes        *string                    `json:"handover_notes"`
+	ApprovedAt           pgtype.Timestamp           `json:"approved_at"`
+	RejectedAt           pgtype.Timestamp           `json:"rejected_at"`
 	CreatedAt            pgtype.Timestamp           `json:"created_at"`
 	UpdatedAt            pgtype.Timestamp           `json:"updated_at"`
+	CreatedByUserID      *string                    `json:"created_by_user_id"`
+}
+
+type ClientNote struct {
+	ID        string             `json:"id"`
+	ClientID  string             `json:"client_id"`
+	AuthorID  string             `json:"author_id"`
+	Body      string             `json:"body"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type ClientPiiPurge struct {
+	ClientID        string             `json:"client_id"`
+	RetentionMonths int32              `json:"retention_months"`
+	PurgedAt        pgtype.Timestamptz `json:"purged_at"`
+}
+
+type CoordinatorAvailability struct {
+	ID         string             `json:"id"`
+	EmployeeID string             `json:"employee_id"`
+	Weekday    int32              `json:"weekday"`
+	StartTime  pgtype.Time        `json:"start_time"`
+	EndTime    pgtype.Time        `json:"end_time"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
 }
 
 type Employee struct {
-	ID            string               `json:"id"`
-	UserID        string               `json:"user_id"`
-	FirstName     string               `json:"first_name"`
-	LastName      string               `json:"last_name"`
-	Bsn           string               `json:"bsn"`
-	DateOfBirth   pgtype.Date          `json:"date_of_birth"`
-	PhoneNumber   string               `json:"phone_number"`
-	Gender        GenderEnum           `json:"gender"`
-	ContractHours *int32               `json:"contract_hours"`
-	ContractType  NullContractTypeEnum `json:"contract_type"`
-	LocationID    string               `json:"location_id"`
-	CreatedAt     pgtype.Timestamp     `json:"created_at"`
-	UpdatedAt     pgtype.Timestamp     `json:"updated_at"`
-	IsDeleted     *bool                `json:"is_deleted"`
+	ID             string               `json:"id"`
+	UserID         string               `json:"user_id"`
+	FirstName      string               `json:"first_name"`
+	LastName       string               `json:"last_name"`
+	Bsn            string               `json:"bsn"`
+	DateOfBirth    pgtype.Date          `json:"date_of_birth"`
+	PhoneNumber    string               `json:"phone_number"`
+	Gender         GenderEnum           `json:"gender"`
+	ContractHours  *int32               `json:"contract_hours"`
+	ContractType   NullContractTypeEnum `json:"contract_type"`
+	LocationID     string               `json:"location_id"`
+	CreatedAt      pgtype.Timestamp     `json:"created_at"`
+	UpdatedAt      pgtype.Timestamp     `json:"updated_at"`
+	IsDeleted      *bool                `json:"is_deleted"`
+	OrganizationID *string              `json:"organization_id"`
+	MaxCaseload    *int32               `json:"max_caseload"`
+}
+
+type Evaluation struct {
+	ID            string                    `json:"id"`
+	ClientID      string                    `json:"client_id"`
+	CoordinatorID string                    `json:"coordinator_id"`
+	ScheduledDate pgtype.Date               `json:"scheduled_date"`
+	CompletedDate pgtype.Date               `json:"completed_date"`
+	Notes         *string                   `json:"notes"`
+	Outcome       NullEvaluationOutcomeEnum `json:"outcome"`
+	CreatedAt     pgtype.Timestamptz        `json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz        `json:"updated_at"`
+}
+
+type FeatureFlag struct {
+	Key       string             `json:"key"`
+	Enabled   bool               `json:"enabled"`
+	Value     []byte             `json:"value"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
 }
 
 type GoalProgressLog struct {
@@ -1149,6 +1646,7 @@ type Incident struct {
 	IncidentTime        pgtype.Time          `json:"incident_time"`
 	IncidentType        IncidentTypeEnum     `json:"incident_type"`
 	IncidentSeverity    IncidentSeverityEnum `json:"incident_severity"`
+	IncidentCategory    IncidentCategoryEnum `json:"incident_category"`
 	LocationID          string               `json:"location_id"`
 	CoordinatorID       string               `json:"coordinator_id"`
 	IncidentDescription string               `json:"incident_description"`
@@ -1158,6 +1656,15 @@ type Incident struct {
 	CreatedAt           pgtype.Timestamp     `json:"created_at"`
 	UpdatedAt           pgtype.Timestamp     `json:"updated_at"`
 	IsDeleted           *bool                `json:"is_deleted"`
+	DeletedAt           pgtype.Timestamptz   `json:"deleted_at"`
+	DeletedBy           *string              `json:"deleted_by"`
+	CreatedByUserID     *string              `json:"created_by_user_id"`
+	EscalatedAt         pgtype.Timestamptz   `json:"escalated_at"`
+}
+
+type IntakeDocumentRequirement struct {
+	CareType     CareTypeEnum           `json:"care_type"`
+	DocumentType IntakeDocumentTypeEnum `json:"document_type"`
 }
 
 type IntakeForm struct {
@@ -1176,18 +1683,54 @@ type IntakeForm struct {
 	Status                  IntakeStatusEnum `json:"status"`
 	CreatedAt               pgtype.Timestamp `json:"created_at"`
 	UpdatedAt               pgtype.Timestamp `json:"updated_at"`
+	CreatedByUserID         *string          `json:"created_by_user_id"`
+	IsDeleted               *bool            `json:"is_deleted"`
+}
+
+type IntakeRescheduleHistory struct {
+	ID            string             `json:"id"`
+	IntakeFormID  string             `json:"intake_form_id"`
+	OldIntakeDate pgtype.Date        `json:"old_intake_date"`
+	OldIntakeTime pgtype.Time        `json:"old_intake_time"`
+	NewIntakeDate pgtype.Date        `json:"new_intake_date"`
+	NewIntakeTime pgtype.Time        `json:"new_intake_time"`
+	ChangedBy     *string            `json:"changed_by"`
+	ChangedAt     pgtype.Timestamptz `json:"changed_at"`
+}
+
+type IntakeRequiredDocument struct {
+	IntakeFormID    string                   `json:"intake_form_id"`
+	DocumentType    IntakeDocumentTypeEnum   `json:"document_type"`
+	Status          IntakeDocumentStatusEnum `json:"status"`
+	ReceivedAt      pgtype.Timestamptz       `json:"received_at"`
+	UpdatedByUserID *string                  `json:"updated_by_user_id"`
+	UpdatedAt       pgtype.Timestamptz       `json:"updated_at"`
 }
 
 type Location struct {
-	ID         string             `json:"id"`
-	Name       string             `json:"name"`
-	PostalCode string             `json:"postal_code"`
-	Address    string             `json:"address"`
-	Capacity   int32              `json:"capacity"`
-	Occupied   int32              `json:"occupied"`
-	CreatedAt  pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
-	IsDeleted  *bool              `json:"is_deleted"`
+	ID             string             `json:"id"`
+	Name           string             `json:"name"`
+	PostalCode     string             `json:"postal_code"`
+	Address        string             `json:"address"`
+	Capacity       int32              `json:"capacity"`
+	Occupied       int32              `json:"occupied"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+	IsDeleted      *bool              `json:"is_deleted"`
+	OrganizationID *string            `json:"organization_id"`
+}
+
+type LocationCapacitySnapshot struct {
+	LocationID   string             `json:"location_id"`
+	SnapshotDate pgtype.Date        `json:"snapshot_date"`
+	Capacity     int32              `json:"capacity"`
+	Occupied     int32              `json:"occupied"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+}
+
+type LocationCareType struct {
+	LocationID string       `json:"location_id"`
+	CareType   CareTypeEnum `json:"care_type"`
 }
 
 type Notification struct {
@@ -1205,6 +1748,29 @@ type Notification struct {
 	ExpiresAt    pgtype.Timestamptz       `json:"expires_at"`
 }
 
+type NotificationDeadLetter struct {
+	ID             string             `json:"id"`
+	NotificationID string             `json:"notification_id"`
+	UserID         string             `json:"user_id"`
+	Channel        string             `json:"channel"`
+	Error          string             `json:"error"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+}
+
+type NotificationQuietHour struct {
+	UserID    string             `json:"user_id"`
+	StartTime pgtype.Time        `json:"start_time"`
+	EndTime   pgtype.Time        `json:"end_time"`
+	Timezone  string             `json:"timezone"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+}
+
+type Organization struct {
+	ID        string             `json:"id"`
+	Name      string             `json:"name"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
 type Permission struct {
 	ID          string             `json:"id"`
 	Resource    string             `json:"resource"`
@@ -1237,10 +1803,28 @@ type RegistrationForm struct {
 	RegistrationReason string                     `json:"registration_reason"`
 	AdditionalNotes    *string                    `json:"additional_notes"`
 	Status             NullRegistrationStatusEnum `json:"status"`
-	AttachmentIds      []string                   `json:"attachment_ids"`
 	CreatedAt          pgtype.Timestamptz         `json:"created_at"`
 	UpdatedAt          pgtype.Timestamptz         `json:"updated_at"`
 	IsDeleted          *bool                      `json:"is_deleted"`
+	DeletedAt          pgtype.Timestamptz         `json:"deleted_at"`
+	CreatedByUserID    *string                    `json:"created_by_user_id"`
+}
+
+type RegistrationFormAttachment struct {
+	RegistrationFormID string             `json:"registration_form_id"`
+	AttachmentID       string             `json:"attachment_id"`
+	Caption            *string            `json:"caption"`
+	SortOrder          int32              `json:"sort_order"`
+	CreatedAt          pgtype.Timestamptz `json:"created_at"`
+}
+
+type RegistrationStatusHistory struct {
+	ID                 string                     `json:"id"`
+	RegistrationFormID string                     `json:"registration_form_id"`
+	OldStatus          NullRegistrationStatusEnum `json:"old_status"`
+	NewStatus          NullRegistrationStatusEnum `json:"new_status"`
+	ChangedBy          *string                    `json:"changed_by"`
+	ChangedAt          pgtype.Timestamptz         `json:"changed_at"`
 }
 
 type Reminder struct {
@@ -1280,14 +1864,18 @@ type Session struct {
 }
 
 type User struct {
-	ID             string             `json:"id"`
-	Email          string             `json:"email"`
-	PasswordHash   string             `json:"password_hash"`
-	IsMfaEnabled   bool               `json:"is_mfa_enabled"`
-	MfaSecret      *string            `json:"mfa_secret"`
-	MfaBackupCodes *string            `json:"mfa_backup_codes"`
-	CreatedAt      pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+	ID                  string             `json:"id"`
+	Email               string             `json:"email"`
+	PasswordHash        string             `json:"password_hash"`
+	IsMfaEnabled        bool               `json:"is_mfa_enabled"`
+	MfaSecret           *string            `json:"mfa_secret"`
+	MfaBackupCodes      *string            `json:"mfa_backup_codes"`
+	FailedLoginAttempts int32              `json:"failed_login_attempts"`
+	LockedUntil         pgtype.Timestamptz `json:"locked_until"`
+	LastLoginAt         pgtype.Timestamptz `json:"last_login_at"`
+	CreatedAt           pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt           pgtype.Timestamptz `json:"updated_at"`
+	OrganizationID      *string            `json:"organization_id"`
 }
 
 type UserRole struct {
@@ -1295,3 +1883,14 @@ type UserRole struct {
 	RoleID     string             `json:"role_id"`
 	AssignedAt pgtype.Timestamptz `json:"assigned_at"`
 }
+
+type WorkerHeartbeat struct {
+	WorkerName string             `json:"worker_name"`
+	LastRunAt  pgtype.Timestamptz `json:"last_run_at"`
+}
+
+type WorkerLease struct {
+	WorkerName string             `json:"worker_name"`
+	Holder     string             `json:"holder"`
+	ExpiresAt  pgtype.Timestamptz `json:"expires_at"`
+}
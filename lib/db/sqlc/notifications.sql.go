@@ -69,6 +69,37 @@ func (q *Queries) CreateNotification(ctx context.Context, arg CreateNotification
 	return i, err
 }
 
+const createNotificationDeadLetter = `-- name: CreateNotificationDeadLetter :exec
+INSERT INTO notification_dead_letters (
+    id,
+    notification_id,
+    user_id,
+    channel,
+    error
+) VALUES (
+    $1, $2, $3, $4, $5
+)
+`
+
+type CreateNotificationDeadLetterParams struct {
+	ID             string `json:"id"`
+	NotificationID string `json:"notification_id"`
+	UserID         string `json:"user_id"`
+	Channel        string `json:"channel"`
+	Error          string `json:"error"`
+}
+
+func (q *Queries) CreateNotificationDeadLetter(ctx context.Context, arg CreateNotificationDeadLetterParams) error {
+	_, err := q.db.Exec(ctx, createNotificationDeadLetter,
+		arg.ID,
+		arg.NotificationID,
+		arg.UserID,
+		arg.Channel,
+		arg.Error,
+	)
+	return err
+}
+
 const deleteExpiredNotifications = `-- name: DeleteExpiredNotifications :exec
 DELETE FROM notifications
 WHERE expires_at IS NOT NULL AND expires_at < CURRENT_TIMESTAMP
@@ -94,6 +125,16 @@ func (q *Queries) DeleteNotification(ctx context.Context, arg DeleteNotification
 	return err
 }
 
+const deleteNotificationQuietHours = `-- name: DeleteNotificationQuietHours :exec
+DELETE FROM notification_quiet_hours
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteNotificationQuietHours(ctx context.Context, userID string) error {
+	_, err := q.db.Exec(ctx, deleteNotificationQuietHours, userID)
+	return err
+}
+
 const getNotification = `-- name: GetNotification :one
 SELECT id, user_id, type, priority, title, message, resource_type, resource_id, is_read, read_at, created_at, expires_at FROM notifications
 WHERE id = $1
@@ -119,6 +160,24 @@ func (q *Queries) GetNotification(ctx context.Context, id string) (Notification,
 	return i, err
 }
 
+const getNotificationQuietHours = `-- name: GetNotificationQuietHours :one
+SELECT user_id, start_time, end_time, timezone, updated_at FROM notification_quiet_hours
+WHERE user_id = $1
+`
+
+func (q *Queries) GetNotificationQuietHours(ctx context.Context, userID string) (NotificationQuietHour, error) {
+	row := q.db.QueryRow(ctx, getNotificationQuietHours, userID)
+	var i NotificationQuietHour
+	err := row.Scan(
+		&i.UserID,
+		&i.StartTime,
+		&i.EndTime,
+		&i.Timezone,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const getUnreadCount = `-- name: GetUnreadCount :one
 SELECT COUNT(*) FROM notifications
 WHERE user_id = $1 AND is_read = FALSE
@@ -230,3 +289,100 @@ func (q *Queries) MarkNotificationAsRead(ctx context.Context, arg MarkNotificati
 	_, err := q.db.Exec(ctx, markNotificationAsRead, arg.ID, arg.UserID)
 	return err
 }
+
+const markNotificationsReadByResource = `-- name: MarkNotificationsReadByResource :exec
+UPDATE notifications
+SET is_read = TRUE, read_at = CURRENT_TIMESTAMP
+WHERE user_id = $1
+    AND resource_type = $2::text
+    AND resource_id = $3::text
+    AND is_read = FALSE
+`
+
+type MarkNotificationsReadByResourceParams struct {
+	UserID       string `json:"user_id"`
+	ResourceType string `json:"resource_type"`
+	ResourceID   string `json:"resource_id"`
+}
+
+// Marks every unread notification of the user that points at the resource
+func (q *Queries) MarkNotificationsReadByResource(ctx context.Context, arg MarkNotificationsReadByResourceParams) error {
+	_, err := q.db.Exec(ctx, markNotificationsReadByResource, arg.UserID, arg.ResourceType, arg.ResourceID)
+	return err
+}
+
+const notificationResourceExists = `-- name: NotificationResourceExists :one
+SELECT (CASE $1::text
+    WHEN 'client' THEN EXISTS (SELECT 1 FROM clients WHERE id = $2::text)
+    -- Evaluation reminders link to the client being evaluated
+    WHEN 'evaluation' THEN EXISTS (SELECT 1 FROM clients WHERE id = $2::text)
+    WHEN 'incident' THEN EXISTS (
+        SELECT 1 FROM incidents WHERE id = $2::text AND is_deleted = FALSE
+    )
+    WHEN 'appointment' THEN EXISTS (SELECT 1 FROM appointments WHERE id = $2::text)
+    WHEN 'location_transfer' THEN EXISTS (
+        SELECT 1 FROM client_location_transfers WHERE id = $2::text
+    )
+    WHEN 'registration' THEN EXISTS (
+        SELECT 1 FROM registration_forms WHERE id = $2::text AND is_deleted = FALSE
+    )
+    ELSE FALSE
+END)::boolean AS resource_exists
+`
+
+type NotificationResourceExistsParams struct {
+	ResourceType string `json:"resource_type"`
+	ResourceID   string `json:"resource_id"`
+}
+
+// Reports whether the record a notification deep-links to exists and is not
+// soft-deleted. Unknown resource types are reported as missing, so a new type
+// must be added here before notifications can link to it.
+func (q *Queries) NotificationResourceExists(ctx context.Context, arg NotificationResourceExistsParams) (bool, error) {
+	row := q.db.QueryRow(ctx, notificationResourceExists, arg.ResourceType, arg.ResourceID)
+	var resource_exists bool
+	err := row.Scan(&resource_exists)
+	return resource_exists, err
+}
+
+const upsertNotificationQuietHours = `-- name: UpsertNotificationQuietHours :one
+INSERT INTO notification_quiet_hours (
+    user_id,
+    start_time,
+    end_time,
+    timezone
+) VALUES (
+    $1, $2, $3, $4
+)
+ON CONFLICT (user_id) DO UPDATE SET
+    start_time = EXCLUDED.start_time,
+    end_time = EXCLUDED.end_time,
+    timezone = EXCLUDED.timezone,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING user_id, start_time, end_time, timezone, updated_at
+`
+
+type UpsertNotificationQuietHoursParams struct {
+	UserID    string      `json:"user_id"`
+	StartTime pgtype.Time `json:"start_time"`
+	EndTime   pgtype.Time `json:"end_time"`
+	Timezone  string      `json:"timezone"`
+}
+
+func (q *Queries) UpsertNotificationQuietHours(ctx context.Context, arg UpsertNotificationQuietHoursParams) (NotificationQuietHour, error) {
+	row := q.db.QueryRow(ctx, upsertNotificationQuietHours,
+		arg.UserID,
+		arg.StartTime,
+		arg.EndTime,
+		arg.Timezone,
+	)
+	var i NotificationQuietHour
+	err := row.Scan(
+		&i.UserID,
+		&i.StartTime,
+		&i.EndTime,
+		&i.Timezone,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
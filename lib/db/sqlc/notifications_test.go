@@ -0,0 +1,171 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================
+// Test: notification quiet hours
+// ============================================================
+
+func clockTime(d time.Duration) pgtype.Time {
+	return pgtype.Time{Microseconds: d.Microseconds(), Valid: true}
+}
+
+func TestNotificationQuietHours(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		userID := CreateTestUser(t, q, CreateTestUserOptions{})
+
+		_, err := q.GetNotificationQuietHours(ctx, userID)
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+
+		saved, err := q.UpsertNotificationQuietHours(ctx, UpsertNotificationQuietHoursParams{
+			UserID:    userID,
+			StartTime: clockTime(22 * time.Hour),
+			EndTime:   clockTime(7 * time.Hour),
+			Timezone:  "Europe/Amsterdam",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, clockTime(22*time.Hour), saved.StartTime)
+
+		// A second upsert replaces the window
+		_, err = q.UpsertNotificationQuietHours(ctx, UpsertNotificationQuietHoursParams{
+			UserID:    userID,
+			StartTime: clockTime(23 * time.Hour),
+			EndTime:   clockTime(6 * time.Hour),
+			Timezone:  "Europe/London",
+		})
+		require.NoError(t, err)
+
+		got, err := q.GetNotificationQuietHours(ctx, userID)
+		require.NoError(t, err)
+		assert.Equal(t, clockTime(23*time.Hour), got.StartTime)
+		assert.Equal(t, clockTime(6*time.Hour), got.EndTime)
+		assert.Equal(t, "Europe/London", got.Timezone)
+
+		require.NoError(t, q.DeleteNotificationQuietHours(ctx, userID))
+		_, err = q.GetNotificationQuietHours(ctx, userID)
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+	})
+}
+
+func TestUpsertNotificationQuietHours_EmptyWindow(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		userID := CreateTestUser(t, q, CreateTestUserOptions{})
+
+		_, err := q.UpsertNotificationQuietHours(context.Background(), UpsertNotificationQuietHoursParams{
+			UserID:    userID,
+			StartTime: clockTime(22 * time.Hour),
+			EndTime:   clockTime(22 * time.Hour),
+			Timezone:  "Europe/Amsterdam",
+		})
+		require.Error(t, err)
+		assert.True(t, IsCheckViolation(err), "expected check violation, got: %v", err)
+	})
+}
+
+// ============================================================
+// Test: MarkNotificationsReadByResource
+// ============================================================
+
+func TestMarkNotificationsReadByResource(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		userID := CreateTestUser(t, q, CreateTestUserOptions{})
+		otherUserID := CreateTestUser(t, q, CreateTestUserOptions{})
+
+		createNotification := func(userID, resourceType, resourceID string) string {
+			n, err := q.CreateNotification(ctx, CreateNotificationParams{
+				ID:           generateTestID(),
+				UserID:       userID,
+				Type:         NotificationTypeEnumIncidentCreated,
+				Priority:     NotificationPriorityEnumNormal,
+				Title:        "Incident",
+				Message:      "An incident was reported",
+				ResourceType: strPtr(resourceType),
+				ResourceID:   strPtr(resourceID),
+			})
+			require.NoError(t, err)
+			return n.ID
+		}
+
+		first := createNotification(userID, "incident", "inc-1")
+		second := createNotification(userID, "incident", "inc-1")
+		otherResource := createNotification(userID, "incident", "inc-2")
+		otherUser := createNotification(otherUserID, "incident", "inc-1")
+
+		err := q.MarkNotificationsReadByResource(ctx, MarkNotificationsReadByResourceParams{
+			UserID:       userID,
+			ResourceType: "incident",
+			ResourceID:   "inc-1",
+		})
+		require.NoError(t, err)
+
+		isRead := func(id string) bool {
+			n, err := q.GetNotification(ctx, id)
+			require.NoError(t, err)
+			return n.IsRead != nil && *n.IsRead
+		}
+		assert.True(t, isRead(first))
+		assert.True(t, isRead(second))
+		assert.False(t, isRead(otherResource))
+		assert.False(t, isRead(otherUser), "another user's notifications must be left alone")
+	})
+}
+
+// ============================================================
+// Test: NotificationResourceExists
+// ============================================================
+
+func TestNotificationResourceExists(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		clientID, deps := CreateTestClientWithDependencies(t, q)
+		appointmentID := CreateTestAppointment(t, q, CreateTestAppointmentOptions{OrganizerID: deps.EmployeeID})
+		incidentID := CreateTestIncident(t, q, CreateTestIncidentOptions{
+			ClientID:      clientID,
+			LocationID:    deps.LocationID,
+			CoordinatorID: deps.EmployeeID,
+		})
+		deletedIncidentID := CreateTestIncident(t, q, CreateTestIncidentOptions{
+			ClientID:      clientID,
+			LocationID:    deps.LocationID,
+			CoordinatorID: deps.EmployeeID,
+		})
+		_, err := q.SoftDeleteIncident(ctx, SoftDeleteIncidentParams{ID: deletedIncidentID})
+		require.NoError(t, err)
+
+		tests := []struct {
+			name         string
+			resourceType string
+			resourceID   string
+			want         bool
+		}{
+			{"client", "client", clientID, true},
+			{"evaluation_links_to_client", "evaluation", clientID, true},
+			{"appointment", "appointment", appointmentID, true},
+			{"missing_appointment", "appointment", "apt-missing", false},
+			{"incident", "incident", incidentID, true},
+			{"deleted_incident", "incident", deletedIncidentID, false},
+			{"unknown_type", "invoice", clientID, false},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				exists, err := q.NotificationResourceExists(ctx, NotificationResourceExistsParams{
+					ResourceType: tt.resourceType,
+					ResourceID:   tt.resourceID,
+				})
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, exists)
+			})
+		}
+	})
+}
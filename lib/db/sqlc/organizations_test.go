@@ -0,0 +1,311 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================
+// Test: organization scoping
+// ============================================================
+
+// createOrganizationClient creates a client with the last name in the
+// organization, with its own dependency chain.
+func createOrganizationClient(t *testing.T, q *Queries, orgID, lastName string, opts CreateTestClientOptions) string {
+	t.Helper()
+	deps := CreateFullClientDependencyChain(t, q)
+	opts.LastName = &lastName
+	opts.RegistrationFormID = deps.RegistrationFormID
+	opts.IntakeFormID = deps.IntakeFormID
+	opts.AssignedLocationID = deps.LocationID
+	opts.CoordinatorID = deps.EmployeeID
+	opts.OrganizationID = &orgID
+	return CreateTestClient(t, q, opts)
+}
+
+func TestGetClientByID_OrganizationScope(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		ownerOrg := CreateTestOrganization(t, q)
+		otherOrg := CreateTestOrganization(t, q)
+		emptyOrg := ""
+		clientID := createOrganizationClient(t, q, ownerOrg, "Scopedclient", CreateTestClientOptions{})
+
+		client, err := q.GetClientByID(ctx, GetClientByIDParams{ID: clientID, OrganizationID: &ownerOrg})
+		require.NoError(t, err)
+		assert.Equal(t, clientID, client.ID)
+		require.NotNil(t, client.OrganizationID)
+		assert.Equal(t, ownerOrg, *client.OrganizationID)
+
+		_, err = q.GetClientByID(ctx, GetClientByIDParams{ID: clientID, OrganizationID: &otherOrg})
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+
+		_, err = q.GetClientByID(ctx, GetClientByIDParams{ID: clientID, OrganizationID: &emptyOrg})
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+
+		// Unscoped callers (worker, seed) pass no organization
+		client, err = q.GetClientByID(ctx, GetClientByIDParams{ID: clientID})
+		require.NoError(t, err)
+		assert.Equal(t, clientID, client.ID)
+	})
+}
+
+func TestUpdateClient_OrganizationScope(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		ownerOrg := CreateTestOrganization(t, q)
+		otherOrg := CreateTestOrganization(t, q)
+		clientID := createOrganizationClient(t, q, ownerOrg, "Scopedclient", CreateTestClientOptions{
+			Notes: strPtr("original"),
+		})
+
+		_, err := q.UpdateClient(ctx, UpdateClientParams{
+			ID:             clientID,
+			Notes:          strPtr("written by another organization"),
+			OrganizationID: &otherOrg,
+		})
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+
+		client, err := q.GetClientByID(ctx, GetClientByIDParams{ID: clientID})
+		require.NoError(t, err)
+		require.NotNil(t, client.Notes)
+		assert.Equal(t, "original", *client.Notes)
+
+		updatedID, err := q.UpdateClient(ctx, UpdateClientParams{
+			ID:             clientID,
+			Notes:          strPtr("written by the owner"),
+			OrganizationID: &ownerOrg,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, clientID, updatedID)
+	})
+}
+
+func TestListClients_OrganizationScope(t *testing.T) {
+	inCare := ClientStatusEnumInCare
+	discharged := ClientStatusEnumDischarged
+	completed := DischargeStatusEnumCompleted
+
+	tests := []struct {
+		name string
+		opts CreateTestClientOptions
+		// list returns the IDs of the organization's clients with the last name
+		list func(t *testing.T, q *Queries, orgID, lastName string) []string
+	}{
+		{
+			name: "search",
+			list: func(t *testing.T, q *Queries, orgID, lastName string) []string {
+				rows, err := q.SearchClients(context.Background(), SearchClientsParams{
+					Limit: 10, Search: lastName, OrganizationID: &orgID,
+				})
+				require.NoError(t, err)
+				ids := []string{}
+				for _, row := range rows {
+					ids = append(ids, row.ID)
+				}
+				return ids
+			},
+		},
+		{
+			name: "waiting_list",
+			list: func(t *testing.T, q *Queries, orgID, lastName string) []string {
+				rows, err := q.ListWaitingListClients(context.Background(), ListWaitingListClientsParams{
+					Limit: 10, Search: &lastName, OrganizationID: &orgID,
+				})
+				require.NoError(t, err)
+				ids := []string{}
+				for _, row := range rows {
+					ids = append(ids, row.ID)
+				}
+				return ids
+			},
+		},
+		{
+			name: "in_care",
+			opts: CreateTestClientOptions{Status: &inCare},
+			list: func(t *testing.T, q *Queries, orgID, lastName string) []string {
+				rows, err := q.ListInCareClients(context.Background(), ListInCareClientsParams{
+					Limit: 10, Search: &lastName, OrganizationID: &orgID,
+				})
+				require.NoError(t, err)
+				ids := []string{}
+				for _, row := range rows {
+					ids = append(ids, row.ID)
+				}
+				return ids
+			},
+		},
+		{
+			name: "in_care_by_cursor",
+			opts: CreateTestClientOptions{Status: &inCare},
+			list: func(t *testing.T, q *Queries, orgID, lastName string) []string {
+				rows, err := q.ListInCareClientsByCursor(context.Background(), ListInCareClientsByCursorParams{
+					Search: &lastName, OrganizationID: &orgID, PageSize: 10,
+				})
+				require.NoError(t, err)
+				ids := []string{}
+				for _, row := range rows {
+					ids = append(ids, row.ID)
+				}
+				return ids
+			},
+		},
+		{
+			name: "discharged",
+			opts: CreateTestClientOptions{Status: &discharged, DischargeStatus: &completed},
+			list: func(t *testing.T, q *Queries, orgID, lastName string) []string {
+				rows, err := q.ListDischargedClients(context.Background(), ListDischargedClientsParams{
+					Limit: 10, Search: &lastName, OrganizationID: &orgID,
+				})
+				require.NoError(t, err)
+				ids := []string{}
+				for _, row := range rows {
+					ids = append(ids, row.ID)
+				}
+				return ids
+			},
+		},
+		{
+			name: "export",
+			list: func(t *testing.T, q *Queries, orgID, _ string) []string {
+				rows, err := q.ListClientsForExport(context.Background(), ListClientsForExportParams{
+					OrganizationID: &orgID, BatchSize: 10,
+				})
+				require.NoError(t, err)
+				ids := []string{}
+				for _, row := range rows {
+					ids = append(ids, row.ID)
+				}
+				return ids
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runTestWithTx(t, func(t *testing.T, q *Queries) {
+				ownerOrg := CreateTestOrganization(t, q)
+				otherOrg := CreateTestOrganization(t, q)
+				emptyOrg := ""
+				lastName := "Scoped" + generateTestID()[:8]
+
+				ownedID := createOrganizationClient(t, q, ownerOrg, lastName, tt.opts)
+				otherID := createOrganizationClient(t, q, otherOrg, lastName, tt.opts)
+
+				assert.Equal(t, []string{ownedID}, tt.list(t, q, ownerOrg, lastName))
+				assert.Equal(t, []string{otherID}, tt.list(t, q, otherOrg, lastName))
+				assert.Empty(t, tt.list(t, q, emptyOrg, lastName))
+			})
+		})
+	}
+}
+
+func TestListLocations_OrganizationScope(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		ownerOrg := CreateTestOrganization(t, q)
+		otherOrg := CreateTestOrganization(t, q)
+
+		ownedID := CreateTestLocation(t, q, CreateTestLocationOptions{OrganizationID: &ownerOrg})
+		CreateTestLocation(t, q, CreateTestLocationOptions{OrganizationID: &otherOrg})
+		CreateTestLocation(t, q, CreateTestLocationOptions{})
+
+		results, err := q.ListLocations(ctx, ListLocationsParams{
+			Limit:          10,
+			Offset:         0,
+			OrganizationID: &ownerOrg,
+		})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, ownedID, results[0].ID)
+		assert.Equal(t, int64(1), results[0].TotalCount)
+	})
+}
+
+func TestUpdateLocation_OrganizationScope(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		ownerOrg := CreateTestOrganization(t, q)
+		otherOrg := CreateTestOrganization(t, q)
+		locationID := CreateTestLocation(t, q, CreateTestLocationOptions{
+			Name:           strPtr("Owned"),
+			OrganizationID: &ownerOrg,
+		})
+
+		updated, err := q.UpdateLocation(ctx, UpdateLocationParams{
+			ID:             locationID,
+			Name:           strPtr("Renamed elsewhere"),
+			OrganizationID: &otherOrg,
+		})
+		require.NoError(t, err)
+		assert.Zero(t, updated)
+
+		updated, err = q.UpdateLocation(ctx, UpdateLocationParams{
+			ID:             locationID,
+			Name:           strPtr("Renamed"),
+			OrganizationID: &ownerOrg,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), updated)
+
+		results, err := q.ListLocations(ctx, ListLocationsParams{Limit: 10, OrganizationID: &ownerOrg})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, "Renamed", results[0].Name)
+	})
+}
+
+func TestSoftDeleteLocation_OrganizationScope(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		ownerOrg := CreateTestOrganization(t, q)
+		otherOrg := CreateTestOrganization(t, q)
+		locationID := CreateTestLocation(t, q, CreateTestLocationOptions{OrganizationID: &ownerOrg})
+
+		deleted, err := q.SoftDeleteLocation(ctx, SoftDeleteLocationParams{ID: locationID, OrganizationID: &otherOrg})
+		require.NoError(t, err)
+		assert.Zero(t, deleted)
+
+		results, err := q.ListLocations(ctx, ListLocationsParams{Limit: 10, OrganizationID: &ownerOrg})
+		require.NoError(t, err)
+		assert.Len(t, results, 1)
+
+		deleted, err = q.SoftDeleteLocation(ctx, SoftDeleteLocationParams{ID: locationID, OrganizationID: &ownerOrg})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), deleted)
+	})
+}
+
+func TestGetLocationCapacityStats_OrganizationScope(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		ownerOrg := CreateTestOrganization(t, q)
+		otherOrg := CreateTestOrganization(t, q)
+		CreateTestLocation(t, q, CreateTestLocationOptions{Capacity: int32Ptr(7), OrganizationID: &ownerOrg})
+		CreateTestLocation(t, q, CreateTestLocationOptions{Capacity: int32Ptr(50), OrganizationID: &otherOrg})
+
+		stats, err := q.GetLocationCapacityStats(ctx, &ownerOrg)
+		require.NoError(t, err)
+		assert.Equal(t, int32(7), stats.FreeCapacity)
+	})
+}
+
+func TestCreateLocation_UnknownOrganization(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		missingOrg := generateTestID()
+		err := q.CreateLocation(context.Background(), CreateLocationParams{
+			ID:             generateTestID(),
+			Name:           "Orphan",
+			PostalCode:     "1234AB",
+			Address:        "1 Test Street",
+			Capacity:       5,
+			OrganizationID: &missingOrg,
+		})
+		require.Error(t, err)
+		assert.True(t, IsForeignKeyViolation(err))
+	})
+}
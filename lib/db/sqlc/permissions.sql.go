@@ -33,3 +33,33 @@ func (q *Queries) HasPermission(ctx context.Context, arg HasPermissionParams) (b
 	err := row.Scan(&exists)
 	return exists, err
 }
+
+const listPermissionKeysForUser = `-- name: ListPermissionKeysForUser :many
+SELECT DISTINCT (p.resource || ':' || p.action)::text AS permission
+FROM user_roles ur
+JOIN role_permissions rp ON ur.role_id = rp.role_id
+JOIN permissions p ON rp.permission_id = p.id
+WHERE ur.user_id = $1
+ORDER BY permission
+`
+
+// Returns the user's permissions as resource:action, deduplicated across their roles
+func (q *Queries) ListPermissionKeysForUser(ctx context.Context, userID string) ([]string, error) {
+	rows, err := q.db.Query(ctx, listPermissionKeysForUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var permission string
+		if err := rows.Scan(&permission); err != nil {
+			return nil, err
+		}
+		items = append(items, permission)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
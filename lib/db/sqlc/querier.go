@@ -6,11 +6,26 @@ package db
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Querier interface {
+	// ============================================================
+	// Worker Leases
+	// ============================================================
+	// Takes or renews the lease for holder. Returns no rows while another
+	// holder's lease has not yet expired.
+	AcquireWorkerLease(ctx context.Context, arg AcquireWorkerLeaseParams) (WorkerLease, error)
 	AddAppointmentParticipant(ctx context.Context, arg AddAppointmentParticipantParams) error
 	// ============================================================
+	// Client Notes
+	// ============================================================
+	AddClientNote(ctx context.Context, arg AddClientNoteParams) (ClientNote, error)
+	AddLocationCareTypes(ctx context.Context, arg AddLocationCareTypesParams) error
+	// Grants the role every permission it does not hold yet.
+	AssignAllPermissionsToRole(ctx context.Context, roleID string) error
+	// ============================================================
 	// Role Permissions
 	// ============================================================
 	AssignPermissionToRole(ctx context.Context, arg AssignPermissionToRoleParams) error
@@ -18,14 +33,29 @@ type Querier interface {
 	// User Roles
 	// ============================================================
 	AssignRoleToUser(ctx context.Context, arg AssignRoleToUserParams) error
+	// Permission IDs are deduplicated and inserted in sorted order so concurrent
+	// batches with overlapping IDs acquire row locks in the same order.
 	BatchAssignPermissionsToRole(ctx context.Context, arg BatchAssignPermissionsToRoleParams) error
-	ConfirmLocationTransfer(ctx context.Context, id string) error
+	// Soft-deleted forms are skipped. history_ids pairs one history row ID with
+	// each form ID; every form whose status changes gets a
+	// registration_status_history entry. Returns one row per updated form with
+	// the user ID of the coordinator assigned through its intake form, if any.
+	BatchUpdateRegistrationFormStatus(ctx context.Context, arg BatchUpdateRegistrationFormStatusParams) ([]BatchUpdateRegistrationFormStatusRow, error)
+	// Cancelled appointments are kept with their reason but no longer show up on
+	// the dashboard or trigger reminders. Returns no row when already cancelled.
+	CancelAppointment(ctx context.Context, arg CancelAppointmentParams) (Appointment, error)
+	CancelLocationTransfer(ctx context.Context, arg CancelLocationTransferParams) (int64, error)
+	// Returns pgx.ErrNoRows when the evaluation does not exist or is already completed.
+	CompleteEvaluationRecord(ctx context.Context, arg CompleteEvaluationRecordParams) (Evaluation, error)
+	ConfirmLocationTransfer(ctx context.Context, arg ConfirmLocationTransferParams) error
 	CountAuditLogs(ctx context.Context) (int64, error)
+	CountMissingIntakeDocuments(ctx context.Context, intakeFormID string) (int64, error)
 	CreateAppointment(ctx context.Context, arg CreateAppointmentParams) (Appointment, error)
 	// ============================================================
 	// Attachments
 	// ============================================================
 	CreateAttachment(ctx context.Context, arg CreateAttachmentParams) error
+	CreateAttachmentAccess(ctx context.Context, arg CreateAttachmentAccessParams) error
 	CreateAuditLog(ctx context.Context, arg CreateAuditLogParams) error
 	// ============================================================
 	// Clients
@@ -34,14 +64,24 @@ type Querier interface {
 	CreateClientEvaluation(ctx context.Context, arg CreateClientEvaluationParams) (ClientEvaluation, error)
 	CreateClientGoal(ctx context.Context, arg CreateClientGoalParams) error
 	// ============================================================
+	// Coordinator Availability
+	// ============================================================
+	CreateCoordinatorAvailability(ctx context.Context, arg CreateCoordinatorAvailabilityParams) error
+	// ============================================================
 	// Employees
 	// ============================================================
 	CreateEmployee(ctx context.Context, arg CreateEmployeeParams) error
+	// ============================================================
+	// Evaluations
+	// ============================================================
+	CreateEvaluationRecord(ctx context.Context, arg CreateEvaluationRecordParams) (Evaluation, error)
 	CreateGoalProgressLog(ctx context.Context, arg CreateGoalProgressLogParams) error
 	// ============================================================
 	// Incidents
 	// ============================================================
 	CreateIncident(ctx context.Context, arg CreateIncidentParams) error
+	// Adds a missing item for every document the intake's care type requires
+	CreateIntakeDocumentChecklist(ctx context.Context, id string) error
 	// ============================================================
 	// Intake Forms
 	// ============================================================
@@ -52,6 +92,11 @@ type Querier interface {
 	// ============================================================
 	CreateLocationTransfer(ctx context.Context, arg CreateLocationTransferParams) (CreateLocationTransferRow, error)
 	CreateNotification(ctx context.Context, arg CreateNotificationParams) (Notification, error)
+	CreateNotificationDeadLetter(ctx context.Context, arg CreateNotificationDeadLetterParams) error
+	// ============================================================
+	// Organizations
+	// ============================================================
+	CreateOrganization(ctx context.Context, arg CreateOrganizationParams) error
 	// ============================================================
 	// Permissions
 	// ============================================================
@@ -74,11 +119,15 @@ type Querier interface {
 	DecrementLocationOccupied(ctx context.Context, id string) error
 	DeleteAllPermissionsFromRole(ctx context.Context, roleID string) error
 	DeleteAppointment(ctx context.Context, id string) error
+	DeleteClientNotesByClient(ctx context.Context, clientID string) error
+	DeleteCoordinatorAvailability(ctx context.Context, employeeID string) error
 	DeleteDraftEvaluation(ctx context.Context, id string) error
 	DeleteExpiredNotifications(ctx context.Context) error
 	DeleteGoal(ctx context.Context, id string) error
 	DeleteGoalProgressLogsByEvaluationId(ctx context.Context, evaluationID string) error
+	DeleteLocationCareTypes(ctx context.Context, locationID string) error
 	DeleteNotification(ctx context.Context, arg DeleteNotificationParams) error
+	DeleteNotificationQuietHours(ctx context.Context, userID string) error
 	DeletePermission(ctx context.Context, id string) error
 	DeleteReferringOrg(ctx context.Context, id string) error
 	DeleteReminder(ctx context.Context, id string) error
@@ -86,7 +135,15 @@ type Querier interface {
 	DeleteUserSession(ctx context.Context, tokenHash string) error
 	DisableUserMFA(ctx context.Context, id string) error
 	EnableUserMFA(ctx context.Context, arg EnableUserMFAParams) error
+	// Creates a preset role if it is missing; an existing role is left as is.
+	EnsureRole(ctx context.Context, arg EnsureRoleParams) error
 	GetAppointment(ctx context.Context, id string) (Appointment, error)
+	// The attachment with the registration form or discharged client it belongs
+	// to, recorded as the resource context of each download. organization_id is
+	// the owner's organization: the client's, else that of the registration
+	// form's creator, else the uploader's for a file not attached to anything yet.
+	GetAttachmentForDownload(ctx context.Context, id string) (GetAttachmentForDownloadRow, error)
+	GetAttachmentsByIDs(ctx context.Context, ids []string) ([]GetAttachmentsByIDsRow, error)
 	GetAuditLogByID(ctx context.Context, id string) (GetAuditLogByIDRow, error)
 	GetAuditLogBySequence(ctx context.Context, sequenceNumber int64) (AuditLog, error)
 	GetAuditLogStats(ctx context.Context) (GetAuditLogStatsRow, error)
@@ -94,122 +151,296 @@ type Querier interface {
 	GetAuditLogsByUser(ctx context.Context, arg GetAuditLogsByUserParams) ([]AuditLog, error)
 	// Get audit logs in sequence order for hash chain verification
 	GetAuditLogsForVerification(ctx context.Context, arg GetAuditLogsForVerificationParams) ([]GetAuditLogsForVerificationRow, error)
+	// Open intake slots for a coordinator on a date: slot_minutes-long slots inside
+	// their working hours for that weekday that overlap neither a non-rejected
+	// intake nor a non-cancelled appointment they organize or attend. An intake
+	// being rescheduled passes its own id as exclude_intake_id so it does not block
+	// its current slot.
+	GetAvailableIntakeSlots(ctx context.Context, arg GetAvailableIntakeSlotsParams) ([]GetAvailableIntakeSlotsRow, error)
 	GetCareTypeDistribution(ctx context.Context) (GetCareTypeDistributionRow, error)
-	GetClientByID(ctx context.Context, id string) (Client, error)
+	// Buckets in-care clients by age. band_starts holds the inclusive lower bound of each band;
+	// a band ends where the next one starts and the highest band is open-ended. Clients without a
+	// date of birth are counted in a final row with a NULL band_start.
+	GetClientAgeDistribution(ctx context.Context, arg GetClientAgeDistributionParams) ([]GetClientAgeDistributionRow, error)
+	GetClientAssignmentHistory(ctx context.Context, clientID string) ([]GetClientAssignmentHistoryRow, error)
+	// Discharged clients are no longer active and are not matched; the same
+	// person may have several discharged records but at most one active client
+	// (uq_clients_active_bsn).
+	GetClientByBSNForOrganization(ctx context.Context, arg GetClientByBSNForOrganizationParams) (Client, error)
+	// A NULL organization_id matches a client of any organization; ScopedStore
+	// always passes one.
+	GetClientByID(ctx context.Context, arg GetClientByIDParams) (Client, error)
 	GetClientEvaluationHistory(ctx context.Context, clientID string) ([]GetClientEvaluationHistoryRow, error)
+	// The coordinator's own cap and active (waiting list or in care) client count,
+	// leaving out the client being assigned so a reassignment isn't counted twice
+	GetCoordinatorCaseload(ctx context.Context, arg GetCoordinatorCaseloadParams) (GetCoordinatorCaseloadRow, error)
 	GetCoordinatorClients(ctx context.Context, coordinatorID string) ([]GetCoordinatorClientsRow, error)
 	GetCoordinatorDraftEvaluationClients(ctx context.Context, coordinatorID string) ([]GetCoordinatorDraftEvaluationClientsRow, error)
 	GetCoordinatorDrafts(ctx context.Context, arg GetCoordinatorDraftsParams) ([]GetCoordinatorDraftsRow, error)
-	GetCoordinatorExpiringContractClients(ctx context.Context, coordinatorID string) ([]GetCoordinatorExpiringContractClientsRow, error)
+	GetCoordinatorExpiringContractClients(ctx context.Context, arg GetCoordinatorExpiringContractClientsParams) ([]GetCoordinatorExpiringContractClientsRow, error)
 	GetCoordinatorGoalsProgress(ctx context.Context, coordinatorID string) (GetCoordinatorGoalsProgressRow, error)
+	GetCoordinatorHighPriorityWaitingClients(ctx context.Context, coordinatorID string) ([]GetCoordinatorHighPriorityWaitingClientsRow, error)
 	GetCoordinatorIncidents(ctx context.Context, coordinatorID string) ([]GetCoordinatorIncidentsRow, error)
-	GetCoordinatorLongWaitingClients(ctx context.Context, coordinatorID string) ([]GetCoordinatorLongWaitingClientsRow, error)
-	GetCoordinatorOverdueEvaluationClients(ctx context.Context, coordinatorID string) ([]GetCoordinatorOverdueEvaluationClientsRow, error)
+	GetCoordinatorLongWaitingClients(ctx context.Context, arg GetCoordinatorLongWaitingClientsParams) ([]GetCoordinatorLongWaitingClientsRow, error)
+	GetCoordinatorOverdueEvaluationClients(ctx context.Context, arg GetCoordinatorOverdueEvaluationClientsParams) ([]GetCoordinatorOverdueEvaluationClientsRow, error)
+	GetCoordinatorPendingTransferClients(ctx context.Context, coordinatorID string) ([]GetCoordinatorPendingTransferClientsRow, error)
 	GetCoordinatorReminders(ctx context.Context, userID string) ([]GetCoordinatorRemindersRow, error)
-	GetCoordinatorStats(ctx context.Context, coordinatorID string) (GetCoordinatorStatsRow, error)
-	GetCoordinatorTodaySchedule(ctx context.Context, organizerID string) ([]GetCoordinatorTodayScheduleRow, error)
+	GetCoordinatorStats(ctx context.Context, arg GetCoordinatorStatsParams) (GetCoordinatorStatsRow, error)
+	// The day runs from day_start up to day_end, both computed by the caller in the
+	// application timezone; the session itself stays in UTC
+	GetCoordinatorTodaySchedule(ctx context.Context, arg GetCoordinatorTodayScheduleParams) ([]GetCoordinatorTodayScheduleRow, error)
 	GetCoordinatorUnresolvedIncidentClients(ctx context.Context, coordinatorID string) ([]GetCoordinatorUnresolvedIncidentClientsRow, error)
 	// ============================================================
 	// Coordinator Dashboard
 	// ============================================================
-	GetCoordinatorUrgentAlertsData(ctx context.Context, coordinatorID string) (GetCoordinatorUrgentAlertsDataRow, error)
-	GetCriticalAlertsData(ctx context.Context) (GetCriticalAlertsDataRow, error)
+	GetCoordinatorUrgentAlertsData(ctx context.Context, arg GetCoordinatorUrgentAlertsDataParams) (GetCoordinatorUrgentAlertsDataRow, error)
+	// Counts are org-wide when coordinator_id is NULL, otherwise limited to that coordinator's caseload
+	GetCriticalAlertsData(ctx context.Context, arg GetCriticalAlertsDataParams) (GetCriticalAlertsDataRow, error)
 	GetCriticalEvaluations(ctx context.Context, arg GetCriticalEvaluationsParams) ([]GetCriticalEvaluationsRow, error)
-	GetDashboardDischargeStats(ctx context.Context) (GetDashboardDischargeStatsRow, error)
+	GetDashboardDischargeStats(ctx context.Context, today pgtype.Date) (GetDashboardDischargeStatsRow, error)
 	// ============================================================
 	// Dashboard
 	// ============================================================
 	GetDashboardOverviewStats(ctx context.Context) (GetDashboardOverviewStatsRow, error)
 	GetDischargeStats(ctx context.Context) (GetDischargeStatsRow, error)
+	// Discharges per calendar month over the last @months months, ending with the month
+	// starting at @current_month, oldest first. Months without discharges are included.
+	GetDischargeTrendByMonth(ctx context.Context, arg GetDischargeTrendByMonthParams) ([]GetDischargeTrendByMonthRow, error)
 	GetDraftByClientId(ctx context.Context, clientID string) (ClientEvaluation, error)
 	GetDraftEvaluation(ctx context.Context, id string) ([]GetDraftEvaluationRow, error)
 	GetEmployeeByID(ctx context.Context, id string) (GetEmployeeByIDRow, error)
 	GetEmployeeByUserID(ctx context.Context, userID string) (GetEmployeeByUserIDRow, error)
 	GetEvaluationById(ctx context.Context, id string) (ClientEvaluation, error)
 	GetEvaluationDetails(ctx context.Context, id string) ([]GetEvaluationDetailsRow, error)
-	GetEvaluationStats(ctx context.Context) (GetEvaluationStatsRow, error)
-	// Get clients with evaluations due in the next 3 days for reminder notifications
-	GetEvaluationsDueSoon(ctx context.Context) ([]GetEvaluationsDueSoonRow, error)
+	GetEvaluationStats(ctx context.Context, arg GetEvaluationStatsParams) (GetEvaluationStatsRow, error)
+	// Get clients with evaluations due within due_soon_days days for reminder notifications
+	GetEvaluationsDueSoon(ctx context.Context, arg GetEvaluationsDueSoonParams) ([]GetEvaluationsDueSoonRow, error)
+	// ============================================================
+	// Feature Flags
+	// ============================================================
+	GetFeatureFlag(ctx context.Context, key string) (FeatureFlag, error)
 	GetInCareStats(ctx context.Context) (GetInCareStatsRow, error)
 	GetIncident(ctx context.Context, id string) (GetIncidentRow, error)
 	GetIncidentStats(ctx context.Context) (GetIncidentStatsRow, error)
+	// Counts non-deleted incidents per category; categories without incidents are not returned
+	GetIncidentStatsByCategory(ctx context.Context) ([]GetIncidentStatsByCategoryRow, error)
+	// Non-deleted incidents per calendar month and severity over the last @months months,
+	// ending with the month starting at @current_month, oldest first. Months without
+	// incidents are included.
+	GetIncidentTrendByMonth(ctx context.Context, arg GetIncidentTrendByMonthParams) ([]GetIncidentTrendByMonthRow, error)
 	GetIntakeForm(ctx context.Context, id string) (IntakeForm, error)
 	GetIntakeFormWithDetails(ctx context.Context, id string) (GetIntakeFormWithDetailsRow, error)
 	GetIntakeStats(ctx context.Context) (GetIntakeStatsRow, error)
 	GetLastClientEvaluation(ctx context.Context, clientID string) ([]GetLastClientEvaluationRow, error)
 	// Get the most recent audit log entry to retrieve its hash for the chain
 	GetLatestAuditLog(ctx context.Context) (GetLatestAuditLogRow, error)
+	// No rows when the location is missing, deleted or, when organization_id is
+	// set, owned by another organization
+	GetLocationByID(ctx context.Context, arg GetLocationByIDParams) (GetLocationByIDRow, error)
 	GetLocationCapacityList(ctx context.Context) ([]GetLocationCapacityListRow, error)
-	GetLocationCapacityStats(ctx context.Context) (GetLocationCapacityStatsRow, error)
+	GetLocationCapacityStats(ctx context.Context, organizationID *string) (GetLocationCapacityStatsRow, error)
+	// Daily snapshots of one location between two dates (inclusive), oldest first
+	GetLocationCapacityTrend(ctx context.Context, arg GetLocationCapacityTrendParams) ([]GetLocationCapacityTrendRow, error)
 	GetLocationCapacityTotals(ctx context.Context) (GetLocationCapacityTotalsRow, error)
 	GetLocationTransferByID(ctx context.Context, id string) (GetLocationTransferByIDRow, error)
 	GetLocationTransferStats(ctx context.Context) (GetLocationTransferStatsRow, error)
 	GetNotification(ctx context.Context, id string) (Notification, error)
+	GetNotificationQuietHours(ctx context.Context, userID string) (NotificationQuietHour, error)
 	// Get reminders due in the next hour that haven't been completed
+	// Returns the users holding a role within one organization
+	GetOrganizationUserIDsByRoleName(ctx context.Context, arg GetOrganizationUserIDsByRoleNameParams) ([]string, error)
 	GetPendingRemindersByDueTime(ctx context.Context) ([]Reminder, error)
 	GetPermissionByID(ctx context.Context, id string) (Permission, error)
-	GetPipelineStats(ctx context.Context) (GetPipelineStatsRow, error)
+	// Pipeline totals. With a from/to date range (inclusive, either end may be
+	// left open) forms only count when created in it and clients only when their
+	// record was last updated in it; without one the counts are current totals
+	GetPipelineStats(ctx context.Context, arg GetPipelineStatsParams) (GetPipelineStatsRow, error)
 	GetRecentEvaluationsGlobal(ctx context.Context, arg GetRecentEvaluationsGlobalParams) ([]GetRecentEvaluationsGlobalRow, error)
 	GetReferringOrgByID(ctx context.Context, id string) (ReferringOrg, error)
 	GetReferringOrgStats(ctx context.Context) (GetReferringOrgStatsRow, error)
 	GetRegistrationForm(ctx context.Context, id string) (RegistrationForm, error)
 	GetRegistrationFormWithDetails(ctx context.Context, id string) (GetRegistrationFormWithDetailsRow, error)
+	// Existing registrations for the same person, used to warn about duplicates
+	GetRegistrationFormsByBSN(ctx context.Context, bsn string) ([]string, error)
 	GetRegistrationStats(ctx context.Context) (GetRegistrationStatsRow, error)
+	// Oldest change first
+	GetRegistrationStatusHistory(ctx context.Context, registrationFormID string) ([]GetRegistrationStatusHistoryRow, error)
 	GetReminder(ctx context.Context, id string) (Reminder, error)
 	GetRoleByID(ctx context.Context, id string) (Role, error)
 	GetRoleByName(ctx context.Context, name string) (Role, error)
 	GetRoleForUser(ctx context.Context, userID string) (Role, error)
 	GetScheduledEvaluations(ctx context.Context, arg GetScheduledEvaluationsParams) ([]GetScheduledEvaluationsRow, error)
-	GetTodayAppointmentsForEmployee(ctx context.Context, organizerID string) ([]GetTodayAppointmentsForEmployeeRow, error)
+	// The day runs from day_start up to day_end, both computed by the caller in the
+	// application timezone; the session itself stays in UTC
+	GetTodayAppointmentsForEmployee(ctx context.Context, arg GetTodayAppointmentsForEmployeeParams) ([]GetTodayAppointmentsForEmployeeRow, error)
 	GetUnreadCount(ctx context.Context, userID string) (int64, error)
-	// Get appointments starting in the next hour for reminder notifications
-	GetUpcomingAppointments(ctx context.Context) ([]GetUpcomingAppointmentsRow, error)
+	// Get confirmed appointments starting before window_end for reminder notifications
+	GetUpcomingAppointments(ctx context.Context, windowEnd pgtype.Timestamptz) ([]GetUpcomingAppointmentsRow, error)
 	GetUserByEmail(ctx context.Context, email string) (User, error)
 	GetUserByID(ctx context.Context, id string) (User, error)
 	GetUserIDsByRoleName(ctx context.Context, name string) ([]string, error)
 	GetUserMFAState(ctx context.Context, id string) (GetUserMFAStateRow, error)
 	GetUserSession(ctx context.Context, tokenHash string) (Session, error)
 	GetWaitlistStats(ctx context.Context) (GetWaitlistStatsRow, error)
+	GetWorkerHeartbeat(ctx context.Context, workerName string) (WorkerHeartbeat, error)
 	HasPermission(ctx context.Context, arg HasPermissionParams) (bool, error)
 	IncrementLocationOccupied(ctx context.Context, id string) error
 	LinkGoalsToClient(ctx context.Context, arg LinkGoalsToClientParams) error
 	ListAppointmentParticipants(ctx context.Context, appointmentID string) ([]AppointmentParticipant, error)
+	// Participants of a page of appointments, fetched in one round trip
+	ListAppointmentParticipantsByAppointmentIDs(ctx context.Context, appointmentIds []string) ([]AppointmentParticipant, error)
+	// Lists appointments matching the optional filters, ordered by start time. The client
+	// is the filtered client, or otherwise the first client participant by name. The
+	// employee filter matches appointments the employee organizes or attends.
+	ListAppointments(ctx context.Context, arg ListAppointmentsParams) ([]ListAppointmentsRow, error)
 	ListAppointmentsByOrganizer(ctx context.Context, organizerID string) ([]Appointment, error)
 	ListAppointmentsByParticipant(ctx context.Context, arg ListAppointmentsByParticipantParams) ([]Appointment, error)
 	ListAppointmentsByRange(ctx context.Context, arg ListAppointmentsByRangeParams) ([]Appointment, error)
+	// Who downloaded the attachment and when, newest first
+	ListAttachmentAccess(ctx context.Context, attachmentID string) ([]ListAttachmentAccessRow, error)
 	ListAuditLogs(ctx context.Context, arg ListAuditLogsParams) ([]ListAuditLogsRow, error)
+	// Newest first, with the author's name.
+	ListClientNotes(ctx context.Context, clientID string) ([]ListClientNotesRow, error)
+	// Discharged clients past the retention period whose personal data is still stored
+	ListClientsDueForPurge(ctx context.Context, retentionMonths int32) ([]ListClientsDueForPurgeRow, error)
+	// Keyset page over every client, or one organization's clients, ordered by
+	// id. Pass the last id of the previous page as after_id (NULL for the first
+	// page).
+	ListClientsForExport(ctx context.Context, arg ListClientsForExportParams) ([]ListClientsForExportRow, error)
+	ListCoordinatorAvailability(ctx context.Context, employeeID string) ([]CoordinatorAvailability, error)
+	// Soft-deleted forms for admin review, most recently deleted first
+	ListDeletedRegistrationForms(ctx context.Context, arg ListDeletedRegistrationFormsParams) ([]ListDeletedRegistrationFormsRow, error)
 	ListDischargedClients(ctx context.Context, arg ListDischargedClientsParams) ([]ListDischargedClientsRow, error)
 	ListEmployees(ctx context.Context, arg ListEmployeesParams) ([]ListEmployeesRow, error)
+	ListEvaluationRecordsByClient(ctx context.Context, arg ListEvaluationRecordsByClientParams) ([]ListEvaluationRecordsByClientRow, error)
 	ListGoalsByClientID(ctx context.Context, clientID *string) ([]ClientGoal, error)
 	ListGoalsByIntakeID(ctx context.Context, intakeFormID string) ([]ClientGoal, error)
 	ListInCareClients(ctx context.Context, arg ListInCareClientsParams) ([]ListInCareClientsRow, error)
+	// Keyset variant of ListInCareClients ordered by (created_at, id), newest
+	// first. Pass the last row's created_at and id as the cursor (NULL for the
+	// first page). No total count is computed.
+	ListInCareClientsByCursor(ctx context.Context, arg ListInCareClientsByCursorParams) ([]ListInCareClientsByCursorRow, error)
 	ListIncidents(ctx context.Context, arg ListIncidentsParams) ([]ListIncidentsRow, error)
+	ListIntakeDocuments(ctx context.Context, intakeFormID string) ([]IntakeRequiredDocument, error)
 	ListIntakeForms(ctx context.Context, arg ListIntakeFormsParams) ([]ListIntakeFormsRow, error)
+	// Oldest change first
+	ListIntakeRescheduleHistory(ctx context.Context, intakeFormID string) ([]ListIntakeRescheduleHistoryRow, error)
 	ListLocationTransfers(ctx context.Context, arg ListLocationTransfersParams) ([]ListLocationTransfersRow, error)
 	ListLocations(ctx context.Context, arg ListLocationsParams) ([]ListLocationsRow, error)
 	ListNotifications(ctx context.Context, arg ListNotificationsParams) ([]ListNotificationsRow, error)
+	// Open evaluation records scheduled before today
+	ListOverdueEvaluationRecordsByClient(ctx context.Context, arg ListOverdueEvaluationRecordsByClientParams) ([]ListOverdueEvaluationRecordsByClientRow, error)
+	// In-care clients whose next evaluation date has passed, grouped per coordinator
+	// with the longest overdue first. Matches the overdue count in GetCriticalAlertsData.
+	ListOverdueEvaluations(ctx context.Context, arg ListOverdueEvaluationsParams) ([]ListOverdueEvaluationsRow, error)
+	// Lists unresolved incidents that have been open longer than their severity's
+	// SLA and are not escalated yet, oldest first. An SLA of 0 hours never
+	// escalates that severity.
+	ListOverdueIncidents(ctx context.Context, arg ListOverdueIncidentsParams) ([]ListOverdueIncidentsRow, error)
+	// Returns the user's permissions as resource:action, deduplicated across their roles
+	ListPermissionKeysForUser(ctx context.Context, userID string) ([]string, error)
 	ListPermissions(ctx context.Context, arg ListPermissionsParams) ([]ListPermissionsRow, error)
 	ListPermissionsForRole(ctx context.Context, roleID string) ([]Permission, error)
+	// Active locations of the organization with a free bed that offer the care type
+	// (or list no care types at all), most available beds first
+	ListPlacementCandidatesForOrganization(ctx context.Context, arg ListPlacementCandidatesForOrganizationParams) ([]ListPlacementCandidatesForOrganizationRow, error)
 	ListRecurringAppointments(ctx context.Context, arg ListRecurringAppointmentsParams) ([]Appointment, error)
 	ListReferringOrgs(ctx context.Context, arg ListReferringOrgsParams) ([]ListReferringOrgsRow, error)
 	ListReferringOrgsWithCounts(ctx context.Context, arg ListReferringOrgsWithCountsParams) ([]ListReferringOrgsWithCountsRow, error)
+	ListRegistrationFormAttachments(ctx context.Context, registrationFormID string) ([]ListRegistrationFormAttachmentsRow, error)
 	ListRegistrationForms(ctx context.Context, arg ListRegistrationFormsParams) ([]ListRegistrationFormsRow, error)
 	ListRemindersByRange(ctx context.Context, arg ListRemindersByRangeParams) ([]Reminder, error)
 	ListRemindersByUser(ctx context.Context, userID string) ([]Reminder, error)
 	ListRoles(ctx context.Context, arg ListRolesParams) ([]ListRolesRow, error)
-	ListUsersWithRole(ctx context.Context, roleID string) ([]ListUsersWithRoleRow, error)
+	ListUnresolvedIncidentsByClient(ctx context.Context, clientID string) ([]ListUnresolvedIncidentsByClientRow, error)
+	// Users holding a role, a page at a time; search matches the email or the
+	// linked employee's name
+	ListUsersWithRole(ctx context.Context, arg ListUsersWithRoleParams) ([]ListUsersWithRoleRow, error)
 	ListWaitingListClients(ctx context.Context, arg ListWaitingListClientsParams) ([]ListWaitingListClientsRow, error)
+	// Locks the employee row for the rest of the transaction so checks on their
+	// schedule or caseload and the write that depends on them are not interleaved
+	// with another transaction doing the same
+	LockEmployee(ctx context.Context, id string) (string, error)
+	// Locks an incident that is not escalated yet for the rest of the transaction.
+	// Incidents that are escalated or locked by another replica return no rows.
+	LockUnescalatedIncident(ctx context.Context, id string) (string, error)
 	MarkAllNotificationsAsRead(ctx context.Context, userID string) error
+	MarkIncidentEscalated(ctx context.Context, id string) error
 	MarkNotificationAsRead(ctx context.Context, arg MarkNotificationAsReadParams) error
+	// Marks every unread notification of the user that points at the resource
+	MarkNotificationsReadByResource(ctx context.Context, arg MarkNotificationsReadByResourceParams) error
+	// Reports whether the record a notification deep-links to exists and is not
+	// soft-deleted. Unknown resource types are reported as missing, so a new type
+	// must be added here before notifications can link to it.
+	NotificationResourceExists(ctx context.Context, arg NotificationResourceExistsParams) (bool, error)
+	// Keeps gender, care type, dates, location and discharge reason for statistics;
+	// the date of birth is reduced to the birth year
+	PurgeClientPII(ctx context.Context, id string) error
+	PurgeIntakeFormPII(ctx context.Context, id string) error
+	PurgeRegistrationFormPII(ctx context.Context, id string) error
+	// ============================================================
+	// Client Assignment History
+	// ============================================================
+	// Snapshots the client's current coordinator and location. Nothing is written
+	// when the assignment is unchanged since the latest history row.
+	RecordClientAssignment(ctx context.Context, arg RecordClientAssignmentParams) error
+	RecordClientPIIPurge(ctx context.Context, arg RecordClientPIIPurgeParams) error
+	// Counts a failed password attempt. Once max_attempts is reached the account
+	// is locked for lockout_seconds and the counter starts over.
+	RecordFailedLogin(ctx context.Context, arg RecordFailedLoginParams) (RecordFailedLoginRow, error)
+	// Call before UpdateIntakeForm with the new date and time; a NULL keeps the
+	// current value. Nothing is written when the appointment does not move.
+	RecordIntakeReschedule(ctx context.Context, arg RecordIntakeRescheduleParams) error
+	// Stamps a completed sign-in; a password login pending MFA does not count.
+	RecordLogin(ctx context.Context, id string) error
+	// Completes the client's open evaluation records with a submitted assessment,
+	// or records a completed one when none was scheduled. The outcome follows the
+	// assessment's goal progress: an adjusted goal gives plan_adjusted, a delayed,
+	// stagnant or deteriorating one needs_attention, anything else on_track.
+	RecordSubmittedEvaluation(ctx context.Context, arg RecordSubmittedEvaluationParams) error
+	// ============================================================
+	// Worker Heartbeats
+	// ============================================================
+	RecordWorkerHeartbeat(ctx context.Context, workerName string) error
 	RefuseLocationTransfer(ctx context.Context, arg RefuseLocationTransferParams) error
+	// Gives up the lease on shutdown so another replica can take over immediately
+	ReleaseWorkerLease(ctx context.Context, arg ReleaseWorkerLeaseParams) error
 	RemoveAppointmentParticipants(ctx context.Context, appointmentID string) error
+	// Returns the roles that held the permission
+	RemovePermissionFromAllRoles(ctx context.Context, permissionID string) ([]string, error)
 	RemovePermissionFromRole(ctx context.Context, arg RemovePermissionFromRoleParams) error
 	RemoveRoleFromUser(ctx context.Context, userID string) error
+	// Sets sort_order to each attachment's position in attachment_ids.
+	// IDs that are not linked to the form are ignored.
+	ReorderRegistrationFormAttachments(ctx context.Context, arg ReorderRegistrationFormAttachmentsParams) (int64, error)
+	// Increment occupancy only while the location has free capacity; returns no rows when it is full
+	ReserveLocationCapacity(ctx context.Context, id string) (int32, error)
+	ResetFailedLogins(ctx context.Context, id string) error
+	// Undoes a soft delete unless another active form has taken the BSN since
+	RestoreRegistrationForm(ctx context.Context, id string) (int64, error)
+	// Searches clients in every status by name or BSN prefix, with an optional
+	// status filter. Clients are never soft-deleted, so every match is returned.
+	SearchClients(ctx context.Context, arg SearchClientsParams) ([]SearchClientsRow, error)
+	SetFeatureFlag(ctx context.Context, arg SetFeatureFlagParams) (FeatureFlag, error)
+	// Links exactly attachment_ids to the form, in that order. Attachments that stay
+	// linked keep their caption; the rest are unlinked.
+	SetRegistrationFormAttachments(ctx context.Context, arg SetRegistrationFormAttachmentsParams) error
+	// Records today's capacity and occupancy for every active location; later runs
+	// on the same day overwrite that day's snapshot
+	SnapshotLocationCapacity(ctx context.Context) (int64, error)
 	SoftDeleteEmployee(ctx context.Context, id string) error
-	SoftDeleteIncident(ctx context.Context, id string) error
-	SoftDeleteLocation(ctx context.Context, id string) error
+	// Returns pgx.ErrNoRows when the incident does not exist or is already deleted
+	SoftDeleteIncident(ctx context.Context, arg SoftDeleteIncidentParams) (string, error)
+	// Intakes that were already converted into a client are left untouched; zero
+	// rows means the intake is missing, already deleted or converted
+	SoftDeleteIntakeForm(ctx context.Context, id string) (int64, error)
+	// Zero rows means the location is missing, already deleted or, when
+	// organization_id is set, owned by another organization
+	SoftDeleteLocation(ctx context.Context, arg SoftDeleteLocationParams) (int64, error)
 	SoftDeleteRegistrationForm(ctx context.Context, id string) error
 	SubmitDraftEvaluation(ctx context.Context, id string) (ClientEvaluation, error)
+	// Active coordinator at the employee's location with the fewest in-care clients
+	SuggestReplacementCoordinator(ctx context.Context, employeeID string) (SuggestReplacementCoordinatorRow, error)
 	UpdateAppointment(ctx context.Context, arg UpdateAppointmentParams) (Appointment, error)
 	UpdateClient(ctx context.Context, arg UpdateClientParams) (string, error)
 	UpdateClientByIntakeFormID(ctx context.Context, arg UpdateClientByIntakeFormIDParams) error
@@ -217,21 +448,32 @@ type Querier interface {
 	UpdateClientEvaluation(ctx context.Context, arg UpdateClientEvaluationParams) (ClientEvaluation, error)
 	UpdateClientGoal(ctx context.Context, arg UpdateClientGoalParams) error
 	UpdateClientNextEvaluationDate(ctx context.Context, arg UpdateClientNextEvaluationDateParams) error
+	// BSN is immutable after onboarding and intentionally not updatable here.
+	// clear_max_caseload resets the employee's cap to the default.
 	UpdateEmployee(ctx context.Context, arg UpdateEmployeeParams) error
 	UpdateGoalProgressLog(ctx context.Context, arg UpdateGoalProgressLogParams) error
 	UpdateIncident(ctx context.Context, arg UpdateIncidentParams) error
+	// received_at keeps the first time the document was marked received
+	UpdateIntakeDocumentStatus(ctx context.Context, arg UpdateIntakeDocumentStatusParams) (IntakeRequiredDocument, error)
 	UpdateIntakeForm(ctx context.Context, arg UpdateIntakeFormParams) error
 	UpdateIntakeFormStatus(ctx context.Context, arg UpdateIntakeFormStatusParams) error
-	UpdateLocation(ctx context.Context, arg UpdateLocationParams) error
+	// Zero rows means the location is missing, deleted or, when organization_id
+	// is set, owned by another organization
+	UpdateLocation(ctx context.Context, arg UpdateLocationParams) (int64, error)
 	UpdateLocationTransfer(ctx context.Context, arg UpdateLocationTransferParams) error
 	UpdateReferringOrg(ctx context.Context, arg UpdateReferringOrgParams) error
 	UpdateRegistrationForm(ctx context.Context, arg UpdateRegistrationFormParams) error
+	UpdateRegistrationFormAttachmentCaption(ctx context.Context, arg UpdateRegistrationFormAttachmentCaptionParams) (int64, error)
+	// Also appends the change to registration_status_history; nothing is recorded
+	// when the status is unchanged.
 	UpdateRegistrationFormStatus(ctx context.Context, arg UpdateRegistrationFormStatusParams) error
 	UpdateReminder(ctx context.Context, arg UpdateReminderParams) (Reminder, error)
 	UpdateRole(ctx context.Context, arg UpdateRoleParams) (Role, error)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) error
+	UpdateUserEmail(ctx context.Context, arg UpdateUserEmailParams) error
 	UpdateUserMFASecret(ctx context.Context, arg UpdateUserMFASecretParams) error
 	UpdateUserSession(ctx context.Context, arg UpdateUserSessionParams) error
+	UpsertNotificationQuietHours(ctx context.Context, arg UpsertNotificationQuietHoursParams) (NotificationQuietHour, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -11,6 +11,20 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const assignAllPermissionsToRole = `-- name: AssignAllPermissionsToRole :exec
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT $1::text, p.id
+FROM permissions p
+ORDER BY p.id
+ON CONFLICT DO NOTHING
+`
+
+// Grants the role every permission it does not hold yet.
+func (q *Queries) AssignAllPermissionsToRole(ctx context.Context, roleID string) error {
+	_, err := q.db.Exec(ctx, assignAllPermissionsToRole, roleID)
+	return err
+}
+
 const assignPermissionToRole = `-- name: AssignPermissionToRole :exec
 
 INSERT INTO role_permissions (role_id, permission_id)
@@ -53,7 +67,9 @@ func (q *Queries) AssignRoleToUser(ctx context.Context, arg AssignRoleToUserPara
 
 const batchAssignPermissionsToRole = `-- name: BatchAssignPermissionsToRole :exec
 INSERT INTO role_permissions (role_id, permission_id)
-SELECT $1::text, UNNEST($2::text[])
+SELECT $1::text, p.permission_id
+FROM (SELECT DISTINCT UNNEST($2::text[]) AS permission_id) p
+ORDER BY p.permission_id
 ON CONFLICT DO NOTHING
 `
 
@@ -62,6 +78,8 @@ type BatchAssignPermissionsToRoleParams struct {
 	PermissionIds []string `json:"permission_ids"`
 }
 
+// Permission IDs are deduplicated and inserted in sorted order so concurrent
+// batches with overlapping IDs acquire row locks in the same order.
 func (q *Queries) BatchAssignPermissionsToRole(ctx context.Context, arg BatchAssignPermissionsToRoleParams) error {
 	_, err := q.db.Exec(ctx, batchAssignPermissionsToRole, arg.RoleID, arg.PermissionIds)
 	return err
@@ -157,6 +175,59 @@ func (q *Queries) DeleteRole(ctx context.Context, id string) error {
 	return err
 }
 
+const ensureRole = `-- name: EnsureRole :exec
+INSERT INTO roles (id, name, description)
+VALUES ($1, $2, $3)
+ON CONFLICT DO NOTHING
+`
+
+type EnsureRoleParams struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+}
+
+// Creates a preset role if it is missing; an existing role is left as is.
+func (q *Queries) EnsureRole(ctx context.Context, arg EnsureRoleParams) error {
+	_, err := q.db.Exec(ctx, ensureRole, arg.ID, arg.Name, arg.Description)
+	return err
+}
+
+const getOrganizationUserIDsByRoleName = `-- name: GetOrganizationUserIDsByRoleName :many
+SELECT u.id
+FROM users u
+JOIN user_roles ur ON u.id = ur.user_id
+JOIN roles r ON ur.role_id = r.id
+WHERE r.name = $1
+  AND u.organization_id IS NOT DISTINCT FROM $2::text
+`
+
+type GetOrganizationUserIDsByRoleNameParams struct {
+	RoleName       string  `json:"role_name"`
+	OrganizationID *string `json:"organization_id"`
+}
+
+// Returns the users holding a role within one organization
+func (q *Queries) GetOrganizationUserIDsByRoleName(ctx context.Context, arg GetOrganizationUserIDsByRoleNameParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, getOrganizationUserIDsByRoleName, arg.RoleName, arg.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getPermissionByID = `-- name: GetPermissionByID :one
 SELECT id, resource, action, description, created_at FROM permissions WHERE id = $1
 `
@@ -256,7 +327,7 @@ func (q *Queries) GetUserIDsByRoleName(ctx context.Context, name string) ([]stri
 const listPermissions = `-- name: ListPermissions :many
 SELECT id, resource, action, description, created_at, COUNT(*) OVER() as total_count
 FROM permissions
-ORDER BY resource, action
+ORDER BY resource, action, id
 LIMIT $1 OFFSET $2
 `
 
@@ -342,7 +413,7 @@ SELECT
     (SELECT COUNT(*) FROM role_permissions rp WHERE rp.role_id = r.id) as permission_count,
     (SELECT COUNT(*) FROM user_roles ur WHERE ur.role_id = r.id) as user_count
 FROM roles r
-ORDER BY r.name
+ORDER BY r.name, r.id
 LIMIT $1 OFFSET $2
 `
 
@@ -390,20 +461,49 @@ func (q *Queries) ListRoles(ctx context.Context, arg ListRolesParams) ([]ListRol
 }
 
 const listUsersWithRole = `-- name: ListUsersWithRole :many
-SELECT u.id, u.email
+SELECT
+    u.id,
+    u.email,
+    e.first_name,
+    e.last_name,
+    COUNT(*) OVER() AS total_count
 FROM users u
 JOIN user_roles ur ON u.id = ur.user_id
+LEFT JOIN employees e ON e.user_id = u.id AND e.is_deleted = FALSE
 WHERE ur.role_id = $1
+  AND (
+    $4::text IS NULL OR
+    u.email ILIKE '%' || $4::text || '%' OR
+    CONCAT(e.first_name, ' ', e.last_name) ILIKE '%' || $4::text || '%'
+  )
 ORDER BY u.email
+LIMIT $2 OFFSET $3
 `
 
-type ListUsersWithRoleRow struct {
-	ID    string `json:"id"`
-	Email string `json:"email"`
+type ListUsersWithRoleParams struct {
+	RoleID string  `json:"role_id"`
+	Limit  int32   `json:"limit"`
+	Offset int32   `json:"offset"`
+	Search *string `json:"search"`
 }
 
-func (q *Queries) ListUsersWithRole(ctx context.Context, roleID string) ([]ListUsersWithRoleRow, error) {
-	rows, err := q.db.Query(ctx, listUsersWithRole, roleID)
+type ListUsersWithRoleRow struct {
+	ID         string  `json:"id"`
+	Email      string  `json:"email"`
+	FirstName  *string `json:"first_name"`
+	LastName   *string `json:"last_name"`
+	TotalCount int64   `json:"total_count"`
+}
+
+// Users holding a role, a page at a time; search matches the email or the
+// linked employee's name
+func (q *Queries) ListUsersWithRole(ctx context.Context, arg ListUsersWithRoleParams) ([]ListUsersWithRoleRow, error) {
+	rows, err := q.db.Query(ctx, listUsersWithRole,
+		arg.RoleID,
+		arg.Limit,
+		arg.Offset,
+		arg.Search,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -411,7 +511,13 @@ func (q *Queries) ListUsersWithRole(ctx context.Context, roleID string) ([]ListU
 	items := []ListUsersWithRoleRow{}
 	for rows.Next() {
 		var i ListUsersWithRoleRow
-		if err := rows.Scan(&i.ID, &i.Email); err != nil {
+		if err := rows.Scan(
+			&i.ID,
+			&i.Email,
+			&i.FirstName,
+			&i.LastName,
+			&i.TotalCount,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -422,6 +528,33 @@ func (q *Queries) ListUsersWithRole(ctx context.Context, roleID string) ([]ListU
 	return items, nil
 }
 
+const removePermissionFromAllRoles = `-- name: RemovePermissionFromAllRoles :many
+DELETE FROM role_permissions
+WHERE permission_id = $1
+RETURNING role_id
+`
+
+// Returns the roles that held the permission
+func (q *Queries) RemovePermissionFromAllRoles(ctx context.Context, permissionID string) ([]string, error) {
+	rows, err := q.db.Query(ctx, removePermissionFromAllRoles, permissionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var role_id string
+		if err := rows.Scan(&role_id); err != nil {
+			return nil, err
+		}
+		items = append(items, role_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const removePermissionFromRole = `-- name: RemovePermissionFromRole :exec
 DELETE FROM role_permissions
 WHERE role_id = $1 AND permission_id = $2
@@ -4,7 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/stretchr/testify/assert"
@@ -229,6 +233,42 @@ func TestListPermissions(t *testing.T) {
 	}
 }
 
+func TestListPermissions_StablePaging(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		// The aaa_ prefix sorts these before the seeded permissions
+		for i := 0; i < 4; i++ {
+			resource := fmt.Sprintf("aaa_paging_resource_%d", i%2)
+			action := fmt.Sprintf("action_%d", i/2)
+			CreateTestPermission(t, q, CreateTestPermissionOptions{Resource: &resource, Action: &action})
+		}
+
+		const pageSize = 3
+		page1, err := q.ListPermissions(ctx, ListPermissionsParams{Limit: pageSize, Offset: 0})
+		require.NoError(t, err)
+		page2, err := q.ListPermissions(ctx, ListPermissionsParams{Limit: pageSize, Offset: pageSize})
+		require.NoError(t, err)
+		all, err := q.ListPermissions(ctx, ListPermissionsParams{Limit: 2 * pageSize, Offset: 0})
+		require.NoError(t, err)
+
+		require.Len(t, page1, pageSize)
+		require.Len(t, page2, pageSize)
+
+		seen := map[string]bool{}
+		var walked []string
+		for _, p := range append(page1, page2...) {
+			assert.False(t, seen[p.ID], "permission %s returned on both pages", p.ID)
+			seen[p.ID] = true
+			walked = append(walked, p.ID)
+		}
+		var expected []string
+		for _, p := range all {
+			expected = append(expected, p.ID)
+		}
+		assert.Equal(t, expected, walked)
+	})
+}
+
 // ============================================================
 // Test: DeletePermission
 // ============================================================
@@ -553,6 +593,37 @@ func TestListRoles(t *testing.T) {
 	}
 }
 
+func TestListRoles_StablePaging(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		// The aaa_ prefix sorts these before the seeded roles
+		for i := 0; i < 4; i++ {
+			name := fmt.Sprintf("aaa_paging_role_%d", i)
+			CreateTestRole(t, q, CreateTestRoleOptions{Name: &name})
+		}
+
+		const pageSize = 2
+		page1, err := q.ListRoles(ctx, ListRolesParams{Limit: pageSize, Offset: 0})
+		require.NoError(t, err)
+		page2, err := q.ListRoles(ctx, ListRolesParams{Limit: pageSize, Offset: pageSize})
+		require.NoError(t, err)
+
+		require.Len(t, page1, pageSize)
+		require.Len(t, page2, pageSize)
+
+		seen := map[string]bool{}
+		var names []string
+		for _, r := range append(page1, page2...) {
+			assert.False(t, seen[r.ID], "role %s returned on both pages", r.ID)
+			seen[r.ID] = true
+			names = append(names, r.Name)
+		}
+		assert.Equal(t, []string{
+			"aaa_paging_role_0", "aaa_paging_role_1", "aaa_paging_role_2", "aaa_paging_role_3",
+		}, names)
+	})
+}
+
 // ============================================================
 // Test: UpdateRole
 // ============================================================
@@ -812,6 +883,70 @@ func TestBatchAssignPermissionsToRole(t *testing.T) {
 	}
 }
 
+// Concurrent batches open their own transactions, so this test runs against
+// testStore directly instead of inside runTestWithTx and deletes what it
+// commits. The role assignments go with the roles and permissions.
+func TestBatchAssignPermissionsToRole_ConcurrentOverlappingBatches(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	q := testStore.Queries
+
+	perms := make([]string, 6)
+	for i := range perms {
+		perms[i] = CreateTestPermission(t, q, CreateTestPermissionOptions{})
+		deleteAfterTest(t, "permissions", perms[i])
+	}
+	roleA := CreateTestRole(t, q, CreateTestRoleOptions{})
+	deleteAfterTest(t, "roles", roleA)
+	roleB := CreateTestRole(t, q, CreateTestRoleOptions{})
+	deleteAfterTest(t, "roles", roleB)
+
+	// Each role receives two overlapping batches listed in opposite orders
+	forward := []string{perms[0], perms[1], perms[2], perms[3]}
+	backward := []string{perms[5], perms[4], perms[3], perms[2]}
+	batches := []BatchAssignPermissionsToRoleParams{
+		{RoleID: roleA, PermissionIds: forward},
+		{RoleID: roleA, PermissionIds: backward},
+		{RoleID: roleB, PermissionIds: backward},
+		{RoleID: roleB, PermissionIds: forward},
+	}
+
+	start := make(chan struct{})
+	errs := make(chan error, len(batches))
+	var wg sync.WaitGroup
+	for _, batch := range batches {
+		wg.Add(1)
+		go func(batch BatchAssignPermissionsToRoleParams) {
+			defer wg.Done()
+			<-start
+			errs <- testStore.ExecTx(ctx, func(q *Queries) error {
+				return q.BatchAssignPermissionsToRole(ctx, batch)
+			})
+		}(batch)
+	}
+	close(start)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err, "concurrent batch assignment must not deadlock")
+	}
+
+	want := append([]string(nil), perms...)
+	sort.Strings(want)
+	for _, roleID := range []string{roleA, roleB} {
+		assigned, err := q.ListPermissionsForRole(ctx, roleID)
+		require.NoError(t, err)
+
+		got := make([]string, 0, len(assigned))
+		for _, perm := range assigned {
+			got = append(got, perm.ID)
+		}
+		sort.Strings(got)
+		assert.Equal(t, want, got)
+	}
+}
+
 // ============================================================
 // Test: ListPermissionsForRole
 // ============================================================
@@ -924,6 +1059,85 @@ func TestRemovePermissionFromRole(t *testing.T) {
 	}
 }
 
+// ============================================================
+// Test: RemovePermissionFromAllRoles
+// ============================================================
+
+func TestRemovePermissionFromAllRoles(t *testing.T) {
+	t.Run("removes_from_every_role", func(t *testing.T) {
+		runTestWithTx(t, func(t *testing.T, q *Queries) {
+			ctx := context.Background()
+
+			permID := CreateTestPermission(t, q, CreateTestPermissionOptions{})
+			otherPermID := CreateTestPermission(t, q, CreateTestPermissionOptions{})
+			roleIDs := []string{
+				CreateTestRole(t, q, CreateTestRoleOptions{}),
+				CreateTestRole(t, q, CreateTestRoleOptions{}),
+				CreateTestRole(t, q, CreateTestRoleOptions{}),
+			}
+			for _, roleID := range roleIDs {
+				AssignTestPermissionToRole(t, q, roleID, permID)
+			}
+			AssignTestPermissionToRole(t, q, roleIDs[0], otherPermID)
+
+			removed, err := q.RemovePermissionFromAllRoles(ctx, permID)
+			require.NoError(t, err)
+			assert.Len(t, removed, 3)
+			assert.ElementsMatch(t, roleIDs, removed)
+
+			for _, roleID := range roleIDs {
+				perms, err := q.ListPermissionsForRole(ctx, roleID)
+				require.NoError(t, err)
+				for _, p := range perms {
+					assert.NotEqual(t, permID, p.ID)
+				}
+			}
+
+			// Other permissions are untouched
+			perms, err := q.ListPermissionsForRole(ctx, roleIDs[0])
+			require.NoError(t, err)
+			require.Len(t, perms, 1)
+			assert.Equal(t, otherPermID, perms[0].ID)
+		})
+	})
+
+	t.Run("unassigned_permission", func(t *testing.T) {
+		runTestWithTx(t, func(t *testing.T, q *Queries) {
+			permID := CreateTestPermission(t, q, CreateTestPermissionOptions{})
+
+			removed, err := q.RemovePermissionFromAllRoles(context.Background(), permID)
+			require.NoError(t, err)
+			assert.Empty(t, removed)
+		})
+	})
+}
+
+// RemovePermissionFromAllRolesTx opens its own transaction, so it runs against
+// testStore directly instead of inside runTestWithTx.
+func TestRemovePermissionFromAllRolesTx(t *testing.T) {
+	ctx := context.Background()
+	q := testStore.Queries
+
+	permID := CreateTestPermission(t, q, CreateTestPermissionOptions{})
+	deleteAfterTest(t, "permissions", permID)
+	roleA := CreateTestRole(t, q, CreateTestRoleOptions{})
+	deleteAfterTest(t, "roles", roleA)
+	roleB := CreateTestRole(t, q, CreateTestRoleOptions{})
+	deleteAfterTest(t, "roles", roleB)
+	AssignTestPermissionToRole(t, q, roleA, permID)
+	AssignTestPermissionToRole(t, q, roleB, permID)
+
+	result, err := testStore.RemovePermissionFromAllRolesTx(ctx, RemovePermissionFromAllRolesTxParams{
+		PermissionID:     permID,
+		DeletePermission: true,
+	})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{roleA, roleB}, result.RoleIDs)
+
+	_, err = q.GetPermissionByID(ctx, permID)
+	assert.ErrorIs(t, err, pgx.ErrNoRows)
+}
+
 // ============================================================
 // Test: DeleteAllPermissionsFromRole
 // ============================================================
@@ -1098,6 +1312,31 @@ func TestGetRoleForUser(t *testing.T) {
 	}
 }
 
+// ============================================================
+// Test: ListPermissionKeysForUser
+// ============================================================
+
+func TestListPermissionKeysForUser(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+
+		coordinatorID := CreateTestUser(t, q, CreateTestUserOptions{})
+		AssignTestRoleToUser(t, q, coordinatorID, "role_coordinator")
+
+		permissions, err := q.ListPermissionKeysForUser(ctx, coordinatorID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"client:read", "client:write", "employee:read", "incident:read", "incident:write",
+			"intake:read", "intake:write", "location:read", "registration:read", "registration:write",
+		}, permissions)
+
+		noRoleID := CreateTestUser(t, q, CreateTestUserOptions{})
+		permissions, err = q.ListPermissionKeysForUser(ctx, noRoleID)
+		require.NoError(t, err)
+		assert.Empty(t, permissions)
+	})
+}
+
 // ============================================================
 // Test: RemoveRoleFromUser
 // ============================================================
@@ -1141,20 +1380,82 @@ func TestRemoveRoleFromUser(t *testing.T) {
 	}
 }
 
+// ============================================================
+// Test: GetOrganizationUserIDsByRoleName
+// ============================================================
+
+func TestGetOrganizationUserIDsByRoleName(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		roleName := fmt.Sprintf("escalation_%s", generateTestID()[:8])
+		roleID := CreateTestRole(t, q, CreateTestRoleOptions{Name: &roleName})
+		otherRoleID := CreateTestRole(t, q, CreateTestRoleOptions{})
+		orgID := CreateTestOrganization(t, q)
+		otherOrgID := CreateTestOrganization(t, q)
+
+		createUser := func(orgID, roleID string) string {
+			userID := CreateTestUser(t, q, CreateTestUserOptions{})
+			_, err := q.db.Exec(ctx, `UPDATE users SET organization_id = $2 WHERE id = $1`, userID, orgID)
+			require.NoError(t, err)
+			require.NoError(t, q.AssignRoleToUser(ctx, AssignRoleToUserParams{UserID: userID, RoleID: roleID}))
+			return userID
+		}
+		memberID := createUser(orgID, roleID)
+		// Same role in another organization, other role in the same organization
+		createUser(otherOrgID, roleID)
+		createUser(orgID, otherRoleID)
+
+		userIDs, err := q.GetOrganizationUserIDsByRoleName(ctx, GetOrganizationUserIDsByRoleNameParams{
+			RoleName:       roleName,
+			OrganizationID: &orgID,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{memberID}, userIDs)
+	})
+}
+
 // ============================================================
 // Test: ListUsersWithRole
 // ============================================================
 
 func TestListUsersWithRole(t *testing.T) {
+	// seedUsers gives a new role to users with the given emails; an employee
+	// named after the email's local part is linked to each
+	seedUsers := func(t *testing.T, q *Queries, emails ...string) string {
+		roleID := CreateTestRole(t, q, CreateTestRoleOptions{})
+		for _, email := range emails {
+			userID := CreateTestUser(t, q, CreateTestUserOptions{Email: &email})
+			firstName := strings.SplitN(email, "@", 2)[0]
+			lastName := "Jansen"
+			CreateTestEmployee(t, q, CreateTestEmployeeOptions{
+				UserID:    userID,
+				FirstName: &firstName,
+				LastName:  &lastName,
+			})
+			AssignTestRoleToUser(t, q, userID, roleID)
+		}
+		return roleID
+	}
+	emails := func(users []ListUsersWithRoleRow) []string {
+		result := make([]string, 0, len(users))
+		for _, u := range users {
+			result = append(result, u.Email)
+		}
+		return result
+	}
+
 	tests := []struct {
 		name     string
-		setup    func(t *testing.T, q *Queries) string
+		setup    func(t *testing.T, q *Queries) ListUsersWithRoleParams
 		validate func(t *testing.T, users []ListUsersWithRoleRow)
 	}{
 		{
 			name: "no_users",
-			setup: func(t *testing.T, q *Queries) string {
-				return CreateTestRole(t, q, CreateTestRoleOptions{})
+			setup: func(t *testing.T, q *Queries) ListUsersWithRoleParams {
+				return ListUsersWithRoleParams{
+					RoleID: CreateTestRole(t, q, CreateTestRoleOptions{}),
+					Limit:  10,
+				}
 			},
 			validate: func(t *testing.T, users []ListUsersWithRoleRow) {
 				assert.Len(t, users, 0)
@@ -1162,7 +1463,7 @@ func TestListUsersWithRole(t *testing.T) {
 		},
 		{
 			name: "multiple_users",
-			setup: func(t *testing.T, q *Queries) string {
+			setup: func(t *testing.T, q *Queries) ListUsersWithRoleParams {
 				roleID := CreateTestRole(t, q, CreateTestRoleOptions{})
 				email1 := "alice@example.com"
 				email2 := "bob@example.com"
@@ -1170,13 +1471,57 @@ func TestListUsersWithRole(t *testing.T) {
 				user2 := CreateTestUser(t, q, CreateTestUserOptions{Email: &email2})
 				AssignTestRoleToUser(t, q, user1, roleID)
 				AssignTestRoleToUser(t, q, user2, roleID)
-				return roleID
+				return ListUsersWithRoleParams{RoleID: roleID, Limit: 10}
 			},
 			validate: func(t *testing.T, users []ListUsersWithRoleRow) {
 				assert.Len(t, users, 2)
 				// Verify ordering by email
 				assert.Equal(t, "alice@example.com", users[0].Email)
 				assert.Equal(t, "bob@example.com", users[1].Email)
+				// Users without an employee have no name
+				assert.Nil(t, users[0].FirstName)
+			},
+		},
+		{
+			name: "pagination",
+			setup: func(t *testing.T, q *Queries) ListUsersWithRoleParams {
+				roleID := seedUsers(t, q,
+					"dave@example.com", "alice@example.com", "erin@example.com",
+					"carol@example.com", "bob@example.com",
+				)
+				return ListUsersWithRoleParams{RoleID: roleID, Limit: 2, Offset: 2}
+			},
+			validate: func(t *testing.T, users []ListUsersWithRoleRow) {
+				assert.Equal(t, []string{"carol@example.com", "dave@example.com"}, emails(users))
+				for _, u := range users {
+					assert.Equal(t, int64(5), u.TotalCount)
+				}
+			},
+		},
+		{
+			name: "search_by_email",
+			setup: func(t *testing.T, q *Queries) ListUsersWithRoleParams {
+				roleID := seedUsers(t, q, "alice@zorg.nl", "bob@example.com", "carol@zorg.nl")
+				return ListUsersWithRoleParams{RoleID: roleID, Limit: 10, Search: strPtr("ZORG")}
+			},
+			validate: func(t *testing.T, users []ListUsersWithRoleRow) {
+				assert.Equal(t, []string{"alice@zorg.nl", "carol@zorg.nl"}, emails(users))
+				assert.Equal(t, int64(2), users[0].TotalCount)
+			},
+		},
+		{
+			name: "search_by_employee_name",
+			setup: func(t *testing.T, q *Queries) ListUsersWithRoleParams {
+				roleID := seedUsers(t, q, "alice@example.com", "bob@example.com")
+				return ListUsersWithRoleParams{RoleID: roleID, Limit: 10, Search: strPtr("bob jan")}
+			},
+			validate: func(t *testing.T, users []ListUsersWithRoleRow) {
+				require.Len(t, users, 1)
+				assert.Equal(t, "bob@example.com", users[0].Email)
+				require.NotNil(t, users[0].FirstName)
+				assert.Equal(t, "bob", *users[0].FirstName)
+				require.NotNil(t, users[0].LastName)
+				assert.Equal(t, "Jansen", *users[0].LastName)
 			},
 		},
 	}
@@ -1185,9 +1530,9 @@ func TestListUsersWithRole(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			runTestWithTx(t, func(t *testing.T, q *Queries) {
 				ctx := context.Background()
-				roleID := tt.setup(t, q)
+				arg := tt.setup(t, q)
 
-				users, err := q.ListUsersWithRole(ctx, roleID)
+				users, err := q.ListUsersWithRole(ctx, arg)
 
 				require.NoError(t, err)
 				tt.validate(t, users)
@@ -1195,3 +1540,22 @@ func TestListUsersWithRole(t *testing.T) {
 		})
 	}
 }
+
+func TestClientExportPermission_AdminOnly(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		hasExport := func(roleID string) bool {
+			perms, err := q.ListPermissionsForRole(ctx, roleID)
+			require.NoError(t, err)
+			for _, perm := range perms {
+				if perm.Resource == "client" && perm.Action == "export" {
+					return true
+				}
+			}
+			return false
+		}
+
+		assert.True(t, hasExport(AdminRoleID))
+		assert.False(t, hasExport("role_coordinator"))
+	})
+}
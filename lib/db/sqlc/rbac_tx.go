@@ -84,3 +84,40 @@ func (s *Store) UpdateRoleWithPermissionsTx(
 
 	return result, err
 }
+
+type RemovePermissionFromAllRolesTxParams struct {
+	PermissionID string
+	// DeletePermission also deletes the permission once no role holds it
+	DeletePermission bool
+}
+
+type RemovePermissionFromAllRolesTxResult struct {
+	RoleIDs []string
+}
+
+func (s *Store) RemovePermissionFromAllRolesTx(
+	ctx context.Context,
+	arg RemovePermissionFromAllRolesTxParams,
+) (RemovePermissionFromAllRolesTxResult, error) {
+	var result RemovePermissionFromAllRolesTxResult
+
+	err := s.ExecTx(ctx, func(q *Queries) error {
+		// 1. Remove the permission from every role that holds it
+		roleIDs, err := q.RemovePermissionFromAllRoles(ctx, arg.PermissionID)
+		if err != nil {
+			return err
+		}
+		result.RoleIDs = roleIDs
+
+		// 2. Optionally delete the permission itself
+		if arg.DeletePermission {
+			if err := q.DeletePermission(ctx, arg.PermissionID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return result, err
+}
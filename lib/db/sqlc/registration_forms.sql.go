@@ -11,6 +11,95 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const batchUpdateRegistrationFormStatus = `-- name: BatchUpdateRegistrationFormStatus :many
+WITH targets AS (
+    SELECT t.id, t.history_id
+    FROM unnest($1::text[], $2::text[]) AS t(id, history_id)
+),
+updated AS (
+    UPDATE registration_forms r
+    SET status = $3::registration_status_enum, updated_at = NOW()
+    FROM registration_forms prev, targets t
+    WHERE r.id = t.id
+      AND prev.id = r.id
+      AND r.is_deleted = FALSE
+    RETURNING r.id, r.first_name, r.last_name, t.history_id, prev.status AS old_status, r.status AS new_status
+),
+history AS (
+    INSERT INTO registration_status_history (
+        id,
+        registration_form_id,
+        old_status,
+        new_status,
+        changed_by
+    )
+    SELECT
+        u.history_id,
+        u.id,
+        u.old_status,
+        u.new_status,
+        NULLIF($4::text, '')
+    FROM updated u
+    WHERE u.old_status IS DISTINCT FROM u.new_status
+)
+SELECT
+    u.id,
+    u.first_name,
+    u.last_name,
+    e.user_id AS coordinator_user_id
+FROM updated u
+LEFT JOIN intake_forms i ON i.registration_form_id = u.id AND i.is_deleted = FALSE
+LEFT JOIN employees e ON e.id = i.coordinator_id
+`
+
+type BatchUpdateRegistrationFormStatusParams struct {
+	Ids        []string               `json:"ids"`
+	HistoryIds []string               `json:"history_ids"`
+	Status     RegistrationStatusEnum `json:"status"`
+	ChangedBy  string                 `json:"changed_by"`
+}
+
+type BatchUpdateRegistrationFormStatusRow struct {
+	ID                string  `json:"id"`
+	FirstName         string  `json:"first_name"`
+	LastName          string  `json:"last_name"`
+	CoordinatorUserID *string `json:"coordinator_user_id"`
+}
+
+// Soft-deleted forms are skipped. history_ids pairs one history row ID with
+// each form ID; every form whose status changes gets a
+// registration_status_history entry. Returns one row per updated form with
+// the user ID of the coordinator assigned through its intake form, if any.
+func (q *Queries) BatchUpdateRegistrationFormStatus(ctx context.Context, arg BatchUpdateRegistrationFormStatusParams) ([]BatchUpdateRegistrationFormStatusRow, error) {
+	rows, err := q.db.Query(ctx, batchUpdateRegistrationFormStatus,
+		arg.Ids,
+		arg.HistoryIds,
+		arg.Status,
+		arg.ChangedBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []BatchUpdateRegistrationFormStatusRow{}
+	for rows.Next() {
+		var i BatchUpdateRegistrationFormStatusRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.FirstName,
+			&i.LastName,
+			&i.CoordinatorUserID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const createRegistrationForm = `-- name: CreateRegistrationForm :exec
 INSERT INTO registration_forms (
     id,
@@ -25,7 +114,7 @@ INSERT INTO registration_forms (
     registration_date,
     registration_reason,
     additional_notes,
-    attachment_ids
+    created_by_user_id
 ) VALUES (
     $1, $2, $3, $4, $5, $6, $7,
     $8, $9, $10, $11, $12, $13
@@ -45,7 +134,7 @@ type CreateRegistrationFormParams struct {
 	RegistrationDate   pgtype.Date  `json:"registration_date"`
 	RegistrationReason string       `json:"registration_reason"`
 	AdditionalNotes    *string      `json:"additional_notes"`
-	AttachmentIds      []string     `json:"attachment_ids"`
+	CreatedByUserID    *string      `json:"created_by_user_id"`
 }
 
 func (q *Queries) CreateRegistrationForm(ctx context.Context, arg CreateRegistrationFormParams) error {
@@ -62,13 +151,13 @@ func (q *Queries) CreateRegistrationForm(ctx context.Context, arg CreateRegistra
 		arg.RegistrationDate,
 		arg.RegistrationReason,
 		arg.AdditionalNotes,
-		arg.AttachmentIds,
+		arg.CreatedByUserID,
 	)
 	return err
 }
 
 const getRegistrationForm = `-- name: GetRegistrationForm :one
-SELECT id, first_name, last_name, bsn, date_of_birth, phone_number, gender, reffering_org_id, care_type, registration_date, registration_reason, additional_notes, status, attachment_ids, created_at, updated_at, is_deleted FROM registration_forms WHERE id = $1
+SELECT id, first_name, last_name, bsn, date_of_birth, phone_number, gender, reffering_org_id, care_type, registration_date, registration_reason, additional_notes, status, created_at, updated_at, is_deleted, deleted_at, created_by_user_id FROM registration_forms WHERE id = $1
 `
 
 func (q *Queries) GetRegistrationForm(ctx context.Context, id string) (RegistrationForm, error) {
@@ -88,10 +177,11 @@ func (q *Queries) GetRegistrationForm(ctx context.Context, id string) (Registrat
 		&i.RegistrationReason,
 		&i.AdditionalNotes,
 		&i.Status,
-		&i.AttachmentIds,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.IsDeleted,
+		&i.DeletedAt,
+		&i.CreatedByUserID,
 	)
 	return i, err
 }
@@ -108,13 +198,13 @@ SELECT r.id,
         r.registration_date,
         r.registration_reason,
         r.additional_notes,
-        r.attachment_ids,
         r.status,
+        r.created_by_user_id,
         ro.name as org_name,
         ro.contact_person as org_contact_person,
         ro.phone_number as org_phone_number,
         ro.email as org_email,
-        EXISTS (SELECT 1 FROM intake_forms inf WHERE inf.registration_form_id = r.id) AS intake_completed,
+        EXISTS (SELECT 1 FROM intake_forms inf WHERE inf.registration_form_id = r.id AND inf.is_deleted = FALSE) AS intake_completed,
         EXISTS (SELECT 1 FROM clients c WHERE c.registration_form_id = r.id) AS has_client
 FROM registration_forms r
 LEFT JOIN referring_orgs ro ON r.reffering_org_id = ro.id
@@ -133,8 +223,8 @@ type GetRegistrationFormWithDetailsRow struct {
 	RegistrationDate   pgtype.Date                `json:"registration_date"`
 	RegistrationReason string                     `json:"registration_reason"`
 	AdditionalNotes    *string                    `json:"additional_notes"`
-	AttachmentIds      []string                   `json:"attachment_ids"`
 	Status             NullRegistrationStatusEnum `json:"status"`
+	CreatedByUserID    *string                    `json:"created_by_user_id"`
 	OrgName            *string                    `json:"org_name"`
 	OrgContactPerson   *string                    `json:"org_contact_person"`
 	OrgPhoneNumber     *string                    `json:"org_phone_number"`
@@ -158,8 +248,8 @@ func (q *Queries) GetRegistrationFormWithDetails(ctx context.Context, id string)
 		&i.RegistrationDate,
 		&i.RegistrationReason,
 		&i.AdditionalNotes,
-		&i.AttachmentIds,
 		&i.Status,
+		&i.CreatedByUserID,
 		&i.OrgName,
 		&i.OrgContactPerson,
 		&i.OrgPhoneNumber,
@@ -170,6 +260,33 @@ func (q *Queries) GetRegistrationFormWithDetails(ctx context.Context, id string)
 	return i, err
 }
 
+const getRegistrationFormsByBSN = `-- name: GetRegistrationFormsByBSN :many
+SELECT id FROM registration_forms
+WHERE bsn = $1 AND is_deleted = FALSE
+ORDER BY created_at, id
+`
+
+// Existing registrations for the same person, used to warn about duplicates
+func (q *Queries) GetRegistrationFormsByBSN(ctx context.Context, bsn string) ([]string, error) {
+	rows, err := q.db.Query(ctx, getRegistrationFormsByBSN, bsn)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getRegistrationStats = `-- name: GetRegistrationStats :one
 SELECT 
     COUNT(*) as total_count,
@@ -192,6 +309,170 @@ func (q *Queries) GetRegistrationStats(ctx context.Context) (GetRegistrationStat
 	return i, err
 }
 
+const getRegistrationStatusHistory = `-- name: GetRegistrationStatusHistory :many
+SELECT
+    h.id,
+    h.old_status,
+    h.new_status,
+    h.changed_by,
+    h.changed_at
+FROM registration_status_history h
+WHERE h.registration_form_id = $1
+ORDER BY h.changed_at, h.id
+`
+
+type GetRegistrationStatusHistoryRow struct {
+	ID        string                     `json:"id"`
+	OldStatus NullRegistrationStatusEnum `json:"old_status"`
+	NewStatus NullRegistrationStatusEnum `json:"new_status"`
+	ChangedBy *string                    `json:"changed_by"`
+	ChangedAt pgtype.Timestamptz         `json:"changed_at"`
+}
+
+// Oldest change first
+func (q *Queries) GetRegistrationStatusHistory(ctx context.Context, registrationFormID string) ([]GetRegistrationStatusHistoryRow, error) {
+	rows, err := q.db.Query(ctx, getRegistrationStatusHistory, registrationFormID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetRegistrationStatusHistoryRow{}
+	for rows.Next() {
+		var i GetRegistrationStatusHistoryRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.OldStatus,
+			&i.NewStatus,
+			&i.ChangedBy,
+			&i.ChangedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDeletedRegistrationForms = `-- name: ListDeletedRegistrationForms :many
+SELECT r.id,
+        r.first_name,
+        r.last_name,
+        r.bsn,
+        r.date_of_birth,
+        r.care_type,
+        r.registration_date,
+        r.status,
+        r.deleted_at,
+        COUNT(r.id) OVER () AS total_count
+FROM registration_forms r
+WHERE r.is_deleted = TRUE
+ORDER BY r.deleted_at DESC NULLS LAST, r.id
+LIMIT $1 OFFSET $2
+`
+
+type ListDeletedRegistrationFormsParams struct {
+	Limit  int32 `json:"limit"`
+	Offset int32 `json:"offset"`
+}
+
+type ListDeletedRegistrationFormsRow struct {
+	ID               string                     `json:"id"`
+	FirstName        string                     `json:"first_name"`
+	LastName         string                     `json:"last_name"`
+	Bsn              string                     `json:"bsn"`
+	DateOfBirth      pgtype.Date                `json:"date_of_birth"`
+	CareType         CareTypeEnum               `json:"care_type"`
+	RegistrationDate pgtype.Date                `json:"registration_date"`
+	Status           NullRegistrationStatusEnum `json:"status"`
+	DeletedAt        pgtype.Timestamptz         `json:"deleted_at"`
+	TotalCount       int64                      `json:"total_count"`
+}
+
+// Soft-deleted forms for admin review, most recently deleted first
+func (q *Queries) ListDeletedRegistrationForms(ctx context.Context, arg ListDeletedRegistrationFormsParams) ([]ListDeletedRegistrationFormsRow, error) {
+	rows, err := q.db.Query(ctx, listDeletedRegistrationForms, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListDeletedRegistrationFormsRow{}
+	for rows.Next() {
+		var i ListDeletedRegistrationFormsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.FirstName,
+			&i.LastName,
+			&i.Bsn,
+			&i.DateOfBirth,
+			&i.CareType,
+			&i.RegistrationDate,
+			&i.Status,
+			&i.DeletedAt,
+			&i.TotalCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRegistrationFormAttachments = `-- name: ListRegistrationFormAttachments :many
+SELECT
+    rfa.attachment_id,
+    rfa.caption,
+    rfa.sort_order,
+    a.content_type,
+    a.uploaded_at,
+    a.filename
+FROM registration_form_attachments rfa
+JOIN attachments a ON a.id = rfa.attachment_id
+WHERE rfa.registration_form_id = $1
+ORDER BY rfa.sort_order, rfa.created_at
+`
+
+type ListRegistrationFormAttachmentsRow struct {
+	AttachmentID string             `json:"attachment_id"`
+	Caption      *string            `json:"caption"`
+	SortOrder    int32              `json:"sort_order"`
+	ContentType  string             `json:"content_type"`
+	UploadedAt   pgtype.Timestamptz `json:"uploaded_at"`
+	Filename     *string            `json:"filename"`
+}
+
+func (q *Queries) ListRegistrationFormAttachments(ctx context.Context, registrationFormID string) ([]ListRegistrationFormAttachmentsRow, error) {
+	rows, err := q.db.Query(ctx, listRegistrationFormAttachments, registrationFormID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListRegistrationFormAttachmentsRow{}
+	for rows.Next() {
+		var i ListRegistrationFormAttachmentsRow
+		if err := rows.Scan(
+			&i.AttachmentID,
+			&i.Caption,
+			&i.SortOrder,
+			&i.ContentType,
+			&i.UploadedAt,
+			&i.Filename,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listRegistrationForms = `-- name: ListRegistrationForms :many
 SELECT r.id,
         r.first_name,
@@ -203,13 +484,13 @@ SELECT r.id,
         r.registration_date,
         r.registration_reason,
         r.additional_notes,
-        r.attachment_ids,
+        (SELECT COUNT(*) FROM registration_form_attachments rfa WHERE rfa.registration_form_id = r.id) AS attachment_count,
         r.status,
         ro.name as org_name,
         ro.contact_person as org_contact_person,
         ro.phone_number as org_phone_number,
         ro.email as org_email,
-        EXISTS (SELECT 1 FROM intake_forms inf WHERE inf.registration_form_id = r.id) AS intake_completed,
+        EXISTS (SELECT 1 FROM intake_forms inf WHERE inf.registration_form_id = r.id AND inf.is_deleted = FALSE) AS intake_completed,
         COUNT(r.id) OVER () AS total_count
 FROM registration_forms r
 LEFT JOIN referring_orgs ro ON r.reffering_org_id = ro.id
@@ -234,7 +515,7 @@ WHERE
         -- If intake_completed is NULL, ignore filter
         $5::boolean IS NULL OR
         -- Filter by intake completion status
-        EXISTS (SELECT 1 FROM intake_forms inf WHERE inf.registration_form_id = r.id) = $5::boolean
+        EXISTS (SELECT 1 FROM intake_forms inf WHERE inf.registration_form_id = r.id AND inf.is_deleted = FALSE) = $5::boolean
     )
 ORDER BY r.created_at DESC
 LIMIT $1 OFFSET $2
@@ -259,7 +540,7 @@ type ListRegistrationFormsRow struct {
 	RegistrationDate   pgtype.Date                `json:"registration_date"`
 	RegistrationReason string                     `json:"registration_reason"`
 	AdditionalNotes    *string                    `json:"additional_notes"`
-	AttachmentIds      []string                   `json:"attachment_ids"`
+	AttachmentCount    int64                      `json:"attachment_count"`
 	Status             NullRegistrationStatusEnum `json:"status"`
 	OrgName            *string                    `json:"org_name"`
 	OrgContactPerson   *string                    `json:"org_contact_person"`
@@ -295,7 +576,7 @@ func (q *Queries) ListRegistrationForms(ctx context.Context, arg ListRegistratio
 			&i.RegistrationDate,
 			&i.RegistrationReason,
 			&i.AdditionalNotes,
-			&i.AttachmentIds,
+			&i.AttachmentCount,
 			&i.Status,
 			&i.OrgName,
 			&i.OrgContactPerson,
@@ -314,8 +595,74 @@ func (q *Queries) ListRegistrationForms(ctx context.Context, arg ListRegistratio
 	return items, nil
 }
 
+const reorderRegistrationFormAttachments = `-- name: ReorderRegistrationFormAttachments :execrows
+UPDATE registration_form_attachments rfa
+SET sort_order = (t.ord - 1)::int
+FROM UNNEST($1::text[]) WITH ORDINALITY AS t(attachment_id, ord)
+WHERE rfa.registration_form_id = $2::text
+  AND rfa.attachment_id = t.attachment_id
+`
+
+type ReorderRegistrationFormAttachmentsParams struct {
+	AttachmentIds      []string `json:"attachment_ids"`
+	RegistrationFormID string   `json:"registration_form_id"`
+}
+
+// Sets sort_order to each attachment's position in attachment_ids.
+// IDs that are not linked to the form are ignored.
+func (q *Queries) ReorderRegistrationFormAttachments(ctx context.Context, arg ReorderRegistrationFormAttachmentsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, reorderRegistrationFormAttachments, arg.AttachmentIds, arg.RegistrationFormID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const restoreRegistrationForm = `-- name: RestoreRegistrationForm :execrows
+UPDATE registration_forms r SET is_deleted = FALSE, deleted_at = NULL, updated_at = NOW()
+WHERE r.id = $1
+  AND r.is_deleted = TRUE
+  AND NOT EXISTS (
+      SELECT 1 FROM registration_forms o
+      WHERE o.bsn = r.bsn AND o.is_deleted = FALSE
+  )
+`
+
+// Undoes a soft delete unless another active form has taken the BSN since
+func (q *Queries) RestoreRegistrationForm(ctx context.Context, id string) (int64, error) {
+	result, err := q.db.Exec(ctx, restoreRegistrationForm, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const setRegistrationFormAttachments = `-- name: SetRegistrationFormAttachments :exec
+WITH removed AS (
+    DELETE FROM registration_form_attachments
+    WHERE registration_form_id = $1::text
+      AND attachment_id <> ALL($2::text[])
+)
+INSERT INTO registration_form_attachments (registration_form_id, attachment_id, sort_order)
+SELECT $1::text, t.attachment_id, (t.ord - 1)::int
+FROM UNNEST($2::text[]) WITH ORDINALITY AS t(attachment_id, ord)
+ON CONFLICT (registration_form_id, attachment_id) DO UPDATE SET sort_order = EXCLUDED.sort_order
+`
+
+type SetRegistrationFormAttachmentsParams struct {
+	RegistrationFormID string   `json:"registration_form_id"`
+	AttachmentIds      []string `json:"attachment_ids"`
+}
+
+// Links exactly attachment_ids to the form, in that order. Attachments that stay
+// linked keep their caption; the rest are unlinked.
+func (q *Queries) SetRegistrationFormAttachments(ctx context.Context, arg SetRegistrationFormAttachmentsParams) error {
+	_, err := q.db.Exec(ctx, setRegistrationFormAttachments, arg.RegistrationFormID, arg.AttachmentIds)
+	return err
+}
+
 const softDeleteRegistrationForm = `-- name: SoftDeleteRegistrationForm :exec
-UPDATE registration_forms SET is_deleted = TRUE, updated_at = NOW() WHERE id = $1
+UPDATE registration_forms SET is_deleted = TRUE, deleted_at = NOW(), updated_at = NOW() WHERE id = $1
 `
 
 func (q *Queries) SoftDeleteRegistrationForm(ctx context.Context, id string) error {
@@ -337,7 +684,6 @@ UPDATE registration_forms SET
     registration_reason = COALESCE($11, registration_reason),
     additional_notes = COALESCE($12, additional_notes),
     status = COALESCE($13, status),
-    attachment_ids = COALESCE($14, attachment_ids),
     updated_at = NOW()
 WHERE id = $1
 `
@@ -356,7 +702,6 @@ type UpdateRegistrationFormParams struct {
 	RegistrationReason *string                    `json:"registration_reason"`
 	AdditionalNotes    *string                    `json:"additional_notes"`
 	Status             NullRegistrationStatusEnum `json:"status"`
-	AttachmentIds      []string                   `json:"attachment_ids"`
 }
 
 func (q *Queries) UpdateRegistrationForm(ctx context.Context, arg UpdateRegistrationFormParams) error {
@@ -374,21 +719,70 @@ func (q *Queries) UpdateRegistrationForm(ctx context.Context, arg UpdateRegistra
 		arg.RegistrationReason,
 		arg.AdditionalNotes,
 		arg.Status,
-		arg.AttachmentIds,
 	)
 	return err
 }
 
+const updateRegistrationFormAttachmentCaption = `-- name: UpdateRegistrationFormAttachmentCaption :execrows
+UPDATE registration_form_attachments
+SET caption = $3
+WHERE registration_form_id = $1 AND attachment_id = $2
+`
+
+type UpdateRegistrationFormAttachmentCaptionParams struct {
+	RegistrationFormID string  `json:"registration_form_id"`
+	AttachmentID       string  `json:"attachment_id"`
+	Caption            *string `json:"caption"`
+}
+
+func (q *Queries) UpdateRegistrationFormAttachmentCaption(ctx context.Context, arg UpdateRegistrationFormAttachmentCaptionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateRegistrationFormAttachmentCaption, arg.RegistrationFormID, arg.AttachmentID, arg.Caption)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateRegistrationFormStatus = `-- name: UpdateRegistrationFormStatus :exec
-UPDATE registration_forms SET status = $2, updated_at = NOW() WHERE id = $1
+WITH updated AS (
+    UPDATE registration_forms r
+    SET status = $1, updated_at = NOW()
+    FROM registration_forms prev
+    WHERE r.id = $2 AND prev.id = r.id
+    RETURNING r.id, prev.status AS old_status, r.status AS new_status
+)
+INSERT INTO registration_status_history (
+    id,
+    registration_form_id,
+    old_status,
+    new_status,
+    changed_by
+)
+SELECT
+    $3::text,
+    u.id,
+    u.old_status,
+    u.new_status,
+    NULLIF($4::text, '')
+FROM updated u
+WHERE u.old_status IS DISTINCT FROM u.new_status
 `
 
 type UpdateRegistrationFormStatusParams struct {
-	ID     string                     `json:"id"`
-	Status NullRegistrationStatusEnum `json:"status"`
+	Status    NullRegistrationStatusEnum `json:"status"`
+	ID        string                     `json:"id"`
+	HistoryID string                     `json:"history_id"`
+	ChangedBy string                     `json:"changed_by"`
 }
 
+// Also appends the change to registration_status_history; nothing is recorded
+// when the status is unchanged.
 func (q *Queries) UpdateRegistrationFormStatus(ctx context.Context, arg UpdateRegistrationFormStatusParams) error {
-	_, err := q.db.Exec(ctx, updateRegistrationFormStatus, arg.ID, arg.Status)
+	_, err := q.db.Exec(ctx, updateRegistrationFormStatus,
+		arg.Status,
+		arg.ID,
+		arg.HistoryID,
+		arg.ChangedBy,
+	)
 	return err
 }
@@ -38,7 +38,6 @@ func TestCreateRegistrationForm(t *testing.T) {
 					CareType:           CareTypeEnumProtectedLiving,
 					RegistrationReason: "Test reason",
 					AdditionalNotes:    strPtr("Test notes"),
-					AttachmentIds:      []string{"att1", "att2"},
 				}
 			},
 			wantErr: false,
@@ -52,7 +51,6 @@ func TestCreateRegistrationForm(t *testing.T) {
 				assert.Equal(t, params.Gender, form.Gender)
 				assert.Equal(t, params.RefferingOrgID, form.RefferingOrgID)
 				assert.Equal(t, params.CareType, form.CareType)
-				assert.Equal(t, params.AttachmentIds, form.AttachmentIds)
 			},
 		},
 		{
@@ -73,15 +71,15 @@ func TestCreateRegistrationForm(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name: "duplicate_bsn",
+			name: "duplicate_active_bsn",
 			setup: func(t *testing.T, q *Queries) CreateRegistrationFormParams {
-				bsn := "DUPLICATE1"
+				bsn := generateTestID()[:9]
 				CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{Bsn: &bsn})
 				return CreateRegistrationFormParams{
 					ID:                 generateTestID(),
 					FirstName:          "Another",
 					LastName:           "Person",
-					Bsn:                bsn, // Duplicate
+					Bsn:                bsn,
 					Gender:             GenderEnumOther,
 					DateOfBirth:        toPgDate(time.Date(1992, 2, 2, 0, 0, 0, 0, time.UTC)),
 					CareType:           CareTypeEnumProtectedLiving,
@@ -90,7 +88,32 @@ func TestCreateRegistrationForm(t *testing.T) {
 			},
 			wantErr: true,
 			checkErr: func(t *testing.T, err error) {
-				assert.True(t, IsUniqueViolation(err), "expected unique violation, got: %v", err)
+				assert.True(t, IsUniqueViolationOf(err, "uq_registration_forms_active_bsn"),
+					"expected active BSN violation, got: %v", err)
+			},
+		},
+		{
+			name: "bsn_of_deleted_form",
+			setup: func(t *testing.T, q *Queries) CreateRegistrationFormParams {
+				bsn := generateTestID()[:9]
+				deletedID := CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{Bsn: &bsn})
+				require.NoError(t, q.SoftDeleteRegistrationForm(context.Background(), deletedID))
+				return CreateRegistrationFormParams{
+					ID:                 generateTestID(),
+					FirstName:          "Returning",
+					LastName:           "Person",
+					Bsn:                bsn, // Re-registration after the first form was deleted
+					Gender:             GenderEnumOther,
+					DateOfBirth:        toPgDate(time.Date(1992, 2, 2, 0, 0, 0, 0, time.UTC)),
+					CareType:           CareTypeEnumProtectedLiving,
+					RegistrationReason: "Re-registration test",
+				}
+			},
+			wantErr: false,
+			validate: func(t *testing.T, q *Queries, params CreateRegistrationFormParams) {
+				ids, err := q.GetRegistrationFormsByBSN(context.Background(), params.Bsn)
+				require.NoError(t, err)
+				assert.Equal(t, []string{params.ID}, ids)
 			},
 		},
 		{
@@ -335,6 +358,31 @@ func TestListRegistrationForms(t *testing.T) {
 	}
 }
 
+// ============================================================
+// Test: GetRegistrationFormsByBSN
+// ============================================================
+
+func TestGetRegistrationFormsByBSN(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		bsn := generateTestID()[:9]
+		otherBsn := generateTestID()[:9]
+
+		deletedID := CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{Bsn: &bsn})
+		require.NoError(t, q.SoftDeleteRegistrationForm(ctx, deletedID))
+		activeID := CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{Bsn: &bsn})
+		CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{Bsn: &otherBsn})
+
+		ids, err := q.GetRegistrationFormsByBSN(ctx, bsn)
+		require.NoError(t, err)
+		assert.Equal(t, []string{activeID}, ids)
+
+		ids, err = q.GetRegistrationFormsByBSN(ctx, "000000000")
+		require.NoError(t, err)
+		assert.Empty(t, ids)
+	})
+}
+
 // ============================================================
 // Test: GetRegistrationFormWithDetails
 // ============================================================
@@ -512,22 +560,25 @@ func TestGetRegistrationStats(t *testing.T) {
 				// Create and update to approved
 				id1 := CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{})
 				q.UpdateRegistrationFormStatus(ctx, UpdateRegistrationFormStatusParams{
-					ID:     id1,
-					Status: NullRegistrationStatusEnum{RegistrationStatusEnum: RegistrationStatusEnumApproved, Valid: true},
+					ID:        id1,
+					HistoryID: generateTestID(),
+					Status:    NullRegistrationStatusEnum{RegistrationStatusEnum: RegistrationStatusEnumApproved, Valid: true},
 				})
 
 				// Create and update to in_review
 				id2 := CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{})
 				q.UpdateRegistrationFormStatus(ctx, UpdateRegistrationFormStatusParams{
-					ID:     id2,
-					Status: NullRegistrationStatusEnum{RegistrationStatusEnum: RegistrationStatusEnumInReview, Valid: true},
+					ID:        id2,
+					HistoryID: generateTestID(),
+					Status:    NullRegistrationStatusEnum{RegistrationStatusEnum: RegistrationStatusEnumInReview, Valid: true},
 				})
 
 				// Create and update to another approved
 				id3 := CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{})
 				q.UpdateRegistrationFormStatus(ctx, UpdateRegistrationFormStatusParams{
-					ID:     id3,
-					Status: NullRegistrationStatusEnum{RegistrationStatusEnum: RegistrationStatusEnumApproved, Valid: true},
+					ID:        id3,
+					HistoryID: generateTestID(),
+					Status:    NullRegistrationStatusEnum{RegistrationStatusEnum: RegistrationStatusEnumApproved, Valid: true},
 				})
 
 				// Create pending (default)
@@ -651,6 +702,330 @@ func TestSoftDeleteRegistrationForm(t *testing.T) {
 	}
 }
 
+// ============================================================
+// Test: ListDeletedRegistrationForms / RestoreRegistrationForm
+// ============================================================
+
+func TestListDeletedRegistrationForms(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+
+		activeID := CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{})
+		deletedID := CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{})
+		require.NoError(t, q.SoftDeleteRegistrationForm(ctx, deletedID))
+
+		rows, err := q.ListDeletedRegistrationForms(ctx, ListDeletedRegistrationFormsParams{
+			Limit:  1000,
+			Offset: 0,
+		})
+		require.NoError(t, err)
+
+		var ids []string
+		for _, row := range rows {
+			ids = append(ids, row.ID)
+			if row.ID == deletedID {
+				form, err := q.GetRegistrationForm(ctx, deletedID)
+				require.NoError(t, err)
+				require.True(t, form.DeletedAt.Valid, "the soft delete sets deleted_at")
+				assert.Equal(t, form.DeletedAt, row.DeletedAt)
+			}
+		}
+		assert.Contains(t, ids, deletedID)
+		assert.NotContains(t, ids, activeID)
+		require.NotEmpty(t, rows)
+		assert.Equal(t, int64(len(rows)), rows[0].TotalCount)
+	})
+}
+
+func TestRestoreRegistrationForm(t *testing.T) {
+	isDeleted := func(t *testing.T, q *Queries, id string) bool {
+		form, err := q.GetRegistrationForm(context.Background(), id)
+		require.NoError(t, err)
+		require.NotNil(t, form.IsDeleted)
+		return *form.IsDeleted
+	}
+
+	t.Run("restores_deleted_form", func(t *testing.T) {
+		runTestWithTx(t, func(t *testing.T, q *Queries) {
+			ctx := context.Background()
+			id := CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{})
+			require.NoError(t, q.SoftDeleteRegistrationForm(ctx, id))
+
+			restored, err := q.RestoreRegistrationForm(ctx, id)
+			require.NoError(t, err)
+			assert.Equal(t, int64(1), restored)
+			assert.False(t, isDeleted(t, q, id))
+
+			form, err := q.GetRegistrationForm(ctx, id)
+			require.NoError(t, err)
+			assert.False(t, form.DeletedAt.Valid, "restoring clears deleted_at")
+		})
+	})
+
+	t.Run("blocked_by_active_bsn", func(t *testing.T) {
+		runTestWithTx(t, func(t *testing.T, q *Queries) {
+			ctx := context.Background()
+			bsn := generateTestID()[:9]
+			deletedID := CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{Bsn: &bsn})
+			require.NoError(t, q.SoftDeleteRegistrationForm(ctx, deletedID))
+			CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{Bsn: &bsn})
+
+			restored, err := q.RestoreRegistrationForm(ctx, deletedID)
+			require.NoError(t, err)
+			assert.Zero(t, restored)
+			assert.True(t, isDeleted(t, q, deletedID))
+		})
+	})
+
+	t.Run("active_form_untouched", func(t *testing.T) {
+		runTestWithTx(t, func(t *testing.T, q *Queries) {
+			id := CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{})
+
+			restored, err := q.RestoreRegistrationForm(context.Background(), id)
+			require.NoError(t, err)
+			assert.Zero(t, restored)
+		})
+	})
+}
+
+// ============================================================
+// Test: Registration form attachments
+// ============================================================
+
+func attachmentOrder(t *testing.T, q *Queries, formID string) []string {
+	t.Helper()
+	rows, err := q.ListRegistrationFormAttachments(context.Background(), formID)
+	require.NoError(t, err)
+	ids := make([]string, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.AttachmentID)
+	}
+	return ids
+}
+
+func TestSetRegistrationFormAttachments(t *testing.T) {
+	tests := []struct {
+		name     string
+		run      func(t *testing.T, q *Queries, formID string, att []string)
+		validate func(t *testing.T, q *Queries, formID string, att []string)
+	}{
+		{
+			name: "links_in_given_order",
+			run: func(t *testing.T, q *Queries, formID string, att []string) {
+				err := q.SetRegistrationFormAttachments(context.Background(), SetRegistrationFormAttachmentsParams{
+					RegistrationFormID: formID,
+					AttachmentIds:      []string{att[2], att[0], att[1]},
+				})
+				require.NoError(t, err)
+			},
+			validate: func(t *testing.T, q *Queries, formID string, att []string) {
+				assert.Equal(t, []string{att[2], att[0], att[1]}, attachmentOrder(t, q, formID))
+			},
+		},
+		{
+			name: "replace_unlinks_missing_and_keeps_captions",
+			run: func(t *testing.T, q *Queries, formID string, att []string) {
+				ctx := context.Background()
+				require.NoError(t, q.SetRegistrationFormAttachments(ctx, SetRegistrationFormAttachmentsParams{
+					RegistrationFormID: formID,
+					AttachmentIds:      att,
+				}))
+				_, err := q.UpdateRegistrationFormAttachmentCaption(ctx, UpdateRegistrationFormAttachmentCaptionParams{
+					RegistrationFormID: formID,
+					AttachmentID:       att[1],
+					Caption:            strPtr("Indicatiebesluit"),
+				})
+				require.NoError(t, err)
+
+				require.NoError(t, q.SetRegistrationFormAttachments(ctx, SetRegistrationFormAttachmentsParams{
+					RegistrationFormID: formID,
+					AttachmentIds:      []string{att[1], att[2]},
+				}))
+			},
+			validate: func(t *testing.T, q *Queries, formID string, att []string) {
+				rows, err := q.ListRegistrationFormAttachments(context.Background(), formID)
+				require.NoError(t, err)
+				require.Len(t, rows, 2)
+				assert.Equal(t, att[1], rows[0].AttachmentID)
+				assert.Equal(t, int32(0), rows[0].SortOrder)
+				require.NotNil(t, rows[0].Caption)
+				assert.Equal(t, "Indicatiebesluit", *rows[0].Caption)
+				assert.Equal(t, att[2], rows[1].AttachmentID)
+				assert.Nil(t, rows[1].Caption)
+			},
+		},
+		{
+			name: "empty_list_unlinks_all",
+			run: func(t *testing.T, q *Queries, formID string, att []string) {
+				ctx := context.Background()
+				require.NoError(t, q.SetRegistrationFormAttachments(ctx, SetRegistrationFormAttachmentsParams{
+					RegistrationFormID: formID,
+					AttachmentIds:      att,
+				}))
+				require.NoError(t, q.SetRegistrationFormAttachments(ctx, SetRegistrationFormAttachmentsParams{
+					RegistrationFormID: formID,
+					AttachmentIds:      []string{},
+				}))
+			},
+			validate: func(t *testing.T, q *Queries, formID string, att []string) {
+				assert.Empty(t, attachmentOrder(t, q, formID))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runTestWithTx(t, func(t *testing.T, q *Queries) {
+				formID := CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{})
+				att := []string{
+					CreateTestAttachment(t, q, nil),
+					CreateTestAttachment(t, q, nil),
+					CreateTestAttachment(t, q, nil),
+				}
+
+				tt.run(t, q, formID, att)
+				tt.validate(t, q, formID, att)
+			})
+		})
+	}
+}
+
+func TestListRegistrationFormAttachments(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		formID := CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{})
+		emptyFormID := CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{})
+		attachmentID := CreateTestAttachment(t, q, nil)
+
+		require.NoError(t, q.SetRegistrationFormAttachments(ctx, SetRegistrationFormAttachmentsParams{
+			RegistrationFormID: formID,
+			AttachmentIds:      []string{attachmentID},
+		}))
+
+		rows, err := q.ListRegistrationFormAttachments(ctx, formID)
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		assert.Equal(t, attachmentID, rows[0].AttachmentID)
+		require.NotNil(t, rows[0].Filename)
+		assert.Equal(t, attachmentID+".pdf", *rows[0].Filename)
+		assert.Equal(t, "application/pdf", rows[0].ContentType)
+		assert.True(t, rows[0].UploadedAt.Valid)
+
+		rows, err = q.ListRegistrationFormAttachments(ctx, emptyFormID)
+		require.NoError(t, err)
+		assert.NotNil(t, rows)
+		assert.Empty(t, rows)
+	})
+}
+
+func TestReorderRegistrationFormAttachments(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		formID := CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{})
+		otherFormID := CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{})
+		a := CreateTestAttachment(t, q, nil)
+		b := CreateTestAttachment(t, q, nil)
+		c := CreateTestAttachment(t, q, nil)
+
+		require.NoError(t, q.SetRegistrationFormAttachments(ctx, SetRegistrationFormAttachmentsParams{
+			RegistrationFormID: formID,
+			AttachmentIds:      []string{a, b, c},
+		}))
+		require.NoError(t, q.SetRegistrationFormAttachments(ctx, SetRegistrationFormAttachmentsParams{
+			RegistrationFormID: otherFormID,
+			AttachmentIds:      []string{a, b},
+		}))
+		_, err := q.UpdateRegistrationFormAttachmentCaption(ctx, UpdateRegistrationFormAttachmentCaptionParams{
+			RegistrationFormID: formID,
+			AttachmentID:       a,
+			Caption:            strPtr("Verwijsbrief"),
+		})
+		require.NoError(t, err)
+
+		affected, err := q.ReorderRegistrationFormAttachments(ctx, ReorderRegistrationFormAttachmentsParams{
+			AttachmentIds:      []string{c, a, b},
+			RegistrationFormID: formID,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), affected)
+
+		rows, err := q.ListRegistrationFormAttachments(ctx, formID)
+		require.NoError(t, err)
+		require.Len(t, rows, 3)
+		assert.Equal(t, []string{c, a, b}, attachmentOrder(t, q, formID))
+		require.NotNil(t, rows[1].Caption, "caption should survive reordering")
+		assert.Equal(t, "Verwijsbrief", *rows[1].Caption)
+
+		// Other forms sharing the attachments keep their own order
+		assert.Equal(t, []string{a, b}, attachmentOrder(t, q, otherFormID))
+	})
+}
+
+func TestUpdateRegistrationFormAttachmentCaption(t *testing.T) {
+	tests := []struct {
+		name         string
+		attachmentID func(linked, unlinked string) string
+		caption      *string
+		wantAffected int64
+	}{
+		{
+			name:         "sets_caption",
+			attachmentID: func(linked, unlinked string) string { return linked },
+			caption:      strPtr("Intakeverslag huisarts"),
+			wantAffected: 1,
+		},
+		{
+			name:         "clears_caption",
+			attachmentID: func(linked, unlinked string) string { return linked },
+			caption:      nil,
+			wantAffected: 1,
+		},
+		{
+			name:         "attachment_not_linked",
+			attachmentID: func(linked, unlinked string) string { return unlinked },
+			caption:      strPtr("Ignored"),
+			wantAffected: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runTestWithTx(t, func(t *testing.T, q *Queries) {
+				ctx := context.Background()
+				formID := CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{})
+				linked := CreateTestAttachment(t, q, nil)
+				unlinked := CreateTestAttachment(t, q, nil)
+				require.NoError(t, q.SetRegistrationFormAttachments(ctx, SetRegistrationFormAttachmentsParams{
+					RegistrationFormID: formID,
+					AttachmentIds:      []string{linked},
+				}))
+				_, err := q.UpdateRegistrationFormAttachmentCaption(ctx, UpdateRegistrationFormAttachmentCaptionParams{
+					RegistrationFormID: formID,
+					AttachmentID:       linked,
+					Caption:            strPtr("Old caption"),
+				})
+				require.NoError(t, err)
+
+				affected, err := q.UpdateRegistrationFormAttachmentCaption(ctx, UpdateRegistrationFormAttachmentCaptionParams{
+					RegistrationFormID: formID,
+					AttachmentID:       tt.attachmentID(linked, unlinked),
+					Caption:            tt.caption,
+				})
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantAffected, affected)
+
+				if tt.wantAffected == 0 {
+					return
+				}
+				rows, err := q.ListRegistrationFormAttachments(ctx, formID)
+				require.NoError(t, err)
+				require.Len(t, rows, 1)
+				assert.Equal(t, tt.caption, rows[0].Caption)
+			})
+		})
+	}
+}
+
 // ============================================================
 // Test: UpdateRegistrationForm
 // ============================================================
@@ -764,23 +1139,6 @@ func TestUpdateRegistrationForm(t *testing.T) {
 				assert.Equal(t, CareTypeEnumAmbulatoryCare, form.CareType)
 			},
 		},
-		{
-			name: "update_attachment_ids",
-			setup: func(t *testing.T, q *Queries) (string, UpdateRegistrationFormParams) {
-				id := CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{})
-				return id, UpdateRegistrationFormParams{
-					ID:            id,
-					AttachmentIds: []string{"att1", "att2", "att3"},
-				}
-			},
-			wantErr: false,
-			validate: func(t *testing.T, q *Queries, id string) {
-				ctx := context.Background()
-				form, err := q.GetRegistrationForm(ctx, id)
-				require.NoError(t, err)
-				assert.Equal(t, []string{"att1", "att2", "att3"}, form.AttachmentIds)
-			},
-		},
 		{
 			name: "update_referring_org",
 			setup: func(t *testing.T, q *Queries) (string, UpdateRegistrationFormParams) {
@@ -911,8 +1269,9 @@ func TestUpdateRegistrationFormStatus(t *testing.T) {
 				id := CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{})
 				// First change to approved
 				q.UpdateRegistrationFormStatus(ctx, UpdateRegistrationFormStatusParams{
-					ID:     id,
-					Status: NullRegistrationStatusEnum{RegistrationStatusEnum: RegistrationStatusEnumApproved, Valid: true},
+					ID:        id,
+					HistoryID: generateTestID(),
+					Status:    NullRegistrationStatusEnum{RegistrationStatusEnum: RegistrationStatusEnumApproved, Valid: true},
 				})
 				return id
 			},
@@ -951,8 +1310,9 @@ func TestUpdateRegistrationFormStatus(t *testing.T) {
 				id := tt.setup(t, q)
 
 				err := q.UpdateRegistrationFormStatus(ctx, UpdateRegistrationFormStatusParams{
-					ID:     id,
-					Status: tt.status,
+					ID:        id,
+					HistoryID: generateTestID(),
+					Status:    tt.status,
 				})
 
 				if tt.wantErr {
@@ -968,3 +1328,165 @@ func TestUpdateRegistrationFormStatus(t *testing.T) {
 		})
 	}
 }
+
+// ============================================================
+// Test: GetRegistrationStatusHistory
+// ============================================================
+
+func TestGetRegistrationStatusHistory(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		userID := CreateTestUser(t, q, CreateTestUserOptions{})
+		id := CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{})
+
+		setStatus := func(status RegistrationStatusEnum, changedBy string) {
+			err := q.UpdateRegistrationFormStatus(ctx, UpdateRegistrationFormStatusParams{
+				ID:        id,
+				HistoryID: generateTestID(),
+				ChangedBy: changedBy,
+				Status:    NullRegistrationStatusEnum{RegistrationStatusEnum: status, Valid: true},
+			})
+			require.NoError(t, err)
+		}
+
+		setStatus(RegistrationStatusEnumInReview, userID)
+		setStatus(RegistrationStatusEnumRejected, userID)
+		// Setting the current status again is not a change
+		setStatus(RegistrationStatusEnumRejected, userID)
+		setStatus(RegistrationStatusEnumApproved, "")
+
+		history, err := q.GetRegistrationStatusHistory(ctx, id)
+		require.NoError(t, err)
+		require.Len(t, history, 3)
+
+		expected := []struct{ old, new RegistrationStatusEnum }{
+			{RegistrationStatusEnumPending, RegistrationStatusEnumInReview},
+			{RegistrationStatusEnumInReview, RegistrationStatusEnumRejected},
+			{RegistrationStatusEnumRejected, RegistrationStatusEnumApproved},
+		}
+		for i, want := range expected {
+			assert.Equal(t, want.old, history[i].OldStatus.RegistrationStatusEnum, "row %d", i)
+			assert.Equal(t, want.new, history[i].NewStatus.RegistrationStatusEnum, "row %d", i)
+			if i > 0 {
+				assert.False(t, history[i].ChangedAt.Time.Before(history[i-1].ChangedAt.Time))
+			}
+		}
+
+		require.NotNil(t, history[0].ChangedBy)
+		assert.Equal(t, userID, *history[0].ChangedBy)
+		assert.Nil(t, history[2].ChangedBy, "an empty actor is stored as NULL")
+	})
+}
+
+// ============================================================
+// Test: BatchUpdateRegistrationFormStatus
+// ============================================================
+
+func TestBatchUpdateRegistrationFormStatus(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+
+		deps := CreateFullClientDependencyChain(t, q)
+		withoutIntakeID := CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{})
+		deletedID := CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{})
+		require.NoError(t, q.SoftDeleteRegistrationForm(ctx, deletedID))
+
+		updated, err := q.BatchUpdateRegistrationFormStatus(ctx, BatchUpdateRegistrationFormStatusParams{
+			Ids:        []string{deps.RegistrationFormID, withoutIntakeID, deletedID, "nonexistent-id"},
+			HistoryIds: []string{generateTestID(), generateTestID(), generateTestID(), generateTestID()},
+			Status:     RegistrationStatusEnumApproved,
+			ChangedBy:  deps.UserID,
+		})
+		require.NoError(t, err)
+		require.Len(t, updated, 2, "soft-deleted and unknown forms must be skipped")
+
+		coordinators := map[string]*string{}
+		for _, row := range updated {
+			coordinators[row.ID] = row.CoordinatorUserID
+		}
+		require.Contains(t, coordinators, deps.RegistrationFormID)
+		require.NotNil(t, coordinators[deps.RegistrationFormID])
+		assert.Equal(t, deps.UserID, *coordinators[deps.RegistrationFormID])
+		require.Contains(t, coordinators, withoutIntakeID)
+		assert.Nil(t, coordinators[withoutIntakeID])
+
+		form, err := q.GetRegistrationForm(ctx, withoutIntakeID)
+		require.NoError(t, err)
+		assert.Equal(t, RegistrationStatusEnumApproved, form.Status.RegistrationStatusEnum)
+
+		deleted, err := q.GetRegistrationForm(ctx, deletedID)
+		require.NoError(t, err)
+		assert.Equal(t, RegistrationStatusEnumPending, deleted.Status.RegistrationStatusEnum)
+
+		history, err := q.GetRegistrationStatusHistory(ctx, withoutIntakeID)
+		require.NoError(t, err)
+		require.Len(t, history, 1)
+		assert.Equal(t, RegistrationStatusEnumPending, history[0].OldStatus.RegistrationStatusEnum)
+		assert.Equal(t, RegistrationStatusEnumApproved, history[0].NewStatus.RegistrationStatusEnum)
+		require.NotNil(t, history[0].ChangedBy)
+		assert.Equal(t, deps.UserID, *history[0].ChangedBy)
+
+		history, err = q.GetRegistrationStatusHistory(ctx, deletedID)
+		require.NoError(t, err)
+		assert.Empty(t, history, "skipped forms get no history")
+
+		// Setting the current status again is not a change
+		_, err = q.BatchUpdateRegistrationFormStatus(ctx, BatchUpdateRegistrationFormStatusParams{
+			Ids:        []string{withoutIntakeID},
+			HistoryIds: []string{generateTestID()},
+			Status:     RegistrationStatusEnumApproved,
+			ChangedBy:  deps.UserID,
+		})
+		require.NoError(t, err)
+		history, err = q.GetRegistrationStatusHistory(ctx, withoutIntakeID)
+		require.NoError(t, err)
+		assert.Len(t, history, 1)
+	})
+}
+
+// ============================================================
+// Test: UpdateRegistrationFormTx
+// ============================================================
+
+// UpdateRegistrationFormTx opens its own transaction, so this test runs
+// against testStore directly instead of inside runTestWithTx.
+func TestUpdateRegistrationFormTx_StatusHistory(t *testing.T) {
+	ctx := context.Background()
+	q := testStore.Queries
+
+	userID := CreateTestUser(t, q, CreateTestUserOptions{})
+	deleteAfterTest(t, "users", userID)
+	id := CreateTestRegistrationForm(t, q, CreateTestRegistrationFormOptions{})
+	deleteAfterTest(t, "registration_forms", id)
+
+	update := func(params UpdateRegistrationFormParams) {
+		params.ID = id
+		require.NoError(t, testStore.UpdateRegistrationFormTx(ctx, UpdateRegistrationFormTxParams{
+			RegistrationForm: params,
+			ChangedBy:        userID,
+		}))
+	}
+
+	update(UpdateRegistrationFormParams{
+		Status: NullRegistrationStatusEnum{RegistrationStatusEnum: RegistrationStatusEnumInReview, Valid: true},
+	})
+	// Updates without a status, or with the current one, are not changes
+	firstName := "Jan"
+	update(UpdateRegistrationFormParams{FirstName: &firstName})
+	update(UpdateRegistrationFormParams{
+		Status: NullRegistrationStatusEnum{RegistrationStatusEnum: RegistrationStatusEnumInReview, Valid: true},
+	})
+
+	form, err := q.GetRegistrationForm(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, RegistrationStatusEnumInReview, form.Status.RegistrationStatusEnum)
+	assert.Equal(t, "Jan", form.FirstName)
+
+	history, err := q.GetRegistrationStatusHistory(ctx, id)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, RegistrationStatusEnumPending, history[0].OldStatus.RegistrationStatusEnum)
+	assert.Equal(t, RegistrationStatusEnumInReview, history[0].NewStatus.RegistrationStatusEnum)
+	require.NotNil(t, history[0].ChangedBy)
+	assert.Equal(t, userID, *history[0].ChangedBy)
+}
@@ -1,11 +1,49 @@
 package db
 
-import "context"
+import (
+	"care-cordination/lib/nanoid"
+	"context"
+)
+
+type CreateRegistrationFormTxParams struct {
+	RegistrationForm CreateRegistrationFormParams
+	// Attachments are linked in this order
+	AttachmentIDs []string
+}
+
+func (s *Store) CreateRegistrationFormTx(
+	ctx context.Context,
+	arg CreateRegistrationFormTxParams,
+) error {
+	return s.ExecTx(ctx, func(q *Queries) error {
+		// 1. Create the registration form
+		if err := q.CreateRegistrationForm(ctx, arg.RegistrationForm); err != nil {
+			return err
+		}
+
+		// 2. Link the attachments
+		if len(arg.AttachmentIDs) > 0 {
+			if err := q.SetRegistrationFormAttachments(ctx, SetRegistrationFormAttachmentsParams{
+				RegistrationFormID: arg.RegistrationForm.ID,
+				AttachmentIds:      arg.AttachmentIDs,
+			}); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
 
 type UpdateRegistrationFormTxParams struct {
 	RegistrationForm UpdateRegistrationFormParams
 	// If true, also update the associated client with the relevant fields
 	UpdateClient bool
+	// If non-nil, replaces the linked attachments, in this order. Captions of
+	// attachments that remain linked are kept.
+	AttachmentIDs []string
+	// User making the change, recorded in the status history
+	ChangedBy string
 }
 
 func (s *Store) UpdateRegistrationFormTx(
@@ -13,12 +51,34 @@ func (s *Store) UpdateRegistrationFormTx(
 	arg UpdateRegistrationFormTxParams,
 ) error {
 	return s.ExecTx(ctx, func(q *Queries) error {
-		// 1. Update the registration form
+		// 1. If provided, update the status first so the history sees the old one
+		if arg.RegistrationForm.Status.Valid {
+			if err := q.UpdateRegistrationFormStatus(ctx, UpdateRegistrationFormStatusParams{
+				ID:        arg.RegistrationForm.ID,
+				Status:    arg.RegistrationForm.Status,
+				HistoryID: nanoid.Generate(),
+				ChangedBy: arg.ChangedBy,
+			}); err != nil {
+				return err
+			}
+		}
+
+		// 2. Update the registration form
 		if err := q.UpdateRegistrationForm(ctx, arg.RegistrationForm); err != nil {
 			return err
 		}
 
-		// 2. If requested, update the associated client with relevant fields
+		// 3. If provided, replace the linked attachments
+		if arg.AttachmentIDs != nil {
+			if err := q.SetRegistrationFormAttachments(ctx, SetRegistrationFormAttachmentsParams{
+				RegistrationFormID: arg.RegistrationForm.ID,
+				AttachmentIds:      arg.AttachmentIDs,
+			}); err != nil {
+				return err
+			}
+		}
+
+		// 4. If requested, update the associated client with relevant fields
 		if arg.UpdateClient {
 			if err := q.UpdateClientByRegistrationFormID(ctx, UpdateClientByRegistrationFormIDParams{
 				RegistrationFormID: arg.RegistrationForm.ID,
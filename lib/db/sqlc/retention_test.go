@@ -0,0 +1,148 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createDischargedClient creates a client with a note, discharged on dischargeDate.
+// It returns the client ID and the client's dependencies.
+func createDischargedClient(t *testing.T, q *Queries, dischargeDate time.Time) (string, ClientDependencies) {
+	t.Helper()
+	deps := CreateFullClientDependencyChain(t, q)
+
+	status := ClientStatusEnumDischarged
+	careStart := dischargeDate.AddDate(-1, 0, 0)
+	reason := DischargeReasonEnumTreatmentCompleted
+	dischargeStatus := DischargeStatusEnumCompleted
+	dob := time.Date(1985, 6, 15, 0, 0, 0, 0, time.UTC)
+	clientID := CreateTestClient(t, q, CreateTestClientOptions{
+		RegistrationFormID: deps.RegistrationFormID,
+		IntakeFormID:       deps.IntakeFormID,
+		AssignedLocationID: deps.LocationID,
+		CoordinatorID:      deps.EmployeeID,
+		DateOfBirth:        &dob,
+		PhoneNumber:        strPtr("+31612345678"),
+		Notes:              strPtr("Prefers morning appointments"),
+		Status:             &status,
+		CareStartDate:      &careStart,
+		DischargeDate:      &dischargeDate,
+		ReasonForDischarge: &reason,
+		DischargeStatus:    &dischargeStatus,
+	})
+	_, err := q.AddClientNote(context.Background(), AddClientNoteParams{
+		ID:       generateTestID(),
+		ClientID: clientID,
+		AuthorID: deps.EmployeeID,
+		Body:     "Spoke with family about the move",
+	})
+	require.NoError(t, err)
+
+	return clientID, deps
+}
+
+func TestPurgeClientPII(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		oldID, oldDeps := createDischargedClient(t, q, time.Now().AddDate(0, -30, 0))
+		recentID, _ := createDischargedClient(t, q, time.Now().AddDate(0, -6, 0))
+		recentBefore, err := q.GetClientByID(ctx, GetClientByIDParams{ID: recentID})
+		require.NoError(t, err)
+
+		due, err := q.ListClientsDueForPurge(ctx, 24)
+		require.NoError(t, err)
+		dueIDs := map[string]ListClientsDueForPurgeRow{}
+		for _, row := range due {
+			dueIDs[row.ID] = row
+		}
+		require.Contains(t, dueIDs, oldID)
+		assert.NotContains(t, dueIDs, recentID)
+
+		row := dueIDs[oldID]
+		require.NoError(t, purgeClientData(ctx, q, PurgeClientPIITxParams{
+			ClientID:           row.ID,
+			RegistrationFormID: row.RegistrationFormID,
+			IntakeFormID:       row.IntakeFormID,
+			RetentionMonths:    24,
+		}))
+
+		// Personal data is gone, statistics fields are kept
+		client, err := q.GetClientByID(ctx, GetClientByIDParams{ID: oldID})
+		require.NoError(t, err)
+		assert.Equal(t, "[verwijderd]", client.FirstName)
+		assert.Equal(t, "[verwijderd]", client.LastName)
+		assert.Empty(t, client.Bsn)
+		assert.Equal(t, "1985-01-01", client.DateOfBirth.Time.Format("2006-01-02"))
+		assert.Nil(t, client.PhoneNumber)
+		assert.Nil(t, client.Notes)
+		assert.Equal(t, ClientStatusEnumDischarged, client.Status)
+		assert.Equal(t, GenderEnumOther, client.Gender)
+		assert.True(t, client.DischargeDate.Valid)
+
+		form, err := q.GetRegistrationForm(ctx, oldDeps.RegistrationFormID)
+		require.NoError(t, err)
+		assert.Equal(t, "[verwijderd]", form.FirstName)
+		assert.Empty(t, form.Bsn)
+
+		notes, err := q.ListClientNotes(ctx, oldID)
+		require.NoError(t, err)
+		assert.Empty(t, notes)
+
+		// A purged client is not picked up again
+		due, err = q.ListClientsDueForPurge(ctx, 24)
+		require.NoError(t, err)
+		for _, row := range due {
+			assert.NotEqual(t, oldID, row.ID)
+		}
+
+		// The recently discharged client is untouched
+		recentAfter, err := q.GetClientByID(ctx, GetClientByIDParams{ID: recentID})
+		require.NoError(t, err)
+		assert.Equal(t, recentBefore.FirstName, recentAfter.FirstName)
+		assert.Equal(t, recentBefore.Bsn, recentAfter.Bsn)
+		assert.Equal(t, recentBefore.DateOfBirth, recentAfter.DateOfBirth)
+		assert.Equal(t, recentBefore.Notes, recentAfter.Notes)
+		recentNotes, err := q.ListClientNotes(ctx, recentID)
+		require.NoError(t, err)
+		assert.Len(t, recentNotes, 1)
+	})
+}
+
+func TestPurgeClientPII_SeveralForms(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		dischargeDate := time.Now().AddDate(0, -30, 0)
+		_, firstDeps := createDischargedClient(t, q, dischargeDate)
+		_, secondDeps := createDischargedClient(t, q, dischargeDate)
+
+		due, err := q.ListClientsDueForPurge(ctx, 24)
+		require.NoError(t, err)
+		purged := 0
+		for _, row := range due {
+			if row.RegistrationFormID != firstDeps.RegistrationFormID &&
+				row.RegistrationFormID != secondDeps.RegistrationFormID {
+				continue
+			}
+			// Both forms end up with an empty BSN; the active BSN index must
+			// not treat them as duplicates
+			require.NoError(t, purgeClientData(ctx, q, PurgeClientPIITxParams{
+				ClientID:           row.ID,
+				RegistrationFormID: row.RegistrationFormID,
+				IntakeFormID:       row.IntakeFormID,
+				RetentionMonths:    24,
+			}))
+			purged++
+		}
+		require.Equal(t, 2, purged)
+
+		for _, formID := range []string{firstDeps.RegistrationFormID, secondDeps.RegistrationFormID} {
+			form, err := q.GetRegistrationForm(ctx, formID)
+			require.NoError(t, err)
+			assert.Empty(t, form.Bsn)
+		}
+	})
+}
@@ -0,0 +1,39 @@
+package db
+
+import "context"
+
+type PurgeClientPIITxParams struct {
+	ClientID           string
+	RegistrationFormID string
+	IntakeFormID       string
+	// Retention policy the purge was made under, recorded with it
+	RetentionMonths int32
+}
+
+// PurgeClientPIITx removes a discharged client's personal data from the client,
+// its registration and intake forms and its notes, and records the purge so the
+// client is not picked up again.
+func (s *Store) PurgeClientPIITx(ctx context.Context, arg PurgeClientPIITxParams) error {
+	return s.ExecTx(ctx, func(q *Queries) error {
+		return purgeClientData(ctx, q, arg)
+	})
+}
+
+func purgeClientData(ctx context.Context, q *Queries, arg PurgeClientPIITxParams) error {
+	if err := q.PurgeClientPII(ctx, arg.ClientID); err != nil {
+		return err
+	}
+	if err := q.PurgeRegistrationFormPII(ctx, arg.RegistrationFormID); err != nil {
+		return err
+	}
+	if err := q.PurgeIntakeFormPII(ctx, arg.IntakeFormID); err != nil {
+		return err
+	}
+	if err := q.DeleteClientNotesByClient(ctx, arg.ClientID); err != nil {
+		return err
+	}
+	return q.RecordClientPIIPurge(ctx, RecordClientPIIPurgeParams{
+		ClientID:        arg.ClientID,
+		RetentionMonths: arg.RetentionMonths,
+	})
+}
@@ -0,0 +1,140 @@
+package db
+
+import "context"
+
+// ScopedStore runs tenant-owned reads and writes for a single organization.
+// Services get one from ForOrganization with the authenticated user's
+// organization. Each method fills the organization_id argument of the
+// underlying query, which leaves that argument NULL for unscoped callers such
+// as the worker. An empty organization ID matches no rows, so a token without
+// a tenant reads and changes nothing rather than everything.
+type ScopedStore struct {
+	q              Querier
+	organizationID string
+}
+
+func NewScopedStore(q Querier, organizationID string) *ScopedStore {
+	return &ScopedStore{q: q, organizationID: organizationID}
+}
+
+func (store *Store) ForOrganization(organizationID string) *ScopedStore {
+	return NewScopedStore(store.Queries, organizationID)
+}
+
+func (s *ScopedStore) OrganizationID() string {
+	return s.organizationID
+}
+
+// GetClient returns the client only if it belongs to the organization.
+func (s *ScopedStore) GetClient(ctx context.Context, id string) (Client, error) {
+	return s.q.GetClientByID(ctx, GetClientByIDParams{
+		ID:             id,
+		OrganizationID: &s.organizationID,
+	})
+}
+
+// GetClientByBSN returns the organization's active (not discharged) client
+// with the BSN.
+func (s *ScopedStore) GetClientByBSN(ctx context.Context, bsn string) (Client, error) {
+	return s.q.GetClientByBSNForOrganization(ctx, GetClientByBSNForOrganizationParams{
+		Bsn:            bsn,
+		OrganizationID: s.organizationID,
+	})
+}
+
+// UpdateClient returns pgx.ErrNoRows when the client belongs to another
+// organization.
+func (s *ScopedStore) UpdateClient(ctx context.Context, arg UpdateClientParams) (string, error) {
+	arg.OrganizationID = &s.organizationID
+	return s.q.UpdateClient(ctx, arg)
+}
+
+func (s *ScopedStore) SearchClients(ctx context.Context, arg SearchClientsParams) ([]SearchClientsRow, error) {
+	arg.OrganizationID = &s.organizationID
+	return s.q.SearchClients(ctx, arg)
+}
+
+func (s *ScopedStore) ListWaitingListClients(
+	ctx context.Context,
+	arg ListWaitingListClientsParams,
+) ([]ListWaitingListClientsRow, error) {
+	arg.OrganizationID = &s.organizationID
+	return s.q.ListWaitingListClients(ctx, arg)
+}
+
+func (s *ScopedStore) ListInCareClients(
+	ctx context.Context,
+	arg ListInCareClientsParams,
+) ([]ListInCareClientsRow, error) {
+	arg.OrganizationID = &s.organizationID
+	return s.q.ListInCareClients(ctx, arg)
+}
+
+func (s *ScopedStore) ListInCareClientsByCursor(
+	ctx context.Context,
+	arg ListInCareClientsByCursorParams,
+) ([]ListInCareClientsByCursorRow, error) {
+	arg.OrganizationID = &s.organizationID
+	return s.q.ListInCareClientsByCursor(ctx, arg)
+}
+
+func (s *ScopedStore) ListDischargedClients(
+	ctx context.Context,
+	arg ListDischargedClientsParams,
+) ([]ListDischargedClientsRow, error) {
+	arg.OrganizationID = &s.organizationID
+	return s.q.ListDischargedClients(ctx, arg)
+}
+
+func (s *ScopedStore) ListClientsForExport(
+	ctx context.Context,
+	arg ListClientsForExportParams,
+) ([]ListClientsForExportRow, error) {
+	arg.OrganizationID = &s.organizationID
+	return s.q.ListClientsForExport(ctx, arg)
+}
+
+// GetLocation returns the location only if it belongs to the organization.
+func (s *ScopedStore) GetLocation(ctx context.Context, id string) (GetLocationByIDRow, error) {
+	return s.q.GetLocationByID(ctx, GetLocationByIDParams{
+		ID:             id,
+		OrganizationID: &s.organizationID,
+	})
+}
+
+func (s *ScopedStore) ListLocations(ctx context.Context, arg ListLocationsParams) ([]ListLocationsRow, error) {
+	arg.OrganizationID = &s.organizationID
+	return s.q.ListLocations(ctx, arg)
+}
+
+// UpdateLocation affects no rows when the location belongs to another
+// organization.
+func (s *ScopedStore) UpdateLocation(ctx context.Context, arg UpdateLocationParams) (int64, error) {
+	arg.OrganizationID = &s.organizationID
+	return s.q.UpdateLocation(ctx, arg)
+}
+
+// SoftDeleteLocation affects no rows when the location belongs to another
+// organization.
+func (s *ScopedStore) SoftDeleteLocation(ctx context.Context, id string) (int64, error) {
+	return s.q.SoftDeleteLocation(ctx, SoftDeleteLocationParams{
+		ID:             id,
+		OrganizationID: &s.organizationID,
+	})
+}
+
+func (s *ScopedStore) GetLocationCapacityStats(ctx context.Context) (GetLocationCapacityStatsRow, error) {
+	return s.q.GetLocationCapacityStats(ctx, &s.organizationID)
+}
+
+// ListPlacementCandidates returns the organization's locations that can take a
+// client of the care type, most available beds first.
+func (s *ScopedStore) ListPlacementCandidates(
+	ctx context.Context,
+	careType CareTypeEnum,
+) ([]ListPlacementCandidatesForOrganizationRow, error) {
+	return s.q.ListPlacementCandidatesForOrganization(ctx, ListPlacementCandidatesForOrganizationParams{
+		OrganizationID: s.organizationID,
+		CareType:       careType,
+	})
+}
@@ -4,25 +4,93 @@ import (
 	"care-cordination/lib/util"
 	"context"
 	"fmt"
+	"math/rand/v2"
+	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+const (
+	DefaultTxMaxRetries   = 3
+	DefaultTxRetryBackoff = 50 * time.Millisecond
+)
+
+// TxRetryOptions bounds how ExecTxRetry retries a transaction that lost a
+// serialization conflict or a deadlock.
+type TxRetryOptions struct {
+	MaxRetries   int           // Retries after the first attempt
+	RetryBackoff time.Duration // Initial backoff, doubled after every retry and jittered
+}
+
 type Store struct {
 	*Queries
 	ConnPool *pgxpool.Pool
+	txRetry  TxRetryOptions
 }
 
 func NewStore(connPool *pgxpool.Pool) *Store {
+	return NewStoreWithTxRetry(connPool, TxRetryOptions{
+		MaxRetries:   DefaultTxMaxRetries,
+		RetryBackoff: DefaultTxRetryBackoff,
+	})
+}
+
+// NewStoreWithTxRetry creates a store whose ExecTxRetry uses the given retry
+// bounds
+func NewStoreWithTxRetry(connPool *pgxpool.Pool, opts TxRetryOptions) *Store {
+	if opts.MaxRetries < 0 {
+		opts.MaxRetries = 0
+	}
+	if opts.RetryBackoff <= 0 {
+		opts.RetryBackoff = DefaultTxRetryBackoff
+	}
 	return &Store{
 		Queries:  New(connPool),
 		ConnPool: connPool,
+		txRetry:  opts,
 	}
 }
 
 func (store *Store) ExecTx(ctx context.Context, fn func(*Queries) error) error {
+	return store.execTx(ctx, pgx.TxOptions{}, fn)
+}
+
+// ExecTxRetry runs fn in a REPEATABLE READ transaction and runs it again from
+// the start when the transaction fails with a serialization failure or a
+// deadlock. fn may therefore run more than once and must not have side effects
+// outside the transaction.
+func (store *Store) ExecTxRetry(ctx context.Context, fn func(*Queries) error) error {
+	backoff := store.txRetry.RetryBackoff
+
+	for attempt := 0; ; attempt++ {
+		err := store.execTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead}, fn)
+		if err == nil || !isRetryableTxError(err) || attempt >= store.txRetry.MaxRetries {
+			return err
+		}
+
+		// Equal jitter: wait between half and the full backoff so competing
+		// transactions do not collide again in lockstep
+		wait := backoff/2 + rand.N(backoff/2+1)
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(wait):
+		}
+		backoff *= 2
+	}
+}
+
+// isRetryableTxError reports whether a transaction failed only because it lost
+// a conflict with a concurrent one and can be run again as is
+func isRetryableTxError(err error) bool {
+	return isPgError(err, "40001") || // serialization_failure
+		isPgError(err, "40P01") // deadlock_detected
+}
+
+func (store *Store) execTx(ctx context.Context, opts pgx.TxOptions, fn func(*Queries) error) error {
 	// 1. Start a transaction
-	tx, err := store.ConnPool.Begin(ctx)
+	tx, err := store.ConnPool.BeginTx(ctx, opts)
 	if err != nil {
 		return err
 	}
@@ -45,7 +113,7 @@ func (store *Store) ExecTx(ctx context.Context, fn func(*Queries) error) error {
 	// 4. Handle Rollback or Commit
 	if err != nil {
 		if rbErr := tx.Rollback(ctx); rbErr != nil {
-			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
+			return fmt.Errorf("tx err: %w, rb err: %v", err, rbErr)
 		}
 		return err
 	}
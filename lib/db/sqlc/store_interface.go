@@ -13,16 +13,45 @@ type StoreInterface interface {
 
 	// Transaction methods
 	ExecTx(ctx context.Context, fn func(*Queries) error) error
+	// ExecTxRetry runs fn in a REPEATABLE READ transaction, rerunning it on
+	// serialization failures and deadlocks
+	ExecTxRetry(ctx context.Context, fn func(*Queries) error) error
+
+	// Tenant-scoped reads
+	ForOrganization(organizationID string) *ScopedStore
 
 	// Evaluation transaction
 	CreateEvaluationTx(ctx context.Context, params CreateEvaluationTxParams) (CreateEvaluationTxResult, error)
+	SubmitDraftEvaluationTx(ctx context.Context, arg SubmitDraftEvaluationTxParams) (SubmitDraftEvaluationTxResult, error)
 	UpdateEvaluationTx(ctx context.Context, params UpdateEvaluationTxParams) (UpdateEvaluationTxResult, error)
+	CompleteEvaluationRecordTx(ctx context.Context, arg CompleteEvaluationRecordTxParams) (CompleteEvaluationRecordTxResult, error)
 
 	// Client transaction
+	MoveClientInCareTx(ctx context.Context, arg MoveClientInCareTxParams) (MoveClientInCareTxResult, error)
 	MoveClientToWaitingListTx(ctx context.Context, arg MoveClientToWaitingListTxParams) (MoveClientToWaitingListTxResult, error)
+	ConvertIntakeToClientTx(ctx context.Context, arg ConvertIntakeToClientTxParams) (ConvertIntakeToClientTxResult, error)
 
 	// Employee transaction
 	CreateEmployeeTx(ctx context.Context, arg CreateEmployeeTxParams) error
+	SetCoordinatorAvailabilityTx(ctx context.Context, arg SetCoordinatorAvailabilityTxParams) error
+
+	// Incident transaction
+	EscalateIncidentTx(ctx context.Context, arg EscalateIncidentTxParams) (EscalateIncidentTxResult, error)
+
+	// Registration transaction
+	CreateRegistrationFormTx(ctx context.Context, arg CreateRegistrationFormTxParams) error
+	UpdateRegistrationFormTx(ctx context.Context, arg UpdateRegistrationFormTxParams) error
+
+	// RBAC transactions
+	CreateRoleWithPermissionsTx(ctx context.Context, arg CreateRoleWithPermissionsTxParams) (CreateRoleWithPermissionsTxResult, error)
+	UpdateRoleWithPermissionsTx(ctx context.Context, arg UpdateRoleWithPermissionsTxParams) (UpdateRoleWithPermissionsTxResult, error)
+	RemovePermissionFromAllRolesTx(ctx context.Context, arg RemovePermissionFromAllRolesTxParams) (RemovePermissionFromAllRolesTxResult, error)
+
+	// Data retention transaction
+	PurgeClientPIITx(ctx context.Context, arg PurgeClientPIITxParams) error
+
+	// Admin bootstrap transaction
+	BootstrapAdminTx(ctx context.Context, arg BootstrapAdminTxParams) (BootstrapAdminTxResult, error)
 }
 
 // Ensure Store implements StoreInterface
@@ -0,0 +1,138 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"care-cordination/lib/db/pool"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================
+// Test: ExecTxRetry
+// ============================================================
+
+// ExecTxRetry commits for real, so these tests run against testStore directly
+// instead of inside runTestWithTx and delete what they commit.
+
+func newRetryTestStore(maxRetries int) *Store {
+	return NewStoreWithTxRetry(testStore.ConnPool, TxRetryOptions{
+		MaxRetries:   maxRetries,
+		RetryBackoff: time.Millisecond,
+	})
+}
+
+func TestExecTxRetry_RetriesSerializationFailure(t *testing.T) {
+	ctx := context.Background()
+	store := newRetryTestStore(3)
+
+	var orgIDs []string
+	attempts := 0
+	err := store.ExecTxRetry(ctx, func(q *Queries) error {
+		attempts++
+		orgID := CreateTestOrganization(t, q)
+		orgIDs = append(orgIDs, orgID)
+		deleteAfterTest(t, "organizations", orgID)
+		if attempts == 1 {
+			return &pgconn.PgError{Code: "40001", Message: "could not serialize access"}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, attempts)
+
+	// The failed attempt was rolled back; only the retry was committed
+	assert.False(t, organizationExists(t, orgIDs[0]))
+	assert.True(t, organizationExists(t, orgIDs[1]))
+}
+
+func organizationExists(t *testing.T, id string) bool {
+	t.Helper()
+	var exists bool
+	err := testStore.ConnPool.QueryRow(context.Background(),
+		"SELECT EXISTS (SELECT 1 FROM organizations WHERE id = $1)", id).Scan(&exists)
+	require.NoError(t, err)
+	return exists
+}
+
+func TestExecTxRetry_GivesUpAfterMaxRetries(t *testing.T) {
+	store := newRetryTestStore(2)
+
+	attempts := 0
+	err := store.ExecTxRetry(context.Background(), func(q *Queries) error {
+		attempts++
+		return &pgconn.PgError{Code: "40P01", Message: "deadlock detected"}
+	})
+	require.Error(t, err)
+	assert.True(t, isRetryableTxError(err))
+	assert.Equal(t, 3, attempts)
+}
+
+func TestExecTxRetry_DoesNotRetryOtherErrors(t *testing.T) {
+	store := newRetryTestStore(3)
+	errBusiness := errors.New("location has no free capacity")
+
+	attempts := 0
+	err := store.ExecTxRetry(context.Background(), func(q *Queries) error {
+		attempts++
+		return errBusiness
+	})
+	assert.ErrorIs(t, err, errBusiness)
+	assert.Equal(t, 1, attempts)
+}
+
+// ============================================================
+// Test: statement timeout
+// ============================================================
+
+func TestStatementTimeout(t *testing.T) {
+	ctx := context.Background()
+
+	poolConfig := testStore.ConnPool.Config()
+	// One connection, so the override test also proves it is reset on release
+	poolConfig.MaxConns = 1
+	pool.ConfigureStatementTimeout(poolConfig, 50*time.Millisecond)
+	connPool, err := pgxpool.NewWithConfig(ctx, poolConfig)
+	require.NoError(t, err)
+	t.Cleanup(connPool.Close)
+
+	t.Run("slow_query_is_cancelled", func(t *testing.T) {
+		hit := new(atomic.Bool)
+		queryCtx := pool.WithStatementTimeoutHit(ctx, hit)
+
+		_, err := connPool.Exec(queryCtx, "SELECT pg_sleep(1)")
+		require.Error(t, err)
+		assert.True(t, pool.IsStatementTimeout(err))
+		assert.True(t, hit.Load(), "the request context must learn about the timeout")
+	})
+
+	t.Run("fast_query_succeeds", func(t *testing.T) {
+		_, err := connPool.Exec(ctx, "SELECT 1")
+		require.NoError(t, err)
+	})
+
+	t.Run("override_lifts_the_limit", func(t *testing.T) {
+		_, err := connPool.Exec(pool.WithStatementTimeout(ctx, 0), "SELECT pg_sleep(0.2)")
+		require.NoError(t, err)
+
+		// The connection goes back to the pool with the default timeout
+		_, err = connPool.Exec(ctx, "SELECT pg_sleep(0.2)")
+		assert.True(t, pool.IsStatementTimeout(err))
+	})
+
+	t.Run("client_cancel_is_not_a_timeout", func(t *testing.T) {
+		cancelCtx, cancel := context.WithTimeout(pool.WithStatementTimeout(ctx, 0), 20*time.Millisecond)
+		defer cancel()
+
+		_, err := connPool.Exec(cancelCtx, "SELECT pg_sleep(1)")
+		require.Error(t, err)
+		assert.False(t, pool.IsStatementTimeout(err))
+	})
+}
@@ -32,6 +32,13 @@ func IsUniqueViolation(err error) bool {
 	return isPgError(err, "23505")
 }
 
+// IsUniqueViolationOf checks if the error is a violation of the named unique
+// constraint or index.
+func IsUniqueViolationOf(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == constraint
+}
+
 // IsForeignKeyViolation checks if the error is a PostgreSQL foreign key constraint violation.
 func IsForeignKeyViolation(err error) bool {
 	return isPgError(err, "23503")
@@ -138,18 +145,64 @@ func CreateTestUser(t *testing.T, q *Queries, opts CreateTestUserOptions) string
 	return createdID
 }
 
+// ============================================================
+// Factory: Attachment
+// ============================================================
+
+// CreateTestAttachment creates an attachment record for testing. Returns its ID.
+func CreateTestAttachment(t *testing.T, q *Queries, uploadedBy *string) string {
+	t.Helper()
+	ctx := context.Background()
+
+	id := generateTestID()
+	err := q.CreateAttachment(ctx, CreateAttachmentParams{
+		ID:          id,
+		Filekey:     fmt.Sprintf("test/%s.pdf", id),
+		ContentType: "application/pdf",
+		UploadedBy:  uploadedBy,
+		Filename:    strPtr(fmt.Sprintf("%s.pdf", id)),
+	})
+	if err != nil {
+		t.Fatalf("CreateTestAttachment failed: %v", err)
+	}
+
+	return id
+}
+
+// ============================================================
+// Factory: Organization
+// ============================================================
+
+// CreateTestOrganization creates an organization for testing. Returns its ID.
+func CreateTestOrganization(t *testing.T, q *Queries) string {
+	t.Helper()
+	ctx := context.Background()
+
+	id := generateTestID()
+	err := q.CreateOrganization(ctx, CreateOrganizationParams{
+		ID:   id,
+		Name: fmt.Sprintf("Test Organization %s", id[:8]),
+	})
+	if err != nil {
+		t.Fatalf("CreateTestOrganization failed: %v", err)
+	}
+
+	return id
+}
+
 // ============================================================
 // Factory: Location
 // ============================================================
 
 // CreateTestLocationOptions configures a test location.
 type CreateTestLocationOptions struct {
-	ID         *string
-	Name       *string
-	PostalCode *string
-	Address    *string
-	Capacity   *int32
-	Occupied   *int32
+	ID             *string
+	Name           *string
+	PostalCode     *string
+	Address        *string
+	Capacity       *int32
+	Occupied       *int32
+	OrganizationID *string
 }
 
 // CreateTestLocation creates a location for testing.
@@ -188,12 +241,13 @@ func CreateTestLocation(t *testing.T, q *Queries, opts CreateTestLocationOptions
 	}
 
 	err := q.CreateLocation(ctx, CreateLocationParams{
-		ID:         id,
-		Name:       name,
-		PostalCode: postalCode,
-		Address:    address,
-		Capacity:   capacity,
-		Occupied:   occupied,
+		ID:             id,
+		Name:           name,
+		PostalCode:     postalCode,
+		Address:        address,
+		Capacity:       capacity,
+		Occupied:       occupied,
+		OrganizationID: opts.OrganizationID,
 	})
 	if err != nil {
 		t.Fatalf("CreateTestLocation failed: %v", err)
@@ -540,6 +594,7 @@ type CreateTestClientOptions struct {
 	DischargeDate       *time.Time
 	ReasonForDischarge  *DischargeReasonEnum
 	DischargeStatus     *DischargeStatusEnum
+	OrganizationID      *string
 }
 
 // CreateTestClient creates a client for testing.
@@ -622,6 +677,7 @@ func CreateTestClient(t *testing.T, q *Queries, opts CreateTestClientOptions) st
 		Limitations:         opts.Limitations,
 		FocusAreas:          opts.FocusAreas,
 		Notes:               opts.Notes,
+		OrganizationID:      opts.OrganizationID,
 	})
 	if err != nil {
 		t.Fatalf("CreateTestClient failed: %v", err)
@@ -928,6 +984,7 @@ type CreateTestIncidentOptions struct {
 	IncidentTime        *time.Time
 	IncidentType        *IncidentTypeEnum
 	IncidentSeverity    *IncidentSeverityEnum
+	IncidentCategory    *IncidentCategoryEnum
 	LocationID          string // Required
 	CoordinatorID       string // Required
 	IncidentDescription *string
@@ -977,6 +1034,11 @@ func CreateTestIncident(t *testing.T, q *Queries, opts CreateTestIncidentOptions
 		incidentSeverity = *opts.IncidentSeverity
 	}
 
+	incidentCategory := IncidentCategoryEnumOther
+	if opts.IncidentCategory != nil {
+		incidentCategory = *opts.IncidentCategory
+	}
+
 	description := "Test incident description"
 	if opts.IncidentDescription != nil {
 		description = *opts.IncidentDescription
@@ -999,6 +1061,7 @@ func CreateTestIncident(t *testing.T, q *Queries, opts CreateTestIncidentOptions
 		IncidentTime:        toPgTime(incidentTime),
 		IncidentType:        incidentType,
 		IncidentSeverity:    incidentSeverity,
+		IncidentCategory:    incidentCategory,
 		LocationID:          opts.LocationID,
 		CoordinatorID:       opts.CoordinatorID,
 		IncidentDescription: description,
@@ -7,26 +7,34 @@ package db
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const createUser = `-- name: CreateUser :one
 
-INSERT INTO users (id, email, password_hash) 
-VALUES ($1, $2, $3)
+INSERT INTO users (id, email, password_hash, organization_id)
+VALUES ($1, $2, $3, $4)
 RETURNING id
 `
 
 type CreateUserParams struct {
-	ID           string `json:"id"`
-	Email        string `json:"email"`
-	PasswordHash string `json:"password_hash"`
+	ID             string  `json:"id"`
+	Email          string  `json:"email"`
+	PasswordHash   string  `json:"password_hash"`
+	OrganizationID *string `json:"organization_id"`
 }
 
 // ============================================================
 // Users
 // ============================================================
 func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (string, error) {
-	row := q.db.QueryRow(ctx, createUser, arg.ID, arg.Email, arg.PasswordHash)
+	row := q.db.QueryRow(ctx, createUser,
+		arg.ID,
+		arg.Email,
+		arg.PasswordHash,
+		arg.OrganizationID,
+	)
 	var id string
 	err := row.Scan(&id)
 	return id, err
@@ -65,7 +73,7 @@ func (q *Queries) EnableUserMFA(ctx context.Context, arg EnableUserMFAParams) er
 }
 
 const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT id, email, password_hash, is_mfa_enabled, mfa_secret, mfa_backup_codes, created_at, updated_at FROM users WHERE email = $1
+SELECT id, email, password_hash, is_mfa_enabled, mfa_secret, mfa_backup_codes, failed_login_attempts, locked_until, last_login_at, created_at, updated_at, organization_id FROM users WHERE email = $1
 `
 
 func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error) {
@@ -78,14 +86,18 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error
 		&i.IsMfaEnabled,
 		&i.MfaSecret,
 		&i.MfaBackupCodes,
+		&i.FailedLoginAttempts,
+		&i.LockedUntil,
+		&i.LastLoginAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.OrganizationID,
 	)
 	return i, err
 }
 
 const getUserByID = `-- name: GetUserByID :one
-SELECT id, email, password_hash, is_mfa_enabled, mfa_secret, mfa_backup_codes, created_at, updated_at FROM users WHERE id = $1
+SELECT id, email, password_hash, is_mfa_enabled, mfa_secret, mfa_backup_codes, failed_login_attempts, locked_until, last_login_at, created_at, updated_at, organization_id FROM users WHERE id = $1
 `
 
 func (q *Queries) GetUserByID(ctx context.Context, id string) (User, error) {
@@ -98,8 +110,12 @@ func (q *Queries) GetUserByID(ctx context.Context, id string) (User, error) {
 		&i.IsMfaEnabled,
 		&i.MfaSecret,
 		&i.MfaBackupCodes,
+		&i.FailedLoginAttempts,
+		&i.LockedUntil,
+		&i.LastLoginAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.OrganizationID,
 	)
 	return i, err
 }
@@ -129,6 +145,68 @@ func (q *Queries) GetUserMFAState(ctx context.Context, id string) (GetUserMFASta
 	return i, err
 }
 
+const recordFailedLogin = `-- name: RecordFailedLogin :one
+UPDATE users SET
+    failed_login_attempts = CASE
+        WHEN failed_login_attempts + 1 >= $1::int THEN 0
+        ELSE failed_login_attempts + 1
+    END,
+    locked_until = CASE
+        WHEN failed_login_attempts + 1 >= $1::int
+            THEN now() + make_interval(secs => $2::int)
+        ELSE locked_until
+    END,
+    updated_at = now()
+WHERE id = $3
+RETURNING failed_login_attempts, locked_until
+`
+
+type RecordFailedLoginParams struct {
+	MaxAttempts    int32  `json:"max_attempts"`
+	LockoutSeconds int32  `json:"lockout_seconds"`
+	ID             string `json:"id"`
+}
+
+type RecordFailedLoginRow struct {
+	FailedLoginAttempts int32              `json:"failed_login_attempts"`
+	LockedUntil         pgtype.Timestamptz `json:"locked_until"`
+}
+
+// Counts a failed password attempt. Once max_attempts is reached the account
+// is locked for lockout_seconds and the counter starts over.
+func (q *Queries) RecordFailedLogin(ctx context.Context, arg RecordFailedLoginParams) (RecordFailedLoginRow, error) {
+	row := q.db.QueryRow(ctx, recordFailedLogin, arg.MaxAttempts, arg.LockoutSeconds, arg.ID)
+	var i RecordFailedLoginRow
+	err := row.Scan(&i.FailedLoginAttempts, &i.LockedUntil)
+	return i, err
+}
+
+const recordLogin = `-- name: RecordLogin :exec
+UPDATE users SET
+    last_login_at = now()
+WHERE id = $1
+`
+
+// Stamps a completed sign-in; a password login pending MFA does not count.
+func (q *Queries) RecordLogin(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, recordLogin, id)
+	return err
+}
+
+const resetFailedLogins = `-- name: ResetFailedLogins :exec
+UPDATE users SET
+    failed_login_attempts = 0,
+    locked_until = NULL,
+    updated_at = now()
+WHERE id = $1
+  AND (failed_login_attempts > 0 OR locked_until IS NOT NULL)
+`
+
+func (q *Queries) ResetFailedLogins(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, resetFailedLogins, id)
+	return err
+}
+
 const updateUser = `-- name: UpdateUser :exec
 UPDATE users SET 
     email = COALESCE($2, email),
@@ -148,6 +226,23 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) error {
 	return err
 }
 
+const updateUserEmail = `-- name: UpdateUserEmail :exec
+UPDATE users SET
+    email = $2,
+    updated_at = now()
+WHERE id = $1
+`
+
+type UpdateUserEmailParams struct {
+	ID    string `json:"id"`
+	Email string `json:"email"`
+}
+
+func (q *Queries) UpdateUserEmail(ctx context.Context, arg UpdateUserEmailParams) error {
+	_, err := q.db.Exec(ctx, updateUserEmail, arg.ID, arg.Email)
+	return err
+}
+
 const updateUserMFASecret = `-- name: UpdateUserMFASecret :exec
 UPDATE users SET
     mfa_secret = $2,
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/stretchr/testify/assert"
@@ -177,6 +178,99 @@ func TestGetUserByEmail(t *testing.T) {
 	}
 }
 
+// ============================================================
+// Test: RecordFailedLogin / ResetFailedLogins
+// ============================================================
+
+func TestRecordFailedLogin(t *testing.T) {
+	params := func(id string) RecordFailedLoginParams {
+		return RecordFailedLoginParams{MaxAttempts: 3, LockoutSeconds: 600, ID: id}
+	}
+
+	t.Run("counts_attempts_below_threshold", func(t *testing.T) {
+		runTestWithTx(t, func(t *testing.T, q *Queries) {
+			ctx := context.Background()
+			id := CreateTestUser(t, q, CreateTestUserOptions{})
+
+			for want := int32(1); want <= 2; want++ {
+				row, err := q.RecordFailedLogin(ctx, params(id))
+				require.NoError(t, err)
+				assert.Equal(t, want, row.FailedLoginAttempts)
+				assert.False(t, row.LockedUntil.Valid)
+			}
+		})
+	})
+
+	t.Run("locks_account_at_threshold", func(t *testing.T) {
+		runTestWithTx(t, func(t *testing.T, q *Queries) {
+			ctx := context.Background()
+			id := CreateTestUser(t, q, CreateTestUserOptions{})
+
+			var row RecordFailedLoginRow
+			var err error
+			for range 3 {
+				row, err = q.RecordFailedLogin(ctx, params(id))
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, int32(0), row.FailedLoginAttempts)
+			require.True(t, row.LockedUntil.Valid)
+			assert.WithinDuration(t, time.Now().Add(10*time.Minute), row.LockedUntil.Time, time.Minute)
+
+			user, err := q.GetUserByID(ctx, id)
+			require.NoError(t, err)
+			assert.Equal(t, row.LockedUntil.Time, user.LockedUntil.Time)
+		})
+	})
+
+	t.Run("unknown_user", func(t *testing.T) {
+		runTestWithTx(t, func(t *testing.T, q *Queries) {
+			_, err := q.RecordFailedLogin(context.Background(), params("missing-user"))
+			assert.True(t, errors.Is(err, pgx.ErrNoRows), "expected ErrNoRows, got: %v", err)
+		})
+	})
+}
+
+func TestResetFailedLogins(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		id := CreateTestUser(t, q, CreateTestUserOptions{})
+
+		for range 2 {
+			_, err := q.RecordFailedLogin(ctx, RecordFailedLoginParams{
+				MaxAttempts:    2,
+				LockoutSeconds: 60,
+				ID:             id,
+			})
+			require.NoError(t, err)
+		}
+
+		require.NoError(t, q.ResetFailedLogins(ctx, id))
+
+		user, err := q.GetUserByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, int32(0), user.FailedLoginAttempts)
+		assert.False(t, user.LockedUntil.Valid)
+	})
+}
+
+func TestRecordLogin(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+		id := CreateTestUser(t, q, CreateTestUserOptions{})
+
+		user, err := q.GetUserByID(ctx, id)
+		require.NoError(t, err)
+		assert.False(t, user.LastLoginAt.Valid)
+
+		require.NoError(t, q.RecordLogin(ctx, id))
+
+		user, err = q.GetUserByID(ctx, id)
+		require.NoError(t, err)
+		assert.True(t, user.LastLoginAt.Valid)
+	})
+}
+
 // ============================================================
 // Test: Factory Functions
 // ============================================================
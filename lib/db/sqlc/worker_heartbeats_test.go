@@ -0,0 +1,32 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================
+// Test: RecordWorkerHeartbeat / GetWorkerHeartbeat
+// ============================================================
+
+func TestWorkerHeartbeat(t *testing.T) {
+	runTestWithTx(t, func(t *testing.T, q *Queries) {
+		ctx := context.Background()
+
+		_, err := q.GetWorkerHeartbeat(ctx, "notification")
+		require.ErrorIs(t, err, pgx.ErrNoRows)
+
+		// Recording twice keeps a single row per worker
+		require.NoError(t, q.RecordWorkerHeartbeat(ctx, "notification"))
+		require.NoError(t, q.RecordWorkerHeartbeat(ctx, "notification"))
+
+		heartbeat, err := q.GetWorkerHeartbeat(ctx, "notification")
+		require.NoError(t, err)
+		assert.Equal(t, "notification", heartbeat.WorkerName)
+		assert.True(t, heartbeat.LastRunAt.Valid)
+	})
+}
@@ -0,0 +1,75 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================
+// Test: AcquireWorkerLease / ReleaseWorkerLease
+// ============================================================
+
+func TestWorkerLease(t *testing.T) {
+	acquire := func(q *Queries, holder string, seconds int32) (WorkerLease, error) {
+		return q.AcquireWorkerLease(context.Background(), AcquireWorkerLeaseParams{
+			WorkerName:   "notification",
+			Holder:       holder,
+			LeaseSeconds: seconds,
+		})
+	}
+
+	t.Run("held_lease_blocks_other_holders", func(t *testing.T) {
+		runTestWithTx(t, func(t *testing.T, q *Queries) {
+			lease, err := acquire(q, "replica-a", 60)
+			require.NoError(t, err)
+			assert.Equal(t, "replica-a", lease.Holder)
+
+			_, err = acquire(q, "replica-b", 60)
+			require.ErrorIs(t, err, pgx.ErrNoRows)
+
+			// The holder renews its own lease
+			renewed, err := acquire(q, "replica-a", 60)
+			require.NoError(t, err)
+			assert.Equal(t, "replica-a", renewed.Holder)
+		})
+	})
+
+	t.Run("expired_lease_is_taken_over", func(t *testing.T) {
+		runTestWithTx(t, func(t *testing.T, q *Queries) {
+			_, err := acquire(q, "replica-a", 0)
+			require.NoError(t, err)
+
+			lease, err := acquire(q, "replica-b", 60)
+			require.NoError(t, err)
+			assert.Equal(t, "replica-b", lease.Holder)
+		})
+	})
+
+	t.Run("release_frees_lease", func(t *testing.T) {
+		runTestWithTx(t, func(t *testing.T, q *Queries) {
+			ctx := context.Background()
+			_, err := acquire(q, "replica-a", 60)
+			require.NoError(t, err)
+
+			// Releasing someone else's lease is a no-op
+			require.NoError(t, q.ReleaseWorkerLease(ctx, ReleaseWorkerLeaseParams{
+				WorkerName: "notification",
+				Holder:     "replica-b",
+			}))
+			_, err = acquire(q, "replica-b", 60)
+			require.ErrorIs(t, err, pgx.ErrNoRows)
+
+			require.NoError(t, q.ReleaseWorkerLease(ctx, ReleaseWorkerLeaseParams{
+				WorkerName: "notification",
+				Holder:     "replica-a",
+			}))
+			lease, err := acquire(q, "replica-b", 60)
+			require.NoError(t, err)
+			assert.Equal(t, "replica-b", lease.Holder)
+		})
+	})
+}
@@ -0,0 +1,73 @@
+package email
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+)
+
+// Sender delivers plain-text emails.
+//
+//go:generate mockgen -destination=mocks/mock_sender.go -package=mocks care-cordination/lib/email Sender
+type Sender interface {
+	Send(ctx context.Context, to, subject, body string) error
+}
+
+// SMTPConfig holds the connection settings of the outgoing mail server.
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string // Optional; PLAIN auth is used when set
+	Password string
+	From     string
+}
+
+type smtpSender struct {
+	cfg  SMTPConfig
+	addr string
+	auth smtp.Auth
+}
+
+// NewSMTPSender returns a Sender that delivers through the given SMTP server.
+// STARTTLS is used when the server offers it.
+func NewSMTPSender(cfg SMTPConfig) Sender {
+	s := &smtpSender{
+		cfg:  cfg,
+		addr: net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port)),
+	}
+	if cfg.Username != "" {
+		s.auth = smtp.PlainAuth("", cfg.Username, cfg.Password, cfg.Host)
+	}
+	return s
+}
+
+func (s *smtpSender) Send(ctx context.Context, to, subject, body string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := smtp.SendMail(s.addr, s.auth, s.cfg.From, []string{to}, buildMessage(s.cfg.From, to, subject, body)); err != nil {
+		return fmt.Errorf("send email to %s: %w", to, err)
+	}
+	return nil
+}
+
+// buildMessage formats a minimal RFC 5322 message. Header values are stripped
+// of line breaks so user-controlled titles cannot inject extra headers.
+func buildMessage(from, to, subject, body string) []byte {
+	var b strings.Builder
+	b.WriteString("From: " + headerValue(from) + "\r\n")
+	b.WriteString("To: " + headerValue(to) + "\r\n")
+	b.WriteString("Subject: " + headerValue(subject) + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(body)
+	return []byte(b.String())
+}
+
+func headerValue(v string) string {
+	return strings.NewReplacer("\r", " ", "\n", " ").Replace(v)
+}
@@ -0,0 +1,75 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBuildMessage(t *testing.T) {
+	msg := string(buildMessage("noreply@example.com", "jan@example.com", "Incident\r\nBcc: x@example.com", "Body text"))
+
+	if !strings.HasPrefix(msg, "From: noreply@example.com\r\nTo: jan@example.com\r\n") {
+		t.Errorf("unexpected headers: %q", msg)
+	}
+	if strings.Contains(msg, "\r\nBcc:") {
+		t.Errorf("subject line break was not stripped: %q", msg)
+	}
+	if !strings.HasSuffix(msg, "\r\n\r\nBody text") {
+		t.Errorf("body not separated from headers: %q", msg)
+	}
+}
+
+// flakySender returns err for its first failures sends, then succeeds
+type flakySender struct {
+	failures int
+	err      error
+	calls    int
+}
+
+func (s *flakySender) Send(ctx context.Context, to, subject, body string) error {
+	s.calls++
+	if s.calls <= s.failures {
+		return s.err
+	}
+	return nil
+}
+
+func TestWithRetry(t *testing.T) {
+	transient := &textproto.Error{Code: 421, Msg: "service not available"}
+	policy := RetryPolicy{Attempts: 3, Backoff: time.Millisecond}
+
+	t.Run("delivers_after_transient_failures", func(t *testing.T) {
+		inner := &flakySender{failures: 2, err: transient}
+		if err := WithRetry(inner, policy).Send(context.Background(), "jan@example.com", "s", "b"); err != nil {
+			t.Fatalf("expected delivery, got %v", err)
+		}
+		if inner.calls != 3 {
+			t.Errorf("expected 3 attempts, got %d", inner.calls)
+		}
+	})
+
+	t.Run("gives_up_after_attempts", func(t *testing.T) {
+		inner := &flakySender{failures: 5, err: transient}
+		err := WithRetry(inner, policy).Send(context.Background(), "jan@example.com", "s", "b")
+		if !errors.Is(err, transient) {
+			t.Fatalf("expected the last send error, got %v", err)
+		}
+		if inner.calls != 3 {
+			t.Errorf("expected 3 attempts, got %d", inner.calls)
+		}
+	})
+
+	t.Run("permanent_rejection_is_not_retried", func(t *testing.T) {
+		inner := &flakySender{failures: 5, err: &textproto.Error{Code: 550, Msg: "mailbox unavailable"}}
+		if err := WithRetry(inner, policy).Send(context.Background(), "jan@example.com", "s", "b"); err == nil {
+			t.Fatal("expected an error")
+		}
+		if inner.calls != 1 {
+			t.Errorf("expected 1 attempt, got %d", inner.calls)
+		}
+	})
+}
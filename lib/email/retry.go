@@ -0,0 +1,67 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/textproto"
+	"time"
+)
+
+// RetryPolicy bounds how often a failed send is tried again.
+type RetryPolicy struct {
+	// Attempts is the total number of tries, including the first
+	Attempts int
+	// Backoff is the wait before the first retry; it doubles after every
+	// further failure
+	Backoff time.Duration
+}
+
+// DefaultRetryPolicy tries three times, waiting one and then two seconds.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{Attempts: 3, Backoff: time.Second}
+}
+
+type retrySender struct {
+	next   Sender
+	policy RetryPolicy
+}
+
+// WithRetry returns a Sender that retries failed sends of next with
+// exponential backoff. Permanent SMTP rejections (5xx replies) are not
+// retried. Once it gives up the last error is returned.
+func WithRetry(next Sender, policy RetryPolicy) Sender {
+	if policy.Attempts < 1 {
+		policy.Attempts = 1
+	}
+	return &retrySender{next: next, policy: policy}
+}
+
+func (s *retrySender) Send(ctx context.Context, to, subject, body string) error {
+	backoff := s.policy.Backoff
+	for attempt := 1; ; attempt++ {
+		err := s.next.Send(ctx, to, subject, body)
+		if err == nil {
+			return nil
+		}
+		if attempt >= s.policy.Attempts || isPermanent(err) {
+			return fmt.Errorf("gave up after %d attempt(s): %w", attempt, err)
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("gave up after %d attempt(s): %w", attempt, err)
+		case <-timer.C:
+		}
+		backoff *= 2
+	}
+}
+
+// isPermanent reports whether the server rejected the message outright, so
+// sending it again cannot succeed
+func isPermanent(err error) bool {
+	var protoErr *textproto.Error
+	return errors.As(err, &protoErr) && protoErr.Code >= 500
+}
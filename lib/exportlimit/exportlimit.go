@@ -0,0 +1,58 @@
+// Package exportlimit bounds how many exports run at once. Exports past the
+// concurrency limit wait in a bounded queue for a free slot; once the queue is
+// full as well they are turned away with ErrQueueFull instead of piling onto
+// the database.
+package exportlimit
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrQueueFull is returned by Acquire when every slot is taken and the queue
+// has no room left
+var ErrQueueFull = errors.New("export queue is full")
+
+// Limiter hands out export slots. The zero value is not usable; use New.
+type Limiter struct {
+	// slots holds one token per running export
+	slots chan struct{}
+	// admitted holds one token per running or queued export
+	admitted chan struct{}
+}
+
+// New returns a Limiter running at most concurrency exports at once with up
+// to queueSize more waiting for a slot
+func New(concurrency, queueSize int) *Limiter {
+	return &Limiter{
+		slots:    make(chan struct{}, concurrency),
+		admitted: make(chan struct{}, concurrency+queueSize),
+	}
+}
+
+// Acquire blocks until an export slot is free and returns the function that
+// gives it back. It fails with ErrQueueFull straight away when the queue is
+// full, and with ctx's error when ctx ends while the export is still queued.
+func (l *Limiter) Acquire(ctx context.Context) (release func(), err error) {
+	select {
+	case l.admitted <- struct{}{}:
+	default:
+		return nil, ErrQueueFull
+	}
+
+	select {
+	case l.slots <- struct{}{}:
+	case <-ctx.Done():
+		<-l.admitted
+		return nil, ctx.Err()
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			<-l.slots
+			<-l.admitted
+		})
+	}, nil
+}
@@ -0,0 +1,79 @@
+package exportlimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimiterAcquire(t *testing.T) {
+	t.Run("queues_past_concurrency_and_rejects_past_queue", func(t *testing.T) {
+		limiter := New(2, 1)
+		ctx := context.Background()
+
+		first, err := limiter.Acquire(ctx)
+		require.NoError(t, err)
+		second, err := limiter.Acquire(ctx)
+		require.NoError(t, err)
+
+		// The third export waits for a slot instead of running
+		acquired := make(chan func(), 1)
+		go func() {
+			release, err := limiter.Acquire(ctx)
+			assert.NoError(t, err)
+			acquired <- release
+		}()
+		require.Eventually(t, func() bool { return len(limiter.admitted) == 3 }, time.Second, time.Millisecond)
+		select {
+		case <-acquired:
+			t.Fatal("export ran past the concurrency limit")
+		default:
+		}
+
+		// The queue is full, so a fourth is turned away
+		_, err = limiter.Acquire(ctx)
+		assert.ErrorIs(t, err, ErrQueueFull)
+
+		// Finishing an export lets the queued one start
+		first()
+		var third func()
+		select {
+		case third = <-acquired:
+		case <-time.After(time.Second):
+			t.Fatal("queued export never started")
+		}
+
+		second()
+		third()
+		assert.Empty(t, limiter.slots)
+		assert.Empty(t, limiter.admitted)
+	})
+
+	t.Run("release_is_idempotent", func(t *testing.T) {
+		limiter := New(1, 0)
+		release, err := limiter.Acquire(context.Background())
+		require.NoError(t, err)
+		release()
+		release()
+
+		again, err := limiter.Acquire(context.Background())
+		require.NoError(t, err)
+		again()
+	})
+
+	t.Run("cancelled_while_queued_frees_its_place", func(t *testing.T) {
+		limiter := New(1, 1)
+		release, err := limiter.Acquire(context.Background())
+		require.NoError(t, err)
+		defer release()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err = limiter.Acquire(ctx)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Len(t, limiter.admitted, 1)
+	})
+}
@@ -0,0 +1,136 @@
+package featureflags
+
+import (
+	db "care-cordination/lib/db/sqlc"
+	"care-cordination/lib/logger"
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// Known flag keys
+const (
+	// DigestNotifications sends non-urgent worker notifications at low priority,
+	// which the notification routing holds back for the digest
+	DigestNotifications = "digest_notifications"
+	// GeoPlacement enables distance-based placement suggestions
+	GeoPlacement = "geo_placement"
+)
+
+// DefaultCacheTTL is how long a flag is served from memory before it is read again
+const DefaultCacheTTL = time.Minute
+
+// Flag is the state of a single feature flag
+type Flag struct {
+	Key     string
+	Enabled bool
+	// Value is optional JSON configuration for the feature
+	Value json.RawMessage
+}
+
+// FeatureFlags answers whether experimental features are switched on.
+// Flags that are not in the table are off.
+//
+//go:generate mockgen -destination=mocks/mock_feature_flags.go -package=mocks care-cordination/lib/featureflags FeatureFlags
+type FeatureFlags interface {
+	// Get returns the flag, or a disabled flag when it is unknown
+	Get(ctx context.Context, key string) Flag
+	IsEnabled(ctx context.Context, key string) bool
+	// Set stores the flag; the new state is visible in this process right away
+	// and in other processes once their cached copy expires
+	Set(ctx context.Context, key string, enabled bool, value json.RawMessage) (Flag, error)
+}
+
+type cachedFlag struct {
+	flag      Flag
+	fetchedAt time.Time
+}
+
+type featureFlags struct {
+	store  db.StoreInterface
+	logger logger.Logger
+	ttl    time.Duration
+	now    func() time.Time
+
+	mu    sync.RWMutex
+	cache map[string]cachedFlag
+}
+
+// NewFeatureFlags creates a FeatureFlags that caches every flag for ttl
+func NewFeatureFlags(store db.StoreInterface, l logger.Logger, ttl time.Duration) FeatureFlags {
+	if ttl <= 0 {
+		ttl = DefaultCacheTTL
+	}
+	return &featureFlags{
+		store:  store,
+		logger: l,
+		ttl:    ttl,
+		now:    time.Now,
+		cache:  make(map[string]cachedFlag),
+	}
+}
+
+func (f *featureFlags) Get(ctx context.Context, key string) Flag {
+	f.mu.RLock()
+	cached, ok := f.cache[key]
+	f.mu.RUnlock()
+	if ok && f.now().Sub(cached.fetchedAt) < f.ttl {
+		return cached.flag
+	}
+
+	row, err := f.store.GetFeatureFlag(ctx, key)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return f.put(Flag{Key: key})
+		}
+		f.logger.Error(ctx, "FeatureFlags", "Failed to read feature flag",
+			zap.String("key", key),
+			zap.Error(err),
+		)
+		// Keep the last known state rather than switching features off on a
+		// blip, and wait a full TTL before asking the database again so an
+		// outage is not met with a read on every call
+		if ok {
+			return f.put(cached.flag)
+		}
+		return f.put(Flag{Key: key})
+	}
+
+	return f.put(toFlag(row))
+}
+
+func (f *featureFlags) IsEnabled(ctx context.Context, key string) bool {
+	return f.Get(ctx, key).Enabled
+}
+
+func (f *featureFlags) Set(ctx context.Context, key string, enabled bool, value json.RawMessage) (Flag, error) {
+	row, err := f.store.SetFeatureFlag(ctx, db.SetFeatureFlagParams{
+		Key:     key,
+		Enabled: enabled,
+		Value:   value,
+	})
+	if err != nil {
+		return Flag{}, err
+	}
+	return f.put(toFlag(row)), nil
+}
+
+func (f *featureFlags) put(flag Flag) Flag {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.cache[flag.Key] = cachedFlag{flag: flag, fetchedAt: f.now()}
+	return flag
+}
+
+func toFlag(row db.FeatureFlag) Flag {
+	return Flag{
+		Key:     row.Key,
+		Enabled: row.Enabled,
+		Value:   json.RawMessage(row.Value),
+	}
+}
@@ -0,0 +1,198 @@
+package featureflags
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	db "care-cordination/lib/db/sqlc"
+	dbmocks "care-cordination/lib/db/sqlc/mocks"
+	loggermocks "care-cordination/lib/logger/mocks"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// fakeClock lets tests move time forward past the cache TTL
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func newTestFlags(t *testing.T, ttl time.Duration) (*featureFlags, *dbmocks.MockStoreInterface, *fakeClock) {
+	ctrl := gomock.NewController(t)
+	mockStore := dbmocks.NewMockStoreInterface(ctrl)
+	mockLogger := loggermocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Error(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	clock := &fakeClock{now: time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)}
+	flags := NewFeatureFlags(mockStore, mockLogger, ttl).(*featureFlags)
+	flags.now = clock.Now
+	return flags, mockStore, clock
+}
+
+func TestGet(t *testing.T) {
+	tests := []struct {
+		name        string
+		setup       func(mockStore *dbmocks.MockStoreInterface)
+		wantEnabled bool
+		wantValue   json.RawMessage
+	}{
+		{
+			name: "enabled_with_value",
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					GetFeatureFlag(gomock.Any(), DigestNotifications).
+					Return(db.FeatureFlag{Key: DigestNotifications, Enabled: true, Value: []byte(`{"hour":8}`)}, nil)
+			},
+			wantEnabled: true,
+			wantValue:   json.RawMessage(`{"hour":8}`),
+		},
+		{
+			name: "unknown_flag_is_off",
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					GetFeatureFlag(gomock.Any(), DigestNotifications).
+					Return(db.FeatureFlag{}, pgx.ErrNoRows)
+			},
+			wantEnabled: false,
+		},
+		{
+			name: "read_error_is_off",
+			setup: func(mockStore *dbmocks.MockStoreInterface) {
+				mockStore.EXPECT().
+					GetFeatureFlag(gomock.Any(), DigestNotifications).
+					Return(db.FeatureFlag{}, assert.AnError)
+			},
+			wantEnabled: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flags, mockStore, _ := newTestFlags(t, time.Minute)
+			tt.setup(mockStore)
+
+			flag := flags.Get(context.Background(), DigestNotifications)
+
+			assert.Equal(t, DigestNotifications, flag.Key)
+			assert.Equal(t, tt.wantEnabled, flag.Enabled)
+			assert.Equal(t, tt.wantValue, flag.Value)
+		})
+	}
+}
+
+func TestIsEnabled_RefreshesAfterTTL(t *testing.T) {
+	flags, mockStore, clock := newTestFlags(t, time.Minute)
+	ctx := context.Background()
+
+	gomock.InOrder(
+		mockStore.EXPECT().
+			GetFeatureFlag(gomock.Any(), GeoPlacement).
+			Return(db.FeatureFlag{Key: GeoPlacement, Enabled: false}, nil),
+		mockStore.EXPECT().
+			GetFeatureFlag(gomock.Any(), GeoPlacement).
+			Return(db.FeatureFlag{Key: GeoPlacement, Enabled: true}, nil),
+	)
+
+	assert.False(t, flags.IsEnabled(ctx, GeoPlacement))
+
+	// Served from the cache while the TTL has not passed
+	clock.now = clock.now.Add(59 * time.Second)
+	assert.False(t, flags.IsEnabled(ctx, GeoPlacement))
+
+	clock.now = clock.now.Add(time.Second)
+	assert.True(t, flags.IsEnabled(ctx, GeoPlacement))
+}
+
+func TestIsEnabled_KeepsLastKnownStateOnReadError(t *testing.T) {
+	flags, mockStore, clock := newTestFlags(t, time.Minute)
+	ctx := context.Background()
+
+	gomock.InOrder(
+		mockStore.EXPECT().
+			GetFeatureFlag(gomock.Any(), DigestNotifications).
+			Return(db.FeatureFlag{Key: DigestNotifications, Enabled: true}, nil),
+		mockStore.EXPECT().
+			GetFeatureFlag(gomock.Any(), DigestNotifications).
+			Return(db.FeatureFlag{}, assert.AnError),
+		mockStore.EXPECT().
+			GetFeatureFlag(gomock.Any(), DigestNotifications).
+			Return(db.FeatureFlag{Key: DigestNotifications, Enabled: false}, nil),
+	)
+
+	require.True(t, flags.IsEnabled(ctx, DigestNotifications))
+
+	clock.now = clock.now.Add(2 * time.Minute)
+	assert.True(t, flags.IsEnabled(ctx, DigestNotifications))
+
+	// The failed read backs off for a TTL instead of retrying on every call
+	clock.now = clock.now.Add(59 * time.Second)
+	assert.True(t, flags.IsEnabled(ctx, DigestNotifications))
+
+	clock.now = clock.now.Add(time.Second)
+	assert.False(t, flags.IsEnabled(ctx, DigestNotifications))
+}
+
+func TestIsEnabled_BacksOffWhenFirstReadFails(t *testing.T) {
+	flags, mockStore, clock := newTestFlags(t, time.Minute)
+	ctx := context.Background()
+
+	gomock.InOrder(
+		mockStore.EXPECT().
+			GetFeatureFlag(gomock.Any(), GeoPlacement).
+			Return(db.FeatureFlag{}, assert.AnError),
+		mockStore.EXPECT().
+			GetFeatureFlag(gomock.Any(), GeoPlacement).
+			Return(db.FeatureFlag{Key: GeoPlacement, Enabled: true}, nil),
+	)
+
+	assert.False(t, flags.IsEnabled(ctx, GeoPlacement))
+	assert.False(t, flags.IsEnabled(ctx, GeoPlacement))
+
+	clock.now = clock.now.Add(time.Minute)
+	assert.True(t, flags.IsEnabled(ctx, GeoPlacement))
+}
+
+func TestSet(t *testing.T) {
+	flags, mockStore, _ := newTestFlags(t, time.Minute)
+	ctx := context.Background()
+
+	mockStore.EXPECT().
+		GetFeatureFlag(gomock.Any(), DigestNotifications).
+		Return(db.FeatureFlag{}, pgx.ErrNoRows)
+	mockStore.EXPECT().
+		SetFeatureFlag(gomock.Any(), db.SetFeatureFlagParams{
+			Key:     DigestNotifications,
+			Enabled: true,
+			Value:   []byte(`{"hour":8}`),
+		}).
+		Return(db.FeatureFlag{Key: DigestNotifications, Enabled: true, Value: []byte(`{"hour":8}`)}, nil)
+
+	require.False(t, flags.IsEnabled(ctx, DigestNotifications))
+
+	flag, err := flags.Set(ctx, DigestNotifications, true, json.RawMessage(`{"hour":8}`))
+	require.NoError(t, err)
+	assert.True(t, flag.Enabled)
+
+	// The cached "off" is replaced without waiting for the TTL
+	assert.True(t, flags.IsEnabled(ctx, DigestNotifications))
+}
+
+func TestSet_Error(t *testing.T) {
+	flags, mockStore, _ := newTestFlags(t, time.Minute)
+
+	mockStore.EXPECT().
+		SetFeatureFlag(gomock.Any(), gomock.Any()).
+		Return(db.FeatureFlag{}, assert.AnError)
+
+	_, err := flags.Set(context.Background(), GeoPlacement, true, nil)
+
+	require.Error(t, err)
+}
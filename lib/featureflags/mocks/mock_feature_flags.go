@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: care-cordination/lib/featureflags (interfaces: FeatureFlags)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/mock_feature_flags.go -package=mocks care-cordination/lib/featureflags FeatureFlags
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	featureflags "care-cordination/lib/featureflags"
+	context "context"
+	json "encoding/json"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFeatureFlags is a mock of FeatureFlags interface.
+type MockFeatureFlags struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeatureFlagsMockRecorder
+	isgomock struct{}
+}
+
+// MockFeatureFlagsMockRecorder is the mock recorder for MockFeatureFlags.
+type MockFeatureFlagsMockRecorder struct {
+	mock *MockFeatureFlags
+}
+
+// NewMockFeatureFlags creates a new mock instance.
+func NewMockFeatureFlags(ctrl *gomock.Controller) *MockFeatureFlags {
+	mock := &MockFeatureFlags{ctrl: ctrl}
+	mock.recorder = &MockFeatureFlagsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeatureFlags) EXPECT() *MockFeatureFlagsMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockFeatureFlags) Get(ctx context.Context, key string) featureflags.Flag {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, key)
+	ret0, _ := ret[0].(featureflags.Flag)
+	return ret0
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockFeatureFlagsMockRecorder) Get(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockFeatureFlags)(nil).Get), ctx, key)
+}
+
+// IsEnabled mocks base method.
+func (m *MockFeatureFlags) IsEnabled(ctx context.Context, key string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsEnabled", ctx, key)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsEnabled indicates an expected call of IsEnabled.
+func (mr *MockFeatureFlagsMockRecorder) IsEnabled(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsEnabled", reflect.TypeOf((*MockFeatureFlags)(nil).IsEnabled), ctx, key)
+}
+
+// Set mocks base method.
+func (m *MockFeatureFlags) Set(ctx context.Context, key string, enabled bool, value json.RawMessage) (featureflags.Flag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", ctx, key, enabled, value)
+	ret0, _ := ret[0].(featureflags.Flag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockFeatureFlagsMockRecorder) Set(ctx, key, enabled, value any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockFeatureFlags)(nil).Set), ctx, key, enabled, value)
+}
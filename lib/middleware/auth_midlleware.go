@@ -45,6 +45,7 @@ func (m *Middleware) AuthMdw() gin.HandlerFunc {
 
 		ctx.Set(UserIDKey, payload.Subject)
 		ctx.Set(EmployeeIDKey, payload.EmployeeID)
+		ctx.Set(OrganizationIDKey, payload.OrganizationID)
 		ctx.Next()
 	}
 }
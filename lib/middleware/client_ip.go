@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"care-cordination/lib/util"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ClientIPMiddleware resolves the client address once per request and stores
+// it under util.ClientIPKey, where util.GetIPAddress reads it for the audit
+// trail and the auth session records.
+func (m *Middleware) ClientIPMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctx.Set(util.ClientIPKey, m.clientIP(ctx))
+		ctx.Next()
+	}
+}
+
+// clientIP returns the address the rate limiter and audit trail should key on.
+// Forwarding headers are only honored when the peer is a trusted proxy, so a
+// client connecting directly cannot choose its own address.
+func (m *Middleware) clientIP(ctx *gin.Context) string {
+	if ip := resolveClientIP(ctx.Request, m.trustedProxies); ip != nil {
+		return ip.String()
+	}
+	return peerHost(ctx.Request)
+}
+
+// resolveClientIP returns the peer address, or the address it forwarded for
+// when the peer is a trusted proxy. X-Forwarded-For is walked right to left
+// so that entries prepended by the client itself are never trusted; X-Real-IP
+// is used only when the proxy sent no X-Forwarded-For.
+func resolveClientIP(r *http.Request, trusted []*net.IPNet) net.IP {
+	ip := net.ParseIP(peerHost(r))
+	if ip == nil || !containsIP(trusted, ip) {
+		return ip
+	}
+
+	xff := r.Header.Get("X-Forwarded-For")
+	if strings.TrimSpace(xff) == "" {
+		if real := net.ParseIP(strings.TrimSpace(r.Header.Get("X-Real-IP"))); real != nil {
+			return real
+		}
+		return ip
+	}
+
+	hops := strings.Split(xff, ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if hop == "" {
+			continue
+		}
+		ip = net.ParseIP(hop)
+		if ip == nil || !containsIP(trusted, ip) {
+			return ip
+		}
+	}
+	return ip
+}
+
+func peerHost(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,153 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	loggermocks "care-cordination/lib/logger/mocks"
+	"care-cordination/lib/ratelimit"
+	"care-cordination/lib/util"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestClientIP(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	m, err := NewMiddleware(nil, nil, nil, nil, nil, 2, []string{"192.168.1.0/24"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		xff        string
+		xRealIP    string
+		want       string
+	}{
+		{
+			name:       "direct_client",
+			remoteAddr: "203.0.113.7:5000",
+			want:       "203.0.113.7",
+		},
+		{
+			name:       "spoofed_xff_from_untrusted_peer",
+			remoteAddr: "203.0.113.7:5000",
+			xff:        "10.1.2.3",
+			want:       "203.0.113.7",
+		},
+		{
+			name:       "spoofed_x_real_ip_from_untrusted_peer",
+			remoteAddr: "203.0.113.7:5000",
+			xRealIP:    "10.1.2.3",
+			want:       "203.0.113.7",
+		},
+		{
+			name:       "xff_from_trusted_proxy",
+			remoteAddr: "192.168.1.10:5000",
+			xff:        "198.51.100.4",
+			want:       "198.51.100.4",
+		},
+		{
+			name:       "client_prepended_xff_through_trusted_proxy",
+			remoteAddr: "192.168.1.10:5000",
+			xff:        "10.1.2.3, 198.51.100.4",
+			want:       "198.51.100.4",
+		},
+		{
+			name:       "x_real_ip_from_trusted_proxy",
+			remoteAddr: "192.168.1.10:5000",
+			xRealIP:    "198.51.100.4",
+			want:       "198.51.100.4",
+		},
+		{
+			name:       "trusted_proxy_without_headers",
+			remoteAddr: "192.168.1.10:5000",
+			want:       "192.168.1.10",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+			ctx.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+			ctx.Request.RemoteAddr = tt.remoteAddr
+			if tt.xff != "" {
+				ctx.Request.Header.Set("X-Forwarded-For", tt.xff)
+			}
+			if tt.xRealIP != "" {
+				ctx.Request.Header.Set("X-Real-IP", tt.xRealIP)
+			}
+
+			assert.Equal(t, tt.want, m.clientIP(ctx))
+		})
+	}
+}
+
+func TestClientIPMiddleware_FeedsGetIPAddress(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	m, err := NewMiddleware(nil, nil, nil, nil, nil, 2, []string{"192.168.1.0/24"})
+	require.NoError(t, err)
+
+	var got string
+	router := gin.New()
+	router.Use(m.ClientIPMiddleware())
+	router.GET("/", func(ctx *gin.Context) {
+		got = util.GetIPAddress(ctx)
+		ctx.Status(http.StatusOK)
+	})
+
+	send := func(remoteAddr, xff string) string {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = remoteAddr
+		req.Header.Set("X-Forwarded-For", xff)
+		router.ServeHTTP(httptest.NewRecorder(), req)
+		return got
+	}
+
+	assert.Equal(t, "203.0.113.7", send("203.0.113.7:5000", "10.1.2.3"))
+	assert.Equal(t, "198.51.100.4", send("192.168.1.10:5000", "198.51.100.4"))
+}
+
+func TestNewMiddleware_InvalidTrustedProxy(t *testing.T) {
+	_, err := NewMiddleware(nil, nil, nil, nil, nil, 2, []string{"not-a-cidr"})
+	require.Error(t, err)
+}
+
+func TestRateLimitMiddleware_IgnoresSpoofedHeaders(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	mockLogger := loggermocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	limiter := ratelimit.NewMemoryLimiter(&ratelimit.Config{
+		IPLimit:     1,
+		IPWindow:    time.Minute,
+		EmailLimit:  1,
+		EmailWindow: time.Minute,
+	})
+	defer limiter.Close()
+
+	m, err := NewMiddleware(nil, limiter, mockLogger, nil, nil, 2, []string{"192.168.1.0/24"})
+	require.NoError(t, err)
+
+	router := gin.New()
+	router.Use(m.RateLimitMiddleware())
+	router.GET("/", func(ctx *gin.Context) { ctx.Status(http.StatusOK) })
+
+	send := func(xff string) int {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = "203.0.113.7:5000"
+		req.Header.Set("X-Forwarded-For", xff)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	// A new forged address per request must not earn a fresh bucket
+	assert.Equal(t, http.StatusOK, send("10.0.0.1"))
+	assert.Equal(t, http.StatusTooManyRequests, send("10.0.0.2"))
+}
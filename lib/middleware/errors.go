@@ -7,6 +7,8 @@ var (
 	ErrUnauthorized   = errors.New("unauthorized")
 	ErrForbidden      = errors.New("forbidden")
 	ErrInternal       = errors.New("internal server error")
+	ErrIPNotAllowed   = errors.New("access from this IP address is not allowed")
+	ErrSearchTooShort = errors.New("search term is too short")
 
 	// Rate limiting errors
 	ErrRateLimitExceeded = errors.New("rate limit exceeded, please try again later")
@@ -16,4 +18,8 @@ var (
 	ErrRateLimitEmail = errors.New(
 		"too many login attempts for this account, please try again later",
 	)
+	ErrExportQueueFull = errors.New("too many exports in progress, please try again later")
+	ErrQueryTimeout    = errors.New(
+		"the request took too long to process, please narrow it down or try again later",
+	)
 )
@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"care-cordination/lib/exportlimit"
+	"care-cordination/lib/resp"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// exportRetryAfterSeconds is the Retry-After sent when the export queue is full
+const exportRetryAfterSeconds = "30"
+
+// ExportLimitMdw runs the request once limiter grants it an export slot. It
+// waits behind running exports while there is room in the queue and answers
+// 429 once there isn't. A nil limiter lets every export through.
+func (m *Middleware) ExportLimitMdw(limiter *exportlimit.Limiter) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if limiter == nil {
+			ctx.Next()
+			return
+		}
+
+		release, err := limiter.Acquire(ctx.Request.Context())
+		if err != nil {
+			if errors.Is(err, exportlimit.ErrQueueFull) {
+				m.logger.Warn(ctx, "ExportLimitMdw", "Export queue full",
+					zap.String("path", ctx.Request.URL.Path))
+				ctx.Header("Retry-After", exportRetryAfterSeconds)
+				ctx.AbortWithStatusJSON(http.StatusTooManyRequests, resp.Error(ErrExportQueueFull))
+				return
+			}
+			// The caller went away while queued; nobody is left to answer
+			ctx.Abort()
+			return
+		}
+		defer release()
+
+		ctx.Next()
+	}
+}
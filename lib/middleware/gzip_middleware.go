@@ -0,0 +1,196 @@
+package middleware
+
+import (
+	"bytes"
+	"compress/gzip"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DefaultGzipMinSize is the smallest response body, in bytes, that gets compressed
+const DefaultGzipMinSize = 1024
+
+// GzipConfig configures response compression
+type GzipConfig struct {
+	Enabled bool
+	// MinSize is the body size below which responses are sent uncompressed;
+	// zero compresses every non-empty body
+	MinSize int
+}
+
+// incompressibleTypes are content types that are already compressed
+var incompressibleTypes = []string{
+	"image/",
+	"video/",
+	"audio/",
+	"application/pdf",
+	"application/zip",
+	"application/gzip",
+	"application/x-gzip",
+	"application/x-7z-compressed",
+	"application/x-rar-compressed",
+}
+
+// GzipMiddleware compresses responses for clients that send Accept-Encoding: gzip.
+// Bodies are buffered until MinSize bytes have been written, so small responses
+// and responses with an already-compressed content type are sent as-is.
+func GzipMiddleware(cfg GzipConfig) gin.HandlerFunc {
+	minSize := cfg.MinSize
+	if minSize < 0 {
+		minSize = DefaultGzipMinSize
+	}
+
+	return func(ctx *gin.Context) {
+		if !cfg.Enabled || !acceptsGzip(ctx.Request) {
+			ctx.Next()
+			return
+		}
+
+		gw := &gzipResponseWriter{ResponseWriter: ctx.Writer, minSize: minSize}
+		ctx.Writer = gw
+		ctx.Header("Vary", "Accept-Encoding")
+
+		defer func() {
+			gw.close()
+			ctx.Writer = gw.ResponseWriter
+		}()
+
+		ctx.Next()
+	}
+}
+
+func acceptsGzip(r *http.Request) bool {
+	if r.Method == http.MethodHead || r.Header.Get("Upgrade") != "" {
+		return false
+	}
+	for _, encoding := range strings.Split(r.Header.Get("Accept-Encoding"), ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(encoding), ";")
+		if strings.EqualFold(strings.TrimSpace(name), "gzip") && qualityOf(params) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// qualityOf returns the q parameter of an Accept-Encoding entry, 1 when it is
+// absent and 0 when it cannot be parsed, so "q=0", "q=0.0" and "q=0.00" all
+// opt out
+func qualityOf(params string) float64 {
+	for _, param := range strings.Split(params, ";") {
+		key, value, _ := strings.Cut(strings.TrimSpace(param), "=")
+		if !strings.EqualFold(strings.TrimSpace(key), "q") {
+			continue
+		}
+		q, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return 0
+		}
+		return q
+	}
+	return 1
+}
+
+func isCompressible(contentType string) bool {
+	contentType = strings.ToLower(contentType)
+	if strings.HasPrefix(contentType, "image/svg+xml") {
+		return true
+	}
+	for _, prefix := range incompressibleTypes {
+		if strings.HasPrefix(contentType, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// gzipResponseWriter holds back the body until it knows whether compressing
+// it is worthwhile, then either streams through gzip or writes it unchanged.
+type gzipResponseWriter struct {
+	gin.ResponseWriter
+	minSize  int
+	buf      bytes.Buffer
+	gz       *gzip.Writer
+	decided  bool
+	compress bool
+}
+
+func (w *gzipResponseWriter) Write(data []byte) (int, error) {
+	if !w.decided {
+		if !w.canCompress() {
+			w.decide(false)
+		} else {
+			w.buf.Write(data)
+			if w.buf.Len() < w.minSize {
+				return len(data), nil
+			}
+			w.decide(true)
+			return len(data), w.flushBuffer()
+		}
+	}
+
+	if w.compress {
+		return w.gz.Write(data)
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *gzipResponseWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+func (w *gzipResponseWriter) Flush() {
+	if !w.decided {
+		w.decide(false)
+		_ = w.flushBuffer()
+	}
+	if w.compress {
+		_ = w.gz.Flush()
+	}
+	w.ResponseWriter.Flush()
+}
+
+func (w *gzipResponseWriter) canCompress() bool {
+	header := w.Header()
+	return !w.ResponseWriter.Written() &&
+		header.Get("Content-Encoding") == "" &&
+		isCompressible(header.Get("Content-Type"))
+}
+
+func (w *gzipResponseWriter) decide(compress bool) {
+	w.decided = true
+	w.compress = compress
+	if compress {
+		header := w.Header()
+		header.Set("Content-Encoding", "gzip")
+		header.Del("Content-Length")
+		w.gz = gzip.NewWriter(w.ResponseWriter)
+	}
+}
+
+func (w *gzipResponseWriter) flushBuffer() error {
+	if w.buf.Len() == 0 {
+		return nil
+	}
+	var err error
+	if w.compress {
+		_, err = w.gz.Write(w.buf.Bytes())
+	} else {
+		_, err = w.ResponseWriter.Write(w.buf.Bytes())
+	}
+	w.buf.Reset()
+	return err
+}
+
+// close writes out whatever is still buffered once the handler has finished
+func (w *gzipResponseWriter) close() {
+	if !w.decided {
+		w.decide(false)
+	}
+	_ = w.flushBuffer()
+	if w.compress {
+		_ = w.gz.Close()
+	}
+}
@@ -0,0 +1,140 @@
+package middleware
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newGzipRouter(t *testing.T, cfg GzipConfig) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(GzipMiddleware(cfg))
+	router.GET("/large", func(ctx *gin.Context) {
+		items := make([]gin.H, 200)
+		for i := range items {
+			items[i] = gin.H{"id": i, "name": "client record"}
+		}
+		ctx.JSON(http.StatusOK, gin.H{"data": items})
+	})
+	router.GET("/small", func(ctx *gin.Context) {
+		ctx.JSON(http.StatusOK, gin.H{"success": true})
+	})
+	router.GET("/pdf", func(ctx *gin.Context) {
+		ctx.Data(http.StatusOK, "application/pdf", bytes.Repeat([]byte("%PDF"), 1024))
+	})
+	return router
+}
+
+func serveGzip(router *gin.Engine, path, acceptEncoding string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	if acceptEncoding != "" {
+		req.Header.Set("Accept-Encoding", acceptEncoding)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestGzipMiddleware_CompressesLargeJSON(t *testing.T) {
+	router := newGzipRouter(t, GzipConfig{Enabled: true, MinSize: 1024})
+
+	plain := serveGzip(router, "/large", "")
+	require.Equal(t, http.StatusOK, plain.Code)
+
+	w := serveGzip(router, "/large", "gzip, deflate")
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "gzip", w.Header().Get("Content-Encoding"))
+	assert.Equal(t, "Accept-Encoding", w.Header().Get("Vary"))
+	assert.Less(t, w.Body.Len(), plain.Body.Len())
+
+	reader, err := gzip.NewReader(w.Body)
+	require.NoError(t, err)
+	body, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	assert.Equal(t, plain.Body.String(), string(body))
+}
+
+func TestGzipMiddleware_Untouched(t *testing.T) {
+	tests := []struct {
+		name           string
+		cfg            GzipConfig
+		path           string
+		acceptEncoding string
+		wantPrefix     string
+	}{
+		{
+			name:       "no_accept_encoding",
+			cfg:        GzipConfig{Enabled: true, MinSize: 1024},
+			path:       "/large",
+			wantPrefix: "{",
+		},
+		{
+			name:           "gzip_not_accepted",
+			cfg:            GzipConfig{Enabled: true, MinSize: 1024},
+			path:           "/large",
+			acceptEncoding: "br, gzip;q=0",
+			wantPrefix:     "{",
+		},
+		{
+			name:           "gzip_not_accepted_decimal_quality",
+			cfg:            GzipConfig{Enabled: true, MinSize: 1024},
+			path:           "/large",
+			acceptEncoding: "gzip;q=0.00, identity",
+			wantPrefix:     "{",
+		},
+		{
+			name:           "below_threshold",
+			cfg:            GzipConfig{Enabled: true, MinSize: 1024},
+			path:           "/small",
+			acceptEncoding: "gzip",
+			wantPrefix:     "{",
+		},
+		{
+			name:           "already_compressed_type",
+			cfg:            GzipConfig{Enabled: true, MinSize: 1024},
+			path:           "/pdf",
+			acceptEncoding: "gzip",
+			wantPrefix:     "%PDF",
+		},
+		{
+			name:           "disabled",
+			cfg:            GzipConfig{Enabled: false, MinSize: 1024},
+			path:           "/large",
+			acceptEncoding: "gzip",
+			wantPrefix:     "{",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := newGzipRouter(t, tt.cfg)
+
+			w := serveGzip(router, tt.path, tt.acceptEncoding)
+
+			require.Equal(t, http.StatusOK, w.Code)
+			assert.Empty(t, w.Header().Get("Content-Encoding"))
+			assert.True(t, strings.HasPrefix(w.Body.String(), tt.wantPrefix))
+		})
+	}
+}
+
+func TestGzipMiddleware_ZeroMinSizeCompressesSmallBodies(t *testing.T) {
+	router := newGzipRouter(t, GzipConfig{Enabled: true, MinSize: 0})
+
+	w := serveGzip(router, "/small", "gzip;q=0.5")
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "gzip", w.Header().Get("Content-Encoding"))
+}
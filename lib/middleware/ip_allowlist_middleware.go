@@ -0,0 +1,115 @@
+package middleware
+
+import (
+	"care-cordination/lib/resp"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// IPAllowlistConfig configures which routes are restricted to which networks
+type IPAllowlistConfig struct {
+	// AllowedCIDRs are the networks allowed to reach protected routes.
+	// An empty list disables the allowlist entirely.
+	AllowedCIDRs []string
+	// TrustedProxies are the networks whose X-Forwarded-For header is honored
+	TrustedProxies []string
+	// Routes are protected path prefixes, optionally preceded by a method,
+	// e.g. "/audit" or "POST /admin"
+	Routes []string
+}
+
+type protectedRoute struct {
+	method string // empty matches every method
+	prefix string
+}
+
+// IPAllowlistMiddleware rejects requests to protected routes with 403 unless
+// the client IP falls inside one of the allowed CIDR ranges
+func IPAllowlistMiddleware(cfg IPAllowlistConfig) (gin.HandlerFunc, error) {
+	allowed, err := parseCIDRs(cfg.AllowedCIDRs)
+	if err != nil {
+		return nil, err
+	}
+	trusted, err := parseCIDRs(cfg.TrustedProxies)
+	if err != nil {
+		return nil, err
+	}
+	routes, err := parseProtectedRoutes(cfg.Routes)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx *gin.Context) {
+		if len(allowed) == 0 || !isProtectedRoute(routes, ctx.Request.Method, ctx.Request.URL.Path) {
+			ctx.Next()
+			return
+		}
+
+		ip := resolveClientIP(ctx.Request, trusted)
+		if ip == nil || !containsIP(allowed, ip) {
+			ctx.AbortWithStatusJSON(http.StatusForbidden, resp.Error(ErrIPNotAllowed))
+			return
+		}
+
+		ctx.Next()
+	}, nil
+}
+
+func isProtectedRoute(routes []protectedRoute, method, path string) bool {
+	for _, route := range routes {
+		if route.method != "" && route.method != method {
+			continue
+		}
+		if path == route.prefix || strings.HasPrefix(path, strings.TrimSuffix(route.prefix, "/")+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func containsIP(networks []*net.IPNet, ip net.IP) bool {
+	for _, network := range networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func parseCIDRs(values []string) ([]*net.IPNet, error) {
+	networks := make([]*net.IPNet, 0, len(values))
+	for _, value := range values {
+		_, network, err := net.ParseCIDR(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIDR %q: %w", value, err)
+		}
+		networks = append(networks, network)
+	}
+	return networks, nil
+}
+
+func parseProtectedRoutes(values []string) ([]protectedRoute, error) {
+	routes := make([]protectedRoute, 0, len(values))
+	for _, value := range values {
+		fields := strings.Fields(value)
+		var route protectedRoute
+		switch len(fields) {
+		case 1:
+			route.prefix = fields[0]
+		case 2:
+			route.method = strings.ToUpper(fields[0])
+			route.prefix = fields[1]
+		default:
+			return nil, fmt.Errorf("invalid protected route %q", value)
+		}
+		if !strings.HasPrefix(route.prefix, "/") {
+			return nil, fmt.Errorf("invalid protected route %q: path must start with /", value)
+		}
+		routes = append(routes, route)
+	}
+	return routes, nil
+}
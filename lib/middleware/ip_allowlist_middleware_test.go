@@ -0,0 +1,150 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newAllowlistRouter(t *testing.T, cfg IPAllowlistConfig) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	mdw, err := IPAllowlistMiddleware(cfg)
+	require.NoError(t, err)
+
+	router := gin.New()
+	router.Use(mdw)
+	ok := func(ctx *gin.Context) { ctx.Status(http.StatusOK) }
+	router.GET("/metrics", ok)
+	router.GET("/admin/roles", ok)
+	router.POST("/admin/roles", ok)
+	router.GET("/clients", ok)
+	return router
+}
+
+func TestIPAllowlistMiddleware(t *testing.T) {
+	cfg := IPAllowlistConfig{
+		AllowedCIDRs:   []string{"10.0.0.0/8"},
+		TrustedProxies: []string{"192.168.1.0/24"},
+		Routes:         []string{"/metrics", "POST /admin"},
+	}
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		remoteAddr string
+		xff        string
+		wantStatus int
+	}{
+		{
+			name:       "allowed_cidr",
+			method:     http.MethodGet,
+			path:       "/metrics",
+			remoteAddr: "10.1.2.3:5000",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "blocked_cidr",
+			method:     http.MethodGet,
+			path:       "/metrics",
+			remoteAddr: "203.0.113.7:5000",
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "blocked_admin_mutation",
+			method:     http.MethodPost,
+			path:       "/admin/roles",
+			remoteAddr: "203.0.113.7:5000",
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "admin_read_not_protected",
+			method:     http.MethodGet,
+			path:       "/admin/roles",
+			remoteAddr: "203.0.113.7:5000",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "unprotected_route",
+			method:     http.MethodGet,
+			path:       "/clients",
+			remoteAddr: "203.0.113.7:5000",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "trusted_proxy_forwards_allowed_client",
+			method:     http.MethodGet,
+			path:       "/metrics",
+			remoteAddr: "192.168.1.10:5000",
+			xff:        "10.1.2.3",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "trusted_proxy_forwards_blocked_client",
+			method:     http.MethodGet,
+			path:       "/metrics",
+			remoteAddr: "192.168.1.10:5000",
+			xff:        "203.0.113.7",
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "untrusted_peer_spoofs_forwarded_header",
+			method:     http.MethodGet,
+			path:       "/metrics",
+			remoteAddr: "203.0.113.7:5000",
+			xff:        "10.1.2.3",
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "client_prepended_hop_is_ignored",
+			method:     http.MethodGet,
+			path:       "/metrics",
+			remoteAddr: "192.168.1.10:5000",
+			xff:        "10.1.2.3, 203.0.113.7",
+			wantStatus: http.StatusForbidden,
+		},
+	}
+
+	router := newAllowlistRouter(t, cfg)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			req.RemoteAddr = tt.remoteAddr
+			if tt.xff != "" {
+				req.Header.Set("X-Forwarded-For", tt.xff)
+			}
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+		})
+	}
+}
+
+func TestIPAllowlistMiddleware_DisabledWithoutCIDRs(t *testing.T) {
+	router := newAllowlistRouter(t, IPAllowlistConfig{Routes: []string{"/metrics"}})
+
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	req.RemoteAddr = "203.0.113.7:5000"
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestIPAllowlistMiddleware_InvalidConfig(t *testing.T) {
+	_, err := IPAllowlistMiddleware(IPAllowlistConfig{AllowedCIDRs: []string{"not-a-cidr"}})
+	assert.Error(t, err)
+
+	_, err = IPAllowlistMiddleware(IPAllowlistConfig{Routes: []string{"metrics"}})
+	assert.Error(t, err)
+}
@@ -6,6 +6,7 @@ import (
 	"care-cordination/lib/logger"
 	"care-cordination/lib/ratelimit"
 	"care-cordination/lib/token"
+	"net"
 )
 
 const (
@@ -13,6 +14,7 @@ const (
 	authorizationTypeBearer = "bearer"
 	UserIDKey               = "user_id"
 	EmployeeIDKey           = "employee_id"
+	OrganizationIDKey       = "organization_id"
 )
 
 type Middleware struct {
@@ -21,6 +23,10 @@ type Middleware struct {
 	logger      logger.Logger
 	store       *db.Store
 	auditLogger audit.AuditLogger
+
+	searchMinLength int
+	// trustedProxies are the peers whose forwarding headers are honored
+	trustedProxies []*net.IPNet
 }
 
 func NewMiddleware(
@@ -29,12 +35,21 @@ func NewMiddleware(
 	logger logger.Logger,
 	store *db.Store,
 	auditLogger audit.AuditLogger,
-) *Middleware {
-	return &Middleware{
-		tokenMaker:  tokenMaker,
-		rateLimiter: rateLimiter,
-		logger:      logger,
-		store:       store,
-		auditLogger: auditLogger,
+	searchMinLength int,
+	trustedProxies []string,
+) (*Middleware, error) {
+	trusted, err := parseCIDRs(trustedProxies)
+	if err != nil {
+		return nil, err
 	}
+
+	return &Middleware{
+		tokenMaker:      tokenMaker,
+		rateLimiter:     rateLimiter,
+		logger:          logger,
+		store:           store,
+		auditLogger:     auditLogger,
+		searchMinLength: searchMinLength,
+		trustedProxies:  trusted,
+	}, nil
 }
@@ -5,7 +5,6 @@ import (
 	"care-cordination/lib/resp"
 	"net/http"
 	"strconv"
-	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
@@ -21,8 +20,8 @@ func (m *Middleware) RateLimitMiddleware() gin.HandlerFunc {
 			return
 		}
 
-		// Get client IP (handles X-Forwarded-For for proxies)
-		ip := getClientIP(ctx)
+		// Get client IP (forwarding headers only count from trusted proxies)
+		ip := m.clientIP(ctx)
 
 		// Check IP-based rate limit
 		result, err := m.rateLimiter.CheckIPLimit(ctx, ip)
@@ -70,7 +69,7 @@ func (m *Middleware) LoginRateLimitMiddleware(
 		}
 
 		// Get client IP
-		ip := getClientIP(ctx)
+		ip := m.clientIP(ctx)
 
 		// Check IP-based rate limit first
 		ipResult, err := limiter.CheckIPLimit(ctx, ip)
@@ -136,31 +135,6 @@ func (m *Middleware) LoginRateLimitMiddleware(
 	}
 }
 
-// getClientIP extracts the real client IP from the request
-// Handles X-Forwarded-For header for requests behind proxies/load balancers
-func getClientIP(ctx *gin.Context) string {
-	// Check X-Forwarded-For header (for proxies/load balancers)
-	if xff := ctx.GetHeader("X-Forwarded-For"); xff != "" {
-		// X-Forwarded-For can contain multiple IPs (client, proxy1, proxy2, ...)
-		// Take the first one (the original client)
-		parts := strings.Split(xff, ",")
-		if len(parts) > 0 {
-			ip := strings.TrimSpace(parts[0])
-			if ip != "" {
-				return ip
-			}
-		}
-	}
-
-	// Check X-Real-IP header (alternative to X-Forwarded-For)
-	if xri := ctx.GetHeader("X-Real-IP"); xri != "" {
-		return xri
-	}
-
-	// Fallback to RemoteAddr
-	return ctx.ClientIP()
-}
-
 // SetRateLimitContext stores rate limit information in the context
 // This can be used by handlers to reset limits on successful login
 func SetRateLimitContext(ctx *gin.Context, email string) {
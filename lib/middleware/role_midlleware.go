@@ -17,19 +17,8 @@ func (m *Middleware) RequirePermission(resource, action string) gin.HandlerFunc
 			return
 		}
 
-		hasPermission, err := m.store.HasPermission(ctx, db.HasPermissionParams{
-			UserID:   userID,
-			Resource: resource,
-			Action:   action,
-		})
-
+		hasPermission, err := m.hasPermission(ctx, userID, resource, action)
 		if err != nil {
-			m.logger.Error(
-				ctx,
-				"Middleware.RequirePermission",
-				"failed to check permission",
-				zap.Error(err),
-			)
 			ctx.AbortWithStatusJSON(http.StatusInternalServerError, resp.Error(ErrInternal))
 			return
 		}
@@ -42,3 +31,48 @@ func (m *Middleware) RequirePermission(resource, action string) gin.HandlerFunc
 		ctx.Next()
 	}
 }
+
+// LoadPermission records whether the user holds resource:action under
+// PermissionKey without rejecting the request, for handlers that narrow what
+// they return instead of refusing callers without the permission
+func (m *Middleware) LoadPermission(resource, action string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		userID := ctx.GetString(UserIDKey)
+		if userID == "" {
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, resp.Error(ErrUnauthorized))
+			return
+		}
+
+		hasPermission, err := m.hasPermission(ctx, userID, resource, action)
+		if err != nil {
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, resp.Error(ErrInternal))
+			return
+		}
+
+		ctx.Set(PermissionKey(resource, action), hasPermission)
+		ctx.Next()
+	}
+}
+
+// PermissionKey is the context key LoadPermission sets for resource:action
+func PermissionKey(resource, action string) string {
+	return "permission:" + resource + ":" + action
+}
+
+func (m *Middleware) hasPermission(ctx *gin.Context, userID, resource, action string) (bool, error) {
+	hasPermission, err := m.store.HasPermission(ctx, db.HasPermissionParams{
+		UserID:   userID,
+		Resource: resource,
+		Action:   action,
+	})
+	if err != nil {
+		m.logger.Error(
+			ctx,
+			"Middleware.RequirePermission",
+			"failed to check permission",
+			zap.Error(err),
+		)
+		return false, err
+	}
+	return hasPermission, nil
+}
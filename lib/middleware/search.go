@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"care-cordination/lib/resp"
+
+	"github.com/gin-gonic/gin"
+)
+
+const SearchKey = "search"
+
+// likeEscaper escapes the LIKE/ILIKE wildcards; backslash is PostgreSQL's
+// default escape character, so the queries need no ESCAPE clause
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// SearchMdw normalizes the search query parameter before the handler binds it.
+// The term is trimmed and its LIKE wildcards are escaped so it matches literally.
+// A blank term is dropped so the list is unfiltered, and a term shorter than the
+// configured minimum length is rejected.
+func (m *Middleware) SearchMdw() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		query := ctx.Request.URL.Query()
+		if !query.Has(SearchKey) {
+			ctx.Next()
+			return
+		}
+
+		search := strings.TrimSpace(query.Get(SearchKey))
+		if search == "" {
+			query.Del(SearchKey)
+		} else if utf8.RuneCountInString(search) < m.searchMinLength {
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, resp.Error(ErrSearchTooShort))
+			return
+		} else {
+			query.Set(SearchKey, EscapeLikePattern(search))
+		}
+
+		ctx.Request.URL.RawQuery = query.Encode()
+		ctx.Next()
+	}
+}
+
+// EscapeLikePattern escapes s for literal use inside a LIKE/ILIKE pattern
+func EscapeLikePattern(s string) string {
+	return likeEscaper.Replace(s)
+}
@@ -0,0 +1,75 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func newSearchRouter(minLength int) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	mdw := &Middleware{searchMinLength: minLength}
+
+	router := gin.New()
+	router.GET("/clients", mdw.SearchMdw(), func(ctx *gin.Context) {
+		var req struct {
+			Search *string `form:"search"`
+		}
+		_ = ctx.ShouldBindQuery(&req)
+		if req.Search == nil {
+			ctx.String(http.StatusOK, "<none>")
+			return
+		}
+		ctx.String(http.StatusOK, *req.Search)
+	})
+	return router
+}
+
+func TestSearchMdw(t *testing.T) {
+	tests := []struct {
+		name       string
+		minLength  int
+		noSearch   bool
+		query      string
+		wantStatus int
+		wantSearch string
+	}{
+		{name: "no_search", minLength: 2, noSearch: true, wantStatus: http.StatusOK, wantSearch: "<none>"},
+		{name: "empty_search_dropped", minLength: 2, query: "", wantStatus: http.StatusOK, wantSearch: "<none>"},
+		{name: "blank_search_dropped", minLength: 2, query: "   ", wantStatus: http.StatusOK, wantSearch: "<none>"},
+		{name: "too_short", minLength: 2, query: "a", wantStatus: http.StatusBadRequest},
+		{name: "too_short_after_trim", minLength: 2, query: "  a  ", wantStatus: http.StatusBadRequest},
+		{name: "multibyte_counts_runes", minLength: 2, query: "é", wantStatus: http.StatusBadRequest},
+		{name: "configured_min_length", minLength: 3, query: "ab", wantStatus: http.StatusBadRequest},
+		{name: "min_length_ok", minLength: 2, query: "ab", wantStatus: http.StatusOK, wantSearch: "ab"},
+		{name: "trimmed", minLength: 2, query: "  jan  ", wantStatus: http.StatusOK, wantSearch: "jan"},
+		{name: "escapes_percent", minLength: 2, query: "50%", wantStatus: http.StatusOK, wantSearch: `50\%`},
+		{name: "escapes_underscore", minLength: 2, query: "a_b", wantStatus: http.StatusOK, wantSearch: `a\_b`},
+		{name: "escapes_backslash", minLength: 2, query: `a\b`, wantStatus: http.StatusOK, wantSearch: `a\\b`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := newSearchRouter(tt.minLength)
+
+			target := "/clients"
+			if !tt.noSearch {
+				target += "?" + url.Values{"search": {tt.query}}.Encode()
+			}
+			req := httptest.NewRequest(http.MethodGet, target, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantStatus == http.StatusOK {
+				assert.Equal(t, tt.wantSearch, w.Body.String())
+			} else {
+				assert.Contains(t, w.Body.String(), ErrSearchTooShort.Error())
+			}
+		})
+	}
+}
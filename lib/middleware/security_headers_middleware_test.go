@@ -0,0 +1,122 @@
+package middleware
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newSecurityHeadersRouter(t *testing.T, cfg SecurityHeadersConfig) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	mdw, err := SecurityHeadersMiddleware(cfg)
+	require.NoError(t, err)
+
+	router := gin.New()
+	router.Use(mdw)
+	router.GET("/clients", func(ctx *gin.Context) {
+		ctx.JSON(http.StatusOK, gin.H{"success": true})
+	})
+	router.GET("/swagger/index.html", func(ctx *gin.Context) {
+		ctx.Status(http.StatusOK)
+	})
+	return router
+}
+
+func TestSecurityHeadersMiddleware(t *testing.T) {
+	cfg := SecurityHeadersConfig{
+		Enabled:               true,
+		ContentSecurityPolicy: DefaultContentSecurityPolicy,
+		HSTSMaxAge:            365 * 24 * time.Hour,
+		TrustedProxies:        []string{"192.168.1.0/24"},
+	}
+
+	t.Run("normal_response_has_headers", func(t *testing.T) {
+		router := newSecurityHeadersRouter(t, cfg)
+		req := httptest.NewRequest(http.MethodGet, "/clients", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "nosniff", w.Header().Get("X-Content-Type-Options"))
+		assert.Equal(t, "DENY", w.Header().Get("X-Frame-Options"))
+		assert.Equal(t, "strict-origin-when-cross-origin", w.Header().Get("Referrer-Policy"))
+		assert.Equal(t, DefaultContentSecurityPolicy, w.Header().Get("Content-Security-Policy"))
+		// Plain HTTP must not advertise HSTS
+		assert.Empty(t, w.Header().Get("Strict-Transport-Security"))
+	})
+
+	tests := []struct {
+		name     string
+		tls      bool
+		peer     string
+		proto    string
+		wantHSTS bool
+	}{
+		{name: "direct_tls", tls: true, peer: "203.0.113.7:4000", wantHSTS: true},
+		{name: "trusted_proxy_https", peer: "192.168.1.10:4000", proto: "https", wantHSTS: true},
+		{name: "untrusted_forwarded_proto", peer: "203.0.113.7:4000", proto: "https"},
+		{name: "trusted_proxy_http", peer: "192.168.1.10:4000", proto: "http"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := newSecurityHeadersRouter(t, cfg)
+			req := httptest.NewRequest(http.MethodGet, "/clients", nil)
+			req.RemoteAddr = tt.peer
+			if tt.tls {
+				req.TLS = &tls.ConnectionState{}
+			}
+			if tt.proto != "" {
+				req.Header.Set("X-Forwarded-Proto", tt.proto)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if tt.wantHSTS {
+				assert.Equal(t, "max-age=31536000; includeSubDomains", w.Header().Get("Strict-Transport-Security"))
+			} else {
+				assert.Empty(t, w.Header().Get("Strict-Transport-Security"))
+			}
+		})
+	}
+
+	t.Run("swagger_has_no_csp", func(t *testing.T) {
+		router := newSecurityHeadersRouter(t, cfg)
+		req := httptest.NewRequest(http.MethodGet, "/swagger/index.html", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Empty(t, w.Header().Get("Content-Security-Policy"))
+		assert.Equal(t, "nosniff", w.Header().Get("X-Content-Type-Options"))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		disabled := cfg
+		disabled.Enabled = false
+		router := newSecurityHeadersRouter(t, disabled)
+		req := httptest.NewRequest(http.MethodGet, "/clients", nil)
+		req.TLS = &tls.ConnectionState{}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		for _, name := range []string{
+			"X-Content-Type-Options", "X-Frame-Options", "Referrer-Policy",
+			"Content-Security-Policy", "Strict-Transport-Security",
+		} {
+			assert.Empty(t, w.Header().Get(name), name)
+		}
+	})
+
+	t.Run("invalid_trusted_proxy", func(t *testing.T) {
+		_, err := SecurityHeadersMiddleware(SecurityHeadersConfig{TrustedProxies: []string{"not-a-cidr"}})
+		assert.Error(t, err)
+	})
+}
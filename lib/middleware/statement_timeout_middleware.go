@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"care-cordination/lib/db/pool"
+	"care-cordination/lib/resp"
+	"net/http"
+	"sync/atomic"
+
+	"github.com/gin-gonic/gin"
+)
+
+// statementTimeoutRetryAfterSeconds is the Retry-After sent when a query ran
+// into the database statement timeout
+const statementTimeoutRetryAfterSeconds = "5"
+
+// StatementTimeoutMiddleware answers 503 instead of 500 when the request
+// failed because one of its queries exceeded the database statement timeout.
+// Handlers report such failures as internal errors; this tells the client the
+// request was too expensive rather than broken, so it can be narrowed or
+// retried.
+func StatementTimeoutMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		hit := new(atomic.Bool)
+		ctx.Set(pool.StatementTimeoutHitKey, hit)
+		ctx.Request = ctx.Request.WithContext(pool.WithStatementTimeoutHit(ctx.Request.Context(), hit))
+
+		tw := &statementTimeoutWriter{ResponseWriter: ctx.Writer, hit: hit}
+		ctx.Writer = tw
+
+		ctx.Next()
+
+		ctx.Writer = tw.ResponseWriter
+		if tw.suppressed {
+			ctx.Header("Retry-After", statementTimeoutRetryAfterSeconds)
+			ctx.JSON(http.StatusServiceUnavailable, resp.Error(ErrQueryTimeout))
+		}
+	}
+}
+
+// statementTimeoutWriter holds back a 500 response once a query of the request
+// timed out, so the middleware can send a 503 in its place.
+type statementTimeoutWriter struct {
+	gin.ResponseWriter
+	hit        *atomic.Bool
+	suppressed bool
+}
+
+func (w *statementTimeoutWriter) WriteHeader(code int) {
+	if code == http.StatusInternalServerError && w.hit.Load() && !w.ResponseWriter.Written() {
+		w.suppressed = true
+		return
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *statementTimeoutWriter) Write(data []byte) (int, error) {
+	if w.suppressed {
+		return len(data), nil
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *statementTimeoutWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
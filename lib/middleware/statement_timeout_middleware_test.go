@@ -0,0 +1,88 @@
+package middleware
+
+import (
+	"care-cordination/lib/db/pool"
+	"care-cordination/lib/resp"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatementTimeoutMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name       string
+		timedOut   bool
+		viaRequest bool
+		status     int
+		wantStatus int
+		wantError  string
+	}{
+		{
+			name:       "timed_out_internal_error_becomes_503",
+			timedOut:   true,
+			status:     http.StatusInternalServerError,
+			wantStatus: http.StatusServiceUnavailable,
+			wantError:  ErrQueryTimeout.Error(),
+		},
+		{
+			name:       "timed_out_through_request_context_becomes_503",
+			timedOut:   true,
+			viaRequest: true,
+			status:     http.StatusInternalServerError,
+			wantStatus: http.StatusServiceUnavailable,
+			wantError:  ErrQueryTimeout.Error(),
+		},
+		{
+			name:       "other_internal_error_is_kept",
+			status:     http.StatusInternalServerError,
+			wantStatus: http.StatusInternalServerError,
+			wantError:  ErrInternal.Error(),
+		},
+		{
+			name:       "timed_out_but_handled_is_kept",
+			timedOut:   true,
+			status:     http.StatusBadRequest,
+			wantStatus: http.StatusBadRequest,
+			wantError:  ErrInternal.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.Use(StatementTimeoutMiddleware())
+			router.GET("/clients", func(ctx *gin.Context) {
+				if tt.timedOut {
+					// What the pool's tracer does when a query of the request times out
+					queryCtx := context.Context(ctx)
+					if tt.viaRequest {
+						queryCtx = ctx.Request.Context()
+					}
+					pool.StatementTimeoutHit(queryCtx).Store(true)
+				}
+				ctx.JSON(tt.status, resp.Error(ErrInternal))
+			})
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/clients", nil))
+
+			require.Equal(t, tt.wantStatus, w.Code)
+			var body resp.ErrorResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+			assert.Equal(t, tt.wantError, body.Error)
+			if tt.wantStatus == http.StatusServiceUnavailable {
+				assert.Equal(t, statementTimeoutRetryAfterSeconds, w.Header().Get("Retry-After"))
+			} else {
+				assert.Empty(t, w.Header().Get("Retry-After"))
+			}
+		})
+	}
+}
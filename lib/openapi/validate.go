@@ -0,0 +1,38 @@
+// Package openapi loads and checks the OpenAPI 3.0 contract the API serves,
+// converted from the Swagger 2.0 document swag generates from the handler
+// annotations.
+package openapi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// Version is the OpenAPI version of the served contract, as produced by
+// kin-openapi's Swagger 2.0 conversion
+const Version = "3.0.3"
+
+// Validate loads an OpenAPI 3.0 JSON document with kin-openapi and checks it
+// against the specification, including that every reference resolves
+func Validate(spec []byte) error {
+	doc, err := Load(spec)
+	if err != nil {
+		return err
+	}
+	if err := doc.Validate(context.Background()); err != nil {
+		return fmt.Errorf("validate openapi document: %w", err)
+	}
+	return nil
+}
+
+// Load parses an OpenAPI 3.0 JSON document and resolves its references, so the
+// result can back an openapi3filter router for request and response checks
+func Load(spec []byte) (*openapi3.T, error) {
+	doc, err := openapi3.NewLoader().LoadFromData(spec)
+	if err != nil {
+		return nil, fmt.Errorf("load openapi document: %w", err)
+	}
+	return doc, nil
+}
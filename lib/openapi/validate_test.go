@@ -0,0 +1,48 @@
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		want string
+	}{
+		{
+			name: "missing_title",
+			doc:  `{"openapi": "3.0.3", "info": {"version": "1"}, "paths": {}}`,
+			want: "value of title must be a non-empty string",
+		},
+		{
+			name: "undeclared_path_parameter",
+			doc: `{"openapi": "3.0.3", "info": {"title": "t", "version": "1"}, "paths": {
+				"/items/{id}": {"get": {"responses": {"200": {"description": "OK"}}}}}}`,
+			want: "must define exactly all path parameters (missing: [id])",
+		},
+		{
+			name: "unresolved_reference",
+			doc: `{"openapi": "3.0.3", "info": {"title": "t", "version": "1"}, "paths": {
+				"/items": {"get": {"responses": {"200": {"description": "OK",
+					"content": {"application/json": {"schema": {"$ref": "#/components/schemas/Missing"}}}}}}}}}`,
+			want: `failed to resolve "schemas"`,
+		},
+		{
+			name: "no_responses",
+			doc:  `{"openapi": "3.0.3", "info": {"title": "t", "version": "1"}, "paths": {"/items": {"get": {}}}}`,
+			want: "value of responses must be an object",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate([]byte(tt.doc))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
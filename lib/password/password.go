@@ -0,0 +1,158 @@
+// Package password enforces the complexity policy for new and changed account
+// passwords. Existing password hashes are never re-checked.
+package password
+
+import (
+	"bufio"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ErrWeakPassword is matched (errors.Is) by every policy violation
+var ErrWeakPassword = errors.New("password does not meet the password policy")
+
+// Unmet requirements, reported together in a *PolicyError
+var (
+	ErrTooShort      = errors.New("too short")
+	ErrMissingUpper  = errors.New("missing an uppercase letter")
+	ErrMissingLower  = errors.New("missing a lowercase letter")
+	ErrMissingDigit  = errors.New("missing a digit")
+	ErrMissingSymbol = errors.New("missing a symbol")
+	ErrCommon        = errors.New("too common")
+)
+
+//go:embed common.txt
+var builtinCommonList string
+
+// CommonList is a set of rejected passwords, stored lowercased
+type CommonList map[string]struct{}
+
+// Contains reports whether password is on the list, ignoring case
+func (l CommonList) Contains(password string) bool {
+	_, ok := l[strings.ToLower(password)]
+	return ok
+}
+
+// BuiltinCommonList returns the common-password list shipped with the binary
+func BuiltinCommonList() CommonList {
+	list, _ := readCommonList(strings.NewReader(builtinCommonList))
+	return list
+}
+
+// LoadCommonList reads a common-password list with one password per line;
+// blank lines and lines starting with # are skipped. An empty path returns the
+// built-in list.
+func LoadCommonList(path string) (CommonList, error) {
+	if path == "" {
+		return BuiltinCommonList(), nil
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return readCommonList(f)
+}
+
+func readCommonList(r io.Reader) (CommonList, error) {
+	list := CommonList{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		list[strings.ToLower(line)] = struct{}{}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// Policy lists the requirements a new password must meet
+type Policy struct {
+	// MinLength counts characters, not bytes
+	MinLength     int
+	RequireUpper  bool
+	RequireLower  bool
+	RequireDigit  bool
+	RequireSymbol bool
+	// Common passwords are rejected; a nil list disables the check
+	Common CommonList
+}
+
+// DefaultPolicy is used when no policy is configured
+var DefaultPolicy = Policy{
+	MinLength:    12,
+	RequireUpper: true,
+	RequireLower: true,
+	RequireDigit: true,
+	Common:       BuiltinCommonList(),
+}
+
+// PolicyError lists every requirement a password failed
+type PolicyError struct {
+	Unmet []error
+}
+
+func (e *PolicyError) Error() string {
+	reasons := make([]string, len(e.Unmet))
+	for i, err := range e.Unmet {
+		reasons[i] = err.Error()
+	}
+	return ErrWeakPassword.Error() + ": " + strings.Join(reasons, ", ")
+}
+
+// Unwrap makes ErrWeakPassword and each unmet requirement match errors.Is
+func (e *PolicyError) Unwrap() []error {
+	return append([]error{ErrWeakPassword}, e.Unmet...)
+}
+
+// Validate returns a *PolicyError listing every unmet requirement, or nil
+func (p Policy) Validate(password string) error {
+	var hasUpper, hasLower, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case !unicode.IsLetter(r) && !unicode.IsSpace(r):
+			hasSymbol = true
+		}
+	}
+
+	var unmet []error
+	if utf8.RuneCountInString(password) < p.MinLength {
+		unmet = append(unmet, fmt.Errorf("%w (minimum %d characters)", ErrTooShort, p.MinLength))
+	}
+	if p.RequireUpper && !hasUpper {
+		unmet = append(unmet, ErrMissingUpper)
+	}
+	if p.RequireLower && !hasLower {
+		unmet = append(unmet, ErrMissingLower)
+	}
+	if p.RequireDigit && !hasDigit {
+		unmet = append(unmet, ErrMissingDigit)
+	}
+	if p.RequireSymbol && !hasSymbol {
+		unmet = append(unmet, ErrMissingSymbol)
+	}
+	if p.Common != nil && p.Common.Contains(password) {
+		unmet = append(unmet, ErrCommon)
+	}
+
+	if len(unmet) > 0 {
+		return &PolicyError{Unmet: unmet}
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package password
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	policy := Policy{
+		MinLength:     12,
+		RequireUpper:  true,
+		RequireLower:  true,
+		RequireDigit:  true,
+		RequireSymbol: true,
+		Common:        CommonList{"welcome2026!a": {}},
+	}
+
+	tests := []struct {
+		name     string
+		password string
+		want     error
+	}{
+		{name: "too_short", password: "Sh0rt!pass", want: ErrTooShort},
+		{name: "missing_upper", password: "lowercase-only-42", want: ErrMissingUpper},
+		{name: "missing_lower", password: "UPPERCASE-ONLY-42", want: ErrMissingLower},
+		{name: "missing_digit", password: "No-Digits-Here!", want: ErrMissingDigit},
+		{name: "missing_symbol", password: "NoSymbolsHere42", want: ErrMissingSymbol},
+		{name: "common", password: "Welcome2026!A", want: ErrCommon},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.Validate(tt.password)
+			require.Error(t, err)
+			assert.ErrorIs(t, err, ErrWeakPassword)
+			assert.ErrorIs(t, err, tt.want)
+
+			var policyErr *PolicyError
+			require.True(t, errors.As(err, &policyErr))
+			assert.Len(t, policyErr.Unmet, 1, "only the broken rule is reported: %v", err)
+		})
+	}
+
+	t.Run("passing", func(t *testing.T) {
+		assert.NoError(t, policy.Validate("Correct-Horse-42"))
+	})
+
+	t.Run("reports_every_unmet_rule", func(t *testing.T) {
+		err := policy.Validate("abc")
+		assert.EqualError(t, err, "password does not meet the password policy: "+
+			"too short (minimum 12 characters), missing an uppercase letter, "+
+			"missing a digit, missing a symbol")
+	})
+
+	t.Run("length_counts_characters", func(t *testing.T) {
+		assert.NoError(t, Policy{MinLength: 4}.Validate("éééé"))
+		assert.ErrorIs(t, Policy{MinLength: 5}.Validate("éééé"), ErrTooShort)
+	})
+}
+
+func TestDefaultPolicy(t *testing.T) {
+	assert.ErrorIs(t, DefaultPolicy.Validate("Password1234"), ErrCommon)
+	assert.NoError(t, DefaultPolicy.Validate("Correct-Horse-42"))
+}
+
+func TestLoadCommonList(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "common.txt")
+	require.NoError(t, os.WriteFile(path, []byte("# comment\n\nHunter2Hunter2\n"), 0o600))
+
+	list, err := LoadCommonList(path)
+	require.NoError(t, err)
+	assert.Len(t, list, 1)
+	assert.True(t, list.Contains("hunter2hunter2"))
+
+	builtin, err := LoadCommonList("")
+	require.NoError(t, err)
+	assert.True(t, builtin.Contains("password123"))
+
+	_, err = LoadCommonList(filepath.Join(t.TempDir(), "missing.txt"))
+	assert.Error(t, err)
+}
@@ -1,6 +1,7 @@
 package ratelimit
 
 import (
+	"care-cordination/lib/logger"
 	"context"
 	"time"
 )
@@ -36,11 +37,13 @@ type Config struct {
 	IPWindow       time.Duration
 	EmailLimit     int
 	EmailWindow    time.Duration
-	EnableFallback bool // Use in-memory fallback if Redis fails
+	EnableFallback bool          // Use in-memory fallback if Redis fails, at startup or later on
+	Logger         logger.Logger // Optional; warns when a check falls back to memory
 }
 
 // NewRateLimiter creates a new rate limiter instance
-// It will use Redis-backed rate limiting with optional in-memory fallback
+// It will use Redis-backed rate limiting with optional in-memory fallback, both
+// when Redis is unreachable at startup and when a later check fails
 func NewRateLimiter(config *Config) (RateLimiter, error) {
 	// Try to create Redis-backed rate limiter
 	redisLimiter, err := NewRedisLimiter(config)
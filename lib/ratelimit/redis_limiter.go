@@ -1,6 +1,7 @@
 package ratelimit
 
 import (
+	"care-cordination/lib/logger"
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/go-redis/redis_rate/v10"
 	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
 )
 
 // RedisLimiter implements RateLimiter using Redis
@@ -19,6 +21,9 @@ type RedisLimiter struct {
 	ipWindow    time.Duration
 	emailLimit  int
 	emailWindow time.Duration
+	// fallback answers checks while Redis is failing; nil when disabled
+	fallback *MemoryLimiter
+	logger   logger.Logger
 }
 
 // NewRedisLimiter creates a new Redis-backed rate limiter
@@ -39,39 +44,79 @@ func NewRedisLimiter(config *Config) (*RedisLimiter, error) {
 		return nil, fmt.Errorf("failed to connect to Redis: %w", err)
 	}
 
-	return &RedisLimiter{
+	return newRedisLimiter(client, config), nil
+}
+
+func newRedisLimiter(client *redis.Client, config *Config) *RedisLimiter {
+	r := &RedisLimiter{
 		client:      client,
 		limiter:     redis_rate.NewLimiter(client),
 		ipLimit:     config.IPLimit,
 		ipWindow:    config.IPWindow,
 		emailLimit:  config.EmailLimit,
 		emailWindow: config.EmailWindow,
-	}, nil
+		logger:      config.Logger,
+	}
+	if config.EnableFallback {
+		r.fallback = NewMemoryLimiter(config)
+	}
+	return r
 }
 
 // CheckIPLimit checks if the IP has exceeded the rate limit
+// If Redis fails and the fallback is enabled, the in-memory limiter decides
 func (r *RedisLimiter) CheckIPLimit(ctx context.Context, ip string) (*LimitResult, error) {
 	key := fmt.Sprintf("ratelimit:ip:%s", hashKey(ip))
-	return r.checkLimit(ctx, key, r.ipLimit, r.ipWindow)
+	result, err := r.checkLimit(ctx, key, r.ipLimit, r.ipWindow)
+	if err != nil && r.fallback != nil {
+		r.warnFallback(ctx, "CheckIPLimit", err)
+		return r.fallback.CheckIPLimit(ctx, ip)
+	}
+	return result, err
 }
 
 // CheckEmailLimit checks if the email has exceeded the rate limit
+// If Redis fails and the fallback is enabled, the in-memory limiter decides
 func (r *RedisLimiter) CheckEmailLimit(ctx context.Context, email string) (*LimitResult, error) {
 	key := fmt.Sprintf("ratelimit:email:%s", hashKey(email))
-	return r.checkLimit(ctx, key, r.emailLimit, r.emailWindow)
+	result, err := r.checkLimit(ctx, key, r.emailLimit, r.emailWindow)
+	if err != nil && r.fallback != nil {
+		r.warnFallback(ctx, "CheckEmailLimit", err)
+		return r.fallback.CheckEmailLimit(ctx, email)
+	}
+	return result, err
 }
 
 // ResetEmailLimit resets the rate limit for an email
+// The fallback is reset as well, since it may have counted attempts during an outage
 func (r *RedisLimiter) ResetEmailLimit(ctx context.Context, email string) error {
 	key := fmt.Sprintf("ratelimit:email:%s", hashKey(email))
-	return r.client.Del(ctx, key).Err()
+	err := r.client.Del(ctx, key).Err()
+	if r.fallback == nil {
+		return err
+	}
+	if err != nil {
+		r.warnFallback(ctx, "ResetEmailLimit", err)
+	}
+	return r.fallback.ResetEmailLimit(ctx, email)
 }
 
-// Close closes the Redis client connection
+// Close closes the Redis client connection and stops the fallback
 func (r *RedisLimiter) Close() error {
+	if r.fallback != nil {
+		_ = r.fallback.Close()
+	}
 	return r.client.Close()
 }
 
+// warnFallback logs that Redis failed and the in-memory limiter is used instead
+func (r *RedisLimiter) warnFallback(ctx context.Context, operation string, err error) {
+	if r.logger == nil {
+		return
+	}
+	r.logger.Warn(ctx, operation, "Redis rate limiter failed, using in-memory fallback", zap.Error(err))
+}
+
 // checkLimit performs the actual rate limit check
 func (r *RedisLimiter) checkLimit(
 	ctx context.Context,
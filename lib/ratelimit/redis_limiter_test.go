@@ -0,0 +1,88 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	loggermocks "care-cordination/lib/logger/mocks"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// unreachableRedis returns a client whose every command fails, as it would
+// when Redis goes down after startup
+func unreachableRedis(t *testing.T) *redis.Client {
+	t.Helper()
+	return redis.NewClient(&redis.Options{
+		Addr:        "127.0.0.1:1",
+		MaxRetries:  -1,
+		DialTimeout: 100 * time.Millisecond,
+	})
+}
+
+func TestRedisLimiterFallback(t *testing.T) {
+	config := &Config{
+		IPLimit:     2,
+		IPWindow:    time.Minute,
+		EmailLimit:  1,
+		EmailWindow: time.Minute,
+	}
+	ctx := context.Background()
+
+	t.Run("live_redis_error_uses_memory_limiter", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockLogger := loggermocks.NewMockLogger(ctrl)
+		mockLogger.EXPECT().
+			Warn(gomock.Any(), "CheckIPLimit", gomock.Any(), gomock.Any()).
+			Times(3)
+
+		cfg := *config
+		cfg.EnableFallback = true
+		cfg.Logger = mockLogger
+		limiter := newRedisLimiter(unreachableRedis(t), &cfg)
+		defer limiter.Close()
+
+		// The in-memory limit still applies, so an outage does not lift it
+		for i, wantAllowed := range []bool{true, true, false} {
+			result, err := limiter.CheckIPLimit(ctx, "10.0.0.1")
+			require.NoError(t, err, "check %d", i+1)
+			assert.Equal(t, wantAllowed, result.Allowed, "check %d", i+1)
+		}
+	})
+
+	t.Run("reset_clears_fallback_counts", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockLogger := loggermocks.NewMockLogger(ctrl)
+		mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+		cfg := *config
+		cfg.EnableFallback = true
+		cfg.Logger = mockLogger
+		limiter := newRedisLimiter(unreachableRedis(t), &cfg)
+		defer limiter.Close()
+
+		result, err := limiter.CheckEmailLimit(ctx, "user@example.com")
+		require.NoError(t, err)
+		require.True(t, result.Allowed)
+		result, err = limiter.CheckEmailLimit(ctx, "user@example.com")
+		require.NoError(t, err)
+		require.False(t, result.Allowed)
+
+		require.NoError(t, limiter.ResetEmailLimit(ctx, "user@example.com"))
+		result, err = limiter.CheckEmailLimit(ctx, "user@example.com")
+		require.NoError(t, err)
+		assert.True(t, result.Allowed)
+	})
+
+	t.Run("without_fallback_error_is_returned", func(t *testing.T) {
+		limiter := newRedisLimiter(unreachableRedis(t), config)
+		defer limiter.Close()
+
+		_, err := limiter.CheckIPLimit(ctx, "10.0.0.1")
+		assert.Error(t, err)
+	})
+}
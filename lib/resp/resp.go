@@ -36,6 +36,8 @@ type PaginationResponse[T any] struct {
 	TotalPages int `json:"totalPages"`
 	Page       int `json:"page"`
 	PageSize   int `json:"pageSize"`
+	// NextCursor is only set on keyset-paginated responses that have more rows
+	NextCursor string `json:"nextCursor,omitempty"`
 }
 
 func PagResp[T any](data []T, totalCount int, page int, pageSize int) PaginationResponse[T] {
@@ -62,3 +64,13 @@ func PagRespWithParams[T any](
 ) PaginationResponse[T] {
 	return PagResp(data, totalCount, int(page), int(pageSize))
 }
+
+// CursorResp builds a keyset pagination response. Totals and page numbers are
+// not known in keyset mode; an empty nextCursor means this is the last page.
+func CursorResp[T any](data []T, pageSize int32, nextCursor string) PaginationResponse[T] {
+	return PaginationResponse[T]{
+		Data:       data,
+		PageSize:   int(pageSize),
+		NextCursor: nextCursor,
+	}
+}
@@ -42,18 +42,18 @@ func (m *MockTokenManager) EXPECT() *MockTokenManagerMockRecorder {
 }
 
 // GenerateAccessToken mocks base method.
-func (m *MockTokenManager) GenerateAccessToken(userID, employeeID string, now time.Time) (string, error) {
+func (m *MockTokenManager) GenerateAccessToken(userID, employeeID, organizationID string, now time.Time) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateAccessToken", userID, employeeID, now)
+	ret := m.ctrl.Call(m, "GenerateAccessToken", userID, employeeID, organizationID, now)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GenerateAccessToken indicates an expected call of GenerateAccessToken.
-func (mr *MockTokenManagerMockRecorder) GenerateAccessToken(userID, employeeID, now any) *gomock.Call {
+func (mr *MockTokenManagerMockRecorder) GenerateAccessToken(userID, employeeID, organizationID, now any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateAccessToken", reflect.TypeOf((*MockTokenManager)(nil).GenerateAccessToken), userID, employeeID, now)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateAccessToken", reflect.TypeOf((*MockTokenManager)(nil).GenerateAccessToken), userID, employeeID, organizationID, now)
 }
 
 // GenerateMFAPendingToken mocks base method.
@@ -11,7 +11,7 @@ import (
 
 //go:generate mockgen -destination=mocks/mock_token_manager.go -package=mocks care-cordination/lib/token TokenManager
 type TokenManager interface {
-	GenerateAccessToken(userID, employeeID string, now time.Time) (string, error)
+	GenerateAccessToken(userID, employeeID, organizationID string, now time.Time) (string, error)
 	GenerateMFAPendingToken(userID string, now time.Time) (string, error)
 	GenerateRefreshToken(userID string, now time.Time) (string, *RefreshTokenClaims, error)
 	ValidateAccessToken(tokenStr string) (*AccessTokenClaims, error)
@@ -33,8 +33,9 @@ const (
 )
 
 type AccessTokenClaims struct {
-	Scope      string `json:"scope,omitempty"`
-	EmployeeID string `json:"employee_id,omitempty"`
+	Scope          string `json:"scope,omitempty"`
+	EmployeeID     string `json:"employee_id,omitempty"`
+	OrganizationID string `json:"organization_id,omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -60,14 +61,15 @@ func NewTokenManager(
 }
 
 func (tm *tokenManager) GenerateAccessToken(
-	userID, employeeID string,
+	userID, employeeID, organizationID string,
 	now time.Time,
 ) (string, error) {
 
 	accessExpire := now.Add(tm.accessTTL)
 
 	accessClaims := &AccessTokenClaims{
-		EmployeeID: employeeID,
+		EmployeeID:     employeeID,
+		OrganizationID: organizationID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			Issuer:    tm.issuer,
 			Audience:  jwt.ClaimStrings{tm.audience},
@@ -44,6 +44,7 @@ func TestGenerateAccessToken(t *testing.T) {
 				require.NoError(t, err)
 				assert.Equal(t, userID, claims.Subject)
 				assert.Equal(t, employeeID, claims.EmployeeID)
+				assert.Equal(t, "org-1", claims.OrganizationID)
 				assert.Equal(t, "care-coordination", claims.Issuer)
 				assert.Contains(t, claims.Audience, "care-coordination")
 				assert.WithinDuration(t, now, claims.IssuedAt.Time, time.Second)
@@ -82,7 +83,7 @@ func TestGenerateAccessToken(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tm := newTestTokenManager()
 
-			token, err := tm.GenerateAccessToken(tt.userID, tt.employeeID, tt.now)
+			token, err := tm.GenerateAccessToken(tt.userID, tt.employeeID, "org-1", tt.now)
 
 			if tt.wantErr {
 				require.Error(t, err)
@@ -193,7 +194,7 @@ func TestValidateAccessToken(t *testing.T) {
 		{
 			name: "valid_token",
 			setup: func(t *testing.T, tm TokenManager) string {
-				token, err := tm.GenerateAccessToken("user-123", "emp-456", time.Now())
+				token, err := tm.GenerateAccessToken("user-123", "emp-456", "org-1", time.Now())
 				require.NoError(t, err)
 				return token
 			},
@@ -208,7 +209,7 @@ func TestValidateAccessToken(t *testing.T) {
 			setup: func(t *testing.T, tm TokenManager) string {
 				// Generate a token with a time in the past
 				expiredTime := time.Now().Add(-2 * testAccessTTL)
-				token, err := tm.GenerateAccessToken("user-123", "emp-456", expiredTime)
+				token, err := tm.GenerateAccessToken("user-123", "emp-456", "org-1", expiredTime)
 				require.NoError(t, err)
 				return token
 			},
@@ -242,7 +243,7 @@ func TestValidateAccessToken(t *testing.T) {
 			setup: func(t *testing.T, tm TokenManager) string {
 				// Create a token with a different secret
 				wrongTm := NewTokenManager("wrong-secret-key-32-bytes-long!", testRefreshSecret, testAccessTTL, testRefreshTTL, 5*time.Minute)
-				token, err := wrongTm.GenerateAccessToken("user-123", "emp-456", time.Now())
+				token, err := wrongTm.GenerateAccessToken("user-123", "emp-456", "org-1", time.Now())
 				require.NoError(t, err)
 				return token
 			},
@@ -254,7 +255,7 @@ func TestValidateAccessToken(t *testing.T) {
 		{
 			name: "tampered_token",
 			setup: func(t *testing.T, tm TokenManager) string {
-				token, err := tm.GenerateAccessToken("user-123", "emp-456", time.Now())
+				token, err := tm.GenerateAccessToken("user-123", "emp-456", "org-1", time.Now())
 				require.NoError(t, err)
 				// Tamper with the token by modifying a character
 				runes := []rune(token)
@@ -374,7 +375,7 @@ func TestValidateRefreshToken(t *testing.T) {
 			name: "access_token_used_as_refresh",
 			setup: func(t *testing.T, tm TokenManager) string {
 				// Try to use an access token for refresh validation
-				token, err := tm.GenerateAccessToken("user-123", "emp-456", time.Now())
+				token, err := tm.GenerateAccessToken("user-123", "emp-456", "org-1", time.Now())
 				require.NoError(t, err)
 				return token
 			},
@@ -442,7 +443,7 @@ func TestCrossTokenValidation(t *testing.T) {
 		{
 			name: "refresh_token_cannot_be_validated_as_access",
 			setup: func(t *testing.T, tm TokenManager) (string, string) {
-				access, err := tm.GenerateAccessToken("user-123", "emp-456", time.Now())
+				access, err := tm.GenerateAccessToken("user-123", "emp-456", "org-1", time.Now())
 				require.NoError(t, err)
 				refresh, _, err := tm.GenerateRefreshToken("user-123", time.Now())
 				require.NoError(t, err)
@@ -458,7 +459,7 @@ func TestCrossTokenValidation(t *testing.T) {
 		{
 			name: "access_token_cannot_be_validated_as_refresh",
 			setup: func(t *testing.T, tm TokenManager) (string, string) {
-				access, err := tm.GenerateAccessToken("user-123", "emp-456", time.Now())
+				access, err := tm.GenerateAccessToken("user-123", "emp-456", "org-1", time.Now())
 				require.NoError(t, err)
 				refresh, _, err := tm.GenerateRefreshToken("user-123", time.Now())
 				require.NoError(t, err)
@@ -474,7 +475,7 @@ func TestCrossTokenValidation(t *testing.T) {
 		{
 			name: "both_tokens_valid_with_correct_validators",
 			setup: func(t *testing.T, tm TokenManager) (string, string) {
-				access, err := tm.GenerateAccessToken("user-123", "emp-456", time.Now())
+				access, err := tm.GenerateAccessToken("user-123", "emp-456", "org-1", time.Now())
 				require.NoError(t, err)
 				refresh, _, err := tm.GenerateRefreshToken("user-123", time.Now())
 				require.NoError(t, err)
@@ -0,0 +1,49 @@
+// Package urgency classifies deadlines by how many days remain, so the
+// notification worker and the dashboard agree on what is overdue or due soon.
+package urgency
+
+import "time"
+
+// Level is how pressing a deadline is
+type Level string
+
+const (
+	// Overdue deadlines have passed
+	Overdue Level = "overdue"
+	// High deadlines are due within Thresholds.HighDays
+	High Level = "high"
+	// Normal deadlines are due within Thresholds.DueSoonDays
+	Normal Level = "normal"
+	// None deadlines are further away than Thresholds.DueSoonDays
+	None Level = "none"
+)
+
+// Thresholds sets how many days ahead a deadline becomes pressing. A deadline
+// due today is zero days away.
+type Thresholds struct {
+	HighDays    int
+	DueSoonDays int
+}
+
+// Classify returns the level of a deadline daysUntil days away
+func (t Thresholds) Classify(daysUntil int) Level {
+	switch {
+	case daysUntil < 0:
+		return Overdue
+	case daysUntil <= t.HighDays:
+		return High
+	case daysUntil <= t.DueSoonDays:
+		return Normal
+	default:
+		return None
+	}
+}
+
+// DaysUntil counts the calendar days between now's date and due's date,
+// ignoring the time of day; it is negative once due has passed. Today is
+// taken in now's location, so callers pass now in the application timezone.
+func DaysUntil(due, now time.Time) int {
+	dueDay := time.Date(due.Year(), due.Month(), due.Day(), 0, 0, 0, 0, time.UTC)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	return int(dueDay.Sub(today).Hours() / 24)
+}
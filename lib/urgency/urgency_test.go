@@ -0,0 +1,38 @@
+package urgency
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassify(t *testing.T) {
+	thresholds := Thresholds{HighDays: 1, DueSoonDays: 3}
+
+	tests := []struct {
+		daysUntil int
+		want      Level
+	}{
+		{daysUntil: -1, want: Overdue},
+		{daysUntil: 0, want: High},
+		{daysUntil: 1, want: High},
+		{daysUntil: 2, want: Normal},
+		{daysUntil: 3, want: Normal},
+		{daysUntil: 4, want: None},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, thresholds.Classify(tt.daysUntil), "daysUntil=%d", tt.daysUntil)
+	}
+}
+
+func TestDaysUntil(t *testing.T) {
+	now := time.Date(2025, time.March, 10, 23, 30, 0, 0, time.UTC)
+
+	// Only the calendar dates count, not the hours in between
+	assert.Equal(t, 0, DaysUntil(time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC), now))
+	assert.Equal(t, 1, DaysUntil(time.Date(2025, time.March, 11, 0, 0, 0, 0, time.UTC), now))
+	assert.Equal(t, -1, DaysUntil(time.Date(2025, time.March, 9, 23, 59, 0, 0, time.UTC), now))
+	assert.Equal(t, 21, DaysUntil(time.Date(2025, time.March, 31, 0, 0, 0, 0, time.UTC), now))
+}
@@ -0,0 +1,5 @@
+package util
+
+// DefaultMaxAttachmentsPerForm is how many attachments a form may link when
+// MAX_ATTACHMENTS_PER_FORM is not set.
+const DefaultMaxAttachmentsPerForm = 20
@@ -7,9 +7,11 @@ import (
 )
 
 const (
-	UserIDKey     = "user_id"
-	EmployeeIDKey = "employee_id"
-	ClientIDKey   = "audit_client_id" // NEN7510: Track which client's data was accessed
+	UserIDKey         = "user_id"
+	EmployeeIDKey     = "employee_id"
+	OrganizationIDKey = "organization_id"
+	ClientIDKey       = "audit_client_id" // NEN7510: Track which client's data was accessed
+	ClientIPKey       = "client_ip"       // Set by middleware.ClientIPMiddleware
 )
 
 func GetUserID(ctx context.Context) string {
@@ -23,6 +25,15 @@ func GetUserID(ctx context.Context) string {
 	return ""
 }
 
+// GetUserIDPtr returns the authenticated user ID for nullable columns,
+// or nil when the context carries no user
+func GetUserIDPtr(ctx context.Context) *string {
+	if userID := GetUserID(ctx); userID != "" {
+		return &userID
+	}
+	return nil
+}
+
 func GetEmployeeID(ctx context.Context) string {
 	if ginCtx, ok := ctx.(*gin.Context); ok {
 		return ginCtx.GetString(EmployeeIDKey)
@@ -34,6 +45,27 @@ func GetEmployeeID(ctx context.Context) string {
 	return ""
 }
 
+// GetOrganizationID returns the tenant of the authenticated user, or "" when
+// the token carries none
+func GetOrganizationID(ctx context.Context) string {
+	if ginCtx, ok := ctx.(*gin.Context); ok {
+		return ginCtx.GetString(OrganizationIDKey)
+	}
+	if organizationID, ok := ctx.Value(OrganizationIDKey).(string); ok {
+		return organizationID
+	}
+	return ""
+}
+
+// GetOrganizationIDPtr returns the authenticated user's organization for
+// nullable columns, or nil when the context carries none
+func GetOrganizationIDPtr(ctx context.Context) *string {
+	if organizationID := GetOrganizationID(ctx); organizationID != "" {
+		return &organizationID
+	}
+	return nil
+}
+
 func GetRequestID(ctx context.Context) string {
 	if ginCtx, ok := ctx.(*gin.Context); ok {
 		if v, exists := ginCtx.Get("X-Request-Id"); exists {
@@ -48,9 +80,15 @@ func GetRequestID(ctx context.Context) string {
 	return ""
 }
 
+// GetIPAddress returns the client address resolved by the client IP
+// middleware. Without it, the peer address is used; forwarding headers are
+// never read here, since only the middleware knows which proxies to trust.
 func GetIPAddress(ctx context.Context) string {
 	if ginCtx, ok := ctx.(*gin.Context); ok {
-		return ginCtx.ClientIP()
+		if ip := ginCtx.GetString(ClientIPKey); ip != "" {
+			return ip
+		}
+		return ginCtx.RemoteIP()
 	}
 	return ""
 }
@@ -2,6 +2,7 @@ package util
 
 import (
 	"context"
+	"net/http"
 	"net/http/httptest"
 	"testing"
 
@@ -51,3 +52,53 @@ func TestGetUserID(t *testing.T) {
 		})
 	}
 }
+
+func TestGetIPAddress(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	newCtx := func() *gin.Context {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+		c.Request.RemoteAddr = "203.0.113.7:5000"
+		c.Request.Header.Set("X-Forwarded-For", "10.1.2.3")
+		return c
+	}
+
+	tests := []struct {
+		name     string
+		setupCtx func() context.Context
+		want     string
+	}{
+		{
+			name: "Gin context with resolved client IP",
+			setupCtx: func() context.Context {
+				c := newCtx()
+				c.Set(ClientIPKey, "198.51.100.4")
+				return c
+			},
+			want: "198.51.100.4",
+		},
+		{
+			name: "Gin context without resolved client IP ignores forwarding headers",
+			setupCtx: func() context.Context {
+				return newCtx()
+			},
+			want: "203.0.113.7",
+		},
+		{
+			name: "Standard context",
+			setupCtx: func() context.Context {
+				return context.Background()
+			},
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetIPAddress(tt.setupCtx()); got != tt.want {
+				t.Errorf("GetIPAddress() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
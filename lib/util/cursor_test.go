@@ -0,0 +1,30 @@
+package util
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	createdAt := time.Date(2025, 3, 4, 5, 6, 7, 123456000, time.UTC)
+
+	cursor := EncodeCursor(createdAt, "client_a-1")
+	gotTime, gotID, err := DecodeCursor(cursor)
+	if err != nil {
+		t.Fatalf("DecodeCursor() error = %v", err)
+	}
+	if !gotTime.Equal(createdAt) {
+		t.Errorf("createdAt = %v, want %v", gotTime, createdAt)
+	}
+	if gotID != "client_a-1" {
+		t.Errorf("id = %q, want %q", gotID, "client_a-1")
+	}
+}
+
+func TestDecodeCursorInvalid(t *testing.T) {
+	for _, cursor := range []string{"", "!!!", EncodeCursor(time.Time{}, "")[:4], "bm90LWEtY3Vyc29y"} {
+		if _, _, err := DecodeCursor(cursor); err == nil {
+			t.Errorf("DecodeCursor(%q) expected error", cursor)
+		}
+	}
+}
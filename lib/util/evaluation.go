@@ -0,0 +1,17 @@
+package util
+
+// Bounds for a client's evaluation interval. The same range is enforced by
+// CHECK constraints on intake_forms and clients.
+const (
+	MinEvaluationIntervalWeeks = 1
+	MaxEvaluationIntervalWeeks = 52
+)
+
+// ValidEvaluationInterval reports whether weeks is within the allowed range.
+// A nil interval is valid; the column falls back to its default.
+func ValidEvaluationInterval(weeks *int) bool {
+	if weeks == nil {
+		return true
+	}
+	return *weeks >= MinEvaluationIntervalWeeks && *weeks <= MaxEvaluationIntervalWeeks
+}
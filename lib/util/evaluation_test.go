@@ -0,0 +1,31 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidEvaluationInterval(t *testing.T) {
+	weeks := func(v int) *int { return &v }
+
+	tests := []struct {
+		name  string
+		weeks *int
+		want  bool
+	}{
+		{name: "nil", weeks: nil, want: true},
+		{name: "below_min", weeks: weeks(0), want: false},
+		{name: "negative", weeks: weeks(-3), want: false},
+		{name: "min", weeks: weeks(MinEvaluationIntervalWeeks), want: true},
+		{name: "valid", weeks: weeks(6), want: true},
+		{name: "max", weeks: weeks(MaxEvaluationIntervalWeeks), want: true},
+		{name: "above_max", weeks: weeks(520), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ValidEvaluationInterval(tt.weeks))
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package util
+
+import (
+	"errors"
+	"slices"
+	"strings"
+)
+
+// ErrInvalidSort is returned for a sort parameter naming a field that is not
+// allowed or an unknown direction.
+var ErrInvalidSort = errors.New("invalid sort")
+
+// SortOrder is a validated sort field and direction.
+type SortOrder struct {
+	Field string
+	Desc  bool
+}
+
+// ParseSort parses a sort query parameter of the form "field" or
+// "field:asc|desc". The field must be one of allowed; anything else is
+// rejected, so the result is safe to hand to a query. An empty value returns
+// the zero SortOrder, which lists keep in their default order.
+func ParseSort(value string, allowed ...string) (SortOrder, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return SortOrder{}, nil
+	}
+
+	field, direction, _ := strings.Cut(value, ":")
+	order := SortOrder{Field: strings.ToLower(strings.TrimSpace(field))}
+	if !slices.Contains(allowed, order.Field) {
+		return SortOrder{}, ErrInvalidSort
+	}
+	switch strings.ToLower(strings.TrimSpace(direction)) {
+	case "", "asc":
+	case "desc":
+		order.Desc = true
+	default:
+		return SortOrder{}, ErrInvalidSort
+	}
+	return order, nil
+}
+
+// Key returns the order as "field_asc" or "field_desc", the form list queries
+// switch on, or "" for the default order.
+func (o SortOrder) Key() string {
+	if o.Field == "" {
+		return ""
+	}
+	if o.Desc {
+		return o.Field + "_desc"
+	}
+	return o.Field + "_asc"
+}
@@ -0,0 +1,39 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSort(t *testing.T) {
+	allowed := []string{"name", "created_at"}
+
+	tests := []struct {
+		name    string
+		value   string
+		wantKey string
+		wantErr bool
+	}{
+		{name: "default", value: "", wantKey: ""},
+		{name: "field_only_is_ascending", value: "name", wantKey: "name_asc"},
+		{name: "descending", value: "created_at:desc", wantKey: "created_at_desc"},
+		{name: "case_and_space_insensitive", value: " Name : DESC ", wantKey: "name_desc"},
+		{name: "column_not_allowed", value: "bsn", wantErr: true},
+		{name: "injection_attempt", value: "name; DROP TABLE clients", wantErr: true},
+		{name: "unknown_direction", value: "name:sideways", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order, err := ParseSort(tt.value, allowed...)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrInvalidSort)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantKey, order.Key())
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package util
+
+import "unicode/utf8"
+
+// MaxTextFieldLength is the hard ceiling, in characters, for the large
+// free-text fields (notes, focus areas, closing reports). The same limit is
+// enforced by CHECK constraints on intake_forms and clients; the configured
+// limit may be lower but never higher.
+const MaxTextFieldLength = 20000
+
+// DefaultTextFieldLength is the configured limit when none is set.
+const DefaultTextFieldLength = 10000
+
+// TextWithinLimit reports whether every non-nil value is at most limit
+// characters long.
+func TextWithinLimit(limit int, values ...*string) bool {
+	for _, v := range values {
+		if v != nil && utf8.RuneCountInString(*v) > limit {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,34 @@
+package util
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTextWithinLimit(t *testing.T) {
+	text := func(n int) *string {
+		s := strings.Repeat("a", n)
+		return &s
+	}
+
+	tests := []struct {
+		name   string
+		values []*string
+		want   bool
+	}{
+		{name: "no_values", values: nil, want: true},
+		{name: "nil_value", values: []*string{nil}, want: true},
+		{name: "at_limit", values: []*string{text(10)}, want: true},
+		{name: "over_limit", values: []*string{text(11)}, want: false},
+		{name: "one_of_many_over", values: []*string{text(1), nil, text(11)}, want: false},
+		{name: "multibyte_at_limit", values: []*string{StrPtr(strings.Repeat("é", 10))}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, TextWithinLimit(10, tt.values...))
+		})
+	}
+}
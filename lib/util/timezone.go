@@ -0,0 +1,81 @@
+package util
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	// Embed the timezone database so the slim runtime images can load zones
+	_ "time/tzdata"
+)
+
+// DefaultTimezone is the application timezone unless APP_TIMEZONE is set.
+const DefaultTimezone = "Europe/Amsterdam"
+
+var appLocation atomic.Pointer[time.Location]
+
+func init() {
+	loc, err := time.LoadLocation(DefaultTimezone)
+	if err != nil {
+		panic(err)
+	}
+	appLocation.Store(loc)
+}
+
+// SetAppTimezone sets the timezone used for date formatting and day, week and
+// month boundaries. It is called once at startup with the configured zone.
+func SetAppTimezone(name string) error {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return fmt.Errorf("load timezone %q: %w", name, err)
+	}
+	appLocation.Store(loc)
+	return nil
+}
+
+// AppLocation returns the application timezone.
+func AppLocation() *time.Location {
+	return appLocation.Load()
+}
+
+// InAppTimezone returns t in the application timezone.
+func InAppTimezone(t time.Time) time.Time {
+	return t.In(AppLocation())
+}
+
+// StartOfDay returns local midnight of the day t falls on.
+func StartOfDay(t time.Time) time.Time {
+	t = InAppTimezone(t)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// StartOfWeek returns local midnight of the Monday of the week t falls in.
+func StartOfWeek(t time.Time) time.Time {
+	day := StartOfDay(t)
+	offset := (int(day.Weekday()) + 6) % 7 // days since Monday
+	return day.AddDate(0, 0, -offset)
+}
+
+// StartOfMonth returns local midnight of the first day of the month t falls in.
+func StartOfMonth(t time.Time) time.Time {
+	t = InAppTimezone(t)
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+}
+
+// Today returns the current date in the application timezone, for queries that
+// compare against DATE columns while the database session runs in UTC.
+func Today() pgtype.Date {
+	return TimeToPgtypeDate(StartOfDay(time.Now()))
+}
+
+// FormatDate formats t as YYYY-MM-DD in the application timezone.
+func FormatDate(t time.Time) string {
+	return InAppTimezone(t).Format(time.DateOnly)
+}
+
+// FormatClock formats t as HH:MM in the application timezone.
+func FormatClock(t time.Time) string {
+	return InAppTimezone(t).Format("15:04")
+}
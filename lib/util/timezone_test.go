@@ -0,0 +1,86 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppTimezoneFormatting(t *testing.T) {
+	require.NoError(t, SetAppTimezone(DefaultTimezone))
+
+	tests := []struct {
+		name      string
+		at        time.Time
+		wantDate  string
+		wantClock string
+	}{
+		{
+			name:      "winter_time",
+			at:        time.Date(2026, time.January, 15, 10, 0, 0, 0, time.UTC),
+			wantDate:  "2026-01-15",
+			wantClock: "11:00",
+		},
+		{
+			name:      "summer_time",
+			at:        time.Date(2026, time.July, 1, 10, 0, 0, 0, time.UTC),
+			wantDate:  "2026-07-01",
+			wantClock: "12:00",
+		},
+		{
+			name:      "late_utc_evening_is_next_local_day",
+			at:        time.Date(2026, time.June, 30, 22, 30, 0, 0, time.UTC),
+			wantDate:  "2026-07-01",
+			wantClock: "00:30",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantDate, FormatDate(tt.at))
+			assert.Equal(t, tt.wantClock, FormatClock(tt.at))
+		})
+	}
+}
+
+func TestAppTimezoneBoundaries(t *testing.T) {
+	require.NoError(t, SetAppTimezone(DefaultTimezone))
+
+	t.Run("month_starts_at_local_midnight_across_dst", func(t *testing.T) {
+		// 01:30 CEST on 1 April, still 31 March in UTC
+		at := time.Date(2026, time.March, 31, 23, 30, 0, 0, time.UTC)
+		start := StartOfMonth(at)
+		assert.Equal(t, time.April, start.Month())
+		// March started in CET, April starts in CEST
+		assert.Equal(t, time.Date(2026, time.March, 31, 22, 0, 0, 0, time.UTC), start.UTC())
+		assert.Equal(t, time.Date(2026, time.February, 28, 23, 0, 0, 0, time.UTC), StartOfMonth(start.Add(-time.Hour)).UTC())
+	})
+
+	t.Run("day_of_dst_change", func(t *testing.T) {
+		// Clocks go forward on 29 March 2026; the day is 23 hours long
+		at := time.Date(2026, time.March, 29, 12, 0, 0, 0, time.UTC)
+		start := StartOfDay(at)
+		assert.Equal(t, time.Date(2026, time.March, 28, 23, 0, 0, 0, time.UTC), start.UTC())
+		assert.Equal(t, 23*time.Hour, StartOfDay(start.AddDate(0, 0, 1)).Sub(start))
+	})
+
+	t.Run("week_starts_on_monday", func(t *testing.T) {
+		// Sunday 5 April 2026, local time
+		at := time.Date(2026, time.April, 5, 20, 0, 0, 0, time.UTC)
+		start := StartOfWeek(at)
+		assert.Equal(t, time.Monday, start.Weekday())
+		assert.Equal(t, "2026-03-30", FormatDate(start))
+	})
+}
+
+func TestSetAppTimezone(t *testing.T) {
+	t.Cleanup(func() { _ = SetAppTimezone(DefaultTimezone) })
+
+	require.Error(t, SetAppTimezone("Mars/Olympus_Mons"))
+	assert.Equal(t, DefaultTimezone, AppLocation().String(), "an invalid zone keeps the current one")
+
+	require.NoError(t, SetAppTimezone("UTC"))
+	assert.Equal(t, "09:00", FormatClock(time.Date(2026, time.January, 1, 9, 0, 0, 0, time.UTC)))
+}
@@ -0,0 +1,31 @@
+// Package version holds build information injected at link time, e.g.
+//
+//	go build -ldflags "-X care-cordination/lib/version.Version=v1.2.0 \
+//	  -X care-cordination/lib/version.Commit=$(git rev-parse --short HEAD) \
+//	  -X care-cordination/lib/version.BuildTime=$(date -u +%Y-%m-%dT%H:%M:%SZ)"
+//
+// Values stay "dev" for local builds that do not set them.
+package version
+
+// Set via -ldflags "-X ..." at build time
+var (
+	Version   = "dev"
+	Commit    = "dev"
+	BuildTime = "dev"
+)
+
+// Info describes the running build
+type Info struct {
+	Version   string `json:"version"   example:"v1.2.0"`
+	Commit    string `json:"commit"    example:"a1b2c3d"`
+	BuildTime string `json:"buildTime" example:"2026-01-22T10:00:00Z"`
+}
+
+// Get returns the build information of the running binary
+func Get() Info {
+	return Info{
+		Version:   Version,
+		Commit:    Commit,
+		BuildTime: BuildTime,
+	}
+}
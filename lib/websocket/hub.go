@@ -3,6 +3,7 @@ package websocket
 import (
 	"context"
 	"sync"
+	"time"
 
 	"care-cordination/lib/logger"
 
@@ -31,6 +32,17 @@ type Hub struct {
 
 	// Worker done channel for graceful shutdown (used in tests)
 	workerDone chan struct{}
+
+	// batchWindow holds notifications to a connection for this long so a burst
+	// goes out as one notifications_batch frame; 0 sends each one immediately
+	batchWindow time.Duration
+
+	// Notifications per connection waiting for their batch window to close;
+	// only touched by the Run loop
+	pending map[*Client][]NotificationPayload
+
+	// Connections whose batch window has closed
+	flush chan *Client
 }
 
 // BroadcastMessage contains the message and target user
@@ -39,15 +51,26 @@ type BroadcastMessage struct {
 	Message *Message // The message to send
 }
 
-// NewHub creates a new Hub instance
+// NewHub creates a new Hub instance that sends every message immediately
 func NewHub(logger logger.Logger) *Hub {
+	return NewHubWithBatchWindow(logger, 0)
+}
+
+// NewHubWithBatchWindow creates a Hub that coalesces the notifications sent to
+// a connection within batchWindow of the first one into a single
+// notifications_batch message. A lone notification is still sent as a plain
+// notification message. A batchWindow of 0 disables batching.
+func NewHubWithBatchWindow(logger logger.Logger, batchWindow time.Duration) *Hub {
 	return &Hub{
-		clients:    make(map[string]map[*Client]bool),
-		broadcast:  make(chan *BroadcastMessage, 256),
-		register:   make(chan *Client),
-		unregister: make(chan *Client),
-		logger:     logger,
-		workerDone: make(chan struct{}),
+		clients:     make(map[string]map[*Client]bool),
+		broadcast:   make(chan *BroadcastMessage, 256),
+		register:    make(chan *Client),
+		unregister:  make(chan *Client),
+		logger:      logger,
+		workerDone:  make(chan struct{}),
+		batchWindow: batchWindow,
+		pending:     make(map[*Client][]NotificationPayload),
+		flush:       make(chan *Client),
 	}
 }
 
@@ -64,6 +87,9 @@ func (h *Hub) Run() {
 		case message := <-h.broadcast:
 			h.broadcastMessage(message)
 
+		case client := <-h.flush:
+			h.flushBatch(client)
+
 		case <-h.workerDone:
 			return
 		}
@@ -91,6 +117,9 @@ func (h *Hub) unregisterClient(client *Client) {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
+	// Notifications still held for the connection have nowhere to go
+	delete(h.pending, client)
+
 	if clients, ok := h.clients[client.UserID]; ok {
 		if _, ok := clients[client]; ok {
 			delete(clients, client)
@@ -117,30 +146,81 @@ func (h *Hub) broadcastMessage(msg *BroadcastMessage) {
 		// Send to specific user (all their connections)
 		if clients, ok := h.clients[msg.UserID]; ok {
 			for client := range clients {
-				select {
-				case client.send <- msg.Message:
-				default:
-					// Client's send buffer is full, close connection
-					close(client.send)
-					delete(clients, client)
-				}
+				h.deliver(clients, client, msg.Message)
 			}
 		}
 	} else {
 		// Broadcast to all connected clients
 		for _, clients := range h.clients {
 			for client := range clients {
-				select {
-				case client.send <- msg.Message:
-				default:
-					close(client.send)
-					delete(clients, client)
-				}
+				h.deliver(clients, client, msg.Message)
 			}
 		}
 	}
 }
 
+// deliver sends a message to one connection, or holds it for the
+// connection's next batch when batching is on and it is a notification
+func (h *Hub) deliver(clients map[*Client]bool, client *Client, message *Message) {
+	payload, ok := message.Payload.(NotificationPayload)
+	if h.batchWindow <= 0 || message.Type != MessageTypeNotification || !ok {
+		h.send(clients, client, message)
+		return
+	}
+
+	// The first notification opens the window; the rest join its batch
+	if len(h.pending[client]) == 0 {
+		time.AfterFunc(h.batchWindow, func() {
+			select {
+			case h.flush <- client:
+			case <-h.workerDone:
+			}
+		})
+	}
+	h.pending[client] = append(h.pending[client], payload)
+}
+
+// flushBatch sends the notifications held for a connection once its batch
+// window has closed
+func (h *Hub) flushBatch(client *Client) {
+	notifications := h.pending[client]
+	delete(h.pending, client)
+	if len(notifications) == 0 {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	clients, ok := h.clients[client.UserID]
+	if !ok || !clients[client] {
+		return
+	}
+
+	if len(notifications) == 1 {
+		h.send(clients, client, &Message{Type: MessageTypeNotification, Payload: notifications[0]})
+		return
+	}
+	h.send(clients, client, &Message{
+		Type: MessageTypeNotificationsBatch,
+		Payload: NotificationsBatchPayload{
+			Count:         len(notifications),
+			Notifications: notifications,
+		},
+	})
+}
+
+// send queues a message on a connection, dropping the connection when its
+// send buffer is full
+func (h *Hub) send(clients map[*Client]bool, client *Client, message *Message) {
+	select {
+	case client.send <- message:
+	default:
+		close(client.send)
+		delete(clients, client)
+	}
+}
+
 // Register adds a client to the hub
 func (h *Hub) Register(client *Client) {
 	h.register <- client
@@ -313,3 +313,81 @@ func TestCountConnections(t *testing.T) {
 
 	assert.Equal(t, 2, hub.CountConnections())
 }
+
+func TestSendToUserBatchWindow(t *testing.T) {
+	const window = 100 * time.Millisecond
+
+	newBatchingHub := func(t *testing.T) (*Hub, *Client) {
+		ctrl := gomock.NewController(t)
+		mockLogger := loggermocks.NewMockLogger(ctrl)
+		mockLogger.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+		hub := NewHubWithBatchWindow(mockLogger, window)
+		go hub.Run()
+		t.Cleanup(hub.Stop)
+
+		client := &Client{hub: hub, UserID: "user-123", send: make(chan *Message, 256)}
+		hub.Register(client)
+		return hub, client
+	}
+	notification := func(id string) *Message {
+		return &Message{Type: MessageTypeNotification, Payload: NotificationPayload{ID: id, Title: id}}
+	}
+
+	t.Run("burst_sent_as_one_batch", func(t *testing.T) {
+		hub, client := newBatchingHub(t)
+
+		hub.SendToUser("user-123", notification("notif-1"))
+		hub.SendToUser("user-123", notification("notif-2"))
+		hub.SendToUser("user-123", notification("notif-3"))
+
+		select {
+		case received := <-client.send:
+			assert.Equal(t, MessageTypeNotificationsBatch, received.Type)
+			payload, ok := received.Payload.(NotificationsBatchPayload)
+			require.True(t, ok)
+			assert.Equal(t, 3, payload.Count)
+			ids := []string{}
+			for _, n := range payload.Notifications {
+				ids = append(ids, n.ID)
+			}
+			assert.Equal(t, []string{"notif-1", "notif-2", "notif-3"}, ids)
+		case <-time.After(3 * window):
+			t.Fatal("timeout waiting for batch")
+		}
+
+		// Nothing else follows the batch
+		select {
+		case extra := <-client.send:
+			t.Fatalf("unexpected extra message %q", extra.Type)
+		case <-time.After(2 * window):
+		}
+	})
+
+	t.Run("single_notification_sent_plain", func(t *testing.T) {
+		hub, client := newBatchingHub(t)
+
+		hub.SendToUser("user-123", notification("notif-1"))
+
+		select {
+		case received := <-client.send:
+			assert.Equal(t, MessageTypeNotification, received.Type)
+			assert.Equal(t, "notif-1", received.Payload.(NotificationPayload).ID)
+		case <-time.After(3 * window):
+			t.Fatal("timeout waiting for notification")
+		}
+	})
+
+	t.Run("other_messages_not_held", func(t *testing.T) {
+		hub, client := newBatchingHub(t)
+
+		hub.SendToUser("user-123", &Message{Type: MessageTypeUnreadCount, Payload: UnreadCountPayload{Count: 2}})
+
+		select {
+		case received := <-client.send:
+			assert.Equal(t, MessageTypeUnreadCount, received.Type)
+		case <-time.After(window / 2):
+			t.Fatal("unread count was held back")
+		}
+	})
+}
@@ -3,11 +3,13 @@ package websocket
 // Message types for WebSocket communication
 const (
 	// Server -> Client message types
-	MessageTypeNotification = "notification"
-	MessageTypePing         = "ping"
-	MessageTypeConnected    = "connected"
-	MessageTypeError        = "error"
-	MessageTypeUnreadCount  = "unread_count"
+	MessageTypeNotification       = "notification"
+	MessageTypeNotificationDigest = "notification_digest"
+	MessageTypeNotificationsBatch = "notifications_batch"
+	MessageTypePing               = "ping"
+	MessageTypeConnected          = "connected"
+	MessageTypeError              = "error"
+	MessageTypeUnreadCount        = "unread_count"
 
 	// Client -> Server message types
 	MessageTypePong        = "pong"
@@ -33,6 +35,20 @@ type NotificationPayload struct {
 	CreatedAt    string  `json:"created_at"`
 }
 
+// NotificationDigestPayload is the payload for digest messages, which bundle
+// notifications that were held back instead of pushed one by one
+type NotificationDigestPayload struct {
+	Count         int                   `json:"count"`
+	Notifications []NotificationPayload `json:"notifications"`
+}
+
+// NotificationsBatchPayload is the payload for batch messages, which bundle
+// notifications to one connection that arrived within the hub's batch window
+type NotificationsBatchPayload struct {
+	Count         int                   `json:"count"`
+	Notifications []NotificationPayload `json:"notifications"`
+}
+
 // ErrorPayload is the payload for error messages
 type ErrorPayload struct {
 	Code    string `json:"code"`